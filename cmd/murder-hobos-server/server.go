@@ -5,23 +5,32 @@ import (
 	"net/http"
 	"os"
 
-	"github.com/go-sql-driver/mysql"
+	"github.com/murder-hobos/murder-hobos/db/datastore"
 	"github.com/murder-hobos/murder-hobos/routes"
 )
 
 func main() {
-	// setup DB
-	dbconfig := mysql.Config{
-		User:            os.Getenv("MYSQL_USER"),
-		Passwd:          os.Getenv("MYSQL_PASS"),
-		DBName:          os.Getenv("MYSQL_DB_NAME"),
-		Net:             "tcp",
-		Addr:            os.Getenv("MYSQL_ADDR"),
-		MultiStatements: false,
+	db, err := datastore.Open(os.Getenv("DATABASE_DSN"))
+	if err != nil {
+		// don't want the server to start without database access
+		log.Fatalln(err)
 	}
 
-	log.Println(dbconfig.FormatDSN())
-	r := routes.New(dbconfig.FormatDSN())
+	r, err := routes.New(db, http.Dir(envOr("TEMPLATES_DIR", "templates")), routes.Options{
+		SigningKey: []byte(os.Getenv("TOKEN_SIGNING_KEY")),
+		Static:     http.Dir(envOr("STATIC_DIR", "static")),
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
 	port := os.Getenv("PORT")
 	log.Fatal(http.ListenAndServe(":"+port, r))
 }
+
+// envOr returns the environment variable key, or def if it's unset
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
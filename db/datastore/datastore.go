@@ -0,0 +1,80 @@
+// Package datastore opens the model.Datastore our servers run on,
+// seeding it with the spells bundled with initDb when it needs them.
+package datastore
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/murder-hobos/murder-hobos/db/initDb"
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/model/memdb"
+)
+
+// Open picks a datastore based on dsn:
+//
+//	sqlite3://path/to/file.db  sqlite, seeded with our spells if empty
+//	memory:                    in-memory, seeded with our spells
+//	anything else              a mysql DataSourceName
+//
+// An empty dsn builds a mysql one from the MYSQL_* environment variables.
+func Open(dsn string) (model.Datastore, error) {
+	switch {
+	case strings.HasPrefix(dsn, "sqlite3://"):
+		path := strings.TrimPrefix(dsn, "sqlite3://")
+		log.Printf("Using sqlite database %s\n", path)
+		db, err := model.NewSQLiteDB(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := Seed(db); err != nil {
+			db.Close()
+			return nil, err
+		}
+		return db, nil
+	case dsn == "memory:":
+		log.Println("Using in-memory datastore")
+		return memdb.NewSeeded()
+	case dsn == "":
+		dbconfig := mysql.Config{
+			User:            os.Getenv("MYSQL_USER"),
+			Passwd:          os.Getenv("MYSQL_PASS"),
+			DBName:          os.Getenv("MYSQL_DB_NAME"),
+			Net:             "tcp",
+			Addr:            os.Getenv("MYSQL_ADDR"),
+			MultiStatements: false,
+		}
+		dsn = dbconfig.FormatDSN()
+	}
+	log.Println(dsn)
+	return model.NewDB(dsn)
+}
+
+// Seed imports our bundled compendium into db if it has no spells yet.
+// Spells are streamed in through initDb.UpdateStream, the same as
+// murder-hobos-init-db does.
+func Seed(db *model.DB) error {
+	spells, err := db.GetAllCannonSpells()
+	if err != nil {
+		return err
+	}
+	if len(*spells) > 0 {
+		return nil
+	}
+
+	b, err := initDb.Asset(initDb.CompendiumAsset)
+	if err != nil {
+		return err
+	}
+	log.Println("Importing spells")
+	t, err := initDb.UpdateStream(db.DB, bytes.NewReader(b), nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("Imported spells: %s\n", t)
+	// UpdateStream goes around db, so it doesn't know about the new spells
+	return db.LoadSpellNames()
+}
@@ -0,0 +1,53 @@
+package datastore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+func TestOpen_Memory(t *testing.T) {
+	db, err := Open("memory:")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if spells, err := db.GetAllCannonSpells(); err != nil || len(*spells) == 0 {
+		t.Errorf("GetAllCannonSpells() = %v, want some spells", err)
+	}
+}
+
+func TestOpen_SQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dsn := "sqlite3://" + filepath.Join(dir, "spells.db")
+
+	db, err := Open(dsn)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	spells, err := db.GetAllCannonSpells()
+	if err != nil || len(*spells) == 0 {
+		t.Fatalf("GetAllCannonSpells() = %v, want some spells", err)
+	}
+	seeded := len(*spells)
+	if found, err := db.SuggestSpells(0, "fire"); err != nil || len(*found) == 0 {
+		t.Errorf("SuggestSpells() after seeding = %v, %v, want some", found, err)
+	}
+	db.(*model.DB).Close()
+
+	// opening it again doesn't import the spells twice
+	db, err = Open(dsn)
+	if err != nil {
+		t.Fatalf("Open() again error = %v", err)
+	}
+	defer db.(*model.DB).Close()
+	if spells, err := db.GetAllCannonSpells(); err != nil || len(*spells) != seeded {
+		t.Errorf("GetAllCannonSpells() after opening again = %v, want %d spells", err, seeded)
+	}
+}
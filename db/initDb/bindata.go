@@ -1,7 +1,6 @@
 // Code generated by go-bindata.
 // sources:
 // data/Spells Compendium 1.2.1.xml
-// data/drop-everything-and-start-over.sql
 // DO NOT EDIT!
 
 package initDb
@@ -69,7 +68,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataSpellsCompendium121Xml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbd\xdb\xb6\x1b\xb7\xb5\x26\x7c\xbd\xf7\x53\x60\xec\x1b\x49\x6d\x4a\xd6\x5a\x3a\x44\xf1\x76\xbb\xc7\xb2\x24\xc7\x1e\x3b\x8e\x3d\x22\x65\x67\xf8\x12\xac\x02\x49\x64\xa1\x80\x32\x80\x5a\x34\x7d\xd5\xcf\xd2\x8f\xd6\x4f\xf2\x8f\x79\x00\x0a\x55\x2c\x16\x8b\xd2\x92\x93\xf4\x9f\x2b\x5b\x5c\x64\x15\x8e\xf3\xf8\xcd\x6f\x7e\xf9\xbf\x7e\x69\x8c\xb8\x53\x3e\x68\x67\xff\xe7\x83\xab\x27\x4f\x1f\x08\x65\x2b\x57\x6b\xbb\xfd\x9f\x0f\xfe\xf2\xfe\x9b\xc7\xaf\x1e\xfc\xaf\xaf\xfe\xfd\xcb\xca\x35\xad\xb2\xb5\xee\x9a\xfc\xe5\xff\x78\xf1\x1f\x5f\xfd\xfb\xbf\xff\xfb\xbf\x7d\x19\x5a\x65\xcc\x57\xff\xfe\x6f\xff\xf6\xa5\x95\x8d\xfa\xea\x66\xad\x1f\xbf\x91\xe6\x57\xdd\x3c\x08\xe2\x5b\xe7\xbd\xae\xc5\x5f\xb5\x89\xda\x6e\xc5\xc3\xb7\x6f\x1f\x7d\xf9\x39\x7e\x0d\xbe\x6f\xd4\x9d\x32\x5f\xbd\xfa\xf2\x73\xfa\x1f\xf8\x28\x54\x3b\xe7\xcc\x57\x7f\xfa\xf2\x73\xfe\x3f\xf8\x30\xea\x46\x7d\x75\x25\x64\x15\xb5\xb3\x5f\x7e\x8e\xff\x84\xcf\xbd\xb4\x5b\xf5\xd5\xd5\x8b\xa7\x62\xa3\x54\xfc\xf2\x73\xfa\x37\xfc\x05\xc6\xeb\xac\xb2\x31\x7c\xf5\xdf\x2b\xf1\x6e\x25\xbe\x17\x0f\xa5\x58\xeb\x28\xdc\x46\x84\xd6\xd9\xad\x7a\xf4\xe5\xe7\xc5\xb7\xe0\x47\x75\xe7\x25\xbc\xe1\xab\xef\x6c\x88\xd2\x46\x69\x95\xeb\xc2\x97\x9f\xe7\xcf\xf1\xc9\x46\x86\xa0\xc2\x57\xef\x9c\xaf\x94\x57\x7e\x25\xfe\xaa\x7f\x95\xbe\xfe\xf2\xf3\xf4\x17\x1c\xb1\xfa\x25\x7e\xf5\x93\xeb\x44\xed\xe5\x5e\xc4\x9d\x12\x8d\xd3\x21\x76\x5e\x89\x8d\x77\x8d\x50\x77\xca\x1f\x44\xe5\x95\xc4\xcf\xb4\x15\x52\x3c\x7b\xfa\x78\xe3\x5c\x14\x55\xb7\x56\xa2\x52\x36\x2a\xaf\x6a\xe1\xe0\x4f\xad\xd3\x36\x8a\x83\xeb\x04\x2c\x4a\x50\x62\xaf\xe3\x4e\x5b\x81\x13\x7e\x22\xde\xca\x6a\x37\x78\x58\xdc\xc9\x28\xa4\x57\x52\x34\x5d\x88\xa2\x91\xb7\x4a\x48\xf1\xda\xd9\x10\x75\xec\x60\x2e\x22\xc8\x3b\xd8\x91\xb8\xf3\x6e\xff\x84\xfe\xe4\xbb\x2a\x06\x21\x6d\x2d\x3a\x5b\x2b\x59\xc3\x13\xec\x83\x28\xe4\x66\xa3\xaa\xa8\xea\x15\xfe\xad\x35\xd2\xf2\xd7\xf6\x32\x2a\x2f\x94\x51\x8d\xb2\x51\x9a\x40\x2f\x8a\x3b\x1d\x06\x8f\xc7\xd1\x8a\x5a\x07\x59\xdf\xc1\xb2\xc2\x90\x6f\xfa\xf1\x46\x79\xab\x82\xb8\x7a\x5a\xbf\x12\x56\x55\xde\x45\x5d\x89\x5a\x36\x72\xab\x68\xf2\x1b\xa9\x8d\xaa\xe1\x89\x6a\x25\x9c\x17\x3b\x69\x36\x42\x06\xd1\x74\xd5\x6e\xf0\xc5\xd0\x55\x95\x0a\x61\xd3\x19\xe1\xac\x7a\xf2\xe5\xe7\xb8\x0b\x69\x3b\xc4\xe7\xfd\xce\xbc\xc7\x21\xc2\xb9\x15\x95\xb4\x62\xad\xc4\xc6\x75\xb6\xa6\xa5\x53\xe2\x6d\x9a\x91\x78\x7b\xa7\x8d\xf8\xd1\xc8\x83\xf2\x0f\x82\x78\xed\x9a\x56\x5a\x3a\x83\xe9\xd1\xde\x19\xf3\x15\x0c\xfe\xcb\xcf\xf1\x7f\xff\xfd\xdf\xbe\xfc\x3c\xdd\x88\xe3\xab\x11\x9c\x5f\xa7\xc7\x87\xe9\xeb\x70\x35\x71\x1d\x6e\xa6\xae\x83\x57\x74\x21\x56\x62\xbf\xd3\xd5\x0e\x8f\x07\xac\xa5\xd8\xef\x94\xed\xff\x25\x2b\x5d\xaf\x44\xe5\x4c\xbd\x12\x1b\xed\xd5\x4a\x18\xbd\xdd\x45\xab\xed\x16\x97\x33\xee\x60\xb7\x3d\xaf\xe4\xd1\xed\x7a\xa7\xcc\xe6\xc4\xcd\x7a\x77\xfa\x06\x5d\x09\x0f\x0b\x7a\xe2\xee\xbc\xf1\x1d\x8c\xe9\xcf\xf0\xd0\x7c\x81\x56\xe2\x1b\x18\x97\xf2\xe2\xe1\x5b\x53\x7b\x1d\xab\x9d\xf8\x2f\x0b\x1f\x3d\x9a\xb8\x5b\xef\x77\x2a\x6f\x60\x0b\xc7\x28\x88\xe0\x1a\x05\xd7\x1b\x76\x50\xdb\xca\x35\x70\x00\x95\x55\x7e\x7b\x58\x09\xa3\x42\x50\x30\x67\xa1\x63\x10\x0a\x4f\x34\x9c\x1a\x58\x26\x38\xca\x21\x3a\x4f\x7f\x15\x1b\xe7\xe1\x63\x2f\x2c\x9c\x9a\x46\x19\xa5\x84\x8c\x51\x56\xb7\x4f\x04\x5c\xe9\x9d\xbc\x53\xc2\xab\xa0\x41\x48\x54\x4a\x44\x87\xaf\x8c\x5e\x6f\xb7\x0a\x1f\xc2\x87\x32\x1e\x5a\x25\x3a\x1b\xb5\xc1\x2f\x84\x28\x3d\xca\x9f\xfe\xe1\xb1\xf3\xf6\x89\xb8\x31\xc1\xad\xf0\x2b\x1b\xed\x43\x14\xb0\x03\x38\xb0\x9d\x8e\x74\x77\xe4\x60\x18\x3c\xee\xe2\x19\xf4\xeb\x28\xfd\x56\x45\xbe\x4f\xd2\x0a\xf5\x4b\xf4\x52\x5c\xd5\x2f\xf3\x2d\xd9\x8c\x47\x0a\x43\xa4\x6b\x1d\xf3\x82\x2a\x5b\x87\xb9\xcb\x73\x13\xc5\xb7\x7a\xbb\x53\x5e\xfc\x11\xce\x69\xf8\x42\xfc\x35\x1d\xb8\x4a\xc2\xf0\xfb\xcb\xd5\x05\x78\x89\xe4\x7f\x05\xe3\x70\xfe\xd7\xb6\x16\x78\xc4\xf1\x36\xe3\xa3\x68\x02\x34\x60\x1e\xac\xb6\x20\x21\x82\x0a\x62\x7d\xc0\x49\xc0\xbe\x28\x90\x74\xf8\x1c\x7a\x80\x5c\xbb\x3b\x25\xae\x42\xfc\x4d\x6f\x7b\xfd\x72\xc9\x65\xaf\x74\x2d\xde\xb5\x46\x86\xdd\xd1\x1d\x7f\x3a\x71\xc7\x5f\x5f\xa0\xf2\x5e\x9e\xd5\x78\xf7\xab\xdc\x66\xee\xe6\x4a\xfc\xd9\x6d\x3b\x25\x1e\xde\xf8\x4a\x5a\x25\xde\x7b\x5d\xdd\x86\xa8\xfc\xd4\xad\xc5\xeb\xd3\x79\x23\xa4\x58\x77\xeb\xb5\xc1\x23\x09\x02\xea\x89\x78\x4d\x8a\xcd\x59\xd5\x6b\x86\x52\xcb\xa1\xac\x62\xed\x17\xf7\x2e\x7f\x29\x0c\xbe\x95\x35\x5f\xfa\xf4\x05\x2e\x14\xbc\x06\x8f\x8e\x8b\x3b\xe5\x41\xfb\xf0\x5d\x41\xf5\x88\x7a\x23\xa9\xda\x37\xea\x97\xa8\xbc\x8e\x87\xa1\x0a\x03\x41\x09\xd2\x14\x0e\x22\x0c\x98\x4f\xe9\xb2\x63\x17\x4e\x9e\xe9\x2c\xaa\x3d\x0e\xef\x45\xdc\xd1\xa5\x12\x0f\xaf\xeb\x97\x8f\x56\xe2\xea\x2a\xee\xf8\xa8\x3f\x7c\x56\xbf\x7c\x84\x57\xf5\xea\x77\xfd\x87\xcf\xeb\x97\x8f\x9e\xcc\x1d\x50\xfe\xe8\xfa\xf8\xa3\x67\xc7\x1f\x3d\x5f\x76\xb2\xb7\xd2\xca\x5f\x7f\x95\x70\x49\xde\x55\xce\x57\x3b\x3c\x18\x13\xba\xec\x7a\xe2\x9c\xbf\xfd\xef\x0b\x0e\xfa\xb3\xa5\xa6\x1d\x98\x4a\xb5\x97\x5b\x67\x1f\x04\x11\x2a\x69\xee\xdb\xbe\xbb\x4c\x3d\xdd\x08\xa3\x2d\x9e\x6e\xef\x24\x4a\xda\x8d\x91\x8d\x12\x3c\x1f\x61\x1c\xc8\x45\x5b\xa7\xf3\xb9\xd7\xb5\x12\xaa\x91\x56\x46\x15\xc8\x44\x84\x53\x81\x76\x61\xad\xbd\xc2\xd5\x29\x0c\xc0\x49\x9b\x4f\xd1\x4b\x4b\x93\x6f\xfa\x34\x4f\x98\x5f\xcf\xea\x57\x68\x1d\x7c\x6a\xcb\xeb\xa3\x95\xc7\x33\x7f\x42\x79\x4c\x5f\xb1\x57\x33\x6a\xe3\xda\xd6\xbf\xa5\xda\x78\xb6\xcc\x46\xd4\xf5\xa2\x6b\x34\x69\x12\x7e\xec\x2d\x8a\xda\x1e\x44\x88\x5e\xb7\xb0\xd4\xfb\x9d\x8e\x4a\x54\xc6\xc5\xdd\xcc\x65\x7a\x25\x76\xae\xf3\xa7\xae\xd1\x6b\xa3\xbc\xae\x56\xe2\x47\x69\x64\xad\xed\xb4\x4e\xf0\xbc\xcc\x6b\x67\x40\x73\x04\xb2\x6e\xa4\x31\x9a\xc5\xbb\x88\xae\xdb\xee\xac\x0a\xe4\x77\x78\x15\x9c\xb9\x53\x59\x69\x74\x2d\x19\x61\x5e\xa9\x13\x7a\x81\xef\x0b\x49\xfd\x07\x01\x2d\x2b\x72\xaa\x1a\xf9\x8b\x6e\xba\x06\x9f\x5b\x75\xde\x2b\x1b\xfb\xbf\x86\x7c\x9c\xe0\x34\xbd\xc0\xb3\x84\x67\x8d\xa7\xfa\xdb\x9f\x74\x39\x31\x87\xe1\x28\xa5\x15\xb2\xae\x35\x0c\x4f\x1a\x1e\xf3\x82\xf3\x3f\x73\x22\x8d\xf4\xcd\x07\xb8\x29\x5e\xc7\x4e\x9a\xaf\x7e\x7a\xfb\xee\xcb\xcf\xf9\xff\x8b\xa3\xda\x68\xdb\xc5\x63\x77\xe3\xb2\xa3\xba\x86\xf5\x82\x9d\x93\xa2\xd5\xaa\x42\x81\xbb\x01\x19\x18\xb4\xb9\x53\x5e\xec\xb5\x9f\xd3\x03\xf3\x47\xf7\x63\xdc\x13\x30\x74\x82\x8a\xb8\x19\xb0\x7c\x42\x6e\xa5\xb6\x21\x8a\xce\xee\xa5\x8d\x0a\xa4\x48\xf4\x5d\x80\x33\x94\x4e\xb1\x14\xb5\x73\xb8\xc5\x7b\x6d\x6b\xb7\x47\x91\x0b\x0f\x00\x3f\xfe\xd8\xc8\xd1\x41\x58\x27\x0c\x9c\x06\x38\x94\xf0\x45\x71\x5d\x84\x10\x9e\x88\xbf\xf4\xae\x47\x36\xec\x57\xc5\x90\x8c\xf2\x11\xef\x1a\x9a\x21\x0a\x16\x8c\xd7\xd5\xf9\xf4\xe0\x5e\x4d\xb8\xae\xda\xa9\x00\x7f\xc2\xd0\x44\xc0\x07\xef\xa5\xaf\x15\x06\x0a\xe4\x93\xa3\xf3\xcd\xef\x5d\xf1\x67\x56\xd4\x2a\xe8\x2d\xa8\xb8\xe2\x96\xe2\x5c\xf6\xce\x3e\x88\xb8\x60\x6e\x43\x3e\x0a\x0e\x91\xfc\x2d\x69\x82\xcb\x71\x8f\x9d\x02\x0b\xae\xff\x0a\x2c\x03\x8b\x61\x58\xad\xae\xd6\x6b\x33\xaf\x80\xd2\xd7\x8f\x17\x81\x3c\xad\x16\xdd\x40\xf6\xb0\x1a\x0d\x02\x7f\x43\x6e\x62\x6f\x55\xc2\xf1\x35\xd9\x9f\x1a\x2c\x02\x6a\x0d\x7c\x86\xdc\xcb\x5b\x65\xe9\xd9\xc5\x23\x82\x51\x0a\xfe\x3e\x3b\x48\x9b\xa6\xc2\xc3\x6c\xbd\xab\xbb\x4a\xd1\xa2\x07\x54\x43\x60\x39\x8b\x1d\x1c\x7e\xbc\x05\x70\xcd\xaf\x9e\x8a\xa0\x2a\x67\xeb\x2c\xfc\xd8\x51\x58\x76\xcd\xe1\x70\x93\xbb\xbf\x40\xff\xbc\xbf\x40\xff\xcc\xc4\x10\xe6\x7d\x95\xd7\xce\x56\xca\x46\xfa\xd7\x8a\xe5\xfc\x15\xde\xda\x8f\x0b\xcb\xc9\x10\xba\x06\xaf\x9c\xde\x6c\x14\x0a\xfd\x8d\x83\x03\x77\xf2\x0c\x57\xbd\x7f\xc2\xdb\xbe\x71\xc6\xb8\x3d\xec\xb4\x6b\x61\x1c\x81\x9d\x58\x8c\x2d\x04\x56\xa0\xd5\x4e\x18\x78\xd2\x58\x73\xa4\x87\xe0\xd3\xe1\xb5\x70\x9e\xfa\xab\x0a\xbf\x09\xfd\xc5\x51\xb0\xdf\xf0\x66\x7c\x11\x98\x60\x70\x8b\xc9\x28\x8c\x4e\x80\x6c\xc1\x1f\xaf\x95\x55\x1b\x4d\x2f\x2f\xe7\x76\xce\x28\xfb\xb9\x93\x51\x57\xe2\xa6\x96\x6d\xc4\xe1\x7d\x41\x37\x0f\xfe\x4d\x17\x61\xed\xea\x03\xbc\x0a\xde\xcb\xdf\x56\xf6\x4e\x7b\x67\xe1\x3a\xad\x44\x68\xbd\xeb\x30\xc6\xbb\xd5\xc6\x04\x8a\x29\x6c\x3d\xad\xcf\x5e\xad\xd7\xf0\xdf\xb5\x8a\x7b\xa5\xf8\x6a\x6d\x34\x88\xd7\x40\x77\x1c\x0d\x2c\x90\x09\x3b\x85\xc1\x47\x4f\xa1\x45\x7c\x88\x44\x2b\x38\xec\x75\x83\x11\x9d\xd0\x82\xaf\xa6\x7e\xee\xa4\x81\x01\xe1\xb3\xf6\xd2\xdc\xe6\xbf\xcd\xcd\xf4\xf5\x0e\x05\xe8\x4d\xdb\x2a\xe9\xa5\xad\x14\x4d\x34\x7a\x69\x03\x1c\x00\xb6\x3c\xf2\x9f\x69\x74\xb5\xaa\xc0\x3e\xdf\x83\xa8\x82\x2d\x31\xce\xdd\x0a\xa3\x6f\xd5\x0a\x34\xaf\xe9\x6a\x78\x37\xfe\x72\xa7\x40\x23\xac\xc4\x9e\xff\xbb\x91\x95\x96\x46\x6c\x58\xda\xad\xfa\xbb\x8b\x5f\xbf\x73\xba\x52\x2b\xb1\x93\xda\x0b\xa3\xec\x36\xee\x30\x52\xe7\xd2\x81\x87\xf9\xd7\x3a\xc0\xba\x76\x3a\xec\xe0\x35\xd5\x4e\x7a\x59\x81\x51\x1f\xa2\xae\xc2\x0a\x44\x8b\xb4\x87\x7e\x19\xd1\xf2\x87\xa7\x07\x05\xd6\x3a\x4e\x05\x4f\x8c\x68\x54\xb3\x56\x1e\xcf\x86\x45\x47\x58\x78\x09\xaf\x8f\x3b\xb0\xae\x84\xe5\xb3\x8d\x23\x0b\x70\x0e\xf0\x0d\xf0\x46\xb4\xa0\xb2\x2c\xae\x51\x5a\x97\x4f\xce\x5a\x62\x74\xee\x82\xfe\x55\x91\x6e\x3a\xb8\x8e\xa6\x43\xa7\x49\x06\x5d\x89\xb0\x93\x2d\x86\xc5\x0e\x2c\xd7\x64\xa3\x56\x2c\x2a\x1f\x78\x25\xd6\xba\x55\xb5\xec\xf5\xc7\x83\x28\xba\xa0\x4a\xc3\x29\x3a\xb1\x56\x95\x6b\x94\xf8\xb9\x93\xb5\xef\xf8\xfb\x70\xd9\xb4\xa5\xf0\xdc\x13\x71\x03\x6a\xf8\x40\x51\xb5\xd9\x6b\xd8\xdf\x37\x78\x0d\x9d\x84\x7c\xc7\x68\x15\xc6\xe7\x83\x9c\x01\x1d\xc4\x5e\x1e\x48\xc7\xcf\x9d\xbe\x3f\xc1\x22\x49\x23\xfe\xaa\x64\xeb\x6c\xa0\xb3\x07\x97\x44\x54\x46\xee\x03\x1c\x17\xbb\x85\x43\xd2\x6a\x0b\x87\x65\xe7\x3c\x48\x15\x50\x6c\xc5\xa2\x5a\x7e\xca\x1e\x9f\x92\x77\xac\xda\xc1\x61\xc2\x6d\xf2\xa2\xb3\xd2\x37\xe0\xba\x45\xaf\xc1\xbd\xab\x95\x34\x18\x70\x58\x9b\xae\xde\x2a\x47\xd1\xdf\x56\x2b\x5f\xa5\x38\x70\x30\x92\x4e\x18\x39\x52\x2b\xdc\xd9\xb6\xf5\xae\xf5\x1a\x54\x36\x87\x39\x47\x6f\x67\x97\x34\xa8\xbc\xbb\xa8\xe4\x5a\xef\x36\xba\xd2\x30\x5c\x54\xab\xf0\xf9\x68\x4c\x4f\xc4\x37\xda\x4a\x63\x0e\xab\xa9\xe7\x82\x5a\x97\x5b\x5d\xe5\xa7\x62\xd4\x55\x8a\xcf\xae\xc4\xda\xd9\x2e\xa4\xe1\x70\x44\x14\x2f\x0a\x39\x80\xe0\x61\x91\xd2\xc5\x9b\x40\xb6\xb5\x8e\x53\x41\x92\xcf\xae\x96\x38\x64\x56\x37\xd2\x88\x6f\xbc\x06\xfb\x69\xa7\xdb\x45\xa6\xf0\xdb\x4b\x32\x58\x8b\x8d\xde\xc6\xc1\xad\x46\x2b\xd7\xb9\x7a\xc6\xb2\xbd\x7e\x3e\x6b\xda\x7e\x8d\x06\x2d\xf9\x66\xe2\x21\x1e\x4b\xf5\x68\x25\x06\x71\xf9\xc9\xa0\x7b\xbe\x79\x46\xb1\xd5\x54\x39\x7b\xa7\xe1\x26\x48\xb1\x56\xa4\x33\x59\x4a\x36\x4a\x5a\xa1\x23\x58\xa9\x3b\x34\xe6\xb2\x9d\x3b\xfa\x22\xdc\xb8\xa0\xc6\x49\xad\xef\x52\x74\x4e\x29\xdc\xde\x1d\x48\x9b\x83\xeb\x9e\x88\xef\x36\xac\xec\x64\x00\x3f\xe8\x3b\x1b\x95\x31\x7a\xab\xf0\x3e\x06\xf1\x7c\x1c\x19\xa0\x11\x6f\xa4\x36\xe1\x89\xf8\x01\x04\xdf\x5e\x07\xb5\xea\x1f\x32\x11\x06\xfc\xab\x0e\xb5\x6b\x8e\x62\x80\x6b\x85\x02\x18\x8e\xf1\xfa\x80\x83\x4f\x12\x05\x5f\xf2\x20\xf4\xae\x21\x0c\x13\xbe\xe0\xbc\x28\x05\x6b\x95\xc2\x03\x01\x57\x25\x14\x91\xfb\xd5\xa7\x0d\xc2\xf7\x61\xf7\xd2\xcf\xec\x97\x2a\x6d\x85\x4c\xc9\x11\x55\x3a\x92\xb4\x50\x0b\x63\xf0\x67\x6f\xd3\xf7\x98\x8e\xc1\x53\xb6\x24\x64\xf8\xa7\x0b\x1c\xcb\x7f\xac\x3b\x76\xee\x4a\x7d\x7d\xc0\x7b\x12\x48\x11\xa5\x3d\xa3\xcd\x00\x2d\x04\xfb\x41\x4b\x16\x9d\xa8\x95\xd1\xe4\xa5\x35\x2a\x04\xcc\xa3\xe6\x3b\xf5\x9e\xfc\x61\xd8\xa4\x53\x77\x6a\x05\x47\x7c\x47\x1a\x3b\xfc\xdc\x69\xef\x95\x01\x77\x73\x6d\x3a\x25\xbc\x3c\xb0\xa6\x59\x6b\x5f\x93\xb6\x0f\xad\xaa\xf4\xe6\x20\xa4\x30\xae\x92\xe3\x5c\x23\x5e\x1a\x14\xcb\x77\x3a\xe8\x9c\x1f\x96\xc2\xab\x4a\xb7\x24\xfb\x77\x4e\x34\x32\xa2\xe3\x28\xc5\x56\x59\x05\x22\xbe\x56\xa1\xf2\xba\xa5\xe7\xf5\x43\x6a\xa4\x85\x01\xec\x1d\xfc\x4f\xed\xe1\x84\xe4\x60\x5b\x67\x35\x9a\x67\x29\x83\xe5\xf6\x56\x6c\x3b\xe9\x6b\x1a\xb2\x57\xf5\x63\x30\xa2\x54\x2d\xea\xbd\xf4\x1b\xd0\x22\x9e\x4e\x3d\x46\x48\x54\x2d\x76\x32\x16\x26\x4c\x68\x95\xbc\xed\xd7\x11\x9e\xcb\x41\xa4\xbd\xb2\xf1\xf0\x78\xa3\xef\x94\xd8\x3b\x5f\x07\xf0\xe8\x72\x6a\x8d\x05\x97\x97\x70\xe5\xe6\x8d\x09\x18\xa3\xf4\x75\x48\x9f\x54\x7a\xa3\x55\x5d\x2c\x64\xe5\xee\x28\x07\x27\xd7\xae\x8b\xe2\xc5\x53\xf4\x29\x83\x68\x95\x17\xe9\x68\xe1\x2b\xa4\xd8\x98\x03\x7c\xb1\x49\x97\x06\x26\x7d\xfd\x82\xbf\x0f\x5f\x21\x6b\x8e\x0e\xca\xac\xd8\x40\x21\x81\x70\x84\xfc\x2c\xe9\xbd\xbe\x03\x6b\x43\xc7\x74\xc0\x38\x00\x97\x16\x87\x55\x6d\x1f\x0a\x48\x82\x9b\x36\x72\x0d\x3b\xef\x55\x6b\x34\x4c\x0d\xa5\xa5\x9a\xb2\x73\x69\x25\xfb\x17\xe3\x1e\x04\xe1\xac\x21\xcf\xa2\x7f\x01\x1e\x99\xf4\xa4\xe2\xb4\xe0\x4b\xb7\xf2\x4e\x65\x1d\xd0\x3f\xad\x76\x2a\x80\x85\x48\x89\x14\xf0\x81\x6a\x05\x86\x33\xed\xcc\x5a\x6d\x9c\x57\x47\xb1\x90\xf4\x08\x0c\x1c\x07\x61\x5c\x88\x7d\x36\x94\x55\x03\x86\xc7\xe1\x79\x60\xe2\xad\xc1\xd8\x88\x4e\xec\x77\xca\xab\x29\x69\x7b\x99\xcc\x66\x15\xf1\xb1\x91\xef\xc9\xf3\x37\x08\x84\x3f\x7f\x55\x1c\xa8\x8f\x8b\x06\x92\x34\x7a\x07\xb6\x7b\x58\x84\xe5\xb9\x24\x52\x70\x5e\x4a\x5f\x18\x2c\x38\x23\xa4\x51\x3c\x9f\x0a\x4a\x93\x01\x1a\x3b\x0f\xe2\x19\x6e\x58\x10\xda\xa2\xcb\x01\xde\x79\x2f\x7b\xed\x41\xd8\x2e\xb9\x56\x7b\x6d\x0c\xfa\x69\xc3\x08\xd7\x69\x3b\x67\xe8\x7f\xaa\x3e\x4c\x4d\x2f\xa3\x48\x03\x89\x3e\x49\x51\x39\xb4\xd6\x1b\x69\x8c\xf2\x7c\x48\x39\x74\x55\xed\xe0\x43\x70\x53\x3c\xdd\x44\xb7\x21\x6b\xc8\xb8\xbd\xf2\x4f\xc4\x0f\x56\x84\x6e\x1d\xd4\xcf\x1d\x08\x67\x9c\xd8\x49\x9f\x87\x2c\xec\x3c\xae\x84\x01\x2a\xe6\x85\xc3\xb3\x6a\x8f\xc3\x9b\x95\x3a\x28\x41\xd3\xa3\xe8\xa8\x62\x80\xe3\x58\x82\xe6\xe3\x99\x2c\x22\xe7\x0b\x04\x05\xaf\x4b\xed\x5d\x8b\xc3\x7b\x5a\xc6\xc0\x9d\x17\xb5\x56\x45\x3c\xa1\xca\x61\xd5\x41\x8c\x67\xfc\x8e\x3e\x1b\xfc\x20\x88\xad\x6c\x54\xe9\xfb\x82\x2f\x03\xa2\x4d\x56\x64\xf1\x31\x8e\x23\xfd\x99\x2f\x1c\x3a\x40\x96\xf6\x22\xbb\xd3\xc5\x80\xbd\x8a\x52\x5b\x92\x22\xd2\xe8\x2d\xc6\x4c\x50\xce\x94\xb6\xeb\x8a\x8d\x4e\x92\x40\xaf\x77\xd2\xeb\xd0\x48\x11\x2a\xe7\xd5\x50\x0d\x51\xf4\x8a\x54\x4b\xb9\x02\x1b\x7c\x43\xda\x12\x7a\x0e\x66\x98\x35\x8c\xe1\x0e\x23\x9d\xd1\xd1\x97\x60\x27\x0c\x7f\x0f\xff\x4c\xe7\x3c\x79\x79\xf9\x3c\xf7\x99\x12\x0d\xda\xbe\x4e\xa2\x54\xc7\x7e\x4b\x55\x8d\x02\xb9\x78\x0b\x2a\x74\xaf\x42\x67\x50\x74\xc1\x8e\x61\x4c\x74\xb4\x69\x2b\xbc\x3c\xea\x97\x4a\x85\x9c\x23\xaf\x40\x21\xa9\x20\x40\x3d\x4e\x8c\xf6\x89\xb8\x09\x9c\x3e\x0d\x0c\x1a\x29\x7f\xdd\xab\x81\xba\xab\xd4\x40\x6f\x3d\x18\x3c\xe7\x78\x28\x3a\x0a\x8d\xbf\xbd\xb5\xae\xba\x55\xb5\xe8\x6c\xe5\x6c\xa8\xb4\xeb\x78\xf9\xfb\x74\x6b\x10\x46\x37\x3a\xf6\x06\x4a\xba\x34\x3a\xe2\xd9\x6b\x95\xc7\x97\xf0\x91\xb1\x39\x3a\x72\xbc\x41\xf4\x8b\x07\x91\x6d\x12\xe7\x49\x1d\x10\x70\xe0\xec\xad\xca\x07\x17\xbc\xa3\x46\x99\x3a\xf4\x62\x23\xbd\x67\x70\x76\xe8\x5d\x30\xdc\x3b\x19\xd5\x4a\xec\xb5\x32\x35\xde\x33\x97\x3c\xa3\x14\x46\xa4\x3c\x34\xec\x10\x0f\x5c\xfd\xdc\xe9\x16\xce\xee\x62\x45\x11\x95\x78\xa3\xe4\x71\x46\xf3\xd9\x52\xcc\xe7\x89\x34\xd1\xd5\x52\x6b\x1e\x4e\x1e\x0c\x7f\x6d\x9c\x03\x03\xb5\xc8\x13\x19\x15\x76\xab\x9c\x3d\xb2\xd5\x0e\xbf\x07\x6e\x4f\xdd\x85\xf8\x91\xf0\x81\x94\xf7\x4c\x3e\xf6\x1b\x25\xe3\xee\x51\xce\x83\x8a\x87\x3f\xc8\xb8\x5b\x7b\x25\x6f\x95\x7f\x34\x13\xac\x2e\x93\xd0\x70\xfa\x08\xd9\xc5\xf8\xcc\xa0\xfc\x9d\xb4\xb1\xf0\x02\x5a\x4e\x4b\xc0\x34\x02\xd9\xc6\x95\xf3\x6d\xe0\xc8\xdc\xf7\x0a\x21\xbc\xce\x8b\x77\xa0\x3f\xc4\xae\x6b\xa4\x75\xba\x3e\x56\x49\x29\x25\xab\x9b\x75\xa7\x4a\x27\x35\xe9\x9a\x8d\xeb\x8c\x68\x74\xa3\x2b\x8f\xe7\xc3\xe8\x8d\x5a\x09\x2f\x35\xc7\x5b\x38\x48\xcd\x23\x4d\x37\x67\x64\x4d\x57\xae\x41\xcf\x20\xdc\x2a\xa3\xa2\xb3\x29\x4f\x82\x02\xb5\x9c\xc4\xaf\xae\x59\x6b\x35\xfc\x73\x9e\xda\x43\x18\xdd\x9b\xef\xc5\x8e\x25\x42\x71\xe1\x47\xf2\xfc\xd1\xdc\x7d\xfa\xc1\x32\x46\x88\x8d\xd6\x09\xfd\x28\x39\xf8\xd4\x87\x05\x29\x99\x64\x0e\xe0\xd7\x37\x78\x96\x6c\x81\xeb\xa5\x28\x54\xad\x38\x89\xdd\xef\xa5\xde\x0c\x4d\x6a\x3d\xce\xd8\xf0\x28\xc4\xc3\x34\x65\x67\xa3\x77\x46\x34\x9d\x89\xba\x35\x45\x06\xad\x1f\x61\x39\x04\x87\xf9\x73\x56\x50\x8d\x90\x31\x47\x58\x31\x10\xba\x12\x3a\x84\x2e\x1b\xeb\xf0\x71\xfa\x75\x74\xbc\x0c\x56\x3d\x3a\x0e\x7f\xa7\x99\xef\x06\x30\x2d\x70\x77\x10\x70\x42\x0a\x87\xd4\x03\x7e\xdc\x80\x69\x59\x77\x3e\x21\x2f\x0b\xc8\x22\xc1\x2c\x71\xe4\x30\x18\x55\x78\x88\x3c\x96\xde\x3b\x8c\x8e\xdd\x3d\x29\x5a\xe9\xa3\xae\x3a\x23\x3d\xd8\x3b\xa4\xa6\x3c\x1c\x05\xaf\x6b\xe7\x73\xd8\x85\x1e\x69\x5d\x7a\x16\x5b\xfb\x7d\xa4\x1a\xdc\x8e\x5a\x6d\xc0\x11\x80\x91\x61\xb0\x9c\x93\xb4\x3b\x17\xa2\x2e\xd7\x18\x4c\xa7\x4a\x89\xad\xbe\x53\xe0\x8f\x0b\xe7\xeb\x64\x7c\xe7\x07\xc2\x0e\x69\x8b\x97\xc5\x71\x6e\x08\x56\x81\xac\x17\x98\x7a\x94\xe1\x16\x36\x1a\xc4\x8a\x51\xf1\x0c\x76\x6c\x78\x38\x08\xa2\xcb\x01\x24\x3a\x09\x60\xc6\x24\xeb\x76\x25\xe4\x26\x2a\xcf\xce\xb9\x8e\x22\x44\x30\x91\xdc\x5a\xa1\xe3\x88\x67\x92\xb7\xf7\xe0\xba\x07\x77\x69\x2a\x3a\x3e\x11\xef\xc1\x45\xd7\x36\xa6\x1c\x52\x7a\xbe\x1b\x1d\x51\x74\x45\x39\x6f\xd0\xbf\x37\x07\x02\xc6\x3e\xcc\xf8\x90\xe0\xda\x96\x7e\x58\x02\x5b\x5c\x3f\x7f\x0c\xcf\x02\x95\xa9\x5d\x4d\x81\x2f\xca\xa2\x76\x41\x0d\x5d\x1a\xf0\x67\x42\xca\xd7\xf6\x2b\x81\x96\x02\x99\xfb\x1b\xfc\x3c\x9b\xa8\x7d\xe0\x98\x94\x51\x3d\xbe\x88\x20\xb1\x38\xa1\x9c\x22\x2d\x91\x7c\x2f\x50\x9e\x9f\x1a\xcb\xf4\x3c\xe3\xf8\x46\x61\x38\x1e\x2e\x7c\x9c\xe6\x3c\x9c\x6d\xdc\xbb\x32\x38\x37\x92\xb2\x63\x37\x8f\x1c\xbc\x67\xbe\x66\x18\xcc\x68\x6b\x03\xef\xa0\x6b\xb8\x3a\xa1\x34\x99\x93\xfa\xf0\x43\xdd\x72\x89\x05\xf0\xc3\xfa\x6f\xaa\x8a\xc7\xce\xe2\x8b\x8f\x74\x16\xaf\xae\xef\xdb\x5b\xec\xed\x8d\x99\x90\xde\x82\x14\x33\xcf\x98\x96\x34\x3a\x54\x8e\x42\xc6\x1c\x01\x86\x9b\x38\x46\x2f\x29\x2b\xac\xb3\xe8\x73\x4a\x23\x1c\x3f\x61\x1a\xdc\x6a\x1d\x68\x4f\x4c\xad\x3a\x6f\xc9\x66\x04\xab\xb9\x7e\x92\x54\x3c\xa9\x58\x18\x41\x67\x51\x17\xc3\x89\xe1\x87\xae\xc4\x1f\xd1\x8d\x3c\xfa\x0e\xde\x9e\xfc\xa5\x6f\xbb\xa9\xef\x60\x62\x33\x7d\x29\x3b\x5b\x60\x52\xf2\x6e\xa3\xf6\xc1\x3f\x0f\xc0\x29\xf0\xb4\x01\x0a\x2b\xfd\x80\x20\x5d\xbd\x2d\x90\x25\xc6\x84\xcc\xeb\x26\x10\x2d\xbd\x8b\x48\x36\x7f\x3d\xb6\xec\x67\xaf\x70\x18\xe9\xf4\x5e\x9b\xde\xbb\x66\x7f\xf1\xf4\x5f\xaa\xfd\x5f\xaa\x3d\x49\xc5\x9a\xc5\xa2\x78\x97\xad\xd3\x39\x7b\x40\xdb\x83\x78\x2c\xbe\xfd\xf1\x0b\x71\xfd\x74\x25\x6e\x5e\x7f\x21\xae\x5e\xad\xc4\x0d\x66\x3f\xbf\x10\x9f\xbd\x82\x91\xed\x74\x5c\x89\xab\xfa\xb9\xf8\x4c\x3c\xcf\x59\xdc\x77\xd1\x7f\x21\x9e\xaf\xc4\x1b\xf5\x0b\xfc\x66\xe6\x1d\xe4\x12\xf0\x4b\x5e\xf0\x4b\x5e\x16\x2f\x79\x59\xbc\xe4\x95\xf8\x4c\x5c\x0f\x5f\xf2\x32\xbd\xe4\xf9\xcc\x4b\x58\x3c\xd1\x5b\x9e\xa7\xa9\x3c\x2b\xde\xf2\x22\xbf\xe5\xba\x7e\x29\x3e\x13\x57\xc3\xb7\x5c\x3d\x4d\xaf\xb9\x9e\x79\x0d\x09\x38\x7a\xcb\x8b\xf4\x96\xa7\x93\x73\xb9\xae\xaf\x9e\x1e\x4f\xe6\x2a\x2f\xd9\xd3\x99\xd7\xa0\x88\xa4\xb7\xbc\x9a\x7a\xcb\xab\xf2\x2d\xd7\xc7\xfb\x02\x7b\x88\x6f\x79\x79\x06\x0c\x96\xce\x0c\x0b\x56\x8d\x82\x32\xd5\xec\x91\x0c\xba\x79\xbd\x1a\x46\x56\x70\x10\x01\xdf\xc4\xd0\x12\xb8\xc3\x3d\x22\xbc\x56\x51\xf9\x46\x5b\x44\x8d\xc2\x29\x0e\xfa\x57\xcc\xbb\x86\x61\xa5\xa0\x0e\xe2\xea\x29\x87\x2a\x46\xd9\x56\xf8\x90\x33\xa5\xa0\x96\x9e\xad\xf2\xd7\x72\xf8\x0a\x7e\x4d\x0f\x25\xe0\x8e\x0e\x09\x04\xbf\x4a\xb2\x32\xe9\x3a\x23\xab\x5b\x61\xd4\x36\x88\x3e\xa5\xd1\xb6\xca\xd6\x72\xab\x72\x64\x05\x8c\xc2\x0d\x86\x34\x2b\xd7\x38\x12\xd9\x3a\x22\xdc\x03\x4c\xa0\x1d\x46\x9e\x38\x61\x42\x6f\x74\x9b\x0c\xbb\x47\x90\xaf\xb4\x62\x07\x46\x54\x4e\x22\xf4\x8b\x1a\x54\xd5\x79\x65\x0e\xb4\x78\x3b\xd2\x25\x52\x84\xce\x6f\x64\xa5\x0a\x58\x24\xfd\xa4\xcc\x5e\x55\x3b\xb4\x6f\x9d\x89\xe9\x57\x7b\x09\xf6\xa5\x2e\x67\xfe\x14\xd3\xda\x30\xc6\xb5\xd1\xb6\x0e\xa8\x4b\xd9\xa1\xf6\xb2\xd6\x5d\x18\x8f\x56\xf3\x57\xc5\x5a\x1d\x1c\x26\x26\x64\x44\x48\x10\x61\x5b\x72\x3e\x67\x7c\x42\x26\x63\xa4\xab\x89\x40\xa0\xf3\x7a\xab\x6d\xb6\x38\x8a\xc0\x14\xe8\x1a\xaf\xc0\x37\x28\x6a\xda\xa6\xa2\x73\x53\x8f\x98\x93\x80\x29\xeb\x91\x75\x5a\xfa\x21\x2c\x1b\x9e\xda\x55\xda\x6d\x2e\x63\x00\xeb\xd9\xa8\x61\x11\x5c\x92\xe8\xf2\xa8\x5e\xa8\xaf\xf9\x01\xff\xe6\xbb\x94\xc3\x91\x22\x18\xd9\xa4\x9f\xd3\xaa\xdb\xf4\x4f\xb6\x01\x60\xa1\x7b\x84\x4c\x0e\x2a\x4e\xdf\x94\xf7\x14\x76\x68\x70\x17\x7d\x67\x92\x81\x96\xd2\x7d\x55\x3e\x59\x76\x63\x34\x9c\xf0\x8c\xb1\x41\x73\x9a\xc0\x37\xe9\x25\x6b\x19\x08\x7c\xa0\x29\x30\x3e\xbb\x84\x1f\x99\x4a\x7a\x79\xca\xf1\xa8\xb2\x17\xa4\xc6\x3e\x46\xba\xa5\xa7\x33\x48\x2f\xe2\x6e\x99\x5b\x10\x35\x1a\xc4\xef\x76\xca\x98\x45\x5e\xc1\x25\xc5\x0e\xef\x40\xdd\x3f\xbc\x62\x24\x34\xdd\xaa\x47\xbf\x11\xfa\x74\x1c\xed\x3b\x95\x5e\xba\x11\x61\xa7\x9b\x86\xf2\xbf\x6b\xbc\x52\x5e\x80\x60\x46\x7b\xb6\x8b\xa3\x0a\xa0\xe1\x64\xf0\x94\x82\xf1\x15\x32\xb6\x6a\x55\x5c\xd4\xb2\x72\x3c\x55\xb7\xee\x54\xbd\xc5\x63\xd7\xc5\xc2\xeb\x73\xbd\xe7\x9b\x0a\xbe\x41\x3e\xe6\x52\xf0\x73\x01\x8a\x34\xf0\xe9\x5c\x0e\xdd\x8f\xf4\x9d\x16\xc4\x0e\xd5\x8d\x1f\xe7\x92\x68\xba\xad\x0c\x81\xef\x06\x26\x71\x13\xf0\xa6\xdb\xee\x9e\x88\x9b\xa9\x9f\xa1\x65\xdc\x07\xcb\xe1\x97\x24\x30\x48\xf5\xd1\xf2\xe0\xc6\xd7\xf9\xa9\x0c\x30\x0b\xe9\xd1\x94\xea\xa5\x51\x2e\x10\x5a\x68\xf4\x06\xc7\x37\x3d\x83\x65\x4a\x73\x7f\x03\xfe\x21\xea\x00\x98\xd1\xd4\x7b\x4e\x03\x7d\xe6\x6f\x0d\x25\x24\xbf\xd1\xca\x1c\x47\xd4\xa7\x32\xaf\x1f\x7c\x6d\x1e\xf3\x49\x0b\x2d\x58\xfc\x73\xb7\x87\xa2\xec\x39\x7c\xde\xba\x7d\x8d\x87\x4f\x7b\x87\x7e\x29\xfe\x77\xa3\x8d\xb6\xdb\x30\x13\x52\xff\xd0\xab\x36\xe3\x83\xdf\x88\xd1\x64\xb4\xbd\xd3\x01\x31\xf5\x34\x2d\x02\xc2\xa6\x55\x0d\x9d\xc7\x92\xf5\x40\x18\x33\x0c\x40\x61\xe5\x85\x0e\xa2\xd6\x77\xb4\xa7\x78\x4e\x11\x34\xc0\x6e\x3a\x95\x97\xd3\x69\x08\xdd\x66\xd3\x05\x0e\x96\xa3\x33\x77\xa7\x7c\x00\x25\x4d\x6a\x89\xf6\x1c\xde\xbc\x4a\x07\x96\x3c\xe7\xb5\xc2\x53\x0c\xd6\x44\xd3\x38\x3b\x48\xb2\xd6\x3a\x10\xda\x94\x74\x32\x5c\x22\xce\x4b\xeb\xa8\x9a\x90\xe0\xaf\x4d\x67\x6b\x69\x4f\xd6\x7c\xf4\xaf\x3e\x12\x1c\x23\x71\x31\x77\x05\xde\xd1\xa0\x61\x1c\x24\x3a\xf2\x2a\x10\xe4\x7d\x85\xb9\xb8\x16\xf4\x8f\x0b\xec\x3e\x51\xda\x14\xab\x58\xa2\xde\xc8\x2a\x32\x9a\x55\xe9\x78\x58\x51\x3d\x44\xd7\xb6\x43\xb0\x0f\x8f\x94\x8d\xb5\x07\x51\xb4\xde\x45\xdf\xd5\x8a\x52\x5b\x1a\x53\xb6\xa8\x7d\xd4\x2f\x60\x1e\xd2\x65\x43\x41\x20\xcb\xc7\xb1\x43\x1e\x50\xa4\x75\x98\xa5\x24\x9c\xbd\xb4\x89\x01\x00\xf4\x64\xfe\x01\x41\x60\x38\x81\xb8\xe9\x2c\x07\x04\xd6\x1d\x79\xd8\x08\x20\xd6\x28\x6c\xe0\x98\x14\x2f\xc2\xe0\x48\xc8\x16\x09\x82\x4f\x16\x54\x88\xbd\xc7\x38\x88\xaa\xc5\x5b\x5a\xbf\x2f\xc4\xf9\x15\x4e\x06\x27\x9d\x81\x46\x87\x40\x13\xaa\x09\x97\x28\x5a\xe5\x83\x43\x3e\x00\x19\x73\xa0\xa5\x70\x8d\x7d\x61\x6e\x0d\x97\x1b\xe3\xa4\xd6\x15\xdc\x08\xc5\x33\x66\x6d\x11\xaf\x24\xda\xad\xdf\xc3\x98\xbe\x40\xb1\x8f\x37\xa7\x00\x9a\x73\x4c\x78\x3c\xa1\x7e\x3e\x1b\xed\xd5\x1a\x0d\x66\xda\x72\x52\x86\x7d\x2e\x93\x06\x99\x4d\xf6\x74\x83\xef\x94\x37\xb2\x0d\xb9\x4c\xca\x6d\xe8\x0d\x74\xe4\x5b\x26\x5c\x88\x69\x44\xa9\x70\x0a\xb1\x57\x45\x46\x9f\x9e\x36\x38\x0c\x4f\xc4\x37\x60\xe8\xfc\x22\xc1\xa3\x67\x76\x06\x23\x1b\x15\x06\xe7\x1a\x8d\x7c\xaa\x3a\xf3\x6a\x7c\x9c\xf7\xc7\xf7\x1e\x7f\x4b\x16\xd9\x56\xb6\x69\x07\xf0\x21\xc9\x19\xa2\x29\x61\x8a\x19\x9d\x58\x65\x51\x03\x9e\xbd\x97\x5f\x88\x1b\x7b\xa0\xc4\xae\xea\xd7\x7b\xea\x18\x11\xc2\x75\xc9\x99\x18\xac\x88\xd8\xe7\x22\x95\xf2\xb7\xbd\xf7\xa4\xed\x08\x67\x7d\xe4\xfc\xe3\xa1\xfd\x0e\x04\x17\x1d\x93\xd6\xbb\x56\xf9\xa8\x39\x08\xd8\xba\xbd\xf2\x21\x6f\x22\x8b\xb8\x39\x21\x31\xda\x25\xc4\x87\x23\x38\x20\xec\x9d\x1f\x0b\x94\x74\xa5\x19\x9e\x50\x44\x5b\xfb\x9f\x80\x68\xa1\x97\x93\x9d\xf0\x20\x9c\x18\xe4\x78\x54\x1b\xc2\x0f\x80\x7f\x5a\x17\xbe\x49\x86\xf2\x0c\x8e\xad\xa7\xb4\x7b\x2f\x1c\xd1\x60\x51\x7e\x3c\xb9\xef\x36\x88\x8b\xec\x87\xc3\x8e\x03\x65\xb0\x19\x20\xdf\x34\x9d\xd5\x84\x9e\xe9\x8c\x39\x08\xa3\xe4\x5d\xaa\x14\xa3\xf7\x3d\x1c\x2c\x12\x87\x1d\xe9\xc4\xa6\x87\xf8\x44\x72\x80\x48\xe7\xf4\x71\xa0\x12\x8f\x62\x22\xae\x8b\x41\xd7\xaa\x78\xfa\xa3\x55\xaf\x11\x33\xfd\x4a\x54\x8d\xa8\x08\x7d\x86\x80\xa9\x72\xa9\x64\x10\xc1\x51\x15\x93\x86\x8b\xae\xe7\x2d\xcd\xef\x79\x8f\xde\x23\xea\xf2\x0b\xf1\x5e\x19\xd5\x3a\x4f\x75\x4a\x99\x91\x48\x7a\x86\x65\x22\xce\x1b\x21\x7a\xce\xdf\x8a\xd1\xf5\x2b\xcb\x07\xfb\x23\xce\x28\xc3\x0c\x16\x4c\x86\xac\x02\xf9\x01\xa7\x9c\xf0\x2f\x60\xe0\xa2\xb8\x4a\xa7\x86\x5e\x08\x27\x06\xc7\x63\xa8\x3e\x8a\x6e\x5c\x01\x98\x75\x9e\x01\x16\x30\x4e\xdc\x38\xf5\x8b\x0e\x91\x20\x3f\x32\x88\x3d\x16\xb1\xa2\x0c\x73\x2d\x71\xd4\x50\xa1\x15\xd2\xa1\xd4\xba\x51\x36\x90\x0b\x16\x5a\x59\xa9\x3e\x70\x0a\xc2\xac\x10\x46\x30\x66\x38\xaa\x22\x7a\x5d\xdd\xb2\x89\xb1\x12\x51\x35\xad\xf3\xd2\x6b\x73\x10\x95\x71\xb0\x25\x74\x91\x87\x67\x6d\xae\x68\x2a\x5b\x23\xb0\xda\x9c\xdb\xf8\xa2\xe4\x10\xe8\xc5\x40\x36\x61\x9c\x2f\xc7\xc6\x50\xba\x62\x28\x7b\x6d\x6f\xc9\xd7\x29\x17\x64\x7c\x01\xde\xe1\x5c\x4b\x9e\x03\x84\x5e\x98\x03\x18\xf3\x68\x17\x05\xe1\x6c\x95\xce\xa3\xac\xc6\xf2\xa9\xaa\xba\x56\x53\xf0\xc5\x3a\xbc\xe7\x58\x16\x3c\x12\xcc\x73\xb3\x7f\xa3\x61\x25\x93\x72\x2b\x34\xf4\x48\x37\xe7\x7d\xa9\xe9\x07\x34\xe5\x29\xad\xda\x4f\xef\x8f\xfa\x56\xf5\xb5\x08\xf4\xe9\x40\xc1\xf4\x79\xb8\xde\x52\xdd\x80\xe8\xc8\xc4\x22\x58\x8b\x65\x3b\x63\xf4\xe6\x50\x72\x9c\x2c\x74\x29\x5a\x19\x77\x87\xcf\xdf\x1d\x1a\xfc\x9f\x45\x5e\xc5\x74\x69\x0b\x99\xea\x17\xf3\xd4\x20\x4c\x47\x69\x0a\xf6\x09\xd3\x35\x88\xd6\x91\xa6\x6b\x44\x70\xf2\x96\xe4\xfd\x9d\xb6\x6a\x2b\x7d\x76\x31\x65\x1a\x78\x5e\x56\xb4\x29\x19\xea\xb3\x73\x56\x1d\xfa\xba\x0c\x9e\x1a\x7f\x75\xc6\x07\xb9\x7a\x2a\x6a\x79\x98\x45\x8a\xf6\xc5\xe0\x17\x90\xde\x14\x10\x1e\x19\xa3\x97\x15\x81\x15\xbd\x6a\x95\x09\xa5\x3b\x7e\x5c\xc8\x95\xa4\x6e\x70\x8d\x8a\xe8\x10\x0f\xf1\xfc\x79\xe1\x30\x10\x5d\xc0\x42\xf9\x36\x3a\x3f\x56\xf3\x03\x33\x68\xa2\x7e\x7c\x50\x40\xfe\x7e\xa7\x6c\x51\x04\x70\xab\x09\xd2\xad\x73\x08\xb8\x77\x7c\x7b\x4b\xae\x67\x62\x09\x2b\xb1\x75\x6b\xa3\xb9\x86\xed\x4e\x36\xad\xf6\x09\xa1\xa9\xed\x9d\xe2\x0a\xdb\x01\xb0\xc8\x0a\xd9\x79\x1e\x62\x9a\xde\xf1\xaa\x0d\x41\xf5\xc3\xbc\xf7\x30\x08\x91\x41\xba\xe9\xc8\xc0\x2a\xa5\x33\xc1\x80\x21\x78\xe3\x83\xc4\x0a\x36\x9f\xbb\xe1\xa7\x90\xf9\xa2\x6c\xb5\x93\x36\x22\x9a\xb3\x92\xe8\xfb\x0d\xb6\x13\x9e\x8d\x8b\xc6\x60\x79\x2a\x7e\x47\x6f\x65\xa3\x94\x81\xc9\xc2\x5a\xda\xa0\x44\xe7\x09\x68\x8f\xca\xbb\x37\x58\x31\x3e\x7a\xe7\x74\x5d\x2c\x14\x47\x7d\xc3\x48\x34\x26\x74\x71\xb1\xa2\x98\x3c\x6b\xd4\x14\xb6\x48\xc7\x51\x8e\xeb\x82\x12\x28\x06\x0a\x20\x1d\x82\x05\x4b\x79\x80\x56\xa1\x48\x54\x28\xbe\x90\xf4\x4d\x3c\x31\xc6\x49\xf0\x93\x8e\xc9\x5b\x2b\x1e\xc4\x5a\xae\x2c\x97\x1a\x4e\xa0\x0b\x0a\xdd\x2f\xf0\x72\x71\x5b\xa2\xa3\x90\x4d\x86\x44\x4a\x0f\xc7\x2e\xc8\x0d\x1c\x20\xc7\x91\xb6\x8c\x9b\x61\x24\xe6\x60\x88\xd9\xe9\xe8\x5f\x85\x3e\x74\x43\x48\x16\xd9\x0f\x3b\x87\x07\x72\x86\xbb\x2e\x9e\x78\xb4\xe4\x03\x9d\xd4\xcf\xb2\xf7\x38\xf3\x37\x53\x76\xbc\x58\x0a\xc2\xd3\x68\x46\xdd\xa2\xbd\x29\x28\x9f\x90\x6a\x64\xf2\xfa\xf6\x2e\xff\xd1\x12\xcf\x79\x15\x7c\x49\x4e\x1e\xf5\x53\x77\x70\xe6\x74\x63\x3d\x29\x42\x00\x8a\x6b\x8f\x9b\x3c\x35\x3a\x14\x59\x6b\x83\xbf\xa4\x05\xfc\x3b\x1f\xfd\xa3\xd3\xe5\x7a\xc8\x20\xa6\x81\x13\x84\x1a\x63\x2a\xfd\x2d\xe6\x3d\xc8\xc2\x5b\x15\x20\x9c\xc1\x9d\x1e\x0c\x6b\x27\x51\xbd\x2b\x11\xdc\xaa\x3f\x9b\x5c\x5f\x60\x0e\xf4\x48\xb9\x97\x87\xf1\xb9\xcb\x07\x36\xe1\xe5\x31\xc1\x10\x86\x18\x5f\x2a\x3e\x9c\x8a\xc6\xd2\xea\x4c\x47\x5b\x39\x1f\x33\xb5\x3e\x38\x6d\x92\x53\xc9\xb9\x97\xa1\x2f\x11\x12\x6b\x65\xdc\x7e\xee\xc0\xbd\xb5\x75\x42\x2d\x50\x40\x04\x93\x1a\x93\xf1\xe2\x94\xd9\xc7\x15\xe7\x13\x64\x5d\x9c\xd8\xec\xe1\x91\x18\x9e\xa6\xf1\x31\xe0\x2c\xd1\xc4\xe4\x9e\x88\x1f\xfe\x34\xa4\xb9\x22\x4e\xac\xd3\xd7\x39\x8f\x79\x20\xb2\x98\xbd\xa8\x72\x5b\xab\x7f\xe5\x2b\x04\x77\x85\x6b\x35\xbc\x6a\xbb\xad\xc5\xba\x72\x97\xd5\x1e\x13\x3e\xb0\xb5\xf9\x44\x7c\xd7\xf3\xfb\xac\xca\x4b\x30\x7e\x23\x1b\x88\x3a\x08\x69\x8c\xdb\x83\xc8\x60\xdf\x64\x6a\xf7\x88\x90\x36\xd7\x9d\xed\x47\x7c\x14\xad\xf2\x41\x87\x33\x58\x9a\x51\x85\x58\xbf\x5a\x06\xe9\xc7\x54\x1f\x21\xc3\x04\x55\x1f\x82\xd3\xe0\xc2\x2a\x4a\x27\x12\x91\x09\xe3\xb0\x86\xb8\xc6\x14\x80\x63\x36\xae\x3c\xe1\x51\xdd\xfd\x8c\xc1\x4b\xd6\xda\xdb\x83\x3a\x32\x74\x9f\x4f\x18\xba\x6f\x3e\x45\x09\x37\x73\x10\xaf\x65\x14\x9b\xce\x7f\x82\x90\x38\x1b\xa5\xf2\x51\x0f\x3e\xff\x2f\xeb\xf6\x46\xd5\x5b\x35\x87\x34\x47\x24\x17\x7a\x1e\x24\xb9\x39\x58\xbe\xea\xfd\x9c\x83\x9a\x80\xa3\x61\x2a\x3d\xe4\x32\x08\xed\x2f\x22\xc9\xfa\x09\xeb\xc4\x19\x68\xe5\x55\xa5\x34\x95\x93\x76\xd2\x08\x6d\xfb\x72\xa1\x2c\xe1\xd4\x41\xa5\x1a\x54\x90\x8f\x5c\xd0\x01\x63\xe5\x60\x40\x2d\xfd\x2d\xff\x13\x9c\xcb\xe8\x52\x3a\x9d\xcc\x14\x98\x04\x9c\x1f\xa4\xf0\xd0\x96\xcf\x7d\xa6\xd8\x3b\x87\x15\xb3\xc7\x30\x31\xc7\xf6\x1a\x3c\x99\x36\x29\xe5\xef\xb5\xc5\x1c\x7a\xff\x70\x18\x41\x2f\x28\x74\xa3\x71\x7c\x3b\xb7\x17\x1b\xe9\x0b\x51\x8e\xdc\xbd\xc5\x60\xe1\x1d\x64\x15\x64\x35\x40\x1a\x26\xdd\xe8\xe3\xc8\x02\x86\xc8\x9d\xd1\x75\xce\xc0\xad\x8d\xab\x6e\x43\x7a\xee\x83\x5e\x8b\xf5\xf6\x46\x7a\xdf\x20\x6f\x55\x84\x25\x64\x20\x07\x03\xfe\xe7\x4a\x60\xce\x47\x5b\x51\x6b\xd9\xa8\xb8\xd0\xe5\xa4\x1b\xf8\x07\x19\x8f\xaf\xe0\xcb\x8f\x24\x45\x4d\xf8\xba\x7b\xcc\xf5\x3e\x65\x39\xb4\x80\x24\x52\x7a\x58\xdf\x65\xf7\xcb\x68\x0b\x9e\x6d\x1c\x44\xb2\x28\x86\xc4\x61\x1d\x32\xa2\x71\xe1\x4f\xfb\x34\x71\xef\x72\xe9\x17\x5d\xbf\x2d\xa6\xb0\x8e\x2a\x0e\x57\x58\xcc\x4f\x51\xac\xc4\x60\x35\xc4\x22\x4a\x26\x18\x1a\x7c\x67\x04\x58\x44\xd6\x48\xed\x09\x9f\x47\xa3\x5d\xe5\xe7\x14\xe7\x60\x85\xc3\x66\x88\x08\x9a\x3a\xf8\xd4\x6c\x95\x70\xb0\x6c\xef\x3a\x53\xd3\x0c\x73\x2c\x06\x41\x36\x29\x64\x83\xf1\xf5\xa1\x51\x52\x30\x39\xf4\x75\xba\x95\x44\x82\x9c\x54\xc0\x7b\x2e\x6b\x9d\xd6\x59\x7a\x5c\xc1\xc7\x65\x80\x6d\xcb\x84\x4e\x5e\xdb\x6d\x10\x1b\x6d\x4c\x82\x8c\x37\x3a\xc4\x15\x09\x3b\xe2\x07\xc3\x9a\xf6\x2c\x96\x78\xe1\x31\x1a\xa9\x3c\x52\xe5\xd3\x3a\x91\x4e\x63\x8c\x26\x6f\x56\x49\xe3\x79\x23\x3c\x8f\x3d\x25\x27\x11\xbd\x88\x0f\x46\xdb\x4f\xd7\x4a\x3c\x2c\xe2\x02\x8f\x92\xf8\xe3\xc0\x25\x47\x63\x65\x1c\x87\xb7\x69\x9e\xf3\xde\xec\x61\x2a\x84\x87\xa2\x25\x59\x62\xbc\x59\x18\xa5\xed\xa7\xcb\x52\x87\xfe\x26\x43\xca\x5d\xc0\x81\xdc\x63\xea\xae\xfe\x9b\xac\xd8\xfd\xea\x63\x52\xab\x9c\xe2\xcf\xb2\x25\x3d\x23\x3f\xd9\x3a\xfb\x98\x3f\xc3\x99\x91\x90\x4f\xae\x39\x55\x90\xeb\x44\x1e\x02\x1b\x14\xd2\x11\xc3\xdf\xe8\x20\x5c\x2b\x7f\xee\x14\x43\x79\x50\xe4\xb1\x3a\x48\x2f\x05\x37\xe2\x07\xdb\xd7\xf8\xf4\xb2\xdc\xbb\x88\xc0\x97\x9d\xe2\x13\x20\xc7\xf0\xe0\x9c\xfa\x8f\x5c\xf3\x77\xc7\x3b\xb0\x91\x15\x96\xc4\x0e\xb0\xf3\x13\x6a\xe5\xac\x68\xfc\xa3\xab\x6e\xef\x9d\x00\xf4\xbd\xeb\xaa\xdd\x39\xdb\x64\xeb\x4c\x8d\xa5\x6f\x62\xef\x7c\xdc\xc1\xa1\x35\x58\x5d\x7c\xfd\x42\x6c\xdb\x74\xec\xfa\x6b\xc8\x59\xd5\xb9\xb4\x3e\x65\xa3\x29\x20\x6a\xd4\x29\x3a\xf8\x0f\x25\x58\x44\x4a\x42\x90\x11\xc6\x05\x55\x33\x7b\x62\xe2\x4e\xdc\x62\x75\x23\xdc\xd2\xd8\x57\x37\xc2\xd9\xf6\x87\xbd\x3c\xe4\xd2\xcb\xe4\x59\x1b\x2a\xf9\x3c\x96\xb5\x3f\xb1\x74\x1c\x16\x4c\x0c\x02\x3a\x3d\x8b\xf3\x18\x7d\x55\xb1\xf2\x2c\x61\x86\x64\xb2\x98\x82\xb1\x8b\x18\x29\x30\x51\x0b\x17\x04\x33\x57\x70\xc8\x56\xf4\xe0\xd0\xba\x5b\x65\x8f\xc1\x6d\xfd\x33\x57\x7d\x7a\x25\x94\xaf\x2f\x4d\xe9\x01\xf3\x8d\x66\xb3\x1b\x5e\x87\x3e\x51\x82\x32\x23\xde\xd0\xe3\xfb\x4a\x5e\x1b\x02\x41\xf0\x2e\x62\x04\xad\xc8\x93\xbe\x66\xf9\x8b\x65\xb3\x49\x13\xe5\x74\x40\x1e\x97\xa4\xf3\x0d\x0b\x9d\xc8\x0a\x59\xb9\xce\x13\x56\x60\x7e\x7b\xe0\xdb\xf4\xc5\x3b\x43\xf4\x26\x06\x64\xe0\xf6\x81\xec\x45\xd9\x83\x05\x96\x30\x5e\xc4\xe4\xe0\x56\xfc\x27\xfe\xe8\xcd\xeb\xfe\xcf\x14\x68\x68\x35\xd2\x48\x1d\x04\xc9\x0c\xc4\xe1\x8d\xc8\x84\x9f\x2e\xbb\xc6\x8d\xc3\xc2\xe9\x9b\xad\x8c\xbb\xc3\x71\xd5\xcb\xe2\xfe\x0e\x1f\x46\xa6\xc8\x6e\x46\xd5\x11\x85\xaf\xe4\xa0\xf4\xc9\x3e\x0d\x33\xfe\x04\xdb\x34\x93\xd8\x9a\xd6\xbb\xa8\x48\xfe\x25\xff\x80\xd6\x78\x80\xa3\x01\xe7\xc1\xea\x8d\xa2\x03\x42\xc4\x33\xad\xaa\xa2\x27\x91\x9f\xe4\x78\xe5\x12\xe9\x48\x4f\x11\xb7\x55\xd2\xd3\x15\xc1\x40\xd7\x8b\x9c\x3c\x3a\x94\x25\xef\xc7\xf7\x15\x53\xa6\x7d\x0c\x45\x0f\xc9\x3d\x07\x08\x52\x72\x72\x73\x8d\x58\xdc\xa9\xa0\x06\x68\xd9\x81\x7b\x4f\x3c\xd9\x2f\xb0\x93\xc6\x02\xf6\xf7\x4f\xd3\x25\x61\xed\x22\x07\xcf\xa6\x56\x23\xcb\xa9\x7e\x88\x13\x04\xc6\x1f\xc7\xdf\xe4\x1b\x0c\x6d\x7f\x2b\x6b\x79\x4c\xde\x34\x75\xb6\x2f\x31\xe1\xef\x0f\xbb\xb9\xa4\x46\xfb\xf4\xf9\xe6\x04\x85\xbb\x55\x6c\x04\xed\x89\x0c\x01\x67\x4d\xe7\xe2\x8d\xf4\xb7\xe2\xdb\xce\x6e\x95\x7f\x22\xde\x2b\x5b\x7b\x6d\x70\x65\xc0\x01\x4d\xb8\x30\xe5\xbb\xb6\x00\x73\xa2\x4d\x22\x23\x7a\x6e\xa9\xa0\xbb\xa4\xaa\xbe\x1a\xd9\xdb\x0b\xfb\xfa\x24\x80\xff\x38\x64\x75\x44\xe1\x2e\x87\x2d\x42\xae\xeb\x97\x47\x1d\x77\xfa\x90\x35\x56\xfd\xa4\x1e\x33\xa1\x28\x76\x29\x3a\x97\xfc\x60\xcf\x46\xc5\xe8\x55\xc8\x1c\x9f\x4a\x1f\xc0\xdd\x0c\x1d\x18\x49\x68\xdb\xb1\x66\x3e\x9f\x7c\xf9\x64\x7d\x47\x3e\xba\xe3\xc8\x51\x8b\x85\x99\x0b\x14\x50\xfa\xfd\xe8\xdd\xdf\x14\x5f\x82\xd1\x25\xfa\xfd\x52\x6e\x84\xc9\x94\xeb\x42\x66\x84\x3c\xb5\x41\x45\x1b\xa3\x59\x8f\x8a\x64\x73\x95\x6f\xeb\xdd\x1d\x98\xbb\xe0\x9a\xfc\x4d\x56\xda\xc6\xdd\xd8\x5a\xbc\x5a\x3d\x7d\xfa\x54\x6c\xdb\xec\x53\x3a\x0f\x46\x92\x39\x88\x4a\xfa\x3b\x85\x01\x09\xec\xc2\xc5\x14\xdd\xa3\x5f\xe3\x6f\x57\xa9\xc2\xed\x03\x6c\xce\x77\xad\xaa\xb4\x34\xe7\x60\xa3\x8b\xfc\x75\xec\x85\x85\xa1\x00\xc5\xa5\x1a\x63\x72\x9f\x41\x40\xac\xa5\x4d\x65\x1a\x1d\xda\xe8\xb5\xab\xb5\x2a\x48\x39\xd2\xfe\x63\xc4\xe6\xe1\xc8\xa9\x9d\xf2\x69\xf7\x32\x44\x35\x20\xa1\x96\xc6\x2b\x59\x1f\x32\x44\x0f\xa3\x3f\x8f\xd8\x39\x02\x85\xaf\xe9\xbd\x44\x50\x48\x19\xc5\xb5\xda\x69\x2a\x27\x29\x58\x4d\xc8\x08\xc6\x3b\x8c\x8e\x0f\xec\x4a\x17\x18\x52\xc9\xc5\xd0\x5c\x5f\x93\x60\x91\x56\xa1\x95\xec\x88\x78\x4d\x13\x45\x6f\xfa\xe3\x19\x95\xf8\xd3\x70\x59\x0e\xc2\xab\xa0\x9a\xb5\x51\x89\x6f\x8c\x7d\x41\xe7\x1b\x1c\x95\x69\xc0\x42\xa0\x08\x1d\xda\xec\x25\xc9\x18\x19\x09\x63\xba\x1e\xc4\x67\xc1\x3e\x82\xc7\xc7\xac\x2d\xad\xd7\xb6\xd2\xad\x34\xd9\x33\xab\x32\xec\x27\x45\xd2\x89\x1e\x83\x8e\xe4\x41\x54\xc9\x02\xcf\x98\x7c\x14\xe1\x03\xfa\xe2\xb0\x73\x9d\xa9\x31\xae\x26\x6b\x86\xc6\x44\x8f\x9b\xc8\x6b\x8d\xf6\xcf\x46\xd6\x0c\xe8\xe1\x88\xaa\x36\x3a\x1e\x38\xac\x7d\x05\x2b\x19\x19\x6c\x8c\x2f\xd5\xbc\x14\x91\x71\x4a\xcc\x6f\x3c\xd8\xd5\x23\x06\x1c\xfe\x72\x4a\xbc\x6a\x1b\x25\xf8\x04\xc9\xab\xdd\xe8\x64\x53\xed\xe5\x41\xec\x5c\xd3\xc3\x28\xd3\x3b\xab\x2e\x3e\xee\x31\x06\x64\x93\x49\x2b\x76\x58\x7e\x45\x11\x09\x74\x45\x7a\xe0\x6c\x2a\x71\xc1\xc0\x2d\x9e\x1e\x8e\x5c\xf1\x59\x79\x4c\x8b\xe4\x3a\xe2\xe7\xc7\xed\x46\xc8\x9c\x6a\xa5\x97\x48\x16\x78\xcb\x97\x89\xca\x1c\x18\xd7\xb3\xf4\xfc\xe0\x21\xc1\xd9\x79\x05\xb2\x85\xc1\x5f\x25\xd8\x7e\x70\xd3\x52\xe5\xd7\x20\xb4\xd9\x87\xdc\x94\x57\x70\x51\xea\x0c\xbe\x3a\x88\x22\xb2\x9a\x2b\x4f\x39\xea\x8a\xe4\x00\x1c\x73\xf5\x4c\x9d\x94\x32\xcc\xf4\x31\x9a\x9c\x08\xf5\xb3\xb4\x72\xe9\x42\x8f\xa4\x2a\x13\x6b\x8f\xce\x2d\xc5\xa6\xbc\xb4\x01\x46\x08\x77\x11\xb7\x9b\xc2\x3d\x28\x35\x61\xe3\x56\x94\xd8\x49\x4b\x18\x9d\xf0\xea\x31\x8d\xb0\x78\x72\x28\x06\x1e\x99\x14\x88\x27\x75\xb4\x9e\x58\x56\x98\x76\x08\xd4\xa1\xf4\x96\xcd\xea\x1b\x7b\xc8\x1d\x5f\xfc\x40\x65\xf3\xbe\xcb\xb6\x25\x1e\x3f\x1d\x27\x40\x4f\x38\xa0\x76\x77\x08\xe8\x29\xc0\xc8\x56\xe0\x08\x8b\x1a\x17\xed\x90\xf2\x4b\x65\x3f\xa4\xb4\xa8\xf3\x59\xea\xf7\xc3\x5a\x70\xee\xdd\xd6\xbb\x11\x05\xdd\x6a\x7e\xf6\x04\xbb\x73\xad\x43\xa3\x43\xe8\x53\xcc\x53\x08\xfa\xe3\x3c\x64\xc1\x9a\x05\x96\xd1\x68\x82\x1c\x62\xe0\x3e\x00\xcb\x66\x41\xc5\x64\x18\x11\x50\xb6\x16\x4a\x7a\x73\xc8\xb3\x3a\x49\x25\x8b\xd1\xfe\xde\x0c\x1b\x20\xd0\x92\x45\x54\xc2\x52\x6d\xda\x76\x37\xda\x15\x5a\xc5\x42\x2b\xa1\x7f\x25\x63\x41\xe0\x33\x70\xb0\x1e\x14\xd5\x7f\xf8\x7b\xe7\x89\xe5\xac\x38\x55\x27\x4a\x11\xe3\xf1\xce\x1d\xbf\x69\xf4\xa5\xdc\xa8\xae\xbf\x05\x04\x3c\x26\x89\x97\x45\x5a\xdb\x19\x73\xc4\x05\x54\x8e\x29\xf1\x38\xc2\x58\x69\xb3\x14\x65\x9f\xb1\xba\x6f\x46\x19\x2e\x28\x11\x92\xf9\x60\x10\xd4\xa7\xbf\x8c\x2d\x88\xe8\x88\x15\xa6\xab\xa3\xe3\xc9\x21\x7f\x8a\x85\xeb\xc1\xc5\x64\xbc\x21\x18\x60\x28\xcc\xe9\x0b\x6e\x6f\x07\x9c\x94\xf4\x29\xd9\x1b\x2b\xd1\x85\x0e\x65\xf3\xfa\x70\x92\x7d\x6d\x91\xbf\xd7\x6d\x3b\x7f\x8c\x0b\x9c\x0a\x48\xbe\xf9\xf8\xee\x2f\x4b\x62\x1b\x81\xec\x3b\x73\x10\x8d\xf4\xb7\xc8\x27\xae\xb1\xce\x19\x79\x49\x88\xca\x5c\x37\x9a\x82\xef\xd8\x80\x63\x2a\x9c\x79\xcf\xf4\x5e\x7d\x86\x75\x9a\xe3\x37\x89\xff\xad\x6a\x1e\x6b\x6b\xa4\xee\x87\x0d\x1e\x03\x96\x81\x22\x66\x2e\xcd\xc2\xc8\x43\x2a\xd8\x23\xfb\x19\xac\xe7\x9a\xa6\xa7\x9a\xd6\x38\x2a\x70\xc6\x96\x97\x28\x8c\x6b\x7d\xa7\x19\x3b\xec\xd8\x62\x4f\xf9\x54\x69\x85\x6b\x94\x4d\x74\x6e\x0c\x02\x71\xde\xd4\x06\x2e\x7c\x44\x83\x04\x49\x68\x31\xf2\x8d\xac\x7d\xdc\x42\x22\x57\xb2\x56\xd8\x50\x80\xda\xf5\x31\x4d\x04\xe7\x98\x40\x99\xe0\xf1\xc3\xd6\xa3\x3d\xb2\x16\xdd\xc4\x67\x7d\x90\x2f\x95\xcb\x52\xe6\xa3\x48\x26\xf4\x7d\x34\x40\x05\x52\x0e\xa4\x39\x23\x30\xff\xef\xff\xfe\x3f\xe2\xaf\x2a\x71\xfd\x6f\xc1\x1e\xe5\x71\x9f\xfb\x91\x53\xf4\x9b\xb5\x5c\xfc\x13\x25\xc9\x88\xd9\xe7\xdf\xba\xb8\xa3\x97\x92\x47\xbf\xf4\x49\x7f\x72\x68\x60\xd1\x43\xd2\x3a\x27\x9f\x1e\xb3\xba\xfd\xe1\xde\xb2\x8d\xbd\x96\xf5\x22\xc5\x91\x6c\x70\xdc\x06\xf4\x36\x64\xc5\xa4\x2d\xf6\xd0\xaf\x2c\x26\xf0\x1a\x2a\x1f\xe7\x77\x93\xca\xe1\xfe\x06\x18\x51\xed\x62\xe5\x9a\x02\xd2\x59\x7a\x24\x70\x02\xfa\xa2\xe0\xbe\xf0\x12\xbe\x63\x5c\xc0\xff\xc7\x88\x1d\x9e\x9f\x2c\xdf\x96\xd4\x83\x0f\x3a\xae\x10\x61\x8d\x67\x94\x9d\x6e\xc0\x2b\x27\xf2\x28\x26\xd9\xc8\x4e\x00\x1e\x34\x34\x90\xbc\x22\xfe\x4d\x4e\xb2\x4b\x51\x75\x4d\x67\x24\x46\x29\xaf\x5f\x80\x91\x81\x49\x29\x98\x6a\xa5\x7a\xef\x3e\x4d\x8d\x8c\xf2\xd8\xf7\x61\x4d\x47\x9c\x8a\xa8\xbc\xb4\xb5\x6b\x84\x27\x33\xb1\x2f\xff\xc6\x00\x07\xda\x75\x70\x95\x41\x80\x07\x55\xf9\x85\x4d\x77\x3a\x8f\x45\x4b\x7f\xd4\x9b\x65\x98\x94\xcb\x4b\x3a\x9f\x2d\x8b\xa6\xdd\x77\x6a\x9c\x89\x0e\xb3\xa7\x2d\x1e\xfe\xc5\xd6\x20\xb4\xa6\x24\x24\x4c\xff\x71\x0b\xee\xa0\xbf\xeb\xbb\xf6\xe2\x90\x8f\x1b\x22\x32\x42\x98\x23\xba\xc3\xe9\xcd\x15\x40\xe2\xaf\xce\x95\x3f\x52\xcc\xcd\x3a\xfb\x78\xcc\x14\x93\x21\x2e\xf0\x98\x87\x83\xbe\x2f\x8f\x30\x4d\x39\x6c\x09\x3c\x0a\xac\xf5\xcc\x18\xc7\xad\xe7\x72\x15\x28\x13\x18\x1d\x61\xbc\x8a\xe1\xac\x84\xd1\x77\x65\x08\x22\x83\x3f\xaf\x8a\x27\x27\xd2\x57\x6c\x39\x5c\x20\xe5\xca\x29\xe0\x1a\x5c\x6e\x0e\xd0\x79\xfd\xb1\xf3\x3a\x1e\x9b\x05\xff\x2f\x9c\xd8\x89\xe3\x09\xb3\xdd\x1c\xfe\x29\x0f\x66\x3a\x5b\x88\xd9\xae\x75\x50\x12\x0b\x5d\x8f\xcf\x6b\xeb\x74\x70\x76\x70\x5a\x91\x4b\x25\xb5\x6c\x17\xa3\x9e\xf1\x3d\x98\x6f\xe0\x06\x22\x36\x38\x71\xc6\x0e\xb5\x7b\xe5\x2c\x1d\xe9\x20\x1e\x13\xa9\x09\x8c\x84\xdb\x69\xac\x44\xad\xe4\x86\xe0\xcf\x25\x14\x1a\x7c\x51\x73\xf8\x15\xff\x17\x47\x98\xda\x0c\x84\xd8\x59\xab\x96\x51\x96\xf3\xa1\xfd\x6f\x1d\xa5\x99\x3a\xb6\x53\x6c\xb4\x17\xb5\xa9\xfd\xa4\xe7\x76\x9e\x93\x2e\xcb\xd9\xcc\x2c\xfb\xda\xbb\xbd\x9d\x92\xb2\xdf\x2a\x69\xfe\xce\x87\xf8\xa7\x59\x32\x55\x3a\x3c\x83\x16\xd0\x73\x67\x1b\x23\x1e\x68\x6b\x5c\xd7\x2f\x4f\x10\xbe\x5d\x12\x99\x47\x3f\xfd\x43\x59\x0a\x73\x2f\xc9\x0f\x81\x5e\xc0\xaa\x6f\x11\x49\x30\x1d\x4b\xff\x20\xf0\xc5\x12\x3f\xa6\x68\x52\x32\x95\xe6\x45\x83\x08\x7d\xe0\x84\x07\xca\x71\x2c\xdd\x60\x88\x0a\x19\x6c\x52\x06\xb8\x95\x71\xb7\x97\x87\x1c\x15\x97\xe0\xf1\x52\xa4\x79\xab\x9a\x10\x9d\x55\x2b\x8e\x55\x11\x72\x63\xd7\x4d\xb1\xd9\x73\xa9\x64\x1c\x90\x05\xf7\xdd\x46\xb8\x2a\xc8\xba\x21\x17\x15\xf2\xa4\x73\x95\xd3\xe0\x0f\x6e\x23\x9e\x21\x75\x93\x0a\x43\x1a\x75\xd2\x9b\x13\x5f\xbf\x7a\x3a\xa4\x5b\x37\xc1\xf1\x97\xf1\x2c\x72\x0c\x37\xa6\x36\x22\x70\x60\x8d\xb4\xdb\x4e\x52\x83\x30\x71\x6b\xdd\x7e\x0c\xc2\x47\x7b\x14\xa7\x85\xf1\xf4\xc9\xc7\x21\x96\x14\x94\xb5\xd1\xcd\x1a\x5d\x43\x17\xc3\x0a\xcb\xdf\x02\xd6\x8e\xab\x56\x79\x46\xbe\x05\x07\x06\x6c\x62\xf5\xca\x4f\x0c\xca\x82\x73\xd5\x7b\xc0\xb8\xca\x8d\xb4\x0f\x02\x07\xfc\x0a\x17\xac\x8c\x9c\x17\x9d\xbe\x72\x99\x1d\x5e\x08\x55\xa7\x61\x97\xee\x40\xf1\xd3\xa3\xaf\x87\x9d\xef\xd6\x62\xfc\x69\xf4\xea\x2c\xe5\x6d\xfe\xf2\xf0\x20\x60\x88\xfa\xb8\xed\xd2\x33\x2a\xd8\xeb\x09\x20\x4f\x45\xfe\x6a\x8c\xe8\x52\x70\x1b\x1e\xb3\xe9\x0c\x47\x16\x8b\xb2\x0b\x7e\x7e\xd6\x52\xa5\x6c\x4b\xc3\xea\x6b\x21\x78\x05\x73\x41\xb1\x4b\xf1\x9b\x0d\xf6\x09\xa3\x50\x28\xca\xc0\xcc\xeb\xb4\x73\x7b\x3a\xfc\x5c\xde\xa9\x63\x5f\x18\xb5\x97\x79\x86\x4b\x34\xda\xd7\xd2\x1e\xbb\x0b\xbf\x5d\x1b\xb2\x01\xa9\xfa\xe5\x10\xf6\x05\x1c\xab\xa3\xb6\xd1\xe2\xe1\x7f\x2b\xbb\x55\x60\xab\x4c\x29\xb6\xbf\x4c\xf6\x7f\x1e\xd6\x53\xce\xb7\xe1\x28\xf2\xe9\x7d\xe3\x85\xd2\xda\xa1\x93\x92\x7a\xe4\x72\x16\x1d\x31\x88\x98\xf2\xa1\xb0\x7e\x59\x50\xc1\xd5\x24\x99\x60\x0c\x7d\x43\xac\x53\x1d\x7c\x6d\xa6\x31\x4d\x29\xf8\xf0\xd7\x52\xd4\xcf\xe9\xee\x77\x6b\x2c\x0d\xa1\x38\x0b\xb5\x6a\x80\x6f\x94\x0c\x35\xa3\xd7\x0e\xa0\x01\xbf\x79\x92\x3d\x2d\x7b\xaa\xc4\x19\xb6\x16\x1b\x50\x4c\x7f\x14\x3a\xe5\x6b\x69\xb9\x4d\xe6\xbb\x46\x4f\x40\xcc\x17\x73\x8b\x95\x96\xc9\x45\x51\xca\x4f\x62\xdc\x4d\x16\x15\x73\x84\x0d\x15\x31\x62\x98\xf4\x11\x17\x9e\x90\x89\x36\x22\xb1\xdc\xa5\xd3\x96\x37\x91\x8e\x1b\x65\x07\xe9\xab\x95\x97\xd5\xad\x49\x66\x1c\x82\xba\x7a\xac\x35\x3f\xa7\x56\x88\xa0\x66\x8a\x02\xf1\xa2\xbe\x7a\xca\xf0\x2f\xce\x11\x71\x32\x2c\x77\x58\xc9\x9b\x6d\x0e\x4c\xfb\x08\xfa\x90\x8f\xa8\xea\x5b\x1b\xa7\x9b\xe5\xc4\x8b\xa7\xa3\xd6\x26\x1b\xb5\x4f\x47\x69\x8d\xdb\x4c\xec\x7e\x63\x25\x6b\x29\xdc\x83\x8a\xaf\x47\xe2\x1e\x17\x48\x50\xe5\x26\x86\xbb\x28\x5f\xf7\x00\xb3\x75\x83\xab\x97\xc9\x97\x40\x17\x63\x44\x3f\xe5\x72\xc1\x8f\x07\x8f\xaa\x4f\x0f\x9e\x1a\xc5\x20\x27\x58\xbe\x23\xef\xd4\x1d\xce\x26\x61\x04\x24\x6a\x28\x83\x2d\x4d\x54\xa3\xf9\x05\xb9\x3f\x70\x42\xc2\xf7\xaf\xd2\xb6\x92\xad\xac\x74\x04\xa5\x82\x74\x87\x29\x11\x4c\x81\xb0\x29\xce\xe4\x15\x98\x98\x64\x52\x52\xc0\x80\x1f\x19\x0b\x5a\x85\x01\x28\x5f\xc3\x75\xdc\x50\xb5\x6e\x0a\xef\x52\x25\x6d\x67\x33\x60\x9f\xbf\xba\xe1\xf2\x2f\xfa\x57\xc8\x80\xfe\x23\xb3\x1c\x8e\xcd\x02\xbb\x9c\x2e\x75\xa3\x6c\xbc\xf7\x78\xc3\x42\x7e\x02\xec\x63\xa9\x1a\xe2\xdd\x0c\x51\xb1\xfd\xd0\xef\xc3\x3d\xeb\xc0\x91\xf6\x3b\xf2\xed\x26\xf5\xe2\x80\x98\x7c\x88\x6e\xb9\x1c\x5a\x2d\x63\x54\x4d\x1b\xa9\x56\x92\x71\x3c\xc7\xfd\xdf\x26\x35\x69\x41\x82\x82\x7d\x93\x46\x65\x49\x63\x93\x7e\x50\x77\x3b\xa9\x7b\xb9\xef\x26\x5d\xfa\xf9\xf6\xce\x8b\xee\xe1\x40\x0a\x14\x97\xb2\x90\x2c\x43\x51\xf4\xb1\x57\xf2\x7d\xd9\x09\xea\x43\xaf\x26\xfd\xfa\x37\xb9\x9c\x17\xac\xea\x79\x19\x1b\xcf\xc8\x58\x1d\xf2\xce\xe6\xd6\x32\x12\xe6\xdd\x72\xd2\xd2\x3a\x84\xac\x2f\x91\xbf\x45\xa6\x98\x35\x5d\xba\x68\x18\xcb\x6a\x25\xb1\xbc\xfd\x46\x2b\x3a\x6e\x35\x9b\x94\x4a\xee\x50\x85\x18\xca\x4f\x69\x8b\xbd\x98\xa3\x79\xfd\x60\x5b\xec\xf9\x32\x9a\xd7\xaf\xa5\xbf\x0d\xb7\xfa\x38\xa0\x32\x95\x3b\xbe\xa4\xed\xc3\xb2\x88\x8a\xd8\x49\x5b\x83\xa0\x76\x1b\xe1\xe4\xad\x58\x4b\x7f\xfb\x49\xaa\x6d\xc7\xdd\x93\xf1\x3f\x48\xb9\xa5\x42\x7c\x34\xd3\xfa\xb5\x2c\x61\x19\x03\x0b\xe7\x22\x6d\xb9\xe7\x17\xac\x2e\x13\x5e\x23\x9e\x69\x85\x93\x7c\x6c\xf4\xad\x2a\x5a\x94\xf7\xc6\x5b\xfe\xe1\xcd\xeb\x3e\xe7\x80\x62\x0d\x6d\xa1\xab\x97\x2b\x8c\xa7\xf9\x1a\x3f\x43\xf4\xa5\x8c\x99\xf5\x45\x62\x61\x03\x95\x88\x70\x7b\xd5\x45\xc7\x40\xc9\x8a\xf0\x75\xdf\xba\xf6\xd8\x22\x9f\x0a\xbd\x5e\xa2\xc1\xef\xbd\x61\xe4\x42\xd5\x9c\x76\xff\x8f\x7a\xa3\x4a\x7d\xfc\x46\xdd\x21\x29\xf9\x19\x22\xa0\xb5\x0a\xd1\xed\x41\x88\xb5\x84\x4d\xbb\xe3\xd8\xf4\x89\xae\x92\xd3\x80\x53\x62\xbf\x2b\xeb\x1f\x56\x83\x06\x92\x47\x01\xfc\x89\xca\x7f\xc2\x96\xd4\x4a\x8e\xf0\xe3\x1c\x66\x4a\xd9\xa5\x88\xd8\x52\xca\x57\x4d\x34\x07\x0c\x7d\x86\x39\x37\x8d\xdb\x51\xb8\x79\xe1\x21\x09\x51\x7c\xed\x6c\x2d\x1e\xbe\x7d\xfb\x68\x51\x68\xe3\x92\xea\xfc\x85\x22\x83\x6b\xf3\x37\xe0\x0c\x79\xb8\x41\x8c\x8e\xad\x8c\x8b\xbb\x8b\x85\xc7\xb9\x7c\xd3\xb9\xd6\xd0\x20\x1f\x54\x88\x72\x6d\xc0\x24\x91\x54\x2e\x2c\xe3\x4e\x57\x58\x40\x4c\xda\x12\xc4\x77\xdf\xf5\x99\xc4\x49\xa2\x77\x1a\x85\xa2\x90\x13\x65\x10\x45\x23\xd3\xa4\xc4\x1f\xeb\xc5\xad\xd5\xe7\x24\x14\x0e\x4f\x87\x54\x20\xd9\xd7\xd9\x0c\x5b\xeb\xca\x9e\xb2\xdd\x68\x32\x1d\x32\x83\x4d\xc1\x64\x26\xde\x17\xa0\x4e\x78\x74\xd9\xb9\xbd\x62\xf2\x6a\x1f\x62\x8e\xfc\x15\x0b\xc5\xcd\x7d\x19\xa1\x57\x36\x5c\x2c\xbe\x95\xbb\xbc\x74\x56\x57\x32\x2a\x11\x74\xd3\x1a\x25\x14\xf5\x17\x08\x89\x16\xbb\x52\x6d\x0c\x19\x6e\x85\xeb\x97\x8d\xc0\xd1\x9c\xcb\x21\x72\x98\xb9\xbc\x87\x45\x70\x26\x14\xa8\xbc\xc3\x0c\x95\x3d\x71\x02\x0c\x0d\xef\xf9\x70\xea\xa0\x0a\x71\x0d\x1a\xbd\xb3\x99\x12\xf3\xad\x51\xc4\xbb\x20\xde\xde\x69\x04\xc9\x1e\x94\x7f\x10\xc4\xeb\x14\x35\x5d\x7c\x6b\xdf\x29\x1b\x8e\xe5\xfa\xc7\x02\xc4\x3e\xe0\x22\xdf\x2b\x93\xfb\x92\xcb\x39\x56\xde\xb8\xdd\xc7\x32\x79\xd0\xc3\xec\x64\xbf\xdd\x44\x4b\x33\xa0\x0b\xe7\x6b\xa8\x0e\x0c\x30\xdf\x29\xe9\x49\x2b\xeb\x88\xff\x60\x29\x9d\x1a\xd4\x20\x94\xd5\x89\xe0\x8a\x80\xf8\xc4\x7b\x7a\x70\x2d\x01\x68\x88\x44\x83\x52\x07\xcb\xc4\x35\x68\x2f\xf1\xba\xf3\x13\x3b\xbf\xb8\xb5\xe7\x07\xca\xea\xdf\x24\xdc\xbc\xb0\x3b\xe7\xa0\x0a\x39\x33\x15\xc8\x44\xc1\xf0\xc1\xe4\x6a\x15\xac\xec\x71\x35\xf2\xe0\x24\x3d\x39\xe9\x0d\xac\x52\xeb\xe3\x38\x68\x3d\x1b\x77\xfc\xe0\x29\xe8\x9d\xc3\x06\xeb\x67\x31\x77\x6c\x9c\xa0\xd3\xc0\x79\x2b\xcc\xbb\x25\x49\x48\x03\x1f\xf8\x3b\x3b\xa2\xc4\x1c\xca\x3f\xfe\x6d\xb5\x53\xd5\x2d\x1d\xed\xa1\x4d\x52\xb6\xd6\x92\x71\xf4\xae\x73\x78\xbd\x8b\x46\x32\x25\x89\xcf\xf0\xa0\xcf\xbe\xa4\x2c\xcc\x9b\xda\xe4\xd4\xae\x2b\x32\x37\xf5\x11\x7f\x58\x6a\xa5\xcc\x2c\x17\x94\x1c\x8a\xdc\x83\xbe\x04\x5f\xe2\x1d\xae\x1d\xf9\xc8\x98\xdc\x5a\x36\xe8\xa1\xf3\x9d\xa6\x40\x22\x82\x1b\x27\xe0\x86\x30\xc1\x28\xa1\x1f\x39\xd2\x7b\x55\xbf\x3a\x2a\x19\x1c\xc6\x7a\x67\x09\xa2\xbc\xc2\x14\x27\x9d\xc2\xc2\x5b\x2f\xf8\xa0\x9e\x88\x1b\x5a\xa1\x37\xdf\x3f\x08\x7c\x2c\xb9\x00\x4d\x1e\x72\x4f\x6f\x2b\xa4\x89\xca\x73\x20\x82\x9e\x97\x58\xc7\x98\x27\x87\x0a\x74\x40\xf1\x59\x47\x28\x42\x2c\xe3\xc4\xd8\x1d\x85\x7e\xe1\x49\x3d\x3f\x19\x3a\xb7\x19\xd5\xb7\x43\x4e\x72\x84\x38\x4a\xac\xb4\x4a\x9c\x73\xf0\xaa\x65\x5c\x91\x1f\xd7\xa2\x65\xb2\x37\xe4\x40\x18\x10\xb1\xfd\x9c\xe1\x99\xeb\x56\x08\x6e\xb1\x20\x38\x30\x7e\x01\xa3\x1a\x66\x1f\xf4\xbb\x25\x0f\x4a\xcc\x62\xa3\x27\xfd\x3e\xff\xb6\x7f\x66\x59\x1a\x40\xad\x45\x4a\x76\x82\x4c\x43\xf0\x44\xfc\x65\x69\xd0\x43\x6c\xbd\xc4\xfa\xe8\x7e\x48\xd4\x47\x29\x87\x62\x7e\xee\x34\xf5\x79\xeb\x97\x73\x91\x22\xd4\xdb\xf5\xe1\x41\x10\xdf\x4a\x7b\xdc\x91\x63\x2a\xd9\x74\x11\xaa\x68\x41\x7f\xcb\x14\x9e\x56\xdb\x6d\xd8\x21\xc3\x2c\xb6\xb3\x0e\x56\xde\x2a\x0c\x0f\x6c\x8d\xbb\x53\xf7\x1c\x9e\x5e\x44\x21\xc6\x3d\x26\x77\x92\xa2\x07\x7d\xbf\x9b\x15\x55\x36\x99\xae\xe2\x8e\xfc\xc4\xfa\x8c\x76\xfc\x28\xb6\x36\x1b\x68\xa6\xab\x8a\xcf\x1f\x36\xa0\xc1\x3d\x7a\x10\x0a\x9f\x98\xad\x7e\x42\x27\x8d\xda\x70\xae\xa8\x89\xf5\x6d\x02\xb8\x24\x56\xac\x3e\x7f\xec\xf6\x16\x5f\x73\x0e\xbc\xb0\xe3\x7e\x5d\x45\x3f\x2b\x24\x48\x93\x18\x70\xb9\xa6\x16\x6a\x85\xc7\xac\x7e\xee\x88\x33\x1c\xdf\x72\x04\x0b\x4d\x7a\x60\x51\xf9\x4c\xee\x2b\x56\x54\x83\xbb\x8d\xb8\x7e\x29\x1e\x7e\xf6\xea\x11\x9f\x8a\xbe\xf5\x1b\x62\x5c\xc4\xc3\xcf\x9e\x3e\x2a\x56\x31\x77\xbe\xd0\xc6\x70\xdb\x32\x59\xcd\x6a\xdb\xb1\x01\x92\x46\x84\xaf\x3b\xa2\xce\x49\x65\x60\xa1\x5b\x07\xf5\x73\x87\xf4\x40\xc3\x16\xdb\x99\x4b\x6d\xd7\x17\xde\xbe\x2c\x9a\xa1\x45\xaa\xa4\x4b\x20\xb1\x23\x84\x61\x42\x22\xa2\xe1\x30\x5f\x37\xf6\xda\x28\x8b\x0d\xde\xbe\xd1\x21\x16\x8b\x10\xa2\xd7\xb7\x2a\x0c\x13\x1e\x3d\x37\xd2\x64\x87\xb1\xef\x49\xdb\x13\xb7\x44\x26\x7a\x96\xcc\x73\xd2\xcf\x27\x9c\xaa\x5b\xe5\x42\xfa\x5d\xa2\xa4\x1c\x54\xe9\x3f\xaf\x5f\x0d\x72\xab\x73\xb3\xfa\x06\xbe\x07\x1a\xee\x5b\x6c\x30\x9b\x67\xc5\xe9\x1c\x3c\x46\x6d\x17\x76\x67\x9a\xa6\x25\x1e\x23\x66\x2f\x1a\x10\x56\xf1\x64\xd1\x72\x4b\x16\x1a\xbd\xe5\x41\xe8\xcf\x1e\x38\x24\x2a\x14\x6c\x94\xf9\x2f\x0f\x6f\xe2\xce\x28\x6c\xc6\xce\x0f\x19\x11\xa1\x1e\x65\x1b\xfa\xa6\xf5\x0c\x13\x5b\xf5\x9c\x1d\x03\x5b\x8e\x60\x3c\xaa\xba\xcd\x4a\x86\x4d\xee\x55\xbf\x09\x30\xfb\x61\xae\x99\x8e\x19\x4f\xbf\x35\x1d\xb6\x86\xc8\x11\x2e\xfc\x34\xdf\xd4\x0d\xa6\x3d\xb0\x18\xe1\x90\x7b\xe4\xe7\xf2\x01\x36\x52\x1b\x57\xeb\x8d\xa6\xc0\x05\xbf\xb5\xc0\x45\x0e\x53\x4b\x0c\x1a\x9a\x3a\x56\x33\xdb\xfc\x07\x2f\x03\xe6\x46\x66\xb6\x79\xeb\x65\xdb\x1a\x35\xc1\x0d\x3e\xdf\x2e\xef\x27\xd6\xcd\x53\xbb\x4a\x10\x3b\x1c\x76\x70\x86\xef\x2a\xbf\xe8\x9e\x36\xae\x37\x51\x93\x38\xa5\xe7\x27\xf1\x9c\xa8\x9f\xe7\xbb\xf2\x27\x3e\x17\x7a\x48\xe5\x3b\x46\x0b\x64\x81\x85\x4e\xf2\xc4\x65\x9b\x68\xfe\x97\x37\x9f\xda\x91\x2e\xd8\xf7\xb9\x54\x9a\x8d\xca\xb7\x2e\x4c\x6c\x9e\xe6\x3f\xa9\xdc\xb3\xb6\xa8\x37\x67\x09\x3e\x20\x6b\x60\x5b\xb8\xf7\xf4\xb7\xba\x9c\xf5\xb0\x89\x37\xb7\x9c\x1e\x9d\xc8\xe8\x40\x0a\x1d\x8e\xde\x54\xae\x34\x51\x3e\xa4\x2a\x63\x3c\xc3\xc8\xe6\x81\xa4\x3d\x05\xa3\x6b\x7f\x83\x8b\x7c\x27\xa5\x19\x58\xae\xf7\x81\x0d\x3e\x58\x39\x83\x06\xda\x66\x74\xca\x74\x28\x52\x13\xce\xf7\xdb\x70\xf2\x60\xb2\xc2\x9c\x7a\x12\x9b\x81\x19\xf2\x31\xf9\xfb\xc1\x71\xe8\xd5\x91\xdb\x4b\x5f\x73\xf8\x6d\x7a\x06\x89\x40\xb3\xcc\x02\x16\x34\x54\xca\x7b\x04\x06\xb2\x2e\x58\xe0\x27\x7d\x6c\xde\x6f\xb2\xbd\x63\xec\x89\x4e\xb2\xff\x5f\x25\x2d\xb8\xd1\x21\xb2\xb3\x35\xa4\x41\xb9\xae\x5f\xe5\x13\x31\xfe\xf5\x36\x89\x21\x3e\xbf\x83\x9f\xcd\xb1\xa7\x0c\x1a\x45\x32\x18\xe4\xaa\xbe\x7e\xfa\xd9\xbb\x1f\xdf\xfe\xf1\x8f\x9f\xfd\xf8\xe7\x1f\xbe\xc9\xb8\x10\xfe\xf3\xf3\xfa\xd5\xf8\xa3\x17\xff\x03\xbf\x3e\xfe\xf8\xba\x7e\xf9\xd9\xf0\x0f\x33\xf6\xbb\x91\xb5\x12\x5f\x13\x43\xea\x22\x42\xd2\x8b\x0c\xf8\xdf\xff\xe6\x84\xa4\x14\xc8\x3a\x67\x9a\xdf\x29\x1f\x11\xb0\xbd\xef\x69\x56\xbc\x41\xdb\xdc\xcb\x5f\x9d\x7f\x1c\x76\xd2\xb7\x89\xd9\x02\x63\x31\xa9\xdd\x50\xee\x79\x47\xd7\x1c\x1f\x90\x52\xe8\x03\x18\xc8\xb1\x5d\x3e\xe4\xd4\x2b\xbb\xbb\x46\x2f\x89\x5e\x05\x9e\x96\x66\xca\x76\x9f\x71\x30\x2c\xf6\x84\xf0\x30\x93\x3d\xcf\x5a\x2b\xee\x74\x75\xbb\x22\x60\x25\x78\x17\xaa\x2e\x9f\xf2\x72\x8a\x9d\x74\xfe\x59\xc5\xbc\x98\xec\x26\x10\xb2\xf4\xf1\xcf\x9d\xf4\x51\x79\xee\x1e\x86\xf5\x0a\x39\x2b\x97\xe8\x55\x62\x5f\xe7\x75\x5a\x14\x9c\x35\xa9\x65\xc9\x74\x8e\xaf\x8c\x3c\xa6\x07\xdc\x94\x30\xad\x29\x17\x2a\xea\x86\x82\x59\x14\x13\x02\x85\x3b\x2a\xfc\x2a\x60\x2a\xc3\xa0\x24\x6f\x41\xef\x1a\x9c\xe3\x90\x8a\xc7\xe4\x4e\x2f\xeb\xab\xa7\x7d\x6b\x5b\xb6\x52\x2f\xe4\x86\x92\x41\x34\x5d\xb5\x9b\xb0\x71\xf1\x12\xbf\x5c\x08\x15\xc3\xfb\xfc\x57\xf4\x4e\x47\x97\xf9\xe9\xa7\xa5\xdd\x9b\xa3\xd8\x43\x3e\xbc\xd9\xf8\xf3\x87\x20\xb7\x2e\xea\x41\x83\xb9\x44\x6a\xa9\x47\xde\xa6\x64\xb6\xda\xe8\xe1\x94\x4a\x11\xf4\x56\x93\x28\x90\x9e\x88\x10\x32\xa1\x77\x99\xe9\x53\xe4\xcd\xf7\x75\xb7\x3d\x81\x2a\xda\x5c\x45\x4d\x59\xb2\x0c\x0a\x93\x6a\x95\x9b\x1e\x73\x0d\xc3\xf0\xcc\x60\xac\x31\x82\xfa\x18\xa0\x56\x97\xa5\x24\xc0\x58\xf8\x3b\x42\xe2\x43\xeb\xb5\xbd\x4d\x44\xfe\x3b\x67\x0e\x67\xb9\x17\xef\x1f\x7e\x70\x62\xe3\xd7\x68\x48\x75\x4b\x40\xf2\xc3\x00\xcb\x31\xe4\xfd\xde\x50\xed\x44\xb7\x5b\x82\xda\x65\x5d\x4f\xe0\xd9\xd9\xe0\xfb\xff\x27\x9a\xdd\xc0\x45\x5f\x04\x7a\xbd\xdf\x33\x7d\x1f\xdc\x18\xa3\x26\xd8\x19\x17\xf5\x46\x05\xe5\xe3\xa3\x93\xb9\xb6\x4b\x28\xd5\xff\xa4\x2a\xef\x1a\x69\xa3\xae\x52\x51\xe3\x5e\xa2\x7f\x4f\xbe\x49\x11\xc1\xb9\xa0\x16\x64\x25\x6a\xcf\xbd\xb4\x1b\xa7\x03\x35\xd7\x28\x20\x3a\x64\x00\xeb\x89\xf2\x34\x56\xa6\xaf\x9d\x0d\x51\xc7\x8e\x08\xa3\x07\xfa\xf4\xfd\xd8\xe1\x7c\x35\x91\x50\x71\x47\x4a\x17\x0e\xdf\xb1\x96\xa4\x2f\x16\x2a\xd6\x59\xc5\x64\x62\x74\x8a\x07\xe4\xd9\xf0\x6d\x6e\xf3\x8d\xcd\x71\x96\x74\xf9\xe6\x30\x4a\xee\x23\x4b\xd5\x58\x83\x96\x62\x7d\xf1\x5f\xae\x6d\x4b\x8c\x08\x6a\x28\x14\xd0\x75\x2c\xf3\x6f\x3d\x32\x8d\x29\x2c\xb0\xaa\x20\xa1\x8c\xfa\xec\xd2\x7c\x30\x64\x3c\xc6\xa2\xad\x27\x0d\x97\xe1\x30\x48\x50\x37\xd1\xaf\x4c\x62\x65\x1a\x4a\x95\x9d\xef\xd6\x03\xb6\xbb\x64\xa0\x16\xd3\xc0\xbf\x23\x40\xe4\x80\x33\xc2\x5e\xa0\xb6\x16\xb5\x9e\x27\x32\xfe\x34\x60\xce\x78\x92\xbd\xf2\xd5\x52\x04\x27\x5b\x58\xaf\x0a\x07\x6b\x56\x24\x51\x2d\xe8\x74\x7d\xcd\xf2\x42\xea\xff\x67\x0a\x6c\x28\xea\x3b\x2c\xb3\xa9\x3b\x3f\xe4\x7a\x1b\x64\x23\x88\x1b\x0e\xbf\xbf\x31\x32\x61\xfa\x84\x14\x6b\xac\x7b\x17\x28\xf5\xcf\x95\xdc\x3c\xab\x5f\x51\x01\xb7\x8d\x8b\x6a\x6e\xc6\x19\xea\x21\xec\xfe\x94\xc0\x4a\xd0\x7b\x2a\xd6\x9f\xc4\xab\x2f\xec\x08\x94\x1e\x31\x20\xf0\xce\xe6\x04\x95\x0b\x9c\x1e\x06\x27\xcd\xd9\xfa\x9c\x48\x99\x4f\x3b\x1b\x3a\x0e\x9b\x32\xf1\x20\x8e\xce\xfe\xb3\xe5\x67\xdf\xaa\x10\x3e\x7f\xa3\xe4\xc6\x4e\xd9\x9b\x53\xa8\xa7\x7b\x54\xcd\x73\x4e\xc6\x05\x28\x97\xb1\x82\x3e\x52\xb9\xe2\xe1\x37\x5a\xd9\xfa\xd1\x04\x79\xcb\xb9\x06\x27\xd2\xd2\x2a\xc3\xc9\x21\x46\x07\xd0\x65\x4e\x3d\x29\xa1\x23\xcb\x2b\x49\xce\x6b\xd4\x01\x58\x62\x18\x7f\xe6\x42\xf1\x74\xf4\xf2\x88\x54\x3d\xec\xa8\x31\x11\xa1\xb8\x99\x3f\x70\xc7\xa1\xc2\xb3\xe3\x2c\x4f\xe8\x51\xf6\xee\x53\xea\x8d\x67\xfe\x53\x98\xb0\xd7\x76\x59\xa1\xb2\xd1\xf6\xb8\x9d\xc5\x94\x9a\xb8\xa4\x02\xe0\x23\x7c\xf1\x05\xde\x95\x78\x88\xae\xb4\xf2\x87\xe9\xbb\x71\xe3\xab\xdd\x46\x1d\xe6\xae\xc2\x9f\xd9\xb3\xb9\x7e\x7a\x42\x74\xe5\xe6\x23\xc7\xe1\xb1\x11\xc8\x0b\xcf\x0d\xf9\x3d\x1b\x71\x75\x35\xde\x44\x2a\x1e\xcc\x14\x1e\x5e\x54\x9d\x3f\x55\x8d\x83\x5e\x16\xc6\xea\x32\x0a\x14\xe3\x42\x1f\x41\x47\x4c\xdd\x5e\x4e\xf0\x11\xdf\x8c\xa0\x4e\xf0\x83\x22\x68\x80\x94\x6a\xc7\x5c\x9b\x25\xd3\xb1\x9b\x1a\xe6\x6a\x44\x10\x3a\x85\x1c\x58\x68\xef\x5f\x0d\x1b\x05\xd2\x6f\xfb\x65\xe5\x7a\x6a\x14\x32\xd6\x4d\xd4\xfe\x04\x21\xef\xa4\x36\xd8\x42\x23\x53\xdf\xc9\x98\xfc\x08\x9c\xc6\x60\xc1\x4f\x96\x11\x3d\xac\x76\x2e\x80\xb0\x8c\x89\x67\x4c\x6f\x8a\x0e\xa3\xd8\x0a\x28\xbd\x13\x53\x21\xe6\x80\x0f\x7b\xd4\x47\x53\x13\x87\x69\x21\x11\x64\xd1\xb2\xec\x7c\x97\x8d\x99\xd5\x4e\xeb\x96\x41\xa8\x71\x40\x75\xcb\x1c\xa0\x91\x17\xac\x68\x51\x84\x32\x4a\x5b\x11\x76\x18\x4b\x76\x1b\xb1\xf5\xa9\xfd\x0a\x3f\x98\xdb\xa3\x66\x86\x86\xe3\xbe\xaa\x72\x2f\x8b\x76\x29\x48\x47\xcc\x4c\xe5\xc8\xbf\x37\xec\x0f\x42\xb6\x44\x11\xe1\x98\x9a\xd5\x13\xf1\x7a\xa0\x83\x98\x7f\x8f\xca\xf2\x68\x4c\xc8\x16\xa7\xef\xd2\x04\x29\x3f\x27\x13\x3b\x3a\x52\x3b\x74\x96\x53\x54\xea\xd0\xa7\x1c\x0b\x3e\x0f\xcc\x32\x1e\x59\x1b\xf5\xf5\xb2\x50\x66\x77\x9c\x91\x98\xb2\x2f\xbe\xbb\x27\xc1\x79\xbf\x86\xf5\xd8\xdb\x2f\xe4\xe8\xe5\x1d\xb4\x7f\xca\x0c\xaa\xa9\x5d\xcf\xda\x80\x98\xab\x57\x22\xec\xf4\x86\xb2\xb0\x20\x4d\x24\xb7\xe7\x02\xa9\x60\x8c\xd8\xef\x5c\x3e\xb9\x08\xd7\x3f\x2e\x97\x19\x80\xee\x2f\x02\x88\x8a\x9b\xf4\x47\xe5\x87\x4d\x2c\x07\xdd\x2d\x37\xa5\x3f\xe9\x95\x21\x30\x21\x9b\xf8\x6c\xfa\xa3\x85\xc2\x9f\x81\x6f\x9e\xdb\xd9\x72\xc3\x2a\x63\x3a\xe4\x85\xee\x7f\x11\x7d\xa7\xf0\x07\x8b\x94\xb0\xb3\x7d\x3f\xea\xb7\xd2\xc7\xdd\x74\x95\xcd\x54\xbe\xeb\x12\xb5\xbc\x00\xaf\xf6\x71\x91\xa5\x53\x3c\x45\x24\x19\xba\x90\x1a\x30\x06\xfd\x8b\x68\xb5\x31\xd2\xe3\xb4\x91\x78\x08\xfb\xfd\x74\x3e\x70\x3b\x0e\x2c\x4d\x3e\xd7\x37\x6f\x14\x0e\x45\x06\x36\x7a\x2e\xd3\x4b\x1e\xd0\xb4\xf4\x3d\xd0\x4b\xe6\x3c\x13\xba\xec\x3d\x74\x49\x8a\x9d\x4a\xb5\x2d\x83\x2e\x91\x14\x13\xe2\x01\xec\x51\xfe\xc8\xf4\x92\x94\x58\x43\x8f\x6d\x0d\xc3\xa9\xc1\x92\xc0\x43\xb1\x61\xa6\xe5\x3e\xaf\x55\x88\xd4\xcc\x70\x44\x8f\xc5\x02\x99\xd2\x77\x4d\x45\xab\x65\xef\xda\x23\xb4\xc6\x70\xc2\x0c\x63\x7b\x81\xaf\x79\x36\xe0\x0d\x24\x8b\x94\xc9\x0b\x8f\x91\x6a\x79\x3a\x95\xef\x88\xed\x1f\x89\x0e\x7c\xb7\xc6\xf6\xa2\xa4\x2a\x28\x37\x19\x72\x8b\x7a\xb7\x99\x48\xa1\xb3\x9f\x7c\x35\x22\x25\x83\x05\xa4\xa7\x0d\xf0\xa2\x95\x01\x65\x7b\xae\xb2\x59\x16\x3b\x5a\x31\x33\xcf\x78\xbd\x56\x67\xe1\xfc\xd3\xf9\x33\x76\x9f\x8d\xde\x14\x48\x28\x7a\x1d\x36\x53\x2c\x3b\x2b\x27\xc8\xbe\x43\xd3\x2b\x45\xcf\x3e\x6d\x8b\xa1\x49\xe4\x6e\x3a\x42\x9c\x2d\x8e\x7b\x57\xba\x06\xe9\x5a\x9d\xf6\x0c\x5e\x0e\x83\x4b\x7f\x8f\xba\x24\xe7\x1a\x98\x2e\x65\x03\x1f\xbe\x7b\x7d\xf3\x87\x63\x61\x37\x95\x0f\xbc\x28\xb9\xff\xe2\x8c\xac\xc3\xb4\x10\xc5\x79\x66\x1b\x70\xcd\x65\x07\x2f\x09\x86\xdf\x04\xd1\xb2\xa1\x1d\xb9\x39\xa1\xb3\xc4\xfb\x8e\xd4\x7a\xa3\x12\x91\xdc\xd2\xa5\x29\xd1\x8b\xa9\x33\xd6\x90\x3c\x86\xb5\xde\xc0\x75\x2f\x98\x9e\x53\x88\x8b\xad\xde\xbe\xc7\xf8\xc8\x9d\x38\x01\x73\x4c\xad\x80\xfa\x60\xd7\x83\xdc\xd8\x97\x11\x9d\x47\xed\xfa\xc2\x4e\xc9\xb8\xa3\xfa\xcc\x35\x6f\x37\x67\x00\x8a\x20\x55\xe1\x78\xf8\xb2\x6d\xd1\x10\xa9\x36\xec\xa8\x1e\x8a\xe4\x15\xb5\x3e\xd1\x4d\xa3\x90\x29\xd1\x1c\x38\x68\x7f\x55\xbf\x12\x71\x47\x52\xa2\x24\xcc\x9c\xf7\xea\xbf\x12\x42\x14\xd1\xf9\x07\xe1\x38\x72\x5b\xb4\x3f\x80\xbb\xc5\xb0\x25\x3c\xa7\x01\xfd\xa9\x1c\x04\xa6\x15\x1c\x6c\xda\x28\x42\x78\x3c\xc8\x61\x84\x70\x35\xc6\xf5\x1c\x81\xe1\x36\xd4\xb7\x9e\xfb\x9d\xf2\x10\x11\x08\xf7\xea\x89\xf8\xda\xc5\x9c\x87\x20\xe3\xa8\xec\x34\x06\x2f\x97\x51\x5c\x5d\x65\x09\x03\x2f\xbb\xca\x02\x67\x02\xfe\x73\x84\xef\xb9\x3e\xfe\xe8\xd9\xf1\x47\xcf\x97\xc5\xee\xc0\xa9\x3a\x1d\xb7\x9e\xb2\xab\xff\x1f\x8f\x5b\x2f\x23\x86\x42\xdc\x09\x7d\x73\x6b\x94\x6c\x52\xac\x9a\x7a\x13\x50\x00\xba\x52\xa9\xcb\x08\x81\xa7\x49\x21\x4f\x1f\xca\xeb\xfa\xe5\x6c\xd8\x3a\x99\x03\xe9\x9e\xa7\xa6\xb8\x64\x46\x23\xb5\x4a\xee\x4b\x3e\x04\x2a\x82\x48\xa8\xc1\x1c\x6a\x28\x80\x4e\x18\xe6\x17\xa5\xa5\x50\x34\x37\xe3\x3a\xbb\xfc\xb4\xcb\x43\xdb\x9f\x24\x2c\x87\x91\x22\x5c\xa8\xcb\x5b\x92\x5d\xdb\xe3\xc0\xf6\x32\xe2\xd3\xaf\x99\x58\xe5\x5b\x69\xeb\x85\x18\x8a\xcb\xc9\x71\xaf\x78\x2b\x2a\x67\xd5\xa7\x6b\xe9\xd7\xe3\x22\x08\x22\x33\x17\xda\xfe\x00\x6a\xa4\x1b\x3a\xe7\x3b\x67\x0a\x1c\x4d\x06\x76\x77\xcd\x3a\x50\x39\x68\x72\x48\x37\xda\x6e\x41\xb5\x85\xd6\x2b\x59\x63\x03\xbe\x1d\x06\x43\x38\xe8\xb4\x31\x92\xb4\x99\x73\x04\x94\x8b\x65\x28\xcf\x75\x31\x44\xaf\x22\x23\x34\xe1\x51\x51\xb7\x61\xa2\x2f\xa0\x14\xe5\xf2\x2e\x42\x76\xdd\x8c\x61\x58\xcf\xe0\x74\x69\xaf\xee\x25\x0b\x7d\xa6\x54\x06\xdf\xa3\xb7\x56\x93\xc9\x7f\xc0\x85\x68\x30\x8c\x46\x85\x16\x99\x8f\x07\x9d\x81\x32\x44\xb3\x56\x30\x89\xbd\x23\x9c\x5b\x85\xd0\xcd\x59\x1b\xff\x1f\xbd\x6d\xe0\xb3\x45\x77\xf4\xb5\x34\x46\xe0\xa9\x86\xab\xba\x28\xa2\x7e\x49\xe3\xcd\x8f\x72\xdd\x3f\x06\xaa\x2a\x1e\xbe\x57\x4d\x4b\x4c\x39\x93\x04\x3a\x93\xdd\x67\x43\xc4\xf6\x5f\xc6\x75\xb5\x18\x13\x38\xed\x64\xab\xb8\xf9\xc4\xc0\x3d\xd7\x21\x87\x7b\x23\x06\x87\x48\x0f\xbe\x1c\x38\x93\x43\xca\x6a\xc9\x15\x59\x65\x48\x20\x03\x53\xa9\x3c\xc6\x1c\x78\x6b\x4f\xd1\x69\x8c\x82\x9c\xfc\xc4\x1e\x5d\xc7\x3e\x3f\xd9\xab\xfd\xac\x2a\x2c\x5e\xe5\xe0\x31\x75\x6b\xfc\x45\x36\x2d\xe8\xbd\x22\x3e\xae\x29\x39\x80\x78\x6c\x99\xd0\xf6\xb2\xaa\x5c\xd3\xb8\x3a\xb5\x32\xc7\x07\x3e\xfa\x80\x3a\xae\x5c\x47\x3e\xb5\x0e\xc3\xd0\xc8\x8d\x58\x3b\x83\xb7\xc5\xa4\x33\x0a\x77\x1a\xb1\x38\xb5\xdb\xdb\x12\x72\x0e\xd3\x8b\xdc\x46\x1d\x9f\x3b\x16\x68\x13\x9d\xae\x65\x2e\x8e\xfb\x30\xd1\x76\xf5\xb4\x18\xd8\xbd\xc0\x6c\x7e\xb0\x53\xc9\x9c\x69\x46\xb7\x19\xca\x87\x4a\x62\xef\x96\xbd\x2d\xc6\x87\x87\x03\x53\x2d\x07\xf2\xbd\x56\x6c\xe3\xa4\x22\x98\x20\x1b\xc5\xeb\x81\x10\x9c\xbe\xd0\xe3\x8c\xec\x2d\x3a\x54\xb9\x2e\xd6\xce\xd1\xbd\xc1\x83\x77\x28\x09\xfa\x4f\x75\x34\x2f\x33\x97\x5b\x7d\xc7\x9d\xd0\x2b\x67\xa3\x77\x86\x50\x57\x64\xc3\x68\x4a\x18\xd1\x91\x06\xef\x11\x71\x47\x4c\x64\x44\x52\xd6\xaa\x3d\x2d\xe5\x5f\xf0\x92\x22\x1e\xa7\x1f\xc2\x6a\x58\xcb\x39\x29\x6a\xcb\x8e\xdc\x9f\x40\xf0\x4f\x94\x60\x97\xce\xd7\xa9\x31\xcd\xc8\xff\x67\x7e\x0a\x7c\xb0\x08\xda\xfc\x5a\x9a\x46\xbc\x65\x46\x9a\x65\x0e\xcc\x25\xc8\x83\xf3\x4c\x98\x9f\x92\x77\xe3\x28\xb7\xba\x80\x96\x92\x3b\xcb\x83\xd3\xe1\xc0\xfb\x4f\x64\x3d\x28\x13\xb7\xde\x51\x03\xf4\x56\x39\x2c\x4e\x43\x09\x83\x44\xe8\x4e\x33\xab\xd3\x35\x49\xfe\xc7\xec\x1c\x84\x96\x92\x3f\xa7\xe4\x3f\x89\xc2\x13\xa4\xd1\xa7\xa8\x2b\xe5\x99\x20\xdf\x98\x35\x9a\xfc\x9c\x3d\xf2\xc1\x8e\x7b\x99\xb3\x4a\x89\x61\x84\x47\xab\x7a\x60\xc5\x51\x45\x6c\xdc\x27\xf8\xdf\xac\x37\x93\x52\x6b\x79\x51\xc1\x26\xe3\x0c\x46\x25\xd3\xed\xe8\xcb\x16\xd7\x3d\x5f\xba\xf3\x45\xbf\x8e\xcc\xa7\x3e\x72\x23\xe1\x71\xe9\xd9\xaa\x4e\x4f\xf6\x0a\x5b\x18\xa4\x12\x33\x55\xa7\x0e\xa2\x3d\x5a\x8a\x21\x8c\x51\xa8\x5f\x5a\xed\x55\x0e\x13\x36\x4a\x5a\x38\xc1\xb3\xf7\xbf\x67\x88\xe0\x7e\x7b\x25\x54\x23\xc1\x44\x6c\x2f\x3c\xa9\x07\xda\x3c\x81\x38\x79\xa1\xa9\x05\x0b\x15\x87\x31\xe6\xb2\x7f\x54\xa5\x72\x26\x7b\x88\x48\xe1\x7c\x51\x4d\x9a\x26\x31\x71\x25\xb9\xe3\x7c\xde\xff\x68\x61\xa5\x42\x6a\xa4\x02\x4b\x42\x6c\x5e\x81\x0d\x60\xe6\x8a\x3f\xdd\xac\xb2\x47\x5e\xb1\x2b\x9d\xc6\xcc\x1a\xa5\xcf\x5d\x8a\x37\xdf\x0b\xdf\x19\x98\x70\x8a\xdc\x2d\xc9\x2a\xbd\x96\x51\xb6\x9d\x89\xcb\x19\xdb\x2e\xca\x25\xbd\x98\x15\x48\x1f\xe9\x26\x8e\xb3\x91\x13\xe2\xa6\x80\x2b\xa5\xda\x6f\xa5\xb7\xe8\xd9\x5d\x51\xc1\x70\xeb\xba\xe4\xfc\xf5\x90\xa5\x1e\x6b\x3a\xed\xa8\xa0\x89\xc8\x0f\xdc\x18\xea\x0c\x5d\x96\x40\x21\x11\x1a\x49\xd0\xdf\xf7\xc5\x4b\xd3\xc5\xd8\x29\x6a\xb3\x91\xc4\x3f\xc5\x91\x14\x4a\xfa\xac\x40\xf7\x12\x83\x2a\x35\x11\xe5\x10\x4a\xd3\x4a\x70\xaf\x32\xe9\x98\x08\xce\xe8\x5a\x84\xce\x6f\x64\xd5\x57\xf1\xa6\x39\xa3\x19\x4a\x71\x9d\x73\x09\x91\x0f\x2a\x25\xe2\xf7\xa4\xb2\xfb\xe2\xba\x50\x35\xbe\x6b\x03\xc7\x20\xb1\x2b\x16\x43\xba\x2a\x19\xd4\x8a\xda\xf0\x15\x0f\xc9\x68\x95\x02\x9f\x3c\x98\x1d\x35\xc7\x7b\x56\xbf\x9a\xa8\xf3\xfd\xfb\xb8\x90\x09\xec\xbc\xcf\xc9\xc1\xe4\xff\x0e\x72\x91\x39\x52\x3d\xe8\xcb\x3e\x34\x3d\xd2\x81\x1c\xc7\x75\x7f\x8b\x5c\xcc\x45\x38\xca\xd7\x3b\xa9\xed\x8c\x2f\xfb\xd1\x65\x97\x67\x64\xc7\x24\xe1\x23\xa5\x29\x15\x41\x87\x64\xb3\x86\x0d\xda\x82\x4c\xa0\x8c\xbc\x3f\x04\x58\x07\xef\xea\xb4\xbc\x5e\xe5\x36\xb4\xad\xb6\x1f\xdb\x87\x67\x81\x40\x1a\x54\x70\x1e\x7b\x5b\x1c\x23\xa9\x42\xaa\x58\xce\x2a\xee\x92\xda\x07\x24\x5b\x54\x0a\x9f\x1f\x88\x6f\xc3\x28\xd9\x26\xf7\x4d\xc6\x92\x16\x3b\x88\x06\xf4\x93\xe4\x02\x49\x46\xde\xd0\x17\xc2\x2a\xbb\x47\x14\xd1\xed\x73\xd8\xf8\xb6\x67\x43\xe4\x15\xd7\x31\x26\xe8\x72\x09\xad\x5c\xab\x41\x21\x87\x2f\xf8\x55\x52\x53\xef\x75\x12\x1a\x8c\x00\xb2\x88\xb2\xc8\xe6\x10\x4e\x66\x1e\xa7\x3c\x51\xc5\x71\x4a\x8e\x1d\x95\x70\x5c\x3d\xad\x5f\x7d\x88\x77\x79\x61\xdc\xec\xd3\x64\x7b\x47\xc8\x4f\x3c\x57\xb0\xe3\x65\x7f\xd6\x62\x6f\x4a\x76\x77\xfe\x64\x71\x26\x98\xf2\x3c\x4f\x97\xca\x08\xdf\x88\x1f\x95\x0f\xee\x98\x40\xfa\x37\xa8\xea\x9b\x4b\xd8\xce\xb0\x46\x96\x5e\xcd\x00\x46\xca\xa1\xad\x99\x3a\xcf\x0b\x8b\x39\x0b\x4f\x08\x2d\xf1\xd4\xe6\x09\xbc\x9b\xd3\x97\xfb\xbb\xb3\xfc\x74\x24\xde\x6a\xa7\x82\x08\x8e\x63\x64\x19\x0e\xc5\x91\x27\xe7\xc5\xb8\xc3\x92\x04\x33\x04\xae\x00\xf5\xd0\x1e\xc0\xb2\x8f\x2a\x81\x12\x30\x7f\xd4\xd2\x69\x2a\x76\x32\xec\xed\x34\xf1\xe2\x99\xd6\x4e\xef\xcb\xae\x4e\x65\xb7\x4c\x5f\x53\xd8\x02\xf1\x3a\x99\x24\x57\x56\x3f\x77\x52\x5b\x2c\x5e\x5d\x62\x57\xdf\x5a\xb7\x0f\xa3\xde\x4d\x89\x57\xf7\x9f\xb3\x24\x91\x56\x2c\xbb\x3f\xa7\x25\x76\x4f\xce\xdb\x07\x8a\x72\x4f\x24\xd5\x2c\xf2\x1e\x76\xda\x18\xc1\x5c\x9f\x0b\xa0\x19\x97\x5c\xef\x8f\x0a\x66\xdf\x1f\x20\x63\xa0\xb0\xb7\xe0\x7e\x81\x1b\x1a\x6e\x95\x51\x60\x4b\x30\x69\x46\x01\x3c\x76\x9b\x09\x5e\x26\xbe\xb9\x4c\xbb\x84\xff\xaa\x87\x24\x53\x25\x05\x4b\x1f\xf8\x04\xfd\x1c\x24\xd1\xe6\x65\xe2\xa1\x0a\x57\x9d\xd5\xe2\xd6\xcb\x3b\x35\x4b\x3d\x35\xc1\xf6\x58\x52\x28\x23\xa0\x11\xfb\x2f\x16\xac\x66\x0b\xe0\x17\xb9\x9c\xdc\x16\xc4\x4c\x15\xb8\x30\x61\x39\x99\x24\x87\x32\x31\xb9\x9d\x0b\x1b\x53\x12\x59\x73\xd3\xbe\x4b\x00\x9d\xc5\xc8\x27\xab\xdc\x97\x81\x9a\x4e\xc6\x2a\x5f\x8d\xc1\x1d\x7d\x3d\xdf\xc3\xeb\xfa\xd5\xa3\x55\x09\x96\x78\xf8\x0c\x3f\x19\x62\x26\xc4\xc3\xe7\xf5\xab\x47\x97\x12\xa7\x7c\x62\x60\xc5\xeb\x9d\x77\x8d\x84\xf3\xf1\x83\x5f\xdf\x7f\xee\xf8\x3c\x83\x4a\x6a\x49\xa7\x65\xe3\xb0\x47\xfb\xa0\x81\xe6\x8b\xa7\x1f\xdf\xeb\xff\x18\xba\x7c\x69\x37\x9d\x5d\xe7\x8d\x90\xe2\xf9\x63\x6d\xab\xdd\xe3\x8c\x10\xe5\x88\x23\x08\x54\x82\x2e\xc9\x01\x50\xe3\x8c\xa1\xfe\x53\x1f\x07\x90\x15\x18\x17\x95\x33\xf5\x0a\x53\xab\xab\xde\x1c\x4d\x6d\x73\x57\xd4\x8f\x91\xe0\x40\x99\x8a\xe8\x40\xa9\x32\xe7\xd7\xf4\x22\x94\x56\xab\x9e\x6b\xaf\x59\x26\x75\x46\xd4\x6d\xfc\x8d\x9d\x8e\x63\x9d\x99\xd2\x31\xaf\xb2\x95\xbc\xe9\x07\xc2\x81\x8d\xa0\x10\xe9\xf4\x9b\xe6\x6c\xe7\x8a\x65\x47\x39\xdb\xbf\x77\x7a\xf9\x82\xa1\x2e\x11\x0f\x0b\x5d\x76\xed\x2b\x83\xbb\x85\x35\x83\x8b\x5c\xf6\x8b\x34\xf6\x42\x8f\x1d\xf1\xb3\x6e\x5f\x13\xbc\x5a\x12\xb3\x1b\x98\x5d\x06\x0e\x5c\xab\xa4\x37\xc7\x12\xe0\x7e\x45\xc0\x79\x1c\x66\x71\xaf\xad\xda\x12\x43\x71\x6a\xb9\xac\xdb\x16\x03\xad\x1d\x87\xf5\x5e\x4e\xe6\x20\xa8\x85\x46\xea\xe5\x7e\x8c\x41\x1f\x76\x47\x26\x6c\x84\x5c\x4a\x41\x70\x33\x26\x20\x78\x79\xbf\x04\x04\xbf\xb9\x0b\x7b\xea\xae\xcc\x73\x90\x4d\x39\xa7\xaf\x96\x41\x31\xf2\x65\xf8\xd1\xed\x27\x68\xc3\x16\x37\x99\xfc\x07\x6e\x82\xbf\xa4\x9d\xf8\x1b\x7d\xa7\xad\x3a\xd9\x4d\x7c\x85\xed\xf2\x9c\xcf\xd5\x39\xb5\xde\x6c\x68\x5b\x87\x4c\x62\x13\x0e\x06\x82\x29\x4e\xf7\x17\xe1\x6b\x72\xb6\xdb\xf8\x89\xee\x38\xd9\xe5\x3b\xea\x32\x0e\xd7\x68\xdc\x65\x7c\x61\x17\x7c\xa6\x4b\x47\xba\x63\xf4\x8c\xf2\x24\x39\xfd\x36\x2a\xc8\x47\x6b\x50\xda\xbe\x74\x2d\x0f\x86\x0b\x0b\x02\xdf\xae\x61\x53\xd9\x5a\x95\xa1\xfb\x94\x36\x1a\xbe\x8b\x65\x82\x31\xec\xa0\xf6\x75\xd4\x83\x87\x81\xa9\x0d\x1f\x63\xb8\x0c\x6f\x77\x32\xf1\x91\x01\x96\x52\xf6\x24\x24\xac\xcb\x37\x0c\xfd\xfa\x72\x88\x63\xef\x7e\x91\xf7\x67\xa4\xf6\x77\xee\x00\xbe\xf6\x22\x2c\xd3\x54\xb3\x9f\xe2\x28\x8f\xd5\x89\x68\xb4\x51\xe7\x8d\xc6\x8d\xab\xba\x70\xd4\x73\x9d\x3b\xae\x73\xaa\x41\x8a\xbf\xa9\xbd\x02\x49\xb8\x73\x9e\x58\x1c\x77\xd4\xfd\x8a\xb0\x17\x18\x24\x16\xea\x40\xce\x75\xa8\x3c\x96\xc9\xdf\xf7\xb5\x1c\xa6\xcb\x27\x50\x8d\x7f\x80\xd3\x23\x7e\x30\xb5\xf8\xc1\xaa\xb3\x55\xfa\xec\x95\xda\x02\x00\x1b\x94\x0d\xce\x0f\xb3\x59\xa8\xa2\x8c\xab\x28\x15\xbb\x91\x8d\x36\x9a\x9a\x8a\x83\xc8\x7e\x28\xb9\xc1\x63\x66\xff\x82\x67\x45\xca\x6e\x06\xa5\x2c\xe7\xa7\x1e\x71\x1b\x3d\x8c\xdb\xde\x61\x37\xf8\xfc\xcc\x84\xcc\xea\xec\xd1\xd3\x13\x3f\x0b\x53\xeb\x49\x51\x3b\xe7\x57\x42\x52\x49\x93\x14\x95\xf3\x16\x23\x98\x3e\xa7\xfc\xef\xc8\xa0\xf4\x4a\x05\x26\xb6\xe6\x59\xa5\xb6\x8b\xda\xf2\x90\xc7\x45\xd7\x23\xb7\x76\xad\x06\xa9\xda\xbe\xa2\x21\x15\x81\x72\xb3\xc2\xe3\x1e\x1c\xc3\x36\x2f\x64\x9e\x07\xa5\xf8\xb8\xf0\xc9\xe9\x6b\x59\x13\xd3\x2e\x57\xff\x62\x0b\x96\x01\xcf\x28\x4f\x40\x86\x41\xc5\xb5\xb6\x05\x41\xb7\xb8\x09\x25\xaa\xa8\x28\xd8\xdd\xa3\x6f\x92\xc8\x5d\x79\x18\xa9\x84\x17\xc7\x71\x33\x72\x37\xfa\xd2\xc3\xfc\xea\x87\x3d\x51\x4e\x91\x53\xb6\x6a\xa3\x51\xb2\xa3\xb8\x87\x9f\xf0\x51\xa2\xe2\x57\x58\xde\x54\xa2\xf8\x08\xfe\x0c\x3f\x37\x5d\xa3\xad\xeb\xc2\x4a\x60\x98\x6d\x8b\xe7\x0e\x7c\x0f\x4a\xbc\xe3\x3b\xf5\xaf\x7d\xf1\xf6\x46\xcf\x9b\xde\xff\x5a\xfb\xbf\xdf\xda\xff\x13\x0d\x7e\x4e\x0d\xb9\x89\x3e\xfa\xaf\x96\x3a\x33\x94\x08\xf8\xb0\x56\x73\x27\xe2\x15\x57\xab\xa7\xa8\x7e\xa8\x66\x34\x7f\x2a\xaa\x6e\xad\x2b\x30\x6f\x77\x04\x1b\x57\x61\x97\x5b\xfa\x0c\xf6\x41\x87\x84\xcf\x81\xb9\xd5\xa9\x9e\xa2\xb7\xa2\x2a\x67\x19\x75\x43\x65\xa9\x77\x2a\x04\x75\xe4\x35\x5d\xa7\x71\x14\x95\xad\x41\x49\x62\x3a\x30\x9a\xb8\x23\x75\x10\x06\x9b\x3c\x70\x49\x6a\x74\x84\x8c\x97\xa9\x96\x74\x8a\x6c\x6b\xd7\x6d\x95\x40\xfe\x87\xca\x6d\x36\x60\x69\x36\x5d\xfd\x78\xa3\x91\xed\xaf\x3a\x84\x8c\x8d\x4d\x30\x86\x22\xfd\x5a\x39\x1b\xa5\xb6\xca\x0b\xfe\x3e\xda\x7e\x41\x9a\x78\x96\x6c\x71\x89\x97\x77\x52\x67\x16\x79\xf2\x2d\xb7\x4c\x14\x30\x8c\x28\xea\xae\x35\xd4\x33\x0e\xfd\x51\xa3\xef\xca\x2e\x9e\x34\xe3\x20\x37\x6a\xdb\x61\x4a\x96\x2d\x37\xec\xb6\xc8\xa8\x21\xdc\x27\xd0\x47\x0d\xa8\xa7\xa0\xeb\xf4\x2b\x25\x61\x86\xbc\x3d\xb0\xdc\xf4\xea\xe8\xc4\xa6\x03\x97\x08\x0e\x3c\x32\x79\xc3\xab\xe0\xea\xc8\x4d\x54\x5e\x5c\x5d\x3f\x15\xb5\x3c\x84\x2c\x89\x30\x10\xda\x03\xcf\x32\x4f\x01\xbd\x18\xb3\xab\x07\xd7\x21\x3f\xd2\x9d\xf2\xa1\x64\x20\x91\x8d\x2a\x3a\x92\x16\x9d\xc5\x69\xf2\xf0\x76\x65\x6b\x4c\x16\x68\x5b\xab\x8d\xb6\x3a\x22\xd6\x4a\x06\xa4\x5c\x42\xd1\x19\x43\x9a\x44\xfa\x75\x67\x6b\xa4\xd5\x5b\x9f\x05\xee\x4b\x7b\xa0\x3a\x26\x9a\x5b\x3f\xec\x86\x72\x14\xab\x04\xb1\x62\x02\x8a\x22\xd5\x51\x6b\xfc\x33\x48\x6a\xd7\x19\xea\x39\x42\x65\x86\xae\x7f\xce\x18\x7a\x86\xb3\x86\xaf\x63\xf7\x44\xe6\xbb\x48\xed\xdd\x72\xe3\x34\x4e\x94\xe0\x40\x74\x10\xed\xee\x10\xb8\x89\xa0\xae\x95\x25\x66\x65\x7e\x4b\x1e\x17\x4a\x7e\x19\x0a\xf8\x2e\xe6\x36\x91\x50\x70\x25\x1a\xd5\x38\xaf\xf3\xa5\x44\x59\x88\xff\x5c\x77\x51\xd8\x22\x95\x9d\x9e\xf7\x00\x59\x40\x74\xdb\x28\xcb\x79\x9b\xa3\x15\x78\xd0\x8f\x2c\xad\x7c\x5a\xae\x83\x08\x51\x83\x47\xf5\x8b\x0e\x71\xd5\x17\x43\xa5\x4d\x25\x23\x88\x08\x33\x70\xe1\xd7\xc8\xec\x1a\x9d\xa7\x62\x52\xa3\x37\x70\xa7\x35\xb5\x78\x56\xe5\x2b\xd3\xea\x29\x13\x14\x22\xdb\x17\xca\xe1\x0e\x23\xee\x6f\xe4\x76\xab\xfc\x32\x78\xeb\x25\xe5\x0d\x4b\xfb\xbc\x8b\x60\xf4\x1d\x05\x97\xd0\xae\xbf\x67\xf6\xd6\x33\x64\xbf\x27\xac\x75\xec\xc7\x92\xab\x05\x50\xe2\xb5\xda\x32\xd6\x00\xd7\x8b\xbb\x94\x76\x6b\x95\x41\xf3\x8c\x4e\x07\x79\x32\x53\xd3\x70\x8c\x69\x9d\x40\xcf\x3f\xaf\x9f\x1f\x31\xf5\xa2\xf7\xaa\x63\x49\x4f\x9d\xd2\x1f\x1f\xce\x4f\x7d\x59\xe8\xe8\x14\x44\xfd\xd2\x7a\xbb\xe9\xd0\xd1\xf3\x0b\x2b\xed\x9e\xd7\xcf\x97\x84\x8e\xe0\xa0\xdf\x6a\x63\x16\x05\x8d\xfe\x49\x0a\x78\x06\x3c\xaf\xc8\x14\xa7\x7c\x2d\xfd\xed\x04\xeb\xcb\xb9\x2c\xe9\x34\x24\xdb\x6d\x38\x89\x81\x26\xe0\x41\x19\xe3\xf6\x8f\xb7\x1e\xec\xea\x8d\xdb\x5e\x76\xba\xb1\xfa\xcc\x6d\xb9\x22\x2f\x24\x77\x92\x9c\x49\x6a\xa3\x34\xcd\x5a\xdf\x23\x11\x18\x70\xbe\xd7\x18\xd3\x0a\x20\xc8\x19\xb9\xb8\x71\xdb\x15\x68\xc4\x5c\xa7\x41\x14\x5e\xdf\x45\xbe\x15\x3a\x80\xf5\x7f\xa7\xcd\x41\xb8\x75\xa8\xba\x33\xec\x14\x33\x5c\xf0\x1f\x7f\xd9\xe6\x20\x9c\x67\x08\x6c\x47\xf2\xe1\x45\xfd\x8a\xf7\xe7\x7e\x2a\x6b\x5e\x67\x04\x82\xf4\x05\xb5\x93\xba\x03\x91\xc3\xfa\x0b\xed\xcc\xb8\x53\xda\x8b\xb5\xc7\xde\xd5\xce\x8b\xda\x81\xda\xb2\x8a\x94\x14\x7d\x7e\xbe\x14\xd1\x6d\x39\x90\x78\x55\xb0\x4d\xe5\x28\xe6\xc9\x7e\x8d\x7d\xd1\xcf\x0a\x73\xc9\x28\x73\xd0\xe8\xc1\xaf\x33\xe0\x34\xa7\xda\xe1\x94\xd1\xea\xdd\xc9\xd6\x79\x50\xed\x84\xe3\x86\xf3\x90\x5a\x93\x48\xed\x51\xad\xde\x26\xf3\xc1\xb8\xbd\x0a\x49\xf8\xf0\xb3\x0c\x36\x2d\xc3\xd5\x68\x1d\x11\x8d\x62\xfd\x90\x6b\x15\xe8\x84\x70\x0f\xd9\xb3\x73\xfd\x43\x2e\x48\x49\xbd\x98\x67\x03\xf9\x74\x65\xd4\x1f\x3d\x54\xea\x31\xb2\x2c\x3d\xe6\x8c\xf3\xe2\x5d\xeb\xe5\x61\x51\x0a\xfc\x52\xca\xae\xe5\xd5\xd3\x6c\xc6\xb4\xc9\x53\x4c\xc9\x32\x2f\x42\xee\x4a\x8b\x7d\x23\x0d\xda\x72\x48\xa1\x45\x02\x95\x4c\xcf\xb5\xe9\xe6\xba\x05\x2e\x06\xc7\x5c\x0e\x6a\xbb\x11\xb5\xfc\xf5\x57\xba\x46\xde\xcb\x03\x97\x47\xa3\xd5\xb1\xca\x03\xa6\xd2\x7e\x24\xdb\xdf\x86\xa2\x54\x1a\xfb\xf3\x09\xa4\x5d\x7c\x59\x5f\x3d\x65\x2c\x8b\x03\xa7\x11\xcb\x35\xf6\x04\x55\x2d\x70\x2a\x83\xa6\xfa\x71\x08\x84\x4e\x9d\x48\x7b\x49\x74\x5c\x63\x9d\x28\xef\x72\x28\x23\x15\xd6\x65\x81\x05\xbf\x09\x15\xab\x03\xe7\x39\x69\x49\x42\x2b\xb1\x34\x16\x03\x7a\xa8\xb7\xd6\xe1\x75\xee\x2c\x38\xe9\x15\x46\x4a\xfb\x31\x52\x27\xab\x7e\xc4\xb9\xde\x33\x28\x35\x5b\xdd\xf9\x0e\x44\x17\xd6\x22\x64\x18\xd0\x20\x64\x90\xdc\x12\x16\x36\xc7\x43\xe3\xb4\x49\xef\xd5\x16\x6c\x7b\x25\x1a\x3d\xcc\x13\x05\x8b\x77\xdd\x3a\x22\x83\xde\xe0\x71\x0f\x42\xb9\x0a\x19\x75\x4a\x9b\xc7\x15\x0e\xcc\x42\x42\x25\x93\x83\x19\xe4\x39\x21\x4e\x87\xa7\x50\x72\x53\xdd\x9c\x78\x51\x42\xb7\xe5\xbe\x52\xce\x17\x0d\xa7\xe0\x89\xe4\x34\x91\xe7\x07\x63\x49\x6a\xb6\xc4\x58\x15\xdc\x83\xbf\x3d\x48\x80\xfa\x27\xd8\x12\xe9\x77\x3d\x5f\x7f\x38\x05\x10\x58\xd8\x5a\xe5\x35\xb5\x10\xbb\x7f\x30\xee\x19\xdf\xec\x43\x85\xd1\x98\xec\xf8\xbf\xac\xdb\x1b\x55\x6f\x8b\x76\x19\x47\x49\xce\x23\x96\x88\x13\xf6\x6a\x68\x95\xbc\x15\x12\xcc\x95\xc7\x7b\xe7\xeb\xd4\x5f\x0d\x51\x77\xa3\x26\x6d\x27\x3b\x97\x9e\x64\xe3\x3e\xd1\x1a\x9c\x8a\xfa\xe8\x04\xf2\xeb\x1c\x85\xa2\x0b\x68\x5d\x5f\x80\x3e\xe4\xfe\x3f\xaa\x44\x23\xc0\xdc\x6a\xc4\x4f\x88\xf0\xa0\x10\x65\x6a\x13\x63\xa4\xdd\x76\x98\x12\xa4\xda\xb4\xb2\x71\x2a\xb5\x36\xe2\x1a\xf8\x21\xf4\x76\x4e\x18\xb9\x06\xe1\x3e\x18\x96\xe0\x27\x65\x9e\x59\xed\x73\x0b\x4f\x9a\x2d\x05\xeb\x1b\xe2\x73\x09\xa1\xc3\x52\x80\x34\xf9\x98\x5b\xba\x81\x3c\xee\x1b\x39\xa3\x2b\x99\x3a\x40\x16\x8d\xfe\xde\x7c\x2f\x6a\x15\x95\x6f\xb4\x55\xa4\x13\x8a\x25\x59\xab\x9d\xbc\xe3\x9a\xcb\x38\xee\x5f\xc7\x4b\x3f\x6c\x1b\x7b\x01\x57\x4c\xdb\x7a\x27\xab\xdd\x17\x83\x7d\xe7\x06\x7c\xb9\xbb\x1c\xd3\xc3\x85\x9d\xf3\x11\x84\x18\x75\xe9\x0b\x89\x68\x00\x0e\x7b\x54\xd9\xbd\xc8\xc6\x3c\x6d\x60\x9f\x0a\x1f\x14\xce\x9f\xc1\x22\xbf\xf1\xae\x1d\x0c\x8a\x7a\xcd\xee\x77\x32\x62\xdb\x97\x54\xec\x68\xea\x94\xbc\x40\x54\x18\xd5\x36\xf2\x00\x66\xdb\x91\x1a\xa5\x06\xcf\x0f\xed\xe0\xb7\x49\xae\x0f\x2d\x6e\x5e\x88\x0d\x76\x3d\x8f\x05\xc3\x6e\xa3\xe4\x7c\x87\xfa\x3f\x78\x77\xa7\xcc\xe0\x8d\x1b\x69\x4c\x10\xad\x47\x44\xf4\xc5\x13\xf8\x56\x9a\x38\x5c\xa0\xd4\x18\x02\xa4\x29\x3e\x2f\xe5\xc7\x49\xba\xa1\xc2\xd9\x98\xc3\x20\x0a\x1c\xa2\x3c\x04\x70\x0e\x36\xf1\xb8\xd6\x15\xcb\x42\xd7\x58\x4b\xca\x7c\xb1\x8c\x9d\x27\x87\x8c\x5a\x10\x52\x97\x46\x7e\x82\x4e\x95\x83\x58\x40\xa6\x2d\x75\xcb\x48\x6d\x97\xc0\xfd\x21\x07\x88\x7b\x8b\x16\x8d\x9c\xfe\x6e\xb0\x74\x99\x3a\x90\xfc\xc3\xc3\xd2\x1d\xb2\xb8\x2e\x0a\xcf\x0c\x31\x09\x5e\xc7\x4e\x9a\xaf\x7e\x7a\xfb\xee\xcb\xcf\xf9\xff\x0b\xdd\x97\xc2\x82\x97\x11\x99\xa3\x35\xaf\x6d\x85\x99\x49\x4e\xd7\x68\x69\x72\x87\x29\x0c\x49\x2c\xea\x35\xb5\xb0\xad\x54\xd6\x87\x6f\xd4\x1d\x96\xd3\x9f\xd2\x80\x98\x89\xa9\xb8\x93\x76\xad\x38\x73\x27\x45\x4d\x98\xa0\xd6\xbb\x5f\x0e\xdc\x06\xfa\x76\xd0\x79\xea\xe7\x4e\x05\xaa\xd3\xcf\x69\x43\xb0\xa1\x6c\xd8\x63\x08\x87\x49\x59\x0e\x0a\x2b\x3f\xac\x63\x05\x00\x5b\x0e\x4f\xc2\xd7\xf5\x8f\x98\x6c\x33\x45\x3f\xf1\x8a\xc8\x9b\x11\x27\xe0\xa9\x58\x0c\xde\xd1\x9f\xb5\xf4\x98\x59\x01\x49\xd3\x41\x57\x3d\x24\x06\x22\xab\x2a\x15\x82\xf4\x18\xc6\x69\xac\x0e\x95\x56\x36\xae\x44\x20\xe0\x02\x69\xab\xf4\xfe\xff\xe8\x2c\xf2\x90\xfe\x47\xe2\xe2\xa6\x51\xe8\x8d\x90\x79\x04\xa2\x55\x3e\x62\x66\x04\xd4\xa7\xdd\x38\xdf\x14\xe8\x08\xbc\xea\x6b\x75\x70\xa9\xaa\x13\x56\xfb\x41\xc0\xfa\x13\x34\x68\xb0\x30\x56\x62\x4d\x6c\x26\x90\xcd\x96\x09\xbf\x8f\xe8\x64\xd6\x20\x2f\x82\x51\x92\x5d\x12\x22\xee\x90\xfe\x90\xcc\xea\xf4\x6c\x84\x39\xa8\x90\xf0\xbb\x6f\xbe\xe7\x59\xb9\xcd\x06\x21\x31\xa8\xa4\x44\xbb\xf3\xf0\xce\xe1\xc4\x08\x37\xb4\x00\xb9\x3f\xea\x19\x1e\xf7\x68\x82\x13\xf9\x37\x76\x58\x4e\xd5\xcd\xda\x6a\x8a\x3d\xf7\x78\x7c\x8c\xb3\xc0\x00\x57\x14\xcc\x62\x3a\xde\xae\xe9\x0c\xe1\x2d\xaf\x5f\x10\x7f\xb7\x8d\xa2\xda\xa1\x5c\xcc\xfb\x9e\xdb\xf6\xe6\x94\x12\xc7\xcd\x12\xdc\x1a\xe4\x05\x08\x74\x9c\x10\x49\x1f\x58\x00\x6e\x6a\xa4\x03\xd9\xdd\x1a\xec\xba\xca\xab\x65\x89\x66\x12\x2b\x74\xbc\xff\x84\x82\xec\x1f\x44\xc4\xdc\x03\x21\x73\x8e\xfc\xde\xf8\x2a\xea\xea\xd1\x98\xc1\xa9\x10\x2b\x37\x16\xef\x4a\x78\xb4\x12\x7f\x86\x11\xf9\x53\x1d\xeb\xbc\x56\x1b\x93\xe8\xbe\x51\x6f\xe0\xd2\x59\x99\xbb\x71\x61\xdd\x49\xbe\x03\x39\x65\xd9\x79\x74\x0a\xd0\x72\x56\xde\xeb\xe8\xfc\x01\x2f\x08\x66\xcf\x98\xf3\x66\x9a\xc5\xe6\xe8\x61\x86\xb2\x7f\xa4\x66\x10\x52\x16\x32\x38\xf1\x3b\x2b\x2a\x30\x18\x0b\xc0\x1f\x0e\x4e\x1a\x32\xa2\x99\x79\x39\xa8\x08\x87\x8d\xdf\xc8\x61\x6d\x1d\x84\xd1\x0d\x22\xa5\x90\x07\xba\x24\x82\xa6\x41\xe5\x56\xfc\x9d\xa5\xa2\x7f\xba\xd7\xb6\xa7\x29\x5f\x2b\xa4\x5c\x46\x34\x13\x3a\xe2\xb9\xa1\x17\x86\xf0\x53\xbe\x5d\x5b\x51\x77\x76\xab\xb0\x46\x0f\x1d\x94\xfd\xbc\x09\x05\xab\xaf\x69\xe7\xcd\x61\x62\x91\x4b\x59\xbe\x41\x26\x81\x51\x79\x31\x41\x32\x98\xb7\x62\x48\x46\x12\x3a\xae\x6d\x97\xcc\x99\xef\x95\x41\x92\x2a\xd7\xa3\x2f\x1f\x67\xb2\x67\x0c\x47\xb9\x5a\xd3\xa2\xa3\x82\xfb\x4f\xd1\x7a\x75\x27\x4d\xea\x6f\x01\xf2\x09\xac\x79\x2f\x0d\xa6\x4e\x75\x83\xff\xe3\x3c\xd3\xbe\x84\xff\x14\xad\xdb\x2b\x0f\x9e\x49\xa5\x0c\x48\x5b\xfc\xc2\x46\x1d\x56\x62\xa3\x09\x50\xaa\x52\x95\x3b\xfd\x92\x1c\xa3\xff\x04\x39\x6c\x3a\xa4\xf2\x40\xc3\x94\xb6\x18\x3b\x1e\x84\x41\x57\x8d\xff\x14\xeb\x4e\xa3\x81\x3c\xbb\xae\xdf\x94\x0c\x5e\xc4\x99\x04\x12\x39\x3b\x24\x1c\x81\xa9\x72\x0b\x90\x3c\x70\x2e\x6d\x2a\x20\xaa\xab\xa3\x2f\x37\xf2\x6f\xc8\x75\xd0\xf9\x8a\x86\x17\xe4\x46\x89\xda\x6b\x7b\x8b\x81\x1f\x99\x09\xc2\xc7\xbf\x84\x8d\xb2\x4a\x7a\x30\xba\x87\x67\x63\x56\x9a\xc1\x07\xaa\x16\x6f\x3a\x75\x9c\xca\x5a\x1e\x11\x98\x25\x53\xfd\xe0\x5e\x48\x1f\xc1\xa7\xba\x04\xf6\x3c\xae\xbe\xc5\xc5\x18\x90\xab\x5b\x0c\x03\xd4\x9d\xc2\xf6\x2d\x8b\xdb\x1d\x9d\xa9\xcc\x3d\xdb\x8c\x17\xfc\x71\x2f\xf7\x96\x11\x94\x2b\x1e\x9a\xc9\x65\xa9\x3e\x99\x67\xb5\xa2\x6e\xf0\xc7\xf8\x68\x1d\x97\xd7\xcc\x15\x44\x39\xbd\x2b\x8e\x2f\x46\xd7\x75\x7e\x36\xa4\x8b\x31\x57\xa5\x63\x5a\x4f\x34\x80\x92\xcb\x23\xb9\x2a\x32\xc5\xac\xfb\xb6\x20\xcf\xa6\x12\x35\xab\x49\x68\xb2\x1e\x93\x25\x15\x7e\x4c\xdf\x94\x21\x44\xaf\xab\xb2\x94\xe9\x01\x92\x8e\xa0\x5c\xe0\xb0\x9f\x5c\x52\x04\x38\xd9\xc6\x86\xcb\xa5\xec\xb8\x33\xc9\xaa\x67\x07\x2c\xc0\xdc\x05\xc7\x02\xa2\xa5\x98\xb4\xa7\xe7\x3f\x88\x83\x46\xf6\xb9\xe0\x71\xc8\x14\x95\x00\xee\x8c\xa5\xa5\x5c\xc8\x80\x5d\x05\x29\x69\x02\xbd\x83\x63\x37\x8c\x26\xb7\xd8\xc5\x3a\xc7\x7b\x44\xee\x19\xcc\x1e\xfb\xe9\x5d\x98\x2a\xdc\x9c\x97\x22\x5e\xed\xe0\xf1\x7f\xe4\x30\xd3\x32\xf2\xd9\x4b\x8c\xa2\x0f\x6b\x20\x35\xce\xa2\x04\xe7\x98\x8f\x46\x9a\x38\xeb\x65\x9d\xe5\x06\xb8\x04\xfc\x71\x7c\x3d\xa9\x4c\x35\xc7\xe8\x50\x98\xeb\x08\xfa\x0f\x83\x23\xdc\x7d\x18\xd9\xb6\x5a\x77\xab\x6c\x0e\xe0\xf5\xa2\x67\xa7\xa4\x27\x17\x09\x81\x61\xc5\xd3\x3e\xe2\x67\x7b\xaf\x63\x9c\xfc\x5d\x50\x8a\x50\x4c\x99\x6f\x7e\xad\x7a\x8e\xdc\x51\xaa\xb4\xc7\x2a\x82\xef\x42\xf9\x5f\x7e\x34\x66\xe6\x29\xd8\x42\x16\x46\x12\xe8\x14\xe9\x90\x35\x1a\x89\x6d\xaa\x29\x54\xbf\xcc\x46\x21\xdf\x1f\x4b\x81\x5a\x55\xae\x56\x6c\xd3\x8b\x06\x3c\xbc\x6d\x4a\xfc\xe0\xcf\x9d\x17\x5b\x73\x68\x77\x05\x98\xd1\x0a\x49\x99\x2d\xec\x9e\xbd\x2a\xd9\x9f\x12\x15\xbf\x3c\x5a\x9b\xa5\x77\xa3\x33\x41\x4f\x90\x5f\x4c\x75\xff\xfd\xcd\xc8\x2f\x3e\x14\xf4\x34\xc5\xaf\x35\x4b\xb3\x76\x52\x49\xe6\x2c\x26\xfc\x15\x3b\x51\x8d\x1b\x19\x4c\xaa\xce\x5c\xf4\x26\xbb\x48\xf5\xca\x88\xd7\x9b\x55\x16\xac\x50\x72\x45\x40\x95\x38\xd7\x8e\xf5\xb0\x2c\x39\xde\x26\xb3\x63\xa7\xca\x99\x4a\x6a\x50\x39\x30\x88\x32\x86\x6b\xc8\x30\x1a\x9d\xa8\x55\xd0\x5b\x4b\x88\x99\x9e\x17\x2c\xe9\xc9\x9d\xf3\xfa\x57\x87\xb4\x4d\x24\xfe\xb9\x68\x30\x8f\xab\x4c\x3c\x74\xe4\xbf\x13\x1f\x0d\xf1\xcc\x65\xc5\x27\x83\x68\x5d\xa0\xea\x90\xa4\x97\x53\xc1\x61\xff\xda\xe3\x1c\xc4\x77\x91\xa9\x28\x6e\x15\xfe\x89\xa7\xc3\xee\x7c\x0a\x56\x3f\x11\x37\xe8\x80\xe7\x06\x03\x99\xf3\x74\xc5\xab\xce\x1b\xca\x6c\x33\x53\x36\x04\x38\x0e\x14\xbc\x50\x2c\x0d\x39\x73\xbb\x84\xeb\x87\xee\x69\x6f\x23\xf5\x53\xa4\x9e\x72\x5c\xab\x62\x0e\x02\xec\x6f\xcc\x71\x50\x52\xbb\xc7\x32\x23\x8d\x75\x0b\xea\x12\x24\x9c\xa6\x56\x12\x18\xe1\x75\xb7\x4a\xb8\xb6\x75\x3e\x76\x16\xb1\xb9\xd4\xc9\x7e\xb8\x8e\xaa\xdf\xc9\xba\x5f\xd1\x65\xf2\x81\x20\xa1\xaf\x9d\x39\xce\xc8\x4d\x05\x12\x3e\x80\xb0\x3d\x11\x23\x2f\x84\x1c\x60\xd3\xa0\x8c\xd4\x46\x61\x29\x43\x48\xbf\xfe\xe8\x20\x43\x11\x5d\x58\x00\x2a\xbb\x11\x6b\x03\x56\x95\xdb\x60\x55\x3c\x22\x27\x95\xef\xda\x38\xc6\x0c\x4c\x93\xa9\x97\x53\x5f\x5e\x56\x3b\x82\x45\xbd\xaa\x5f\xd1\xcb\x3f\x75\x51\x6d\x41\x49\xa3\x93\xb5\x5f\x98\xb9\x89\xff\x51\xc2\xd4\x7f\x55\x56\x84\x28\x63\x97\x3a\x1e\x68\x0c\x7c\xed\x3f\x6d\xaf\x83\x4f\x87\x27\xba\xa0\x75\xf5\x6b\x67\x37\xdd\xa7\x51\xa9\x0b\x59\x2a\x28\x6a\x62\x3b\xec\x55\x61\xcc\xa7\x41\x17\x4f\xe5\xbb\x39\x4c\x77\xbe\xe3\xfe\xe5\xb0\x9d\xf7\x65\xb3\xc9\x20\x3b\x13\x39\xd0\xb4\xd7\xe0\x5d\x64\x1f\xf1\x01\x8c\x1d\x14\x5e\x68\xe5\x9e\xb0\xcb\x8a\xbb\xda\xe1\xf7\x49\x68\x66\xf4\x4b\x84\xed\x54\x75\x9f\x55\x9b\x6a\x79\x70\x3f\xfc\xbd\x67\x73\xef\x27\x81\xc6\xd4\xdd\xab\xd4\xf7\xf3\xb9\xef\x1b\x7b\xa4\x84\x19\x69\x0f\xd2\xc5\x2b\x9e\x6c\xef\x3e\x7b\x6e\x23\x7b\xf5\xf4\x24\x0c\x31\xb7\x24\x26\xc3\x20\x45\x93\xe0\x63\x4c\x69\x6b\xe7\x97\x7b\xb0\x57\x5f\x0c\xf1\x9d\x1d\x52\xd0\x1a\x33\xb4\x0b\x0a\x1d\x26\x53\xb3\xd2\x5e\x7b\x89\xf7\x6e\x14\xd5\xca\x7f\x5b\xe5\x09\xbd\xe2\xdc\x10\x68\xbf\xa1\x11\xe3\x68\x66\xb5\xc6\xf0\xa2\x1a\x01\x4e\x93\xb1\x1e\xc9\x32\xc8\x3c\xea\xb0\x27\xe7\x26\x77\xfd\xf8\xe5\x17\xd3\x4f\xc3\xd9\xc0\x1a\xe1\x53\xab\x94\x9e\x5a\xf0\xcc\xdf\x3d\x7e\x35\xb5\x64\x85\xd5\xd3\xd7\x5e\x0f\x7a\x35\xf5\x35\xdc\xb4\x80\x68\x65\xf0\xa2\xd5\x47\x1c\x50\xf0\x3c\x24\x0d\x4a\xf0\x04\x8a\xf6\x58\x77\xfa\x9b\xa3\xd8\x10\x12\xba\x81\x25\x95\xfb\xd2\x9f\x9b\xda\xef\x1f\x5f\x3d\x1d\xcd\xad\xa2\x15\x67\x64\xc2\x9d\xe2\x26\x5d\xe6\x70\x46\x79\x14\x94\x4a\x45\x07\x6d\x39\x79\x1b\x66\xad\xf8\xef\x06\x11\x9e\xd5\xa0\xd1\x26\xc6\x5c\xfa\x93\x7e\x96\x3f\xea\xa3\x95\xda\x8b\x53\x4a\x8d\xe5\x51\xee\x29\x4c\xdb\x35\xa4\x6a\xc5\xc8\xc9\x50\xcb\x91\x7e\x7b\x3e\xc5\x99\xb8\x54\xbf\xfd\x0d\x76\xe9\x86\xc2\xe0\xf7\xde\x22\xe4\x13\x30\xc4\xcf\x44\x4b\x58\x65\xcd\x66\x89\x42\xd7\x34\xce\x8a\x8d\x3a\x88\xd0\x6a\xaf\x13\x6b\x2e\xde\x63\x4a\x3d\xf8\x06\xf6\x61\xad\x28\xd4\x35\xe8\x7f\x3d\x6e\xc1\x1c\xce\x11\x2e\xbd\x9e\xe1\x59\x77\x2d\x49\x0d\xd8\xd2\x3d\xb2\x2f\x50\x57\x92\xb9\x13\xf8\x7f\xff\xf7\xff\xc1\xf0\x30\x8e\x0e\x4d\xd5\x9d\x34\x46\x81\x62\xf2\x84\x2b\xbd\x46\x50\x22\x91\x8c\xcc\x3e\xe6\xfd\xde\xa5\x49\x4e\x3d\xe7\x6a\xe9\x73\xbe\xc1\x46\xbb\x33\x0f\xfa\x7c\xf1\x90\xde\x62\xc2\x78\xf6\x59\xcf\x97\x3c\x0b\x15\x3f\x2d\x11\x38\xd7\x58\xcc\xe8\xb0\x4e\x12\x94\x3c\x66\x72\xb8\x74\xbf\xd6\x21\xf5\x82\x49\xe5\x49\x84\x67\x1a\x75\x24\x15\xce\x4f\xf5\x1d\x3f\x1b\xda\xc5\xd3\xa6\x4a\x10\xae\x9c\x88\xb5\x66\xec\x5c\x4f\x52\xc9\xc0\x64\x6d\x75\xd4\x94\x9e\x4e\x50\xd2\xa9\x67\x86\xd4\xbe\x20\xd5\xee\xee\xb8\x8a\xd2\xed\x2d\xc9\x4e\x54\x8a\x07\xe1\xd6\xea\x80\xd1\xb7\x3b\xe5\xd7\x25\x9a\x2e\x9f\x63\x82\xcc\x51\x7a\xad\x11\x0f\x33\x4a\x49\x78\xf5\x73\x87\x74\xfa\x94\x18\x78\x54\xc0\xe5\x40\x21\x32\xd4\xce\x1e\x8a\x67\xd2\x43\x56\x94\xb8\xab\xd5\x86\x3d\xee\x26\x28\x73\x97\x58\x5f\xc6\xd1\x6a\x2e\x60\x2c\x1a\x42\xc2\xcd\x2c\xc1\x52\x9c\x5c\x4f\x10\xe4\xc2\x5c\x04\x2f\x45\x87\xa8\xab\xfb\x75\x4d\x2a\x82\x5a\xb0\xc0\x7e\x4c\x02\xbc\x17\xec\x61\x40\x1e\x50\x5c\x75\xda\xa9\xf2\xaa\xa3\xbc\xe6\x5c\x87\xf3\x25\x42\x89\x65\xcc\x63\x30\x82\xa9\x39\x1e\x82\xcf\x19\xdb\xf2\x22\xee\xd2\x6b\x8d\x43\x00\x03\x78\x05\x04\x79\x18\x7d\xf5\x77\xa3\xaf\x62\x4b\x31\x0c\x05\x4d\x7d\xfb\xf7\x83\x6f\x2f\x0c\x23\xa0\xca\xf8\x5a\x7a\x8f\x4c\xe1\xf7\xac\x32\x3e\x20\x90\x80\x51\xdc\x9e\x87\xbb\xe9\xac\x4e\xd5\x57\x92\xcc\x00\x7b\xbe\xa9\xea\x92\xa8\xc2\xac\x66\x49\xcd\x3b\xac\xb3\x89\x5a\x87\xbb\x20\x82\x39\xad\xb9\x47\x33\x8f\xb2\xf8\x52\x31\x60\x0c\x23\xa5\xaa\xcd\xe8\xfa\x1a\xb7\x8a\x4f\x55\x5f\x2b\x4c\x8f\xe6\x7b\x8b\xbd\xe0\x40\x40\x10\x89\x77\x82\x2c\x66\xe1\xb6\x30\x60\xb1\xa0\x3b\xf1\x64\xa3\xa4\x57\xf7\x53\xc3\xf5\xbe\x68\x2b\x7a\x68\xd1\x5a\xcd\x95\xcf\x92\x67\xca\x37\xab\x5f\xd8\x62\xf5\xb0\x6d\x2d\x51\x47\xa4\x3d\x9e\x68\x9c\x73\x89\x59\xf4\x3a\xe5\xff\x8f\x4e\xf9\xef\x96\x9e\xf2\x13\x08\x9b\xf3\xde\xff\x7d\x1a\x46\xe4\xdf\xcf\x5b\x44\xb2\x47\x3b\x4c\xaa\xde\x5e\xf1\x26\x1d\x53\x34\x51\x93\xc7\xa6\xd1\x79\xce\x78\x55\xbc\xf1\x13\xeb\xe1\xfe\x45\x3a\x2c\xd1\xbe\x47\x95\x9c\xa7\xd5\x71\x7e\xf4\x8c\xea\xfd\x2e\xa2\xe2\x0d\x0b\x35\xaf\x8e\x73\x7a\x77\xc0\x94\x40\x9a\x15\x15\xf0\x9d\x76\x88\x8c\x41\x47\xd2\xe8\xad\xa5\x3a\xff\x65\x4a\xba\x9c\x06\xac\x3d\xea\x6a\x9c\x07\x88\xe3\x69\x45\x3d\xa1\xa7\x4b\x54\xf3\x91\x9e\x4e\x6f\x78\x10\x4a\x45\x7d\x5c\x79\x78\x01\x7b\xcf\x91\xbb\x55\xe8\xb3\xac\x9f\x49\x3d\x2f\x3c\xe6\x2f\xf2\x31\xbf\x44\x19\xe6\xae\x17\x1f\x5e\xa3\xfd\xc1\x82\x02\x55\xe0\x9a\xdb\xb1\x26\xe0\x2f\x9c\x4e\xaa\x0c\x75\x9b\x28\x2a\x23\x0f\xec\x39\xfa\x88\xc9\x48\xb3\xe9\x3c\xc5\xd0\x76\x2e\xb4\x3b\xe7\x3b\x3a\xf3\xc4\x04\x0b\x97\x4c\x46\xe5\x39\x7f\x8d\x16\x84\x3f\x8b\x17\xfe\x60\x87\x8d\x83\xf5\xaf\x9d\x44\xe4\xdf\x7c\xd9\xb7\x34\x86\x9b\x9e\x4a\xdb\xe3\xb0\x44\x50\xfe\x4e\xc2\x79\x67\x8f\x0b\xf3\xac\x4a\xa2\x45\x00\xeb\xc0\x33\x1f\xcd\x0f\xef\xde\x46\x23\x42\x25\xc5\x06\x89\x13\x61\xc4\x6f\x50\xbe\x6a\xfe\xd8\x80\x58\xf4\xae\xf5\x7a\x8c\x52\xcb\x54\xb5\xe7\x04\x27\xbf\xfa\xaa\x07\xa9\xeb\x48\xb8\x9b\x0c\x06\xe3\x34\x52\x3f\x26\xd5\x28\xbf\x4d\x26\x35\xe6\x04\x69\xa6\x94\x6c\xa7\xd9\x17\x5f\xf7\x3a\xa8\x00\x3b\x94\x31\x18\x65\xb5\x71\xff\xbd\x4f\x2c\x96\xfb\x17\xdd\xbb\x58\xce\x8f\xfe\xad\xc4\xf2\x62\x49\x5b\x8c\xec\x9e\x24\xed\x79\xe0\x34\xa2\xb6\xfb\xcb\x89\x65\x97\xde\xdd\x26\x32\xf5\x62\xc3\x13\xb2\xa1\x37\x1d\xbf\x23\x74\x36\x49\x51\x03\xe7\x37\xb7\x6e\xdc\x4c\x4c\x68\xdc\x3a\xac\xa8\x56\x9a\xd8\xd1\xec\x88\x13\x56\x9c\x22\x9d\x78\xdf\x06\x21\xfd\x7e\x80\x39\xb3\x5e\xeb\xd0\x68\xec\x0c\x47\x1b\x30\xe5\xd2\x93\xf0\x61\xd4\x76\xaf\x04\x54\x9d\x7b\x3d\x14\xfa\x29\xbf\x63\xa8\x9f\xfe\x2e\x39\xae\x23\xf9\x32\xcc\x76\x2d\xcd\x75\x9d\x57\x5c\xdf\xa8\xe3\xea\xf3\x29\x62\xe6\x7f\x5c\xdb\x96\x75\x08\x23\xa3\xce\xd9\xb8\x1b\x55\x30\xb8\x4e\x09\xf2\x97\x85\x99\x8b\xfe\x63\x1f\x29\xec\x03\x85\x61\x10\x29\x94\x33\xd1\xb8\xfe\x71\x28\x6e\x3e\xde\x64\x1e\x4c\xe0\x13\x8b\xe7\xc1\xbb\xee\xdf\x70\xce\x98\xc6\x7f\x66\xbb\xb9\x5c\xa3\x7f\x1c\x81\x3e\x3c\x25\x1f\x20\xd3\x8f\xa6\xf5\x49\xc4\xfa\x60\x98\x9f\x4e\xb2\x97\xaf\xf9\xed\x84\xfb\x49\xde\xf1\x0f\x17\xee\x2f\x2f\x13\xee\xdf\x6b\xeb\x7c\xef\x9b\x1c\xa7\x77\xa6\x40\x0c\xff\xf8\x92\x7e\xce\x3d\x60\x41\xdf\xd7\x67\x30\xaf\xf5\x87\x67\x70\x7e\x1a\x86\x76\xef\x2f\x85\x33\xef\x50\x5c\x94\xc6\x29\xa6\xfb\xf1\xa9\x9c\x73\x0f\xbb\x34\x9d\x73\xf6\x79\xcf\x27\x5c\xee\x49\x20\x44\xe1\xed\xa5\xdb\x3e\x84\x2d\xfd\x2b\x9b\xf3\xaf\x6c\xce\x3f\x41\x36\xe7\xe5\x20\xe9\x52\xf4\x06\x9d\x4a\xd1\x58\xf1\x6a\xf0\xf5\x0b\xe4\xff\x7f\x83\x96\x3d\xb6\xef\x3f\x9a\x36\x70\x61\xe3\x95\x99\xb4\x0c\x49\xd2\xdf\x28\x31\xb3\x2c\xf7\xc2\x91\x13\x6e\x5b\xd4\x27\x18\x4e\xa7\x75\x06\xd9\x1a\x64\x5a\xe2\xd0\xd3\x64\x13\x96\x6f\x3b\x5b\x7b\x84\x8d\x6f\x7a\x06\xe0\x8c\xf8\x18\x2e\x0f\xbf\x60\x23\xa9\x66\x58\x8a\x3b\xdc\x49\x1e\xe3\x3a\xf3\x48\x9c\x4f\xf2\x3c\x1f\xe0\xdd\x9e\x64\xb2\x44\x38\xfc\xa2\x3a\x20\xf5\x92\x1f\xe0\xdf\x50\x74\xd0\x04\x96\x74\x36\x9d\x84\xb0\xfe\xd6\xe9\xa0\xa9\xd5\xfb\x70\xc4\x27\xde\x9d\xbf\x3a\x57\x63\x2d\xef\xd7\x58\xc7\xff\xe1\xa6\xd3\xc7\xb1\xcb\xc2\x45\xd9\x39\x83\x65\xcd\xde\x1f\x44\x5b\x14\x83\x65\xdd\xf1\x29\x62\xb2\x4b\x41\x34\x23\x76\xb1\x7f\x40\xa8\xcc\x59\x97\xff\x22\x53\x6b\x38\xe7\x8f\xb7\xb6\x16\x3c\xef\x52\x83\x6b\xc9\x23\x17\xc1\x68\x6e\x8e\xad\x93\x8f\x36\xb1\xc4\xdf\xd9\x90\x22\x22\x73\xed\xff\x65\x4b\xfd\xcb\x96\x5a\x8a\x77\x41\xd6\x9a\x1f\xb0\xa2\xe8\x47\x23\xff\x31\x98\x7e\x3e\x98\xb6\x67\x19\x7f\x38\xf9\x59\xe6\x90\x49\x7b\xa4\xa8\x55\xa3\xb7\x2e\x93\x98\x61\x44\x94\x1a\x18\x38\xbb\x7d\x8c\x7c\x03\x21\xd1\xbe\x05\x64\xe0\xa0\x96\x56\x87\x00\x86\x83\xeb\x82\x50\x36\x82\x01\x41\x56\x8c\x2d\xd8\x11\x9e\x08\x5e\xe5\x8c\x34\x56\xbf\x44\x2f\xe1\x6f\xa8\x4c\xa2\x32\x46\x6f\x91\x58\x01\x75\x47\x44\xb2\x07\xe7\x89\xd1\x16\xab\x12\x48\x50\x34\xda\xd6\xc7\xfd\x75\xd2\xa1\x5d\x65\x63\xe6\xb5\xb8\x7a\x21\xbe\x2b\x9f\x7b\xa2\x6a\x1e\xc3\x5f\xc4\x09\x75\xab\xc4\xcb\xfa\xa5\x68\xc3\xa1\xda\xf5\x6d\xef\x30\xc1\x86\xd6\x56\x90\x56\x15\x7d\xa7\x89\x82\x86\x97\x07\xeb\xc5\x61\x60\x70\x9f\xe9\xab\xb9\x8c\x2f\x43\xd3\xe9\x16\xaf\xf8\xb3\xa2\x5a\x16\x35\xde\xb0\x10\x3c\x88\x20\x0f\xab\xdc\x50\x57\xd6\x74\x75\x88\x64\x10\x9b\x73\x69\x2b\xb6\x7a\xbd\x56\x5e\x87\x1d\x98\x69\x5b\xc4\x12\x79\xe6\xe4\xa7\xa8\x61\x62\x2f\x0d\x91\xdb\x9f\x11\x22\xba\x80\x48\xcf\x49\x8b\x1f\x46\x56\x1b\x99\x78\x99\xbe\x2b\xdc\x32\x88\x1b\x37\x9d\x2c\x8f\x0d\x48\xec\xcc\xc5\xf4\x41\x44\x4d\x2c\xe3\x88\x69\x27\x0c\x69\x99\xe8\x9a\x23\xf3\x8b\x2b\xab\xee\x0e\x20\x3b\xad\x83\xfd\x3f\xac\xd5\x4a\x58\x75\xa7\xfc\x4a\x68\xef\x95\x51\x77\xd2\xc6\x95\x60\xca\x25\xf1\x90\x59\x0f\x79\xd8\x29\x76\x7a\x6b\x99\xfa\x8f\x29\x8b\xd8\xf2\x4f\x2f\x26\x91\x7f\x4c\xa1\xb4\x3f\xa6\x50\x1a\xb1\x23\xa5\x9e\x69\x67\x59\x92\x26\x38\x38\x17\x35\x1e\x84\x8b\xb5\x9d\xaa\x60\x9a\x12\x5a\x97\x14\x30\x9d\x6b\x5a\x73\x5a\x42\xfd\x0e\x9b\x8d\x9c\xa1\x15\x9b\xaf\x3f\xca\x9c\x9b\x7f\xb1\x75\x6a\x9e\x36\x21\xc7\x3c\x95\x8d\x23\x31\x8b\xae\x22\x92\x54\x28\x19\xfa\xde\xd8\x54\x60\x31\xd9\xa4\xf6\xb8\xad\x36\x1e\x50\xae\xa9\xe8\x3b\x60\xa3\xa5\xb2\xc1\xe7\x8b\x63\x9a\x59\x99\x5e\x39\x2e\x59\x66\x29\x98\x29\x6f\x9c\x55\xa1\x20\xa4\x5c\x2b\x53\xb6\xc5\x3b\x57\x23\x91\x4a\x7b\x06\x74\x14\x5c\x34\xa1\xe3\xe2\x7a\x44\x0c\xa7\x83\xdc\xe3\x0f\x55\xd2\xd8\x61\x28\x21\x99\x9b\x88\xa7\xf6\x20\x64\x0a\x4e\xac\xa0\x9c\x6e\x94\x36\x58\x9b\x10\xc1\x66\x6c\xe4\x2d\x57\xf4\xd3\xf3\xfb\x0a\x5f\x86\x22\x26\x02\xdf\xb3\x23\x29\x7a\xf5\x57\xee\x0e\x04\x43\x46\x36\xf0\x20\xfb\x41\x2c\x0b\xf8\xbd\xe3\x4e\x25\x3d\x55\xb1\xad\xbb\x0a\x6b\x22\x13\x69\x53\xda\x59\xae\xa1\x49\xbc\x1a\xb0\xa9\x65\x53\x45\xaf\x88\x69\xb3\x38\x0a\x65\x7b\x38\xd9\x28\xa3\x41\x22\x97\x5f\x29\x96\x54\xb6\x2d\x99\xc4\xf3\x85\x62\x5f\x83\x2f\x0f\xcb\xf2\x4e\x57\xb7\x56\x85\xf0\x85\xf8\x11\xb4\xe4\xd6\xeb\x76\x68\x0e\x3e\x08\xa8\x27\x53\x5a\x05\x1b\x11\x26\x6e\x4d\x6d\x6e\x0f\x60\x2b\xc7\x71\x15\xd5\x14\xc3\x0b\xd7\xdb\x54\x3b\x55\xdd\x52\xa5\xc4\x44\x05\x4e\x48\x6d\x9f\x98\xe7\x79\x96\xf0\x48\x9b\xb8\x13\xdf\x80\x74\xfe\x42\xdc\x08\x2f\xb7\xc8\x94\x8d\xbc\xa2\x61\xaf\x14\x4e\xa4\xef\xb5\x56\x4c\x68\xed\xea\xc3\x82\x11\xbf\x8b\x5e\xd9\x6d\xdc\xf1\x98\x57\xfd\x07\xa3\x33\x45\xad\xb4\x0a\x02\x1b\xdc\xc9\x2e\xa8\xfc\x8b\x33\x1c\xa6\x61\x27\xfe\xec\xe2\xb0\x18\xea\x41\xe0\x2e\x5c\xb5\xaa\xe4\x21\x2c\x18\xef\xeb\x9d\xf4\x3a\x34\xb2\x5c\xe3\xbb\xce\x58\xe5\x25\xf7\x3e\x8b\x0e\x8b\xed\xc8\x1a\x99\x1b\xd3\xf7\xda\xd6\x1b\xed\xd5\xd1\x90\xe0\x2c\xe4\xf4\x1e\xae\x35\x5a\x0c\x67\xc7\x36\x30\x9f\x8a\xf1\x9d\x34\xab\xc2\x84\x1c\x60\x0a\x5d\xee\x97\x47\x9d\xc6\xfb\x9a\xfc\x1c\x1e\xab\x52\x1d\x6e\x0a\xf4\x53\x0f\x83\xca\x35\x6b\x39\x4f\x1d\xac\xf4\xaf\xdd\x78\xd2\x70\x1a\x41\x48\x20\x4f\x1c\x35\xc7\xd9\xa1\x24\x5a\x30\xeb\x3e\x0e\x96\x8e\xd0\x74\x64\x6c\xf6\x0c\xe5\x9f\xcc\x0e\xdd\xe8\xe6\x20\xde\x38\xd7\x8c\x46\xbf\x56\x5b\xe6\x7d\x5c\x1b\x85\xc4\xe9\x29\xa5\x2a\xd7\x66\xc9\x2d\x18\x68\x80\x62\xe7\x4e\x6a\x86\x40\x74\x91\xcc\xc2\x4a\x7d\x68\xf1\x52\xc6\xe3\xce\x1e\x65\x3f\xd8\x20\x42\xec\xac\x1d\x90\xbb\x17\x45\x7d\x3d\xff\xc3\xd2\x06\xe4\xf3\x76\x8e\xb6\x70\xe8\x96\x01\x5a\x26\xc9\x0d\x4e\x77\x87\x5d\x46\xfe\x43\x55\xf2\x31\x66\x65\x8f\x10\x80\x4a\xfa\x3b\x55\x93\x42\xd2\x77\xce\x13\xc9\x69\xad\x2a\x14\xfa\xcc\x5f\xba\x55\xcd\x71\x57\xd9\xd5\xd9\x46\xe4\x57\x4f\xe7\x6c\xa9\x93\x2e\x5e\x02\x49\xa6\xa2\xe4\x51\x65\x22\x01\x19\x07\xf1\x3a\x70\x12\x56\x65\x67\xbf\x44\x89\x49\xad\x63\x36\xe2\x2a\xb7\xb3\x1c\xd0\xad\x70\x95\x26\x52\x8a\xfc\xd4\xbb\x64\x32\x26\x97\xac\x92\xc4\xa4\x41\xb7\x9c\xf6\x30\xff\x51\x86\x4c\x8a\x93\xde\x57\xf5\x1b\xbd\x12\xea\x97\x96\xb9\xad\x8b\xa0\x03\xda\x1f\x6b\x17\x77\x14\x2f\x99\x7a\x72\xb6\xeb\xb9\x89\x59\x74\xb9\x7f\x43\x86\x46\xe8\x84\xf5\x61\x5d\xbe\xe7\xce\x3a\x1c\xf2\xa8\xb4\xaf\xba\x86\x09\x94\x5d\x55\x75\x9e\xfd\x99\x64\xc3\xf1\x1a\x94\x5f\x1b\x57\x85\x2b\x64\x30\xa5\x2e\xd0\x47\x08\xcf\x62\xa2\xf4\x7d\x3c\x27\x84\x5b\xa5\x3e\x35\xd8\x16\x9b\x9a\x5b\x44\xdd\x76\xc4\x8b\x08\x2f\x1d\x7f\x89\x64\x7b\x31\xcd\x7e\x24\x12\x7b\x04\x6d\x3b\xb3\xa1\xee\x13\xf4\x53\x44\x3e\x05\xdd\x68\x23\xbd\x30\xfa\xe7\x4e\xcf\xea\xed\xf7\x53\x4b\x3c\x58\x3c\xdd\x34\x0a\x7b\x7d\x9b\x43\xd9\x97\xaf\x5c\x1c\x1d\x44\xa3\xe2\x44\x63\x22\x94\x37\xe8\xfd\x22\xbd\x2f\x9d\xc9\xbd\xb4\x91\xfa\x54\xaf\xfa\x5c\x48\xb9\x64\x8b\xca\x02\x66\xc7\x8c\x6e\xb4\x63\x7a\xba\xd4\x49\x88\x69\x66\x52\x15\x73\xa6\x46\x83\xf1\x85\x61\x9f\x58\xfe\xbd\x1a\x0c\x2b\xb9\x1a\x42\xe2\xdc\x72\xa8\x70\x1c\x5f\x43\x2f\x64\x55\xe8\x42\x22\xe9\xe2\x28\xc0\xd1\x03\x0b\xf7\xfd\x89\xb8\x31\xc1\xad\x8e\x16\x23\x7d\x47\x33\x65\x0f\x6c\xb4\xa6\x40\x27\x89\x16\x6c\xc1\x07\x52\x1d\xd6\xd8\xb1\xbb\x43\x2d\x07\x97\x06\xca\xb4\xed\xa4\x11\xdf\x18\xd9\x1c\x07\xc9\xa6\xfa\xf1\x5d\x44\x31\xb3\xa8\x4b\xaa\xef\xd6\x07\x51\x83\xbb\x43\xc2\xf4\x05\x35\xe6\x3e\xd5\xd1\x74\x46\xb4\x12\xf7\x12\x36\xeb\x02\x09\x75\xc6\x5d\x4d\x34\x18\xdf\xc0\x7d\x54\x5e\x3c\x7c\x6b\x6a\x8f\xed\x7c\xff\xcb\x62\x5f\xda\x49\xc6\x99\x0d\xac\xd4\x0a\xdb\x32\x32\x45\xa9\xb6\x62\xed\xe1\x07\x16\xfb\x8a\x38\x38\x27\xce\x57\xbb\x55\xdf\xc0\x06\xe7\xc5\xee\xa3\x70\x48\x8f\xda\xcb\x6a\xf4\x77\x19\xc6\x4d\xe7\xc6\x38\x77\x1b\x84\xd1\xe8\xfc\x79\xb5\xed\xe0\x4e\xf3\x8b\x99\x82\x88\xea\xcc\x10\x81\xb6\x53\x92\xa2\xa8\xb9\xbd\x03\x9c\xe4\x5f\x0e\x5b\x65\x31\xd9\x97\x37\x19\x9f\x90\xb8\xb8\xd1\xe7\xa2\xbe\xd7\x3b\x5d\xd7\xca\x72\x13\xca\x28\x42\x83\x47\x96\xfe\xf6\x73\xa7\x6c\xb5\x2b\x7d\x80\xf9\xe3\xe4\x1d\x1f\xa6\x20\x1e\xbe\x7d\xfb\xe8\xe8\x48\x3d\x9d\x38\x52\xef\xef\x2d\x09\xb7\x94\xe7\x18\x66\xc6\x78\xb4\x87\x41\x29\x72\xdd\x1f\xcd\xa7\xd7\x96\xf3\xa7\x5c\x76\xa4\x0a\xb4\x52\x91\xb0\xa6\xbd\x5a\x48\x92\xb6\x01\xe9\xc0\x7f\x92\xe2\x45\x5f\x13\xc1\x54\x7e\x2c\xd0\xf1\xa8\x4e\x26\xeb\xf6\xe0\xdb\x2c\xa3\x0b\xa6\x05\x34\x07\x50\xe4\xc9\x37\xa0\xc1\x32\xdd\x01\xbf\xa3\xa0\x03\x19\xf6\x42\xd8\x3b\xd7\x77\x5b\x17\x9b\x4e\x61\x29\x41\xeb\x55\xe0\xcb\x14\xb1\xcd\xcf\x3e\xf3\xd7\x5e\x3e\x30\x10\xa2\x9d\x0e\xbb\x7e\x70\x79\x79\x50\x87\xc1\xca\x9c\x79\x68\xed\x3a\xea\x3e\x2d\x76\xd2\x70\x2b\xdd\x54\x9d\x42\xb7\x9d\x9b\x54\xe1\xc5\xd3\x0d\xff\x0b\x75\x42\xea\x6c\x41\x17\xb6\xda\x51\x93\xfd\xc8\xfd\xb8\x30\xda\x0e\xb6\x0e\x9b\xfd\xf4\xe7\xbe\x1b\x21\x9d\xcb\x73\xe3\xab\x24\xdc\xf2\xa0\xc1\xf4\x00\x5f\xa8\x55\xe1\x71\x8a\x9d\x46\x6c\x3f\x07\x67\xb3\xc7\x3b\xf7\xc8\x50\x6c\x6a\x8c\x24\xca\x60\xf4\xa2\x2c\x62\xdc\x74\x5a\xf0\xc7\x20\xc3\x28\x85\x53\xac\x1a\xaf\x23\x95\xa7\xd0\xaf\x25\x31\x6a\x83\xa0\xe2\x2c\x21\x0e\xa4\x0f\x29\x9d\x9f\xcb\x09\x5d\xda\x74\x26\x6a\x98\x1a\xe6\x84\xfa\x10\x35\x66\x03\x4b\x72\xea\xe4\x97\x38\xfb\x78\x70\x0e\xfa\x58\x4c\x85\x39\xc7\xac\xbe\x57\x29\x47\x89\xcf\x63\xe0\x2a\x87\x9d\x53\x4b\xb0\x14\xc5\xad\xce\x1d\xbf\xf7\xc3\x86\x62\x6b\x58\xf1\xce\x66\x16\xe7\x8c\xe3\x14\x6f\xef\xb4\x11\x3f\x1a\x79\x50\xfe\x41\x10\xaf\x53\x72\xf4\x02\xa1\xfa\x57\x50\xfe\x8b\x30\x0d\x97\x88\xd3\xc4\x4a\xbe\xa0\x93\xb9\x77\x6d\x66\xe8\xe6\x2e\x15\x99\x39\x15\xb4\xf7\xc5\x90\x86\x65\x8d\x47\x7b\xaa\xab\xf7\xaa\x69\x89\x68\xfe\xc2\x22\xb4\x19\x4a\x46\x5e\x5c\x69\x0f\xd8\x08\x1a\x93\x37\x99\x4a\x3b\x37\xea\xb6\x83\x72\x30\xec\xb0\xcd\x2c\x8c\xdc\x8e\x37\xcd\xe8\x69\x6e\xcb\x2b\xb1\x27\x6f\x6f\x5d\xf2\xef\xc6\x21\xe4\x5e\x04\xa7\xf3\x7a\x8a\x86\xea\x89\xb8\x29\x0e\x65\x36\xf6\x22\xf6\x58\x4f\xc7\xd9\xab\x56\x25\x0a\x29\x10\xc9\xc9\x0e\xf5\x3d\xd0\xa9\xd6\x9b\x8d\xc2\xce\x6f\x67\x08\xe7\xbe\x31\xce\xd5\x5f\x88\x5e\xd8\x60\x15\x37\xae\x4b\x6e\x54\x29\xc1\x81\x1b\xaf\x59\x2f\x2b\xa3\xc3\xb2\x35\x10\x88\x09\x3e\x24\x83\xb8\x4e\x44\xf8\xdc\x83\x89\x7a\xa6\xda\xca\x74\x35\xc6\x53\xc3\xce\x79\x26\xbd\xde\xc0\x18\xfa\x67\x87\x16\xeb\xfe\x1c\xf6\x6e\x06\xd7\xa8\xf6\x07\xa4\xef\xef\x6d\xf2\x61\x29\x21\xea\x43\x6a\x5e\xbf\x76\xf5\x21\x9f\x5f\x5a\xb1\x94\xc8\x19\xf7\xa5\x15\x11\xe6\xb5\x27\xe0\x01\x2c\xa7\x97\x77\xca\x70\x7c\xda\x21\x0f\x6c\x9d\x95\x68\x9a\x28\x26\x04\x50\xa5\xf5\xbe\x8d\x97\x61\xa7\x02\xf6\xed\x7c\x22\x6e\xec\x41\x7c\xdb\x6d\x51\xa7\x20\x8b\x22\x76\x62\xdf\xe9\xca\x10\xff\x2c\x2d\xef\x1d\x36\xf4\x96\x71\x87\x6e\x5e\x25\xbd\xd7\x29\xde\x80\xae\x53\xf1\x1e\x3a\x60\xb3\x8f\xc5\x56\x01\xb7\x49\x21\xe1\x8c\x50\x82\xca\x89\xce\x19\xe8\xb0\xb7\x41\xff\x9a\xa3\x6a\xe5\x66\xa7\xa6\xee\x98\x7d\x8b\x27\x7a\xff\xc1\x18\xca\x5d\x28\x0e\x5b\xf6\xd5\x37\x03\x3a\xa7\xd6\xbb\xba\xab\x14\x08\x93\x7d\xa6\x3a\xc7\x71\xd2\x51\x66\xca\xd6\x82\x11\xad\xef\x0e\x82\xe1\xef\x3d\xe6\x67\xf3\x59\xc9\x16\x34\xa8\xd3\xb9\xd3\xfd\x23\x3c\x0e\x65\x6a\x79\xc4\x27\x8f\x30\x35\x81\xc2\xa0\x46\x6e\xce\x08\x93\x8b\x1e\x4c\x63\xee\xf2\x86\xff\x0f\xa6\x07\xae\x83\xac\xbc\x0b\xc7\x2d\x7c\x8b\x84\x86\x6a\x25\x87\x92\xf0\x95\xa0\xa8\x03\x2e\x82\x41\x3e\x57\xa5\x8b\x2d\x2e\x5e\xd0\xf7\xd6\xff\xa0\xd5\xef\x77\x15\x0f\x68\x30\x6e\x6f\x0e\x5c\x4d\xcb\xb3\xe6\x17\xb9\x1c\x2b\xc4\x98\x58\x3a\xed\xb8\xf0\xd4\xf3\xa2\x1f\x02\x79\xd6\x83\xe3\xa2\x43\xee\x24\x3f\xb7\x0b\x7f\x56\xdc\xf5\xec\x1b\xe3\xf6\xe5\x46\x6c\xb2\x61\x7a\x6a\x43\xf4\x90\x51\xae\x9f\xf8\xaa\x6c\x21\xcc\x0f\x40\x78\x8d\xc3\xa7\xb2\xf4\x58\x87\x28\xe1\x8a\xa0\x36\x82\x55\xa7\xb6\x10\x60\xbc\xe2\xca\x77\x16\xac\x1a\x73\xe8\x5f\x11\xca\xe5\x2b\x47\xc4\xa9\x22\xb2\x85\x78\x3e\x89\x2c\xd6\x61\x98\x24\x35\x6e\x2b\xda\xfb\x0c\x8f\x85\x46\x7a\xfa\xae\x61\x52\x39\x1c\xe7\x5a\x86\x04\xef\x54\xb9\x5b\x46\xe5\x2c\x45\x74\x07\xa3\x61\x17\x4f\x1d\x13\xcf\x8e\xd7\x27\xa8\x0f\x3c\x3a\xb3\x15\xfe\xda\x9b\xd6\x39\xec\xc8\xd6\x5f\x6d\x86\x58\x05\xac\x6d\x2e\x64\x6f\x1f\x2c\x65\x50\xb2\x08\x3f\x77\x92\xdb\xbd\x5c\xb3\x13\x51\x2b\xd5\x3e\x29\xce\x83\x84\x8b\x4e\x6f\xc1\x8d\x04\xbb\x36\x59\xf3\x88\x84\x2d\xc5\x31\x2f\x4d\xfe\x41\xba\x5c\x77\xce\x47\xf5\x4b\xd6\xdd\xfc\xaa\x3d\xaa\x78\x52\x9a\x6b\xcc\x3a\x92\x36\x4f\xa3\x2b\xff\x1e\x5d\xbb\x1a\x8c\x13\x34\x05\xc9\xe0\x1e\xaf\xe8\x93\x87\x9f\x85\x7a\xb2\x98\xd8\x9a\xbe\xee\x3b\xf7\xa1\xa9\x4e\xc3\x02\x27\xa8\xc3\xc0\x6a\x2a\x23\xe7\x82\x2d\x1d\x07\x98\x5d\x74\x05\xf7\xba\x19\x31\xf2\xf3\x53\xd6\x87\x94\xa2\x95\x7d\xa6\xec\xe1\x4d\xdc\x19\x15\x75\x15\x1e\x51\xf2\x20\x67\xcc\x51\x9a\x72\x20\x16\xa4\xee\x9b\xd7\x8c\xc5\x99\xee\x47\xce\x2f\xf9\xc0\x4e\xe4\xa3\xac\xf6\x64\x22\xef\x6c\xfb\x0b\x8a\xf7\x5d\xd7\xaf\xc4\xda\x74\xf5\x56\x11\x26\xad\x80\xf6\xe8\x00\x67\x86\x80\x1a\xe5\xa0\xa7\x9b\xd5\x4e\x23\x62\x26\xde\x88\x20\xe8\x94\x29\xa1\xf7\x60\x34\x7a\xe2\x55\xa3\xed\x9a\x18\x4b\x8a\x37\x0e\x19\x24\x99\xe4\xfa\xf4\xe6\xca\x12\x6e\xc0\xf0\x3b\x90\x32\x53\x19\x23\xf8\xd9\xcc\x01\xe8\xdb\x10\xbe\x1f\x6e\x24\x35\xec\xa0\x5d\xc3\xb8\x52\x3a\xcd\x47\xc7\x80\x96\x29\x45\xb3\x66\xb6\x85\x19\x1c\x13\x72\x1c\x03\xef\xf4\x1e\xd2\x24\x28\xfd\x48\xaf\x0d\xd7\x71\x9c\x63\xba\x5e\x8a\x07\x27\x8f\x09\x1b\xc3\x1f\xfb\x4c\xaf\x16\xfa\x4c\xf3\xa9\x25\xf1\xf0\xc5\xe3\x06\x6c\x0f\xc2\xe9\x9f\x65\xbc\x1a\xd3\x7d\x60\xdf\x23\x4d\xf9\x52\xe2\x7a\x40\x09\x01\x36\x4c\xa3\x7f\x29\x22\xfb\x97\xfa\x55\xaf\xd0\xe7\x5e\x88\xd5\x99\x45\x14\x22\xc6\x6d\x54\x65\xba\xa7\x45\xed\x7b\x90\x96\xfd\xb2\x26\x4a\x89\x7f\x2a\xfa\x41\xa4\xbe\x5c\xc8\x93\x35\xf6\x6e\xbe\x27\x82\x78\x0c\x9f\x62\xbb\x2b\x6e\x86\xd5\x83\x72\xd9\x72\x25\xd0\x17\x9a\xc8\x6c\x0e\x87\xdb\x43\x42\xc1\xe5\xcb\x2d\xfd\x3c\x5f\xe9\x18\x70\xa8\x52\xfe\x8a\xd4\x20\xc6\x64\x28\x56\x44\x6d\xb2\xd3\xbc\x7b\x05\x9c\x39\x95\xbc\x2a\x49\x5d\xd9\xd6\x7e\xf3\xfd\x50\x81\x57\x86\x43\x26\xd8\x2d\x4c\x86\xb4\x36\xe4\x1b\xe2\xeb\x5a\xaf\x2a\xdd\xea\xc8\xbb\x19\xc1\xdd\xf5\x39\x68\x03\xda\x03\x1d\x9d\x74\xd7\xae\xea\xe7\xe2\x97\xc2\x8b\xce\x8b\x6f\xd5\xbe\x18\x26\x2e\x12\x6c\x64\xb2\x01\x7f\x20\x90\x0c\x16\x53\x63\xd3\xdc\xc3\x70\x18\x9c\x7c\x4b\x3f\x47\x4d\xc1\x4a\x61\xec\x42\x97\x07\x62\xeb\x65\xdd\x61\x7e\xc5\xab\xcc\x54\x4c\x96\xe1\xb2\x7d\xe8\xdf\x3e\xb5\xd6\x1b\x8d\x11\x87\xb4\x1d\xf9\x4f\x69\x7d\x7b\x47\x3a\xca\xb5\x49\x7d\xd4\xfb\xd0\x5b\x40\xb9\xb8\xc6\xec\x0e\xde\x14\xe7\xd9\x2f\xc3\x11\xe0\x37\x53\xab\x92\x3d\x42\x6d\x46\xcb\xa2\x63\x98\x22\xef\x9f\xf0\x2c\xca\x6d\x1c\x33\x23\x7d\xf5\x0e\xc7\x71\x25\x1e\x8b\xd7\x70\x8e\xa7\xff\x7c\x2d\x1e\x8b\x3f\x52\x3c\xd1\xb8\xae\x0e\xd3\xdf\x7a\x26\x1e\x8b\x1f\xee\x94\x27\x30\xa8\x67\x3e\x18\xb1\x71\xdb\xe9\xef\x3f\x17\x8f\xc5\x9f\x31\x45\xb5\x93\x1a\x93\xc6\xc1\xba\xfd\xf4\x77\x5f\x88\xc7\xe2\xbd\xc3\xb5\xd6\xd2\x08\x8f\x3f\xab\xbd\xc6\x3b\x9a\x7e\xbe\x36\xfa\x57\x12\x1f\xa7\x03\x64\xfd\x21\x9e\x59\x8a\xbf\xd8\xb5\x92\x1e\xdb\xe3\xee\x94\x8c\xa7\x17\xe5\x5b\x77\xe2\x8f\xb0\x16\x7f\x95\xbe\x39\x3d\xf3\xd7\xce\x99\xd3\x73\xa5\x0e\x0b\x53\x7f\x7d\x29\x1e\x0b\xea\x49\x80\x34\xff\x73\x47\x59\xdb\x7a\x6e\xbf\xa5\x39\x31\x3c\x98\xd9\xf7\xae\x86\x75\xa2\xc3\x77\x7a\x8e\xef\xa2\x77\xd8\xe2\xff\xd4\x97\x60\xaa\x7f\x90\x46\x9d\x9e\xea\xbb\xe8\x06\xeb\xc4\xe8\x8d\xe7\xff\x63\x61\x7f\x78\x56\xae\x1a\x04\xee\x64\x8a\x67\x0a\xa5\x7a\xcf\x31\xc9\x4f\x50\x3d\xb5\xa0\xdd\xc4\x29\x9d\x28\xb5\x4f\x54\xf5\x25\x1f\xd5\x47\x14\x4f\x9d\x0a\x06\xaa\xa4\x2d\xcb\x64\x61\xce\x1c\x94\x7e\x65\x0f\xe4\xec\xac\x51\x81\x9c\x53\x30\x39\xd1\xe2\xef\x83\x88\x52\x18\x8a\x07\xa0\xc5\x87\xc6\x67\xac\x76\xa4\x86\x8f\xe3\x07\x49\x63\x51\x73\xa8\xd1\xd3\x40\xd1\xa8\xa6\x75\x9e\x7a\xe6\xee\xa4\x89\x83\x64\xb8\xa7\x1e\x4e\x1c\xc9\xb1\xf8\xe3\x07\x18\x91\x32\x71\x3e\x4c\xf0\x87\x2e\xc4\xf0\x85\xb8\xc1\x73\x2f\x5a\x5d\xdd\x22\x13\xd6\x28\xa5\xb3\xea\x53\x9d\xe6\x20\xd6\xbc\x94\xb8\x31\x45\xb3\x9c\x51\x27\x9d\xde\xeb\x1d\xf0\x06\xc0\x33\xb5\x8d\xca\x06\xec\x79\xbc\xc9\x7a\x41\x3c\x16\x95\x34\xcd\x4a\x34\x7c\x61\xa9\x78\x02\xaf\x65\x8e\x68\xe2\x17\xb1\x97\x1d\x5f\xea\xfc\x95\xd5\xa8\x5a\x35\x37\x8c\xc1\x12\x4e\x19\x7a\xb4\xa4\xa6\x15\x43\x1b\xdc\x2b\xd1\x48\xf0\x42\xd9\x6b\x4b\x8d\xe3\x46\x0b\x40\x16\xd2\x84\x23\xa0\xfd\x00\xd5\x76\x34\xce\x34\x36\x59\xfa\xb1\xa9\x69\x7b\x7a\x67\xfa\x3e\xf1\x8e\xb6\xca\xd6\xe2\x8a\x8a\x3e\x04\x9e\x79\xb7\x19\x36\xd3\x43\x03\x1f\xff\x02\x1f\xcf\xee\xef\x1b\xb7\xb7\xb5\x97\x9b\xf8\xc5\xc0\xe5\x0f\x5d\x88\x92\x8c\xaa\xd4\xe6\x25\xf4\xf2\x8f\xd0\x74\x6e\x8f\x6a\x1c\x1d\xe5\xbe\x3b\x1d\xa5\x2a\x72\xae\x8f\x4a\x23\x17\x2c\x7a\x5e\xc9\xa5\x4b\xaf\xe3\xf2\x45\x2f\x9c\xc2\xb3\x6e\x30\xe3\x56\xa8\x01\x7c\xae\x5a\xc6\xa1\x7d\x90\xf3\xcd\xcd\xea\x97\x34\x97\xbc\xb5\xae\xba\x55\x35\x35\xd3\x9f\xdb\xb5\xbf\xb4\xb3\x7b\xd6\xd1\x9f\x8f\x6f\xa9\xd7\x58\x88\xd6\xb5\xc3\x4d\x83\x3f\x22\x2c\x38\x46\xd7\x08\xea\x78\xfd\x7a\x58\xaa\xaa\xd0\x06\xc4\x1a\xeb\xf1\xd1\x47\xb9\x8c\xf0\x9d\xc2\x51\xef\x1f\xbe\xc1\x38\xcd\x38\xb2\x51\x3e\x80\x1a\x3f\x4b\x71\xa7\x3c\xd1\xf5\xfe\xad\x6b\xda\xd1\xea\x80\xe8\x83\x8f\xfb\x0c\x16\xc6\x69\xa8\x94\x8e\xda\x25\x9e\xb7\x78\x7f\x93\x9c\xa1\xaf\xa9\xef\xeb\x8d\xf7\x6e\x7f\x5c\x0a\x3d\x05\xec\xb9\x44\x45\xbf\x58\x94\x34\x44\x0e\xeb\xd4\xec\x5b\xe2\x48\x28\xf7\x6d\xe2\x1c\x8a\x67\xde\x81\x9d\xad\x72\xc6\x6e\xc1\xc4\x9d\x8d\xdc\x01\xe1\x34\x79\xc0\xe3\x62\x44\x18\xad\x5f\xbb\x3d\x0d\x4d\x3c\x4e\x7b\xc1\x16\xf5\x11\xe8\xc2\xc8\x83\xc0\x47\x8a\xae\xa5\xcb\xde\xc0\x79\x68\xbd\x8b\xd4\x82\x9e\xc3\x90\xd3\x89\xc7\x0c\xc5\x95\x93\xfd\x3e\x29\x2f\x59\x40\x16\x9e\x3d\x1d\x84\x0b\x4b\x02\x84\x33\xa2\x20\xb1\x5a\x0d\xa2\x70\xa7\x38\x1e\x48\xd6\xd0\xc9\x0e\xd1\x6b\x6c\xeb\x36\x82\x26\x2f\xe5\x9c\xce\x6d\x56\xae\xea\x97\xf0\x32\x5f\xf5\xd1\x20\x7a\xe4\xd4\x08\x88\x2e\xc0\x8a\x5a\x81\x98\x3f\xa8\xba\xec\x95\x8d\x73\x41\x7b\xc8\xba\xf2\x47\x1c\x39\xba\xc4\xd7\xef\x8b\x0b\x33\x0c\xa0\x6f\xb5\x67\xcb\x4a\xec\x32\xab\x30\xac\x45\xd1\x5b\xeb\x48\x2e\xa9\xe6\x93\x72\x52\x3d\xf3\xf5\x34\x27\x95\x6c\x5c\x67\xe3\x68\x09\x33\x88\xb7\xec\x58\x34\x20\xab\x8a\x7b\x37\x43\x57\x75\x3d\xf0\x2b\xd8\x35\x58\x56\xb4\xd6\x61\x7c\x10\x3e\x5d\x44\x30\x7f\x33\x25\x6e\x52\x73\xa4\x4f\x04\xfb\x3a\x21\x52\x4e\x36\x6b\xbd\x1c\xc8\x55\x74\x8c\x06\xf3\xd1\xfb\xae\x8d\x13\x1a\xa7\xf5\xae\x02\xa3\xbc\x00\x49\xcb\x64\xda\xb3\x6d\x56\xaa\xe4\xd1\x77\x8e\x8e\x05\x93\x1f\x62\x9c\x03\xbf\x00\x0a\x9e\x42\x20\x3b\x89\x18\xc1\x22\xfd\x4a\x28\xff\x89\x47\x3e\x9f\x62\x3f\x6b\x52\x0b\x26\x2e\x5e\xa1\xe8\x31\x1d\xd5\x3e\x79\x90\x9f\x46\xdf\xe2\x92\xcf\xd7\x42\xfd\xdc\x49\x13\x40\x51\x7e\x36\xf0\x4e\xf0\x35\xc3\xe0\xfb\x50\xd5\x3e\x38\x37\x93\xe5\x57\xee\x74\x71\xf1\x89\x1b\x37\xb9\x0e\xc9\x21\xc0\x1d\x45\x82\x0c\xf8\xc9\x60\x4c\x09\xa6\x9b\x33\xa0\xe8\x77\xa1\x4c\x87\xfb\x06\x4b\x91\x22\x96\x19\x40\xd1\x0b\x14\x7c\x3b\x3b\x69\xcb\xd0\x96\x94\x87\xfe\x9a\x78\x83\x97\xa3\x2d\xff\xce\xcd\x80\x66\x2b\xdd\x8f\x7d\xf1\xf1\x85\xbc\x47\xcc\x65\xca\xe3\x33\xf3\x32\xe8\x33\x56\xf8\x67\x3c\xf7\x91\x5a\x0f\x45\x34\x34\x3d\x2b\x11\x54\x0f\xb0\x98\x83\x34\x21\x4b\x02\xfe\x01\x9e\x77\x39\x59\x6f\x90\x00\x71\x17\x6b\xde\x57\x44\xa3\x94\xb4\xee\xd8\xfd\x40\x1f\xbe\x49\x3d\x94\x8e\x5b\xe1\xe9\x41\xca\x67\x3c\xce\x0f\x30\x3d\x16\x35\x55\x7f\x10\x4e\x76\x8b\xcc\x4b\x83\xf5\xbc\x45\xf9\xcb\xc3\xeb\xfa\xd5\xa3\x95\xb8\xba\xea\x3f\x79\x86\x9f\x80\xd8\xb8\xea\x49\x1d\x1f\x3e\xaf\x5f\x3d\xfa\x4d\x4c\xf3\x89\x8e\x5e\x13\x29\xac\x89\x06\x17\xfc\xd1\xf3\x65\x89\x2e\x3a\xc1\xdf\x38\x47\x1d\x44\xa6\x21\x82\x1f\xdb\xdd\xe5\x63\x1a\x49\x2f\xd1\xbe\x83\x8c\x94\x78\xf8\x46\x05\xe5\xe3\xa3\x5c\x46\x3e\x7f\x7b\x9f\xbf\x10\x2d\x6c\x11\xaa\xd2\x4d\x5a\x88\x67\x4f\xc5\x56\x1a\xe3\x6c\xe8\x51\x07\x6e\x60\xd2\x13\xd4\x03\x99\x32\xb4\x85\x33\x5e\xde\xf0\x95\x50\x96\xc2\x01\x2e\xf9\xb3\x99\x0c\x61\x13\x95\xb2\x62\xd7\x35\xd2\x3a\x4d\xc0\x09\x64\x48\x08\x11\x1b\x4c\xc3\xb5\xb8\x7e\x4e\x0e\x0c\xa7\x56\x61\x50\x58\x4b\x8b\x19\x3f\xc4\xd8\x77\x5e\x87\x9d\xa6\x88\x0b\xa8\x12\x07\x3a\x0e\x8b\x29\x61\x52\x96\xcb\x6d\x86\xcf\x61\xdc\x49\xc0\xfc\x97\x1d\xe0\xfd\xb7\x4e\xac\xe5\x25\x3a\xe3\x2f\xb6\x56\xf2\xb8\xa3\xf0\x54\xd1\xdd\x24\xbd\xc0\x09\xf2\x93\xab\xe5\x04\x59\xd8\xde\xa0\x75\xc4\xe4\x9f\x0b\xea\x3c\x85\x52\x7c\x24\x2f\x65\xf2\x4b\x6b\x2f\xab\x5b\x1d\x76\x09\xc8\x07\x0b\x01\x5f\xbe\xc2\xb2\x11\x58\xe6\xea\x56\x38\x7b\xf8\x45\x84\x08\x9f\x67\x5b\xb7\x72\xbe\x0d\xea\xc9\x47\xd2\xd6\xe5\xe2\x91\x45\x9c\x2c\xa9\x0c\x6f\xd0\x4e\xd4\x9a\x83\x90\x51\xa0\x72\xca\x61\xe0\x12\xd3\x4c\x23\xc5\xb3\xfb\xbd\xaa\x75\xd7\xc0\x29\x7b\x87\x8d\x97\xfb\x83\x37\x54\x49\x6f\xfb\x19\x16\x9d\x80\xb7\x3b\xd7\x19\x2e\xd1\x4d\x0c\xc4\xd1\x3b\xf3\x44\x3c\x2c\x78\x87\xb6\xb2\x51\x05\xdb\x50\x12\xed\xa1\x60\xf4\x81\x55\x3b\x6d\x6f\x85\x45\x2d\xcd\x7b\x6f\xab\x60\xaa\x41\x72\xa5\x61\xdc\x91\x68\xa4\x30\x5b\xca\x5b\x5e\x56\xf4\x1f\x1b\xc7\xa9\xf7\xc2\x75\xef\x26\xc3\x23\x1e\xea\xcd\x00\xe2\x9b\x11\xe5\x05\x37\x53\x4e\xf1\x15\xe3\x70\x1e\x51\xad\x64\x9b\x35\xff\x1f\x7b\xef\xb6\x24\xb7\x8d\xb5\x89\xbe\x0a\xee\xa4\xda\x4e\xe9\x57\xe9\xd4\x1e\xc7\x8e\x89\x28\x5b\x96\xdb\x11\xad\xdf\xde\x96\xff\x76\xf4\x25\x92\x44\x26\xd1\x45\x02\x34\x00\x56\x2a\x7d\x35\xcf\x32\x8f\x36\x4f\xb2\x03\x6b\x2d\x9c\x48\x26\x93\x59\xaa\x52\x7b\x66\xfa\xa6\xdb\xaa\xcc\x24\x41\x10\x58\x58\x87\x6f\x7d\x1f\xcb\xd1\xb4\x08\x1f\x97\xd6\x0e\xa1\x52\x08\x7f\x0e\xbf\x0e\xa2\xa6\x5a\x89\xab\xd0\x57\x58\xc9\x5a\x10\xfd\x59\x48\x3a\x17\x74\x13\xd4\xc0\x86\xf5\x5d\x54\x90\x24\x79\x71\x80\x71\x51\xd3\x74\xd1\x79\xbb\x89\x60\x2d\xae\x02\x51\x15\xdb\x0b\x25\x4c\xa2\xc0\x4a\x3c\x2a\x4e\xb3\xfd\x00\x12\x53\xd0\x96\x29\x2b\xe8\x25\xaa\x1a\xde\x6d\xb1\x3b\xaf\xd2\xc6\xc8\x5a\x9b\x08\xa1\xc5\x4b\x2a\x1d\xa9\xaf\x46\x49\x30\x58\xbf\x23\xca\xec\x90\x1d\x9d\xf0\x5f\x51\xb9\x79\x2f\xef\x04\xf0\xa7\x6b\x53\x47\x4e\xe5\x98\x55\xcb\xd1\x6b\x58\x03\xf1\xb3\x10\x84\xab\x2d\x73\xdc\xde\x82\xe5\xd3\x5d\xdf\x0a\x77\xd6\x91\xc8\x57\xc7\x74\xf1\x17\x36\x7a\x43\xb6\x16\x4b\xf9\xd2\x11\xcb\x85\xde\x8a\x23\x84\x06\x89\x01\x0c\xe6\x06\x72\xbd\xf8\x30\x90\x1b\xf1\x0e\x94\x54\x8e\x90\x79\x79\x4d\x28\x8e\x01\x64\x5d\xa8\x09\x30\xdd\xf5\x18\x60\x11\x53\xeb\x50\xfe\x3c\xa3\xd4\x09\xb5\xef\x97\xaf\x9f\x35\xd4\xe7\x27\x75\x1d\x39\x76\xfc\xc3\x26\xa0\x26\x5e\xcd\x3b\x50\xfe\x38\xb5\xe5\x0c\x00\x04\xb2\xb0\x38\x45\xc6\x03\x81\x16\x27\xb6\xe0\x86\x19\x9e\x92\x55\xf8\x2d\x3f\x55\x4a\x1c\x80\x10\xe5\x81\x93\x21\x7f\x39\xa9\x0e\x54\xc5\xdb\x43\xba\x3e\x3c\x6a\xf9\x90\x90\x0a\x04\x33\x68\xcf\xc7\x81\x05\xd7\xd8\xbd\xee\xe6\x3d\x01\xbc\x1b\xf8\xe1\x07\xcd\xf6\x0d\x2a\x63\x1a\x76\xf0\xf6\x7e\xc5\x28\x16\x04\x91\x56\x0d\xc2\xca\x4f\x34\x86\x20\x3a\x38\x1e\xc3\x26\x0c\xae\x1b\xba\x4e\x0a\xbb\xde\x71\xd0\x86\xbd\xc3\xe4\xdc\x09\x67\xf3\xfa\xb3\x6b\xbf\xf7\x6a\x47\x91\x3b\x5c\xc2\x09\x32\x4c\x42\x10\x07\xef\x54\x48\xf4\x03\x41\x15\x49\xee\x42\x7a\x11\x0d\xeb\x03\xb9\x03\xe0\xbc\x9e\x70\x02\x08\xcb\x5d\xc5\x29\xa4\x01\xe0\x48\x97\xf6\x0b\xcd\x7a\x0e\x53\xc7\xbf\xc4\xfa\x43\xe6\xe6\xa2\x5f\x88\x4f\x5f\x64\xac\x51\xb9\x42\xf7\x22\x73\x78\x9f\xb3\x9b\xd6\x09\xa3\x80\x90\xb1\x3d\x6e\x32\xa0\xea\x8e\x43\xe8\x6a\x01\xf4\x81\xe5\xd3\x57\xa7\x64\x96\x36\x59\xb3\x1d\xd4\xad\x3f\xf5\xda\x8a\x3a\xf4\x8a\x65\x28\xe9\xc5\xf2\x5f\xbe\xa4\xbe\x09\x1d\xf9\xf0\xa7\xb9\x07\x8d\x08\xec\xc9\x53\x8d\x25\xa0\xca\x47\x3c\x66\xd7\xdd\xe9\xfd\x99\x47\x7b\xd4\xa4\xee\x4b\x35\x93\xd4\x3d\xa6\xf7\x9b\x2d\x92\xeb\x17\x91\xc5\x83\xb7\x93\x69\xc0\xad\xec\xed\xbd\xfc\x43\xe4\x35\xcf\x39\x25\xea\xcd\x42\xe2\xf7\xda\xae\xa3\x56\x84\x5d\xb6\x92\x9a\xec\xc7\x0b\x62\x87\xd5\x1b\xdf\x49\x75\x4c\xd5\x83\x8e\xbb\x0c\xfc\x8d\x5e\xda\xb1\x8f\x33\x21\x9d\xe8\x60\x5e\xfb\x96\xab\x24\xcf\xb9\xb0\xe9\x3f\xf6\xa2\x02\xf5\xc8\x73\x99\xe2\x33\xf9\xa8\x53\x05\xaa\x01\x6a\x97\xb6\x47\x8b\xd4\xf0\x5a\x1f\x52\x5f\x7f\x2c\x57\x7e\x84\x0f\x76\x02\x9b\x41\x62\xa6\x4a\x69\xd5\x22\x04\x8b\x50\xb7\x7a\xc7\xee\xc4\x5e\x00\xe4\x2d\xcc\x45\xb1\xf7\x9f\xa1\x9a\xdc\x5e\x6b\xef\xc7\x19\xdd\x8b\x0d\x60\x4c\x23\xe5\x23\xb2\x4d\x10\x6f\xc4\x0c\xc0\x23\x5b\xca\x69\x24\x9d\x44\x6f\x13\x47\x61\xa3\xb3\x09\x11\xd7\x86\x55\xe6\x68\x41\x5b\x4a\x1b\xd6\x09\xc7\x09\xaf\x42\x63\xc6\x6b\xd4\x11\x15\xaa\x34\x76\x68\x05\x8f\x22\xcf\x99\xa5\xaa\x0b\xfd\x38\x42\x49\x77\xde\xbc\x6b\xd3\x21\xfd\x68\x98\xc0\x98\xb8\x03\x17\xc6\xfa\x50\x1d\x1d\xa8\x73\x2e\x63\x78\xdb\xac\x16\x7d\x60\x5e\x48\xb7\x7d\x92\xb8\x17\x62\x7e\x3e\x60\xfc\xd1\x2d\x05\xab\xe7\xd7\x63\x88\x32\xc2\xf7\xed\x86\x85\xde\x39\xe0\x09\x14\xd6\x25\x74\xec\x12\xf7\x53\x78\xa4\x67\xec\x1d\x7d\xfd\x1b\x36\x06\x75\xfd\x7d\xfc\xee\x9f\xb1\x6b\x56\xf3\xe3\x14\xfc\xa5\x95\xf8\x0f\x7a\x2d\xfe\x4b\x2f\x43\x89\xb5\xfc\x1e\x40\x17\xf5\x60\xf1\xad\x59\xb8\x1c\x22\xa7\xca\xef\xfd\x20\x3a\xf8\x30\x47\x48\x17\x5f\xb8\xa9\x79\xc7\xbd\x57\x2f\xfe\xe3\x83\x74\x8d\xc1\xbb\xa6\xbd\x7f\x12\x52\x60\x83\xd7\x9d\xb8\x2e\x68\xb9\x94\xb2\x11\xd0\xa0\x4b\x6d\x70\x94\x36\x9c\x61\xc7\x00\x50\x82\xcd\xb8\x6b\x20\xc2\xe0\x72\xb1\x3e\xff\x88\x12\x61\x27\xec\xf2\x03\x68\x83\x01\x2f\xbf\xde\xb1\x0f\xbc\x56\xc2\xae\x2b\xf8\x7f\x7f\x09\x75\x64\x08\xaf\xbf\x58\x29\xe0\x5b\x30\xaf\x27\xb8\x24\xef\x51\x20\x98\x31\xc8\x3f\x29\x11\x13\x2a\x63\xae\xc7\x65\xe6\x85\x69\x36\x7e\x86\x46\x0f\xb0\x0e\x90\x8b\xf1\xf1\xb6\xe9\x22\xdb\xf4\x0c\x4c\xfe\xb7\xd8\xc6\x48\xec\x34\xde\xae\xd0\x8f\xc0\x8b\x92\x96\x1d\xf8\x71\xc3\x38\x73\x07\x69\x1d\x90\x66\xd3\x3b\xff\x27\xdf\xef\xfd\xd7\x8c\x1f\x08\x11\x7d\x6b\xe4\x37\x6c\x22\xcd\x2c\x67\x1d\x2e\x0d\xb6\x6f\xf5\xc1\x06\x02\x44\x71\x5c\x8e\xd8\x88\xa1\x00\xc6\x41\x23\x83\x67\x1f\x35\xaf\x50\x94\x4a\x90\xb1\x2c\xfd\x13\x2a\x00\xd8\x99\x96\x13\x78\x9e\xa6\xee\xcc\xb0\x10\x94\x5e\x88\x2f\x23\x65\x8e\x08\xb2\xf7\x6b\x9a\xb1\x0a\x9b\xa3\x13\xcf\x0f\xcd\x01\x14\x20\x64\xd1\x1e\xac\x74\xd1\xae\x25\x01\x23\x02\x7e\x6a\xc2\x75\x59\xa2\x0d\x5d\x66\xd3\xc5\x12\xe8\xb0\xb5\xe2\xf7\xc1\x5b\x9c\x2c\xd3\x15\xe7\x69\x84\x91\x9c\x66\x0c\x42\xab\x65\x60\xa3\xcc\xa1\x9c\x05\x3f\x90\x2b\x9f\x96\xe6\x73\x6e\xe9\xf1\x59\xaa\xd1\xf8\x36\xe6\x4a\xaf\x73\x1c\x9b\x4b\x16\x67\xb0\xbc\x86\xf2\xc7\x07\xae\x5c\xbb\x4e\x55\xfe\x22\xf2\xa0\xfb\x71\x69\xdf\xc7\xd6\x04\x86\x81\xdf\xb8\x59\x2c\x3c\xfc\x55\xb7\x47\xd6\x03\xd3\x9a\xe1\xc0\x8d\x45\x4d\xe1\xd8\x4d\x0e\x75\xf2\xc1\xf0\x40\x23\x1b\x62\x0b\xec\x10\xda\x30\x7e\xe0\xb7\x02\x9a\x80\xb6\xba\xc5\xcd\x28\x55\xa2\xd0\xcf\x92\x66\xf3\xc0\x21\x88\xa5\xfc\xf5\xb1\x9b\x14\xee\x02\x4c\x57\xb9\x18\x08\xd0\xb6\x05\xd6\xea\xf8\x93\x0d\xae\x01\xa5\xd5\xb3\x71\x8e\x2e\xff\x1a\x7b\x8a\x7d\xf8\x54\xdd\xbf\x62\xb5\xe0\x50\x7e\x27\xa0\xe7\x75\xfd\x1a\x9f\x5c\xb9\x50\xa6\x0b\xb5\xc2\x26\x70\xd0\x30\x5e\xe2\x2c\x67\x98\x02\x5f\xaf\x29\x6e\xa1\xa4\xc7\xa0\xea\xe9\x69\x36\x97\x65\x78\x50\x5e\xaa\xcf\x4b\x0a\xe0\xd1\x35\xa6\xaf\xf8\x9b\xdc\x89\xab\x31\x49\xf2\x82\x6a\xc7\x4d\x99\x12\x47\x56\x64\x23\xc0\x5e\xfa\x58\x60\xc0\x0c\xee\x2e\x57\x70\x88\x20\x83\xeb\xfa\x6b\xf6\xd5\x69\x80\x06\xeb\x74\x2d\x77\x52\x18\x4a\x1c\xce\xa1\x19\xb4\x82\xe4\x29\xaf\x31\x4b\xac\x80\x4b\x60\xb9\xa9\xfe\x71\xe2\x62\xbf\x38\x1b\xc1\xdb\xa9\xee\x5e\xfd\xf5\xda\x88\x36\x15\x64\x91\xa3\x72\xc5\x02\x7c\xc7\x15\xa0\xd6\xa0\x11\x67\xba\x06\xe7\xa0\x15\x17\xad\xc1\x15\x1e\xd5\x86\x7d\x78\x0c\xa7\x6a\x1a\xc5\x9e\xc4\x4e\x5c\x1c\xe0\x16\x09\xaa\x1d\xd2\x79\x9b\xaa\x79\x66\xe5\x1f\xa2\x46\x92\xa4\x71\xb1\x35\x51\x4a\x77\x81\x76\x08\x0a\x17\xa6\x6a\x84\xdd\xb0\x96\x7b\xe3\xa6\x30\x6b\xb9\x0f\xea\x32\x66\x4b\x7e\x7d\x03\xc7\x67\xb0\x61\xd2\x9c\xe8\x41\x8c\x41\x6d\xa5\xbb\xad\x8c\xaa\x80\x83\x09\x63\x02\x90\xb5\xf7\x02\xc2\x2d\x80\x41\xa9\x3d\x26\x0f\x31\x90\x29\x51\x4d\xce\x3f\x11\x58\xda\xaa\x11\x77\x44\xe1\x50\xd2\x10\xf8\x65\x89\xb4\x50\xb6\x11\xb5\xcd\x68\xa2\xa8\x5b\x24\x3f\x1e\x16\xf7\xd5\x5c\x85\x6d\x86\xfe\x05\x6a\x46\x80\x2f\xc2\x67\xc2\xb7\xf0\x36\xb6\x93\x7b\xb3\x21\x0e\xcc\xf6\x7a\x2c\x75\x75\x43\x03\x0b\xf1\x75\xe8\x54\x4f\x35\xb5\x10\x6e\x11\xcf\xd5\x5c\x54\x16\x3c\x4d\xfc\xca\x41\xaa\x5b\xcb\xf4\x40\xb8\x28\x26\x3e\x55\x50\x22\xcf\x71\x60\xa3\x4c\xdb\xd2\x66\x34\xb7\xeb\x03\x9b\x4b\xb6\xe1\x59\x88\xd3\x07\xf6\x74\xcb\x1d\x0b\xea\xfb\x29\xf5\xdc\xc3\x46\xd1\x26\x65\xa3\x2a\xcd\xdb\x07\xa7\x41\x22\x5c\xc4\xc7\x03\xef\xfa\xab\xfb\x44\x44\x97\x00\xa3\x3e\x10\x50\xba\xa6\xf9\x66\xb6\x37\x82\xd7\x51\xa5\x1e\x55\xc6\x32\x57\xba\x08\x8b\x00\x21\xe1\xa3\x73\x76\xfd\x26\x5f\xdb\xcc\xf6\xd8\x84\x30\xd9\x9a\xa8\x18\x36\xba\x17\x47\x8c\x46\xa5\x8d\x02\x86\xce\x9b\x11\x41\xbf\xff\xc1\x9d\x04\x6e\x69\x54\xd3\xf0\xf1\x59\x6a\xe1\x80\x56\x73\xbc\x24\xae\xc8\x0c\x00\x1e\x58\xda\x40\xe4\xa7\x6d\x87\x4e\x2a\x14\x12\x3e\xc3\x51\x06\xa0\xcd\xf1\xb3\x4b\x88\xb3\x52\x87\x7c\x20\x67\x6d\x74\x8b\x34\xf8\x41\x35\x0f\xf8\x26\x50\x2a\x17\x2a\x14\x1a\x31\x52\xc4\xee\x43\xc4\xfc\x61\x16\x44\xc7\x55\xf2\x2c\xb3\x54\x13\x0a\xed\x44\xcf\x4f\xba\xe7\x80\x3c\x6a\x05\x50\xb4\x02\x9f\x63\xac\x4b\xeb\xc1\x54\x31\x0b\x1a\x2f\x1d\x24\x75\x74\xcf\x7f\x1f\x12\xf9\x5b\x48\xdf\x79\x1b\x73\x68\xb1\x74\xd2\x88\xb6\xdb\xb0\xad\x5f\x49\xb6\xb8\xc8\x99\x89\x8a\xdc\x56\xc1\x28\x10\xc7\x09\x44\x39\x2d\xef\xd3\x20\x02\x9b\xde\xc4\xa0\x64\x90\xd4\xc2\x1f\x20\x94\x6b\xf2\x8a\x11\xec\x4c\x3f\x8c\x66\xcf\xbf\x96\x48\x01\xba\xd6\xb6\xe0\x72\x7a\xf0\x3e\x89\x55\xfc\xa7\x54\x1f\xca\xe9\xd4\x80\xf4\xc9\x2f\x0f\x0d\x5d\x60\x7e\xb6\xf6\xcb\x79\xeb\xe5\xa6\x89\x42\x21\x6e\x7d\xaf\x23\xb8\x9c\x1c\xf0\x08\xc0\x49\x1c\x19\x31\x34\xdb\x9b\xc0\xe6\xeb\x8f\xdd\xc4\x6c\xef\xb7\x62\x20\x9f\xe1\xe6\x16\x29\x92\x5d\xa1\x2c\x91\x5e\x5b\x64\x3b\x4f\x1b\xda\x9f\x17\x70\x50\xa1\x45\xd1\xbb\x70\x7c\xad\x7b\x93\x47\x58\x02\x0f\x1f\x8b\x7e\x0e\x10\x76\xb1\xfb\x74\x1a\x25\xa0\xdb\x55\x52\xdc\xfd\x60\xb8\xb5\x2d\x57\xf5\xd5\x24\x72\x48\x6f\x73\x36\x86\x78\x5b\xa8\x49\x06\x43\x1c\xb7\x5d\x30\xb9\x7e\xda\xd7\x98\xf8\xd0\x91\x81\x50\x15\x3b\xa5\xc2\x1c\x7b\x3a\x3b\x5a\xbe\xa9\x84\x35\x79\x9f\xa7\x49\x21\x9b\x5c\x9b\xf3\xe1\x4c\x6d\xf0\xc9\xa4\x9a\xb1\xb2\x64\x9e\x56\x9b\xda\xd8\x65\x51\xfe\xfe\x5e\x36\x16\x06\xf6\xe0\x06\x36\x9a\xff\x07\xb1\xb1\xf1\x6a\x17\x9b\x59\xc1\x5d\xe3\xbd\x93\x29\xf0\x70\x8e\xc5\x72\xb6\x3f\xe4\xc1\xc3\xf9\x75\x64\x29\x61\x73\xc2\x23\x5c\x4d\x42\xfa\xb8\x23\x57\x4a\x1c\x45\xc3\x1a\x8d\xa0\x5f\x6f\xd1\xa2\x72\xd6\x09\x6e\x49\xfa\x92\x7a\xca\xa2\xdc\x6d\xed\x87\x30\x21\xed\xc9\x12\x83\xa8\x5f\x05\x3e\xea\x58\xdf\x11\x96\x9f\x11\x76\x68\xb1\x99\x0c\xe3\xae\xc4\xd1\x93\x92\xcf\x44\x8c\x18\x65\x22\xaf\xd3\x65\x66\x45\x79\x42\x51\x8c\x40\x7a\x96\x59\x27\x51\x08\x37\x27\xaf\x2f\xd3\xdb\x76\x80\xad\x21\x00\x38\x97\xa8\x57\x89\x23\xd8\x3f\xc4\x2d\x5c\xc3\x4d\x08\x7e\xfd\x02\xf7\x06\xab\xa6\xa4\x40\x7a\x02\xee\x62\x2f\x86\x8d\x4f\xa1\xc4\x1e\x56\x6f\xde\xc9\x9c\x84\x7f\xea\x31\x90\x7f\xdd\x5a\x6e\xf9\x51\xd4\xec\x5b\xe8\x4b\x7e\x2f\x8d\xd8\xf2\x99\xbe\xa7\xbf\x7c\x76\x8e\x60\xa5\x6e\x33\x94\xc5\x05\xdb\x12\x72\xd1\xc7\x2b\xfb\x81\x2b\x8d\x26\x79\x68\x77\xc3\xc5\x34\x42\x67\x32\x09\x2b\x5c\x88\x1b\xb6\x15\x1c\x22\xe6\xa3\x00\x50\x1f\x9d\x08\x2d\xb2\x62\x86\xc4\xa9\xc1\x75\x05\xb2\x41\x12\x4f\xb4\xa0\x0c\x50\x0b\x65\x11\x16\xd8\xc2\x27\xc8\xe2\x0b\xc7\x82\x9a\x51\x6c\x26\xf5\x50\x8a\xdf\xb7\xfe\xdd\xcf\x55\x5a\x66\x88\x6c\xc8\x05\xdb\x0a\x6c\x63\x0e\x60\xb9\x34\x2b\x78\xd6\xe9\x5b\xa1\xa8\xaa\x13\xbe\x17\x30\x9e\x4e\x43\xb2\x5d\x3a\xea\x03\xf1\x37\xdf\xb6\xda\x5a\xdd\xc5\xb4\xa8\x9f\x03\xa3\x51\x1e\x11\x97\xfc\xa7\xbe\xd5\xe8\xf6\xec\x72\xc6\xef\x53\x81\xd0\x8c\x5a\xf4\xcb\xd9\xf3\xfd\x21\xd5\xa1\xb3\x26\x92\xb2\x89\xc9\x69\xc7\x5b\xc6\xab\x6a\xe8\x40\xc8\xa2\x7e\x10\x11\xe9\x95\x4d\x22\x5b\x6e\xe3\xa8\xa4\x65\xd7\x2f\xeb\xb7\x28\xe8\x57\x14\x3e\x62\x8e\x24\xbd\x14\x4c\x72\x3a\x76\x04\x96\x41\xa7\x7d\xac\x15\x43\xb0\xb9\xc6\x93\xb7\x2b\x42\xc3\x62\xcd\x49\x8b\x06\x1e\x24\xe1\x22\x7a\x13\x9a\xc7\xee\x38\x66\x59\xc5\xa7\x5e\x9a\x70\xdb\xcc\xad\x1e\xaa\x06\x61\x69\xab\x5e\xd5\x7c\x2f\x7f\xd6\x2e\x9b\x49\x78\x6c\xa0\x18\x1e\x9c\x16\x18\xa7\x5f\xb2\xd0\x9f\x28\x15\x2e\xbe\x55\x6c\x78\xa0\x10\x03\x35\xa6\x78\x21\x34\x18\xaf\x03\xad\xef\x6f\x54\x11\xc0\x26\x62\x5b\x94\xf6\x40\xa4\x44\xb7\xb2\x8e\x8e\xd1\x78\x2b\x06\x8b\x0c\x57\x86\x0d\x52\x9f\x2f\x51\x66\x6b\xd4\x46\x54\x4a\xce\xd1\x09\xd0\xbf\xbd\x92\x10\x57\xb7\xbc\xeb\x00\x35\x11\xbe\x19\xa8\x1e\x4e\xfa\x8d\x8f\x9a\xf4\xfe\xfa\x14\x5e\xa0\x58\xe3\xa3\x45\xb9\x90\xf8\xfe\x4b\xa9\x74\x86\x89\xef\x97\xab\xba\x78\xdf\x89\x4e\xf6\xb3\x7a\xb9\x73\xa4\x79\x0f\xd7\x49\x78\xdf\xe8\x69\x65\x17\x46\xc0\x4f\x21\xde\xea\xc8\x6a\x0d\xf1\x82\xdf\x3d\xad\x37\xb7\xb0\x20\xed\x60\x76\xfc\x5c\xe5\x9f\x32\x56\xfe\xf7\xd2\x12\xb3\x74\x6a\x16\xe2\x70\xcc\x51\x7e\x38\x93\x59\xd7\x25\xcb\xc8\xa0\x1a\x10\xf1\x17\x41\xf8\x56\xf7\x42\x45\x23\xe4\xaf\xdd\x82\xf9\x47\x12\x9e\xf0\x4a\x72\xb5\x76\x94\x12\x83\xc6\x5c\xd1\xf5\xee\xc8\x8c\xd6\x5d\x24\x0a\x90\x54\x7f\xaf\x65\x27\x94\x85\x33\x1d\x38\x4c\xf4\x2e\x8a\x2f\x00\x52\x6b\x81\xd5\x0d\x46\x91\x84\xc3\x29\xb3\x5b\xf4\xc6\x47\x9e\x53\x1b\x78\xd2\xe1\x87\x71\xb8\xd8\x98\xcf\x9c\xf1\xd7\xa8\x03\xfb\x00\xc9\x13\xc0\xf5\x21\x1f\x9f\xa9\x93\xa7\x50\x2c\x91\x1f\x2f\x6c\x3c\x38\x0d\xc1\xfd\x5d\xec\xed\x4f\xe8\x39\x71\x48\xc3\xa3\x33\xe9\x2e\x40\xb3\xb2\x85\x51\x69\xa5\xc0\x13\x2d\xa7\x3f\xc1\x33\xeb\x70\xa0\xf7\x46\xdc\x01\x5e\x2a\x94\xd7\x8a\xc0\xec\x39\xbb\x89\x51\xaf\x37\xc0\x84\x41\x88\xba\xb4\x2a\xf9\xfe\x95\x56\x4e\x28\x24\x83\xcc\xef\x59\x44\x6c\xb3\x37\x81\x8f\x54\xa9\x34\x3c\x92\x4b\x58\x7a\x40\x60\xb5\x8b\xb7\x23\x8f\x79\x9d\x07\x8c\xf5\x40\x79\x27\xd8\x6f\xfc\x6e\x9d\xc0\xf6\xc5\xe5\x7f\xf6\xb4\xac\xa1\x9f\x64\xd9\x7c\x08\x14\x77\xae\x30\xb0\xdc\x7c\x48\xf4\x18\xa9\x9f\x70\x93\xe0\xe7\x9c\x6d\x07\x83\x64\x45\xb5\xbc\xf3\x5e\xb9\x50\xc2\xec\x8f\x24\x2c\x2a\xfb\x1e\xf8\x32\x07\x97\xd8\x6f\xa0\x64\x5f\xfa\x76\xd3\xf4\xcb\xab\xb2\xbd\x69\x8a\x40\x3a\x29\x05\x18\x5b\x82\xdf\xd4\x6f\x99\x6b\xb0\x23\x26\x32\xd9\x5a\x76\x20\x40\x9d\xff\x38\x14\xf7\xb5\x61\x4a\x54\x46\xbb\x24\x9b\xfd\x34\x83\x47\x51\x6f\xed\x56\x8c\xd8\x82\x0a\xef\x11\x3c\x59\x1c\x61\x04\x24\x15\xe3\xca\x88\x75\x47\x8e\x61\xe2\xd7\x3d\xc9\x46\x04\x67\xd9\x9b\xfa\xed\x57\x6f\x56\x9e\x6c\x40\xcd\x02\x8d\xbb\xfe\xea\x3f\x68\x3d\xcd\x43\xcc\xe1\x0a\xde\x3d\x10\x62\xe5\x1e\x00\xb9\xb5\x02\x19\xa7\x17\xeb\x34\xf9\x1a\x2d\x10\x76\xd2\x61\x16\x0f\xf8\x1d\x84\x09\xdf\xa9\x44\x2b\xac\x93\xbc\xdd\x30\x11\x5a\x9e\x37\x6c\x27\x8e\x1b\xb6\x93\x42\x21\x78\x98\xb0\x01\xb3\xab\xb3\x58\x56\xd8\xd6\x36\xee\xda\x03\xad\x19\x7f\x00\x7e\x44\xe8\x71\x00\xe8\x4f\xc7\x46\x6c\xb0\x7a\x97\x67\xd8\xa6\x1b\x22\xea\x1c\x6e\x85\x50\x8c\xea\x3d\xa8\x86\x6f\x45\x85\x5c\xfb\x08\xab\xa7\x7f\xe5\xe4\x32\xde\x56\xfa\x33\xd8\x01\x5b\x5b\xa7\xad\x63\x5b\xf0\xfc\xfc\xd1\x47\x8a\x5b\xd0\xc3\x8b\x8b\xd1\xbb\x60\x91\xf4\x8c\x40\xcf\xd7\x2c\x64\xef\x2b\xdd\x75\x5a\x21\x86\x16\x00\x7b\x7e\xb4\xb6\xa1\xb1\xb6\x00\xc9\xd3\x86\xbd\x8a\xc3\x0f\x87\x72\x2d\xcd\xba\x34\x37\xae\x65\xa8\xd5\xdd\x63\x0d\x1b\xe9\x06\xde\xfe\xf7\x7f\x7c\xff\xf1\xff\xfd\x0f\xfa\xef\x3f\xdd\xd2\x9e\x87\xd0\x00\x08\x81\x9f\x04\xd1\xac\x2a\x6c\xcc\x6f\x09\x0b\x9c\xcc\x48\xcd\x22\xac\x50\xa1\xbb\x60\x2f\xab\xf9\xf5\x16\xdb\x25\xf1\x97\xf8\xcd\x02\xa9\x79\xcc\x74\x05\x47\x10\x40\xef\x55\x42\xe4\xa6\x1c\xc2\xae\x28\xd0\xf7\x0e\xd6\x9d\xb4\x72\x9b\xc3\xb3\x26\x7c\xf2\x28\x83\xe0\x97\xfb\x16\xdc\x26\xb8\x77\x92\x35\x6a\x05\x37\x64\x6c\xe1\x9d\xc7\x07\x01\x0f\x84\xab\x45\x5a\xe4\x4b\xb6\xc4\x9f\x6d\x3f\xfc\xac\xa5\xd5\xd8\x19\xff\x0e\x25\xb9\xbf\xb4\x81\xa7\x5c\xdc\x51\x1c\xfc\x63\xed\x1e\x4d\x11\x69\x35\x86\x6c\x7e\xb1\x63\x60\x33\x59\xf0\xc0\x6c\x46\x12\x60\x90\x7a\x86\xf9\xb4\x1b\xfa\x0f\xf0\x73\x53\xef\x34\xea\x9e\x59\x8c\x49\x4f\x6c\x11\x60\x44\xf2\x4e\xbe\xac\x85\x72\x72\x87\xd4\xd8\xb7\x12\x93\x33\x78\xd9\xb9\xcb\x6f\xf0\xed\x47\xa9\x76\xec\xdf\xe7\x76\x5d\x76\xe8\x7f\xc3\xa5\xfb\x6b\xe3\x03\xc3\x19\x94\xd9\x5c\x01\xfa\xe1\x17\x6c\xa5\xfb\x5e\x10\x8e\xe5\x81\xf3\xc4\x13\xc4\x59\x28\x91\xfc\xe0\xdf\x35\xfb\xa9\xad\xd9\x4f\x4a\x2c\xa9\x77\x9d\x5e\xc3\x06\xb2\x58\x8d\x5f\xc6\x38\x7d\xf0\xca\x82\xd8\x6f\x42\xd3\x9e\xe0\x7a\xc7\x98\xd6\x8e\x19\x71\x93\x00\xc2\x9c\x62\x44\xba\xfb\x4e\x57\x03\xfd\x18\xf4\xd8\xc1\xf4\x90\x7a\x6c\xe1\x03\xcf\xe4\x12\x66\xce\x92\xc2\x41\xca\xdc\xff\x06\xb5\xe8\x0a\x89\x76\xbd\x63\xaf\x92\xe8\x33\x30\x99\x23\x13\x88\xed\x05\xbf\x85\x61\xb4\x5c\xed\x87\x58\xd7\x29\x3b\xe2\x43\xc9\xf2\xbc\xb0\xa2\xf4\x5e\x60\x7b\xa4\x33\x05\x66\x22\x66\x49\xd2\x94\x17\x77\x78\x86\x74\x07\xc0\x7e\x6b\x5d\xf0\xfb\x61\x86\x24\x25\xa3\x3b\xed\x7d\xca\x52\xd0\x2c\xcd\x6b\x90\x3e\x6a\xe4\x8e\xf4\x49\xb8\xf3\xc1\x31\x9d\x9d\xe3\x60\xf7\x89\xcd\xc6\x62\x72\x02\xb6\xde\xe8\xad\x00\x25\x19\x61\x12\x87\x2a\x52\x36\x94\x5a\xfa\xf1\x30\xcf\x7f\x52\x54\xc4\xf2\x74\xec\x0c\xde\x3e\x10\xab\x01\x5b\x19\x3e\xca\x1e\xbb\x67\x2d\xe1\xfd\x28\xd6\x36\x40\xfd\xef\x7f\xf7\x14\x0f\xe3\x2b\xa4\x6d\x13\x9d\xa6\xf2\xb8\x75\x40\x2a\x0c\x85\x9b\xd8\x9f\x07\xd3\xd6\x6a\xdd\x85\x9c\x93\xcc\xa6\xf5\x69\x6c\xbf\x8b\xdf\x97\xa0\xa5\x6b\xa4\x40\xb1\xb4\x0d\x6b\xf5\x9d\xb0\x94\xf3\x70\xc2\x5e\x85\x01\x87\xf8\x6c\xd2\x02\xc0\xbe\xc7\xd7\x00\xae\x4c\x36\x11\xde\x3f\xb7\x69\x5d\x73\x03\x64\x76\x5b\xc4\xe6\xd2\x43\x76\xc0\x9f\xcf\x41\x23\x2a\x72\x4f\xdf\xe3\x7d\xce\x64\x9e\x47\x7d\x27\x79\x8b\x47\x6c\x30\x19\x6d\x16\x64\xac\x83\x0c\x8b\x75\xb1\x07\xc7\xcc\x7d\x49\x2e\xcf\xc9\xc2\x76\xf9\xff\x06\x1f\x31\x69\x65\xd9\x9d\x30\x5b\xd8\x33\x48\x34\x2d\xea\xa8\x1d\x44\x8d\x1b\xe1\x71\x20\xfd\x03\xdf\x04\xd5\x4c\x7c\xd6\x28\xb6\x05\x8f\x15\xe7\xc1\xea\x82\x04\xc5\x66\x5c\x1e\xed\x91\x4a\x9e\xa0\x72\x99\xc4\xd7\x41\xe6\xd3\x09\x63\xf4\x7e\x95\x8a\xea\x42\xd7\x67\x8d\x87\xd4\xd8\x3b\xf6\x4b\xed\x36\xc7\x05\xd9\xb0\x87\x11\x92\x77\xda\xf0\x6a\x33\xb6\xbb\x44\x74\x72\xca\x57\xe1\x06\xb8\xa4\x4d\xda\xea\x27\x0c\xe9\x29\x27\xa0\x3c\xff\x5f\xc6\x1f\x19\xc8\x1e\xbf\x84\x03\x1f\x19\x77\xa0\xf9\xd0\x9f\xf7\x79\x1f\x52\x3c\xe9\xe7\x5c\x80\x80\xe0\xc8\x04\xf3\xfd\x0c\xd0\xac\xf1\x11\x78\xf1\xac\x2d\x8f\x38\x96\xd3\x46\x7d\xb9\x29\xa9\x0a\x85\xc9\xd9\xb7\x36\x26\xa3\x1c\x87\x2b\x70\xa8\xe6\xab\x2f\xd6\x50\x8d\xb0\x91\x84\x36\xf6\xcb\xce\x49\x12\x05\xfd\xb5\x62\x35\x44\x49\xb4\x50\xdc\x42\x88\xc0\xc9\xae\xfb\x05\x9f\x29\xa4\xb5\xd9\x3b\xad\xd7\x49\xa2\x5e\x52\xa9\x78\x73\x46\x7e\xe0\xe1\x7a\x43\xd8\x53\x80\xd3\x0b\x73\xcc\x71\xbd\x7f\x17\x6a\x2f\xb8\x82\x24\xdb\x49\x54\xef\x29\x60\x89\x68\x45\xaf\x0d\xda\x59\x68\x9d\x4b\x55\xf6\x40\x1f\x13\x5d\x7c\x30\xbe\xc7\x90\x6c\x9f\x62\xc2\xc1\x7b\x37\x86\xd4\x73\xc5\x27\x5e\xb9\xf6\x48\xbb\x58\x3b\xe0\xcc\x85\x12\xc6\x8f\x89\x71\x96\x72\x35\x99\xc7\xb3\x09\x4c\xff\xf0\x87\x3b\x69\x07\xde\xca\x3f\xd0\xc3\xcf\x3f\x09\x8b\xc8\x9b\x67\xe0\xa2\x52\x7b\x1f\x01\x38\x1e\x22\x93\x4c\x4f\xda\x46\x21\xd1\x20\x20\xe7\x0c\x87\x73\x36\x52\xc1\x6b\x13\xf8\xc5\xe9\xd8\x57\xda\xb8\xe6\xe0\x57\x30\x60\x08\x5e\xbf\x79\x56\x8b\xbd\xf1\x71\xb8\xda\xb7\x62\xc3\x82\xe8\xc4\x1a\x33\xb9\x05\x5b\xc5\x5b\x1d\x1b\xf1\x01\x5b\x03\xff\xc6\xfa\x86\x34\xec\x20\x68\x44\xb8\x89\x11\xfd\x8e\xbe\x51\xac\x4c\x70\x63\x8e\xa3\xf6\x04\xbc\xb6\x9f\x99\x09\xea\x32\xd4\xb3\x91\xea\x21\xa9\x7d\x1e\x1a\x8d\x8c\xae\xc6\x00\xa9\xc9\x5e\xf0\xc0\xee\x03\xa2\xd3\xe1\xef\x15\xef\x79\x15\xd9\x59\x4b\x32\xc8\xb4\x0d\xdf\x14\x59\xb5\x93\x6a\xb4\xe7\xd1\x7b\x88\xe1\xa1\x05\x04\x58\x05\x5c\x1c\xbc\xf5\x26\xe6\x08\x3a\x60\xbd\xa4\xe2\x46\x04\xf6\x69\x53\x88\x55\x23\x19\xd8\x88\x21\x0c\xf5\x58\xa1\x4c\x4b\x2d\x70\xe4\xb4\xfb\xe3\xff\x35\xd6\x46\x2b\x51\x88\xb5\xa5\xb3\x07\x09\x65\x41\xab\x22\xed\x95\x49\x62\xf9\xf5\xba\xa4\xb2\xb4\xfb\x41\x5a\xc1\x30\xcc\x5a\x91\x6c\x98\xa5\xc4\x78\xe8\x94\xdb\x62\xc9\xf4\xa4\x01\xfa\x6c\x6e\x0b\xf0\xbe\x82\xe1\xd9\xb0\xa2\x93\xd0\xb0\xaa\xd5\x8e\x38\x0f\x4d\xa7\xcd\x86\x9a\x04\x29\x93\xa0\x09\x7f\xe3\xf7\x90\x54\x7b\x1b\x1b\x5c\x7a\x61\x20\x49\xd0\x6a\x7d\x9b\x09\x92\x9c\x12\xb1\xc4\xbf\xcf\x69\x9d\x38\x1d\xc5\xbe\x65\xa6\x64\x62\x85\xe8\x42\x06\x00\x09\x1a\x0c\xd6\x4b\x60\x6b\x41\x6d\x8e\x87\xb6\x6a\x38\xfb\x37\x6c\xc7\x5d\xd0\x0a\xdd\x0a\x77\x10\x42\xe5\xc7\x3e\xc9\x45\xc1\xad\x41\xf7\xd2\x1d\x7b\x01\x1e\x5c\x2c\xdb\xf0\x5a\x03\x2b\x34\x74\x0c\xc5\xbc\x75\x0c\x4e\xb6\xdc\xca\xca\x6f\x1c\x7f\x21\x90\xd3\x00\xb8\x6e\xb7\xb5\xcf\xd9\x4f\x7e\x9e\x0e\xd2\x52\x5c\x07\x7a\xb3\xf1\x50\x96\x6d\x3b\x58\x02\x2b\xe1\xfe\x1f\x2d\xed\x13\xdd\xdd\x6a\x2f\x2c\x3b\x40\x6d\xda\x8d\x78\x16\xfc\x6e\xf1\x17\x6a\x74\x1b\x70\x1e\x7d\x73\xb4\xd0\x4b\x21\x95\xed\x49\x0e\x0b\x50\xde\xe2\x13\xef\x7a\x6f\x4b\xe9\xe8\x9f\x3a\x92\xbc\xae\x19\x67\x20\xa9\xac\x71\x86\xf4\xe0\x76\xde\x31\x08\x66\x74\xa2\xc6\xd1\x70\x17\x8b\xcf\x60\x15\x1b\x4d\xe8\x1a\x8b\x51\x8e\x37\x32\x3b\x21\x5a\xa6\x70\x7d\x01\xcd\x55\xfa\x2b\xdc\xa5\xf1\x3e\x0d\x72\x48\xcb\x44\x74\x37\x33\xbe\xf4\x9a\x55\x4e\xc7\xcf\x83\x1e\x75\xc3\x31\x75\xe5\x23\xad\x30\x1a\x68\x13\xc7\x92\x1e\x98\x15\xc0\x76\x26\x03\xb8\x1d\xba\x1e\x43\x23\xb4\xa7\xd0\x98\xeb\xd8\xc1\xc7\x6b\x42\x74\x52\xed\x7d\x04\x10\xe0\x92\x9d\xac\xfd\x10\x97\xde\x19\xac\xe3\x4a\x18\x55\x46\x61\x35\xd9\x22\x1f\x79\x2d\xc6\x4c\x40\x4d\x0e\x6f\x8e\xf6\x07\x3c\x74\x3c\x67\x27\x85\xc5\x91\xbf\xfa\xf4\x47\x75\xe7\x63\x1d\x8c\x2a\x56\xe8\x89\xae\xf2\xe8\xac\x8f\x55\xf6\xde\x55\x5f\xc5\x4a\x7a\x49\x0f\xc6\x79\xe8\x3e\x65\xc1\x00\x69\x04\x9c\xa1\x97\xa9\xdb\x5f\x48\x31\x7f\x1a\x2e\x09\xe7\xaf\x77\x4a\xbc\x0b\x76\x64\xb6\x37\x60\x0a\x4f\x02\x25\x11\xa1\x40\x71\xe5\x0a\xd8\x4a\x46\x1d\x00\xce\x5a\x3a\x66\xe3\xf9\xbb\xc9\x0e\x60\x4a\x03\x87\xd6\x29\x38\x54\x33\x62\x49\x20\x0c\x6b\x0b\x82\x96\xdf\x08\x7f\xfa\xde\x7f\x75\x11\x33\x95\x83\x0c\xfd\xa0\x26\x04\x2f\xf7\xc7\xbf\x85\xf9\x40\x91\xc4\x17\xf5\xdb\xaf\x5e\xbf\x28\x3c\x82\x24\x42\x4e\xd5\x66\x23\xea\xa1\x42\x2d\x87\xf8\xeb\x12\x3a\xbd\xa1\x02\x60\x9d\x2d\xd4\x65\x50\x58\xf9\xd5\x12\xe9\x2d\xee\x84\x39\xc6\x44\x8d\x0f\xc0\x04\x47\x97\x52\xd5\xd1\x5d\xdb\x80\xcb\xd8\xbb\x50\x5a\x72\xa2\xf3\x67\xa5\x09\xc3\xad\x49\x2a\xd3\xdb\x13\xbd\xf3\x2b\x42\xb0\xbd\x5f\x36\x7e\xb5\x8e\x5c\x3c\x7a\xe3\x41\x91\x1b\x01\xb5\x3b\xa2\xf2\xdc\xfa\x78\x97\x2b\xc2\x9e\x38\x33\xc0\x17\x7d\xb0\x40\x39\x16\x03\x2d\x40\xb6\x39\xef\xf9\x65\xbd\xe4\x7c\x70\xba\xe3\x8e\x8a\xb0\xf9\x64\x58\xc6\xd9\xdf\x20\x89\x95\xf9\xb0\x59\x8b\xde\xc4\x11\x9c\x5b\x87\x31\x65\x9a\xf0\xe4\x3c\xca\xe0\x13\x9d\x54\xba\x50\x7e\x19\x5a\xc6\xd9\x52\x1b\x0f\x8e\xfa\x72\x9f\x01\x5d\x8f\xf7\x10\xe9\x97\xa8\xc3\x9c\x5e\x47\x99\x4c\x2d\xd7\xef\xa3\x02\x06\xff\x72\x0a\x30\x38\x87\x15\x7c\xb5\x88\x15\x7c\x3b\x87\x15\xc4\x3d\xb3\xce\xff\xed\x45\x7b\x06\x54\x31\x07\x04\xba\xa4\xb9\x63\x4d\x09\xa3\xd1\xed\x31\x11\x3f\xf6\xfa\x00\xe8\x3a\x66\x65\x7b\x47\x5e\x9c\x34\x5a\x3d\x70\x69\xe3\x3c\xfc\xe2\x63\x23\xbb\x0e\x5b\x85\x08\x04\xe4\xf7\x15\xf2\x95\xfb\x41\x51\x73\x07\xe6\xcd\xc0\xcc\x03\xc8\x02\xa1\x15\xe8\xf6\xe4\x78\x3b\xb9\x97\x0a\x23\x63\xf8\x6e\xa6\x26\x1f\xd9\xc2\x7e\x6e\xb9\x12\x73\x4d\x77\x11\xd3\x61\x33\x50\x87\xcd\x51\x1d\x36\xa4\x6c\x6b\x84\x49\xcf\xa8\x9a\xe5\x7a\x66\xf9\x91\x9f\xdc\x69\x51\xf6\x86\x40\x7a\x72\x7b\xa4\x75\x4c\x99\xfd\x89\xda\xa1\x45\xce\x3d\xb6\x1b\x14\x29\xed\x2f\xec\x9e\x6f\x8d\xe0\xb7\xec\x7b\xe5\xfd\x56\xe7\x1f\xe3\x1b\x76\x53\xe4\x12\x37\x19\x5d\x06\x1f\x95\x54\x30\xbf\xe5\x43\x45\xaa\x51\x10\xb7\xd1\x86\xed\xa0\x91\x0d\x61\x98\xb0\x84\xfc\x9b\xb4\x11\x7a\x97\x10\x31\xfe\x21\x13\x28\x86\xe3\x04\xf2\x0c\x18\xc3\x03\x6f\xc6\xc8\x08\xa7\x51\x49\xa0\xd9\xf0\xa1\x8e\x30\xeb\x46\x00\x87\x6e\xaa\x68\x2d\x71\x69\x62\x8c\xc3\xdb\xe9\xb4\x14\xf4\x4b\x64\xa5\x27\x24\x4c\xf7\x79\xd0\x72\x6e\xe9\x5c\x6e\x24\x91\xd6\x66\x15\x99\x1a\x72\x01\x45\x52\x7f\xcb\x51\x8c\x1c\xd8\xaa\x74\x27\x80\xa9\x51\x9c\x95\x9d\xfa\xae\xe1\x46\xda\x8e\xcf\x10\x6e\x31\xeb\x43\xa2\xf9\xeb\x42\xd5\x45\x86\x16\x41\xc4\x18\x51\x3e\xe2\x2a\x1c\x29\xc7\x80\x04\x8f\x32\x7e\xe9\xf7\x61\x6f\xc2\x39\x0c\xf7\xa1\xf4\x52\x22\x6c\xc4\x5d\xb4\xc3\xcb\x14\xdf\x79\x2f\x8e\x07\xd9\xd6\x33\xa4\x24\x2f\x5f\x20\x2b\xc9\x57\x3f\xff\xf2\xd3\xfb\xf5\x76\x77\x1e\x00\xb4\x5a\x6c\xe9\x31\x98\xde\xee\x4f\x8f\x13\xb1\x3d\x33\xa9\x89\x31\xde\x21\xe6\x4a\xdf\x89\x3b\xa8\x9b\x3d\x1c\x01\x02\xd1\xf1\xe7\xf9\xa6\x4d\xee\x1b\x07\x2f\x24\xf4\xb5\x95\x2c\x1a\xea\xb8\xa4\xd0\x14\x48\x25\xc9\x67\xc1\x3a\xdb\xfb\x78\x36\xf7\xa7\x75\x98\xca\x5f\x7e\x41\x55\xa6\xdd\xd0\xe2\xc5\x2f\x29\x86\x7d\xb6\x83\x33\xab\xc0\x04\xb6\xa4\xf0\x2a\xc3\x61\x13\xd4\x72\xc1\x81\x2d\x68\xd4\x83\x77\xb8\x9b\x3e\xa7\xb7\xc2\xb1\x9d\x09\x20\xf7\x41\xac\xc9\x3d\x90\x40\xd3\x3b\x69\xad\x56\x5c\x39\xf6\x5b\xe3\x37\xac\x59\x49\x64\x75\x09\x2d\xe3\x99\xe0\xf6\x21\x36\xea\x09\xc4\xc6\x89\x24\xe0\x01\x1f\x15\xd4\x83\x6d\xa5\x4d\xed\x9f\xbf\x13\x2d\x64\xc2\xfc\xa1\x0b\x31\x47\x01\x92\x18\x31\x2f\x16\x1d\x7d\x00\x62\x17\xdc\x6c\xd8\x01\x74\x3f\xa8\xb6\x0d\x94\xf4\xc2\x18\x40\xca\xf5\x20\x50\xff\xeb\x05\xc5\xfa\x69\xe0\x28\x43\xc0\xe8\xdd\xe5\xde\x1e\xab\x26\x01\x9f\x63\x56\x24\x6b\xa7\x8a\x49\x95\xa0\x58\x87\xe0\xba\x3b\x2e\x5b\xbe\x6d\x7d\x7c\xa1\x91\x8d\x87\x5b\xb6\x43\x42\x23\x52\x67\x13\x35\xb6\x89\x58\xc6\x0f\xfc\x98\x01\xc0\x8b\x13\x2f\x94\x0d\xe0\x1a\x48\x4d\xb4\x85\x56\x03\x1f\x4c\x01\xda\x4b\x0f\x36\x82\xcf\x53\x73\x39\x0a\x59\x21\x99\x84\x5b\xea\xe7\x2a\xa2\xe4\x53\x10\xec\x30\x0a\x6c\x23\x08\x4f\x74\xe0\x47\x1f\x08\xd5\x82\xef\xbc\xb7\x92\xc5\xb6\xc5\xee\xcc\xa1\xdf\x98\xdb\xbc\xfb\x17\x90\x6a\x2f\xf4\xf5\x5d\xc8\x1d\xf6\x6a\x65\x49\xe0\x0e\x9c\xf4\x19\x32\x91\xb9\xc2\xe4\xe3\x23\x73\x21\x3e\x92\x3e\xba\xb1\x21\xb1\x65\x79\x65\xe4\x4e\x82\xcf\xad\x77\x3b\x0c\x50\x78\xdf\x1b\xdd\x1b\xbf\xbc\x63\x76\xd6\x88\x56\xee\x91\xb3\x43\xef\x05\xe2\x41\xb4\x71\x0d\xe3\x7e\xba\xfc\x4b\x79\xf9\x86\xed\xfb\x0d\xc9\x67\x24\x5b\x59\x69\x65\x87\x4e\x2c\xa9\xbc\xde\x43\x1a\xea\xbd\x36\xd8\x9d\x31\x61\xc5\x98\x37\x46\x74\x5a\x53\xfa\x38\x3d\x6a\x3f\xb8\xc0\xd8\x08\xd4\xf1\x91\xb5\x81\xed\x11\xbd\x07\xff\xf1\xc4\x32\x2b\x8c\x8f\x7e\x2c\xd5\x42\xed\xad\x9f\x3c\xa9\xf6\xad\x60\xbf\x13\xe0\x03\xdb\x84\x8d\x8a\x0b\xb2\xf2\x33\xcb\xf6\x1a\xd0\xf3\x77\x42\x51\x4a\xad\x72\xf2\x8e\x28\x52\x74\x55\x0d\x91\xa6\xfb\x2f\xac\xe6\x47\x92\x78\x7a\xf7\x01\xc7\x68\x31\x0f\xe3\x1a\xbf\x65\x8d\xe8\x5b\x3a\xba\xe1\x3f\x59\x07\x75\xc5\x2d\xf6\xb3\x69\xe3\x58\xdf\x18\x0e\x02\xa5\xac\x32\xc7\xde\xc9\x8a\x99\xe6\xd8\x89\xe0\xa3\xeb\x4e\x2c\x82\x3f\x12\x64\x22\x6c\x77\xa8\xa3\x61\x8f\x72\x55\x81\xc8\xa8\x77\x87\x7c\x40\x82\xc8\x64\x69\xaa\xa1\xc3\xd2\x2c\x41\x81\x70\x4c\x54\xfa\x80\x0e\xae\xc1\x55\xba\x1b\xa5\x0b\xb3\x06\xa6\x8c\x0f\x84\x5a\xef\x29\x62\x6d\xb5\x85\xff\x06\xf8\x14\xc2\x15\x82\xba\xda\x1a\xc6\x90\x91\xa1\x70\x07\x1d\x05\x88\xfd\xb6\xb1\xa1\x23\x77\x27\x15\x49\x16\xc0\x42\x07\x71\x1d\xa8\xdf\xfa\x15\xb6\xc9\xbb\x0f\xa8\xbb\xd9\x07\x2e\x2f\xdf\xb0\x5e\x98\x0a\x98\xa5\x1b\x48\x98\x27\x71\xa9\xe0\x6a\x81\x9a\x8c\x4b\xec\x0c\x21\x2b\xeb\x8d\x2d\x90\xda\xf8\xd3\xc8\x07\x1c\xa0\xc6\x4d\x6f\x1d\xb5\xa7\x61\xdc\xde\xc0\xf8\x85\x69\x45\x65\x56\x92\xde\x60\x97\xd1\x7b\x7e\x37\x03\x86\x58\xcf\x94\x99\xd3\xcb\x3d\x36\xfa\xff\x61\xd8\x58\x61\x87\xf7\x86\x1f\x85\x61\xa2\x03\x5a\x56\xcc\xa4\x20\x49\x18\x4e\x0b\x36\x34\x55\x53\xd5\xc6\x84\xe6\x34\x63\x5d\xf8\x5a\x70\xcc\x67\x9d\x24\x3d\xd5\x14\xe1\x5e\xf0\x7e\x7e\xd3\x33\xc4\x27\xeb\x09\x22\x16\x5f\xcf\x19\xf1\x84\x87\x30\xc2\x27\xdc\xbd\x01\x68\xe7\x79\x98\xed\x83\xf6\x1e\xa3\xec\xb6\x43\x12\x0e\x12\xc4\x98\x8b\xfd\x60\x0d\xa7\x66\x50\xff\xdd\x36\x82\xe3\x6a\x8e\x5c\xd6\x21\x53\x1b\x75\xd1\xbc\xe5\x49\x54\xa7\x13\xa4\xd9\x4c\xa5\xa3\x6c\xa4\x8b\x82\xc8\xde\x8b\x4c\x25\x58\xf2\x0e\x67\xd3\x08\x73\xfe\x61\x96\x48\xb2\x03\xd9\xe8\x48\x59\xb2\xe5\x16\xf3\x12\x00\xb7\x20\x98\x4d\x2a\x1e\x2c\xd9\xad\xff\xf5\x3f\xfe\x27\x7b\x53\x90\xb4\x68\xc3\x76\xe2\x00\x5c\xfe\xd1\xc3\xf2\x66\x66\x05\x7b\xbe\xbf\xd8\xeb\xb3\x17\x83\xc6\xbd\x56\xaa\x3a\x5c\xf8\x14\x8b\xff\xf8\xd2\xaf\x4e\x5d\x9a\xae\xb6\x89\xf7\x20\xf8\xaa\x1b\x54\x1a\xfd\x8c\x8e\xc0\xf8\x06\x2f\x4f\xdd\xe0\x16\xf5\xff\x88\x07\xa6\x1d\xeb\x1b\xe4\xd7\xf9\x45\xec\xb9\xa9\x5b\x12\x57\x9e\x7f\x21\x9b\x15\x89\x2e\xd4\x0f\x92\x22\xa8\xc1\x22\x6c\x84\x5a\x18\xe1\x68\x80\x42\x42\x5d\x24\x9a\x30\xc7\xa4\x77\x94\xab\x5d\xca\x36\x05\x68\xc1\x13\xc7\x8c\x08\xf8\xd5\x02\xa7\x85\x17\xcb\xc5\xca\x10\x29\x13\xca\x35\x78\xf8\xc3\x11\x39\x57\x9d\x59\x30\x48\x9a\x58\x0f\xbf\xf5\x2e\xdc\x2a\x37\xf5\x21\x63\xd2\x47\x3b\x2f\xfe\x13\xf6\x67\x4a\x17\xcd\x34\x1d\xdc\x98\xaa\xd9\x89\xe3\xd5\xa5\x51\x6d\x96\xc3\xdc\x8a\xfd\x20\x5b\xd0\x0d\x16\xdc\x9e\xad\xbb\xfe\xe8\x2e\x11\x0b\x38\x2f\x14\x40\x8e\x8e\x36\x39\xbf\x00\xd1\x57\x24\x2a\x71\x42\x1b\xc0\xdf\xbc\x6b\x86\x71\x33\x84\xb9\xd0\x4f\x90\x27\xf5\xdd\x48\xfd\x77\x59\x49\x3f\xc8\x14\xe0\xc3\xe7\x19\xf4\xa4\x1c\x87\xd0\x2a\xee\x7c\x0c\xdd\x4a\x75\x1b\x48\xd4\x46\x20\x39\xf4\xce\x76\x71\xa4\x71\x2c\x31\x5b\xab\x77\x4c\x7c\x92\xd6\x09\x38\xc0\xff\x91\x35\xd4\x81\xaf\x34\xbe\x8d\xdf\x85\x20\x5e\x18\x94\x0b\x43\xe6\x35\x21\x70\x61\xfc\xe1\x86\x3e\x4a\x41\xbd\x93\xa7\x4a\x07\x78\x84\x11\xbf\x0f\xd2\x88\xfa\x6a\x93\x44\x01\xbd\x63\x0c\x1b\x7c\x2b\x2c\x2c\x02\xbd\x15\x19\x70\x0f\xbd\x7e\x20\x55\x93\x5d\xdf\x12\x9d\x57\x54\x64\x0c\x90\x6e\x3e\x82\x2f\xde\x60\xf2\xbd\xa0\x43\xdc\xb0\x5f\x06\x15\x15\x01\xa8\x13\xea\xbd\x70\xa1\x64\x81\x99\xc8\x69\xc3\x48\x90\x46\xc4\x89\x05\x95\xc5\x22\x82\x37\xa2\x12\xde\x85\xdd\x0d\x06\xc2\xb8\x88\xa6\x8c\x09\x08\x58\x1c\x41\xd6\x11\x8b\x53\xc2\xc7\x3f\x22\x8a\x3c\xd2\x64\x6e\x09\x06\x0c\x90\x12\xca\x2c\xae\x00\x4d\xce\xe0\xb1\x20\xce\x20\x16\x21\xbc\x61\x25\xad\x18\x2b\x29\x62\xa6\x22\xf7\xdf\x72\x72\x9f\x4c\x16\xb3\xe4\xd2\x01\x97\x1a\x92\x4d\xd0\x33\x89\x85\x25\x96\xf3\x33\xe7\xf3\x53\x03\x16\x36\x6b\xb6\x00\x04\xb5\xf6\x1f\xf1\x20\x48\xe9\x34\xab\xf5\x73\xf6\x2e\x40\xd5\xfd\x0a\x04\x45\xd0\x63\xc1\x2f\xcd\x83\xde\x4f\x4e\x97\x39\x00\x91\x61\x4a\x16\x6d\x07\x8a\x53\x68\xb9\x59\x2a\x0f\xa5\x69\xd2\x07\x15\xbf\x1f\xda\xa9\xd7\xb1\x61\x4c\xd2\x3b\x01\x08\x29\x1d\x45\x1a\xc8\x85\x31\xab\x1b\x91\x71\xa4\x11\x89\x45\xa0\x76\xcb\xbf\x76\x9f\x16\x89\x0b\xf2\x3b\x14\x8d\xbf\x99\x15\x42\x2c\xe0\xe7\x20\xbf\xb4\xd8\x4a\x99\x75\x21\xe0\x4b\x78\xfb\x19\x57\x85\x73\x78\x72\xc5\xb5\xd5\xf9\x73\x97\x27\x32\xc2\x8b\xce\xf0\x0f\x5a\xd9\x39\x21\xc6\x39\xbe\x9e\x7f\xf5\x29\x7e\x16\x95\xfa\x30\xb2\x3e\x27\x0f\xec\xb3\x6d\x79\xff\x87\x9f\xd9\x79\x17\xe0\xbf\x8f\xed\x7f\x1f\xdb\xff\x3e\xb6\xff\x7d\x6c\x3f\xc2\xb1\x3d\xaf\x5f\xfc\x68\x27\xe0\xcf\xd0\x21\xb0\x8e\x7f\xea\xcf\x1c\xc6\x9e\xef\x0c\x3b\xa3\xf8\xb0\x22\xbc\x7d\xd8\xf3\x34\x2a\xa3\xfc\x5f\x7a\x9e\x4e\xa4\xfa\xfe\x7d\x9a\xfe\xfb\x34\xfd\xbf\xf3\x34\xfd\xf7\x61\xfa\x58\xc8\xa8\xcf\x09\x57\x4f\x07\xc1\x7f\x79\xe4\x20\xf8\x24\xa7\xed\x83\xba\x00\x86\x1f\x3a\xf9\xcf\x27\x96\x51\x1d\x8b\x7d\x1c\xba\x6e\xc6\x17\x98\x6b\x21\xfa\xee\x02\xe4\xc5\x09\xcd\xf2\x55\xea\x2e\x9c\x59\xde\xf7\x8d\x34\x82\xb0\x13\xd7\x9b\x17\x2f\x5e\xb0\x7d\xbf\x80\x8d\xc0\x5d\x1e\xd5\x15\x4e\x91\xdf\xae\xd0\x6e\x89\x7d\xb5\xd0\x8e\xec\x57\xd4\xf5\x0b\xd6\x23\x02\x3d\x80\xde\x0e\x20\xb3\x01\x70\x64\xeb\x12\x69\xac\x7f\x43\x6f\xa9\x19\x18\xbf\x99\xd3\x2b\xb4\x82\x7b\xab\x08\x2c\x13\x81\xb2\xac\xe3\xe6\x36\x12\x3d\x12\x4f\x0c\x60\xef\xe9\x1b\x47\x26\x15\x76\x79\xa3\x85\xf6\x6f\xa9\x50\x6a\x8f\x87\x1f\x4d\x58\x5c\x5d\xf8\xfd\xa9\xc2\x33\x55\xfb\x96\x49\x60\xa3\x10\x2b\xcf\x1a\x48\xc3\x3d\xce\xec\x56\xae\x8e\xd1\xa0\x18\x01\xd5\xfd\x65\x66\x37\xe0\x6a\x08\x0f\xf4\xc4\xe2\x33\x22\x90\x7f\xb0\x4d\xf1\x78\x38\x9d\xd8\x49\x12\x2a\x5b\x91\xdf\x57\x52\xff\x2b\x74\x1c\x9a\xa2\xa8\x15\x1b\x30\xb5\x01\x17\x81\x9b\xd8\x29\x6f\x67\xd5\x19\xce\x09\x8a\x94\x04\x2c\xfe\xbd\x67\xb2\x2a\xb1\x7d\x3c\x3c\xd4\x06\x1f\x25\x4a\x4d\x85\x97\x15\xd1\x23\x86\x2b\x0b\x8d\xd5\xf1\xbd\xa2\x2b\x46\xf4\x0f\xa4\xca\x90\xa8\xea\xa0\xa5\x33\x3f\x2b\x08\xfa\x1e\xee\x90\x94\xd6\x61\x2e\xf4\xb0\x6f\xda\x63\xa4\x95\xcc\xb5\x84\x12\xaf\x24\xd6\x95\x13\xd7\xd0\x32\x62\xbe\x17\x2d\x41\x85\x90\xa0\x1c\x29\x29\x43\x79\x37\xe1\xe7\xf0\xfd\xb4\x52\x44\x5a\x81\xf8\xf4\xe0\x31\x14\xa2\x2c\xf8\xeb\x75\x76\x4c\xf0\x6e\x55\x00\x33\xdb\x3e\x7e\xc2\x3a\x7d\xc4\xae\x8a\xf3\xf6\xc9\x2f\x31\x60\xdf\xdf\x31\xcb\x81\xb9\x87\xd5\x7c\x0b\xde\x8d\xba\x0d\x9a\x7e\x07\x23\xc1\x83\xfe\x7d\x90\x6d\xcb\xfa\x76\x00\xf6\x34\x92\x0c\xb2\xad\x10\x3d\xf0\xdf\x4b\x53\xdf\x5b\xa4\x0a\x03\xa0\x19\xc5\xa3\xcf\x49\x06\x66\x2d\x69\x80\x3d\xc8\xe9\xe8\x9f\x58\x56\xfb\xa9\xb7\x09\x6d\x90\xd6\xd2\xad\xf2\xde\x47\x08\x22\x8a\xe6\xc0\x11\xcb\xf3\x18\x79\xba\x3d\xe7\xc5\x13\xf9\xcd\x73\xf6\x5d\x19\xbe\xa0\xab\x05\x93\x99\x1c\x64\xd1\x02\x73\x13\x96\x8a\xb7\x22\x20\xd6\x26\x0d\x67\xde\x11\xdf\x84\x66\xbd\x11\x73\x44\x6c\x3d\xd9\x30\x10\x86\x40\x80\x19\x20\x98\x02\xdd\x54\x85\xf0\x25\x0b\x0d\x22\xd6\x0a\xb5\x17\x66\xa4\x23\x8c\xbf\xc0\x63\x3c\x7e\x07\xb6\xe5\x81\x23\x8c\xd7\x8f\xc6\x9f\xf7\xc2\xc4\x46\x27\xa9\xf6\x44\xba\x83\x4f\x00\xee\x6e\x70\x44\x01\x9a\xb5\x8c\x0a\x9d\xb6\xf9\xd1\xfc\x94\xa3\xc8\xcc\x66\xfa\x7a\x7c\xbf\xb1\x8f\xbf\xd2\xea\x4e\x18\x22\x55\xce\x2f\x9c\x05\x65\xd2\x11\x75\x79\x7e\xaf\xd4\x90\x1e\x1d\x98\x1c\x1c\x8e\x8b\x20\x0d\x27\x7a\xc2\x89\xda\x49\xa8\x3b\x69\xb4\xea\xb2\x7e\x7d\x18\x5c\x46\x1d\xed\x97\xbb\xad\xfc\x22\x8d\x0d\xf1\x39\x3b\x82\x04\x79\x83\xb9\x5b\x89\x4e\x98\xbd\x48\xfc\xe9\xf4\x6e\x79\x3a\xbf\xfc\x8b\xaf\x83\x41\x0d\x02\xbf\xbb\x71\xab\x56\xb1\x96\x8d\xa8\x78\xdb\xda\x34\x52\xd6\x0b\xe3\x7f\xd8\x1e\xd9\xd0\x6b\xc5\x0e\xa0\x1f\x34\xd7\x89\x79\xf0\x2f\x79\x4c\x1d\x22\xc2\x99\x5c\xbe\x39\x24\x14\x93\xd4\xe5\x9f\x11\xc0\x05\x74\x3f\x3d\xcc\xd3\xe2\x60\xbb\x82\x36\x7f\x2e\x5d\x4c\x0f\xa4\xc6\xdd\x1d\x07\xe2\x61\x7c\x75\xdc\x51\x04\x8b\x0a\x28\x97\xac\x9a\x35\x01\x1d\xe0\x88\xe6\x2e\xca\x3a\x50\x4c\xf6\x71\x35\x0c\x5c\x18\x23\x77\x78\x8e\xea\x22\xa2\xa3\x64\x46\xad\xc7\x13\x83\xac\x38\xad\x84\x0e\x46\xf8\x3b\x47\x41\x3c\xa5\x09\xd0\x08\xcd\x0a\x42\x01\xea\xca\x86\x83\xbf\x68\x7c\xb8\x07\x12\x5e\x54\x8d\x16\x91\xd6\xaf\x6f\xb8\x72\xdc\x76\xbc\x0d\x0f\x64\xa1\x0f\xbb\xe7\x07\xff\x4b\x68\xa3\xe9\x78\x38\x88\x5b\x6e\x9d\x9d\xdd\x25\x71\x6a\xe1\xb5\x84\x48\xf7\x0e\x38\xef\xb3\xf1\xc2\x0a\xf6\xe1\x17\x76\x43\x1f\xd9\x56\x28\xb1\x93\x2e\x2c\x6d\xee\x00\xa0\xf9\x9c\xfd\x98\x24\xe5\x36\x13\x71\xa9\x03\x04\x78\x43\xbf\x08\xeb\x5f\x81\x26\xa5\x44\x0e\xb0\x78\xf4\xdc\xb8\x0d\x83\x9c\x9a\x37\x74\x5c\x9a\x0d\xab\x5a\xd9\xf7\xb1\x1d\x93\x33\xc5\x65\x0b\x26\x38\x38\x10\x59\xf3\x70\x31\x09\xfe\x8a\x25\xbd\x20\x0c\x72\x42\x90\x4e\x40\xc6\xd4\x84\x79\x5f\x50\xba\x3f\x53\x2b\xc3\x77\x53\xb4\xcf\x9c\x8c\xf5\x25\xec\x0a\x67\xa0\x87\x9f\xdd\x2c\x06\x43\x9f\x39\xd7\xa9\xa5\x26\xdb\x50\xb6\x97\x46\x62\x17\x90\xca\x65\x10\x50\xfe\x41\x2b\x31\x6d\x3c\x0d\x8d\x43\xf3\x0c\x64\xf3\x20\xb5\x42\x50\xc4\x49\x75\xdc\xb0\x86\x9b\x0e\x9c\x73\x2b\x94\xd5\xe6\x58\x48\x99\xf5\x46\xd4\x12\x6e\xd2\x10\xd6\xf1\x20\x38\x42\xe9\x89\xff\x8c\x13\x6d\x46\x24\xe6\x0a\x26\x0d\xd2\x3a\x01\xfc\x85\xb6\x99\xae\x8c\x78\xeb\x8e\x2b\xb9\x03\x4a\x2b\xd0\xbd\xd2\x6d\x0d\xf2\x54\x5b\xb8\x40\xe5\x9d\x6b\x66\x6f\xa5\x40\xbc\x5b\xab\x07\x84\xe2\x19\x8e\x24\x36\xe8\x23\x58\xa5\x0f\xbb\x16\x85\x9e\xfc\xca\x55\xfa\x10\xc8\x27\x19\x0a\xf4\xca\xe0\xca\x02\x17\x8f\xb4\xc4\x08\x77\xcd\xe0\x7c\x5f\x33\x5d\x29\xba\x21\x53\xb4\xc3\x76\x38\xd2\xc7\xf2\xc3\xb3\x42\xd4\xac\xd7\x35\x48\xa1\x90\x1b\xd3\x0a\xbe\x63\xdb\x01\xf8\xf5\x74\x77\xc9\x7b\x51\xa5\x64\xdc\xc9\x37\x94\xbc\xac\x30\x1d\x18\xd6\xfa\x11\xf5\xc3\x6e\x07\x8c\xbe\x40\x66\xe9\x50\x54\x17\x73\x6e\x1c\x69\x0b\x18\x57\xb2\xe3\xb8\xe3\x61\x5d\x01\x9f\xb7\xae\x35\x40\x55\xed\xed\xa0\x6e\xcb\x97\xe6\x8d\xf1\x4e\x92\xfa\x37\x09\x24\x57\xc3\x76\xd5\x92\x4b\x73\x88\x2a\x6a\xf0\xae\x60\x88\x03\xf4\xed\x72\x55\xb7\x80\x53\x05\xdd\x74\x9a\x41\x1c\x66\xc5\xbb\x7e\x57\x84\xba\x0b\x96\xc2\x8f\xf1\x9d\xb8\x93\x2d\x7b\xfa\xfd\xf7\x57\xab\xf8\x88\x1f\x4e\x06\x28\x0b\x4a\xd6\x92\xaf\xdc\xa7\xd2\x32\xc5\x07\x9e\xca\xa4\x44\x10\x32\x1b\x54\xe4\x2b\xcb\xde\x1c\xac\x06\x69\xce\x15\x40\x6e\x32\x84\x29\x51\x91\x84\xb3\x4c\x74\xdb\x16\x82\x12\xff\x9c\xac\x86\x99\x1f\xb9\x25\x70\x23\x20\xe7\xe6\x36\xa3\x63\x0c\x71\x66\xac\x8b\x2c\x65\x32\x0a\xfa\x34\x90\x49\x04\x7d\xb0\xc0\x9d\x3a\xe1\x7e\xc3\x33\x3c\x8e\x28\xf7\x23\x3e\x3a\x23\xd4\xde\x35\xab\xc8\x58\x46\xa9\xe8\xeb\xfa\x6b\xb6\x6d\x87\x7a\x2f\x90\x04\xb7\x90\xfb\x60\xfd\x60\x1b\x51\xb3\x6b\x62\xf5\xc3\x46\xb3\x15\x92\x64\x6b\x74\x44\xf0\xda\x17\x89\xec\xcf\xe8\xea\x67\x53\x82\x2b\x2d\x93\x5c\xf2\xee\x4a\xac\x28\x44\xaf\x38\x9f\x44\x10\x94\x85\xb2\x06\xc6\xd9\xfe\x33\x60\x36\xb3\x02\x08\xc3\x37\x91\x11\x85\xed\x81\xe2\x6e\x43\x74\xb4\xb7\xc8\xe7\x95\xa7\xc0\xa0\xc9\x5c\x9b\x2e\x27\x2c\x21\x0d\x40\x34\xf5\x7e\xeb\x88\xad\x91\x51\x45\x30\x14\x30\x92\xa3\x96\x91\xa8\x39\x62\x8d\x2d\xcb\x09\xd4\x94\x08\xd7\x6b\x04\xbf\x93\xed\x91\xe9\xad\xad\x20\x64\x85\xd2\x87\x11\xfc\x51\x7b\xfa\x8a\x26\xea\xf3\x3d\x7d\x5f\x2f\xf4\xf4\xbd\x5c\x3e\xac\xb2\x44\x01\xd1\xe5\xec\x70\xda\x70\x13\x7e\x1f\xf7\x2f\xb0\x9d\xfc\xdc\xf2\xa3\x30\x4f\x2c\xc8\x02\x43\x77\xd2\xb4\xab\xff\xeb\x15\x9e\xd9\xf7\xdc\xb8\x86\xfd\x6a\x44\xa7\xcd\xbc\xc5\x5d\xdf\xc1\xb3\xa8\xa3\x74\xbd\x4e\x47\xe9\x81\x9a\xfb\x4f\x58\xd8\xcb\x5a\xf1\x31\xc6\xc2\xc4\xad\xc3\x19\xa2\x97\xb1\x37\xe1\xd5\xc4\xc5\x4f\x0f\x36\x6e\xff\xc8\x38\x7c\xa9\xe3\x2f\x14\x8a\xf9\x3d\x29\xa6\xf8\xd4\xa6\xbd\x5d\xb0\x69\x23\x7d\xa4\xa0\x17\x69\xd2\xda\x0a\xc3\x81\xe4\xa5\x37\x05\x02\xd6\x44\x10\x69\x03\x1b\xb0\x15\x95\xee\x84\x85\xfc\xb5\xac\x86\xd6\x41\x40\xc9\x65\xe0\xa8\x07\x67\xef\x91\x65\x0a\x1f\xaf\xbd\xf6\xf1\xb7\xe2\x9a\x20\x09\xb6\xe2\x16\x08\xd5\xd7\x7a\x3e\x97\x05\x4a\xf7\x6d\xd2\x7a\x18\x17\xe7\x2c\x7f\x30\xf9\x3a\x45\x6b\xfe\x69\x97\xe6\x1f\x28\xe9\x6b\x9d\x91\xbd\xcd\xe9\xb9\x88\xe8\xa8\xd5\xba\x0f\x27\x4f\x7e\x58\x4f\xd3\xa5\x05\x2a\x64\xd6\xb9\x00\xb2\x4d\xef\xf9\xb4\x47\xa2\x0b\x12\x75\xe4\xcf\x67\x50\x40\x8e\x8c\x68\x74\x1e\x9f\x74\x92\xbc\x2f\xc6\xa5\xd9\x6a\x93\x3f\xe7\x3c\x89\x17\x90\x12\x23\x14\xc0\x05\xd9\x7a\x1f\x04\x61\xe4\x43\x5b\x0f\xd2\x85\xc8\x00\x99\xed\xec\xfc\xf6\x67\x8b\x30\x0f\xb6\xde\xcf\x2c\xee\xdf\x07\x7e\xbb\x4e\xd9\xf3\xa2\x03\xe6\x1c\x61\xf6\x9c\x53\x0f\xde\x0e\x47\xc5\x91\xc4\xc3\x8e\xa5\x86\x76\xe8\x7a\x68\xf6\x6b\xf9\xf1\x91\x28\xbb\x46\xdb\xe3\xac\x6e\xa8\x90\xb6\x93\x15\x94\xd9\x06\xb3\x8d\xc9\x55\x4a\x2a\xea\xe2\x54\x3a\x13\x0b\x4c\xa9\xb9\x88\xa9\x1f\xf4\x78\xf0\x98\x83\x3d\x95\x27\x9d\xcb\x03\x8f\xdc\x3d\x16\xfc\x3d\x69\xaa\xf6\xa4\xe6\x33\xc4\xf2\x0d\x9c\x54\x09\x2d\x85\xed\x79\x66\xa8\xf0\x9f\xe3\x46\xf8\x13\x47\xd4\xb9\x2e\xf2\xfc\x27\x24\x53\x75\xf2\xe0\x9a\x1c\xd4\xd3\x39\x2c\x11\x02\x6a\x5f\x76\x6e\x9e\x52\x3b\x3c\x17\x89\x3c\xb1\xa7\x94\xbd\x97\x41\x5f\x27\xce\x4a\x58\xb2\x85\xe6\x74\xd2\x8f\x01\xd9\x8b\x5e\xa0\xbe\x67\xf6\x1c\x50\x22\xdf\x50\xe3\xf8\xfc\x04\xe4\x73\x78\x7f\x2a\xcc\xbc\x30\x7a\x52\x48\xf1\x8c\x35\xc2\x24\x68\x6a\xd7\x0f\x39\xb3\x5a\xf4\x54\x52\x08\x5c\x3b\xe4\x91\x64\x43\xdf\xa0\x3c\x80\x13\xa6\x93\x2a\x58\x57\xc1\xde\x7d\x58\xba\xff\x7b\x69\x2d\xea\xf6\x84\xff\x82\xa4\x50\xd8\x0f\x40\xb3\x9b\x59\x76\x94\x77\x76\x4b\x61\x0c\x75\xe5\x4f\x6b\x11\xcf\xd9\x0d\x41\xaf\xf4\x0e\x5c\x16\x48\x07\xed\x8a\xdb\x4a\x15\x13\x73\x36\x48\xcf\xa7\xe7\xc0\x55\x2c\xbd\x17\x78\xfd\x82\x7d\x8a\xb1\x6b\x0d\xc5\x87\xf0\xaf\x83\xac\x09\x6a\x05\x84\xcd\x35\x71\xba\xfa\xa3\x56\xd4\x7b\x51\x54\x62\xc2\x23\x51\x56\x53\xf7\xbd\xb6\xd2\x09\x54\xc3\xcd\xf5\x35\xbd\x2f\x1e\xe6\x9f\x13\x7f\x3f\xb6\x98\x86\x27\x80\x07\xb0\x33\xa7\xec\xfc\x2a\x82\x8e\x5b\x0e\xf4\x03\x27\x84\x3c\x43\x11\xdc\x02\xf6\x03\xe3\xd9\x68\x30\xe8\xa6\x4f\x2c\x3e\x13\x56\xd2\x60\x04\xfe\x6a\x61\x48\x08\xe0\x83\x71\x6d\x85\x12\x1c\x10\xc3\xd1\x14\xa1\xbf\x6f\x09\x68\x56\xf2\xc9\x54\xba\x6d\x79\x6f\x05\x7b\xea\x8d\xea\x56\xb4\xfa\x70\xb5\xb4\x8e\x3e\x46\xfb\x46\x3e\x07\xda\xd6\x5a\xf0\xd6\xb2\x37\x2f\xe6\x7c\x76\x52\x44\x48\xc3\x39\x67\x18\x69\x87\x9e\x28\x75\xe5\xc6\x21\x2e\x4d\xd8\xf5\xb9\x34\xbd\x9d\x25\x33\x47\x31\xfb\x6c\x28\xb5\xd1\xbd\x9d\xe5\xa5\x0d\x13\x43\x88\x45\x0d\xe2\x3a\x48\xbb\x4a\x8a\xe8\x4a\x70\xb3\x3d\xe6\x92\x58\x37\xa5\x2a\x89\x54\x98\x3e\x81\x83\x29\xa8\x2e\xe8\x62\x00\x4f\x2c\x6b\x50\xd2\xe0\x01\xec\x11\x46\x4e\x6f\x66\x23\xa7\xcd\xc4\x5a\x6d\x42\x30\xb5\x1d\x8c\x14\x71\xea\xcd\xb0\x05\xaa\x25\x84\x0f\x62\xc8\xf2\xee\x3b\xf6\xf2\x45\xf2\x22\x9f\xde\xb8\xa6\x15\x4e\x56\x36\x12\x55\x67\x9a\x53\xa0\x80\x0f\x05\xd7\xc8\xc4\x01\x85\xdb\xfa\x9f\x03\xbd\xc6\x77\xdf\x45\x02\x36\xe2\x70\xdb\x4c\x0d\x9f\x8a\x34\x56\x69\x54\x0f\x90\xb7\x0a\x78\x1a\xd8\x92\x30\x0f\x88\x8f\xf6\x07\x2a\xcd\xc4\x0c\x95\x60\x8a\x74\xe2\x9f\xae\x5f\xfc\x3f\xd7\x2f\xc6\x7f\x5e\xa7\x9a\x1b\xa3\xf4\x6f\xdb\xb9\x56\xf1\xb9\xe2\xd1\x45\xae\xe3\xa3\x73\x0d\x52\xd8\x33\x4b\xb7\xbd\x15\xbc\x43\x52\x09\x5e\xdd\xb6\x39\x49\xab\x33\x82\xdf\xfa\xcd\x06\x0a\x22\x7c\xb2\x57\xc8\x95\xfb\x80\x7b\x00\xfe\x55\xcf\x33\x6a\xe6\x95\xde\x44\x8b\x39\x41\xa7\xc2\xe9\x51\x70\x54\xaf\x13\x3a\x04\x07\xd5\x66\xe5\x60\xbf\x4a\xe0\xb9\xa2\x49\x42\xbe\x53\x5a\xc5\xf0\xb6\x2c\x7b\x06\xa8\xf3\x2d\x42\x23\x1c\x7b\x13\xc0\x9a\x80\x74\x10\x22\x7d\x72\x7d\x9d\x3e\xc2\x8c\xe3\x60\xb2\x4f\x63\xab\xe3\xf3\xe8\xf0\x62\x06\x94\x30\xd2\xf4\xbd\x08\x85\x09\xf8\x19\xe3\xff\x9a\xb0\x78\x5a\x79\x93\x44\xb3\x69\x45\xcf\x41\xb2\x29\x23\x9b\x4d\xa9\x04\x7f\xcd\xd5\x04\x9a\xd9\x0e\x98\xfb\xdb\x57\xdf\xfd\xf5\x66\xd5\x1e\x08\xe1\xd7\xb7\xc0\x20\x3a\x97\x1c\x98\xe3\x4c\xb8\x24\x39\xf0\xdf\xbe\x70\xaf\x09\xc5\x3f\x0f\x9b\x14\x20\x04\x47\xfa\xc5\xa4\xde\x1a\x8e\xd9\x63\x2f\xfc\x22\xe4\x95\x1f\x44\xa5\xdb\x7a\x03\xc4\x75\x1b\xac\x6d\x29\x60\x3f\x87\x30\x09\xb4\xca\xcf\xa4\x0c\x16\x75\xcf\xb7\xe3\xd8\x3e\xca\xc0\x50\x4e\x21\x25\x06\x7e\x4d\xa4\x4d\xb2\x13\x99\x1b\x0f\xc7\x7c\xb8\xc8\x0c\xa8\x3c\x4a\x20\xa2\x73\x88\x7a\x1f\x93\x2d\xce\x15\xd1\x09\xbd\xac\xdf\x16\x3f\xf4\x1b\xe4\xe8\x8f\xa0\x0f\xda\x08\x54\xeb\xcb\x7e\xdc\x6a\x3c\xd8\x8f\xcc\x08\x1b\x4e\x66\xf0\x0d\xb9\xcb\xe7\x73\xde\x89\x78\xcc\x7c\xe0\x9b\x53\x24\x9d\x7e\x6d\x84\x9d\xae\x8a\xd8\x21\x2e\xa1\xd3\xa9\xc1\xd7\xae\x29\xa9\x10\xed\x58\xa0\x28\x93\x7c\x43\x5f\x0a\x8b\xe9\x61\xf0\x51\x0e\x41\x2c\x96\x8c\x1f\x3a\xc7\xf8\x72\xe5\x79\x1a\x2e\xfb\x1b\x50\x4e\xad\x22\xef\xbd\xc4\x90\x9c\x83\xa4\x3f\x64\xcf\xf6\x69\x66\xae\x9b\x9c\xcf\x9f\xc8\xb5\x12\xfd\x75\xc8\x09\xf0\xa0\xaf\x0d\x5f\x88\x18\xcc\x07\xb5\x1c\xd3\x6c\x0b\x21\x30\xfc\x90\xa0\x0d\x8c\x7d\x15\xc8\xb5\xbc\xf7\x5f\x10\x9c\x7b\x27\x0c\xa2\x85\xb8\x77\xaf\xeb\xd7\xa7\x37\x3d\xae\x41\x09\x4c\x43\x8f\xbf\xf5\xb4\x61\x6f\xf3\x73\x5b\x9c\x78\x8a\x94\x96\x77\x9a\x7d\xf5\x32\xe2\xc3\xf1\x81\x26\xb9\x7b\xa7\xd9\xcb\xfa\xf5\x7d\x29\x0e\x70\x08\xe5\x2d\x5f\xad\xb8\xe5\xab\xfa\xf5\xcc\xa9\xfe\x7a\xcd\x8e\x52\xc8\xc4\x77\x83\x4d\x58\x0f\x9e\xb6\x5f\xd5\xe3\xb1\x1b\x0c\x11\x46\x11\xb4\x87\x00\x61\x40\x92\x73\x79\x0a\x73\xa5\x7a\xd7\xda\x34\xe6\x84\x0a\x1f\x18\xc0\x84\x75\xfa\x80\x40\x4e\xe9\xc2\x5e\x84\xfc\x3d\x4c\x28\x2a\xf4\x2e\x6d\xc9\x90\x08\x7a\x96\x1f\x57\xe0\xf6\xe6\x20\xd3\x4b\x8f\xa5\x6f\x05\xf7\x76\xf6\x7b\xe5\x1f\x5f\x55\xe2\x9b\xfc\xf0\x9f\xf4\x6d\x16\x67\x3f\x04\x78\x16\xda\x4d\x01\x7b\x8b\x63\xf1\xc7\xad\x13\x5d\xaf\x0d\x37\xc7\x22\x74\x46\x5c\x28\x37\x40\x3a\xe9\x12\x8a\x70\xe5\x40\x07\xc8\xd5\x84\x50\x73\x79\x9c\x31\x20\xc5\x31\xa2\xa7\x94\xa1\xa5\x27\x1a\x7d\xac\xd6\xc3\xb6\x5d\xa6\xfa\xff\x8e\xbb\x27\x96\xfd\x60\xf8\xb9\x59\x4a\x41\xfa\x78\x8a\x0a\xc6\x4f\xda\x98\xa8\x43\x41\x78\xa8\x97\x2f\x8a\x0e\x20\x96\x13\x9a\x49\x45\xa3\x3d\x27\x86\xf3\x3d\xdf\xb7\xc2\xcf\x55\xdf\x0a\x55\x6b\x73\xe6\x9d\x06\x4e\x3e\x1a\xec\x52\xaa\x50\x7f\xf2\x67\xf2\xa0\xbc\xe1\x5f\xbe\xea\x54\xed\x77\xf1\xca\x3f\x1d\xfc\xcb\x45\xc8\xec\xf2\x85\x09\x56\x7b\xfe\x92\x8f\x83\x85\xf8\x6c\x87\xab\x80\x45\x2c\xd9\x59\x50\xd5\xf9\x8f\x5f\xa0\xd4\xf6\x08\xd5\xd1\x4b\x4b\x48\x51\xe4\xe5\x11\x94\x5d\x56\x20\xc3\x72\x70\x42\xca\xe1\x9b\xac\xe3\xee\x54\x98\xe4\xef\xbf\xf7\xe1\x49\x90\x29\x4a\x2a\x48\xd3\x56\x77\x32\xc0\x04\x89\x3f\x75\xab\x50\x2e\x51\xf4\xbd\x83\x36\x8a\x29\x6a\xde\x92\xa2\x9e\xc1\xe8\x0f\x8a\xba\x44\x30\x85\x18\xa0\xec\xe7\x0b\x2b\x94\xd2\xca\xbb\x5e\xfd\x9e\x50\x7a\xd2\xf0\xb4\xa6\x99\x23\xeb\xf9\x5e\xa5\x8a\x15\xe5\x0a\x41\x04\x95\x5a\xea\x51\x78\x01\x3a\xcd\x6a\xa3\xfb\x3e\x6a\x8a\xc6\xa8\x2d\x4e\x1b\x72\x2d\x82\xcf\xa1\xb4\xe9\x78\x8b\x17\x02\x5e\xfa\x65\xc9\x32\xda\x00\x79\x30\xfa\x84\x86\x41\xb6\x1a\xca\x73\xa0\x27\x45\x1d\x94\x64\xe7\x7d\x98\x49\x6a\xb0\xd2\x47\x33\xad\x93\xd8\x43\xb6\x3d\x32\xf8\x33\xa1\x6c\xfd\xa2\xf0\xcf\x13\x1d\x23\xc0\x83\xfb\x1b\x6c\x89\x31\x9f\x3b\xb1\xd7\xe6\xc8\x9e\xa1\x91\xfe\x20\x6a\x39\x40\x77\x1d\xe8\x69\x6d\x70\x97\xa3\x0a\x63\x78\xe5\x50\xfc\x01\x01\x5a\x05\xd5\x44\xa3\xbd\x75\x9f\xf4\x4c\xe0\x23\xc4\x3b\x8e\x12\x95\x3a\x8a\x69\x79\xd7\x32\x9c\xf0\x1d\xff\x24\xbb\xa1\x4b\xc4\xd0\x30\x56\x19\x0e\x51\xe8\xfe\x0c\x84\xf8\xa7\xe8\x77\xf3\x16\x1c\x7f\x20\x9d\x3b\x39\x61\x42\x67\x41\x02\x76\xf4\x66\x48\x5e\x94\x5c\x01\xbf\xdf\x40\x20\xde\x01\x99\x81\x85\xe6\x6f\x3f\xde\xd0\xe3\xe4\x1a\x61\x45\xfa\x11\x74\xf4\xc1\x0b\xaf\x37\x25\x76\x9f\x9c\xeb\x50\x0e\xe8\x90\x2d\xd8\x07\x06\xb9\x7b\xbb\xb4\x92\xd0\x84\xce\x2d\x24\x09\xd9\xe0\x3b\x4c\x71\x9f\x5f\x4a\x19\xec\x41\x2b\xf1\x0c\xfe\xde\x60\x87\x88\x5f\xda\xb4\xac\xe0\x5b\xb0\x9b\x6b\x71\xf9\xca\xfa\xe8\x4d\xd3\x68\x65\xad\x7f\x95\x63\x01\xa9\x87\x79\x9b\xb6\x31\xc4\x74\x71\xc9\xfb\xa4\xd9\x9a\x7d\x9d\x76\xee\x7d\x82\xc3\x43\x4e\xd1\x53\x38\x9d\x03\x5f\xab\xbf\x50\x0e\x7d\x82\x62\x14\xbb\xbe\x5a\x77\x90\x5a\x85\x76\xed\xa3\x33\x72\x06\x8f\x31\x07\xf8\x9b\x85\x58\xdf\x9b\xb1\xfb\x61\x0f\xcb\x48\x4f\x73\xa3\x2a\xe9\x2f\x79\xb5\x61\xbf\x80\x44\xc5\x6c\x3b\x26\xb5\x35\xc4\x96\xed\x46\xce\x68\xe4\x97\x8c\xdc\x81\xb4\x3d\xf3\x90\x48\xaf\x0c\xda\x52\xe1\xc8\xe8\xb8\xa5\xa6\x25\x6d\xd4\x91\xdd\x49\x25\x6c\x04\x52\x53\x0e\x9a\xd0\x1a\x3b\x26\xbb\x9e\x57\x2e\xf5\x4a\x5f\x0e\x47\x22\x35\x45\xc3\xf3\xba\x76\x08\xa1\xf0\xe6\xf3\x55\xbd\x9b\xa4\x7d\x48\xe7\x7f\x7c\xf4\x19\x56\x1a\x69\x47\x47\x70\x79\x82\xce\x49\x6c\xe0\xfa\xc6\x21\xf8\x8d\x02\x9e\xde\x81\x2f\xd2\x93\xe0\x96\x19\x3f\x50\xea\xa2\x9f\xa9\x57\xbc\x65\xbd\x14\xa6\xca\x01\x8f\x27\x0a\x9d\x01\x64\x5e\x56\x1d\xa7\xb3\x87\x43\x26\x59\xf8\x48\xdb\x8d\xc1\xab\x1b\xab\x59\x4e\x35\x6d\xc7\xe8\xf4\xb3\xba\xb4\x33\xee\x4c\x72\x4c\x76\x46\x5c\x0a\xa7\x3c\x21\x41\xf0\x2f\xd1\x2a\x59\x89\x78\x54\x0e\xd4\xef\xef\x6f\x82\xfe\x4c\xe5\x8c\x19\x6b\xf3\x83\xe1\x16\x1a\xf4\x0e\xb0\x4b\xfc\x76\xa7\x8d\xd1\x1b\x3d\xb8\xd4\x30\x9b\xe3\xab\x5e\x12\xa0\xd8\xfe\x3e\x70\x43\xeb\x39\x6b\xf2\x43\x23\x72\x0e\xd3\x85\xc7\x4f\xdf\x72\x15\x5a\x2c\x4e\x40\x07\x40\x66\x63\x8a\x8e\x5a\xa7\x9d\xbb\x06\x83\xf0\xb9\x46\x4d\xe0\x22\x01\xdd\x14\x7c\x9c\x53\x86\x6d\x61\x6f\xd3\x2f\x1f\x67\xe7\x42\x7b\xa8\x88\x14\x4d\x67\x61\x5c\x73\x8e\x4b\xa5\xd5\x3f\x07\x1f\x4d\xd2\x48\x0f\xb2\x75\x23\xb3\xb9\xb8\x8f\x1a\xc3\xdb\x76\x55\x54\xfc\xc5\x38\xe8\x56\xd1\xcd\x9d\xae\x8f\x83\x98\x97\xc0\x0e\xd8\x5a\xfa\x17\x8a\x14\x01\xd6\x19\xd2\x70\x81\xb6\xe3\x0d\x44\xc1\x39\xdb\x80\x1d\x0b\x7a\x2d\x02\x22\xe9\x0c\x1e\x8b\x34\xa4\x65\x31\xdb\xb6\x3c\xed\x67\x4a\xfc\x08\xc4\x1e\x57\x9e\x8d\xa3\x83\xb4\x84\x16\x91\x6f\x1d\x59\xe5\x70\xf0\xa1\xd2\x63\x4b\x0a\xb9\x3c\x64\x5d\xa0\x92\x13\x67\xe4\xa9\xe7\xbc\x63\x7a\xd4\xa7\x3f\x0b\x53\x89\xde\x25\x79\x75\xcb\x3a\x5e\x43\xe2\x00\xf4\x67\xe4\x5d\x29\x50\x38\xee\x72\x98\xdb\xa0\xfe\xb9\xd2\xdf\x33\x25\xf0\xa4\x44\x1a\x26\x3f\xa7\xf9\xc1\x36\xb1\x44\x4f\x57\xe4\xfc\x90\x1e\x26\xbf\x04\xd0\xdf\xac\xda\x32\x66\xe8\x61\x3a\xb1\x01\x66\xb6\xa8\xfe\xb9\xb5\xb0\x15\xe0\x92\x98\x54\x22\x18\xb2\xde\x5a\x59\x4b\xbe\x94\x4f\x5a\xdd\xb4\x7c\x51\x83\x21\x1d\x2b\xf9\x2e\x29\x01\xa1\xa3\x86\x42\xa8\x56\x3a\xd2\x48\xaa\x9a\xc1\x78\x63\x8b\x8d\x23\x88\xed\x05\x6c\xa1\x9f\x64\x5b\x9e\x6a\xd0\x4c\x38\x0f\x15\x1e\x43\x72\x2f\x6e\x96\xb9\x19\x43\x9b\x5e\xd5\xd7\x2f\xe7\x70\x76\x7a\xb2\x33\xbc\x17\x34\x83\x83\xd2\x23\x10\x15\xf4\xd0\xdc\xc4\xfc\x66\x4b\x5d\xf4\x73\xed\x34\x2b\x5b\x66\xa8\xcb\x10\x9e\x1c\x1b\x3b\x9f\xd1\xc1\x3f\x6a\xde\x87\x8b\x46\xa6\xba\x28\x80\x16\xac\xac\xdf\x9c\xd8\x72\xbd\x3d\x02\xa3\xce\x9f\xa9\x2d\xee\xfa\xe5\x9f\xa7\x2f\xce\xaf\x89\x35\xbe\x29\x12\x6e\xb5\x4a\xd8\xa9\x6c\xe6\x9c\x6c\xd2\x25\x96\xe1\xde\x72\x56\xff\x95\x53\xd4\xad\x2a\xd1\x5d\xd2\x81\xe3\x8f\x5c\xeb\x44\x8f\x4e\x21\xd6\x53\x81\xc4\xd2\x88\x3d\xf2\xe5\xe0\x52\x0c\x73\x83\x6a\xdf\x9b\xb1\x1b\xe8\xb7\xae\x83\x3e\xd3\x96\xf7\x94\xd9\x98\x2a\xcb\x20\x73\x27\x32\xdd\x84\x2b\x7c\x8b\xb7\x8b\xd7\x1f\xe7\xa3\xd3\x21\x12\x2a\xc3\x23\xfa\xb3\x1a\xd5\xa7\x73\x84\xf5\x69\xde\x65\xd2\xb6\x9c\x69\x9c\x05\xd6\x36\xff\xe9\xd0\xfb\x5b\xd6\xfa\xa0\x28\x3f\xcc\xc0\x88\xe9\x1d\x7c\x0c\x5c\x3a\x95\xb6\x2e\xab\xd0\xfb\xcf\x33\x52\x52\x81\x0e\x06\x1c\x6d\xc1\x11\xa5\x63\x1e\xd5\xd4\x89\xc4\x0a\x29\x8a\xb2\x14\x34\xa6\x4e\x5b\xad\x6f\x2d\xdb\x1b\x4e\x3e\x02\x0d\xfd\x89\xa3\x2b\xd3\x97\x13\xfe\xee\x6d\xde\xbb\x7c\x36\xe2\x26\x13\x3f\x7e\x9f\x61\x82\x80\xb8\x13\x73\xd8\x54\xb3\x2d\xc0\x4c\x25\x6d\x9b\x4d\xc6\x1c\x5f\xef\x77\x13\x6e\xdc\x24\x6a\x3d\xba\x25\x3d\x53\x74\x2e\xe0\xb4\x8f\xfa\x44\xd2\x1f\x16\x11\x02\x0d\x44\x6e\x83\x82\xe4\x18\x8f\xd2\xed\x41\x88\x38\x28\x24\x83\x7f\xb3\x97\x77\xe8\x6e\x63\x90\x15\xbe\x03\xe9\x66\x66\xf5\x39\x7e\x1d\xb9\x57\x1a\x14\x93\xda\xc0\x86\x84\x10\x7a\xaa\x3b\x9f\x7d\xaa\x0d\xb2\x96\x92\xc8\x5f\xd4\x2f\x0d\x7d\x35\xe1\x9a\xfe\xb3\xf8\xe4\x74\xa1\x53\xcb\xe4\xe2\xd8\x02\x9a\x4e\x33\xd9\xd8\xa4\xf5\x14\x6e\x23\xe6\xee\x93\xb2\xe8\xa4\x39\x4c\x5b\xb7\x3d\x82\x7a\x64\x7f\x4c\x24\xc8\xf0\xcf\x04\xb1\x84\x5f\x00\xae\xc5\xea\x56\xd6\x21\x6f\x9f\x71\x25\x87\x42\x37\x24\x9b\xfb\x5e\x28\x1a\x26\xb8\x77\xd9\x50\x6d\x33\x28\x97\x98\xee\x94\x3f\x2e\xad\xcb\x89\x10\x30\xc7\x5f\xb8\xf7\x34\x1c\x70\xea\xbd\xb3\x90\x63\x5a\x93\xdc\xb2\x3b\x48\x4a\xa0\x26\x69\x37\xd8\x36\x61\x1c\xfe\x45\xad\x3d\x95\x8a\xea\x53\xf0\x55\xe1\x18\x95\x6e\xc4\x75\x3c\xbf\xf8\x51\x2e\x17\xfe\x93\x68\xd8\xb0\x28\x4e\xc6\x57\x66\x34\x21\x19\x10\xe2\xa7\xc1\x09\x83\x57\x78\xdc\xb2\xef\x2c\x95\xea\xa8\xec\x8b\x59\x12\x04\xef\x8e\xd9\xdf\x2c\xc8\xbf\xb6\x43\x4d\x1b\xe1\x6a\xc1\x17\xf8\xcb\x59\xf4\xdd\x75\x42\xdf\x81\xd4\xe2\x7c\xd6\x61\x55\x08\x70\xc7\x4d\xfd\xc4\xb2\x6f\x5b\x5e\xdd\xb2\x5f\xbd\x13\x51\xb5\x62\x7a\xd8\xcf\x61\x6b\x1f\x36\x19\x35\x8e\x02\x1c\x8d\x25\xa4\x7c\xf6\x20\xfd\xa8\x2b\xa7\xfb\xc1\x92\x3e\x2c\xfc\xc9\xfe\x3e\xc8\x25\xbe\xc3\xfb\xe4\xb2\xce\x30\xb7\xcf\xb8\x0d\x1f\xbd\x4f\xda\x41\x29\x57\x6c\xb5\x3a\xc6\xf1\x5b\xb6\x93\x6d\x3b\x4d\x69\x69\x75\xef\x5e\x45\xcc\x6b\xa5\x1b\x3c\x6c\x6a\x0b\x76\x46\x56\x0c\x20\xb2\xc2\x02\x80\x0b\x97\x0e\x8e\x49\x86\xd7\x85\x50\x01\x86\x03\x9d\xf3\xdc\xb8\x8c\xdf\x84\x68\xc4\x8b\x34\xf2\x25\x6d\x51\x60\xcb\x5e\x9d\x6e\xf4\x9f\xcd\x9c\xa5\x59\x3a\x9b\x31\xc3\x16\xab\xd1\xa0\xf3\xb9\xa4\x06\x18\x52\x0f\x5c\xbc\x59\x22\x3c\x9b\x0e\x76\x65\x5e\x71\xe9\xf2\xeb\x72\x78\xda\x8c\x51\x47\xec\x29\x88\x31\x42\x4a\xe8\xea\x61\xb2\x7b\x17\x30\xa0\x7d\xff\xa9\x17\x10\x35\x0e\x96\xfd\x22\x9c\x7f\xd0\x55\x59\xef\xd9\xa8\xe2\x4b\x4a\xe5\x66\xe2\x9c\xe7\xa0\x2a\x67\xe3\x8b\xec\xd0\x24\x09\xfa\xdc\x2f\x02\xb6\x48\x8c\x1c\x6b\xd2\xd5\xaf\xc4\xf3\x93\xa7\xd5\x26\x11\x0e\xf2\x31\x73\x8e\xdf\x4e\x2b\xfb\xdc\xf2\xa3\x8c\xa8\x14\xdf\x71\xdb\xd0\x85\x56\x9d\x23\x47\xb1\x95\x6e\x5a\xc4\x98\x23\xfd\xbe\x24\xf7\xfa\x45\x35\x8f\xcf\xe8\x5f\xcd\xbc\xcc\xf7\x27\x18\x0e\x48\xd2\x58\x1c\x41\xec\x1a\x9a\xc5\xe0\xb5\xde\x1e\xd9\x9d\x96\x75\x21\xce\x5b\x7b\x6b\x82\xf2\xbc\x7e\xdb\xe5\x88\x8f\x32\x85\x4b\xc7\xc2\xdb\xf2\xf0\x9f\x9c\x1e\x97\xa8\x7c\x14\x91\xcc\x12\xbe\x74\x34\x94\x29\x46\xea\xa7\x7b\x2c\xb6\x59\x21\x06\xc4\x2e\x8c\xe9\xb0\x73\xee\x5a\xfc\x46\x86\xa4\x05\x99\x72\x04\x45\x7a\x3f\x94\x9e\x3d\x3c\xfd\x09\x59\x01\x40\x12\x44\x29\x74\x5a\xbf\xcb\xd4\x54\xad\x10\x7d\x81\x43\xdc\x01\x37\xeb\xa0\xa2\x7c\x07\x80\x3a\x03\xf3\x25\x0d\x29\x74\x9e\x1c\x63\x3e\xcd\x30\x39\xc3\xf8\x3d\x04\x4c\x48\x46\x64\xde\x84\xed\x08\xf7\x16\x06\x59\x5d\x97\x46\xf9\x33\x57\xb2\xba\x15\x75\x31\x4e\xa8\xa5\x02\xe9\x8f\x3f\x4d\xf0\x15\x15\xaf\x2c\x96\x87\xf1\x78\xce\xbe\x5c\x9e\xd4\x73\xe6\x01\x4c\x10\x1a\xaf\x03\x3f\x46\x15\x8f\xd8\xe9\xc3\x91\x1d\x11\x18\x10\xb4\x71\xf0\x8f\x80\x48\x62\x46\x0f\x4e\xc4\x50\x36\x2a\xbe\x2b\x4d\xcc\xe2\x68\x16\xc7\x25\x77\x6c\x7a\x76\x1a\x43\x06\xe4\x81\xc0\x4c\x60\x1e\xfa\xa7\xa1\xc4\x7c\xcc\x28\x51\x2e\x04\x46\xd2\x2e\xe3\x57\x3c\x07\x39\xfe\xe8\xa7\x57\x8d\x26\x78\xae\x90\x30\x69\x63\x08\xa1\x77\xc0\xff\xde\x4c\x19\x0b\xca\x57\x51\x60\x02\x69\xb9\xcc\x56\x62\x7e\xdc\x11\x47\x59\xa6\x85\x31\xfb\x38\xa7\x0d\xf8\x7b\xbe\x35\xb2\xe2\x33\x26\x7c\x6d\x5b\x5d\xae\x60\xfd\xa5\x1b\x4c\x17\x01\xa2\x40\x37\xed\x98\xe1\x87\xc8\xdc\x66\xd1\x2f\xee\x8d\xae\x07\x32\x6f\x31\x78\x0f\xdf\x41\xcf\x9b\xf0\x56\xc9\x68\xed\xc2\x44\x31\xce\x0e\x5a\xd7\x42\xb1\xad\x91\xf5\x3e\x06\x2a\x55\xa0\x4e\x71\xde\x63\xda\x30\xce\x8c\xee\xe3\xa7\x3d\x80\xa5\xf4\x8e\x35\xa2\xeb\xa9\x59\xaf\xf5\xaf\x96\xe8\x08\x76\x2d\xff\x04\xb4\xca\x5a\x2f\xaa\xac\x50\xcd\xa2\x7c\xa8\x33\x91\xc4\x3f\x66\x1e\x21\x02\x61\x02\xfc\x95\x72\x15\x4f\xa1\xe5\x1e\xbc\x4f\xba\x48\x62\xf9\xaa\x86\x2d\x56\x0b\x10\x04\x57\x69\xa5\xf0\x14\xc9\x48\x19\xed\xd5\x86\x52\x4f\x1c\xc4\xda\x25\xc0\x92\xd8\xef\x03\x57\x0e\x72\x54\xbb\x62\xec\x31\x95\xc2\x51\xa2\xe3\x16\x00\x03\xfe\x78\xec\x04\x28\x82\x13\x0d\x17\x20\x6e\x71\x27\x74\x12\x65\x8d\xec\xb0\xc5\x8e\xbc\x0d\x6b\xf4\x41\xc4\xde\xbd\xf8\x94\x31\xff\x42\xa9\x73\x6f\x00\x10\xf5\x03\xd9\x42\x82\xd9\xcd\x3d\xb0\x95\x6a\xdf\x8a\xfc\xb1\xae\x30\x32\xff\x7d\xe0\x2d\x3d\x45\xc8\x60\x41\x71\xb0\xe8\x6e\x04\xea\x94\xae\x13\xca\x0e\xd0\x4b\x1b\xc9\x0b\xb2\x5f\x43\xb3\x7a\xfe\x0e\x17\xdf\x79\xca\x32\x86\x0c\x9f\x74\xa2\xb3\x59\xdd\x15\x7a\x91\x91\xf9\xc8\x70\x65\xbb\x61\x9e\xa3\x3e\x28\x04\xf9\x9f\x61\x1c\x01\xa5\x13\xa4\xda\xc1\x6b\x22\x47\x84\xa9\xa5\xe2\x46\x42\xd2\x0c\xaa\x2e\xd0\x3d\xbd\x6f\x58\x2d\xf6\x46\x08\xea\xdc\xde\x39\xdb\x71\x65\x1b\x99\x51\xe6\xff\x53\x1c\x44\x6b\x8e\x9b\x00\xff\xdb\xb0\xbd\xdf\x9d\xf8\x0a\x4d\xa7\x4d\xb4\xfa\x91\xe5\xb9\x37\x1a\xd7\x49\x75\xcc\xb8\xe1\x8f\x3d\xb2\x20\x18\x27\x2d\x57\x4f\xbc\xdd\xd7\xfe\xd4\xb5\x98\x16\x83\xdb\xe3\x5d\xe9\x55\xac\x33\x77\xc2\x48\xc1\xde\x4b\xb3\x0e\x78\x73\x51\x43\xfd\x19\xc0\xc0\x63\x2a\xd6\xfd\x0d\x78\xfc\x62\x53\xd2\x44\x94\x6e\xc6\x46\x42\xd5\x2d\xc8\x7b\x4c\xaa\x94\x05\x4c\x40\x5a\xa6\x07\xd7\xc2\x26\x91\xca\x87\xb6\x62\xc3\xfc\x3a\x40\xb6\xe0\x3b\xa9\x5b\xe1\x88\x1b\xf7\x69\xe6\x2f\x5e\x8d\xc0\x02\x13\xc8\x4c\xb9\x69\x48\xd4\x1c\xd6\x68\x7e\x3f\xbc\x30\x7a\x55\x3b\x2e\xfd\xb1\x7a\xb2\x0c\x3a\xcd\x9a\xe4\xe9\xeb\x09\x26\xdd\x32\x60\x3d\xad\x65\x17\xee\x32\x43\x6b\x78\x86\xdb\x35\xef\x95\x0f\x7e\xe6\x2c\xfe\x3d\x21\xb9\x4b\xe0\x82\xa4\x22\x27\x5c\x87\xc8\xe7\xbd\x15\x97\x19\x4a\x72\xe9\x6a\xc1\x0c\x64\x6c\xed\x5b\x01\x50\xfe\xa0\x0e\xb4\x6e\x6b\xb4\x56\xb0\xbf\xc9\xdd\xba\x9d\xf1\x80\xd1\x5c\x90\x6a\x42\x5e\xe7\x4e\x0f\x08\x14\xe5\x6d\xa5\x1b\x0d\x99\xd7\x5a\x5a\x27\x5b\xa8\x58\x23\xd1\xf8\x42\xde\x6f\xb1\x85\x2f\xec\x97\x77\x82\xbb\x66\x5e\xc7\xf1\xbf\x54\x7d\x94\x6a\xbf\x94\xf4\xfb\x56\xb7\xd6\x21\xff\xf9\x11\xc4\xf5\xda\x5d\xc0\xce\x2a\x51\x19\xdd\xf9\xc3\xae\x62\x3b\x5e\x01\x19\x3d\xd8\xb1\x56\xee\xc8\x9b\x80\xb0\xe5\xba\x7e\xfd\xd5\xeb\xd9\xde\xb8\x69\x90\xf5\xc5\x89\x25\xe3\x28\xdf\xe4\xfd\x4c\x27\x07\x7b\x09\x34\xf2\xf5\x57\x6b\x1a\x4b\xdf\x0b\x3e\x55\xd5\x9e\x83\xa4\xcc\x6a\xf2\x2c\x72\xb1\xbe\x0a\x56\x4e\x2b\xb1\xc4\xc4\x4a\x8b\xf2\xd0\x48\x27\x62\x6b\x29\xbd\x99\x46\x10\xcc\x96\xb3\x46\x3c\x74\xff\xd3\x43\xab\x71\xf7\x46\x63\xf7\x48\xae\x27\x21\x43\x1b\x75\x21\xc8\x73\xd0\xc6\x3a\xff\xb0\x66\xc2\xe5\x0a\x76\x31\x9f\xbb\xf5\xf9\x86\xda\xe8\x1e\x08\xf8\xbd\x8f\x46\xed\x45\x41\xe3\x0a\xf3\xb7\x90\x28\xc9\x82\xcf\x4b\x76\x00\x16\x73\xb3\x1f\x8f\xe0\xd3\xfc\xe1\x42\xd9\x32\x7a\xcd\xf3\x6c\x59\xf0\xb6\x36\xa4\xcd\xf2\xec\x75\x99\x77\xe6\x49\x8c\x20\x06\xb0\xa1\x2c\x06\xfe\x92\x3f\x18\x81\xe5\x1e\x4e\xac\xa0\xef\x35\x81\x69\x9f\x95\x1e\x99\x25\x48\xca\xa0\x67\xf8\x1a\x32\x8d\xaf\x55\xc7\x08\x6d\x95\xf7\x73\x98\xcc\xd5\x59\xde\x20\x3c\x79\x39\x28\xf3\x01\x76\xed\xa5\x1b\xf4\xd4\x31\xf1\x4b\x50\xcf\x7c\x96\xac\xb2\x36\x33\x94\x46\xa3\xd4\x1e\xa6\x97\x28\xd2\x43\x9b\xb1\x03\xe9\x54\x3e\x2e\x2e\x4e\x80\x69\xa3\x6f\x3c\xb1\x0c\x42\x10\xe0\x23\xb7\x15\x68\xef\x41\xee\xd9\xe9\x93\x9c\xaa\xe3\x4a\xc9\x78\xbd\x82\x50\x52\xea\x0a\x29\x53\x7c\x31\xef\xa5\x74\x1c\x4c\x56\xaa\xf1\xcb\xb2\x05\x51\x25\x14\x2a\xf4\x03\x98\x53\xcd\xbb\xef\xd2\x13\xdb\x56\x74\x52\xd5\xeb\x78\x56\x2f\x71\x61\xae\xdf\xac\xad\x64\x66\x8a\x6e\x55\xcb\x8f\x1b\x56\x99\xa3\x85\x58\x36\x05\x42\x31\x86\xed\xfd\x06\x5f\xf2\x66\x2e\xa6\xfd\xfe\xdc\xe3\x62\xdb\x86\x62\xb2\x9f\xc8\xf2\x88\x58\x4e\x34\x6c\x82\x50\x35\xe9\xc2\xd8\xc6\xff\x1b\x99\x7e\x24\x74\x80\x07\x2c\x4d\x0f\xaa\xe1\x10\x0c\x17\xfc\x42\xb8\x74\x5e\x4f\xe4\x82\xd0\x3e\x27\x10\xe4\xa8\xa3\x7c\xad\x78\xf4\x59\x6e\xd2\xe2\xaa\xfe\x9e\xb1\x1d\xde\x56\xda\xa4\x9c\xfe\x75\x59\xa7\x27\x07\x1c\x1c\x2e\x58\x92\x16\x35\xdd\xee\xfc\xd6\xcb\xe2\x75\x7f\x38\xd4\xc2\x00\x77\xa4\xdf\x06\xfb\x01\x18\xf7\x34\xe0\x9d\xbb\x41\x61\x6e\x86\x40\x59\x92\xc6\x02\xd5\x20\x80\x49\x8e\x6f\x99\xe5\x3d\x64\x2d\x94\x93\xbb\x23\x6e\x2a\x10\xe1\xb6\x1b\x4a\xe7\x03\x06\x88\x08\x30\xef\x84\xf2\xfe\x80\x23\x96\xb0\x45\x48\xcd\x28\x4d\x09\x00\xb0\x57\x2f\x58\xcd\x8f\x76\xe6\xb0\x31\xa2\x17\xdc\x4d\x55\x94\x8a\xc4\x3b\x25\x22\xca\xb4\x65\x14\x2d\xcd\x7e\x76\x89\xac\x6f\xc6\xc8\x16\x84\xdf\xb6\x30\x6c\xf4\x05\xf6\x90\xdc\x30\x50\x56\xd5\xc1\x11\x6b\x04\x2a\x78\x1e\xa4\x6d\x26\x4e\xea\xeb\x55\x45\xcd\xf7\x42\xee\x15\x83\x38\x62\x95\xa7\xfa\x9f\x17\x48\xde\x7e\x0e\x1d\x4a\xd6\xa5\x0f\x3a\x16\x47\x6e\x6a\xa0\x7c\xbe\x6f\xc8\x34\xcb\x7a\x72\x49\xb4\x94\xd3\xa0\x4c\xd4\x11\xc1\x1c\x0c\x00\x17\x82\xd4\x2c\x67\x15\x77\xbc\x15\xbd\x0f\xa0\x40\x1f\x31\x36\xd8\x4b\x05\x61\xa0\xda\x0f\xd2\x36\xe0\x87\x81\xa3\x56\xfb\x57\xb0\x4c\x54\x71\xaa\x58\x37\x41\x54\x16\xec\x94\xa9\xfb\x2d\x56\xa7\xea\x29\xc8\xb2\xec\xbd\xa5\x9e\xc7\x5a\x70\x48\x52\x01\x7c\x6e\x70\xc0\x64\x28\x95\xed\x45\x72\x37\x51\xaf\xb6\xcd\x32\x5a\x91\xab\xb7\x6c\x92\xf5\x93\x30\x14\x2d\xb9\xc0\xc6\xd9\x4a\x58\xe1\x28\xf1\x9b\xf5\x05\xa4\x5e\x65\xa4\x6a\x4f\xd4\xa5\xcf\xc7\x95\x8b\x92\xd4\x0c\x5a\x9e\x09\x2b\xf6\xa9\x12\xbd\x1b\x6b\xb6\x4d\x79\x0c\x6a\x69\x05\xb7\x98\x77\xec\xb5\xb4\x1a\xb2\xa8\xa7\xa4\x07\x23\x81\xe6\xc9\x1f\x7a\x4f\x1e\x4c\x64\xf1\xbe\x82\x5c\x94\x43\x7e\x54\xff\x43\x22\x5b\xf5\x3f\x44\x97\x38\x61\xd0\x96\x39\x6f\x16\xf6\xb3\x3f\xed\xde\xf3\x4e\xb6\x72\x26\xf6\x3c\xdf\x94\xb7\xbc\xa3\x71\x7f\x8d\x7d\x8a\x75\x2e\xc5\xf5\x0b\xb6\xef\x49\xbf\x1a\x1a\x0e\xb8\xa9\xb4\xf7\x27\xa4\x0f\x2e\x83\x8e\x7c\x23\xcc\x96\xb2\xb7\x01\x35\x56\x69\x65\x87\x0e\xcd\xe0\x4e\x86\x20\x6e\x6b\xb8\xb5\xfe\x7f\xff\x90\xe8\x05\x3c\x56\x01\x06\x32\x08\xf0\x22\x84\xb9\x93\x81\xd3\x76\x47\x53\x0c\xc2\x63\x90\xcc\x21\x1a\xbe\x40\xd4\x87\xc2\x5e\xa0\xaf\x93\x89\xe5\xb3\x67\x6c\xcb\xdd\xc6\x5b\x07\xef\x51\xf1\xed\xc6\xef\xfe\x3d\x7b\xea\x34\xaf\xaf\x36\xac\xe1\x87\xdb\x0d\x6b\xc9\xe7\x21\xb8\xd8\x86\xe9\x43\xbb\xa1\x85\xa2\x07\xcb\xac\xe2\xb7\x62\xc3\x76\xd2\x36\xec\xe9\xef\x83\xec\x7b\x61\xae\x36\xde\x47\xf6\xff\x73\x27\xd4\x86\x59\xc1\x59\xa3\x8d\x9f\x56\xdb\xcb\xda\x9f\xae\xfe\xa0\xf0\xab\xae\x7d\xce\x6e\x60\x83\x63\x6e\x6d\xa4\x4c\x85\x80\xcc\xd2\x21\xc2\x7a\x04\x3e\x30\x6c\x39\x6a\xd2\x75\xde\x90\x55\x76\xc4\x63\xe6\x1f\xd9\xff\x06\x10\xb2\x32\x70\x74\x88\x56\x58\x27\xfd\xfb\xde\x09\xc0\xf8\xee\xfc\xf1\x5e\xe6\x59\xa3\xb0\x33\x4c\x31\x30\x5f\x9d\x01\xf8\x9a\x34\x2e\x5e\x81\x83\x86\xd4\xba\x84\x73\xdd\x65\x9a\xd1\x8e\xf1\xf6\xc0\x8f\x96\xe9\xad\x38\xda\xd8\xa0\xd5\x71\x0c\x0f\x94\xff\x07\xbc\x1b\xe9\x02\xed\x99\xb3\x4c\x1f\x7c\x30\x2b\x9d\xe4\x0e\x5b\xa6\x6a\xbc\x11\x9d\xf6\xa0\xef\x0b\x2a\x47\x37\x69\x24\xe8\x47\x61\x1e\x34\xde\x3c\xc2\x53\xb0\xfc\x13\xe4\x6b\x01\x78\x0a\x4c\x0b\x6b\x60\xc1\xf1\x16\xa7\x99\x9c\x6b\x69\xc9\x7c\x6f\x40\x89\x0e\x24\x95\x45\xe3\xcd\x82\xd2\x49\xf7\xdb\xbf\x24\x28\xf7\x1b\x11\x3b\xdc\xc7\x0c\xe7\x09\xe9\xb3\x07\xb6\xfd\xdf\x02\x10\x36\x9b\x75\x69\x13\xaa\xb3\x08\xff\x52\xc9\x31\xf7\x0a\x89\x6d\x85\x39\xd1\x8a\x9e\xbb\x06\x9b\xe7\xc6\xfd\x38\x39\xc1\xf2\xa6\x70\xd4\x03\xf0\xba\x18\x84\x37\xae\x47\xa2\xae\x06\x94\x3e\xc8\x24\x4a\x07\xff\x58\x21\x51\xb5\x89\x72\xa1\xd0\x2f\x81\x09\x68\x58\xd5\xc0\xe4\x4d\xf0\x74\xeb\x8d\x14\x99\xa6\xf1\x86\x38\xd5\xa2\xc0\x8d\xf0\x87\xe8\x0e\x13\x44\xfe\xac\xc3\x19\x40\x31\x76\xca\x79\x18\x58\x45\x78\xf9\x73\xe2\x62\xd3\x59\x09\xe9\x4c\xd9\x1e\xe3\xa9\x5e\xcc\x0e\xbc\xe5\xb4\x2a\x82\x7f\xd2\xeb\xea\x56\xe4\x52\xfd\x31\x49\xc3\x0f\x5c\x12\xa6\xdd\x0e\x5d\xa7\xa1\xeb\xbe\x75\xc2\x28\xd8\x03\xfe\x05\x25\xa6\x5e\xbc\x21\x6a\xe8\x7a\xe7\xfa\x39\xcb\x87\x49\x87\x21\x1a\x81\x99\x91\xfa\x73\x3e\x2e\x13\x9e\x4a\x79\x61\x51\x86\x28\xe2\x94\x7d\x7a\x55\x2c\xb9\x15\x62\xbb\x21\xf7\x54\xb2\x1d\x27\x1b\xe2\x40\x90\xb3\x13\xcf\x4f\xb5\xe2\x67\x60\x70\xc2\x51\x92\xc2\x6b\x3a\x10\x8e\x24\xb5\xe8\x0d\x59\xfe\x50\xbc\xd6\xbd\x83\xdc\xfa\x81\xb6\xdf\x1c\x35\x9e\xe9\x6c\xea\x29\xc7\x14\x74\x2b\x2d\xf6\xa3\x64\xfb\x0e\xea\xa3\xf8\xed\xd8\xe9\x43\xf6\x77\x9a\x6b\x98\x13\x48\xa0\xad\x56\xba\x39\x21\xad\x4e\x55\x00\x2c\x9b\xf9\xa1\xa1\xf0\x77\xb9\xf5\x73\x71\xe1\xe4\xa8\x70\x1b\xb1\x4c\xf5\x44\x2a\xa1\x7c\x86\x09\x2c\x7c\x64\x40\xd0\x03\xc4\xaf\x05\xb8\x68\xc8\xa8\xa1\xa7\x39\xbe\x79\xf9\x34\xd2\x45\x67\x0f\x3f\x4e\xad\x77\x05\xbe\x65\x33\x6a\x45\xc2\x4f\x3a\x5d\xcb\x9d\xcc\x18\xb5\xfc\x57\x57\xbb\x60\x1f\x9d\x10\xd3\xfc\xcd\x5a\xe9\xcb\xd3\x60\x94\xc7\xd6\xca\x3d\x4d\xc8\xfa\x8f\x68\x0f\x46\x2e\x0f\xb7\xde\x3d\xb3\x71\xfd\xa2\xe1\x64\x83\x1a\xec\x00\x12\x02\x32\xe6\x23\xdc\x86\x59\x67\xb4\xda\xe3\xcb\x6d\xf5\xd1\x1b\x56\x3f\x55\x99\x84\x39\x07\x80\xd3\xb3\x96\xe0\x6d\x5b\x1d\xac\x26\xb0\x74\x5d\xee\xb9\xc0\xf5\xc9\x31\xc3\xae\x51\x3f\xc8\x94\x03\x82\x4d\x98\x2a\xff\x9c\x1d\xb8\x21\xd7\xc9\x9b\x49\x75\x84\xec\x3b\xef\x90\xc0\x9c\x89\xf6\x96\xa4\x51\x3b\x3f\xc2\xdd\xee\x39\x7b\x0a\xeb\xfa\xdd\x07\xd2\xd4\x05\x60\x6c\x38\xe7\xc1\x0d\x84\xa3\x7a\x2b\x68\xfe\x7c\xbc\x63\x71\x58\xf6\xf9\x15\x76\x4a\xc3\x18\x1f\xc2\xb5\xda\xac\xf0\xad\x80\xec\x08\xa9\xcb\x90\x36\xba\x3c\x7e\x25\x1d\x90\x69\x50\xe3\x54\x95\xde\xb1\x37\x81\xcf\x71\x03\x97\x2b\x3e\x0e\xf1\xd1\xdb\xb8\x87\x13\xa7\x67\xd6\xc1\x95\xa5\x91\xbc\x09\x0c\xa9\xa4\x93\xbd\xff\xa3\xce\xf0\x13\x0e\x21\x8e\xda\x3b\xeb\x02\x0f\x31\x78\xa7\x50\x8e\xdd\xb0\xad\x06\x76\x34\xf2\xf5\x50\x18\x7f\x70\xa9\x31\x0f\x8d\x39\x6a\xff\x4a\x55\x81\xcf\x97\x2f\x40\x5a\xf1\x05\xf2\x79\x87\x2f\x1d\xfa\xa5\x04\x47\x8d\xe0\x41\xf9\xc5\x8a\x0e\x13\xdc\x1a\x8b\x4b\x69\x5a\xb3\x16\x46\xcc\x05\x1e\xc9\x46\x65\x87\x0e\x0f\x70\x51\x8b\x6d\x7c\xc7\x80\x7c\xa1\xd0\x35\x5d\x6d\x5d\x4f\x99\x2b\x03\xe9\xcf\xf2\x1c\x03\x18\x0b\x29\x40\x73\xbf\x03\x6f\x03\x93\x7b\xc4\xb6\x8a\xd2\x9d\x0b\x5c\x0f\x78\x28\xc6\x9b\x82\x0b\x4e\xdc\x83\x15\xf7\x9b\x2f\xe0\x5b\xc7\x8e\x68\xf0\x4a\xb2\x86\x35\x9c\x51\xcc\x8d\x61\x16\xc2\xaf\xc9\xf8\x7c\x81\x66\xee\x7c\x01\x2e\x7b\x82\xcc\xb3\x65\xa1\xfa\x7d\xa9\x5f\x7b\x5f\x87\x04\x47\xe0\x17\xde\x44\x4f\x2e\x73\x51\xe6\x1d\x42\x23\x5a\xc8\x2d\xa4\x57\x8e\xdd\x80\xce\xe2\x4a\xce\xde\xcc\xe9\x97\xb1\xf6\x98\xfb\xd5\xf0\x7e\xda\x6e\x35\x77\xcc\xbd\xfb\x33\x49\x7a\x8c\xd2\x80\x27\xa9\xc6\xe0\xcc\x43\x55\xb5\x46\xb0\xde\x08\x1b\xcc\x1f\x4c\xa1\xe1\xfd\x88\x96\x93\x72\x7c\xf4\xc7\xa2\xc2\x09\x42\x51\x86\xf7\x9b\xe8\x50\xf4\x83\xe9\xc1\xff\xdb\x15\xa5\x5e\xec\xb4\x43\xd0\x75\x68\x24\xe6\x8e\x1d\xf4\xd0\xd6\x4c\xaa\x5d\x2b\xa1\x0c\x6e\x87\x1a\xb5\xda\xd9\xa0\xc4\xa7\x1e\x61\x35\x94\x3d\x3a\x22\x70\xd4\xfa\xc0\x1f\x84\xcb\xa1\x92\x63\xc0\xe2\x5a\x69\x38\x68\xde\x20\x77\xf1\x81\x5b\x0c\x71\x76\xa4\x91\x04\x3a\x72\x35\x9d\x51\xc0\xb5\x8e\xf9\x78\x38\x9f\x35\x28\x29\x0c\x05\x75\x27\x0e\x0c\xa7\xc9\xaf\x47\xe8\x35\x1a\x09\x26\xf0\x96\x9b\x2e\xd5\xb2\xf7\xed\xb1\x87\x2c\xd0\x81\x9b\x3a\x10\xae\x73\xd6\x89\xaa\xe1\x0a\xac\x4c\xef\x57\x24\xcc\x16\xc5\xcf\x78\x17\xa5\x7d\xc4\x7a\x27\x78\xeb\x1d\x69\xef\xe6\x22\x3b\xfc\xad\x02\x66\x61\x8a\x92\x5b\xad\xcd\x06\xbd\x03\xeb\x20\xc5\x5a\x09\xd9\xa6\xfb\x34\x12\xa6\xce\x4a\x75\xdb\xe8\x76\xad\x92\x59\x27\x0c\xb6\xdf\xde\x89\x88\x56\xe5\xb8\x08\xa4\xa5\xc5\x41\x7d\xe3\xb5\xf6\x1b\xbb\x15\x9c\x3a\xdb\x62\x25\x3c\x6a\xbb\xc5\x47\x3b\xc2\xd7\xb3\xef\xee\x05\x16\xd5\x4a\x49\xa0\x1a\x96\x28\xf3\xeb\x05\x99\x49\xf1\xc6\xc7\xb0\x40\x57\xef\x58\x7f\xb1\x9f\xe7\xb2\xfd\x73\xbd\x2e\xb3\x9b\x36\x54\x95\xef\x87\x8e\x42\xff\xbe\x96\x77\x52\xf9\xe5\x74\x24\x3c\xe4\xb3\xe8\x7f\x6d\xb5\x12\xfe\x40\xba\xf3\x1f\x7a\x27\xe8\x16\x08\x82\x80\x27\xc8\x09\xe1\x50\x98\xbe\xe2\xe6\x4e\xd4\xcc\x0c\x0a\xc8\xfc\x31\xad\xe8\x23\x88\x7d\x8f\x49\x9a\x48\x6c\x1b\x83\xa9\xf8\x12\x00\x53\x2f\x6d\x83\x87\xb7\xba\x47\xf7\x65\xcd\x8f\xeb\xcb\x0c\x97\x74\x51\xed\xc2\x2b\x0a\xcd\x06\x1b\xd6\x69\xeb\x82\x24\x4e\x3c\x84\x11\xba\x01\x81\x7c\xd8\xbc\x6c\x27\x3f\x89\x3a\x3d\x65\x74\x9b\x80\x7a\x28\xc4\x5c\x70\x50\x45\x8a\xa1\x2e\xf4\x8f\xfb\x75\xf9\x49\x5a\xe7\xcd\x5b\x8c\x7d\xfd\x02\x61\x9c\xd5\xde\xbb\x54\x3c\x34\x65\x05\x00\x33\xf4\xb7\xe7\xbf\xdb\x8c\xbe\x8b\x49\x5c\xe8\x75\x78\x9a\x9c\xeb\x4e\x6f\x01\xe9\xa2\x8d\x33\xc2\xda\x2b\xda\x92\x93\x1f\x22\xe3\x6c\x7c\xb8\xec\x0a\x80\xd8\x64\xb5\xe1\x7b\xad\x9e\x58\xd6\x72\x69\xae\x72\x7b\x04\xd8\xca\x35\x95\x94\x4c\x3e\xd3\x42\xcc\xe1\xff\x7f\xd4\xe7\x7d\x62\x8e\x18\x79\xea\xd9\xb0\xf1\x04\xbe\x55\xfa\xc0\x1a\x7d\x60\x3b\x1e\x40\x42\x58\xdb\xc0\xa4\x54\xe4\xa8\xf0\x9f\xb5\x52\xd8\x2c\xab\x06\xb7\x75\x20\xda\x1e\xd9\x23\x30\x40\x07\xc4\x51\xf8\x06\x19\x9a\xd0\x7f\xc5\x83\x9b\xac\x77\xcc\x7b\x1e\x96\x71\x78\x14\x3f\xba\x03\xa7\x5c\x4d\xa9\x82\x97\x2a\x34\x78\x04\xf8\x9f\x41\x82\x26\x5b\x77\x84\x25\x4a\x4b\x0f\x57\xdc\x53\x6f\xb2\xbc\x11\x56\xa2\x12\xd6\x62\x32\x27\x03\x17\xc1\xb7\xae\x42\xbf\x7f\x36\x3b\x2b\xed\xd3\x1e\x1b\xf9\xe7\xeb\x91\x73\x94\x2d\x5f\x8a\x09\x6d\x8d\x57\x71\x31\x4f\x8b\x0f\xd2\x94\xd8\x63\x6a\x99\x84\xc3\x2a\x3d\x18\x1b\x2a\xc8\x3e\xcc\x5b\x8f\x5b\xc8\x9c\x39\x4b\x41\x72\x0f\x89\xdb\xb1\xf6\xd1\x79\x9a\xee\x1f\x43\x25\xe3\x2f\xf5\xd7\x5f\xbd\x7a\x81\x78\x50\x97\x90\x0c\x9f\xc5\xbe\xb4\xd8\x40\xdc\x0c\x1d\x57\x5a\xd6\xec\x16\x91\xb2\xa5\xf7\x6b\xa4\x15\xf6\xac\x54\xa7\xb7\x12\x7f\xe8\x6e\x2b\x93\x47\xd6\x0b\xd3\x71\x85\x65\x01\xac\xcd\xe5\x05\x80\x4d\xd6\x33\x08\x7f\xbf\x13\x66\x0b\xb5\xf5\x1a\x9a\xc7\x89\x49\xc7\xaf\x70\x0a\x9f\x29\x8c\x9d\x54\xdd\x71\xbe\xd6\x14\xde\xa5\x11\xec\x5b\xdd\xfe\xef\xa8\x88\x77\x81\x26\xff\xc5\xc0\x9d\x66\x30\x2d\x1c\x13\x88\xf7\x82\x82\x1f\x77\x23\xda\x7a\x3a\xda\x1f\x57\x4a\xcf\xdf\x39\xd4\x8c\x6f\xd8\xae\xe5\x5d\x07\xbe\x64\x00\xbf\x4b\x37\x5a\x9c\x72\xaf\xa4\x13\xa5\xc0\x05\x22\xd8\x81\x4c\x3f\xe3\xd2\x5f\xe5\x70\x3e\xb1\xa7\xa8\xb8\x5e\x8c\xa5\xf9\x92\x8e\xd6\xd3\x97\xf5\xf5\x8b\xab\x4d\xa6\xba\xc7\x9e\xbe\xc2\x3f\x79\x8b\x9e\xe4\xf6\xd8\xd3\xd7\xfe\xcf\x0f\x21\x84\xf7\x72\xe6\x6f\xaf\x66\xfe\xf6\x3a\xff\xdb\x99\x9d\xf1\xb1\x91\xa2\x9d\x26\x50\xe7\xba\xf9\x2e\xda\x1b\xeb\xbc\xd4\xad\x84\x8d\xde\x37\xda\xf6\x8d\x36\x3a\x50\x76\xf8\x35\xb1\x6b\x97\x14\xc5\xcf\x36\xdb\x47\xc8\xc9\x7b\x29\x54\x7d\xb5\x62\x0b\xcd\xfb\x8f\x08\xbf\x38\x48\xdb\x1f\x61\x6d\x0a\xcb\x0e\x06\x90\xa2\x68\xc1\xb6\xba\x3e\x4e\x10\xc8\x1b\xe8\x13\x01\xc8\xf2\x16\xa0\xc6\xa7\xdb\x45\x08\x26\x12\x1a\x4a\x76\x28\x16\x91\xe1\xe8\xa9\xec\x9f\x52\x41\xa2\xc4\x3f\x72\xd3\x1e\xfd\x7a\x25\xc0\xbe\x9a\xa1\xf8\x92\x8b\xe5\x5d\x10\xd3\xc3\x27\xeb\x8d\xbe\x93\xb5\x20\xcf\x1d\xd9\xbd\x21\xa0\x84\x55\x42\x68\xd4\x46\xb6\x2d\xfd\x65\x13\x3c\x39\x4c\xee\xe2\x09\x98\xff\x62\x6f\x80\xa2\x15\x77\x50\x0e\x1f\xa9\x74\x5b\x47\x11\xd9\x80\xe8\xcc\x2f\x7d\xfa\xa7\xb9\xc1\x58\x12\xb0\x48\xb3\x98\x79\x77\x53\x34\xed\x9b\x02\x4c\xdb\x84\x92\x1c\x3d\x7e\x27\x5a\x21\x62\x8d\x19\x3d\x37\x18\x1e\x11\x36\xc2\xd7\x60\xf6\xf0\xe1\x63\x57\x0e\x5a\xb8\x97\xf5\xd7\xf9\x78\x43\x7b\x67\x36\x45\x70\xa2\xfb\xaf\x65\x33\x12\x7b\x44\xfd\x9f\xf0\x6b\x13\xfb\xf1\xb2\xfe\x7a\xf5\x1e\x77\xda\x74\xab\xbc\xbc\xcb\x8e\xbf\xf3\x18\xd7\x07\xcd\x1e\x2d\x48\x67\xdd\x30\xeb\x9f\x11\xbb\x2b\x07\x68\xab\xb5\x8d\x10\x58\x5c\x36\x1a\x7d\x34\x78\x4b\x2c\x95\x67\x73\xe4\x7c\x86\x1c\x29\x0f\xbb\x5f\x43\x03\x5a\xe8\xfd\x85\xfb\x40\xce\xc7\xe2\xe5\x89\xcf\x49\xa8\xa2\xf1\x35\x6a\x56\x05\x37\x92\x1b\xe2\xd0\xb5\x31\x2e\x0e\x4d\x13\xc3\x96\x9a\x0d\x30\x2b\x1e\x9a\xd4\xb1\x62\xea\x63\xa0\xfa\x9f\x1c\xc0\xd8\xe4\x1f\xc1\x1f\x75\xc6\x0d\x30\x6c\xc5\x2c\x21\xe8\x44\x94\xfe\x54\x1b\x5c\xe6\x86\x96\x27\xf2\xa3\x39\xa0\xa4\xe0\x29\xa2\xee\x75\xe8\xbb\x9b\x30\xe9\xd0\x61\x3f\xf6\x0b\x4a\x52\xb9\xf9\xe3\x3f\x16\x97\xa9\xf4\x04\x84\xd1\xd0\x5a\x55\x92\x1f\x59\x36\xa8\x32\x93\x16\xd1\xa7\x53\xbf\x73\xf5\xd9\xba\x9d\x6b\x69\x98\xc3\x7a\x3e\xf4\xae\xa3\xc3\xd5\x49\x75\x64\x5b\xc0\x14\xee\xd8\x96\x3b\xb6\x1f\xb8\xd2\x48\xb1\x30\xb4\xbb\xe1\x73\xd1\x5c\xe3\x66\xd2\x99\xe6\xb8\xcf\x39\x7b\x6f\xc2\xd1\x89\x12\xcf\x7e\x01\xd8\xd8\xff\x0e\x47\x2f\xd4\x1c\x60\x67\x63\x40\x4b\x78\x8b\x48\xce\x3b\xdd\xcf\xc8\x81\xb3\x6d\xb5\xb5\xba\xb3\xc1\xc6\xb7\xfa\x00\x46\x82\x20\x1b\x8a\x89\x4f\x7d\xab\x2d\xe5\x11\xc9\xb8\xcf\xf4\x37\xbd\x2c\x0f\xf2\x75\xb4\xbb\x85\x03\xfc\x75\xfd\xf6\xcb\xee\x36\xdb\x1b\xc1\x6b\xcb\x38\xb6\x70\x54\xda\x28\x61\x90\x8f\xe4\xf4\x46\xcb\xf7\xca\xf9\x4d\xf7\x98\xed\x87\xaf\x4f\x89\x59\x5e\x2e\xc5\xf0\xca\x4c\xcf\xd4\xaf\xd7\x41\xb9\xe1\x1c\xb9\x31\x46\x1f\xec\xe3\xb0\x61\x7f\x49\x65\xd8\xa2\x34\xb3\xaa\x4f\x29\x07\x68\xff\x3e\x00\x32\x84\x48\x11\xe0\x95\xe1\xbc\x68\xef\x19\xb7\xce\x12\x29\x15\xcf\x60\xc0\x14\xd0\xc5\x08\xb2\xd4\x8f\x09\x2f\x3e\x32\xfc\x71\xa8\xfe\xc1\x29\x5d\x1b\x7e\x50\x29\xd1\x8c\x37\x5f\xd2\x6f\xbe\x2e\xf7\x57\xc6\x96\xfe\xc4\x52\xab\x05\x12\xae\x13\x95\xe8\xcc\x3d\x73\xa1\x58\xec\xc5\xf1\xb3\x31\xdb\x85\x84\x3d\xea\x07\xd1\xde\xd1\xa5\xec\xe8\x5a\x70\xbe\x6f\x29\xab\x3a\x7d\x92\x7f\xcd\xd6\x49\xb4\x9e\xb3\x63\x8e\xfe\x0b\x56\xbc\x88\x70\x21\xc5\xe2\xf9\x8e\x73\x07\xbd\x7e\xc7\x2d\x54\x80\x1e\x84\xbc\x7a\x9d\xae\x0a\x6e\xe6\x6f\x5b\x5e\xaf\x13\x4a\x9c\x3f\xab\x3f\x87\x65\x0e\x4e\xeb\x56\xf0\x1d\x78\xad\x43\xc7\xab\x8b\xeb\x24\xe7\x62\xe1\x53\xe5\x11\xbf\x93\xc5\x9d\x86\x13\x6b\x27\x85\x39\xb2\xad\x9f\x08\x3f\xe5\x08\x41\x33\x42\x20\x41\x3a\x6c\x1c\xfa\xd0\x32\x68\x53\x47\xd4\x20\x4a\xf2\x01\x6b\x13\xef\x43\xa5\xa4\x92\x9d\x74\x80\xd9\x46\x54\x4a\xcb\xed\x5c\xa3\x7a\x70\xcf\x5a\xe1\xd8\x5e\x07\x0f\x1b\xee\x32\x01\x4a\x84\x12\x1e\x04\xc0\x30\xe4\xf8\x5d\x42\x2b\x4c\x98\xed\x56\xa0\x02\xe6\xb8\xc5\xe8\x04\xc7\xc0\xaf\xc8\x6e\x45\xb2\x91\x6c\xae\x16\xf3\x5b\xaf\x46\xe6\x67\x45\x10\x0e\x29\x03\x78\x2a\xdb\x88\xda\x3e\x5c\xfa\xe0\xcf\x77\x2c\x43\x8b\x98\x37\x19\xc9\x52\xd8\x79\x9e\xfb\x35\x09\xb3\x75\xdc\x92\xb8\xdd\x4f\xa8\xb9\xbd\x79\x64\x46\x97\xb0\xd9\x63\xc7\xd5\x03\xba\xe1\x53\x6e\x97\xf0\xef\xdf\xb8\xb9\xda\xb0\xa8\xfc\xf6\x4e\xdc\x69\x7f\x8d\xab\x89\x9b\x3e\xeb\x83\xdf\x09\x03\xe5\x2c\x56\xe9\x76\xe8\x54\xac\x29\x93\x3f\xe9\xdd\x66\x0b\xa4\xef\xe9\x30\x6b\x04\xbf\x13\x6a\x2e\xc2\xc6\x2a\xe3\x71\xd6\x99\x2e\x97\xf5\x86\xbd\xc6\x7f\x3f\x03\x1a\xa1\xea\x08\xfd\x4b\xa6\x50\xc3\x98\xd3\x77\xba\x9f\xf6\xc5\xeb\x91\x1b\xee\xf7\x94\xff\x9b\x1f\x8b\x8f\x18\x1f\xbb\x18\xf3\xb9\xfb\xef\xed\xa9\xfd\x57\xc4\x16\x84\xae\x2d\x9f\x69\x8c\x5d\x5c\xed\x3f\xbf\x71\xb3\xad\x90\x5f\xad\x6c\x87\x24\x53\xf7\x11\x3a\x9a\xef\x8d\xdf\xfd\xcc\x8d\x18\x13\xd0\x0e\xd0\x01\x80\x43\x0d\x7b\x73\x6b\x64\x47\x6c\x62\x80\x73\x60\xf5\x10\x69\x20\x1f\x53\xcf\xf8\x14\x45\xd3\x29\x6f\xfc\x26\x48\xa1\xd4\x92\x77\xc2\x81\xb0\x0f\x74\x18\xc4\xa2\x4e\x96\xf0\x9a\xc1\xf1\xce\xb3\x87\x26\xbd\xa7\x53\x67\xf7\x54\xd6\xa9\x64\xa6\x98\x64\x57\xd1\x59\x86\xa1\xad\xd9\xa4\x45\xfb\x72\x48\xa6\x7e\x99\x68\xf9\x66\xec\x49\x8c\x74\x30\xb2\x27\xc1\x57\xfa\x2a\xa4\xe8\xc9\xa1\x31\xbc\xcb\xbf\x14\x3a\x55\xa3\x16\x55\x41\x55\x90\xcd\x06\x16\xf8\x67\xdb\xa1\xc3\xc5\x62\xb1\x2a\x8f\x40\xe0\x2e\xd6\xe9\xde\xfa\x01\xa6\xfe\x98\xc1\x9c\xa7\xf0\x8e\xfa\x1d\xe9\x52\x79\xdf\x09\x60\x12\x48\xa2\x84\x6d\x21\xc4\x37\x96\x1e\x9b\xde\xad\x03\x1e\x26\x55\xb3\x7f\x0e\x5d\x0f\x8d\x2d\x95\xd1\xd6\xb2\xde\xaf\x85\xe8\xa1\xc2\x57\x0f\xb2\x8e\x41\x18\x4e\xcd\xc9\xa4\x83\xd2\x27\x12\x0c\xd1\xab\x3c\xe5\x20\xbd\xbc\xcc\x41\x7a\xf9\x05\x1c\xa4\x0b\x35\x80\x96\xec\xee\x9c\x6b\xf4\x72\xa5\xcd\x15\x88\xc5\xfa\xe8\xcd\xda\x2a\x5c\xda\x25\x49\x8b\xd5\x36\x37\x9a\xd8\x16\xa0\xb1\x07\xee\x04\x85\x0a\x20\x8a\xf5\x38\x1c\xfd\x67\xf2\x19\x44\x45\x01\x19\x76\xe0\xa5\xcf\x09\x96\x97\x45\xf1\x60\x77\xc3\x49\x31\x6a\x79\xb6\x58\x1b\x94\x44\xfa\x08\xa9\x44\x61\x9b\x39\x6a\xfb\x95\x7a\xee\x85\xad\x93\xa4\x2b\x1d\xe9\xdf\x39\x5a\x5f\xb8\x09\xdb\x8a\xbd\x44\xed\xf4\x86\x9b\x5a\xa8\x25\x0e\x9f\xe4\x88\x41\x29\x3d\x64\xc2\xef\x49\x40\x9f\x70\x9c\x19\x03\x07\x16\x2a\x4e\x3e\x5f\x40\x9a\x9c\x22\xb9\x2d\x48\x20\x60\xf8\x2d\x9c\x17\xc2\xce\x30\x46\x90\xb6\x86\x5f\x9e\x76\xc2\x0c\x41\x17\x42\x5e\x40\xbc\x42\xf1\x75\x6a\xa1\x43\x91\xb6\xf0\x62\x29\x67\x0e\x96\x29\xa9\xac\xf4\xc2\x19\xb9\xf3\x27\x69\xa5\x15\x9a\x93\x99\x73\x12\x8c\x1d\x0e\x9b\x5a\x27\xfd\xbd\x81\x8e\x07\xd1\xab\x4a\xe0\x25\xa9\x03\x5b\x54\x83\x93\xfe\xcd\xdc\x0a\xd1\x33\x67\x7c\xe0\xe9\xdd\x11\xed\x9a\x19\x51\x40\x0d\x2c\x29\xe1\x19\xa0\x9d\xf7\x56\x2e\x8b\x84\x8d\x09\x7a\xa4\x8d\x70\xc7\xf6\xc8\xb6\x46\xdf\x8a\xd0\x4f\x18\x9f\x70\x83\x93\xbf\xdb\x79\xe3\x0f\x8e\x7e\x48\x01\xd4\x62\xa7\x4d\x27\x9d\x8c\x78\x0c\x68\x4f\x74\x36\x34\x07\x90\x5a\x4e\x8b\xec\x0c\x67\x06\x06\x87\x11\x97\xa8\x96\x47\xc0\xa1\x6c\xcb\x27\x7d\x48\x04\x20\xd2\x64\x0b\xe5\x24\x88\xc0\x91\xb6\x7d\x21\x81\x51\x3c\xe8\xf8\xbd\xa6\x09\x0d\x92\x34\x7e\x47\x8d\x14\x6d\x96\x2c\xea\xf1\x8b\xe7\x7e\x03\x65\x15\xd4\x3d\x88\xb1\x0a\xcb\xc5\xea\xc8\xb6\xd2\x5c\x8e\xb2\x7d\x48\xd9\x82\x25\xf2\x8e\x02\x1f\x87\x8d\x4a\x9c\xed\xda\x23\x28\x95\x02\xf9\x84\x0f\x65\xc8\x53\x98\x6e\xa4\x93\x7a\xb0\x05\xcb\x7a\xc0\x04\x31\x60\x80\x64\xbc\xd5\x3b\x17\x59\xd6\xa8\x3d\xdc\x7b\x4a\x54\x48\x6d\x17\xfb\xc1\x1f\x27\x31\x32\xd2\x03\x02\xfb\x92\xdc\x91\xb8\x5c\x57\x26\x52\x17\x56\xa7\xde\xb3\xef\x5a\x3d\x4c\xd1\x3d\x9f\xab\x19\xfd\x59\xc0\xb7\xcb\x4b\x14\x21\x24\xfa\x55\x74\xbd\xb0\x59\x50\x74\x61\xd1\x82\x88\x8b\xa3\x87\xf8\x8c\x3c\xc4\x2c\x60\xd2\xfb\xb3\x29\x86\xc2\x7f\x9d\xaf\xa7\x25\x52\x95\x50\x5f\x6e\x04\xbf\x93\xed\x91\xe9\xad\xad\x06\x03\xa5\xe9\x53\x69\xd1\x44\x33\x03\xbb\xbc\x46\x05\xbd\x5a\x20\x33\x9b\x89\x0c\x45\xb8\x65\x9e\x26\x75\xcb\x17\xd0\x3e\x05\x10\x4c\x98\xcd\x2b\x56\x4b\xdb\x0b\x83\x82\x01\x5f\x9e\x16\x34\x64\x1b\x06\x9b\xba\xae\xf7\xa5\xab\xfb\x32\xdb\xed\x2b\x68\x41\x97\xd6\xba\xd9\xca\xba\xe6\xaa\x5a\xe7\xd8\xde\xac\x27\x63\x39\xdd\x23\xbc\xd2\x54\xdb\xde\x48\x75\xdb\x52\xee\xa0\xd1\xed\x31\xf8\xbc\x86\x64\x7a\x13\x2d\x4b\xcc\x2c\x98\x61\x7b\xa4\x6e\x8b\xf4\x86\x37\x2f\xa0\xf1\x62\x91\x9a\xe9\x74\xcb\x04\x6e\xa3\x73\x7b\x03\xc8\x87\x82\x53\x05\x25\x32\xde\x12\x5a\x9e\x24\x0b\x91\x10\x2c\x84\x76\xaf\x5f\xc0\xa8\x48\xae\x2a\x04\xfb\xd0\x1a\x14\x44\xe7\x34\x50\x28\x42\xf8\xe5\x63\xa1\xa0\xbd\xb0\x0d\x51\x27\x7c\x79\x8e\x8b\x39\x31\x17\x92\xa8\x87\x68\x45\xaf\x8d\x4b\xad\xf8\x08\xcd\x31\x90\xc7\xf7\x9f\xf0\xd6\xa6\x0e\x63\xd7\x68\x9b\xc8\xce\xa9\x43\x6a\xef\x1f\x33\x50\x31\x69\x94\xad\x8a\x97\xca\xe5\x94\x7b\xa3\xf5\xce\x66\x60\x14\x9a\x93\xbe\xe5\x0a\x89\x01\xee\x44\x52\xd6\x31\x48\x70\xd8\x7b\xef\x47\x85\x2e\x2e\x18\x3a\x1e\xce\xe0\x00\x06\xea\x0b\x14\xbd\xf5\xcb\x20\xb2\xbb\xdf\x78\x27\x3a\x8a\x21\x8e\xc5\x11\xdf\x8b\xe3\x41\xb6\xf5\x86\x7d\x6c\x78\xad\x0f\xbb\x40\x90\xe4\xa2\x30\xa1\x6d\xe4\xce\x4d\x31\x2b\x8b\xa0\xb8\x74\x98\x06\x10\x8e\x3b\xf6\x58\x00\xac\x4a\x41\xca\x1c\x4e\x31\x69\xfd\xcf\xd9\x15\x0c\x36\x58\x4e\x04\x62\x9e\xa5\xee\x69\xbb\x61\x22\xd4\xf2\x2c\xb5\x52\xef\x88\x82\x8e\x03\xad\x64\x2d\x78\x1d\xeb\xc6\x23\xb2\x85\x5c\x67\x2c\x14\x72\x1f\x4c\x66\x0c\xd3\x4c\x6f\xea\xeb\x17\x31\x4d\xaa\xcd\x04\x93\xff\xb4\xc8\x97\x9d\x30\x94\x57\xab\x12\x2f\x13\x9d\xa6\x98\x78\x11\x56\xee\x15\xee\xc8\x9e\x5b\x7b\xd0\xa6\x9e\x51\x21\xeb\x05\xbf\xc5\xb9\x08\x5f\xc2\x28\xb0\x50\x63\x03\xd8\x46\x60\xd9\xcc\x01\x86\x6e\x2a\x01\xb8\xc0\x97\x17\x26\x1a\xb7\x22\x49\xd6\xa6\x9a\x58\x00\xc9\x85\x58\x6f\x97\x2c\x72\x46\xe4\x17\x1f\x3a\xff\x18\x2b\x41\x35\x47\x1c\x2d\x91\x06\x46\x59\x4d\xde\xa5\x96\xb2\x7c\xcd\xe2\xe9\x89\x27\xa5\x0c\xfc\x62\xfe\x93\x90\x22\xf2\x5f\x0d\x82\x09\x2c\x19\x4c\x68\xee\x89\x5c\x57\x54\xf9\x07\x16\x4b\x6a\x84\x9e\xa4\x57\xde\xac\xc4\x7c\x79\x2f\xa4\xe2\xfb\xe9\x09\xf4\xf9\x50\xcb\x17\xeb\x92\x2b\x70\x6a\xd4\x3e\xee\xa6\x46\xbd\xcd\x9b\xf3\x27\xc6\x59\x32\xbf\xf3\x6e\xff\x8d\x62\xb2\xc3\x8e\xb3\x4d\xa2\x91\xdf\x00\x32\xf1\x19\x54\x86\x6b\xd6\x1b\xe0\x5f\x83\x71\x58\x74\xf6\xc3\xf1\x82\xca\xa3\x70\x4e\xee\x89\x67\x25\xeb\x00\x43\xd7\x2a\x74\xbc\x2e\x22\x35\xc3\x3d\x10\x3f\xe0\xd7\xea\x9e\x34\x43\x51\x61\x75\xab\x3f\x8d\x10\xcb\x8b\x39\x0e\xba\x5c\x58\x89\x50\xe1\x46\x86\x53\x7f\x5d\xba\x0b\x1e\x83\xc1\x93\xc1\x54\xb3\xac\x45\x90\x01\x84\xdc\x0f\x6c\xb7\xeb\xff\x78\xf9\x0c\x52\x5f\x31\x53\xbf\xe5\xc6\xe2\x21\x59\xfb\x4f\x19\x7c\xca\x7b\x6e\x96\xbd\xb5\xd3\xe3\xf2\xcf\x57\x0c\xeb\x7a\x3c\xac\x82\xff\x83\x26\x05\xb3\xba\xe1\x74\xa7\x03\xcc\x87\x93\x1d\x32\xb2\xc2\xe8\xbd\x81\xc9\xdb\xa5\x24\xe9\x1c\xfb\x95\x81\xc0\xf3\x63\x60\x28\xc4\x93\xc1\xbf\x45\x6d\x98\x1e\x5c\x2e\xcb\x7e\x89\x59\x14\x49\xbd\x6e\x5c\x6b\x40\xd1\x94\xbe\x15\x20\x7f\x2b\xa1\x03\x1b\x6d\x39\xdf\x8b\x27\xc9\xef\x76\x86\xf7\xbd\x77\xb7\x33\x51\x14\xd5\x1e\x99\x9f\x63\x09\x09\x0f\x5a\x44\x61\x7c\x74\xac\xfa\xe5\xe5\x34\x29\xc0\x20\xe8\xdc\xe5\xb7\xc1\xaf\x42\x9b\xe0\x00\x92\x10\x89\x09\x1c\x46\x81\x6e\x45\x2e\x47\x1f\x53\x0d\x47\x32\x40\x71\xf4\x7a\x70\xc5\x75\x57\xe6\xdd\x46\x0f\x4d\x76\xb9\x15\xfc\x0e\x88\x90\xb6\x47\xa6\xb4\x0a\x3b\xac\x13\x5c\xcd\x90\x33\x3b\x23\x51\x21\x6b\x00\xa5\x50\xf4\xae\x62\x14\x02\x62\xd2\x85\xbb\xe3\xbf\x8a\x77\x08\xb7\xdd\x44\xe6\x20\x3c\x78\x43\x26\x33\x32\xe1\x9e\xe4\x11\x9f\x61\x85\x85\x51\x40\x37\x2b\x40\xb3\xd0\x0c\xb8\x78\xaf\x2c\x0b\x3a\x4c\xce\x6e\x7c\xfc\xe2\xfb\xd8\xb9\xc1\xad\x23\xd2\x9c\xc1\x8a\x54\x88\xf2\x47\x88\x8f\x3a\x20\xef\x43\xe5\x26\xf8\x4d\x6b\xfd\x6d\x1d\x56\xb3\x82\x9f\x39\x76\xc8\xe2\xb2\x17\xe1\x03\x9c\xa0\xf5\x00\x27\x54\xe2\x89\x47\x96\x7f\x5f\xf8\x0f\x7c\xf8\x95\x41\x8f\x00\xca\x83\xc9\x81\xf3\xdf\x3e\xb3\xc7\x7c\x65\x64\xd3\x0c\x5d\x27\xd5\x7e\x2b\x4d\x1d\x72\x51\x0b\x27\xcd\x1a\x29\xfe\x79\x56\xea\xfb\xb0\xc4\x6e\x85\x75\xfa\xc0\x38\x6b\x65\x27\x41\x8c\x36\x11\xe2\x80\x5a\x4a\x78\xb5\x51\x47\x9b\xed\x06\x4c\x53\xcd\x4a\xe9\xc6\x94\x6b\x78\x75\x76\x30\xde\x0e\x13\xa9\x6a\x29\xd1\x32\xd2\x75\xdb\x8c\x44\xdd\xd0\x3f\xc9\xb7\x86\x1d\x73\x03\x8d\x05\xe3\x01\x3a\xb8\xac\x1d\x37\xe9\xbd\xbb\x48\x18\x21\x5b\x9d\xb9\xb2\x38\x6e\x83\x52\xa8\x1b\xf1\x56\x44\xe9\xee\x1d\xea\x98\xba\x58\xcd\x9e\x6a\x84\xa8\x35\x30\x58\x7d\xd0\x77\x10\x0c\xac\x6a\x41\xbb\x79\xf8\x44\x6a\x4b\x39\x54\x4b\x54\x11\xe8\x6c\x04\xc9\x65\x6f\x91\x3b\xf2\x20\x28\xe3\x0d\x3a\xec\x35\xdf\x8b\x87\x61\x48\x2e\x61\x3a\xb4\xfe\xe1\xff\xa0\x97\xd3\xc9\xea\x2a\xfe\xfb\x3b\xcd\x53\xfa\x8b\x3d\x85\xfd\x9f\xfd\xfb\x07\xc3\xad\x6d\x39\x20\xee\xe9\x4f\x1f\x0f\xbc\xeb\x4f\xc0\x7f\x16\xd9\x58\x4e\x27\x71\x97\x76\xc7\x13\x28\x40\xc3\xfb\x9c\x76\x55\x4c\xe4\xa5\x69\x1b\xa0\xd3\x00\x14\x51\xa8\xd8\x46\x67\x56\x10\x15\x05\x35\x46\x62\x2c\x32\xa2\x1e\xaa\x31\xe3\x31\x24\xc2\x14\x54\x88\x07\x2b\x0a\x18\x1e\x94\x55\x7a\x6e\x78\x7b\xfc\x03\xf9\x83\x53\xb9\xea\x5c\xd4\x93\x36\x3c\x1e\x0a\xd6\xbf\xf7\x0c\xd0\x10\x9f\xd4\xe9\x51\xe3\xbf\xb0\x15\x0f\x5a\x7e\xd9\x21\x1c\x6e\xed\xb2\x1c\x45\xc7\x15\x6a\x35\x97\x4a\x0f\x70\x0a\x36\x18\xcb\xed\xbd\x0f\xd3\x7a\x27\x26\xb2\x09\x62\x61\x1c\xfa\xab\x21\x95\xc4\x24\x38\xd5\x10\xe1\xf5\x42\xf1\x16\xea\x31\x14\xdb\x4c\x5f\x8d\x0e\xe4\x7b\x2b\x37\x2b\x44\xe7\xeb\xfa\xa7\x1f\x48\xe7\xc9\xef\xce\x2f\xac\x93\xf3\x30\xad\xd5\xd3\xdd\x91\xe8\xce\x0a\xd3\xdd\xb6\xc9\x49\xc2\x53\x81\xd0\x16\x48\xea\xa5\x17\x14\x7e\x33\xde\x8e\x46\x5b\x27\x5b\xef\xa1\x42\xde\x0e\x04\x5b\x4f\x16\x4a\xb2\x92\x2d\x6f\xe5\x1f\x79\x72\x07\x48\xc5\xa3\xd7\x25\xd5\xae\x1d\x20\xf8\xf2\xd6\xbd\xd3\xba\xce\x94\x77\xec\xec\x4d\x33\xc7\xb4\x37\x7e\xf0\x4e\xa3\xd0\x9b\xbf\x0a\xd1\xf5\xe1\x69\x85\x5f\x1f\xcb\xda\xe2\x57\xac\x10\xb7\xcc\x08\x67\xe4\x16\x8b\xc4\x52\x91\x51\x00\x46\xe1\xa7\x54\x26\x7e\xf7\xe1\x09\x44\xfb\x95\x11\x64\xcb\x90\x8d\x18\x52\xac\xf8\xec\xaa\x9c\x39\x70\x65\x03\x57\x2a\x71\x1c\xae\x5a\xfa\xda\xba\xad\x74\x62\xbe\xcf\xe3\xb3\x19\x04\x1e\x9b\x29\x63\xd4\x39\x79\x81\xdc\xc7\x65\xbd\x5a\x08\xa7\xf6\xc6\x57\x0d\xdd\x16\x4a\x93\x7e\xe6\x20\x70\x02\xa6\xca\x4b\x10\x15\xf7\xe1\xd1\x98\xd7\xea\x18\x91\x0d\xbc\x9d\x76\x1d\xcb\x79\x51\x5e\x58\x41\xfe\x48\x28\x7b\x53\x40\xb3\x4f\xe2\x78\x0a\x4d\x1b\xc2\x2d\x00\xdf\x63\xa0\xc7\x58\x9b\x54\x3b\x05\x01\x5a\xa2\x1e\x78\x3b\x61\x1e\x78\x3b\x4b\x3c\xf0\x76\x31\x0d\xf9\x98\x1d\x17\x33\xc8\xa4\x19\xa0\xf6\x45\x12\x1a\x3f\x70\xeb\x97\xba\x77\x40\xa6\xdd\xcc\x5f\xa8\xec\x4e\x78\xd1\x3d\x1f\xa8\xed\x81\x03\x35\x00\x80\xb9\x3b\x7d\xbb\xe4\x13\xde\xb3\x35\x0b\x34\x33\x84\xa9\xb9\xb9\x9d\xeb\xb1\x3c\x17\x22\x05\xfa\xe2\xb9\x30\xe9\x18\xfc\xbc\x0d\x71\x19\x81\x65\x84\x6c\x2c\xf2\xe1\x49\xef\x35\x1c\x88\xe2\x06\x05\x99\x8c\x39\x02\xbf\x1b\x61\x1a\x3b\x69\x7d\x54\xd3\xea\x61\x46\x86\x2d\x2b\xa6\x84\x30\xa2\x38\x86\x66\x29\x32\xfd\xd9\xe0\x37\x83\x36\xbd\x86\xc0\xfa\x1e\xe8\x24\xa4\x7a\x92\x04\x16\x09\x64\xae\x99\x27\x04\x39\xa0\x4e\xb8\x46\xd7\x85\x27\x27\xe7\x20\x09\x91\x80\x61\xe4\x14\x62\x8e\x07\xfc\xd6\xaa\x1a\xfa\xa0\xe9\x3b\x6e\xce\x9e\x43\x3f\x4c\xe5\x34\x32\x37\x71\xc6\x3e\x15\xc6\xe9\xa3\x33\x42\xed\x5d\xb3\x49\xd8\x59\xfc\xf2\x49\xfb\x68\x27\x63\xef\x39\x88\x92\x60\x2a\x0f\x95\x34\x1b\xdd\x0a\xbb\x61\x0a\x1a\xf8\x98\xee\x85\x92\x6a\x6f\x33\x25\xa0\x4e\x18\xff\xf2\x38\x04\xb0\x89\x1b\xd7\xf9\x07\xb4\xac\x95\xbf\x0f\xb2\xb6\x54\x77\xf3\x9f\x41\xae\xeb\x00\x55\x72\xc8\x33\xda\xc1\xec\x78\x25\x26\x83\x79\x82\xd8\x0d\xb8\x91\x11\x1d\x00\x43\x1a\x7d\x27\x02\xfb\x30\x44\x61\xd2\xe0\x20\xc0\x2a\x5a\x37\x28\x85\xee\x3c\x4c\xf2\x41\x5a\x51\xca\x9a\xac\x5c\x1d\x19\x91\x72\xbe\x94\x67\x02\x7e\xc7\xdb\x5b\xd4\x53\x56\xb2\x1f\x5a\xa8\xc5\x23\x52\x95\xf0\xe1\xea\x98\xfa\x65\x1d\xb0\x3d\x86\xcd\x02\x35\x71\x12\x30\x4c\x89\x1f\xa3\xfb\x5e\xd4\x1b\xf0\xbc\x36\xe3\x27\x41\x67\x85\xa8\xc7\x66\xe6\x8b\xce\x23\x08\x79\xa2\x8a\xd4\x1a\x87\xe6\x87\x39\xe9\xf6\xb9\x6c\xd1\xa3\xa0\xed\x6b\xc9\x3b\x20\xf9\x2d\xeb\xda\x6f\xce\xd7\xb5\xef\x8f\xa4\x5f\x8f\x0e\xd1\xea\x9f\x90\x3c\xa2\x42\x32\x6b\xa5\xa2\xcc\xf6\x14\x35\x7f\x12\x0d\x8a\x3d\xe8\x46\x54\xfe\x45\x26\x3a\x4a\xef\x9e\xf8\xf8\x57\x18\x6f\x66\xe6\xb8\x00\xa1\x7c\x81\x37\x46\xb6\x69\x69\xaa\xa1\xe5\x26\xec\xc4\x31\x9f\x04\xf8\x44\x6f\xf2\xd2\x83\x54\xb1\xb2\x90\xc8\x62\xb4\x01\x41\x75\x97\x5d\x1d\xa9\xfe\x13\x43\xde\x98\xbf\x25\x3c\xfe\x3c\xf0\xff\x8c\x57\x43\x3f\x06\xa3\xe5\x9d\x40\xe5\xe8\x98\xdc\xc2\x82\x25\x0d\x4c\x9c\x80\xa4\x84\x80\x2d\x0a\xcf\xd9\xaf\x01\x67\x60\x82\x05\xc9\xe6\x24\xe2\xfb\xc0\x7a\x6f\x8f\x09\xeb\x1e\x2a\x12\x16\xef\x08\xcd\x09\x19\xa3\x6b\xad\x85\x65\x56\xa3\x28\x14\x38\xcd\xed\x11\x4f\x46\x7f\xf1\x04\xe8\x4c\x7c\x8b\xde\xfa\xe6\x04\xe8\x90\x3d\x1e\xaf\x01\x25\xb8\x8f\xe8\x23\x1c\x14\x06\xba\x34\x3f\xef\x04\xe2\x24\x53\xaa\x83\x92\xeb\x66\x68\x85\xc1\x44\x07\xd5\x5f\x02\x98\xa1\x44\x2f\x24\xe4\xa3\xd1\x5d\x58\x18\x34\x3e\x69\x32\xde\x5c\x7f\x2c\x1d\x71\x7a\x53\x7d\x43\x1a\x56\x6b\x30\xaf\x9f\x57\x9b\x86\xaa\x33\x05\x59\x1d\x15\x9e\x22\x7f\x64\x3c\xad\x9f\x72\xd6\x5b\x31\xd4\x5a\x1d\xfd\xe9\x2b\x5d\x8b\xfd\x19\x4a\x56\xb7\xf0\xbb\xa0\x51\x7a\xd0\xe6\xf6\x8a\x4a\x13\x79\x5b\x84\x24\x16\x79\xda\x2c\x30\x5f\x40\xdc\x0c\xaf\x4a\x89\x8c\x41\x72\x93\xaf\x14\x3f\x2d\xb1\x7a\xec\xef\x54\xe7\x9a\x7d\x29\xdb\x7b\x27\x2b\xa9\xa4\x3b\x62\x83\x80\xe1\x07\x3b\xaa\x8d\xa4\x42\x17\xbd\xe1\xf0\xc2\xa7\x3d\x34\x81\x71\x5c\xd6\xb1\xc2\x40\xcb\x81\x45\x69\x23\xa5\xa3\xc4\x49\xaf\x0f\xc2\x60\x4b\x45\x7e\xcb\x78\xe4\x7b\xa7\x05\x30\xc9\xda\x9b\xdc\xc0\x81\xf9\xee\x03\xab\x85\xe8\xac\x5f\x9a\x46\xf7\xc6\x3f\x05\xe0\xc8\x30\x76\x86\xba\xcc\x26\x8b\xb2\xb1\x1d\x46\xb4\x7d\xa9\xd9\xb1\x70\x38\x08\x3e\xcd\xf2\xcc\x36\x45\xce\x66\x79\x4e\xd4\x12\xee\x2d\x73\x4f\x40\x80\x4b\x74\xe6\x28\xcd\x99\xe5\x3b\xbf\x33\xfa\xa0\xae\xee\xaf\x86\xdc\x02\x01\x4f\xcc\x49\x12\x8f\x21\xae\xcc\xb5\x7c\x91\x3b\x6d\xaa\x44\xfe\x0d\x6e\x01\x94\x45\xad\xee\x32\xb5\x2b\xc3\x8c\xd8\x19\xbf\x52\x10\x60\xed\x3f\xa4\x8c\x05\x48\x3e\x0e\x06\xcb\x57\x20\x15\x09\xeb\x16\x0b\xd9\xb5\xa8\xa0\xab\x66\x5c\xdb\x83\x82\x5a\x62\xfe\x87\x05\x11\x6a\x75\x6b\x74\x98\x49\xbc\xb2\x6a\xb8\x21\x51\x30\x10\x9b\x9d\xc1\xe5\x42\x2e\x68\x84\xb4\x2e\x7f\x96\x29\xbd\x02\xba\x66\xa4\xd7\x89\x54\xcd\xb2\xc3\xc3\x80\x28\x47\xfc\xa4\x2b\x25\x0c\x9d\x54\x2d\x50\x76\x22\x5a\x4b\x87\xa4\x8e\x75\x66\x40\x81\x27\xec\x10\x57\xba\x20\x78\xaf\xe8\xd2\x35\x3f\x4e\xc1\x33\xe8\x49\x95\x33\x34\x47\x05\xb4\x02\x23\x13\x0e\x5b\x69\xed\x80\x1a\x03\x61\x9d\x14\xc2\x17\x8d\x26\x41\x61\x95\x5e\x62\x46\x39\x6e\x84\x1d\x5a\x38\xc6\x2b\x61\x00\x01\x8f\x5a\x85\xec\x63\x03\x9f\xe3\xf8\xe0\x0e\xcc\x0e\xb2\x92\x75\x5a\x90\x97\x28\x60\x7e\x16\x8f\x1c\xbb\x21\x80\x3c\xab\x06\x90\xee\x98\x15\xcc\x24\xad\xcc\x48\xbd\x6c\x75\xe8\x0e\x7c\x54\x18\xea\x5f\x5c\xe3\x6f\xfd\x75\x48\x81\x6c\x4a\x60\xad\xb4\xec\x9a\x1d\x41\x03\xe1\xc2\x0b\x2f\xa1\x8e\xa2\x74\x68\x26\x2f\x44\x35\x0d\x1f\x53\x4a\xd4\x21\xd9\xea\x3b\x71\x5f\x60\xd1\x0f\x42\xb9\x56\xb0\x5f\x44\xaf\xed\xba\x5e\xd9\x7f\x81\x7a\xa8\xe5\x2d\xe9\x7c\x28\xc1\x2a\x1f\xdc\x18\xe2\x53\x01\x1b\x5a\xe7\xfa\xe7\x60\x2c\xb4\xe9\xad\x20\x49\xb1\xe0\xdc\x82\x71\xc2\x18\x10\x01\x7a\x13\x7b\xb3\x4c\x09\xb9\x70\x5e\x84\x93\x62\x55\x45\x19\x07\x17\xa3\xb2\x10\x96\x9e\x29\x0d\x03\x3b\x3d\x80\x63\x83\x18\x44\x2d\x2a\x7e\x0c\x52\xd6\x10\xf8\x81\x4d\x25\x88\xe3\x2a\x95\x5a\xdc\x3c\x60\x91\x40\x14\x2c\x62\x13\xe0\xc6\xde\x66\x42\x81\xdb\x4f\xaf\xe1\x32\x42\x4c\x43\x05\x38\x20\x52\xfc\x0d\x37\xcc\x4a\x6f\x13\x01\x5e\x67\x7b\xef\x66\x26\xf9\xcc\x94\xf3\x2f\x64\x12\xa8\x49\x09\x4c\x6f\x59\x62\xce\x6e\xbd\x0b\x0b\x3e\x54\xb5\xfc\x48\xf0\x9e\xab\xfc\x0e\x40\x5a\xfe\xa8\xac\xa8\xd6\x95\x81\x2f\x49\xec\x7d\x8e\x90\xd3\xc3\xb3\xad\xa4\x7c\x5c\xa2\x0d\xf4\xb7\x90\xbd\xa8\xb1\x5b\xcd\x00\xeb\x88\xac\xa1\x8d\x00\x74\xdc\x0f\xdc\xf6\xa8\x03\x0e\x92\x25\x7e\x6d\x86\x32\x46\xd9\x84\xb8\x87\x79\xbc\x13\xc6\x82\xe4\x21\x6e\x34\x69\xa2\x7e\x03\x89\x9e\x4d\x5b\xb9\xd3\x10\x62\x8d\x87\xd3\xd5\xe2\x27\x24\xc0\xe9\x97\xcb\xf8\x3b\x41\xf8\x92\xc3\x50\x27\x1f\xfb\x3f\x86\x26\xfa\x38\xfa\xc9\x35\xe8\x83\xa5\x4d\x51\xb2\x46\x64\xf2\x92\xc4\x22\x1d\x54\x26\x37\x81\x0a\x3e\x28\x4d\x22\xea\xaa\x72\xd1\x51\x07\x04\x30\x58\x23\x28\xf4\x63\xdc\xfb\xee\xc3\x9c\x30\x14\x4d\x97\x15\x19\x18\x03\x33\x55\x56\xb7\xd8\x3e\xe8\xe7\x38\xea\x4c\x02\x9d\x3b\xa6\x13\x57\x37\x50\x62\xce\x4b\xa2\xd4\x25\xcd\x87\xfc\x63\x6a\xfc\x36\xe9\xe0\x39\x21\x2f\xb4\x2c\x51\x9c\xab\x11\x53\xc3\x1b\x30\xe4\x9f\xb3\x44\x23\xc5\x2d\xd2\x50\x20\x14\x66\x4a\x6b\x50\xdb\x2b\x5a\x49\xf1\x89\x77\x3d\xc0\x41\x11\x3c\x92\x67\xa2\xb7\x42\x6c\xb2\x67\xa5\x15\x4b\xf7\x68\x02\xbc\x8c\x24\x87\xe2\x9b\xe0\xe5\x92\x5a\x65\x59\x5a\xb9\x55\xc2\x4e\xa3\x9a\x39\x81\xff\x4b\xac\xca\x12\xa7\xd3\x43\x60\x6d\x67\xcc\xc7\x7f\xcd\x28\x15\x67\xba\x82\x63\xe0\x1d\xd4\x94\x73\xb5\x22\x0c\x65\x5c\x62\xfc\x82\xda\x92\x4e\x2a\x84\xd7\x6f\xc6\x20\x24\xef\x4e\x23\x04\xf4\x10\xb5\xc1\xf8\x71\x13\x0a\xc5\xc9\x75\x4d\x4a\x07\xf4\xb6\x41\x62\x41\xb4\x6d\x3c\x8e\xcc\x00\x82\x60\x35\xc8\x38\xd9\x52\x34\x03\xd1\x0c\xf0\x95\xdd\xb0\x4a\xfa\xef\x87\x56\x6f\xe1\xa0\xfa\x51\xdd\x0d\xad\x12\x86\xf0\x55\xf7\xe8\xfd\x39\xff\x9e\xd9\xd3\x92\x9b\xe6\xea\xbc\x57\xb4\xf7\x2f\x0f\xc2\x36\x73\xb4\x8e\xb7\x6c\x0b\x0a\xdf\x80\xd9\x6f\x60\x46\x03\x5b\x5c\xf1\x3a\x1f\x38\x01\xba\x22\xf1\x59\x00\xb7\x77\x5c\x42\x6a\xcc\x36\xb2\xeb\x30\xfd\x1e\xb0\xc1\x27\xb0\xd9\xb3\x84\x54\x88\x9d\x82\x97\x9b\x65\xf4\x2f\x49\x24\xde\x44\x95\x36\xbd\xcb\x0a\x9f\xda\xb0\x16\x32\x27\xd8\x41\x00\x39\xb0\x0c\x3b\x1b\xc6\x4a\x29\x72\xb4\x6f\xc9\x68\x47\x3a\xfb\x28\x1b\x25\xdd\x06\x8b\x0a\x32\xf7\xf0\x25\x01\x98\x43\x24\x80\xed\x6c\x34\x84\x14\x16\x3c\x67\x1f\xd1\x57\x4c\x05\xd4\x90\xa2\x2f\xce\x89\xd1\x3d\xb3\x81\x62\xc8\x9a\x6e\xdc\x80\x46\x71\x66\x98\x5d\x23\xba\xe7\xec\x23\xe2\xce\xfc\x7e\x8c\x60\xe2\xe9\xc5\x20\x12\xf9\x04\x82\x56\x75\xa6\x6b\x6a\x84\xb7\x9a\x59\x3c\x0b\x1e\xda\xb8\x5e\xf0\x48\xa1\xd8\x6c\x47\x60\x18\x2f\x20\x68\x6d\x70\x1c\xf5\x0e\xa5\x09\xe1\x17\x34\xe3\xa7\x9b\x02\xdf\xe6\xdc\x43\x4b\x56\x82\xd4\xaf\x7e\x43\xf5\xab\x55\x45\xe3\x59\xeb\x30\xab\xc7\xbe\x2a\x42\xa2\xee\xbe\xb2\xb9\xef\x44\x1d\xe4\x25\x54\x41\x42\x2c\x94\x16\x06\x35\xab\x2c\x99\x07\x3c\x1c\x12\x9a\xd8\x6f\x36\x23\xf7\x7b\x7f\xbb\x55\x09\xb4\xfb\x30\xf4\x2e\xa7\x8a\xa5\xb2\x95\x91\xd0\x86\x81\x32\x64\x84\x69\xf3\x3e\xe8\x08\xdd\xba\x09\x82\x84\x43\x4f\x54\x3d\x50\x29\xcc\xc5\x88\x50\x5e\x0c\x81\x98\x54\xb7\x88\x6d\x86\xda\xb0\x03\x6f\xdb\x2b\x4c\x3b\x38\xe4\xec\x0f\xf2\x11\x21\xd7\x03\xfc\x0a\x2d\x74\x9e\x64\x97\xdd\x6a\x7d\x0b\x9e\x6d\x85\x02\xb5\x70\x03\xe7\x77\x0d\xa4\xd0\x1a\x61\xdd\x15\xd2\xd5\xab\x0a\x50\xde\x8d\xc0\xa7\x19\x71\x4b\xa7\x41\x6f\xd2\x77\x48\xbd\x10\x68\xe7\x55\xc9\x22\x4e\x0f\xa8\xa8\xc3\x80\x32\xdb\xd7\x73\x95\x9c\xd1\x7d\xc2\x83\x11\x8b\x10\x3d\x65\x1e\x38\x93\x1f\x09\xe7\xfd\x26\xd8\x36\xfa\x9e\x44\x14\x60\x9d\xa5\xc9\xc2\x4d\xc1\x68\x60\xcd\x60\xf6\x9d\xa6\xc7\x92\x36\xd0\x44\xcc\x91\xa0\x4a\xd7\xe8\xc1\xc5\x43\x9d\x16\xe1\x39\x17\x33\x5e\x59\x61\x22\x2a\x36\x11\xd1\x21\x12\x04\x85\x73\x06\x93\x42\x0b\xf5\xe9\x8f\xea\x4e\x58\x27\xf7\x98\x26\x40\xff\x27\x46\xac\x98\x9e\x47\x0b\xe5\xfd\xcb\x77\xdf\x11\x36\x14\x10\x2f\xe7\xb2\x65\x98\x62\x45\x27\x88\x9e\xc8\x66\xf3\x31\xee\x01\x8c\x0d\x6f\xde\x0f\x86\xaf\xd8\xb8\x1b\x6a\x96\xaf\x70\x9c\x55\x90\x7f\x72\xc7\x9e\x5a\x6d\xe9\xfa\x24\x4f\x88\x39\x09\x2a\x23\x43\xa2\x32\x43\xb9\xc1\xc5\x37\x98\x91\xc3\xac\x1d\xee\x2c\x7a\xdb\x55\xa8\xa2\x67\xdf\x85\xe2\x01\xc7\x2b\x86\xcd\x92\x12\x8e\x01\x91\x40\xeb\x94\x7e\x93\xd7\xbd\xc3\xe5\xe8\x16\xa8\x3d\x4e\xe6\x2a\xbe\xc4\xf8\xb4\x27\xe6\x60\xbc\x4b\xb3\x79\xf0\x41\x9c\x7f\xba\xf1\x34\x84\x6a\x57\xb6\xea\x2f\x7c\x98\xf0\x23\x3f\x8f\x42\xd0\x8c\x1a\xc1\xeb\x62\x86\x9e\xb3\x9f\xa0\xeb\x2c\x3d\x4c\x5c\xc3\x9b\xfc\xd8\x5b\x9b\x65\xdd\x0d\x86\x52\x48\x3b\xef\x1f\xa3\x33\x0c\x17\x64\x56\x67\x9b\x07\x32\xc2\xe0\x1b\x43\x79\x73\x20\xba\x43\x7c\x14\x28\x06\x77\xf8\x38\x08\x15\xae\x2a\x6d\x70\xe0\x99\x64\x6c\xd5\x70\xc0\x0d\x18\x8a\x98\xa2\xa1\x0b\xdd\xd7\x86\x1d\x04\x61\x5a\x63\xf8\x79\x2b\x55\xcd\x9e\xee\xf2\x90\xcd\xa1\x8a\x47\xcd\x2a\xf0\xee\xb7\x02\xe4\x04\x9d\x0e\x4e\x15\xdf\x0a\x83\x27\x0a\x0c\xa6\x36\xfa\x40\xfa\x72\xad\xdc\xab\x2e\xca\x33\x26\xe8\x34\x70\xd5\x10\x49\x0e\xfa\x82\xa3\x0e\x63\x4c\x33\x85\x99\xc9\xd6\x5e\xd9\xcc\x7d\x68\xb4\x0f\x41\xb2\xb7\x1c\xbb\x63\xd7\xd4\x34\xe3\x99\x94\xdd\x80\x0c\x2b\x71\xbe\xdf\x09\xd2\x3a\xc4\xa3\x06\x5e\x0d\xae\x8b\xa5\xc4\x44\xfc\xed\x2f\xfe\xb7\xe4\x2c\x15\xeb\x26\x6c\x8e\x5c\x36\x24\x02\xdd\x51\x91\x2c\x27\x2f\x1b\x51\x53\xe4\x9c\x14\xd9\x4a\x3d\x4b\x44\x71\x4a\x15\x62\x30\xfc\x7e\x4c\x99\x6f\xea\xaf\x19\xaf\x64\xbd\x01\x28\xe5\x06\xe8\xf8\x36\x48\xad\xa6\x82\xee\xa7\x6b\x70\xf1\xce\xb3\xf4\xa5\x1a\xd3\x89\x3e\x6a\x24\x22\x88\x4f\x79\xf5\x50\xac\x7e\x1f\xe1\x5d\x82\x4f\xf8\x4d\x5c\x9d\x20\x64\x8c\x88\x8d\x9e\x9b\x28\x67\x35\xa6\x6c\xc3\xb0\x43\x66\x93\xef\x7d\xe9\x20\x9c\x2c\xa1\x44\xdb\x93\x48\x5a\xec\xaf\x9c\xbc\xa8\x48\x8a\x45\x81\x02\x67\xde\x75\x6e\x45\x21\xb9\x45\x9e\x42\xfe\x1b\x3c\x4a\x49\x73\x99\xa2\x84\x54\xc7\xa6\x78\x01\x26\x90\x3a\x2f\xd1\x4e\x1d\xf8\x31\x03\x83\xcf\x9b\x33\x11\x2e\x5b\xc4\x3e\x23\x39\xff\x86\x3c\x30\x3f\x68\xaa\xe4\x21\xbf\xd9\xa8\x5e\x9e\x4e\x47\x51\x8f\x3c\xa5\xcc\xce\x51\x57\x07\x3d\x67\x16\xd4\xf8\x9b\x17\xeb\x3c\x43\x03\x8c\x2e\x12\x94\xa9\x03\x12\xbd\x88\xf2\x70\x05\xd9\xa4\xc7\x4b\x11\x18\xf8\xc4\xbc\xb7\x21\x1f\x08\x00\x0f\xff\xe6\xc0\x2d\x84\x57\x18\x10\x26\xa1\x35\x4c\x39\x33\xd4\x04\xf2\xa3\xc2\xba\x1c\xcf\x4e\xf4\x4d\xaa\x32\x44\x8f\xbc\xd7\x19\xc9\x54\x82\x09\xef\x86\xb6\x5d\x17\x0b\x3f\x36\x39\x33\x16\x24\xc7\xe6\x8f\x96\x4b\x09\x4a\xfe\xfa\x0c\x29\x51\xf4\x55\x03\x99\x48\x66\x3f\x33\xfc\x08\xee\xb9\x3c\xc6\xa7\x64\x78\x6c\xf7\xc7\xeb\x52\x2e\xd6\xdf\x29\xa4\x33\x43\x16\x61\x2c\x6c\x3c\x53\x5a\x5b\x23\x8f\xf4\x83\xd6\xb5\x3f\xcb\xa6\x89\xa3\x39\x8a\xa4\x47\xc0\x13\xdb\xde\x48\xa0\x85\xe9\xa4\x75\xad\x70\x7a\x09\x41\x7c\x01\xe2\xff\x64\x6f\xd7\x7f\xc5\xb2\x83\x7f\x6e\x40\x23\xe1\x3e\x08\x84\x6b\x0d\xa2\x19\x6b\x86\xdc\x34\x3b\xe8\x0c\x49\xc7\xd5\x7c\xf9\x60\xdc\xc4\x0b\xd4\x47\x31\xed\x2c\xa8\x9f\x05\x66\xda\x1f\x49\xd4\x7e\x0e\xff\x0e\x6a\xf2\x96\x5d\xa7\x4c\x76\x22\x70\xc0\xef\x90\x2e\x99\x65\x42\x01\x26\x47\xe9\xc1\x48\xdb\x84\xee\x2b\x3b\x58\xf0\x05\x78\xc9\x9e\xe5\xef\x59\xf3\x45\x75\xf8\x5f\xe9\x16\x7e\x22\xc0\x0a\x10\x92\x4a\x3b\xa1\xaa\x23\x05\x4f\x47\xcc\x49\x23\x23\xa9\xb7\xb2\x81\x3c\x82\x5c\xce\x97\xaf\xb1\xb3\x35\x96\x36\x79\x64\x0a\x9e\x53\x12\x5a\x58\x8e\x86\xdb\xde\xff\xf2\xef\x72\x86\xa0\x73\xae\x12\x36\x0b\xd3\x5c\x54\x23\x78\xf8\x7a\xd8\x3a\x3e\xe3\xff\x84\xf7\x32\xe6\xee\x3a\x8b\xc5\xbc\x43\xa7\x19\xc8\x4e\x8c\x1e\x9c\x4d\xc9\xad\xbd\x09\xdd\x0a\x2b\x78\x8d\xcf\x74\x9c\xac\x60\x64\x41\x2a\x5c\x7f\x63\x1c\x93\x0f\xdd\x6d\x03\x40\x9e\x52\x37\x93\xae\x1c\x78\x8e\xc0\xe0\x4f\xee\xef\xcf\xf7\x09\x09\x70\x01\xcb\x99\xf7\xc9\x10\x99\xc3\xfa\x01\xd2\x3c\x01\xfa\x19\x21\x32\xd4\x92\x15\x46\xb9\xb4\xf4\xe7\xeb\x09\x2b\x1f\x37\x9a\xea\xd2\x75\xa1\xe8\x53\x89\xa9\x1a\x43\x5e\xff\x8f\x35\xb8\x75\x98\x65\x38\x82\x1e\x9c\xc4\xee\x52\x8e\xf0\x5e\x9b\x5b\x66\x80\x90\xcd\xb0\xed\xe0\xdc\x62\xb3\xfb\x39\x56\xda\x53\x09\xf9\x8f\xad\xac\x6e\x01\x54\x63\x05\xc6\xef\x76\xbc\xd8\x63\xc2\x9d\x38\xb7\xce\x72\xe2\x83\x2d\xf5\xb3\x0d\x88\x15\x05\x85\xc0\x51\x2b\xee\x45\xe9\xf9\xe4\x53\xe2\x30\xa3\x48\x87\x28\x82\x8d\x98\xac\xa0\x84\x54\xd2\xa2\x5b\xb9\xce\xa1\x2b\x00\xba\x09\xa7\xe8\xad\x31\xc1\x91\xf7\x4b\x0a\xfe\x71\x97\x98\xc6\x31\x04\xbd\xf8\x9e\x2b\x97\xa6\x13\x86\xfd\x88\xd9\xaa\xf9\x1a\xd4\x9c\xdd\xfe\xf1\x5f\xa9\x06\xb5\xa2\x4f\x76\x4d\xeb\x11\xa0\x28\x9b\x9d\x38\xde\x1f\x5e\x59\x36\x3d\xc7\x7e\xa4\x88\x0e\x48\x59\xc0\x61\xc6\x5a\x15\x38\xef\x1c\x85\x13\x5a\x96\x88\x34\x1c\x9a\x30\x64\x7e\xb5\x4c\x87\x1e\x41\x5c\xe3\x56\xe9\x5e\x18\xbb\x4e\x4c\x3d\x2c\x81\x5f\x12\xfe\x6d\x15\x86\xf6\xc1\xa9\x0c\x42\x51\x21\xa7\x7f\xba\x7f\x41\x61\x8d\xaf\x39\x03\xc1\x3d\xf1\x9e\x65\xb7\x05\xd8\xe2\xcc\xab\x06\xd7\xb2\xd7\x56\xe6\xe2\xec\x4e\xb3\x41\xd5\x9a\x71\x56\x0b\xd8\x55\xe8\x32\x06\xb2\x96\x7f\xc4\xa2\xf6\x94\x0d\x40\x7c\x6a\xf8\x60\xe1\xc4\xc1\x30\x02\x91\x79\x1b\xb2\x10\x39\x4a\x2f\xd1\xd3\x05\xaa\x81\x62\x19\x2c\x99\xc0\xff\xf5\x3f\xfe\x27\xfb\x49\xc5\xe0\x1b\x63\x55\x82\xbc\x6a\x93\x51\x43\xa7\xcb\xad\xb8\x1a\x41\x2a\x31\xe5\x59\x2e\xeb\x27\xd6\x07\xa0\x83\x4a\x8c\x03\xf8\xed\xd0\xb5\x2d\x9d\xe8\xce\xdc\xe1\x46\x1d\x71\xc6\x82\x5f\x9e\x4d\x45\xba\x09\x11\x95\xd8\xca\xfb\xe4\xeb\x67\x00\x2e\x3c\x19\x72\x74\xe8\x59\xc7\x3f\xc9\x6e\xe8\xd6\x6d\x28\xa1\x9e\x65\xba\x5c\xec\xe9\xc7\xef\x6e\x7e\x78\x84\xee\xeb\x37\x67\x1c\x00\x24\x5a\x86\x0e\xe0\xc5\x93\x1e\x8e\xe6\x07\x20\x4f\xbe\x49\x49\x24\x38\xd7\xf0\x45\x41\x00\x06\xb8\xf1\x19\xcf\x34\x4f\xe2\xe5\x52\xc5\x51\xbd\x99\xfa\x97\xa9\x0c\x52\x34\x61\x67\x45\xe4\xd0\x8b\x4c\x80\xf5\xd4\x8a\x96\x6c\x06\x30\xa6\x9f\x50\xbb\x0a\xcc\xe0\x2e\x0a\x20\x3f\xb1\x4c\x69\xd3\x25\x16\x0f\x8c\xe8\xf6\xfe\xdd\xa2\x86\x47\x2b\x78\x9f\x39\xf3\x89\xb0\x23\x6f\x94\x5a\x64\x40\x98\xf1\xe6\xdf\x64\x2e\x37\x65\xd0\x44\xe5\x8d\xe2\x28\x8d\x99\xab\x88\x88\xdf\x07\xde\x46\x4c\x39\x3c\x6c\x08\xe0\xc2\x66\xe8\x74\xed\x77\xf3\x44\xa0\xef\xbf\x33\xc6\xd8\xb2\x90\xfc\xa8\x8b\x3b\x07\x13\xf8\xf3\xcb\x25\x78\x03\xd5\x40\xf2\x97\x58\x0b\xde\x16\x6a\x86\xa5\x98\x34\xa5\x4c\x42\x62\x2e\x84\xcc\x33\x5f\x19\xcf\x42\x1a\x9f\x77\x08\xea\xaf\xd9\x57\x6b\x9e\x9e\x7d\xab\x5d\xcc\xbe\x22\xe9\x50\xb8\x50\xc8\x0f\x71\x97\xf7\xa5\x97\x0d\xe9\x33\x8a\x5e\x5f\x4f\xfb\xc5\x27\x7f\x7a\xb5\x2e\x93\x33\x70\x53\x4b\x0e\x55\xe1\xf7\x5c\xba\xe6\xfe\xe1\xf3\xfd\x02\xe7\xfb\xf2\x5e\x4d\xe5\xbb\x76\xe2\x6a\xaa\xe6\x35\x69\x68\x99\x32\xfa\xcc\x8a\x03\xfd\x0d\x08\xec\x6c\x2f\x2a\xe0\x97\xdd\x87\x69\x0a\xaa\x40\xc0\x5d\x85\x61\xc6\x84\x88\xfa\x41\x22\x6b\x28\xee\xc6\x97\x83\x57\xb2\x21\xa2\x87\xe6\x1a\xe4\x49\x94\xaa\x96\x7e\xdd\x55\x8e\x89\x4f\x95\xe8\x49\xaa\x85\xed\x5b\x81\x52\x51\x44\x6f\xaa\xea\xa8\xc0\xde\x6d\x5b\xfe\x07\xc0\xeb\x23\x0b\xa9\x3d\x76\x5b\xdd\xc6\x21\xd6\x42\xba\xc5\xfc\x4f\xa1\x62\x94\x78\x4d\xd0\x3f\x01\x26\x3a\x7d\x87\xbb\x84\xd3\x70\xe9\xe9\xae\x5f\x14\xc2\x46\xf1\x09\x17\x69\x68\xd7\x06\x3f\xf3\xea\x47\x2f\x1e\x54\x8f\xac\x7c\x31\x77\x5c\x49\x50\x57\x8e\x72\xa7\xdc\x82\xf9\x71\x7e\xec\x1a\xfa\xea\x80\x9c\x7f\xac\x64\x78\x66\x47\xe2\x02\xfb\xcd\xff\xd7\xbd\xb1\x79\x9f\x49\xc0\xbd\x1d\x0c\xb5\x49\x06\x9e\x6d\x6a\x78\xef\x08\xe5\x91\x8b\x7d\x21\x64\x4b\xb6\xfe\x5b\xb7\x4a\x3b\xe7\xa7\xd6\x19\x28\x6d\x85\xdf\xf1\x0e\xc0\xf0\x7a\xc7\x10\x47\xd9\x0c\xed\x2d\xdb\xb6\x5a\xd7\x11\xe5\x0c\xdf\xb3\xb2\xbd\x13\x86\x19\x3d\x01\xfa\x5c\x9f\xe9\x76\x0e\x09\xc5\x65\xa4\xe8\x2a\xaa\x7b\x4a\x07\x4d\x79\xbb\x5f\x02\x35\xec\x02\x6d\xf7\xd3\x80\x59\x21\xc1\x71\xfa\x6e\x04\xa2\x37\x83\xaa\x8d\xa8\x49\x7e\x8c\x3e\xc5\x2a\xcb\x41\x28\x77\x7c\x06\xe0\xf5\x32\x57\x61\xaf\x70\xdd\xf9\x51\x89\x3a\xf2\x09\x4f\x69\x54\x1d\x6f\x89\x5b\x9b\xd8\x63\x63\xb3\x9b\x95\x46\xa4\x08\x00\x1e\xcf\x8a\x3b\x61\x40\x61\x44\x43\x22\x17\x3b\x52\x9d\xd1\x6a\xdf\xe8\x96\xb8\xc7\xee\x64\x5d\xd0\x6f\xfb\x60\x09\xfe\xd9\x6d\xf2\x70\xf0\x18\x2f\xdc\xde\x12\xda\x11\x92\x1a\x5a\x39\xb9\x1f\xf4\x40\x69\x0c\xd4\x47\x09\x00\xd6\x70\x62\xae\x6a\x16\x5b\xd5\x60\x5b\xc9\xdd\x11\x4c\xe2\x9d\xac\x07\xef\x04\x04\xdd\xed\x51\x7b\x1a\xd0\x0e\x18\x46\x22\xef\x09\xd7\x3d\xe1\xed\x1e\x17\xe4\xe9\x16\x89\x5d\x1a\x7e\x40\x78\x62\xdb\x23\xba\x07\xb9\x10\x90\xed\x86\x9e\x8d\xdf\x02\xdb\x58\x37\xa8\xe0\x5c\xd8\x78\xcf\xa5\xa7\xce\xac\xc1\x01\xfe\x2b\x54\xe9\xe6\x03\xb1\xcc\x37\xcd\xd6\xca\xd2\x0d\xbe\xd3\xc6\xc8\x5a\x1b\xfb\x0d\x7b\xaf\xf7\x6c\x27\x81\x54\xae\x6d\xf3\x6b\x54\xe1\x3b\xf0\x50\xf4\xc6\xba\x39\x71\x86\x9c\x26\x9d\x3b\x5c\x03\x40\xcb\x10\xc1\x67\x86\x6d\x0d\x57\x08\x3d\xf1\x73\x88\xa5\xb4\x1d\xe2\x6d\x78\x38\x1c\x41\x2e\x93\x1a\xed\x37\x94\x93\x82\xf6\xfe\x37\x2f\x58\x2f\x4c\x05\x4e\x6e\x83\x24\x20\x4d\x99\x46\xce\x3a\x9e\xfd\x6b\x3c\x48\xa8\x04\xb7\x52\x20\xbf\xab\xb4\x6c\xaf\xb3\xf6\x74\xdd\x43\x10\x2d\xb2\xc6\xfe\xe8\x5c\xfb\xed\x2a\x1d\xad\x84\xc5\xd7\xf4\x4e\xc3\x0c\xde\x40\xe3\x8f\x36\xb1\x8f\x3a\xdf\xb0\x7e\x15\x12\x74\xa1\x3d\x32\x1f\xd3\x00\xe3\x36\xf0\xbb\x58\xc1\xdb\xb0\x32\x19\xc7\x2c\x10\xe4\x8a\x02\x05\x78\x3e\xb1\x61\xbd\x23\x44\x2e\x35\xc4\xe0\x9d\x31\x82\x51\x4c\xb6\xed\x00\xed\x01\x4f\xc5\xef\x83\xbc\xe3\x2d\x05\xc0\x50\xa0\xf5\x9f\x69\x13\xa8\x37\xd8\x6e\x50\x11\x1b\x08\xde\xb4\x54\xda\xa4\x2b\x20\x33\x7b\x14\xf9\x85\x97\x9f\xca\xc0\x7d\xcb\xa5\x0a\xe8\x42\x8b\xc5\xc5\xe5\xad\xfc\xd1\x71\xe9\xa7\xeb\x37\xb1\xb5\xb0\xe2\x60\xc1\x59\xf8\xeb\xdc\xcc\xe1\x0b\xd1\xf0\xa0\x5b\xed\x9c\x46\xdb\x03\x5f\x13\xdb\x30\x47\xbf\xc2\x9e\xb2\xce\x70\x55\x5b\x66\xc4\xde\xe8\x03\x43\x97\x83\x0e\xc2\x58\x97\x02\xf4\x3c\x6a\x1a\x79\xbb\xab\x8d\x42\x22\x62\x34\x4f\xfb\xf1\x9e\x83\x7a\xf4\xd2\x03\xfd\x04\x6b\x0e\x91\x12\xdf\xc3\x56\x4c\x50\x09\xec\x1f\xc8\x3d\x3f\x02\xed\x4e\xb2\x29\x63\x02\xc7\xf9\xcd\x1c\xb1\x90\xd1\x50\x9f\x4b\xb8\xfc\x0c\x23\xa8\xb9\x82\x44\x03\x80\x4e\x60\x9a\x77\x28\x14\x45\x7b\x3b\x59\xba\x9c\x44\xdf\xca\xae\x6f\x81\x9b\x6e\x8f\xea\x8c\x54\xc4\xa0\xab\x18\xd1\x0b\xee\xf2\x83\xe0\xf2\xc9\x4b\x43\xc4\x94\x4c\xa7\xb1\xe7\x01\x34\x8e\x03\x15\xc8\xca\x2b\xf8\xf3\x04\x0c\x14\x52\x2b\x4d\x2e\x02\xa7\xe8\x1d\xef\xfd\x46\x49\x05\x5c\xd7\x50\xb7\x65\x3a\x2c\xf1\xf9\x61\xb1\x18\x91\x0b\x73\x96\xab\x29\x28\xc5\xc0\xd6\x05\xd5\x99\xfb\x2e\xa1\xf4\x0c\x1c\xf2\x8c\xce\x3b\xac\x7b\xef\xf8\xfa\x0d\x25\xb1\x86\x81\x3d\xa2\xf8\xc2\x00\x85\xa7\x75\xf7\x3c\xfe\xcc\x0e\xfb\xbd\xb0\x91\xff\x4f\x25\x22\x15\x7c\xb7\x56\xb4\x80\x42\x4b\x98\xda\x42\x8f\x32\xf8\x29\x81\x96\x67\xb6\x70\xa0\x0d\x58\x6d\x61\x0b\xb2\x11\xb8\x9e\x11\x95\x90\x77\xe1\xc8\x4b\x63\x41\x32\xb4\xf6\x6c\x3d\xf9\xd0\xe8\xb6\x5c\xe9\xe0\xb7\xfb\x99\x46\x6a\x67\x76\x53\x50\x3d\xa3\x23\x80\x3e\x7a\xa0\xd1\xc0\xad\x03\xe8\xdd\xe4\xb7\x13\xd0\x33\x40\x04\xf1\x71\x30\x25\xba\x02\x88\x18\x5d\xc2\x5e\x98\x8e\x7b\xaf\xb3\x3d\xe2\xfb\x25\x0a\x63\x1a\x31\xf6\xd4\xfb\xf9\xca\x20\x4e\x85\xba\x9e\x3f\xc4\x4a\xcd\x06\xa0\xe3\x10\xdc\xac\x8b\x0b\xe4\xbc\x4e\xcf\x5c\x3e\x6f\x96\xb4\xfa\x4f\x51\x27\x59\x93\xf6\xc6\x0c\xb7\x9f\x9c\x29\x8d\x2e\xa0\x4c\x33\x0a\xc1\x31\x59\x67\x46\xd6\x05\x4d\x5a\x9c\xd5\xaf\x71\x49\xd7\x98\xe6\xa1\x2e\x2e\xff\x21\x26\x08\x21\x7c\xc9\x79\xa6\x03\xd6\x08\xcd\x35\x50\x85\xc4\xcb\x41\xa0\x2f\xe3\x08\xbc\x0f\xb9\x73\x40\xbe\x7b\x1b\xdd\xe4\xfc\x5a\x39\x1e\xcd\x79\x1f\x71\x8c\x7f\xa5\x8c\xce\xeb\x55\xb9\x1a\xf4\xc5\xbf\xd5\xed\xb4\xdf\x77\xae\xac\x7b\x99\x56\xc6\xe7\x88\x93\x2d\x27\x73\x4f\x2a\x28\xdd\xb0\x1d\x56\xc7\x77\x24\x33\x6b\x9d\x41\x69\x16\xac\xc7\xf3\x93\x99\xcc\x32\x59\xf2\x01\x33\xb9\xf0\xaf\x80\xc9\xa3\x5c\xe0\x94\xde\xfb\x44\x32\x36\x49\x9a\x97\xe9\x82\x94\x20\x04\x8a\xca\x9c\xd2\x12\x34\x2a\x0a\x21\x4d\xba\xd6\x94\xe2\x12\xc6\x1e\x39\x2e\x4b\x72\x3a\x68\x49\x50\xf0\xd4\xe8\x7d\x1d\x2d\x6a\xc7\x27\xf9\xdd\x7d\x2b\x9d\x43\x1f\xb9\xa8\xb5\xa4\xfa\xde\xe3\x82\xe1\x4e\x0a\x95\x5d\x2e\xc4\x5b\x28\x93\xc5\xf5\xff\xf2\xc5\x57\x1f\x7f\xfe\xfe\x6f\x7f\xfb\xea\xe7\x5f\x7e\x7a\x7f\x4f\x9e\x4b\x7f\x58\xae\xe6\x9b\xfd\x52\xbd\xf0\xf7\xa3\x9b\x7d\x70\x96\x59\x2b\xe4\x1f\x24\x95\x21\x11\x97\xe9\x07\x2c\xda\x40\xae\x83\x87\xdc\x62\x91\x0f\xc2\x2c\xac\x4a\x9d\x4c\x5b\xae\x28\x7c\x7d\x10\xb5\x1c\x80\x1b\x1e\xd2\x3d\x39\x99\xf1\x58\x33\x6b\x9a\xf7\x0b\x1c\x8e\xf3\xd8\x1e\x50\x14\x29\x7c\x9a\x24\x2f\x52\x70\x39\xcd\x8f\xae\x48\x00\x61\x2e\x6a\x0b\x02\x47\x7a\x07\xb3\x56\xf1\x1e\xbb\xa9\x76\x81\x42\x0d\xe6\x2b\xeb\x97\x82\x3e\x1c\x6c\xc9\x6a\x44\x1b\xc8\xd5\x41\x7e\x9b\xac\x08\x77\xd8\x4a\x60\x4b\xce\x9d\x37\xac\xf7\xf6\x93\x1c\xd4\xd4\x74\x54\x3c\x53\xc8\x9d\x96\x0f\xe5\x8f\x27\xa4\xf8\xa4\x2f\x23\x1b\x29\x02\xfe\x50\x21\x08\xaa\x60\x83\x15\x93\x2c\xe4\x8a\x79\x68\xb8\xe9\x40\x04\xd4\x0a\x05\x21\x23\xf5\x32\x90\x9d\x90\x26\xb5\x1a\xf8\x7b\x40\x84\x21\x38\x25\x7f\xcd\x60\x81\xbf\x0c\xfc\x57\xa7\x99\x6d\x79\xc7\x6c\x33\x60\x27\xaf\xff\x0f\x48\x8b\xa1\x61\x6f\x35\x61\x12\x34\x33\xb2\xef\x5b\x6a\x93\xdd\x1a\x21\xfe\x58\x1c\xf3\x83\xf1\xf1\x9e\xb1\x2d\x7a\xc7\x7e\x93\x6a\x2a\x0b\x3a\xc7\x24\x73\xd1\xd1\x8b\xdd\xd2\x6f\x29\xe5\xd7\x4a\x25\x56\xf4\x4a\xb7\x62\x3f\x00\x0f\x96\xb8\x5c\xba\x76\x1d\xf4\x70\xa2\x1b\x3a\x35\x50\x97\x98\xa1\x1b\x78\x32\x60\x3d\x81\xd0\x15\x57\x45\x10\xae\xc5\xf8\x51\xd5\x85\xe6\x3d\xee\x3f\x1b\x17\x3b\xae\x88\x39\x0a\xc6\x58\x40\x88\x15\xc5\x88\xb3\x2d\x5b\x39\xb0\x82\x32\x12\xb5\xa3\x95\x02\xe3\xbb\x97\xd1\xb9\x9e\xb3\x37\xe3\xd1\x26\x93\x1a\x6e\xb6\xba\xc4\x32\x1d\x20\x88\x2a\xbc\x9c\x8a\x2a\xc0\xc1\x0b\x41\xc6\x35\x83\xe5\x24\x43\x31\x06\x52\x94\x64\xb8\x00\xba\x1f\x18\xc1\xce\xf6\x18\xfa\x1b\x26\x4d\xd4\x3d\x87\x30\x10\xa2\xe8\x48\xbd\x27\x3e\xf9\x78\x67\xc0\x62\x48\xc5\x55\x0d\xa4\xb8\x4e\x9b\xaa\x11\x41\xb7\x85\x84\x40\x06\x95\xb1\xfd\xb4\x1c\xf1\x4a\x31\x8c\x24\x77\x7b\xf0\x77\x1a\x7f\x6f\xdc\xd5\xe4\xdd\x46\xee\xe3\x52\x05\xf7\x62\x28\x8b\x77\x90\x6d\xdd\x1e\x93\xba\xcc\x5c\xce\x50\x67\x03\x86\x7c\xd6\xe2\x9b\x58\x87\xc9\x3c\x15\x95\xc4\x74\x5d\x83\x4c\xa7\x4d\x9e\x6b\x94\x79\x1f\x20\xbc\xde\xd1\x92\x5f\x13\x1a\xfe\x95\x4b\xc8\x63\xff\xda\x68\xa3\xa6\x05\xa3\xd5\x88\xcf\x45\x00\xf4\xfd\xa8\x3b\xee\x63\x7f\x4e\x42\x9c\x7f\x0d\x4e\x38\xd4\x07\x41\x20\x42\x4e\x20\xc4\x29\x0e\x28\x49\xe8\x4f\xc6\x8c\xe9\xf0\xa0\xd4\xba\xbf\x80\xa4\x5c\xa8\x9f\xd0\x19\x1c\x35\xbc\xf5\xf2\x36\x3e\x14\xec\xba\x41\x49\xb4\x3a\x59\xc6\x36\xf2\x60\xe6\xc8\x8e\x88\x56\x81\xd1\x15\x91\x48\xf1\x09\xf2\x48\x17\x26\x6c\x06\xb4\x71\xbf\xae\xb4\x6b\xe0\x14\x94\x02\x69\x16\x1f\xa2\x3a\x7a\x51\x0c\x92\xeb\x5a\x3e\x74\x04\xe2\x4f\x91\xa5\x10\x84\x3d\x45\x1e\x76\x84\x57\x41\x91\xb6\xbe\x7e\x71\x35\x13\x99\xac\xa2\x9a\xfb\x2b\x30\xfc\xac\x23\x01\x9d\xf1\x0c\x52\xe1\xf2\x3e\x95\xda\x46\x98\xad\xdd\x30\x2d\xdb\x48\xe1\x84\xe4\x09\x27\x74\x90\x1f\x82\x29\xe1\x1c\x5b\x5c\x80\xba\xbe\x97\x42\xd5\xa7\x82\x92\x40\x1b\x87\x41\x05\x8e\x7c\x17\xd8\x97\xb0\x64\x82\x8e\x1c\x02\xb3\x51\x03\xfa\x29\x70\x35\xf9\xff\xbc\x42\x42\x58\xf4\x99\x63\x4d\x14\x35\x63\x02\xab\x0a\x1a\x1c\x72\x32\x36\xa3\xc6\x7b\xc0\x66\x85\x4e\x7f\xfa\x01\xf5\x4d\xdb\x34\x86\xd6\x08\x5e\x1f\x33\xca\x37\xda\xc0\xde\x37\x06\x5e\xa7\x54\x7f\x48\x94\x21\xa1\x33\xcf\x0e\x14\x1e\xe8\xf9\xa8\x6a\x69\xc7\xbc\x97\xc6\xba\xcd\xa5\x0a\xc0\x41\xd1\xaf\x10\x68\xde\x50\x28\xa2\xf0\x04\xcd\x04\xa2\xbd\x7f\xbf\x61\x3b\x03\x8e\x1c\x52\x50\xf6\xda\xbf\xaa\x5c\x8b\x39\x12\xbf\x00\x74\x38\xeb\x5b\x42\xe8\x66\xfa\x3d\x71\xb2\xd3\x15\x32\xea\x14\x5e\xb0\x9c\x2a\x0d\xfe\x9e\x8f\xfd\xce\x5e\x01\xb8\x2d\xe0\x69\xf2\x3a\x74\xaa\x56\x10\x7b\xcb\x54\x47\x19\xda\x92\xa6\xc2\xcc\x54\xdd\x93\x76\x45\x0e\xf8\x23\xe0\xbf\xd2\x11\xbe\x95\xa8\xa8\x8a\xb8\xb2\x00\x62\xd5\xd9\xa8\x48\xd4\x39\x5b\x26\xb1\x9a\x98\xde\x7d\x2b\x2d\x06\x3e\x89\x9c\x22\x1e\x0a\x3b\x68\x49\x20\x06\xd5\x77\x1f\x9e\xb3\x8f\xba\x0b\x41\x79\x2a\x19\x33\xde\xf7\xd0\xb6\x92\x3d\x58\xa1\xc6\x39\x15\x44\x3e\x34\x82\x2a\xa3\x69\x05\xf7\x7d\x4b\x42\x96\xbc\x6d\x73\x5e\x91\x51\x97\x39\x0e\x3d\x4f\xb4\x03\x42\x08\x5a\x7c\x05\x07\x12\xbb\xa2\x35\xbd\xe4\xb6\xb6\xda\xb8\xe4\xba\xe9\x80\x76\x00\x24\x5c\xd2\xab\x2e\x1c\xf4\x43\x68\xa0\x8f\x1b\xea\x81\xf5\xab\xa5\x4b\x6c\xf0\xf7\x91\xdd\x94\x7b\xa5\x0d\xd5\x3a\x8a\xd5\x10\x99\xe6\x42\x14\xbc\x42\x9d\xf4\x3b\x3d\x18\xbe\x17\xdf\xb0\x9b\xf0\xb4\x63\x05\x77\x1f\xd3\xc6\x0d\x42\x35\xa6\xdc\x69\x5e\x2a\x4d\x73\x73\xab\x84\xb5\xdf\xb0\xf0\x5f\x54\xe4\x4f\x8b\xf6\x3f\xd1\x31\x81\x54\x23\x54\x56\x0f\x90\x40\xb5\xb1\x22\x89\x49\xc8\x8c\x53\x3d\xd1\x0f\x71\x6a\xf2\xc6\xf3\x35\x12\x8d\xe7\xdd\xa7\xf3\x30\x5c\x7c\x30\xd9\xb6\x43\x27\x43\xb1\x6d\xc5\xc3\x1c\x61\x2c\xdf\xb0\x6f\x0d\xb2\x77\xc3\xff\x8e\x1f\xe8\x43\x94\x04\xa1\x27\x7e\xc8\xa1\x47\xc9\xd4\x3c\x80\xc0\x81\x2c\x92\x1d\x20\x44\xff\x67\x8c\x69\xa4\x56\xb3\xaf\x3b\x7b\xa9\x78\x92\x95\x72\x27\xd8\x9a\x89\x10\xd5\x63\x3f\xce\x8a\x6f\x72\x08\xe0\xb6\x1d\xea\xbd\xd0\x48\x2f\x10\x9c\x3c\x64\xf1\x68\xb9\x6d\x46\xcd\xbf\x27\x46\xfb\xf7\x9c\x25\x6e\xdd\x80\x0b\x62\xb9\x2f\x3b\x66\xd0\x56\xc7\xc2\xdb\x2f\xc2\xba\x6f\xd8\x3b\x7f\x1e\x6e\x75\x2d\x61\xa0\x4e\x18\x6f\x5b\xf3\x11\xc7\xdd\xe5\x10\x0d\x00\xc8\xa5\xf3\x34\xb3\xdf\xfb\x1d\x5f\xcb\x4e\x28\x0b\xa4\x7f\x40\xa9\x63\x00\x7c\x5d\x2d\x6d\x63\x20\x80\xd6\x51\x15\x18\x3d\xdd\x89\x7e\xf0\xf6\x88\x26\x25\xbf\xc1\x48\x56\x18\x75\x89\x97\x7c\x07\xc1\xcd\xec\x40\x00\xb6\x76\x4f\x53\xf2\x51\xb6\xf8\x80\xff\xa9\x99\x45\xbe\x0c\x7f\x74\x75\x1c\xf6\x2f\x12\x21\x8d\x65\xa1\xbd\x63\xa2\xf2\xaf\x9b\x00\x0a\xd2\xe7\x18\x34\xb5\xda\x0f\xc2\x9e\x9a\x4e\x60\xbc\x19\x14\x10\x23\x06\xd0\xcb\x71\xc4\x90\x55\x9e\x89\x19\x67\xdd\x91\x88\x53\x6c\xc3\x0d\x56\xdc\x81\x3d\xa7\xe5\x6a\x3f\xac\xc4\x69\x7a\x97\x7f\xa8\xbc\xe9\xd2\xe6\xc8\x7e\xc5\x7e\xc0\x7b\x37\xb1\x9d\x06\x6b\xbe\x5a\xa9\x61\xcf\x19\x20\x31\x37\xfe\xec\x3b\xc8\x7d\xc0\x54\x06\xe5\x2d\xe8\x20\xf0\xab\xc7\x7d\x2e\x80\xb2\xd4\x5a\x7d\x27\xac\x30\xee\xea\x02\x89\xbc\x13\xc1\x00\x1c\xc5\x81\x72\x37\xb4\x57\x62\xdf\xe6\x1b\xca\x8c\x56\xc3\x16\x5e\x29\xb6\x67\xb6\xc0\x41\x06\x0b\x8b\x12\x4c\x1d\x30\x8d\xcb\x5b\x81\x2a\x00\xb8\x16\x2c\xb1\xc7\x8f\x2e\xed\xfd\xf5\xc1\x47\x4d\x3d\x74\x56\x88\xb6\x26\xee\x1a\xa3\xd1\x87\xf6\x56\x01\x0a\x7c\x4e\x7b\x23\x2c\x3a\x68\x80\x63\xf6\xc0\xbb\x7e\xc3\x1a\x09\x67\x98\x11\x77\xfe\x51\xd0\x44\xa5\x7b\xa6\x3e\x51\x0d\x1a\xa3\xdc\x5a\xa8\x1c\xc4\x7b\xdf\xb0\x5e\xab\xc9\x6d\xac\x10\x1d\x8e\x9f\xb3\xbd\xe1\xd6\x1e\xfd\x46\xaf\xf5\x61\x13\xc4\x71\x7a\x59\x89\xf8\x0d\xa4\x37\xb7\xad\xee\xc5\x26\x8c\xbd\xba\x7d\x66\x9d\x11\x07\xc5\xf6\x03\xc0\xcc\xf0\xbb\x90\x4c\xc5\x39\xd2\xda\x35\xf0\x90\xfe\x98\x54\xc3\x8e\x03\x68\x21\xc3\x2f\xf8\x30\xe3\xf7\x41\xf6\x3e\xd0\xc0\x89\x9d\x04\x02\x09\x0d\x6a\x04\xd0\x5c\x37\x98\x0a\x91\x01\x20\xef\xcf\xab\xf3\x3a\xb1\x15\x92\x90\x8c\xd8\x97\x42\x6b\x15\x2d\x01\x04\x6f\xd2\x1d\xfc\xfb\xce\x86\x33\x09\x0b\xe0\xdb\xfe\x99\xdb\x28\x58\x9d\xe3\x54\x02\xa2\x2d\x52\x91\x84\x96\x99\x2a\xc8\xa4\xe9\xed\x9d\xd4\x03\x24\x11\x82\xc4\x5b\x4e\xd3\x60\xc4\x0e\xa6\x55\x7c\xe2\x9d\x54\xe1\xce\x11\x28\x07\x38\x51\xe7\x44\xd7\x03\xcc\xe6\xb3\x89\xd6\x6a\x69\x13\x74\xb1\x48\xe0\x1c\x1a\xf8\xb4\x12\x98\x9f\xca\xc6\xe0\xcf\x51\x60\x5c\x03\xb0\xe3\xc6\xcf\x81\x25\xaa\x1d\xce\xee\xb8\xdf\x93\x12\x0e\x63\x3b\xf4\xc2\xa0\x00\x6e\x24\x48\x0a\x0b\x74\x95\xfd\x9b\x91\x13\x9c\xc3\xa6\x5f\x22\x6d\xfb\xd8\xc2\x9e\x27\x91\x08\xde\x02\x0d\xaa\x15\xdc\x36\xc0\x9e\x60\x06\xc0\x4b\xd6\xd2\x42\x9b\xce\x05\xba\x25\x0f\x23\xc3\x19\x75\x3f\xae\x5f\xd7\x6f\x99\x12\x95\xd1\x2e\x0a\x7f\xac\x4e\xc9\xf9\x6b\xe1\x78\xe8\x0b\xe8\x86\xcc\xf5\x7f\x26\x36\x6c\xb6\x15\x3e\xd8\xbb\x8e\x9b\x24\x70\xe2\x43\xb6\x04\x19\x0c\xd2\xe0\x91\x90\x7a\xd2\xb0\xe8\xa3\x7c\xbc\x0d\x0a\x1c\x22\x17\x68\x00\xb8\x62\x27\x40\xec\x20\x43\x70\x45\xf1\x88\x58\x97\xd6\xb7\x98\x70\xc8\xdb\x45\x03\x96\x8b\xc7\x97\x03\x59\x18\x9b\xbd\xa0\xb9\x0e\x4a\x34\xe3\x18\x02\xea\x90\x8a\x8d\x39\x61\x4e\xa1\x23\x62\xdb\xa6\x59\xc0\x75\x08\x84\xbf\x72\x3b\x23\x13\xf7\xb9\x12\x9b\xe7\xd1\x07\xe1\xfc\x6f\xf0\xbd\x00\x9a\xa6\xd2\xc6\x9f\x15\x46\xeb\xa5\x03\xff\x3e\xe9\xf9\x19\xd5\xf4\xd8\x54\xf5\x77\xa1\xf6\xc2\x1b\xff\xab\x55\xfa\x71\xdf\x05\x12\xd0\x89\xc6\xe6\x99\x2d\x36\x4f\xc2\xe1\xa6\xf2\xe6\xd2\xb2\x5a\x0f\xdb\xd6\x1f\x1d\xd2\x81\xa2\x94\x5f\x29\x5f\xbd\xa4\x62\x87\xd3\xec\xe6\xbb\xcd\xac\x5e\xe4\x7c\x1a\xdd\xc6\x92\x17\x5d\x2b\xe5\xf9\x79\x3b\x53\x1f\x0a\x89\x07\x4b\xcc\x25\xf4\x8d\xd0\xbd\x81\xb6\x17\x4f\x2b\x47\xe0\x6a\x76\x83\x99\xff\xa7\x80\x80\x2b\x8a\x18\xfe\xab\x57\x1b\xf6\x8e\xdb\x66\xc3\xde\x49\x2b\xd4\x1e\xec\xc1\x5f\x65\x8d\x56\xe1\xbf\x30\x9d\xf4\x13\x66\x1d\xf1\x66\xab\x38\x29\x4e\xe3\xe8\xf2\xb8\x05\x2c\x19\x01\xbd\x31\xd3\x81\xf0\xb7\x42\xc1\x77\x83\x67\xcd\xc1\x9f\x63\x7e\x2d\x0a\xd7\x70\x1f\x4c\xda\x83\x10\xbd\x45\xfd\xae\x75\x3a\xd2\x7f\x15\xbc\x5d\x75\xc6\xfc\xa9\xd4\xa3\xcf\x41\x1c\xe3\xaa\x5f\xbb\xda\x6f\x98\x1d\x0c\x52\x8e\x8d\x3b\xfe\x0f\x1c\x2a\xae\xb9\x93\x93\x64\xd1\x02\x20\x03\x31\x3e\x46\x80\x9e\xda\x5f\x4a\xd5\xd8\xb1\x62\x8a\xaa\x2d\xdb\xb6\x52\xd5\x0a\x92\x5e\xb5\xe0\x3b\xfc\xaf\x00\x0b\x26\xab\x1b\xe8\xb1\xcb\xde\xf5\xe7\x59\x5b\xef\x94\x98\x1b\x70\xcd\xde\xcb\xb4\x28\xb8\x70\x2e\xcc\x7e\x3c\x36\xed\xd4\x8b\xd6\x08\xde\x52\x9b\x5b\x56\x3f\x5a\xaa\x1e\xad\x64\xd1\xfe\x2b\x5d\xf8\x37\x6d\xa6\x90\x91\xf5\x68\xcd\xc5\x9a\xec\xbd\x35\xe2\x1e\x8e\xa6\xe2\xe6\xe2\x66\x73\x8c\xde\x70\x35\x16\x7e\x47\xf4\x07\xae\xeb\xd7\x2b\x3b\xab\x97\x56\x1b\x95\x43\x40\x04\x2e\xac\xbb\x7f\x0d\x48\x72\x7e\x8d\xd5\xaf\x2f\x46\x49\xbe\x46\x90\xe4\x1a\x57\x44\x70\xc7\x3e\x08\x37\x63\x3f\xe7\xe0\x4a\x97\xf8\x23\xab\x09\xa0\x50\xc6\xca\x9f\x81\x46\x2b\x4a\x47\x00\x90\xe3\x81\x1d\x92\x2c\x2d\xb1\x64\x6d\xbb\x3c\xda\xed\x04\xf4\xe6\x12\xb5\x71\x22\x36\xc7\xbf\x67\x25\x7d\x66\x07\x19\x0c\xc5\x1d\x54\x3c\x3a\x04\x30\xe2\x37\xb9\xe9\xb4\xd9\x9c\x33\xe0\x49\xe7\xdf\x25\x78\x9f\xd3\x6c\xef\x1d\x6d\x23\xea\x67\x8d\x1e\x97\xd6\xa4\xca\xf8\x89\xb5\xf2\xc1\x72\x6a\xd7\x0e\x57\xc0\x06\xe7\x11\xbd\xc1\x49\x5a\xed\x65\xe6\x32\xa8\xbb\x2e\xc3\x5c\xec\xb0\xb5\xe2\xf7\x01\x1a\xc9\x00\xf1\x12\x81\x85\xb0\x37\xe8\xfe\x10\xd2\x2e\x6d\xb4\x1f\x77\xa3\x72\x60\x50\x79\x06\x9e\x65\x6e\x46\x54\xd9\x50\xb3\x8d\xbd\x94\x74\x13\x48\x36\x06\x04\xf7\x02\x1b\xdc\xc9\xa0\x8b\x08\x98\xfb\xfc\x4e\x72\x47\x75\x21\x08\x7d\xa4\x8b\x5a\xab\xa3\x2f\x46\x7f\xb1\x96\xb6\x70\x19\x33\x70\x38\x36\xff\x14\xbd\x00\x39\xa5\x29\x94\xa5\xa6\xc0\xf0\x47\xb5\x4f\x05\x35\xef\x79\x08\xc5\x98\xb5\x14\x0d\xd3\xcb\x92\xf6\x7d\xc2\x3e\xb1\x64\x92\xda\x56\xda\x86\xfd\x22\xb6\xc3\xed\x3a\x5e\xba\xf9\x23\xd1\x88\x7f\x91\x63\x17\xc3\x9c\x9f\xb8\x6b\xb6\x06\x9a\x7a\xaf\x96\xb4\x87\x40\x0a\x35\x20\xa6\x0d\xdb\x49\xb5\x17\x26\xa1\x10\x4a\xff\x0f\xdf\x42\xd4\xd3\xec\x34\x54\xfa\x8d\x6c\x8f\xde\xfd\x03\x24\x04\x56\xb0\x1a\x9a\x48\x04\xc5\x8d\x48\x0f\xd6\x00\x81\x7e\x4c\xa6\xc3\x7b\x3a\x99\xed\xf8\x72\xe0\x9f\xc7\x6d\x40\x38\x07\xff\x99\x59\xc2\xeb\x70\x3e\xc2\x68\x61\x9f\xb0\xf7\x82\xdb\x69\x0f\xce\x5c\x64\x32\x07\xb4\x5b\x4a\xf6\xaf\x3c\x5b\xf7\xa2\x7b\x26\xfe\x7f\xf6\xde\x6d\xd9\x8d\x1b\xdb\x12\x7d\x3f\x5f\x81\x37\x49\x51\x94\x5a\x4b\x17\x97\xaa\xa2\xa3\x23\x56\xf9\x52\x56\xef\x72\xd9\x51\xf2\x6e\x9f\x7a\x04\x33\x41\x12\x5e\x48\x20\x0d\x20\x49\xd1\x4f\xe7\x5b\xce\xa7\x9d\x2f\x39\x81\x39\x27\x6e\x99\x49\x32\xb9\x2e\xaa\xbd\xbb\xf7\x8b\xc3\xe2\x22\x33\x91\x48\x60\x62\x5e\xc6\x1c\x43\x37\x76\x70\x50\xd6\x34\x07\xf5\xa8\x68\x9f\xc7\x08\x73\xc2\xe2\x5f\x83\x31\xdf\xe0\xc8\x50\xf3\x94\x6d\xc2\xf0\x4a\xb6\xae\x8e\x6f\xb5\xdc\xc8\x06\xe1\xab\xa6\x25\x65\x69\xa9\xa9\x7b\x0a\x7e\x10\xd3\x61\x98\x47\x42\x69\x91\xf0\x14\xf8\x65\xe9\x12\x31\x8b\x2f\xdb\x6e\x52\x66\xa7\x64\xc6\xd5\x62\x23\x41\x4f\x3a\x82\x29\xa8\x16\x84\x0a\x22\xd1\x46\xc3\xb9\x34\x80\x40\x50\x88\x58\x5f\x31\xa2\x00\x3e\x08\xb5\x17\x63\x25\x16\xec\xf4\xe5\x16\x02\xe4\xd8\x41\x11\x86\xbd\x50\xcb\x0e\x99\x26\xf0\xf7\xae\xba\x00\xa5\x19\x22\x57\x03\x8e\xde\x8f\x77\xbd\x74\x0c\x5a\xf0\xa1\xac\xad\x54\x11\xa4\x81\x7e\x8e\x74\xc1\x99\x4a\xec\x80\x89\x86\x00\xff\x02\x5f\x42\xb2\xf4\x12\x85\x03\x92\x7c\x30\x9e\x70\xc5\x19\xb5\xe3\xd8\x6b\x1e\x4f\xc0\x60\x58\xe6\x32\x6f\x10\x58\x56\x1b\x34\xec\xb6\x51\x1e\xc5\x47\x66\x54\xea\xcf\x0b\x1e\x57\x1d\xa6\x3a\x91\x9e\x1e\xda\x69\x61\x87\xc7\x8a\xd5\x43\x36\xb4\x74\xd3\x44\xf6\xec\x69\x74\x4d\x26\xfb\x5f\xc1\x3e\x49\xe7\xd3\x6c\x94\x36\xc9\xb0\x65\x2a\x41\xe9\x90\x68\x90\x5a\x47\xd6\x96\xef\x85\x3d\x9e\x4b\xb2\x95\xeb\x2e\xaf\xa6\xf1\x12\x42\xea\x32\x7c\xbb\xa2\xeb\x8d\xe5\xf6\x38\x1b\xef\x5d\x8e\xf4\x12\x77\x6e\x74\x9b\x66\xd2\x6b\x97\x52\x59\xca\xe0\x86\x38\x92\xe0\x0f\xd6\xde\x67\xc6\x95\x11\x62\x17\x49\x49\x9e\xe6\x5c\x8b\x2e\x79\xc4\x03\x6b\x51\xe6\x18\x2b\xae\xee\x05\x27\xdc\xb9\xc5\xff\xf9\x01\x0b\xff\x6c\x66\xe2\x4f\xcb\x0e\x32\xe8\xbc\x4f\xa4\x8f\xe2\x88\xb4\xfa\x4c\x8b\xc3\x3d\xb2\xd6\x67\x44\x22\x2f\xb8\x68\xb1\xef\x1e\xb5\xe2\xaf\xa9\xf5\x9c\x8e\xa9\x5a\x11\xc2\xc3\xcc\x41\x37\xa9\xe2\xd4\x3c\x74\x10\xb6\x05\x23\x9f\x70\xed\x11\xf5\xca\x63\x5c\xf1\x8a\xdd\x2a\x67\x92\xf2\x4a\xd9\xb7\x7c\x32\xe6\x2b\x6a\x51\xb3\x01\x4b\x1d\x9f\x40\x21\x3a\x45\x99\xcd\xce\x38\x91\xbe\x73\x21\xa2\x2b\x9e\x64\x56\x63\x35\xd7\x5a\x2a\x29\xa0\x4b\xf1\x27\x1f\x07\x9d\x31\x66\xc2\xe0\x13\x5e\x18\xac\x97\xdc\x22\x7e\xf6\xc4\x2d\x45\xd8\x13\x81\x40\x31\x76\x92\x88\xce\x63\xe4\xf6\x3c\x73\xc1\xa3\x44\x65\xc6\x66\xb9\x89\x3c\x23\xc1\x48\x01\x3f\x3f\x66\xd5\xca\x45\x1f\x47\x4d\x38\x66\x13\x69\x60\x88\x33\xaf\x20\x66\xc7\x69\xad\x6f\xf9\x7e\x2e\x2d\xfa\x80\xbb\x4e\x0f\xe1\x33\x36\xc7\xa8\x96\xfd\x10\xa2\x72\x61\x97\xe1\xe5\xaf\x39\x75\x17\x9a\x1d\x6a\xef\x5c\x01\x5b\x0c\x20\x08\xab\x5c\xd5\x93\xe4\xa7\x62\x57\xdd\x2f\xdc\x2e\xa8\x98\x3d\x00\x4c\x73\x75\x79\x61\x5c\xaf\xae\x12\x28\x33\x7e\x5f\x6c\x7d\xe3\x96\xab\xe3\xef\x54\xe0\xf5\x95\xb6\xc5\xe5\xbc\x2c\x90\x78\x16\x9e\xfa\xe4\x40\x9f\xd0\x39\x60\x50\x4b\x5c\xf9\x33\xc3\x9a\xc3\xeb\x66\x43\xb3\x9c\xa3\xf8\xc1\x3b\xfa\xab\x73\xdb\x2b\x9d\xe9\x57\x1e\xe9\xef\xfd\xae\xf6\xf9\x1d\xbe\xac\xf5\x9c\x70\x02\x4e\x26\x4c\x54\x3a\x19\x22\xcd\x41\xd5\xec\x76\x61\x9f\xfe\x04\x74\xde\xcb\x1a\x5e\x1f\x17\xe6\xf1\x2f\xde\xa6\x63\x94\xdb\xad\x6d\xbc\x6c\xbe\xd0\xbe\xdd\x0d\x1d\xd7\x46\xb6\xff\x82\x7d\xfb\xbf\xed\x9e\x9c\xcd\x7d\x9e\xdd\x93\xe9\x2d\x9c\xde\x93\x6f\xa2\x04\x7e\xfc\xee\xd3\xef\xc9\x23\xbb\x1d\x2c\x5f\xa4\xcb\x7e\xad\x5e\xf7\xe5\xdd\xe8\x25\x04\x4f\x4a\xfe\x36\x84\x90\xe9\x44\xc2\x09\xcb\x15\x18\x5f\x71\xe6\x78\x63\x45\x0b\xbf\x6a\xca\x42\x8b\x6b\x2c\xef\xa1\x33\x47\x19\xbf\xc3\x98\x2b\x7c\x5b\x6a\xff\xcc\x31\x0b\x3a\xe5\x96\x15\x45\xa4\x9e\xdb\x06\x95\x8b\xe8\xbb\xe1\x92\x5b\x00\xeb\x85\x89\x7b\x92\x1e\xf8\x99\x5d\xfa\x8d\x04\x5d\x17\xec\x3b\xa0\xea\xbb\x19\x7c\x6e\xfa\x46\x6e\x0d\xae\x84\x6b\x10\x1e\xcf\x99\x33\x1b\x4f\xac\x32\x51\xfc\xfb\xed\x29\xf1\xef\x57\xec\xeb\xb2\x8d\xa6\x2c\xa8\x4a\x12\x77\xa3\xdf\x8c\x7d\xfe\xb4\x03\xa0\x23\x3d\x73\xc7\xc0\xfd\xde\xd7\xb7\x83\xde\xe8\xbd\xa8\x01\x2f\x1c\xc1\x87\x63\xa8\xcb\x38\xd5\x05\x3f\x3c\x5f\xf9\xc8\x9c\x3b\xdd\x09\x05\x8a\x8a\xd0\xf0\x80\x2d\x40\xd0\xcb\x46\x5a\x7e\x54\xbc\xdd\x49\x92\xbc\x1b\x43\xc0\x63\xab\x6f\xc9\xfb\x4d\xa5\xfd\xc1\x72\xa4\x15\x22\xbc\x2a\x5b\x5b\xa9\x14\x30\xfa\x60\x93\x06\x76\xea\xc1\x4f\x84\xbd\xb6\x66\xb4\x16\x08\x8f\x10\xed\xfc\x93\x2d\xd9\xc6\x03\xb4\xbe\x99\x0d\xfb\x9e\xb7\x7c\xea\x05\xcf\x01\xc6\xae\x61\xdc\xbe\x79\xbf\xbc\x42\xdb\xdc\x81\xec\x75\xe3\x4d\x0f\x1b\x49\x7b\xde\xa8\xc7\x2e\xd0\x9e\x8f\xbe\x01\x81\xcd\xd9\x96\x7b\x71\xe0\xc7\x18\x15\xb7\xdc\xde\xb1\xb5\xf0\x07\x11\xb3\x3a\x1e\xb4\x72\xc2\xbe\xdf\x62\xe7\xfc\x46\x40\xf2\x1b\xde\xf1\xa0\xef\xb4\x39\x68\xb6\x33\xd6\x02\x99\xe1\xed\x09\x49\x50\xb3\x61\x6b\xc5\x1b\x6c\x0b\x82\xc4\x27\x30\x2b\x81\x2e\x67\x56\xe4\x39\xa5\x0d\x54\x28\x03\xc5\x4e\x93\x13\xce\xef\x3e\x1c\x1d\x12\x7b\xad\xe2\x20\x39\x6b\x78\x63\xfa\x9d\xd1\x47\xa0\xc1\x08\x66\xe1\xb0\x43\x62\x05\x44\x67\xab\xc1\x82\x90\x99\x36\xd2\xc5\xe6\x3b\x82\x8a\xed\x04\xb7\x91\x09\xe6\x6d\xc1\x04\xf3\x8a\xfd\xdd\xc4\xd6\xad\xd8\xaf\x65\x6c\xd6\x22\x80\x4e\xab\xb9\x3e\xab\x31\xb6\x1b\x91\xcd\x33\x08\xef\xb8\xe0\x2f\x01\xba\xe1\x91\x73\x13\xfd\x81\xdb\x3e\x36\x60\x6c\xf8\x3a\x04\x3f\xe1\xa1\x7b\xd0\x6f\x8e\xd9\xf8\xd8\x27\x3b\x51\x56\x1a\x95\xc3\xcf\xd1\x76\xc0\x35\x62\x41\xeb\x2b\x7c\x93\xc4\x74\x33\x73\x91\x5a\xee\xa8\xbc\xc4\x35\x1a\x4b\x90\xe8\x0f\x77\xe3\x8d\x6c\x53\xe5\xdb\xb1\x4e\xaa\xbb\x63\x54\x82\x30\x16\x98\x28\xe2\xae\x72\xcc\x0e\xeb\x64\x1c\xe5\x5c\x11\xea\xab\x29\xb1\xff\x22\xe0\xe9\x10\x96\xeb\x33\xc7\x7e\xe0\xf6\x6e\x51\x4a\x6f\x96\x20\xf0\x21\x19\xbd\xc7\xcc\xd6\xcd\x3a\xd9\xe7\x85\xef\x26\x31\xef\x49\xe0\x11\x94\x35\x88\x61\x4d\x1d\x59\x17\xec\x0c\xc2\xe3\xe1\xac\x0d\xae\xcd\xe9\xe4\xf7\x7c\x62\xef\xca\x7c\x5e\x8d\xe9\xc4\xbd\x00\x5f\x9a\x9e\xc8\xfa\x18\xbd\xea\xe7\x3f\x09\xdb\x88\x1e\x7b\x07\x4c\x72\xb6\x9f\x7f\x1a\xec\x5e\xee\xb9\x8a\x0d\x05\xc7\xd8\x4a\xe3\x0d\xdb\x00\x6d\xa8\x1f\x03\xb9\xbf\x5c\x7e\x0e\x26\xf7\x8a\xf4\xdc\x7f\xe5\xd2\xae\xcb\xa5\x1d\x7b\x0d\x09\xe5\x9f\x78\x38\xc3\xa6\x81\xfa\x9c\x27\x71\x8d\x84\xda\x05\x4e\xc8\x54\x8c\xa6\xbe\xf6\xb0\xa9\x90\x3d\x93\x08\x28\x48\xa7\xec\xe8\x3c\x57\x6c\x2f\xb9\xaa\x8e\xc3\x7e\x67\x5c\xbf\x33\x36\x78\xca\x50\x2e\x0c\xb6\x96\xab\x27\x09\xeb\x1f\x27\x44\xaf\x98\xd1\xfc\x41\xa2\x07\xd0\xe3\xec\x43\x30\x63\x94\xb1\x2e\xf2\xbc\x51\x03\x78\xc1\x93\x2b\x2d\x93\xda\x41\x33\x56\x0a\x01\xa0\xab\x6d\x1a\xd9\xc7\xab\xc6\xc2\x36\xca\x7a\x20\x2a\x04\x4c\x46\xd4\x9e\x38\x25\x56\x8e\x7c\xfb\x06\x1b\x80\x7c\x71\xc5\x12\x22\x72\x3a\x66\xaf\x50\x20\x55\xd1\x2f\xd6\x91\xa3\x70\xd7\xd4\x03\xfa\x65\x17\x1b\xbb\xba\x48\x66\x90\x1b\xbf\x27\x05\x44\xdd\xf0\x9e\x37\xd2\x43\x87\x76\xa6\x6d\x42\x00\xbf\xd9\xb0\xd7\x97\x1c\x8f\x22\xa3\xb0\x21\x29\xf0\x49\xbc\x80\xd8\x2e\xf4\x12\x00\xc6\x4c\x38\x16\x0b\x5c\xc1\xa6\x13\x46\x0b\x26\x94\x03\x4c\x3e\xc6\x1c\x49\xf0\xcb\xed\x22\x32\x3f\x63\x5d\x07\x1f\x33\x23\xce\x0f\xbd\x59\x44\xe1\xfb\xb1\x11\xec\xdf\xb4\xdc\x88\x79\x9a\xca\xa7\x55\xe6\x8c\x9b\x15\xb0\x6c\x40\xd2\x1e\xdc\x5e\x63\x8b\x9c\x5a\xf3\x48\x2a\xca\x0b\x3a\x3f\x6a\xae\xc5\x5d\x70\x6d\x71\x04\xb0\x00\x36\x2a\xea\xc3\xcf\x6b\x6f\x3d\x3a\xed\xeb\x1c\x13\xd2\x2b\xf6\xbd\x84\x06\xce\x4e\xa6\xd4\xd5\x8e\x54\x62\x49\xf8\xbb\x8d\xf0\xab\xa8\x4c\x7f\x99\xab\x09\x36\x22\xc6\x13\x10\x8f\x40\xef\x5e\x23\xe2\xf5\xda\xfb\x79\xa1\xa5\xcf\xfb\x2f\x81\x61\x15\x03\x78\x00\x19\xec\x74\x73\x3f\x12\xb3\xe3\x32\x9e\xd9\x85\x64\x4f\x61\x1b\x7f\xf2\x66\xa6\xfd\x71\xae\xdb\xfb\xaa\xd6\x94\xe5\xcd\xde\xbd\xd4\x98\x9c\x05\xdd\x4a\x02\x57\x8b\x03\x39\x77\x71\x7f\x3f\x0a\xc4\x6b\x86\x09\xf2\x64\x5a\xfc\x56\x37\x32\xdc\x6b\xa6\xff\xeb\x5a\xb6\xc8\x1d\xb1\xda\x41\x53\x33\x6c\xbc\xb0\x4f\x90\x27\x35\xfa\xda\xa5\xbc\x6f\x1d\xef\xaf\xd8\x3b\xfa\x77\x58\xa6\xac\x39\x42\x0c\x6b\x2f\xaa\xfe\x9e\x38\x4d\xd3\xef\xef\x45\xb4\xf6\xa6\xfd\x50\x72\x5b\xa4\x78\x51\xb7\x40\x38\x5d\x6e\x9e\xa7\x42\x60\x7e\xcf\xa5\x02\x82\x80\xcc\x83\x03\xc2\x3f\x5d\x24\xd2\x31\x9b\x58\xa3\x3b\x21\x75\x9c\xd3\x5d\xb3\x9c\xd6\x4f\x6a\x77\x66\xbd\x6c\x40\xf3\x4d\x67\xf5\x02\x8c\xb9\x34\x3f\xef\xca\x56\x9e\x0c\x66\xfe\xc3\xb2\x76\xcf\x8f\xad\xd0\x5e\x6e\xa6\xca\xc5\x97\x03\x6e\x2b\xfd\xc0\xd5\xff\xf8\xe7\xb7\x9f\xfe\xfb\x7f\xa3\xff\x2f\x8c\x43\xf4\x67\xef\x43\x06\xc7\x59\x70\x17\xa7\x68\x50\x4c\xcd\x63\x13\x17\x33\x07\xc5\x36\x82\xfb\xdd\x83\x2d\x44\x5d\xdb\xfe\x37\x6d\x0e\x4a\xb4\x5b\xf1\xe2\xd2\xd1\x9f\x21\x2c\x25\x7f\x72\x92\xe6\x44\x60\x1a\xb9\xce\xc1\xd7\x82\x3d\x48\x19\xb7\x28\x80\x82\x08\x17\x44\xea\x83\x38\x50\x96\x74\x1d\xf1\x31\xc0\x1f\x5e\x12\xc8\x2d\x62\xf8\xc3\xcd\x94\xe0\x16\x55\xb6\x7b\x6b\x7a\x61\xbd\x14\x51\xdb\xef\x00\x6a\xba\xd8\xb2\xd1\xad\x12\x7d\x96\xf4\xcc\x8a\xdf\x06\x09\x5c\x2a\x95\xb2\xec\xe0\x28\xaf\x15\x7e\xdb\x81\x82\xc6\x2e\xb8\x05\x8e\xba\x05\x40\x95\x82\xeb\x23\xb6\x83\xe0\x9d\xe3\x55\xc3\xb7\x89\x98\x08\x08\x49\xaa\xd6\x3a\x78\x20\x50\x9a\x20\xf5\x15\x10\xb0\x49\x51\x3d\xfc\xf9\xc0\x2b\x92\x23\x5e\x6a\x63\xc5\x5b\xc9\xb0\x05\x0a\xa6\xcb\xf6\x02\x01\x0b\xf1\x33\x06\x3f\x4a\xf0\x36\xb1\xf5\x15\xe9\xb4\xb9\xd7\x53\xdf\x13\x88\x33\xc3\x73\xc5\xa7\x5f\xa1\xf2\xd7\x60\x2d\x2a\x69\xe4\x47\x5d\xd6\x12\xfa\x31\x4a\x17\x7d\x6a\xac\xec\x97\xe9\x20\x7c\x7c\xda\xbd\x97\x19\x99\x79\xfb\x72\xcd\x1d\x10\x61\xdc\xcd\x8a\xd7\xdd\x0b\x8c\x7d\xf3\x9a\xb5\xfc\x78\x41\xd5\xee\x81\x51\xed\xc1\x4a\xe0\x7f\xcf\x65\xb6\x15\xeb\x79\x4f\xcc\x6e\x25\x9d\xca\x20\x3d\x70\x99\x84\x5f\x20\x7a\x1b\xc3\x76\x84\xf3\x82\x60\x75\xce\x74\xf5\xc6\x87\xdd\x91\xc8\x29\x60\x97\x13\xc1\xed\x15\xca\xf9\x3f\x9b\x54\x5a\x2b\x95\x69\xc6\x72\x3d\x27\x50\x70\x78\x52\xc4\xf1\xc6\x78\x1a\x29\x00\x56\xf0\xb9\x17\x90\x09\x86\x03\x6d\xc7\x23\x9f\x4c\x63\xf4\x5e\x1c\x1d\xac\x62\xc8\xe4\x75\x82\xc3\x41\x83\xbb\xc2\x0b\xa8\x02\xa5\x9b\x1e\xac\xa1\xec\x7a\x18\xf3\x2b\xf6\x33\xb2\xea\xc1\xb4\xb9\xf9\x31\xa0\xf8\x57\x98\xae\x10\x0a\x14\x23\x81\x0a\x18\x56\x34\x8c\x4d\x69\x7d\x07\x4b\x3e\x51\xcd\x0f\x5a\x12\xc1\x88\x5c\x2b\xf1\x8a\xdd\xaa\x10\xde\x73\x2f\xf7\x42\x1d\x0b\xde\xe1\xd4\x78\x16\x6f\xef\x4d\x14\x3f\x02\xd4\x2e\x24\x33\xb5\x97\x56\xa8\x63\x21\x22\xdc\x09\x50\x67\xab\x66\xa8\x14\x19\xde\xe1\xeb\x68\x13\x6b\x52\x78\xc6\x94\xea\x88\x1f\xa6\x8a\x38\x68\xdd\x98\x81\x85\xa7\x3a\x4b\x32\xb5\x03\xee\xbf\xbc\x43\xd6\x22\xd3\x8e\xe2\x34\x1a\x2b\xb7\x52\xe7\x09\x89\xc5\x84\xb4\xcc\xd6\x20\xb8\x1b\x9b\x02\x16\x62\xf0\x11\xf7\x68\x87\xb0\x9c\x76\x3e\x72\x55\xe1\x95\x77\xb2\x6d\x85\x8e\x53\xb2\xc8\x4e\x75\x9d\x51\x98\xe9\x9b\x0d\xf6\x97\x52\xc3\xde\x17\xea\xf6\xb8\x49\xb4\x05\x11\xfd\x77\x48\xe5\x7d\xb0\xe0\x57\xd4\x61\xfb\xd5\x5c\x29\x17\x3b\x97\xfe\x18\xc2\xca\xc7\x6a\x5c\x3a\x91\xf0\x8a\x41\xbd\x72\x06\xd4\xe2\xdc\x19\x92\xf9\xf0\x1c\x51\x31\x35\xaa\x7b\xef\x44\xeb\xd8\xba\xe4\x29\x9c\x2d\xfd\xeb\xb2\x58\x1f\xf3\x57\x19\x87\x42\xb5\xbe\xeb\xb0\x39\xa8\xcb\x36\x65\x6b\x29\x66\xf0\xed\xc5\x19\xac\xc9\x72\x23\x60\x67\x56\x11\xd7\x25\x69\xc3\x48\xe9\x9e\x98\xf5\x0a\x66\xf8\x36\x25\x43\xb5\xd1\xf1\xfb\x17\xa9\xec\x3e\x6e\xaa\xa6\xcf\x22\x56\x40\x82\x99\xe0\xf9\x65\x19\xf4\xb2\xa6\x51\x4d\x89\x74\x91\xe4\x2f\x98\x3f\xb7\xfb\x92\x79\x87\x3f\x4e\x0b\x7b\x6f\x97\x85\x18\x5d\x6f\xa5\x33\x3a\xdc\x6e\x62\x42\xfe\xb4\x14\xf4\x73\xc2\xa7\x59\xdc\x6c\xb6\x17\x4a\x0d\x1d\x6b\x45\x2f\x93\x8a\x27\x67\x0d\xb7\x7b\xe0\x0d\xe3\x7e\x10\xde\x17\x22\x05\x77\x02\xaa\xeb\xa6\xac\x3a\x25\x8d\xdf\x5e\x40\xef\x55\xba\x13\x1e\x67\x7b\x0e\x12\xb4\xbc\x69\x8c\x6d\xe9\x8c\x0a\x3f\xde\x0b\xeb\x0a\x61\x4a\x7c\x27\x59\xf6\x61\x35\xf6\xb2\xde\x63\x9c\xd3\x0b\x0b\xd9\xbb\x6f\xa4\xa8\x87\xf1\x60\x86\xeb\x91\xa3\x75\x29\xb5\x19\x57\xb9\x15\x80\x61\xc4\x48\x01\xd4\x75\x9f\x1c\x9f\xbb\x86\x25\x4b\x4c\xc2\xe4\x08\xa1\xb7\x41\x17\x70\x2b\x8a\x9c\x4a\x5d\xda\xb4\xf0\x65\xc1\xce\x2e\x3d\x75\x75\x53\x66\xbf\x94\xd2\x3d\x99\xda\x8f\xcd\xd3\x5a\xe0\x9e\x5b\xe3\xc9\xb0\x62\x82\x23\xfb\x31\xb4\xf8\xa5\x36\xb0\xf8\x75\xc8\xbc\x02\xca\x0a\x4f\x4f\x0a\x8b\xd0\x9e\x80\xa0\x21\x64\xed\x41\xd1\x41\xee\xc5\x42\x00\xe1\x38\xdf\x20\x2a\xf1\xe0\x22\x1a\x1d\xab\x1f\x6d\x8c\xed\x60\x29\xcb\x62\x2b\x9e\xbb\xd3\x5f\x86\xe0\x0d\xff\xb9\x7c\x6b\x12\x98\xea\x4c\xb7\x16\x2d\xdb\x70\x0b\x6d\x6c\x9c\x9a\x2c\x04\xb7\xa8\x76\xc9\x0b\x84\x4a\xb2\xa5\x28\xdf\x43\xfe\xde\xaf\xe1\xe5\x2b\x10\xa6\x27\x79\x1f\x6c\x83\x84\x0a\x63\x99\xe8\xfe\x3b\x29\xe9\x60\x53\xa2\xab\x6b\x50\x78\x97\x4c\x08\x5e\x4b\x3d\x12\x17\x29\xf0\xb3\x38\x50\xc5\xd4\x3c\xf1\x95\x7a\xc3\xe0\x71\xb4\x37\x00\x31\x89\x35\x90\x05\xf5\x99\xd1\x9e\xc7\x83\x54\xba\xf9\xfd\x0d\xc1\x3c\x89\x8d\x4b\xbf\xb3\x80\x68\x59\x9f\x65\x50\xde\x21\xe0\xf0\xcf\xec\x7b\xa0\x69\x68\xc2\xbf\xdd\x2a\x1c\x70\x9d\x3a\x02\x0b\x56\x66\x7e\xc5\xa4\xe1\x0a\xb7\x61\x79\x42\x90\x58\xeb\xab\xd1\xbb\x8b\x7b\xf7\x04\xe4\x2b\xad\xe0\x9a\x2b\x69\x2d\xe0\x7f\x93\xdc\x34\x9c\x73\x0b\xf5\xda\xee\x3d\x69\x1b\xa9\x05\x3e\x3c\x40\x26\xad\x68\x00\x20\x09\x5c\x15\x67\xb3\x83\xa2\xdd\x8a\x96\xfd\x04\x2b\xfc\xcf\xa5\x66\xa1\xe5\xda\x85\x15\xe1\xea\x99\x02\xf5\x04\xc0\x83\xb6\xa2\x93\x61\x99\xe4\x75\x1a\x25\x3c\x63\x09\xa6\xe2\xb7\x85\x86\xd4\x72\x55\x11\x3f\x5d\xba\x0a\x1d\xb8\x9c\x29\xbe\x3e\x5a\xa9\x3d\x72\x30\xa2\x12\x1f\xf3\xe6\x40\x31\x29\xa4\x4c\x48\x52\xa6\x31\x7a\x03\xef\x27\x8b\x70\x86\x6f\x50\x5a\xb3\xc0\x50\x3e\xc9\xa4\x77\x52\x4b\xdc\x3e\x56\xf4\x56\xb8\xe0\x1a\xf8\xe2\xab\x68\x37\xb0\xb9\x0a\x5c\x98\x5f\x79\x7b\x76\x24\x3f\x48\x2d\xbb\x21\xf8\x13\xb0\xb7\x83\xc5\xa9\xec\x89\xdb\x05\xcb\x89\xbc\xed\x6c\x27\xc0\x71\x34\x1b\x76\xc3\xa0\x26\x00\xab\xd1\x25\x7b\x05\xeb\x9e\x4a\xce\x5b\x41\x72\xfd\x21\xa6\xa7\xb9\xc3\x74\xd8\x2b\xf6\xb7\x14\xf5\x4c\x4c\x46\xfa\x19\xc6\xcc\xea\xc8\x9e\xf3\x52\xca\x28\x7b\x5f\xe1\xf0\x0a\x66\x61\x0e\x37\x4a\x7f\x96\xfa\xc5\x8a\xad\x07\xcf\x34\x59\x29\x28\xba\x8e\xef\x4b\x6c\xbd\xeb\xb8\x6f\xc2\x54\x8e\x79\x92\x67\x96\x51\x1a\x69\x72\x3f\x9b\xc1\xa3\xe8\x38\x48\xb3\x23\xdd\x71\x7e\x7f\xd8\x09\x0a\x30\xdd\xcb\x6a\x00\xf7\x5e\x20\x60\xb3\x57\xb4\x97\x38\x04\xcf\x71\xa0\x25\x24\xba\x31\x76\xd0\xed\xd0\xad\x58\x2b\x79\x07\xc2\x03\xc6\x32\x3b\xac\xcf\x36\xa0\x7d\x52\xd0\x30\x5d\x2d\x90\x0d\x87\x4c\xa2\x53\x42\x60\xce\x37\x4d\x07\x3f\x84\x79\x78\xec\xa7\x6c\x8c\x76\xd2\x79\x78\x4d\x96\x5b\xc1\x9c\xe9\x8d\x05\xf2\x7f\x10\x27\x39\xcf\x34\xde\xc6\x75\x04\x02\xda\x7f\x66\xdf\x0c\x89\x02\x66\x2e\xe5\xbb\xc2\x04\xc9\x31\x46\x3f\x34\xa2\x02\xaa\x94\x55\xfa\x1b\xa3\xa3\x06\x0f\x40\x32\x24\x78\xf4\x31\x1f\x42\x26\x0e\x58\xcf\x60\x9a\xac\x50\xc0\x28\xe9\x2b\x42\x33\x51\x5c\x26\x5a\x27\x97\xe5\x0d\x0c\xa8\xa0\x0b\xc5\xd7\xc6\x82\xd3\xe7\x2a\x07\x75\x4d\xe9\xd1\x6f\x7e\x40\xef\x8d\x6f\xad\x10\x59\x48\x3b\x5f\x1a\x4e\x1a\xee\x8c\x86\xf4\x5a\xc6\x41\x00\x9d\xad\xdc\x19\xd3\x22\xc2\xa4\x23\xf7\x38\x6c\x97\xd1\xf0\x5c\x1c\x1f\x66\x21\xab\x4e\xd6\x67\x8e\x85\xf8\x61\xc5\x24\x94\x2d\xfc\x11\x7d\x30\xd0\x71\x08\x63\x4c\x80\xd1\x94\xb1\x49\x17\x31\x6b\x27\xec\x1e\x87\x15\x55\xde\x01\x29\xa7\x64\x4a\x98\x6b\xe3\xf3\x0f\xa4\xf6\x5c\x43\x56\xca\xa5\xf5\x4d\x80\x2c\x70\x97\x31\x3a\xcf\xed\xfd\x67\x13\x34\x95\xe6\x73\x0e\xca\x44\x15\x9d\x02\x87\xa2\x8c\xa5\x00\x70\xf2\x60\xf2\xfe\xe4\x77\x2f\xb1\xe2\x13\x5d\x54\x78\xad\x60\x77\x64\xd2\xc3\x20\x0b\x9d\xa3\xfb\xd1\xea\x4f\x4a\x00\xe8\xd6\x9f\xf7\x78\xfe\x59\x01\xe6\x7a\x6e\xbd\x6c\x86\x60\x69\x67\x42\x9f\x42\x79\x53\x1d\xc1\x01\xa5\xa1\x80\xd8\x66\x88\xd7\x5e\x8d\x64\x92\x12\x5b\x1f\x90\xf9\x11\x83\x7c\xe4\x4e\x48\x57\x9e\xd3\x93\x42\x85\x8c\xb8\xa3\xd0\xed\x17\x20\xb0\xad\x8e\x6c\x63\x83\x97\x4e\xac\x4a\x0e\x84\x4e\x2a\xb6\xfd\x33\x21\xaa\x6e\x84\x6e\x25\xb7\x47\xf6\xb5\x32\xc3\x94\xeb\x6e\xae\x37\xe5\x91\xe1\xec\x5f\x3c\xd9\x75\xcb\xdc\x41\x5a\x95\x95\xe6\xcd\x86\xb9\xce\xdc\x09\xe6\x76\xc6\xa7\xc3\x13\x52\x8a\x87\x9d\xf4\xe2\x65\xf8\x58\x04\x43\xed\x52\xf6\x77\xa6\x6a\x1d\x63\x80\xcb\x55\x6a\xd8\xf7\x70\x6b\xd7\x5b\xc1\xdb\xd4\x43\xd2\x18\xab\x23\x9e\x5c\x3a\xa0\x2d\x93\x61\x6d\xad\x1d\x30\x84\x40\x06\x68\x3e\x03\x8f\xa4\x8c\x40\xea\x89\xda\x8c\xa0\x2b\xd8\x0a\x4b\x21\x17\xd0\xb7\x08\x4b\xc0\xac\xe7\x65\x65\xa3\x93\x61\xab\x87\xa8\x7b\x67\x06\xfb\xa2\x50\x0b\x3c\xbf\x57\x12\x67\x03\x3e\x4a\xc2\xdf\x8b\x71\x0d\xfe\xde\x12\x67\xaf\x47\xec\x68\x0f\x4b\x14\xde\x8e\x88\x96\x20\x37\x88\x70\x5b\x08\x83\xcb\x28\x1c\x0a\x03\xd2\xdf\x5b\xb9\xa6\x06\xaa\x83\x6e\xcd\xa5\x73\x1b\x67\x11\xf9\x91\xa3\x8e\x26\x6a\x0d\xaa\xe3\x79\x6d\xca\xb1\xf4\xee\x29\xbe\x8d\x2c\x77\x38\xa5\x27\x7c\xbd\x88\x06\xec\xa3\xde\x28\xd9\x78\xf6\x0b\x00\x39\x16\xd5\xef\xbe\x0c\xef\xca\x35\x9c\x47\xb3\x4c\x60\x33\x76\x82\x10\x6a\xd8\xa5\x34\x0b\x50\x9b\x81\xac\x83\x42\xc6\x59\xb8\xda\xdb\xf6\xe6\xf5\x98\x4e\xe2\x3f\x35\xfd\xd6\x05\x60\xd6\xdb\x85\xc0\x2c\xed\x44\xe3\xd9\x4f\x8a\x6f\x87\x29\xc1\xdc\x5c\xc5\xe5\x9a\x73\x68\x39\x36\x6b\x23\x0e\x6c\x6b\x21\xb0\x08\xe7\xc2\xb0\xe5\x76\x85\x35\xd4\x3b\x61\xb5\x40\xf5\x21\xf8\x42\xca\x8a\x76\x82\x43\x47\xd8\x86\x5f\xdf\xc9\x58\xd0\x89\x9d\x5d\xb4\x11\xa5\xf1\x77\x58\x6e\x2f\xf2\x07\xa7\xe0\x5d\x49\xdb\x63\x86\xf2\x9b\x3e\xfa\x74\xe0\x5d\x9f\xff\xf9\xef\xba\x15\xb6\xe5\xf6\xae\xc0\x7f\xcd\x6c\x8b\x4f\x07\x6e\x3b\xc0\x0a\xac\xb1\x22\xa9\x4c\x33\xc0\x91\x14\x1c\xf4\x6b\x0e\xc5\xc2\x64\x4d\xcf\x47\xfa\xdd\xfc\x01\x59\x7d\x25\xc6\x81\xe3\x23\x31\xe6\x76\x5c\x6a\x54\x82\x32\x4d\x75\x9e\xe6\xcb\x3c\x73\x67\xfa\x99\x96\x9c\x82\xd8\x71\xb5\xf4\x10\x3c\x23\x28\x30\x39\x07\xdf\x3d\xba\xd4\xe7\x7f\xe4\xb3\xf0\x69\x28\x1c\xee\x69\xef\xde\xcf\x80\xcc\xde\x2d\x35\x68\x20\x19\x12\xd9\x95\xa1\xc4\x3a\x5f\x4e\x9e\xa3\x1e\xbc\x86\xd4\xf7\x42\x03\xf8\x63\x9b\xa4\x29\x50\xfc\x62\x59\x85\xea\xcb\x16\xa8\x9a\x1a\x6b\x1c\x35\x6c\xad\x4d\x7b\x5c\x41\xb1\x15\xf2\x08\x8f\x55\x6f\xbd\x50\xeb\xa5\x2a\x27\x32\x07\xee\xb8\x25\x2d\xfe\xd3\x5d\x63\xdb\x98\xa4\xcf\xa5\x85\xc4\xe5\xb7\x40\x8f\x9f\x07\x0b\x90\xca\x34\xa5\x43\x9b\x7a\xb9\x6b\xa5\xb8\x85\x88\xf0\x70\xfd\x69\x8f\x24\x09\x86\x8f\xb1\xeb\xe1\x39\xee\xb1\x53\x4b\x8c\x7d\x31\xf2\x25\x8f\x1d\xe3\x68\x78\xd3\xb9\x21\x23\x95\xd7\xa2\xa2\x3c\x5c\x36\x8a\xb0\x27\x29\xf9\xf7\x85\x92\x7c\x08\x18\x30\xd3\x74\x59\x4c\xfe\x04\x06\x38\xab\xaf\x5f\x1b\x85\xbc\x7b\xcc\x20\xe4\x8b\xe2\xe5\xef\x63\xa6\x3e\x36\xff\xc7\x1a\xa9\xf9\xfd\x0f\x42\x2c\xb2\x13\x95\xc5\x8a\x5d\x9f\x8f\x6a\x18\x4a\x24\xf9\x09\xc3\x70\x8f\x1d\x08\x25\x2d\xb2\xb9\x53\x5c\x78\x01\x3b\x0d\x0f\x6a\x2c\x73\x3a\x1c\xf9\xd2\x03\x34\xd4\xf5\xb4\xef\xb0\x5f\x36\x5c\xeb\x52\x09\x35\xdc\xfb\xe7\x11\xba\xff\xe6\x14\x61\x06\x64\xb2\x9a\x63\xcc\x77\x4c\xc7\xb7\xa9\xa5\x69\x29\xe9\xc7\x35\x5a\xec\x9f\xb3\xf4\x73\xb6\x7a\xb5\xf0\xfe\xfd\xcd\xd3\x0d\xd1\x6e\x34\x54\x5a\xde\x58\x21\x7e\x0f\x73\x01\xe9\x95\xa9\x49\x82\x9a\xaa\xf3\x56\xf8\x66\x27\x5a\x44\xd8\x5d\x6b\xa5\xe0\x5e\xf7\x77\x13\x1f\xb1\x1b\x81\x8d\x09\x6e\x49\x6f\xdb\x3b\xd4\x38\xcb\x3d\x5a\x49\x8c\x19\x52\xde\xf0\x05\xc8\x31\xed\xb8\xda\xd7\x55\xd7\xeb\x69\xd3\x1f\xcd\x26\x2e\xb6\x7f\x9f\xa0\x02\xf5\x5f\x16\xb0\xb0\x80\x6b\x49\xc5\x21\xd3\xdc\x51\x3c\x36\xe3\xc4\x3d\x92\x49\x9c\x31\x7a\x27\x84\x65\x91\x73\x03\x95\x65\x2b\x90\x59\x81\x4f\xc3\x66\xfd\x45\x37\xbe\x64\x0e\x10\xd2\x00\x88\x8f\xdf\x06\x60\x8a\xd6\x93\x2e\xa6\x9b\x9a\xa9\xa7\x8c\x79\xc1\x5e\xfd\x38\xaa\xaa\xc6\xdc\x1d\x5d\xe3\x9a\xee\xc1\xb5\x60\x77\xda\x34\x77\xa2\x65\xbd\xbd\xe0\x59\x2c\x3f\x0e\xa0\xcc\x0d\x19\x43\xeb\x77\xa8\xc0\x0d\x3a\x56\x17\x8e\x83\xfa\xea\x31\x81\xee\x8c\x92\xed\xf8\x52\x19\x35\xcd\x1d\xb4\x54\x43\x6f\x04\x5d\xbf\x15\xce\x03\x63\xe9\xef\xd8\x53\x80\x05\x38\x4a\xad\x81\x2e\x7e\x8b\xef\x27\xde\x99\xa2\xc9\x58\x66\x69\x0d\x73\x06\x5d\xf6\x70\xb4\x8a\x5f\x11\xe8\x94\x54\xea\xb8\x15\xce\xb3\x41\x9b\xa6\x19\x7a\x09\x6d\xa7\x40\x68\x32\xe1\x4d\x88\x8b\x19\xca\xa1\x7e\xd0\x35\x7c\xe4\xea\x16\xaa\x2f\x6f\xc6\x7e\x09\x67\xd4\x7f\x59\xb1\xe2\x95\xc2\xa9\x7d\xd8\x49\xab\x4a\x07\xe4\x51\xec\xd6\x3f\xb0\x87\xb9\x62\x06\x21\x3e\xde\x42\x22\x74\x9e\x7b\xcb\x27\x3a\x2b\xe0\xdf\x5a\xb2\x8d\x61\xa4\x9c\x6d\xd4\x11\x78\x1b\x62\xa7\xfd\x57\x11\x6b\x4c\x9b\x01\xd7\xae\x54\x2a\x7e\xf3\x30\xc7\xb1\x0d\x61\x21\xf0\x34\x0e\x5a\x09\xe7\x72\x1d\xde\x74\x62\x67\x0e\xac\xb7\x62\x0f\x6d\x20\x17\xbd\xbe\xab\x9c\xaa\x61\x0d\xeb\x34\x55\xe2\xe0\xf5\x9c\x4c\x12\xd6\xd0\xca\xaf\x5e\x97\x51\xed\xac\x2b\xc5\x7d\x41\x0b\x74\x0f\x5f\x0a\xc4\x35\x66\xfa\x13\x1f\xe6\x53\x81\x78\xce\xdf\x00\x04\x18\x0c\x62\x38\x51\x4a\xc1\xeb\x4a\xa4\x53\x90\x30\x51\xc9\xb6\x00\x59\xba\x7e\x00\x34\x76\xda\x3f\x89\x4c\x2a\x42\xac\x63\xb2\x35\xd6\x8e\xc3\x5c\x7f\xc9\xc0\x73\x21\x7b\xff\x47\xbd\x97\x4e\x22\x3f\xf5\x22\x96\xd2\x6b\xc8\x4f\x96\xb5\x5b\x6a\x26\x8e\x22\xb8\x10\x4c\xe8\x86\x7a\xbf\xd8\x76\xe8\x18\xb7\x7c\x2d\x9b\x47\x65\x31\x2f\x84\xae\xea\x64\xfc\xe3\xb0\x9b\xdc\xce\x89\x03\x24\xd1\x08\x9c\x6a\x25\x4e\x10\xfa\xdd\xea\x23\xc2\xb9\x6a\xc8\x7d\xd4\x73\x02\xb0\xa9\xb5\xc7\x08\x3d\x48\x57\xe3\x8e\xf2\x35\x8e\xb0\x1b\x15\x27\xe8\x33\x28\x2a\xbb\x98\x75\x1b\x13\x7d\x64\x02\xcd\xb0\x59\xc9\x68\xc2\xad\x9c\x77\x31\xa9\xfb\x1f\x80\x57\xf4\x5a\xfe\xfe\x4a\x64\xe9\xf4\xf2\xff\x9f\x43\xd7\x2f\x2a\xa0\x5e\x73\x38\x2f\xec\x32\x06\x7d\xf2\x9d\xe9\x7b\xd8\xc8\x3b\x09\x05\xc2\xed\xb9\x2e\xc6\x05\xaa\xb2\x91\xe5\x6b\x31\x85\xc8\xb8\x27\xb5\xa6\x48\x7e\xe6\xd8\xaf\x43\xd7\x87\x03\x13\x23\x01\xe9\x98\xb7\xb2\x57\x0f\x20\x6f\xfc\x37\x0d\x4c\x86\x5f\x56\x53\xef\x31\x7a\xb4\xaf\x90\xe3\xd5\x93\xa6\xec\xd3\x8d\x09\xf4\xcd\x84\xda\x6d\x8d\xb1\x2b\x20\x0a\xfb\x0c\xb8\xdd\x9d\x70\x3e\xfc\x8f\xc3\xc3\xb6\xe3\x1a\xa8\xf0\x40\xd1\x9e\xb7\x68\xab\x20\x07\x8f\x89\x99\xf2\xc6\x04\x6d\x07\x88\xed\xa0\x5b\x8e\xb8\xd5\xaa\x6f\x88\x84\x73\xd0\xbf\x80\xf6\x11\xe1\x2e\xc0\xc9\x4a\x93\x01\xf8\x18\xd5\x32\xb7\x1b\x3c\xb6\x4d\xc7\x1b\x85\x81\x21\x1e\x0c\xbf\xe6\xfc\x80\x1f\xac\xb9\x0d\xde\x45\x34\x8b\x83\x56\x10\x41\x05\xdf\x07\xbe\xb3\x42\x14\x0d\x7e\x2d\xf5\x68\xd3\x63\xed\xe0\x84\x57\x3e\x2c\x41\xb8\x85\x5b\x65\xe4\x17\x1e\xb5\x1d\x76\x76\xe2\x55\x17\x74\x67\x27\xe6\xbb\x93\xcf\x85\xad\xb8\x68\xff\x71\xbe\x7d\x6a\xcb\x86\x47\x1b\xfa\xde\x0a\xe7\xa2\x52\x77\x72\xa7\x57\xac\x45\x24\x26\xf5\x2c\xcb\xae\x44\x46\xc6\x57\x6e\xfa\xa4\xf5\x02\xb7\x8b\x18\xe1\x7b\x75\x63\x70\x06\x08\x16\x08\x4b\x57\x8c\x0f\x2d\x9c\x11\x48\xf8\xeb\xa0\x77\x02\xbc\x14\xee\x58\xac\xc6\xaf\x98\x08\x6b\xca\x0b\x97\x7d\x97\x68\x77\x11\xd9\xbc\x60\x53\xff\x4d\x6c\x43\x40\xf6\x37\x63\x97\xc1\x06\xe6\xd8\x13\x4f\x8b\x7a\x2d\x21\x57\x8e\x8c\x69\xa3\x76\xa6\x37\xef\xcf\x77\x8d\x63\xec\xb1\x01\x9d\xaa\xbd\xb1\x47\xe6\x82\x7d\x73\xd3\xae\x28\xb6\xed\xe1\xac\x79\x44\xba\x87\x13\xb4\x0f\xe8\x80\x00\x14\x20\x9c\xf6\xe7\x98\x20\xfe\xce\x3b\x81\x20\x54\xd7\x58\x09\xe6\x03\x4f\xfb\x15\x36\x62\xc0\x5e\x8a\xf8\xf4\x7c\xfe\x83\xa8\x98\x4b\xc2\x41\x9d\x04\x08\xc5\xda\x4a\xb1\x61\x6e\xe8\x3a\x6e\x8f\x09\x30\x2c\x51\x5a\x0c\xc8\x7f\x8d\x15\xe1\x7c\x25\x3c\x2e\x7a\x2b\x61\x11\x86\x17\x4e\x75\x7c\xf8\x4e\x87\x48\x78\xc4\x34\x03\xe6\x16\xd9\x0a\x98\xe7\x60\xb9\x36\xc6\x6e\x0d\x74\x44\x3b\x6f\xac\x14\x08\x67\x05\xbc\xba\x13\x8d\x15\x74\x2b\xd8\x65\xa0\x7e\x00\xcd\xe3\x6b\x21\x34\x94\xa2\xd4\x11\x7a\xa0\x75\xa6\x65\xac\x47\xc2\x24\xf4\x41\x81\x72\x76\x58\x96\xe1\x71\x64\x07\xc8\x77\x1d\xe6\x24\x45\x9a\xda\x30\xa9\x37\x61\xbb\x5d\xec\x9f\xdf\x09\xd6\x19\x08\x6c\xd2\xf7\x31\xc0\x53\x56\xf0\xf6\x48\xd4\x93\xf5\xdc\x60\xc1\x1b\x7e\x06\x8d\x24\x8e\xb4\xd6\x84\xc7\xd8\x05\x3a\xae\x47\xd7\xb3\x02\x9b\xb2\x24\xc1\x2b\xc6\x7f\x27\xbe\x0d\xb0\xd4\x03\xe0\x0a\xd7\x83\xa7\x09\x5f\x0b\xd6\x84\xe3\x1c\x5d\xe8\x8d\xdc\xc2\x02\xdc\xe7\x56\xf2\x57\xec\xbb\x30\xcf\x9f\x79\xd7\x2b\xb1\x8a\x0d\x6a\x38\x74\xa0\xf4\x14\x16\xbb\x5d\x00\x29\xcc\x3f\x43\x04\x85\xed\xfc\x79\xe7\xe0\xbd\x8e\x52\x40\xef\x0f\xb8\xa2\x79\x88\x2f\xd9\x2f\x26\xf1\x78\x8a\xbd\x54\xad\x01\xba\xf6\x60\x61\x21\x19\x0f\xfe\x06\xd1\xe9\xf1\xcf\x62\x85\x3e\xdc\x9e\x82\xe2\x1d\xdf\x78\xe6\x94\x6c\xe8\x1b\xf0\x13\x5a\x89\xe1\x6a\xc1\xcc\xbb\x57\xec\xc7\x60\xf1\x39\xb4\x9a\xb3\xaf\x77\x52\xb5\x29\x69\xbc\x62\x0a\xd4\xd1\x51\x90\x4d\x41\xfb\x90\xd9\xb0\x1f\x8c\xe5\xad\xd4\x2b\xd6\x71\x80\xf1\xdd\xd1\xfd\xe0\x0a\xbd\x39\x08\x9b\x9a\x2d\x61\x50\x70\x5b\x4d\x64\xbe\x14\x0c\x02\x0b\xfb\xc1\xd8\x96\xfd\x63\x68\xb5\xd9\x6e\xa3\xa3\xad\x64\xbf\xc8\xf1\xf9\x9b\x30\xe1\x70\x7c\xe6\xd8\x27\x5c\xe4\x5f\x87\xa3\x7d\x11\x05\xd6\x35\x28\xab\xc5\x51\xd7\xe7\xdf\x06\xe9\xa4\x17\xd1\xc5\x78\x8b\x51\xec\xfa\xc8\xde\x8c\xfe\x6f\x95\x05\xa0\x23\xc6\x19\x9a\x14\x22\x73\xc5\xd4\x5c\x26\xe9\x44\x44\x67\xfd\x8c\xbc\xf7\xbd\x92\x0d\x2f\xda\x78\x12\xec\xfa\xf4\x85\xc2\x55\x1e\x89\x83\x0b\x22\x38\x7e\x89\x58\x67\x87\x8d\x3e\xd1\xed\x0a\xc3\x0f\xa7\xbc\x77\xe0\x4d\x09\x68\x8a\xa6\x17\xff\x2d\x24\x3e\x39\x84\xe3\x9a\x44\x8a\x2b\xf2\x1d\x9a\xda\xd4\x07\x5e\xf5\x39\xc1\x5c\xa0\x23\x21\xec\x5e\x38\x92\x4f\x8e\x64\x20\xe3\xce\x91\x4a\x90\x0a\x2f\x0b\xdc\x14\xd4\xbd\x48\xc1\xef\x1b\xd6\x0c\x6b\xd9\xa4\xd4\x8c\x36\x5a\xc9\x7d\xc5\x32\xf2\xfc\xf4\x7b\x7e\x71\xde\xe5\x88\x9d\x3f\x78\xf7\x88\xf8\x9a\x9d\x8c\x11\xeb\x6d\xe2\x82\x4c\x26\x00\x7e\x93\x66\xc1\x04\xbb\x17\xe6\x39\x5d\x1e\x50\xd6\x25\xce\x5b\x4f\x52\xc6\xa3\xe4\xff\x1c\xd8\x22\x27\xc5\x5d\xec\x76\xc0\xc1\xaf\x79\x73\x17\xcd\x54\x3d\xf2\x30\x1b\x14\xa9\x4e\x47\x0d\x09\x49\xe3\xe7\xde\x2d\x3d\xcb\x59\xd7\x79\xe3\x85\x65\x5f\x21\x19\xcd\x8a\xa0\x1d\xd0\xdf\xd4\x0c\xdd\xa0\xd0\x56\xbf\xc7\xc6\x5c\xed\x59\xb3\x83\x90\xab\x17\x36\xfc\x20\x37\xbc\x44\x3c\x0d\x15\xfc\x30\x7b\xf0\x73\x51\x02\x44\x04\xc9\x66\x36\x08\x87\x5c\x28\x98\x7e\xb8\x14\x65\xbf\xe2\x8b\xc0\x47\x92\x0e\x8a\x00\xd6\x1c\x05\x76\x50\xc9\xca\x53\xa6\x76\x9f\xa2\x93\xa2\x60\xfb\x4c\x67\x72\x91\x69\x88\x53\x04\x5d\x5c\x36\xdf\xe4\xc4\xca\xa1\x96\x6a\x6b\x85\xb7\x52\xec\xf9\x5a\x1d\x99\x32\x33\x98\xd7\x65\x29\xae\x6c\x76\xc1\x0a\x7d\x3f\x4c\x4d\xee\x1c\xcb\xef\x88\x4a\xe4\x5e\x9c\x47\xc1\x6f\x65\xcf\x6f\x6a\x2c\xe6\x4e\x74\x12\xd1\x8e\x2f\x16\xca\xf7\x24\x02\xe0\xb5\xe0\xed\x19\x63\x48\x7c\xcb\xe7\x69\x8e\xee\xc5\x24\x38\x7a\x04\xd9\x75\x66\x1d\xac\x41\x0b\xb4\x46\x1b\x6a\xb3\x76\x3d\xfa\x95\xd0\xdc\x2a\x3e\x4b\xe7\x45\x58\xc0\x94\xe8\x47\x35\x6f\xb3\x17\x89\x89\x28\x5a\x10\x87\x1d\x89\xc1\x4d\x9b\xd3\x02\xa8\xd6\x12\x2d\x45\x25\x82\xd3\x12\x61\xa5\xe7\x36\xdd\xdf\xa5\x2e\x25\x9f\x82\x33\x80\xf2\xf3\x4e\xfe\x5e\x67\x80\x79\xf0\x99\x7c\xec\xf5\x84\x51\x84\xc7\x4b\x70\x86\x62\x28\x54\x87\xdf\x14\xc0\x56\x10\xe3\x15\xa9\x59\xb1\xc8\x28\x87\x90\x9b\xdc\x59\xcd\x74\x35\x9c\x52\xab\x04\x7c\x0e\x70\xd5\x5d\xa9\x21\x80\x63\x38\x95\x53\x9b\xd4\xfb\xa4\x07\x8c\x84\x3a\xbe\x62\xb7\x91\x3b\xa9\x78\xfc\xf2\x26\x20\x4c\x80\xc1\x38\x9c\xc6\x3d\x77\x2e\xa6\xe3\xf1\x52\xaf\xb0\xa1\xd0\x15\xdd\xa8\x31\x79\x10\x95\x7f\xa9\x26\x08\x40\x8c\xaa\xe5\x15\x97\x06\xd4\x48\x69\xd0\xf9\xaa\x28\x1f\xd2\x19\xc2\x0e\x17\x33\x8e\x86\x5d\x86\xc3\xb6\xdb\x04\x87\x3d\x76\xf2\xb5\xf6\xb8\x62\x56\x6c\xb9\x6d\x55\x41\x8d\x71\x40\x0e\x3e\x40\x7d\xc8\xf3\x3d\xc1\xe7\xc5\xfb\x1b\xd3\x75\x89\x05\x49\x83\x23\x4c\xe4\x4e\x4d\x78\x90\x56\x76\xc1\x0a\x21\x9d\x6d\xcb\x2d\x09\x2b\xc3\x43\x06\x4b\xd6\xf3\xdf\x86\xc2\xa9\xa1\xe1\xac\x40\x8c\x53\x1f\x91\xd5\x7a\xd2\xd4\x88\x86\xae\x6c\x6a\x4d\x17\xc0\x29\x59\xe6\x55\x3a\x27\x2c\xfb\x87\x08\xa1\x14\x47\x8f\x70\x41\x6e\xed\x1a\x6d\xa3\xa7\xed\x08\x99\x8f\x88\xff\x26\x37\x22\xc3\xe9\xa9\x5b\xa4\x68\x1b\xf9\x46\xec\x0d\x50\xf9\x5f\xd0\x35\x98\x70\xee\x51\x33\x2f\x1c\x61\xd4\xc0\x68\xb4\x88\x7a\xcf\x10\x2f\xeb\xb2\xa9\x94\x6f\xa0\xdb\x86\xf8\xf5\xe6\x9b\x59\x49\xde\x63\xc5\x5a\xc1\x37\xa8\xfd\x9c\x34\xc2\xe0\x00\x45\x99\x68\xb1\x28\x27\xfd\x37\xb1\x07\x26\xef\x2f\x9d\x22\x8d\x87\x0e\x4d\x4a\x3c\x7b\x14\xed\x30\x65\x4c\x3f\x12\x94\xda\x1a\xd5\x42\x48\x0e\x94\xe6\x3a\xb1\x1a\x34\x43\xcf\xdc\x8e\xf7\x49\x63\x08\xea\x13\x6e\xc7\xf5\x1d\x34\xc3\x6a\xa8\xde\x3f\x7a\x97\xc6\x82\xfc\xec\x8f\x25\xa7\x56\x4a\x8d\x8c\x65\xa2\xce\x26\x6d\x99\x05\x61\x99\xbd\xb0\x24\x80\x11\xc7\xf7\x26\xa6\xd3\xaa\xa3\x6d\x00\xb8\x06\x06\xfb\x54\xb1\x38\x75\xbe\x85\xbb\x29\x7a\xf9\xa3\x74\xe4\x41\xc8\xed\xce\xd1\x8d\xde\xbf\x7e\x4d\x24\xbe\xaf\xd8\x6d\x70\x8b\x27\xca\xd4\x18\x55\x10\x47\xcd\x25\x49\x26\xc8\x96\x46\x3e\x9a\x4b\x39\x90\x52\xb9\x0e\x68\x3a\x42\x90\xbc\x3e\x42\x3d\x94\xaa\x54\xfd\x80\xa3\xc9\xfd\x59\x1b\xf9\x39\xb1\x86\xc2\x89\x3b\xd8\x4d\x30\xf3\x71\x5a\xa1\x84\xf3\x3c\xb7\xf1\x1f\x80\xf5\x0f\x18\x92\x84\x54\x98\x02\xc3\xfc\x1d\x90\x26\x40\x9d\xcb\x1b\x42\xd1\x54\x04\x80\x8d\x92\xdd\x5a\xea\x6d\xf6\xfb\x83\x13\xbd\x15\x75\x41\x8c\xab\x30\x13\x2d\xba\xfa\xe5\xbb\x03\x16\x03\x5c\xfe\x19\x2f\x68\x74\xee\xd9\xab\xea\xfa\xbe\xa0\x66\x3a\x96\xd8\x9b\x01\x36\x4a\x6b\x0e\x3a\x0c\xaf\x2f\x01\x77\xe1\xef\x04\x41\x42\x69\xa1\xe3\xe9\x7a\x3d\x9d\xeb\xf9\x26\x38\x07\x10\x61\xe2\xfa\x2a\xfd\x84\x18\x15\x60\x65\x61\x49\xaf\x4c\x79\x0c\x16\x49\xdf\x8d\x32\xdc\x3b\xb6\x45\x76\xd1\x11\x4d\x74\xec\x0f\x47\x4c\x03\x57\x66\xb3\x2c\x3d\x1c\x5c\xcb\x89\x41\x7b\xfd\x50\x06\xbf\x0b\x07\x13\xb6\x92\x49\x2b\x36\xea\x08\x2b\x73\x24\x97\x61\xdc\x59\xd2\xd0\xcb\x85\xe4\x78\x68\x3d\x8c\xa8\x3b\x1f\x53\x63\x36\x5f\x09\xc2\xaf\xe4\x4f\x82\xfb\x78\x93\x17\x2a\x68\x30\xc8\x4e\x68\x07\x76\xe4\xb4\xd6\x3c\x5d\xf1\x14\x9b\xde\x9b\xeb\xba\x3b\xde\x44\x84\x0b\x64\x7b\x13\xe5\x09\xe4\x1e\x95\x09\xce\x24\xd1\x37\x28\x79\x27\x5e\x01\x40\x41\x09\xe8\x90\x6f\xcc\x5e\x24\x5e\x0a\x1a\x14\x1c\x10\xce\x74\x02\x53\xb8\xe4\x47\xad\xa1\xb2\x43\xc9\xb5\x24\x38\x37\x8d\x02\x2a\x6a\x2d\xd8\x73\xd2\x75\xd2\x39\x92\x23\xca\x41\xe9\xe5\x2a\x50\xd2\x1f\x48\x95\x26\xa1\x40\x0a\xe7\x60\xac\xc6\xca\xd6\xce\x84\x55\x9f\x0f\x90\x31\x36\x64\x31\x72\x30\x1c\x92\x7b\x94\x27\x15\xe3\x0a\xfb\x85\x3d\x04\x30\x98\x5b\x6b\xcd\x61\x51\x10\x3b\xeb\x1e\x9c\x15\xa9\x7a\x64\x64\xd9\xf9\x92\xf5\x49\xcf\xed\x67\x40\x0a\x7e\xa6\xf6\x98\xa4\xc8\x94\x94\x2a\x2a\x94\x57\x2c\xb2\xcd\xf5\x1a\xad\x62\x94\xd0\x1b\x1d\x2c\x7f\xd7\x0d\x9a\x34\x13\xe9\x24\xa6\x4b\x49\xef\x42\xb0\x0e\x06\xee\x19\x10\x22\x86\x57\xa5\xe9\xcf\x44\x3d\x83\x54\x66\xe4\xe5\xac\x8d\xc2\x1a\x43\x7c\x2f\x24\xa7\x31\x02\x93\x31\x1e\x99\x72\x2b\x56\xac\xdc\xd6\x92\x7e\x5f\xa1\x96\xa0\x6d\xf9\x1c\xac\x09\x35\x35\x22\xb5\x74\x0e\x87\xf0\x41\xf1\x8e\x0b\xba\x14\x7e\x21\xfe\xec\xa8\xb1\x95\xd4\x3a\x66\x55\x38\x6e\x5e\x57\x32\x1c\x57\xf0\x8e\x7e\x4b\xed\xef\x1e\xa8\x2e\x0b\x4e\xa3\x44\xef\x3f\x3f\x0f\x4f\xd2\xe3\x23\xb2\x0f\x9b\x57\x04\x6c\x76\x5c\x0c\xa0\x4f\x62\x05\x74\xe9\x87\x65\x1c\xa2\x7c\x9a\xd1\xb0\x04\x9e\x14\x0f\xf3\xee\x42\xe3\x64\xb0\xc8\x90\x82\x8c\x11\x78\xcd\x96\xb4\x9c\xba\xff\xad\x6d\x67\xba\x2a\x97\x10\x10\x64\x4b\xf4\x17\xa3\xee\x95\x4d\xbb\x8c\x6e\x65\xcf\x6f\x5e\xd3\xc1\xa4\xa4\x5e\x92\x2a\x5b\x4b\x98\xbf\xcd\x60\xa9\xe8\x60\x91\xef\x87\x77\xeb\x30\x85\x94\x43\x83\x45\xb4\x0d\xf6\xe6\x31\x94\x7a\xd8\xf3\x1f\xcc\x00\x09\xf8\x47\x90\xeb\x70\xde\x9a\x3b\x51\x99\x14\x58\x6e\xb8\x4a\x62\xa3\xde\x0d\x95\xed\xe7\x9b\xf4\xd6\x48\x4c\x52\xe9\xe9\x7e\xb9\x2e\xbd\x0f\xed\x57\x5f\x76\x1b\xe7\x9b\xc9\xad\x96\x00\x61\x50\xbc\xeb\x20\x6b\x14\xd3\x5c\x95\xc6\x25\x61\x52\x91\xcb\x0b\x90\x19\x06\xbb\x2e\x1b\x6e\xad\x3c\x1f\xff\x3c\xf9\xd6\xbe\x20\xfb\x73\x7a\xdb\x7e\x58\x44\x85\x9b\xb7\xed\xdf\xc2\x2b\x7b\xfe\xe9\xeb\xdb\xbf\x4e\xa1\xe9\x0f\x76\xca\xa9\x89\xf4\x69\x90\x58\xd7\x40\xd0\x2b\x1a\x59\x00\x98\x56\x5b\x4b\x68\x61\xb7\xc7\xa8\x8b\x6a\x25\xe8\x9a\x8d\xd4\xb2\xae\x49\x0c\x5c\xa0\x99\xfd\xe4\xad\xd0\xdb\xe0\xed\xce\x08\xca\x0f\xa0\xe9\x57\xa3\x89\x91\xd1\x34\xea\xf6\xc3\xa6\xf4\xe6\xc0\x6d\x9b\xf2\xe7\x70\x4c\x81\x82\xd5\xcd\xdc\xf9\x99\x82\xb5\xd9\xca\xd8\x68\xa1\xff\x0f\xc6\x58\xf6\xb2\x83\x07\x75\x42\x0b\x26\xa5\xa2\x31\x6c\xcf\xba\x32\xcf\xdf\xb4\x1f\x5e\xac\xd8\xcd\x4d\xfe\xe4\x2d\x7c\x12\x86\x7a\xf3\xc7\xfc\xe9\xbb\xf6\xc3\x8b\x19\xfe\x92\x0f\x53\xe5\xd6\xc9\x47\x6f\xa7\x1f\x2d\x3c\xb2\x90\xf6\xf6\x56\xcb\x8e\x2b\x40\xb8\xfe\xa4\xb8\xf6\x53\xf6\x9c\xb9\x24\xdb\x35\xca\x33\x0f\x92\x87\x2f\x8e\x30\x92\x68\x5f\x2b\x63\xda\x5d\x88\xbd\x1f\x05\x77\x54\x61\x44\xe7\xb4\xd9\x23\x8e\xc8\x58\x80\xd2\x44\xba\xe9\x8d\x6c\xd8\x1d\x11\x5a\xad\xa1\x30\x4f\xec\x91\x3e\x44\x78\xc9\xf1\xd7\x09\x13\xb1\x87\xfd\x62\x36\x4c\xa7\xa3\x05\x36\x92\x1b\x2c\x64\x12\xa4\xde\xba\x52\xe7\x04\xac\x60\x3a\xa2\x30\x06\xcd\xcd\x67\xc4\x6f\xe5\xa0\xe8\x5d\xa4\xef\x60\x04\xe8\x00\x71\x8f\x03\x4c\x6b\x1d\x08\xb5\xa2\x66\x0a\xe4\x6b\x88\x59\x74\x51\xac\x85\xcb\x25\x16\x67\x16\x61\x34\x66\x05\x81\xff\x15\xcb\xe1\xbe\xb0\xf8\x98\xcd\xa8\x29\x6c\x22\x3f\x4d\x4a\xc1\x47\x80\xf1\x49\xbb\x3b\xb3\x88\xea\xcc\xa4\x74\x6c\xc3\x3b\xa9\x24\xea\x67\xa4\x3a\xbd\x03\x00\x5f\xbd\x14\xe2\xdb\xcf\x80\x64\x60\xb0\x46\x66\x99\x8c\x7c\x9f\x74\x69\xc4\x80\x05\x80\x28\x15\x22\x80\xea\xd3\xe5\x97\x3b\xb3\x4f\xba\x3b\x77\x3a\x44\xe8\xd5\x18\x88\x26\x73\x49\xe3\xf5\x28\x9b\x8b\xcb\xa8\xd8\x44\xe9\xae\x28\x4d\x82\x4f\x9f\x62\xd1\xd8\x1c\x57\x9e\x3f\xe3\x1d\x58\xe4\x49\x77\x43\xc7\x89\x4a\x7e\xd0\xb2\x31\x36\x78\xa1\xce\xa4\x49\x49\xe0\x2e\x27\x84\x26\x1a\xc9\x7c\xed\xa1\xc7\x3d\xc5\x5e\xc6\xc9\x8a\xc4\x1d\x2f\x33\xfe\x26\x2c\xa5\xe9\x94\x91\x68\x0c\xbc\xe5\x36\xbe\x66\xe4\xcc\xad\x15\x4e\x82\xfb\x9a\x09\x5a\xd1\xe3\x1a\x40\x05\x0e\x10\x5d\x39\x82\xe1\xac\x37\xea\xd8\x19\xdb\xef\x32\x15\x7a\x72\xa7\x22\xb3\x39\xcd\x67\x39\x96\xe5\x3d\x35\xf9\xf7\xbc\x12\x35\xb5\x83\x86\x53\x13\xe5\x3c\x4b\x4e\xbe\xd2\xad\x86\x84\x54\x74\xa3\x59\xcf\xfd\x2e\x69\xeb\x17\xc7\xf1\xcc\xb0\x2e\x1a\x99\x1f\xc1\x4f\xbd\xc7\x41\xf4\x18\x26\x66\x63\xec\x9d\x68\x99\x3f\xc8\x73\x9d\x07\xf7\xab\xc7\xcc\x5a\x95\x87\x98\x11\x3d\x4e\x8e\x5e\x6b\x45\xf0\xe7\x67\x6d\x08\xdd\x61\x89\x05\xc9\x5f\x95\x9a\x75\x06\xaf\xf7\xf4\x26\x84\x6e\x5b\x1b\x90\xe2\x31\xea\x5d\x2f\xfd\x35\x1b\x7d\x24\x80\xe4\xe7\x86\x52\x1a\xaa\x5c\x3f\xab\x78\x5a\x83\xa1\xaa\x78\x99\x85\x05\x90\x5a\x74\x21\x78\xdf\x73\x2b\xd4\x8a\xfd\x2a\x0e\x42\xd9\xe3\x2a\x9c\x71\x5a\x52\x52\xd5\x18\x8c\xd2\x31\x19\x73\xaf\x0d\x9e\x56\x0a\x1d\xff\x7e\x27\x9b\xbb\x28\xa9\xa1\x04\x6f\x89\x1d\x9b\x03\x50\x97\xb9\x1d\x14\xee\x16\xef\xf2\x2b\x70\xf1\x46\x6f\x43\x70\xd1\x06\x77\xeb\x5f\xd3\x67\x94\x75\x4e\xa5\x3d\x47\x8d\xb7\xb4\x71\x6e\xc1\xd6\x3d\xdd\x58\x94\x6a\x70\xd8\x31\x5b\x47\x17\xb4\x2a\xcf\x37\x16\x7d\x29\x6e\xc6\x07\xb7\xa0\x55\x2c\x8d\xa7\x57\xc8\x0f\x5c\x28\xe7\x83\x7b\xf7\x34\x2a\x57\x17\x14\xe8\xd3\x42\x01\xad\xba\x30\x01\x4a\xf0\x4d\x4c\x9a\x60\x15\x1f\xb6\xf7\x66\xd0\x5a\x3c\xb6\xb4\x3c\x2c\xa8\xd9\x64\x58\xc7\x71\xb3\x62\xab\xf2\xcb\x56\x88\x3e\x9e\xd0\x51\xfa\x4d\xb7\xd8\xb9\xec\x66\x59\xca\x16\xb6\x31\x97\x7a\xf1\xf0\x9d\xac\xb6\x16\x8b\x9e\xc6\xe2\x0d\xd6\xa6\x3d\x26\x5d\xe0\xd3\x05\xb7\xd8\xf5\x3c\x47\xa5\xb3\x9a\x28\xf0\xc5\xfc\x03\xb7\x7e\xc2\xfa\x75\x45\x12\x01\xb2\x00\x5f\xb5\x5f\x9d\x12\xc9\xcd\x49\x86\xb8\xcb\x28\x97\x90\x3b\x95\xbf\x64\x77\xf2\x57\x8b\x12\x56\x3f\x84\xd1\xdf\xda\xce\x2c\xb3\x9d\x8f\x09\x69\x4a\xb6\x93\xca\x04\xc0\x54\x19\xb1\x30\xf7\x86\x60\x3e\x56\x9d\x98\xb3\x09\xe4\xe3\xb0\x33\xd4\xf6\x12\x1b\x86\x79\x98\xb8\x08\xc0\xef\xad\xf1\xc1\x15\xd9\x8b\x91\x34\x4e\x0c\xc8\xa1\x66\x3a\xdf\x98\x5c\xd9\xed\x35\x77\x82\xdd\x7e\x9d\xda\xf7\x6e\xde\xb2\x3f\xc0\xba\xcd\x49\xe2\xce\xb4\x72\x23\xc3\x16\x19\x57\x6d\x7d\xc4\x1d\x93\x51\x6d\x8d\x76\x38\xce\x02\x65\x1c\x6b\xb8\x27\xf0\xc5\x93\xc4\xd1\xdb\x3f\x7c\xf3\xed\xff\xbd\x74\x35\x7d\xcf\xf5\x94\x5f\x7d\x2e\xe5\x79\x1d\xaf\xed\xfd\xe9\xd5\x2f\x18\xc8\x49\xe7\xe9\xb8\x3b\xfd\xe4\x02\xba\xb2\x71\x3d\xb8\x97\xde\x72\xb5\x42\xfc\x47\x58\x40\x28\x4f\x19\xad\xad\x5f\xc6\x18\xbb\x43\x3d\xcb\xf3\xec\xe8\xe5\x6b\x1e\x97\xea\xf3\x55\xf6\x5c\x4b\xb7\x13\x2e\x27\x32\x51\x43\x34\x61\x69\xdf\x4e\x78\x17\xc2\x85\x0b\xbc\xfa\x1c\xf6\x7d\xa9\xf0\xc0\x98\x3c\xc3\x68\x6f\x8d\x4a\x5d\x49\xaf\x2a\xa6\x8d\xd4\xab\xe4\x0d\xeb\xb8\x96\xfd\xa0\x2a\x3f\x74\x05\x4d\x9e\xd8\xc4\x80\x8d\xa9\xd0\xe6\x0b\x85\x07\x6c\xe0\x08\x2f\xd7\x79\x34\xe6\x04\x7c\x87\xdf\x83\x36\x31\x66\x7f\x34\x5e\xa4\xf8\x01\x20\x0b\x07\x9c\xe5\xd8\x9d\x12\x45\xa5\x38\xdb\x4b\xae\x26\xac\x40\x34\x4e\x3c\x9d\xe3\x04\x82\x03\x93\x4a\xed\xe1\x81\x2e\x4b\x52\xed\xb2\x2a\x0a\x56\xbb\x57\x30\x5b\xfb\xf0\xdc\xd1\xc4\x84\xc1\x63\x6f\x1f\x50\x17\x14\xaf\xee\x26\x41\xd8\x16\xb9\x48\x5b\xd9\xb0\xaf\xa5\x6d\xd4\x34\x15\x37\x57\x6d\xbc\x46\xc3\xef\x66\x99\x7b\xb4\x33\xea\x48\x0e\x08\xcc\xfa\xa1\x05\xe7\xc2\x49\x15\xdb\xcd\xa4\x35\x7a\x5e\x34\xfc\x7e\x9a\xc5\xe7\xdc\xf1\x31\x5a\x36\xb5\x36\xa5\xf0\x7a\xb9\xa1\xb8\x54\x39\xa9\xb1\xff\xab\x44\x2d\xed\xa1\x33\x21\xaa\xfa\x17\x9a\x6b\x54\x58\x99\x73\xc0\xea\x8e\x9d\x0b\xe5\x94\xbf\x12\x03\x90\x1d\xb4\x88\x9a\x0f\xec\x10\x3c\xa3\x3d\x25\x90\xd2\xdd\x01\xb1\xed\x12\x74\x1e\x29\x84\x14\xed\xb0\x28\xbb\x0c\xa0\xc3\xf3\x1a\x68\x59\xc5\x8e\xec\xcc\x44\xcd\xce\x1f\x7b\xec\x22\xc8\x10\x86\x97\xac\x11\x4a\x38\x2f\xb9\x72\x2b\x26\xa2\x2f\xe4\x56\x6c\x23\x42\x68\x2b\xd1\x33\x04\xeb\xd7\x0a\x4e\x8d\xb2\x0d\x2c\x67\x12\x02\x74\x65\x4e\x2a\x32\xc6\xec\x8c\x13\x1a\x6e\xc8\x26\xac\x48\x07\x7e\xbc\xc8\x88\x54\x12\x39\xd0\x4e\x25\xb7\x41\x1d\x91\x47\xba\x9e\xc4\xf5\x71\xaa\x2a\x3a\x49\xfd\x79\x50\x99\x8c\xc2\xee\x63\x41\x29\x78\x0f\x13\xc9\x3b\x62\x04\x8b\xe4\xdb\x48\x7c\x5b\xf9\xb5\x5f\xef\xb8\x95\xae\xe3\x75\x81\xf9\x9a\xe7\xdb\x71\x37\xa1\x75\x2a\x50\x38\x2e\x73\x25\x82\xeb\x51\xb5\x58\xc4\x19\xb8\x78\xc3\xd3\x3f\x2d\xe4\xb2\x76\xdc\x76\xa2\x5d\xb1\x0d\x20\xed\x10\x0d\x0e\x26\x23\xec\x31\x27\x92\xa0\xe4\x92\x0c\xe6\xa9\x40\x36\x87\xa7\x42\x41\x42\xcc\x90\x28\x13\x24\x99\xc0\x5c\x7a\x13\x8e\x0b\xe8\x10\xa6\xb1\xc2\xbe\x71\x45\x56\x6a\x15\x79\x1f\x30\x28\x1e\x2f\x40\xca\x38\x89\x16\xd7\x20\x1c\x42\x4a\xc4\x17\x54\x3c\x3b\x28\xd2\x91\x83\x99\xd4\x7b\x5d\x6c\x82\xb8\x70\x96\x3c\x61\x55\x3d\xfa\x1d\x75\x96\x01\xec\xea\x52\x5c\xcc\xa5\x43\xe9\x7f\xf2\x69\x6c\x32\x47\xee\x36\x2b\xc0\x71\xa6\x6b\x6c\x01\x7d\x8c\xe8\x0a\x50\x8b\x15\x4a\xfe\x36\x70\x7b\x1c\xab\xcd\x1b\x1b\x46\x07\xb1\x59\x72\x1c\xe6\x25\x5f\x1f\x2e\xec\x7a\x26\x1d\x84\x04\x95\x24\xab\x16\xdb\x04\xb8\xe7\xde\x68\xd9\x40\x03\x58\x94\xfd\x0a\xa3\x1f\x14\x36\x78\xa1\x77\xa8\xdb\x92\xf5\x3e\x3f\x05\xf9\x2a\xed\x84\x68\x7c\xda\x43\x1b\x65\x57\xf3\xf5\xa5\xde\x71\xe0\xd4\xac\xae\x99\x69\x0b\xf9\x81\x17\xf5\xff\xb2\x6c\x49\x90\x73\x10\xac\x17\x15\x6b\x2c\x5e\x03\x92\x5b\x20\x82\xf9\xcf\xc4\x99\x18\x25\x2d\xc3\x16\xb5\x82\x24\xc0\x88\xfe\x05\x7a\xd9\x0b\x68\x0e\xa7\x9a\xbe\x04\x33\xdb\x5b\x03\x89\xcc\x3c\xf2\x98\x7d\x8f\x49\xe9\x21\xc1\xf1\x8a\xc7\x20\x14\x3b\x62\xd8\x48\xee\x0c\x2e\x41\xdd\xc0\xf0\x36\x9e\xe3\xd4\xb6\x15\x78\xf2\x05\x14\x94\xbc\x17\x5d\x1f\xa5\xee\xc9\x74\xc5\x8a\x93\x91\xad\x83\x0b\x2c\x71\xa5\xe9\x4a\xd5\x65\xf4\x31\x5d\x28\x03\x0b\xab\x1c\xfb\xd4\x35\x78\x9e\xcf\x5b\x52\xc7\x04\x4c\x6e\x34\x3b\x46\xa3\x7d\x02\x92\x80\xf0\x58\x5b\x50\x7b\xa3\x7e\xb5\x78\xd8\xba\xbe\x50\xc2\x5d\x8b\x6c\x93\x5f\x9c\xd4\x51\x9f\x3f\x99\xb2\xde\x39\xa4\xac\xf3\xcb\x41\x06\x64\x58\xe0\x55\xcb\x41\xa6\x67\xad\x3e\x86\xdf\xc4\x50\xda\xdb\xe0\xe3\xb4\x93\x05\x45\x77\x23\x64\xd4\x48\xa5\x1c\x55\x03\x61\x00\x62\xb3\x41\x99\xd1\x3c\xd5\xd2\x67\x6e\xc5\xe4\xab\x8f\xdf\x47\x02\x50\x87\x7d\xf4\xe6\x1d\x66\x30\xce\xbd\xdb\x1f\x75\x83\x91\x42\x5e\x18\x45\x7d\x16\x1f\x15\xee\x48\x31\x93\x44\x72\x52\xcb\xb6\xbc\x13\xd8\xed\xe9\xbc\x6c\xb0\x03\xd1\x0a\x60\x2a\xc9\x3a\xcb\xf9\xcf\x66\xec\x7b\xec\xa0\x83\x10\xc1\x27\x50\x50\xc0\x20\x4d\xc9\x2d\x48\x7c\xc6\x47\xb5\xec\xa3\xf6\x42\x29\xb9\x15\xc0\xf7\x81\x0a\xcf\x38\x13\xf9\x7d\x36\x06\x1a\x30\xff\x99\xaf\x16\x6e\x46\x74\x93\x69\xe7\x9b\x83\x46\xc5\x3d\xb6\x89\x79\xec\x8f\x55\x0e\x03\x24\x06\xf5\x91\xbe\x04\x86\x3f\xb7\x15\x3e\xf3\xd4\xff\x9e\xb4\x16\xab\x6b\x9d\x9d\xe5\x1f\x04\xd7\xa0\xb6\x89\x6f\x3c\xfb\x0f\xc5\x5c\xc3\xfa\x01\xe5\xcf\x28\xe6\x9c\xe9\x07\x93\x9d\xc4\x63\x13\xf8\x83\x0f\x1a\xcb\x63\x2e\x35\x20\xd6\xf6\x09\x0c\x4f\x54\x28\x0c\x21\xff\x79\xf2\x37\x34\xaa\x34\x32\x3c\x9b\x8b\xb7\x3f\x1f\x48\xa3\x49\xca\xe3\xdc\x19\xe7\xd1\x1c\xc5\x52\x7f\x1a\xf8\x18\xc0\x34\x32\x12\xab\xd2\xbc\xc5\x2b\x8d\x27\x87\xd0\xbb\x60\xae\xe2\x9b\xcb\xb7\x6c\x83\x33\x7b\x88\x47\xc3\xb3\x28\x12\x33\xd6\xe1\x96\xa2\xa0\xa3\xbd\x68\x1d\x4a\xbe\x51\x5c\x40\x60\x77\xa2\x6e\xe0\x37\x5f\x8f\x37\x34\x2d\x68\x48\xfc\x5e\x39\x07\x93\x5e\xa2\x56\x9e\x75\x28\x3f\x6e\xc6\xd7\x2f\x7a\xfd\x59\x79\x8e\xe6\xfe\xd8\x78\x62\x16\x62\x87\x05\x36\x3a\xb1\x4f\xc7\xde\x28\x3a\xea\x01\x56\x91\xc3\xfd\xb9\x54\x4d\xc1\x2d\x90\x7f\x14\x22\xa4\x0c\x32\x2b\xcc\x55\x9e\x21\xe9\xf3\xb3\x02\x9d\xa7\xae\xdb\x9c\xe3\xbb\x97\x6e\x62\x4b\x93\x8e\x4e\xcd\x71\x30\x82\x98\xc0\xaf\x47\xf8\x6f\x1c\x1f\x4e\x5f\x1c\x2b\x57\x72\x2f\x12\x79\x72\xf1\x76\xaa\xd7\x52\x63\x53\xc2\x62\xba\x57\x7b\xd6\xfc\x3b\x5b\xee\xaa\xfe\x20\x9d\x93\x33\x09\x94\xb9\x54\xfa\x63\x57\x98\x1e\x99\xc0\xe5\xe1\x79\x74\xca\x6e\xf8\x9d\x15\x82\x6d\x29\xa8\x6e\xa1\x08\x33\x56\x8f\x27\x94\x74\xf8\x23\xdb\xc9\x49\xa8\x7e\x05\x3e\xf4\x16\x2f\xd2\x0a\xae\x1c\xbb\x69\xdf\xfd\xe1\x86\x92\xf0\x54\xa8\xa1\x95\x56\xea\xe6\xe2\x90\x78\x08\x77\x00\xa2\xca\x9c\xec\x06\x45\xd3\xa5\x8e\xe5\xe1\x1e\xab\xd6\x40\x81\xe7\x0d\x76\x73\x8c\xfa\x5d\x5d\x08\x01\xcf\x0b\x99\x3f\x9d\xd2\x1d\xa5\xbe\x60\xe6\x1d\x0c\x0d\x0c\x04\xcc\xc9\x1c\xd2\x79\x5e\xe6\xee\xdd\x1f\x6e\x96\x25\xfb\xc3\x92\x37\x83\xdf\xdd\x83\xd6\xf6\x5e\x64\x1f\x97\xab\x00\x15\xa7\x07\x81\xfb\x76\x46\x8b\x63\x63\xba\x35\xbc\xca\x5f\x79\x1b\xe2\x56\xe7\xa7\x89\xc4\xfb\xe6\x11\x97\xc5\x6e\x35\x39\xc8\x15\x95\x83\xb0\x99\x64\x87\x40\x4c\xce\x3a\xe1\x5c\x58\xc9\xb4\xee\x0b\x1c\x06\x6d\x82\x55\xf1\xa5\x88\xe2\x19\x3c\xe6\x09\xc1\x34\x73\xe6\xad\xdc\x02\x7f\x46\xa9\xeb\xdc\x85\x0d\x71\x99\x5d\x93\xc7\xac\x22\x96\xc2\x66\x21\xfe\x10\x38\x8c\x4e\x0c\xd8\x6c\x70\x4e\xf3\x3b\x98\x5e\x1a\x63\xd5\x73\xbb\x16\xec\xcd\x7b\x60\x00\x73\x58\x27\x47\x67\x3c\xd2\x6e\x50\x61\xb4\x15\xe1\x50\x80\xbc\x27\x64\x86\x33\x30\x27\x03\xa6\x5e\xb1\xef\xa4\xc6\xde\xf1\x56\x78\x61\x3b\x00\x77\x53\x52\x70\xe8\x22\xf0\x35\x09\x6e\xc7\x29\xf1\xa5\xe4\x36\xdd\x88\xfa\x8a\x71\xbc\x0b\x0e\x96\x70\x16\x95\x77\x31\x4d\x33\x58\x60\x13\x4d\x99\xbf\xb0\x6b\x52\xe1\x87\x52\xb6\x34\xe3\xd8\xdb\xde\x40\xab\x45\x31\x4f\x09\xdf\x8b\xb8\x5f\x28\x1c\x11\x59\x51\x70\xf9\xf7\x46\x0d\x54\x5c\x70\xbd\xb9\x13\x63\x08\x15\x55\x94\x9c\x20\xc1\x6e\x1c\x01\x25\x32\x22\x29\x72\xd8\x0b\xc6\xdc\x39\x68\x2c\x4d\x5f\x7a\xbe\x29\x19\xed\x60\x48\xf8\x63\x40\x4e\x7a\xee\x07\xf1\x82\x3e\x9f\x7d\x3c\xac\xb4\x3b\x43\xb3\x0d\x2f\x97\x52\xcd\x50\xf5\xe9\x4a\x56\x8f\x08\x1f\x83\x4b\xbc\x3a\x69\x18\xb3\xff\x0b\x38\xac\xea\x30\x67\x1c\x48\xa0\xa4\x8f\xef\x0f\xab\xfe\x69\xbd\x19\x1b\xd7\x12\xb5\x78\xe3\x8c\xf7\x82\xfb\xea\x9b\xb0\x5b\x52\x22\x3c\x2e\x10\x7c\x99\x17\x9b\xf8\xf1\xdb\x50\x3f\x2e\x97\x42\x96\x66\xdf\x0a\x1d\xce\x09\x52\x66\x4f\x84\x85\x45\x67\x6f\xb9\xf2\x27\x72\xe7\x7b\xe9\x06\xfa\x35\x11\x90\x16\x02\xeb\x08\xb3\x0b\x03\x1d\xc3\xd1\x4c\xb9\x28\x46\x5c\x85\x18\x4f\x0e\xaa\x85\x96\x47\x3b\xe0\x41\x47\x2f\xd3\x1b\xda\xba\x68\x42\x4a\x78\x45\x25\xc4\x36\x7b\x23\x80\x9a\xa1\xe9\xa1\x6a\xce\x3a\xbc\x2b\xa4\x32\x9a\xfe\x50\x2e\x44\xf5\x84\xf3\xe7\x8c\x8e\xcd\x5c\xcd\xf9\xfa\x6e\xdd\xfb\x33\xb3\x2c\x23\x93\xa6\x2a\xd2\xc5\xa6\x75\x08\x5b\x82\x2b\xd5\x8b\x35\x68\xd6\x43\x41\xac\x5b\x0f\x02\xdd\x11\x28\xca\xc0\x06\xc4\x80\x9b\xb6\x36\x30\x81\x28\x87\x2b\xaf\xee\xf0\xa5\x8a\x2d\x66\xf0\xe1\xe7\x99\xe1\x37\xdd\x05\x52\x06\x16\x9d\x37\xec\x5d\xdd\x0d\xa8\x64\x20\x23\x6b\x2f\x73\x0a\x5a\x73\xc1\xe0\x58\x73\xd0\x50\x87\x40\x33\x83\x7c\x1e\x23\xd5\x86\x6a\x5c\x91\x11\x3d\x15\x95\xf0\xce\xab\x92\x2f\x3d\x18\xf9\xb6\x8d\xb9\xcb\x32\xd2\xe3\xc8\xab\x9f\x00\x11\x2b\xd0\xf8\xcf\xfd\xc1\xc2\x3e\x0b\x27\x88\x19\xb7\x0c\x9f\x55\x2e\x9e\x01\xf8\x88\xdf\xc2\x6e\xf3\x06\x7a\xc6\xfe\xb0\x60\x20\xaf\xd8\xf7\x65\xab\x6f\x71\xac\x84\x7d\x00\x58\x0d\x32\xfb\xa0\x17\xb3\x84\x44\x79\x9e\x66\xae\x8e\x63\x98\xe0\x16\xa8\x9a\x61\x8b\xc6\x97\x48\x54\x12\x44\x40\x02\x22\x68\x17\x8a\xcf\x8f\x2d\xd1\x77\x56\x39\xf9\xa6\xfd\x0a\xff\xba\xd8\xeb\xfc\x05\x70\xab\xf7\xa7\xef\x79\xa2\x1d\x7f\x7d\x5b\xc8\x24\xee\x8a\x1f\xfc\xc2\x6d\x55\x6b\x5e\x82\x03\x9d\x08\x42\x9d\x45\xd0\x51\x23\x76\x4c\x8d\x92\x73\x12\x3f\xa6\xad\xfd\x87\x38\x57\xde\x8c\x6a\x7d\x3a\xe9\xaf\xc1\x07\xff\x9a\xfa\x13\x8e\x2d\x17\x9f\xbc\x61\x7f\x78\x53\x78\x0d\x90\x19\x5c\x2a\x9b\x3b\x77\xb1\xb7\xcb\x4e\xa2\x5f\x8d\x65\x1f\xc3\x64\x2c\xc2\x4e\x5c\x23\xf0\xb1\x14\x5b\x9a\x5b\x99\x94\x10\x8d\x78\xa2\x4e\x83\xc7\xd1\xf0\xa8\xb2\x03\x82\x49\x6c\x4d\xde\x94\x58\x1e\x5e\x50\x80\xd4\xd5\xb6\xa8\xc9\xd1\xef\x84\x36\x9d\xd0\x51\x5b\x6c\x4a\xe4\x92\x79\x57\x40\x19\x06\xb9\x9e\x11\xae\x59\x22\xae\x5c\x6f\x2e\x88\x08\xb0\xd3\x80\x2b\xe0\x50\x75\x42\x74\x40\xa7\x17\x69\x58\xac\xe0\x6a\x45\x7c\x85\xa0\x5d\x04\x38\x9c\x15\x73\x9d\x50\x8a\xf2\x9c\x5e\x74\x50\x3b\x06\xc2\xb4\xbe\xb7\xa6\xb7\x12\x66\x84\xca\x1a\x28\xf7\x26\x7a\x09\x9c\x51\x45\xa1\x8b\x66\xce\x0d\x9b\x8d\x6c\xa4\xd0\x9e\xed\x04\xf7\x88\x73\x52\x6d\xae\x53\xe3\xee\x04\x59\x03\x30\xde\x40\x5a\x2f\x34\xb2\x07\x1a\x48\x91\x30\xbf\xc3\x56\xa1\xd8\x1e\x6e\x89\x5e\x6d\x3c\xff\x1c\x87\x4e\xc2\xbb\xd0\x2d\xeb\x64\x73\x57\x7d\x91\x3d\xa7\xa8\xc1\x5b\xb3\x55\xa6\x3d\x7a\xc8\xf3\x79\xa1\x9b\xdd\x59\xd6\xdb\xdb\xba\xb1\x82\xdb\xd1\xec\x93\x47\x22\x95\x1a\x5c\x6a\x00\x39\x85\x21\xe3\x11\x2b\x26\x63\xbe\x07\x51\x59\x06\x2b\x04\x88\x53\x09\x6f\x7c\x94\x37\x72\xc5\x8f\x90\xd0\xaa\x6c\x61\x89\x77\xe4\x0a\x23\x8b\x18\xc0\x80\x4f\x1f\x23\x9b\xb2\xfb\x24\x45\x38\xd0\x9c\x89\x79\xae\x7c\x87\x79\x92\xf0\x08\x07\xd2\xc5\x8e\xa8\x49\xf5\xe0\x51\xa4\x1f\x8f\x27\x8f\x3c\x0f\x25\x47\x5f\x26\x9c\xdf\x07\xae\xee\xc0\x49\xfb\x24\x3b\xa9\x82\x8f\x50\xf0\x32\xe6\x39\xa3\x29\x46\x8c\xdd\x9d\x28\xda\xcc\x70\x09\x87\x1d\x93\x3f\x0b\xa6\xca\x51\x9b\x47\xc7\xef\xc8\x29\x44\x1c\x1a\x60\x4e\x1a\xa3\x43\xf8\x45\x93\x58\x84\x91\xe7\x96\xc3\x4f\xbb\xa3\x43\x68\x9b\xf6\xc2\xd2\xab\x4d\xde\x21\x3e\xa7\x15\x7b\xc8\xf0\x21\x4b\xd9\x1a\x27\x2d\xad\x8f\xb5\x88\x8f\x04\x01\x06\x14\x6f\x38\xc0\x5d\x33\x3f\xe6\x58\xf6\x73\x70\x02\x83\xbf\xbc\x96\xc2\x58\x63\xd6\x80\x16\x06\xd7\x55\x36\x81\x47\x82\xc9\xe2\xf6\xc9\x29\xce\x2c\x0b\x65\xa9\x8c\x3d\x27\x05\xbb\x2d\xcc\xca\x0b\xd6\xec\x44\x73\x57\xd7\x33\xe8\xbc\x0a\x81\xed\x37\x5f\x93\x9a\x56\x91\xdc\x76\x8d\x80\xa4\x79\xf9\xc2\x36\x10\x61\xc5\xf1\x8c\x8a\x2b\xd1\xa6\x95\xdc\xa1\x92\x8c\x43\xf0\xea\x7c\x54\x9c\x75\x42\x3b\x63\x8f\x2c\x38\xbb\xd2\xcb\xe0\x0a\x23\x37\xe7\x86\x4b\xed\xc7\x8d\xa2\xff\x1a\x71\x7b\xfc\x1a\x5a\xe3\xa1\x4e\xbc\xad\x92\xc0\xa2\x15\xbf\x0d\xd2\x92\x62\x01\xe4\xb9\xf2\x91\xb7\xec\x44\x77\x8e\x7d\x1d\xe6\xee\x17\x58\xf7\x8f\xdf\x33\x72\x99\x29\xf2\xa9\x29\x3f\x67\x31\xca\x87\xb0\xe8\xcc\x26\x1c\x29\xaa\x60\x70\x38\x70\x40\x09\x27\xce\x93\x04\x36\xac\x93\xf3\xb5\x4d\xa5\x5c\x22\x3a\x18\x4e\x7e\x2e\xf0\x7c\x65\x37\x49\x64\x3c\x26\xa6\xda\x12\xd3\x88\xc2\x39\xe1\x4e\x54\x21\x48\x35\x79\xd8\x2e\x90\x7f\x87\x3f\xbb\x1c\xa0\xbd\x6d\x3f\x2c\x0c\xd0\x8a\x28\x07\x14\x30\x4c\x24\xf7\x36\x9a\x60\x84\x04\x1e\xc6\x64\xc8\xd3\xba\xb8\x27\xd7\x7b\x7c\x13\x57\xd0\x0e\xbd\xf7\xbb\x49\x56\xff\x6d\xfb\xe1\x8a\x18\xcb\x39\xf6\xbd\xe0\x6a\xb2\xec\xff\xf4\x1f\x7d\xd9\xe3\x42\x9f\x5d\xda\x1b\x65\x90\xad\x68\xb4\xb6\x37\xc0\x77\x59\x50\xf9\x78\xc3\xa4\xfe\x15\xba\x51\x0a\x56\xe7\x24\xa5\x19\xf1\x0c\xce\x1b\x1b\x57\xf7\x1f\x5f\xbf\x2e\x16\xe3\x8a\xb5\x72\x2f\xdb\x9c\xcd\x8b\xfa\x47\x1d\xf2\x09\x1d\x99\x1e\xba\x35\x22\x7a\x0b\x9a\xae\xf3\x95\xad\xcc\x62\x1d\xc6\x1f\xc1\x1c\x39\x1b\x10\x5c\x04\xe5\x0c\xb5\xd1\x04\xdf\x41\xa9\x48\xb9\xeb\x52\x4f\x14\xad\x70\x3a\xad\x21\x71\x44\xcc\xba\xd9\xf9\x43\x9d\x99\xfb\xee\x8d\x05\xcb\x2a\xdc\xfa\x17\x63\xa7\x5d\x22\xcb\x69\xad\xce\x06\xf0\x4f\x2a\x54\x76\x7a\x85\x45\x4d\x51\xa5\xc0\x50\x52\x15\x63\x43\x4b\xa5\x0a\xb7\x6a\x6b\x78\x1d\x37\x2e\xd8\xbe\x59\xd3\x77\xd3\xbe\xfb\xcf\x68\xfa\x4e\x46\xf7\x27\x4c\xdf\xbb\x2b\x19\xd7\x6e\xda\x77\xd7\x99\xbe\x4f\xc3\x76\x1b\x5c\xb4\x99\x0c\xd3\x1c\xf0\xf4\xdb\x59\xe4\xe9\x7d\x0d\x20\xd6\x2f\x35\xbf\x0b\xd1\x93\x37\x3a\x44\xd5\x3c\x13\x69\xf3\x69\x55\x13\xe2\xb3\xd6\x9a\x1e\x85\x66\x21\x89\x2e\xcf\xb5\x91\x46\x00\xda\x17\x0a\xf5\x1d\x4e\x27\xc4\x03\x83\x75\x18\xd8\x34\x5e\xee\xc3\x9a\x7c\xae\x64\x27\x49\xbc\x9a\x07\x07\x14\x05\x0d\x42\xb4\x74\x30\x2f\x30\xea\xc1\x9c\x96\x3a\x32\xa9\x37\x6a\x80\xbf\xe3\x36\xf2\x07\xa1\xf6\xe2\xbe\x3b\x29\x55\x32\xa1\x8c\x14\x82\x35\x68\xdb\x09\x01\x71\x30\x03\x64\xec\xbf\xae\x0d\xf4\x18\x84\x0e\x86\x57\x76\xdd\x10\x53\xf4\x49\x23\x84\x7a\xf3\xd2\x5a\x4a\x35\x9c\x60\x18\x10\x83\x48\x7c\x21\x1d\xd7\x1a\xeb\x98\x31\xee\x42\x30\x4a\x39\x59\x46\x53\x18\x1f\x76\x82\xd1\x7c\xad\x20\x6a\xbd\x4b\x48\xf1\x14\xcd\x18\xe6\x3c\x5f\x13\xdb\xe6\x2a\x32\x50\x23\xf7\xa6\x41\x58\xb0\x0b\xa1\xe1\x0a\x34\x26\xa0\x89\x2a\x7e\x17\x28\x95\x2b\x68\xf3\x7a\x2f\x11\x05\x11\x9e\x36\xc4\x32\xbc\xf1\x8c\x0f\xde\x74\x9c\xb8\xb9\x99\x16\x5b\x1e\x6b\x95\xd1\x8a\x14\x7c\x89\xe7\x4c\x47\xe9\xd3\x95\x98\x2f\x44\x13\x9e\xc2\x83\x26\x92\xbb\x60\x02\x07\xeb\x06\xe1\xe6\x27\xac\xe6\x50\xa1\xe8\x65\x2d\x9c\x8f\x48\x41\x32\x8c\xd5\x9b\x0a\xa7\xfe\xf8\x42\x51\x05\x48\xea\x21\xf3\x8b\x0b\xed\xa5\xad\x32\x41\xf4\xd4\xe9\x3a\x69\x91\x27\xf6\x5e\xcc\x10\x91\x8a\xbe\xdb\x19\x0b\xe1\xbb\xec\xc4\x24\x8f\x9f\x45\xac\x07\x2c\xa1\x6d\x24\xb5\xea\xc5\x30\x0f\x84\xe5\xdd\x1d\x3e\x59\x6b\x16\x21\x85\x83\x83\x80\x3d\x07\xc7\x49\xbd\xb0\x2a\x83\x13\x19\x06\x57\xf9\x21\x0a\x0a\xd8\xfc\xcc\x93\x02\x22\x25\x87\x68\xcb\x63\x0d\x07\x9a\x91\xd0\x3a\x19\xd5\x4a\x61\x1d\xdb\x02\xaa\x0b\xcb\x21\xd2\xb2\x2e\x18\xb3\xf8\x86\xb0\x79\x65\x2d\xb6\x5b\x0e\x33\x7d\x64\x5d\xac\x1c\xf9\x4a\x1a\x00\x11\x08\x9d\x08\x5f\xde\x18\x2b\x66\x31\x5d\x69\xfc\xf8\xed\x5e\xd8\x8d\x09\x1b\x77\x41\xc5\x05\x88\x99\x8f\xd9\xa8\xc4\xf2\x46\x62\x6e\x2b\xd3\x33\x45\x71\xa5\x2c\x53\xfb\xa9\x22\x70\x05\x56\x7b\xe4\x93\xf5\x8f\x7e\xf7\x12\xcf\xc3\x7c\xc6\x8e\x5b\x35\x00\x26\xd1\xf2\xa3\x1b\x27\xca\x35\xfb\xb0\xe8\xe7\x6f\xe7\x7f\xce\xfe\xb4\xe8\xd7\x9c\x1d\xa3\xad\xe5\xe1\x3a\xcb\x5c\xc7\xcf\x12\x78\x68\xf4\x33\xc7\xbe\xe5\x16\x8a\x68\x7f\xb5\xdc\xf5\xf3\xa5\xdf\x87\xea\x38\x2c\x06\x1a\x65\xb9\x31\x68\xd6\x74\xcd\xa0\xfa\x24\xe0\xd6\x28\x7e\x7c\x64\x36\x87\x85\x92\xc3\x49\x70\x95\xb8\x1d\xdc\x6f\x43\x38\xa7\x2e\xc8\x7b\x5d\xc4\xd6\x91\xac\xcf\x0e\x4f\xe5\x28\x31\x07\xfb\x02\xd6\xbe\x33\x52\x91\x78\x03\x82\x3e\x10\x59\xc1\x41\x8e\x70\x13\x75\x3f\x4a\x8a\xab\xd1\x9d\xde\x97\x18\x80\x53\xfd\x02\xb3\x0c\x0d\x73\xe7\xc3\xa4\xb0\xfb\xe6\x34\x7b\x83\x74\xe0\xa7\x5b\x2e\xf5\x4c\xbb\x4b\x32\x78\xe7\xd3\xd8\xa9\xc5\x7a\x2e\xb9\x1a\x9b\x97\x1b\x3b\xb8\xa8\xcd\x96\x6e\x98\xf5\x07\xcc\x92\x67\xfd\x78\xe1\x89\x9e\x8c\x10\xd6\xb0\xb5\x15\xfc\x2e\x04\x38\xab\xf9\x67\x28\x81\x06\x69\xf4\x97\x93\x9c\x27\xfb\x2f\x84\x6b\x78\x1f\xe1\x0e\x58\xe3\x31\x7a\x0b\x8d\x37\xe9\xce\xd4\xd7\x00\x4d\xe3\x8f\xf0\x86\x90\x76\x13\xc5\xdb\x73\xb6\xbb\x04\x79\x96\x65\x85\xe2\x2b\x93\xfd\x75\xa2\x79\xdf\x0a\x45\x61\xf9\xcc\xfc\x45\x8e\x06\x43\x2e\xff\x97\x2c\xd4\xbf\x59\x48\x1a\xf2\xfb\xb4\xd0\xf9\xe1\x81\x0c\x0f\x0f\xc9\x0c\xdd\xaf\xa4\x79\xd6\x82\xae\x81\x19\x61\xc2\xab\x78\x32\x98\xa0\x86\x3b\xc5\xd7\x47\x2b\x75\xf8\x83\x60\xad\xe8\x64\x8f\xba\x95\x3f\x97\x0d\x45\xa8\x5b\x33\xaf\x56\x93\x19\x1c\x64\x5e\xfb\x88\xad\xfb\xfd\x22\x57\x73\xb1\x03\x63\xa7\x6a\x2e\x67\x14\x38\x7f\xbc\x2e\x9d\xdf\xd2\x03\xf9\x5f\xea\x1f\x06\xbb\xc2\xd9\x37\x5f\xb3\x37\xaf\xeb\xd2\x05\xec\x62\xf0\xc3\x64\xd6\xbe\x59\x15\x03\xcd\xed\x57\x85\xcb\x83\xa7\xa4\xf1\x7c\x80\x56\xf1\xad\xb1\xd2\x79\x6b\xc2\xec\x04\xeb\x53\xc5\x12\xf1\xa2\x17\x54\x32\xcf\xab\xab\x58\x51\x88\x5a\xfa\xac\x78\xe6\x99\x12\x1b\xcf\x8c\x25\x6d\x46\xd0\x17\x27\x2d\xb4\xb8\x6f\x57\xf1\x37\x91\x7e\x6d\xbc\xa5\x17\x79\x2b\x02\x30\x74\xde\x20\x3a\xed\x1e\x32\x12\xf7\x22\xc0\xbd\x3f\x6e\xe5\x3c\xa5\xce\x3c\x69\x69\x62\x65\x3f\x15\xf5\x7b\xd1\xc7\x5d\x01\x10\xa7\x19\x75\x22\xa8\xd6\x17\x35\xe9\xcd\xa0\x40\xc4\x44\xe7\xce\x33\x6c\x76\xea\x84\x6a\x63\x35\x07\x02\xd9\x28\x1a\x0b\x81\xd8\x6f\x83\xec\xa1\x39\x0d\x77\xa7\xb5\xa5\xa8\x30\xdc\x7a\x5a\xb6\xff\x77\x97\xaa\x43\xb1\x66\x8b\x47\x42\x1e\x77\xfe\xf9\x98\x99\x85\x6b\xc4\xdc\xbc\x62\x7f\x37\xa4\xaa\x42\x31\x02\x12\xe0\x36\x22\xed\xf2\x08\x57\x88\x24\x09\x07\xe9\x04\xd4\x10\x1b\x14\xde\xab\x19\x01\xb0\x73\xec\x72\x3f\x58\x27\x2c\x68\x74\x54\x4f\x59\xb6\xc4\x81\x7d\x4a\x28\xd0\xb2\x51\x3c\xf3\x63\x51\x94\xfd\xfc\x27\x61\x1b\xd1\x17\xd5\x48\x4c\x64\x62\x22\xc2\x60\x66\x84\xca\xbf\xf9\x32\x0c\xc5\x89\x5b\x12\x20\x2b\x79\x01\x62\x82\x1c\xb1\xb5\xb1\xc9\x17\xa0\x7d\x1c\x81\xb5\xe1\x9f\xb2\xcb\x42\x71\x10\xcd\x50\xe7\x28\x69\x41\xc1\x5b\x3e\x94\x0f\x2b\x4b\xb8\x1c\x9b\xf0\xc8\xc4\xb7\x18\x86\x8c\xd2\x95\xf9\xfd\x01\xa3\x05\x3c\x54\x2c\x2e\xc9\xa4\xf5\x04\x36\x2a\x67\x2b\x10\x3b\x99\xde\x54\xee\xdd\x3b\xdb\x47\x28\x35\x68\x1f\x51\xe1\x3a\xf7\x9a\xe4\x21\x44\x7e\xd5\x1d\xb7\xd4\x98\x85\xdd\x81\x28\x5e\x15\x02\x6d\xe8\x37\x1a\x9a\x78\x08\xf0\xa8\xab\x05\xed\x72\x8e\x78\xe2\x9e\x47\xdd\xef\xcf\x1e\x9e\x35\x9e\x49\xd9\x2d\xda\xc8\x84\x6d\x90\xfe\x05\x13\x9f\x7b\xa1\x5c\xa2\x56\xc4\xc6\x98\x13\x2c\x66\x91\xe2\xf3\xe7\x38\xea\x67\x19\xd5\x52\x0d\xef\xb9\xb1\xa8\xd6\xd2\x0d\x3e\xf6\xff\x8f\x5c\x21\x37\xac\x11\xf2\x7c\x62\x08\xef\x5f\x9f\x1b\xc1\xc7\x0d\xfe\x0a\x8a\xba\xe1\x87\x9b\xb0\xd9\x7b\x1b\x66\xf2\x84\x52\x71\x24\xaf\xf6\xa6\x78\xdd\x98\x4a\xa0\x97\x3e\x49\x0c\x2f\xa4\x48\x13\x6a\xf3\xcc\xb1\xdb\x46\xb6\x27\x64\x81\xe6\xa2\xcd\xab\xaa\x62\x7f\x5a\xc8\x1f\x18\x29\x71\xec\x6e\x58\x73\xbb\x46\x1e\x41\xdc\xce\x8c\xb7\x2d\x36\xc6\x87\xf3\xb4\xd9\x3d\x8e\xf4\x46\x64\xa6\xbe\xa7\xda\xc6\x4e\x76\x1d\x82\xe0\xb7\x56\x08\xcd\x78\x98\x3e\xe6\x7c\x08\x21\x5c\xa4\xc7\x4b\x32\x7c\x93\xe4\xec\x7a\xb0\xce\x53\xb1\xd8\xf5\x96\x1f\x31\x25\x27\x5b\x92\x01\x9a\x47\x32\x27\x16\x92\xe4\x11\x9c\x05\xfb\xbe\x6b\xdf\xc1\x35\x13\x1b\x7f\xd1\x83\x19\x06\xf1\x66\xf4\x77\x6a\xba\x10\x48\xb3\x0a\xb2\x35\xa0\xa2\x04\xd2\x75\x3f\x66\xd9\x20\xc8\x3d\xe1\xf3\xf6\x0a\xcb\xe7\xc5\xbd\x11\x24\x12\x2e\xbb\x19\x47\x66\x11\xee\xa4\x25\x9a\x85\x1c\xa3\x6a\xb3\x64\x10\x4f\x59\xaa\x79\x6b\x4f\x34\x9e\xd1\xb8\x9e\x83\x6c\x4e\x1c\x3a\xc2\xe6\xbc\xb0\x2f\xae\x29\xe1\xbc\xd1\x73\x25\x9c\xb3\x00\xe2\x77\xed\xbb\xa9\xb2\xc1\xbb\xe5\x7b\xfb\x07\x88\x13\xd8\x0f\xc2\x0b\x63\xdd\x7c\x4a\xe9\x51\x24\x77\x2e\xed\x70\x2d\x3d\xb0\x94\x0d\x6a\x33\x10\xb1\x60\x0f\x50\x23\x0e\x74\x2a\xc0\x00\x4e\xd2\x58\xe7\x75\xaa\x1f\x47\xde\xf3\xde\x4d\x9f\x4e\x7e\x66\x5e\xea\x23\xeb\x68\x4a\x93\x38\x01\xd2\x76\xfc\xbc\x13\x47\x64\xc1\x4b\xda\x31\x12\x53\x82\xc6\xae\x25\x1e\x69\x27\x73\x30\x93\x45\x4b\xdf\x79\x09\x96\xd0\x41\x87\x7c\xae\x0e\x07\x87\x42\x90\x32\x56\x12\x99\xa4\xe8\x0b\x1a\x85\x5e\x26\x9e\x9f\xcf\x3d\x6c\x28\x1d\xcb\x4f\x71\x2b\xd2\x43\xac\x40\xe1\x3e\xd5\xcd\xd1\x8c\x21\x7f\x07\x19\x32\x02\xc5\xd8\x58\x99\x9d\xd2\xf8\x45\x38\x6f\xee\x2d\x07\xf9\x6e\xba\x45\xca\x95\x85\x1d\x1d\x0e\x5c\xa9\x33\xef\x13\x24\xd9\x5c\xa1\xf9\xe9\x8c\x92\x6d\xf4\xa5\x57\xc5\x48\xc3\x93\x28\xd3\x0a\x37\x56\x1f\x9a\x64\xd9\x7c\xe2\x64\xc5\x33\x73\xe6\x1a\xf7\x93\x29\x7a\xd3\x7e\x05\xb2\x90\x4f\x9d\x90\x7a\xba\xe2\x73\x86\x68\xc4\x35\x8c\x6b\x7b\xc4\xa8\x1c\x96\xc9\xe2\x72\xf4\x7f\x90\xf4\x0d\xae\xe2\xfb\xf7\x4b\x9d\xe8\xce\x5d\x44\xf8\x1a\xe6\x0b\xd6\x55\xf0\x2f\x1f\x2a\x10\x36\x4b\x7f\xbf\x20\x45\x5e\xcc\xbb\x15\x3d\x97\xd6\x41\x67\x9a\xde\x86\x80\x0c\xf3\x9b\x96\xf9\x10\xf7\x54\x1d\xb6\xc7\xd8\x6b\x51\xe8\x2d\x58\x73\x27\x40\x02\x57\x6a\xa6\xa4\xbe\x5b\xc1\x8a\xd8\x71\xb5\x17\xa4\xba\x40\x5f\xb9\x13\xc7\x55\xf0\x75\x8c\x05\xb6\x93\xe6\x8e\x80\xd5\x8a\x8c\xc8\x41\x6a\xe1\xee\xa4\x06\x2c\x72\xe6\xcb\x9f\x0c\x68\xaa\x57\xca\x00\xe3\x3e\x96\x2b\xa5\x72\x9c\xd0\x18\xec\x44\x56\x31\x6d\x82\xe7\xde\x14\xb4\x7b\xb6\x4b\x00\xf0\x2b\xd6\x6a\x0c\x76\x00\xed\xde\x83\xf5\xa6\x06\x12\xa0\xd3\x2c\xd1\x23\x18\xea\xf8\x92\xe8\xfe\x99\x4f\x00\xaa\x44\xa5\x86\x53\xaa\xaf\x60\x7e\xff\x01\xfb\x34\xef\xbf\x90\x1f\xdc\x73\x01\x25\xdc\x82\xc2\xd8\xf4\xbd\xb0\xa0\xd6\x7d\x96\x76\x12\xca\x2d\x4b\xd1\x17\x8f\xca\x42\xfb\x4f\xa0\x14\xa2\x44\x46\x08\x16\x21\x66\x4c\x07\xd8\xf8\xa4\xc8\xbe\xf8\x61\x27\x5d\x0f\x85\xe1\xd8\x07\x5d\xe6\x34\x81\xe6\x0a\x68\xb6\xb2\x8f\xfb\x02\x32\x07\x75\x0f\x73\x0c\xf8\xad\xe8\x01\xce\xc1\x78\xba\x30\xf6\xac\x86\x4b\xa4\xce\x5e\xc1\xcf\x26\xbf\x93\xfe\xf4\xc8\xd0\x47\x98\x33\x9e\x8f\x49\xa3\x2e\xab\x4a\x27\x4d\x8b\x94\x3f\x49\xd2\xb4\x2d\x2a\x4b\x20\xaa\x7b\x2d\x8e\x86\xf2\x99\x6b\x68\x6c\xb7\x21\xf0\xa0\x4c\x8d\x54\xc8\x7c\x44\xdb\xcf\x6c\x62\x12\xe6\x86\x45\x35\x80\xc6\x74\x9d\xd1\xe1\xfc\xe0\x6a\x55\x09\x21\x64\x8d\x04\x63\xd9\xdb\x74\x1c\x1f\x8c\x69\x4b\x65\x5e\x4a\x49\x64\x8e\xe7\x9c\x45\xc0\xe2\x03\xb2\x55\x4e\x44\xcc\xe2\x34\x13\x3f\xe4\xc6\x0a\x88\x66\xb0\xca\xd7\x18\xab\x85\x75\xc8\x44\x83\x33\x65\x7a\x11\xa2\xf0\x65\x28\x3e\x74\x0f\x3e\x1d\xb8\xed\x1e\x1f\x1f\x7a\x03\xa2\x52\x4f\x06\x0f\x5d\x70\x36\xfc\x45\xf1\xdf\x51\x66\x7d\x0d\x06\x1c\xbc\x98\x5e\x0d\x5d\x27\xfc\x48\xaa\x9b\xfb\x70\x5e\xdb\x22\xeb\x51\xfa\x7d\xb3\x35\xd4\x89\x1a\x24\x67\xef\x2e\xc2\xa0\xc1\xb7\x98\x10\x43\x2f\xf1\xcb\x70\xe5\x60\xff\x7d\x6f\x05\x6f\xdd\x68\x15\xcc\xb9\x6e\xaf\x47\xbe\x1b\x44\xc1\xaf\x9f\xa0\xd0\x58\xde\xbb\x94\x90\x0c\x3e\x57\xe2\x35\x82\xf4\xad\x14\xf6\x88\xe9\x00\x40\x10\x44\xac\x05\x98\x0b\x90\x55\x59\x24\xf6\x82\x43\x71\xb3\xb2\x95\x50\x5e\x3c\x29\x70\x79\x95\xa8\x25\xba\x62\xaf\xdb\xaf\xfe\x10\xfe\xb3\xc4\x21\x93\xba\x65\x7f\x51\x5c\xdf\x2d\xaa\xaa\x3d\x26\x09\xff\x03\x41\x82\x27\x77\xd1\x7c\xdf\x29\xe4\x62\xc7\xb4\xfa\xc9\xab\x02\x32\xa9\x84\xa4\xeb\xdd\xb1\xd9\xc9\x26\xb7\xb1\x65\xf4\x32\xf1\x48\x0c\xaa\x25\x25\xa2\x10\x2e\x09\x94\x07\x72\xc8\xee\xcd\xb1\xad\x05\x09\x14\x22\x22\x9b\x82\x29\x4c\x34\xe7\x5a\x55\x44\xf2\x25\x60\x51\xc5\xaa\xbf\x17\x9a\x6d\x8c\x54\x8e\x1d\xa4\xdb\xc5\x2c\x35\x80\x3d\x28\x61\x6d\x4b\x95\x2d\x87\xfd\x55\xac\x37\x07\xe0\x85\x23\x64\x63\x1c\x78\x41\x4c\xd8\x49\x14\xbe\xf0\x86\x01\xa2\x57\xea\xe0\x8b\xe1\x10\xf9\xda\x0c\x55\x06\x6b\x89\x61\x86\x24\xf4\x47\xea\x08\x5a\xe4\x14\x5d\xd3\x88\x7a\x1e\x12\x73\x6d\x13\xea\x43\x39\xf9\x4f\xb9\x3c\x0f\x20\xe1\x76\x51\x89\xa4\x6a\xc0\xd3\xa5\x8c\x7e\xf2\x88\x90\x2a\xe5\x44\x47\xe8\xcf\x65\x63\x16\x40\xee\x4a\x41\xfd\x13\xa4\xfc\x68\x4b\xae\x24\xd3\xff\x58\x37\x0e\xe2\x23\xac\x60\xe5\x13\x1f\x0c\x38\x5b\x30\x64\xea\xdb\x49\xee\x16\xd4\xe5\xc2\x2f\x3b\x80\x93\x50\xfc\x99\x19\x66\x56\x15\x4d\xc3\x33\x17\x3f\xe5\x4c\x49\xd0\x3f\xb7\x86\xdb\x15\x66\xa2\x3c\x95\xc0\x5a\x3b\x10\x19\x7d\xd1\x6c\x19\xfb\x26\x92\x22\x32\x6c\x2e\x3a\x84\x10\x53\xe9\xd8\xa0\xf9\x1a\x82\x6b\x72\x49\xe2\xf2\xcf\x22\xf3\xc6\xa6\x63\x95\x5a\x13\xc3\xe8\xbd\x38\xdd\x99\x38\x8f\x0a\xbc\x62\x3e\x67\xd7\xc1\xcb\x52\x74\x6b\xc7\xa5\x5d\xb1\x6e\x68\xdb\x23\xb8\x81\xbd\xc5\xfe\x0f\x6a\x99\x05\xfa\xf6\x9d\x70\xe1\x57\x05\x89\xcb\xa4\x4f\xf9\xfd\x7c\x9b\x72\xdd\xef\x8b\xef\x16\x24\x68\xa9\x93\x78\x3c\xd3\xd4\xbd\x17\xe1\xc7\x5f\xa4\x91\xf2\xfc\x6c\x96\x5a\x88\xe7\x1b\x2c\xd3\xee\xa3\xc6\xc4\x49\x03\xe3\x7f\x9e\xbe\xcb\xf4\xc7\x48\xad\x70\xa1\x7b\xf2\x9c\x49\xb7\xa8\xcf\x13\xac\xdc\xc4\xa2\xff\x71\xa1\x45\x2f\xb2\xbe\xe3\x94\x74\x58\x4c\xf7\xe3\xb6\x41\x08\xe8\x12\x29\xb3\x7b\x76\x0d\x60\xf9\x19\x35\x9d\x28\x0d\x03\x8e\x5a\x86\x49\x2a\xc1\x08\xd7\xa8\x8c\xb9\x5b\xc5\x1d\x42\x5b\x03\x7a\x26\xe0\xf0\x16\x42\x21\xa1\x55\x01\x6c\x77\x21\x6e\x37\x9b\x78\x03\x0a\x66\xf1\x1f\xcf\x32\x39\x13\x16\x5d\x0b\xc4\x0e\x90\x6d\xad\xd8\xce\x1c\xc4\x1e\x68\x83\xfb\x70\x07\x29\x14\x12\x96\xf1\x60\x15\x5b\x62\x51\x5a\x53\x81\x1c\x10\x65\x4e\x74\xc1\xa1\xe4\xcc\x1d\x78\xd7\xaf\xd8\x4e\x86\x41\x36\x56\xec\xc3\xb3\x8f\x49\x0e\xb2\xaa\x15\x65\x7e\x9d\x03\x7f\x34\x0d\xf7\x96\xf5\x86\x82\xbc\xe2\x36\x4e\x88\x2e\x72\x77\x6d\x2d\x77\xee\xc8\x3a\xc1\x5b\x73\x58\x01\x8f\xb3\x68\x64\x2f\x1b\x91\xbe\x21\xb4\x0f\x73\xa8\x4c\x1f\xbb\xfc\xad\x69\xee\x5e\x3a\x6f\xc5\x41\xb3\x2d\x80\x31\xe8\xbb\x20\xd1\x09\x4e\x4f\x67\x8c\xdf\xc1\x63\x9e\xdb\xfb\x33\x6d\xe6\xb9\x57\xbd\xe8\x9d\x87\xb0\xd9\x0e\x0d\x74\x58\xe0\x20\xda\x16\xf3\xeb\x98\x91\xd6\x80\xbf\x28\xa4\x85\x67\xa2\xe1\x56\xba\xed\x00\x3c\xa0\xd0\xe8\xcb\x55\xba\x50\xea\x0c\xb9\x14\x20\xa4\x3d\x8b\xa4\x0d\xc2\x45\x5a\xad\x15\x31\x6d\xad\x98\x0f\x86\x4b\x51\xcf\xb4\x51\x1b\xde\x78\xb0\xb6\x98\xa4\x75\x20\x0b\x4b\xbc\x62\xc0\xa2\xda\x28\xc1\x6d\x0c\x15\xa1\x6a\x33\xd1\x2a\x83\x42\xfa\x3e\xbc\x12\x68\x93\x7f\x51\xa3\x44\x64\xd7\x8b\x56\x14\xc0\x86\xa2\x7d\x3b\x6c\x85\x57\xec\x56\x1f\x73\xf2\x29\x99\x9e\x30\xaa\x74\x83\x7c\x58\x85\x77\x0b\xcb\xcc\xcb\xe6\xee\x45\x22\xcd\xb0\x22\xdc\xa0\xcd\x14\x6c\xb1\xde\x02\xbb\xad\x95\x2e\x81\xa9\x72\x61\xf4\xac\xc2\x47\x26\x11\x87\xa3\xc6\x0e\xc2\x41\x66\x62\xb6\x0b\xbd\x20\x1c\xf0\xd5\x0e\x0c\xbf\x23\x6d\x5b\xda\x6c\x2b\x00\xfc\xe0\xfe\x88\x93\x3e\xe6\x86\xa0\xad\x0a\xef\x02\xc1\x23\x7e\xa4\x43\x5c\xe1\x51\xe2\xcf\x9e\xb9\x04\xdc\x99\x6b\x9d\x07\xf6\xa4\x22\xe1\x19\xcf\xd3\xe2\x30\xa5\x0b\x2d\x34\xea\xf6\x24\x5d\xcc\x65\xe2\xcc\x07\x15\x19\xef\xed\x90\xc7\x06\x72\x30\xdb\xc2\x1e\x5f\x24\x1c\xd8\xd7\x86\x3b\xff\xe2\xb1\xfa\xc5\x7e\x06\x8a\xb3\xb4\x8c\xdb\xa1\x57\xb2\x41\x32\xd3\x4d\x81\xfe\x42\x6e\x8d\x51\x51\xf1\x14\xd7\x91\x28\x2f\x03\xb0\x5d\x78\x6f\x11\x8f\xd2\xc9\x4e\x36\x25\x8f\x48\xd8\xca\x3b\xb9\x01\xa7\xb6\x37\x4e\x52\xf3\x15\x93\x21\x6a\x93\x5d\x6f\x1c\x42\xba\x3c\xa6\xd2\xef\x08\x48\x84\x0e\x15\xec\xa8\x52\x14\x6a\x86\xa4\xa4\x94\x5f\x9b\x79\xd4\xcb\x1d\x53\x72\xa4\xf8\x4d\x6a\x20\xa0\x97\x00\x5e\x50\x12\x66\x29\x9a\x76\xc6\x75\xd4\x15\x10\x37\x31\xce\xda\x37\xaf\x91\x10\x26\x3a\x58\x87\x9d\x80\x5d\xe6\x33\x67\x5a\x70\x92\x42\x50\x9d\x84\x47\x74\x66\xe6\x5d\x36\x72\xf2\xad\x89\xae\x31\xbc\xe5\xfc\xbb\x55\x66\xfd\xa6\x31\x7d\x95\xcb\x71\x80\x99\xdf\x51\xd8\x15\x07\xf4\xcc\x25\x14\x07\xe0\x8e\xe2\xa5\x5e\xb1\x5f\x60\x4f\x1e\xcc\xb9\xcb\x6b\xf6\x21\x5f\x9f\x7e\x02\x20\xad\xf8\x93\x99\x5f\xdc\xdc\x14\x3f\x39\x57\x91\xcc\x57\x79\xe6\xd8\xed\xd7\xd8\x9e\x0b\x3d\x37\xd4\x9b\x3b\x27\xe3\x07\x14\xdb\x71\xb2\x89\x02\xb9\x18\x4d\xb5\x88\x6b\x72\x6a\x56\xdc\x30\x13\xa4\x26\xba\x71\xad\x8e\xc8\xc6\x1a\x2f\x0e\x76\x1f\xee\x20\x3d\x44\x7e\x72\xab\x0d\x74\xb8\x27\xeb\x5a\xe4\xfd\x28\xb3\x31\xd1\x19\x44\x36\x47\x4c\xb6\x57\xaf\x12\xce\xea\x19\xea\xec\xb9\x89\x2a\xed\xf2\xa0\xe7\x3b\xa8\x88\x9d\x3d\xa1\x1a\x57\xf4\x81\x15\x4a\x02\xc5\x31\x61\x26\x69\xe8\x10\x50\x39\x8a\x91\x62\x8d\x4e\x49\xdd\xd2\x67\xc6\xe6\x0b\x66\x46\xff\x68\xbe\x41\x78\x64\xc3\x55\x70\x25\xf8\xf8\x00\x9b\xa4\xf8\xda\x37\xaf\x27\x4c\x76\xaf\x97\x2a\x25\x4a\xa7\x04\x9f\x76\xc0\xcf\xf1\x8a\x3c\x92\xfd\x7f\x54\xde\xba\x07\x91\x19\x13\xa9\x8c\xd4\x11\x1c\x5b\x12\xd9\x82\x71\xc3\x7c\xab\x2e\xac\xa3\x19\x20\x1f\x4b\x45\x1c\x72\x49\x32\xd0\x2f\xac\x3a\x68\xda\x05\x92\x23\xa0\xf5\xc6\x5f\xcc\x27\x69\x72\x49\x92\x06\x81\x0d\xf2\x65\x9e\x86\xb6\x4b\x4c\xcb\xf0\xcb\xdd\xac\x67\x2c\x3e\x9c\x3a\xf0\xd9\xf8\x89\x62\x23\xb3\x6c\x44\x0a\x42\x45\x4b\x7d\xcf\x77\x80\xb4\xdd\x0a\x87\x44\x5c\x40\xc0\xba\x22\xb1\x59\x30\xa4\x52\x43\xf4\x09\x24\xb5\x07\x7e\xac\xd2\x2c\xff\x2c\x8a\x10\x39\x60\x77\x4c\x1c\xa9\x9b\x06\x80\xbd\xd5\x5f\x80\x13\xad\x96\xde\x41\xb9\xef\x96\xa6\x1a\xa2\x5c\x80\xb9\xcd\x40\x6a\x26\xd0\x9b\xec\xfb\xbb\x03\x64\xe2\xc0\xc7\xcc\x5a\x19\xb4\x73\x41\xe9\x2c\xa1\xb1\x81\x3f\xd4\x58\xb6\x4e\x58\xbe\x42\x59\x08\xde\xf3\xf8\x02\x59\x51\x28\x52\x59\x10\xcc\x14\xb8\x2c\x80\x17\x5b\x6c\x41\x88\xd7\xb0\xac\x17\x51\xc8\x0d\x2d\xf3\xda\x9c\x3f\xb2\x4f\x5e\xf4\x8b\x7c\xb6\xd9\xce\x97\xb3\xa4\x15\xe7\x3c\xb7\x47\xc1\x72\x46\x47\x8d\x38\x25\xd9\xf3\x5b\x0d\xf4\x71\xae\xfc\xec\x7f\x09\xbd\x15\x00\xdb\x3d\xe3\xd1\xcd\x55\xcd\xac\x14\x1b\x68\xe0\xc0\x69\x45\x33\x8e\x64\xbf\x47\xd6\x49\x47\xe8\xfa\x28\x67\x37\x52\xc7\x04\x7a\xb6\x29\xa6\x77\xdc\x72\xb3\xe8\x45\x85\x33\xf5\xc8\x7e\x10\x9d\xb1\xc7\x65\xbc\x4d\xd7\xf0\x37\x3c\x44\x88\xf6\x3e\x0d\x9f\x25\xc4\xa5\x72\xbf\xef\x69\x7b\x2b\xb5\x0f\x4c\x6f\xcc\x68\x7b\x74\x61\xf6\xa4\x80\xad\x2e\xce\xb4\x40\x5d\x6c\xda\xff\x58\x54\xdd\xc3\x21\x3a\xa6\x2b\xc8\x54\xc1\xa5\xaa\x20\x1e\x06\x8b\x9b\x3b\x63\x9e\x2d\x16\x72\xd6\xc7\x0a\x40\x58\x67\xe6\xe3\x97\x62\xb7\x1d\x30\x7e\xf2\x9e\x37\xd2\x83\xa1\x43\x0e\x88\x14\x26\x82\x99\x29\x6c\xc5\x98\x8e\x1e\x83\x43\x30\xa5\x11\xb9\x2e\x23\xb4\x18\xf0\x38\x89\x59\x51\x46\x97\xb5\xe6\xc7\x4f\x7d\xe2\xc9\xe3\xc1\xb8\x05\x01\xbf\x99\x90\x39\xd7\x8d\xe8\xdd\x60\x07\x06\x7a\x90\x67\x1d\xad\x5f\x28\x10\x96\x34\x43\x78\x26\x16\x89\x99\xb9\xe2\x14\x6c\x1f\xca\x7c\x83\x80\x61\x4a\xbb\x8a\xcf\xbd\xb0\x32\xc4\xca\x6d\x29\xd3\x18\x2e\x9a\xa4\xad\x32\xb7\x46\xf8\x58\x00\x74\xb9\x52\xa6\x4d\x2c\xfd\xf1\x94\xea\x85\xed\x78\xd8\x33\xea\xc8\x84\x92\x9d\xd4\x90\x82\x57\xaa\x18\x0a\x40\x9e\xf7\xd0\xa9\xc3\x01\xf7\x50\x82\xb9\xc3\x7a\x6e\x52\x4b\x10\x8c\x18\xfc\xb7\x5e\x58\x78\xbc\x46\x71\xf0\xb9\xc1\xab\xfd\xcc\x51\x47\x11\x69\xd7\x57\xa9\xd5\xc2\xbb\xd1\xed\xf0\x0b\x6e\x74\x77\x63\x73\xcd\x25\x7f\xbf\xc8\xdf\xc1\xf7\x2e\xf9\x0a\xa8\x3d\x8f\x7a\x08\x66\xf4\x2c\x91\xb6\x82\xed\x90\xbc\xa3\x7e\xeb\xd1\x55\x8e\xfc\x7d\xa9\xb2\xc0\x29\x2e\xad\x49\x4c\x2c\x53\x5c\x6f\x87\xb0\x0e\xe3\x9e\x88\xeb\x26\x5f\x37\x0c\x21\x6c\x64\x6b\x0c\xc4\x05\x54\xa0\xdc\x48\x45\xea\xff\xfa\xc8\xb6\xbc\x4f\x55\xf3\x62\x66\x30\x0a\x84\x11\xf7\x35\x15\x46\x0e\x18\xa8\x16\x93\x42\x40\xa2\xb3\x68\xe3\x93\x46\xc3\x30\x19\xd8\x44\x39\x88\x26\x1c\xe9\x1c\x20\xa9\x18\xa2\xa0\x4a\x0c\x68\xf6\xf9\xc2\xc3\xed\x8c\x6a\x0b\x6a\x8d\x45\x45\xa1\xdb\xd1\xc5\x8e\x29\xef\xa8\x45\x23\x9c\xe3\x56\xaa\x63\xdc\x44\x3b\x40\xe3\x24\x83\x89\x6e\x9a\x5b\x61\x93\x4f\x33\x40\x4a\x34\x4a\x1c\xd1\xd5\x40\xc2\x8d\xb7\xb2\x89\x4a\x89\xf9\x51\x23\x79\xa8\xd4\x8d\xa2\xea\x75\xb0\x0c\x51\x8c\x0d\xfd\xa4\x10\x08\x6d\xdc\x2b\x76\x0b\xee\xb2\x89\x0a\x0c\xd5\x90\x73\x30\x44\x42\x22\x08\x85\xcd\x6b\x37\x8c\x1b\xe0\x1a\x55\xf6\x4b\xe8\x5f\x21\x8a\x6c\x4d\xf2\xb9\x84\xda\xc0\x6a\x68\x64\xbb\x82\x40\x14\x73\x19\x61\x2d\xf6\xc2\xee\xc2\x02\x41\x3f\x90\xb7\xac\xc5\xea\x62\xb0\xba\xdf\xfc\x40\xcc\x20\xad\x10\x1d\x28\x4b\xd4\x53\xea\x8d\x09\xd6\x2e\x38\x74\x30\xfe\x5c\x33\xe7\x23\xb8\x8c\x93\x5b\x2d\x37\xb2\xe1\xda\x13\x71\xce\xf9\x97\x87\xf9\x4d\xd6\x20\xa5\x8b\x65\x5b\xd8\xb8\xb6\x64\xe5\x4a\xb8\x44\x97\xe4\x93\xb3\xf8\x9f\x37\x56\x4c\x5e\x0c\x64\x27\x71\xe8\xd7\xa1\x93\x4f\x70\xb9\x5f\xc1\x09\x38\x4d\xa7\x4f\x4f\x86\x89\xd9\xf6\xc6\xdc\x31\x10\x01\x8c\x84\x75\x50\xbe\x61\x7c\x6b\xd8\xf3\x74\x9b\x17\xab\x48\xed\x81\x7f\xf8\x63\xf1\x87\x1b\x62\xed\x08\x9f\x7f\x28\x3e\xa7\x0a\x24\x44\x6d\x51\x09\x2c\xcf\x53\x1f\x9e\xf3\xf9\x9f\xd2\xf7\x97\x39\x70\xaa\x45\x8e\x8f\x07\xca\x3a\xdc\x0f\xc8\xb0\xd0\xd7\x0e\x0f\x4e\x3a\xb7\xcf\x83\x27\xb4\x16\xca\x1c\x5e\x9c\x97\x7a\x98\x82\x3a\x1f\x11\xc6\x10\x19\x3f\x82\x77\x0d\x78\x82\x0d\x6b\xa5\xc5\x76\x5b\xe8\x40\x5c\x48\x86\xb5\x91\x59\x7e\x79\x54\x99\xc6\xb2\x5c\x92\xde\x97\x00\x3a\x2e\x5c\x94\xeb\xb4\xb3\x69\x2b\x24\xe8\x65\xc2\x7e\x29\x78\x12\x11\x56\x40\x5e\xee\xb2\x9c\xfc\xe0\x23\x7c\x6e\xf8\x1e\x47\xb1\x8a\xbc\xc8\x8c\x03\x6e\x3a\x23\xf4\x56\x14\x09\x42\x16\x37\x7c\x01\x17\xff\xfb\x2c\x94\x47\x54\x78\xa9\xc6\x52\x01\x2c\xa9\x31\x19\x05\xc4\x46\xbc\xda\x97\x9e\x0e\xfd\x9a\xf0\x7d\x70\xb2\xdd\x8a\x35\x46\x41\x13\x49\xb0\xd9\x06\x15\x55\x28\x8f\x4d\x36\xa7\x7a\x5d\x2b\xb4\x05\x00\xac\x88\x64\x82\x2b\xdc\x5a\x60\x89\x01\xc9\x86\x65\xc3\x1d\xef\x21\x55\x1d\x5c\x7b\x1b\x35\x6f\x13\x87\x75\xd8\x81\x9b\xb4\x58\x17\xbc\x94\xc9\x50\xca\xd7\x24\x5d\xcd\x19\x13\x5f\x10\xd2\x0d\x20\x86\x00\x92\x3b\x93\x5a\xd7\x2b\x76\x1b\x2c\x56\x38\xc5\xf6\x62\x9e\x7e\x3a\xd2\xd0\xa4\x8b\x68\x63\xbb\x70\x18\xc4\x2a\x70\x14\x6a\xe4\xca\x0a\x0e\xda\x92\x93\x9b\xfc\x4c\x6e\x6e\x58\xd3\x39\xf7\x73\xf9\xe1\x4f\x18\xe6\x6e\x50\x5e\xf6\x4a\x44\xae\xeb\x4a\x75\xa8\x72\x6a\xa9\x5f\x08\x7a\xf1\x8c\x7e\x59\xad\xd7\x04\x10\x03\x32\x2a\xea\x27\x47\xda\xaf\x5a\x5d\x0e\xeb\x02\x11\x09\x4f\x70\xb7\x12\x26\xf4\x45\xba\x49\xce\xd9\x66\xa3\xd7\x82\x4f\x81\xbf\x0f\x6e\x81\x5d\x8a\xb9\x27\x79\xbd\x60\xc0\x5a\x3a\xe8\x8e\xac\x33\xc1\x75\x10\x2d\x23\x91\x34\xa0\x96\x4a\x85\x51\xd3\x73\x25\x5c\x88\xbc\xd9\x46\xa8\xd6\xf5\xdc\x3e\x32\x2b\x13\xd9\xf7\x69\x66\x65\xbe\x2d\x96\xb2\x22\x61\x22\xc3\xf8\xc2\xf0\x10\xd7\xc3\xdc\x4e\x6a\xe1\x58\x6b\x0e\x88\x79\x88\xf6\x17\xb1\xc1\xab\x84\x15\x0e\xce\x40\x21\x8c\x5f\x60\x85\x63\x13\x5a\x0d\x3b\x9e\x2f\x8d\xb5\xb2\xa3\xfb\xa2\x97\xef\x17\x0a\xf5\xff\xb2\xab\x89\xff\x0b\xe9\xf0\xaa\x78\x1c\x83\x0d\xec\xc2\x06\x17\x01\x46\x08\xe5\x71\xb0\x2e\x20\xbd\x08\x40\x4d\xd0\x1d\xdd\x09\xca\x08\x48\xc7\x84\xde\x0e\x6a\x83\xf9\xbb\xed\xce\xb8\x10\x18\x6e\x14\xef\x32\x11\x23\x18\x57\xc1\x2d\x1a\x3f\xa9\xeb\x68\x28\xca\xc7\x1a\xed\xbc\xf4\x03\xfa\x79\xa7\x48\x94\x6e\x5e\xc3\x14\x87\xc5\xf3\xd4\x0d\x6b\x78\x1e\xa0\x85\xb2\xc4\xb6\x02\x49\x86\x52\xde\x76\x86\x53\x01\x13\x0b\x61\x44\x48\xb9\x02\xc0\x42\xb2\x32\xd0\x84\xb3\x17\xa4\x4c\x0d\x7c\xee\x56\x6e\xa5\x46\xdd\x81\x2e\xf6\x03\x84\x78\xc9\xb9\x01\x8a\x87\x19\x2b\x07\xdf\x48\x94\x33\x24\x0a\x5f\xbe\x4b\x35\xae\x7e\x8c\x9f\xe9\x44\x3a\x18\x54\xd8\xce\x6a\xb7\x11\xeb\xdc\x28\x4f\x0e\xfd\x16\x61\x6f\x10\x25\x50\x6e\x6d\xb2\xe2\xa2\x11\x7c\xf2\x16\xe7\x51\x27\xf3\xcd\xeb\x2b\x5b\x99\xc3\x62\x5b\x52\x20\x32\xb6\x15\xfa\x8e\x4b\x2d\xf4\x33\xc7\xbe\xe3\xd2\xef\xc2\xf2\xfa\x1e\x5b\x87\x46\xe6\xf7\xdd\x03\xc9\x97\x16\xf3\xdd\x41\x53\x2f\x69\xb8\x1d\x76\xd2\x79\x00\xc4\x64\x5b\xbb\x06\xd7\x05\x0d\xb0\xdf\xd9\xf3\x4d\xca\xe7\x69\x67\xce\x13\xdc\x19\x60\xe8\x0e\xb7\xde\x71\xed\x4d\xc7\x0e\xdc\x37\xbb\xd6\x6c\x4f\xf1\x43\x9c\x75\x7f\x57\xb9\xbe\x11\xc1\x5e\xd3\x92\x11\xee\x91\x33\x18\xde\x55\x26\x6f\x82\xf2\x71\x58\x02\x67\x05\x9a\x2f\xa9\x70\x89\x30\x41\xc8\x7b\x96\x8b\x65\xc1\x6f\x8c\x22\xaf\x80\xb3\x11\x9f\x1b\xd1\xfb\x84\x67\x48\x0c\xb5\x98\xe8\xa4\x76\x69\xce\x3e\x01\x1c\xd5\xd8\x88\x3d\xcd\x18\x17\x48\x9e\xce\xc9\xf0\x25\x3d\x05\xb4\xe0\x90\xab\x8a\x89\x9a\x9e\x3b\x17\xbc\xd2\x3c\xb1\x91\x58\xf4\x70\x52\xb3\xd1\xa7\x27\x22\xd3\xbf\xe6\x16\x2e\xa8\xcc\xd0\x2a\xe2\x61\xa5\x2f\x08\x51\x3e\x74\x41\xc4\x4e\xb0\xb7\xf0\x0a\x13\x4d\xcf\xb7\xd8\xce\x0d\x5e\x8d\x16\x55\x59\x3b\xd5\x76\x2f\x58\x0d\xa4\x6a\xe1\x08\x0b\x9c\x9a\xb3\x72\xf0\x94\x43\x47\x6d\x15\xe9\x45\x4d\x6b\x38\x43\x65\x98\xc0\x56\xe1\x1c\x93\xaa\x66\x5a\x81\x6b\x86\x23\x13\x0b\x8e\xa4\x42\x55\xd0\x7a\x5f\x26\xf4\x8e\xc8\x82\xde\x1a\xd4\x04\x6a\x8e\x78\x9d\x92\xf9\x42\x46\x59\xe4\x77\xed\x87\xb0\x61\x6d\x93\xfb\x78\x26\x56\xea\x5d\xfb\x61\x5c\xdb\x9e\xa1\x5f\x58\x6c\xbe\x7e\xe0\x98\xb4\x09\xe7\xcd\x0f\x5c\xcf\x36\x28\xcc\xc1\x59\x67\x6d\xd8\x49\x71\xe0\xeb\x49\x3b\x43\xb0\xcc\x83\xbb\x6c\x13\x04\x4e\xee\x21\x5f\x16\xe1\xdb\xc9\xb2\xf5\x46\x61\xc2\xb2\xe3\x76\xad\xb2\x91\x2b\x0c\xa2\xeb\x4d\x30\x02\xb0\x78\xa0\x0b\x76\xa4\x30\xfc\x9e\x6d\xfb\x87\xd2\x72\x2f\xb3\x8a\x9a\x89\xcf\x90\x54\xa4\x66\x60\xae\x58\x7b\xa0\x70\x72\x59\x13\x43\x91\x53\x88\xa6\x11\x51\x3f\xe0\x1b\x6b\x64\x4d\xa3\xe2\x30\xae\xe3\xf4\x07\xa2\x7d\x21\xdc\x33\xd1\xa8\x48\x17\x77\x44\x82\x92\xde\xc4\xe2\x1f\x8f\xbc\x4b\x91\x99\xaa\xea\x0d\x6a\x85\x93\x5b\xa8\x06\x8c\x0d\x4b\xd1\x50\x8c\x3e\x68\xa4\x46\x3a\xf1\xe4\xbc\x6e\xac\xa6\x97\x1f\x0d\xbe\xe9\x85\xce\xf5\x88\xf0\x2f\xc8\xf3\x2b\x43\xd1\x29\x7d\xbd\xe8\x23\x9d\x55\x2e\xa5\x62\x35\xfc\x8e\x64\xef\xe3\x2f\x0b\x83\x76\xce\x18\xfd\x25\xb7\x9d\xe6\x5f\x42\x77\x75\xda\x43\x1b\x73\x14\xd4\xbe\xaa\x87\x4e\xd8\x10\x63\x36\x3b\xde\xad\xc3\xb4\x63\xdb\x2a\xbe\x14\xee\x3b\x43\x0d\x80\x21\x2e\x56\x82\xeb\x15\xdb\x58\xe1\x76\xb8\x80\x0f\xdc\x76\x8b\x7a\x6c\x63\x4b\x04\xd0\x76\x18\x0b\x91\x16\xcb\x4a\xb5\xb9\xc3\x9b\x37\xb1\x71\x21\x9c\x4b\xa2\x65\xef\x5f\x43\xfa\xc8\xd1\xc6\x08\xff\x4f\x3d\x74\x71\x01\xc4\x16\x47\x27\x5b\xea\x7f\xc0\x54\xa5\x74\x6c\x33\x58\xaa\x13\x60\x26\xa7\x31\x16\xab\x74\xae\xc2\x3a\x7c\xf4\x91\x35\xce\x95\xc2\x68\x1b\x63\x5a\xc4\x45\xdb\x10\x75\x33\x2d\x75\xa2\x09\x5f\x73\xfd\xdb\x20\x30\x37\x12\xe9\x51\x5e\xb3\x5e\x98\x1e\x98\xd5\xc3\x59\xb0\xd9\x84\x77\x1a\x3e\xd6\x82\xdb\x97\xc0\x78\xd5\x73\xa4\xb7\x12\x36\x78\xe6\x60\xb5\xa1\xbe\x11\x6c\xc5\x61\x67\x70\x1d\xe2\x32\x6a\x45\x13\x96\x7a\x98\x16\x52\xeb\xad\x81\xcf\x7e\x27\x20\x82\x89\x57\xc2\x77\x2e\x6d\xb8\xa6\xb4\x91\x25\x85\x5b\x51\x4a\xcb\x99\xb5\x68\x25\xd1\x9c\x21\x98\xc1\xb6\xd0\xc7\x09\xc8\x40\xba\x58\xac\xa8\x91\xf3\x7f\x64\x9e\xbb\x3b\x10\x88\x84\x7c\xca\x6e\xe8\xe0\x00\xa5\xef\x02\x58\x9d\xbe\x9d\xde\xe4\x31\x06\x0c\x09\x06\x03\x05\x94\x70\xb1\xe8\xae\xe7\x2e\x3c\xf4\xcc\x89\x25\x7e\x56\xf9\x7b\xa0\x90\x22\x3e\x6c\x18\xe0\x46\x78\x28\x38\x60\x59\x95\xd6\x66\x27\x74\xbb\x62\x1b\xa3\xda\xb0\x85\xc2\x14\xad\x52\x8c\x0a\x10\x71\x7c\x97\xe1\xcd\xae\x58\x6f\x40\xe1\x38\xba\x9c\xce\xb0\xd4\xbc\x57\xde\x68\x0b\xe2\x6f\x64\xc6\x30\x19\xd2\xe1\x21\x04\x4f\x8b\x0f\x8a\x9c\x71\x61\x03\x7f\x87\x6b\x0e\x5a\x5e\x00\xeb\x4d\x54\xf8\xd8\x0a\x1a\x59\x40\x6a\x94\x5a\x2b\x9d\x93\x3d\xe4\x23\x83\x4f\xe2\x3a\x73\x27\x82\xa9\x98\xa0\xab\xe9\xc6\xe4\x95\x8d\x23\xf2\xd2\xf8\x05\x6b\xe1\xe2\x02\x9a\x18\x35\xdc\x68\x61\x71\x44\xa2\xb4\xec\x0d\x81\xed\x82\x2f\xb8\xc4\x63\x19\x79\xe3\xc8\x48\x2f\x4b\xc4\x57\x67\xf8\x4f\x56\x42\xc2\xf5\x13\xd7\x8d\x1f\xa6\x29\xa0\xb9\x18\x64\xae\x55\xf5\x74\x3b\xca\x72\xde\x85\xb9\x4e\xfa\x3a\xe3\xf3\xdb\x20\xd8\x36\x9c\x91\xe1\x0f\x07\xde\x62\x3f\xbe\xf7\x26\x5a\x75\x4f\x16\x30\x91\xaa\x35\x3b\x7b\x74\x46\x49\x7f\xae\x6f\xf1\xc2\x19\x7d\x5f\xda\x24\x4c\x56\x50\x0a\xbc\xca\xcb\x67\xf1\x0b\x27\x9a\x28\xa3\x8f\xdf\x83\x53\x01\xcc\x69\x52\xb1\x40\x69\x71\xf4\x5c\x78\x3a\x71\x43\xc4\xe0\x88\x6c\x13\x44\xf2\x4f\xda\xdd\x52\x5c\xed\x34\x33\x6d\x41\x18\x3f\x05\x35\x9f\x8f\x69\x4e\x50\x37\xad\xe8\xa4\x07\x83\x09\xfd\x54\xb1\x41\x07\x9e\x3a\xf8\xba\x79\xab\xf4\xd6\xec\x65\x0b\x29\xf5\x60\xfe\x25\x69\x2a\x19\x8b\x10\xd6\x71\x2d\xa2\xb7\xa6\x17\xd6\xcb\xf3\xf0\xe4\xff\xef\xff\xf9\x7f\xd9\x27\x6c\x4a\x04\x73\x50\xf5\xb9\x15\x0c\x10\x89\x7a\xad\xcd\xca\x94\x07\x0e\x82\x1e\xd0\x93\x71\xe1\x16\x3f\x17\x57\x9a\xfe\x3a\x01\x1b\x5b\x6e\xef\x60\x75\x6e\xcc\x76\x7b\x5c\xb1\xde\x42\x31\x2f\x3c\x4e\x70\x20\x8c\x66\xcf\xb3\xba\x68\xf8\x32\x7e\xfa\x62\x61\x67\x1e\x3c\x2c\x74\x0b\x56\xf6\x6c\xd2\x23\x1d\xcf\x80\x08\xb7\x4f\xe6\xa8\xb7\xc6\x23\x58\x17\x8b\x36\xf6\xec\x7c\x01\xcb\xa5\xb0\xb2\x13\xfe\x7c\x62\x31\x8c\xeb\xeb\x52\x24\x2e\xb5\xe7\xa7\xa0\xb6\x84\xd0\x4c\xc6\x7b\xe9\xda\x91\xad\x15\x7a\x74\x22\x12\x0d\xcc\xa6\xb1\x50\x66\xb9\xfe\x85\x86\x80\x93\xdb\xc8\xb4\x21\x1d\xd2\x77\xd4\xd0\x98\x85\x97\xfb\x9a\xa2\xbb\xe2\x58\x49\x58\xa8\x4e\xa0\x6a\xa9\x80\xcc\x71\xcb\x8f\x44\x4f\x0e\x45\x59\x4c\x22\x16\xd2\x33\x19\x4d\xf3\xa4\xa9\xb2\xf7\xe7\xea\xd3\x31\x4f\x86\x0f\x20\x7f\x4f\xbb\x05\x1d\xc0\x63\x36\x43\x73\xf9\x33\xa2\x5e\x79\xe7\x77\xe4\x3d\xc0\x65\xd1\x53\xc1\xb5\x37\x67\xfb\xd0\x8f\x7b\x53\xd8\xb7\x10\xb1\xc0\xaa\x5d\x1f\x2f\x3d\xc4\x3d\x8e\xc4\x4f\x87\x39\x15\xb3\xb9\x40\xf6\x91\x45\xf2\xa6\x81\xac\xe2\x5e\xea\xa1\x63\x98\x93\xa1\x5e\xd8\xad\x95\x3d\x1d\x72\x5d\x27\x54\x41\x51\x14\x3e\xfc\x5d\xea\x66\x45\x31\xea\x9b\xf7\xaf\xcf\x07\xa7\xf7\xc7\x2e\x9e\x8f\x58\x53\xeb\x7a\x18\xf7\x4b\xc8\x8d\x63\x19\x07\xd6\x0b\x95\x5e\xa1\x65\xc1\xec\x43\x70\x53\xd7\x35\x3e\x9e\x0c\x60\x17\xf1\x87\xc3\x5c\x91\xcd\x5d\x65\xd2\x63\xce\x3a\xa1\x84\x98\xa7\x15\x4d\x74\xa5\xf3\xda\x97\x35\xb7\x1e\xdc\x21\x27\x61\x5e\x55\x28\x2f\xd8\xb5\x6f\x29\x83\xdc\xa4\xc2\xf2\x89\x62\x4d\x95\x33\x5f\xc4\x70\x58\xa4\xd3\xf1\x49\xe3\xf6\xc8\x5e\x01\x1a\x95\x32\x2b\x8a\xf2\x04\xbd\xe0\xb4\xff\x67\x48\x55\x11\xa6\x4f\x93\x50\x6b\x24\x8c\x8a\x1f\x4b\xb8\x3b\xdf\x2e\xcd\x87\xef\x05\xa2\x42\x16\x69\xb2\x3d\x09\xdd\x97\x66\xd2\x86\x98\x41\x71\xca\x60\xc4\x24\xd1\x9a\x6f\x63\xfc\x49\xc0\x2a\xf9\x19\xa5\x22\x36\xa0\x89\xe2\xd8\x4b\x10\x83\x59\x31\x65\x78\x47\xc1\x0a\xd7\x57\x13\x78\xbe\x39\xeb\x7c\x2e\xe7\xbf\x23\x65\xd6\x02\x73\x11\x4b\xec\x23\x6a\x82\x77\xd9\x92\x72\xb4\xa3\xf5\xfe\x8b\xe9\x81\x08\x25\x6e\xa5\xf5\x2b\x78\x34\x04\x49\x2a\x7e\xac\x8e\x71\xaa\xe4\x90\xe0\x59\x41\x66\x74\x22\x01\x05\xec\x15\x12\x81\x5b\x0a\x18\x55\xe2\xa5\x9e\x39\x26\x94\xd8\xd3\xf4\x90\x19\x31\x16\x2a\x99\x58\x3a\xf5\x56\xe8\x66\xb7\x62\xc2\x12\xeb\xfc\x46\x41\xb0\x0e\xf1\x00\x91\x27\x60\x80\xcc\x7a\xa9\x14\xb7\x94\xc6\x42\x66\x6d\xaa\x2c\x43\x29\x3e\x62\x29\xaa\xb4\x06\xd4\x02\x8b\xd1\xc0\xb4\x39\x9f\x09\xf8\x5e\xb1\x4f\x26\x69\x7b\x27\x30\x1b\x55\x70\xa9\x73\xbd\x40\x41\x44\x43\x88\x63\x19\xed\xd5\x70\xb8\xae\xe6\xa6\x02\x2f\x53\x4e\x06\x9c\x74\xe5\x8f\x57\xac\x95\xdb\x34\x1f\xa3\x0b\xb7\x42\xf4\x55\xf0\x9c\x8a\xa2\x39\x4e\x8b\x2f\xc7\x65\x54\x89\x37\x29\x27\xb1\x20\xad\x4e\x34\xc5\xe8\xbb\x14\xd7\xa5\xa2\x01\x12\x81\xc4\xd5\xae\xb7\xc9\xeb\xa9\x8b\x85\x09\xe1\xa4\xc5\x61\xb2\x6e\x13\x5e\xf0\x7c\x62\x2d\x63\x4d\xca\x4e\x63\xae\x5d\xa6\xde\x21\xc2\x7c\xa7\xcc\x41\x1d\x57\xb5\x66\xf1\xc8\x1d\x1d\xdc\x00\x91\x59\xf0\x42\x6c\x38\x46\x90\xc0\x82\xb4\x63\x49\x94\x06\x41\x2d\xcf\x32\xc6\x68\x39\x84\xe3\x99\x2f\xe1\x56\x11\x0c\x4a\x62\x0a\x11\x9c\x93\x68\x16\x61\xc9\xfd\x03\xb8\xe3\xe0\x7d\xa6\xb6\x7a\xec\x66\x85\x29\x6a\x80\x92\xae\x0d\x97\xf3\x20\x72\x71\xc8\xc8\x99\x8f\xd1\xf7\x45\xf4\x3c\x6e\xe8\x62\xaa\x28\xd7\x43\x27\x64\xba\x3c\x1b\xb4\x03\x29\x03\x54\x0f\xc1\x56\x6f\xa3\x14\xef\xdd\xd9\xb5\x51\x30\x04\x94\x79\x94\xd4\xd2\x4f\x19\x25\xda\x38\x08\xec\xd8\x5a\x73\x40\x8f\x10\xdb\xe2\x5b\x44\x89\x11\x09\x17\x02\xee\xe1\x9b\x8e\xa0\x60\xe0\x07\xc9\x45\xf4\x49\x7f\x37\x1a\x65\x19\xe6\x6a\x13\x73\xe4\xcf\x8f\x49\xc3\x45\xfe\x5c\x1f\x99\x03\x5b\xc9\xbb\xe0\xff\xb6\x83\xf3\xc9\x43\x63\xdb\x9e\xb9\xde\x4a\x7d\xa7\xc2\x4a\xdb\x57\xf0\xcf\x28\x67\x50\x64\xc5\x8d\x76\x43\x77\x96\x63\xf5\x7c\x01\xb6\x6e\x3d\xf9\x37\x6d\x0e\x2a\x44\xbd\x2f\x56\xec\x1f\x00\x5e\xb8\x1f\x4b\xf4\x77\x93\xba\x2a\x60\xc2\xa1\x28\x90\x5c\xa3\x58\x52\x44\x5e\x30\x48\x9e\x15\xc1\x1e\xe4\x44\x2a\xfe\xc9\x98\xfb\x98\xb2\x8b\x81\x83\x82\xe9\x64\xa4\x76\x22\x1a\x9f\x31\x89\xea\x18\x6a\x90\x8c\x78\x7d\x97\x69\x10\x8a\x5f\xaf\xc7\x06\x01\x31\x35\x97\x26\x4a\x23\x96\x44\x3e\x1a\x7b\x0c\x63\x44\xbe\x9e\x45\xdd\x67\x7f\x3f\x3a\x3f\x28\x2c\xa4\xc9\x86\xdd\x0e\x96\x3f\x3a\x75\xc0\xc2\x35\x8a\x1e\x0f\x11\xaf\x00\xe1\x99\xba\xbb\x7f\xc2\x2c\xae\x2e\x40\xc0\xf2\xfb\xb7\x35\xe1\x1b\x2e\xe9\x80\x4c\x05\x51\xaa\xde\x7d\x5e\x59\xce\xe0\x5a\x9b\x66\x3e\x90\x18\x09\x9b\x81\x67\xa8\xd9\x46\xf2\x74\xe7\xd7\x5f\xba\x31\x95\x81\x33\x8b\x20\xb1\x07\x86\x6f\x01\xa1\x60\xd1\x17\xb4\x44\x20\xf2\x64\x36\x8d\xce\x4a\x3a\x28\x00\x6e\x3a\xc9\x8b\x55\xad\xdd\x94\x37\x38\x55\x0d\x3c\x81\x8e\x2c\xd3\x13\xe5\x13\xd3\xe3\xd6\xc9\x0a\x82\x96\xaf\xe0\x5d\x25\x52\x82\xf0\xd3\xac\x3d\x2d\x3d\x46\x32\x59\x12\x35\xbd\x4f\x1c\x5a\xc2\x29\x61\xeb\x01\x66\xbe\xcf\x4d\xd1\x77\xf0\x02\xc3\x86\xf9\x33\xd5\x34\x13\x93\x40\x38\xe9\x8a\x78\x2c\xe6\xde\xbc\x29\x49\xfe\xe2\xa6\xa5\xd9\xca\x8d\x14\x78\x58\xe0\xdf\x57\xb4\x8a\x8a\x8f\xb8\x62\x7c\xb0\xbc\xe8\x70\xa2\x83\xb3\x50\xfb\xa1\x69\xa2\xf4\x1a\x7d\xba\x8a\x44\xca\x93\x2f\xe4\x5f\xa2\x73\x9d\x9f\x04\xbf\x17\x7c\x8c\xe2\xd6\x69\x71\xcb\xea\xd9\xd6\x02\x41\xd2\x26\x6a\xbc\x6e\x64\xc3\xd0\x48\x00\xf1\x26\xd2\x31\x27\xb0\x0c\x15\xd8\x2a\xa5\xcf\x32\xd3\x64\x0a\x82\xec\x55\xcd\xd0\x06\xcb\x0d\xa6\x1f\x2f\xca\x7b\x2a\x9e\x41\xb7\xe7\x71\xd4\x56\xb8\xe3\xba\x55\x84\x3c\x93\x5e\x9c\xad\x4a\xfe\xc0\xdd\xdd\xa3\xbc\xce\xea\xbd\xe5\xf1\x1c\x01\xb0\x9d\xa9\x73\x7a\x04\x76\x3e\x73\xec\x9b\x60\x28\x04\x24\x4f\x51\x25\x20\xdc\x90\x34\x73\x81\xed\xdb\x1d\xbb\xb5\x51\xa5\x98\x50\xf2\x5b\xab\xeb\x17\x95\xa5\x73\xc3\xb3\x82\xfb\xea\xa9\x1c\xa1\xa0\xa1\x39\xbb\x34\x70\xa4\x68\x06\xd7\x36\x36\xfd\x3b\x35\x0b\x2d\x39\x66\x7e\xf4\x52\x0d\x20\x3c\xfe\x9d\x15\x02\x68\x70\x3f\x41\xf1\x78\x99\x0e\xfa\x35\x39\xae\xe5\x68\x0d\xa2\xd1\xb3\x47\xe7\xa1\xee\x15\xc6\xf3\x40\xde\xf8\x93\x81\xf1\x2d\xdb\x58\xb9\x95\x2d\xdb\x2a\xb3\x26\x4a\x6f\xd5\x32\xa1\x85\xdd\x1e\x93\x70\x0d\xf8\x23\x05\x7f\xb6\x97\xbd\xc3\x0d\x45\x02\x10\xb3\x99\xa1\x31\xd0\x2c\x09\x2c\x40\xb4\xfa\xd5\x1c\x0b\xf0\x09\xfd\x86\x14\x86\x2c\x04\xba\x4e\x9b\x67\xf9\x98\xf4\xf7\xe6\x75\xfb\x15\x3e\x6b\xcc\x42\xfd\xa8\x4b\x54\x6b\x12\xdc\xc6\xaf\xcf\x00\x61\x2f\x80\xeb\x21\x14\x82\x49\x75\xde\x4a\x94\x18\x5c\x9b\x16\x9a\xcf\x0e\xd0\x90\x85\x6c\xf8\xf2\xb7\x41\xb6\x09\x2f\x15\xbc\xdd\x46\xf6\x10\x65\xe1\xb7\x9e\x6b\xe0\xba\x8f\xb5\x0f\xf8\xf0\xe5\x9a\x3b\x51\x90\x7d\xbd\x80\x91\x6e\xc2\x12\x26\x83\x12\x2f\x0b\x5c\x31\xa2\xc7\xe3\xf0\x2b\x20\xea\x16\x0e\x5d\xe9\x98\x0c\x89\x68\x0e\xf4\x70\xa8\xa1\x00\x58\xd3\x8a\x6e\x02\x8c\x60\x27\xe2\xf0\xb0\x29\xdd\x41\x76\x5d\x19\xc6\x92\xd8\x9d\xd9\x84\x85\xf3\xbb\xd0\xf4\x24\xc1\x7f\x8a\x61\x23\xbd\x54\xd9\x00\xf4\x20\x7e\x5a\xb1\x50\x4d\xb1\xb2\x57\x6a\xac\x82\xf1\x07\x2d\x81\x30\x33\x4b\x40\x1f\x56\x6c\x20\xf0\x83\xf5\xbe\x91\x89\x49\x08\xdf\x23\xe2\x7b\x29\x0d\x50\x91\x0c\xa5\xac\xc7\x41\xba\x1d\x60\x29\x60\x0b\xd3\xcf\x12\xb5\x6d\x2c\x0a\x70\xe6\xc0\x67\xa2\xbe\x97\x26\x9c\x44\xb1\x21\x16\xd5\x16\x0a\x3d\x47\x78\x2e\x10\x7a\x65\xb7\x3e\xb5\x9e\xe1\xc9\x83\xaa\x69\x25\x76\x08\x64\xc6\xf3\x90\xa1\x87\x47\x13\xc6\x3a\x7f\xfc\x1c\xd6\x05\xd6\x5b\xcd\x26\x26\xbd\x80\x8d\x6d\x37\x58\x15\x81\x90\x69\xa0\x51\x84\x0d\xfe\xf5\xcc\x45\x58\x05\x5c\xe0\x05\xa4\x50\xdc\x0e\x9a\x71\xa0\x59\x06\x85\x5b\x56\x85\x58\x5f\xe1\xf3\x10\x14\x89\xae\x10\xf1\x7d\x95\x7e\x3e\xab\xd5\xdb\x85\x2f\x46\x0e\x8d\x02\x11\xa4\x49\x37\xc5\x46\xcd\x57\xec\x16\xde\x4f\x5c\xab\xab\xd8\x75\x8a\x3f\xdc\x71\x6a\x9f\xc5\x46\x1a\xfa\x29\xe8\x5f\x66\xcb\xf4\xa4\xc5\xa3\x3f\x9e\x12\x5e\x99\xc7\x59\x7f\x75\x06\x66\xfd\x95\xdf\x4d\x92\xce\xcb\x98\xb7\xf3\x81\xf7\x0f\xe1\xa4\x02\x60\xcd\x89\x13\x6f\x0e\xde\x70\xe5\x89\xb7\xf0\xc0\xdb\x89\x4e\x82\xfd\x07\x57\x20\x8b\x4b\x00\x9f\x60\x3c\x07\x31\xfd\xdc\x71\xdf\x40\x45\xf3\xc4\x6f\xb6\x43\xc7\xb8\xe5\x6b\xd9\x3c\x72\x69\xe7\x9e\x82\x70\x88\x44\x0b\x51\x63\x96\x86\xc3\xe2\x87\xd0\x28\xe0\x37\x0a\xda\xa2\x66\xe8\x86\xfd\x0d\xe0\x0b\x68\x33\x2c\x5a\x14\x82\xc0\x15\x0a\xe5\x9a\x0d\x7a\x12\x82\x2d\x21\xca\x9f\xe7\x97\x28\x09\xaa\x68\x80\xed\x55\xa5\x26\x2a\x38\xaf\x58\x38\xb5\x92\x3e\x24\x41\x89\x56\xe4\x57\xac\x12\xed\x63\x2c\xf8\xc4\x58\x63\x42\xb8\x5b\x14\xd6\x41\xa9\x16\xcb\xd6\x89\x93\x82\x4f\xf8\xf4\xa3\x9a\x40\x88\x4f\xc3\x9f\x76\x02\xbb\x6c\x2a\x69\x80\x8a\x77\x9d\x27\xa2\xfc\x08\x71\x9d\x79\x1f\x04\x05\x48\x09\x11\xfc\x01\xe6\x43\xa0\x60\x54\xd3\xa2\x63\x33\x0a\x98\x36\x38\x4b\x48\x46\x34\x4c\x8b\x45\xd3\x56\x0e\x3c\xa1\x0c\x68\x78\x89\x8e\xec\x48\x5a\xab\x49\x84\xf4\x32\xed\x7f\x7c\xbe\x83\x08\xab\x52\x09\x87\x7e\xf6\xaf\x61\x49\x8c\xd5\x67\xa3\xee\x6c\xf5\xe6\xc7\xcf\x0c\x8b\x2c\xad\x85\xc9\x09\x5d\x13\x1c\xf7\x83\xdb\xd5\xa5\x33\x18\xd0\x33\x07\x15\x88\x08\xf9\x1b\x1c\x92\xcc\x15\x8f\xcc\x63\xab\x6a\xaa\x32\x14\x4d\xd5\xc0\x10\xf5\x8a\x55\x09\xd4\x68\xd7\x29\x11\xd1\x4b\xc0\x20\x0c\x58\xfd\xc5\x54\xe9\x3a\xf2\x44\x2f\x22\x45\xbd\x0d\xe1\xb5\xd4\x5e\x6c\x2d\xb0\x4f\xa3\xb2\x0a\x04\x21\xb5\x1b\x40\x5c\x6f\xae\x6c\x17\xd8\x71\xdb\x11\x2c\x07\x5f\x19\xbd\xd4\x65\x99\xd8\x1f\xbd\x37\xcf\x1c\xfb\x68\xad\x70\xd2\x79\xc0\xff\x7f\xc3\x75\xb3\x30\x0a\x79\x44\x36\x9f\xa7\x2b\x87\x5f\x95\xe0\xfa\x3a\x67\x73\x96\xa9\x91\x57\xb9\xa9\xb5\xd8\x4a\x0d\x76\xd5\x74\xa0\xa7\x00\xe0\x6d\x4d\x29\xb3\x97\xcc\xed\x86\xcd\x46\x81\x95\xf2\xbc\xef\x23\xb7\x16\x96\x8e\xb0\xdb\xa2\x4f\x96\x7a\x94\x17\x1a\xf9\xbf\xc9\x1e\x44\x92\x9d\xe0\xe3\x66\xd3\x92\x7d\x82\x0b\x2b\x8f\xeb\x66\x6a\xc1\xc1\x01\x56\x0a\xd9\x50\x0a\xb1\x5f\x7c\xa0\xb8\xf4\xa2\xce\x15\xb4\xd4\x21\x46\x52\xb7\xc0\x30\x54\xb6\xd3\x05\xbf\x6c\xfe\x28\xc0\x4d\x49\x55\xef\xb0\x2d\x5d\x44\x74\x17\x51\x78\xa9\x41\x52\xe1\x40\x57\xe3\x2d\x86\x8d\xc2\xd5\x7d\xcb\x6b\x27\xe3\x00\x0e\x5b\xd5\x4a\xc4\x67\xa6\x81\xa4\xd9\x67\x98\x96\x90\xff\x06\x74\x24\x80\x4f\x04\x93\x39\xc8\xd7\x41\xa7\xdb\x24\x9a\xf3\x27\x28\x50\x4e\xef\xcc\x9f\xc2\x59\xf4\x0b\xcd\xf4\xcf\x96\xcf\x6c\xc9\xb9\x2c\xf4\x35\x95\x92\x25\x2a\x99\xa8\xa6\x4a\x82\x06\xeb\xc1\x6a\xd1\xa2\x14\xae\xd9\x00\xd5\x98\x12\xde\xa4\x02\x7d\x6f\x25\x38\xd4\xae\xb7\xc3\x59\x49\x8a\xeb\x49\x10\x4f\x91\xe2\x26\x6e\xdc\xbf\x5a\xee\x9c\xe2\xba\x4d\x35\x93\x59\xb7\x68\x2f\xa4\x42\xa7\x88\xb7\x71\x05\x92\xbe\x14\xb6\xa5\x7a\x91\x93\x0e\x2b\xd6\x71\x77\x47\x74\x79\x2c\x31\xfc\x34\xb1\x8b\x9b\xbe\x99\x6a\x59\xaf\xd8\xb4\xe2\x22\xaa\xac\xc2\x54\xa5\xf2\x6d\x25\x52\x59\x62\x0d\x8f\x66\x78\x81\x7c\x5d\xec\x0f\x37\xaf\x09\x86\xe2\x4d\xb1\x99\x9e\x7f\xf2\x82\x2b\xbf\x4b\x5a\xe2\x55\x4f\x1a\x10\xe6\x8a\x36\xf6\xac\xad\x8f\x29\xe5\xd5\x09\xae\x47\x72\x44\xc1\xa4\x58\x01\x55\x14\x82\xb7\xe1\xfd\xa8\x47\x75\x2d\x76\x12\x55\x71\xe1\xaa\x2e\xbb\x51\xa0\x70\xe7\x62\x3b\x3e\xa9\x8e\x2f\x5d\xe0\xe1\x68\x5e\xc4\x1b\xf7\x78\xa4\x23\x73\x25\x40\x27\x10\xf5\x27\x44\x7b\xae\x84\x77\x76\x81\x8e\xa5\xfa\xcf\x40\x42\x6e\x93\x38\x7b\x0c\xb6\x0b\xd1\xfb\x51\x42\x6b\x72\xf6\x40\xad\xe5\x60\x4c\x2b\x34\xa4\xf7\x1d\xa8\xd8\xa6\x12\x75\xcc\x80\x14\x64\xe4\x88\xc0\xe0\xac\x11\x12\x8f\x1e\x88\xdd\xa1\xb5\xe4\xc5\x28\x61\x86\x2a\xc2\x17\xbb\x94\x22\xf0\x1d\x43\xf2\x54\xc0\x73\xec\x65\xcd\xfa\x71\x00\xc7\xf3\x43\x6e\x3d\x5a\x91\xc0\x55\x06\x44\x50\x0f\x0a\xcd\x07\x22\x33\x40\x76\x11\xda\xad\xa9\xf7\x0e\xc9\x88\x52\x15\xbc\xe0\x88\x5b\x02\x81\x2e\x46\x5b\x70\xac\x8f\x1b\x00\x4c\x6e\x3b\x40\x9e\x39\xf2\x4e\xeb\xa1\x25\xa8\x01\xe1\xd5\xc2\x68\xf8\x46\xa8\x23\x13\xbf\xe2\x19\x75\x82\x5e\x71\xd4\x1b\x90\x12\x55\x9a\x6a\xc9\xd3\xca\xd2\xa2\x3d\xb4\x0b\x67\x5d\x08\xd0\x82\xe5\x59\x78\x44\x5c\x53\xa8\x5c\x0c\x8f\x5c\x2a\x46\x74\x7f\x67\x6e\x42\x91\x09\x0e\xdd\x6e\x23\x80\xa1\x31\x7e\xf4\x57\xc8\xe2\xff\xa8\x5a\xf6\xa3\x16\xf7\xaf\x71\x36\x96\x6f\x7c\x55\xe3\xc4\x8c\x3f\x78\x05\x96\x34\x40\xa1\xc7\x44\x22\xe0\x86\xdf\xc7\x5d\x2c\xa2\x65\x5d\x2b\xa8\x5c\x8a\x98\x2b\x29\xa2\x3e\xaf\x82\x58\x1c\xca\x9c\x8f\xc9\x50\xc7\xf6\xde\x7e\x27\xb4\xe9\x84\x46\xde\xa2\x89\xad\x99\x8a\x9e\x72\x76\xf3\x3a\x53\x13\x65\xf6\x22\xe9\x62\xe1\x1d\xf5\xdb\x40\x92\xb2\x22\xd7\x9b\x63\x85\x4c\xa9\xaa\x70\xb2\x69\x53\xd4\xb6\x06\xdd\x0a\xde\x56\x72\xa6\x17\xb5\x24\xe2\xa3\x67\x2d\x09\x52\x26\xf1\xa2\xeb\x85\xa5\x39\x28\xea\x3f\x5e\x76\x83\x92\x2b\xd2\x8a\xda\xcb\x16\xa1\x94\x79\xe8\x4b\x6a\xc1\xa3\x6a\xf4\x34\x18\x2d\xd5\x76\xd2\x10\x23\x5d\xfc\xc3\xa5\x72\x60\x9c\xf0\x45\x70\x34\x45\xe4\xde\x4f\x4c\x6a\x5c\xc9\xdf\x63\xa0\x50\x8d\xa1\xd6\xf1\xc9\xba\x6c\xcb\xe8\xf9\xd0\x39\xe7\x27\x5d\x73\x51\x36\x9f\x47\x7c\x49\x98\x4f\x57\x8f\xa2\xae\xac\x21\x87\x7f\x31\xa7\xb8\x58\xe8\x19\x82\x95\xce\x5c\x7b\x66\xf0\xa4\xf3\x03\xfd\xc3\x51\x6c\x29\xc2\x8f\xca\xbb\xa0\x33\x16\xde\x44\x8f\xc4\x09\xb1\xac\x87\x1d\xe5\x28\x26\x1e\x0e\xc7\x3b\xc6\x1b\x6b\x9c\xab\x77\xd2\xda\xca\x36\x36\xee\xba\x9e\x6b\xd2\xa0\x72\x1d\xdb\x40\x3e\xc1\x40\x68\xe7\x99\xf3\xa2\x0f\xff\xa2\xe5\x83\x3f\x4b\x6f\x89\xee\xe9\x06\xbb\x97\x91\xeb\x63\x03\x07\x21\xfc\x34\x9c\x33\x40\x2a\xb8\x2f\xdf\x16\xdd\x5a\x7c\x96\x8e\x7a\x15\xf1\x99\x87\x1e\x9f\xb2\x24\xbf\xfc\xdc\x2b\x4e\x68\x8a\xb0\xdb\x20\x9a\x0c\x91\x1b\x88\x60\x1d\xb8\x83\xbc\x08\xe5\x9a\x9d\x92\x7d\x2f\xda\x28\x99\xe5\x2d\xc2\xb6\x40\x97\x61\xbb\x23\x22\xae\x3b\x8d\x3d\x15\x60\xcf\x37\x67\x83\x47\x9d\x5f\x7f\x5e\x11\x0e\x92\x3b\x7b\x09\x9c\xa5\x51\xf6\x9c\xa6\xf5\x19\x0a\x36\x60\x8b\x11\x56\xcb\x1b\x22\xcf\xc3\x96\x47\x4a\x3f\xa5\x0e\xbe\xa4\xf5\xc1\x6e\xf3\xea\x89\x27\x71\x26\xf5\xe2\x55\x2a\xb3\x29\x09\xf8\x93\xaa\x5f\x99\xc3\xe1\x17\x2e\xb6\x91\x16\xa9\x36\xa8\x50\x8f\x0c\x8c\x40\xe7\xb0\xe7\x98\xf7\x21\x0e\x9d\x74\x75\x28\x1e\x22\xa7\x96\xd1\x19\x1a\xbe\xaa\x17\x3e\x8a\x6b\x71\x05\x49\xf6\x5a\x75\x71\x64\x3a\x13\xff\x56\x74\x43\xf2\x14\xc6\x36\xa0\x79\x85\x79\xfa\xde\x2a\xb6\x6e\xb5\x79\x5d\x65\xe1\x1d\x37\x3a\xb2\x80\x6f\xe7\x77\x1e\x19\x26\xb0\xf9\x83\xf6\x9c\x3a\xe2\x90\x63\xa2\x32\x51\xfd\xc7\xfc\x63\xa2\x81\xa7\xa1\x47\x34\x96\x2b\x4b\x0c\xf0\x8a\xb0\x18\xdf\xf7\xd6\xf4\x56\x02\x30\xd2\xd4\xaf\x79\x8a\x67\x5f\x52\x59\x28\xbc\xa0\x7f\x93\x4a\x85\xc8\x6f\x41\x41\xe1\x1a\x37\x68\x01\x6c\xfd\x69\x72\xfe\x57\xba\x2d\x9e\xf7\xb9\x35\x15\x8a\x03\x1d\xb7\x62\xfc\xb6\xcf\x12\x13\x16\x82\x7c\x91\x3e\xbf\xe3\x5a\x6e\x84\xf3\x3c\x92\x1d\x06\x2b\x13\x9c\xf7\xe0\xd0\x6e\xd0\x99\x8e\xde\x45\x3e\x4a\xb9\xaf\xba\x92\xe7\x9c\x9e\x79\x1e\xeb\x45\xfc\xd3\x1b\x0b\xd5\x10\x3d\x57\x24\x60\x23\x2c\x34\xf5\x6b\xf8\x92\xbf\x13\xfb\x36\x66\x55\x0b\xab\xfb\x21\x71\x30\x9e\xb2\x18\x7b\xcd\x65\x84\x62\xcb\xf6\xbb\xf6\xe6\xf5\x68\x5f\x5f\x9b\x11\xfa\x52\xbd\x63\xa7\xcb\x7f\x67\x69\x96\xde\xf9\xdd\x0c\x81\xc9\xa2\xb6\x92\x9f\x88\x3d\xe8\x93\x17\x62\xda\xc9\x35\x07\xfb\xad\xb7\xa8\x95\x7e\xe0\xea\x7f\xfc\xf3\xdb\x4f\xff\xfd\xbf\xd1\xff\xff\x5f\x0b\x98\x4a\xee\xbf\x71\xcf\xc6\xfb\xf7\xd8\xa1\xb7\x54\x58\xfb\x6d\xe0\x4e\xbe\xb4\x20\x9e\xb6\x33\xd6\x89\x97\xa0\xfc\x96\xf6\x67\xcc\x0a\x54\xec\x8f\xa7\xb8\x96\xce\x00\x60\x26\xd4\x08\x45\x61\x23\xd3\x23\x20\x0d\x01\x11\xc1\xfd\x36\x80\x8b\x90\x6a\xf0\xbc\x6d\x83\xf3\xb4\x96\x94\x9f\x0e\xae\x09\x70\x36\xe8\xcc\x12\x1e\x7e\x03\x09\xe2\xd9\x10\x79\xcf\x81\x54\x82\xa0\x37\xfd\x80\x44\x0f\xa9\x69\x1f\xef\x1b\xb1\x99\x15\x6f\xf9\x88\xc0\x09\x2e\x19\x96\xce\x59\x08\xe2\x2c\xe2\x79\x0a\x56\xa0\x44\x06\x20\x2f\x52\x09\x0c\x2e\x8e\x8c\x9e\x95\x92\x26\xfe\x91\x7b\xe9\xbc\x6c\x1c\x75\x83\x5a\x09\xf9\x07\x78\x7f\xab\x98\x63\x8b\x04\xfb\xa4\x2c\x42\x8c\x16\xf8\x20\xb5\x4e\x3c\xb4\x66\x28\x9a\x16\x0d\x0b\x0d\x3c\x8c\x9b\xb7\xf4\x39\xa4\x84\x36\x61\x7f\xa3\xe2\xd6\x2c\x87\x41\x1a\x36\xdb\x5a\xde\x62\x87\xc4\x86\x43\xbf\xf6\x56\xee\x63\xf1\x28\x3c\x61\x46\x27\xc4\x06\x72\x33\xd4\x0a\x7f\xa5\x20\xcb\x99\x8e\x73\x92\xf5\xc9\x77\x1e\xf3\xef\x2f\xca\x5b\x60\x27\xef\xad\x52\x53\xd9\x88\xb9\x42\xd3\x1c\x37\xd1\x69\x6e\x83\xcb\x49\x8b\x87\x41\xda\x30\x17\x7d\x52\x70\xc7\x09\x81\x9c\xa2\x28\x2f\x68\xac\x6a\xd5\x91\x09\xed\x83\xdf\x0b\x8b\x47\xd2\x32\x43\x60\x72\x24\x94\xbf\xd3\xe6\xa0\x89\x6b\x84\xbd\x64\x9c\x6d\x0d\x52\x2d\x58\xd9\x19\xdb\x4a\xc4\xab\xb6\xa2\x33\x1a\xc1\x5a\x13\x29\x4b\xbc\x1e\x20\xe9\x5c\x27\x1b\x14\xc6\x0e\xb7\xe2\x3e\xde\xdf\x21\x83\x0a\x6b\x84\x0a\x61\x27\x5c\x53\x47\x8d\xbf\xa8\xe6\xc8\x36\x32\x9c\x9d\xca\x1c\x91\x15\x0b\xd9\x66\xb9\x6c\x63\x52\xfc\x6e\xac\x2d\x51\xc8\xc4\xcd\x19\xa9\x51\x27\x29\x2e\xb2\xf0\xbc\x25\x30\xb6\x22\x63\xef\x8a\x7e\x2d\xe2\xed\x0f\x4f\x11\xfe\x70\x92\xf4\x0c\x0b\x33\xbf\x0d\x90\x67\x2b\xfd\x8f\x54\xb4\x87\xb6\x53\x88\x75\x20\x12\x2c\xba\x29\xf3\x83\xe8\x63\x30\x39\xcf\xbf\xf9\xe1\x99\x23\xa3\xfa\x62\x51\x8a\x71\x6c\xbe\x23\xb9\x26\xe8\x03\x30\x8d\x5d\x5c\x83\x8a\x12\x8c\x59\x55\x08\x3a\x69\x12\x67\x3d\x03\xa6\xe4\x84\x43\x72\x77\xf5\xc5\xbd\xc9\x64\x33\xc0\xbc\x22\xb1\xc2\x28\x3e\x13\x3c\x77\x03\x04\x01\x47\xe4\xac\x4f\x96\x5d\x3f\xf3\xcc\xac\x95\xdc\x62\xa8\xd3\x1a\xe6\x0c\xae\x41\x9a\x30\xd8\xc8\xee\x8e\x5c\xff\x42\xc6\xda\xc9\x10\x3f\xb3\xe7\x1b\x75\x64\xd0\x06\x0b\xa1\x32\x66\x20\x20\xce\x08\x3e\x85\x50\x7d\xf8\x23\xc8\x8e\x00\x21\xbd\xf7\x4a\xbc\xc8\xad\x6b\x9f\xd9\x73\xd7\x1f\xa1\x49\x7c\xb0\x4c\x68\xd1\x49\x62\x54\x8e\x1d\xe6\x83\x8b\xfa\x79\x80\xf5\x34\x16\xba\x19\xc9\x93\x6d\x07\xbd\x15\x46\xbf\x20\x5a\xec\x91\x00\x43\x63\xba\x6e\xd0\x28\xca\x96\xb2\x00\xe5\x84\xad\xb9\x85\x6a\x5d\x0c\x90\x69\xda\xce\x3a\x5d\x3f\xe1\x14\xa2\xc9\x46\x67\x75\xcf\xad\x14\xfe\x48\xbd\xd2\x1d\x16\x50\xe2\x36\xa2\x65\x15\x66\x53\x02\x5a\xd8\xc9\xdf\x61\x80\xad\xd1\xc9\x6f\xde\x1a\x05\xf9\x2d\xc4\x68\x4b\x2f\x3a\x47\xf9\x62\xae\x54\xd8\x2d\x5e\x60\xb2\xe2\x40\x89\x16\xdc\x87\x91\xcd\xbf\xc3\x32\x9b\x42\xcb\xee\x78\x63\x81\xa3\x0a\xb7\xec\x56\x6e\x30\x08\x0c\xae\x1c\xf8\xdc\x9f\x4c\x57\x92\xf9\xe1\x55\xd2\x3a\x41\xfa\xa3\xb8\x82\xf0\x4c\xc3\xad\x03\x0b\x36\x3c\xb3\x26\x75\x97\xb3\xce\x29\xc8\x9d\x0e\x8a\x50\xd1\x43\xb7\xc6\x54\x8b\xbb\xab\xd8\x02\x3a\x1c\x14\x78\x31\xb1\x25\x91\xe6\x0a\x41\xdf\x38\xdb\xd8\x7e\x15\xce\xcc\x6d\x1f\xd6\x79\x02\x7f\xde\xe2\x25\xcb\xcb\xa0\xf8\x49\xba\xc8\xcd\xea\x75\xfe\x19\x30\x50\xb3\x5b\x64\x8d\x1b\xff\x10\x48\xf7\x9f\x47\x1e\x2a\xf8\xe7\x8b\xe2\x3a\xaf\xcb\x0b\xb5\xfc\x98\x44\x15\x60\x3f\xb6\x00\x67\xc1\x0e\x4d\x1a\xb5\x63\x88\x89\x25\x77\xad\x91\xb6\x19\xba\x70\x8a\x34\x22\xee\xfe\x93\x39\xfe\x9c\x27\x72\x77\xc8\xb6\x2d\xb7\x3a\xfa\x5f\x23\x97\x4d\xf8\x9d\xa1\x33\x3f\xce\x17\xbe\x53\xc0\x18\xaa\x3d\xf6\xb6\x40\x32\xe5\xc0\xe5\x3e\xf8\x32\x7f\x37\x1a\xe3\x7a\x33\x38\xb8\x87\x0b\x31\x38\x45\xf5\x71\xad\x42\xc8\x96\xc0\x2f\x6e\xd8\x6e\xd1\x1e\x24\x2b\x82\xab\x51\x80\xa1\xc6\x7c\x00\xd4\x57\xf3\x35\xc7\x6b\x3f\xea\x3e\x84\x35\x59\x02\x17\x2c\xb7\x42\x1d\x19\x6f\xc0\x59\xa2\xf1\x40\x82\x4d\x88\x8e\xb9\x41\x36\xb2\xe5\xe7\x01\x0b\x9b\x48\x3d\x57\x52\x6a\x42\x73\xac\x4b\x13\x09\x86\x25\x29\x8f\xf0\xad\x15\xa2\x7d\x39\xf4\x46\x17\xfc\x3a\x9b\xb4\xf4\xc5\xe7\x1e\xd9\xae\xaa\xab\x5a\x91\x3a\xfb\x83\xc9\xd8\x85\xbd\x84\x54\x09\x88\xc5\xb5\x40\x68\x12\xf6\x21\x88\xa7\xe1\xa9\x0d\xc0\xcf\x99\xfc\x06\x52\x82\x05\x1f\x7e\xc3\xa2\xa4\x69\xa5\xd7\x34\xe8\x68\xcc\x60\xb4\x44\x2e\x6d\xe3\xce\x1c\x83\x92\x70\xe4\x63\xdc\x5c\xd6\x0c\x3e\x3d\xfa\x85\x22\x91\x42\x2b\xe9\x28\x2d\xf6\xab\x89\x50\xe0\x10\x88\xf4\xe1\x84\x80\xa6\x4f\x87\xa2\x26\x6b\xec\xc6\x08\x01\x02\xd6\x8f\x31\x02\xdd\x0c\x0a\x24\xbd\x11\x82\x98\x75\x8c\xb0\xca\x89\xda\xc0\x6d\xe9\xcb\x5f\x74\x16\xff\x22\xa1\xe2\xb7\xa8\x5c\x3c\x8b\x82\xc0\x60\xee\xbe\x05\xae\x5f\xc5\x41\xa8\x31\xcb\x64\x34\x3a\xf7\xea\x08\x5d\xc4\x41\x89\xbe\x66\x6e\x0d\x4d\xcd\x7b\x95\xe2\xfb\x8c\x2f\x8a\xda\xac\x23\x22\xe8\x42\x91\x6c\x2d\x11\x70\x78\xca\x0f\xe4\x6c\x23\x8e\x95\x3b\x98\xe8\x51\x71\xf9\x8d\x22\xa5\x78\x2a\x57\x09\xa5\xb8\x78\x83\xf3\x09\x99\xd9\x39\xcc\xf5\xf3\x9f\xa3\x49\x9a\x22\x41\x89\x0c\x77\xe8\x3a\xa3\x4b\xda\x35\xa4\x61\x27\xf1\x15\xa9\xf7\xc2\x7a\x41\x2d\x3d\x60\x80\x95\x40\xc8\x66\x8b\x9a\xb6\x77\x42\xf4\xe0\xc5\x12\xda\xff\x90\x65\xba\x48\xf9\xd4\xc1\xe0\x5e\xbd\x88\xb9\xa3\x88\xb5\x47\x5b\x01\x9f\xe0\xe0\xa7\xd9\xa1\xd8\x14\xb2\xe3\x56\xba\x8e\x5f\xcc\x66\xa1\x5b\xb8\x8e\xfa\x0b\x48\xb2\x37\x2f\xa2\xf6\xb1\x06\x24\x42\x42\x3f\x4d\x46\x52\xc6\x9a\xd7\x38\x83\xba\x45\x2d\x43\x0c\x21\xfe\x67\x2f\x40\x33\x10\xda\x1a\x3c\x2d\xda\xd2\x30\x2e\x85\x8e\xe1\x13\xd4\x0b\x00\x5b\x1e\x49\x7e\x33\x37\xcb\xbb\x68\x09\xd7\xc1\xc1\xa0\x0c\x22\x55\xf7\xc9\xa9\xa3\x66\xf6\xae\x8b\x25\xa9\xd2\x83\x83\x66\xfa\x3e\x38\xca\x10\xce\x43\x90\xc1\xdb\xbd\xd0\xe4\xdd\x1b\xb6\x1d\xb8\x05\xe7\xc8\x34\x3c\xf1\x43\x83\xb8\x97\x92\xd0\x72\xc2\x3a\x81\xd0\x94\x7a\xd9\x9a\xb5\x38\x52\xdf\x8a\xf0\xb4\xa4\x26\xa3\x27\x47\x7a\x33\x59\x9e\x35\xc7\x30\x15\x75\x2c\xe6\xbe\x41\x69\x34\xd6\xf0\x40\x53\x04\x9f\x64\x27\xc5\x9e\x28\x5e\x0f\xb1\x9b\x2f\xfc\x62\xfa\xb6\x63\xab\xbd\x19\xfc\xcc\x8c\x16\x44\x95\x27\xd2\x98\xb8\xe0\xf7\x42\xb9\x18\x55\x42\x80\x04\x4c\x5c\xf0\x92\x37\xbc\xf1\x25\xc9\x6a\xd9\x63\x9a\x78\x81\xa0\x0c\x15\x4c\x77\x75\x62\xc1\x09\x95\xc3\xa7\xe9\xb7\x57\x48\xf0\x9d\xce\x21\x9f\xa8\x46\xb3\x92\x2c\xae\x20\xe9\x89\x09\x07\xb9\x61\x01\x33\x4d\xcd\xa7\x5f\x30\x49\xca\xa3\x04\x36\x1c\x26\xab\x7a\x57\xe4\x0c\x69\xf6\x19\x63\x8e\xec\x2b\xbf\x7b\x89\x19\xd2\x70\xa9\x15\x49\x6d\x96\xdf\xf8\xe3\xf4\x1b\x37\x1f\xaa\xaf\x68\xf6\x61\xf4\x1d\xae\xa9\x85\x0a\xa5\xa0\xc0\x56\xb7\xfc\x18\x2f\xf9\xa7\xfc\xf5\xf4\x14\x4b\xcf\x52\xc1\x3e\xed\xe4\xc6\xdf\x9f\x14\xfa\x61\x4d\xed\x1b\x63\xef\x44\xbb\x62\x9d\x00\x46\x60\xd3\x8e\x0f\x55\xe4\xc7\x5a\x85\xc3\x6a\xd0\x54\x9a\x2b\x03\xe4\xe9\x5a\x7b\x60\x8b\x62\x3c\x63\xa7\xa4\x3e\x17\xb5\x58\x23\x93\x32\x46\x13\x00\x7c\x9f\xf4\xa5\x3b\x20\xab\x55\x52\xdf\x41\x33\x15\x65\x42\xe9\x94\xa2\xce\x34\xed\xc2\xae\x8c\x0f\x7b\x6e\x5f\x15\x62\xc3\xc4\x01\x9f\x2a\xdb\x6d\x38\xc9\x75\x5c\xb1\x6c\x2b\x34\xc8\xb7\x78\x61\xbb\xa2\xc5\x36\x2c\xec\xaf\x25\xc6\xb1\x7f\xb1\xdc\xa5\x3c\x73\x16\xad\xf9\x29\xde\xf5\x3b\x69\x53\xeb\x6d\xcf\x15\xe5\x9b\xbf\x91\xae\xc7\x36\x42\xb2\x17\xa2\x31\x3a\x29\x39\xa0\x11\xfb\xbb\xd4\x82\x7d\x2f\x94\x72\x59\x77\x27\xe7\x88\x8c\x05\x40\x54\x6c\x09\x4e\xc3\xae\x4c\x8c\x47\xea\x06\xb0\x59\x9c\xce\xa9\x6a\xe0\x2b\xac\x53\xc4\x0a\x7f\x4e\xee\x04\xbb\x89\xb9\x95\x60\x66\x3f\x79\x4b\x05\xd2\x4f\x5e\x84\xad\x4d\xe6\x32\xfc\xed\xaf\x40\x65\xb4\x61\xb7\x6e\x47\x09\x09\x65\x0c\x64\xb7\xa8\x66\xda\x40\xd2\x0e\xe0\xaf\x39\xf3\xf1\x09\x09\x71\xbe\xc3\x32\x31\x7e\x11\xf2\x45\xad\x74\x8d\x15\x17\x75\x33\x6a\x71\x26\x59\xa4\xc3\x60\x3a\xe5\x56\x2a\xe6\xc4\x6f\x83\x20\xf6\x62\x9e\x18\x14\xf1\xe5\xd2\xda\x81\x73\x10\x4f\xfd\x39\xfb\xeb\x6b\x8d\x22\xc8\x62\xd0\x21\x80\xa1\x39\x5c\x25\x87\xa0\x73\xb7\x90\x0e\xe4\x02\xb1\xa9\xd0\x1b\x14\x75\x8c\xca\x9f\x79\x81\xc3\x65\xf3\x2a\x06\xeb\x79\x2c\xde\x37\x7c\x1b\x7a\x8e\xfc\x24\x39\xe8\x98\x0e\xf3\x13\x1d\x3b\x1c\xd4\x42\xe9\xef\x3a\xf7\xb7\x86\x52\x03\x26\x20\x27\x9d\x49\x90\x67\x29\x26\xeb\x15\xfb\x7a\xd2\x2c\x4e\xbe\x2b\x9c\xb5\xb8\xe6\x92\x36\xf8\x59\x9e\x3a\xe9\x4b\xf2\x7f\x3f\x71\x8b\xcf\x7b\x88\xe3\x63\x1f\x74\x67\xe8\x20\xce\xfe\xe2\xc4\x48\x58\xae\x5b\xd3\x25\x87\x27\x6e\xc6\xe9\x4a\x28\x05\x23\x2a\xd0\xaf\x33\xd5\x55\xd1\x81\x93\x70\x22\xa3\x73\x72\xe0\xc7\x32\xbc\xb5\xac\x19\xec\x29\xb3\xb4\x94\x8a\xee\xfc\xd9\xe4\xd9\x5f\x81\x77\x68\x51\x79\xf0\x0c\x28\x38\xec\xe4\xc4\xb8\x33\x2e\xe6\xbf\xff\x22\xf5\x01\xf6\xfc\xef\x30\xcd\x13\xa4\xfa\x77\xc6\x8a\x93\xea\xe0\x89\xee\x67\x8c\x71\x9c\x39\x7c\x4a\x06\xab\x1d\xd7\x3a\x38\x7a\x7b\xe9\x39\x61\x66\xbd\x89\x04\x4d\x49\x62\x30\xe5\xda\x81\x1f\x35\x78\xc2\x96\x8e\xa0\x83\x49\x99\x09\x2c\x7a\xc5\x60\x84\xe2\x89\xad\x25\x25\x53\x21\x61\x0f\xa5\x6c\x03\xda\x4c\xbd\x7d\x19\x8e\x19\xb6\x16\x5a\x6c\xe4\x79\xdc\xde\x59\x5d\xce\x9b\xd4\x94\x51\xa8\x2c\x4e\x45\xb6\x6e\x95\x8a\x8d\xbc\xf4\x8c\x12\x81\x8a\xaf\x4b\x11\xaf\x4a\xad\x0b\x6c\x1e\x5e\x8b\x24\xef\xfc\x4e\x36\x98\x97\x31\x7b\x61\xb7\xd6\x1c\x74\xb5\x3f\x3a\x93\xb6\x69\x6c\x08\xcc\x54\x00\x48\xa6\xf6\x2e\x81\x6f\x52\x9b\xcc\x06\x93\x70\xf6\xc8\x6e\x18\x8c\x45\x7a\xf8\xe3\xd9\x39\x89\x46\x4d\x7c\x06\x18\x63\x64\x93\x81\x7a\x68\xb8\x67\x92\x5f\x83\xa6\xcc\x82\x9b\xa0\x16\x01\x0b\xe7\x14\x16\x81\x22\x32\xeb\x1e\x2f\x02\x5a\xf4\x69\xf3\xe0\xa9\x2a\xb4\x8d\xd9\x0d\x85\xed\xe0\xaa\x9e\xf7\x1d\x57\x9b\x97\x5d\x88\x84\x66\x26\x7e\xee\x0d\xc6\x57\x80\x57\x26\x10\x07\xea\x9e\x26\x55\x82\x70\xf5\xa3\x14\xaa\x0d\x81\x54\x43\x2c\x6a\xf8\xce\x39\x54\x2f\x31\x13\x6f\x48\x65\x78\xc7\xed\x1e\x12\x97\x8b\xfc\x60\x23\x9d\xd1\xec\x53\x6f\xf9\xb4\x02\x39\xa7\x7a\x7a\x8d\x23\x7c\xf3\xc4\xf6\xe5\x9e\x7e\x2a\x46\xfe\xb9\xab\x9f\x79\x73\xc0\xa0\x79\x19\x32\xa8\xb7\x06\x29\x72\x52\x15\x5f\x9b\xcf\xd2\x0c\x8e\x6d\x79\x49\xd4\xd1\x73\xd5\xcd\x25\x87\x2a\x14\xcd\x49\x0a\x8d\x84\xa5\xb9\x69\x6f\xde\x84\x95\x13\xde\xd3\x65\xda\x8b\x6c\x0b\x83\x1b\x36\x8f\x6b\x79\x93\x2b\x87\x78\xba\x67\x60\xcc\xf3\x37\xed\xcd\x9b\x17\x2b\x76\x73\x93\x3f\x7a\x8b\x1f\x81\x7c\xca\x1f\xe3\x87\xef\xc2\x87\x33\x87\xdd\xcd\x9b\x31\xd9\xea\x9b\x99\xcf\xde\xce\x7c\xf6\xae\xfc\xec\xdc\x8a\x55\xc7\xce\xd8\x7e\x7a\x34\xce\x81\xdb\xae\xe9\x97\x59\x9c\x02\x6d\x82\xd3\x4f\x9c\x99\x8d\x69\x8c\xd1\x8f\xda\xe2\x55\x73\xe0\x4d\x1b\xbd\x16\x90\xad\x16\x27\x62\xa2\x9a\x74\x63\x0f\xaf\x48\xdf\x06\x87\x8d\xac\xd3\x79\xc9\x5e\x38\x4d\x91\x0e\x33\x5c\x73\x49\xfb\xfb\x89\xce\x41\xbe\x37\x12\x13\x5c\x88\x7c\x07\xce\x8e\x52\xa6\x90\x0f\xde\x74\xdc\x47\x25\x02\x02\x78\xe3\x09\x26\x6b\xed\xc2\x8b\x18\xf5\x9a\x6e\x73\xbe\xb3\xa7\x50\x52\x2b\x32\x9b\xad\x35\x48\xb4\xf3\x3a\xcf\x11\x34\xc9\xb4\x52\x10\x8f\x59\x9c\x8c\xc4\xc8\xa6\x41\x67\xd3\xf9\x10\xef\x22\x81\xa9\x52\x22\xcc\x1e\x71\x8d\x96\x42\x5b\xc1\x6b\x10\x10\x4e\x71\x5d\x23\xf3\x9e\x9b\x91\xd4\x36\x25\x64\x10\x2c\x54\x89\x06\xf3\xc9\x3d\x5e\x94\x50\xc3\x67\xc1\x32\x75\x25\x76\x67\x55\xd0\xd9\x50\xac\x1b\x7b\x8c\x5c\x63\xa0\x0a\xc4\xa1\xf8\x03\x99\xaa\x8c\x64\xca\xe0\x9f\x98\x0c\x0e\x4f\xa8\xf1\x71\x81\x85\xc4\x0a\x54\xc4\x81\xc4\x66\xe4\x81\x42\xb3\x29\xac\x03\x34\xbb\x3f\xab\x5f\xfe\x73\x49\x3c\x05\xa5\x03\xb8\x51\x39\xf9\xa4\x78\x9b\xd6\x20\x64\xb8\x20\xcb\x56\xe9\x51\xd2\x19\x89\x12\x33\xa7\x8a\x5a\x70\x98\x0e\xb1\x7a\x53\xdc\x05\x20\x4c\x6d\x8e\x91\xf3\x22\x12\x6d\x54\xc6\x8c\x37\x84\x1a\x90\x15\x6e\x50\x70\x16\x87\x35\xd3\x53\xc8\x5e\x2e\x1b\x6c\xb5\x12\x9f\x9b\xf0\xc2\xc9\x36\xa7\xdc\xe6\x1e\x33\xf4\xa3\x81\x43\x87\x6e\x29\x20\x55\xff\x3a\xae\x02\x2b\xda\xa1\x99\x60\xdb\x8a\xeb\x4c\x87\x92\xe0\x10\x11\xe8\x3e\xe8\xc6\x68\xd7\x84\x83\xec\xd2\xfb\x29\x53\xbf\x4a\x76\xd2\x67\x3a\x21\x4e\x59\x59\x59\x0b\x02\xd1\x02\xd2\x89\x38\xac\x7c\x83\x49\x44\x15\x5b\x36\x01\xe6\xb2\x42\x77\x0c\x79\xca\x56\x95\x16\x10\x06\xaf\xa5\x22\x50\x2a\x5a\x63\x5e\xc9\x40\xf6\x5f\x34\xbb\x65\xeb\x2c\x6c\x0f\x20\xc3\x13\x0a\x92\x52\x71\x55\xa4\xf5\xf5\x73\x9d\x8c\x06\x12\x9b\xc6\x83\x32\xcd\x2a\x04\x09\x2b\x76\x08\xee\x59\xee\x31\x3a\x48\x27\x62\x08\x40\x49\x13\xc4\x09\x86\xa7\x4e\x38\xc1\x65\xfe\xd9\x41\x58\xf6\x8b\xb1\x2d\xfb\x5e\xf0\x69\x8f\xe8\x9f\x1e\xca\x10\x73\x29\x59\xf9\x30\x0f\xed\x2f\xa7\x1a\x3f\x0f\xc1\x6c\x85\x0d\x27\x38\x9c\x1c\xc4\x76\x76\xc0\x36\xeb\xc4\x33\x5b\x39\x64\x40\xcc\x54\x37\xc8\x40\xf7\xb9\x4b\x2d\xfa\x79\x7d\x4f\xb3\x09\x28\xd1\x1d\x76\xef\xaa\x00\x4d\xaf\x58\xcf\x2d\x57\xc7\xdf\xa9\x1d\xc4\xf9\x41\xeb\xa8\xa1\xd6\x18\xdd\x4a\x58\x65\x90\x75\x9f\xbb\x64\x6f\x81\x3f\x4a\xd6\x0d\x50\xc1\xab\x8a\x88\xc1\x30\x49\x2d\x1b\xfa\x87\xf4\x7c\x2d\x5a\x1f\xff\x26\x67\x7a\x88\x67\xd7\xc7\x35\x6c\x15\x17\x9c\xa2\x87\x2f\x8f\x33\xfe\xfb\xd5\xb0\xff\x01\x2a\x57\x1c\xaa\x4c\xa8\x9f\x88\x9c\xe5\x84\xbf\x09\x43\x15\xaa\xe6\xb4\x38\xe9\xe9\x00\xd8\x53\x64\xb5\xe3\xe9\xeb\x27\xfc\xac\x03\x66\x2b\x00\xeb\xd4\x1e\xc2\x46\x1c\x80\x32\xc7\x93\xaf\xf0\x63\x34\x0d\x25\xe8\xbd\x5a\x09\x57\xbe\xf1\x4f\x7e\x98\xd2\x46\x7f\xf8\x3f\xea\x8d\x23\x24\xf2\xe4\x1b\x0f\x86\xe4\xb0\x13\x8a\x74\xd0\xa8\x47\x75\xd1\x02\x58\x15\xb4\x1e\xac\x1d\xba\x35\x08\xdb\xd3\xd9\x5f\x38\x88\xf0\xee\xdf\x9f\x7e\xf7\x61\xcf\xa3\x51\xb9\x6e\x05\x9c\x60\x17\xc2\x4b\xcd\xd6\xdd\x4f\x46\x92\x7c\xb6\x0d\x24\x4a\xa0\xbb\xd3\x9d\x19\x71\xec\x99\xba\x77\x39\x6d\x87\xe5\x47\xf4\xa9\xbe\x47\x13\x7f\x7f\xf9\xfe\xd3\x00\xe7\x7b\x53\xe9\x3c\x08\xdd\xfc\xef\x10\xc2\x39\xf9\xb9\xec\x9a\xbf\xc0\x56\x50\x99\x16\x78\x03\xc4\x9c\x52\x2c\x9b\x14\x13\xbc\x69\x3f\x44\x75\xdd\xb3\x12\xbc\x8f\xdb\x46\xfc\x74\x2a\xe6\xf1\x90\x1f\xe5\x21\x3e\x5c\xad\x62\xfe\x01\x13\xe9\x4b\xb2\x04\x56\x38\x2f\x5b\xb9\x95\x58\xc7\x59\x94\xdb\xba\x4a\xc0\xe5\x01\xb9\xad\x7f\x5f\x9a\x03\x38\x63\x27\x4f\x92\xe0\x5d\x65\x42\x7f\xae\x90\x3f\x20\xee\x64\x12\x2b\x34\xf3\xe1\xa7\x84\x37\x37\x80\x11\x4c\xcb\x51\x58\x07\xde\x0e\xa0\xac\xa1\xf5\x38\x55\x64\x49\x16\x45\x8b\x29\xfd\xf7\x98\x6a\xb8\xca\x65\x5f\x10\x3d\x2b\x95\x9b\x74\x3c\x96\x71\x03\xaf\x80\xfc\x0b\xc2\x68\xe4\xb7\x3f\xd2\x1d\x4a\x3e\x65\xb7\x83\xa3\x01\x58\x79\xb8\xbd\x03\x8d\xc6\x98\xbf\x3b\x48\x10\x00\xe5\x52\x83\x49\x85\x31\x6a\xe3\xa9\x12\x1a\xce\x91\xb6\x65\xa6\x35\x17\x85\xe4\xc2\x20\xab\xa1\xa9\x23\x29\x89\x06\xdf\x52\xc3\xed\x06\x90\x11\x03\xe6\x69\x80\x08\x1b\xdb\xec\x62\x2b\x32\xd2\x0e\xf3\xae\xdf\xc8\xf3\x9d\xf7\xa7\x6e\x06\x8a\xa6\xd8\x0d\x21\xd5\x19\xe9\x01\xd6\x0c\x6b\xd9\x40\x5e\x7e\xd1\xc4\xef\xa4\x52\x2b\x50\xee\x5d\x91\xac\xcd\x3e\x6b\xd8\x16\x17\xc3\x6c\xa8\x26\x68\x76\xc7\xbd\xb0\x12\x43\x50\x5a\xef\x4b\xee\x46\x07\x5a\x63\x94\xb1\x59\x15\xbb\xe3\xf6\x2e\xce\x13\x12\x5e\x53\x5d\xb5\xa4\x03\xa7\xbf\x13\xab\xc8\x75\xb7\x4d\x3c\x12\x05\x5d\xba\xb7\x52\xdf\x91\xe6\x54\xd1\x09\x2a\xb1\x53\x39\xba\x19\x21\xcc\x2b\x79\x62\x33\x37\x44\xc9\x20\x5f\x9c\xc1\xf0\x4d\x2c\xf7\x0e\xf6\x6c\x8d\xfc\x44\x6d\xa2\x1b\x94\x97\xbd\x12\xc0\x44\x5b\x28\x75\x41\x3a\x08\x5f\x8b\xdf\x01\x82\x96\x42\x6d\xa3\x5f\x56\x6b\x25\xed\x41\x88\x64\x05\xb6\x59\x21\xad\x6d\x84\x29\x40\x93\x36\xf5\x3b\xe1\x3e\xd2\x05\x85\x6c\x6a\x8b\x5a\xe6\x0d\xc4\xd6\x9d\x60\xc9\x5a\xf6\xfc\xdb\x6f\x5f\x2c\x6a\x78\x7a\x44\x1a\xaf\xc7\x6d\x4c\x86\x5c\xec\x09\xa7\x14\x5e\x02\x12\x09\x02\x4f\x1c\xc0\xdd\x64\xbb\x02\xb2\xeb\x15\x75\xd5\x83\x59\xd0\x00\xa4\x24\x4a\x46\x40\xc8\xc7\xce\xff\xb2\xea\x58\x20\x17\x17\x29\x33\x94\x14\x02\xe4\xee\x82\x29\x06\xca\x22\xe4\x99\x2f\x95\xdd\x52\x99\x3f\x86\xac\xe0\x98\x00\x85\x1e\x30\x14\x98\x92\xe5\x7d\x93\xfa\xdf\x73\x06\xb1\x64\x9b\x24\x62\xfa\x42\xe6\x3f\x42\x59\xa0\xd7\x06\x1d\x82\x3c\x37\x8e\x81\xf6\x72\x5c\x72\x30\x73\xd0\x09\x1f\xef\x7e\x61\xeb\x00\xec\x84\x30\xc7\xb2\x1b\x43\xff\x96\xcb\x1e\xb1\x75\x98\x73\x6a\x2f\xad\x41\x40\xdf\xee\x25\x20\x81\x8e\xc2\x3e\x73\xec\xeb\xc8\x79\xb6\x6c\xd5\x3b\xc8\x60\x9f\xa8\xaf\x3d\x58\xb4\x31\xac\x78\xf6\x3c\x04\x6c\xc0\x4a\x63\xb4\x78\xf1\x64\xa9\x9c\x05\x55\x87\x6f\xe1\xa4\x03\xd3\x04\xb6\x5b\xb4\xcc\xf2\x23\x38\xc7\x24\xa7\xad\xb8\xdb\x15\x15\x32\xe4\xd4\x46\xca\x08\x7e\x44\x17\xa4\x68\x45\x0b\xd7\x48\x54\x8a\x15\x1a\x0c\x8e\x72\x48\x2a\x0f\xb6\x07\xb1\x88\x9a\xb4\xbe\xa4\xb7\x87\x79\x59\x44\xc8\xfb\x5d\x74\x47\xa3\xbc\x11\x50\xa4\x72\xd6\x7e\x40\x04\xad\x17\x16\xd8\x6c\x70\xbd\xe1\xf0\xc2\xb8\x39\x99\xd2\xf3\x1c\x5c\x37\x2f\xff\x21\xda\x3f\x97\xe9\xab\x82\x06\x3f\xd8\x84\xb4\x65\x27\x48\x69\xa0\xa5\x98\xb0\xdf\x93\x42\x5e\x0e\xdb\xcc\xf9\xbe\x86\x37\x2f\x7f\x84\xb5\x71\x6a\x0c\xc1\x46\x3d\xf5\x18\xde\xbe\xfc\xa7\x08\xde\xe0\xa9\x31\x24\xab\xf8\xd4\x03\x79\xf7\xf2\xaf\x56\x08\x7d\x6a\x1c\x55\xbd\xf5\xc9\x06\xf1\xfe\xe5\x5f\xd4\x70\xf2\x7d\x14\xd2\x08\x4f\x36\x82\xaf\x5e\x7e\xd4\xad\xdc\x9a\x3f\x9f\x67\x9b\x90\x2e\xd8\x6c\x6f\xb9\x84\x3c\xc6\x47\xca\x3c\xf8\x70\xe2\x3c\x3c\xfd\x80\xc5\x94\x3c\x68\x05\x1b\x13\x0a\x3f\xc1\x85\x21\x17\x67\x64\xda\xe9\x57\x08\x12\x83\x6e\x46\x3c\x3b\x8a\x5f\xc8\x42\x94\x58\x1d\xb3\x44\x29\x32\x04\x42\xcd\x21\x78\x36\xeb\xcc\x58\x07\xc0\x31\xe1\x6d\x08\xaf\xdb\x9c\xff\xa5\xf6\x70\x1c\xa0\x40\x2a\xa2\x70\x63\x08\xfd\x5b\xa3\x9f\x79\xa6\x05\x5e\x61\x8d\xea\x78\xa2\x19\x82\x5b\xb5\xc2\x8e\x0b\xe0\x8a\x0c\x0f\x0d\xaa\x48\x93\x1a\x63\x63\x94\x22\x65\x18\x72\xd9\x38\xbb\x93\xfa\x2c\x66\xe5\x8f\x2f\xff\x97\x34\x4a\xf8\xcb\x2f\x6e\xad\x24\xe5\xad\x66\xde\xda\x5c\x71\x96\xde\x97\xf3\xdc\xfa\x19\x67\x95\xdd\x8e\x53\x45\xd8\x32\x0f\x6d\x0d\xe1\x5e\x5a\xb8\xd1\xeb\xc1\x26\x8c\x59\x8a\xf3\x31\x9c\xef\x24\x8e\x33\x46\x93\xb1\x1f\x39\x8a\xb0\xb7\x91\xa9\xcd\x40\xd5\x38\x3d\x6d\xd9\x4d\x33\x26\xa5\x93\x8e\xf0\x38\x70\x97\x42\x21\x6b\xd4\xe2\x16\x66\x0f\x69\x1b\x5a\xf8\x38\xb6\x00\x8e\x09\x77\xa1\x75\x8d\x84\x19\xc1\x53\x4f\x45\xa4\xdb\xb0\x69\x20\x21\xf2\x2d\xe0\xf9\x09\xa7\x25\xdc\xab\x17\x67\x5e\xee\x87\x97\x9f\xb0\xc7\xb0\x32\x0d\x90\x8d\xb3\x43\x73\x07\xd5\xb4\x83\x81\xc3\xf5\x15\xfb\x07\xf0\x78\x03\x32\xa8\x33\xc1\xb3\xb4\x22\x9c\x5b\x51\xa2\xfe\xc3\x0c\x48\xe3\xc3\x18\x7b\xb1\x50\xba\x20\x3b\x34\xbf\xcc\x11\x96\xce\x15\x1b\xae\x71\xe0\x1f\xc2\x57\x50\x66\x09\xaf\xd5\xdf\xc9\xc2\x00\xab\xda\x7b\x49\xab\x90\xdc\x17\x42\x51\xec\x85\xf5\xc8\xc2\xd6\xf3\xdf\x06\x01\xc4\xa2\x89\xf2\xf3\x4f\xc4\x73\x11\xd6\xe3\x2a\xb1\xda\xa2\x98\x2a\x40\x68\x40\x01\x86\x30\x77\x2f\xd9\x0c\x3e\xec\x34\x65\xe2\x08\x30\x9d\xbe\x98\x64\x33\x61\x24\x84\xd1\x20\x5a\x77\x1c\xd5\xdb\xac\x35\xd8\x4a\x0e\xa2\xf8\xb3\xd8\xb4\x29\x2f\xef\xc5\xe4\xcc\xcf\xf1\xbe\xb1\x71\x25\xf1\x7c\x9f\xd4\x74\xeb\x8d\x93\x54\xbd\xa5\xdf\x16\xba\x61\x3d\xbc\x9f\x84\x36\xe4\x91\xdf\x25\x82\xa5\xd7\xc7\x62\x3f\x97\xc7\x02\xd8\x9a\x55\xa6\x27\xa6\x80\xa6\xa6\xf6\x83\x4c\x23\xc7\x16\x32\x1f\x69\x4e\x70\x08\x3b\xd1\x3a\xb6\x86\xd2\x60\x4c\xda\x0c\x9e\xd5\x3a\x2d\x15\x8d\x49\x2b\xbb\xbc\x32\xb0\x15\x0b\xcf\x0b\xae\xd2\x17\x31\x29\xc6\x8b\x06\x31\x44\x89\xb7\xc2\xc9\xad\x86\x7c\x03\x05\x4e\x21\x7c\x99\x76\x46\x4f\xf5\x18\x72\x8f\x50\xec\x1d\xa0\x07\x28\x99\xf0\x61\xaa\xc7\xb2\x7e\x39\x61\x11\x96\x56\xfa\x1d\x80\x32\x81\x03\x10\xdf\xf8\x9b\xcc\xc4\x8c\x34\x27\x70\x12\xb8\x74\x62\x63\x67\xd2\x1c\xbe\x7b\x39\xb2\x8d\x60\x8f\x64\xad\x6b\x85\xa3\x25\x6b\x2d\x9c\xb1\xc0\x0a\x08\x0d\xcc\x7b\xa1\x19\x04\x68\x8e\x78\xa6\xa9\x3b\x68\x14\x4b\x10\x52\xa4\x38\x0d\xa8\x0f\xd4\xe5\xe6\x0a\xd8\x3c\x40\x1d\x31\x92\xc0\x38\x44\xb6\xc2\xd6\x00\xbb\x26\x84\xd5\x70\xd3\x9c\x3b\xc9\xef\x88\x3a\x03\xc2\x8f\x40\xa7\x39\x8c\x0d\xb0\x1c\x79\x81\x1b\xc2\xd6\x17\x6b\x1d\x46\x00\x5f\x3e\x83\x9e\x9f\x8f\x5e\x70\x52\x47\x3c\x94\x90\x80\xc2\xb8\xb5\xb7\xa6\x0f\x66\x4b\x38\x14\x12\x74\x8d\x95\xeb\xf0\x3d\xa1\x2e\xe3\xa7\x70\xca\x31\x4a\x26\xed\x05\xd1\x12\x77\xe8\xcc\x34\xac\x72\xcf\x29\xc4\x7a\x16\xcf\xf6\x3d\x38\x2c\x2b\x20\xe0\x16\x5c\xbb\x8c\xc2\xf6\xa6\x78\xf4\x57\xec\xc7\x70\xdc\x73\xba\xaa\x74\xe5\x2d\x01\x74\x83\x66\xc6\x99\x89\x81\x19\x35\xd4\xde\x42\x57\x95\xd9\x84\xa1\x37\x42\x29\xfc\x4e\x12\x8f\x80\xce\x72\x3a\xcf\xf0\xed\xae\x07\xe0\xba\xe5\xda\x4b\x6c\xa1\xdd\x00\xec\x76\x52\x5a\x39\x1f\xe8\xa5\x30\x6f\x4e\xef\xec\xd1\x02\xbd\xa4\x10\x20\x5d\x9e\xa9\x68\x7a\x57\x65\x0e\x13\x8c\x57\x9b\x44\x52\x10\xc0\x32\xbb\xbe\xd1\x1a\xe2\xd5\xc6\xaf\x3b\xbc\xb6\x96\x0a\x39\x45\x8b\x5a\x15\xa5\x00\x7e\xe9\xdc\xd4\x94\x01\xe8\xdc\xec\x3c\x5a\x08\x7a\x61\x76\x1e\x36\x35\xe5\x92\xc4\xe3\xa8\xe0\x10\x3d\x8b\x6e\x2f\x62\xdf\xb9\xc7\x7f\xdc\xe8\x17\xab\x83\x57\xbc\x4d\x48\x24\xd9\x46\x2c\x7e\x9d\x45\x08\x3d\xf7\x38\x8f\x18\x44\x33\xe4\x8a\xc7\x83\x9a\x54\x2f\x6c\xdd\xeb\x0d\xd1\x02\x94\x50\x8d\x4d\xcc\x1b\x58\x53\xcc\x78\x80\x5e\xa0\xaf\x63\x3d\x57\x74\x23\xa3\x64\x1b\x2b\x06\xab\x6c\x1d\xf2\xd2\x39\x2b\xef\x9e\xe2\xf7\x0b\xe2\x86\x5f\xf8\x55\xbe\x79\x5f\x59\x9a\x8b\x6f\xf2\x7c\x16\xa0\x0c\xd8\xfe\x2b\x0f\xf0\xf8\x79\x80\x82\xc3\xe5\x44\x26\xe0\x82\x3d\x23\x39\xd7\xac\x85\x83\x3e\xe4\x72\x13\x56\xb9\xbd\x10\xef\x82\x5d\x6b\xf9\x91\x3e\xc2\x1d\x06\xc5\x35\xe4\x0f\x9e\xd9\x74\x65\xe3\xf8\xb9\xc5\x76\x3e\x73\x51\x2e\xb6\xff\xdc\xb9\x8b\xff\xad\xd2\x15\xa5\x09\x9a\x1e\x80\xa8\x8b\x4d\xc4\x23\x57\x2f\x96\x6c\xa0\x83\x71\x38\xad\x4d\x3c\x4d\x65\x2c\xcd\x5b\x18\xc0\x47\x7f\xa7\x78\x37\xd5\x86\x78\x68\x9b\xd3\xbd\xeb\x8e\x17\x73\x16\xa7\x8a\x8c\xb7\x6c\xa3\xa0\x2b\x04\x14\xb2\xc2\x43\x9d\x90\x2b\x0d\x1b\x1e\xff\x5e\xb3\x4a\x4c\x1c\x67\xac\xb2\xd8\xce\x31\x4d\x8d\x8d\x21\x14\xd5\xc6\xe2\xd5\x32\x66\xb9\xb8\xe4\x4c\xc0\x2c\x4b\x95\x04\x6a\x81\xbb\x1c\x29\x53\xa0\x3c\x4f\xc1\x59\x30\x6e\x96\x55\x67\xa2\xdf\x4c\x01\x73\xf8\xf3\x96\xcb\xb3\x05\xd2\x4a\xd4\x94\x5a\x97\x13\xc1\x18\x3c\x53\x88\x69\x88\x22\xb1\x35\x40\x2e\x67\xb2\x81\xa0\xa8\xe2\x14\x2a\x0a\x3d\x92\x6a\x88\x1a\xa2\x18\x60\xea\x82\x5a\x65\xaa\xd2\x0f\x56\xe5\x9b\x62\xe0\x34\x6e\xbe\xae\xf5\x90\x5e\xb1\x1f\xd0\xe8\x91\xc7\x5a\xb6\x5f\x8f\x7b\xae\xeb\x4a\x42\xfb\xa1\xf4\x04\x1e\xd8\x3a\xf6\xe1\x6c\xe7\xd8\x87\x49\xe3\xd8\x87\xd4\x37\x96\x3f\x7d\xd7\x7e\x98\x6b\x1c\x9b\xef\x92\x3e\x9d\xb2\x7c\x33\xfd\xe8\xed\xf4\xa3\x77\xc5\x47\x67\xed\xc3\xd6\xf2\xae\x13\x2d\xfb\x48\x14\xec\x8b\xd0\x09\x8f\x4c\x9d\xbe\x62\x3f\x9c\x01\x8d\x81\xb7\x80\x76\x56\x89\xf6\xb1\xf5\xfe\x46\xf0\xaa\xc8\x90\x8f\xc4\x52\x51\x4d\x85\xd7\x7a\x2a\x05\xe3\xea\x8c\xa8\x4a\x8d\xa6\x06\x5d\x78\x6a\x9a\x70\xb8\x8a\x4a\xca\xd3\x84\xb4\x37\x4d\x33\x58\x6a\xac\x2a\x79\xfa\x65\x07\x74\xfa\x3d\x4a\x33\x26\xd7\x49\x67\xd7\x60\x2d\xa6\x82\x2d\x6f\x0b\xc1\x96\x8c\x32\x20\x26\xe8\x31\x7d\x6a\x02\xe4\x12\xff\x44\xba\x3d\x52\x94\xa2\x25\x3b\x80\x3b\x60\x06\xb0\x50\x98\x93\x71\x11\x87\xd9\x58\xd9\x03\x61\x20\x36\xbd\x00\xf6\x23\x6c\x77\x15\xae\x1f\x05\xa1\xc8\xe2\x2f\xa3\x52\x4d\xd3\x52\x50\x17\xd0\x14\xad\xea\x31\xba\x3e\x1c\x06\xd4\xbe\x92\x8b\x26\xd4\x78\x85\x99\xeb\x28\xd5\xc3\xb5\x26\x03\x9f\xf2\x40\x94\x74\xa9\x2e\xb9\x91\xc4\x58\x4d\x17\x80\xfc\x38\xa0\xd9\x5d\xd2\xeb\x2a\xf8\x8a\x5a\x78\x64\xb4\xf0\xf9\x64\x8b\x9a\xd0\x60\x27\x31\x39\x54\xdd\x24\xf6\xcc\xd1\xd2\x68\x2f\x5b\xf1\x9f\x6b\x94\x49\x9e\xa3\x78\x29\x97\x18\x5f\xd0\x20\xb7\xc2\xa3\x8f\xc9\x31\xf5\xaa\xe4\x5d\x66\xc2\x95\x79\xf5\x24\xf6\xca\xbd\x74\xe4\xa4\xf0\xa1\x85\xf5\x96\x6e\x42\xfe\x20\xbc\x82\x19\x4b\x1d\x5b\xe3\x47\x9a\x2b\xb0\xc8\x80\x50\x76\x7e\x8b\x85\x33\xd6\x09\xb5\x29\xd5\x37\xc2\x5a\xe3\x36\xfc\x79\x83\x7b\x0c\xe9\x73\x0a\xe6\x3a\x0a\x66\x23\x97\x5b\x6b\x0c\x52\x67\xe7\xbb\x45\xe1\xee\x2c\xde\x64\xe2\xd4\x21\xdb\xe5\x61\x94\x0f\x75\x9c\x48\xc0\x1a\x63\xad\x68\x3c\xe1\xea\x2d\xeb\x77\x96\xbb\xb3\xa7\xc7\x4f\x51\x69\x38\x89\xd1\x90\x0d\x20\x3c\x4f\x38\x4d\xac\xd8\x0b\x0e\x61\x1a\x85\x48\x95\x06\xcf\x5a\x34\x9c\xc8\x4c\xc2\x4a\x9e\x24\xbe\x41\xaf\x72\xe4\x1a\x03\x45\xc3\x69\xcd\xa1\xff\x9f\xbd\xb7\x6d\x8e\xdc\xb6\xd6\x45\xff\x0a\x2a\x5f\x66\xe6\xa6\x35\x47\xd2\x48\xb2\x93\xba\x75\xaa\x14\x4f\xec\xb8\x6e\x66\x3b\xd7\x33\x89\x2b\x1f\xd1\x24\xd8\x44\x44\x02\x34\x40\xaa\xa7\xfd\xe9\xfc\x96\xfd\xd3\xf6\x2f\xb9\x85\xb5\x16\xde\x48\x36\x9b\x2d\x69\xec\x9c\x7b\xf6\x87\xbd\xe3\x51\x77\x93\x20\x08\x2c\xac\x97\x67\x3d\x8f\xa4\x1e\x3b\x95\xa0\x37\x7c\x9d\x21\x97\x00\x0a\x4c\xef\x31\x3c\x78\xbe\x3e\x51\x32\xb3\xa5\xb4\x85\x30\xe8\x80\x25\x5b\x0c\x08\x3a\xfd\x78\x46\x11\x46\x4c\xd4\xc7\x38\x4e\x26\x22\xc9\xea\xc0\x94\x96\xd0\x72\x44\x32\x9d\x64\x97\x6a\x24\x9f\x3b\x2a\xe4\xb4\x78\x10\x02\x8a\xf2\x7b\x77\x9b\x55\x78\xa5\x73\x8e\xc0\xdb\x4b\x22\x7b\x3f\xdd\x62\x8d\x90\x4f\x23\xba\x46\x16\xdc\x6b\x42\xb6\xbc\x24\x32\x66\x0f\x2c\xb5\x63\xce\xac\x5b\xb6\xeb\xce\x6f\xc6\x2e\xf9\xe1\x57\x38\x4f\xb5\x39\xb0\x42\x77\x87\x7c\xc7\x47\x94\xe8\x9c\x4a\x98\xc0\x5f\x80\xd7\x4a\x86\xa1\x87\x37\x1f\xe8\x6e\xa6\xc7\x6c\x00\xd2\x59\x01\xad\xb9\x15\x55\x85\x77\xdc\x94\x00\x92\xd6\x15\x6e\xd2\x47\xd4\x02\xd4\x5b\xdb\xf3\xa2\x11\xe3\x53\xb7\xd1\x9a\xa4\x34\x69\x5d\x81\x3a\x03\xf0\xd5\x0d\x24\xc9\xd3\x73\xb5\x0b\x24\xae\xb9\xa5\x19\xb1\xe1\x8f\x4e\x8e\x73\x15\xb7\xfe\x39\xc6\x2b\xc6\xfd\xde\xea\x47\x4a\x8c\x84\x9b\x13\x00\x16\x6a\xe2\x7e\x6b\x0a\x82\x19\x42\xf2\x40\xf6\x6c\x27\x2c\x3a\x32\xd4\x6e\x0a\xaa\x0e\x81\x8b\xdc\x6d\x4a\xf1\x28\x80\x85\x3c\x29\x8a\xa2\xb7\x21\x5b\x59\x58\xbc\x30\x9e\xa5\xd2\xb6\x78\x4a\x8a\xa2\x6f\x16\xbb\x9b\xff\x99\xd4\x76\xa3\x5d\xb3\x4c\x1c\xc8\xbf\xa8\xb1\xa4\x97\x7c\x02\xe7\x6c\x08\x87\x40\x29\x8c\x08\xbd\x2c\x2a\x22\xfc\x90\xe8\x2c\x21\xd6\x1d\x25\x4b\x3d\x81\x4c\x28\x13\xef\xa1\x4f\x00\x36\x10\x76\x70\x20\x09\xb8\x22\x3e\x3f\xfc\x1b\x5c\x4a\xef\xb1\x1d\x7e\x1b\xd8\x9d\x7c\x06\x2a\x30\xf5\x8e\x2e\xb0\x09\x9f\xf8\x9a\x1e\xc4\x7d\x82\x57\x02\x09\x4b\x69\x01\x06\x0a\x25\xbd\x57\xa9\x80\xe5\x32\x09\xf9\x7f\x9f\x2d\xff\xbf\x38\x5b\x48\x1e\x18\x97\xe0\x9f\xa1\xe5\x77\x15\xc1\xd5\x39\x20\x92\xa7\x37\x34\x3f\x55\x8c\x79\xcc\x6b\xf5\x5e\x58\x61\x8e\xf1\x5a\x85\xbf\xfd\x43\xa8\x9d\x70\x21\x42\x42\x76\x35\xc6\xd8\x2e\xb4\xf9\xcc\x1c\x39\x53\xcd\x17\xc8\xfc\x8e\xb9\x39\x42\x2b\x35\xd4\x35\x73\x98\xba\x56\xb1\x64\x40\x70\xef\xb4\xad\xed\x62\x19\xc7\x0e\xf7\x07\x18\xfb\x93\xd6\xc3\x23\x74\xac\x94\xec\x3b\xad\xa7\xb2\x48\x57\x5f\x78\x59\x80\xdf\x51\x6b\xe7\x13\x23\xa5\xa3\x61\x9d\xde\x97\x80\x8f\xb1\xb2\x79\x24\xbc\xb1\x34\x6e\x5e\x9f\x40\x72\x3d\xbf\xb8\x4e\x25\x00\xfd\x02\xa3\x55\x93\x2c\x9f\xf7\xe2\x51\x83\xb5\x38\xa7\x37\x6c\xae\x99\x71\x86\x5f\x76\x03\xeb\x60\x61\xe1\x60\xe3\x7b\x8f\xe5\x12\x6f\xa7\x0a\x61\x7a\xee\x42\xcc\x43\x87\x0d\x91\x31\xe1\x7c\xc1\xf8\x56\x18\x7c\x3e\xbb\x89\x5c\xdb\x76\x13\x99\xb6\xed\x06\x89\xb6\x81\x63\x9b\xfc\x03\x6c\x61\x3c\xa9\x7d\x1a\x17\x12\xb0\xb3\x59\x40\x84\xb8\xf8\x2f\xb0\xb0\x25\xdc\xfb\x79\x27\x04\x3a\x4a\x63\x99\xfe\x59\xb9\xfc\x3e\x51\x39\x4c\x49\x4a\x1a\xab\x93\xc2\xcb\x1c\xc5\x00\xac\x25\x37\xed\x36\x50\xa9\xb4\xe3\x36\x62\xd0\x3c\x30\x82\x97\x87\x75\xd7\xc0\x86\x9c\x11\x0e\x2a\x69\x49\xce\x9f\xc7\x99\x73\xb1\x1f\x95\x4a\x92\xe7\x32\xa2\x11\xee\xfb\xe7\xf4\xa1\x8f\xb6\x2f\x32\x89\xbd\xb8\x36\xff\xd3\xcd\xf9\x39\x96\x3b\xec\xaf\xa3\x8a\xf9\xff\x0c\x8b\x9f\x27\x72\x7e\xdf\x7b\xe1\x2e\x2c\x75\xbb\x17\x05\x79\x73\x31\xf4\x06\x74\x5b\xb1\x92\x08\x1f\xc2\xb7\xa3\xb0\x97\xdb\x62\x54\x20\xe7\x55\xd5\xc8\xa2\xb7\xc9\x3b\x9c\x5c\x47\x2b\xff\xed\xe0\xe3\x03\x51\x2a\x6c\x45\x61\x41\x9f\x82\x62\xff\xec\x57\x90\x5d\x46\x8a\xcc\xf3\xf4\xc2\x96\xd6\x53\xba\x8e\xe2\x06\x41\xc2\xbd\x38\x13\x44\xea\x32\x3d\x65\x8e\x90\x99\x2d\xac\xb5\xc1\xc8\xea\xc0\xbe\xd5\x1a\xdd\xca\xf7\x46\xaa\x87\x55\x47\xc4\xa7\x33\x94\xf3\x7e\x23\x16\xbb\xf9\xa5\x38\x57\xf5\x01\xae\xc7\x00\xeb\xa9\xfc\x6c\x94\x6e\x36\x22\xb7\xe5\x2d\xa4\x3c\x2f\xa8\xfa\x42\x28\xd0\x39\xac\xe7\x82\x62\x1e\x2c\xab\x81\xca\xdf\x98\xe4\x53\x78\x16\x56\x54\x97\xf6\x4b\x17\x8a\x3b\x56\x64\xc9\xa1\x85\x17\x79\x00\xab\x51\x2b\x17\x40\xcd\x36\x01\xce\xd9\x8b\x97\x25\x71\x7b\x61\x02\x8b\x63\xb0\x62\xcf\xaa\xab\x48\xa7\xb6\xa2\x62\xcf\x84\x1a\x20\xf4\x8a\xfa\x4e\xd2\xd1\x9b\x44\xb5\xf1\xa9\x9e\x21\xd2\x64\xf6\x52\xed\x06\x69\xa9\x86\x25\x31\x38\xc4\x6c\xbb\x11\x3c\x69\xe6\xc4\x5c\x04\x15\xf7\xdc\x17\xf7\xda\x3c\x20\x57\x52\xab\x1f\x16\x53\x7b\xdf\xfa\x6f\x67\x70\x74\xf1\xb9\x6b\x74\x29\x02\xbb\x7b\xc9\x7f\xf9\x05\x1c\x86\x52\xda\xae\xe1\x07\xd4\x5f\x6b\xb4\xb1\xe3\xee\x2c\x7a\x96\xab\x3c\x6f\x7a\x54\x68\xf4\x1c\x20\xe9\x33\x3a\x6d\x3f\xba\x69\x70\x4f\x28\x8b\x07\xb6\x6d\x9c\x03\x80\xea\x8c\xb6\x73\xe7\x32\xca\x0f\x04\x05\x46\x7f\x6c\xbb\x01\x5e\x67\x05\xcf\x8d\xe7\x4b\xe5\xa8\x57\x59\x68\xa3\x84\x2f\x69\xf8\xb5\x00\x0e\x17\x6a\x3f\x5a\x17\xf3\x3f\xca\xe6\xc0\xf4\xd6\x16\x83\xf1\xa0\x64\xfc\xb8\x13\x06\x81\xae\x29\x44\x36\x32\xd5\xe5\xf2\xd5\x50\x1d\x32\x18\xb6\xfe\xc6\xbd\x8f\x3f\x72\x17\x3d\xbe\x17\x7c\xea\xca\xcf\x09\xd5\xcc\x12\xd4\xcc\x0a\xd5\xac\x64\xd7\x2f\x25\x6f\xb5\x9a\x70\xea\xdf\x3e\x5d\xa6\x66\xbd\x75\x18\x6b\xd5\xfc\x55\x56\xe2\xcd\x92\x65\xff\x27\xd4\x53\x31\x7f\xc3\x80\xb5\x63\xc6\xe9\xee\x35\x6b\x64\x25\xc6\x82\xd6\x74\xc2\x6e\x85\x50\xf8\xd3\x08\x2e\xf1\x02\xa2\x25\xf4\x89\x8c\xe8\x8b\x5e\x41\x48\xdf\xa0\x04\x4b\x5f\x07\x77\x1f\xc2\xff\x9e\x35\x72\x2b\x0c\x76\xff\x1a\x01\xd2\x47\x80\x91\xd1\xe5\x58\x9c\x26\xe1\xd2\x73\xc3\x43\x6b\x90\x10\x4a\xae\x5c\x87\xe0\x46\x47\xcf\x05\x53\x87\xc9\x21\x53\x80\xf3\x9e\x1c\x7f\x74\xea\x50\x41\x26\x0a\xb0\x67\x0a\x84\x09\xb4\x1e\x69\x98\xca\x8c\x33\x85\xe8\xf3\x36\xac\xd6\x7b\x17\x32\x6c\x98\x11\x90\x4e\x1c\xdf\x64\xe3\xee\x6f\x89\x0f\xc2\x63\x5a\x08\x94\xb2\x21\x41\x14\x0b\xbb\xdb\xc5\x01\x28\xd6\x83\x97\x2a\x59\x67\x24\x4a\xb0\x78\x1a\x97\x3e\xb2\x49\x03\x3d\x3c\xb4\x6b\x13\xb8\x78\x3f\x51\x51\x1c\xcd\x6f\x0a\x93\xc0\xa8\xc3\x2f\x9c\xc0\xf8\x92\x52\xbd\xc3\x4f\x56\x5a\x02\xe0\xa5\x67\xd8\x11\x00\xf8\xc8\x3d\xa4\x7c\x82\x7a\x62\x24\x1b\xb4\xbd\x73\x63\x5b\x69\x21\x09\xe8\x16\x05\xe8\x52\xcc\x53\xa4\x35\x28\x8d\x9e\x7c\x0d\x78\xf2\xcc\x8e\x43\xaf\x48\x2f\x76\x86\x18\x22\x20\x9b\x38\x98\x47\xf9\xc8\x51\xb8\x1f\x4c\x63\x89\xf2\x06\xc8\x5e\x00\x49\x88\xb8\x6d\x73\x8e\x50\x00\x6c\x2d\x3d\xed\x37\xba\x0d\x4a\x65\xc1\x92\xc3\x9c\x61\xc2\x4e\x9b\x52\xf0\xe6\xed\xb4\x1f\x93\xb3\x8b\x1b\xd6\x09\xc5\x1b\xdc\x12\x3c\x72\xdf\x43\x90\xb8\xc9\x5d\x62\x4a\xa2\x11\x5d\x0f\xa4\xf3\xdc\x21\x08\x64\xd8\xa1\x4d\x9d\x6e\x10\xaa\xac\x1c\x79\x1e\xdd\xec\x92\xb8\x1d\xdd\x0f\x40\x97\xe5\x40\x3c\x9c\x57\x64\xf9\xb3\x74\xfa\x1a\xdf\xeb\x47\x6e\x0e\xaf\x2c\xfb\x24\x1a\xd1\xf1\xbe\x96\x05\xfb\x93\x56\xeb\xac\xf3\xfb\xe7\x7b\xd1\xa7\xf5\xa7\xc1\x70\x77\x52\x14\x18\xa4\x8b\xdd\xce\xd6\xd0\xd6\x03\x2f\x6a\xaf\x93\x6e\x8e\x07\x09\x34\x8f\x49\x8a\x61\xc1\x6e\xaf\x50\xaf\x3e\x62\x92\x2b\x6d\x76\xc2\x8b\x4e\xe0\x94\x81\x7a\x89\x3b\x59\x76\x27\xa4\x4e\x16\x9c\xeb\x8d\x57\x46\x87\x45\xeb\xae\x08\xfc\x5a\x99\x8b\x44\x6b\xcc\x2d\x03\x2a\xfe\x4e\xab\x43\x31\xa7\x01\x36\x37\x4b\x29\x23\x8b\xab\x1b\xc5\x75\xa0\x98\x25\xeb\x94\xb7\x88\xac\x91\xb8\x9a\xcf\x11\xc5\x35\x6c\x3d\xbb\x5e\x90\x1f\x8d\x33\x06\xcf\x98\x66\x99\xb7\x70\x2a\xd7\x02\xfc\x50\x6d\x98\xd2\x3d\x9a\x41\xcf\x64\xab\xdb\x16\x38\x7a\xd5\x6e\x88\x32\x55\xe1\xda\x04\x07\x09\x1c\xfd\x1a\x33\x73\x07\xb7\x19\x7a\x4e\x7a\x1f\xbe\xb2\x8f\x06\x92\xf8\xbe\x7b\xcd\x12\x7c\xa6\x3d\xa2\xda\xb0\xb4\x7f\xc0\xa5\xfd\xb3\xaa\x84\xd8\xa2\x87\xb4\x2a\x7a\x79\x41\xee\xbd\x17\x67\x31\xf1\x1e\xca\x7b\xc1\xfb\x7a\x9a\x4a\x9c\x85\x1e\xa2\x6b\xbc\x15\xbc\x85\x29\x54\xc2\x3c\x22\xad\x31\x71\x78\x7a\x24\x4a\xa4\x79\xa8\x24\xba\x23\x13\x9a\xf5\x3c\xa8\x39\x8e\x70\x9b\xcd\xc1\xcd\x82\xde\x4a\xa8\x01\x45\x25\x4f\x4a\x66\xc3\x06\xd9\x0b\xde\x85\xe4\x9e\x0d\x35\x1e\xd0\xc5\x51\xbb\x0c\x90\xbd\x5a\x6b\x6b\x06\xd0\xde\xa7\xc4\xb2\x70\x76\x07\x7e\xd0\xd3\x10\xf9\xe4\x39\x09\x6e\x98\x72\xf3\x4d\xc0\xef\xcf\x17\x1b\xa1\x55\xfd\xad\xd1\x76\xba\x9c\xe7\x90\xb1\x67\x11\x94\x7c\xe9\x68\xfc\x8c\x82\xc9\x59\xd5\xfb\x7b\xc8\xbd\xca\x32\x2c\xf3\x6d\x33\x88\x8b\x7d\x2d\x7b\xe1\x31\xf2\xbd\x11\xdc\xad\xa2\x2f\xb5\xa8\x65\x8a\xe0\x4c\x1a\x49\x7c\x5e\xcd\x92\xb6\xfe\xc8\x3b\xa0\x98\x3a\x59\xca\x47\xa0\xf0\xa7\x88\x26\xff\x4f\xc4\x81\xd2\x66\xf8\x28\x8b\x07\x25\xac\x5d\x95\x61\xfc\xb5\xcc\xfb\x39\x82\x3b\xde\x9c\x4f\xf8\x03\xd9\xeb\xbf\xab\xf2\x20\xd5\xee\xcd\xa2\x89\x37\x38\x0f\x56\x16\x0f\x08\x11\xd9\x19\x21\x40\x53\x8a\x6c\x7c\x43\x3c\xcd\xd0\x1f\xfd\xe5\xad\x3a\x6e\x84\xeb\xf2\xeb\x51\x4b\x19\xe0\x39\xd6\x50\xa0\x1e\x97\x1f\x85\xb8\xd3\xe7\xac\x9f\x93\x3e\x7a\x36\x7d\xe6\xb5\x3a\x42\x9f\x79\x6c\x0b\x1e\x67\xcf\xbc\xb2\xfd\xb9\x3b\xeb\x7a\xdd\x06\x11\x08\xad\xec\xd7\x81\xc3\x66\x13\xb7\xde\x21\x79\x5a\x5e\xa7\x43\x5e\xd9\x7d\x2d\x04\x56\x8b\x63\xcd\xf6\xa9\x31\x40\x9e\xb4\x59\x22\x76\x1b\x17\x80\x10\x1c\xd5\xcb\x76\x68\x9c\xd7\x0b\x44\x7b\xee\x03\xde\x04\xce\xd3\x20\xee\x3a\x43\x5b\x7e\x03\x3c\xaf\x57\xb7\x19\x6f\xf9\xb8\x12\x93\xf5\x34\x67\xbb\xc2\x5f\x26\x55\xef\x18\xf7\x3f\x4d\xba\xec\xd8\xeb\xab\x8c\xab\x18\xbe\x80\xaf\xe4\xcd\x6a\xba\x7c\xa8\xaf\xba\x03\xc7\x85\xf4\xa8\x7d\x6d\xd9\x6b\x74\xf3\xec\x86\x35\x62\xe7\x5c\x95\x48\xc7\x00\x6d\xe2\x6f\x50\x15\x5c\x1d\xbc\xb6\x04\x64\x11\x4a\xd2\x11\xbf\x8e\x80\x61\x22\x89\x08\x5c\x70\xfe\x6e\x9d\x7b\x26\x7a\xe5\x25\xe1\x7c\xf0\x61\x87\xb6\x4b\x5d\xa3\x09\x29\x26\x1f\x2c\xc9\x48\x34\xb2\xdd\x82\x10\xb2\x1a\xfd\x7e\xb2\x5f\x6e\xca\xaf\x7f\x7f\x75\xbb\x6a\x4f\x48\x55\x70\xa3\xe6\x36\xc5\x5c\x30\x3d\xbb\x29\x9e\x9e\xea\x34\xa0\x42\x2b\xa9\x61\x6a\x50\xbb\x01\xa4\xf8\x5f\x52\xa0\x7b\xb5\x16\xd3\x89\x6d\x03\x99\x96\x7a\x68\xb9\xd2\xb2\xc4\xfe\x30\x88\xf7\xb1\xcf\x31\xfb\xf4\x2d\xfb\x5b\x96\xe5\xcc\x92\x4a\x6b\xf2\x9d\x19\x31\x08\xf1\xb6\x28\xb1\x67\xbc\x1c\x9a\x1e\x17\x2e\xa6\x9c\x3c\x10\x51\x31\x17\xab\x52\xa7\x8f\x1e\x80\x88\x19\xc5\xb5\x61\x00\xee\x17\xa3\x9a\x7d\xcc\xa0\x42\xd2\x0f\x0a\x63\x26\xa4\x02\x7a\x9d\x32\x1e\x26\x0c\x25\xa7\x76\x19\x31\x0f\x70\x5b\x03\xde\x1c\x87\x1d\x06\xdc\x8f\x14\xa1\xa5\xaa\xf9\xd6\x1d\x97\xf8\x5e\x1b\xf9\x20\xf2\x15\x9f\xe4\x7b\x0d\xc7\xfa\x2b\x2a\xec\xa0\x49\x7a\xff\xc1\xc3\x1c\x58\x79\x75\x79\x89\xb9\x56\xb7\x24\x1a\xaa\x47\x47\xf6\xde\xde\x6b\xf3\xa7\x4c\x78\xbc\x27\x7d\x91\x69\x0b\x34\xb8\x88\x61\x9b\x87\xf4\x35\x3d\xc4\xfb\x0f\x84\xa9\x74\xb7\x06\x9d\x8d\x85\x79\x81\xa1\x5d\xb0\x1f\x79\x21\xfe\x38\xfa\xda\xff\xbc\xbc\xba\xb8\xbc\x61\xef\x0d\xdf\x69\xb5\xd5\x46\x4d\x3e\xbf\xbd\xb8\x7a\xc7\xde\xef\xb9\xa9\x36\xac\x06\x91\x84\xfc\x0b\x57\x37\x17\xd7\x57\xfe\x0b\x20\xa8\xc6\xe5\xe4\x2a\xd7\xd7\x17\xd7\xb7\xec\xcf\x4d\xb5\x61\x25\x37\x0f\x93\x8f\xef\x2e\xde\xdd\xe0\xc7\xee\xe8\x1e\x7f\xfc\xee\xf6\xe2\xe6\x1a\x3f\xde\x03\xba\x29\xff\xf8\xe6\xdd\xc5\xcd\x1d\xfb\x4e\x41\xcf\x66\x05\xb2\x88\x93\xaf\x7c\x75\x71\x7b\xed\xbf\x62\x74\x31\x19\xc1\xed\xbb\x8b\xdb\x3b\xf6\x17\xde\x54\x17\xd0\x8f\x38\xfa\xf4\xab\x8b\xbb\x4b\xfc\x54\x9b\x62\xfc\xe9\xdd\xd5\xc5\xdd\xd7\xf0\x69\x03\x40\x2e\x08\x71\x2a\xad\x27\xa3\xb8\xfb\xc3\xc5\x57\x77\xc9\x17\x6d\xaf\x87\xc9\x97\xbe\xfa\xea\xe2\x0f\x77\xec\x2f\x6e\x27\x8f\x3f\xfa\xc3\x57\x17\x97\x97\xec\x93\x14\x15\x52\xc2\x2f\x6e\x04\x13\x0d\x6b\x99\xe6\xc3\x71\x9f\x4a\xac\x89\xb5\xc2\x60\xdd\xc1\x2d\x5c\xf1\xb9\x13\x46\x0a\x55\xc0\x31\x12\xc5\x88\x60\x71\xf2\x0e\xcf\x62\x29\x82\xb8\x0f\xe1\x68\xb5\x8b\xf5\x54\x90\x0a\x12\x9f\x0b\xd1\x41\xc6\x5b\x7c\xc6\xad\x62\xf3\xaf\x99\x94\xdc\xc8\xed\x4e\xdf\x46\x9e\x7e\xdb\xf0\x42\x02\x81\x30\x47\x08\x69\xa1\x4d\x29\xd5\x2e\x85\x07\x2f\x9d\x29\x50\x86\xf8\x66\x30\x76\x7a\xa8\xfc\x76\x08\xc9\x73\xe0\x0c\x01\x52\xf3\x2c\x78\xda\x3d\xe1\x5f\xe1\x08\xd9\x40\x4e\x14\x2b\x31\x94\xc6\x04\xfc\x7a\x2a\xe7\xa0\x8d\x67\x82\x16\x2a\x6a\x35\xd0\x9f\x80\x60\x14\x7e\x4f\xad\xc5\x4c\xf6\xa2\xdd\xc0\xfb\x82\x3f\xfb\x66\x26\xac\x79\x44\xc3\xbd\xc5\x60\x9f\xf4\x60\xa1\x18\x8a\xaa\xdc\xa0\x6e\x45\x7e\x04\xdd\xc4\x6a\x9f\xee\xd9\x8a\x50\x04\x02\xc1\x30\x5b\x70\x53\xae\xd3\x65\xfc\x31\x00\x66\x56\x25\x65\x5e\x18\x09\xf9\x25\x92\x8c\x6b\x3c\xeb\x39\xd8\x21\x75\xa6\x1d\x91\xf6\x4f\xdc\x61\x37\xe3\x9e\xb0\xf5\x06\x0b\x30\x25\xd6\x03\x49\xdf\xcb\x7d\x88\x07\x91\xbb\x4f\x0e\x24\x40\x0f\x19\x93\xf5\x60\x3a\xc2\xd5\xc0\x17\x97\x61\x00\xce\x6b\xa1\x86\x36\x31\x0a\x31\x63\x61\x0e\xdc\x89\xd9\x04\x5d\x79\xb3\xca\xa3\xb4\x03\xb4\x5f\xcd\xf5\xa1\xce\xc5\x59\xbf\x56\xf5\xfc\x8b\x7b\x91\x69\x28\xb6\xc6\x99\xcc\x01\xfb\xb9\x5f\x58\x41\x71\x21\x41\xdc\x71\x40\x41\x0d\x06\x2a\xd8\xbc\x67\xa5\x2c\x9d\xe3\xe6\x5e\xae\xae\x18\x08\x7a\xfb\xf4\x5a\xc2\xb1\x40\x18\x54\x84\xfa\xc5\x9a\x39\xb8\x7b\xd0\xa4\x87\xeb\x75\x14\x99\xcd\xd4\xc4\x67\x44\xaa\xd6\x15\x66\xe7\xab\xe2\x36\x2b\x8b\x83\xd0\x5b\x28\x89\x13\xa0\xd0\x17\x9b\x63\x66\x86\x84\xf2\xb0\x10\xfe\x7d\xff\x94\x02\xb8\xef\xdb\xc1\x2e\x4a\x59\x11\x12\xd5\xb3\xc9\x63\x8d\x69\x75\xed\x9b\x46\x9a\x4e\x9e\x56\xa4\xa1\x85\x5c\x73\x2f\x52\xc3\x26\x0c\x1b\xb8\xa2\x38\x89\x33\xc5\xeb\xff\xb3\x0b\xc7\x33\x0f\x1d\xde\x59\x2a\x2e\xef\xab\xff\xb0\xb0\x81\x1c\xf1\xd4\x26\x74\xd6\xf6\x00\x42\x0d\x26\x44\x6a\xfc\x33\xf1\x20\x02\x51\x53\x73\x78\xcb\xb0\xc8\xe8\xfe\x84\x0f\x96\x3f\x16\x01\xe6\x5e\xf5\xa9\x2c\x20\x26\x10\x47\xec\xf1\x13\x2c\xf7\x64\xc6\xf3\xe9\xa5\x0c\x45\xfa\x16\xd6\x1d\xd0\x8f\xc2\x39\x0c\xdf\x19\xfe\x28\xfb\x75\xdc\xee\xe7\xe9\xcb\xac\x55\xa3\x05\x10\x5e\x64\x32\x32\xd0\xb9\xed\x4c\xd2\xf9\xed\x08\x2b\x24\x0f\xce\x95\x9f\x35\x38\x4f\x96\xed\x70\xa2\x10\x31\x77\x7b\x99\x82\x54\x37\x51\xaf\xdc\x05\x4f\xac\x38\x00\x9a\x6f\x9e\xa1\x74\xaa\x7f\x9e\x10\xdb\xa8\x92\xbc\x30\x1b\x30\x90\xa0\x28\xa9\x5b\x51\xeb\x3d\xe3\xaa\xa8\x7d\x30\xea\x36\xca\xce\xa4\xb0\x37\x94\x0e\x77\xeb\x65\xe8\x30\xa7\x0d\x46\x83\xd3\x41\xd7\xeb\x2e\x6d\xf1\x9e\xd0\x07\x84\x4a\x7a\xda\x48\x96\x54\xfd\x8e\xf0\x36\xf6\xda\x4d\xcd\x96\x69\x64\x6d\xad\xe4\x67\x51\x06\x7f\x95\xfc\x10\x37\x06\xb7\xb5\x07\x8b\xb2\xb9\xde\x92\xba\xc1\x9e\x10\x0f\x01\x8e\x06\xe4\x38\xa3\x8b\xbe\x8e\x9a\x38\x85\x80\x85\xf2\x06\x14\x69\x55\xe1\x42\x5f\x98\x6e\x49\x1a\xbb\x15\xb0\x22\xba\xff\x8f\x7d\xa2\x38\xb3\x39\x99\xa9\xed\x8d\xc4\x8e\xca\x7f\x0d\xb6\x27\x99\xd2\x83\xb3\xbb\x4d\xc9\xca\xc1\x60\xaa\xdb\x9f\x50\x7a\xaf\x60\x6a\x61\xe4\xc8\x51\x9a\x28\xb7\x24\x41\x9e\x27\xc6\x9c\x99\x77\xa2\x39\x85\x1b\x13\xcb\x31\xb4\xf5\x65\xec\x90\xc4\x51\xaa\x6d\x21\x81\xf8\x51\xed\x98\x85\xb0\xb6\x39\x6c\xa6\x58\x8a\xd5\x45\xde\x1c\x23\x1f\xa0\x14\xf3\x53\x03\x6b\x09\xce\x0c\xf7\xdc\x2b\xad\x8a\x7c\x94\xd5\xba\xae\xb8\x73\x2a\x4f\xab\xfc\x3e\xf2\xfa\x7c\xf2\xf0\xdd\x97\xf5\xf5\x9e\x84\x92\x9c\x64\xe0\xc3\x99\xe3\x7c\x1a\x6f\x1c\xc0\x3f\x71\xbb\x92\x18\x65\xd9\x27\xc0\x57\xaf\x86\x2c\xb2\x1c\x20\x1b\x41\x76\xfe\x17\x47\x07\x90\x3a\x92\x4a\x23\x59\x9f\x8c\x90\xb9\x53\x9e\xc7\xc2\xca\xd0\x1d\xd5\xac\x5f\x1c\x32\xbf\x6a\x6d\x84\x66\xb8\x42\x1b\xc0\x9d\x1b\x5e\x60\xee\x94\xb3\x7e\x2f\xad\xdb\x05\x8d\xc6\xad\xda\x71\x53\xd4\x2e\x32\xfe\x22\xa8\x2c\xbf\x04\x1a\x04\x6d\xe8\x8a\x19\xdd\x45\xa6\x34\x3c\xca\xee\x12\x16\x6e\x17\x41\x66\x1b\x98\xbe\x2f\x14\x33\x12\xc0\xd2\x9e\xfe\x8c\xcb\x54\x01\x7c\x5f\xeb\x86\xbe\x5c\x73\xb5\x83\x0e\xef\x4e\xa8\x52\x16\x43\xc3\x4d\x7e\x86\xbc\x65\x64\x27\x86\xae\x13\x66\x7c\xb3\x0d\x4a\x96\x79\x92\x1c\x38\x78\x81\xd2\xbe\x13\xb8\x0c\x39\x4d\x69\x29\x5b\xa1\x2c\xf2\x62\x21\xed\xf5\xac\x8a\xd4\x5a\x85\x1b\x71\xec\xaa\x21\x3b\xe1\xcc\x2c\x78\x87\x45\x23\xdb\x2d\xe5\xad\x47\x56\xd7\x3d\x81\x8f\x6e\xfd\x8f\xa1\x04\xc3\x2d\x6b\xdd\x8a\xe6\x96\x60\x70\x1f\x44\x29\x87\x16\x7b\x0a\x78\xd3\xa4\xa4\x73\x78\x01\x98\x4c\xba\x79\x37\x40\x38\x1e\x26\x1f\x2e\xbe\x71\xa7\xa5\x3f\xde\xe0\xdb\xc1\x57\x45\xc7\xfb\x51\x8a\x3d\xd3\x43\x6f\x65\x29\xe2\xcf\x96\x0d\x38\xe2\x7d\x78\xa4\x96\x23\x4f\xd2\xe8\x11\xd1\x6b\x78\x31\x9e\x05\xda\x9d\x33\x34\x0b\xf3\x33\xe9\x13\x45\xda\x82\x54\xac\x1b\x94\xef\xeb\xa0\xdf\x22\x6f\x19\x40\x84\x3d\xa1\xf9\x3b\x74\x7e\xb6\x87\x0b\xea\xf0\xd8\x4b\x55\xea\x7d\xe6\xee\x84\x89\x5f\x7a\x32\x3a\xf8\xfc\x9d\x8f\x0f\x93\x64\xec\xdd\x90\x02\xc2\x78\x6e\x11\x1d\xb7\x3f\x1f\x79\xe1\x46\xb6\x9e\x41\xef\xdf\x0a\x27\x74\x34\xa3\x00\xcf\x73\x01\xc4\x16\x6e\xda\xe0\xe5\x97\xc2\x16\x40\xfa\xa6\xd5\xc4\xd0\x1f\xe7\xe6\xff\xb4\xd4\xc2\x72\x9c\xb3\x1b\xc0\xdf\x57\xe5\xd7\x74\xfb\x3e\xa8\x66\x25\xd7\xc3\x32\xaf\xd2\xb9\xa4\x78\x01\xd0\x47\x74\x65\xa4\xcd\x73\x51\xff\xae\xe0\x9e\x2f\x8a\xde\xf9\xc8\x55\xd1\x0f\xdc\x4c\xbd\xa7\xd5\xcd\xe3\x29\x71\xc6\x53\x21\xcc\x9e\xbc\x86\xba\xc6\x5b\x69\x8c\x5e\xc6\x26\xac\xca\x9e\x4e\x3b\xbf\x8f\x9c\x8f\x4b\x70\x9c\x00\xb9\xf1\xcc\x81\xf3\xd0\x5e\x67\xd4\x93\xa4\x91\x0e\x38\x5f\x38\x16\x21\x89\x9d\x5f\x1f\xda\xdc\x30\xc8\x86\xb2\x6e\xcd\x4d\x0b\x0c\xab\x24\x56\x68\x7d\x53\xc4\x71\xea\xd6\x39\x88\x4e\x3f\xe1\xc0\x27\x6d\x0a\x2c\x8a\xfa\x84\x91\x61\x0d\x34\x74\xd7\x22\x19\x04\xc5\x62\xd3\x6e\x0f\xdf\x2f\x3e\xfb\x30\x28\xd6\xef\xc2\x8c\xd0\xdd\xe1\xc3\x25\xb4\xae\x5d\xa3\x03\x31\x1e\xb4\xd1\x6d\x4f\xc7\x60\x73\x37\x6a\x89\x1f\x27\x19\x71\x01\xa7\x3c\x0f\x39\xe4\xd0\xd6\x02\xdf\x13\x4a\xb4\x87\xac\xdf\x3b\x3c\xd8\x6a\x1b\x5e\x68\x53\xc0\x81\xf1\xe3\x8c\x1a\xdd\x6a\x3d\xe6\x67\x30\x1c\xbe\x0c\xbc\xed\xaf\x04\xe8\x9c\x81\xb7\x7d\x2b\x85\x2a\xdf\x3c\xad\xd6\x94\x50\x36\x21\xfd\xb2\x17\xaf\x83\xb6\x4a\x00\x9e\x10\x6f\x67\x0b\x38\x1b\xda\x13\xf3\x9d\x99\xd9\x57\xb5\x12\xc9\x62\x25\x76\x82\xa5\x45\xb3\x00\x9a\x0b\xa8\x2f\xc3\x0f\x27\xe0\x72\x77\x6b\x99\x3f\xbf\x8c\xf4\xf3\x21\x57\x03\x4e\x28\x5f\x0d\x3f\x9c\xa9\xfd\x7c\x12\xbd\xb6\x86\x06\xf8\x63\x61\x0e\x73\x4a\xe4\xa7\x5b\x5e\x4e\x09\x91\x9f\xa0\x00\xa6\x73\xa1\xd2\xc5\xb0\x80\xcc\x49\xf2\x32\xe6\x60\x7b\xde\xb0\x2d\xe4\x5f\x78\x7e\x94\x90\x06\x6e\xa5\x95\x33\xaa\xe0\x43\x83\x09\x5e\x05\x84\x7b\x1a\x0d\xc9\x7c\x5b\xe3\xff\xa3\xf4\xbe\x11\xe5\xce\x45\xed\xe3\xad\x99\x33\xe2\x7c\xa3\xb9\x0d\x7f\x65\xaf\x3f\xee\x79\xdb\x1d\xe3\xc2\x39\xdd\x82\xf0\xcf\x51\xf3\x34\x50\x66\x71\x08\xa5\x29\x34\xcb\xc5\xfd\xf1\xc8\x48\x98\xb4\xb1\x74\x17\x68\xb3\x33\x95\x31\xe4\x88\x9c\xba\x73\xc7\x0f\x2e\x9f\x20\x91\xd6\x0b\xb3\x43\x40\x55\xeb\x3d\xdb\xbb\x3d\x12\xa8\x1a\x92\x1d\x1a\xc8\xcf\x34\xc2\xf4\x3a\x4f\x70\x55\x68\xa5\x88\x55\x23\x62\xe0\x80\xbc\x1f\x39\x9f\xe8\xf7\xee\x7a\x30\xd0\x57\x46\x24\xb5\x95\x48\x45\x4c\x99\x43\x77\x9a\x4e\x2a\x95\xec\x51\x37\x83\xea\xb9\x91\xcd\xc1\x05\x26\xb2\x67\x7b\xa0\x4d\x41\xde\x2c\xbd\xb5\xc2\x3c\x8a\x45\xda\x95\xf0\xea\xd9\x05\xfb\xe8\xc6\xf8\x81\x24\xe9\x97\xfa\xab\x45\xa1\x55\x09\xf2\xc8\xaf\xc3\xb3\xb9\x77\x57\xe6\x5d\x13\x6f\xd8\x05\xfb\xfd\xed\x72\x3b\xba\xed\x47\xd7\x69\x45\x9f\x5d\xe2\xf7\x97\x63\xe8\xc9\xb7\xbc\x95\x8d\xe4\x06\x7f\x34\x7e\x21\xee\x4d\xb9\x1b\x5f\x2c\xdd\xf8\x9b\xf8\x72\xd6\x3f\xf6\x5f\xe5\x83\x50\xc0\xba\x67\x58\x27\x0b\x58\x96\x17\xec\xe2\x7a\x89\xee\x0c\x89\x5e\x88\xe5\x7a\xc7\x0d\xa0\x0c\x2e\xd8\xc5\xcd\x92\x12\x85\x4f\x27\x6d\x18\x1c\x85\xba\x62\x35\x97\x66\xc3\xb6\x12\x55\xb9\xb9\x6c\x02\xf8\x0a\x02\x9e\x0b\x76\x71\x35\x9e\xa6\x4c\xac\x65\x24\x44\x31\x23\x2e\x98\xf6\x93\x65\xc2\xd1\xaf\xb0\xb5\x26\x39\x38\xbc\xe7\xe9\x89\xba\xe1\x00\xb8\xbe\x81\x34\xd0\x62\x1a\x63\xde\x2d\xa4\xe4\x1c\x1c\x2f\x36\x4f\xaf\xa0\x06\xfc\x22\xcb\x40\x3c\xa7\x33\x23\x92\xe6\x02\xe8\x2a\x23\xe6\x3d\x48\x2b\x53\x32\x04\xbf\x80\xea\x59\x81\x8c\x2e\x48\xc7\x42\x1e\x5a\x42\x67\xfe\x78\x1c\xb2\x9f\xe9\xe6\x1b\xb3\xcf\xf9\xc1\xc5\xe7\xf2\x49\x66\x2b\x28\x33\x1e\x87\xc8\x59\x33\xb4\x52\xe9\xc1\x2d\xb3\xad\x3b\x47\x3d\x7c\x05\x58\x60\x2a\xdf\x87\x65\x4f\x88\x80\xd8\x5e\x70\xdc\x8e\xf0\x18\x78\xd4\x47\x9f\xd3\x47\xbf\xc1\x0b\x0f\x2c\x94\x73\x9c\x93\xde\x69\xf6\x9e\x4f\x95\x15\x49\x82\x87\x51\x7a\x88\x24\x3d\x8d\xa7\x16\xee\x29\xf7\xe5\x6f\x01\x54\x23\xe4\xc2\xbb\x49\x5f\xe5\xf1\x0a\x0e\x05\x88\x8f\xad\x9c\x41\xe7\xce\x85\x85\xf3\x1e\xef\x62\x5c\xb8\x74\xf8\xbf\x6c\x05\xee\x78\x6e\xfc\x13\x20\xd1\x3e\xf7\x51\x46\xae\x96\x13\x56\x79\xc6\x59\x2b\x1a\x21\xf2\x4e\x38\x5f\xa4\xe9\x67\xe4\xc6\x37\xb8\x70\xe8\xfb\x55\xc3\x43\xb7\x29\xf4\x42\x5d\xd4\x1a\xc4\x23\x84\xb2\xb2\x3f\x44\x6c\x80\xbf\x30\x34\xe3\xf9\xdc\x26\xbb\x1a\xc9\x5f\xf9\x4c\x63\x3c\x12\x13\xd8\xaa\x77\x65\x35\x93\x3b\x25\x7b\xa0\x35\x01\x02\x15\x1b\x52\xad\x0b\x20\xf7\xb9\x54\x69\x66\xba\x9e\xd3\x35\xe2\xdb\xb2\x72\xf7\x9a\x1d\x37\x97\x23\xb5\x9a\x74\x4f\x98\x99\x48\xfd\x36\x35\x14\x83\x15\xa9\xa4\x40\xaf\x59\x37\x90\x30\xa1\xd7\x0f\x40\x56\x02\x59\xa5\x7c\xec\x44\x27\x50\xea\x30\xa1\xf8\xd5\x58\xfd\x4b\x46\x81\xc4\x4d\x76\xd8\xb6\xc2\xec\xb0\xfc\x87\x7e\xe4\x52\xbf\xe1\x8b\xc7\x10\x47\xfb\x5f\xa4\x92\xbd\xe4\x0d\xad\x22\x5a\x3c\x6e\x6d\xf5\x44\xa6\xe6\xd7\xdb\x28\x8f\x75\x37\x8a\x31\x30\xba\x70\xdf\x9f\xef\x8f\x59\x15\x42\x08\xc1\xbe\x47\x8b\x0c\x20\x82\x55\x51\xf4\x5c\x2c\xf1\x44\x31\x11\x88\x24\xee\x59\x07\x1a\xa2\xba\x62\x9f\x78\x53\x50\x15\x86\xb2\x4e\x9d\x91\xea\x01\xeb\xb5\xd5\x98\xc2\xf0\x79\x1d\x32\x2b\x0a\xff\x53\x1a\x31\x20\xae\xcf\x8e\xb1\xf9\x72\xbd\xcf\x91\x8b\x03\x1e\xb4\xf4\xed\xcc\xa9\xa0\x0b\x91\xdd\x08\x0a\x35\x7f\x73\x5e\xfc\xdb\xf8\xef\x23\x37\xc0\x22\xc2\xae\xd6\xb6\x6f\x0e\x68\xaa\x0c\x57\xb6\x19\x9c\x19\x5e\x77\x98\x88\x76\x6d\xf8\x78\x0e\x2d\xf6\xe9\xbc\xe2\xf1\xd7\xf6\x35\xfa\x4f\x6b\xdf\x9b\x4f\x93\xdc\x9b\xa2\xae\xc4\xe1\xcd\x93\xa8\xad\x33\xd6\x96\x86\x02\x91\xd8\x57\x80\x3b\x12\x66\x9b\x93\x6e\x33\xf0\x0f\x22\x08\x33\xe3\x85\x3c\x91\x52\x77\x3e\xda\x4e\x3e\x8a\x54\x94\x3f\x0d\xe9\x20\x0b\xb8\x89\xe4\xda\xf1\xa6\x6f\xd9\xbd\x62\x83\x0a\xbd\x18\x11\x21\xea\x6d\x7e\xcd\x41\xfb\x71\x14\xc9\x01\x82\x26\xaa\x90\x89\xd2\xfa\x3e\xc1\x41\x9d\xcf\x9b\x10\x01\xa1\xa5\xb4\xbb\x01\x6a\x8d\x21\xd2\x8b\x83\x85\xc5\x0f\xa1\x22\xb7\xac\x68\x34\x81\x19\xb8\x69\x5d\xb0\x8f\x67\x2f\xc1\x94\x12\x1d\x1e\xef\xc0\xc2\x03\xe5\xe4\x11\x56\x88\x96\x5d\x31\x28\x2d\xd9\x5a\x1b\xe2\x33\xed\xb1\x24\x07\xd6\x02\x37\x83\xbb\xd3\x86\x55\x1c\xb9\x03\xa5\xba\xd8\x8a\x7e\x2f\x84\x0a\x57\x7f\xd5\xfb\x97\xca\x63\xd3\x0b\x14\xae\xfb\x43\x27\x36\xcc\x6a\x24\x66\xcf\x52\xb3\xd4\x0f\x42\x65\xf1\x10\x6c\x6f\xb9\x95\x05\xe3\x06\x5e\x2e\x44\x34\x20\xac\xdc\x6e\xed\x5b\xf6\x83\xdb\xbb\x7b\x69\xe9\xc0\x04\xc6\x86\xe0\xb2\xa7\x32\x20\xe8\x26\x85\x08\x1d\x67\x77\x9e\x3e\x7d\xc3\x06\x05\xbc\x17\x6e\x84\x33\xbc\xe1\x89\xb0\x90\xd5\x5a\x2d\xeb\xfc\x01\x09\x05\xa1\xfb\xf7\x10\x24\xf4\xf9\x3a\xa0\x50\x5b\x63\x65\x14\x87\xd9\x45\xda\x6a\xdb\x61\xe0\x68\x47\xca\x10\x14\x5b\x8c\x62\xa5\x5e\x03\x76\x99\x33\x68\x7d\x4a\x31\x76\xaf\xa0\x88\x57\xb9\x90\x8e\xec\xda\x44\xad\xb2\x76\xaf\x93\x48\x9c\x01\x50\x5d\x6b\xac\x99\x63\xdf\x03\x02\x73\x2a\x21\x1a\xa6\x70\xa9\x41\xd7\x52\xfc\xeb\xa8\x69\xa8\x76\x11\x01\xca\x43\x49\x13\x9a\xf3\x66\x06\x1c\x97\x94\x42\x78\xb4\xf2\x94\xe0\x1b\x1a\x17\x16\xc5\x9d\x97\xe2\xc7\xe5\x71\x3e\x24\xb4\x8c\xa5\x7d\x08\xb6\x60\x3c\xdb\xa1\xed\xd0\xe0\xc3\xdf\x80\x80\x1c\xb2\x15\x10\x04\xb5\xd0\x4e\xc1\x6c\x2f\xa1\xe7\x8f\xb5\xb2\x74\x43\x5c\xf2\x51\x72\x60\xd6\x60\xc5\x88\xdf\x9b\x5e\x54\x4c\xb4\x04\xc6\x78\xae\x5e\x80\xb3\x7b\x6c\x57\x90\xbc\xce\x32\xbe\xe7\x3e\xec\xcb\xc2\xeb\x60\x37\x56\xf5\x0c\x7c\x14\xc0\xa4\xbe\x0a\x3b\x74\x56\x62\xff\xef\xaa\x91\x2e\x80\x2a\x4f\x3b\x26\x60\x6e\x62\x57\x5f\x25\x15\x28\x18\x74\xc2\xc5\xe3\x46\x2c\x3a\x20\x00\xa4\x38\x51\x05\x0b\x27\xd8\x77\xee\x45\xb2\x1f\x9a\x92\xfd\xa0\xc4\x33\x12\xfe\x56\xa0\xf3\x04\xe3\x6e\x85\xb5\x1c\x85\xbe\xfb\xbd\x50\xfd\xe1\xa2\x72\xc7\xcf\x5e\x9b\xd2\x06\x1a\x9d\x1c\xf3\x0a\xc1\x90\xa7\xbe\xaf\x28\xc7\xf4\x36\x57\x04\xad\x21\xa0\x75\x6f\xd6\xdf\x80\x1a\x8f\x5a\xa9\xca\x0d\x33\xa2\xd0\x3b\x05\xf0\x6e\xb8\x50\x08\xee\x4b\x61\xe8\x34\x0a\xf9\xbe\x0d\xc5\x49\x2e\x1c\xb6\x7b\xf7\x39\x68\x8c\xc9\x87\x20\xeb\x23\xdb\x56\x94\x92\xf7\xa2\x39\xe4\x92\x6a\x7c\xdb\x08\x9b\x1c\xbe\x4b\xa4\x41\x31\x3b\x0d\xc2\x00\x6e\x24\xb6\xf7\xd1\x5d\x2b\xb8\x22\xff\x12\x35\x10\xf0\xa1\xd6\xc9\x1e\x84\x6b\xe0\x44\x70\x04\x61\xa4\x04\x3e\x78\xd0\x3d\x0a\xb5\xc8\xd8\x43\xac\x64\x63\x0e\x65\xa8\xb6\x47\xa2\xa8\xa0\x98\xa8\x70\xf2\x50\xfd\x0e\xda\x80\x6e\x19\x08\x4a\x29\x3c\xe1\x8a\x64\xfb\xf9\xb1\xf9\x64\x03\x37\x46\x3e\x8a\xd9\x92\xc4\xaa\x88\xe1\xe7\x41\x38\x57\xea\xc5\x21\xc2\x6b\x3b\xe6\x21\x06\x60\xf0\xa1\x45\x88\x1a\x01\xfd\x36\x4c\xb4\xc2\xf0\xc6\xad\xc1\x61\x7b\xf0\x48\xe8\xae\xab\x21\xae\x75\x87\xfa\x98\x3e\xf1\x39\x0d\x20\xeb\x14\xcc\x8e\xc6\x16\x7f\xf2\xb2\xe1\x59\x22\x69\xc3\xf8\x94\xa9\x1c\x35\x06\x09\x57\x4a\x70\xa3\x5a\x96\xa5\x50\xce\xd4\x1e\x36\xcc\xf2\x8a\x6a\xbd\xa5\x08\x2c\xd6\x93\x94\xdc\x38\x80\x0d\xac\x73\xc0\x26\x35\x14\x79\xc6\x2f\xb1\xe7\x31\xd8\xa1\xdd\x8a\xe4\xe0\xf8\x4d\x52\xec\x95\x8f\x52\x61\x62\x8b\x40\x49\xda\xe0\x8a\x94\x8f\xd0\xf4\x8c\x07\xba\xc5\x92\x15\x25\xc7\x68\xfb\x06\x16\xf1\xf4\x2a\xee\x64\x85\x0b\xad\x28\x46\x27\x9c\xe3\x49\x76\x0f\x24\x4f\xa1\xb5\x12\xa1\xc8\xb6\x87\xd2\x5d\xc5\xec\x60\x3b\x41\x9a\x1e\xb2\xf5\xda\x34\xb2\x15\xac\x20\x0a\x46\xcd\xaa\x46\xef\xa9\xa3\x3a\x30\x3f\xfb\x0d\xb4\x03\xac\x4b\x53\x2e\xbb\x58\xd1\x46\x40\xe2\x2a\xc8\x7a\xf9\xf7\x12\x3c\x0d\x67\x43\x04\x37\xde\xbe\x4d\x05\xc0\x3c\x2c\x2a\x86\x0a\x81\xc3\x10\x3c\x55\x54\xef\x42\xc1\x7c\xff\xa2\x7c\x96\x16\x04\x8a\xc6\xc9\xe2\x3f\xa3\xbb\xe6\x66\xa6\x68\x86\x52\x50\x0b\x18\x96\xee\x0e\x60\xdd\x41\x6c\x83\x90\x55\xbd\x73\x72\xed\xcf\x83\x70\xab\x2d\xd1\x87\xa3\xa6\x85\xc6\x8b\x4a\xe6\xd6\x6b\xac\x98\xb3\xea\xc8\xaf\x79\x27\xd8\x4f\x1c\x4f\xbc\x19\x3a\xe5\x39\x64\xd6\x39\x36\x66\x39\x36\x5d\x5b\xcf\x67\xc0\x5d\xeb\x22\x55\xf6\xda\x85\x79\x5b\xd1\xe8\xfd\x9b\xf3\xda\x0c\x8e\x87\xa8\x4f\xa8\xf2\x4f\xb8\x99\x51\xf4\x61\x31\x1e\x8d\x8d\x61\x95\x8c\x65\xff\x8c\xa5\x19\xe3\xa6\x96\x2b\xd9\x79\x46\x10\x77\x40\xeb\x28\x07\x1c\xbb\xe9\xf7\xfc\xb0\xb8\x55\xff\xeb\x7f\xfd\x27\x5c\x6d\x4c\x66\x01\xed\x59\xda\xe0\xc9\xe8\x42\xa6\x34\xec\x86\x5d\xa8\x3d\xe2\xc4\x3d\x12\x69\xde\x95\xd2\x80\x82\xa3\x57\x21\x84\x0c\xa3\x54\x74\xf0\x52\xaf\x21\xad\x53\x77\x07\x88\xcf\xfc\xfe\xad\x51\x27\x19\x0c\x12\x0a\xc4\x43\x47\x97\x73\x9f\x4f\x63\x0b\xfc\x73\x78\xfd\x1d\x3f\x30\x67\x33\x5c\xa0\x08\xc6\xc6\x4a\xb7\xbf\x98\x75\xcb\xd9\x52\xf0\xe2\x2c\x0d\x50\xb8\x82\x93\x31\x1e\x24\x3d\x74\x0c\xfd\x70\x7d\xad\x1a\x49\x9c\x2f\x18\xca\x2b\x8b\x3c\xd5\x30\xab\x1d\x2f\x02\x5d\x0b\x0e\xd4\xcb\x03\xe2\xcf\x42\xb7\x08\xc4\xb4\x7b\x1e\xc8\x0d\xf5\xd0\x3f\x7f\x68\x95\x11\xe2\x97\x64\x68\x63\xb2\x5f\xf4\x5f\x9c\xab\xa9\x74\xe2\xca\x81\x3b\x99\x8e\x79\x50\x78\x25\xf8\xe8\xf4\xed\xbf\xaf\x66\xf3\xb4\xed\xd0\xf4\xd2\xbd\x17\xd2\x51\x0f\xf2\xb5\x6e\x3d\x64\xad\x95\xfd\x5e\xfb\x04\xbc\xd2\xea\x22\x5b\xb3\x1e\x49\x85\x1a\x8f\xa4\x76\x8b\x4a\x90\x69\x6e\xcf\x87\xe3\x98\x9f\x56\x3e\x83\x9d\x0a\xea\xfe\xc6\x94\xd6\x60\x6c\xf1\xe5\x4e\xec\xec\x1f\x9e\x69\x67\xd7\x01\x48\xfe\xc5\x4b\xc1\x0a\x69\x8a\x46\x4c\x5c\xb3\xab\x4d\xce\x6d\x1d\xf2\x32\x20\x11\xcf\xb4\xd7\x85\x76\xe1\x3c\xd5\xe7\xa6\xae\xcd\xf9\x1d\x63\x0b\x59\x63\x32\xe4\x8b\x38\x0e\x6e\x9d\xd7\x48\xa6\xd1\xb4\xc4\x04\x13\xfc\xf7\x88\xcb\x9b\xd5\xc6\x53\x62\x8f\x3f\x8b\x27\xff\xa4\xe2\x55\xd4\xbc\x69\x84\xdb\x90\x86\x68\x2a\x41\x81\xdc\xcb\x90\x85\x8a\x43\xa3\xf7\x62\x1c\xb8\x05\xcf\x0d\xfc\x11\xdb\x9b\x01\xfb\x94\x02\xd7\x72\x5c\xc2\x30\xd5\xb1\x02\x9a\x82\x3b\x52\x3a\x6a\x7c\x57\x1a\x52\x94\xd0\xcc\x60\xb8\xb2\xd1\x0e\xba\xa5\xfe\x28\x8c\x8b\x3e\x28\x45\x84\xd6\x3c\xe9\x5e\xf1\xe2\x3c\xd8\x04\x05\x4f\xec\x26\x16\x1f\xc4\xfa\x02\xff\x47\xf7\x36\x03\x30\xc4\xf0\x65\x3e\xf8\x7f\xba\x99\xd8\x81\xa6\x77\xcf\x7b\x69\x7b\x59\x58\x22\x69\x82\xd5\x13\xfc\xcd\xe4\x63\x3a\x65\x8a\x5a\x5b\xa1\x32\x5c\x22\x9c\x13\x88\x1e\x06\x41\x20\xcc\xbb\x35\x72\xa7\xe0\x54\x71\x33\x96\x86\x9d\x1b\x02\xd5\xe0\x5c\xc6\xc4\x2c\x06\xa3\xb0\x48\x9c\xc7\x44\x17\xe3\x28\x23\x8f\x09\x96\x07\x49\xde\x78\x06\x6f\xe9\x8c\xae\x64\x21\x85\x2a\xa4\xb3\x5a\x32\xaa\x8d\x43\xb3\x1d\x95\xe5\x11\xb3\xaf\x73\xbe\xea\xcd\x84\xc0\x3a\x4b\x61\xc6\x2b\x1f\xe8\x60\x8d\x35\x4f\x2c\x11\x37\xd8\x09\xe3\x05\xfa\xe2\x74\x49\xeb\x15\xef\x7d\x34\x6a\xec\x86\xf9\x23\x31\xc9\xb7\xe1\x75\xa4\xf2\xfb\x96\xb4\x22\xd3\x94\xec\x60\x71\xa9\x37\x62\x27\x54\xc9\xcd\x81\xac\x0a\xe6\x28\xb8\x34\xf1\xdf\xf8\x34\x7e\x9f\x9c\x72\xbf\x93\xdd\x98\x50\x84\xb9\x27\xfc\x8b\xec\xd9\x7b\x59\x88\xc9\x15\x63\x90\x04\x9d\x9e\x7d\xd8\x58\xd8\xf1\xb7\xf1\x0b\x81\xba\xa7\xfa\x48\xc7\xa0\xab\xf4\x26\x08\x20\xc8\x4c\x53\xd8\x1b\xa2\x0c\x79\x47\xba\x09\x40\x1e\x8c\xb0\x43\x03\x3b\xac\x34\xba\xeb\xa8\x79\x25\x65\x37\x43\x24\xb3\xf8\x5c\x08\x1b\xc0\xee\x85\x0b\xe7\x85\x45\xe2\xe0\x7c\xb0\xf4\x44\xf7\x16\x3b\x8f\x03\xe6\x37\xfd\x79\x24\x41\x2f\x07\xaf\x2e\x99\xfc\x7a\x3a\x02\xca\x0d\xb9\xdf\x3c\x28\x5d\x3c\x00\xcb\xa0\xb3\x26\x85\xd4\xc3\x49\xcd\x4b\x5a\xf5\xb0\xc0\x08\xfc\x4f\xf5\x94\x2a\x74\x19\x06\x9e\x5d\x30\x04\x1b\xa0\xa7\xd9\x04\x17\x91\x59\x3d\x98\x22\x44\xa2\x7e\xc9\xb5\x63\xd7\x82\x98\x0e\xc9\xa0\xca\x58\xa9\x00\x3a\xa9\x0e\xb8\x9f\xdc\x54\x93\x2c\xfe\xdc\x7a\x04\x81\x6a\xde\x78\x49\xc9\x80\x09\x79\x5d\xa5\x29\xef\x92\x9b\x07\x17\x75\x69\xf5\x26\xc9\xd0\x27\xf7\x86\xed\x8e\xfb\x8e\xf7\x78\xb1\x58\xee\x00\x6a\x5f\x90\xec\xf4\xe1\x53\x96\xd2\xc5\x57\xe1\xbf\x72\x52\x64\x3b\x5b\x66\x9b\xb4\xb6\xe4\x19\xaa\x61\x68\xa1\xec\x42\x01\x72\xd6\xfa\xb5\x61\x50\x36\x4f\xda\xc8\xfc\x93\x60\x55\x05\x10\x9f\xca\x59\x50\xe7\xa3\xfd\x04\xed\x73\xf1\x7a\x83\xa2\xad\xca\x3d\x8d\x43\xe0\xe5\x0a\x34\x5b\x36\x8c\x86\xc4\xa6\x40\x02\xb3\x20\xd6\xfc\xf4\x8e\x6e\x64\x7b\x82\x43\xfa\x1c\x6c\xb8\xd9\x26\x2a\x5c\x8f\x6c\x0e\xf8\xdd\x68\x48\xe5\x2f\x38\xd9\xe9\x43\xfb\x35\x4f\x8e\x2d\x7e\x1b\x84\x10\x7e\x81\x50\xa0\xe5\x3d\xe5\x42\xe2\x93\x7b\x15\xc9\x78\x95\x90\x5f\x4b\x4f\xed\x57\x3d\x8e\x17\x8e\x4f\x2f\x4e\xc3\x31\xce\x8f\x53\xec\x6e\x06\x93\x1c\x8a\x01\x26\xb1\x40\x7f\xce\x6f\x41\x6f\xa3\x86\x09\xf5\x6e\xa4\x17\xc5\x81\x9c\xc6\xd2\xb2\x78\xef\xc1\x30\xde\x9d\x1c\xa3\x61\x8e\xc9\xd0\x92\xed\x4c\xbd\x17\x78\xc8\x84\x3f\xcd\x1f\x23\x46\xd8\xde\x48\xff\xde\x55\xc9\xcc\xd0\x88\x58\xc0\x1a\xd1\x51\x81\x1f\xe3\xce\x7c\xe4\xa5\x42\xf4\xa1\xac\x46\x9b\xc6\x3d\x2e\xf8\xe3\x60\x7b\xc0\xa4\x86\x83\x86\x15\x83\x81\x01\x69\x25\x08\xd1\x93\x58\x5e\x44\xaa\x11\x73\xa4\x8c\x5f\x7e\xe4\xcd\xb0\x36\x09\xd1\xcf\xe5\x37\x9f\xdd\x50\x70\xba\x2b\x8c\xfc\xe2\xa2\x96\xd0\xad\xd8\xca\x82\xbf\x20\x03\x0d\x7b\xfd\x49\xb4\x9d\xb0\xb3\xbd\x06\x4f\xe4\xb3\x62\x76\x80\x3c\x64\xa3\x87\x92\xb9\xa5\xe6\x56\x06\x48\xad\x6e\x58\xc7\xa5\xaa\x06\x67\xbd\x10\x53\x25\x36\x4c\x98\xa1\xeb\xc9\xc6\xaf\xd0\xed\x1a\x2b\x2d\x41\x82\xe2\xea\xf2\x94\x22\x18\x6c\x0d\xbc\xf8\x4a\x60\xd4\xfd\x98\xec\xef\x5d\xf9\xb5\x17\xff\xf4\x47\xa5\x9e\x80\xa7\xb4\x41\x56\x76\xb7\x56\x5d\x98\x97\x7e\x31\x41\x4e\x69\x25\xb2\x3b\x80\xee\x37\x88\x56\x83\x6a\x88\x2c\x82\xfe\x77\xc0\xcc\x03\x01\xc6\xc6\x03\xe7\x37\x68\x30\x5c\xb8\x87\x9d\xd9\x39\x25\xc8\x59\xfd\x72\xf7\xa9\xe8\x0c\xa5\x94\x13\x7a\x20\xc4\x4e\x81\x85\x87\x16\x1e\xe7\x5c\x94\x78\x84\xe1\xc4\xf4\x09\x83\xaf\x8b\x90\x5f\xd9\x90\x41\x20\xf3\xc2\x8d\xe0\xbf\x7e\x77\x46\xff\x24\x66\xe1\xac\x37\x63\xc9\x24\x48\xd1\x3c\x43\xc4\xd5\x88\xa7\xc5\xc9\x4f\x2d\x2f\x3e\x4f\x78\xf7\x3e\x45\x1f\x6f\x61\x09\x80\x7b\x1b\x95\xfa\xc0\x01\xf3\xc0\x56\x55\xfa\x16\x34\xea\x35\xf8\xfb\x29\xb2\xf6\x4d\xf4\xa5\x38\xfb\xfd\x2d\xc5\x08\xbd\x66\xf7\xdf\x6c\x28\x27\x0d\xef\x3d\xe5\xd3\x36\x72\xb7\x13\xc8\xbe\x01\xe8\xb8\x18\xaa\x42\xcb\xa9\xd2\xfe\xed\x93\xa0\xfe\xce\x6d\x2b\x69\xad\x6c\x56\x1a\x7d\xf7\x86\x41\x3a\x80\xcb\xbe\xfe\x42\x2d\x97\xab\x4f\x00\x04\xbf\x05\xe2\x01\x1f\xfc\x13\xf6\x1d\x5a\x63\x60\x5b\xed\x8d\xec\x7b\xa1\x90\x64\xea\x8b\xc9\xf5\xfa\xe3\xe3\x27\x6e\x16\x19\x2d\xee\x99\xad\x65\xdb\xe2\x6b\xaa\x60\x42\xd3\x55\xe2\xe5\xd1\xd3\x32\xcd\xb2\xb0\x0c\xe8\xdf\xa2\x30\xbb\x5b\x29\xd7\xe9\x4a\x59\x60\x3d\x59\x7c\xcd\x4d\x23\x1a\xbe\x9b\xbe\xe1\xd5\xa5\x85\xc5\x37\xfc\x2c\x36\xc2\x35\x84\x45\x47\xc0\xd2\x7b\xad\x4b\xcc\x36\x15\xcd\xb0\x75\x86\xf1\xe7\x81\x9b\x1e\xea\xdc\x55\x15\x2a\xfa\xb5\x6e\x60\x6b\x49\xcb\x64\xbb\x1d\x7c\xaf\x95\xf2\x9e\x73\x87\x59\xa3\x79\xdc\x63\xe6\x29\xda\x34\x23\xe3\x29\xa8\x64\x44\xdc\x07\x15\x12\xff\x9a\x3c\x49\xbe\xf2\xca\x0f\x44\xd4\xeb\x0c\x0b\xa0\xb8\xbd\x90\x09\x1a\x7d\x38\x93\x10\x2c\x16\x93\x12\xf8\xef\xd8\xe9\x8d\x44\x86\x04\x3a\x61\xe5\xd7\x94\x33\x26\x38\xb8\x3b\xb4\xfc\xa7\x64\xbb\x36\x04\x40\xa0\x56\x0f\x54\x28\xce\x71\x05\x65\xe8\x90\x80\xd3\x28\xf4\x77\x20\x26\xd9\xfd\xbd\x11\x3d\xdb\xe9\x50\x97\x80\xbb\xad\x5b\x7c\x1a\xa5\xc3\xbe\x33\xdc\x76\x2f\xcf\x3a\xf0\x65\x89\x50\xbf\x94\x36\xc9\x5f\xbd\xe0\xfa\x8c\xd2\x4e\x8d\xa5\x69\x56\x8a\x46\x82\x30\x12\xb3\x6e\x0e\x47\x98\x15\x8c\x7a\x0f\x01\x71\x15\x44\x1a\x70\x5d\x9d\xd4\x68\xf8\x67\x38\x8c\x72\xaf\x4a\xa4\x44\x6b\x53\x6c\xc6\x9e\x9a\x31\x00\xd8\x18\x9c\x3a\x70\xd3\x16\x1b\x59\x9d\x47\x12\x54\xe6\x47\x2a\x28\x14\x43\xc2\x91\xe6\x9d\x06\x3b\xa7\x7e\x02\xa5\x89\xff\x16\x3f\x59\xda\x6d\xb2\x11\x73\x7c\xb3\x73\x61\xdc\xf7\x2f\x20\xab\xfc\x9c\x6e\xf1\x97\x68\x63\xa5\x66\xd4\xf7\xc2\x0a\x03\x5b\x10\xa4\xbf\x67\xa4\x52\x56\xe1\xdc\x95\x66\x16\xe5\x5e\xb1\x14\xe1\x81\x1a\x74\x3e\x6b\x93\x03\x35\x83\x68\xec\x0a\x9d\xe6\x34\x2b\x95\x93\xdf\xa5\xe5\x8e\x94\xf8\xb8\x97\x46\x40\x14\x99\xb0\xe4\x78\xe4\x93\x6c\xdb\x41\x51\xf3\x4d\x1a\xae\x6d\x46\xe4\x65\xee\xf4\x2b\x05\xaf\x84\x72\x4f\x01\xd0\xcb\xf1\x85\x65\xff\x96\x79\x92\xc8\x8c\xb6\x80\x60\x12\xee\x8c\x79\x14\x66\x0b\x7d\xad\xf4\x22\x71\x08\x41\xbe\x0d\xfb\xe9\xd6\x9c\x05\x6e\x75\xf6\xec\x7b\x37\xd4\x55\xce\xe6\x39\x08\xfc\xd5\x6e\x26\xf9\x93\x55\x23\x44\xb1\x04\x66\x5c\x5e\x9f\x67\x75\x59\x9c\x75\x36\x64\x14\x06\x82\xc9\x96\xd0\x8c\x01\x0d\xb5\xc9\x10\x3f\xda\xa4\x3d\x43\x3e\x82\xe9\x6a\xa1\x74\x2b\x94\x4f\x0c\x48\x48\xa4\x35\xce\x24\x1b\xcf\xa4\x7b\x95\xa1\x1e\x3e\x85\x7b\x25\xdd\x7b\x6e\x45\xe8\x7e\x0a\xa1\x98\x47\x6b\xa7\x17\x41\xf1\x71\xb7\xd0\x1e\xa5\x1d\x78\xb3\x49\x3c\x90\xa2\xc0\x8a\x2d\xd6\xa4\x2c\x26\x60\x6d\x0b\x48\xb0\x98\xf2\x06\xb4\xd4\xc1\x57\x9e\xd7\xa0\x8e\x66\x32\x7a\x11\xb1\x20\x7d\xbf\x1a\x80\x2f\xa0\x4e\x77\x98\x3e\x1d\x54\x0e\xe2\xd7\x3d\x5c\xdc\x37\x30\x46\x9f\x90\x37\xbd\x40\xb5\xd3\xa4\x4d\xc2\x6a\xaf\xe7\x1b\x11\x18\xa1\x6b\xc5\xab\xca\xf8\x59\x93\xd8\x72\x36\x07\x25\xa7\xd7\xcf\x55\xf2\xf6\x73\xc1\x1a\x78\x08\xd9\x8f\xc7\x13\x47\x1e\x87\x12\xde\x27\xb6\x86\xef\x79\xe3\xfc\xb1\xa5\xf9\xfc\x5b\xc4\xbc\xf7\xc2\xd0\x64\x86\xee\x58\xbc\xbe\x11\x8f\xd0\x19\x88\x12\x2c\x5b\x1c\x2c\x81\xfc\x37\xce\x03\xa5\x89\x07\xe7\xc6\x0d\x30\x33\x9d\xce\xea\x8c\x9b\x65\x07\x4b\xac\xfb\xf1\xed\xb9\x79\x91\x2a\x7d\x7f\x80\x32\x08\xd2\x15\xfe\x09\x91\xc0\x37\xf4\x18\x50\xe0\x98\x64\xa3\x9e\x0f\xfb\x4e\xe6\xbf\x94\xb6\x10\x86\x24\x09\xc2\x4d\x2b\x40\x8f\xf9\xf1\x6c\xa6\x65\x0d\x2b\x44\x86\xf1\x97\xab\x01\x62\xa0\x4d\x54\x98\xa1\x5d\x05\x3f\x9d\x33\x9a\xd7\xbe\xc5\xe8\x29\xf0\x53\xab\x90\xe6\x4a\x02\xa9\x9a\x8b\xb1\x54\x8f\x12\x0c\x76\xa8\xb0\x96\xda\x63\x09\xa1\x84\x7e\x62\x59\x89\x0b\x28\x2b\x14\xba\x3b\x44\xc2\xcd\xae\x01\x5d\xd1\x84\x3f\x07\xec\x16\x36\xb8\xa3\xee\x90\xe2\xb2\x61\x45\x23\xa1\x10\x68\x13\x63\x10\x0a\x21\x59\x19\xdd\xf7\xb1\x50\x7d\x3b\x3d\x2a\x93\x21\x43\xae\xc6\xf7\xd0\x99\x61\x7b\x20\xd4\x45\x04\x5b\x50\xbf\x9d\x3b\xb1\x1f\x85\xc9\x87\x1b\xc5\x54\xda\xa4\x84\x7e\x02\x67\x71\x0e\x4a\xf6\xec\xb3\xc1\x17\x7a\x62\xcf\x54\x1c\xac\xae\xa0\xca\xb0\x45\x84\x82\x89\x42\x3d\xfe\x10\xc8\x2c\xb9\xb7\x44\xe8\x13\x44\x0e\x0a\xd9\x8a\x4c\xb1\x0a\xed\x7a\xbc\xcb\x08\x73\x0a\xa4\x1d\x50\xb0\x83\xd6\x3d\x37\x61\x58\xf1\xc5\xa0\x06\x2a\xce\x06\x5e\x49\xea\xf1\xa3\xbf\xcf\x93\x0a\x4a\x44\xc3\x39\x73\xe2\x3b\xb5\xa0\x4c\x4c\xd5\xd9\xa8\x27\xf0\xfd\xd8\xac\x85\xc2\x0c\x55\x7c\x7d\x01\x26\xa0\x46\x6b\x6e\x31\x71\x3d\xee\x8d\x09\xba\x5b\x2d\xff\x2c\xdb\xa1\xc5\x31\x5a\xff\x0c\x29\x4a\x23\x69\xdd\x1a\x75\x3b\x05\x33\x30\x78\xee\xf2\x23\x50\x8b\x90\xd5\xef\xe3\x84\x9e\x64\x80\xb4\xc1\x02\x20\x6d\xbd\x14\xaa\x6c\x0e\x54\xf9\x1e\xf9\x7c\x80\x14\x14\x56\xee\x14\xef\x71\xa2\xf4\x56\x1c\xac\xb7\x6b\xfa\x41\xa0\xcc\x2f\x87\x76\xea\x56\x3f\x06\xc1\x78\x24\x9b\x97\x8a\x84\x4e\xe0\x44\xf3\xad\x11\x86\xed\x89\xa8\x3c\x7e\xd3\xc3\x91\x00\x17\x20\xe1\xaa\x5b\x4e\x10\xb6\x64\xc4\x0d\x29\xb5\x8a\x90\x31\xe9\x35\x6b\x04\xc7\x6c\x3b\xe6\x2a\xb0\xec\x05\x89\x98\x6a\x68\x36\xa4\xbf\xa1\xc4\x23\x74\x47\xf8\xc8\xcd\x1d\x0e\x21\x07\x8e\x86\x21\xc8\xc1\x8c\xa8\x5a\x21\x7b\x21\x3e\x13\xe4\x39\xa6\xd2\xd7\x20\xab\xf3\xd9\x46\xcf\xba\x8c\x27\xad\x11\x1d\x97\x86\x60\xa1\x70\xf2\x16\xb5\x68\xe1\xbc\x6c\xf8\x56\x1b\xde\x6b\x73\xd8\x50\x46\x07\x94\xbd\x6a\x61\xb6\x38\x73\xee\xd4\x32\xee\xd8\x24\x13\x04\x06\x88\x75\x22\x29\xe6\xc5\xf1\xdb\x99\xa9\x8c\x1c\xa2\x6e\x01\x01\x21\xe4\x14\x2f\xd1\x13\x7c\x2c\xb9\xde\xa3\x30\x48\x0e\x03\x96\x11\x86\x22\x9a\xde\x7a\x64\x6a\xaa\x68\xb3\x1a\x5a\x18\x98\xdb\x0f\xbe\xde\xd8\x1c\x3c\x40\x30\xae\xed\xc4\xa1\x29\xbd\x13\x11\x2f\x01\xf5\x2d\x1a\x82\x5b\xb6\xbd\xd1\x87\x95\x9d\x52\x0f\x87\xbd\x91\xbd\x98\xc7\x4c\x9f\xe6\xd3\x7d\x52\xc0\xfb\x51\xee\xea\x17\x55\x76\x3e\xd9\xb0\xbd\x06\x80\x87\xbe\x16\x5e\xb2\x17\x8a\x7a\x9d\x22\x38\x97\x88\x95\x82\x4d\x7f\x38\xa4\x00\x69\xca\x1f\xc2\x6f\x7c\x03\x20\x98\x54\x9f\xdd\x29\xa0\xce\x89\x44\xea\x50\x66\x0e\xc0\xa6\x0c\xd6\x9f\x14\xd9\xd3\x4b\x96\xd2\x5a\xd9\xb9\x63\x63\x8e\xb5\x94\xdd\x33\xf7\xce\x81\x62\x85\x02\x6f\x38\x36\x8d\x07\x55\xb9\x7b\xe3\xde\xf1\x6d\x47\xf4\x6b\x68\x1e\xf8\x8d\xc1\xa4\x8d\x10\xd3\x94\xe6\x2c\x23\xc9\x39\x3c\xdf\x7f\x58\x1b\xc9\x06\x06\x81\x4a\x02\x7e\x0e\x1a\xd1\xb4\x15\xac\x13\x3d\x6f\xec\xc6\x53\x53\xc8\xe2\x41\x2c\xf3\x38\x9f\x17\xd3\xbe\x74\x07\xba\x85\x04\x74\x0a\x85\x86\x5c\xa7\x2f\xbd\xd9\x06\xc0\x66\x6f\xd9\x8f\xba\x69\xd8\x6d\xf9\x35\xe5\x17\xb5\x7b\x7b\xd2\x02\x4b\x17\x70\x18\x27\x80\x88\x51\x8b\x7a\xb6\x14\xd0\xb5\x18\x6b\xef\x4b\xc5\xae\x23\xbd\xcf\x89\xbc\x0d\x18\xae\xe0\xa2\xb8\x1d\x06\x74\xb3\xd8\x88\x5b\x62\xe5\x30\x47\x62\x24\x63\x7b\x2d\x77\x4a\x43\x16\x35\x01\x91\xc5\xe1\x2e\x4a\x78\x7e\xec\x9d\xb3\x45\x8c\x44\xb9\x47\x91\x75\x6b\x37\x7a\x2f\x6c\x3f\x73\xf7\xcd\xa8\xcf\x7c\xbe\x23\x9e\x30\x52\xe9\xf8\x8e\xd3\xb1\x58\xb7\x0d\x84\xa1\x4c\xaf\xcf\x44\x51\x5e\xc2\xb9\xa3\x53\xfe\x13\x5b\x3b\xe7\xcf\x7d\xa5\xe1\x5d\x76\x11\xe8\xb6\x79\xcb\x3e\x0e\x5b\x64\x27\xcf\x46\x9b\xba\x6b\x36\x8a\xb8\xe0\x4a\x20\xd4\x21\x79\x34\x3a\x40\x15\x73\x30\x71\xef\x49\x76\x68\x86\x52\xd9\xd6\xfb\x23\x37\xf2\xdd\x03\x01\x75\x1c\xda\x48\x01\x37\x5f\x8b\x84\x29\x0a\xc7\x82\x86\x31\xe5\xab\xa7\x20\x99\x66\x7b\xe9\x15\xff\x1d\x50\xc9\x23\xaf\x2e\x66\xfc\x10\xac\x50\xd4\x1c\xbc\x53\x42\x25\x9e\xcf\x6b\xf0\x65\xa8\x5e\x50\xbb\x4b\xa5\x3c\x91\xd7\x67\xab\x1c\xdf\xae\x4b\x75\x37\x6e\xab\x7e\xec\xb5\x99\x86\xc5\x73\xdd\xd2\xdf\x9c\xc3\x82\x7a\x7b\xb6\x09\x86\x4e\x4b\x24\x71\xa9\xc4\xde\x73\x75\x57\x4f\x24\x73\x5c\x43\x27\x9c\x22\x9b\x72\xaa\xc6\x7b\x53\xf4\xb2\x78\xb3\x8a\xed\x65\x9e\x3c\x18\x3a\x4f\xd0\x7f\x95\xc8\xda\x65\x61\xba\x01\xd6\x07\x0e\x85\x4f\x7d\xf7\x20\xe7\xe7\xc5\x5e\x28\xf3\x72\x43\x4a\x30\x94\x17\x3f\x2b\x21\xfe\xc9\x6b\x84\x48\xe0\x2d\x78\x94\xcd\x81\xe9\xad\x2d\x06\xe3\xe9\xe9\xc4\x67\x6c\x82\x25\x36\xa4\x54\xf9\x05\x12\xde\x7a\xb0\xcb\x3b\xec\xd3\xbc\x6c\x8c\xb4\xc8\xc7\xed\xfd\x54\xdb\xc8\xe2\xc1\x85\xaf\x81\xd0\x1e\x64\x97\xaa\x4a\x16\x43\xd3\xb3\x5e\x18\x37\x3b\x84\x9e\x4e\xf2\x43\xf0\xb4\x76\x02\x0f\x0a\xde\x12\xb2\x28\x63\x94\xed\x7e\x08\xf1\x93\xb3\x85\xce\xb6\xdb\xc0\x80\xc5\x48\xfe\x44\xe6\x80\xae\x79\x1a\xf2\x23\x34\x57\x68\xc6\x3b\xa3\xd5\x22\x60\x32\xcf\x6f\xc1\x3c\x84\x55\x89\x21\xe1\xf8\x61\xe6\x78\x9d\x69\x80\x49\xda\xed\x28\xf8\x6c\x21\xdb\x16\xa8\xa0\x8b\x74\x67\xac\x8a\x08\x1a\xbd\x5f\x65\x0a\xce\xd2\x70\x3a\x5d\xe2\xf2\x7a\x7b\x06\xe5\x16\x5a\x8d\x7b\xec\x4b\x28\x37\x9d\xb7\xb7\xb1\xef\x02\xf2\xc2\x6e\xb1\x71\x5c\xf2\x78\x37\x2b\x3f\x27\xc7\xcb\x08\x90\x02\x3b\xdc\x6f\xe2\x62\xd8\xce\x02\x14\x8f\x12\xe5\xcf\xf0\xab\x52\xa3\xee\x31\x67\xe3\x1c\x29\x1f\x15\xaf\x12\x25\x9c\x3b\x77\x73\x09\x09\x9e\x47\x67\x26\x64\xa2\xdd\x76\x9d\x6a\xb7\xdd\x7f\x03\x26\x64\x7e\x13\xd9\x51\x45\x7a\xb0\x49\x41\x1a\x76\x98\xdf\x9b\x81\x9c\xd5\x7d\x85\x20\xcf\xd1\xc1\x01\xb7\x3b\x45\xcc\x6c\x98\xd2\x3d\xdb\xea\xbe\x7e\xcb\x7e\x14\x3b\x6e\x4a\x70\x1f\xf4\x24\x19\x15\xd5\x6d\xa1\xba\xee\xd5\x4d\xed\x26\x0e\x0a\x76\x59\xec\xda\x73\xfe\x15\x96\xfc\xb5\xf1\x5c\xd3\x39\x27\xa0\x1f\xf3\x8a\xcc\x47\xd2\xe5\xd4\x8b\xb6\xc3\x8c\x01\xb8\x03\xfe\xf4\xf7\x0d\x59\xa3\x54\xe1\x55\x78\x50\xaf\xde\x79\x7d\x89\x26\x49\xb1\xab\xab\x31\x64\x31\xa7\x72\x87\x3c\x20\x41\xbc\xa3\x93\x99\x4c\x4a\x02\xa1\xf3\x98\x98\xdc\xf0\x8c\x38\x60\x7c\xcd\xcb\xf7\xab\xb8\x5d\xd8\x88\x5e\xa4\x09\x4d\x24\x72\x81\x19\xcd\x54\xe7\x2d\xdb\x73\x7b\xc2\x41\xbb\x3f\xe9\x3d\x7b\x62\x78\xcc\x51\xcd\xed\x07\x9e\x29\x52\x85\x57\xb4\x40\x40\x48\x33\x04\xa1\x12\xf6\xe8\xaf\xb2\x89\x4a\x36\x8d\x2e\x5e\x59\xf6\x51\xe9\xfd\xd6\x1d\xd6\x1f\xf7\xdc\xb4\xeb\xb3\x26\x67\xa1\x71\xce\x08\x5d\x29\xa1\x4f\xd9\x61\x8f\xfe\x03\x4a\x4a\xd0\xc6\x06\x40\xf8\x33\x81\xe0\xcf\x84\x84\xb2\xaa\x19\x8c\x39\x04\x18\x28\x24\xcf\xb6\x70\xa4\xce\x61\xba\x8f\xba\x34\x33\x78\xee\xdb\x27\xc2\xb9\x8f\x9d\xfe\x33\x58\xee\x3b\x56\xe8\xa6\x7c\x11\x20\xf7\xbf\x11\xb0\x79\x4c\x08\xb9\x40\x3a\xff\xc5\x32\x42\x01\xa5\xb3\x8a\x6d\xb2\xe3\xa4\x79\xfc\x7e\x96\xb8\x7e\x0e\x01\xf7\x92\xaa\x70\x5f\x48\x74\x27\x53\xf1\x92\x8f\x19\x75\x4b\xc8\x44\x5c\x66\xe1\x75\xd6\x96\xeb\x31\x8a\xb6\xe7\xdb\x46\x64\x1a\x1f\x79\xd7\x8f\xf6\x7d\xba\x80\xcd\xf7\x1d\xbc\xeb\xb4\x32\xa0\xc5\x0c\x8e\xac\x7b\x25\x5b\xde\xd8\x55\xc9\xba\xf7\x2f\x90\x27\x7e\x32\xc4\xfd\x1c\xfc\x13\x7b\xfd\x1f\x30\x99\x31\x00\x0c\x6c\xfc\xf7\x0a\xca\xb2\x36\x60\xa2\x8e\xbc\xc2\x9d\xef\x4c\x4c\x6a\x33\x6e\x6c\x46\xd4\x40\xaa\xa5\x4a\x42\xff\x34\x07\xa8\x1a\x0d\x0a\x4b\x80\x30\xa9\x50\x66\x3c\x06\x03\x79\x08\xf4\xf2\x10\x15\xef\xb9\x21\x0a\xf5\x0a\x93\x75\xf4\x63\x69\x19\x11\x93\x51\x71\x55\x1a\x80\x1d\xc4\xce\xe2\xed\xd0\xbb\xe3\xb2\x95\x4a\xb6\x43\xbb\xf1\x3f\x74\x5b\x18\x88\x25\x0f\x40\xb0\x51\x69\xd3\x12\xc7\x34\x10\x67\x2b\xc1\xcd\xf6\x10\x80\x1b\x54\x7e\x71\xcb\x47\x18\x9b\x02\xee\xf7\x35\xef\x05\x95\x7d\x0f\x88\x54\x20\x06\x1f\xb4\x90\x8f\x22\xa1\xf4\x49\xb4\x09\x3b\x67\xdd\x4a\x7e\x20\xbe\x10\xd0\x4e\x73\x2e\x2e\x20\xa2\xb4\xfb\x8d\x1d\xb0\x20\x8e\xd5\x58\xfc\x1b\x36\x47\xd2\x61\x57\xf1\x47\x8d\xea\x4f\xd8\x85\x8c\x3e\xc1\xfb\x0f\xaf\x20\x6b\x5e\x18\xb1\x3a\xf2\x89\x0b\xfd\xbd\xe0\xd3\x9e\x8d\xe7\x2a\x4f\x5e\xad\x3b\xd6\xb7\x83\x51\x18\x2f\x16\x42\xd9\x45\xa2\xb8\xe7\xab\x55\xac\x81\xf8\xc1\x0a\x37\x5c\x11\x3b\x82\x68\xb7\x8d\xa7\x33\x45\x09\x48\xb0\xfe\x09\x34\x04\x31\xb6\xda\x74\xf6\x04\x48\x9f\xfb\x86\x40\xc4\xbf\x10\x91\x9b\xbb\x0b\x30\x85\xc1\x8a\x73\x0b\xb3\xd3\x56\x78\x8a\x23\xb8\x2a\x86\x4b\x40\x77\x48\xbd\x20\xad\x1e\x40\x7c\x29\x21\x99\xf2\x7a\xe5\x11\xa2\xed\x4e\xee\x40\x36\x44\x97\xda\x2f\x70\xb3\x4f\x54\x34\xaf\x2e\xdd\x62\x5d\x2c\x7f\xce\xa7\x82\x02\xc0\xc8\x3e\xa4\x37\xc7\x28\x12\xa8\xf5\xc2\x13\x67\x0f\x8a\x74\x77\xd0\xea\xeb\x61\x31\x0f\x4a\xec\xdd\x69\xeb\xe6\x3e\xdd\x82\x38\x48\xb5\x1b\xf8\x0e\xf9\x2d\xdd\x17\xdf\xb2\x7b\x98\x54\x44\x31\x0e\x76\x00\x1b\xb4\x35\x52\x54\xd0\x23\xd6\xf5\x6e\x65\xb8\xa0\x47\x74\xa2\x97\xbd\x7c\x14\x49\x7c\x80\x63\x00\xce\xd7\x52\x80\x86\xbc\x5b\x8a\x43\x63\x29\x01\xad\xab\x0a\xa0\xd5\xbd\x19\xfa\xda\xb9\x39\x9e\x8a\x2f\x6d\x1b\x70\xaf\x89\xa4\x3b\x00\x4c\xd2\xcf\xd0\xfc\x79\x55\xa7\x59\x95\x2a\xdf\x33\x3f\xea\xd4\xd5\x43\x83\x57\x45\xfc\xca\x06\x67\x09\x22\x18\xe4\xe6\x02\x5c\xb8\x34\xc8\x07\x33\x78\x30\x11\x3e\x13\x2e\x12\xac\x9f\xe3\x7c\x06\xd3\xb7\x89\x5d\xbe\xa9\x09\x27\x26\xad\x70\x2a\xd7\xbc\xeb\x00\x11\x6a\x25\xe8\x24\xa2\x96\xfc\x26\x59\x82\xb6\x13\x05\x92\x1f\xa1\x31\xad\x06\x4c\x6c\x3d\x0a\x75\xf6\xc9\xfb\xb7\x86\xab\x7e\x7a\xf0\x3e\x37\x2f\xe3\x4e\x58\xf6\xfa\x5d\x96\x68\x7c\xf3\x25\x8f\x5c\x3a\x61\x17\x4f\x53\x68\x2f\x01\xc2\xc2\x48\x2e\xf5\x2e\x16\x95\x80\x00\xd5\xcd\x89\x3f\xf1\xac\x50\xbd\x14\x9e\x2f\x31\x10\xb3\x6d\xdc\xc1\x46\x1b\xa3\x9d\x39\x9d\xf3\x53\xd8\x43\x40\xb0\x49\x99\x32\x6a\xc8\xc5\xe4\x71\x1e\xb1\xf5\xde\xef\x55\x3f\x48\x7c\xbf\xf8\x62\xe7\xf2\x7c\x73\x27\xde\x26\xf0\x6f\x4c\x8f\xdd\x11\x2f\x34\x1e\x9f\x6e\xa6\x4a\x60\x41\xee\xc1\xbb\x0f\x60\x1f\xe0\xc1\x19\x5a\xe4\x8c\x5c\x05\xf1\xc4\xa6\x4c\xb7\xa9\x26\x89\x58\x2c\x83\x83\x1b\x01\x0f\x07\x94\x75\x7d\x9d\xb2\xe5\x43\x21\x14\x5d\x01\xb0\x0b\xf8\x8d\x37\x5e\x2a\xb4\x94\x8a\x9b\x43\xb8\x5e\x22\xdb\x3a\x75\x71\x7e\x30\xc1\x32\x52\xfe\x76\xf4\xeb\x3d\x84\x74\x7e\x9a\xdd\x7f\x1a\x61\x01\x3c\xad\x80\xb8\x78\x3c\xfa\x85\xbb\x6d\xe0\xd1\xdc\x84\x3f\x02\x93\x80\x1b\xff\xd6\x70\x85\xf2\xda\x9a\xd5\x98\x7c\xef\x06\x63\x07\xf0\x6e\x12\xae\x86\x45\x9c\x27\x0e\x6e\xce\x77\x41\x22\x1f\x78\x47\x3d\xb7\x0f\x36\x80\x7d\xb6\xc2\x05\x8d\x47\x9d\x95\xe4\xc8\xf2\xb6\x08\x59\x4b\xfd\x4c\xf4\x9a\x0d\x9d\x71\x7b\xd6\x2d\x6e\x2b\x9a\x47\x61\x23\xa2\x15\x16\x91\xd7\x63\x25\x77\xac\x32\x42\x78\xce\x34\xff\xd4\x1b\xd6\x0b\x55\x1a\xd9\x78\xe9\xff\x9e\x37\x0f\xa7\xb0\x3d\x9c\xd6\x45\x9a\xe8\x4e\xf2\xff\x89\x34\xca\x78\x8b\x05\xfd\xd7\x03\xe2\xef\x5c\x8c\xce\xe1\x6b\x5a\x85\x93\x0b\x87\x7d\xf0\x4e\xb5\xd2\xa1\x80\x9d\xec\x5e\xa9\xaa\x66\x10\x68\x76\xcf\x88\x55\x23\x8c\x99\xa6\x71\xc4\x21\xe9\xe2\x56\xb5\x6b\x12\x82\x45\x23\x1a\x17\x35\x23\xc5\x4a\xef\x56\x58\x82\xbc\x5c\x67\xc2\xa5\x5b\x54\xdf\x34\xb2\xdd\xfe\x36\x72\xd5\x31\xa5\xbe\x95\xfd\xd0\x0a\xe5\x45\x12\x60\x64\x2f\x4f\x73\xc5\x5e\x7f\xab\x8d\x48\x45\xce\x3e\xe8\x41\xf5\x5c\xaa\xf8\x97\xbf\xbb\x9d\x56\x72\xf3\xb0\x44\x27\xb0\xba\xcc\x86\x2c\x50\x23\xe2\xd3\x43\x88\xac\x77\x5e\x05\x3f\x5d\x41\xb0\x0f\x86\x6e\x03\x7a\xf4\xc4\xd1\x81\x04\xbc\x8f\xc2\x20\xa3\x88\x1d\x4c\xc5\x0b\xda\x57\x43\x07\xa8\x58\xf7\x6d\xc6\x81\x13\xa7\x10\xb2\x41\x7c\x2d\xb6\x84\x34\x02\x53\x49\xce\x01\xa9\x39\xe4\x17\x8d\xc8\xd5\x6b\xc1\xec\xe2\x70\x78\x94\x99\xc6\xb4\x7b\xa8\x8a\x03\xa3\x22\xa2\xcb\xf1\xa3\x95\xcb\xec\x41\xb0\xef\xc0\x10\xbf\xf8\x32\x5b\x5b\xc7\xb5\x40\x55\xec\x36\x76\xc7\xfa\x5a\x1b\x64\x61\xa2\xbf\x42\xa8\xd6\xef\xe5\xce\x63\x27\xe0\x7b\xe0\x40\x41\xb8\x00\xe9\x8d\x2f\xd4\x86\x3c\x8d\xf0\xc7\x65\xa0\xe9\x4a\x3d\xea\xa1\xe4\x65\xcf\x50\xc2\x5d\xaa\xd2\x66\x98\x17\x90\x88\xf7\x1a\xdc\x46\x0f\xb0\x5c\x8c\xfb\x87\x7c\xa0\xc5\x86\x93\x97\x14\x71\x7d\x9a\x67\x7a\xde\x1d\xa1\xea\x4d\xea\x90\xa8\xca\xe5\x65\xbc\x13\x47\x04\xad\xb5\x8c\x22\x99\x37\xac\x93\xc2\x14\xb1\xb5\x10\x4f\xc0\x47\x61\x0e\x81\x27\xb3\x67\xbd\xe1\x8f\x62\x99\x5b\x17\x96\xbc\xe7\x12\x0a\x9c\xbc\x09\x89\x8d\xb4\xac\xe0\xad\x1e\xaa\x86\xef\x70\x01\x34\x5a\x3f\xf8\xde\x8e\x51\x3b\x97\xd7\x00\x73\x1e\xb5\x10\x49\x95\x9a\x5a\x31\x65\x2b\xf2\xda\x43\xc1\xad\x98\x93\x2a\x7c\xfd\x37\x61\x88\x37\xe6\x74\xbb\x02\x5a\x7f\x8a\x53\xf0\x46\x34\xe5\xe0\xfb\x3b\x03\xa5\x07\xeb\xe1\x32\xf0\xd2\x71\xf7\x4f\x80\x18\xd7\xe5\xcd\xaa\x04\xa7\x8b\x54\xd8\x77\x03\x37\xa5\xe4\x6a\x9d\xab\x7f\x0e\x1a\x03\x5d\xfd\xab\x2c\x65\xbe\xe4\xea\xd3\xe1\x01\x84\x01\xf6\xd0\x6e\xf5\xf9\x94\x88\xcf\x22\x09\x60\xaf\xbf\x31\x7a\x7f\x54\xdb\xb9\xe0\x58\xf2\xec\x6b\x0a\xf2\xc0\x29\xf2\x82\xc6\x5e\x16\xe3\xc0\xaa\xc6\xb9\x1d\xb8\xec\x48\x9c\x85\x47\x82\x75\x5d\xb1\x2b\x5a\xda\xd3\x9d\xf4\x7d\x8c\x62\x77\xd0\x23\x6f\x98\x12\x43\x6f\x78\xb3\xa1\x14\x1b\x48\x24\x18\x4f\x78\x16\x09\x0b\x76\xa2\x91\x85\xfb\x7e\x25\x0e\xa0\xb2\xe8\xf3\x1f\x6f\xb2\xab\x8a\x47\x89\x97\xf2\x82\x2d\xac\x92\x42\x95\xd2\xd6\xab\xe8\xba\x46\x95\x81\xe8\x79\x05\xe0\xfa\x51\xb9\x99\x94\xd9\x17\x31\x55\xb9\xaa\x0b\x74\x16\x25\x35\xe2\x34\xee\xce\xab\xc4\xb9\xef\xe7\xac\x57\x00\xa8\xce\x41\x39\x5e\x10\xc2\xf1\x14\xe5\xec\xc8\xd0\x93\x8b\xcc\xb3\xd7\xf2\xe8\xcb\x7e\xe3\xfe\xdb\xfd\x44\x89\xc2\xe8\x5e\x16\x73\xbf\x71\xaf\xf2\xcd\x52\xe1\x71\x34\x84\x99\x9a\xd1\x17\xad\x0f\xdd\x84\x86\xeb\x67\x13\xdf\xbc\x33\x53\x51\xe2\x77\xeb\xc0\x66\xb0\x51\x9d\x9f\xf3\x13\x30\x1f\x3c\xa3\x72\xfa\x4c\xae\x94\x67\x6b\xd0\x47\x95\xdf\x2a\x15\xfd\x75\x16\x6c\xb9\x19\x95\xb3\xaa\xd1\x90\x9c\xda\x44\xf3\x41\xb4\x13\xb9\x9f\x70\x3c\x92\x75\xef\x10\x6b\xfc\x47\x1b\x0a\x8e\x52\xf6\x47\x2b\xd1\x9e\x64\x3a\x58\x12\xe4\x4b\xf8\x2b\x96\x18\x0b\x90\xf0\x87\x16\x59\xf0\x71\xdd\x02\xfb\xfd\x02\x19\x08\xa9\x07\x2f\x2b\xd8\xd8\x11\x3c\x24\xeb\xa4\x49\x1e\xd3\x4b\xdd\xd1\x24\xe3\xd9\xe4\x41\xca\x88\xc7\xef\x04\x39\x13\x67\x4c\xc0\xa9\xc8\x33\xdc\x30\x74\x69\x85\x1a\x09\x9c\x16\xb1\xce\xfd\x96\x96\x0f\x54\x75\x4a\x81\xc8\x95\x7d\xad\x11\x1e\x6d\xad\x2e\x64\x6c\xfb\xc8\xd4\x9d\xe9\x0e\xaf\xb9\x65\x1f\xfb\xb7\xec\x9b\xa1\xaf\xb7\xc2\x40\x8b\xda\x83\x72\xa1\x4a\x05\xbb\xdd\xb2\x96\x53\x76\xec\x53\x4d\xb5\x92\x1a\x2c\x78\xdb\x0a\xf3\x06\x57\x42\xc6\xf3\x47\x55\x43\x23\x20\xdb\x2f\x52\x4a\x95\x7f\xa7\x32\xf6\xd7\x89\x87\xda\xef\x75\x62\xab\x6c\xa2\x71\xf8\x04\x15\xf5\xab\xf2\x6b\xfc\x74\x8d\x55\xeb\xb9\xe7\x97\x9a\x17\x57\xbd\xf9\x6f\x71\xd5\x4c\x5c\xf5\x18\x9f\x64\x58\xcf\x10\x86\xb8\x77\xac\xfb\x1a\x7b\x53\xa9\xeb\xab\x3c\x25\xac\x7a\x53\xde\xb1\xce\x1e\x8a\x3a\x9e\xd2\x99\xb6\xea\x39\x6a\xe6\x47\xc0\xa0\x73\x34\x7a\x09\xd9\x0b\xf5\xf6\x91\x21\x83\x28\xc3\xa6\x45\x81\x9c\x9f\x65\x93\x60\xb5\x12\x14\xd3\x2c\x3d\x0b\x11\x98\xac\x42\x4b\xf4\x52\x41\x02\xe1\x9b\x46\x0f\xeb\x0a\x99\xe7\x84\x12\xab\xf1\x49\x46\x03\xc5\x98\xd8\xed\x7c\x26\xc0\x9d\x76\xf6\x61\x50\x0f\xac\xe0\xdb\xad\x9b\xbe\x46\xf0\xc7\x17\x87\x79\x26\xc5\x86\x28\xb2\xbf\x26\xf9\xc4\x5e\x7f\xeb\x5c\xf0\x37\xa7\x7a\xc5\xfc\x61\x3e\xcf\x5d\xa2\x2b\x76\x10\x4d\xa3\xf7\x1b\xa6\xf8\x60\x05\x16\xa3\x76\xfc\x74\x7a\x80\x8a\x7f\xd0\x2b\x66\x3b\x23\x78\x69\x7d\xf4\x52\x68\xa3\x20\x2f\x8d\xe8\x4a\x7b\x14\xdf\x9d\x5e\xa3\x81\x8e\xf0\x98\xa1\x95\xe6\x2c\x9c\x68\x8e\xb9\xf2\xed\xcf\x1e\x0a\xd8\x1c\xd2\x7c\x02\x35\xb8\x8d\x14\x89\x83\x13\xbf\x8a\x4e\x33\x9c\xbc\x9d\x96\x36\xb8\x16\xc7\xfd\x79\x50\xe3\xc9\xf9\x0e\x02\x66\xd1\x88\xbe\xa8\x7d\x5b\xae\x11\xc2\x4d\x45\xda\xae\x04\xdf\x2c\xb5\xdb\x94\x4a\x60\x16\x62\x6b\xa0\xb8\x02\x50\x3a\x23\x92\x76\x11\x1c\x0e\xe3\x43\xaf\x5b\xde\x13\x17\x74\x02\xd7\x3d\x93\x47\xb3\xd5\xa5\x30\x98\x17\x57\x25\x7b\x1d\x95\x0b\x2e\x41\x74\xc7\x62\x2f\xab\x1e\xcc\x9b\xd0\xc7\x67\xd3\x39\x06\xcd\x9d\x1b\xe4\x71\x9c\x74\xff\xc5\xcb\x5d\x2f\x5d\xce\xd9\x6d\xd4\xee\xc1\xeb\xac\x4a\x33\xf6\x5a\x09\x06\xea\x0f\xab\x4e\xb9\x17\x4f\x66\x5b\x5d\xf5\xac\x68\xf8\xc1\x6b\x3b\xf8\xa6\xa2\xbd\x36\x0f\x10\x8c\xf6\x9a\x01\x39\x44\x43\xb2\x50\xc2\x4a\x50\xfd\x45\xa6\x66\x6a\xdd\x84\xc7\x40\x1a\x98\x67\x62\x23\x33\x0a\xa5\x95\x19\xef\x45\xcb\xe2\xd1\x60\xe9\x18\xdd\xb8\x3f\x88\x52\x0e\x2d\xa9\xd8\x1b\xcc\xa7\xa2\x98\x29\x67\x96\x74\xbb\x74\x45\x3f\xcb\x14\x49\xc6\x12\x37\xad\x50\xd6\xab\xc8\x63\x6b\x6b\xef\x25\x17\x0e\x34\x4f\x48\x10\x3d\xc8\x9e\x1a\xde\xbb\xc1\x20\x04\xe3\xa3\xce\xca\x62\xe4\x65\x83\x42\x25\x11\x2a\x20\x3b\x0e\x62\x4f\xa9\x03\xd1\x53\xf1\xc9\x52\x23\x35\x4d\x01\xb8\x01\xa4\xa6\xa5\x6e\x07\x22\xad\x44\xb9\xb9\x48\x0d\xb5\xe7\xa0\x6b\x96\xd3\xd0\xef\x39\xe6\xf9\x62\xf3\x18\x3d\x22\x94\x26\xa9\x4a\x0b\x63\x82\x84\xbb\x1f\x18\x4e\x4d\xa9\x51\xde\x46\x02\xee\x94\xb7\xb0\xbd\xad\xe0\x78\x06\xc3\xa7\xb6\x1e\xc8\x51\xa0\xe9\x4f\xc8\x64\x82\xe8\x0b\xf5\x09\xef\xa1\x6e\xb8\xf3\x2d\xfd\xac\xe1\x7d\x51\x63\x0d\xab\x92\x4a\x18\xd6\x82\x4e\x0a\x14\x14\x4a\xd1\x73\xd9\x10\x7d\x8f\xe7\x7d\x5a\xbd\xed\xec\x83\x9c\x06\xcc\x73\x9b\x6e\x96\x5b\xf4\x39\x9b\x8e\x34\xf2\x52\x19\xbc\xab\xa7\x6b\xdf\x9d\x5f\x56\xca\xd3\x83\x47\x77\xda\x71\xd4\xde\x1c\x95\xd4\x39\x10\xe6\xa4\x8c\x88\xa2\xfa\x90\xbc\x6a\x84\xad\xb1\xc9\x75\xa1\xf8\xc4\x29\xbb\xef\x89\x99\x33\x8a\xdb\x6e\x56\x90\xdf\x39\x98\x46\x58\x9f\xa2\xec\x75\x4a\xbb\xbc\x6d\x86\x72\x27\xb4\x82\xfc\x81\x4f\xd7\x53\xf5\xb6\xe1\xb6\x8e\xc9\xfb\x95\x0b\xcb\xb4\xec\x23\x3a\x2d\xb3\x58\xf6\xf5\xb1\xcb\xd3\x8b\x47\x2f\xeb\xfa\x3d\x17\xad\x7e\xd4\xa3\xdb\xd7\xd2\xc0\x6b\xe6\x90\xf9\x45\xc2\x49\x30\x71\x41\x8a\xf3\xfc\x1e\x3d\xba\x3c\x76\xbe\xda\x05\x2a\x80\x09\xfa\xbd\x8f\xbf\x86\x8c\x6b\x42\x51\xe5\xdb\x66\x83\x6f\x14\xb3\xa8\x33\x8d\x46\x81\x6e\x75\xdc\x6a\x04\xa1\xca\x75\x79\x97\x2e\x3a\xbf\xb8\x92\xc1\x43\x4e\x98\x17\x02\x55\x7a\xc7\x7d\x7d\x4f\x46\xca\x81\x94\xc6\x24\xc1\x03\x29\x49\x8f\x29\xa4\xcd\xe8\xf9\xc9\xdc\xb7\x1b\xd2\xd2\xf6\x1c\x99\x48\x92\xd2\xc5\x2e\x67\x7c\x43\x91\x98\x07\x26\xa5\xd7\x7b\xb7\x49\xdd\xf9\x90\xed\xe1\xfc\xb5\xdd\x5d\x66\xd9\x2f\xbc\x52\x60\x0c\xa5\xae\xa1\x34\x91\xf6\x64\x7e\xd0\xec\xf5\x2e\xe6\xd7\x5c\xc0\x3b\x66\x04\x5d\x9a\xf3\x49\xb7\xfe\xbb\xfc\xa1\x68\x42\x60\xcc\xe3\x28\xf7\x68\x19\xcd\x22\xc9\x85\x9b\x6b\xd8\x08\x5f\x34\x45\x74\xbb\x3a\x93\x1d\x52\xd8\x14\x86\x78\xf5\xb5\x93\x1d\x10\x37\x7d\xfd\x2b\x36\x40\x9c\x48\x45\x5d\x27\x11\xff\x99\x49\x00\x92\x11\xfb\x87\x50\x3b\xc1\xe7\x08\x4d\xe7\xb4\xda\xce\xaa\x29\xbe\x34\x91\xcb\x93\x48\xac\xef\x59\x51\x13\x66\xda\xc2\x33\x63\x94\xe4\x7c\x5b\xbb\x39\x6e\x92\xa9\xfc\x45\x85\x78\xc1\x4b\xb2\x17\xdc\x97\xf2\x75\xc5\xde\xd1\x9e\x7f\xcb\x22\xd7\x70\xe5\xce\x59\x31\x02\x3d\x79\x06\xd1\xad\xd6\x6d\x40\x53\x85\x98\xcc\x32\xa3\xb9\x19\x1b\x71\xfc\x45\x0c\xeb\x5e\xe7\x6e\x3b\x48\xaa\xc2\xde\xdc\x0a\xe5\xe2\xd2\xf8\xd5\x37\x13\x93\xbf\x52\xac\x62\x1a\x4e\xf3\x71\x65\xca\x19\xfc\x91\x7c\x05\x20\xe5\x3c\x04\xc1\x13\xa1\xba\xed\x73\xeb\x0b\xbc\x27\x73\x08\xb1\x00\xeb\x0e\x3a\x50\x72\xca\x3a\x93\x63\x10\x8d\x45\x0a\x0a\xd3\x4c\xcb\x3a\xa3\xcb\x01\x60\x38\x91\x0d\xc1\xef\xe4\x34\xe3\xbf\x34\x84\x1f\xe1\xee\xd7\x7f\x64\xf7\x85\x2c\x65\x81\xfd\xf8\xd8\xd5\x1d\x0f\x06\x37\xb1\xe3\x57\x04\x18\x4b\x0c\x02\xc6\x6f\xcb\x33\x32\xdf\x31\x5e\xc8\x72\x85\xe9\xc5\x41\xbc\xfb\x23\x0b\xe5\x6b\x2b\x3f\xc3\x91\x65\xf3\x33\x2b\x1f\x12\xc4\x27\xbd\x91\x0f\x62\xdc\x6d\xec\x69\x6e\x27\x8d\xc7\x93\xf5\xf2\x96\xdd\x43\x97\x87\x9a\x23\xc9\x0d\x78\x79\xe8\x09\x7a\x70\xe6\x31\x6f\x8c\x75\x43\xa4\x24\x83\xfb\x7c\xb6\x5d\xfd\x58\x47\xdd\xa7\x69\xe9\xf3\xea\x72\xe6\xcc\xfa\x62\x9d\x75\x38\xeb\x37\x7f\x64\x7f\xe1\xb2\x01\x17\xdc\xe2\xeb\x07\x28\xd7\xf2\xdb\x9f\x7f\xe5\x47\x3c\xa2\x93\x63\xb8\xbd\xb8\xba\xfc\x23\xfb\x6e\xf0\xe8\x9f\x11\x3b\x84\xb5\x9c\xbc\x21\xc8\xeb\x8d\xee\xfd\x36\x7e\x72\x1c\x0c\x44\x94\x7b\xd3\x7c\xe0\x38\x8d\x27\xe2\xcb\xc8\x1b\x1c\xdf\x62\xc4\x54\xe6\xa9\xfb\x29\x3b\x44\xe4\x2d\x26\x92\x2a\x49\x6d\x4e\x08\x21\x76\x41\x19\x52\x0e\x42\xce\x17\x5c\x89\xde\x53\xa0\x7a\x1f\x97\x92\x0a\xd4\xcb\x84\x46\x01\xa2\xa8\xb1\x5d\x00\x93\x60\xdf\xb2\x6f\xa5\xe2\x4d\x73\xd8\xb0\x1d\xcc\x21\x64\x3b\x92\xb4\x97\x3b\x85\xc2\xf6\xb9\xbe\x04\x75\xdf\x69\x02\x2c\x4f\xe1\x05\x96\xac\x4a\xef\x36\xac\x95\xb6\xf7\xc6\x5b\xb6\xb2\xe1\x26\x30\x00\xf3\x6c\x0a\xbc\x08\x59\x3b\xa8\x92\x2b\x11\xda\xcc\x79\x33\x83\xfe\x99\x1c\xdf\x57\x33\x7f\xba\x5c\x77\xa4\x0f\xbb\x1d\x22\xcf\xd7\x95\xce\xcf\xe9\x4e\x5a\xd6\xb7\xfe\x87\x57\x17\x51\xfc\xc1\x79\xfc\xbd\x56\xbb\x01\xed\xa3\x6f\xdc\x8f\x12\x23\x4a\x1c\x0a\xdd\x6e\x31\x39\xe5\xd1\xa7\x76\x0f\x6e\xa6\x3c\x1b\x46\xf4\xb5\x67\x5d\x5d\xd1\xc9\x97\xb6\x36\x2d\xe8\x54\x9d\xcb\x15\x8a\xd3\x0e\x60\xe5\xc1\x60\x53\x13\x30\xf4\x39\x7b\xf7\xda\xb7\x21\xa0\x52\xbc\xf3\x36\x14\x76\x63\xf7\x7b\xfd\x06\x2b\x57\xb8\x36\x80\x2e\xdc\x03\x97\x47\x22\x04\xb3\x32\xdb\x1e\xf9\xe6\x76\xb4\x89\x78\x7b\xdb\x93\x7e\xcd\x24\x9b\x1d\x4c\x79\x42\x6c\x94\xd1\x9c\x4b\xef\x05\x53\x00\x17\xd6\x53\x9a\x48\x2d\x11\xd5\x83\x80\x7f\xd6\x72\xa5\x50\x3d\x1b\xc8\x69\x1f\x88\x51\x2e\x9b\x08\xb7\x49\xc1\xc6\x39\xcf\x5b\x2b\xec\x45\xbd\xb7\x0f\xbe\x2d\x29\xe5\x6f\xb2\x3d\xdf\x3a\x77\x5c\x34\xd5\x86\x62\x4d\xfc\x17\xd3\x24\xb6\xef\x3c\x9a\x8d\x1b\xb6\x26\xe5\x70\xfc\xae\x36\xac\xd4\x29\x4b\xb7\xde\x3e\x4a\x54\x02\x77\x4f\x0b\xed\x47\xbe\xb5\x3f\x72\x14\x9c\x82\x29\x3e\xa7\x14\xd8\x0d\x31\x31\x3f\x9e\x0f\x22\x10\x2d\x8c\xdc\xe2\xda\xe8\x41\xb8\xd2\x86\xa2\x08\x95\x08\xb3\x17\x21\xca\xe9\x85\x10\x4a\xaf\x7a\xa9\x86\x09\xd7\x6c\x86\x55\xeb\xb3\xeb\x84\xf5\xe9\x59\xf8\xa9\x66\x43\xa8\x55\x5b\x6b\xe3\x29\x4d\x36\xa3\x18\x3c\xa1\x18\x1c\xd0\x45\xa8\xa4\x42\xde\x52\xdf\x83\xb6\x77\xc6\xdd\x3e\xe0\x93\x95\x7a\x75\xcf\x09\xe8\x62\x56\x07\xf7\x40\xe8\xcc\xd1\xc2\xdd\xcb\xa6\xf1\x42\x4d\xb8\x04\x40\x3e\x33\x3c\x44\xa8\x1d\xa7\xcf\xfc\xed\x38\x11\x8d\x2d\x18\x7e\xb7\xc2\x85\x39\x7b\x80\x5c\x0f\x36\xb9\xba\x55\xb3\xe7\xa6\xd6\x40\x8c\xa8\x13\x10\xda\x56\xec\x76\xdc\x30\x5b\x0b\xd6\x0a\xd1\xdb\x30\xa3\x61\xa4\x94\xb7\x6d\xc1\x1f\xaf\xb4\x49\x41\xa7\xe2\x73\x27\x8d\xa0\x34\x5e\x18\x34\x25\x7a\x8d\x20\xe5\xd6\xd3\xdc\xa0\x20\xa8\x7c\x88\xee\x9c\x0f\x19\x83\xfe\x46\x8c\xff\xc7\x2f\x6d\x55\xba\x6f\x50\x5b\xc1\xa7\x44\x5f\x77\xcf\x4d\xf2\x21\xb6\xf4\x8e\x90\xd0\x8d\x54\x62\x05\xb2\xb4\xe5\x3b\x25\xab\x03\x54\x46\x9d\xd5\xfd\x12\x4c\x3f\xab\x08\x7e\xee\x99\x9b\x15\xe8\x90\x30\xb2\x69\x00\x16\x08\xfe\x69\x08\xf5\xa0\x7b\x2f\x97\x96\x49\xf9\x27\xf6\xb2\x14\x1b\x46\x8f\x7f\xe1\x1e\xff\x48\xbe\xce\x7d\xf4\x82\xa1\xda\xdd\x14\xc7\x48\x2e\xe0\x16\x98\xc4\xca\x88\x12\x4b\x24\xd4\x8e\xa1\x14\x03\x12\x7c\xce\xeb\xc6\xeb\xc2\xe9\x42\x97\xce\x39\xf2\x58\xc2\xb7\xa7\xf5\x2f\x70\x95\x99\x14\xd2\x59\x75\x52\x6f\x39\x42\xb1\x5d\x89\x3d\x4e\xa1\xae\xe8\xb9\x0b\x41\xea\xd3\x26\xc9\x11\xba\x2d\x04\x19\xcf\x39\xb2\xc5\xa5\x1b\x4e\xb5\x4c\x38\x6b\x35\x12\x82\xc7\xa5\x11\xee\x6c\x6b\xe8\x23\xf3\xba\xde\x6e\x59\x00\x53\xb4\xad\x45\x69\xdd\x0f\xdc\x12\xc2\x85\x04\xab\x25\x6f\xb3\x44\x39\x2b\xd9\xfa\xa5\x86\x62\xea\x49\x90\xfb\xce\xa7\x1e\x3e\xc1\xca\x81\xcb\x58\x66\x07\x05\xff\x3d\x71\x34\xef\xd6\x41\x30\x07\xb5\x1d\x8c\xed\x27\x36\xe0\xeb\x5f\x21\xd1\x0f\x1b\xbf\x92\x14\x57\x27\xf4\x35\x76\x50\x10\x9a\x3d\xb3\x18\xbb\x7e\xcb\xff\x29\xbc\x4d\x3f\x9f\x69\x5a\x87\xfb\xbd\xfc\x24\xea\xbd\x99\x9d\xcf\xfd\x3a\x78\xb9\xbd\x7f\xe5\xc2\xd0\xe5\xcd\xef\x96\x94\x37\x92\xff\xdb\x6c\xfa\x84\x82\x67\xf6\xa6\x23\x56\xa8\x05\x24\x49\xc6\x0d\x95\xe6\x81\xa1\x6c\xb0\x30\x21\xa4\xf2\xa2\x95\x73\x46\x68\x10\x2b\xbb\x0c\x51\xac\xc0\x62\xa5\x9d\x9b\x07\xe0\xea\x20\x09\x7c\x88\x1c\xd1\xdb\xe1\x59\xeb\x21\x9f\xba\xaa\x18\x14\x5e\xaf\x0b\x0a\xf7\xb2\xea\xd9\xff\x3b\xc8\xc7\x19\xe5\xe1\xdb\x5f\x4b\x9a\xd0\x9d\xe8\x3f\xc3\x18\xc0\x5b\xa5\x58\x3e\x40\x51\xb4\x12\x71\xbb\x73\x68\x0c\x85\x02\xc2\xcb\x9e\xf9\x8b\x6d\xe5\xd0\x91\xd4\x0e\x3d\xa9\xdb\xd0\x60\x91\x23\x3f\x26\x19\x95\x5b\x31\x80\x2c\xb0\x43\xd7\x35\xe0\x8c\x25\xf5\xd7\x38\x74\x5f\xfc\xb6\x42\xb4\x36\xd4\x99\xa2\x2a\x77\x1d\x7a\x21\xa2\x3c\xda\x98\x71\x6c\xba\x96\x7e\x98\x2d\x71\xcd\xb3\x06\x2f\x54\xca\x42\xa8\xb6\xd7\x21\x87\x43\xd0\x4c\xca\xec\x44\xb1\x98\xf8\x48\x31\x1d\x86\x93\xe3\x29\x0a\x3d\xd6\x13\x2e\x49\x61\x61\x46\x92\xb7\xc9\xa6\x34\x86\xbb\x46\x00\xc3\x39\x86\x48\x33\xaf\x1f\xae\x0a\xed\xe6\x5e\x6c\xc6\xa7\x5d\xfc\x77\x67\xa7\x1e\x1b\xc4\xe0\x3b\x76\x74\xc1\xac\xa3\x37\xdd\x96\x52\xf5\x62\x67\x8e\x12\xa8\x93\xb7\x4f\x4f\x80\x98\x44\xc2\xbe\x68\xb7\x94\x50\x90\xe7\x09\x2e\xb7\x8b\xa7\xd9\x9f\xdc\x91\xcb\x5e\x7f\xfc\xe6\xfe\xbb\x69\x89\x7d\x8e\xba\xea\x9c\x22\xcc\xed\xe2\xb1\xfb\x62\xd4\x6f\x27\x9b\x73\x33\x5c\x64\xab\xa1\x08\x66\x0e\x40\x4e\xd0\xe0\x29\xef\xdb\x1d\xb6\x0d\x2f\x7d\xca\xc2\xee\x85\xe8\x92\x06\xad\xf1\xf9\x99\x13\xc6\x50\x2f\x3d\xc9\xb9\x6f\x66\xaa\xd9\xf3\x89\xe8\x50\xce\xa6\xc2\x5f\xe8\x49\x18\xef\xc5\xff\xc9\x18\x63\xa7\x85\x0f\xef\x16\x85\x0f\xef\x26\xc2\x87\x77\xb3\xc2\x87\x77\x73\xc2\x87\x93\xdc\xe0\x4c\x06\xf1\xdd\xca\x9a\xe0\x64\x35\x42\x1f\xdf\x2a\xfd\xa3\x59\x14\x91\x37\xbe\x4f\x39\x1e\x5a\x61\x8a\xc1\x1c\x36\xac\xab\xb5\xed\x6a\x6d\x06\x4a\xb5\x06\x55\x21\x8f\x33\x02\x77\xa2\xe3\x81\x26\x13\xc9\xc0\x41\x84\x1f\x76\x7b\x40\x4b\x42\x85\xec\x89\x30\xa4\x91\xb8\x10\x2c\x10\xcc\x3f\x1c\x55\x1a\xca\xd9\x94\x8e\xee\x83\xe5\xfe\x3c\xa9\x30\x27\xc4\x78\xc8\x5a\xed\x9a\x43\x57\xfb\x34\x2a\x95\x37\xa0\xf5\x3c\x92\x6e\x64\x18\xbf\xaa\xd1\xda\x6c\x02\x3a\x0e\xb2\xac\xc0\xff\xf6\x26\xe9\xf0\x0d\x3a\x77\x31\x8b\xb8\x85\x5a\x82\xc5\xa4\x0d\x64\xd9\x09\xf5\x86\x03\x48\x6e\xb6\xd5\xfa\xc1\xdd\xc0\x16\x6e\x45\xf9\x5b\xb8\x0d\x00\x32\x5c\xb5\xb0\x7d\xec\x64\x24\x27\x38\x8c\x7a\x93\x5c\x13\x93\x57\x8f\xc4\xeb\xea\x1c\x20\x8f\x33\xa0\x27\x1c\xc9\xe9\x11\x45\x96\x73\x99\xdc\x6a\x10\x00\xb0\x18\xdd\x27\x0a\xf8\xc1\x93\xd1\x53\x82\x25\x88\xca\x1a\xce\xe1\x82\xb3\x67\xe3\xd1\x15\xf4\x3d\x89\x9a\x72\x65\x52\xe3\xf2\x37\x85\xd3\x0f\x09\x47\x66\x5f\x5f\x7c\x2c\xe7\x64\x1b\xfd\x20\x12\x2e\xd5\x34\x7d\x46\x5a\x47\xc8\x31\x1f\x56\xd5\x49\x2e\x6d\x7f\x69\x05\xaa\x1c\x51\x48\x7d\xc3\x8c\xf8\x79\x90\xa8\x18\xab\x9e\xa7\xc2\x86\x74\x53\x00\x20\x3f\x15\x7a\x97\xa2\x90\x25\x76\x2d\xf9\x87\xb0\xc9\x1c\xec\xe7\xfb\xcb\x30\x2d\x07\x5f\xb1\x61\xb1\x97\xd9\xb2\xc6\x99\x6c\xb5\xfb\xd9\xa1\x83\xb3\x3d\x5c\x9f\xf4\x32\x11\x3d\x87\x42\x10\xcc\xf6\x02\xa4\xcc\x3c\x74\x06\x2e\xee\x26\x25\xc8\x2e\xf9\x7c\x30\xd6\x33\xdd\x7a\xc3\x5e\xec\x0d\xe3\x5d\x67\x34\xc7\x4b\xf9\x9d\xc1\x0a\x61\xa0\x02\x9d\xb6\x01\xbb\x2f\x43\x19\x47\xc9\x6e\x68\x10\xb0\x9f\x2c\x1b\xe4\xe1\xd1\x0d\x00\xaa\x8e\x3c\xe3\x78\xe7\x25\xcf\x49\x24\x27\xe1\x31\xb9\x11\x4c\x77\x42\xe5\x77\x39\x7f\xb8\x56\x08\x9a\xa4\x00\x60\xf0\x13\xb4\x26\xb3\x52\x0d\x06\x66\xce\x88\xca\x0b\x04\xfa\x24\xac\xd5\x79\x53\x3d\x64\x35\xc1\xb5\x02\x9a\x2d\x2c\x4f\xfa\x91\x65\x04\x44\x60\x2d\x40\xfb\x2a\x00\x2a\x92\xf6\xe1\xce\xab\x22\x16\x35\x37\xbc\x70\x67\x35\x4a\x7b\x05\xf3\x53\x0b\x08\x95\x9d\x29\x13\xa4\x09\xad\x4d\xfc\xdd\x03\x14\xfb\x32\x10\x32\x82\x82\x4d\x49\xa8\xdf\xad\x60\x56\x20\xa1\x1c\xb6\xb1\xd5\x7c\x87\x54\x14\x35\xef\x04\x8a\x51\x1a\xfb\x26\xd2\x39\xe5\xa9\xe9\xb9\x06\x01\x3f\x2b\xc9\xf2\x8b\x7c\x48\x90\x02\xa8\x35\xb3\xfc\x90\xbc\xad\x8e\x5b\xeb\x9c\xbf\x55\xad\xdc\xe1\x54\x48\x6e\x40\xf6\x4e\xab\x80\x5b\xd7\x1d\xa6\xcc\xb7\xa2\xd1\x7b\x0a\x26\x62\x41\xe7\x07\x40\x8b\x7a\x6b\x93\x9a\xab\x5d\x03\xac\xdc\x15\xc1\x54\x43\x7a\x63\x84\x71\x84\xe3\x36\xcf\x48\xc5\x16\xfe\x0d\x75\x0b\xd0\x51\x9b\x93\x2e\xa0\x0f\x7d\x12\xa2\x18\x47\xe4\x57\x13\x30\x57\x62\x42\xdb\x37\x9e\xfb\x07\x02\xd8\x77\xa6\x91\xe7\x41\x8d\x89\x2e\x00\x5c\xfb\x54\x3b\xf9\x0c\x0c\x72\xe9\xa5\xbc\x17\xbc\xaf\xff\x38\x65\x67\x3f\x95\xb6\xd9\x38\x0f\xd3\xfd\xeb\xea\xb2\xbc\xba\x9c\x74\x8b\x3f\x19\xe0\xe0\xbe\xbc\x38\x5e\x69\xdd\x66\x7b\xc2\x88\xe7\x13\x4d\x74\x85\xad\x2c\x1e\xc0\x54\x39\x8f\xcc\xec\x06\x82\x0c\x7a\xca\xb1\xb0\x4b\xf2\x2c\xd3\xfb\xa4\xc9\x0f\x6b\x4b\x92\x10\x8d\x05\xef\x80\xc1\x0a\x84\xce\xb9\xb3\x7c\xee\xe1\x22\x49\x94\x6f\x49\x78\x42\x7f\xdd\x62\x4e\x57\x70\x73\x7c\x6a\x66\x49\xc5\x13\xb4\x53\x05\x49\xdc\x80\x77\xf2\xcf\x39\x79\x99\x6f\xd9\x4f\x40\x02\x14\xbf\x9f\x61\x35\x51\xc7\x24\xf4\xff\xe2\x94\xb8\xd3\xc4\xb7\x27\xe1\x98\x80\xbb\xcb\x7b\xb7\x1e\x98\xc9\xf7\xfc\x10\x03\x73\xdc\x3e\x09\x10\x36\x24\xb4\xc0\xd1\xe1\xdb\x65\xae\xb2\xbf\xe8\x4e\x34\xc2\x5a\x25\xac\x5d\x58\x30\x35\x37\xd2\xb6\x7c\x15\xb7\x42\x44\xad\xba\x73\x77\x5f\x8b\xc6\xeb\x3d\xb2\x52\xd8\xce\x77\x9c\x9d\x58\x22\x58\xb9\xa6\xf7\x0c\xc1\x1a\x32\x26\xa5\x44\x30\x70\xcd\x50\xea\x8d\x92\x85\x88\x0f\x49\x74\x4e\x7d\xc6\x60\x85\xe8\xf1\xf7\xca\x72\x25\xfb\xc3\xd1\xc9\x18\xf9\x5b\xe7\xcd\x48\x69\x00\x74\x25\xdd\x4d\xc4\x68\x1a\xee\xc3\xdf\x53\x7d\x5b\xdf\x21\x1a\xfa\x43\x49\x43\x21\xd6\xfd\xc1\x1f\x1b\x6f\x42\xcb\x0f\xfe\xab\xce\x11\x20\x0c\x4b\x27\xf8\x03\x91\x87\x4a\xc5\x76\x72\xbb\x75\xa7\x6d\x8d\x15\x85\xf7\x1f\x20\x51\x67\x74\x63\x33\xa5\x65\x1f\x4d\x49\xcb\x84\x71\x51\x50\xb1\x48\x7e\xc7\xa5\x7a\x82\xe5\xc9\x36\x19\xda\x86\x42\xf6\xb1\xcb\x5d\x7c\x2e\xcc\x50\x48\x74\xc3\x3a\x4f\x74\x74\x7c\xfb\x2d\x69\x7d\x35\x42\x74\xe7\x5b\x80\xa9\x74\x57\x7e\x1e\xce\x98\x80\x54\xd1\x60\xcf\x1f\x84\x02\xb2\xbb\x90\x61\xf7\xe6\x1d\xe8\x51\xd7\x8a\x7a\xc9\x9e\xb4\xbc\x16\xd5\xcc\x06\xe5\xec\xe9\xd3\xcd\x9c\x75\x57\x38\x69\xe3\x26\xb9\x0a\x77\xce\xad\x48\x50\x7f\xe2\xb6\xe6\xaf\x2c\xfb\x8b\x2c\x85\x9b\xc8\xbf\xf2\x01\x1a\x32\x5e\x5e\xf3\xef\x24\x86\x09\x53\x11\xbd\x54\x07\x86\x1c\x33\x5e\x6b\x8a\xfb\xc4\x52\xcf\xf6\x3c\xa5\xb5\x91\x2f\x2d\x3b\x85\x59\x84\xd0\x6e\xfc\x9d\x5b\x32\xec\x87\xa6\x64\x3f\x28\xf1\x34\x0d\xc0\x64\xdd\x8d\x80\xa0\xf0\x3c\x47\xe1\x45\x9e\x90\xdb\x22\x83\x02\xf2\xdb\x3a\x07\x5c\x36\xdc\x10\xb2\xa6\x1a\x94\x3a\x24\x1b\x02\xf2\xdb\x95\x24\xc8\xaa\x7b\x91\x48\xf7\x9b\xf2\x8f\x10\x38\x57\xce\x74\xdb\xaf\x51\xb8\x01\x75\x2c\x10\x5b\xda\xe0\x02\x25\x32\xec\xc4\x46\x20\x14\xca\x13\x6d\xa2\x5d\x1c\xba\x19\x0a\xa7\xfb\x31\x33\xc1\xd8\x9c\x17\x1a\x67\xed\x26\xa8\xd1\x91\xba\xfe\x0a\x89\xb9\xfb\xe5\x0a\x13\xc9\x6c\x85\x0c\xfa\xc8\x10\x64\x67\x45\xe4\x63\x39\xae\x76\x92\xcd\xa6\xf3\x92\x26\x3d\x25\xd9\x4c\x82\xe9\x79\x65\x63\x44\xe0\xdc\x6b\x8f\xf2\x4c\x6b\x5f\x4f\x49\x6b\x7f\x12\x8d\x78\x90\x4a\x58\x39\x25\x2a\x5b\x5d\x72\xfa\x02\xec\x3d\x4f\xe3\x21\x9b\x61\x01\x98\xdf\x96\x67\xd0\xff\x83\x33\x97\x26\x14\xc4\x3c\x74\x1b\x0a\x15\xda\x19\xc4\x05\xca\x1e\xb0\x52\x39\xce\x41\x44\x54\xfd\x94\xe8\xd6\x6f\x79\xf1\x59\x98\xde\x0b\x4f\x37\x0d\xd3\x2a\xef\x71\x8a\x20\xf0\x45\x4b\x11\x99\x73\xe1\x21\xbb\xce\xe8\xce\x48\xf7\x48\xc4\x13\x03\x01\x69\x12\x4f\xe3\x5f\x7b\x2f\x26\x4e\x6b\x16\x47\x8b\x61\x24\xfc\x37\x36\x03\xfb\xf4\xa1\x12\x7b\x18\x1d\x47\xb7\xcf\xad\x8a\x90\xfb\xb3\x7b\xe8\xdb\xc3\x0b\xd1\x8a\xed\x8c\x8c\x8e\x62\x56\xb3\xcd\xf5\x84\x56\x20\x01\x3d\x8c\xf2\x8f\xe1\x11\x7a\x13\xdf\x22\x67\x7f\x19\x76\x59\xd7\x75\x54\x50\xff\x40\x4e\x62\x16\x90\x24\x7a\xdf\xb3\xf4\x46\xce\xef\x42\x94\x1e\x0d\x30\xc9\x8f\x84\x6e\x3c\xb8\x52\x98\x80\xbd\x27\x59\xc0\x9f\x12\xde\xcd\x33\xdc\x44\xc4\x3a\x2c\xf8\x77\x97\xa3\x96\x6f\x23\x48\x57\x2a\xb2\xc5\x0f\x1d\x64\x4c\xb6\x43\x8f\xaa\x5a\xe2\xa0\x29\x93\x89\x87\x43\xc6\x83\x9f\x76\xad\x92\xb9\xcb\x31\x45\x23\x5a\x06\x69\x53\xbe\x5c\x0f\x90\xe9\xc9\x68\xb8\xa0\x78\x67\x64\x97\x99\xe7\x46\x56\x6e\x42\x68\x58\x00\x74\xb1\xa4\x3b\x2e\x15\x6b\x65\x79\xc1\xe5\x22\x1f\xd4\x0f\x8a\xd9\x61\x6b\xc5\xcf\x83\x50\xb4\xd6\x46\x35\xd2\x74\xfb\xf4\xda\xab\x73\x61\xad\x94\x7a\x52\x66\xc7\xe9\xcd\x6b\x04\x26\x1c\x88\x33\x2a\xe0\x5b\xf1\xad\x2c\x0e\x0f\xf6\xd9\x91\x05\x96\xe7\xea\x21\xed\x65\xbd\xf1\x82\x22\x47\x47\x4c\x0f\xdf\x8f\x72\xd9\x08\xf7\x85\x6c\xa1\xc6\x64\x47\xc1\x8d\x91\x5e\x68\x3d\x07\xbb\xc3\xbd\x64\x7f\x72\x91\xac\x5c\x13\xa7\x85\xd0\x62\xca\x7d\x34\x3a\x44\x3d\xf8\xf9\xa4\xc5\x9c\xc6\x5c\xcf\xd9\x4e\x89\x5e\xec\xf1\xfd\x44\x8e\x08\xde\xba\x1b\x9a\x26\x1d\x70\x1a\x72\xa7\xea\xb3\xc4\xe2\xb3\x72\x22\x5f\x60\x1e\xff\x99\xd9\x71\xc8\xd1\x52\xcc\x06\xa6\x9c\x8e\x90\x38\x3d\xe3\x95\x1b\xb3\x94\x59\x5e\x9b\x7b\x66\xfb\x5e\x03\x23\x03\x25\xa2\x79\x20\x47\xe0\x1e\xc3\xe1\xce\x44\xdb\xa3\x46\x07\x24\x99\x7b\x23\x05\x25\xdd\x41\x53\x8f\xb4\xbb\x14\x5c\x24\xfd\x95\x36\x6e\xd1\x9a\x6c\x87\xa8\xa8\xf5\xf5\x28\x67\x7a\x25\x62\x27\xe4\x9a\xa8\x42\x34\xa2\xe3\x7d\x7d\x78\x79\x28\xdb\xdf\x15\x61\xfa\x57\x28\xb0\x71\x69\xb0\x7b\x4b\x3d\x80\x16\x44\xf3\xe8\x4e\x37\xa9\x76\x4b\xc5\xc7\xeb\x9b\xc5\x9e\x86\x75\x25\xf6\x9e\x26\x40\x16\x70\x77\xb6\x15\xfd\x5e\xa0\x03\x61\x00\x4f\x8f\xb1\xcd\x84\x9c\x00\x96\x0b\xc6\xf8\x9e\xbd\xb2\xe2\xad\x6c\x24\x37\xa3\x7e\x2d\x2a\x1a\x72\x75\xc0\xaa\x58\x70\x33\x5b\x64\x50\x87\x95\x1c\x9b\xdf\xd1\x43\x49\xc9\xeb\x31\xf3\x1a\xb0\xcd\x89\xc3\xeb\xee\x1a\x0f\xec\xc9\x85\x9f\xd6\x36\xee\x6b\x71\x89\x53\x2d\x53\x90\x43\x73\x40\xda\x79\xd4\xd4\xdf\x30\xe9\x9c\x61\xbb\xc1\xde\x05\x9b\x2a\x2a\x58\xa1\xac\x36\x07\xd6\x0a\x60\x24\xf1\xa9\x4f\x15\x1d\x74\xcf\x51\x42\xb0\xde\x87\xcd\xf8\xee\x53\x91\x93\xfc\x88\xf4\x4c\x4a\x3e\xfd\x49\x15\x9f\x7c\xfa\x5c\x88\x63\xa7\xfc\x6a\xc7\xa2\x98\x58\x1a\x07\x85\x80\x98\x34\x82\x42\x14\x66\x5d\xc3\xe1\x0d\x93\x80\xa3\x76\x56\xd7\xe7\xeb\x0b\x8d\x44\x39\xc0\x06\x33\x9e\x06\x30\x9d\xce\x03\x00\xa2\xf2\xb5\x91\x42\xa7\xcd\x14\x70\x3a\x07\x3a\x38\x4b\x5d\x7a\x39\x4c\x78\x1e\xee\x65\x22\xd6\x7f\x6c\x47\x26\xd8\x3f\x5a\x6a\xcd\x01\x91\x66\xee\xa1\x6d\x58\x96\x78\x54\x60\x65\x6b\xbc\x23\x27\xfd\x9b\x27\x9c\x71\xe4\xfc\x91\xa0\x54\xb0\xce\x7d\x47\xd2\x63\xe1\x8e\x4d\x1e\x70\x57\x56\x34\x50\x37\xa2\x13\x31\x64\x5c\x43\x31\xd2\xf3\xed\x26\x9a\x16\x95\xec\xa9\xf7\x04\x72\x8a\xc0\x89\xcf\xd9\x55\xa2\x71\x3b\x12\x7f\xdd\x0a\x56\x8b\xa6\x1c\x9f\xfd\x8a\x0d\x6a\x3c\x0d\xb8\xf2\xc7\xa3\xa4\xf0\xc0\x0f\x04\x69\x2d\x11\x63\x17\x6e\xe5\x3f\x5c\x69\x9f\xfe\xe9\xa6\xda\x9b\x3c\xe7\x40\x04\x49\xfb\xf4\xe6\xa5\xe8\x85\x69\x01\xe1\xed\xdb\xfd\xd0\x5c\x1a\xf9\xe8\x7b\x28\x63\x95\xa6\x39\x84\xac\x2a\x56\x29\xca\xab\xcb\x4b\x74\x18\xb4\xb2\x43\xd3\x7b\x91\xa9\x13\x09\xfa\x6f\x93\x61\x5d\xb0\x0f\xd2\xd6\xbc\x63\x17\xec\x23\xe1\xe2\xee\x8d\xe0\xec\x82\xfd\x50\x55\xec\x13\xbe\xaf\x0b\x17\xcb\xe3\x7f\x8f\xa1\x4c\x7f\x13\xa6\xe5\x6e\x03\xb0\x6f\x10\x83\x75\xc1\x3e\x27\xff\x77\x79\x75\x71\x75\x79\x39\xfe\xd1\x7d\xe4\x1b\x45\x97\x75\xd5\xaf\xfe\x21\xcc\x81\xf9\xb1\xe3\xb7\x2e\x6f\xdd\xff\xde\x5d\x5c\xbd\x63\x17\xec\xea\xe6\xe2\xfa\x86\x5d\xb0\xeb\xdb\xb9\x5f\x7f\x74\xc7\xd6\x37\x9c\xc4\xa9\xe0\xd7\xef\xdc\xaf\xde\xdd\x5c\xdc\xb8\xff\xbd\xb9\xb9\xb8\x75\xff\x7b\x7b\x33\x7b\x6f\x29\xf6\x6e\xb4\xee\x15\xc3\x6f\xc7\xbf\xf9\xca\xfd\xef\x57\xb3\xbf\x7d\x0f\x1d\x5a\x50\x56\x3d\xfb\xb7\xdf\xf2\xc6\x0a\x36\xb9\xc2\xed\xa5\xfb\x25\xcc\x92\x9f\xb6\x75\xef\xfb\x8f\xec\x77\xe3\x37\xf6\x3b\x30\xda\xee\x00\xe8\xc2\x27\x3d\x19\x54\x5c\xa5\x84\xae\xdb\x43\x11\xda\xca\x9d\x6c\x18\x86\x40\x05\x82\x58\xdc\x7e\x79\xcb\x7e\x37\x79\xab\xfe\xca\xc1\x70\x10\xcc\x31\x8d\x45\xf8\x83\x48\x20\xa1\x9e\x49\x2d\xdd\x23\x63\x79\x34\x2b\x3f\xb3\x56\xab\xbe\xb6\xd1\xf3\x44\x34\x91\xf7\xfb\xf6\x60\x48\x5f\x59\xd6\xc8\xad\xe1\xe6\xb0\x61\x5b\x01\x2c\x85\xfe\x56\x9c\x19\x7d\x00\xf5\x0d\xd9\x7b\x5b\x57\xd4\x83\x7a\xc0\x36\x61\xb3\x85\x2a\x1b\x7c\xf1\xaf\xb2\xa8\x5f\x59\x66\x45\x61\xa0\xdc\xdf\x6e\x97\x76\xd6\xef\xb2\x35\xfa\x3b\x2c\x2d\x03\x44\x08\x79\x4c\xf9\xa3\x60\x5b\xb7\x10\x81\x4d\x56\x57\x3d\xa0\x7b\xc6\xdf\x28\xb8\x11\xb0\xdd\x99\xed\x07\x14\xfc\x82\x21\xc6\xaf\x05\x0b\x7c\x0c\x1f\xf3\xbb\x6c\xb9\xc3\x40\xa0\xa9\x71\x74\x27\xeb\xbe\x95\x36\xe2\x17\x02\x22\x8a\xa9\xfb\xe6\xec\x2c\x0c\x3a\xba\x71\xbf\x4b\x36\xc5\xfc\xa3\xc2\xe5\x35\x48\x43\x52\x2b\xf7\x81\x08\x57\xa0\x7a\xf6\x96\xfd\x2e\x59\xd9\xd9\x25\x70\xad\x79\x49\x48\xf4\x32\x81\x85\x82\xa7\x8b\x2e\x38\x48\xbe\xd2\x21\x1a\xeb\xe2\xb1\x32\x5e\x75\xe3\x56\x75\xcd\xbb\x10\x14\xb4\xbc\x5b\x7c\x83\x93\x1d\x97\x8d\x8b\xe0\x1a\x24\x97\xe4\xac\xfe\x5b\xf6\x37\xba\x01\x1c\x70\x70\xfa\xf4\x9a\xd9\xc2\x1c\x82\xe2\x9c\x5b\x40\x5c\x15\xfd\xd0\xc2\xec\xba\x43\x5c\xf0\x92\x3d\xe2\xfc\x39\x0f\xb2\x69\x06\x44\xff\x6a\x13\x1c\x66\xca\x1d\x10\xc0\xc5\x6f\x49\x3c\x67\xfd\x5b\x88\x53\x04\x23\x8b\xde\x2e\x8c\x6d\xb1\x2a\x19\x4c\x3a\xe6\x0b\xa8\xdd\xd7\x80\x7c\x46\xc7\x5e\xe7\xae\x34\xf1\x18\x7a\x09\x81\x08\x60\xdb\x83\x64\xa3\x66\xbb\xc5\xfe\xcc\x78\x96\x3c\xe1\x66\x80\x03\x2f\x75\x1b\xb1\x49\x79\xb9\x3a\x35\x16\x80\x65\x0a\x5f\xa7\x20\xf9\x2d\x7b\x1f\x51\x4d\x55\x92\xcd\xbb\x2a\xaf\x2e\xd9\x67\xfc\x1f\x28\x54\xa8\xc0\x51\x14\xee\x15\x9a\x29\x50\xc2\x00\x95\x49\x1a\x51\x8e\x5a\x43\x29\x00\x09\xef\x1f\xbf\xc7\xae\x3c\x3d\xe6\x86\x35\x1c\x12\x4d\x71\x04\xe8\x5a\xb8\x93\x1c\x34\xaa\x6e\xe1\x9f\xef\xbc\x83\xd1\xef\xb5\x3b\xdf\x31\x0f\x89\x9b\x7c\xef\x91\x47\xc9\x53\x6c\x0f\xec\xea\xd6\x0f\x1e\x96\xcf\xd5\xd7\x78\xc7\xe0\x2b\x24\x4e\x06\x3e\x99\x09\x30\xcf\x70\x19\x9c\xb2\xe6\x00\x69\x27\xed\x21\x3c\xe5\xd7\xd8\x40\x46\x52\x0f\x80\x3b\x61\x20\xf9\x6b\xfa\x7a\xc3\xae\xc3\x7f\x5f\xb8\x80\x60\xc3\xde\xb9\x3f\xe0\x7f\x42\x6d\x58\x43\x0d\x06\x53\xb2\x90\x4d\x05\x79\xe1\xc0\x6d\xa5\xdb\x8e\x5b\x1b\xd3\x8f\xc0\x18\x41\xcb\x3c\xc2\xba\x34\xb7\x3d\x6f\x58\xe1\xbc\x15\xa8\x4d\xc3\xe5\x86\x2e\x3c\x28\x74\x4e\xf2\x9e\xd9\x20\x0c\xe6\xe7\xc9\x1d\x1b\x46\x0f\x27\xdc\xa1\xd4\xef\x39\x67\x79\x02\x23\xc4\xd0\xe1\x9a\x2b\x65\x55\x09\xe3\x16\x50\x68\xc1\x79\x65\xd9\xa3\x24\x7f\x03\xaf\x90\xd0\xba\xd2\x3d\x33\xe2\x68\xf8\x69\x26\xde\x51\x13\xe0\x8e\x84\x67\x0d\xab\x9d\x11\x6f\xf8\x56\x1b\xde\x6b\x77\xb2\x4d\x88\x32\x5b\x72\xff\xe3\xd8\x28\x98\x8c\xa7\x62\xf8\x39\xd4\x89\x01\x4c\xc8\x9b\xa2\x16\x2d\x49\x52\x41\x4b\x8a\xad\x75\x17\x85\x28\x5b\x6a\x19\x8e\xe9\x76\xad\x09\x26\x03\x59\x9e\x16\xf2\x2e\xbe\x7c\x10\xef\xf0\x96\x7d\x27\x94\x30\x48\xa9\x01\x4f\x85\x7b\x3a\xd2\xf9\x5a\x01\x67\x3a\xb5\x64\x20\x9a\xd6\x19\x49\x54\xbc\x8c\x11\x38\x49\x4d\x63\x72\x0b\x52\x27\xe9\xcb\x06\xa4\xb1\x7c\xe4\x5b\xa0\x0b\xc6\x67\x1f\xa7\x14\x4e\x06\xfd\xe8\x09\xff\x31\x83\xe6\x0f\xaa\x33\xa2\x94\x05\x38\xd5\xa4\x2d\x6f\x04\x3a\xdb\xe1\xc5\x23\x43\xca\xbf\xf4\x60\x94\x38\xf8\x86\x96\x64\x21\x87\xc8\xfa\xc8\x4a\xf2\x8a\x21\x2e\xdc\x4c\xb5\x0c\x7c\x54\xed\x1c\x7e\x81\x2e\xbf\xb7\x0e\xa1\x2e\x89\x5e\x80\xb7\xc6\xf4\xe8\xaf\xdb\xa1\xe9\x65\xe7\x46\x0c\xcf\x84\x1a\xcc\xba\x28\x06\xb3\x01\x3a\xf3\x84\x14\x27\x14\x0f\xdf\x9c\x13\x6a\xa3\xb9\x45\x0f\x72\x55\x75\x6e\x36\xec\x3e\x82\xf5\x3f\x29\x62\xfc\x81\xbd\x36\x1c\x50\xe2\xbc\x79\xa0\x65\xa8\x80\x42\xa6\x8a\x9d\x3e\x9d\x11\x08\xaa\xd8\x89\x96\x92\x2b\xb7\x4f\x86\xf2\x13\x81\xf1\x2a\x82\x10\xa8\xa7\xf3\x37\xeb\xfa\xc2\xed\x51\xfd\x8c\xd2\xf0\x7d\x0c\x7c\x2f\x3c\x56\x3d\x34\xbb\x10\xd6\xd1\xcb\x84\xa5\x08\x61\xf4\xd2\xad\xef\x0a\x55\x0f\xb4\x2f\x83\xb3\xa0\x4f\xbb\xfb\x63\x51\xe5\x25\xef\x9f\x56\x2a\x32\x77\xaf\x8c\x90\xef\x99\xad\x65\xdb\x22\x8c\x1a\xee\xde\x40\x82\xd7\x66\x7c\xdf\x38\x16\x9c\x0e\xb1\x27\x9a\x6d\xe4\xb1\x84\x6c\xf0\x84\xda\x7e\xdc\x87\x3e\x95\xf9\x4a\xc0\x9d\x74\xdb\x98\x55\xf1\x54\x67\xb3\x52\x24\xa9\xa3\xe1\x27\x29\x98\x32\x25\xb8\x71\xa6\x6c\x50\x6e\x9b\x75\x12\x68\x1a\x81\xb1\xb2\xa2\x26\x24\xcf\x5f\xe9\x3f\x5f\x34\x45\xce\xde\xb6\xfc\x5f\xce\x5e\x08\x67\x60\xed\x86\xed\x06\xd9\xe4\x19\x44\xd9\xc2\x03\xa8\x9e\x51\x0b\x9c\xd7\x40\x5f\x78\xaf\x29\x98\xdc\xb9\xcd\xfb\x00\xcb\xc5\x07\x91\xd0\x5b\x59\x39\x77\x81\x2c\x19\x04\x59\xf4\xbc\x04\x9d\x77\x0e\xf1\xa0\xe4\xcf\xc3\x64\x45\x5c\x00\x09\xb2\xa1\x1c\xa0\x07\xc9\x99\x01\xc4\x50\x0d\x35\xf4\x81\xe1\x4c\x24\x46\x3a\xe7\xea\x9a\x54\x4f\x06\x61\xb6\xb3\x92\xf7\x73\x0d\x2f\xa8\xb1\x0c\xab\x2e\x1b\x0e\x91\x9d\x3a\x57\x2a\xbe\xbb\xb0\x42\x3f\xf0\x5e\x18\xc9\x81\x3f\x51\x89\x4d\x74\x94\x42\xcd\xf4\xfd\x87\x58\x70\xc6\x7b\x24\xbd\xf3\xe3\x5b\x11\x7f\x08\x56\x00\xcb\x47\xa1\x20\xef\x16\x2f\x50\xe8\xb6\x05\x21\x0b\x25\xf6\xe3\x69\xeb\x35\x6b\x45\xeb\x8e\x64\x2c\x5d\xbb\xc0\xef\x40\xa2\xe5\x19\xa4\xef\x1c\x2a\x83\x13\x1b\x7c\x7b\x60\xbe\xca\xd5\xa7\x19\xc6\xb8\x7b\x83\xbd\x40\xf5\x93\xd2\x79\xdd\xda\x40\x8a\xfa\x20\x5c\x08\xe8\x6e\x09\xbc\xf6\x4a\x43\xa3\x67\xba\x67\xd3\x98\xe5\x48\x9c\x8a\x37\x93\x96\xed\xf9\x61\xdd\xe1\xa3\x2c\xf0\x5c\x7e\x4b\xf2\x46\xce\xb1\x7f\x58\x05\xf1\xca\x8f\x1f\x54\x88\xfa\x9f\xff\xfc\xf3\xc7\xff\xfb\x7f\xd0\x7f\xbf\x18\xf0\x2b\xb2\x51\x51\x37\xda\xe2\xa9\xb2\x40\x49\xbd\x26\x1f\x8c\xef\x1a\xb2\xf7\xd2\xc0\x66\xda\xb0\x5a\x1b\xf9\x8b\x06\x76\xd0\x60\x7e\xc1\xad\x70\x0e\xfa\xb8\xf3\x09\x3b\x06\xdd\xbe\xae\x91\xda\x9c\x3a\x9e\x40\x40\xca\xfa\x1f\x44\x9d\x9b\x44\x95\x52\x4d\x6d\xdd\x39\x39\x66\x4a\xc5\x4a\xfb\x30\x61\x26\x4e\xb8\x31\xa8\x1a\x5a\xeb\xc6\xe7\xb7\x6f\xf3\x52\x35\x64\x2f\xb0\x89\xc3\x19\x57\x30\x84\x80\xfd\x92\x13\xfa\x1a\x02\x4b\x45\x1c\x1a\xd9\x01\x18\x03\x42\xcf\xc8\x1f\xdf\x8d\xd4\x0a\xe6\x9b\xa9\xe0\x77\xd2\x02\x8b\xd4\x56\x42\x86\x4c\xd2\x61\xc5\xa3\x4d\xbd\xbe\x4c\xe5\x9c\xbc\x77\x0f\xe5\xdd\x98\x79\xb9\x9e\x00\xb1\xfd\xf0\x71\x70\x20\x18\x4e\xa5\x11\x4d\x27\x6d\x1f\xe6\x6d\x7a\x27\x38\x61\xbf\x27\xcc\x97\xbb\x0b\x09\xcf\x0e\x0a\x94\x52\x89\xcc\x0f\xc0\x4b\x40\x79\xb5\x57\xcc\xf6\x5c\x1a\xbb\x61\xb6\xd1\x5d\xd0\x08\x75\xae\xf6\x03\x79\xe4\x21\xdf\x8e\x97\xe2\x8a\x89\x46\x3c\x92\x49\xa9\x7d\xd1\xd9\x37\xba\x69\x03\x37\x1e\x85\xc9\xe1\x89\xf0\x4a\x88\x4c\xa0\xeb\x45\x4f\x47\x88\x8e\x75\x6e\x02\x14\x10\xf9\x3b\x07\x5f\xf6\xd8\x30\xed\x5b\xc3\x3b\xd9\x13\xf0\x35\x25\x3a\x20\x3e\x86\xad\xee\x7b\xdd\xae\xe0\x42\x1a\xbd\x85\xab\xcb\xb4\x4b\x2f\x8c\xf5\x35\x75\x90\xb9\xf0\x58\x20\x0d\x74\x98\x0b\x7c\x02\x42\x1d\x29\xa6\xb7\xb6\xe7\x64\xfd\xa2\xca\xfb\x9b\x27\xc1\xdf\x6a\x3e\xb4\xce\x6d\xd9\x4d\xab\xcf\x73\xed\xdc\xe7\xc0\xdf\x4e\x98\xb2\x85\x8e\xd6\x35\xe8\x53\xf4\x83\x8f\x94\x96\x5b\xae\x64\x85\x44\x77\xad\x74\xef\x77\xaf\x55\x09\x52\xf3\xee\x4c\x44\x7d\x89\xa1\x13\x86\x74\x5a\x59\xa7\xf7\xee\xd3\xdc\x72\x24\x35\xea\xa4\x75\x09\x27\x3c\xa4\x17\x13\x0e\xd7\xec\xd7\x0b\xcb\xe2\xbf\xfe\xd7\x7f\x62\xe5\xe6\x11\x39\x4e\xb5\x6e\x3d\x46\xa6\xaf\x8d\x40\x21\x58\x8b\x5a\x11\x43\x49\xe9\x8f\x16\xb5\x39\x57\x9d\xd1\x74\x03\x22\x13\xaf\x1a\xde\xa2\x2e\x7c\xd5\x40\x27\xcc\x86\x18\x7d\x84\xda\xb0\x52\xb6\x84\x5d\x83\xad\x55\xe8\x46\x9b\x27\xde\xa7\xe6\xa6\x45\x21\x0b\xe3\x9c\x8c\x40\xb5\xb9\x8b\xd8\xbe\xb3\x2e\x4a\x0e\xc6\xa8\xf2\x4d\xf4\x7c\xd8\x30\x6b\xe4\xce\xb9\x5b\x22\xe4\x5d\x91\xd0\x66\x1c\x54\x64\x15\xc4\x98\xcd\x37\x43\x4b\x6d\x3c\xc4\x12\xec\xb1\x5f\x07\x14\x43\x30\xfc\x51\x60\xb2\x54\xbb\x45\x34\x58\x67\x7a\x81\x65\x73\xcd\xf8\xc7\xf5\x7a\x62\x76\x77\xe7\x59\xa3\x8b\x07\x51\x06\x8c\x8a\x8b\xa7\xf5\x1e\x5f\xd0\x01\x03\x0e\x04\xcd\xb7\x28\xe0\xb1\xe2\x66\xbc\xe9\xa9\xa7\x2f\x49\x60\xfb\x69\x10\x87\x71\x73\xd3\x69\x9b\x35\x66\x30\x0f\xa1\x3e\xac\xcd\x88\x46\x4b\x15\x44\x60\xed\x12\x6e\xf8\xea\x82\xb0\xf7\x7e\x73\x40\x9f\x1c\x42\x22\xa9\x57\x86\xf2\x5f\xa8\xf3\x2a\x6d\x2b\x81\xa9\xa4\x00\x50\x00\xa1\x31\x79\xd2\x51\xb0\xce\x9a\x69\xa3\xd8\x4f\xb5\xec\x7e\x6d\x63\x86\x7e\xd9\x0b\x90\x3e\x9d\xc2\xca\x34\x5a\xed\x36\xec\x51\x2a\x71\xe1\x0e\x4d\xb7\x24\x3b\x6c\x14\xf6\x50\x7f\x6d\x7c\x35\x2c\xe1\x7c\xe3\x7d\x20\xe2\x6b\xe1\xd0\x45\x9d\x00\x9e\x75\x39\x92\xdd\xfb\x70\x52\x4e\xb3\x4f\x64\xe8\x08\x21\x47\xdf\xa8\xa5\x07\xb5\x8e\x59\x9e\xca\xbb\xb1\x28\x37\x55\xbd\xab\x09\xe2\xf2\xaf\x20\xbc\x13\x51\xaa\x23\x6c\xdb\x08\x23\xea\x7d\x01\x48\xf3\x19\xaa\xa8\xaf\x63\x39\xfa\x2d\x58\x31\x4e\xa8\x35\xbe\x24\x69\xc6\xcc\xfe\x00\x3b\x57\x34\xbc\x9b\x17\x49\x99\xdb\x25\x4f\xe0\x4f\xbc\x4d\x39\xc7\x8e\x11\x28\x2e\x80\xdf\xd7\x03\x5b\xa6\x44\x69\x6b\x19\x72\xcf\x53\x53\xc9\xf6\x20\xf0\xce\x25\xe7\x46\x38\x96\x7c\xd2\x8f\xe0\x66\xb5\x70\x7b\x2c\xf8\x7b\xce\xed\x1e\xf7\x16\xe7\x34\x30\xd3\x8c\xd0\x08\x4f\x7a\x5e\x07\xec\x91\x6d\x98\x73\xe2\x9f\x8a\x3f\x7e\xfd\x8d\x72\x7c\xbf\xbe\x94\x4c\xc5\xaf\xb1\xc9\xdc\xa2\x98\x57\x51\x9d\x6d\x0e\xfb\xdf\x57\x45\x35\x69\x56\xf7\x32\xaa\x2f\xa2\x9d\x8a\xb2\x44\x84\x68\xf2\x24\xf2\x98\xfc\xe5\x43\x29\x9d\xeb\x16\x94\x5f\xb2\x98\xf4\x94\xb4\xea\x8c\x2e\xc4\x48\x5a\xf5\x3e\xe4\xdf\x9a\xc3\x66\x2a\x6a\x93\x02\xbe\xe5\x5c\x3f\xd8\x51\x21\xa2\xad\x60\xdd\x60\x6b\x51\x86\x53\x2b\xc6\xe2\x1e\x60\xf7\xa0\xd0\x3d\x84\xc6\xb1\x45\x16\xf6\x93\x6b\x70\xcf\x1f\x9f\xb3\xfc\x16\x6d\xfc\xd5\x6d\x84\xca\x2d\x71\xe4\xbe\x84\x95\xf7\x35\x8f\x4f\xa2\xed\x84\xed\xdf\xcc\xd9\xfd\x27\x49\x64\xb9\x09\x1a\x19\x72\xac\x8d\x01\x99\x57\x4e\x97\x3b\x43\x0b\xc1\x59\x3a\x0b\x21\x1e\x09\xdc\xfd\xcf\xb2\xde\x33\x7a\x26\x5f\xcf\xe9\x99\x48\xbb\xb8\xa6\x9e\x27\xe5\x9f\x30\x67\xe2\x4d\x96\x5b\xd0\x43\xe6\x7a\xc3\x06\x65\x05\x88\x36\x04\x74\x3f\xd2\x58\x1b\x71\x44\x75\xd5\x93\x2a\x79\xdf\xdf\x88\x51\xd7\xc7\xe2\xce\x49\x1b\xa4\x5e\x25\xcc\x1b\x39\xa9\x51\x0b\xf4\x95\xe9\xeb\x76\xd1\x77\xb0\x27\xee\x75\x43\x1f\x04\x11\xd6\x7e\x49\xf5\xa7\x6b\xf5\x14\x81\xf0\x59\x95\xa7\x2b\x3b\x25\xd1\xbd\x5e\x45\xa2\xfb\x49\x96\xbc\x61\x3f\xb9\x5d\x30\xeb\x0f\x3e\x57\x5f\xf9\xea\xfa\xdc\x74\xf6\x9e\x53\x6f\xf0\xf3\xd9\x73\x97\x9b\x0f\xb4\xfa\x17\xc5\x10\x3c\x98\x01\xb8\xb9\x6f\x95\xc1\xe0\x09\xb2\x96\x5a\x05\xd6\xaf\x69\x72\x19\xfe\x4c\x8e\x49\xde\x47\x83\xb1\x5a\x1e\xa5\x20\xc3\x76\x04\x52\xfb\xbf\xf7\xbc\x69\x8e\x31\xee\xc2\x1d\xd6\x68\xd4\x04\x23\x02\x47\xd3\xc4\x80\xdc\x94\x5f\xcf\xe8\xbd\x78\x23\x92\x9f\x3b\xa3\xc6\xda\xc9\xb5\x96\x6d\xc5\xe8\x5a\x20\xac\x42\x73\x2b\x54\x90\x83\x86\xc0\x14\xf3\xb2\xa3\xac\x3f\x30\x5d\x12\x82\xc8\x6e\xdc\xb9\x2d\xd5\x6e\x90\xa8\x0a\x39\xa8\xce\xe8\x1e\xfb\x22\x29\xb9\x95\x12\xd3\x02\x7c\x67\x22\x09\xb7\xcc\x12\xf6\xd2\x2e\xe6\xcd\xca\xdd\xd7\x0a\xf6\xb1\xd7\xd3\x6c\xc5\x9c\x9c\xd9\x39\xd9\x8a\xa7\xf9\x86\x67\xf2\x68\x2e\x6d\xaf\xad\x91\xa2\x02\x6c\x29\x00\x7c\x04\xab\x1a\xe7\x01\x55\xe8\x27\x82\x29\x7b\x14\xe6\x00\xa2\x4c\x43\x1f\x3a\x7d\xde\xb2\xff\xd0\xf8\x95\x0e\x2e\x8a\x95\xc0\x9c\x22\x64\x93\x54\x40\x88\x09\xf3\xe6\xf7\x57\xc4\x2f\x8b\xd0\x3c\xbd\xdf\x78\x47\x33\xe2\x4b\x03\xc1\x2c\xae\x2a\x64\xab\x81\xc4\xba\x4f\xb0\xae\x5c\x21\xbe\x17\x28\x49\x0a\xfb\x6b\x12\x0b\x6c\xe6\xe5\x76\xc2\x48\x4d\xe8\x5a\x75\x08\x09\xb1\x58\xb6\xc2\xb0\x72\xee\x27\x9e\x80\x80\x1f\x0d\x18\x91\x6b\x9d\x70\xce\xb3\xfd\x93\xee\xf8\xf0\xc8\xd5\xfc\xc7\x6f\x59\x76\x60\xf7\xb3\xcd\x4e\xd8\x99\xab\x03\x36\x35\xa9\x63\x44\x59\xb7\x50\xc9\x08\xb5\xdc\x11\xf9\xa0\x9c\x1e\x50\xf0\xd6\xd6\x6c\x12\xd0\xe7\x99\x36\xe7\xcf\x1d\x4d\xef\x5f\x4e\x30\x18\x35\x82\x40\xcc\xb9\x68\xf8\x01\x84\xce\x1b\xcc\x0b\xff\x22\x77\x3b\xb7\x35\x9e\x5a\x72\xcd\xd9\x37\xcf\x21\xa6\x4d\x16\xe1\xce\x70\xd5\x8f\x3a\xb1\xa2\x58\xef\x08\xce\x90\xf4\x4f\x41\x33\x54\xa7\x1f\x84\x62\x0d\x57\xbb\x01\x5c\x8d\x1e\x52\x15\xf6\x2d\xfb\xa0\x8d\xd0\x8f\x50\x0a\xf1\x34\x67\x14\xf6\x20\x05\xcf\x26\xe7\x31\x82\x35\xfc\xa0\xf4\xde\xe6\xfc\xd8\xe1\xca\xa1\xb2\x2a\x78\x86\x49\x8b\x03\x8a\x4a\x2c\x96\x1f\xd6\x95\xac\x02\xfb\xf5\x8f\x40\x70\x30\xe7\xb7\x3c\x97\xb9\x61\xad\xdf\x02\x4b\x03\x0e\x9c\x5f\xcb\x6d\x09\xd4\xa1\xde\x5d\x26\x79\x75\xc3\xda\xa1\x9c\xd6\xc2\xb1\xcc\x2e\x63\x75\x8a\xb3\x9b\xa4\xc7\x29\x83\x54\xe5\x75\x12\x78\x75\x13\xe2\xbe\x58\xfd\x5a\x41\x49\x35\x7a\x53\xbd\x66\x1f\x86\xf2\x8f\xec\x3f\x22\x13\x36\x88\xab\xfb\x16\x3d\x6d\xfa\x4c\x9b\xcc\x73\xfa\xb8\xc0\xfd\xe7\x81\x37\xec\x51\x37\x43\xeb\x9b\x8e\x8b\x07\x24\x73\xf1\xc5\x38\xff\xf4\x27\x05\x88\x57\x17\x3f\xbc\x39\x4c\xbc\x3f\x5d\x91\x97\x02\x81\xbf\x1f\x60\x3b\x94\xe5\x81\x09\x45\x6d\x94\x49\xa3\x35\x42\x22\xad\x54\x24\x21\x2f\x7b\xf2\xf1\xe0\x0d\x90\xae\x4f\x94\x21\xd4\x8f\x00\x9f\x8e\xfd\x98\xee\xa9\x0a\x6d\x7b\xcb\x6e\x82\x27\x13\xe9\xad\xfc\x7e\x8e\x87\x43\x56\x71\x3b\x86\x87\x49\x3d\xc9\xd9\x4c\x45\x46\x67\x00\xdf\x06\x5e\x49\x92\xcc\x42\x09\x04\x04\x6a\x24\xb9\x1f\x99\x61\xde\x70\xb6\x4e\x71\x17\x2e\x47\xbd\x96\xd0\x96\x94\x9c\x71\x73\x41\xae\x6a\xa4\x64\xd8\x10\xe7\x08\xd5\xca\x19\xd5\xd8\x22\x37\x82\xf0\xfd\xea\x91\xc5\x21\x0a\x21\x01\x80\x03\x3a\x8c\xb7\x07\xa8\x2f\x20\x18\x0a\xfe\x52\x51\x35\x8b\x6e\xfd\xb4\x65\xc3\x0a\x21\x1b\xd0\x35\xf7\x4f\x00\x08\x90\x11\x60\x30\x2a\x24\xba\x6f\x78\x41\x54\xc4\x8a\xac\x71\xfb\xef\x57\x3a\xfa\x5f\x4a\x99\x32\xee\xf3\x0f\xa8\xf2\xe9\xb6\x7b\xb6\xcf\xdd\x73\x69\xa0\xd5\x2f\x1e\x2c\x8f\x8e\x35\x2c\x93\x4c\x2c\xd6\xc7\x43\x00\xcf\x80\xf6\x53\x50\xbf\xc5\x65\x60\x75\xd5\x93\xc1\x3b\xae\x2f\x9e\x4d\x2d\xdd\x27\x9d\xd7\xe4\xa2\x67\xc5\x54\x73\x4b\xd4\x5b\x80\x64\x75\x51\x22\xc2\x59\x36\x0c\x7c\xd2\x95\x97\xf6\xa3\x4f\x96\x2a\x11\x69\x6c\x8d\xe0\x0f\xb8\xfc\x9c\xeb\x86\x79\x45\x02\x12\xf1\x11\x0b\x04\x7b\xfd\xfe\x1b\x76\x7d\x09\x74\xd9\xdb\x43\x00\x5d\x5f\xdf\x8e\x12\x9b\x60\x67\x09\x40\x22\xfb\x63\x29\x21\x9e\x55\xe1\x6c\x3d\xa8\x1e\x1e\xba\x12\xcd\xc1\x5f\xc9\xd3\x5d\x3b\x97\x70\x8a\xcd\xfa\x37\x8c\xb1\x7c\x07\x33\x7b\x94\x1c\x30\x98\xfd\xd4\x97\x9c\x93\x0d\x7b\xc1\x0e\xee\xc5\x2c\xe8\x32\xe6\xfb\x58\x4d\x78\x16\x9d\xe7\x77\x5b\x46\xa2\xc0\x63\x39\x95\xf8\xc9\xa5\xe2\x4a\xb6\x2e\xe6\xe8\xdc\x74\xe4\x54\x6a\x78\xa6\x60\xa0\x00\x1f\x6f\x3c\x8d\x91\x6f\x56\xda\x9c\xc0\x5e\x23\x70\x06\xb6\x56\xd2\x9f\x67\xd0\x33\x15\xe5\x04\xe4\x8c\x83\x48\xfc\x47\xd0\xfd\xad\x02\xac\x6b\x8c\xd5\x3b\xe4\xdb\xc8\xf6\xa2\x8b\x87\x04\xf9\x97\x74\x4d\x55\xba\x61\xf5\xf3\x5d\x5c\xf8\x9d\xad\x6f\x16\xbc\x9d\x1c\xb0\xeb\x9c\x51\xe1\x42\x78\x23\xcb\x67\x34\x28\x3c\x21\x88\x7f\x71\x49\x72\x9f\x62\xff\x0f\x98\xd9\x98\x61\x87\xff\x61\xaf\xbf\xd5\x06\x13\xef\x54\x0b\x62\xaf\xef\x55\x21\xdd\xad\xdf\x6c\xd8\xa2\x58\xcd\x1c\xbc\x1a\x1c\x05\x24\xe2\x17\x31\x0e\x47\x44\x22\xb6\xe2\x4b\x48\xc4\xfa\xbe\x7c\xcf\x54\x91\x1c\xc9\xb0\xf8\x80\x41\xdb\xd7\x4c\x7d\xd2\x96\xfd\x49\xf7\x35\x7c\xd7\x86\x7e\xfa\x46\x3e\x7a\x82\xda\xb0\xd0\xc2\x55\xac\xfc\x25\x6b\xa9\xc7\x5f\x39\xf3\x3c\x5d\x13\xe3\xd1\xbf\x4d\xb1\x3e\xcd\xc1\x37\x8c\x26\x91\xb0\xf3\x6e\x9d\x37\x10\x9e\xc0\x9e\x7c\x04\x37\x4e\x20\x8d\xee\xb8\x09\x7d\x01\x30\x45\x03\x69\x1e\xe0\x10\x60\xc1\x1b\x01\xac\x66\xca\xcb\x2e\x74\xdc\xd2\x69\x19\xdc\x76\xe7\xc3\xd3\x9d\x91\x83\x1e\x32\x6f\x74\x5d\x98\xd4\x83\x1e\x5e\xc1\x69\x89\xcf\x13\xfb\xa7\xb8\x0b\x16\x8f\x21\xaa\x8e\x37\x2e\xe0\xa8\x28\xf1\x4d\x6f\x6f\x66\x83\x79\x9c\x2a\xd8\x09\xa5\xe3\x2c\x37\xa2\xea\xb3\x56\xae\xd9\x3b\xfa\xa1\x33\x12\x35\x5b\x83\x5b\x47\xfc\xb8\xb4\x91\xda\x82\x9a\x7f\x69\x75\x82\xf9\xe9\x3c\xaf\xdb\x0c\x11\x63\x58\x20\x22\x70\x22\x82\x87\x3b\xf4\xc4\x21\x01\xcb\x62\x95\xe9\x18\x04\xfb\x9b\x6e\x0e\xad\x36\x5d\xfd\xe2\x29\xc0\xa7\x02\xc9\x37\xc0\x9e\xd4\x34\xf8\xc7\xdd\xd0\x32\x6e\xf8\x56\x16\x9b\xc0\xc2\x63\x51\x05\xb9\xd5\x0f\x4b\x32\x77\x47\x8c\xd0\xc9\xbc\xc8\xe9\x2c\xc8\x37\x31\x2a\x1d\xb1\xc9\x44\xd2\x98\x5a\xf6\x1e\x0c\x68\x52\xe9\xa5\x35\x2c\x23\xf8\x8e\x83\x9b\x38\x82\x27\xa1\xb4\x6f\xec\xc4\x8c\xa5\xe0\x99\xef\x45\x0a\x12\x5a\x47\x9e\x48\x4c\x65\x6a\x02\xec\x75\xf2\x99\xb7\x59\x8a\x36\x34\xe4\xf3\xd4\x98\x76\x24\x4f\x8a\xbe\x21\x4a\x4d\x3f\x66\x5c\xd3\x0d\x77\x91\xe3\x14\xef\xae\x4d\xd2\xd1\x94\x11\x7b\xf7\x9a\x5d\xc6\xb9\x03\x7b\x51\x4a\x11\xbb\x67\xa3\xa2\x79\xa2\x87\x07\x5e\x48\xa0\xae\x1f\x9a\x26\xb9\x55\x3f\x1d\x95\xf7\x99\x43\xe3\xc8\x62\xdb\x6c\x2a\x74\xe0\x29\x02\x72\x56\xc4\x48\xed\x77\x3f\x47\xc6\x92\x30\x92\xce\x71\x91\x06\x10\x9a\x0c\x35\x7c\x4b\x54\xef\x0b\xb7\x5a\xc1\xc0\x88\xef\x38\xf2\x31\x7a\x72\x1a\x67\x2c\xf8\x64\x9d\xe0\xeb\x84\xc3\x40\x57\xa3\x35\xa5\x04\x48\x24\xb4\x09\x8b\x15\x7e\x33\x15\x6e\x04\x23\x5f\xd4\xbc\x69\x84\x73\xe3\x0c\x56\xa1\xa5\xa5\xd4\x89\x3b\x0e\x88\x16\x16\xc2\xac\xf8\xe6\x5f\x59\x68\x18\x75\x91\x39\xd8\x9b\x31\xde\xc1\xf3\x10\x80\x8d\xe6\x93\x5b\xbc\x49\xc9\x5c\x5f\x59\xb6\x83\x13\xd5\x19\x55\x10\xc3\x48\x89\x1a\xc9\xbd\xf7\x96\x16\xb8\x9e\xec\x06\x0a\xbd\x24\xaf\x16\x79\x2e\xc3\x05\xbc\xad\xf7\x93\x00\x0d\x07\x46\xf4\x90\xe3\x81\x9a\x4f\x23\x77\x0a\x0e\x0d\x90\x60\x12\xc6\x6a\xc5\x41\x8f\x7a\x9d\x60\x36\xb7\xd0\x95\x09\x37\x49\x57\x3e\xe2\x58\xfd\x6d\x71\x99\xf8\x10\xd2\x88\x47\x61\x7a\x8b\xdc\x51\x29\x3c\xbb\x1d\x59\x01\x23\xb0\x2c\x41\xde\xa9\x1a\xda\xad\x00\x96\xb5\xe4\x4e\xd2\xcd\x6d\xe9\xa5\x98\xd3\x00\x55\x94\xb0\xf1\x92\x1b\x22\x6e\x19\x3a\x83\xdd\x55\xdc\xa6\xed\xa8\x89\x3d\xdd\xb7\xe8\x26\x8b\xcf\x2e\xbc\xf3\x91\x20\x5a\x0f\xe4\xb6\x9f\x19\xf8\x5b\x76\x6f\xa1\x60\xe9\xf9\xbd\xf2\x5f\xfb\x55\x60\x44\x39\x14\x39\x55\xe7\xab\xec\x3a\xd3\xa1\x84\xbd\xe4\x8b\x82\x09\x15\xf9\xa9\x77\x94\xc6\xa3\x5e\x9d\xde\xbb\x93\x54\x72\xa1\xc4\x4f\x27\x0c\xdc\x9e\x16\x90\x0a\x2c\xd2\xd3\xb7\x18\xda\x2a\x20\xa7\xbd\xc1\xbc\x4d\x42\xbd\x8f\xb4\xf5\xea\x40\x3e\x9b\x0f\xd0\x31\xb9\xf8\xf3\x20\x0d\x94\x3f\x55\x89\xee\x54\x27\x44\x51\xb3\x41\x21\xe5\x72\x72\x33\x60\x45\x8b\x52\x11\x08\x6d\xc6\x41\xaf\x5b\x9a\x6e\x37\x39\xdf\xa7\x15\x20\xd6\xe3\x43\x9c\xb8\x13\xc6\x14\x77\xce\x5e\x91\x1c\x89\x73\xbe\xc4\x86\xed\xa5\x68\xca\xa8\x27\xb0\x97\xd6\xc5\x56\x4a\x54\x3e\x28\xa2\x8e\x7b\x10\x2b\xf9\x79\x90\x5d\x7b\xc2\x1e\xff\x90\x9c\x5f\x33\x5c\xb3\x60\xdc\x54\x7e\xca\x79\x6b\x95\xd9\x35\x9e\x2f\xb6\x64\x2d\x81\x37\x4e\x24\xb8\x89\x9e\x56\x3c\x20\xfd\x77\x3c\xb4\x23\xf9\xed\x9c\xfd\xfb\x03\x18\x3e\xbd\x17\x63\x4e\x40\x09\xa2\x18\x42\x95\x98\xdc\x38\xa4\x94\x0c\x51\xaf\x1c\xec\x0d\x2f\x7a\x9b\x4a\x55\x44\x41\x4b\xf4\x15\x52\x81\x29\x5a\x2e\x81\x23\x1b\x94\x40\xf4\x1e\x80\x62\xfa\x31\x61\xcc\xa8\x67\x9e\x3d\x9a\x3d\xec\x41\xb6\xba\x79\x74\xd7\x68\x9a\x20\xb6\x3f\xaa\x5e\x9e\x7a\x61\x5e\x4e\x1f\x0e\xe9\xc9\xd1\x8b\x3e\x76\x24\x74\xf1\x9c\x9c\xe9\xb8\x60\x06\x90\xf0\x81\x54\xc9\x46\xd3\xb6\x61\xa5\xe8\x84\xf2\x39\xaa\x1a\xdb\x91\xf0\xc0\xe8\xa9\x67\x6a\xb9\xfc\x9e\x9f\x99\x9e\x60\x76\xf9\xc4\x4c\xc9\xdd\x92\x94\x1e\x87\x65\x45\xd4\x42\x99\x4a\xc9\x5e\x70\xe3\x83\x40\x67\x0c\xb1\xf3\x15\x77\x15\xef\x67\xb6\x54\xfe\x42\x70\xee\x28\x9a\xd2\x29\x2d\xec\x66\xb2\x14\x3d\x8f\x04\x75\xdb\xfa\xb2\xb7\xed\xdc\x31\xe5\xdb\x51\xc9\x20\x55\x3e\x92\x4b\x6a\xaf\x64\xa5\x92\xd3\x63\x6c\xad\xd7\xc6\x17\x3f\x0a\x3b\x18\x02\x52\xac\x0a\x31\x66\x45\x0a\xd0\x69\x7f\x9a\x94\xae\xed\x8c\x54\x0f\x68\x03\x6b\xdd\x1c\x08\x08\x82\x9a\xe1\x20\x93\x08\xa4\xba\xa9\xfb\x7e\x7d\xfb\x1c\x3d\xc4\x35\xa5\x36\x5f\x7c\x5d\x6a\xb9\xc0\x4a\xea\x58\xb4\xc9\xbd\x59\x20\xd7\x02\xbd\xe8\x0a\xa2\x0b\xbf\x7b\xa8\xc9\xf2\x12\xfa\x96\x6d\xac\xad\x95\x92\x14\x29\x9c\x2d\x34\x82\x5b\x67\x4a\x3e\x17\xa2\xeb\x99\x6e\x4a\x06\x3c\xf6\xdf\x57\x13\x63\xa0\x87\x06\x8d\x14\x25\x49\xc8\xb3\x9d\x67\xa8\xd6\x06\x33\x03\x8d\xac\x7c\x34\xd4\x34\xb0\x6c\xe2\x59\xbc\x36\x3b\x0b\x7c\x29\xf0\xfb\x3d\xf1\x89\x2a\x31\xf4\x86\x37\xc0\x01\xea\x1e\xa2\xd3\xd2\x3a\xf7\xbe\x80\x1a\x17\xc2\x75\x2c\xa0\xd5\x70\x33\x34\xb2\xea\xf1\x9b\xc5\x60\xe0\x62\xe0\x47\x07\x0e\xdd\x10\xb6\x91\x43\xe5\x66\x28\xe5\x0b\x0d\x72\xbb\xe8\x7e\x40\xf1\xa0\x95\xd6\x22\x7b\xef\x8e\x2b\x38\x7b\x1b\xd9\x6e\x4f\x9e\xa5\x31\xe3\x0c\x4d\xa9\x9d\xd1\x8f\x18\xa7\xbb\x73\x74\xab\xcb\x43\x90\x55\x44\xf4\x66\x33\xe1\xb7\x72\x3e\x6c\x00\xf6\x5b\x11\x51\x9d\xe0\x3f\x4c\x7c\x21\xde\x8a\xd1\x49\x03\xa0\x27\x4f\xfa\x70\xa4\xa9\x79\x2a\xc2\x7e\x95\x37\xda\xae\xdd\xf1\x1f\x41\x48\x6f\x55\x8a\xfb\x05\xe1\x12\xb4\xdb\x15\x73\x0b\x0d\xbc\x71\x1f\x10\x42\xf7\x19\x16\x45\xa1\x90\x79\x7d\x0b\x1b\x5b\x5a\xd6\xf2\x92\x92\x7e\xed\x60\x6b\xa3\x75\x4b\x0a\xaa\x1b\x66\x79\x55\x19\xdf\x9a\x5d\x71\x32\xb1\x40\x33\x0b\xfb\x3f\x27\xc4\xff\x6d\x81\x18\xf2\x91\xa2\x87\x49\xe4\x79\x14\x8f\x81\x95\x79\xc0\xb7\xa3\x13\x70\x70\xd3\x8e\x24\x4e\x7c\x3e\xef\x3d\x39\x5e\x7a\x33\x08\xeb\x4e\xe5\x0d\x53\xba\x97\x6e\xb3\x10\x7f\x60\xa9\xb5\x71\xdb\xbe\x2c\x85\x72\xf3\x04\xb9\x8f\xd8\xe5\xee\x6e\x1e\x9c\xc9\x3f\x43\x21\x36\xb2\x53\x80\xa7\x31\x10\xff\x5b\xe0\xf8\xf6\x70\x88\xf5\x8b\xb0\x37\xf2\x61\x9a\x11\x9f\x6b\x2f\x3a\x67\x11\x2e\xe7\xb4\xce\xcf\x88\x9f\x66\xdb\x59\x68\x2c\x3a\x8a\x34\x3f\x4b\xf2\xc6\x9d\x34\xe2\x73\x2f\xbc\xe7\x59\x73\xd2\x0d\xc6\xec\x15\x67\x95\x44\x25\x88\x3e\x0a\xda\x65\x89\x2a\x12\x09\xf3\x80\x20\x70\x63\x11\x7e\x07\xe9\x73\x60\x2c\xc8\xab\x23\xc0\x5d\x58\x09\x65\x9d\x33\xfa\x83\x62\x63\x01\x84\x83\xcf\xda\x67\xb2\x2c\xf0\x2d\xac\xf7\x27\x22\x76\x33\x2d\x81\x1b\x6f\x63\xe9\xf4\x4b\x92\x44\x35\x47\x4a\x43\x55\xa6\xc9\xda\x85\xb5\x64\x07\xc5\x5b\xb9\x8a\x17\xfd\x1c\xea\xa7\x8f\x6e\x5a\x5e\xae\xb4\x72\x47\x22\x0d\x67\xd6\xf0\xee\x41\xf6\x38\xe2\x8e\xc1\x53\xda\x51\xa4\x97\x50\x19\xf5\xa1\xb1\x79\x7a\x48\xa4\xad\xea\x3e\xcf\x05\xb6\xc8\x5d\xd9\xa3\x91\x33\x38\x72\xf8\x67\x2d\x77\x35\x5a\x85\x5b\x8f\x41\xae\xa5\x2f\x5a\xc3\xef\xe7\xf3\x87\x27\x65\x40\xc3\xfd\xe9\xc8\xdb\x60\xdc\x34\x16\x42\x0f\x80\xdd\x15\x78\x94\x15\xdd\x11\x77\xe5\xd5\xe5\x0c\xe8\xe1\xc5\xc4\x29\x49\x23\xc9\xf6\x54\x93\x99\xc6\x82\x89\x2f\x9f\x3f\xbe\xff\xcb\x26\x8d\x4e\xd2\x42\x26\x01\x98\x37\x84\x00\xba\x9d\xef\xe3\xb8\x57\x87\xa3\xfa\x2d\xbe\x54\x16\x6e\xae\x8d\x27\xd3\xea\x78\x91\xfc\x9d\x30\x3a\xde\xf1\xc2\x1b\x9e\xd1\xc1\x04\xc9\x91\xdb\xf9\xb9\xce\x90\x28\x90\x0f\x08\x88\xa1\x30\xe9\xcd\xb8\xc4\xf2\x96\xe5\xea\x53\x60\x48\x82\x10\x8b\x1b\xf3\xab\xa0\xa4\x0b\xbe\x6e\x39\x50\xa2\x90\xa6\x29\x86\x5f\x3e\xd3\x15\x75\x6f\xdd\xfd\x43\xf1\x37\xc0\x72\x26\xea\x2a\xa3\x0b\x5f\x95\x57\x97\x44\x13\x15\xe6\x0d\xba\x1b\x85\x65\x51\x22\x03\xc7\x74\x9c\x74\x63\x66\x09\xa5\xb3\x33\xa0\x65\x8e\x77\x08\x7a\x1c\xdb\x03\xb3\x7b\xd9\xb6\x52\xed\xde\xb2\x3f\x11\x17\x48\xc0\xdf\x99\x22\xfc\x63\x4f\x8d\x3c\x7a\x70\x3b\x39\xf3\x10\xd2\x3d\x95\x2c\xf2\xf0\x5a\x5f\xdf\xf7\x75\x23\x5c\x60\xbb\x42\x69\x5b\x2a\xa6\x4d\x89\x69\x43\x2f\xed\x09\xed\x0a\x98\x9c\x74\xfb\x92\x52\x19\xee\x4a\x9b\x9c\xb7\x24\x87\x27\xb9\x03\x01\xd7\x5c\x52\x82\x04\x2b\x70\x82\x1a\x07\x80\x1e\x77\xa9\x4c\x1f\xfd\xed\x76\xe6\x6f\x37\x33\x7f\x7b\x37\xf3\xb7\xeb\x99\xbf\x5d\xad\x93\x02\xfc\xbb\x02\x5c\xc3\x47\x61\xdc\x19\xf9\x6b\xb1\x43\x9d\x56\x14\xf3\x6a\x1e\x52\xe0\x3a\x21\x9a\x34\xac\xdd\x6d\x25\xcc\xf9\x5e\xeb\xf2\x79\x3e\xf3\x59\x1e\x72\x00\xa8\xa8\x54\x09\xbe\x95\xaa\x6c\xa0\xb7\x04\xc4\xac\x21\x7d\x8a\xab\x1b\x16\x09\xe5\x73\x6d\x10\x78\xe1\xf6\xc1\x4e\x68\x0c\x86\x19\x05\x0d\x8a\x23\xf1\xc5\x1c\x3b\x54\x8f\x51\x4b\xe5\x00\xca\xec\x8c\xfd\x1e\x83\xff\xfb\x6f\xd8\xd5\xe5\x26\x2d\x31\xfa\xca\x68\xd8\x5c\xba\x62\xd7\xa3\x54\x33\xba\x4c\x7e\xc7\xcc\x16\xdb\xce\xb1\x24\x40\x11\x3f\x9b\x90\xf4\x44\xe5\xb1\x71\x38\x92\x75\x60\x01\xa6\x39\x44\x16\x88\x64\xa2\xfc\xde\x26\xdf\xf8\x36\x20\x11\xdc\xd4\x09\xc3\x8b\x3e\x48\x76\x60\xda\x2b\x9f\xe7\x34\x07\x9f\xbd\x32\x02\xb9\xd6\x43\x0b\xb1\x07\x7d\x1b\xe8\x96\x4a\x1d\xe9\x58\x2a\xd1\xa3\x58\x3c\xc6\x45\x1b\x56\x34\xa8\xf2\xb1\x71\xc3\x2e\xe1\x3f\x2a\xd2\x19\x2e\x1a\xdd\xd7\xc0\x76\xec\x2c\x30\x74\x5b\x4a\x28\x25\xb9\xcb\x23\x93\xad\x26\xe5\x58\xaf\xdc\xb3\x97\xd8\xd3\x44\x91\xf6\x4e\x7a\xbd\x31\x9c\x89\x4d\x36\x15\x61\xed\xa1\x53\x6b\x1f\xbc\x59\xda\x0a\xdb\x87\x44\x2c\x85\x73\xb8\x04\x65\x1f\xba\x1a\xe3\xcf\x88\x55\x79\xcf\x25\xb9\x51\xd1\xd3\xa6\xfb\xae\x41\x95\xce\xbf\x2b\x3f\xd7\x9c\x06\x08\x04\xd2\x30\xab\x5e\xd3\x29\x62\x2a\xef\xe6\xfc\x89\xa7\xb0\x45\xfd\x83\xb7\x9d\x34\xb2\x60\x94\x0d\x58\xd1\x92\x71\x8e\xe8\xe9\x6f\x02\x78\x02\x91\xf4\x37\xeb\x4c\x9a\xa0\x70\xde\xef\x38\x5b\xf3\x52\xef\x2f\xf6\x06\x54\x57\x4b\x0c\xde\x10\xf1\xdd\xd5\x5a\x91\x3d\x83\x19\x87\xfa\x0b\xec\xf9\xda\x85\xdb\xa4\x5b\x83\x04\x73\xa7\x09\x57\xf8\xc4\x7b\x86\x0b\x80\x53\x42\xde\x71\xed\xb9\xdc\x28\x48\xf4\x74\xbf\x77\x63\xfd\xf6\xc8\xbc\x63\x04\x84\x79\x49\x2d\x32\x14\x8b\xc1\x63\x86\xb3\xb9\xd5\x03\x52\x2a\x8d\x65\xe0\x4b\xc1\x9b\x3e\xd5\xd3\x1b\x8b\x18\x65\x01\x49\xfa\x40\x0b\x96\x6b\xca\xf6\xf3\x05\x5a\x6f\x6f\x02\xf7\xc4\xaa\xd6\xdb\xbb\x85\xd6\xdb\x77\x66\xea\xa5\x9c\xe0\x78\x79\xb7\xaa\x83\xff\x1f\x12\x69\x85\x3f\xe8\xe2\x41\x98\x75\xe4\x6c\x67\x29\x0c\x9f\x70\x25\x9e\xdf\xb6\x7f\x24\xd9\x31\xa8\x46\x70\x5b\x67\x0c\xae\x92\x84\x65\x10\x03\x80\xdc\xc2\xc3\xb6\x6f\x5c\x50\x50\xd4\x1c\x33\x8a\x96\xe5\xdd\x12\xc7\xe1\x3b\xdf\x67\x00\x86\xd0\x7f\xe4\x7e\xf0\x9a\x24\x4a\x9d\x81\x8c\x9c\xa2\xb1\x3d\x0a\x79\xf4\x66\x49\x1d\x8e\x88\xfc\xfa\x16\x44\xd6\xd9\x43\x51\xc7\xed\x01\x75\x3f\xe7\x40\x97\xd2\x4e\xf4\x6d\xe1\x0c\x48\xd3\x28\x12\xbd\x75\xeb\x61\x00\x49\x40\x84\x35\x65\x4f\x35\xfc\x3c\x5e\xa3\x9b\x45\xba\x96\x9b\x09\x5d\xcb\xcd\x9b\x19\xb6\x96\x9b\x39\x5a\xa3\x9b\xa9\x6f\x3d\xf9\xd3\xbb\xe9\x9f\x6e\x92\x3f\x2d\x6d\x86\xde\x48\xdd\xc8\x82\x7d\xec\xa0\xb1\x70\xb6\xe9\xeb\xe6\xb9\xc4\x16\x57\xb7\xe7\x62\xe6\x76\x12\x7c\xcc\x66\xd8\xb1\xad\x6c\x96\xe0\x70\x4f\xe9\xad\x3d\x9f\xa2\x88\x92\x86\x7d\x94\x38\x99\xf4\x74\x71\xb6\xa3\x96\xa9\x2b\xec\x03\xdb\x52\x12\x4a\xb4\xc2\xf0\xa6\x64\xbc\x90\xa5\xdb\x9b\x20\xb9\x8f\xea\x50\x08\xa4\xee\x1a\x5d\x0a\x6a\xb1\xbd\xbe\x4c\x29\x9e\x5e\xae\x71\xfd\x78\x7e\xe7\xea\xb2\xbc\xc1\xb1\x25\x1b\xec\x76\xfc\xb7\xfe\xf8\xce\x39\xdd\xf4\x90\x74\xb6\xbb\xab\x48\x25\x7b\xc9\x9b\xf4\x7e\x4a\xaf\xb9\xd3\x97\x3c\xbb\x6e\x8f\x9d\x5d\xa3\xd1\x66\x1b\xff\xba\xbc\x59\x38\xc3\x6e\xfa\xfa\x57\xa5\x5f\xba\x9c\x9a\x81\xdb\x55\x66\xe0\x27\x5a\xa9\xdf\x4a\x33\xad\x2d\x3c\x7f\xf7\xaf\xe6\xaa\xc0\xb6\xe0\x10\x62\x77\xb5\xb6\x5d\xad\xcd\xb0\x54\x95\x7e\x8e\x8b\xfa\x57\x2a\x2b\x1c\x23\x3b\x63\xaf\xbf\x95\x42\x95\x6f\x9e\x6e\x37\x02\xb5\x99\xcf\x48\xbb\x80\x8a\x52\xa4\xba\x71\xf6\x80\xda\x7e\xd6\xa7\x9d\xef\xb2\xac\xf3\xf5\x24\xe9\x7c\xe5\x7b\x20\x41\x72\x12\xa4\xa7\x9c\x3b\xe0\xce\xff\x78\x91\xeb\xcb\x09\x97\xf4\xa9\x6b\x25\xf9\x6b\x69\x99\xee\xf8\xcf\x03\xee\xdd\xdf\x20\x9b\xfd\x0c\x73\x77\x5b\x7e\x8d\x2f\xe1\x85\xd3\xd8\x3f\x28\xc1\x20\x53\x1c\x72\x88\x4d\xb3\x21\x05\x45\xcc\x7e\x02\x0f\xdd\x11\xa3\xb4\x21\x2e\xad\xd1\xe8\xa0\x25\x21\x9b\x15\xd2\x40\x48\x5b\x3e\xa7\x65\x6d\x54\x2c\x80\xc1\x98\x71\x02\x7b\xa6\xab\x31\xf4\x4c\xd0\x4d\x7d\x0e\x3b\x69\x3b\x85\x17\x15\xa0\xc8\xb1\x39\xf5\x74\x27\xaa\x5b\x34\xa4\x98\x3a\x9a\x1d\x7a\xe4\x60\xfa\x7f\x1b\xf3\x7e\x3e\x2b\xd0\xc8\xac\x93\x9d\x5d\xd3\x33\x17\xec\xac\x33\x34\xab\xda\x9a\xbe\x90\xa1\xed\x80\x31\xde\x99\x58\xc0\x05\x20\x62\x60\x7b\x60\x85\x19\x90\x0a\x86\x43\x9a\xc8\x80\xf8\x4a\xaf\xd5\xf9\xfd\x08\x97\x64\x81\xcf\x16\x30\xbe\x4f\x92\x99\xd1\x6a\x22\x77\xd8\xd3\xeb\x78\x9f\x46\xc6\xc6\x4b\x71\x6b\x23\xdd\x19\xdb\xe7\x5a\xa2\x1b\x4c\xf6\x25\x54\xfc\xda\xb0\x47\x61\x80\x30\x8b\x6d\x01\xf0\x8b\x2a\xd8\xa0\x86\xca\xd5\xae\x11\x81\xb0\xdd\x9d\xe3\x46\x08\xe4\xe0\x0f\xaa\xd8\xa8\xd4\x30\x35\xfc\xd1\xd6\x23\xb4\x35\xb4\x30\xd4\xa2\x95\xd6\x39\xe5\x70\xcf\x52\xb7\x82\x54\x5d\xbb\x20\xf9\x01\x48\x02\xe7\x26\xba\x29\xca\x58\x8f\x82\x12\x1d\xc4\x71\x35\xef\x9c\xd1\xac\x1a\x67\x15\xe8\xbc\x81\x37\x3e\x80\xaf\xdd\x0b\x15\xc8\xdb\xb7\x87\x0b\x2f\xd5\xda\x71\x25\x1a\xef\x81\xc2\x3f\x42\x07\x45\xa1\x55\x2f\x77\x03\x30\x37\x63\xea\x92\xfa\x1a\xdd\xb7\x90\xfd\x44\x1d\x12\xd8\xb6\x3f\x34\xae\xfe\xc7\x4d\xa2\x55\x00\x33\x76\xe4\xf0\xf0\xa1\x26\x16\x70\x86\x3e\x76\xef\xf3\x0c\xca\xd5\xa1\xdc\x20\xda\xab\xac\x2e\x98\xe2\xb8\x88\xe0\x8c\x5a\xb7\x26\x76\xe8\x75\xae\x94\x23\xdd\xa6\x97\xa5\x58\xe4\xce\xfc\x0f\x8d\x22\x04\x90\x9e\xad\x0f\xd6\x53\xa9\x71\x9b\x13\x0d\xa0\xc9\xfd\xbe\x27\xa5\x81\x41\x61\x87\x6f\x93\x79\xbf\x41\x08\xb7\x94\x60\x31\xf0\xbc\xc0\x7f\x78\xb1\xc5\x7b\xf7\x6f\xa9\x7a\xb1\x83\x26\x11\xb4\x6e\xa5\xb0\xbd\xd1\x87\xc4\x46\x87\x8e\xb9\x0d\xab\xf5\x5e\x3c\x7a\x74\x2e\x2e\xfe\xc6\x6a\x02\x45\xd8\xe3\x50\x95\xad\x73\x7d\x80\x89\xc2\x7f\x40\x72\x78\x93\xe7\x5a\x91\xd9\xf4\x26\xef\xfb\x19\x83\x37\x07\x9d\xfa\x75\x3c\xcb\x9f\x07\x6e\xfa\x5f\x7e\x3d\xa3\x36\xeb\x0a\xca\xe2\x0c\x4f\xf0\x09\xd6\x01\x6c\x43\xfb\x72\xb6\xc1\xba\x59\x13\x5f\xc4\x2e\x04\x37\x93\xc8\x47\xce\x77\x29\x5f\xce\x5e\x8c\xfd\xce\xd3\xf6\x03\x20\xe3\x4f\xf2\x4e\xfb\x19\x22\xe1\xcb\xf2\x8e\x15\xba\x29\x5f\xda\x41\x4d\xbd\xf7\x44\xc2\x17\x00\x84\x78\x6c\x79\x4c\x28\x56\x45\x06\x0b\x8c\x09\x45\x0d\xdd\x32\xb1\x46\x77\x8d\x4a\x97\x59\x3b\x5b\x27\x4c\x90\xf8\xb6\xa2\x88\xea\x2f\x88\x10\x7f\x1c\x1a\x25\x4c\x54\x08\x0f\xfe\xed\x5b\xf6\xa3\x28\x87\x02\x3d\x8e\xd1\x05\x10\xbf\xd3\x34\x93\xde\xb9\x60\xf4\x24\x96\xd1\x40\x59\xc4\xb2\xad\xa8\x25\xa4\x61\x6c\x4d\x6e\x70\x65\xe4\x4e\x96\x8c\xcb\x20\xf3\x35\xc2\x6c\x04\x2d\xaf\x8c\x97\x45\x87\x77\xe6\x8d\xdd\xdc\x15\x17\x9d\xe1\x95\xdc\xa4\x9f\xa6\xaf\xff\x36\x7f\xfb\x5f\x8c\x5a\xe4\xd9\x0e\xf5\x57\x27\x1c\xea\x91\x97\x3f\xda\x73\xe3\x34\xca\xdd\x04\x6c\x02\xc5\x1d\x77\x9c\xae\x79\x19\x67\x14\x16\xee\x66\xbc\x77\xb7\xe7\xa6\x99\x93\x35\xd5\x04\x7f\xbc\x7d\x74\xa3\x5f\xcd\xf4\x79\xd6\x21\xf7\x87\xb5\x67\x5c\xcd\x55\x09\x6f\xbe\x62\x96\xab\x25\x20\xc2\x73\x4e\xb7\x27\xe4\x40\x72\x46\x50\x9c\x30\xbb\x97\x06\x40\xbd\xc0\x5a\x93\xc3\x03\xc6\x0e\xfd\xd1\x56\xe2\xe3\xc8\xbc\x34\x45\x72\x35\x4d\x6b\xa4\xb8\xbc\x60\xa8\x1e\xb9\x92\x40\x4d\x1a\x28\xda\x52\xec\xc3\xf7\x3d\x3a\x46\x16\xa4\xc8\xe1\x11\x00\x3a\xb5\x1d\x7a\xa8\x75\xc4\xde\xfb\xfb\xcc\xf7\xdc\x36\x12\x94\x83\x91\x5b\x31\xc1\x25\x85\xe3\x28\x1c\x1f\xb6\x13\xa0\x21\x3c\xe1\x46\x08\xb4\x8e\xfe\xa4\x0c\x00\x33\x0c\xb2\x7f\x8d\x24\xe3\x8a\x3d\xe0\x02\xc5\xdf\x32\xaa\x45\x27\x0f\xde\x12\x12\x8a\x71\x25\xfb\x97\x0f\x5d\x73\x02\x8f\xf7\xc2\x0a\x13\x92\x88\xec\xf5\x07\x3d\xa8\x9e\x4b\xb5\x52\x40\x34\xed\xa1\x0f\x7b\x03\xfd\x41\xa0\x63\x7a\x89\x98\x57\x5a\x76\x07\x71\x17\x2c\x18\xef\x63\x01\xe8\xbf\xed\xb4\x15\xe5\x0b\x45\x81\x91\x69\x86\x65\x7e\xdf\x7d\xd3\x83\x22\x94\x7c\x14\x5e\x48\x38\x51\x3a\x4c\x6e\x7a\x9d\xdd\x34\xf2\x5a\x03\xba\xf1\x5d\xf6\x08\xbf\x8e\x2f\x78\x5e\xec\xf8\x06\xc2\xd6\x14\x52\xe0\xa5\xac\xed\x60\xc0\xb4\x51\xc9\xd3\x1d\xa3\xb2\xc4\xd3\x2a\x5e\x49\x9b\xdc\xa5\xf4\xde\x73\x16\x1e\xbc\xd9\xe4\x44\x78\x69\x17\xfe\xa2\xdf\x19\x18\x87\x13\x42\x37\xe7\xe3\x3a\x17\xcf\x13\x65\x25\x38\x25\xf7\x91\xed\x04\xc8\x8c\x06\xc5\x3a\xea\x1e\xa5\x1e\x1e\x05\xfd\x4c\x65\xfa\x10\xab\xfc\xcf\x20\x6f\x04\xe4\x84\x10\x80\x80\x42\xac\xb0\xd2\x88\x11\xef\xdc\xab\x1e\x9d\xb4\x43\x4c\x52\x92\xd1\xcc\xc9\x5b\x4d\xc0\x4e\x45\xbf\x83\x3a\xab\xa1\x1a\x8d\xf2\xef\x21\x85\x33\x4a\xc9\xb8\xa0\x44\x9a\x16\x4d\xa3\x4f\x40\x60\xa9\x3a\x84\xd1\x1b\xd6\x0a\xb3\xf3\x89\x17\x85\xaf\xd5\xbd\x99\xe6\x00\xa2\xdb\xda\x50\x9a\x17\x36\x29\x9c\x6c\x3d\x71\x34\x0f\x36\xe3\xc9\x4d\x5c\x2b\x60\x25\x93\xe5\x8e\x08\x00\x6c\x0b\xfc\xb2\x3e\x50\x34\xbc\xed\xd6\x00\x99\xfc\xf7\x6d\xc7\x15\xdb\xc1\xbf\x4c\x2e\x4c\x28\x15\x6b\x00\x42\xb7\x89\x4d\x56\x35\x6f\x08\xab\x05\x5d\xbf\x1e\x79\x8d\x7b\xbc\xd7\xfe\xb2\xf4\x6c\xa9\x12\xaa\x19\x4a\xd1\xf8\x57\x17\xe6\x3b\xfe\xa4\x30\x42\x89\xa6\xe1\x44\x32\xbd\xe5\x7d\x4f\x1a\xe3\x89\xb2\xfc\xf9\xa1\x0a\x84\xa5\x81\x98\x73\x65\xe0\x92\xd8\x2d\x1f\xc0\xdc\x1e\x09\x60\x7c\x4e\x14\xec\x8b\x12\xd6\x66\xd1\x49\x98\x97\xc5\x58\x04\xdb\x79\x11\x59\x5c\x68\xa5\x30\xf9\xef\xed\x99\x76\xae\x7d\xc3\x3b\x1b\xca\x9c\xef\x3f\xbc\xb2\xac\x94\xb6\x30\x62\x25\x87\x66\xcb\x25\x9c\x2f\x1e\xaf\x99\x9c\x60\x33\xcc\x21\x40\x50\x5a\xeb\x66\xdc\xf1\x04\xb3\x3b\xe9\x5a\x3e\x92\x8c\x7a\xcb\x7e\xf0\x4d\xee\xc9\xaf\x4b\x69\x83\xde\xf2\x8c\xc3\x74\x86\xe3\x00\xea\x62\x5f\x80\x3c\x6e\xb5\xe3\x90\x78\xce\x28\xf3\xf5\x65\x7c\x86\xb5\x89\xa1\x1e\x71\xe7\x5d\x23\x39\x20\x7a\x7b\x48\x30\x97\x6c\x6b\x06\x5b\x3b\x83\x61\xfb\x46\xfa\x76\x07\x67\xde\x1a\x71\x61\x6b\x6e\x3a\x1a\xfd\x4c\xba\x3b\xa3\xa2\x9b\x49\x3a\x2d\x24\x5d\x58\x42\x8f\xdb\xeb\x15\x25\xc9\xa9\xbf\x7d\x9b\xb8\xdb\x98\xa7\xf2\x2a\xc3\xbe\xf3\x37\x02\x1f\x32\x69\x5b\x69\x47\xf5\x4a\x77\xcd\xc9\x25\x93\xe7\x9d\x73\xd1\xff\x5d\x2b\x91\x5f\x95\x5f\x4f\x95\xdb\x5e\xaa\xa9\x66\xe4\x20\xa0\x00\x70\x9e\xbe\xdd\x30\xde\x6c\x85\xec\x5d\x8c\xbc\x6f\x90\x6e\xb9\xe3\x52\x55\x43\xd3\x1c\x48\xf9\x35\x0d\x3a\x16\x09\x75\xf1\x82\x01\xdf\x05\x91\xcc\x94\x5a\xf7\x2d\xfb\x76\x30\xce\x96\xb4\xda\x93\xf0\x24\xf9\x93\xe4\x06\xe3\xd2\xe3\xa9\x3a\xe3\x42\x97\xc6\xb1\x37\xf4\x7d\x9f\xbc\x07\xdb\x70\x2c\x7c\xad\x4e\xbb\x3c\xa1\xcd\xe9\x0b\x24\x5c\xb6\x40\xc9\x77\xe8\x90\xfc\x6e\x54\xcb\x3c\x5d\xca\x9c\x4b\x86\x7c\x75\x56\x29\xf3\x27\x8e\xa9\x80\x2f\x91\xf9\x58\xdd\x94\xb1\x56\xe3\xe4\x59\xf1\xde\xf3\x41\x64\xb3\x89\x10\x6c\x51\xfc\xb5\x13\x20\x49\xc2\x3d\xab\x07\xd0\x9e\x79\x01\xc0\x48\x66\x96\x97\x53\x2b\x9f\xc6\x19\x11\x69\x81\x07\x4e\x16\x43\xd3\x7b\xb5\xeb\xc5\xbd\xa5\x0e\x38\x2b\xe5\x48\x14\x8d\xd0\x12\x99\x2d\x09\x77\x71\xe7\xce\x1c\x86\x34\x81\x8f\x52\x9f\x7c\x02\xc8\x90\x16\x1d\xe7\xa0\xaf\x09\x1f\x92\xb4\x12\x69\x6b\x8c\xed\xa2\x9b\x3f\x44\x85\x82\xd1\x22\x2d\xb4\x8f\x40\x8c\x44\x44\x16\x82\x37\x59\xda\x17\x5b\x75\xe6\x8a\x89\xe4\x5c\xf6\x89\xb0\x53\xbc\x4f\x2d\xc6\x25\x48\x0d\xc5\xe8\x5f\x28\x56\x61\xaf\x43\x90\xce\x19\x29\x6c\x51\x59\xc7\x67\xdd\x81\x18\x43\xff\x22\xd4\x1b\x72\x9e\x6f\xb3\xea\x0f\x7e\x16\xbe\x4d\x3e\x35\xba\xd4\x57\xb7\x29\x27\x46\xea\x3c\x8f\x7e\xb5\xe0\x45\x53\x7b\x20\x4f\xc7\x43\x1f\x8b\x32\x6b\x5d\xc4\x8d\xe3\xa3\xbc\x4a\x02\xd8\xf7\x37\x4f\x85\x19\x68\xa4\xf9\x93\x56\xe5\xc4\x1e\x5e\xcf\xd8\xc3\xfb\x17\x67\x8a\x70\x47\x38\x30\xa1\x75\x2e\x00\x53\x43\xeb\xf5\xfe\x72\x2a\x98\xdb\x4b\xb6\xeb\xe0\x54\xd8\x24\x52\x2e\x10\x40\x21\xba\x1b\x8e\xd0\xbd\xe0\x66\xe2\x11\x3e\xb5\xc3\xcd\xf3\x41\x04\xa6\xdb\x6f\x8c\xde\xab\x39\x63\x99\xbc\xa9\x3d\x37\xa5\x05\x76\xcc\xa3\x1c\x10\x10\xb4\x44\xae\xe6\x83\xed\x65\xe1\x83\x2f\xe4\x45\x44\xc2\xe6\xf0\x80\xa9\x5c\xc6\x5c\x9b\xdb\x4f\x90\xaa\xed\x33\x69\x42\xb2\xbd\x77\x23\x41\x44\xd9\x43\x47\xbf\xbb\xf1\xef\xbd\xac\x64\xaf\xdd\x96\x80\x40\x37\x71\x38\x6c\xc8\x33\xbb\x3d\x63\x84\x25\xd6\xe7\x1c\x0e\xf0\x96\xdd\x37\x56\x93\x03\xea\xe9\xff\xd1\x4d\xf1\x1e\x62\x90\xe7\x09\x19\x91\xd8\x4a\x72\x86\xf2\x68\x26\x46\x03\x07\xe8\x0c\x47\x25\x7d\x3c\x9a\x35\x62\x6c\xb2\xa2\xe3\x86\x53\xd6\x63\xd2\x14\x85\xfc\x5e\x00\x38\xa0\x1b\xc5\x69\x06\x02\x37\x67\x83\x42\xd3\x0a\x32\x72\x92\x2d\x8b\x81\x73\xe8\x38\x8e\xc7\x1d\x5c\xd2\xcb\x5a\xc7\x4b\x9e\xab\x68\xed\x77\xea\x4f\xf2\x58\xe1\x66\x6e\xbb\x9e\x2d\xe7\xf8\x52\x3a\xa2\xa7\x7c\x94\x59\xd1\xde\xd9\x7c\xb3\x33\xa5\xd8\xb7\x57\xb2\xd7\xd7\xee\xba\x0d\xa6\x00\x60\xeb\xbe\x71\x11\xd4\xde\x7a\x4a\xfb\x03\x70\x30\x27\xb5\x59\xc2\x1b\x79\x92\x34\xca\xfc\xc2\x56\x40\x0e\x33\xd8\xa3\x44\x1b\x4c\xec\x16\x74\xc0\xcb\x49\x88\x79\x8e\x82\x48\xb8\x84\x67\x77\x3b\x4b\x30\xe5\xbf\xfe\xd7\x7f\xba\xe5\x58\x0a\x5e\x09\x52\x93\x82\xb2\x53\x2e\x80\x95\xaa\x9d\xad\xb8\x5a\x14\x4f\x13\xf6\x94\x76\x5a\x48\x65\xf7\xda\x14\xf5\x85\x95\xbf\x20\xf7\x12\xd1\x0d\x9c\xba\x5b\x90\xc3\x9b\xf3\x8c\x60\x3e\xe3\x43\x8c\xb4\xa8\xd6\x5c\x39\x3a\x3c\x10\x46\xcc\xb9\x52\x76\xa6\xe7\x06\xf7\xf4\xc1\x33\x63\x43\x16\x36\x36\x9f\xbb\xb7\xb5\x62\x16\x6b\x51\xee\xa8\x6b\xfd\x91\x77\xda\x6c\xd8\x8e\x93\xad\xac\xf4\x2e\x4f\xf3\x49\xdb\x09\x43\x29\xe7\x64\x1d\xff\xe6\x87\xbe\x73\x44\xfe\x04\xbd\x8a\x73\x0c\x51\x73\x71\xd0\xa7\xe7\xb7\xac\xaf\xe6\xa1\xb6\xb5\x36\x3d\x33\x02\x57\x5c\xda\xc0\xce\xf7\x0b\x67\xf9\xf5\x0d\x58\x84\x75\x55\xb0\x6f\x34\x07\x16\x7b\x64\xac\x5f\x55\xfa\x9a\x6a\x6e\xa1\xad\xeb\x85\x9a\x9c\xf6\xf6\x68\x1c\x84\x8e\x70\x64\x80\xda\x62\xcb\x28\x23\x99\x5e\xf7\x66\xe6\x0f\xf9\x7b\x4f\x40\x1c\xef\x01\x47\x0b\x52\xe0\xba\xaf\x4b\xe3\x99\x01\x5b\x8d\x99\x67\x23\x6c\x27\xc7\xb6\xea\xd4\xc2\xf8\x89\x37\x0f\xff\x76\x6b\x22\xac\x82\x42\x9b\x87\xe7\x3a\x74\xf3\x4b\x81\xfe\xf9\x71\xcf\xdb\x6e\x66\x65\xac\x56\x61\x4b\x5e\x2e\x72\x64\xa0\xb0\x25\x57\x07\xd6\xc8\x9f\x87\x24\x89\x79\x11\x9a\xde\xe1\xc5\x6f\xa0\x83\x69\xc3\xda\xa1\xdc\x30\xab\xf4\x7e\x13\x75\x72\x36\x28\xdb\xf1\xc8\xd9\x85\xfb\x3e\xd2\x54\xef\x05\x50\x80\x99\x16\x38\x13\x30\x60\xa2\x88\xfc\x75\x22\x3c\xe5\x6e\x0f\xb4\xcb\xba\xe9\x41\xed\xed\x91\x93\x60\x86\x8b\x41\xc0\x35\x4b\x31\x2c\xee\x19\x6a\xc1\xfb\x37\x6f\xd9\xdf\x9f\xb2\xc0\x49\xe7\x41\xc6\xce\xf8\x33\x71\x71\xd8\x09\x4c\xa4\xcc\x89\x0a\xd4\xb0\x85\x8a\x16\x0a\x83\xd2\x5c\xa6\xcd\xeb\x9e\xd8\x38\xed\x7d\xce\xb5\x6a\xc8\xd2\xd3\x6b\x80\xac\x05\x32\x98\x57\xc1\x51\x8e\x74\x34\x6b\x37\xcc\xe1\xec\x5e\xc4\x73\x8a\x02\xab\xd1\x34\xce\x25\x6e\xd0\xd3\x7f\x5a\x5a\x69\xb9\x05\xe9\x48\x52\x69\x4d\xe6\x88\x30\x9e\x21\x77\x44\x50\xf0\x91\x8b\x06\xf9\xc9\x53\x39\x24\x8c\x06\xe0\x72\x50\x95\x05\x0e\x6b\xcf\x03\x2d\xcd\x86\xd0\x2d\x33\x32\x52\xba\xaa\x52\x42\x99\xf4\x4a\xcf\x11\x8a\x9b\xd3\x98\xc2\xcb\x86\xb4\xcd\x5a\x56\xab\x13\x2a\x4c\xe2\x5f\xe4\xac\xe1\x1e\xe5\xbd\x07\x07\x7a\x32\x68\x6e\x84\xed\x67\x98\x4c\x48\x8f\x42\x02\xd2\xc7\xd3\x46\x11\xa3\x72\xb2\xb9\x90\xf1\x3e\x4a\xfa\x24\xcd\xcd\x99\xfa\xf6\x89\x52\x81\x9c\xd3\xc0\xa2\x89\xa6\x0a\x89\x50\xbb\xa1\xa9\xd2\x42\x7c\x2f\xcc\x98\xfd\xc9\xd3\x02\xf8\xb4\x39\x48\x0f\x27\x57\x4e\x7a\xba\x8d\xe8\x04\xef\x27\xc3\x3e\x1d\x55\x86\x75\xe4\xaf\x0b\xd2\x49\x9f\x65\x8b\x28\xe4\xca\xd9\xa1\x0f\x02\x41\xc9\x53\xa6\x2d\x08\x38\xb5\x12\x24\xab\x94\xb0\x24\x57\xe9\x43\xfb\x6b\xdb\x9c\xba\xd8\xd3\xaa\xa3\x49\xb2\x9e\x12\x1e\x1f\x93\xab\x52\xb7\xa3\x1e\xaa\xbd\x8b\x18\x1b\x23\x78\x79\x38\x3e\xc3\xc8\x9d\x44\x4e\xad\x87\x9b\x02\x43\x39\x08\x30\x7b\x21\x17\x3e\x27\xdd\xb2\x9c\x8c\xba\x4f\xe2\xd5\x84\xd5\x41\xfb\x92\x3c\xde\x3f\xcf\xe6\xe2\xdd\xdc\x48\x77\x75\x0f\x35\x2e\xcf\x7b\x43\xcc\x4f\x8f\x20\x9f\xd3\xc9\x7e\xc3\x8a\x46\x56\x55\x24\x28\x07\x73\xb6\x41\x35\x50\x50\x1d\x2b\x85\x2d\x20\x30\x0f\x34\x2b\xd2\xe2\xfe\x87\x0e\x66\xbf\xcb\xdd\x15\xfd\x16\xcf\xf6\xe6\xd1\x29\x4b\xc2\x42\xb7\x45\x7c\xd8\x6e\x78\x9b\x7e\x0b\xe0\x4c\x89\xf2\x70\xa5\xb1\x0c\xd6\xd7\xa2\xcd\xaa\x8c\xb9\xa4\xc4\xc4\x1c\x05\xca\xb3\x53\xfd\xc1\x3f\x4d\x13\xcf\x9b\xe9\x9b\xce\x58\xb2\xa8\x3b\x3b\x09\xf2\x78\xd3\x04\xb2\x68\x8c\xf1\x66\xf5\xb0\x57\xce\xd4\x58\x1a\x3c\x07\x29\xa3\xd0\xaf\x97\x2b\xfc\xad\x43\x1c\xb1\x5d\x95\x1f\x39\xe7\x24\x5e\x5d\xdd\x21\x72\x2d\xdb\xc9\xd2\x9d\x76\x62\xfb\xa2\xe2\x38\xe4\xa6\xfe\xdd\xc5\x0b\x25\x37\x0f\xeb\x70\x7b\xe9\xa1\xec\x8c\x9c\xb7\x3c\x50\x4f\xb1\xbd\x2c\x1e\x0e\x6e\xa4\x5b\x48\x7f\xc7\x6a\xce\xbc\xdc\x53\x8a\xd7\x13\x5b\x8b\xb9\xec\x58\x11\x07\x3d\xd9\x78\x4c\xe1\x95\xa6\x65\xfa\xf0\x73\x6e\xc4\x4c\x92\x00\xd9\xa3\x77\x75\xdf\x1c\x98\xde\xda\x82\xf8\x93\xa5\x39\x99\xee\xf0\x70\x3a\xba\x36\xb0\x6e\xa9\xa2\x06\x62\x6c\x9f\x5b\xed\xf7\x9a\x1c\xe6\x16\x6b\x20\xaf\xa3\x2b\x0e\x26\x94\x24\xba\xde\xe0\x61\x79\x80\xf4\x90\xf7\xe4\x59\xd5\x68\x17\xf8\x63\x0d\x59\x9b\x5c\xdc\x93\xa6\xb9\x74\x03\x08\xf8\x19\x1b\x25\x46\x23\x72\x3c\x25\x7a\x1f\x91\x4a\xe6\x54\xac\x6f\xd9\x4f\xee\x61\xfc\x38\xc8\x6e\x66\x3d\x30\xa4\xca\x52\x8a\x0e\x79\xc7\x6e\x47\x3c\xbd\xd3\x69\xfa\xf3\xb4\x4f\x17\x6e\x9f\x94\xaa\x3d\xfa\xd7\xdd\x1c\xde\x5f\x56\x9d\x6a\xbd\x54\x79\xf8\xfe\x0b\x34\x95\xe4\xfe\x43\xa2\x0e\x21\xa3\xa2\xef\x68\x58\xe1\x44\xd8\x22\x55\x45\x95\xe9\x6e\x2d\x62\x0d\xa6\xb6\x0e\x2e\x9a\xe2\x0d\xbd\xce\x82\x37\xf2\x13\xe5\xcd\xe3\x7c\x89\xfe\xb4\x1b\xc9\x09\x25\x98\xc4\x78\xff\x93\xb9\x44\xbf\x53\x9c\x21\x6f\xf9\xb6\x11\x68\xb6\x6f\x93\x3d\xe7\x3c\x6c\xf7\x35\xf1\x19\x51\xb2\xbe\x77\x65\x8b\xac\x4a\x7b\x7e\x70\x53\x47\x6c\xc7\x9b\x28\x12\x5a\xde\x8c\x7b\xb8\xa7\xe2\xde\x47\x96\x86\xfb\xdd\xa4\x22\x7f\xbd\x8e\xc4\x41\x48\x33\x2d\x3a\xcd\x49\x11\x7c\xff\xb2\x20\xaa\x97\x0d\x8c\x7c\x71\xfd\x0c\xaa\xe7\xf7\x86\xef\x09\xca\x09\x26\x51\x88\xce\xb9\xee\x15\x20\x9f\x40\x59\xde\x1d\xe8\x5d\x2a\x94\xe2\x99\xb4\x10\x7a\x25\x9b\x66\xb0\xda\x8c\x78\x63\xd1\x3a\xb6\x12\x5c\x05\xdf\x91\x0c\x50\x64\x74\x13\xda\x19\x76\x16\xce\xde\x11\x9c\x99\xc2\x30\xef\x15\x27\x29\xf1\x15\x87\x41\xb6\xf3\x67\xf8\x91\x56\xaa\xe7\x56\x06\x10\x0a\x4a\xcc\xe9\xed\xb9\x5d\x00\x0f\x2e\xb5\x62\x05\x5a\x69\x95\x19\x0f\xe0\xb3\x4e\xe6\x72\xc3\x5a\xae\x64\x45\xc0\x59\x80\x16\x6a\x63\x7b\x06\xa0\x87\x96\x43\x0a\x0d\xd9\x28\xdb\xae\xe1\x05\xf6\x7b\xd5\xee\x62\xc7\xa2\x11\x58\xf2\x71\x90\xc9\x8d\x29\x46\x39\x9b\x30\xea\xa6\xbc\xba\x1c\x31\x46\x1d\x8b\x07\x47\x47\x46\xe5\x2d\x72\x88\x3c\xa6\xc2\xb8\xab\x18\x4c\x7f\xaa\xa5\x69\xf6\x47\xeb\x4a\x5f\x3d\xb7\xae\xe4\x89\xa6\x8f\x6f\xc9\x09\x53\xe9\x62\xa2\xf7\x19\xe9\x8b\x85\xbe\x86\x7d\x98\x85\x5a\xef\x1b\xcb\x4a\xbd\x57\xc8\x7e\x4f\xab\x3f\x43\xbc\xb8\xcd\x68\x3b\x51\xc8\xea\x40\x9e\x4c\xf8\xb9\xb4\x31\xb9\x41\xbb\x6a\x13\x76\x19\xe0\x01\x8b\x03\xf4\xd8\x98\x6c\x97\x45\x5f\xe9\x14\x95\xde\x80\xd2\x13\x86\x8d\x80\xf0\x7d\x36\x8c\x69\x28\x06\xd2\xbd\x24\x01\x43\x04\xd4\xe3\x8c\x48\xfc\xb9\x1d\x8a\x07\xc8\x70\xbb\x9f\x4d\x83\x60\x84\x38\xc7\x96\x07\x50\xd1\x12\xc5\x40\xe2\x23\x5c\x1d\xb0\xdd\x3c\x48\x9f\xd0\x77\x40\xc3\x70\x2c\x61\x78\x38\xd5\xfb\x77\x0e\x50\xee\x78\x93\xa3\xef\x0a\x48\xf1\x35\xe1\x79\xfd\x66\xca\xff\x4a\x5f\xc5\xe6\x02\x5e\x88\x54\xc5\x2e\x40\x83\xe2\xd7\x89\x15\x1f\x51\x99\x33\xc4\x25\xd0\x2b\xfb\xd2\x7a\xec\xd0\xa6\x5c\xa2\x90\xfd\x26\x55\x79\x9e\x50\x83\xa3\xbc\x51\x20\x69\x06\x2f\xe5\x0c\xc6\x6f\x2a\xa3\x27\x3e\x93\x1c\x4f\xc0\x31\x7c\x82\xc8\x84\x9b\xc6\xbe\xc4\x8c\x17\xe6\xaf\x38\xd7\xdf\x02\x7c\x03\xb7\x11\xc9\x25\x4c\x14\x0b\xde\x78\x01\xb6\xf1\xcf\xe8\xd5\xf6\xba\x73\xef\x65\x4e\x8a\x3d\x49\x04\xe4\x0f\x85\xd2\x23\x4d\x33\x8b\x06\x9b\xbf\x61\xcd\x2d\xb3\x6e\xb2\x5a\xc1\xd1\x77\xb4\x3d\x3f\xb8\x4d\x57\x2d\x67\x59\xd6\x8b\xc4\x8f\x9d\x51\x6d\x92\x0d\xb1\xca\x33\x8d\xeb\x68\x3a\xcb\x73\x2e\xea\xe4\xe5\xf8\x84\x5e\x3d\x18\xf7\x4a\xde\x95\x77\xec\x73\x48\xc5\x44\x8e\x59\x49\x99\x20\x4a\x6b\x05\x1b\xf4\x2b\x73\x8d\x4d\x3a\x66\xdf\x95\x77\xff\xd7\x1c\xed\xf7\x0c\x9c\x74\x72\x64\xba\xa7\x9f\x2d\x94\xcd\x35\x01\xcc\xaa\xf5\x1e\x51\xa4\x58\x59\x11\x03\x7f\x1d\x15\xe5\xbc\x92\xd6\xc2\x89\xf9\xf5\xe9\xca\xe8\x91\x9c\x01\x0f\x27\xc9\x99\x55\x20\x54\xd3\x74\xae\x2c\xb7\x42\x0f\xa8\x72\x36\x23\x41\x8b\xc6\x52\x62\x8c\xb7\x97\xb6\x03\x0f\xb8\x68\xf4\x50\x7a\x60\x93\x2f\x27\xc1\x1f\xbd\x5a\x5a\xbe\xd7\x5c\x30\x0c\x32\x6e\xd8\x02\xa6\xab\xa8\x6e\xc1\x09\x83\x91\x23\x98\xd2\x82\x57\xda\xd1\x08\x10\x02\x82\x78\x82\xeb\x1c\x64\xf6\x66\x04\x0d\x52\x01\x37\x04\x4b\xd4\x82\xbd\x07\x4e\x53\x62\x04\x30\x08\xa5\x7c\x14\xa6\x9f\x95\xda\xfc\x11\x3e\x02\x2b\x8b\x07\x04\x2d\x8b\x8d\x0f\xad\x49\xf6\x6c\x84\x36\x97\xd0\x8f\xce\x3b\xfe\xff\x31\x77\x2d\x3d\x8e\xe3\x46\xf8\x9e\x5f\xc1\xdb\xcc\x02\x1d\x23\xbd\x8d\x01\xf6\x14\x60\x06\x7b\xcc\xe6\x90\xce\x25\xb9\xd1\x52\xd9\x26\x9a\x22\x15\x92\xb2\xc7\xf9\xf5\x41\x3d\x48\x51\x0f\xdb\xea\x9d\x5e\x20\xb7\x45\xef\x58\xa4\x28\xb2\x58\x8f\xaf\xbe\xaf\x31\x89\x00\x4d\x63\x1f\x0c\x33\xed\xaf\x3b\x10\xb3\x77\x18\xe7\x55\xaf\x2c\x8f\x28\xa5\x65\x11\xb9\xc4\x07\x3d\xff\x99\xa7\x36\x53\xf1\x7d\x90\x88\x99\x4f\xba\x1a\x8a\xed\x2a\x7f\xb6\x62\x58\x05\x98\x3a\xa6\x3b\xcb\xcf\x4b\x02\x78\x51\xa9\xa3\x5d\x95\x57\x6e\xcc\x06\x50\xde\x3b\xbf\x8f\x61\xfd\x5a\xc9\x26\xe7\xc0\x9c\x97\xcc\x52\xf3\x22\x49\x76\x8c\xeb\x3f\x19\x5b\x92\xad\x27\xa8\x40\x43\xad\xec\xa6\x4d\x75\x42\x31\x17\xf6\xe3\x31\xe7\xdb\x9b\x86\x92\x71\x57\x75\xd0\x4e\xc8\x3f\x0f\x84\x3b\x20\x0c\x1b\x7c\x27\x3a\x67\xd6\x3d\xfb\x83\xa8\x0b\xff\xce\xf2\xd4\xa5\xf8\xce\xe5\xf5\xbb\x62\x38\x35\xf2\x2b\x98\x48\xf1\xa1\xd4\xa7\x6f\xe0\xce\xdf\xab\x88\xf3\x65\x0a\x3b\xff\x72\x1f\x1d\xfe\xaf\x09\x07\x4d\x1a\x19\x8c\xc8\x47\xc6\xeb\xae\x9a\x44\xf4\x75\xe6\x8a\x29\x7d\x49\xa8\xdc\xbb\x64\x1c\x35\x21\xf7\x9a\xc4\xf9\x56\xfd\xf0\xff\x2f\xd5\x9d\x85\x23\xfb\xd2\xfe\xf2\xe1\x7e\xec\xa3\x72\x5d\xb5\x1b\xde\x00\x7a\x5c\x99\xe3\x13\xab\xcf\x3f\x55\xe8\xb8\x23\xd1\x6a\xe8\xa4\xf6\xfa\xba\x53\xaf\x9d\x68\xe0\x64\x67\x58\x0c\xce\xb4\x88\x27\xc1\x0c\x9b\x83\x1b\x94\x58\x7f\x63\xa2\x35\x4a\x49\x5f\x58\x85\xa6\xc3\x6b\xd7\x68\x1b\xd5\x3e\x08\x99\x74\xe6\xa8\x62\xfe\x41\x7b\x55\x43\x7f\xd1\xa1\xdd\xa9\xaf\x81\x31\xb5\x7b\x6f\x73\x5b\x29\xcf\xd7\x87\xd6\x38\x1d\xae\xaa\x0f\x1e\xa7\x41\xf0\x46\xab\x07\xd7\x9c\x80\x76\x38\x57\x3a\x0b\x5f\xcc\xf8\x79\xc9\x28\x1e\x84\xaf\x91\xc7\xe1\xb3\x5d\x97\x6b\x49\x2d\x71\xa7\x3e\x7f\xf3\x83\x6d\x31\x96\x11\x67\x6d\x7f\x65\xce\x62\xd6\x2e\x36\x70\x04\x05\xee\x68\x5c\x16\x6e\x8c\xa6\x33\x56\x87\x7a\x56\xac\xd0\x3d\xb8\x2c\x85\xbe\xfb\xa9\x28\xff\x92\x69\x9f\xdc\xf4\x2b\x6b\x39\x2d\x30\x8a\x0b\xb6\xa9\x77\xc7\xc4\xa5\xc6\xc0\x5a\xa2\xf0\x3d\xe5\x9c\x07\x1a\x03\x4f\xea\xb7\x8f\xe5\x70\x5e\x31\x75\xf8\x5e\x78\x2d\xe2\x27\xa5\x46\x5c\x03\x59\x80\x5a\x69\xd5\xf9\x80\x0e\xee\xe4\xca\x6e\x74\x4c\x3b\xf5\xed\xca\xca\x1a\x57\x16\x9e\x24\xe7\x09\xaf\xd5\x31\x45\xa0\x6d\x16\xa0\xa2\x86\xb8\xb1\x4f\x9c\xf1\x92\x40\xba\xe8\x64\xb9\x9a\xc6\x87\xb6\xa0\x62\x83\x74\xb5\x3f\x14\xd3\xdc\xeb\x68\x1a\x55\xf4\x88\x8a\xb3\x6e\x28\xd8\x69\x87\xde\x9a\x86\x9a\x44\x8b\x7a\xb6\x88\x2e\x1d\xd4\x2f\x75\x5f\x98\xe8\x31\x93\x76\xb2\xaf\xbb\xdf\x3b\xf6\xdd\xae\xd9\x09\x61\xe2\xf7\xcc\x27\x2d\x1c\xa4\x63\x30\xde\xf8\x98\x58\xcf\x44\x3e\x58\x2d\x22\x2a\x8b\xc5\xa6\x8b\x3d\x8c\x47\x24\x0f\xde\x55\x4a\x4b\x33\xe0\xee\x2c\x11\xfa\x08\x33\x5a\xb5\xcc\xd2\x53\xb9\x39\xbc\xb0\x9c\x15\x8d\x5b\x7c\xb9\xb3\xb6\x83\xc4\xe9\x2c\x95\xae\x45\xd4\xcf\x24\x10\x51\x62\xf9\xbd\x44\x45\x93\xe2\x72\xf1\x79\x4b\xb6\xa7\x03\x17\x6b\x6e\xab\x2d\xda\xa3\xe2\xcd\x4f\xf2\x5d\x5b\xde\x51\xe3\x32\xe5\x60\xe1\x02\x2e\xd5\xe9\x69\x7a\xa5\xfa\xf1\xe4\x17\xb3\xb8\xa0\xb5\x33\xc5\x7a\x4e\xaf\x01\x9a\x32\xf4\x5d\xf3\xaa\x3b\x29\x3a\x41\x6c\x82\xd9\x8f\xe9\x87\xcc\x20\xc0\xea\xb7\xdc\x59\x4e\x1f\x7e\xcb\xac\x09\xb8\x57\x85\x38\x77\xa6\x3c\xeb\x81\x28\xe5\x92\x6b\x5f\xab\xb6\xfe\xd8\xa0\xf5\x78\x44\xbf\x28\x48\x42\xad\xa2\x71\x47\x5b\xb4\xcb\x32\x4c\x22\x07\x2e\xe2\x37\xa3\xb3\x20\xc1\x1e\x37\xc4\x32\xbd\x62\x23\x6d\x18\xac\xb6\x43\x77\x3d\x6e\xe0\x08\x78\x33\xcb\x32\xcf\xe4\xce\x6b\xf2\x47\x65\x4d\x73\xfa\x14\x59\x52\xb8\xe5\xea\x2c\xcb\x18\x6d\x78\xd7\xc1\xb5\xd5\xf4\x03\xa0\x07\x49\x0a\xbc\x09\xef\xa0\x8c\xa3\xd0\x2a\x00\xe9\x1f\xf8\x03\x9f\x7a\xfc\x6f\x22\x50\x10\x77\x85\x70\x7b\x3a\x8a\x50\x9b\xfa\x3c\x1e\x7d\x9a\x38\xfd\xaf\x34\x04\xf7\x13\x06\x55\x6c\xde\x02\x90\xa7\x16\xa5\xee\x4a\xaf\xd2\x34\xbe\xeb\x7c\x8b\x87\x31\x89\x84\x7e\x80\x38\xd8\x24\xfd\xc3\xdf\x75\xd7\x5b\x2a\x4e\x5c\xd0\x38\x67\xad\x69\x5c\x36\x7e\x11\xa7\x7c\xcf\x26\xe6\x53\x5c\x83\x6c\x1d\x3c\xe9\xcf\x07\xc3\xc4\x1d\x24\xc6\x42\x1d\xe4\xac\x95\xfe\x29\xd6\xfe\xd1\x84\x2e\x51\x88\xde\x64\x1d\x98\x00\xa4\xac\x40\x2d\xc1\x19\x26\xa8\xf6\xf1\xc0\x90\xf9\x12\x17\xf8\x04\x8c\xaa\xf7\x2a\x37\xdf\xe5\xf5\xe5\x6b\xa1\x68\x1c\xe3\x1f\xef\x7d\x46\x9c\x20\x93\x44\xec\x41\x65\x46\x3c\xdd\x9c\x0c\x9c\x81\x52\x5c\x9c\xe7\xdd\xc3\xd5\xe7\xaa\x76\xe3\xfb\x62\x3c\xb9\x6d\x57\x96\x35\xee\xd4\x2b\xc6\xab\x22\x7a\x83\x0b\x2c\xee\xd2\xaf\xbf\xa1\x87\xd8\x07\x68\x4c\x04\x8c\xba\xa3\xea\x7d\xa4\x0a\xd6\x44\x26\x9f\x8e\xba\xb2\x3a\x99\x34\xb4\x14\x80\x87\x81\xa9\x05\xf0\xb0\xa2\x35\x63\xe3\xc6\xc5\x7c\x57\x6d\xff\xda\x50\x30\xda\x3f\x9e\x96\x7f\xb5\xe6\x0d\xac\x39\x79\x2f\x59\xeb\xf1\x05\x8f\x18\x3d\x5e\xd0\x05\xc5\x09\x95\xfb\x8e\x97\x46\x2e\x18\xfc\xb2\x4f\x75\x18\x3b\xd2\xc4\xc8\x3f\xa4\x94\xc2\x1e\x54\xaf\x43\xa2\xe4\x02\xad\x63\x5b\x5a\x5a\xe5\x71\xc3\xe1\x40\x3c\x3a\x1d\xba\x5b\x07\x1f\x80\x24\xe6\x1a\xef\x58\xad\x30\xd3\xc9\xf0\xce\x65\x3d\x75\x96\xda\xbf\xf8\xc0\x72\xab\xfc\x82\xb3\x4d\x8d\x7f\x62\xaf\x9e\x02\xa7\xb3\xb1\x16\xcf\x32\xc1\x88\x49\xc5\x9c\x87\xef\x83\xef\x81\x0c\x02\x6e\x4b\x72\x2b\xf1\x00\x9a\x4e\xac\x41\x0f\xc1\xf8\x36\xc7\xec\x3a\x95\x07\x4d\xf2\x86\xda\x1a\x3c\x11\xbc\x12\x74\xc9\x62\xf0\xcc\x5c\x85\xf4\xe8\x12\xcb\x91\x4e\xf6\x2b\xfb\x9b\x78\x15\xe7\x69\x92\x50\xba\xb2\x70\x04\xd7\xa2\x83\x3c\xde\x84\x74\xa2\x42\x32\x07\xdd\x24\x99\x74\xe1\x91\xe5\xfc\x44\xef\x43\x92\x96\x3b\x1a\xa4\xc7\x35\x74\x23\x04\x18\x9f\x82\xe7\x74\x08\x01\x2d\x91\xbf\xb8\xfb\x4d\x2d\x12\x81\x08\x62\x0f\xdd\x30\x91\x28\xab\x98\x71\xfa\xe0\xdb\xa1\x61\x9f\x47\x36\x40\xd5\xdb\x92\x7d\x22\xae\x8a\xd4\x3e\xd1\x05\xf4\x9b\x74\xf9\xec\xd4\x57\xca\x43\x80\x93\xfc\x8f\xd4\xde\x03\x31\x21\x8d\x4d\x76\xa5\x0b\x3f\x37\xdd\x73\xd6\x83\xd6\xd5\x38\x43\xaa\x3a\x14\x58\xe2\x9d\x58\xb5\xe0\x3d\xb7\xcf\x7f\x59\xe8\x37\xf5\x10\xb2\x43\x76\xa8\xdc\x2b\xd9\xe7\x59\x4b\x33\xb3\xac\x64\x79\x4c\x8f\xb1\x01\xd9\x71\x41\x64\x73\x0c\x3c\x2b\x47\xd0\x49\x2f\x51\x65\x91\xbb\x7b\x79\x12\x08\x3b\x7b\x39\x2f\xc5\x15\xcc\x10\x47\x42\xd8\x11\x32\xa1\xd5\x57\xb9\xcb\xc6\x22\x2c\x9a\x38\xfc\xfb\x78\x47\x33\x81\x43\xe6\x48\x42\x8b\xd8\x7a\xfc\x2f\x27\xb4\xc7\xa3\x93\x44\x01\x1c\x05\x05\x67\x93\xae\x4f\x59\x3f\x2b\xa7\x75\x02\x34\x9e\x7c\x66\x5a\xe7\x16\x6a\xd6\xc7\x3c\x17\x34\x9c\xb8\x51\x53\x86\xc5\x69\xf5\xf2\x82\xcb\x48\x77\x5b\x73\x62\x17\x21\x4f\x4d\x82\x26\x31\x9d\xf4\xd5\xc8\xf1\x07\x42\xf1\x90\x0b\x24\x6d\x8b\x72\xee\x79\x57\xd1\x37\x5f\xd1\xd7\x59\x13\xaf\x5c\xc8\x68\x3c\x6f\x23\x83\x24\x0e\x80\x6f\xde\x6e\x93\xad\x7c\x67\xd1\x77\x6b\x5a\xea\x62\x8e\x6c\x0c\x34\x41\xae\x46\x8e\x95\x3d\x1a\xbd\x98\xc2\xd0\xbc\xe1\xf2\xd3\x87\xc3\x6f\xf4\xc1\x19\xaa\xdf\xa3\xec\xbd\x9a\xb7\xda\x83\xee\x18\xb3\xa1\x9b\x37\xc6\x83\xed\xd1\x9d\x07\x07\xe1\x78\x55\x16\xb7\x45\x14\x51\x75\x0e\xd6\x17\x49\x19\x91\xe7\xc1\x08\x5a\x28\x35\x86\x98\x04\x0a\x15\x1a\x7c\x78\x59\x85\x3b\x6d\xc2\x45\x48\x4e\x1a\xe3\x56\x95\xe4\xa6\xd0\x81\x7b\xd2\x71\xcf\xed\xf3\xcf\xd5\xb8\xb5\x78\xdc\xba\x7e\xdb\x32\xcb\x7f\xa7\x74\x4d\x54\x02\xab\x43\xa8\x89\x58\xf9\x1c\x85\xbe\xde\x18\xbc\x36\x40\x55\x8e\x06\x1b\xa1\xfe\xe9\xa2\xdf\x77\x6c\xa0\xa6\xe3\x99\xc1\xf3\x75\x5b\x80\x70\x12\x05\xb1\x60\xd4\xdf\xe9\x29\x52\x27\x5c\x5e\x91\x69\xfe\x23\xb9\x55\x7e\x26\x1a\xe1\x77\x8a\xff\xd0\x22\xdf\xe6\x56\x79\x8e\xcb\xfc\x0c\xfe\x64\x8b\x21\xf1\x81\x8a\x2e\xff\x80\x66\x2d\xc9\xfd\xa3\xc4\x58\x5f\x36\x98\x92\x1f\x4b\xd6\x70\x96\xfa\x61\x0d\xec\x60\xce\xb0\x52\x04\x5b\x40\xe9\xb9\x0d\xb9\x38\x24\x60\x81\xfc\x11\xf6\x9e\x02\x9c\x8d\x1f\x22\x63\xda\xcd\xd1\x51\x01\x27\x6a\xd7\xa4\x41\x87\x2b\x87\x04\x4c\xa7\xb8\x08\xa0\xcb\x93\x72\xbe\x46\x02\xfa\x1c\x09\xdf\x6c\x02\xc9\x7d\x50\xbd\x1f\x9d\x52\x19\xb9\x28\xbc\xf4\x01\x7a\x1d\xa0\x95\x72\x71\x9e\x90\xfa\x8c\xb1\xe8\x1e\xac\xbf\x30\x5f\xe4\xda\x2e\xc5\xf9\xa0\x61\x63\x00\x04\x3f\x49\xf6\x6d\x7e\x4e\x8d\x5f\xc2\x33\xe3\x7c\x4e\xc5\x3c\x8a\x3e\x86\x98\xc6\x09\xd7\x8f\x24\x05\x90\xa5\x2b\x26\x9f\x03\x3d\xa0\x46\xec\x4f\x06\xf7\x6a\x95\x80\x7d\xe1\x16\x5a\xca\x50\x31\x53\x66\x90\xf4\xb2\xc5\x6b\xc6\xbd\xf1\x5f\x25\x1f\x66\xd2\xb5\xbc\xb7\x4e\xf8\xfb\x54\x2e\xf2\x8a\x22\x40\x6a\x81\x9d\x76\x8e\x7b\x92\xb4\xab\x5a\xa9\xd9\xcf\x99\x0c\x3a\x3e\x7e\xc3\xd2\xdc\x39\x7b\x41\xa7\x13\x49\x7a\x77\x26\x2d\xe9\x6c\xb7\x5f\xe4\xb5\x54\xf0\x87\x66\x4b\x7f\xcf\xa5\x2c\x9c\x1b\x37\xc4\x57\x19\x18\x9d\x1d\xe1\x93\xc9\x8a\xc4\xa2\x9c\x3a\xe5\xd4\x29\x9e\x74\x2d\x8a\x58\xdf\x4c\xa1\xfc\x4b\x62\xfe\xd6\x4e\xc1\xf7\x14\x34\xf1\x72\xcf\x91\x79\x5f\xc5\xb3\x65\x07\x70\x71\x69\x8c\x37\xfa\x08\x05\xbc\x8d\x8a\x64\x50\x4d\x0d\x28\x14\xdb\x71\xa3\x03\x78\xd2\x82\x33\x29\x50\x56\x54\x48\x32\xce\x43\x2d\x77\xc2\xa4\x00\x58\x21\x55\x8d\xde\x9e\x45\xdf\x8f\x7c\x89\xbc\x58\x5b\xb6\xe0\xbf\x25\x5b\xfa\xcf\x30\xa4\x65\xa2\x7e\x95\x97\xe2\x03\x05\x4a\x1f\x6d\xbf\x8d\x5c\x14\x33\xb6\x97\x8a\xf6\xe5\x57\x38\x7b\xe2\x91\xd9\x42\x05\x33\x21\x48\xcc\xd9\xb9\xff\x16\x02\xd0\xe3\xc0\xfc\x30\xf2\x5d\x5a\x68\xa0\x67\xda\x20\x62\xad\xf8\xf2\x01\x58\xdc\x0d\xd5\xff\x39\xcd\x54\x3e\x16\xac\xbf\xff\x40\x35\x6b\x8e\xde\xe2\x28\x68\xa2\x20\x70\xd2\xc1\xc4\x4e\xbf\x07\x02\xcc\xf1\x25\xd5\x33\xa8\x8d\xc1\x9a\x3d\x50\x6f\xae\x35\x30\xe5\x22\xcf\x4a\x93\xb8\xd2\x6f\xce\x5f\x4a\xb2\x6b\x5a\xe6\x1c\x3b\x1a\x83\x60\xde\xb8\x03\x63\x73\x8f\xe0\x57\xa7\xf4\xbc\xe7\x9e\xce\xf8\x45\x87\x92\x47\x10\x37\x52\xd4\x9e\x89\xcb\x55\x9f\xbd\xc1\xdb\x3b\x5e\xb8\x37\xed\x3f\x03\x46\xa5\xd2\x42\x50\xd0\x08\x4c\xb5\xcc\x60\x10\x4a\x8d\xc4\xde\x8b\xd2\x3c\x25\x5c\x61\xa7\x5e\x87\xea\x7d\x62\xce\xf8\xc3\x59\x47\x74\x43\x72\x09\x97\xc6\x89\x37\x3a\x25\xaa\xe4\xe9\x9e\x6a\x40\xd4\x20\x2d\x73\x4f\x78\x5c\x57\xce\x37\x1f\x25\x70\xad\x19\xba\xbf\xfe\xe9\x7f\x01\x00\x00\xff\xff\x8a\x6e\x2d\x6a\x62\x66\x07\x00")
+var _dataSpellsCompendium121Xml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\xd9\x96\xe3\xd6\xb1\xe5\xf3\xbd\x5f\x81\xe5\x97\xca\x6c\xb3\x4a\x95\x59\x83\xcb\xba\xbe\xea\x95\xaa\x2a\x59\x5a\xd7\x1a\xda\x55\xb6\x96\x1e\x41\xe2\x90\x84\x13\x04\x68\x0c\x49\x51\x4f\xfd\x2d\xfd\x69\xfd\x25\x1d\x3b\x22\xce\x04\x82\x20\x72\x2a\xd9\xb7\xdd\xab\xbb\xad\x62\x92\x07\x07\x67\x88\x71\xc7\x8e\x3f\xfc\xcf\x9f\x37\x45\x72\x63\xea\x26\xaf\xca\xff\x7c\x72\xf1\xec\xf9\x93\xc4\x94\x8b\x2a\xcb\xcb\xd5\x7f\x3e\xf9\xcb\xc7\xaf\x9e\xbe\x79\xf2\x3f\xbf\xf8\xf7\x3f\x2c\xaa\xcd\xd6\x94\x59\xde\x6d\xdc\x97\x7f\xf3\xea\x37\x49\x53\x75\xf5\xc2\xfc\xe7\x6f\x7e\xf8\xfa\xcb\xdf\x7c\xf1\xef\xff\xfe\xef\xff\xf6\x87\x66\x6b\x8a\xe2\x8b\x7f\xff\xb7\x7f\xfb\x43\x99\x6e\xcc\x17\x57\xf3\xfc\xe9\xbb\xb4\xf8\x25\xdf\x3c\x69\x92\xaf\xab\xba\xce\xb3\xe4\xc7\xbc\x68\x69\xf8\xe4\xec\xfd\xfb\xf3\x3f\x7c\xc6\x5f\xc3\xf7\x0b\x73\x63\x8a\x2f\xde\xfc\xe1\x33\xf9\x0f\x7c\xd4\x2c\xd6\x55\x55\x7c\xf1\xdd\x1f\x3e\xd3\xff\xc2\x87\x6d\x4e\x3f\xb8\x48\xd2\x45\x4b\xb3\xf8\xc3\x67\xfc\x4f\x7c\x5e\xa7\xe5\x8a\xfe\xf0\xea\x79\xb2\x34\xa6\xfd\xc3\x67\xf2\x6f\xfc\x05\x93\xaf\x4a\x53\xb6\xcd\x17\x7f\x9d\x25\x1f\x66\xc9\xb7\xc9\x59\x9a\xcc\xf3\x36\xa9\x96\x49\x43\x7f\x5a\x19\x9a\x48\xf0\x2d\xfc\x28\xeb\xea\x14\x4f\xf8\xe2\x9b\xb2\x69\xd3\x92\xfe\xaf\xa9\xba\xe6\x0f\x9f\xb9\xcf\x79\xe4\x22\x6d\x1a\xd3\x7c\xf1\xa1\xa2\x65\xa8\x4d\x3d\xa3\x97\xfb\x25\xad\x33\x1a\x4d\xff\xc2\x33\x36\x3f\xb7\x5f\xfc\x54\x75\x49\x56\xa7\xbb\xa4\x5d\x9b\x64\x53\xe5\x4d\xdb\xd5\x26\x59\xd6\xd5\x26\xa1\xf7\xad\xf7\xc9\xa2\x36\x29\x7f\x96\x97\x49\x9a\xbc\x78\xfe\x74\x59\x55\x6d\xb2\xe8\xe6\x26\x59\xd0\xac\x68\xf8\x2c\xa9\xf0\xa7\x6d\x95\x97\x6d\xb2\xa7\xf1\xb0\x28\x8d\x49\x76\x79\xbb\xa6\x1f\xf1\x0b\x3f\x4b\xde\xa7\x8b\x75\x34\x58\xbb\x4e\xdb\x24\xa5\x0f\x92\x4d\xd7\xb4\xc9\x26\xbd\x36\x34\xca\xdb\x8a\xde\x2b\x6f\x3b\xbc\x4b\xd2\xa4\x37\xd8\x91\x76\x5d\x57\xbb\x67\xf2\xa7\xba\x5b\xb4\x4d\x92\x96\x59\xd2\x95\x99\x49\x33\x8c\x50\x3e\xa1\x81\x96\x4b\xb3\x68\x4d\x36\xe3\xbf\x6d\x0b\x5a\x1a\xf9\xda\x2e\xa5\x39\x26\xa6\x30\x1b\x9a\x6d\x5a\x34\xf2\x20\x9a\x59\x13\x0d\xcf\xb3\x4d\xb2\xbc\x49\xb3\x1b\x2c\x2b\xa6\x7c\xe5\xe7\xdb\xd2\x8f\x9a\xe4\xe2\x79\xf6\x26\x29\xcd\xa2\xae\xda\x7c\x91\x64\xe9\x86\xbe\x26\x2f\xbf\x4c\xf3\x82\x16\x82\x46\x34\xb3\xa4\xaa\x93\x75\x5a\x2c\x93\x94\x1e\xd6\xd1\x5b\x87\x5f\x6c\xba\xc5\xc2\x34\xcd\xb2\x2b\xe8\xdf\xe6\x19\x1d\x14\xec\x82\xdd\x8e\xe4\x33\xbf\x33\x1f\x79\x8a\x38\xb7\xc9\x22\x2d\x13\x5a\xef\x65\x45\xef\x2c\x4b\x67\x92\xf7\xf6\x8d\x92\xf7\x37\x79\x91\xfc\x50\xa4\x7b\x53\xd3\x69\x7e\x4b\xe7\x25\x2d\xe5\x0c\xda\xa1\xeb\x8a\x0e\x3f\x26\x4f\xc7\xaf\xe2\x7b\x40\x47\x57\x6f\xc4\xe1\xd5\x68\xaa\x7a\x6e\x87\x6f\x86\xaf\xc3\xc5\xc0\x75\xb8\x1a\xba\x0e\xb4\x7e\x7c\x21\x66\xc9\x6e\x9d\xd3\x52\xe0\x78\x60\x2d\xe9\x9f\xa6\xf4\xff\x4a\x17\x39\xed\xdc\xa2\x2a\xe8\xff\x5f\xe6\x35\xad\x61\x91\xaf\xd6\x6d\x49\xdb\xc3\xcb\xd9\xae\xb1\xdb\xb5\xae\xe4\xc1\xed\xfa\x60\x8a\xe5\x91\x9b\xf5\xe1\xf8\x0d\xa2\xd9\x61\x41\x8f\xdc\x9d\x77\x75\x87\x39\xfd\x19\x83\xba\x0b\x34\x4b\xbe\xc2\xbc\x68\x26\x67\xef\x8b\xac\xce\x5b\x7a\xa5\xff\x2a\xf1\xd1\xf9\xc0\xdd\xfa\x48\xdb\x64\x37\x70\x8b\x63\x44\xfb\x59\x6d\x0c\xae\x37\x76\x30\x27\x81\xb6\xc1\x01\x34\xa5\xa9\x57\x7b\x7a\x67\x3a\x18\x06\xef\x9c\xe4\xb4\xf0\x86\x4f\x34\x4e\x0d\x96\x09\x47\xb9\x69\xab\x5a\xfe\x4a\x47\xa1\xc6\xc7\x35\x9d\x46\x3a\x35\x1b\x3a\xde\xb4\x88\x6d\x9b\x2e\xae\x9f\x25\xb8\xd2\x6b\x3a\x88\xb4\xf8\x4d\x0e\x21\xb1\xa0\xf3\x5b\xf1\x23\xdb\x3a\x5f\xd1\xeb\x60\x10\x3d\x94\xed\x7e\x6b\xe8\x2a\xb5\x74\x84\xf0\x05\xfa\x7a\xcd\xf2\xc7\x0f\x4e\x13\x2f\xe9\x2e\x14\x4d\x35\xe3\xaf\xd0\xfe\xd0\x75\xc5\x0e\xf0\xc4\xd6\x34\x1b\xbe\x3b\x69\x34\x0d\x9d\x77\x30\x86\xfc\x9a\xc6\x5f\x99\x56\xef\x13\x1d\x6b\xfa\x6b\x9d\x26\x17\xd9\x6b\x77\x4b\x96\xfd\x99\x62\x8a\x72\xad\x5b\xb7\xa0\x24\xf4\x9b\xb1\xcb\x73\xd5\x26\x5f\xd3\xb6\xd0\x46\xfd\x09\xe7\xb4\xf9\x3c\xf9\xd1\x1e\xb8\x45\x8a\xe9\xfb\xcb\xd5\x35\x78\x48\xaa\xff\x6a\x8a\x8a\xdf\xff\x92\x1e\xc7\x47\x9c\x6f\x33\x0f\x25\x2f\x20\x13\xd6\xc9\xd2\x16\xd2\x09\xa7\x4d\x4f\xe6\x7b\x7e\x09\xec\x8b\x81\xa4\xe3\x71\x64\x80\x74\x5e\xd1\x66\x5c\x34\xed\x27\xbd\xed\xd9\xeb\x29\x97\x9d\xee\x5d\xf2\x81\xa4\x65\xb3\x3e\xb8\xe3\xcf\x07\xee\xf8\xdb\x5b\xa8\xbc\xd7\x27\x35\xde\xc3\x2a\xb7\x91\xbb\x49\xd7\xb8\x5a\x75\x26\x39\xbb\xaa\x69\x71\x4d\xf2\xb1\xce\x17\xd7\x0d\x7d\xf5\xfc\x88\x46\x5c\x77\x35\xed\x5b\x32\xef\xe6\xf3\x82\x8f\x24\x04\x14\x69\x20\x51\x6c\x34\x63\xaf\x19\x42\x2d\xc7\xb2\x4a\xb5\x5f\xbb\xab\xdc\x97\x9a\xe8\x5b\x4e\xf3\xd9\x4f\x5f\xf1\x42\xe1\x31\x7c\x74\x2a\xda\xf0\x1a\xda\x47\xef\x0a\xab\x47\xd6\x1b\x56\xd5\xbe\xa3\x79\xd2\xdd\x68\xf7\xb1\x0a\x83\xa0\x84\x34\xc5\x41\xc4\x84\xf5\x94\x4e\x3b\x76\xcd\xd1\x33\xed\x44\x75\xcd\xd3\x7b\x45\x77\x9d\x2f\x55\x72\x76\x99\xbd\xa6\xb5\xbd\xb8\xa0\x4f\xe4\xa8\x9f\xbd\xa0\x4f\xf8\xaa\x5e\xfc\xce\x7f\xf8\x92\x3e\x7c\x36\x76\x40\xf5\xa3\xcb\xc3\x8f\x5e\x1c\x7e\xf4\x72\xda\xc9\x5e\xa5\x65\xfa\x0b\x9d\x0b\xba\x24\x1f\x16\x74\x54\xd6\x7c\x30\x06\x74\xd9\xe5\xc0\x39\x7f\xff\xd7\x5b\x1c\xf4\x17\x53\x4d\x3b\x98\x4a\x64\x6b\xad\xaa\x92\x26\xd5\x2c\xd2\xe2\xa1\xed\xbb\xdb\xa9\xa7\x2b\x52\xb2\x25\x9f\xee\xba\x4a\x59\xd2\x2e\x0b\x5a\x9a\x44\xdf\x27\x29\x2a\xc8\x45\xda\x4b\x3d\x9f\xbb\x3c\x23\xf1\xb7\xa1\x75\x6d\xe9\x70\xb0\x89\x88\x53\xc1\x76\x61\x46\x4a\x9b\x57\x27\x30\x00\x07\x6d\x3e\x23\x0f\x0d\x4d\xbe\xe1\xd3\x3c\x60\x7e\xbd\x20\xeb\x0b\xd6\xc1\x63\x5b\x5e\xf7\x56\x1e\x2f\xea\x23\xca\x63\xf8\x8a\xbd\x19\x51\x1b\xa4\x87\x3e\xa5\xda\x78\x31\xcd\x46\xcc\xb3\x49\xd7\xe8\xea\x31\x6e\x11\x39\x69\x74\x52\xc8\x3e\xd8\x62\xa9\xc9\xac\x6c\x49\x1a\xd3\xb2\xad\x47\x2e\xd3\x9b\x64\x4d\xb6\xc8\xb1\x6b\xf4\xb6\xa0\xe3\xb7\x98\x25\x3f\xa4\x45\x4a\x1e\xe6\xb0\x4e\xa8\x75\x99\xe7\x55\x01\xcd\xd1\x88\x75\x93\x16\x45\xae\xe2\x9d\x6c\xac\x6e\xb5\x2e\xe9\x88\xf1\xa5\x21\xa9\x5f\x15\x37\xc6\x29\x8d\x6e\x2b\x46\x58\x6d\xcc\x11\xbd\xa0\xf7\x45\xa4\x3e\x6d\x12\x2c\x2b\x71\xaa\x36\xe9\xcf\xf9\x86\x7c\x5c\x8c\xbb\xe8\x6a\xf2\x78\x5a\xff\xd7\xc6\x1d\x27\x9c\xa6\x57\x7c\x96\xf8\xac\xe9\xab\x7e\xfa\x93\x9e\x0e\xbc\x43\x3c\x4b\x3a\xaa\x69\x96\xe5\x98\x1e\x9d\xcd\x57\x53\xcf\xff\xc8\x89\x2c\xd2\x7a\x73\x07\x37\x85\xc4\x4e\x97\x16\x5f\xfc\xf4\x9e\x8c\x11\xfd\xef\xe0\xa8\x92\x75\xde\xb5\xe6\x9e\x47\x75\x8e\xf5\xc2\xce\x91\x8b\x9c\x9b\x05\x0b\xdc\x25\x64\x60\x93\xd3\xf9\xa8\xe9\x08\xd4\xe6\xce\x47\xf7\x3e\xee\x09\x0c\x9d\x86\xe4\x3a\x36\x03\xcb\x97\xa4\xab\x34\x27\xad\x43\xee\xc0\x8e\x34\x8f\x81\x14\x21\x7f\x1b\x51\x15\x77\x8a\x49\xd2\x57\x15\x6f\xf1\x2e\x2f\xb3\x6a\xc7\x22\x17\x03\xc0\x8f\x3f\x34\x72\xe8\xf4\x94\x55\x52\xe0\x34\xe0\x50\xe2\x8b\xc9\x65\x10\x42\x78\x96\xfc\xc5\xbb\x1e\xce\xb0\x9f\x05\x53\xa2\xab\xd9\xf2\x5d\x63\x33\x04\x21\x89\x44\xd7\x95\x1e\xac\x03\x7b\x35\x51\x91\xd0\xa7\x5b\x85\xd3\x54\xf2\x25\xc5\xc0\x3b\x5a\x19\xc3\x81\x82\xf4\xd9\xc1\xf9\xd6\xe7\xce\xf4\xb3\x32\xc9\xc8\x69\x5a\x41\xc5\x05\xb7\x94\xdf\x65\x57\x21\xce\xd0\xb0\xa1\x26\x3e\x0a\x4f\x51\xfc\xad\x94\xdc\x23\x17\xf7\x58\x1b\x58\x70\xfe\x2b\x58\x06\x15\xc3\x58\xad\x2e\xcb\xc9\xaa\x1c\xbd\x96\xf6\xeb\x87\x8b\x20\x9e\xd6\x96\xdd\x40\xf5\xb0\xe8\x94\xd2\x56\x2d\xc5\x4d\xf4\x56\x25\x8e\x6f\xe1\xfc\xa9\x68\x11\x58\x6b\xf0\x18\xe9\x8e\x34\x6b\x29\x63\x07\x43\x34\xe4\xc8\xe1\xef\xa3\x93\x2c\xed\xab\xe8\x34\xb7\x75\x95\x75\x0b\x23\x8b\xde\xb0\x1a\x82\xe5\x4c\x1a\x99\xfe\x8b\x6f\x01\xae\xf9\xc5\x73\x5a\xc3\x45\x45\xdb\x6c\x27\xaa\x8e\xc2\xb4\x6b\x8e\xc3\x2d\xee\xfe\x04\xfd\xf3\xf1\x16\xfa\x67\x24\x86\x30\xee\xab\xbc\xad\x4a\x04\xc2\xe4\x5f\x33\x95\xf3\x17\x7c\x6b\xef\x17\x96\xa3\x8f\xba\x0d\x5f\xb9\x7c\xb9\x34\x2c\xf4\x69\x01\x37\x23\x67\x78\xe1\xfd\x13\xdd\xf6\x25\xa9\xf2\x6a\x87\x9d\xae\xb6\x98\x47\xa3\x4e\x2c\xc7\x16\x1a\x55\xa0\x24\x25\x0a\x8c\xd4\xd7\x1c\x76\x10\x1e\x1d\x8f\xc5\x79\xf2\x57\x15\xbf\x69\xfc\xc5\x31\xd8\x6f\x3c\x99\x1f\x04\x13\x0c\xb7\x58\x8c\x42\x5a\x11\xc8\x16\xfe\xf1\x9c\xae\xf1\x32\x97\x87\x87\xef\x76\xca\x28\xfb\x7b\x97\x22\xea\x76\x95\xa5\xdb\x96\xa7\xf7\xb9\xdc\x3c\xfc\x5b\x2e\xc2\xbc\xca\xf6\x78\x14\x9e\xab\xdf\x36\xe5\x4d\x5e\x57\x25\xae\xd3\x8c\xa6\x5d\x57\x1d\xc7\x78\x57\x39\x39\x3c\x12\x53\x58\xd5\xb2\x3e\x3b\x33\x9f\xe3\x7f\xe7\xa6\xdd\x19\xa3\x57\x8b\xe4\x34\x09\x98\x46\xee\x38\x1b\x58\x90\x09\x6b\xc3\xc1\xc7\x5a\x42\x8b\x3c\x48\xca\x56\x70\xb3\xcb\x37\x1c\xd1\xa1\x05\xa2\xcb\x66\x68\x12\x05\x26\xc4\x63\xed\xd2\xe2\xda\xfd\x6d\xec\x4d\xdf\xae\x59\x80\x5e\x6d\xb7\x26\xad\x11\xbd\x91\x17\xa5\x13\x56\x36\x38\x00\x6a\x79\xb8\x3f\xcb\xec\x32\xb3\x80\x7d\xbe\x83\xa8\xc2\x96\x14\x55\x75\x4d\xb6\xf6\x35\x59\xc5\xa4\x79\x8b\x0e\xa1\x73\xf9\xe5\xda\x40\x23\xcc\xe8\x8d\xe5\x7f\x97\xe4\x2b\xd2\x3c\x97\x2a\xed\x66\xfe\xee\xf2\xd7\x6f\xaa\x7c\x41\x83\xac\xd3\x9c\x04\xae\x29\x57\xed\x9a\x23\x75\x95\x3d\xf0\x78\xff\x2c\x6f\xb0\xae\x5d\xde\xac\xf1\x98\xc5\x9a\x66\xb6\x80\x51\x4f\x1f\x2f\x68\x44\x12\x2d\x69\xb9\xf7\xcb\xc8\x96\x3f\x46\x6f\x0c\xac\x75\x7e\x15\x3e\x31\x24\xf8\x36\x73\x5a\x54\x9c\x8d\x92\x1d\x61\x52\x27\x78\x7c\xbb\x86\x75\x45\xda\x44\xce\x36\xcf\xac\xc1\x39\xe0\x27\xe0\x89\x6c\x41\x39\x59\x9c\xb1\xb4\x0e\x47\x76\x5a\xa2\x77\xee\x9a\xfc\x17\x23\xba\x89\x06\x95\xd7\x91\xd3\x94\x36\x74\x80\x9a\x75\xba\xe5\xb0\xd8\x5e\xe5\x1a\x49\x9d\x99\x8a\xca\x27\x34\xd8\x3c\xdf\x9a\x2c\xf5\xfa\x83\x1e\xda\x35\x26\x34\x9c\x68\xff\xe7\x24\xf0\xe8\x26\xd3\x71\xc8\xea\x4e\xbf\x8f\xcb\x96\x97\x12\x9e\x23\x5f\x07\x6a\x78\x2f\x51\xb5\xd1\x6b\xe8\xef\x1b\x1e\x23\x27\xc1\xdd\x31\x59\x85\xfe\xf9\x10\x67\x80\xe6\xb3\x4b\xf7\xa2\xe3\xc7\x4e\xdf\x77\x58\x24\x3a\x0e\x3f\x9a\x94\xc4\x5d\x23\x67\x0f\x97\x84\x8c\xec\x74\xd7\xe0\xb8\x94\x2b\x1c\x12\xd2\x0f\x38\x2c\xeb\xaa\x86\x54\x81\x62\x0b\x16\xb5\xd4\x51\x76\x3c\x8a\xdb\x31\x12\x50\xb9\x1e\xd8\x9a\xee\x10\x69\x0d\xb8\x6e\x64\xcb\xc3\xbd\xcb\x0c\xfd\x00\x01\x87\x39\x9d\xd6\x95\xa9\x24\xfa\x4b\x36\x53\xbd\xb0\x71\xe0\x06\x91\x2a\x1f\xbd\x9c\xf1\xce\x6e\xe9\x5a\x6f\xeb\x1c\x2a\x5b\xc3\x9c\xbd\xa7\xab\x4b\xda\x18\xb7\xbb\xac\xe4\xe8\x67\xcb\x7c\x91\x63\xba\xac\x56\xf1\x79\x6f\x4e\xcf\xc8\xa0\x22\x0b\xb5\xd8\xcf\x86\xc6\x85\x5a\x4f\x57\x74\x48\xec\xa8\x1c\x75\x4d\x93\xdf\x5e\x90\x28\x2a\xbb\xc6\x4e\x47\x23\xa2\x7c\x51\xc4\x01\x84\x87\x25\x4a\x97\x6f\x82\xd8\xd6\x79\x3b\x14\x24\xf9\xed\xc5\x14\x87\xac\xcc\x37\x34\xaf\xaf\xea\x1c\xf6\xd3\x3a\xdf\x4e\x32\x85\xdf\x7f\xf7\x18\xfe\xd9\xa6\xc2\xad\x66\x2b\xb7\xaa\xb2\x11\xcb\xf6\xf2\xe5\xa8\x69\xfb\x25\x1b\xb4\xe2\x9b\x25\x67\x7c\x2c\xcd\xf9\x2c\x89\xe2\xf2\x83\x41\x77\x77\xf3\x0a\xa3\x56\x13\x99\x1b\x37\x39\x6e\x42\x4a\x57\x51\x74\xa6\x4a\xc9\x8d\xa1\x9b\x44\x3e\x0a\x59\xa9\x6b\x36\xe6\x9c\x9d\xdb\xfb\x22\x6e\x5c\x63\xfa\x49\xad\x6f\x6c\x74\x0e\x71\x6f\xda\xde\x35\xa4\x0d\x7d\x9d\xfe\xb2\x54\x65\x47\xa3\x90\x1f\xf4\x0d\x99\xa3\xe4\x2a\xae\x0c\xdf\xc7\x26\x79\xd9\x8f\x0c\xc8\x8c\x11\xcc\xa0\x43\xf7\x3d\x04\xdf\x2e\x6f\xcc\xcc\x0f\x32\x10\x06\xfc\x31\x6f\xb2\x6a\x73\x10\x03\x44\x66\x6e\x2d\xc7\x98\x5c\x2d\x4c\xde\x4a\x14\x7e\x08\xcd\xc6\xb9\x86\x98\x26\xbe\x40\x7f\x0f\x05\xeb\xc2\x86\x07\x1a\x5e\x95\x26\x88\xdc\xcf\x1e\x37\x08\xef\xc3\xee\xa1\x9f\xe9\x97\xca\x6e\x45\x6a\x93\x23\x26\x74\x24\x65\xa1\x26\xc6\xe0\x4f\xde\xa6\x6f\x39\x1d\xc3\xa7\x6c\x4a\xc8\xf0\xbb\x5b\x38\x96\xff\x58\x77\xec\xd4\x95\xfa\x72\xcf\xf7\xa4\x11\x45\x64\xf7\x4c\x36\x03\x5a\x08\xfb\x21\x4b\x46\x02\x2f\x33\x45\x2e\x5e\xda\x86\xd6\x8f\xf3\xa8\xee\x4e\x7d\x14\x7f\x18\x9b\x74\xec\x4e\xcd\x70\xc4\xd7\xa2\xb1\x9b\xbf\x77\x79\x5d\x9b\x02\xee\x26\xe9\x04\x12\x9b\xe9\x5e\x35\xcd\x3c\xaf\x33\xd1\xf6\x34\x97\x45\xbe\x24\xc5\x46\x16\xcf\x22\xed\xe7\x1a\xf9\xd2\xb0\x58\xbe\xc9\x9b\xdc\xe5\x87\x11\x8b\x5d\xe4\x5b\x91\xfd\xeb\x8a\xa4\x70\xcb\x8e\x63\x9a\xac\x90\x8c\xa3\x37\x21\x37\x70\x51\xe7\x5b\x19\xcf\x4f\x69\x43\x53\xa6\x09\xec\x2a\xfc\x47\x56\xe3\x84\xb8\x60\x5b\x57\xe6\x6c\x9e\xd9\x0c\x56\xb5\x2b\x93\x55\x47\x2b\x2c\x53\xae\x4d\xf6\x14\x46\x14\x82\xc0\xe4\x8d\x2d\xa1\x45\x6a\x39\xf5\x1c\x21\xa1\xcf\x49\xd8\x04\x26\x0c\xbd\x59\x7a\xed\xd7\x11\xe3\x6a\x10\x69\x47\xf3\xde\x3f\x5d\xd2\x3a\xd3\x4c\x6a\xba\x84\xc9\x47\x9f\x5a\x53\xc1\x55\xa7\xb8\x72\xe3\xc6\x04\xe6\x48\xf3\x6b\xec\x27\xb4\x90\x39\x4d\xc3\x2f\xe4\x82\x6e\x8d\xcc\x71\x4e\x96\x73\xf2\xea\x39\xfb\x94\xe4\x3b\xd2\x06\xdb\xa3\xc5\x8f\x48\x93\x65\xb1\xc7\x17\x37\xf6\xd2\xe0\xa5\x2f\x5f\xe9\xf7\xf1\x15\xb1\xe6\xe4\xa0\x8c\x8a\x0d\x16\x12\x0c\x47\x70\x63\xa5\x75\x4d\x2f\x0b\x43\xb2\xb5\x07\x4c\x03\x70\x76\x71\x54\xd5\xfa\x50\x80\x15\xdc\xb2\x91\x73\xec\x7c\x6d\xb6\x45\x8e\x57\x63\x69\x69\x86\xec\x5c\x59\x49\xff\x60\xde\x03\x3a\xf8\x65\x21\x9e\x85\x7f\x00\x1f\x19\x3b\x52\x70\x5a\xf8\xa1\xab\x14\x91\x3f\xd5\x01\x7e\xb4\xac\x32\x0d\x2c\x44\x49\xa4\xc0\x07\xa2\x1f\xd2\x74\x64\x67\xe6\x86\x96\xc9\x1c\xc4\x42\xec\x10\x1c\x38\x6e\x68\x73\x9a\xd6\x67\x43\x55\x35\x70\x78\x1c\xe3\xc1\xc4\x9b\xc3\xd8\xa0\xc9\xee\x68\xbd\xcd\x90\xb4\xbd\x9d\xcc\x56\x15\x71\xdf\xc8\xf7\xe0\xf9\x8b\x02\xe1\x2f\xdf\x04\x07\xea\x7e\xd1\x40\x91\x46\x1f\x60\xbb\x37\x93\xb0\x3c\x1f\x1f\xd4\x12\xba\x65\xb0\xe0\x84\x90\x66\xf1\x7c\x2c\x28\x2d\x06\x28\xf2\xeb\x8d\xdc\x30\x04\x5a\xd9\xe5\x80\x77\xee\x65\x2f\x09\xde\xb2\xb3\xae\xd5\x8e\xdc\x5f\xf6\xd3\xe2\x08\xd7\x71\x3b\x27\xf6\x3f\x8d\x0f\x53\xcb\xc3\x24\xd2\x20\xa2\x2f\x95\xa8\x1c\x5b\xeb\xb4\x09\x64\xbe\xe9\x21\xd5\xd0\x15\x99\x26\x05\xdc\x48\x48\x73\xbe\x89\xf4\x1b\xb6\x86\x8a\x6a\x87\xb4\xe7\xf7\xf4\xfc\x6e\xde\x90\xef\x0c\xe1\xcc\x2f\x76\xd4\xe7\x11\x0b\xdb\xcd\xcb\x62\x80\x82\xf7\xe2\xe9\x95\x66\xc7\xd3\x6b\xc6\x33\x29\xc6\x0f\x25\x47\x95\x03\x1c\x87\x12\xd4\x1d\x4f\x6b\x11\x55\x75\x80\xa0\xd0\x75\xc9\xc8\x27\xe1\xe9\x3d\x0f\x63\xe0\xf4\xcd\x2c\x37\x41\x3c\x61\xe1\xc2\xaa\x51\x8c\xa7\xff\x0c\x9f\x0d\x26\xeb\x6d\x85\x24\x5d\xe0\xfb\xc2\x97\x81\x68\x23\x3f\x99\x2d\x3e\xc5\x71\xd8\x3f\xeb\x85\x63\x07\xa8\x94\xbd\x70\xee\x74\x30\xe1\xda\xb4\x88\xfe\xb2\x14\x49\xc9\x52\xe5\x98\x09\xcb\x99\xd0\x76\x9d\xa9\xd1\x29\x12\xe8\x2d\x59\x87\x39\xed\x72\xd2\x2c\x48\x72\xc5\x6a\x48\xa2\x57\xa2\x5a\xc2\x15\x58\xf2\x13\xec\x96\xc8\x38\x9c\x61\xce\x31\x87\x1b\x8e\x74\xd2\xb2\xf1\x97\xb0\x13\x85\x7e\x8f\xff\x2c\xe7\xdc\x7a\x79\xee\x3c\xfb\x4c\x49\x0e\x6d\x9f\x59\x51\x9a\xb7\x7e\x4b\x4d\xc6\x02\x39\x78\x0a\x2b\x74\x9a\x76\x57\xb0\xe8\xc2\x8e\x71\x4c\xb4\xb7\x69\x33\xbe\x3c\xe6\x67\x24\x0f\xad\xff\xb6\x80\x42\x42\xa0\x19\x96\xce\xe1\x6c\x69\xbb\x1a\x4d\x9f\x36\x0a\x1a\x09\x7f\xed\xd5\x00\xe2\xa5\x91\xde\x7a\x12\x8d\x73\x38\x95\x1c\x31\x75\xfc\xf6\xba\xac\x16\xd7\x06\x28\x38\x72\x6e\x9a\x45\x5e\x75\xba\xfc\x3e\xdd\x4a\x53\xc8\x37\x79\xeb\x0d\x14\x7b\x69\x68\x10\x9c\x3d\x52\xe2\xfc\x10\x3d\x32\xa5\x8b\x8e\x1c\x6e\x90\xfc\x02\xe1\x6f\xb6\x49\x80\x72\xc0\x95\x16\xe0\xc0\xc9\x5b\xe5\x0e\x2e\xbc\xa3\x8d\x29\xb2\xc6\x8b\x0d\xfb\x9c\xe8\xec\xc8\xb3\x30\xdd\x1b\xf2\xea\xc9\x9e\xcb\x0d\x90\x61\xd6\x88\x80\x67\x64\xc3\x88\x92\x87\xc6\x0e\xe9\xc4\x49\x70\xe4\x5b\x9c\xdd\xc9\x8a\xa2\x35\xc9\x3b\x93\x1e\x66\x34\x5f\x4c\xc5\x7c\x1e\x49\x13\x5d\x4c\xb5\xe6\x71\xf2\x30\xfd\x79\x41\xc6\xfc\x2c\xca\x13\x91\xf1\xb4\x9e\xb9\xec\x51\x09\x74\xc8\x12\xc1\x05\x88\xa3\xa6\xbd\x27\x7c\xc0\xe6\x3d\xad\x8f\xfd\x0e\xb1\xcd\x73\x97\x07\x4d\xce\xbe\xa7\x7f\x23\xe2\x79\x6d\xea\xf3\x91\x60\x75\x98\x84\xc6\xe9\x13\x64\x97\xe2\x33\x1b\x53\x03\x50\x19\x78\x01\x5b\x4d\x4b\xe0\x35\x1a\xb1\x8d\x49\x7c\x6c\x1b\x8d\xcc\x7d\x6b\x18\xcf\x4b\x9f\x7f\x80\xfe\x48\xd6\x1d\xd9\xda\x55\x9e\x1d\xaa\x24\x9b\x92\xcd\x37\xf3\xce\x84\x4e\xaa\xd5\x35\xcb\xaa\x2b\x68\x77\x36\xf9\xa2\xe6\xf3\x51\xe4\x4b\x3a\x4d\x75\x9a\x6b\xbc\x45\x83\xd4\x3a\x53\x7b\x73\x7a\xd6\x34\x42\x77\xec\x8d\x5c\x9b\xc2\xb4\x08\xfb\x2c\x7d\x44\x29\x7c\x89\x5f\xaa\xcd\x3c\x37\xf1\x9f\xdd\xab\x9d\x61\x76\xef\xbe\x25\xe9\xd4\xf4\x2f\x7c\x4f\x9e\x9f\x8f\xdd\x27\xd2\x8e\x82\x11\x52\xa3\x75\x40\x3f\xa6\x1a\x7c\xf2\x61\x41\x49\x26\x91\x05\x4b\xaf\xb2\xe1\xb3\x54\x06\xb8\x5e\x89\x42\x65\x46\x93\xd8\x7e\x2f\xf3\x65\x6c\x52\xe7\xfd\x8c\x8d\xce\x22\x39\xb3\xaf\x5c\x91\x65\x53\xd1\x8a\x93\x44\xcd\xb7\x45\x90\x41\xf3\x33\x0c\xa7\x50\x71\xfe\x5c\x15\x14\x5d\xe2\xd6\x45\x58\x39\x10\x4a\xd2\x8e\x74\x88\x33\xd6\xf1\xb1\xfd\x35\xbd\x94\x2c\x43\x69\xce\x0f\xc3\xdf\xf6\xcd\xd7\x11\x4c\x0b\xee\x0e\x03\x4e\x44\xe1\x88\x7a\xe0\x8f\x37\x30\x2d\xe9\x86\x58\xe4\x65\x00\x59\x14\x98\x25\xcf\x1c\x93\x31\x81\x87\xa8\x73\xf1\xde\x21\x92\x1c\xec\xee\xd1\x11\x4f\x6b\xda\xca\x8e\x2c\x21\xd8\x3b\xa2\xa6\x10\x56\x01\xd0\xbc\xaa\x5d\xd8\x45\x86\x2c\x2b\x3b\x96\x5a\xfb\x3e\x52\x0d\xb7\x23\x33\x4b\x38\x02\x98\x19\x07\xcb\x35\x49\x4b\xc7\xab\xcd\xc3\x35\x86\xe9\x44\x52\x63\x45\xce\x11\xfc\x71\x7a\x60\x66\x8d\x6f\x37\x20\x76\x88\x84\x95\xe1\xc9\x4a\x6e\x08\xab\x20\xd6\x0b\x5e\xbd\x4d\x9b\x6b\x6c\x34\xc4\x0a\x9d\x76\x73\x4a\xb6\x87\x87\x43\x20\xba\x1a\x40\x92\x93\x00\x33\xc6\x5a\xb7\x24\xc6\x96\xc8\x97\x88\x73\x4e\x4f\x6d\x5a\x98\x48\xd5\xdc\xb0\xe3\xc8\x67\x52\xb7\x17\xb1\xf5\x1b\xfb\x2a\x39\xc9\x8e\x8f\x70\xd1\x49\x67\xd8\x1c\x92\x1d\xbf\xea\x1d\x51\x76\x45\x35\x6f\xe0\x9f\xeb\x02\x01\x7d\x1f\xa6\x7f\x48\x78\x6d\x43\x3f\xcc\x82\x2d\x2e\x5f\x3e\xc5\x58\x50\x99\x79\x95\x49\xe0\x4b\xb2\xa8\x5d\x63\x62\x97\x06\xfe\x4c\x63\xf3\xb5\x7e\x25\xd8\x52\x10\x73\x7f\xc9\x9f\x3b\x13\xd5\x07\x8e\x45\x19\x65\xfd\x8b\x08\x89\xa5\x09\x65\x1b\x69\x69\xc5\xf7\x82\xf2\x7c\x6c\x2c\xd3\x4b\x87\xe3\xeb\x85\xe1\x74\xba\xf8\xd8\xbe\x73\xfc\xb6\x40\x40\x06\xc1\xb9\x9e\x94\xed\xbb\x79\xe2\xe0\xbd\x40\x58\xe7\xbd\x4a\xb8\x70\x6f\x1a\xdd\x41\xe4\x51\x44\xe5\x07\x26\xb3\x55\x1f\x75\xac\x5b\x6e\x63\x01\x7c\x3f\xff\x1b\x32\xa2\x07\x46\xc0\xab\x7b\x3a\x8b\x17\x97\x0f\xed\x2d\x7a\x7b\x63\x24\xa4\x37\x21\xc5\xac\x6f\x2c\x4b\x4a\xe3\x42\x39\x26\x69\xeb\x22\xc0\xb8\x89\x7d\xf4\x12\x9d\x9c\xb2\x2a\xd9\xe7\x04\x98\x41\x47\x18\x06\xb7\xd2\x35\xa4\xab\xc4\xa9\xd5\xaa\x2e\xc5\x66\x84\xd5\x4c\x83\xaa\x8a\x17\x15\x8b\x19\x74\x25\xeb\x62\x9c\x18\x1d\x74\x96\xfc\x89\xdd\xc8\x83\xef\xf0\xed\x71\x5f\xfa\xba\x1b\xfa\x0e\x27\x36\xed\x97\x9c\xb3\x05\x93\x52\x77\x9b\xb5\x0f\xff\x39\x02\xa7\x60\xb4\x08\x85\x65\x7f\x20\x90\x2e\x6f\x0b\x38\x89\x31\x20\xf3\xba\x01\x44\x8b\x77\x11\xc5\xe6\xcf\xfa\x96\xfd\xe8\x15\x6e\x7a\x3a\xdd\x6b\xd3\x07\xd7\xec\xaf\x9e\xff\x4b\xb5\xff\x4b\xb5\x5b\xa9\x98\xa9\x58\x4c\x3e\x38\xeb\x74\xcc\x1e\x40\x74\xff\x69\xf2\xf5\x0f\x9f\x27\x97\xcf\x67\xc9\xd5\xdb\xcf\x93\x8b\x37\xf4\xbf\x9c\xfd\xfc\x3c\xf9\xed\x1b\xcc\x8c\x8e\xfc\x2c\xb9\xc8\x5e\x26\xbf\x4d\x5e\xba\x2c\xee\x87\xb6\xfe\x3c\x79\x39\x03\x12\x18\xbf\x19\x79\x86\xb8\x04\xfa\x90\x57\xfa\x90\xd7\xc1\x43\x5e\x07\x0f\x79\x43\x0f\xb9\x8c\x1f\xf2\xda\x3e\xe4\xe5\xc8\x43\x54\x3c\xc9\x53\x5e\xda\x57\x79\x11\x3c\xe5\x95\x7b\xca\x65\xf6\x9a\x9e\x72\x11\x3f\xe5\xe2\xb9\x7d\xcc\xe5\xc8\x63\x44\xc0\xc9\x53\x5e\xd9\xa7\x3c\x1f\x7c\x97\xcb\x8c\x9c\xc9\x83\x97\xb9\x70\x4b\xf6\x7c\xe4\x31\x2c\x22\xe5\x29\x6f\x86\x9e\xf2\x26\x7c\xca\xe5\xe1\xbe\x60\x0f\xf9\x29\xaf\x4f\x80\xc1\xec\x99\x51\xc1\x9a\xb3\xa0\xb4\x35\x7b\x22\x83\xae\xde\xce\xe2\xc8\x0a\x4f\xa2\xe1\x27\x29\xb4\x04\x77\xd8\x23\xc2\x33\x3a\xa8\xf5\x06\x68\xc9\xf9\x9e\x4f\x31\xd0\x1a\xc8\xbb\x36\x71\xa5\x60\x8e\xe2\x3c\x0d\x55\xf4\xb2\xad\xf8\x50\x33\xa5\x50\x4b\x2f\x66\xee\x6b\x2e\x7c\x85\x5f\xcb\xa0\x02\xdc\xa1\x7f\x6b\x90\x77\x66\x65\xa5\xd5\x75\x05\x82\xeb\x85\x59\x35\x89\x4f\x69\x6c\x51\x83\x4a\x0b\xe6\x22\x2b\x30\x0a\x97\x1c\xd2\xa4\xcb\x56\x89\xc8\x46\xe4\x86\x26\x0c\x13\x68\xcd\x91\x27\x4d\x98\xc8\x13\x11\x3a\x57\xc9\xcb\x20\x5f\x1a\x64\x0d\x23\xca\x25\x11\xfc\xa2\x36\x86\xac\x52\x43\xd2\x83\x17\x6f\x2d\xba\x04\xf0\xf6\x7a\x99\x2e\x4c\x00\x8b\x94\x9f\x84\xd9\x2b\x12\x58\xb0\x6f\xab\xa2\xb5\xbf\xda\xa5\xb0\x2f\xf3\xf0\xcd\x9f\x73\x5a\x1b\x73\x9c\x17\x39\x49\x27\xd6\xa5\xea\x50\xd7\x29\xdd\x8c\xa6\x3f\xdb\x5c\xbf\x4a\x0a\x72\x5f\x71\x62\x82\x24\x75\x96\x5b\x6c\x8b\xcb\xe7\xf4\x4f\xc8\x60\x8c\x74\x36\x10\x08\xac\xea\x7c\x05\x1c\x86\xfd\xa1\x0f\x4c\x41\xd7\xd4\x06\xbe\x41\x50\xd3\x36\x14\x9d\x1b\x1a\x62\x4c\x02\xda\xac\x87\xd3\x69\xf6\x87\x58\x36\x3e\xb5\x33\xbb\xdb\x5a\xc6\x00\xeb\xb9\x30\x71\x11\x9c\x95\xe8\xe9\x41\xbd\x90\xaf\xf9\x81\x7f\xf3\x8d\xcd\xe1\xa4\x80\xb8\x6c\xec\xcf\x65\xd5\x4b\xfb\x4f\xb5\x01\xb0\xd0\x1e\x21\xe3\x82\x8a\xc3\x37\xe5\xa3\x84\x1d\x36\xbc\x8b\x75\x57\x58\x03\xcd\xa6\xfb\x16\xee\x64\x95\xcb\x22\xc7\x09\x77\x18\x1b\x36\xa7\x05\x7c\x63\x1f\x32\x4f\x1b\x01\x1f\xe4\x12\x18\xdf\x3c\x62\x2a\xe9\xf5\x31\xc7\x63\xe1\xbc\x20\xd3\xf7\x31\xec\x2d\x3d\x9e\x41\x7a\xd5\xae\xa7\xb9\x05\xa4\x36\x61\x10\x7f\x58\xd3\xe7\x93\xbc\x82\xab\x5b\x82\x4d\x93\xb3\x0b\x45\x42\xcb\xad\x3a\xff\x44\xe8\xd3\x7e\xb4\xef\x58\x7a\xe9\x2a\xa1\x63\xb0\xd9\x48\xfe\x77\xce\x57\xaa\x46\xf5\xa3\xd8\xb3\x5d\xdb\xab\x00\x8a\x5f\x86\x4f\x29\x8c\xaf\xc6\x61\xab\x66\xc1\x45\x0d\x2b\xc7\x6d\x75\x2b\x49\xb2\x15\x1f\x3b\x1a\xda\x7b\x7d\x95\xf7\x7c\x6d\xc1\x37\xe4\xa3\x2b\x05\x3f\x15\xa0\xb0\x13\x1f\xce\xe5\xc8\xfd\xb0\xdf\xd9\x42\xec\x48\xdd\xf8\x61\x2e\x49\x5e\x77\x4b\x8b\xa4\x77\x83\x93\xb8\x16\x78\xd3\xad\xd6\xcf\x92\xab\xa1\x9f\xb1\x65\xec\x83\xe5\xf8\xa5\x08\x0c\x51\x7d\xb2\x3c\xbc\xf1\x99\x1b\x55\x01\x66\x8d\x1d\x5a\x52\xbd\x32\xcb\x09\x42\x8b\x8d\xde\xa6\xd2\x9b\xee\xc0\x32\xa1\xb9\xbf\x84\x7f\xc8\x3a\x00\x6f\x34\xf4\x9c\xe3\x40\x9f\xf1\x5b\x23\x09\xc9\xaf\x10\xb1\x9f\x94\x79\xbd\xf3\xb5\x79\xaa\x27\xad\xd9\xc2\xe2\x1f\xbb\x3d\x12\x65\x77\xe1\xf3\x6d\xb5\xcb\xf8\xf0\x01\x19\x8c\x35\xe7\xff\x5d\xe6\x48\x89\x36\xe7\x0f\x7f\xd5\x46\x7c\xf0\xab\xa4\xf7\x32\x79\x09\x9c\x0a\x30\xf5\xf2\x5a\x02\x84\xb5\xab\x4a\x3a\x9e\x4b\xd6\x1b\xc1\x98\x71\x00\x8a\x2b\x2f\xe8\x7f\xb3\xfc\x46\xf6\x94\xcf\x29\x83\x06\xd4\x4d\x97\xf2\x72\x39\x0d\x4d\xb7\x5c\x76\x8d\x06\xcb\xd9\x99\x03\x94\x02\x4a\x5a\xd4\x92\xec\x39\x9e\x3c\xb3\x07\x56\x3c\x67\x80\xca\x38\x73\xd8\x74\x9b\x0d\xad\x4e\x18\xc8\x01\x6b\x02\xa3\x4d\x45\x27\xe3\x12\x69\x5e\x3a\x6f\xcd\xa6\xb1\xf0\xd7\x0d\xcd\x3c\x2d\x8f\xd6\x7c\xf8\x47\x1f\x08\x8e\x9e\xb8\x18\xbb\x02\x1f\x64\xd2\x98\x87\x88\x0e\xb7\x0a\x02\x79\x9f\x71\x2e\x6e\x0b\xfd\x83\xb8\x86\xe4\x2b\x32\x5b\x93\x44\xae\x1d\x19\x51\xad\xa2\x59\x0d\x59\xa0\x33\xa9\x87\xe8\xb6\xdb\x18\xec\xa3\x33\x55\x63\x8d\x16\x68\x5b\x57\x24\x91\x32\x23\xa9\xad\x9c\x53\xb6\xac\x7d\xcc\xcf\x30\x0f\xe5\xb2\xb1\x20\x48\xc3\xe1\xd4\x21\x6f\x58\xa4\x75\x9c\xa5\x14\x9c\x3d\x10\xf5\x72\x6f\xa1\x27\xdd\x0f\x04\x02\xa3\x09\xc4\x65\x57\x6a\x40\x60\xde\x89\x87\xcd\x00\xe2\x9c\x85\x0d\x8e\x49\xf0\x20\x0e\x8e\x34\xce\x22\x61\xf0\xc9\x84\x0a\xb1\x8f\x1c\x07\xa1\xdf\xbf\x97\xf5\xfb\x3c\x39\xbd\xc2\xd6\xe0\x94\x33\xb0\x21\x57\x58\x5e\x28\x13\x5c\x22\xa2\xa8\x4d\xc5\x7c\x00\x88\x0b\x68\xa0\x25\x70\x8d\xeb\xc0\xdc\x8a\x97\x9b\xe3\xa4\xe4\x54\x7b\x6e\x84\x60\x8c\x51\x5b\x04\x21\x4a\x5c\xa6\x6f\x31\xa7\xcf\x59\xec\xf3\xcd\x09\x80\xe6\x1a\x13\xee\xbf\x90\x7f\x1f\xd4\x9e\xce\xd9\x60\x96\x2d\x17\x65\xe8\x73\x99\x32\x49\x67\xb2\xdb\x1b\x4c\x37\xac\x48\xb7\x8d\x2b\x93\xa2\x47\xf2\x13\xe4\xc8\x6f\x95\x70\xa1\xb5\x33\xb2\x85\x53\x8c\xbd\x0a\x32\xfa\x32\x5a\x74\x18\x9e\x25\x5f\xc1\xd0\xf9\x39\x85\x47\xaf\xec\x0c\x28\xe1\x6d\xa2\x73\xcd\x46\xbe\x54\x9d\xd5\xa6\x7f\x9c\x77\x87\xf7\x9e\x7f\x2b\x16\xd9\x2a\xdd\xda\x1d\xe0\x41\xac\x33\x24\xaf\xc4\x29\x66\x76\x62\x4d\xc9\x1a\xf0\xe4\xbd\xfc\x9c\x74\xe4\x5e\x12\xbb\xc6\xaf\xf7\xd0\x31\x12\x84\xeb\x94\x33\x11\xad\x08\xb2\x0b\x85\xe9\x85\x5a\xea\xc0\x7b\xca\xcb\x1e\xce\xfa\xc0\xf9\xe7\x43\xfb\x0d\x04\x97\x1c\x13\xa0\xcb\xc9\x19\xc9\x35\x08\xb8\x05\x4c\xa5\x71\x9b\xa8\x22\x6e\x4c\x48\xf4\x76\x89\xf1\xe1\x0c\x0e\x68\x00\xde\xeb\xbd\x8d\xbd\xd2\x0a\x4f\x08\xa2\xad\xfe\x27\x10\x2d\xf2\x70\xb1\x13\x9e\x34\x47\x26\xd9\x9f\xd5\x52\xf0\x03\xf0\x4f\xb3\xc0\x37\x71\x50\x9e\xe8\xd8\xd6\x92\x76\xf7\xc2\x91\x0d\x16\x53\xf7\x5f\xee\x9b\x25\xe3\x22\xfd\x74\xd4\x71\x90\x0c\xb6\x02\xe4\x37\x24\xfc\x73\x41\xcf\x74\x88\x57\x16\x26\xbd\xb1\x95\x62\xf2\xbc\xb3\x68\x91\x34\xec\x28\x27\xd6\x0e\x52\x5b\x92\x03\x46\x3a\xdb\x8f\x1b\x29\xf1\x08\x5e\x84\x2c\xc8\x06\x11\x43\x3f\xfa\xf9\xcc\x6b\x44\x47\xbf\x42\x1b\x47\x9a\x85\xd1\x67\x0c\x98\x0a\x97\x2a\x05\x55\x8b\x54\x31\xe5\xb8\xe8\xf9\xb8\xa5\xf9\xad\xee\xd1\x47\x46\x5d\xd2\xb9\x21\x27\x70\x5b\xd5\x52\xa7\xe4\x18\x89\x68\x9a\x02\xcb\x64\x9c\x37\x43\xf4\xaa\xfa\x3a\xe9\x5d\xbf\xb0\x7c\xd0\x1f\x71\x45\x19\x3a\xb0\xa0\x35\x64\x0d\xe4\x07\x4e\xb9\xe0\x5f\x60\xe0\xb2\xb8\xb2\xa7\x46\x1e\x88\x13\xc3\xf3\x29\xa4\x3e\x4a\x6e\x5c\x00\x98\xad\x6a\x05\x58\x60\x9e\xbc\x71\xf4\xce\x4d\x2b\x90\x1f\x5a\x84\x1d\x17\xb1\xb2\x0c\xa3\x83\x56\x2a\x4c\xc6\xf2\xb7\x64\xa4\x75\xca\x46\x5c\xb0\x66\x8b\x28\x84\x0b\x9c\x42\x98\x05\xc2\x08\x73\xc6\x51\x05\xbf\x0b\x79\xb4\x62\x62\xd0\xe6\x18\x32\xb9\xea\xb4\xce\x11\xca\x2e\x2a\x6c\x89\x5c\xe4\xf8\xac\x8d\x15\x4d\x39\x6b\x04\xab\xad\xb9\x8d\xcf\x43\x0e\x01\x2f\x06\x9c\x09\x53\xd5\xe1\xdc\x14\x4a\x17\x4c\x65\x97\x97\xd7\xe2\xeb\x84\x0b\xd2\xbf\x00\x1f\xf8\x5d\x43\x9e\x03\x86\x5e\x14\x88\x4c\x88\x5d\x04\x00\xe9\xc2\x9e\xc7\x74\xd1\x97\x4f\x8b\x45\xb7\xcd\x25\xf8\x82\x6a\xd9\x8a\xb1\xa2\x07\x82\x79\xec\xed\xdf\xe5\x58\x49\xab\xdc\x02\x0d\xdd\xd3\xcd\x6e\x5f\x32\xf9\x81\xbc\xf2\x90\x56\xf5\xaf\xf7\xa7\xfc\xda\xf8\x5a\x04\xf9\x34\x52\x30\x3e\x0f\xe7\x2d\xd5\x25\x44\x87\x23\x16\xe1\x5a\xac\x92\x2e\x3e\x80\xda\x01\xc7\xc9\x44\x97\x62\x4b\x8e\xea\xfe\xb3\x0f\xfb\x0d\xff\xc7\x24\xaf\x62\xb8\xb4\x45\x4c\xf5\x5b\xf3\xd4\x30\x4c\x87\x8c\x41\x0e\xf6\x25\x45\xb7\x61\xb4\x4e\x4a\xff\x41\x42\x22\xbd\x16\x79\x7f\x93\x97\x66\x45\x37\xdc\xba\x98\xa9\x9d\xb8\x5b\x56\xb6\x29\x15\xea\xb3\xa6\xe1\xf7\xbe\x2e\x43\x5f\x4d\xbf\x3a\xe2\x83\x5c\x3c\x4f\xb2\x74\xdf\x8c\x73\x56\xd9\x62\xf0\x5b\x90\xde\x04\x10\x1e\x92\xf3\x28\xde\x6b\xc4\x1b\xdd\x02\x44\x1e\xb8\xe3\x87\x85\x5c\x56\xea\x82\xda\xaa\x65\x87\x38\xc6\xf3\xbb\x85\xe3\x40\x74\x00\x0b\xd5\xdb\x58\xd5\x7d\x35\x1f\x99\x41\x03\xf5\xe3\x51\x01\xf9\x47\x44\x17\x7d\x11\xc0\x75\x2e\x90\xee\xdc\x85\x80\xbd\xe3\xeb\x2d\x39\xcf\xc4\x42\xc2\x67\x55\x21\x7a\x29\x35\x6c\x37\xa4\x7f\xf2\xda\x22\x34\xc9\x1b\x33\x5a\x61\x1b\x01\x8b\x50\xf9\x5c\xeb\x14\xed\xeb\x1d\xae\x5a\x0c\xaa\x8f\xf3\xde\x71\x10\xc2\x81\x74\xed\x91\xc1\x2a\xd9\x33\xa1\x80\x21\x3c\xf1\x89\x65\x05\x1b\xcf\xdd\xe8\x28\x62\xbe\x90\xc4\xa2\x75\x6b\x19\xcd\xb9\x48\xd9\xf7\x8b\xb6\x13\x63\xf3\xa2\x29\x58\x5e\x8a\xdf\xd9\x5b\xa1\x6b\x01\xe6\x02\x5e\xcb\x12\x49\xe0\x5a\x80\xf6\xac\xbc\xbd\xc1\xca\xf1\xd1\x1b\x20\xb4\xfc\x42\x69\xd4\xb7\xe9\x89\x46\x8b\x2e\x0e\x56\x94\x93\x67\x1b\x33\x84\x2d\xca\xdb\x5e\x8e\xeb\x16\x25\x50\x0a\x14\x60\x3a\x84\x12\x96\x72\x84\x56\x91\x48\x54\x13\x7c\xc1\xea\x9b\xf6\xc8\x1c\xf3\xe1\x09\x5a\x6f\x2d\x18\x48\xb5\x5c\x58\x2e\x15\xbf\x00\xb2\x03\x70\xbf\xe0\xe5\xf2\xb6\x00\xa2\x85\x90\x8d\x83\x44\xd2\xb2\xe2\x45\xd3\x25\x0e\x50\xa5\x91\x36\x87\x9b\x51\x24\x66\x34\x45\xe7\x74\xf8\x47\xb1\x0f\xbd\x11\x24\x4b\xea\xa7\xed\xc2\x03\x2e\xc3\x9d\x05\x23\x1e\x2c\x79\xa4\x93\xfc\x5b\x7a\x8f\xd3\x7d\xd3\x66\xc7\x83\xa5\x10\x3c\x4d\xae\xa8\x5b\xb6\x37\x13\xc9\x27\xd8\x1a\x19\xb7\xbe\xde\xe5\x3f\x58\xe2\x31\xaf\x42\x2f\xc9\xd1\xa3\x7e\xec\x0e\x8e\x9c\x6e\xae\x27\x65\x08\x40\x70\xed\x79\x93\x87\x66\xc7\x22\x0b\xf1\x1a\xfa\xa5\x2c\xe0\xaf\x7c\xf4\x0f\x4e\x57\xe5\x21\x83\x9c\x06\xb6\x10\x6a\x8e\xa9\xf8\x5b\xac\x7b\xe0\x84\xb7\x09\x40\x38\xd1\x9d\x8e\xa6\x85\x34\x51\x06\x38\x2a\xd8\x01\xdd\xd9\xd4\xfa\x02\xb2\x80\x78\xc8\x14\x55\x29\xbd\x73\xe7\x0e\xac\xc5\xcb\x73\x82\xa1\x89\x31\xbe\x52\x7c\x38\x14\x8d\x95\xd5\x19\x8e\xb6\x6a\x3e\x66\x68\x7d\xf8\xb5\xb3\x80\x02\x81\x8d\x5b\x57\x22\x04\x96\x8a\x6a\x37\x76\xe0\xde\x97\x99\x45\x2d\x48\x40\x84\x93\x1a\x83\xf1\x62\x9b\xd9\xe7\x15\xd7\x13\x04\x4c\xcc\xe1\x66\xc7\x47\x22\x3e\x4d\xfd\x63\xa0\x59\xa2\x81\x97\x7b\x96\x7c\xff\x5d\x4c\x73\x25\x9c\x58\xc7\xaf\xb3\x9b\x73\x24\xb2\x94\xbd\x68\x51\xad\xca\xfc\x17\xbd\x42\xb8\x2b\x5a\xab\x41\xca\xad\x23\x15\x51\x4a\xaa\x51\xd5\x9e\x12\x3e\xa8\xb5\x49\x7b\xeb\xf9\x7d\x66\xe1\x25\xe8\x3f\x51\x0d\x44\x84\x31\x01\x58\x80\xc8\x50\xdf\x64\x68\xf7\x84\x90\xd6\xd5\x9d\xed\x7a\x7c\x14\x08\x2b\xe5\xcd\x09\x2c\x4d\xaf\x42\xcc\xaf\x56\xc1\xf4\x63\xc6\x47\xc8\x38\x41\xe5\x43\x70\x39\x5c\x58\x23\xe9\x44\x21\x32\x51\x1c\x56\x8c\x6b\xb4\x01\x38\x65\xe3\x72\x2f\xdc\xab\xbb\x1f\x31\x78\xc5\x5a\x7b\xbf\x37\x07\x86\xee\xcb\x01\x43\xf7\xdd\x63\x94\x70\x2b\x07\xf1\x3c\x45\x88\xb1\x3e\x7f\x8c\xec\x13\xbf\x66\x7a\xee\xc1\xe7\xff\x55\x56\xbb\xc2\x64\x2b\x73\x7e\x82\x16\x45\x3c\x0f\x91\xdc\x1a\x2c\x9f\x79\x3f\x67\x6f\x06\xe0\x68\x9c\x4a\x6f\x5c\x19\x44\x5e\xdf\x8a\x24\xeb\x27\xae\x13\x57\xa0\x15\x5d\x0c\x93\x4b\x39\x29\xc8\x3d\xf2\xd2\x97\x0b\x39\x09\x47\x93\xb0\x35\xa8\x90\x8f\x5a\xd0\x81\xb9\x6a\x30\x20\x4b\xeb\x6b\xfd\x27\x9c\x4b\x5a\x33\xdd\x1a\x31\x53\xf0\x12\x38\x3f\x4c\xe1\x41\x93\x96\x73\xef\x28\xf6\x4e\x61\xc5\xca\x43\x98\x58\xa5\xf6\x1a\x46\x96\x4d\xb2\xf9\x7b\xe4\xee\xca\x70\x70\xcc\xc0\x0b\x0a\x14\x90\x30\x3e\x84\xae\xdf\x12\x61\x16\x27\xca\x99\xbb\x37\x98\x2c\x9e\x21\x56\x81\x53\x03\xa2\x61\xec\x8d\x3e\x8c\x2c\x70\x88\xbc\x2a\xc8\x70\xb4\x19\xb8\x79\x51\x21\x27\xa6\xe3\x3e\xf1\x5a\xcc\xdb\x1b\xf6\x79\x51\xde\x2a\x08\x4b\x20\x72\xc3\x20\x21\xfa\x8f\x8b\x84\x73\x3e\xf4\x8e\x59\x4e\x57\xa9\x9d\xe8\x72\xca\x0d\xfc\x63\xda\x1e\x5e\xc1\xd7\xf7\x24\x45\xb5\xf8\xba\x07\xcc\xf5\x3e\x57\x39\x34\x81\x24\x32\xad\xb1\xbe\xd3\xee\x17\xc9\x7b\x78\xb6\x6d\x14\xc9\x92\x18\x92\x86\x75\xc4\x88\xe6\x85\x3f\xee\xd3\x20\x41\x6f\x4b\xbf\xe4\xfa\xad\x38\x85\x75\x50\x71\x38\xe3\x62\x7e\x89\x62\x59\x06\xab\x18\x8b\x98\x2a\xc1\x50\xf4\x9d\x1e\x60\x91\x59\x23\xf3\x5a\xf0\x79\x32\xdb\x99\x1b\x27\x38\x07\x33\x9e\xb6\x42\x44\xd8\xd4\xe1\x51\x9d\x55\xa2\xc1\xb2\x5d\xd5\x15\x99\xbc\xa1\x8b\xc5\x30\xc8\xc6\x86\x6c\x38\xbe\x1e\x1b\x25\x01\x93\x83\xaf\xd3\x45\xe2\x87\x31\x88\x52\xc0\x7b\x2a\x6b\x6d\xd7\x19\x01\x5c\x5a\xc1\xa7\x61\x80\x6d\xa5\x84\x4e\xc8\xcb\x23\x27\x51\x14\x16\x32\xbe\xc9\x91\xa0\x5b\xdb\x9a\x6d\x9c\x7d\x4b\xd4\x19\x2c\x3c\x47\x23\x4d\xcd\xbc\xf9\xb2\x4e\xa2\xd3\x14\xa3\xa9\x9b\x15\xd2\x78\x5e\x25\xb5\xce\xdd\x26\x27\x19\xbd\xc8\x03\xb3\xed\x87\x60\xeb\x59\x10\x17\x38\xb7\xe2\x4f\x03\x97\x1a\x8d\x4d\xdb\x7e\x78\x5b\xde\x73\xdc\x9b\xdd\x0f\x85\xf0\x58\xb4\x58\x4b\x4c\x37\x8b\xa3\xb4\xfe\x75\x55\xea\xc8\xdf\x10\xc7\x55\x43\x8b\x0e\xe4\x8e\x53\x77\xd9\xdf\xd2\x85\xba\x5f\x3e\x26\x35\x73\x29\x7e\x27\x5b\xec\x18\x6e\xe4\xb2\x2a\x9f\xea\x67\xfc\x66\x22\xe4\xad\x6b\x2e\x15\xe4\xb9\x25\x0f\xc1\x06\x35\xf6\x88\xf1\x6f\x68\x51\xaa\x6d\xfa\xf7\xce\x28\x94\x87\x45\x9e\xaa\x03\xfb\x50\xb8\x11\xdf\x97\xbe\xc6\xc7\xcb\xf2\xba\x6a\x19\xf8\x82\x40\x2a\x9f\x80\xb4\x0f\x0f\x76\xa9\x7f\x5b\xf3\x77\xa3\x3b\x00\x7c\x58\x63\xf9\x5a\x6d\xcc\x6e\x40\xad\x9c\x14\x8d\x7f\xa2\x29\x3f\x38\x01\xe8\x47\x90\xf7\x9d\xb2\x4d\x56\x15\xdd\x47\x94\xbe\x21\x64\x8d\x50\x0c\xd0\x3d\x48\xaa\x5e\xbe\x4a\x56\x5b\x7b\xec\xfc\x35\xd4\xac\xea\x58\x5a\x5f\xb2\xd1\x12\x10\xa5\xab\x74\x44\x8a\xde\x95\x60\x91\x29\x09\x21\x23\x10\xd5\xce\x94\x3d\xd1\x72\x27\xae\xb8\xba\x11\xb7\xb4\xf5\xd5\x8d\x38\xdb\xf5\x7e\x07\xaa\x0b\x2d\xbd\xb4\x9e\x75\x21\x25\x9f\x87\xb2\xf6\x27\x95\x8e\x71\xc1\x44\x14\xd0\xf1\x2c\xce\x7d\xf4\xd5\x42\x95\x67\x08\x33\x14\x93\xa5\x08\x18\xbb\x84\x91\x82\x13\xb5\xb8\x20\x9c\xb9\xc2\x21\x9b\xc9\xc0\xcd\xb6\xba\xa6\xff\x39\x00\xb7\xf9\x31\x67\x3e\xbd\xd2\x84\x8f\x0f\x4d\xe9\x88\xf9\x26\x57\xb3\x1b\x8f\x63\x9f\xc8\x42\x99\x19\x6f\x58\xf3\xf3\x42\x5e\x1b\x01\x41\xe8\x2e\x72\x04\x2d\xc8\x93\xbe\x55\xf9\xcb\x65\xb3\x56\x13\xb9\x74\x80\x9b\x57\x2a\xe7\x1b\x0b\x6d\xc9\x0a\x55\xb9\x8e\x13\x56\x70\x7e\x3b\xf2\x6d\x7c\xf1\x4e\x8c\xde\xe4\x80\x0c\x6e\x1f\x64\x2f\xcb\x1e\x2e\xb0\xc4\x7c\x19\x93\xc3\x5b\xf1\x1f\xfc\xa3\x77\x6f\xfd\x9f\x25\xd0\xb0\xcd\x99\x46\x6a\x9f\x88\xcc\x60\x1c\x5e\x8f\x4c\xf8\xf9\xb4\x6b\xbc\xa9\xb8\x70\xfa\x6a\x85\xe8\x49\x73\xf7\xfe\x0e\x77\x23\x53\x54\x37\x83\x74\x28\xb3\x11\xa4\x1a\x94\x3e\xda\xa7\x61\xc4\x9f\x50\x9b\x66\x10\x5b\x03\x48\x86\x11\xf9\x67\xfd\x03\x59\xe3\x08\x47\x03\xe7\xa1\xcc\x97\x46\x0e\x88\x10\xcf\x6c\xe9\x67\xb5\x88\x7c\x2b\xc7\x17\x95\x25\x1d\xf1\x14\x71\x28\x68\x96\x2b\xc2\x81\xae\x57\x2e\x79\xb4\x0f\x4b\xde\x0f\xef\x2b\xa7\x4c\x7d\x0c\x25\x8f\xc9\x3d\x23\x04\xa9\x38\xb9\xae\x46\x8c\xc6\x69\x4c\x84\x96\x8d\x59\x4f\x38\x3c\xf0\x8a\x3b\x69\x4c\x60\x7f\x7f\x9c\x2e\x09\xf3\xaa\xd5\xe0\xd9\xd0\x6a\x38\x39\xe5\xa7\x38\x40\x60\x7c\x3f\xfe\x26\x44\x8d\x68\x76\x5f\xa7\xe4\x68\x4d\x3a\xdb\x6f\x7f\x15\xec\xe6\x94\x1a\xed\xe3\xe7\x5b\x13\x14\xd5\xb5\x51\x23\x68\x27\x64\x08\xfc\xd6\x72\x2e\xde\x91\x9f\x99\x7c\xdd\x21\xd8\x43\x66\x09\x19\x7c\x75\x5e\xf0\xca\xc0\x01\xb5\xb8\x30\x53\x77\xdb\x00\xcc\xc9\x36\x09\x1d\x3e\x23\x15\x3c\x07\x54\xd5\x17\x3d\x7b\x7b\x62\x5f\x1f\x0b\xf0\xef\x87\xac\x0e\x28\xdc\xd3\xb8\x45\x08\xea\x2b\xfa\x1d\x77\x7c\xc8\x9a\xab\x7e\x6c\x8f\x99\x26\x28\x76\x09\x3a\x97\x7c\x5f\x9e\x8c\x8a\xc9\xa3\x98\x39\xde\x96\x3e\xc0\xdd\x04\x58\x0e\x77\x9e\x6c\x3b\xd5\xcc\xa7\x93\x2f\x8f\xd6\x77\xe4\xde\x1d\x47\x0e\x5a\x2c\x8c\x5c\xa0\x86\xa5\xdf\x0f\x75\xf5\x37\xa3\x97\xa0\x77\x89\x7e\x3f\x95\x1b\x61\x30\xe5\x3a\x91\x19\xc1\xbd\x5a\x54\xd1\xa6\x68\xd6\x83\x22\x59\x57\xe5\x4b\x62\xff\x06\xe6\x2e\x5c\x13\x32\xf1\x49\xea\xac\xfb\xd6\xe2\xc5\xec\x39\xf9\x8d\xab\xad\xf3\x29\xab\x1a\x46\x12\xd0\x07\x69\x7d\x63\x38\x20\xc1\x5d\xb8\x94\xa2\xbb\xf7\x6b\xfe\xed\xcc\x56\xb8\xdd\xc1\xe6\xfc\x80\x94\x44\x5a\x9c\x82\x8d\x4e\xf2\xd7\xb9\x17\x16\x87\x02\x8c\x96\x6a\xf4\xc9\x7d\xa2\x80\xd8\x56\x36\x55\x69\x74\x64\xa3\xe7\x15\x58\x69\x3c\x90\xcd\xee\x3f\x47\x6c\xce\x7a\x4e\xed\x90\x4f\xbb\xa3\xff\x34\x11\x09\x75\x5a\xd0\xe3\xb3\xbd\x83\xe8\x71\xf4\xe7\x5c\x9d\x23\x28\xfc\x5c\x9e\x2b\x04\x85\x92\x51\x9c\x9b\x75\x2e\xe5\x24\x01\xab\x89\x18\xc1\x7c\x87\xd9\xf1\xc1\xae\x74\x8d\x42\x2a\xb5\x18\x5a\xeb\x6b\x2c\x2c\xb2\x34\x6c\x25\x57\x42\xbc\x96\x0b\x45\xaf\xfd\xe3\x09\x95\xf8\x53\xbc\x2c\x08\xf8\x35\x66\x33\x2f\x8c\xe5\x1b\x53\x5f\x10\xc4\x29\x98\x55\xb1\x81\x85\x20\x11\x3a\xb6\xd9\x43\x92\x31\x31\x12\xfa\x74\x3d\x8c\xcf\xc2\x3e\xc2\xe3\x53\xd6\x96\x2d\xf9\x72\x8b\x7c\x0b\xe2\x39\xf5\xcc\x16\x0e\xf6\x63\x23\xe9\x42\x8f\x21\x47\x12\x15\x9f\x6a\x81\x3b\x4c\x3e\x8b\xf0\x88\xbe\xb8\x59\x23\x7e\xc1\x71\xb5\x34\x53\x68\x0c\xbd\x19\x36\x51\xd7\x9a\xed\x9f\x65\x9a\x29\xa0\x47\x23\xaa\x79\x81\xda\x2b\x09\x6b\x5f\x60\x25\x5b\x05\x1b\xf3\x43\x73\x5d\x8a\x56\x71\x4a\xca\x6f\x1c\xed\xea\x01\x03\x8e\x7e\xd9\x26\x5e\x51\xcb\x0f\x9f\xc0\x7a\xb5\xcb\xdc\xda\x54\x08\x30\xae\xc9\xdd\xf1\x19\x4d\x7d\xe6\xa2\x6b\x9f\x7a\x8c\x81\xd8\x64\x28\x93\xe2\xf2\x2b\x89\x48\xb0\x2b\xe2\x81\xb3\xb6\xc4\x85\x03\xb7\x7c\x7a\x34\x72\xa5\x67\xe5\xa9\x2c\x12\x48\x42\x58\xeb\x61\xbb\x19\x32\x07\x50\x55\xca\x64\x81\xd7\x7a\x99\xa4\xcc\x41\x71\x3d\x53\xcf\x0f\x1f\x12\x7e\xbb\xda\x40\xb6\x28\xf8\x2b\x04\xdb\x47\x37\xcd\x56\x7e\x45\xa1\x4d\x1f\x72\x43\xd0\x82\x2e\x4a\xe6\xc0\x57\xfb\x24\x88\xac\xba\xca\x53\x8d\xba\x32\x39\x80\xc6\x5c\x6b\xa5\x4e\xb2\x19\x66\xf9\x98\x4d\x4e\x86\xfa\x95\xb2\x72\xf6\x42\xf7\xa4\xaa\x12\x6b\xf7\xce\xad\xc4\xa6\xc0\xae\x84\x19\xe2\x2e\xf2\x76\x4b\xb8\x87\xa5\x26\x36\x6e\x26\x89\x1d\xbb\x84\xf4\xfc\xda\x3c\x95\x19\x06\x23\x37\xc1\xc4\x2d\x29\x90\xbe\xd4\xc1\x7a\x72\x59\xa1\xdd\x21\xa8\xc3\xb4\x2e\xd5\xac\x46\xac\xc8\x76\x7c\xa9\x23\x95\xad\xfb\x4e\x47\x45\x78\xfc\xf2\x76\x00\xf4\xc4\x13\xda\x92\x3b\xc4\x9e\x02\x66\x36\x83\x23\x4c\x47\x05\x93\xda\xdb\xfc\x52\xd8\x0f\xc9\x2e\x6a\x7e\x32\xa2\x17\xd4\x82\x6b\xef\x36\xef\x46\x04\x74\xab\x6e\xec\x01\x76\x67\x72\x6e\x81\xa5\xf6\x29\xe6\x21\x04\xfd\x61\x1e\x32\x60\xcd\x82\x65\xd4\x7b\x41\x0d\x31\x68\x1f\x80\x69\x6f\x21\xc5\x64\x1c\x11\x40\x36\x95\x9c\xa0\x62\xef\xde\xea\x28\x95\x2c\x47\xfb\xbd\x19\x16\x21\xd0\xac\x45\x14\xc2\x52\x4b\xbb\xed\x55\x6f\x57\x64\x15\x03\xad\xc4\xfe\x55\xda\x06\x04\x3e\x91\x83\xf5\x24\xa8\xfe\xe3\xdf\x03\xce\xc1\xfc\x5f\xfe\x54\x1d\x29\x45\x6c\x0f\x77\xee\xf0\x49\xbd\x2f\xb9\x46\x75\xfe\x16\x08\xf0\x58\x24\x9e\x13\x69\xdb\xae\x28\x0e\xb8\x80\xc2\x39\x59\x1e\x47\xcc\x55\x36\xcb\x48\xf6\x99\xab\xfb\x46\x94\xe1\x84\x12\xa1\xd4\x1d\x0c\x81\xfa\xf8\xcb\xb8\x85\x88\x6e\xb9\xc2\x74\x76\x70\x3c\x35\xe4\x2f\xb1\xf0\x3c\xba\x98\x8a\x37\x84\x01\xc6\xc2\x5c\xbe\x00\x0e\xd4\x90\x93\x52\x3e\x15\x7b\x63\x46\x1b\xde\xb1\x6c\x06\x88\xf0\x08\xfb\xda\x24\x7f\xaf\x5b\x75\xf5\x7e\x52\x40\xf2\xdd\xfd\xbb\xbf\x4c\x89\x6d\x34\x62\xdf\x01\x00\x41\x9e\x16\xf3\x89\xe7\x5c\xe7\xcc\xbc\x24\x42\x65\x9e\x6f\x72\x09\xbe\x73\x03\x8e\xa1\x70\xe6\x03\xd3\x7b\xf9\x0c\xeb\x30\xc7\xaf\x15\xff\x2b\xb3\x79\x9a\x97\x45\x9a\xfb\x69\xc3\x63\xe0\x32\x50\xc6\xcc\xd9\xb7\x28\xd2\xbd\x2d\xd8\x13\xfb\x19\xd6\x73\x26\xaf\x47\xae\x7f\x51\x49\x81\x33\xb7\xbc\x64\x61\x9c\xe5\x37\xb9\x62\x87\x2b\xb5\xd8\x6d\x3e\x15\x41\xc8\x8d\x29\x2d\x9d\x9b\x82\x40\x00\x4b\x2e\x70\xe1\x5b\x36\x48\x98\x84\x96\x23\xdf\xcc\xda\xa7\x2d\x24\x5c\x25\xeb\x82\x1b\x0a\x48\xbb\x3e\xa5\x89\xd0\x1c\x13\x94\x09\x1f\x3f\x6e\x3d\xea\x91\xb5\xec\x26\xbe\xf0\x41\x3e\x5b\x2e\x2b\x99\x8f\x20\x99\xe0\xfb\x68\x40\x05\x4a\x0e\x64\x73\x42\x60\xfe\xdf\xff\xfd\x7f\xc0\xa2\xaf\x5c\xff\x2b\xd8\xa3\x3a\xef\x53\x3f\xaa\x8c\xfc\x66\x9e\x4e\xfe\x09\xb8\xf3\x99\xfe\xc2\xfd\x16\x51\x18\x7e\xa8\x78\xf4\x53\x47\xfa\xae\x62\x03\x4b\x06\xb1\xeb\x6c\x7d\x7a\xce\xea\xfa\xc3\xbd\x52\x1b\x7b\x0e\xf6\xbc\x09\x8a\xc3\xda\xe0\xbc\x0d\xec\x6d\xa4\x0b\x25\x6d\x21\xd5\xed\x56\x96\x13\x78\x1b\x29\x1f\xd7\x67\x8b\xca\xd1\xfe\x06\x1c\x51\xed\x5a\x04\xc5\x03\x8a\x8e\xc0\x23\xc1\x09\xf0\x45\xc1\xbe\xf0\x92\xdb\xcf\xd1\x53\xf0\xdf\x1c\xb1\xe3\xf3\xe3\xe4\xdb\x94\x7a\xf0\xa8\xe3\x8a\x10\xd6\xd4\x8a\xb2\xcb\x11\xa3\x57\xf2\x28\x25\xd9\x70\x4e\x00\x1f\x34\x36\x90\x6a\x23\xfc\x9b\x9a\x64\x47\x14\x74\xd3\x15\x29\x47\x29\xe9\xd6\x93\x91\xc1\x49\x29\xbc\xea\xc2\x78\xef\xde\xbe\x9a\x18\xe5\x41\x1f\x56\x7b\xc4\xa5\x88\x8a\xc4\x12\x60\x33\xb5\x98\x89\xbe\xfc\x9b\x03\x1c\x6c\xd7\xe1\x2a\x43\x80\x37\x86\xd4\xce\xb4\x70\x1a\xb0\xae\xb4\x52\x7f\xca\x97\xd3\x30\x29\xb7\x2f\xe9\x7c\x31\x2d\x9a\xf6\xd0\xa9\x71\x25\x3a\x74\x9e\x76\x72\xf6\x97\x32\x83\xd0\x1a\x92\x90\x78\xfd\xa7\x48\x14\x98\xfa\xc6\x77\xed\xe5\x29\x1f\x36\x44\x54\x84\xb0\x46\x74\xe3\xd7\x1b\x2b\x80\xe4\x5f\x9d\x2a\x7f\x94\x98\x1b\xb2\x90\x7d\xa6\x18\x07\x71\xc1\x30\x67\x51\xdf\x97\x73\x4e\x53\xc6\x2d\x81\x7b\x81\x35\xcf\x8c\x71\xd8\x7a\xce\x55\x81\x2a\x81\xd1\x01\xc6\x2b\x98\x0e\xba\x37\xdf\x84\x21\x08\x07\xfe\xbc\x08\x46\xb6\xa4\xaf\xdc\x72\x38\x40\xca\x85\xaf\xc0\x6b\x70\x7b\x73\x40\xce\xeb\x0f\x1d\x28\x44\xfe\x5b\x9e\xd8\x81\xe3\x89\xb7\x5d\xee\xff\x29\x0f\xa6\x3d\x5b\x8c\xd9\x26\xbf\x00\xf1\xcd\x6c\x36\x70\x5e\xe9\x0c\x34\xf4\xa8\xf0\xb4\x32\x97\x8a\x6d\xd9\x9e\xf4\x7a\xc6\x7b\x30\x5f\xe4\x06\x32\x36\xd8\x72\xc6\xc6\xda\x1d\x8d\xc9\x72\x09\x26\x3f\x15\x52\x13\xcc\x44\xdb\x69\xcc\xd0\xa2\x66\x29\xf0\xe7\x10\x0a\x0d\x5f\xb4\xd8\xff\xc2\xff\xc9\x33\xb4\x6d\x06\x9a\xb6\x2b\x4b\x93\xdd\xe6\xd0\xfe\x35\x27\x6f\x7f\xe8\xd8\xbe\xb8\x6f\x9b\xda\x47\x3d\xb7\xe3\x9c\x74\x4e\xce\x3a\x66\xd9\xb7\xb4\x3b\xe5\x90\x94\xfd\x9a\x0c\x99\x5f\xf9\x10\xff\x34\x4a\xa6\x2a\x87\x27\x6a\x01\x3d\x76\xb6\x39\xe2\xc1\xb6\x06\x32\x19\xc3\x84\x6f\xb7\x89\xcc\xb3\x9f\x7e\x57\x96\x42\xd7\x4b\xf2\x2e\xd0\x0b\xac\xfa\x8a\x91\x04\xc3\xb1\xf4\x3b\x81\x2f\xa6\xf8\x31\x41\x93\x92\xa1\x34\x2f\x1b\x44\xec\x03\x5b\x3c\x90\x8b\x63\x01\x8b\x0b\x7c\x32\x37\xa6\xd0\x0c\x30\xea\x05\x76\xe8\xe6\xa5\x6e\x40\x0a\x8f\x57\x22\xcd\xe4\xf8\x34\x2d\xcd\x72\xa6\xb1\x2a\x41\x6e\xac\xbb\x21\x36\x7b\x2d\x95\x6c\x23\xb2\x60\xdf\x6d\x44\xab\x82\xca\x2a\xe6\xa2\x62\x9e\x74\xad\x72\x8a\xfe\x00\x46\x25\xa6\x6e\x32\x4d\x4c\xa3\x2e\x7a\x73\xe0\xeb\x17\xcf\x63\xba\x75\x44\x63\xe4\xcb\x7c\x16\x35\x86\xdb\xda\x36\x22\x38\xb0\x34\xdf\x55\x97\x4a\x83\x30\x20\x21\x76\x7d\x10\x3e\xdb\xa3\xfc\x5a\x1c\x4f\x1f\x1c\x8e\xb1\xa4\x50\xd6\x45\xbe\x99\xb3\x6b\x58\x21\x52\x72\x23\xdd\xc0\xe8\x4e\x18\xc4\xc9\x54\xfe\x81\x89\xae\xb6\xac\x5e\x6e\xc4\x06\xf5\x16\x4d\xe0\x01\xf3\x2a\x6f\x48\x0b\x34\x1a\xf0\x0b\x5c\xb0\x30\x72\x1e\x74\xfa\x72\x65\x76\x7c\x21\x4c\x66\xa7\x1d\xba\x03\xc1\x4f\x0f\xbe\xde\xac\xeb\x6e\x9e\xf4\x3f\x6d\x69\xfa\xa7\x62\x60\xee\xcb\xf1\x41\xe0\x10\xf5\x61\xdb\xa5\x17\x52\xb0\xe7\x09\x20\x8f\x45\xfe\x32\x8e\xe8\x4a\x70\x1b\xc3\x20\x46\xa6\x44\x12\xbe\xec\x42\xc7\x77\x5a\x2a\x94\x6d\x76\x5a\xbe\x16\x42\x57\xd0\x15\x14\x57\x36\x7e\xb3\xe4\x3e\x61\x12\x0a\x65\x19\xe8\x78\x9d\x80\xf3\xe5\xc3\xaf\xe5\x9d\x79\xeb\x0b\xa3\x76\xa9\x7b\xc3\x29\x1a\xed\x4b\xba\xd4\xbf\x62\x1b\xb2\x88\x54\xfd\xfc\x31\x38\x56\x7b\x6d\xa3\x93\xb3\xbf\xa2\xad\x05\x6c\x95\x21\xc5\xf6\x97\xc1\xfe\xcf\x71\x3d\xe5\x78\x1b\x8e\x20\x9f\xee\x1b\x2f\x84\xd6\x8e\x9c\x14\xdb\x23\x57\xb3\xe8\x8c\x41\xe4\x94\x8f\x84\xf5\xc3\x82\x0a\xad\x26\x71\x04\x63\xec\x1b\x72\x9d\x6a\xf4\xb5\x91\xc6\x34\xa1\xe0\xe3\x5f\xd3\xc2\xbf\x94\xbb\xdf\xcd\xb9\x34\x24\x6c\xd5\x80\x6f\x84\x0c\x35\xbd\xc7\x46\xd0\x80\x4f\x9e\x64\xb7\xcb\x6e\x2b\x71\xe2\xd6\x62\x11\xc5\xf4\xbd\xd0\x29\x74\x2f\xb4\x4d\xe6\x07\x74\x67\xb8\x3b\xb7\x58\x68\x99\xdc\x2a\x4a\xf9\x28\xc6\xdd\x60\x51\xb1\x46\xd8\x58\x11\x33\x86\x29\x3f\xe0\xc2\x03\x3f\x89\xd0\x46\x58\x96\x3b\x7b\xda\xdc\x26\xca\x71\x93\xec\xa0\x7c\x75\x41\x27\xeb\xba\xb0\x66\x1c\x83\xba\x3c\xd6\x5a\xc7\x41\x3b\xc9\xc6\x51\x14\x24\xaf\x40\xa1\x29\xf0\x2f\xcd\x11\x69\x32\xcc\x75\x58\x71\x9b\x8d\xc8\x77\xae\xad\xce\xec\x11\x35\xbe\xb5\xb1\xbd\x59\x15\xfa\x61\xc5\xad\x4d\x96\x66\x67\x8f\xd2\x9c\xb7\x59\xd8\xfd\xfa\x4a\xb6\x94\x70\x0f\x2b\x3e\x8f\xc4\x3d\x2c\x90\x90\xca\x4d\x0e\x77\x49\xbe\xee\x09\x67\xeb\xa2\xab\xe7\xc8\x97\xa0\x8b\x39\xa2\x6f\x73\xb9\xf0\xe3\xe1\x51\xf9\xf4\xe0\xb1\x59\x44\x39\xc1\xf0\x19\x6e\xa7\x6e\xf8\x6d\x2c\x46\x20\x65\x0d\x55\x70\x4b\x13\xb3\xc9\xf5\x01\xae\x3f\xb0\x45\xc2\xfb\x47\x21\x4d\xb7\x25\x3b\x0c\x49\x8b\x8c\xe9\x0e\x6d\x22\x58\x02\x61\xdd\x70\x17\xf0\x56\x4d\x4a\x09\x18\xe8\x90\x6d\x40\xab\x10\x81\xf2\x73\x5c\xc7\xa5\x54\xeb\x96\x51\x25\x6d\x57\x3a\xc0\xbe\x7e\x75\xa9\xe5\x5f\xf2\xaf\xc6\x01\xfa\x0f\xcc\x72\x1c\x9b\x09\x76\xb9\x5c\x6a\x54\xaa\x3c\x78\xbc\x61\x22\x3f\x01\xf7\xb1\x34\x1b\xe1\xdd\xa4\xe3\xa3\xf6\x83\xdf\x87\x07\xd6\x81\x3d\xed\x77\xe0\xdb\x0d\xea\xc5\x88\x98\x3c\x46\xb7\xdc\x1e\x5a\x0d\x90\xda\x66\xdb\x4a\xad\xa4\xe2\x78\x0e\xfb\xbf\x0d\x6a\xd2\x80\x04\x85\xfb\x26\xf5\xca\x92\xfa\x26\x7d\x54\x77\x3b\xa8\x7b\xb5\xef\xa6\x5c\xfa\xf1\xf6\xce\x93\xee\x61\x24\x05\x82\x4b\x19\x48\x96\x58\x14\xdd\xf7\x4a\x7e\x0c\x3b\x41\xdd\xf5\x6a\xca\xaf\x3f\xc9\xe5\xbc\xc5\xaa\x9e\x96\xb1\xed\x09\x19\x0b\xc0\xb9\xee\xac\x6b\x2d\x83\x76\x14\xf4\xf6\x92\xb4\x2c\x2b\x86\xac\x4f\x91\xbf\x41\xa6\x58\x35\x9d\xbd\x68\x1c\xcb\x02\xf6\x03\xe1\xa3\x4f\xb4\xa2\xfd\x56\xb3\x56\xa9\xb8\x0e\x55\x8c\xa1\x7c\x4c\x5b\xec\xd5\x18\xcd\xeb\x9d\x6d\xb1\x97\xd3\x68\x5e\xc9\x90\xbf\x6e\xae\xf3\x72\x52\xee\xf8\xe3\x43\x17\xb3\xe0\xce\x96\x19\x04\x35\xad\x43\x45\x4e\xfa\x9c\xa6\xf3\x28\xd5\xb6\xfd\xee\xc9\xfc\x3f\x4c\xb9\x45\xe7\xe5\x7c\xa4\xf5\x6b\x58\xc2\xd2\x07\x16\x8e\x45\xda\x5c\xcf\x2f\xac\xae\x12\x5e\x33\x9e\x69\xc6\x2f\xf9\x14\x8d\xe9\x83\x16\xe5\xde\x78\x73\x3f\xbc\x7a\xeb\x73\x0e\x2c\xd6\xd8\x16\x02\xd7\x3b\xe2\x69\x75\xc6\x9f\x31\xfa\x92\x0e\xb6\x65\x7d\x49\xb9\xb0\x41\x4a\x44\xb4\xbd\xea\xa4\x63\x40\x87\x48\xf0\x75\x5f\x57\x5b\x33\x29\xf4\x7a\xf5\x6b\x36\x8c\x9c\xa8\x9a\xed\xee\x23\x73\x15\xea\xe3\x77\xe6\x86\x49\xc9\x4f\x10\x01\xcd\xe9\x6c\x20\x86\xbe\x06\x5f\x17\xf6\xe7\x46\x63\xd3\x47\xba\x4a\x0e\x03\x4e\x85\xfd\x2e\xac\x7f\x98\x45\x0d\x24\x0f\x02\xf8\x03\x95\xff\x82\x2d\xc9\x40\x58\x1c\x7f\x3e\x53\xb2\x02\x1f\xa9\xb2\xf9\xaa\x81\xe6\x80\x8d\xcf\x30\xbb\xa6\x71\x6b\x09\x37\x4f\x3c\x24\x24\xcf\xbe\x04\xc1\xf9\xd9\xfb\xf7\xe7\x93\x42\x1b\xef\x1e\x5e\x64\x68\x6d\xfe\x12\xce\x50\x8d\x1b\xa4\xe8\xd8\x05\x89\xc0\xf5\xf9\x43\xe7\x9b\x4e\xb5\x86\x86\x7c\xa0\x63\x92\xce\x0b\x98\x24\xa9\x94\x0b\xd3\x36\xd1\xb1\x43\x01\xb1\x68\x4b\x88\x6f\xdf\xf5\x59\xc4\x89\xa5\x77\xea\x85\xa2\x98\x13\x25\x8a\xa2\x89\x69\x12\xe2\x8f\xf3\xc9\xad\xd5\xc7\x24\x14\x4f\x2f\x6f\x6c\x81\xa4\xaf\xb3\x89\x5b\xeb\xa6\x9e\xb2\xbd\xc8\xc5\x74\x70\x0c\x36\x01\x93\x19\x4d\xd2\x83\x3a\x31\x74\xd8\xb9\x7d\xa1\xe4\xd5\x35\x62\xdd\x99\x05\xcf\xba\x85\xd2\xe6\xbe\x8a\xd0\x0b\x1b\x2e\x06\xdf\x72\x5d\x5e\xba\x12\xe8\x62\xc0\xcb\x80\x30\x48\x8c\xf4\x17\x68\x2c\x2d\x36\xb8\x6d\x1b\x07\xb7\xe2\xf5\x73\x46\x60\xef\x9d\xc3\x29\x6a\x98\x39\xbc\x87\x41\x70\xa6\x09\x50\x79\xfb\x11\x2a\x7b\xe1\x04\x88\x0d\xef\xf1\x70\x6a\x54\x85\x38\x87\x46\xef\x4a\x47\x89\xf9\xbe\x30\xc2\xbb\x90\xbc\xbf\xc9\x19\x24\xbb\x37\xf5\x13\x74\x7e\xd0\xa8\xe9\xe4\x5b\xfb\x01\x11\xe7\x07\x07\x88\xdd\xe1\x22\x3f\x28\x93\xfb\x94\xcb\xd9\x57\xde\xbc\xdd\x87\x32\x39\xea\x61\x76\xb4\xdf\xae\xa5\xa5\x89\xe8\xc2\xf5\x1a\x9a\xbd\x02\xcc\xd7\xa0\xe3\xdc\x29\xcc\x7a\x2d\x81\x09\x3d\x9c\xdc\xa0\x86\xa1\xac\x15\x72\x03\x3e\x20\x3e\xf0\x1c\x0f\xae\x15\x00\x8d\x90\x68\x48\xea\x60\x9a\xb8\x86\xf6\x4a\xde\x02\x12\x76\xf7\xd6\x9e\x77\x94\xd5\x9f\x24\xdc\x3c\xb1\x3b\x67\x54\x85\xec\x98\x0a\x52\x4b\xc1\x70\x67\x72\xb5\x05\x56\x36\x1b\x6f\x30\xff\xec\xa8\x37\x30\xb3\xad\x8f\xe3\xd6\xb3\xda\x74\xaf\x31\x43\xd0\xbb\x8a\x1b\xac\x9f\xc4\xdc\xa9\x71\xc2\x4e\x83\xe6\xad\x38\xef\x66\x25\xa1\x4c\x3c\xf2\x77\xd6\x42\x89\x19\xcb\x3f\xfd\xed\x62\x6d\x50\x6c\xcb\x31\xed\xc8\x26\x09\x5b\x6b\x01\x1e\x17\x3d\xeb\x14\x5e\xef\x56\x33\x19\x92\xc4\x27\x78\xd0\x47\x1f\x12\x16\xe6\x0d\x6d\xb2\x6d\xd7\xd5\x2a\x37\xf5\x01\x7f\x98\x6d\xa5\xac\x2c\x17\x92\x1c\x6a\xb5\x07\x7d\x08\xbe\xe4\x3b\x9c\x55\xe2\x23\x73\x72\x6b\xda\xa4\x63\xe7\xdb\xbe\x82\x88\x08\x6d\x9c\xc0\x1b\xa2\x04\xa3\x82\x7e\xd4\x48\x2f\x1a\x3f\xf5\x4b\x06\xe3\x58\xef\x28\x41\x54\x6d\x38\xc5\x29\xa7\x30\xf0\xd6\x03\x3e\xa8\x67\xc9\x95\xac\xd0\xbb\x6f\x01\x2e\xdf\x7a\xb6\x9d\x4d\xba\x77\x3d\xbd\x51\x1d\xd4\x9a\x5a\x03\x11\x32\x9e\x65\x1d\x53\x9e\x1c\x29\xd0\x81\xe2\x2b\x2b\x41\x11\x72\x19\x27\xc7\xee\x24\xf4\x8b\x91\x3c\x3f\x19\x3b\xb7\x0e\xd5\xb7\x66\x4e\x72\x86\x38\xa6\x5c\x69\x65\x39\xe7\xf0\xa8\x69\x5c\x91\xf7\x6b\xd1\x32\xd8\x1b\x32\x12\x06\x42\x6c\x3f\x66\x78\xba\xba\x15\x81\x5b\x4c\x08\x0e\xf4\x1f\xa0\xa8\x86\xd1\x81\x7e\x37\x65\x20\xcb\x2c\xd6\x1b\xe9\xf7\xee\xb7\x7e\xcc\xb0\x34\x40\x5a\x8b\x84\xec\x04\x8e\x86\x80\x0c\xd0\xa9\x41\x8f\x64\x45\xda\x04\xb7\xc7\x4f\x49\xfa\x28\xb9\x50\xcc\xdf\xbb\x5c\xfa\xbc\xf9\xe5\x9c\xa4\x08\xf3\xd5\x7c\x4f\xa7\xe1\x6b\xba\x30\x93\x92\x4d\xb7\x42\x15\x4d\xe8\x6f\x69\xc3\xd3\x66\xb5\x6a\xd6\xcc\x30\xcb\xed\xac\x9b\x12\xd9\x47\x84\x07\x56\x05\x9d\xea\x07\x0e\x4f\x4f\xa2\x10\xd3\x1e\x93\xeb\x54\xa2\x07\xbe\xdf\xcd\x4c\x2a\x9b\x8a\x8e\xb1\xb8\x92\x35\x12\xe0\xd1\x41\x6c\x6d\x34\xd0\x2c\x57\x95\xc7\x8f\x1b\xd0\xf0\x1e\x3d\x69\x02\x9f\x58\xad\x7e\x41\x27\xf5\xda\x70\xce\xa4\x89\xf5\xb5\x05\xb8\x58\x56\x2c\x9f\x3f\x46\x9d\x05\x1e\x73\x0a\xbc\xb0\xd6\x7e\x5d\x41\x3f\x2b\x26\x48\x4b\x39\xe0\x72\x29\x2d\xd4\x02\x8f\x99\x0e\x9d\x70\x86\xf3\x53\x0e\x60\xa1\x56\x0f\x4c\x2a\x9f\x71\x7d\xc5\x82\x6a\x70\x24\x63\x5f\x27\x67\xbf\x7d\x73\xae\xa7\xc2\xb7\x7e\x63\x8c\x0b\xfd\xe9\xf9\x79\xb0\x8a\xae\xf3\x05\x9a\x3b\x4a\xdb\x32\xda\x84\x71\x72\x8e\xd8\x00\xb1\x33\xe2\xc7\x1d\x50\xe7\xd8\x32\xb0\xa6\x9b\x37\xf4\xee\x4c\x0f\x14\xb7\xd8\x76\x5c\x6a\x6b\x5f\x78\xfb\x3a\x68\x86\xd6\x4a\x25\x9d\x05\x89\x1d\x20\x0c\x2d\x12\x91\x0d\x87\xf1\xba\x31\xb2\xf8\x4a\x6e\xf0\xf6\x55\xde\xb4\xc1\x22\x34\x6d\x9d\x23\x71\x1f\x25\x3c\x3c\x37\xd2\x60\x87\xb1\x6f\x45\xdb\x0b\xb7\x84\x23\x7a\x4e\x95\xe7\xc4\xbf\x4f\x73\xac\x6e\x55\x0b\xe9\xd7\x96\x92\x32\xaa\xd2\x7f\x49\x2a\x37\xcc\xad\x8e\xbd\xd5\x57\xf8\x1e\x34\xdc\xd7\xdc\x60\xd6\xbd\x95\xa6\x73\xf8\x18\x6d\xbb\x66\x7d\xa2\x69\x9a\xe5\x31\x52\xf6\xa2\x88\xb0\x4a\x5f\x96\x2d\x37\x6b\xa1\xc9\x53\xe8\xca\xb9\xb3\x07\x87\xc4\x34\x01\x1b\xa5\xfb\xcb\xd9\x55\xbb\x06\x7a\x7f\xd1\x9c\xeb\x20\x3d\x22\xd4\x83\x6c\x83\x6f\x5a\xaf\x30\xb1\x59\xd0\xd7\x39\xb4\xe5\x04\xc6\x43\x43\x3a\x25\xa3\x26\xf7\xcc\x6f\x02\xde\x3e\xce\x35\xcb\x31\xd3\xd7\xdf\x16\x1d\xb7\x86\x70\x11\x2e\xfe\xd4\xdd\xd4\x25\xa7\x3d\xb8\x18\x41\x8e\x32\x36\xdb\x95\x0f\xa8\x91\xba\xa9\x32\x50\xe2\xd6\xc1\xfa\x07\xb8\xc8\x38\xb5\xa4\xa0\xa1\xa1\x63\x35\xb2\xcd\x7f\xac\xd3\x86\x73\x23\x23\xdb\xbc\x42\x00\x0b\xd4\x39\x07\xdc\xe0\xe3\xed\xf2\x7e\x52\xdd\x3c\xb4\xab\x02\xb1\xe3\x69\x37\x55\xa1\x77\x55\x1f\xf4\x40\x1b\xe7\x4d\x54\x2b\x4e\x65\x7c\x2b\x9e\x2d\xf5\xf3\x7e\x14\x48\x6a\xf9\x5c\x64\x90\x45\xdd\x29\x5a\xc0\x09\x2c\x76\x92\x07\x2e\xdb\x40\xf3\x3f\xb7\xf9\xd2\x8e\x74\xc2\xbe\x8f\xa5\xd2\x80\x87\xdd\x56\xcd\xc0\xe6\xe5\xfa\x27\xe3\x7a\xd6\x06\xf5\xe6\x2a\xc1\x23\xb2\x06\xb5\x85\xbd\xa7\xbf\xca\xc3\xb7\x8e\x9b\x78\x6b\xcb\xe9\xde\x89\x44\xcc\xa1\x45\x09\x61\xef\x49\xe1\x4a\x0b\xe5\x83\xad\x32\xe6\x33\xcc\x6c\x1e\x4c\xda\x13\x30\xba\xfa\x1b\x1c\xe4\x3b\x25\xcd\xa0\x72\xdd\x07\x36\xf4\x60\xb9\x0c\x1a\xb4\x4d\xef\x94\x81\xcd\xcf\xa5\x26\x90\x8b\xb2\xdb\x70\xf4\x60\xaa\xc2\x1c\x1a\x49\xcd\x40\x07\xf9\x18\xfc\x7d\x74\x1c\xbc\x3a\xaa\x76\x68\x4e\x2c\xe1\xb7\xe1\x37\xb0\x04\x9a\x61\x16\x30\xa0\xa1\x32\x75\xcd\xc0\x40\xd5\x05\x13\xfc\xa4\xfb\xe6\xfd\x5e\x1f\xb5\xc8\xe5\x48\x3b\xff\x7f\x61\xb5\xe0\x12\xc5\xd7\xe2\x6c\xc5\x34\x28\x97\xa4\x7d\xec\x89\xe8\xff\x7a\x65\xc5\x90\x9e\xdf\xe8\x67\xaf\xa7\x36\x8a\x54\x30\xc8\x45\x76\xf9\xfc\xb7\x1f\x7e\x78\xff\xa7\x3f\xfd\xf6\x87\x3f\x7f\xff\x95\xc3\x85\xe8\x9f\x49\x0b\xf6\x3f\x7a\xf5\x3f\xf8\xeb\xfd\x8f\xe9\xd1\xbf\x8d\xff\x30\x62\xbf\x83\xbb\x21\xf9\x52\x18\x52\x27\x11\x92\xde\xca\x80\xff\xfd\x27\x27\x24\x95\x40\xd6\x29\xd3\x1c\x0d\x60\x19\xb0\xbd\xf3\x34\x2b\x75\xc1\xb6\x79\x9d\xfe\x52\xd5\x4f\x9b\x75\x5a\x6f\x2d\xb3\x05\xc7\x62\x6c\xbb\x21\xd7\xf3\x4e\xae\x39\x0f\x60\x53\xe8\x11\x0c\xe4\xd0\x2e\x8f\x39\xf5\xc2\xee\xae\xe0\xcd\x60\x7a\x15\x8c\x66\xdf\x54\xed\x3e\x14\xf6\xcd\x12\xf5\x84\xf8\x30\x8b\x3d\xaf\x5a\x0b\xf1\xfb\xeb\x99\x00\x2b\xe1\x5d\x00\x45\xe0\x47\x79\x3d\xc4\x4e\x3a\x3e\x56\xf0\x5e\x4a\x76\xd3\x08\xb2\xf4\x29\x09\xa0\xba\x05\x67\xd1\xc2\x36\xbf\xf5\x59\x39\x4b\xaf\xd2\xfa\x3a\xaf\xe3\xa2\xe0\xa4\x49\x9d\x86\x4c\xe7\xfc\x48\xdb\x25\xec\x89\x36\x25\xb4\x6b\xaa\x85\x8a\xc0\x00\x72\x58\x91\x63\x42\x50\xb8\xbd\xc2\xaf\x00\xa6\x12\x07\x25\x75\x0b\xbc\x6b\x70\x8a\x43\x6a\x80\xdc\xe9\x35\xe0\x7f\xae\xb5\xad\x5a\xa9\xb7\xe4\x86\x02\xc3\x39\x22\x2c\x87\x36\x2e\x5f\xe2\xd7\x13\xa1\x62\x7c\x9f\x7f\x64\xef\xb4\x77\x99\x9f\x3f\x2e\xed\xde\x18\xc5\x1e\xf3\xe1\x8d\xc6\x9f\xef\x82\xdc\xba\x55\x0f\x1a\xce\x25\x4a\x4b\x3d\xf1\x36\x53\x65\xab\x05\x8e\x58\x3a\x2c\xaf\x72\x11\x05\xf4\x54\x61\xb8\xb5\x84\xde\x61\xa6\xcf\x88\x37\xef\xeb\x6e\x3d\x81\x2a\xdb\x5c\x41\x4d\x99\xb5\x0c\x02\x93\x6a\xe6\x9a\x1e\x6b\x0d\x43\x7c\x66\x38\xd6\xd8\x42\x7d\x44\xa8\xd5\x69\x29\x09\x18\x0b\xbf\x22\x24\xbe\x01\xcb\xd0\xb5\x25\xf2\x5f\x57\x60\xcc\x39\xc1\xbd\xf8\xf0\xf0\x83\x23\x1b\x3f\x67\x43\xaa\x9b\x02\x92\x8f\x03\x2c\x87\x90\xf7\x07\x43\xb5\x0b\xdd\x6e\x08\x6a\x4f\xb3\x6c\x00\xcf\xae\x06\xdf\xff\x9f\x68\xf6\x02\x17\x7d\x12\xe8\xf5\xbb\x4f\x04\x99\x99\xce\x8d\xd1\x6b\x82\xed\x70\x51\xef\x50\xe4\xdd\x9e\x1f\xcd\xb5\xdd\x86\x52\xfd\x3b\x64\x22\x36\x68\xed\xb4\xb0\x45\x8d\xbb\x94\xfd\x7b\xf1\x4d\x82\x08\xce\x2d\x6a\x41\x66\xa0\xe2\x10\x2e\x8d\x4d\x45\xd2\xac\xab\x63\x88\x8e\x18\xc0\xf9\x40\x79\x9a\x2a\xd3\xb7\xe8\xa0\x9d\xb7\x9d\x10\x46\x47\xfa\xf4\x63\xdf\xe1\x7c\x33\x90\x50\xa9\x0e\x94\x2e\x0e\xdf\xa1\x96\x94\x2f\x06\x2a\xb6\x2a\x8d\x92\x89\xc9\x29\x8e\xc8\xb3\xf1\x6d\x6d\xf3\xcd\xcd\x71\xa6\x74\xf9\xd6\x30\x8a\xeb\x23\x2b\xd5\x58\x51\x4b\x31\x5f\xfc\xe7\x6a\xdb\x2c\x23\x82\x89\x85\x02\xbb\x8e\x61\xfe\xcd\x23\xd3\x94\xc2\x82\xab\x0a\x2c\xca\xc8\x67\x97\xc6\x83\x21\xfd\x39\x06\x6d\x3d\x65\xba\x0a\x87\x61\x82\xba\x81\x7e\x65\x29\x57\xa6\xb1\x54\x41\xdd\x5a\xc4\x76\x67\x0d\xd4\xe0\x35\xf8\xef\x0c\x10\xd9\xf3\x1b\x71\x2f\x50\x44\x4f\xf3\x71\x22\xe3\xc7\x01\x73\x1e\x67\xaf\x7c\x33\x15\xc1\xa9\x16\xd6\x9b\xc0\xc1\x1a\x15\x49\x52\x0b\x3a\x5c\x5f\x33\xbd\x90\xfa\xbf\x4d\x81\x8d\x44\x7d\xe3\x32\x1b\x7a\x54\xcc\xf5\x16\x65\x23\x84\x1b\x8e\xbf\xbf\x2c\x52\x8b\xe9\x43\x14\x8b\xeb\xde\x13\x96\xfa\xa7\x4a\x6e\x5e\xd0\x06\x73\x01\x77\xd9\x4e\xaa\xb9\x69\x47\x61\xf7\xc7\x04\x96\x85\xde\x4b\xb1\xfe\x20\x5e\x7d\x62\x47\x20\x3b\x44\x44\xe0\xed\xcc\x09\x29\x17\x38\x3e\x0d\x4d\x9a\xab\xf5\x39\x90\x32\x1f\x76\x36\xf2\x36\x6e\xca\xa4\x93\x38\x38\xfb\x2f\xa6\x9f\xfd\x92\x1e\xf0\x19\x69\xb6\x65\x39\x64\x6f\x5e\x3e\xae\x6a\x1e\x73\x32\x6e\x81\x72\xe9\x2b\xe8\x03\x95\x9b\x9c\x7d\x05\xe4\xe0\xf9\x00\x79\xcb\xa9\x06\x27\x80\x9a\x15\x8c\x18\xae\x95\xd1\x01\xba\xac\x32\xcf\x42\xe8\xc8\xf4\x4a\x92\xd3\x1a\x35\x02\x4b\xc4\xf1\x67\x2d\x14\xb7\x47\xcf\xcd\x88\xfe\x3b\xea\xa8\x31\x10\xa1\xb8\x1a\x3f\x70\x87\xa1\xc2\x93\xf3\x0c\x4f\xe8\xec\x36\x77\xe8\xbe\x7a\xe3\x45\xfd\x18\x26\xec\x65\x99\x4d\x34\x61\xcb\xeb\x49\x6a\xe2\xe3\xa7\xf1\xc5\x27\x78\x57\xc9\x19\xbb\xd2\xa6\xde\x0f\xdf\x0d\xf2\xb7\xd7\x4b\xb3\x1f\xbb\x0a\x7f\x56\xcf\x06\x49\xdf\xc1\x93\xe4\x9a\x8f\x34\xa7\x40\x5e\x7c\x6e\xc4\xef\x59\x26\x17\x17\xfd\x4d\x94\xe2\x41\x47\xe1\x51\x03\xa0\x72\xac\x1a\x87\xbd\x2c\x8e\xd5\x39\x14\x28\xc7\x85\xee\x41\x47\x2c\xdd\x5e\x8e\xf0\x11\x5f\xf5\xa0\x4e\xf8\x41\x10\x34\x60\x4a\xb5\x43\xae\xcd\x90\xe9\xb8\x1a\x9a\xe6\xac\x47\x10\x3a\x84\x1c\x98\x68\xef\x5f\xc4\x8d\x02\xe5\xb7\x7e\x59\xb5\x9e\x9a\x85\x4c\x59\x0d\xd4\xfe\xd0\x0a\xdd\xd0\x42\x71\x0b\x0d\x47\x7d\x87\xf6\x4d\xe2\x47\xf0\x6b\x44\x0b\x7e\xb4\x8c\xe8\x6c\x01\x38\x12\x2c\x08\xcb\x33\x46\xcb\xe0\x3b\x8c\x72\x2b\x20\xfb\x4c\x4e\x85\x90\xf9\x89\xc1\xce\x7d\x34\xd5\x72\x98\x06\x12\x21\x0d\x5a\x96\x9d\xee\xb2\x31\xb2\xda\x76\xdd\x1c\x08\x35\xa6\xba\x55\x0e\xd0\x56\x17\x2c\x68\x51\xc4\x32\x0a\x94\x68\x6b\x8e\x25\xd3\x42\xaf\x6a\xdb\x7e\x45\x07\xd6\xf6\xa8\x8e\xa1\xe1\xb0\xaf\x2a\x3a\xa3\xf9\xf7\x64\x3a\x62\x65\x2a\x67\xfe\xbd\xb8\x3f\x88\xd8\x12\x41\x84\x63\xe8\xad\x48\x1d\x45\x3a\x48\xf9\xf7\xa4\x2c\x4f\xe6\xc4\x6c\x71\xc8\xa8\x29\x74\x9e\xf3\x73\xa9\x65\x47\x67\x6a\x87\xae\xd4\x14\x95\xd9\xfb\x94\x63\xc0\xe7\xc1\x59\xc6\x03\x6b\x83\xa4\xc2\x24\x6b\xa3\xab\x27\xd9\x17\xdf\x3c\x90\xe0\x7c\x58\xc3\xba\xef\xed\x07\x72\xf4\xf6\x1d\xb4\x7f\x72\x0c\xaa\xb6\x5d\xcf\xbc\x80\x98\xa3\x51\x9a\x75\xbe\x94\x2c\x2c\xa4\x49\xaa\xed\xb9\x20\x15\xe8\xf8\xef\xd6\x95\x3b\xb9\x0c\xd7\x3f\x2c\x97\x89\x40\xf7\xb7\x02\x88\x26\x57\xf6\x8f\xa6\x8e\x9b\x58\x46\xdd\x2d\x97\xa1\x3f\x09\xe6\x57\x06\x13\xaa\x89\xaf\xa6\x3f\x5b\x28\xfa\x19\x7c\x73\xd7\xce\x56\x1b\x56\x15\x45\xc7\xbc\xd0\xfe\x17\xe4\xba\x1b\xfe\xc1\x24\x25\x0c\x4a\x51\x2b\xe3\xde\xa7\x60\x18\x1a\xac\xb2\x79\x7d\x4f\xb5\x3c\x01\xaf\x76\xbf\xc8\xd2\x31\x9e\x22\x91\x0c\x80\x02\xc8\xd9\x6c\xf2\x9f\x93\x2d\xad\x1a\x92\x52\xc0\x9e\x81\x78\x88\xfb\xfd\x74\xc8\x96\xb0\xae\xe4\xd2\xe4\x53\x7d\xf3\x7a\xe1\x50\x66\x60\x93\x71\x95\x5e\x72\xcf\xa6\x65\xed\x81\x5e\xa9\xcb\x33\xb1\xcb\xee\xa1\x4b\x29\x89\x4d\x5b\xdb\x12\x75\x89\x94\x98\x90\x4e\x60\xc7\xf2\x27\xb5\x0f\xb1\x89\x35\xf6\xd8\xe6\x98\x4e\x06\x4b\x82\x0f\xc5\x52\x99\x96\x7d\x5e\x2b\x10\xa9\x8e\xe1\x48\x86\xe5\x02\x99\xd0\x77\xb5\x45\xab\x61\xef\xda\x03\xb4\x46\xfc\xc2\x0a\x63\x7b\xc5\x8f\x79\x11\xf1\x06\x8a\x45\xaa\xe4\x85\x87\x48\x35\xf7\x3a\x8b\xba\x13\xb6\x7f\x26\x3a\xa8\xbb\x39\xb7\x17\x15\x55\x21\xb9\xc9\xc6\xb5\xa8\x47\x23\x95\x83\x14\xba\xfa\xc9\x17\x3d\x52\x32\x2c\xa0\x8c\x16\xe1\x45\x17\x05\x94\xed\xa9\xca\xe6\x34\xd8\xd1\x85\x32\xf3\xf4\xd7\x6b\x76\x12\xce\x3f\x9c\x3f\x53\xf7\xb9\x20\x11\xe5\x91\x50\xf2\x38\x6e\xa6\x18\x76\x56\xb6\x90\xfd\x8a\x4d\x2f\x1b\x3d\x7b\xdc\x16\x43\xbf\x1b\xab\x0f\xd6\x6c\x31\x68\x58\x03\xd7\xc0\x5e\xab\xe3\x9e\xc1\xeb\x38\xb8\xf4\x6b\xd4\x25\x55\xd5\x06\xaf\x2b\xd9\xc0\xb3\x0f\x6f\xaf\xfe\x78\x3e\x29\x1f\x78\xab\xe4\xfe\xab\x13\xb2\x8e\xd3\x42\x12\xe7\x39\xbf\x6b\x76\xf0\x36\xc1\xf0\x2b\x14\xb8\xd7\xce\x96\x55\x30\x14\xf3\xbe\x33\xb5\x5e\xaf\x44\xc4\xb5\x74\xd9\x84\xe8\x45\xdb\x19\x2b\x26\x8f\x51\xad\x17\xb9\xee\x01\xd3\xb3\x0d\x71\xa9\xd5\xeb\x7b\x8c\xf7\xdc\x89\x23\x30\x47\xdb\x0a\xc8\x07\xbb\x9e\xb8\xc6\xbe\x8a\xe8\x3c\x68\xd7\x47\xb6\x79\xda\xae\xa5\x3e\x73\xae\xdb\xad\x19\x80\x20\x48\x15\x38\x1e\x75\xd8\xb6\x28\x46\xaa\xc5\x1d\xd5\x9b\x20\x79\x25\xad\x4f\x00\x5c\x66\xa6\x44\x54\x52\x72\xc0\x0a\xd1\xd5\x76\x2d\x52\x22\x24\xcc\x1c\xf7\xea\xbf\x48\xe8\xff\x7c\x8c\x62\x82\xfd\xc8\x6d\xd0\xfe\x00\x77\x4b\x61\x4b\x7c\x4e\x1b\xf6\xa7\x5c\x10\x58\x56\x30\xda\xb4\x5e\x84\xf0\x70\x92\x71\x84\x70\xd6\xc7\xf5\x1c\x80\xe1\x96\xd2\xb7\x5e\xfb\x9d\xea\x14\x19\x08\xf7\xe6\x59\xf2\x25\x38\xb0\xf5\x97\x62\x1c\x85\x9d\xc6\xf0\x70\x92\x98\x17\x17\x4e\xc2\xe0\x61\x17\x4e\xe0\x0c\xc0\x7f\xde\x1c\x02\x79\x0e\x3e\x7a\x71\xf8\xd1\xcb\x69\xb1\x3b\x38\x55\xc7\xe3\xd6\x97\xff\xff\xc5\xad\xa7\x11\x43\x31\xee\x44\xbe\xb9\x22\x75\xba\xb1\xb1\x6a\xe9\x4d\x20\x01\x68\x44\x16\xa4\xcb\x88\x80\xa7\x45\x21\x0f\x1f\x4a\xe0\xc2\xc6\xc2\xd6\xd6\x1c\xb0\xf7\xdc\x36\xc5\x15\x33\x9a\xa9\x55\x5c\x5f\xf2\x18\xa8\x08\x91\x90\xc1\x1c\xda\x48\x00\x5d\x30\xcc\xaf\x42\x4b\x21\x68\x6e\xa6\x75\x76\x6e\xb4\xdb\x87\xb6\x1f\x25\x2c\xc7\x91\x22\x5e\xa8\xdb\xb7\x24\xbb\x2c\xb3\x3b\x12\x9f\x7e\xa9\xc4\x2a\x00\xa4\x4e\xc4\x50\xdc\x9e\x1c\xf7\x42\xb7\x62\x41\x57\xe0\xfc\xd1\x73\xcf\x7f\x52\x88\xcc\x58\x68\xfb\x0e\xd4\x48\x57\x72\xce\xd7\xe8\xaf\xe8\x70\x34\x0e\xd8\x4d\xd6\x6d\x23\xe5\xa0\xd6\x21\x5d\x02\x91\x56\xe3\x20\x20\x42\xc6\x0d\xf8\xd6\x1c\x0c\xd1\xa0\xd3\xb2\x48\x45\x9b\x81\xe8\x53\xf8\x3c\x83\x50\x5e\xd5\xb5\x74\xa3\x4c\xab\x08\x4d\x0c\xd5\xe6\xdb\x66\xa0\x2f\x20\x49\xfb\x60\x79\x27\x21\xbb\xae\xfa\x30\xac\x17\x38\x5d\xa8\x82\x7a\x88\x2c\xf4\x89\x52\x19\x7e\x4e\xbe\x2a\x73\x31\xf9\xf7\xbc\x10\x1b\x0e\xa3\x49\xa1\x85\xe3\xe3\x61\x67\x20\x0c\xd1\xcc\x0d\x5e\x62\x57\x09\xce\x6d\xc1\xd0\xcd\xec\x9f\xb9\x6d\xe0\x8b\x49\x77\xf4\x2d\xe2\x19\x7c\xaa\x71\x55\x27\x45\xd4\xdf\x7e\x2a\xd7\xfd\x3e\x50\xd5\xe4\xec\xa3\xd9\x6c\x85\x29\x67\x90\x40\x67\xb0\xfb\x2c\x79\xf4\x68\xff\x55\x54\x5d\x96\xf4\x09\x9c\xd6\xe9\xd6\x68\xf3\x89\xc8\x3d\xa7\x5d\xb6\xe1\xde\x96\x83\x43\xa2\x07\x5f\x47\xce\x64\x4c\x59\x9d\x6a\x45\x56\x18\x12\x70\xc0\x54\x29\x8f\x29\xf6\xba\xb5\xc7\xe8\x34\x7a\x41\x4e\x1d\xd1\xa3\xeb\xd4\xe7\x17\x7b\xd5\xbf\xd5\x82\x8b\x57\x35\x78\x2c\xdd\x1a\x7f\x4e\xc1\x4a\x31\x0b\xe3\xe3\xb9\x24\x07\x18\x8f\x9d\x5a\xb4\x3d\xfa\x7e\x6c\x36\x55\x66\x5b\x99\xf3\x80\xe7\x77\xa8\xe3\x72\x75\xe4\x43\xeb\x10\x87\x46\xae\xc8\x30\x2a\xf8\xb6\x14\xf6\x8c\xe2\x4e\x33\x16\x27\x43\x01\x5d\x00\x39\xc7\xeb\xb5\xda\x46\x9d\xc7\xed\x0b\xb4\x81\x4e\xd7\xa9\x2b\x8e\xbb\x9b\x68\xa3\x9d\xf7\x13\x7b\x10\x98\x0d\xb9\x32\x03\xc9\x9c\x61\x46\xb7\x11\xca\x07\x90\x8f\xc8\x0a\xf9\xf9\xf1\xe1\xe0\x54\xcb\x5e\x7c\xaf\x99\xda\x38\xb6\x08\xa6\x41\xf9\x98\xac\x07\x43\x70\x7c\xa1\xc7\x09\xd9\x1b\x74\xa8\x22\xe5\x82\x86\xe9\x7c\x6f\xf8\xe0\xed\x43\x82\xfe\xdd\x51\x6a\x01\xff\x6e\x28\x37\x11\x7d\x88\x6a\x2f\x92\x60\x82\xba\x12\x1b\x26\x97\x84\x91\x1c\x69\x78\x8f\x8c\x3b\x52\x22\x23\x91\xb2\xe8\x44\xc7\x4b\xf9\x17\xbe\xa4\x8c\xc7\xf1\x53\x98\xc5\xb5\x9c\x83\xa2\x36\xec\xc8\xfd\x08\x82\x7f\xa0\x04\x3b\x74\xbe\x8e\xcd\x69\x44\xfe\x93\xd1\x37\x20\xff\x27\x41\x9b\x49\x01\x6c\x92\xf7\xca\x48\x33\xcd\x81\xf9\xee\x41\x99\x30\x1f\x93\x77\xe3\x20\xb7\x3a\x81\x96\x52\x3b\xcb\xc3\xe9\x40\xef\x1f\x47\xd6\xc3\x32\x11\x81\x4f\xa6\xa8\xde\x9a\x8a\x8b\xd3\x58\xc2\x30\x11\x7a\x95\x2b\xab\xd3\xa5\x48\xfe\xa7\xea\x1c\x34\x5b\x49\xfe\x1c\x93\xff\x22\x0a\x8f\x90\x46\x1f\xa3\xae\x4c\x4f\x04\xf9\xfa\xac\xd1\xe2\xe7\xec\x98\x0f\xb6\xdf\xcb\x5c\x55\x4a\xdb\xf4\xf0\x68\x0b\x0f\xac\x38\xa8\x88\x45\xf4\x4e\x63\x28\x27\x3a\x6b\x8a\x60\xb7\x8b\x0a\x9b\x4c\x33\x18\x08\xac\xcb\xed\xf0\x65\x8b\x73\xcf\x97\x4e\x67\xdd\xf7\xeb\x70\x7c\xea\x3d\x37\x12\xc3\xd9\xb1\xe9\x37\x3a\x32\x3a\x60\x6d\xc0\x6c\xaf\x85\x16\x99\xed\x20\xea\xd1\x52\x0a\x61\x44\x23\xd6\x6d\x5e\x1b\x17\x26\xdc\x18\xa0\x3e\x37\xe3\xe1\x51\xcf\x10\xa1\xfd\xf6\x42\xa8\x86\x85\x89\x94\x5e\x78\x4a\x0f\xb4\x71\x02\x71\xf1\x42\x6d\x0b\x16\x29\x0e\x53\xcc\xa5\x1f\x6a\x61\x5c\x26\xbb\xd7\x01\x40\xf2\x45\x99\x68\x1a\xcb\xc4\x65\xe5\x0e\x22\xfa\xba\xff\x2d\xe0\x45\x6a\x1b\x2b\xf0\x44\xd8\xbc\x1a\x35\x80\x95\x2b\xfe\x78\xb3\x4a\x8f\xbc\x52\x57\xda\xce\x59\x35\x8a\xcf\x5d\x82\xee\xa2\xee\x10\x97\x77\x91\xbb\x29\x59\xa5\xb7\x69\x9b\x6e\x11\x9d\x9f\xcc\xd8\x76\xab\x5c\xd2\xab\x51\x81\x74\x4f\x37\xb1\x9f\x8d\x1c\x10\x37\x01\x5c\xc9\xd6\x7e\x23\x81\x83\xb5\xbf\x90\x82\xe1\x2d\x02\xb7\xbd\xaa\xa7\x00\x6b\x3a\xec\xa8\xb0\x89\xa8\x03\x2e\x0b\xe9\x0c\x1d\x96\x40\x31\x11\x9a\x48\xd0\xdf\xfb\xe2\xa5\xe1\x62\x6c\x1b\xb5\x59\xa6\xc2\x3f\xa5\x91\x14\x49\xfa\xcc\xa0\x7b\x85\x41\x55\x9a\x88\x6a\x08\x65\xb3\x4d\xe1\x5e\x39\xd2\xb1\xa4\xa9\x0a\x74\x23\xec\xea\x25\xaa\xfd\x6d\x6c\xd4\xbe\x33\x9b\xa1\x12\xd7\x39\x95\x10\xb9\x53\x29\x91\x3e\xc7\x96\xdd\x07\xd7\x45\xaa\xf1\xc1\x7d\x20\x31\x48\xee\x8a\xa5\x90\x2e\xd2\xdf\xa8\xba\xac\xb4\x9e\x5a\x07\x71\x68\x95\x00\x9f\x1c\xbd\x9d\x34\xc7\x03\x74\xf2\xb0\xce\xf7\xd7\x71\x21\x2d\xd8\x79\xe7\x92\x83\xd6\xff\x8d\x72\x91\x2e\x52\x1d\xf5\x65\x8f\x4d\x0f\x7b\x20\xfb\x71\xdd\x4f\x91\x8b\xb9\x15\x8e\x92\x34\x25\x3d\xe0\xb8\x2f\x7b\xef\xb2\xcb\x13\xb2\x63\x90\xf0\x51\xd2\x94\x46\xa0\x43\x29\x2a\x50\x66\xc9\x0a\x32\x41\x32\xf2\xf5\xbe\xc1\x3a\xd4\x55\x66\x97\x17\x98\x71\x6d\x43\x4b\x97\xec\xbe\x7d\x78\x26\x08\xa4\xa8\x82\xf3\xd0\xdb\xd2\x18\xc9\xa2\xb1\x15\xcb\x4e\xc5\xdd\xa6\xf6\x81\xc9\x16\xe9\x33\x8c\xdf\x08\xdf\x46\x61\xd2\xad\x75\xdf\x00\x66\xf3\xb4\xd8\xc0\xea\x97\x7b\xe9\x08\xc3\x10\x7a\x69\x85\xc2\x5f\x68\x66\xce\x3d\x92\x88\xae\xcf\x61\xf3\xd3\x5e\xc4\xc8\x2b\xad\x63\xb4\xd0\xe5\x10\x5a\x39\x37\x51\x21\x47\x1d\xf0\xab\xd8\xa6\xde\x73\x2b\x34\x14\x01\x54\x32\xca\xc2\x99\x43\xfc\x32\xe3\x38\xe5\x81\x2a\x8e\x63\x72\xec\xa0\x84\xe3\xe2\x39\x09\x94\x3b\x78\x97\xb7\x8c\x9b\x3d\x4e\xb6\xb7\x87\xfc\xe4\x73\x85\x1d\x0f\xfb\xb3\x06\x7b\x13\xb2\xbb\xeb\x27\x93\x33\xc1\x92\xe7\x79\x3e\x55\x46\x90\xdf\xf8\x83\xa9\x9b\xaa\xfc\x35\xaa\xfa\xc6\x12\xb6\x23\xac\x91\xa1\x57\x13\xc1\x48\x35\xb4\x35\x52\xe7\x79\xcb\x62\xce\xc0\x13\x62\x4b\xdc\xb6\x79\x82\x77\x73\xfc\x72\x7f\x73\x92\x9f\x4e\xc4\x1b\xf0\x4a\x60\x91\x94\x18\x99\x83\x43\x69\xe4\xa9\xaa\x0f\x3a\x2c\x21\xa2\xb0\xc4\x15\x90\x1e\xda\x11\x2c\xfb\xa0\x12\xc8\x02\xf3\x7b\x2d\x9d\x86\x62\x27\x71\x6f\xa7\x81\x07\x8f\xb4\x76\xfa\x18\x76\x75\x0a\xbb\x65\xd6\x99\x84\x2d\x18\xaf\xe3\x48\x72\xd3\xc5\xdf\x3b\x10\xcf\xa7\xdc\xa6\xe0\xb4\x5d\x8d\x26\x5f\x4d\xaf\x77\x93\xe5\xd5\xfd\xe7\x2c\x49\x94\x15\x73\xee\xcf\x71\x89\xed\xc9\x79\x7d\xa0\xc8\xf5\x44\x32\x9b\x49\xde\xc3\x1a\x2c\x53\xca\xf5\x39\x01\x9a\xf1\xdd\xa7\x0a\x66\x3f\x1c\x20\x23\x52\xd8\x2b\xb8\x5f\x70\x43\x9b\x6b\x53\x18\xd8\x12\x4a\x9a\x11\x00\x8f\xab\xe5\x00\x2f\x93\xde\x5c\xa5\x5d\xe2\x7f\x65\x31\xc9\x54\x48\xc1\xe2\x03\x9f\xd0\xcf\x4d\x2a\xb4\x79\x8e\x78\x68\xc1\xab\xae\x6a\x71\x55\x03\x5f\x34\x46\x3d\x35\xc0\xf6\x18\x52\x28\x33\xa0\x91\xfb\x2f\x06\xac\x66\x13\xe0\x17\xae\x9c\xbc\x0c\x88\x99\x16\x70\x61\x9a\xe9\x64\x92\x1a\xca\xe4\xe4\xb6\x2b\x6c\xb4\x49\xe4\x5c\x9b\xf6\xdd\x06\xd0\x19\xcc\x7c\xb0\xca\x7d\x1a\xa8\xe9\x68\xac\xf2\x4d\x1f\xdc\xe1\xeb\xf9\xce\x2e\xb3\x37\xa4\x23\x02\xb0\xc4\xd9\x0b\xfe\x24\xc6\x4c\x24\x67\x2f\xe9\xd3\xdb\x12\xa7\x3c\x32\xb0\xe2\xed\x1a\x15\xb8\x38\x1f\xdf\xd7\xf3\x87\xcf\x1d\xff\x7e\x72\x4b\xba\x3c\xdd\x54\xdc\xa3\x3d\x6a\xa0\xf9\x0a\xdd\x33\x1f\xd8\x36\xbf\x7d\x37\x9d\x75\x57\xa3\x1c\xe4\xe5\x53\x3a\x13\xeb\xa7\x0e\x21\xaa\x11\x47\x08\x54\x81\x2e\xa5\x11\x50\xe3\x84\xa1\xfe\x93\x8f\x03\xa4\x0b\x18\x17\x8b\xaa\x40\xf3\xdc\xbc\xe6\x26\xd1\x6a\x8e\xda\xb6\xb9\x33\xe9\xc7\x28\x70\x20\x47\x45\xb4\x97\x54\x59\x55\xcf\xe5\x41\x2c\xad\x66\x9e\x6b\x6f\x33\x4d\xea\xf4\xa8\xdb\xf4\x1b\x74\x35\x9b\x41\xc2\x0f\x38\xe0\xd6\x4a\x5e\xfa\x89\x68\x60\x03\x24\x73\x9f\x3a\x67\xfb\x66\x7a\xce\xf6\xd7\x4e\x2f\xbf\xb9\x65\x7a\xf9\x84\x78\x98\xe8\xb2\xe7\xf5\xa2\xe0\xdd\xe2\x9a\xc1\x49\x2e\xfb\x77\x8f\xe0\xb1\x33\x7e\xb6\xda\x65\x02\xaf\x4e\x85\xd9\x0d\x66\x57\x81\x03\x87\x5c\x69\x71\x28\x01\x1e\x56\x04\x9c\xc6\x61\x06\xf7\xba\x24\xf5\xc8\x0c\xc5\xb6\xe5\x72\x0e\xaa\xbc\x06\xc9\x37\x09\xeb\xbd\x1e\xcc\x41\x48\x0b\x0d\xdb\xcb\xfd\x10\x83\x1e\x77\x47\x16\x6c\x44\x3a\x95\x82\xe0\xaa\x4f\x40\xf0\xfa\x61\x09\x08\x3e\xb9\x0b\x7b\xec\xae\x8c\x73\x90\xbd\x1e\xac\x81\x7f\x7d\xab\xcb\xf0\x03\x08\xa5\xef\xde\x64\xf2\xd7\x68\x26\x7e\x0a\x95\x71\x8b\x76\xe2\xef\x72\x34\x26\x3e\xda\x4d\x7c\xc6\xed\xf2\xe8\x32\x5a\x30\x14\xb2\x23\xb2\xad\x31\x93\xd8\x80\x83\xc1\x60\x8a\xe3\xfd\x45\xf4\x9a\x9c\xec\x36\x7e\xa4\x3b\x8e\x73\xf9\x0e\xba\x8c\xe3\x1a\xf5\xbb\x8c\x1f\x34\xd1\xe9\x75\xcf\x51\x3d\xa8\x74\xe9\x4c\x77\xcc\x9e\x91\x7b\x49\x4d\xbf\xf5\x0a\xf2\xd9\x1a\x44\xb5\x83\x2d\x5d\x73\x93\xd1\xc2\x82\x46\x6f\x57\xdc\x54\x36\x33\x61\xe8\xde\xa6\x8d\xe2\x67\xa9\x4c\x40\x0e\x90\x1d\x54\x5f\x47\x1d\x0d\x06\x53\x1b\x1f\x73\xb8\x8c\x6f\xb7\x35\xf1\x99\x01\x56\x52\xf6\x22\x24\xca\xca\xdd\x30\xf6\xeb\xc3\x29\xf6\xbd\xfb\x49\xde\x5f\x91\xe6\xf5\x4d\xb5\x87\xaf\x3d\x09\xcb\x34\xd4\xec\x27\x38\xca\x7d\x75\x42\x7f\x29\xcc\x69\xa3\x71\x59\x2d\x48\xe8\xf6\x7b\xae\x6b\xc7\x75\x4d\x35\xa4\xc9\xdf\xcc\xce\x40\x12\xae\x91\xca\x81\x40\x59\x4b\xf7\x2b\xc1\x5e\x70\x90\x18\x1d\x3a\xf8\x4f\xcd\xa2\xe6\x32\xf9\x87\xbe\x96\x71\xba\x7c\x00\xd5\xf8\x47\x9c\x9e\xe4\xfb\x22\x23\x7f\xce\x9c\x4f\xf4\x4a\xcb\x00\x00\x8b\xee\x1f\xf4\x02\x51\x36\x8b\x55\x14\x8d\x2f\xa9\xd8\x65\x4a\xab\x9a\x4b\x53\x71\x88\xec\xb3\x54\x1b\x3c\x3a\xf6\x2f\x8c\xd5\x4a\x76\xb3\x01\x9d\xa8\xe4\xa7\xce\xb5\x8d\x1e\xc7\x6d\x6f\xb8\x1b\xbc\x1b\xd3\x22\xb3\xba\xf2\x60\x74\xcb\xcf\xa2\xd4\x7a\x64\xe2\x57\x15\xc2\xf4\x52\xd2\x44\xba\x9f\xb6\x83\x23\x98\xb5\x4b\xf9\xdf\x88\x41\x59\x1b\xd3\x28\xb1\xb5\xbe\x95\x6d\xbb\x48\x5f\x94\x29\x2f\x07\x0a\x01\x03\xb7\x76\x6e\xa2\x54\xad\xaf\x68\xb0\x45\xa0\xda\xac\xf0\xb0\x07\x47\xdc\xe6\x45\xcc\x73\x5a\x0b\x3d\x2e\x7a\x72\x7c\x2d\xab\x65\xda\xd5\xea\x5f\x6e\xc1\x12\xf1\x8c\xea\x0b\xa4\x4d\x54\x71\x9d\x97\x01\x41\x77\x72\xd5\x84\xa8\xa2\xa0\x60\x77\xc7\xbe\x89\x25\x77\xd5\x69\xd8\x12\x5e\x9e\xc7\x55\xcf\xdd\xf0\xa5\x87\xee\xd1\x67\x9e\x28\x27\xc8\x29\x97\x66\x99\xb3\x64\x67\x71\x8f\x9f\xe8\x51\x92\xe2\x57\x2c\xaf\x2d\x51\x3c\xc7\x9f\xf1\xf3\xa2\xa3\x43\x5e\x01\x64\xc7\x61\xb6\x15\x9f\x3b\xf8\x1e\x92\x78\xe7\x67\xe6\xbf\xf8\xe2\x6d\x90\x92\xde\x09\xb6\xf6\xaf\xb5\x7f\xf4\xb5\xff\x27\x9a\xfc\x98\x1a\x22\x09\x7d\xa0\x7f\xde\x4c\x75\x66\x24\x11\x70\xb7\x56\x73\x47\xe2\x15\x17\xb3\xe7\xac\x7e\xa4\x66\xd4\x7d\x9a\x2c\xba\x39\x99\xe7\x08\x1f\x08\x6c\xdc\x34\x6b\xd7\xd2\x27\xda\x87\xbc\xb1\xf8\x1c\xbc\x5b\x66\xeb\x29\xbc\x15\x05\xe2\x2f\x41\xdd\x48\x59\xea\x0d\x80\x26\x07\x5e\xd3\xa5\x9d\x47\x50\xd9\xda\x98\x54\x98\x0e\x90\x51\x57\x72\xee\x82\x9b\x3c\x68\x49\x2a\x88\xb7\x81\x8c\x4f\x6d\x2d\xe9\x10\xd9\xd6\x1a\x6c\xe4\xcc\xff\xb0\xa8\x96\x4b\x58\x9a\x9b\x2e\x7b\x8a\x9e\x03\xb0\x81\xf6\x8d\xc3\xc6\x5a\x18\x43\x90\x7e\x05\xce\x90\xe4\x38\x22\x18\xf2\x7d\xb6\xfd\x1a\x90\x46\x9e\x22\x5b\x9c\xe2\xe5\x1d\xd5\x99\x41\x9e\x7c\xa5\x2d\x13\x13\x4c\xa3\x25\xfd\xb1\x2d\xa4\x67\x1c\xfb\xa3\x45\x7e\x13\x76\xf1\x94\x37\x6e\xd2\xa5\x59\x75\x9c\x92\x55\xcb\x8d\xbb\x2d\x2a\x6a\x88\xf7\x09\xfa\x68\x03\xf5\xd4\xa0\xc2\xd7\xad\x36\xbd\xa1\x6e\x0f\x96\x5b\x1e\x0d\xec\x58\x07\x97\x08\x07\x9e\x99\xbc\xf1\x28\x5c\x9d\x74\x89\xa0\x12\x82\xdf\x59\xba\x6f\x9c\x24\xe2\x40\xa8\x07\x9e\x39\x9e\x02\x79\x30\x67\x57\xe9\xab\xcc\x8f\x74\x63\xea\x26\x64\x20\x01\xcc\xd4\x77\x24\x0d\x3a\x8b\xcb\xcb\xe3\xe9\x64\x48\x73\xb2\x00\x40\x6b\xda\xcd\xbc\x65\xac\x55\xda\x30\xe5\x12\x8b\x4e\x92\x95\xfa\x12\xf6\xd7\xb4\xad\x4c\xab\x37\x3f\x09\xdc\x47\x7a\x99\xeb\x98\xe4\xdd\xfc\xb4\x37\x92\xa3\x98\x59\x88\x95\x12\x50\x04\xa9\x0e\xf0\xb1\xcd\x44\x52\x57\xc8\x09\xa1\xe7\x88\x94\x19\x56\x7e\x9c\x3e\xf4\x8c\xdf\x1a\x5f\xe7\xee\x89\xca\x77\x61\xdb\xbb\xb9\xc6\x69\x9a\x28\xe1\x89\xd0\x17\xb7\xeb\x7d\xa3\x4d\x04\x69\xa4\x52\x98\x95\xf5\x29\x6e\x5e\x2c\xf9\xd3\x26\x80\xef\x72\x6e\x93\x09\x05\xe9\x0a\x98\x0d\x7d\xd3\x5d\x4a\x96\x85\xfc\x4f\x10\x8b\x97\x41\x2a\xdb\x8e\xf7\x84\x59\x40\xf2\x2d\xf0\x19\x8a\x29\xea\xaf\xc0\x13\x3f\x33\xbb\xf2\x76\xb9\xf6\x09\xa0\x60\x85\xc0\x74\x67\xbe\x18\xca\x6e\xaa\x18\x41\x42\x98\xc1\x0b\x3f\x67\x66\x57\x72\xe6\xa4\x98\xb4\xc8\x97\xb8\xd3\xb9\xb4\x78\x36\xe1\x23\xed\xea\x91\x77\x6f\x18\xd9\x3e\x51\x0e\x77\x1c\x71\x7f\x97\xae\x50\x2f\x33\x09\xde\xfa\xf6\x11\xfa\xbc\x27\x0d\xdd\x60\x09\x2e\xb1\x5d\x7f\xfe\x18\xa0\xd7\xdb\xe6\x90\xb8\x1f\x8b\xab\x16\x60\x89\xb7\xcd\x4b\xc5\x1a\xf0\x7a\x69\x97\xd2\x8e\xb6\xc9\x82\xe6\x15\x9d\x0e\x79\x32\xbb\x0d\xa6\x75\x00\x3d\xff\x32\x7b\x79\xc0\xd4\xcb\xde\x2b\x99\xcb\x01\x3d\xb5\x4d\x7f\xdc\x9d\x9f\xfa\x76\xa1\xa3\xdd\x03\xd5\xdb\x0d\x87\x8e\x5e\xde\xb2\xd2\x8e\x16\x69\x4a\xe8\x08\x07\xfd\x9a\xf6\x73\x52\xd0\xe8\x9f\xa4\x80\x27\xe2\x79\x65\xa6\x38\x53\x67\xe8\xb6\x7d\xc8\xfa\x72\x2a\x4b\x3a\x0c\xc9\x06\x8c\x9b\x93\x18\x6c\x02\xee\x0d\x02\x1b\x4f\x57\x35\xec\xea\x65\xb5\xba\xdd\xe9\xe6\xea\x33\xfa\x91\x54\xe4\x35\xd6\x9d\x14\x67\x52\xda\x28\x0d\xb3\xd6\x7b\x24\x82\x02\xce\x77\x39\xc7\xb4\x1a\x08\x72\x45\x2e\xd2\xc0\x33\x68\x44\x57\xa7\x21\x14\x5e\xdf\xb4\x7a\x2b\x80\x1c\x36\xe9\x4d\x0e\x78\xd2\xbc\x59\x74\x27\xd8\x29\x46\xb8\xe0\xef\x7f\xd9\xc6\x20\x9c\x27\x08\x6c\x7b\xf2\xe1\x55\xf6\x46\xf7\xe7\x61\x2a\x6b\xde\x3a\x04\x02\x50\x25\x2e\x3e\x46\xb7\xa3\x74\xfa\x8b\xed\x4c\xfa\x2f\x92\x88\xa0\x0e\x46\xb7\xab\x3a\xc9\x2a\xa8\xad\xd2\x88\x92\x92\xcf\x4f\x97\x22\x56\x2b\x0d\x24\x5e\x04\x6c\x53\x2e\x8a\x79\xb4\x5f\xa3\x2f\xfa\x99\x71\x2e\x99\x65\x0e\x1b\x3d\xfc\x75\x05\x9c\xba\x54\x3b\x4e\x99\xac\xde\x4d\xba\xad\xd0\xb7\x55\x71\xdc\x38\x0f\xb6\x35\x09\x09\x78\x56\xab\xd7\xd6\x7c\xa0\x83\x0e\xb6\x30\x95\x5b\x32\x56\xc1\x4d\xcb\x78\x35\xb6\x95\x10\x8d\x72\xfd\x50\xb5\x35\xd0\x09\xcd\x03\x64\xcf\x5e\x3f\x1c\xd5\xec\xab\x71\x36\x90\xc7\x2b\xa3\xbe\xf7\x54\xa5\xc7\xc8\xb4\xf4\x58\x55\xd0\xa8\x1f\xb6\x75\xba\x9f\x94\x02\xff\xe6\xd1\xaa\xa7\xd5\x8c\xd9\x5a\x4f\xd1\x26\xcb\x40\x99\x6e\x09\x8e\xb8\x6f\x64\xc1\xb6\x1c\x53\x68\x89\x40\x15\xd3\x73\x5e\x74\xe6\x21\xd8\x4a\x6e\x0f\x6a\xbb\xa2\x1d\xfb\xe5\x17\xb9\x46\x75\x9d\xee\xb5\x3c\x9a\xad\x8e\x99\x9b\xb0\x94\xf6\x33\xd9\xfe\xaa\x09\x4a\xa5\xb9\x3f\x5f\xc2\xb4\x8b\x68\x15\xa1\x58\x96\x0a\x4e\x23\x97\x6b\xec\x04\xaa\x1a\xe0\x54\x6c\x2d\x1a\x0b\x9a\x36\x06\x42\xdb\x4e\xa4\x5e\x12\x1d\xd6\x58\x5b\xca\x3b\x17\xca\xb0\x85\x75\x4e\x60\xe1\x37\xcd\x42\xd5\x41\x55\x6b\xd2\x52\x84\x96\x65\x69\x0c\x26\x74\x96\xaf\xca\x8a\xaf\x73\x57\xc2\x49\x5f\x70\xa4\xd4\xcf\x51\x3a\x59\xf9\x19\xbb\x7a\xcf\xc6\x98\xd1\xea\xce\x0f\x10\x5d\x5c\x8b\xe0\x60\x40\x51\xc8\xc0\xba\x25\x2a\x6c\x0e\xa7\xa6\x69\x13\xef\xd5\x06\x6c\x7b\x21\x1a\xbd\x19\x27\x0a\x4e\x3e\x74\xf3\x96\x19\xf4\xa2\xe1\x9e\x34\xe1\x2a\x38\xd4\xa9\x6c\x9e\x56\x38\x28\x0b\x89\x94\x4c\x46\x6f\xe0\xde\x89\x71\x3a\xfa\x0a\x21\x37\xd5\xd5\x91\x07\x59\x74\x9b\xeb\x2b\x55\xd5\x41\xc3\x29\x8c\x28\x4e\x93\x78\x7e\x98\x8b\x55\xb3\x21\xc6\x2a\xe0\x1e\xfc\xf4\x20\x01\xe9\x9f\x50\x86\x48\xbf\xcb\xf1\xfa\xc3\x21\x80\xc0\xc4\xd6\x2a\x6f\xa5\x85\xd8\xc3\x83\x71\x5f\xdf\x9d\xf3\x78\x4c\x18\xf5\xc9\x8e\xff\xab\xac\x76\x64\x8f\xac\x82\x76\x19\x07\x49\xce\x03\x96\x88\x23\xf6\x2a\x2d\x4d\x7a\x4d\x7b\x44\xb3\x7a\xba\xa3\x9b\x6d\xfb\xab\x31\xea\xae\xd7\xa4\xed\x68\xe7\xd2\xa3\x6c\xdc\x47\x5a\x83\x4b\x51\x9f\x9c\x40\x7d\x5c\x25\xa1\xe8\x00\x5a\xe7\x0b\xd0\x63\xee\xff\x83\x4a\x34\x01\xcc\xcd\x7a\xfc\x84\x0c\x0f\x42\xa0\x4c\xe9\x2d\xc8\xd6\x58\x75\x9c\x12\x94\xda\xb4\xb0\x71\xaa\xb4\x36\xd2\x1a\xf8\x18\x7a\x3b\x26\x8c\x10\x69\x68\xf7\x5b\x0e\x4b\xe8\x48\x8e\x67\x96\x04\xa3\x6d\xe1\x29\x6f\x2b\xc1\xfa\x8d\xf0\xb9\x34\x4d\xc7\xa5\x00\xf6\xe5\x5b\xd7\xd2\x0d\xf2\xd8\x37\x72\x66\x57\xd2\x76\x80\x0c\x1a\xfd\xbd\xfb\x96\xbe\x44\xda\x87\xbc\x1a\x23\x3a\x21\x58\x92\xb9\x41\x60\xac\xe9\x73\x43\x89\x84\xd5\xa5\x8f\xdb\xc6\xde\x82\x2b\x66\xbb\xad\x2b\xba\x8f\x9f\x47\xfb\xae\x0d\xf8\x5c\x77\x39\xa5\x87\x6b\xd6\x55\x8d\xd6\x99\x12\xdb\xab\x1a\x4b\x34\x80\xc3\x0e\x6c\x96\xea\x13\x67\xcc\xcb\x06\xfa\x54\x78\x54\x38\x7f\x02\x8b\xfc\xae\xae\xb6\xd1\xa4\xa4\xd7\xec\x8e\xb4\x02\xb7\x7d\xb1\xc5\x8e\x45\x66\x93\x17\x8c\x0a\x93\xda\x46\x9d\xc0\x68\x3b\xd2\xc2\x98\x68\x7c\x5a\xaf\xf0\xb7\x56\xae\xc7\x16\xb7\x2e\xc4\x92\xbb\x9e\xb7\x01\xc3\x2e\x8a\x3e\x9b\xf1\xbe\x98\xb4\x0c\x45\xf4\x44\xd4\xc6\x35\x88\xf2\x95\xe6\x0e\x2f\xf0\x75\x5a\xb4\xf1\x02\xd9\xc6\x10\x90\xa6\x3c\x9e\xcd\x8f\x8b\x74\x63\x85\xb3\x2c\xf6\x51\x14\x18\x2d\x16\x1b\x38\x07\xcb\xf6\xb0\xd6\x95\xcb\x42\xe7\x5c\x4b\xaa\x7c\xb1\x8a\x9d\x17\x87\x4c\x5a\x10\x4a\x97\x46\x1d\x21\xb7\x95\x83\x5c\x40\x46\x1a\x8a\xbb\x65\xd8\xb6\x4b\x70\x7f\xc4\x01\xd2\xde\xa2\x41\x23\xa7\x5f\x0d\x96\x9e\xda\x0e\x24\xff\xf0\xb0\xf4\x8a\x59\x5c\x27\x85\x67\x62\x4c\x42\x4d\xfe\x72\x5a\x7c\xf1\xd3\xfb\x0f\xa4\xc9\xe4\xbf\x03\xdd\x67\xc3\x82\xb7\x23\x32\x67\x6b\x1e\x51\xd6\xb2\x31\x36\x5d\x93\xa7\x85\xeb\x30\xc5\x21\x89\x49\xbd\xa6\x26\xb6\x95\x72\xfa\xf0\x9d\xb9\xe1\x72\xfa\x63\x1a\x90\x33\x31\x0b\xed\xa4\x9d\x19\xcd\xdc\x21\xad\xc5\x98\x20\x3a\xe4\x3f\xef\xb5\x0d\xf4\x75\xd4\x79\xea\xef\x1d\xdd\x69\xae\xdc\x70\x69\x43\xd8\x50\x65\xb3\xe3\x10\x8e\x92\xb2\xec\x0d\x57\x7e\x94\x95\x2a\x00\x6c\x39\x46\xe2\xc7\xf9\x21\x06\xdb\x4c\xc9\x4f\x48\x64\x32\x79\x33\xe3\x04\x6a\x29\x16\xc3\x33\xfc\x59\xb3\xc3\x8c\x0a\x48\x79\x1d\x76\xd5\x1b\xcb\x40\x54\x1a\x84\x2b\xd2\x9a\xc3\x38\x9b\x32\x27\x4b\xdd\xa0\xf3\x4d\x23\xc0\x05\xd1\x56\xf6\xf9\xbf\x21\x63\x1e\x3c\xa4\xbf\xb1\x5c\xdc\x32\x8b\x1c\xd9\x22\x3b\x03\xe4\x02\x5a\xce\x8c\x40\x7d\x96\xc8\x05\x05\xe8\x08\xbe\xea\x73\xb3\xaf\x6c\x55\x27\x56\x9b\x6c\xd9\x6b\x6b\xd0\x70\x61\x6c\xca\x35\xb1\x8e\x40\xd6\x59\x26\xfa\x3c\xa1\x93\x99\x43\x5e\x34\x34\x1d\x75\x49\x84\xb8\x23\xad\xf7\xd6\xac\xb6\x63\x33\xcc\x81\x26\xd7\x38\xcd\xb9\xd1\xfa\xd4\x25\x43\x62\x58\x49\x25\xdb\x75\x8d\x67\xc6\x2f\x26\xb8\xa1\x09\xc8\xfd\x5e\xcf\x70\x30\x15\x30\xdf\x60\x6d\x3b\x2c\xdb\xea\xe6\x1c\x54\xec\xae\x75\xb6\xda\xf9\xf4\x4f\x4c\x70\xa6\x94\xdd\x42\xc7\xdb\x6d\xba\x42\xf0\x96\x97\xaf\x84\xbf\x1b\xdd\x8a\xd6\x2c\x17\xdd\xbe\xbb\xb6\xbd\x2e\xa5\xa4\x71\x33\x0b\xb7\x86\xbc\x80\x40\xe7\x17\x12\xe9\x83\x05\xd0\xa6\x46\xf4\x24\xb6\xbb\x41\x9b\x62\x48\x2a\xb5\xb7\x10\x2b\x72\xbc\xbf\x63\x41\xf6\x0f\x22\x62\x1e\x80\x90\xd9\x45\x7e\xc9\xcd\x6f\xf3\xc5\x79\x9f\xc1\x29\x10\x2b\x57\x25\xdf\x95\x06\xcd\x0b\x31\xa3\xfa\x58\xc7\xba\x3a\x37\xa4\x45\x6d\x66\xaa\xb2\x4b\x57\xa6\xae\x1b\x17\xd7\x9d\xb8\x3b\xe0\x52\x96\xe4\xbc\xc2\x29\x60\xcb\xd9\xd4\xb4\x4a\x55\xbd\xe7\x0b\xc2\xd9\x33\xe5\xbc\x19\x66\xb1\x39\x18\xac\x90\xec\x9f\xa8\x19\x86\x94\x35\x0e\x9c\xf8\x0d\x1a\xd1\xdf\x98\x10\xf0\xc7\x93\x23\xe9\xcc\x46\xb4\x32\x2f\x37\xa6\xc5\x61\xd3\x27\x6a\x58\x1b\x79\xf3\x7c\xc3\x48\x29\xe6\x81\x0e\x89\xa0\xb5\x13\x96\x9a\x19\x4b\x92\x1e\x2c\x09\xe4\x5e\x97\x9e\xa6\x7c\x6e\x98\x72\x99\xd1\x4c\xec\x88\xbb\x86\x5e\x1c\xc2\xb7\xf9\x76\x34\x3e\x45\x6e\x97\x6b\xf4\xd8\x41\xd9\x95\x27\x99\x40\x72\xd9\x79\x5a\xff\xc3\x45\x0e\x65\xf9\x92\x99\x04\x7a\xe5\xc5\x02\xc9\x50\xde\x8a\x98\x8c\xa4\xe9\xb4\xb6\x3d\x55\xce\xfc\xda\x14\x4c\x52\x55\x79\xf4\xe5\x53\x47\xf6\xcc\xe1\xa8\x0a\x69\x5c\x69\x4b\x49\x9f\xff\x07\x29\x16\x73\x93\x16\xb6\xbf\x05\xe4\x13\xac\x79\x5a\x75\x4e\x9d\xe6\x1b\xfe\x0f\xba\xe9\x42\xfb\xd2\xfc\x07\x02\x60\xa6\x86\x67\xb2\x30\x05\xa4\x2d\x7f\x61\x69\xf6\x28\x94\x10\x40\xa9\xb1\x55\xee\xf2\x4b\x71\x8c\xfe\x03\x72\xb8\xe8\x98\xca\x83\x0d\x53\xd9\x62\xee\x78\xd0\x44\x5d\x35\xfe\x23\x99\x77\x39\x1b\xc8\xa3\xeb\xfa\x55\xc8\xe0\x25\x9c\x49\x90\xc8\xce\x21\xd1\x08\xcc\xc2\xb5\x00\x71\x13\xd7\xd2\xa6\x00\xa2\x3a\x3b\xf8\xf2\x26\xfd\x1b\x73\x1d\x74\xf5\x42\xa6\x07\xcc\x01\x59\xf3\x79\x79\xcd\x81\x9f\xd4\x11\x84\xf7\x7f\x89\x8d\x42\x1f\x09\x18\xdd\xf1\xd9\x18\x95\x66\xf8\x80\xce\xdd\xbb\xce\x14\xf7\x88\x08\x8c\x92\xa9\xde\xb9\x17\xd2\x3d\xf8\x54\xa7\xc0\x9e\xfb\xd5\xb7\xbc\x18\x11\xb9\x7a\xc9\x61\x80\x8c\xd6\x06\xd5\x75\x93\xdb\x1d\x9d\xa8\xcc\x3d\xd9\x8c\x17\xfe\x78\x9d\xee\x4a\x45\x50\xce\x74\x6a\x85\x2b\x4b\xad\xad\x79\x96\x19\xe9\x06\x7f\x88\x8f\xce\xdb\xe9\x35\x73\x01\x51\x8e\x77\xc5\xf9\xc1\xec\xba\x8e\xbf\x8d\xe8\x62\xce\x55\xa1\x58\x4f\xd6\x93\x0d\x20\xeb\xf2\xa4\x5a\x15\x69\x63\xd6\xbe\x2d\xc8\x8b\xa1\x44\xcd\x6c\x10\x9a\x9c\xf7\xc9\x92\x02\x3f\xc6\x37\x65\x00\xfb\xc8\x22\x2c\x65\x7a\xc2\xa4\x23\x2c\x17\x34\xec\x97\x4e\x29\x02\x1c\x6c\x63\xa3\xe5\x52\x65\xbf\x33\xc9\xcc\xb3\x03\x06\x60\xee\x80\x63\x81\xd1\x52\x4a\xda\xe3\xf9\x0f\xda\xa8\x91\xbd\x2b\x78\x8c\x99\xa2\x2c\xc0\x5d\xb1\xb4\x92\x0b\x89\xd8\x55\x98\x92\xa6\x91\x67\x68\xec\x46\xd1\xe4\x25\x77\xb1\x76\xf1\x9e\xc4\xf5\x0c\x56\x8f\xfd\xf8\x2e\x0c\x15\x6e\x8e\x4b\x91\xda\xac\x31\xfc\x9f\x34\xcc\x34\x8d\x7c\xf6\x36\x46\xd1\xdd\x1a\x48\xf5\xb3\x28\x0d\xa2\xfe\xcc\x47\x93\x16\xed\xf9\xbd\xb8\x01\x6e\x03\xfe\x38\xbc\x9e\x52\xa6\xea\x62\x74\x2c\xcc\x73\x20\x92\x0b\x0e\x8e\x68\xf7\x61\x66\xdb\xda\x56\xd7\x20\xef\xd0\x95\xf5\xa2\x07\xf0\x53\x71\x91\x18\x18\x16\x8c\x76\x8f\x9f\xed\x68\xe5\xdb\xc1\xdf\x91\x94\x13\x14\x93\xe3\x9b\x07\x59\x87\xe5\xc8\xed\xa5\x4a\x3d\x56\x11\xbe\x8b\xe4\x7f\x75\x68\xce\xcc\x4b\xb0\x45\x2c\x0c\x2b\xd0\x25\xd2\x01\x72\x41\xf8\x9d\xb6\xa6\x90\x96\x6f\x62\x13\x02\x2b\x05\x32\x32\x35\x33\xa3\x36\x3d\xad\x26\x79\x78\x2b\x9b\xf8\xe1\x9f\xd3\x66\xac\x8a\xfd\x76\x1d\x80\x19\xd1\xab\x82\x33\x5b\xdc\x3d\x7b\x16\xb2\x3f\x59\x2a\xfe\xf4\x60\x6d\xa6\xde\x8d\xae\x68\xf2\x01\xf2\x8b\x97\xbf\x26\xf9\xc5\x5d\x41\x4f\x43\xfc\x5a\xa3\x34\x6b\x47\x95\xa4\xcb\x62\xe2\xaf\xdc\x89\xaa\xdf\xc8\x60\x50\x75\xba\xa2\xb7\xb4\x6b\xa5\x5e\x99\xf1\x7a\xa3\xca\x42\x15\x8a\xab\x08\x58\x58\xce\xb5\x43\x3d\x9c\x86\x1c\x6f\x83\xd9\xb1\x63\xe5\x4c\x21\x35\x68\x1a\x19\x44\x0e\xc3\x15\x33\x8c\x22\x4c\x08\x78\x74\x29\x88\x19\xcf\x0b\x66\xf5\x24\xb9\xe6\xf9\x2f\x15\xd3\x36\x89\xf8\xd7\xa2\x41\x37\xaf\x30\xf1\xd0\x89\xff\x2e\x7c\x34\xc2\x33\xe7\x14\x1f\x7d\xbe\xad\x1a\xa9\x0e\xb1\x7a\xd9\x16\x1c\xfa\xc7\x1e\xe6\x20\xbe\x69\x95\x8a\xe2\xda\xf0\x9f\xf4\x75\xd4\x9d\xb7\xc1\x6a\xda\x13\x76\xc0\x5d\x83\x01\xc7\x79\x3a\xd3\x55\xd7\x0d\x55\xb6\x99\x21\x1b\x02\x8e\x83\x04\x2f\x8c\x4a\x43\xcd\xdc\x4e\xe1\xfa\x91\x7b\xea\x6d\x24\xff\x8a\xd2\x53\x4e\x6b\x55\xe8\x94\xc0\xfe\xe6\x1c\x87\x24\xb5\x3d\x96\x99\x69\xac\xb7\x50\x97\x90\x70\xb9\xb4\x92\xe0\x08\x6f\x85\xba\xaa\xed\xb6\xaa\xdb\xae\x64\x6c\xae\x74\xb2\x8f\xd7\xd1\xf8\x9d\xcc\xfc\x8a\x4e\x93\x0f\x02\x09\x7d\x5b\x15\xd9\xa4\x40\xc2\x1d\x08\xdb\x2d\x31\xf2\x44\xc8\x01\x37\x0d\x72\x48\x6d\x16\x96\x28\x8c\xd2\x5f\xdf\x3b\xc8\x10\x44\x17\x26\x80\xca\xae\x50\x11\xdc\xb0\x00\x5e\x30\x22\x1d\x99\xa5\xba\xdb\xb6\x7d\xcc\xc0\x30\x99\x7a\xf8\xea\xd3\xcb\x6a\x7b\xb0\x28\x74\xf6\xe6\x87\x3f\x76\x51\x6d\x40\x49\x93\x5b\x6b\x3f\x30\x73\x2d\xff\x23\xa8\x6e\xaa\x5f\x50\x8b\xd2\xd2\xb7\x6d\xc7\x83\x9c\x03\x5f\xbb\xc7\xed\x75\xf0\x78\x78\xa2\x5b\xb4\xae\xa6\xbd\x5b\x76\x8f\xa3\x52\x7f\x3f\xb5\x7a\x1d\x51\x13\x52\x95\x68\x00\x40\x9e\xd4\xf9\xe3\x51\xea\xc6\xf9\x6e\x0d\xd3\x39\xdf\xf6\xfb\xb4\x5d\x03\x1e\x77\x6d\xea\xfb\xb6\xe6\xfb\x18\x36\x9b\x6c\xd2\x0e\xb4\x71\xac\xa3\x77\x39\xbc\x0b\xe7\x23\x3e\xc1\xdc\xa1\xf0\xc8\xa9\xdb\x09\x76\xd9\x68\x57\x3b\xfe\xbe\x08\x4d\x87\x7e\x01\x9d\x34\x8e\xb2\xcb\xaa\x0d\xb5\x3c\x78\x18\xfe\xde\x93\xb9\xf7\xa3\x40\x63\xe9\xee\x15\xea\xfb\xf1\xdc\xf7\x55\x79\xa0\x84\x15\x69\x0f\xe9\x02\x56\x98\xd6\xad\x07\x4f\xad\xd6\x36\xb2\x17\xcf\x8f\xc2\x10\x5d\x4b\x62\x31\x0c\x6c\x34\x09\x1f\x73\x4a\x3b\xaf\xea\xe9\x1e\xec\xc5\xe7\x31\xbe\xb3\x63\x0a\x5a\x04\xbb\x43\xbb\x20\xd0\x61\xa9\x6d\x56\xea\xb5\x57\xf2\xb1\xea\x45\xb5\xdc\xdf\x66\xee\x85\xde\x68\x6e\x08\xda\x2f\x36\x62\x2a\x79\xb3\x2c\xe7\xf0\xa2\xe9\x01\x4e\xad\xb1\xde\x8a\x65\xe0\x78\xd4\xb1\x27\xa7\x5e\xee\xf2\xe9\xeb\xcf\x87\x47\xe3\xb7\xc1\x1a\xf1\xa8\x0b\x9b\x9e\x9a\x30\xe6\xef\x9e\xbe\x19\x5a\xb2\xc0\xea\xf1\xb5\xd7\x51\xaf\x26\x5f\xc3\x2d\x0b\xc8\x56\x86\x2e\x5a\x76\xc0\x01\x85\xf1\x98\x34\xc8\xc2\x13\x24\xda\x53\x56\xc7\xbf\xd9\x8b\x0d\x31\xa1\x1b\x2c\x29\xd7\x97\xfe\xd4\xab\xfd\xfe\xe9\xc5\xf3\xde\xbb\x2d\x64\xc5\x15\x99\x40\x6b\x26\x4d\xba\x8a\xfd\x09\xe5\x11\x50\x2a\x05\x1d\xb4\xd3\xc1\xdb\x30\x6a\xc5\x7f\x13\x45\x78\x66\x51\xa3\x4d\x8e\xb9\xf8\x93\x7e\x92\x3f\xea\xde\x4a\xed\xd5\x31\xa5\xa6\xf2\xc8\xf5\x14\x96\xed\x8a\xa9\x5a\x39\x72\x12\x6b\x39\xd1\x6f\x2f\x87\x38\x13\xa7\xea\xb7\xbf\x61\x97\xae\x24\x0c\xfe\xe0\x2d\x42\x1e\x81\x21\x7e\x24\x5a\xa2\x2a\x6b\x34\x4b\xd4\x74\x9b\x0d\xea\xd2\x51\xcf\xb4\xcd\xeb\xdc\xb2\xe6\xf2\x3d\x96\xd4\x43\xbd\xc1\x3e\xcc\x8d\x84\xba\xa2\xfe\xd7\xfd\x16\xcc\xcd\x29\xc2\xa5\xb7\x23\x3c\xeb\xd5\x56\xa4\x06\xb6\x74\xc7\xec\x0b\xd2\x95\x64\xec\x04\xfe\xdf\xff\xfd\x7f\x38\x3c\xcc\xb3\x63\x53\x75\x8d\xbe\x9b\x50\x4c\xb5\xe0\x4a\x2f\x19\x94\x28\x24\x23\xa3\xc3\x7c\xdc\x55\xf6\x25\x87\xc6\xb9\x98\x3a\xce\x57\xdc\x68\x77\x64\xa0\xcf\x26\x4f\xe9\x3d\x27\x8c\x47\xc7\x7a\x39\x65\x2c\x56\xfc\xb2\x44\x70\xae\xb9\x98\xb1\xe2\x3a\x49\x28\x79\xce\xe4\x68\xe9\x3e\x82\xd6\xda\x0b\xc6\x96\x27\x09\x9e\xa9\xd7\x91\x34\xa9\xea\xa1\xbe\xe3\x27\x43\xbb\x7c\xda\x4c\x08\xc2\x4d\x07\x62\xad\x0e\x3b\xe7\x49\x2a\x15\x98\x8c\xea\xc8\x5c\xd2\xd3\x16\x4a\x3a\x34\x66\x63\xdb\x17\xd8\xda\xdd\xb5\x56\x51\x02\xe4\xcf\xb2\x93\x95\x22\x4a\x48\xcc\x9e\xa3\x6f\x37\xa6\x9e\x87\x68\x3a\x77\x8e\x05\x32\x27\xe9\xb5\x4d\x72\xe6\x50\x4a\xa4\x22\xfe\xde\x31\x9d\xbe\x24\x06\xce\x03\xb8\x1c\x14\xa2\x42\xed\xd0\x1a\xd9\x8d\x29\x83\xcc\x24\x71\x97\x99\xa5\x7a\xdc\x9b\xc6\x14\x37\x96\xf5\xa5\x1f\xad\xd6\x02\xc6\xa0\x21\x24\x6e\x66\x08\x96\xd2\xe4\xba\x85\x20\x07\xe6\x22\xbc\x14\x34\x11\x59\x3c\xac\x6b\xb2\x10\xa8\x85\x0a\xec\xa7\x22\xc0\xbd\x60\x6f\x22\xf2\x80\xe0\xaa\xcb\x4e\x85\x57\x9d\xe5\xb5\xe6\x3a\x10\xd8\x08\xb6\x50\x64\xcc\x53\x18\xc1\xd2\x1c\x8f\xc1\xe7\x8a\x6d\x21\xbd\x61\x1f\x4b\x0f\x9c\x69\x2e\x55\x20\x0f\xbd\xaf\xfe\xae\xf7\x55\x6e\x29\xc6\xa1\xa0\xa1\x6f\xff\x3e\xfa\xf6\xc4\x30\x02\xab\x8c\x2f\x81\xb8\x5f\x99\x07\x57\x19\x77\x08\x24\x70\x14\xd7\xf3\x70\x6f\x10\x3e\xd1\xea\xab\x54\xcc\x80\xf2\x74\x53\xd5\x29\x51\x85\x51\xcd\x62\x9b\x77\x94\x55\x69\xa9\x75\xb4\x0b\x22\xcc\xe9\x5c\x7b\x34\xeb\x2c\x83\x2f\x05\x13\xe6\x30\x92\xad\xda\x6c\x2b\x5f\xe3\xb6\xd0\x53\xe5\x6b\x85\x65\x68\xbd\xb7\xdc\x0b\x0e\x02\x42\x48\xbc\x2d\x64\xd1\x09\xb7\x89\x01\x8b\x09\xdd\x89\x07\x1b\x25\xbd\x79\x98\x1a\xae\x8f\x41\x5b\x51\x70\xfb\xe5\x41\xe5\x73\xaa\x6f\xaa\x37\xcb\x2f\x6c\xb0\x7a\xdc\xb6\x56\xa8\x23\xec\x1e\x3f\xbb\x23\xdb\xbc\x9e\xf1\xb7\x36\xff\x7f\x70\xca\x7f\x37\xf5\x94\x1f\x41\xd8\xfc\xfe\x93\x1a\x46\xe2\xdf\x8f\x5b\x44\xa9\x47\x3b\x0c\xaa\x5e\xaf\x78\xad\x8e\x09\x9a\xa8\xa5\x87\xa6\xd1\x69\xce\x78\x13\x3c\xf1\x91\xf5\xb0\x7f\x50\xde\x4c\xd1\xbe\x07\x95\x9c\xc7\xd5\xb1\x1b\x7a\x44\xf5\x7e\xd3\xb2\xe2\x6d\x26\x6a\x5e\x7a\xdb\x11\xbd\x1b\x31\x25\x88\x66\x65\x05\x4c\x6e\x3b\x23\x63\xd8\x91\x2c\xc8\x49\x96\x3a\xff\x69\x4a\x3a\x7c\x0d\xac\x3d\xeb\x6a\x7e\x0f\x88\xe3\x61\x45\x3d\xa0\xa7\x43\x54\xf3\x81\x9e\xb6\x4f\x40\xc5\xbf\x57\xd4\x87\x95\x87\xb7\x60\xef\x39\x70\xb7\x02\x7d\xe6\xf4\xb3\xa8\xe7\x89\xc7\xfc\x95\x3b\xe6\xb7\x51\x86\xae\xeb\xc5\xdd\x6b\xb4\xef\x2c\x28\x58\x05\xce\xb5\x1d\xab\x05\xfe\xe2\x74\x4a\x65\x68\xb5\x24\x11\x5f\x20\xdd\xce\x9e\x63\xdd\x72\x32\xb2\x58\x82\x7f\x08\x31\x34\xda\x56\xfa\x7f\x75\x27\x67\x5e\x98\x60\x71\xc9\x00\xff\xd1\xfc\x35\x5b\x10\xf5\x49\xbc\xf0\x9d\x1d\x36\x0d\xd6\xbf\xad\x52\x46\xfe\x8d\x97\x7d\x23\xb4\x24\x4d\x4f\x51\x74\xe7\xba\x8d\x34\xa6\x06\xf6\xc4\x79\x5c\x9c\x67\x35\x29\x5b\x04\x58\x07\x7d\xf3\xde\xfb\xf1\xdd\x03\x57\x42\xe3\x63\x83\xc2\x89\xd0\xe3\x37\x08\x1f\x35\x7e\x6c\x20\x16\x49\x72\xd5\x79\x1f\xa5\xe6\xa8\x6a\x4f\x09\x4e\x7d\xf4\x85\x07\xa9\x83\x99\x3e\x02\x83\x69\x1a\xc9\xcf\x89\xfe\xb7\x5e\x59\x93\x9a\x73\x82\xf2\xa6\x92\x6c\x97\xb7\x0f\xbe\x5e\xe7\x88\x2a\x74\x5b\x8f\xc1\x08\xab\x8d\xfd\xf7\x1e\x59\x2c\xfb\x07\x3d\xb8\x58\x76\x43\x7f\x2a\xb1\x3c\x59\xd2\x06\x33\x7b\x20\x49\x7b\x1a\x38\xcd\xa8\x6d\x7f\x39\xb9\xec\xb2\x06\x6a\x63\x16\x4f\xc9\x23\x1b\xbc\xe9\xf8\x8d\xa0\xb3\x45\x8a\x16\x38\xbf\xae\x75\xe3\x72\xe0\x85\xfa\xad\xc3\x82\x6a\xa5\x81\x1d\x75\x8e\xb8\x60\xc5\x25\xd2\xc9\xf7\x2d\x0a\xe9\xfb\x09\xba\xcc\x3a\xcd\x70\x93\x73\x67\x38\xd9\x80\x21\x97\x5e\x84\x8f\xa2\xb6\xbd\x12\x40\x9d\x6d\x7b\xe0\x47\xba\x67\xc4\xfa\xe9\x57\xc9\x71\x1d\xc8\x97\x38\xdb\x35\x35\xd7\x75\x5a\x71\x7d\x65\xf6\x93\x88\x99\xff\x71\x6d\x5b\xd5\x21\x8a\x8c\x3a\x65\xe3\x22\xee\xe7\x71\x71\x03\x82\xfc\x75\x60\xe6\xb2\xff\xe8\x23\x85\x3e\x50\xd8\x44\x91\xc2\x74\x24\x1a\xe7\x87\x63\x71\x73\x7f\x93\x39\x7a\x81\x47\x16\xcf\xd1\xb3\x1e\xde\x70\x76\x98\xc6\x7f\x66\xbb\x39\x5c\xa3\x7f\x1c\x81\x1e\x9f\x92\x3b\xc8\xf4\x83\xd7\x7a\x14\xb1\x1e\x4d\xf3\xf1\x24\x7b\xf8\x98\x4f\x27\xdc\x7f\xf7\xf0\xc2\xfd\xf5\xed\x84\xfb\xb7\x79\x49\x43\x39\xdf\xa4\x99\x04\x62\xf8\xc7\x97\xf4\x63\xee\x81\x0a\x7a\x5f\x9f\xa1\xbc\xd6\x77\xcf\xe0\xfc\x14\x87\x76\x1f\x2e\x85\x33\xee\x50\xdc\x2a\x8d\x13\xbc\xee\xfd\x53\x39\xa7\x06\xbb\x6d\x3a\xe7\xe4\x78\x2f\x07\x5c\xee\x41\x20\x44\xe0\xed\xd9\xdb\x1e\xc3\x96\xfe\x95\xcd\xf9\x57\x36\xe7\x9f\x20\x9b\xf3\x3a\x4a\xba\x04\xbd\x41\x87\x52\x34\x65\xf2\x26\xfa\xfa\x2d\xe4\xff\x5f\xa1\x65\xf7\x0f\x4f\x1b\x38\xb1\xf1\xca\x48\x5a\x46\x24\xe9\x27\x4a\xcc\x4c\xcb\xbd\x68\xe4\x44\xdb\x16\xf9\x04\xc3\xf1\xb4\x4e\x94\xad\x61\xa6\x25\x0d\x3d\x0d\x36\x61\xf9\xba\x2b\xb3\x9a\x61\xe3\x4b\xcf\x00\xec\x10\x1f\xf1\xf2\xe8\x03\x96\xa9\xd4\x0c\xa7\xc9\x0d\xef\xa4\xce\x71\xee\x78\x24\x4e\x27\x79\x5e\x46\x78\xb7\x67\x8e\x2c\x11\x87\x3f\x59\xec\x99\x7a\xa9\x8e\xf0\x6f\x2c\x3a\xe4\x05\xa6\x74\x36\x1d\x84\xb0\x7e\xea\x74\xd0\xd0\xea\xdd\x1d\xf1\xc9\x77\xe7\xc7\xaa\xca\xb8\x96\xf7\x4b\xae\xe3\xbf\xbb\xe9\x74\x3f\x76\x59\x5c\x94\x75\x55\x70\x59\x73\x5d\xef\x51\xa0\x1e\x36\xfd\x10\xdd\x71\xfe\x2b\x82\x68\x7a\xec\x62\xff\x80\x50\x99\x93\x2e\xff\xad\x4c\xad\xf8\x9d\xef\x6f\x6d\x4d\x18\xef\xb6\x06\xd7\x94\x21\x27\xc1\x68\xae\x0e\xad\x93\x7b\x9b\x58\xc9\xaf\x6c\x48\x09\x91\x39\x09\xec\x7f\xd9\x52\xff\xb2\xa5\x26\x3a\xd3\xcc\x5a\xf3\x3d\x57\x14\xfd\x80\x62\xfe\x7f\x04\x1a\x8e\x3b\xd3\xf6\x4c\xe3\x0f\x17\x3f\xab\xd8\x3b\xd2\x9e\x14\x05\xe0\xf9\xaa\x72\x24\x66\x1c\x11\x95\x06\x06\x55\xb9\x7a\xca\x7c\x03\x8d\xa5\x7d\x6b\x98\x81\x43\x5a\x5a\xed\x51\x06\xc0\xbc\x94\xc0\xae\xb4\xd6\x8a\x29\x03\x76\x84\x67\x89\xae\xb2\x43\x1a\xd3\x3c\xea\x14\x7f\x63\x65\xd2\xd2\x8e\xe4\x2b\x26\x56\x60\xdd\xd1\x32\xd9\x03\xc2\x24\x60\xb4\xe5\xaa\x04\x11\x14\xa8\x17\x78\x76\xf4\xd0\xce\x9c\x31\xf3\x36\xb9\x78\x95\x7c\x13\x8e\x7b\xa4\x6a\x9e\xc3\x5f\xc2\x09\x45\xbf\x7c\x9d\xbd\x4e\xb6\xcd\x7e\xb1\xf6\x6d\xef\x38\xc1\xc6\xd6\x56\x83\xba\x07\xdf\x77\x5a\x28\x68\x74\x79\xb8\x5e\x1c\x13\xc3\x7d\x96\xaf\xba\x32\x3e\x07\x4d\x97\x5b\x3c\xd3\xcf\x82\x6a\x59\xd6\x78\x71\x21\x38\xaa\x0e\xf7\x33\xd7\x50\x17\xa1\x3c\xce\xd5\x32\xc9\x20\x37\xe7\xa2\x05\x5a\xe5\x73\xb2\x1a\x68\x16\x30\xd3\x56\x8c\x25\xaa\x95\x93\x5f\xa2\x86\x96\xbd\xb4\x69\xb5\xfd\x99\x20\xa2\x03\x88\xf4\x98\xb4\xf8\xbe\x67\xb5\x89\x89\xe7\xe8\xbb\x9a\x6b\x05\x71\xf3\xa6\x8b\xe5\xb1\x84\xc4\x76\x5c\x4c\x77\x22\x6a\x52\x19\x27\x4c\x3b\x4d\x4c\xcb\x24\xd7\x9c\x99\x5f\xaa\xb0\xea\x6e\x0f\xd9\x59\x56\xd8\xff\xfd\x9c\xe6\x58\x82\xa9\x6e\x96\xe4\x75\x6d\xe8\xde\xa6\x20\x62\x52\xca\xa5\xe4\x4c\x59\x0f\x75\xda\x36\x76\x0a\x3e\x13\x31\x35\x85\xb2\x48\x2d\x7f\xfb\x60\x11\xf9\x87\x14\x4a\xbb\x43\x0a\xa5\x1e\x3b\x92\xed\x99\x76\x92\x25\x69\x80\x83\xf3\xf5\x34\x7b\xb6\x25\xb7\x65\xa0\x82\xe9\xd5\x3d\xfb\x6f\x9e\x6a\x5a\x73\x5c\x42\xfd\x8e\x9b\x8d\x9c\xa0\x15\x1b\xaf\x3f\x72\x9c\x9b\x7f\x29\x33\xdb\x3c\x6d\x40\x8e\xd5\x52\x36\xce\xc4\x2c\x39\x58\x64\xc8\x7c\x41\xa4\xd5\xf5\xc6\x96\x02\x8b\xc1\x26\xb5\x87\x6d\xb5\xf9\x80\x6a\x4d\x85\xef\x80\xcd\x96\xca\x92\xc7\x1f\xa0\x99\x4d\xed\x23\xfb\x25\xcb\x2a\x05\x1d\xe5\x4d\x05\x92\x18\x4f\x48\x39\x37\x45\xd8\x16\xef\x54\x8d\x84\x2d\xed\x89\xe8\x28\xb4\x68\x22\x6f\x27\xd7\x23\x72\x38\x1d\x72\x4f\x3f\x34\x56\x63\x37\xb1\x84\x54\x6e\x22\x7d\xb5\x27\x8d\xa3\xe0\xe4\x0a\xca\xe1\x46\x69\xd1\xda\x90\x0c\xda\x42\x35\x5f\x6b\x45\xbf\x8c\xef\x2b\x7c\x15\x8a\x68\x09\x7c\x4f\xce\xc4\x0d\x5c\x9b\x45\x85\x96\x34\x1e\xd9\xa0\x93\xf4\x93\x98\x16\xf0\xfb\xa0\x9d\x4a\x3c\x55\x71\x99\x75\x0b\xae\x89\xb4\xa4\x4d\x76\x67\xb5\x86\xc6\xf2\x6a\x60\x53\xc3\xa6\x8a\xb5\x11\xa6\xcd\xe0\x28\x84\xed\xe1\xe8\x56\x16\x39\x24\x72\xf8\x95\x60\x49\xc9\xfe\x11\x93\x78\xbc\x50\xec\x4b\xf8\xf2\x58\x96\x0f\xf9\xe2\x9a\xce\x12\x19\x6f\x3f\x40\x4b\xae\xea\x7c\xdb\xf4\x3b\xae\x40\x4f\xda\xb4\x0a\x37\x22\xb4\xdc\x9a\x79\x71\xbd\x87\xad\xdc\xf6\xab\xa8\x86\x18\x5e\xb4\xde\x66\xb1\x36\x28\x53\xc6\x68\x03\x15\x38\x8d\x6d\xfb\xa4\x3c\xcf\xa3\x84\x47\x79\x41\x57\xe6\x2b\x48\xe7\xcf\x49\x65\xd5\x24\xb8\xc0\x94\xcd\xbc\xa2\x24\x03\x0d\xbf\x88\xef\xb5\x16\xbc\xd0\xbc\xca\xf6\x13\x66\xfc\xa1\xad\xc9\x0f\xa2\x67\xc8\x9c\x67\xfe\x83\xde\x99\x92\x56\x5a\x01\x81\x0d\xef\x24\x2a\xdf\xed\x2f\x4e\x70\x98\x92\xea\xff\x73\xd5\xc6\xc5\x50\x34\x4f\xe9\xc2\x95\x99\x05\x09\xc0\x09\xf3\x7d\xbb\x4e\x49\x7f\x6f\xd2\x70\x8d\x6f\xba\x02\xec\x55\xda\xfb\x0c\x75\xe7\x88\x7c\xb3\x35\x32\x36\xa7\x6f\x69\xf5\x11\x09\x3b\x98\x12\xce\x82\x4b\xef\xf1\x5a\xb3\xc5\x70\x72\x6e\x91\xf9\x14\xcc\xef\xa8\x59\xd5\x0c\xc8\x01\xa5\xd0\xd5\x7e\x79\xd2\x69\xdc\xd7\xe4\xbb\xf0\xd8\xc2\xd6\xe1\xda\x40\xbf\xf4\x30\xa0\x39\xcf\xd3\x71\xea\x60\x93\xff\xd2\xf5\x5f\x1a\xa7\x11\x42\x82\x79\xe2\xa4\x39\xce\x9a\x25\xd1\x84\xb7\xf6\x71\x30\x7b\x84\x86\x23\x63\xa3\x67\xc8\xfd\x64\x74\xea\x45\xbe\xd9\x27\xef\xaa\x6a\xd3\x9b\xfd\xdc\xac\x94\xf7\x71\x5e\x18\x26\x4e\xb7\x29\xd5\x74\x5e\x4c\xb9\x05\x91\x06\x08\x76\xee\xa8\x66\x68\x84\x2e\x52\x59\x58\xa5\x0f\x2d\x5f\xca\x81\x46\xed\x61\x3f\x58\xe4\x5a\xbb\xb2\x8c\xc8\xdd\x83\xa2\x3e\xcf\xff\x30\xb5\x01\xf9\xb8\x9d\x43\xf3\xa5\x43\x37\x0d\xd0\x32\x48\x6e\x70\xbc\x3b\xec\x34\xf2\x1f\xa9\x92\x6f\x5b\xa7\xec\x19\x02\xb0\x48\xeb\x1b\x24\xbb\xa1\x90\xf2\x9b\xaa\x16\x92\x53\x70\xc7\xd4\xa9\x6d\x0a\x9a\xac\xc8\xf7\x3f\x6c\xec\x77\xb2\x11\xf9\xc5\xf3\x31\x5b\xea\xa8\x8b\x67\x41\x92\xb6\x28\xb9\x57\x99\x28\x40\xc6\x28\x5e\x07\x27\x61\x16\x76\xf6\xb3\x94\x98\xd2\x3a\x66\x99\x5c\xb8\x76\x96\x11\xdd\x8a\x56\x69\x32\xa5\xc8\x4f\xde\x25\x4b\x5b\xeb\x92\x01\xd0\x69\x32\x7b\xcb\x65\x0f\xdd\x1f\x41\x27\xa2\xc5\xcb\xf6\x79\x0b\xbf\xd1\x33\x72\x13\xb7\xca\x6d\x1d\x04\x1d\xd8\xfe\x98\x57\x80\x7b\xce\xb5\xd3\x63\x7f\x64\x67\xd7\x6b\x13\xb3\xb6\x72\xfd\x1b\x1c\x34\x22\xb7\x58\x1f\xd5\xe5\x3b\xed\xac\xa3\x21\x8f\x45\x5e\x2f\xba\x8d\x12\x28\x23\xd4\x59\xab\x3f\x63\x6d\x38\x5d\x83\xf0\x6b\xbb\x83\xe6\xa3\x60\x30\x95\x2e\xd0\x07\x08\xcf\xe0\x45\xe5\xfb\x7c\x4e\x04\xb7\x2a\x7d\x6a\xb8\x2d\xb6\x34\xb7\x68\xf3\x6d\x27\xbc\x88\x78\x68\xff\x4b\x22\xdb\x83\xd7\xf4\x33\x49\xb9\x47\xd0\xaa\x2b\x96\xd2\x7d\x42\x7e\xca\xc8\xa7\x26\x27\x73\x80\x5c\xa2\x22\xff\x3b\x99\xe2\x27\x21\xf6\xfd\x25\x8e\x16\x2f\xdf\x6c\x0c\xf7\xfa\x2e\xf6\x61\x5f\xbe\x70\x71\x40\xd7\x66\xda\x81\xc6\x44\x2c\x6f\xd8\xfb\x65\x7a\x5f\x39\x93\x3b\x12\x67\xd2\xa7\x7a\xe6\x73\x21\xe1\x92\x4d\x2a\x0b\x18\x9d\x33\xbb\xd1\x95\xd2\xd3\xd9\x4e\x42\x4a\x33\x63\xab\x98\x1d\x35\x1a\xe6\xd7\xc4\x7d\x62\xf5\xf7\x26\x9a\x96\x75\x35\x50\x99\x44\xef\xe6\x42\x85\xfd\xf8\x1a\x7b\x21\xb3\x40\x17\x0a\x49\x97\x46\x01\x0e\x06\x0c\xdc\x77\x32\xa1\x0b\xd0\xd7\xf7\x17\xc3\x7e\x27\x57\xca\x1e\x6c\x74\x2e\x81\x4e\x11\x2d\xdc\x82\x0f\x52\x1d\x6b\x5c\xa9\xbb\x23\x2d\x07\xa7\x06\xca\x48\x7a\xd2\x88\x5f\x15\xf4\xef\x49\xfd\xf8\x6e\x45\x31\x33\xa9\x4b\x6a\xdd\xcd\xc9\x6b\x87\xbb\x23\xc2\xf4\x95\x34\xe6\x3e\xd6\xd1\x74\x44\xb4\x0a\xf7\x12\x37\xeb\x82\x84\x3a\xe1\xae\x5a\x1a\x8c\xaf\x70\x1f\x69\x0d\xcf\xde\x17\x59\xcd\xed\x7c\xff\xab\xe4\xbe\xb4\x83\x8c\x33\x4b\xac\xd4\x8c\xdb\x32\x2a\x45\x69\x8e\x78\x16\x7e\x50\x72\x5f\x11\x50\x1a\xb6\x55\x8d\xda\x7c\xd7\xc0\x86\xdf\x4b\xdd\xc7\xa4\x62\x7a\x54\x2f\xab\xd9\xdf\x55\x18\xb7\x9c\x9b\xa2\xaa\xae\x41\x1e\xcb\xce\x5f\x6d\xe8\xaa\xd3\x9d\xd6\x07\x2b\x05\x91\xd4\x99\x31\x02\x6d\x8d\x96\xe0\xac\x9f\x6c\x7b\x07\x9c\xe4\x9f\xf7\x2b\xd0\xa9\x5d\xe9\xa1\xc2\x26\xf3\x08\x96\x8b\x9b\x7d\x2e\xe9\x7b\xbd\xce\xb3\x0c\x41\x39\x6e\x42\x49\xf7\x6a\xc3\x47\x56\xfe\xf6\x77\x90\xa3\xae\x43\x1f\x60\xfc\x38\x01\x90\xc6\x87\xa9\xa1\xf5\x7c\x7f\x7e\x70\xa4\x9e\x0f\x1c\xa9\x8f\x0f\x96\x84\x9b\xca\x73\x8c\x37\x53\x3c\xda\x19\xd2\x5a\xec\xba\x9f\x8f\xa7\xd7\xa6\xf3\xa7\xdc\xee\x48\x05\x68\xa5\x20\x61\x2d\x7b\x35\x91\x24\x6d\x09\xe9\xa0\x7f\x4a\x93\x57\xbe\x26\x42\xa9\xfc\x54\xa0\xf3\x51\x1d\x4c\xd6\xed\xe0\xdb\x4c\xa3\x0b\x96\x05\x24\x31\x49\x8a\xdc\xfa\x06\x32\x59\xa5\x3b\xd0\x67\x04\x74\x20\x71\x2f\x84\x5d\x55\xf9\x6e\xeb\xc9\xb2\x33\x5c\x4a\xb0\xad\x4d\xa3\x97\x49\xda\xfc\xec\x1c\x7f\xed\xed\x27\x06\x21\xda\x21\x94\xeb\x26\xe7\x96\x87\x75\x18\x56\xe6\xc4\xa0\x59\xd5\x49\xf7\x69\x64\xbf\xb5\x95\xae\xad\x4e\x91\xdb\xae\x4d\xaa\xf8\xe2\xe5\x1b\xfd\x17\xeb\x04\xdb\xd9\x42\x2e\x2c\x88\xc9\x57\x82\x55\xe5\xf6\x56\x1c\x6d\x87\xad\xa3\x66\xbf\xfc\xd9\x77\x23\x94\x73\x79\x6a\x7e\x8b\x14\xb7\x9c\xf4\x3d\x99\x1e\xf0\x85\xb6\xa6\x79\x6a\x63\xa7\x2d\xb7\x9f\xc3\xd9\xf4\x78\x67\x8f\x0c\xe5\xa6\xc6\x4c\xa2\x0c\xa3\x97\x65\x91\xe2\xa6\xed\x82\x3f\x85\x0c\x93\x14\x4e\xb0\x6a\xba\x8e\x52\x9e\x22\xbf\x4e\x85\x51\x1b\x82\x4a\xb3\x84\x3c\x11\x1f\x52\x3a\xfd\x2e\x47\x74\xe9\xa6\x2b\xc8\x3e\x2a\x34\x27\xe4\x43\xd4\x9c\x0d\x0c\xc9\xa9\xad\x5f\x42\xb3\x8e\xce\x81\x8f\xc5\x2c\x38\xe7\xe8\xd4\xf7\xcc\xe6\x28\x79\x3c\x05\xae\x6a\xd8\xd9\xb6\x04\xb3\x51\xdc\xc5\xa9\xe3\xf7\x31\x6e\x28\x36\xc7\x8a\x83\x0f\x5c\x97\xcc\xe1\x38\x93\xf7\x37\xa4\x98\x7e\x28\xd2\xbd\xa9\xc9\x7f\x7f\x6b\x93\xa3\xb7\x10\xaa\x3f\x42\xf9\x4f\xc2\x34\x7c\xbc\x15\x4b\xe4\xd4\x96\xb9\x48\x19\x3b\x86\x6e\xed\x52\xe1\x98\x53\xa1\xbd\xcf\x1f\xa7\xf1\xa8\xa7\xba\xfa\x68\x36\x5b\x21\x9a\xbf\x65\x11\xda\x08\x25\xa3\x2e\x2e\xe2\x7f\x68\x04\xcd\xc9\x1b\x47\xa5\xed\x1a\x75\x97\x51\x39\x18\x77\xd8\x56\x16\x46\x6d\xc7\x6b\xdf\xe8\xb9\x6b\xcb\x9b\x72\x4f\x5e\x6f\x5d\xea\xef\xfa\x21\x64\x2f\x82\xed\x79\x3d\x46\x43\x45\xaa\x3c\x38\x94\xce\xd8\x6b\xb9\xc7\xba\x3d\xce\xb5\xd9\x1a\x4b\x21\x05\x91\x6c\xed\xd0\xda\x03\x9d\xb2\x1c\x69\x0b\x88\xda\x13\x84\x73\x5f\x91\x15\x92\x7d\x9e\x78\x61\xc3\x55\xdc\xbc\x2e\xae\x51\x25\xe2\x58\x07\x6b\xe6\x65\x25\x38\x5b\x11\x2f\x25\x81\x68\xe1\x43\xf4\xbf\x97\x96\x08\x5f\x7b\x30\x49\xcf\xd4\x72\x51\x74\x19\xc7\x53\x91\x4f\x51\xd2\xeb\x25\xe6\xe0\xc7\x6e\xb6\x5c\xf7\x57\x71\xef\x66\xb8\x46\x19\x79\xe6\x05\x73\x5c\x5b\x39\x12\x97\x12\xb2\x3e\x94\xe6\xf5\x08\x37\xba\xf3\x2b\x2b\x66\x13\x39\xfd\xbe\xb4\x09\xf2\xa8\xf4\xc5\x1b\xdd\x67\x3a\xb8\x48\xad\x2b\x43\x3c\xf3\xc0\x66\x4e\x89\xda\x17\xe5\x84\x00\xab\x34\xef\xdb\xd4\x69\xb3\x46\x7c\xa5\xda\xc1\x12\xa3\x5d\xff\xba\x5b\xb1\x4e\x61\x16\x45\xee\xc4\x4e\x46\x6e\x21\xfc\xb3\xb2\xbc\x37\xdc\xd0\x1b\x9d\x4e\x53\x06\xd8\xd7\x75\x6e\xe3\x0d\xec\x3a\x05\xcf\x91\x03\x36\x3a\x2c\xb7\x0a\xb8\xb6\x0a\x89\xdf\x88\x25\x68\x3a\xd0\x39\x83\x1d\xf6\x6d\x93\xff\xe2\xa2\x6a\xe1\x66\xdb\xa6\xee\x9c\x7d\x6b\x8f\xf4\xfe\xc3\x1c\xc2\x5d\x08\x0e\x9b\xf3\xd5\x97\x11\x9d\x13\x99\x06\x08\xd1\x43\x98\xec\x1c\xd5\x39\xcf\x53\x8e\xb2\x52\xb6\x06\x8c\x68\xbe\x3b\x08\x87\xbf\x77\x9c\x9f\x75\x67\xc5\x59\xd0\x50\xa7\x63\xa7\xfb\x07\x0c\xc7\x32\x35\x3c\xe2\x83\x47\x58\x9a\x40\x71\x50\xc3\x35\x67\xc4\xcb\x21\xaa\x6c\x0d\x77\xf9\x6f\x98\x1e\xbc\x0e\xe9\xa2\xae\x9a\xc3\x16\xbe\x41\x42\xc3\xd0\x78\x12\x4a\xe2\x47\x42\x51\x37\xbc\x08\x05\xf3\xb9\x9a\x3c\xd8\xe2\xe0\x01\x76\x1b\xee\xb8\xfa\x7e\x57\xf9\x80\x36\x24\x7b\xc8\x60\x92\x6a\x5a\x7d\x6b\x7d\x50\xe5\x62\x85\x1c\x13\xb3\xa7\x9d\x17\x5e\x7a\x5e\xf8\x29\x88\x67\x1d\x1d\x17\xb4\x4f\xd1\x4e\xf2\x63\xbb\xf0\x67\xa3\x5d\xcf\x48\xd8\xec\xc2\x8d\x58\x3a\xc3\xf4\xd8\x86\xe4\x31\xa3\x9c\x7f\xf1\x59\xd8\x42\x58\x07\x60\x78\x4d\xc5\xa3\xaa\xf4\x98\xd3\x81\xc2\x15\x61\x6d\x84\x55\x97\xb6\x10\x30\x5e\x79\xe5\xbb\x12\x56\x0d\xf8\xda\xec\x23\x9a\x70\xf9\xc2\x19\x69\xaa\x48\x6c\x21\x7d\x1f\x4b\x16\x5b\x71\x98\xc4\x36\x6e\x0b\xda\xfb\xc4\xc7\x22\x67\x7a\xfa\x6e\xa3\xa4\x72\x3c\xcf\x79\xda\x58\x78\xa7\x71\xdd\x32\x48\x61\x49\x44\x37\x9a\x8d\xba\x78\xe6\x90\x78\xb6\xbf\x3e\x8d\xb9\xe3\xd1\x19\xad\xf0\xcf\xeb\x62\x4b\xf6\x06\x82\xde\xfe\x6a\x2b\xc4\xaa\xe1\xda\xe6\x40\xf6\xfa\x60\xa9\x82\x92\x93\xe6\xef\x5d\xaa\xed\x5e\x2e\xd5\x89\xc8\x8c\xd9\x3e\x0b\xce\x43\x8a\x8b\x2e\x4f\xe1\x8d\x84\x5d\x6b\xad\x79\x46\xc2\x86\xe2\x58\x97\xc6\xfd\xc0\x5e\xae\x1b\xb4\xdc\xfb\xd9\xe9\x6e\x7d\xd4\x8e\x55\xbc\x28\xcd\x39\x67\x1d\x45\x9b\xdb\xd9\x85\x7f\x6f\xab\xed\x2c\x9a\x27\x34\x85\xc8\x60\x8f\x57\xac\xad\x87\xef\x84\xba\xb5\x98\xd4\x9a\xbe\xf4\x9d\xfb\xd8\x54\x97\x69\xc1\x09\xea\x38\xb0\x6a\xcb\xc8\xb5\x60\x0b\xe5\x51\x57\x31\x3d\x5f\xb3\x23\x8f\x23\x66\xe4\xd7\x51\x48\xd4\x6b\x8a\x36\xf5\x99\xb2\xb3\xab\x76\x5d\x18\x40\xc9\xce\x25\x79\xe0\x32\xe6\x2c\x4d\x35\x10\x0b\xa9\xfb\xee\xad\x62\x71\x86\xfb\x91\xeb\x43\xee\xd8\x89\xbc\x97\xd5\x1e\x4c\xe4\x9d\x6c\x7f\x21\xf1\xbe\xcb\xec\x0d\x9a\x18\x67\x68\x79\xc3\x2c\xa3\x1e\xda\x83\xbe\xc7\x69\x27\x40\x8d\x70\xd2\xc3\xcd\x6a\x87\x11\x31\x03\x4f\x64\x10\xb4\xcd\x94\xc8\x73\x38\x1a\x3d\xf0\xa8\xde\x76\x0d\xcc\xc5\xc6\x1b\x63\x06\x49\x25\xb9\x3e\xbe\xb9\x69\x08\x37\x50\xf8\x1d\xa4\xcc\x50\xc6\x08\x3f\x1b\x39\x00\xbe\x0d\xe1\xc7\x78\x23\xa5\x61\x87\xec\x1a\xc7\x95\xec\x69\x3e\x38\x06\xb2\x4c\x36\x9a\x35\xb2\x2d\xca\xe0\x68\x91\xe3\x1c\x78\x97\xe7\x88\x26\x61\xe9\x27\x7a\x2d\x5e\xc7\x7e\x8e\xe9\x72\x2a\x1e\x5c\x3c\x26\x6e\x0c\x7f\xe8\x33\xbd\x99\xe8\x33\x8d\xa7\x96\x92\xb3\x57\x4f\xd1\x2b\x4a\x79\x20\xcf\x6f\x4b\xf7\xc1\x7d\x8f\x72\xc9\x97\x0a\xd7\x03\x4b\x08\xd8\x30\x9b\xfc\xe7\x20\xb2\x7f\x5b\xbf\xea\x0d\xfb\xdc\x13\xb1\x3a\xa3\x88\x42\xc6\xb8\xf5\xaa\x4c\x77\xb2\xa8\xbe\x07\x69\xd8\x2f\x6b\xa0\x94\xf8\xa7\xa0\x1f\x84\xed\xcb\xc5\x3c\x59\x7d\xef\xe6\x5b\x21\x88\xe7\xf0\x29\xb7\xbb\xd2\x66\x58\x1e\x94\xab\x96\xab\x80\xbe\xd8\x44\x56\x73\xb8\xb9\xde\x5b\x14\x9c\xbb\xdc\x69\x3d\xce\x57\xfa\xe3\x41\x5e\x47\xf3\x57\xa2\x06\x39\x26\x23\xb1\x22\x69\x93\x6d\xdf\xdb\x2b\x60\xc7\xa9\x04\x88\xb5\x27\x75\x55\x5b\xfb\xdd\xb7\xb1\x02\x5f\x14\x1a\x32\xe1\x6e\x61\x69\x63\xd7\x46\x7c\x43\x7e\xdc\x96\x74\x75\xbe\xcd\x5b\xdd\x4d\xb4\xca\x31\xb5\x0b\xda\x40\x7b\xb0\xa3\x63\xef\xda\x45\xf6\x32\xf9\x39\xf0\xa2\xdd\xe2\x23\x76\xe6\xa7\xc9\x8b\x84\x8d\xb4\x36\xe0\xf7\x02\x92\xe1\x62\x6a\x6e\x9a\xbb\x8f\xa7\xa1\xc9\x37\xfb\x73\xd6\x14\xaa\x14\xfa\x2e\x74\x78\x20\x56\x74\x0f\x3a\xce\xaf\xd4\xc6\x31\x15\x8b\x65\x38\x6d\x1f\xfc\xd3\x87\xd6\x7a\x99\x73\xc4\xc1\x6e\x87\xfb\x93\x5d\x5f\xef\x48\xb7\x68\xb3\xaa\x7d\xd4\x7d\xe8\xad\x61\xb9\x38\xe7\xec\x0e\xdf\x14\x5a\x2c\xf1\xcb\x78\x06\xfc\x4d\xdb\xaa\x64\xc7\x50\x9b\xde\xb2\xe4\x6d\x33\x44\xde\x3f\xe0\x59\x84\xdb\xd8\x67\x46\x42\x97\x76\x1a\xec\x22\x79\x8a\xb0\x46\x5a\x0f\xff\xf9\x92\xfe\xfc\x27\x89\x27\x16\x55\x97\x35\xc3\xdf\x7a\x41\xdf\xfa\x1e\x98\x08\x06\x83\xd6\xca\x07\x43\x2b\xb1\x1a\xfe\xfe\x4b\xfa\xfe\x9f\x39\x45\xb5\x26\xed\xca\x7a\xba\xac\x76\xc3\xdf\x7d\x45\xdf\xfd\x58\xf1\x5a\x23\xe1\x54\xf3\xcf\xb2\x3a\xe7\x3b\x6a\x7f\x3e\x2f\xf2\x5f\x44\x7c\x1c\x0f\x90\xf9\x43\x3c\xb2\x14\x7f\x29\xe7\xb4\x16\xdc\x1e\x17\xc9\x8c\xe3\x8b\xf2\x75\xd5\x1e\x5f\x8b\x1f\xd3\x7a\x73\xfc\xcd\xdf\x92\x70\x3f\xfe\xae\xd2\x61\x61\xe8\xaf\xaf\xe9\xaf\xd2\x93\x80\x69\xfe\xc7\x8e\x32\x1d\x9b\xb1\xfd\x4e\x8b\xcd\xf1\x37\xfb\xb6\xca\xb0\x4e\x72\xf8\x8e\xbf\x23\x69\x74\x60\x93\x8f\x7f\x09\xaf\xfa\xc7\xb4\x30\xc7\x5f\xf5\x03\x79\x65\x9b\x43\xf4\xc6\xcb\xff\x31\xb1\x3f\xbc\x2a\x57\xd0\xbc\x0f\xa7\x78\x5e\x3d\x7e\x4c\xf2\x11\xaa\xa7\x26\xb4\x9b\x38\xa6\x13\x51\x4c\xa8\x54\xf5\x21\x1f\xd5\x3d\x8a\xa7\x8e\x05\x03\x8d\xd5\x96\x61\xb2\xd0\x65\x0e\x42\xbf\xd2\x03\x39\xc9\x93\x45\x5e\x92\x7b\x4a\x35\x46\x2c\x7e\x1f\x44\x44\x78\x8c\xe3\x01\x6c\xf1\xb1\xf1\x89\x74\x95\xb4\xb6\x3b\x88\x1f\x58\x8d\x25\xcd\xa1\x7a\xa3\x41\xd1\xd0\x75\xaf\x6a\xe9\x99\x4b\x06\x73\x1b\x25\xc3\x6b\xe9\xe1\xa4\x91\x9c\x92\x7f\xfc\x84\x23\x52\x45\x3b\x1e\x26\xf8\x23\x19\x10\x0d\xe0\x85\x38\xf7\xc9\x16\x79\x36\x76\xdb\xe3\x94\xce\xcc\xa7\x3a\x51\x7c\xa7\x4b\xc9\x1b\x13\x34\xcb\xe9\x75\xd2\xf1\x5e\x6f\xc4\x1b\x80\x31\x51\xc4\x50\x36\xdc\xf3\x78\xe9\xf4\x02\x5d\xa0\x05\x5d\xe4\x19\xb9\xd9\x72\x61\xa5\x78\x82\xaf\xa5\x8b\x68\xf2\x17\xb9\x97\x9d\x5e\x6a\xf7\x95\x59\xaf\x5a\xd5\x35\x8c\xe1\x12\xce\xb4\xf1\x68\xc9\x5c\x56\x8c\x6d\x70\xb2\x30\x36\x29\xbc\x50\xf5\xda\x6c\xe3\xb8\xde\x02\x88\x85\x34\xe0\x08\xa0\xd8\x35\x40\xb5\x1d\xcc\xd3\xce\x2d\x0d\xfd\x58\xdb\xb4\xdd\x3e\xd3\x7e\x5f\x78\x47\x01\xe2\xa1\x7b\xc5\x45\x1f\x09\x9f\x79\xf4\xa7\x0c\x9b\xe9\xb1\x81\xcf\x7f\xc1\xc7\xa3\xfb\xfb\x8e\x14\x71\x56\xa7\xcb\xf6\xf3\xc8\xe5\x6f\xe8\x49\xa9\x18\x55\xb6\xcd\x4b\xe3\xe5\x9f\xa0\xe9\xaa\x1d\xab\x71\x76\x94\x7d\x77\x3a\x49\x55\xb8\x5c\x9f\x94\x46\x4e\x58\x74\xb7\x92\x53\x97\x3e\x6f\xa7\x2f\x7a\xe0\x14\x9e\x74\x83\x15\xb7\x22\x0d\xe0\x5d\xd5\x32\x4f\xed\x4e\xce\xb7\x36\xab\x9f\xd2\x5c\xf2\xba\xac\x16\xd7\x86\x5b\x75\x8c\x27\x08\xfe\xb2\x1d\xdd\xb3\x4e\xfe\x7c\x78\x4b\xeb\x9c\x0b\xd1\xba\x6d\xbc\x69\xf8\x23\xc3\x82\xdb\x96\x3e\x91\x8e\xd7\x6f\xe3\x52\x55\xc3\x36\x20\xd7\x58\xf7\x8f\x3e\xcb\x65\x86\xef\x04\x8e\xba\x1f\x7c\xc9\x71\x9a\x7e\x64\x23\x1c\x40\x1a\x3f\xa7\xa8\x25\x14\xba\xde\xbf\x75\x9b\x6d\x6f\x75\x20\xfa\xf0\xb1\xcf\x60\x71\x9c\x46\x4a\xe9\xa4\x5d\xe2\x69\x8b\xf7\x93\xe4\x0c\xeb\x4c\xfa\xbe\x5e\xd5\xc0\x82\x4e\x02\xf6\xdc\x46\x45\xbf\x9a\x94\x34\x64\x0e\x6b\xdb\xec\x3b\xe5\x99\x48\xee\xbb\x68\xc7\x50\x3c\xe3\x0e\xec\x68\x95\x33\x77\x0b\x16\xee\x6c\xe6\x0e\x68\x8e\x93\x07\x3c\x0d\x66\xc4\xd1\xfa\x39\x62\xae\x98\x1a\xfd\x49\xf7\x42\x2d\xea\x03\xd0\x05\xb8\x39\x79\x48\x3a\x08\x72\xd9\x37\x38\x0f\x74\x63\x5a\x69\x41\xaf\x61\xc8\xe1\xc4\xa3\x83\xe2\xa6\x83\xfd\x3e\x25\x2f\x19\x40\x16\x5e\x3c\x8f\xc2\x85\x21\x01\xc2\x09\x51\x60\x59\xad\xa2\x28\xdc\x31\x8e\x07\x91\x35\x72\xb2\xd1\x29\x95\xdb\xba\xf5\xa0\xc9\x53\x39\xa7\x5d\x9b\x95\x0b\x94\xdf\xe5\xe4\xa3\xf8\x68\x90\x0c\x39\x34\x03\xa1\x0b\x28\x11\xe3\x22\x31\xbf\x37\x59\xd8\x2b\x9b\xdf\x85\xed\x21\x94\xcf\xfa\x1f\x69\xe4\xe8\xd9\x1d\x28\x68\xbd\x8f\x17\xb4\xda\x2b\xc3\x4a\xec\x30\xab\x10\xd7\xa2\xd0\xd7\x2b\x91\x4b\x66\xf3\xa8\x9c\x54\x2f\x48\x46\x0e\x72\x52\xa5\x1b\x3a\x9c\x6d\x6f\x09\x1d\x88\x37\xec\x58\x14\x91\x55\x01\xbe\x7a\x9c\xae\xea\x32\xf2\x2b\xd4\x35\x98\x56\xb4\xd6\x71\x7c\x10\x9f\x4e\x22\x98\xbf\x1a\x12\x37\xb6\x39\xd2\x23\xc1\xbe\x8e\x88\x94\xa3\xcd\x5a\x6f\x0f\xe4\x0a\x3a\x46\xc3\x7c\xac\xd1\x8c\x6e\x40\xe3\x90\xa4\x40\xc0\x39\x04\x49\xa7\xd6\xb4\x57\xdb\x2c\x54\xc9\xbd\xef\x1c\x1c\x0b\x25\x3f\xe4\x38\x07\x7f\x01\x0a\x5e\x42\x20\x08\x0d\x97\x55\x98\x7e\x15\x94\xff\xc0\x90\x2f\x87\xd8\xcf\x36\xb6\x05\x93\x16\xaf\x48\xf4\x58\x8e\xaa\x4f\x1e\xb8\xd1\xe4\x5b\x5a\xf2\xf9\x16\x28\x49\x90\x48\x91\xa2\xfc\x6d\xe4\x9d\xf0\x63\xe2\xe0\xfb\xac\x5f\x1f\x34\xfe\x26\xd3\xaf\xdc\xb3\xdb\xde\xb8\x97\xc7\x58\xe0\xdc\x8e\x32\x41\x06\x7e\x12\xcd\xc9\xc2\x74\x5d\x06\x94\xfd\x2e\x96\xe9\xb8\x6f\x58\x0a\x1b\xb1\x74\x00\x0a\x2f\x50\xf8\xe9\xea\xa4\x4d\x43\x5b\x4a\x1e\xfa\x4b\xe1\x0d\x9e\x8e\xb6\xfc\x95\x9b\x01\x8d\x56\xba\x1f\xfa\xe2\xfd\x0b\xf9\x80\x98\x4b\x9b\xc7\x57\xe6\x65\xe8\x33\x55\xf8\x27\x3c\xf7\x9e\x5a\x6f\x82\x68\xa8\x1d\xcb\x12\x54\x47\x58\xcc\x28\x4d\xa8\x92\x40\x7f\xc0\xe7\x3d\x1d\xac\x37\xb0\x80\xb8\x5b\x6b\xde\x37\x42\xa3\x64\xb5\x6e\xdf\xfd\x60\x1f\x7e\x63\x7b\x28\x1d\xb6\xc2\xcb\xa3\x94\x4f\x7f\x9e\x77\x30\x3d\x26\x35\x55\x7f\xd2\x1c\xed\x16\xe9\x96\x86\xeb\x79\x83\xf2\x97\xb3\xcb\xec\x0d\x1d\x80\x8b\x0b\xff\xc9\x0b\xfe\x04\x62\xe3\xc2\x93\x3a\x9e\xbd\xa4\x4f\x3f\x89\x69\x3e\xd0\xd1\x6b\x20\x85\x35\xd0\xe0\x42\x3f\x7a\x39\x2d\xd1\x25\x27\xf8\x2b\xe4\x8c\xb8\x94\x73\x10\x22\x78\xdf\xee\x2e\xf7\x69\x24\x3d\x45\xfb\x46\x19\xa9\xe4\xec\x9d\x69\xc8\x03\x3b\x77\x65\xe4\xe3\xb7\xf7\xe5\xab\x64\x8b\x2d\x62\x55\xba\xb4\x0b\x41\x53\x5e\x91\xc3\x87\xf4\x85\x43\x1d\x54\x91\x49\x2f\x50\x0f\x66\xca\x20\x67\xb5\x6e\xa2\x1b\x3e\xa3\xd3\x2b\xe1\x80\xca\xfa\xb3\x8e\x0c\x61\xd9\x1a\x3a\x85\xeb\x6e\x93\x96\x55\x2e\xc0\x09\x66\x48\x20\x21\x64\xb4\x33\xdd\xe5\x4b\x71\x60\x34\xb5\x8a\x49\x71\x2d\x2d\x67\xfc\x18\x63\xdf\xa1\x5e\x33\x97\x88\x0b\x54\x49\x05\x1d\xc7\xc5\x94\x78\xa9\x52\xcb\x6d\xe2\x71\x14\x77\xd2\x70\xfe\xab\x8c\xf0\xfe\xab\x2a\x99\xa7\xb7\xd1\x19\x7f\x29\xc1\xfd\x31\xa9\xe8\xee\xbb\x5b\x70\x8b\x5e\x4c\x27\xc8\xe2\xf6\x06\xdb\x4a\x98\xfc\x5d\x41\x5d\x2d\xa1\x94\xba\x15\x2f\x65\xf0\x4b\xf3\x3a\x5d\x5c\x03\xdb\xad\x40\x3e\x2c\x04\xbe\x7c\xc1\x65\x23\x58\xe6\x05\xe8\x34\xf6\x3f\xa3\x60\xbd\x34\xde\xd6\x5d\x54\xf5\xb6\x31\xcf\xce\x1f\xe6\xb4\x4e\xe3\x64\xb1\x65\x78\x51\x3b\x51\x04\x29\x48\xbf\xb0\x72\x72\x61\xe0\x10\xd3\x2c\x33\xe5\xb3\xfb\xad\xc9\xf2\x6e\x83\x53\xf6\x81\x1b\x2f\xfb\x83\x17\xab\xa4\xf7\xfe\x0d\x83\x4e\xc0\x2b\x3a\x3f\x85\x96\xe8\x5a\x06\x62\x04\xae\x9f\x25\x67\x01\xef\xd0\x0a\x48\x51\xcf\x36\x64\x45\x7b\x13\x30\xfa\x60\xd5\x8e\xdb\x5b\xcd\xa4\x96\xe6\xde\xdb\x0a\x98\x6a\x98\x5c\x29\x8e\x3b\x0a\x8d\x14\x67\x4b\x75\xcb\xc3\x8a\xfe\x43\xe3\xd8\xf6\x5e\xb8\xf4\x6e\x32\x86\x00\x2b\x48\x08\xf1\x75\x88\xf2\x80\x9b\xc9\xa5\xf8\x82\x79\x00\x09\x5f\x58\xdb\x6c\x93\x84\x68\x5a\x81\x8f\x83\x1b\xca\x66\x0a\xf9\x63\xfb\x6b\xdb\xd4\x14\xfd\xaf\x6c\x5d\xe1\x02\xe0\x21\xa1\x3f\xb3\x41\xe7\x88\x6e\x42\x0b\xd8\x24\xbf\x2b\x1d\x24\xb5\xbd\x38\xc3\xb8\xb4\x68\x3a\xaa\xbc\x9d\x39\xb0\x16\x90\xfc\x42\x54\x95\xac\x0c\x0a\xcc\x1d\x05\x96\xe7\x51\xa1\x49\xad\x3a\x6e\x31\xc5\x65\x99\xf9\x82\x6b\x89\x16\xeb\x74\x33\x97\xea\x3c\x3a\x34\x75\x9e\x55\xb5\x83\xd0\xca\x90\x68\x3a\xaa\xd4\x57\xbd\x20\x18\x9f\xdf\x1e\x65\xb6\x8d\x8e\x1e\xf0\x5f\x69\xba\x79\x95\x03\x92\xc7\x86\x70\xe6\x38\x95\x5d\x54\x2d\x44\xaf\x49\x0e\x04\xab\x60\x1b\x57\x83\xa5\xa1\xb9\x66\xc9\x57\xa1\xda\xa1\x3d\x69\x48\x84\xa7\xe3\xf0\xf0\x47\x32\x7a\xa6\xb2\x56\x52\xf9\xe8\x3a\xca\x2c\x17\x60\x16\x63\xd7\xc0\x33\x80\xf1\xda\x70\xac\x57\x5e\x86\x63\x23\x30\xa0\xf2\xb2\x55\x64\x5e\x98\x13\x72\x73\xe0\xb6\x2e\x5a\x04\xe8\x9f\xba\xb7\xb0\x88\x43\xe9\xd0\xaf\xb6\x77\x94\x3a\x36\xf7\x7d\xf9\xf2\xe9\x5a\xeb\xfc\x72\x20\x5e\x95\x63\x07\x2f\xeb\x81\x9a\x32\x1a\x0c\x28\xa8\xd3\x26\x5e\x01\x86\x40\x46\x12\x27\x8a\x78\x08\xd0\xe2\xc8\x15\x44\x06\xc3\x07\xab\xe4\x5b\x58\x2a\xa0\x0e\x40\x88\xf2\xc0\xc1\x90\xdf\x1d\xed\x0e\xb4\x70\x8f\xe7\x70\xbd\x7d\xd5\xf8\x25\x39\x14\xc8\x62\xb0\x39\xed\x07\x46\x5c\x63\x77\x7a\x1a\x2c\x01\x79\x1a\xdb\xe1\x3b\xba\x7c\x6b\xe9\x8c\x09\xc4\x0c\xc9\xfb\x09\xb3\x18\x69\x88\x34\x69\x12\x4d\xfe\xb3\xce\xc1\x36\x1d\xec\xcf\x61\x66\x27\xb7\xe9\x36\x9b\xdc\x34\xd3\x0d\x07\xfa\xdd\x3b\x09\xce\x1d\x31\x36\x2f\xee\x9d\xfb\xbd\x53\x39\x0a\xc9\x7a\x3e\xc2\x1e\x32\xac\x8d\x20\x76\x30\x2a\x72\xb1\x03\xb9\x2b\x12\x7d\x53\xc3\x8b\x22\x58\xcf\x1f\xd0\x78\x3d\x62\x04\x28\x96\x7b\xe1\x96\x50\x27\x20\x33\x1d\xbb\x2f\xba\xea\x21\x4c\x5d\x3e\x71\xf9\x87\xc0\xcc\x15\xbb\x50\xde\x3e\x8a\x58\x4b\xe7\x8a\x6a\x6b\x02\x83\x17\x15\xc7\xf4\xc5\x92\x09\x19\x8b\xfd\x2c\x00\xaa\x22\x55\xc2\x70\x66\x06\x1c\x73\xfa\xf4\xc5\xb1\x36\x4b\xb3\xa0\xd8\x8e\xf3\xd6\x3f\x6f\x2b\x80\x9e\xb4\x56\x2c\x40\x49\x8f\xa6\xff\xc2\x23\xf5\xb9\xad\xc8\xe7\x8f\x86\x5e\xd4\x21\xb0\x0f\xde\xaa\xdf\x02\x2a\x7e\xc5\x7d\x30\xee\xb2\x5a\x9d\x78\xb5\x47\x0d\xea\x5e\x96\x03\x41\xdd\xbd\xdf\xdf\xe0\x90\xa0\x29\xbc\xb2\x78\x90\x7e\xef\x2f\x83\x5c\x65\xc8\xfb\xfc\x17\x13\xe6\x3c\x87\x3a\x51\xcf\x46\x02\xbf\x17\x4d\x3b\xd9\x81\x98\x4a\x4d\xf6\xcd\x2d\x7c\x87\xc9\x17\x9f\x8e\xdb\xde\x67\x0f\x36\x08\xb8\x3a\xf0\xb7\x58\x69\x60\x1e\xd6\x0f\xf2\x96\x4c\xb8\xbd\x26\x86\x7c\x7b\xce\x91\x4b\xff\x61\x4b\x36\x1b\x9a\xc2\x9d\x8a\x14\x9f\x88\x47\x1d\x4b\x50\x75\x9c\xbb\x6c\xb6\x22\x91\xd6\x69\x46\xc6\x8e\xab\xeb\x77\xe9\xca\x0f\xfc\x87\xa5\x91\x62\x10\x17\xa9\x2a\xc9\xf6\x12\x08\x96\xa2\x6e\x69\x8c\x1b\xb3\x32\x0c\x79\xb3\x6b\x11\xdd\xfd\xa7\xd2\x4d\x6e\x45\x4e\x28\x89\x7d\x92\x99\xa0\x66\xa8\x40\x09\xa9\x94\x8f\xc2\x36\xa1\xbc\x11\x03\x00\x8f\xe0\x28\xfb\x99\x00\xe9\x08\x6b\x53\x66\xd1\x38\x63\x93\x3d\xae\x19\x7d\x6b\xdf\x70\x6f\x29\xe4\xfe\x68\x72\x8a\x57\xd1\x39\xcb\x18\x99\x43\x85\x92\xa5\xc9\x15\x5a\xd6\xa2\x08\x63\x66\x3e\xeb\xa2\x3f\x76\x50\x52\xd4\xb4\x72\x15\x00\xd3\x8f\xda\x05\x74\x81\x3b\x36\x61\x1a\xb8\xea\x62\x40\x9d\x32\x19\xed\x6e\xd3\xb5\xdb\x5a\xe6\x05\xff\xd8\x27\x9e\x7b\xc1\xc5\xe7\x2d\xc6\x5f\xcc\x52\x96\x7a\x38\x8f\xd6\xcb\xb0\xdf\x47\x81\x89\xe2\x49\x98\x27\x90\x6e\xb5\x47\xc7\x8e\x71\x3f\xd9\x57\x7a\x9a\xbc\xd3\xaf\x7f\x9e\xf4\x41\x5d\x7f\xed\xef\xfd\xd3\xe4\x02\xe4\x32\x87\xe0\x2f\xda\x97\xcf\x74\x5b\xf0\xa5\x4b\x9b\x62\x8d\xbf\xc7\xd0\x45\x14\xcf\xf2\xae\x35\x3c\x9c\x20\xa7\xe2\xef\xfd\x11\xcc\x37\x4f\xa3\x0a\xce\xf8\x0b\x57\x88\xe1\xc1\xaa\x37\x9f\x7d\x4b\xc7\xb1\x96\xa7\xfa\xbb\x7f\x14\x52\xd0\x58\xab\xdb\x73\x5d\xe8\x71\x89\xdb\x46\x70\x81\xae\x96\xc1\x69\xd8\x70\x80\x1d\x83\x41\x09\x4d\xc0\x5d\xc3\x1e\x46\x9a\x17\xbf\x52\x8b\xb0\x23\x72\xf9\x01\x7a\x83\x31\x2f\x3f\xe2\x05\x69\x06\x16\x8a\x69\x4c\x1e\xb7\xa1\x8e\xb4\xee\xf5\x27\x4b\x05\x7c\xc9\xe2\xf5\x08\x97\xe4\x1d\x12\x04\x03\x02\x19\x54\xe3\x36\xa0\xd2\xe7\x7a\x1c\x67\x5e\x38\x8c\xc6\x0f\xd0\xe8\x31\xd6\x81\x63\x31\xf0\xb7\xeb\x8d\x63\x9b\x1e\x80\xc9\xff\xe8\xca\x18\x95\x9d\x06\x72\x45\x7f\xc4\x56\x14\x02\x1d\xa0\x8f\x4d\xc1\xa9\xdc\xb4\x4c\x9a\xad\x7b\xfe\xb7\x74\x05\x70\x53\x5e\x63\x22\x4a\xf4\x5d\x09\xbf\xe1\xda\xd1\xcc\xa6\x80\x31\x31\x41\xc9\xaa\x00\xea\x41\x09\x10\xc1\x23\x78\xd2\x9d\xd6\x79\xe8\xcc\xf8\xdd\x7b\xc5\x2b\xea\xa5\x2a\x64\x2c\x08\xff\xd8\x0c\x80\x54\xa6\x85\x04\x9e\xc7\xa9\x3b\x03\x2c\x84\x86\x17\xdc\x66\xf8\xc8\x91\x42\xf6\x3e\xfa\x15\x5b\x48\x71\xb4\xe7\xf9\xd1\x35\xe0\x04\x44\x1e\x95\x07\x97\x55\x54\xae\x95\x33\x46\x84\xed\x54\x8f\xeb\x6a\x94\x36\x74\x9c\x4d\x57\x52\xa0\xdd\xbc\x31\x20\x4a\x69\xc3\x48\x97\x5b\xa7\x1e\x46\xf2\x30\x62\x60\x4b\x2d\x2d\x1b\x65\x08\xe5\x8c\xf8\x81\xda\xf8\x6d\x75\x3d\x87\x8e\x5e\x3a\x48\x35\xea\x76\x63\x28\xf5\x3a\xc4\xb1\x39\x26\x71\xba\x26\xcd\x38\xfd\xf1\x2d\x49\xfa\x62\x5a\x57\xf9\x5b\x91\x07\xdd\x8d\x4b\xfb\x2e\xb2\xc6\x32\x0c\x90\x30\x19\x4d\x3c\x7c\x5d\x15\x08\x48\x83\x69\x0d\x55\x3f\x4c\xc0\xc3\x36\x9b\x54\x93\x73\x9e\x9c\xc6\xb7\x34\xb2\xd6\xb7\x90\x0a\xa1\x19\x6a\xba\xae\x0d\x17\x01\xcd\xab\x42\x2e\x63\x5e\x7a\x0a\xfd\x20\x68\x36\x0c\x1c\x62\x5f\x0a\xe3\x4b\x35\x29\x3f\x85\x99\xae\xc2\x66\x20\x4c\xdb\x66\x59\xab\xdd\x4f\x66\x72\x06\x40\x93\xd1\x8f\xd1\x85\x5f\x4b\xce\xa4\x0e\x5f\xb3\xfb\xe7\x64\x0e\xa5\x9c\x7e\x57\xa0\x27\x6a\x4e\xf8\xcd\xe9\xa8\x6b\x9a\xce\xe6\x0a\xd7\x96\x83\x06\xb5\x9a\x21\xce\x72\x80\x29\xf0\xe5\x94\xe4\x96\xb4\xf4\x40\x6e\x67\x52\x94\xe1\x41\x79\xa9\xee\x17\x14\x10\xd5\xd5\xa7\xaf\xf8\x53\xbe\x34\xe7\x7d\x92\xe4\x91\xae\x1d\x57\x71\x48\x5c\x58\x91\x6b\xc3\xf2\x12\xbe\x40\x27\x11\xdc\x65\xd8\xc1\xc1\x81\x0c\x90\x2f\xfd\xed\x71\x80\x06\x90\xc9\xf9\x32\x47\x1c\x20\xc8\x79\xc6\x68\x86\xaa\xe4\xe0\x69\x9a\x49\x94\xb8\x64\x2e\x81\xb6\xf9\xf4\x7e\x31\x0e\x27\x29\xb2\xe2\xb0\xef\x1e\x52\xdc\xd3\x3c\x5a\x9f\x90\x15\x8e\xca\x09\x07\xf0\x5d\x5a\x32\x6a\x8d\x0b\x71\x9a\x49\xd0\x8a\x5b\x9d\xc1\x09\x16\x15\xb9\xbc\x8f\x61\x54\x1d\x7a\xb1\x47\xb1\x13\xb7\x76\x70\xa3\x00\xd5\x52\xe8\xbc\xeb\xc5\xfa\x29\xe2\x13\x99\x90\x24\xf5\x93\xad\x9e\x52\x7a\x63\x69\x87\x38\x71\x41\x3f\x43\x92\x06\x90\x4e\xc3\x5a\x15\x35\x4e\xb6\xbb\x4c\x3d\x57\xbb\x7e\xcd\xea\xd3\xca\xb0\xbc\x3e\x52\x83\xe8\x9c\x5a\x10\xd0\xe6\xae\x2b\x60\x57\xdb\x39\x31\xc8\x1a\x56\x80\x7d\x04\x33\x28\x01\x4f\x6c\x2d\x44\x4b\xa6\xa4\x39\x39\xbc\x11\x4b\x5a\x9a\xe6\x8d\x52\x38\xc4\x34\x04\x38\x96\x42\x0b\xd5\xac\x91\x1c\xf6\x34\x51\x5a\x2d\x12\xaa\x87\x67\xb7\xcd\xb0\x0d\xd0\xbf\x70\xce\x88\xf1\x45\xf2\x4e\xb2\x0b\xaf\x5d\x39\x39\xc4\x86\xd9\x21\xe1\xdc\x6f\x75\x75\xa5\x13\xb3\xfe\xb5\xad\x54\xf7\x39\x35\xeb\x6e\x29\xcf\xd5\x90\x57\x66\x2d\x4d\xf9\x0a\x2d\xe2\x75\x83\x9a\x4f\xc5\xb2\x9b\x9f\x17\x9c\x22\x0f\x71\x60\xbd\x48\xdb\xd8\x65\xac\xaf\xa7\x3b\x36\x7f\x7d\x48\x88\x13\x6a\x77\xc1\xb1\xd6\x59\xd6\x23\x1b\x7a\xde\xf2\x45\xa9\x6a\x1f\x8d\x5a\x54\x69\xf1\xe0\x34\x48\x8a\x8b\xf8\xb0\x4b\x37\xdb\xf3\xbb\x78\x44\xb7\x01\x46\x7d\xab\x40\xe9\x4c\xd7\x1b\x14\x86\xa4\x02\x5c\x97\x7a\xe9\x32\x16\x98\xd2\x91\x5b\xc4\x08\x09\x78\xe7\xc9\xc5\xab\xf0\x6c\xd3\x28\x52\x84\x70\x70\x35\xa5\x63\x58\xef\x59\xa9\x60\x34\x16\x55\x5d\x32\x43\xe7\x55\x8f\xa0\x1f\x3f\xb8\xc9\x99\x5b\x5a\xba\x69\xc0\x3f\xf3\x25\x1c\x5c\x6a\x2e\x43\xca\x89\x0c\x00\xe0\x96\xa5\x8d\x9b\xfc\x14\x45\x47\x4b\x2f\x8d\x84\x4f\x70\x94\x31\x68\xb3\xff\xee\x39\xfb\x59\xbe\x42\xde\x92\xb3\xae\xc9\xba\x13\x21\xa5\x5d\xf3\x98\x6f\x42\x5a\xe5\x72\x86\xa2\x12\x8c\x94\xb2\xfb\x28\x31\xbf\x5d\x05\x43\xe2\xc6\x5b\x96\x41\xa8\x49\x1a\xed\x38\xcb\x0f\x09\xc8\xb7\x9a\x11\xe5\x5c\x3a\x68\xc0\x6d\x5e\x9a\x64\xc3\xc2\x45\x41\xdd\xd0\xb6\xa5\x4e\xb5\x4d\xc9\x5d\x71\xe4\x6f\x36\x7c\x07\x19\xb3\x2b\x24\x75\xb2\x36\x28\x72\x9a\xe3\x24\x35\xd1\x20\x27\x16\xca\x71\x5b\x59\xa1\xa0\x1c\x27\xec\xe5\x14\xe9\xd6\x4f\xc2\xb2\xe9\x1d\x08\x94\x00\x92\x1a\xd9\x03\x8a\x72\xf5\x56\xb1\x80\x9d\xf5\x87\x4e\xec\x61\x5b\x1c\x05\xe8\x54\xd9\x22\xc7\xe9\xc1\xeb\x24\x26\xf1\x9f\x6a\x7e\x28\xa4\x53\x63\xd2\x27\x1c\x8f\x8a\xab\xc0\xb0\x5a\xab\xf1\xb8\xf5\x78\xd1\x44\xd4\x21\x6e\x7a\xad\x23\x9b\x9c\x29\xe3\x11\x98\x93\xd8\x31\x62\x54\x48\xab\x29\x9b\x2f\xd4\xae\x67\xb6\xc7\x55\xb4\xe4\x33\xb4\xae\x42\x91\x1c\x77\x96\xf0\xdb\xe6\xd8\xce\xfd\x85\x86\xbe\x60\x45\x25\x12\x05\xe1\x34\xa5\x0b\x9b\xb4\x93\x7b\x3e\x02\x0f\xef\x8b\xde\x07\x08\x3b\x5a\x7d\x7a\xe8\x25\x88\xd9\x15\x53\xdc\xfd\xb1\xa6\x6f\x03\x40\x76\x7e\xe0\x39\xf8\xdd\x1c\xf4\x21\x5e\x47\xdd\x24\xad\x20\x76\xd7\xce\x8a\x5c\x2c\xfb\x14\x11\x6f\x2b\x32\x04\xaa\xd2\x1c\x52\x61\xf6\x2d\x9d\xa5\x1e\x5f\x9f\xc2\x3a\xd8\xcf\xe3\xa4\x90\xeb\xb0\x37\xe7\xc3\x89\x5a\x6b\x93\xe5\xe5\x80\x94\x55\xf1\x34\x59\xd4\xba\x2a\x8b\xf8\xf7\x77\x92\xb1\x3c\xb1\x07\x17\xb0\x4e\xfc\x3f\x88\x8c\x75\xa3\xdd\x5a\xcc\x82\xd5\x01\xd6\x49\x36\x89\xc5\xf2\xea\x93\xb8\xf3\xd3\xc8\x52\xec\xe5\xe4\x57\x38\x3f\x70\xe9\xdd\x8d\x9c\xd8\xe2\xc8\x09\x56\x27\x04\x71\xde\x9c\x44\x45\x84\x34\x6d\xb4\xf5\xa5\xd6\x94\xb9\x76\xb7\x19\xa6\x70\x40\xda\x13\x04\x06\xa5\x7f\x15\xdb\xa8\xfd\xfe\x8e\x7c\xfc\xc0\x33\x56\x48\x31\x99\xf8\x5d\x9e\xa3\xc7\x07\x9f\x95\x18\xd1\xb5\x89\xbc\xf0\xc3\x0c\x36\xe5\xb1\x49\x31\x05\xe9\x21\x13\x98\x4b\x23\xdc\x90\xbc\x3e\x0e\x6f\x37\x1d\x5f\x0d\xc3\xc0\x39\x4f\xbd\xaa\x1c\xc1\x78\x89\x6b\x1e\xa3\x3d\x20\xf8\xc5\x01\x87\xc0\xca\x34\x28\xe0\xdf\x20\x6d\x5d\x2d\x46\xe3\xde\xa2\x34\x2b\x3e\xbd\x61\x25\xb3\x6f\xfc\x93\xf5\x81\xfc\xd3\xce\x32\xb0\xdf\x59\xf2\x25\xd7\x25\x7f\x95\xd7\x66\x9e\x0e\xd4\x3d\xfd\xee\xde\x31\x82\x57\xb7\x48\x8b\x83\xbe\x4c\xae\x34\xfc\x95\x55\x97\xa2\x50\x0e\x22\xb9\x2b\xc8\x79\x39\x7f\xd8\x48\xc2\x04\x13\xe2\x8a\x64\x70\xca\x1e\xf3\xde\x30\xa8\x4f\x35\x42\x21\xac\x98\x36\x70\x5a\xcb\xb9\xe2\xb6\x41\xb9\x68\x34\xdb\x19\x20\x03\x53\x12\x1f\xc0\x82\xff\x22\x2c\xbe\xac\x16\xca\x81\x8e\xcd\xda\x3d\x54\xfd\xf7\x39\xf6\x7e\x28\xd3\x32\x40\x64\xa3\x26\xd8\xdc\x48\x19\xb3\x05\xcb\xf9\x55\x11\x5d\x57\x5d\x9b\x52\xb3\x3a\xf6\x7b\x16\xe3\x09\xf0\x27\x53\x59\x69\x1d\x08\x1e\x4e\x92\xbd\x69\xaa\x8d\x0b\x8b\x62\x0d\xea\x4a\xda\x23\xca\x91\xff\x79\x4b\x5f\xc9\xa5\x54\x37\x60\xfc\x3e\xe6\x08\x0d\x74\x8b\xbe\x1c\xd4\xef\x0f\xd9\x1d\x3a\x28\x22\x89\x8b\x98\xda\x0a\xd9\xe4\x74\xb1\xe8\x36\xdc\xc8\x22\x7b\x90\x26\xd2\x13\x8b\x44\x40\xae\xe4\x2a\x45\x9a\xe4\xe2\x32\x7b\x2d\x0d\xfd\xa2\xc4\x87\x8b\x91\xf8\x4d\x91\x20\x27\x59\x10\xcc\x32\xd8\x56\xf0\xb5\x9c\x0b\x36\x54\x78\xf2\x7a\x82\x6b\x18\x9d\x39\x94\xb1\x42\xc0\x73\x4b\x38\x87\xde\xe4\xe2\xb1\x9b\x54\xa2\xac\xb4\xf1\xe8\x3e\xdb\x27\x9a\xc3\xaf\x04\x96\x36\x69\xab\x86\x6b\xf9\x83\x72\xd9\xa0\x85\xc7\x8c\x93\xe1\xd6\x68\xe1\x79\xe2\xc8\x72\x7d\x22\x92\x10\x38\x7c\x93\xd8\xf0\xb8\x43\x0c\xe7\x98\xdc\x40\x22\x30\x5e\x5a\x5a\xdf\x1f\x35\x23\x20\x45\xc4\x4d\x94\xda\xe3\x26\x25\x55\x91\x67\xce\x30\xea\x5f\x45\x2b\x91\x79\x64\xbe\x20\xd9\xe9\x14\x65\x70\x46\x1b\x87\x4a\x09\x39\x3a\x19\xfa\xb7\x2a\x73\xf6\xab\xe9\x5d\x37\x8c\x9a\xb0\xdf\xb4\x54\x0f\x47\xed\xc6\x47\x0d\x7a\xbf\x39\x86\x17\x88\xce\x78\xef\x50\x8e\x04\xbe\x7f\x17\x77\x3a\x93\xc0\xf7\xe5\xa4\x2a\xde\x77\x66\x93\x6f\x07\xfb\xe5\xbe\x79\xd4\x4a\xc2\xbb\x7a\x4f\x13\xab\x30\x2c\x7e\x4a\xf0\x56\x20\x3e\x63\x7f\x01\xb7\xa7\x80\xb8\xe5\x03\x49\x16\xd7\x32\x5d\x9c\xa4\xed\xe1\x88\x15\x7e\x8f\x02\x4f\x66\x96\xf6\xc5\x42\x29\xab\x39\x8d\x0f\x07\x6d\xd6\xab\x98\x65\xa4\x2b\xd7\xe8\xab\x07\x6e\x5c\x39\x2b\x40\x31\x3a\x21\x84\xb1\x0b\x16\xff\x42\xc2\x63\xb7\x24\xec\xd6\x2e\xad\xc4\xb8\x30\x17\xe5\xc6\x7b\x52\x2b\xa4\x4c\x2d\x51\x40\xae\xf9\x77\xf2\xc2\x40\x60\x03\x9d\xce\x1c\x26\x00\x0d\x6a\xf3\x05\x46\x6a\x8d\xb0\xba\xf1\x2c\x7c\xe3\x70\x8d\xec\x46\xb5\xf1\x8e\xe7\xb4\xb1\x3c\xe9\xfc\x43\x37\x5d\x29\xcc\x07\x79\x36\x8d\x91\x59\xf6\x01\x6d\x4f\xc0\xe3\x73\x3c\x3e\xe8\x4e\xee\x5d\x31\x4f\x7e\x3c\x72\xf1\x58\x1b\xb2\xf9\x3b\x5a\xdb\xef\xd1\x73\x66\xe7\xa7\xa7\x3a\xe9\xc6\x42\xb3\x82\x83\x41\x8a\xbf\x64\x4b\x34\x5e\x7e\x0f\xcf\xcc\xac\x42\x27\x35\x7d\xc3\x78\x29\x9b\x5e\x8b\x1c\x33\xd2\xa5\xce\xeb\x85\x00\x56\x0c\x82\xeb\x4b\x5b\x7a\xdb\x1f\x50\x00\x53\x0a\x19\x64\xf8\xcc\xc8\x63\x1b\x7c\x08\xff\xa9\x8c\x3b\x0d\xf7\xda\x25\x8c\xbd\x20\xb3\xda\xb9\xc7\xa9\xc5\x3c\xcd\x02\x96\x7c\x20\x10\xef\x3f\xd2\x53\x26\x01\x42\x6f\x9d\xfe\x4f\xce\xe2\x1c\xfa\xf9\xed\xf1\x00\xd3\x51\xdc\x61\x87\x81\xf1\xe2\x43\xa5\xc7\xf0\xf5\x84\x33\x0f\x3f\x27\x07\xbf\xab\x85\xac\x28\x03\x56\x13\xf2\xc1\xd4\xab\xbd\x36\x16\xcd\xb7\x5b\xe6\xcb\xec\x5a\xcf\x7e\xc3\x29\xfb\xd8\xb6\x3b\x0c\xbf\xbc\x88\xcb\x9b\x0e\x11\x48\x47\x5b\x01\xba\x92\xe0\x57\xa4\x36\xda\xb5\x54\xc4\x38\x26\x5b\xb2\x4e\x15\x50\x87\x3f\xdb\xe4\x3e\xda\x76\x99\x05\xf9\x9e\xbe\x6d\xf6\x59\x00\x8f\xd2\xda\x5a\x92\x40\x31\x5b\x50\x64\x3d\xb2\x25\x2b\x33\x74\x80\xa4\x68\x5e\x01\xb1\x6e\xcf\x30\xf4\xfc\xba\x47\xd9\x88\x58\x97\xd1\x94\x7f\xfb\x6a\xa2\x66\x63\x6a\x16\x2e\xdc\xc5\xe8\x7f\x24\x41\x38\x09\x57\xf0\xee\x81\x10\x2b\x77\x00\xc8\x4d\x6d\x90\x71\xfc\xb0\x1e\x06\x5f\x9d\x04\x92\x4a\x3a\x89\xe2\x31\xbf\x03\x18\xc6\xe5\x3b\x0b\x53\xa0\x47\x36\x10\xbc\xc6\x96\x3c\xcf\xe8\xf4\xed\x41\xc3\x69\x4a\x01\x0f\x2b\x36\x60\xf0\x74\x46\xc7\x4a\xca\xda\xfa\x55\x7b\xdc\x6b\x06\x0a\xf0\x83\x40\x8f\x2d\x40\xff\x70\x6e\xca\x06\x4b\x23\x07\x11\xb6\xc3\x0b\xe1\xfa\x1c\xce\x81\xfa\xd5\x7c\x0f\x07\xeb\xc8\x87\x5c\x08\xd7\xbe\xc0\xea\xf5\x5f\x21\xb9\x0c\x64\x25\x74\x70\xcb\x6c\x6d\x9b\x0a\x49\x50\xb6\xfc\xa0\xfa\xb4\xe3\x16\xd7\xf0\xca\x61\x84\x09\xe6\x48\xcf\x14\xf4\x7c\x91\xd8\xe8\x3d\x0a\xc6\xaa\x52\x30\xb4\x0c\xd8\xc3\x6c\xc9\xe5\x95\xb9\x16\x0c\xc9\xa3\x99\xbc\x70\xd3\xb7\x4a\x19\x95\xaf\xd3\x64\x2f\x9f\x65\xce\xd5\xdd\xe1\x0c\x93\x27\x41\x5e\xdc\x17\x3f\xbd\xa7\x03\xa9\xff\xfd\x0f\x77\xb4\x87\x21\x34\x0c\x42\x48\x8f\x82\x68\x26\x25\x36\x86\xaf\x44\xc3\x9c\xcc\x42\xcd\x82\x46\x54\xb6\xba\x00\x1c\x4f\x83\xe7\xcd\x95\x4b\xca\x2f\xe5\x9b\x11\x52\x73\x1f\xf4\x15\xec\x41\x00\x61\x55\xb2\xe7\x46\x92\x96\x61\x57\xea\xe8\xc3\xc0\x42\x1e\x64\x1e\xc2\xb3\x0e\xf8\xe4\xa5\x0d\x02\x8e\xfb\x9c\xcd\x26\x7e\xb6\x6f\x6b\x04\x5a\x59\x15\xb6\xbc\xe7\xee\x45\xd8\x02\xa1\x47\x4c\x72\xb1\x27\x5c\x89\x7f\xb4\xfb\xf0\x43\x95\x37\x95\x54\xc6\xbf\x93\x96\xdc\x9f\x5a\xc0\x6b\x2c\x6e\x8f\xe6\x65\x26\x5d\x3e\x5a\x47\xa4\xc9\x18\xb2\xe1\xc3\x2e\x8e\xcd\xc1\x81\x67\x66\x33\x6d\x01\xc6\xa1\x67\x5e\x4f\xda\x6d\xf9\x0f\xb6\x73\x7d\xed\xb4\xf4\x3d\x6b\xc4\x27\x3d\x72\x45\x98\x11\x09\x46\x3e\x59\xf2\x65\x9b\x2f\x85\x1a\xfb\x3a\x97\xe0\x8c\x0c\x3b\x34\xfc\x4c\x76\xdf\xb5\x6a\x97\xfa\x7d\xfa\xc7\x7f\xd7\xa3\xfb\x71\x0d\xc7\xb0\x9d\x06\x6f\x79\xf8\x03\xbb\xa8\xc8\x03\x53\x1c\xcb\xf9\x23\x23\xce\x6c\x8a\xe4\x8f\xd8\xeb\xe4\xfb\x22\x4b\xbe\x2f\xcd\xf9\xad\x05\xb6\x74\xbb\x4a\x25\x56\xd4\xea\xf2\xf1\x96\xd9\x66\xbf\x1e\x4d\x7b\x84\xeb\x5d\x7c\xda\xa6\xcf\x88\xeb\x1b\x20\x74\xc7\xfb\x86\xa5\x20\xdf\x5b\x74\xfa\x63\xee\xc7\xce\xa2\x47\xbb\xc7\x46\x36\xf0\x40\x2c\x61\x40\x97\x44\x06\x52\x60\xfe\xaf\xa5\x17\x5d\xd4\xa2\x1d\xfc\x5f\xbe\xe9\x33\x33\x99\x0b\x13\x08\xcd\x33\xbd\xe6\x69\x90\x3f\xb7\xea\x5c\x5e\x27\xae\x88\xb7\x29\xcb\xd3\x8d\x15\x73\x58\x81\x64\x44\x89\x4e\xe1\x95\x70\x51\x12\xbf\xe4\xd1\x13\x9e\x0a\xdd\x01\xb3\xdf\x36\xad\xb5\xfb\x79\x85\x72\x0d\x46\x6f\x2a\xd8\x94\x71\x43\x33\xbf\xae\xb6\xf5\xd1\x3a\x5f\x6a\x7f\x12\xd4\x36\x95\x56\x77\xf6\x9d\xdd\x27\x4d\x30\x97\x3a\x24\x60\x23\x97\x61\x6e\xb8\x93\x8c\xa9\x3d\x87\xaa\x50\x36\xf8\x1f\x6f\xf2\xa0\x7d\x58\xf8\x93\x28\x23\x16\x86\x63\x07\xf0\xf6\x96\x58\x8d\xd9\xca\xe4\x55\x56\x52\x3d\xdb\x28\xde\x4f\x7d\xed\x9a\xa9\xff\xf1\xbb\x33\x51\xc6\xe7\x42\xdb\x66\x36\x95\xa6\xc7\x41\xc4\xa1\x75\x6f\xbe\x3e\x8f\x97\xad\xa8\x90\x4b\x90\x98\x53\x1e\x2c\xeb\x99\x2b\xbf\x73\xdf\xcf\xb9\x97\x6e\x0d\x42\x47\xa4\x85\x67\xf4\xdb\x1b\xd3\x68\xcc\x83\xf4\xcb\xb9\x9d\xb0\xf5\xcf\x0e\x4a\x00\x92\xf7\xb2\x0d\x6c\xca\x04\x0b\x01\xfb\xbc\xf1\xe7\x1a\x39\x78\x2c\x9a\x60\x73\xf5\x25\x37\xcc\x9f\x9f\x72\x8f\x28\xc7\x3d\x7d\x87\xfd\x1c\x88\x3c\xf7\xea\x4e\xc2\x12\x0f\x57\x60\xd2\xbb\x2c\xc2\x58\xc7\x11\x96\xa6\x75\x35\x38\xf5\xd0\x97\xf2\xf1\x35\x19\xb9\x2e\xff\xab\x83\xc7\x84\xca\x5c\x5a\xee\x39\xdf\x19\x21\x9a\x46\xde\xb1\x8d\x0a\x37\xec\xeb\x70\xf8\x87\xbf\xc9\x5d\x33\x7b\xcd\xb6\xf8\xb5\xdc\x3a\x34\x55\x44\x82\xd2\x04\x5c\x1e\x68\x78\xca\xf7\x99\xbb\x5c\xfa\xe6\xeb\xdc\xe6\x13\x7c\x75\xd5\x6a\x52\x17\xd5\x91\xaa\xcf\x4c\x94\x54\xdf\x3a\xc6\x51\xbb\x0e\x71\x41\x8d\xbd\xc3\x02\xc9\x3b\x2e\x78\xab\xba\x2f\x77\x95\xe8\xe4\x98\xad\x92\xd6\xcc\x25\x5d\xfb\xab\x7e\x44\x90\x1e\x33\x02\x62\xfd\x7f\xe9\x7e\x54\x73\xf4\xf8\x92\x15\xbe\x30\xee\x70\xf1\x21\xf4\x7d\x58\x87\xe4\x34\xfd\x90\x09\x60\x11\x1c\xce\xe4\x91\x15\xd0\x55\x4b\x7b\xe0\xc5\x93\xb2\xdc\xe1\x58\x8e\x0b\xf5\xf1\xa2\xa4\x85\x4d\x4c\x0e\xee\x5a\x9f\x8c\xb2\xef\xae\xb0\x52\x0d\x4f\x9f\xcb\xa1\x82\xba\xdd\x41\x0a\x6d\xbd\xec\x50\x4b\x22\xdb\x7f\x2d\x3a\x0d\xae\x25\x9a\x4d\x6e\x09\x44\xe0\x68\xd5\xfd\x88\xcd\x64\xc3\xda\xc9\xbb\xaa\x9a\xd6\x12\xf5\x36\x99\x8a\x57\x27\xda\x0f\x3c\x5c\x6d\x48\x72\xc6\x70\x7a\x53\xef\x43\x5c\xef\x5f\x0d\x3d\x15\x1d\x19\xc7\x50\xbd\xc7\x80\x25\xa6\x30\xdb\xaa\x16\x39\xcb\xa5\x73\x3e\xcb\x6e\xe9\x63\x9c\x89\xcf\xc2\x77\x6f\x83\xed\x87\x98\x70\xb6\xde\xe9\xda\x48\xf7\x5c\xf3\x33\xad\x57\xb1\xd7\x5b\x5c\xb5\xcc\x99\xcb\x29\x8c\x6f\x3c\xe3\xac\xc6\x6a\x02\x8b\x67\x66\x99\xfe\xf9\x03\x72\x6e\xbb\xb4\xc8\x7f\x11\x0b\x3f\xfc\x8b\x3d\x44\x10\xcf\xcc\x45\x05\x5c\x47\x8e\xf5\x54\xcf\x24\xe8\x27\xdd\xb8\x46\xa2\xb6\x81\x1c\x5d\x72\xd6\xb3\x8e\x0a\x1e\x01\x2a\xe1\x17\x57\xb5\x5f\xa2\x05\xfb\x0e\x27\x98\x31\x04\x2f\x5f\x3d\xcd\xcc\x0a\xe4\x28\xf4\xae\x05\x4d\xc7\x36\x9d\x98\x22\x26\xe7\x2c\xab\xd2\xa2\x72\x85\xf8\x8c\xad\xe1\x7f\x4b\x7e\x23\x27\xc5\x69\x74\x46\x72\x89\x05\xfd\x2e\xb6\x91\xcb\x4c\xd0\xea\xee\x7b\xe5\x09\x32\x36\x56\xe6\x00\x75\x69\xf3\xd9\x42\xf5\xe0\xbb\x7d\xee\xd6\x95\x30\xba\xd2\x68\xf8\xc1\x0a\x15\x14\x62\x9c\x73\xd3\x69\xfb\xf9\x22\xdd\xa6\x0b\xc7\xce\x1a\x93\x41\xfa\x6b\xf8\x2a\x8a\xaa\x1d\xed\x46\x7b\x1a\xbd\x27\x18\x1e\x3d\x40\x8c\x55\x90\xc3\x91\x16\x10\x31\x7b\xee\x03\xb6\xcd\x35\xb9\xe1\x80\x7d\x55\x1d\x35\xab\x16\x32\xb0\x1e\x43\x98\xf4\x63\xe5\x34\xad\x96\xc0\xa9\xd1\x0e\xf5\xff\x52\x72\xa3\x0b\x13\x35\x6b\xf3\xba\x47\x08\x65\xb9\x57\x85\xbf\x2b\x07\x81\xe5\x97\xd3\x82\xca\x79\x03\xea\x13\x93\x88\x9b\x35\x21\xd8\xf0\xcd\xa7\x88\x26\x8f\xa6\x4c\x8f\x0a\xa0\x7b\x73\x5b\xb0\xf5\x65\x05\xcf\x2c\x89\x2a\x09\x6b\x74\x32\x6a\x95\xf3\xb0\xde\xa0\x0b\xba\x14\x09\x6a\x24\xa1\x52\xfc\x0d\xee\x10\x7d\xa9\x71\x05\x2e\x64\x88\x73\x90\x80\x8c\xdf\xeb\xa0\x21\xc9\xb1\x26\x96\xf2\xf9\x50\xaf\x93\xb6\x72\xcd\xbe\xf3\xa0\x93\x09\x89\xa8\x8d\x8d\x00\x08\x41\x43\x2d\xf9\x12\xbe\x5a\x9c\x9b\x4b\x6d\x59\x35\xeb\xfe\x19\x9d\xa0\xd6\xf6\x0a\x9d\x9b\x76\x67\x4c\x19\xaa\x7d\x6d\x17\xc5\x8f\xe6\xbe\x97\xe0\x23\x61\x0b\xce\xa5\x6d\xd2\xac\x62\x56\x68\xae\x18\x72\x71\x6b\xe7\x9c\xcc\xd3\x86\xb6\x86\x2e\x0e\x06\xe2\x76\x1a\x0c\xd7\xdd\xcc\x51\xb6\x8b\x75\xda\xe5\x8d\xfa\x75\xdc\x6f\xd6\x29\x65\x14\x34\x34\x0a\x56\x92\xfb\xdf\x3b\xda\x47\xaa\xbb\x4b\xa0\x26\x76\x9c\x9b\x6e\x7b\x3c\x0b\xb8\x2d\x18\x08\x38\x5b\x1d\x73\xbb\xde\x37\x5c\x4b\x41\xce\xcd\x56\xdb\x61\x31\xca\x9b\x34\x04\x20\xb2\x2e\xd1\x79\x68\x48\xa6\x19\xea\x67\xb8\xa5\x72\x25\x2b\x54\x75\xed\x12\x86\x81\x15\xa3\x07\xdd\x38\xd6\x69\xeb\x92\xcf\x2c\x15\x49\xd8\x09\xba\xa6\x11\x2f\x07\x42\x86\x24\x56\x01\x50\xcf\x5a\xa1\xc0\xc1\xa7\xfc\x14\x94\xc1\x2b\x87\x74\xee\x89\xee\xfe\x1f\x7b\xef\xb6\xe5\xb8\x71\x6d\x89\xfe\x0a\xdf\x54\x79\xc4\x52\xd7\xdd\xb2\x1f\x7a\x8c\x54\x49\xb2\xd5\xdb\xba\x0c\x95\xf6\xd6\xf1\x23\x48\x82\x24\x5c\x20\x40\x03\x64\xa6\xe8\xa7\xfe\x96\xfe\xb4\xfe\x92\x13\x73\xae\xb5\xe2\x02\x80\x24\x98\xc9\x2c\xb9\x4f\xbb\xc7\x68\x6f\x55\x92\x04\x02\x81\x88\x15\xeb\x32\xd7\x9c\x03\xe3\x0b\xaf\xb9\x8a\xe9\xf8\x33\xd3\xa3\x5e\x67\x92\xba\x42\xa4\x65\xa3\x61\x9b\xb8\x94\xf4\x68\x56\x88\xed\x0c\x06\x70\x06\xbd\x08\x86\x46\x62\x4f\xd9\x98\xeb\x06\x8e\x78\xcd\xad\x3d\x37\x62\x44\x00\x06\x97\xdc\x14\x0b\x0c\xf1\xd4\x3b\xe3\x3a\x76\x3b\xb5\x4a\xa3\xb0\x85\xda\x22\x44\x5e\x27\x63\x26\x52\x93\xf3\xcd\xe9\xfe\xe0\x43\xfb\x73\xb6\x57\x58\xec\xf8\xab\xcf\xbe\xab\xee\x10\xeb\x48\x54\x31\x42\x4f\x74\x94\x47\xd7\x22\x56\x59\xc1\x55\x1f\xc5\x4a\xfa\xcb\x55\xa1\xfb\x9a\x05\x23\xd2\x88\x9c\xa1\x97\xa9\xdb\x5f\x48\x31\x7f\x1c\x2e\xc9\xf3\x17\x4e\x09\x5c\xb0\x03\x50\x80\x34\x85\x47\x81\x92\x82\x50\xd0\xb8\x72\x04\x6c\x25\xa2\x0e\xa0\xb3\x16\x8e\x59\x7f\xfe\x4e\xa3\x03\x58\xd3\xc0\xd6\x3a\xc5\x43\x35\x22\x96\x24\x61\x58\x99\x10\xb4\xfc\xaa\xf8\xd3\x6f\xf1\xd5\x93\x98\xa9\x18\x64\x88\x41\xf5\x08\x5e\x1e\x8e\x7f\xb3\xf9\x10\x91\xc4\x17\x8b\x77\x9f\x03\x99\x16\x79\x04\x41\x84\x5c\xab\xcd\xce\x8b\xdd\xcf\x45\xcb\xc1\xff\x3a\x85\x4e\x4f\xb5\x00\xb8\x88\x16\xea\x69\x50\x58\xfa\xd5\x14\xe9\x8d\x76\xd1\x83\x4f\xd4\x20\x00\x73\x1b\x50\x88\x6f\x16\xde\x5d\x9b\xd2\x65\xdc\xee\xac\xb4\xb4\xcb\x37\x38\x2b\x1b\x1b\xee\x42\xa5\x32\x61\x4f\x80\x1e\x05\xd2\x60\x85\x65\x83\xd5\xda\x71\xf1\xf4\x8d\x9b\x22\xb7\x00\x6a\x97\x4a\xe5\x09\xc5\x60\x17\x6f\x28\xf6\x64\xd7\xec\xf9\x45\x04\x0b\x9a\x63\x69\xd8\x02\xd4\xae\xcf\x7b\x7e\x51\x2f\x79\xb6\xdf\xd5\x60\x86\x9c\x6b\x2e\x24\x4c\x06\x4a\xba\x7f\x65\x12\x2b\xf2\x61\xa3\x16\xbd\x9e\x23\x38\xb4\x0e\x7d\xca\x34\xe0\xc9\xb3\xc9\x5f\xf6\x72\x51\xa5\x93\x0a\x17\x8a\x2f\xa3\xcb\x38\x5a\x6a\xdd\xc1\x69\x5f\xee\x73\xd2\xf5\xc0\x43\xd4\x5f\x8a\x0e\x73\x78\x1d\x69\x32\x35\x5d\xbf\x4f\x0a\x18\xfc\xc3\x31\xc0\xe0\x10\x56\xf0\xf5\x49\xac\xe0\xbb\x21\xac\xa0\xec\x99\x71\xfe\xaf\xfb\xc3\x19\x50\xc5\xdb\x47\x36\x77\x8c\x29\x61\xac\x41\xcc\xe1\x89\x1f\xb7\xf5\x3d\xd1\x75\x2e\x52\x2a\xef\xd4\x8b\x03\x3b\xce\xcd\x53\xb0\x86\x9c\x82\x5f\x7c\x58\x03\x81\xcb\xad\xad\x20\x20\xec\x2b\xe1\x2b\xc7\xa0\xb4\xb9\x43\xf2\x66\x34\xf3\x04\x59\x08\xb4\x42\xdc\x9e\x18\x6f\x57\xac\xd0\xad\x4a\xfb\x8f\xef\x46\x6a\xf2\x9e\x2d\xec\x27\xe0\xb8\x86\x9a\xee\x3c\xa6\xa3\x8d\x40\x1d\x6d\x8c\xea\x68\x2d\x65\xbb\x10\x98\xf4\x80\xaa\x59\xac\x67\x16\x1f\xf9\xc1\x9d\xce\xd3\xde\x10\xa6\x27\xdd\x22\x94\x75\xac\x99\xfd\x9e\xda\x61\x2b\x9c\x7b\x93\xe5\xbe\x9a\x8b\xb6\xfd\x89\xdd\xf3\x15\x5a\x9e\x27\xdf\x54\xf0\x5b\x77\x78\x8c\x3f\xa1\x8e\x10\x79\xfa\xd3\x88\x2e\x23\xeb\x94\x54\x24\xbf\x85\x50\x51\x6b\x14\xca\x6d\x34\x05\x0b\x0b\xba\xa4\x09\xc3\xe4\x12\xc2\x9b\x6c\x3d\xf4\x2e\x20\x62\xf0\x90\x01\x14\x93\xc9\x04\x66\x11\x30\x26\x33\xde\x8c\x8e\x11\x0e\xa3\x2a\x48\xb3\x81\x50\x27\x6f\xc6\x8d\x80\x87\x6e\xa8\x68\x9d\xe2\xd2\x94\x18\x27\x2b\xfb\xd3\x92\xd0\x2f\xa9\x95\xee\x91\x30\x3d\xe4\x41\xd3\xb9\xd5\x73\x79\x5d\x28\x69\x6d\x54\x91\x59\x30\x17\x90\xf2\x1b\x67\x22\x46\x4e\xb6\x2a\x50\x65\x6d\x65\x05\x9f\x91\x9d\x7a\xef\x66\xcd\x3d\x69\x36\x40\xb8\x85\xd2\xf6\xee\xc8\x75\x59\x75\x29\xac\x45\x50\x30\x46\x9a\x8f\xb8\xb1\x23\xe5\x60\x48\x70\x2f\xe3\x17\x7e\x6f\x7b\x93\xe7\x30\xef\xa3\xe9\xa5\x40\xd8\x28\xbb\x68\x29\x97\x49\xbe\xf3\x6d\x7e\xb8\x2f\xca\xc5\x00\x29\xc9\xab\x17\xc2\x4a\xf2\xf9\x4f\x3f\xff\xf8\xed\x78\xbb\x3b\x0c\x00\x7a\xfd\x48\x7b\xfb\x28\xa6\xb7\x87\xd3\xe3\x78\x6c\xcf\x40\x6a\xa2\x8b\x77\xf0\xb9\xd2\xaf\xf3\x3b\xd6\xcd\xae\x47\x80\xa0\x74\xfc\x71\xbe\x69\x1a\xfb\xc6\xe6\x85\x58\x5f\x5b\xca\xa2\x51\x1d\x4e\x29\x34\x19\xa9\xa4\xfa\x2c\x52\x67\xfb\xd6\x9f\xcd\x27\x74\x98\xd2\x5f\x7e\x42\x55\x26\x34\x81\xf0\xe2\x97\x14\xc3\x1e\xed\xe0\x0c\x2a\x30\xd1\x96\x24\x5e\xa5\x1d\x36\xa6\x96\x4b\x07\x36\xa1\x51\x37\xef\x70\xd9\x7f\x4e\x58\x61\xdf\xce\x44\xc8\xbd\x89\x35\x5d\x4b\xa0\xc9\xed\xd2\xb6\xae\x80\xa6\xfd\x75\x8d\x0d\xdb\x8c\x24\xb2\xfa\xe1\x7a\xc1\xed\x35\x36\xea\x11\xc4\xc6\x91\x24\xe0\xbd\x3c\x2a\xd5\x83\xdb\x79\xdd\x2c\xf0\xfc\xee\xc8\x61\x26\x0c\x87\x2e\x63\x8e\x04\x24\xd1\x61\x5e\x4c\x3a\xfa\x08\x62\x77\x6e\xc4\x74\x72\x4f\xdd\x0f\xad\x6d\x93\x92\x3e\x6f\x1a\x22\xe5\xb6\x14\xa8\xff\xe5\x82\x62\x7d\x3f\x70\x2c\x2c\x60\x84\xbb\xbc\x6d\x0f\xf3\x75\x00\x3e\xfb\xac\x48\xd4\x4e\xe5\x93\x2a\xa6\x58\x27\xe0\xba\x3b\x77\x4d\x74\x16\x4d\x59\x8d\xae\xa5\x22\xbb\x14\x42\x23\x55\x67\x43\x99\xac\x24\x05\x63\x76\xef\xa2\xb5\x00\x00\x4f\x4e\x3c\x2b\x1b\xf0\x1a\x42\x4d\x34\x63\xab\x01\x82\x29\xa2\xbd\x80\x97\x32\xf0\x79\x68\x2e\x17\x21\x2b\x21\x93\xd8\x9d\xea\xe7\x4a\xa2\xe4\x63\x10\x6c\x1b\x85\xb4\x11\xd8\x13\xb9\x61\x23\x10\x72\xe7\xe0\x12\xde\x4a\x14\xdb\x26\xbb\x33\x86\x7e\xab\xc2\x54\xfe\xfb\x90\x87\x5d\xde\x42\x35\xc4\x1d\xf6\x7a\x64\x49\xe0\x8e\x4e\xfa\x00\x99\xc8\x9b\xdf\x05\x99\xcb\xf8\xa8\x40\x74\xd3\x5a\x62\xab\xcd\xe6\x4d\xb1\x2c\xe8\x73\xd7\xc8\xa8\x73\x36\xb7\x2e\x1a\xd9\x36\x58\xde\x3e\x3b\xdb\xe4\xa5\x8b\x39\xc8\xd9\x51\xbb\xd5\x42\x3c\x08\xea\x68\xa8\xa1\x95\x39\x5e\xca\xab\xb7\x93\xd5\x76\xaa\xf2\x19\xc1\x56\x02\x74\xbd\xdf\xe4\xed\xcd\x55\xa5\xa1\xbe\x85\xe2\xa6\x27\xc7\x88\x59\x31\x86\x8d\x91\x9e\xd6\x9a\x3e\x0e\x8f\xba\xdd\xef\x8c\xb1\x91\xd4\xf1\x9e\xb5\x61\xb2\x12\xf4\x1e\xff\x03\x52\x67\xe8\xd8\xac\x20\xda\xc0\x5a\x68\xfb\x11\x93\x87\x8c\x6d\x3e\xf9\x87\x02\x3e\xa4\x4d\xb8\xa9\xfc\x82\x9c\x63\x66\xdd\xef\x89\x9e\xbf\xcb\x2b\x4d\xa9\x01\x9f\xa1\x14\x29\x28\x7c\x79\x9a\xee\x3f\x80\xaf\x59\x25\x9e\xbe\xfe\x5e\xc6\xd8\x4a\x1e\x66\xb7\xc6\x96\x6d\xf2\x6d\xa9\x47\x37\xff\xd3\xc5\xa4\x4c\xd3\x4b\x3f\x1b\xea\x57\xdb\xb5\x9b\x87\x5c\xd2\xbe\x87\x2d\x3a\x36\x9a\xf5\x61\x93\x9b\x8f\x0e\xb0\xd5\x38\xdc\xa4\x6d\x77\xd6\xd1\xa4\x47\x79\x3e\xa7\xc8\x28\xdc\x21\x04\x24\x82\x4c\x2e\x9a\xf9\x7e\x23\xa5\x59\x85\x02\xc9\x98\xb4\xf4\xc1\x0e\xae\xfd\x0e\xc4\xb7\x69\xba\x30\x6a\x60\x8a\xf8\x40\xb4\xf5\x5e\x23\x56\xb4\x4c\x93\xe8\x0d\xf0\x29\x81\x2b\x98\xba\xda\x18\xc6\x90\x8e\xa1\x80\xc8\x85\x09\x10\x63\xdb\xb4\xd6\x91\xbb\x2c\x2a\x95\x2c\xe0\x42\xa7\xb8\x0e\xeb\xb7\x58\x61\xd3\xb8\xfb\x40\xbb\x9b\x11\xb8\xb8\xc5\xee\x8e\xb6\x39\x99\xa5\xd7\x4c\x98\x07\x71\x29\x73\xb5\xa8\x26\x13\x09\xe3\x59\x56\x16\xc6\x96\xa4\x36\x38\x8d\x10\x70\x50\x8d\x5b\xdf\xba\x68\x4f\x73\xdc\x30\x30\x58\x98\xe8\x52\x18\x49\x7a\x23\x5d\x46\xdf\x66\x77\x75\xf3\x08\xa6\xcc\x98\x5e\xee\xa9\xd1\xff\xd7\x61\x63\xe5\x0e\xdf\x36\x10\xe1\x43\x17\x24\x3c\x6c\xc9\xa4\x08\x49\x98\x4c\x8b\x34\x34\xcd\xf3\x63\x9c\xaa\x7c\xfd\x1d\x5d\x78\xf0\x4a\x48\xa2\xf6\x18\xe9\x69\xad\x11\xee\x05\xef\xe7\xd7\x7a\x80\xf8\xe4\x0f\xd7\x79\x3d\xaf\x9f\xd2\x0b\x94\xd7\x71\xc4\xdd\xdb\x93\x76\x3e\xb3\xd9\x76\xa7\x83\x73\x47\x8a\xcd\x6c\x1f\x84\x83\x72\x65\xcc\x95\x7e\x30\x40\xdd\xc4\x6b\x77\xdf\x2d\x3d\x38\x6e\x91\x09\x97\xb5\x65\x6a\xbd\x2e\x1a\x2c\x4f\xa0\x3a\xed\x21\xcd\x06\x2a\x1d\x69\x23\x9d\x17\x44\x86\x17\x19\x4a\xb0\xea\x1d\x0e\xa6\x11\x86\xfc\xc3\x28\x91\xd4\xee\xd5\x46\x7b\xca\x12\xf4\x69\x2f\x0c\x8b\x68\x30\x9b\x50\x3c\x38\x65\xb7\xfe\xf7\xff\xfc\x5f\x93\xb7\x09\x49\x0b\x04\x05\xf3\x7b\x72\xf9\x7b\x0f\x0b\x66\x66\x04\x7b\x3e\x2e\xf6\xe6\xec\xc5\xd8\xb8\x57\xa2\x01\x59\x2f\x7c\x8c\xc5\xbf\x7b\xe9\xd7\xc7\x2e\xad\x57\x9b\xfa\x7b\x28\x7c\x75\xb7\xaf\xc2\xe8\x07\x74\x04\xba\x37\x78\x75\xec\x06\x1f\x45\xff\x4f\x79\x60\xca\xc3\x89\xeb\xfc\x9c\xaf\x5c\xd4\x52\xaa\xb8\xf2\xf0\x0b\x99\x8e\x48\x74\x89\x7e\x50\x91\x9b\x1a\xac\xc0\x46\xb4\x85\x91\x47\x03\x0b\x09\x8b\x24\xd1\x24\x39\x26\x74\xaa\x31\x57\x7b\x2a\xdb\x64\xd0\x02\xf7\x81\xb3\xf3\x8a\x5f\x4d\x70\x5a\x72\xb1\x58\xac\x4c\x90\x32\x56\xae\x91\xc3\x9f\x47\xe4\x50\x75\xe6\x84\x41\xaa\x95\xf5\xf0\x2b\xb8\x70\xa3\xdc\xd4\x6b\xc6\xa4\x4f\x76\x5e\xfc\xc0\xfd\x19\xd2\x45\x03\x4d\x07\xb7\xcd\x7c\xed\x5e\xef\xcd\xa5\x51\x6d\x94\xc3\x9c\xe5\xab\x3d\x6a\x6e\x19\xba\xaf\xda\xb3\x75\xd7\xef\x76\x97\x88\x05\x9c\x17\x0a\x50\x47\xa7\x6e\x62\x7e\x01\xa5\xaf\x08\x54\xe2\x8a\x36\xe0\xdf\xe0\x9a\x49\xdc\xcc\x30\x97\xfd\x04\x71\x52\xbf\xab\xfe\x7b\xca\x5c\x05\x99\x02\x79\xf8\x38\x83\x1e\x94\xe3\x04\x5a\x95\xed\x10\x43\x3b\xd3\xf0\xd1\x48\xd4\x3a\x20\x39\xf1\xce\x96\x7e\xa4\x7e\x2c\x3e\x5b\x0b\xea\xfa\xdf\xa0\x76\xc0\x03\xfc\x6f\x51\x43\x1d\x7d\xa5\xee\x6d\xb0\x0b\x29\x5e\x68\xca\x85\x96\x79\x0d\x08\x5c\x8e\xdf\x6e\x88\x28\x45\xf4\x4e\x9e\x81\x2a\x49\xca\x9d\x4d\xfe\x8f\x3d\x70\x8d\x37\xd3\x20\x0a\x08\xc7\x98\x1b\x7c\x06\x00\x21\x83\xf1\x3c\x02\xee\x89\xd7\x4f\x52\xb5\x02\x08\x18\xa1\xf3\xf2\x8a\x8c\x06\xe9\xce\x3a\xf0\xc5\x5b\x49\xbe\x27\x74\x88\xd3\xc9\xcf\xfb\xca\x2b\x02\x68\x27\xd4\xb7\xf9\xce\x4a\x16\x92\x89\xec\x37\x8c\x98\x34\xa2\x4c\x2c\x55\x16\x93\x08\xbe\xc9\xe7\x39\x5c\xd8\xe5\xbe\x61\x18\xe7\xd1\x94\x3e\x01\xc1\xc5\x61\xb2\x8e\x52\x9c\x82\x12\xef\x5d\xee\x45\x1e\x75\x32\x67\x0a\x03\x26\xa4\x44\x33\x8b\x23\x40\x93\x03\x78\x2c\xc6\x19\xca\x22\x24\x37\x9c\x03\x4c\xd7\x51\x52\x94\x4c\x45\xec\xbf\xc5\xe4\x3e\x91\x2c\x66\xca\xa5\x43\x97\x9a\xc9\x26\xf6\x4c\x4a\x61\x29\xe1\x67\x8e\xe7\x67\x41\x2c\x6c\xd4\x6c\x41\x04\x75\x8d\x8f\x32\x13\xa4\x44\x01\xa3\xfe\x02\x72\x3c\xf2\x2d\xac\x40\x2a\x82\x1e\x12\x7e\xe9\xcc\xf4\x7e\x62\xba\xcc\x3d\x89\x0c\x43\xb2\x08\x40\x68\xf1\xf7\x65\xb9\xb5\x5a\x1e\x0a\xd3\x04\x71\x0f\xfb\xbe\xb5\x53\x8f\x63\xc3\xe8\xa5\x77\x0c\x08\x59\xec\x34\xd2\x10\x2e\x8c\x41\xdd\x88\x88\x23\x4d\x49\x2c\x8c\xda\x2d\xfe\xda\x43\x5a\x24\x2e\xc8\xef\x68\x34\xfe\x76\x50\x08\x31\x81\x9f\x53\x7e\xe9\x64\x2b\x65\xd4\x85\x20\x2f\xe1\xdd\x23\xae\xca\x73\xb8\x77\xc5\xb1\xd5\xf9\x73\x97\x57\x32\xc2\x8b\xce\xf0\xef\xc1\xfa\x30\x20\xc4\xf8\xe5\xbf\xe0\x29\x7e\x16\x95\x7a\x1d\x59\x9f\xa3\x07\xf6\xd9\xb6\xbc\xff\x9f\x9f\xd9\x71\x17\xe0\xbf\x8f\xed\x7f\x1f\xdb\xff\x3e\xb6\xff\x7d\x6c\x3f\xc1\xb1\xfd\xc7\x87\x1f\xb0\x0f\x39\x01\x7f\x62\x87\xc0\x38\xfe\xa9\x7f\xe5\x30\xf6\x7c\x67\xd8\x19\xc5\x87\x11\xe1\xed\x75\xcf\x53\xaf\x8c\xf2\x7f\xe9\x79\xda\x93\xea\xfb\xf7\x69\xfa\xef\xd3\xf4\xff\xce\xd3\xf4\xdf\x87\xe9\x53\x21\xa3\xde\x3d\x49\x10\xfc\x87\x27\x0e\x82\xbf\xfc\x24\x41\x70\x93\xdd\x6f\x8a\xbf\x7f\xd6\x4e\xb4\x8e\x35\xf9\xb0\x07\x5f\xcf\xa8\x16\xa2\xf7\x17\x20\x2f\x8e\x68\x96\x8f\x52\x77\x41\x6d\x69\xbb\x5d\x03\x97\x23\xd8\x89\x97\xd3\x17\x2f\x5e\x4c\x56\xdb\x13\xd8\x08\xd9\xe5\x5e\x5d\xe1\x18\xf9\xed\x08\xed\x16\xdf\x57\xcb\x76\x64\xac\x28\xb7\x28\xb6\x82\x40\x37\xd0\xdb\x3d\x65\x36\x08\x47\x86\x38\xb5\xef\xae\x77\x6f\xe8\x9d\x36\x03\xcb\x37\x63\x7a\x85\x32\xcf\x60\x15\xc9\x32\x61\x94\x65\x9b\xac\xf9\xe8\x89\x1e\x95\x27\x86\xd8\x7b\xfd\xc6\x01\x15\x1c\x76\x79\xb7\x4a\x80\xba\x49\x95\xda\xfd\xe1\xa7\x13\xe6\x57\x97\x7c\xbf\xaf\xf0\xac\xd5\xbe\xd3\x24\xb0\x5e\x88\x35\x8b\x1a\x48\xed\x1e\x67\x76\x2b\xca\x2d\x66\x50\x9c\x25\x42\x75\xff\x34\xb3\x1b\xb9\x1a\xec\x81\xdc\xca\xe4\x33\x0a\x90\x7f\xdf\xae\x93\xc7\x93\xe9\x94\x4e\x12\xab\x6c\x79\x7e\xdf\x42\xfb\x5f\xd9\x71\xd8\x24\x45\x2d\xdf\x80\x09\x94\xb8\x73\x11\xb2\xc6\x77\xca\xb7\x83\xea\x0c\xe7\x04\x45\x52\x02\x16\xbc\xf7\x48\x56\xc5\xb7\x8f\xdb\x43\x4d\xe5\x51\xbc\xd4\x94\xbd\x2c\x8f\x1e\x71\xfb\xa1\x65\x63\xb5\x7f\xaf\xe2\x8a\x29\xfd\x83\xaa\x32\x04\xaa\x3a\xb6\x74\xc6\x67\x85\x42\xdf\xed\x0e\x41\x69\x9d\x73\x81\xd6\x50\x74\x7b\x28\xad\x64\xac\x25\x14\x78\x25\xa5\xae\x1c\xb8\x86\x4e\x23\xe6\x01\xa6\x16\xa8\x90\x10\x94\x0b\x25\xa5\x95\x77\x03\x7e\x4e\xde\x4f\x59\xe4\x9e\x56\xc0\x3f\x3d\x3d\x86\x44\x94\x45\x7e\x3d\xce\x8e\xe5\xd9\x66\x54\x00\x33\xd8\x3e\x7e\xc4\x3a\x7d\x90\xae\x8a\xf3\xf6\x09\x4b\x8c\xec\xfb\x4b\xf7\x38\x64\xee\x71\xa7\xe5\x8c\xde\x4d\xf5\xd1\x34\xfd\xee\x9d\x55\xc4\x0b\x71\x27\xb5\x5b\x56\xdb\x72\x4f\xf6\x34\x95\x0c\x6a\xcb\x3c\xdf\x92\xff\xbe\x68\x16\x0f\x16\xa9\x92\x00\x68\x40\xf1\xe8\x31\xc9\xc0\xa8\x25\x8d\xd8\x83\x98\x8e\xde\xbd\xa4\x05\xa6\xbe\x0d\x68\x83\xb0\x96\x40\x74\x54\xf9\x20\x22\x69\x0e\xec\xb0\x3c\x77\x91\xa7\xb3\x73\x5e\xbc\x92\xdf\xb8\xbb\xa6\xe1\x8b\xb8\x5a\x9c\xcc\xe0\x20\xe7\x25\x99\x9b\xa4\x54\x3c\xcb\x0d\xb1\xd6\x6b\x38\x83\x23\x3e\xb5\x66\xbd\x0e\x73\x84\x6f\x3d\x99\x4e\x28\x0c\x21\x00\x33\x22\x98\x8c\x6e\x6a\x2e\xf0\xa5\x96\x0d\x22\x6e\x0a\x01\x31\xed\xe8\x08\xcb\x2f\xe4\x18\xf7\xdf\xe1\xb6\xbc\xcf\x04\xc6\x8b\xd1\xe0\xbc\x07\x9f\x88\x36\x3a\xa1\x83\x55\x36\xbe\x3c\x01\xdd\x5d\x73\x44\x09\xcd\x3a\x8d\x0a\xed\xb7\xf9\xe9\xfc\xa4\xa3\x88\xcc\x66\xf8\xba\x7f\xbf\xbe\x8f\xdf\x4d\x9e\x8b\x2a\x94\x54\x39\xbe\x70\x14\x94\x15\x3b\xa5\x2e\x8f\xef\x15\x1a\xd2\xbd\x03\x13\x83\xc3\x65\x11\x84\xe1\x78\x4f\x38\x50\x3b\xe5\xee\x10\x6c\xea\x6a\x13\xf5\xeb\x73\x70\x11\x75\x34\x96\x7b\x3b\xc7\x22\xf5\x0d\xf1\x31\x3b\x42\x41\x79\x83\xa1\x5b\xe5\x9b\x1c\xfd\x94\x9e\x3f\x5d\xdf\x6d\x16\xce\x2f\xbc\xf8\x85\x19\x54\x13\xf8\x5d\x76\x5b\xb5\x92\xb5\xec\x02\x91\x0c\xc0\x3c\x3f\x52\x80\xdf\xf0\x43\xa0\x9f\x01\x97\xba\xa7\x7e\xd0\x50\x27\x26\x74\xae\x7b\xd4\x21\xb9\x9d\xc9\xe9\x9b\x13\x42\xb1\x42\xbb\xfc\x23\x02\x38\x43\xf7\xeb\xc3\x3c\x4b\x0e\xb6\x1b\xb6\xf9\x67\xc5\xce\xa7\x07\x42\xe3\xee\x32\x23\xf1\xb0\xbc\x3a\x88\x09\x31\x82\x15\x05\x94\x4b\x56\xcd\x98\x80\x8e\x38\xa2\xa1\x8b\xba\x85\x0d\xc5\x64\xc4\xd5\x1c\x38\xc0\xea\x4b\x39\x47\xeb\x24\xa2\xd3\x64\xc6\xa2\xee\x4e\x8c\xb0\xe2\x94\x05\x3b\x18\xf9\xf7\x4c\x04\xf1\xaa\x5a\x01\x8d\x6c\x56\x70\x93\x0c\xd4\x55\x6b\x07\x7f\x75\x11\x6d\x5d\x1f\xe9\x94\xbb\xf3\x25\xf7\xb4\x7e\x5b\x74\xd9\x65\xe8\xd2\xb5\x07\x6a\xd9\x87\xbd\x05\x5e\xcb\x85\x50\x48\xac\x6c\x32\x3b\x88\xa1\x86\xd4\x0e\xee\x12\x3f\xb5\x7c\x2d\x16\xe9\xde\x91\xf3\x3e\x1a\x2f\x57\x30\xc2\x2f\xe9\x86\x3e\x38\x83\x57\xe5\xcb\x62\x67\x4b\x1b\x0c\xea\x39\xda\x9b\xbf\x0b\x92\x72\xd3\x9e\xb8\xd4\x3d\x03\xbc\xfd\xf6\x24\xac\x7f\x04\x9a\x54\x13\x39\x64\xf1\x00\xad\x1a\x58\x48\xe6\x1f\x69\xe8\xb2\xc2\xf9\x81\xf3\xb2\xd8\x6e\x7d\x3b\xa6\x9b\x0d\x37\x8f\x34\xc1\xe6\x40\x44\xcd\xc3\xc9\x24\xe0\x8a\x29\xbd\x20\x07\xd9\x23\x48\x57\x20\x63\x68\xc2\x7c\x28\x28\x1d\x67\xea\xbc\x71\xee\xeb\x28\x19\xeb\x4b\xd8\x15\x5e\x3f\x71\xb3\x18\x87\x3e\x70\xae\x6b\x4b\x4d\xb4\xa1\xda\x6d\xd1\x14\xd2\x05\x54\xc5\x32\x08\x22\xff\x50\x57\x79\xbf\xf1\xd4\x1a\x87\x86\x19\xc8\x86\x41\x6a\x89\xa0\x88\x33\xdb\xee\x45\x22\xf9\x47\xe7\x1c\xa4\xba\x75\x73\x48\xa4\xcc\xdc\x32\x5f\x14\xbc\xc9\x5a\xb1\x8e\xf7\x90\x70\x03\x94\x5e\xf9\xcf\x32\xa5\xcd\xf0\xc4\x5c\x66\xd2\x98\xd6\x31\xf0\x97\xd8\x66\xbd\xb2\xe0\xad\x37\x59\x55\x2c\x49\x69\x45\xdd\x2b\xe7\xb3\x53\x9e\x6a\xc6\x0b\xcc\xe1\x5c\x4f\xda\x8f\x45\x2e\x78\xb7\xb2\xde\x0b\x14\xaf\xc9\x84\xc4\x46\x7c\x84\xd6\x99\xdf\x65\x29\x42\x4f\x58\xb9\xee\x9f\x46\x3e\x39\x11\x81\xde\xc2\x5c\x59\x72\xf1\x14\xad\x32\xc2\xbd\x9c\xf0\x7c\x1f\x33\x5d\x21\xba\x51\x53\xb4\x94\x76\x38\xd5\xc7\xc2\xf0\x5a\xe4\x85\xb7\x40\xdf\x6f\xf3\x4a\xdd\x18\x50\x28\x3b\xcf\x81\xfc\x7a\xf5\xe6\x92\xf7\x52\xa5\x92\x71\x47\xdf\x50\xf0\xb2\x6c\x3a\x24\xac\xc5\x88\xb6\xfb\xe5\x92\x8c\xbe\x24\xb3\x54\x51\x5d\xc9\xb9\x65\x42\x5b\xe0\xee\xe3\x8e\x64\xd9\xf1\x5c\x57\xe4\xf3\xae\x17\x35\xa1\xaa\xed\xc7\x7d\xf5\x31\x7d\x69\x30\xc6\xcb\x42\xd5\xbf\x55\x20\x19\x24\x03\x97\xcd\xa1\xa8\xa8\xf1\x5d\x71\x88\x7b\xf6\xed\xba\x57\x56\x12\xa7\x4a\xdd\x74\x9d\x41\x19\xe6\x3c\xdb\x6c\x97\x49\xa8\x7b\xc2\x52\x60\x8c\x5f\xe7\x68\xe8\x7f\xf6\xcd\x37\x37\xa3\xf8\x88\xdf\x3f\x05\x19\xcb\x58\xf2\x95\x87\x54\x5a\xfa\xf8\xc0\x63\x99\x14\x0f\x42\x9e\xec\x2b\xcf\x57\x16\xbd\x39\xae\x86\xa2\x39\x57\x00\xb9\x8d\x10\xa6\x4a\x45\x62\x67\x59\xbe\x99\x95\x0c\x4a\xf0\x9c\xee\xbc\x27\x95\x42\xea\x96\xf0\x46\x24\xe7\xce\xda\x88\x8e\xd1\xe2\x4c\x5f\x17\x39\x95\xc9\x48\xe8\xd3\x28\x93\x48\x7d\x30\xe3\x4e\xed\x71\xbf\xc9\x19\xee\x47\x14\xfb\x11\x1f\x76\x8d\x73\x50\xdc\xc1\x34\x86\x8c\xa5\x93\x8a\x7e\xb9\xf8\xd2\xed\xe6\xfd\x62\x95\x0b\x09\x6e\x22\xf7\xe1\xb6\x1c\xd4\x63\x91\xa1\xe2\x30\xa4\xd1\x6c\x84\x24\xd9\x18\x1d\x11\xb9\xf6\xc9\x39\x12\x71\xd6\x00\xb3\x0f\x89\x1e\xf6\xbd\x75\xa6\x44\x56\x5a\x24\xb9\x04\x77\xc5\x57\x14\xbc\x57\x1c\x4f\x22\x05\x65\x59\xd6\x90\x38\x1b\x9f\x91\xd9\x0c\x32\x5f\x45\x23\x9d\x42\xb2\x67\x57\xa4\xb8\x9b\x2a\x1d\xed\x47\xe1\xf3\x8a\x53\x60\x6c\x32\xaf\x9b\x4d\x4c\x58\xa2\x1a\x80\x62\xea\xb1\x75\xf2\x59\x53\x78\x15\x41\x2b\x60\x04\x47\x2d\x22\x51\xdb\x29\x6b\x6c\x5a\x4e\xd0\xa6\x44\x5e\x6f\xed\x2c\x63\x81\xd6\xcd\x59\x3b\x67\xc8\xca\xd2\x87\x7b\x05\x4f\xda\xd3\x97\x34\x51\x9f\xef\xe9\xfb\xf2\x44\x4f\xdf\xab\xd3\x87\x55\x94\x28\x50\xba\x9c\xa5\x4c\x9b\x6c\xc2\x6f\xfc\xfe\x25\xdb\xc9\x4f\xd0\xff\x6c\xdc\xee\x7b\x6f\xdd\x49\xfd\xae\xfe\x2f\x47\x78\x66\xdf\x64\x48\x09\xff\xe2\x02\xce\xba\x19\xb6\xb8\x2f\xaf\xa3\xa3\xf4\x72\x9c\x8e\xd2\x95\x9a\xfb\x8f\x58\xd8\xcb\x5a\xf1\x25\xc6\x92\xc4\xed\x4e\x66\x48\x5f\xc6\xaa\xb1\x57\xe3\x17\xbf\x3e\x58\xb7\xfd\x23\xe2\xf0\xd5\x8e\x3f\x2b\x14\x67\x0f\xa4\x98\xca\xfa\x36\xed\xdd\x09\x9b\xd6\xd1\x47\x32\xbd\xc8\x26\xac\x2d\x1b\x0e\x93\x97\x30\x05\x39\xd7\x84\x89\xb4\xd1\x06\xcc\x72\x74\xd3\xb5\xcc\x5f\x83\xf6\x79\xc7\x80\x12\x2d\x72\xb2\x87\xe9\xec\x3d\xb1\x4c\xe1\xd3\xb5\xd7\x3e\xfd\x56\x7c\x37\x76\x2b\xce\x48\xa8\x3e\xd6\xf3\xb9\x2c\x50\x7a\x68\x93\xd6\x75\x5c\x9c\xb3\xfc\xc1\xea\xeb\x24\xad\xf9\xc7\x5d\x9a\xbf\x89\xa4\x2f\x94\xd0\xb6\x6d\x4c\xcf\xa5\x44\x47\x6e\x29\x6f\xed\xe4\x89\x0f\xeb\x7e\xba\x34\x41\x85\x0c\x3a\x17\x24\xdb\x84\xe7\x53\x1e\x94\x2e\xc8\x7d\xdd\xf8\xf3\x27\x2c\x20\x7b\x46\x34\x3d\x8f\x8f\x3a\x49\xf0\xc5\x9c\xc7\x36\x83\xa4\x6e\xd4\xb9\x3e\x48\xe2\x45\x52\x62\x81\x02\xec\x4c\xb6\x1e\x41\x90\x44\x3e\xba\xf5\x98\x2e\x14\x06\xc8\x68\x67\xc7\xb7\x3f\x5b\x84\xb9\xda\x7a\x3f\xb3\xb8\xff\xb1\x77\x06\x6b\x1c\x52\xf8\xbf\xae\x48\x98\x3d\xe4\xd4\xd3\xdb\xc9\x44\x71\x24\xf0\xb0\x4b\xa9\xa1\x04\x63\x26\x9a\xfd\xdc\x83\x3e\x11\x65\x57\x67\x7b\x9c\xd5\x0d\xcd\xc1\xaa\x34\x67\x99\x6d\xdf\xcc\x7c\x72\x55\x93\x8a\x75\x72\x2a\x9d\x89\x05\xfa\xd4\x5c\xca\xd4\x4f\x3d\x1e\x39\xe6\xb8\xa7\xe2\xa4\x73\x7a\xe0\xa9\xbb\x37\x31\x7f\xaf\x68\xe6\xe5\x51\xcd\x67\xc6\xf2\x6b\x9e\x54\x01\x2d\x25\xed\x79\xd0\x6f\x14\x57\xae\xd3\x08\x7f\xe4\x88\x3a\xd7\x45\x1e\xff\x44\x65\xaa\x8e\x1e\x5c\xbd\x83\xba\x3f\x87\x29\x42\xc0\x6d\xfc\xa4\x73\xf3\x98\xda\xe1\xb9\x48\xe4\xb3\xf6\x98\xb2\xf7\x69\xd0\xd7\x91\xb3\x92\x4b\x36\xd1\x9c\x0e\xfa\x31\x94\xbd\xd8\xe6\xa2\xef\x19\x3d\x07\x4b\xe4\x53\x6d\x1c\x1f\x9e\x80\x78\x0e\x1f\x4e\x85\x19\x17\x46\x8f\x0a\x29\x9e\xb1\x46\x92\x04\x0d\xed\xfa\x96\x33\x5b\xe4\x5b\x2d\x29\x18\xd7\x8e\x7a\x24\xd1\xd0\xa7\x22\x0f\xe0\x3e\x71\xbb\xd2\xac\x2b\x3a\xde\x4f\xdd\xff\x5b\xc0\xd7\xa8\xdb\x63\xff\xc5\xa4\x90\xed\x07\xd2\xec\x46\x96\x5d\xe4\x9d\x77\xa7\xc2\x18\xed\xca\xef\xd7\x22\x40\x63\x22\xd0\x2b\xf7\x1b\xb8\x2c\x4c\x07\x2d\x93\xdb\xba\xc7\xb1\xc4\x5c\x6b\xd2\xf3\xe1\x39\x64\x15\x43\x80\x7c\xe1\xc2\xd6\xdf\x7c\xec\xba\x60\xf1\xc1\xfe\x75\x5f\x2c\x14\x6a\x45\xc2\xe6\x85\x72\xba\xe2\xa8\xcd\x17\xab\x3c\xa9\xc4\xd8\x23\x69\x56\xb3\xde\x6e\x91\x72\xcf\x45\x0d\x37\xd6\xd7\x84\x2f\x6e\xf3\x9f\x29\x7f\xbf\xb4\x98\xda\x13\xf0\x01\xda\x81\x53\x76\x78\x15\xb1\xe3\x36\x23\xfd\xc0\x11\x21\x4f\x2b\x82\xb7\xc4\x7e\x48\x3c\xeb\x0d\x86\xde\x14\x95\x6f\x3c\x93\x54\xd2\x38\x02\x5c\xcd\x86\x24\x00\x3e\x8e\x0b\xb9\xfd\x8c\x88\x61\x6f\x8a\xc4\xdf\x6f\x15\x68\x96\xf2\xc9\xcc\x9d\x03\x97\x6d\x9d\x91\x7c\x06\xa3\x0a\xaa\xf0\xfb\x9b\x53\xeb\xe8\x83\xb7\x6f\xea\x73\x88\x6d\x45\x73\x7f\x8b\x9e\xeb\x01\x9f\x5d\x15\x11\xc2\x70\xce\x19\x46\xdd\xa1\xf7\xa7\x04\xa6\x3a\x4b\x93\xbb\x3e\x96\xa6\x6f\x07\xc9\xcc\x45\xcc\x3e\x1a\xca\xa2\xa9\xb7\xed\x20\x2f\xad\x4d\x8c\x22\x16\x6b\x8a\xeb\x08\xed\xaa\x2a\xa2\xbb\x79\x6e\x66\x87\x58\x12\xeb\x36\x55\x25\x29\x2a\x49\x9f\xf0\x60\x32\xd5\x85\x3a\x19\x80\x7b\xaf\x6b\x91\x34\xb8\x82\x3d\x92\xc8\xe9\xed\x60\xe4\x34\xed\x59\xab\xa9\x05\x53\xb3\x3d\xc4\xd8\x6d\xea\x9b\xfd\x8c\x54\x4b\x02\x1f\x94\x90\xe5\xeb\xf7\xe8\x22\xf7\x5e\xe4\xb3\xdb\xdd\xba\xcc\xdd\x0a\x6a\x3d\x51\x75\xa4\x39\x85\xd9\xcc\x59\x70\xf5\x4c\x1c\x2c\xdc\x2e\xfe\xbe\xd7\xd7\xe8\x2e\x67\x04\x6c\xca\xe1\x36\xed\x1b\xbe\xca\xd3\x58\x85\x51\x5d\x21\x6f\x65\x78\x1a\x6e\x49\xce\x83\xe0\xa3\x71\xa0\xea\x4c\x7c\x71\x2a\xd2\xf1\x7f\x7a\xf9\xe2\xff\x79\xf9\xa2\xfb\xe7\x71\xaa\xb9\x3e\x4a\xff\xaa\x1c\x6a\x15\x7f\xf1\x58\xd7\xf1\xc9\xb9\x06\x35\xec\x19\xa4\xdb\x9e\xa1\xa6\x4d\x52\x89\x6c\xfe\xb1\x8c\x49\x5a\x77\x00\xd9\x63\xb3\x51\x41\x24\xeb\xed\x15\x75\xe5\xbe\x97\x3d\xc0\x7f\x2d\x86\x19\x35\xe3\x4a\x6f\xa0\xc5\xec\xd3\x54\xe3\xf4\x48\x38\xaa\xc7\x09\x1d\xd2\x41\x6d\xa3\x72\x30\x56\x09\x9f\xcb\x9b\x24\xe1\x3b\xd5\x55\xcc\xb7\xd5\x4e\x9e\x13\x75\x3e\x13\x68\xc4\x0e\x7d\x96\xf2\x09\x91\x0e\x79\x1e\x3e\x79\xf9\x32\x7c\x24\x19\x47\xa8\x1a\xf8\x4f\x7d\xab\xe3\x17\x41\xc7\x85\x19\x50\x6b\x11\x97\xef\x79\x28\x8c\xe1\x67\x20\x01\x16\x61\xf1\xdc\xa0\x5b\x3f\x9b\x6d\xbe\xcd\x28\xd9\x14\x91\xcd\x86\x54\x02\xae\x39\x9a\x40\x33\xda\x01\x43\x7f\xfb\xfc\xfd\x5f\x6e\x47\xed\x01\x0b\xbf\xbe\x22\x83\xe8\x50\x72\xe0\xcd\x23\x93\x03\x7f\xfc\xc4\xbd\x26\x1a\xff\x5c\x37\x29\xa0\x08\x8e\xf0\x8b\x5e\xbd\xd5\x8e\xd9\x03\x70\x58\xcf\xdd\x64\x60\x10\xee\xf8\x02\xfb\x6d\x81\x82\x2d\x6b\x5b\x15\xd9\xcf\x19\x26\x51\xab\xfc\x4c\xca\xe0\xa4\xee\xf9\xac\x1b\xdb\x7b\x19\x18\xcd\x29\x84\xc4\xc0\x2f\x81\xb4\x09\x80\xcf\xe0\xc6\xf3\x98\xb7\x8b\x0c\x80\xca\xbd\x04\xa2\x38\x87\xa2\xf7\xd1\xdb\xe2\x00\xb6\x90\x4e\xe8\x95\x3b\xf5\xe2\x1f\x62\x83\x1c\x70\x04\x7d\xef\x76\xb1\xa8\xf5\x45\x3f\x2e\x6b\x39\xd8\x0f\x28\x16\xd9\xc9\x4c\xdf\x30\xdb\xc5\xf3\x39\xec\x44\x3c\x65\x3e\xf0\xed\x31\x92\x4e\xac\x0d\xdb\xe9\x55\x12\x3b\xf8\x25\x74\x3c\x35\xf8\x66\xb7\x4e\xa9\x10\xdb\xae\x40\x51\x24\xf9\x26\xbe\x94\x14\xd3\x6d\xf0\x5e\x0e\x21\xdf\x7c\xca\x1c\xe3\xab\x91\xe7\xa9\x5d\xf6\x57\x52\x4e\x8d\x22\xef\xbd\xc4\x90\x9c\x83\xa4\x5f\xb3\x67\xfb\x38\x33\xd7\x6d\xcc\xe7\xaf\xe4\x5a\x81\xfe\xda\x72\x02\x99\xe9\x6b\xf3\x0b\x1e\x83\x79\x55\xcb\xd1\xcf\xb6\x28\x02\x03\x43\x62\x1b\xd8\xe4\x73\x23\xd7\x82\xf7\x9f\x10\x9c\xc3\x09\x63\xb4\xe0\xf7\x2e\xa8\xc0\x8e\x6e\x7a\x59\x83\x05\x99\x86\x9e\x7e\xeb\xb9\x07\x7b\x17\x9f\xdb\xf9\x91\xa7\x08\x69\x79\xf7\xc9\xe7\xaf\x3c\x3e\x5c\x1e\xa8\x97\xbb\x77\x5f\x7a\xb5\x78\xf3\x50\x8a\x03\x19\x42\x7a\xcb\xd7\x23\x6e\xf9\xda\xdd\xb2\x7f\xaa\xbf\x19\xb3\xa3\x2a\x61\xe2\xbb\x95\x26\xac\xab\xa7\xed\x47\xf5\x78\x2c\xf7\x8d\x12\x46\x29\xb4\x47\x01\x61\x24\xc9\xb9\xb9\x3e\x4f\xc2\x65\x69\xcc\x1e\x15\x3e\x19\xc0\xa0\x05\x72\x2f\x40\x4e\x34\x25\x46\xf9\x7b\x4e\xa8\x28\xf4\x9e\xda\x92\x96\x08\x7a\x1e\x1f\x57\x74\x7b\x63\x90\xe9\xa5\xc7\xd2\x57\x2e\x54\x75\x76\xf6\x9b\x0a\x8f\xef\xc6\xf1\xa7\xf8\xf0\xef\xf5\x6d\x26\x67\x3f\x03\xbc\x96\xed\xa6\xc4\xde\xca\x58\x70\xdc\xa2\x77\xb5\x6e\xb2\xe6\x90\x84\xce\x82\x0b\x05\x78\xb1\x84\x52\xb1\x47\x11\x8e\x1c\xe8\x9e\xb9\x1a\x0b\x35\x4f\x8f\xd3\x07\xa4\x32\x46\xf1\x94\x22\xb4\x74\x4f\xa3\xcf\x45\x7f\x7b\x00\x43\x4e\x8d\xe0\x7d\x06\x2c\xe1\x9f\x5d\xf4\x72\x66\x96\x42\x90\xde\x9d\xa2\x84\xf1\x53\x37\xa6\xe8\x50\x28\x1e\xea\xd5\x8b\xa4\x03\x68\x12\x13\x9a\xb9\x1d\x2c\xa3\x3d\x27\x86\xf3\x4d\xb6\x2a\x91\x3f\xf8\xb0\x2d\xdd\xac\xd6\xcd\x99\x77\x6a\x9c\x7c\x3a\xd8\x53\xa9\xc2\xfa\x37\x9c\xc9\xfb\x0a\x86\xff\xf4\x55\xfb\x6a\xbf\x27\xaf\xfc\xe3\x3d\x5e\xae\x40\x66\x4f\x5f\x58\x61\xb5\xe7\x2f\xf9\x34\x58\x88\x47\x3b\x5c\x09\x2c\xe2\x94\x9d\xa5\xaa\xce\x7f\xfb\x99\xa5\xb6\x27\xa8\x8e\x5e\x5a\x42\xf2\x22\x2f\x4f\xa0\xec\x32\x02\x19\x16\x83\x13\x42\x0e\xbf\x89\x3a\xee\x8e\x85\x49\xb8\xff\x0a\xe1\x89\xc9\x14\x05\x15\xa4\x7e\xab\xbb\x1a\x60\x85\xc4\x1f\xbb\x95\x95\x4b\x2a\xfd\xde\x7d\xed\xc2\x96\x4a\x9b\xb7\x8a\x7c\x31\x80\xd1\xdf\x57\xda\x25\x22\x29\x44\x83\xb2\x9f\x2f\xac\x68\x4a\x2b\xee\x7a\xc5\x9e\xa8\xea\x5e\xc3\xd3\x98\x66\x8e\xa8\xe7\x7b\x94\x2a\x96\x97\x2b\xa4\x08\xaa\xb6\xd4\x8b\xf0\x02\x3b\xcd\x90\x25\xdd\x7a\x4d\x51\x1f\xb5\xf9\x69\x13\xae\x45\xfa\x1c\x6e\x7e\x00\x6b\xe7\x85\xc8\x4b\x7f\x5a\xb2\x4c\x37\x40\x1c\x8c\x7e\xa6\xc3\x50\x5b\xcd\xf2\x1c\xf5\xa4\xb4\x83\x52\xed\x3c\xc2\x4c\x55\x83\x2d\x10\xcd\x94\xbb\x42\x7a\xc8\xdc\x20\xf9\x67\x45\xd9\x62\x51\xe0\x79\xbc\x63\x44\x3c\x38\x6e\x30\x53\xc6\x7c\x67\x68\x57\x40\xaf\x3e\x17\x23\xfd\x7d\xbe\x28\xf6\xec\xae\xa3\x9e\xd6\x54\x76\xb9\xa8\x30\xda\x2b\x67\xf1\x87\x02\xb4\x15\xab\x89\x4d\x0d\xeb\xde\xeb\x99\x90\x47\xf0\x77\xec\x24\x2a\x6b\x2f\xa6\x05\xd7\xd2\x4e\xf8\x4d\xf6\x5b\xb1\x71\xf7\xf7\xc4\xd0\x1c\x6b\x61\x87\x28\xbb\x3f\x8d\x10\xff\x18\xfd\x6e\xdc\x82\x83\x03\xe9\xdc\xc9\xc9\x09\x1d\x04\x09\xb4\x9d\x37\xa3\xf2\xa2\xea\x0a\x60\xbf\x51\x20\x7e\x47\x32\x83\x96\xcd\xdf\x18\xaf\xf5\x38\xb9\x81\xb4\x79\xf8\x11\x3b\xfa\xf8\xc2\x17\xd3\x14\xbb\xaf\xce\xb5\x95\x03\x36\xc2\x16\x8c\xc0\x20\x76\x6f\xbf\x38\x49\x51\x0a\x13\x3a\xb4\x90\x0a\x66\x83\xef\x24\xc5\x7d\x7e\x29\x45\xb0\x07\xb7\x3c\x9e\xf3\xef\x6b\xe9\x10\xc1\xd2\xd6\x65\xc5\x6f\x89\xc8\x7a\x7e\xf9\xca\xfa\x00\xd3\xd4\x59\x59\xe3\x5f\x65\x57\x40\xea\x3a\x6f\xb3\x5d\x37\xca\x74\x71\xc9\xfb\xd4\xd9\x1a\x7c\x9d\xed\xd0\xfb\xa4\xc3\xa3\x4e\xd1\x33\x9e\xce\xc6\xd7\x8a\x0b\xc5\xd0\x27\x16\xa3\x26\x2f\x6f\xc6\x1d\xa4\x6d\x25\x76\xcd\x3d\x78\x31\x80\xc7\x78\x39\x16\x62\xfd\x60\xc6\xee\xeb\x1e\x96\x9e\x9e\xe6\xb6\x9a\x17\xb8\xe4\xcd\x74\xf2\x33\x25\x2a\x06\xdb\x31\xb5\xad\xc1\xb7\x6c\xaf\x19\x7d\x24\x19\x76\xb4\x30\xc6\x8c\xdc\x46\xda\x1e\x79\x48\xaa\x57\xc6\xb6\x54\x1e\x19\x9b\xac\xd5\xa6\x25\x77\xf6\x1d\x26\x60\xa3\x6e\x3d\x90\x5a\x73\xd0\x8a\xd6\x70\x2e\xec\xc6\x59\xa7\x5d\xe8\x95\xbe\x1c\x8e\xa4\x6a\x8a\x28\x78\x87\xcc\xa2\x85\x50\x72\xf3\xe1\xaa\xde\x6d\xd0\x3e\xd4\xf3\xdf\x3f\xfa\x00\x2b\x4d\xd1\x76\x8e\xe0\xf4\x04\x1d\x92\xd8\x90\xf5\x2d\x43\xc0\x46\xa1\xa7\x07\x24\xf5\x59\x4a\x9b\xee\x03\x85\x2e\xfa\x81\x7a\xc5\x3b\x60\x77\x9a\x79\x0c\x78\x3c\x52\xe8\x34\x90\x79\x5a\x75\xec\xcf\x9e\x0c\x59\x65\xe1\x3d\x6d\xb7\x04\xaf\xbb\xae\x9a\x65\x5f\xd3\xb6\x8b\x4e\x3f\xab\x4b\x3b\xe0\xce\x04\xc7\x64\xd9\xe4\x97\xc2\x29\x8f\x48\x10\xfc\x2e\x5a\x25\x23\x11\x8f\x28\x9e\xb9\xd0\xec\xe1\x26\xe8\x5f\xa9\x9c\x31\x60\x6d\xd0\x44\xce\x06\xbd\x7b\xee\x12\x6c\x77\xdd\x18\xdb\x06\x38\x12\xdf\x30\x1b\xe3\xab\x5e\x29\xa0\xb8\xfd\xc7\x3e\x6b\x74\x3d\x47\x4d\x7e\x62\x44\xce\x61\xba\xe4\xf8\x41\xeb\xb7\xb5\x58\x1c\x81\x0e\x50\x66\xa3\x8f\x8e\x1a\xa7\x9d\x3b\x06\x83\xf0\x58\xa3\x96\xcb\x22\xa1\x6e\x8a\x3c\xce\x31\xc3\x76\x62\x6f\xeb\x2f\x9f\x66\xe7\xb2\x3d\x34\xf7\x14\x4d\x67\x61\x5c\x43\x8e\xcb\xbc\xae\xfe\xbe\x47\x34\xa9\x23\x75\xc1\xd1\xae\x63\x36\x4f\xee\xa3\x75\xe3\xdc\xa4\x51\x51\xf1\x27\xe3\xa0\x1b\x45\x37\x77\xbc\x3e\x4e\x31\xaf\x5c\x3a\x60\x01\x09\x69\x94\x22\x00\x88\x5f\xd1\x70\x61\xdb\xf1\x94\x51\x70\xcc\x36\xd0\x76\x05\xbd\x4e\x02\x22\xf5\x0c\xee\x8a\x34\x84\x65\x31\xd8\xb6\xdc\xef\x67\x0a\xfc\x08\xca\x1e\x97\x9e\x8d\x9d\x83\x34\x85\x16\xa9\x6f\xed\x59\xe5\x64\xf0\x56\xe9\x69\x53\x0a\xb9\x38\x64\x3d\x41\x25\x97\x9f\x91\xa7\x1e\xf2\x8e\xf5\x51\x9f\xfd\x04\x91\x99\x6d\x24\xaf\xee\xa2\xc6\x6c\xc1\xc4\x01\xf5\x67\xc0\x61\x16\x0b\x14\x76\xbb\x1c\x86\x36\x28\x9e\x2b\xfc\x3d\x52\x02\x0f\x4a\xa4\x36\xf9\x31\xcd\x8f\xb4\x89\x05\x7a\xba\x24\xe7\x27\xf4\x30\xf1\x25\x48\x7f\x33\x6a\xcb\x34\xfb\x2d\xa7\x53\x1a\x60\x06\x8b\xea\x8f\xad\x85\x8d\x00\x97\xf8\xa4\x92\xc2\x90\xeb\x59\x5b\x40\xe8\xe5\xe6\x0a\x4d\xcb\x17\x35\x18\xea\xb1\x12\xef\x92\x14\x10\xda\x69\x28\x64\xb5\x72\xa7\x1a\x49\xf3\xb5\x3b\x60\xdc\xeb\x90\xc6\x11\xc1\xf6\x12\x5b\x88\x49\x6e\xd3\x53\x8d\xcd\x84\xc3\x50\xe1\x2e\x24\xf7\xe2\x66\x99\xdb\x2e\xb4\xe9\xf5\xe2\xe5\xab\x21\x9c\x5d\xdd\xdb\x19\xf0\x82\x06\x70\x50\x75\x07\x44\xc5\x1e\x9a\x5b\x9f\xdf\x2c\xb5\x8b\x7e\xa8\x9d\x66\x64\xcb\x8c\x76\x19\xf2\xc9\xa5\xb1\xf3\xb9\x1e\xfc\x9d\xe6\x7d\x5e\xd4\x33\xd5\x79\x01\x34\xb3\xb2\xd8\x9c\xd2\x72\xed\x8e\x3c\x30\xea\xfc\x2b\xb5\xc5\xb9\xb7\xf0\x2f\xd3\x17\x87\x35\x31\xc6\x37\x15\xc2\xad\xd2\x79\x6b\xed\x28\xd9\xa4\x5f\xae\x27\x29\x7e\x82\x98\x2d\xa6\xa8\x1b\x55\xa2\xbb\xa4\x03\x07\x47\x6e\xbb\xcb\xb7\xe2\x14\x4a\x3d\x95\x24\x96\x4d\xbe\x12\xbe\x1c\x59\x8a\x36\x37\xa2\xf6\x3d\xed\xba\x81\xd8\xba\x3b\xf6\x99\x02\x16\x2a\x11\x75\x5f\x59\x46\x98\x3b\x85\xe9\xc6\xae\xf0\x95\xdc\xce\x5f\xbf\x9b\x8f\x0e\x87\x88\x55\x86\x3b\xf4\x67\x0b\x51\x9f\x8e\x11\xd6\xc7\x79\x97\x55\xdb\x72\xa0\x71\x96\xac\x6d\xf8\xd4\x79\xff\x80\xec\xd6\xf7\x95\xe6\x87\x27\x34\x62\x68\x37\x72\x1f\x93\x4b\x67\x5e\xa3\x95\xd5\x57\xe8\xf1\x79\x44\x4a\x9a\x8b\x83\xc1\xa3\xcd\x1c\x51\x3d\xe6\x45\x4d\x5d\x49\xac\x84\xa2\x28\x4a\x41\x4b\xea\xb4\xac\xeb\x8f\x48\xcc\x66\xea\x23\xe8\xd0\xc1\xd2\xc4\x2b\xeb\x97\x03\xfe\xee\x5d\xdc\xbb\x7c\x36\xe2\x56\x13\xdf\x7d\x9f\x36\x41\x24\xee\x94\x1c\xb6\xd6\x6c\x13\x30\x53\x4a\xdb\xd6\x06\x63\x2e\xaf\xf7\x7d\x8f\x1b\x37\x88\x5a\x77\x6e\xa9\xcf\xe4\x9d\x0b\x9e\xf6\x5e\x9f\x08\xcd\x29\x8d\x87\x40\x93\xc8\x6d\x5f\x31\x39\x96\x79\xe9\x76\x13\x22\x36\x85\x64\xfa\x37\x2b\x77\xad\x4a\x92\x6a\x5c\x9f\xfa\x1d\xa6\x9b\x27\x6d\x7d\x8e\x5f\xa7\x58\x55\x35\x15\x93\x4a\x63\x43\x12\x08\xbd\xd6\x9d\xcf\x3e\xd5\x54\x58\x4b\x55\xe4\xcf\xeb\x97\x5a\x5f\x8d\x5d\x13\x9f\xf9\x27\xd7\x0b\x1d\x5b\x26\x17\xc7\x16\x6c\x3a\x8d\x64\x63\x83\xd6\x93\xdd\x26\x1f\xba\x4f\xc8\xa2\xab\xe6\xb0\x6e\x5d\xf4\x5f\x83\x86\xe0\x10\x48\x90\xf9\xcf\x00\xb1\xe4\x2f\x88\x6b\x69\xeb\xb2\x58\x58\xde\x3e\xe2\x4a\xb6\x42\x37\x93\xcd\x5b\x20\xf1\xa7\xc1\xbd\x8b\x86\xda\xae\xdd\x56\x0f\x4c\x77\xc0\x90\x83\x6b\x24\x22\x42\x90\x1c\x7f\xe2\xde\xeb\x70\xe8\xd4\xc3\x59\x88\x31\xad\x41\x6e\x79\x77\x5f\x68\x02\x35\x48\xbb\x71\xdb\xd8\x38\xf0\xa2\xc6\x9e\x4a\x49\xf5\xc9\x7c\x55\x1e\xa3\xc5\xae\xc3\x75\x3c\xbc\xf8\x45\x2e\x97\xff\xa9\x34\x6c\x52\x14\x57\xe3\x5b\x44\x34\x21\x11\x10\xe2\xc7\x3d\x7a\x4a\x78\x85\xa7\x2d\xfb\x7e\x39\x02\x67\x27\x59\x12\x01\xef\x76\xd9\xdf\x5a\xca\xbf\x3a\x27\x4c\x37\xc2\xcd\x09\x5f\xe0\x0f\x67\xd1\x77\x2f\x03\xfa\x8e\x52\x8b\xc3\x59\x87\x51\x21\xc0\x9d\x3b\x05\x9d\xa7\xf0\x55\x89\x14\xef\x2f\x70\x22\x9c\x03\xd5\x8e\xc2\xd6\x5e\x37\x19\xd5\x8d\x02\x76\x3a\x16\x4b\xf9\xac\x28\xfd\x58\xcf\x77\xf5\x76\xdf\xaa\x3e\x2c\xff\xe4\x3c\xc5\x62\x71\xe5\xda\xf3\x19\xe6\xf6\x01\xb7\xe1\x03\x7c\xd2\x0d\x4b\xb9\xf9\xac\x06\xe1\x9b\xcd\xa5\x8b\x5a\x01\x49\xef\xa6\xb4\xdc\x36\x78\x68\xaf\xa2\xe4\xb5\xc2\x0d\xae\x9b\xda\xe2\xce\x88\x8a\x01\x4a\x56\x98\x00\x70\x79\x69\x73\x4c\x22\xbc\x2e\x43\x05\x0e\x87\x9d\xf3\x2e\x14\x8a\xf8\x4d\x94\x46\x3c\x49\x23\x5f\xd2\x16\x45\x5b\xf6\xfa\x78\xa3\xff\x60\xe6\x2c\xcc\xd2\xd9\x8c\x99\xb4\x58\x75\x06\x1d\xcf\xa5\x36\xc0\xa8\x7a\xe0\xc9\x9b\x05\xc2\xb3\xfe\x60\x47\xe6\x15\x4f\x5d\x7e\x5c\x0e\xcf\xcd\x59\x07\x75\xe4\xac\xd0\xae\xd5\x94\xd0\xcd\x75\xb2\x7b\x17\x30\xa0\x7d\xf3\xdb\x36\x67\xd4\xe8\x36\xf0\xcf\x39\xfa\x3b\x76\xa3\xb2\xde\xbf\xfc\xde\x52\xb9\x91\x38\xe7\x39\xa8\xca\xd9\xf8\x22\x3a\x34\x55\x82\x3e\xf6\x8b\xc8\x16\x29\x91\xe3\x82\xe5\x7c\x9c\xee\x5f\x1c\x3d\xad\xa6\x81\x70\x30\xeb\x32\xe7\x60\x3b\x8d\xec\x73\x8b\x8f\x32\xa5\x52\xfc\x3a\x6b\xd7\x7a\xa1\x51\xe7\xc8\x21\x9f\x15\xbb\x7c\x14\xe9\xf7\x0f\x9f\x22\x48\x7c\xb8\xf8\xc7\x25\x2f\xf3\xdb\x23\x0c\x07\x2a\x69\x9c\x1f\x28\x76\xcd\x66\x31\xbe\xd6\x8f\x87\xc9\x1d\xb4\x3a\x62\x71\x5e\xf0\x5c\x2e\x44\x9e\x17\xdb\x2e\x46\x7c\xa4\x29\x5c\x3d\x16\xde\xa5\x87\x7f\xef\xf4\xb8\x44\xe5\x23\x89\x64\x4e\xe1\x4b\x3b\x43\xe9\x63\xa4\x7e\x7c\xc0\x62\x1b\x14\x62\x10\xec\x42\x97\x0e\x3b\xe6\xae\x95\x6f\x44\x48\x5a\xca\x94\x0b\x28\x12\x7e\xa8\x3e\xbb\x3d\xfd\x11\x59\x01\x22\x09\xbc\x14\xba\xae\xdf\xd3\xd4\x54\x60\xcd\x4c\x70\x88\x4b\x72\xb3\xee\x2b\x2f\xdf\x41\x50\xa7\x31\x5f\xea\x90\xac\xf3\xe4\xe0\xf3\x69\xcd\xa4\x18\x60\xfc\xde\x1b\x26\x24\x22\x32\x5f\xdb\x76\xe4\xbd\x01\x3e\xc3\xc5\x4f\x8d\xf2\xa7\xac\x82\x66\xcd\x22\x19\x27\x6b\xa9\x24\xfd\xc1\x69\x22\xaf\x28\x79\x65\xbe\x3c\x2c\xc7\x73\xf4\xe5\xf4\xa4\x1e\x32\x0f\x34\x41\x62\xbc\x5c\xbc\xed\x55\x3c\x7c\xa7\x4f\x26\xec\x88\x64\x40\xa8\x9b\x1d\xff\x61\x88\x24\x90\x78\x80\xf9\x58\x43\x59\xaf\xf8\x5e\xd5\xca\x2c\x2e\x66\xb1\x5b\x72\x97\xa6\x67\x80\xec\x11\x32\x08\x0f\x84\x64\x02\xe3\xd0\x3f\x0c\xc5\xe7\x63\x3a\x89\xf2\x3c\x97\x48\x7a\x17\xf1\x2b\x9e\x83\x1c\x7f\xc0\xf4\x56\x9d\x09\x1e\x2a\x24\xf4\xda\x18\x2c\xf4\x36\xfc\xef\x6d\x9f\xb1\x20\x7d\x15\x09\x26\x50\x97\xcb\x60\x25\xe6\xbb\xa5\x72\x94\x45\x5a\x18\x83\x8f\x73\xdc\x80\x7f\x9b\xcd\x9a\x02\x08\xa4\x07\xb7\xd5\xc5\x0a\xd6\x9f\xba\xc1\xf4\x24\x40\x94\x74\xd3\xe0\xa6\xba\xf7\xcc\x6d\xad\xf8\xc5\xdb\xa6\x06\x2c\xcb\xe7\xf4\x18\xbc\xdb\x77\xc4\xf3\x56\xbc\x55\x30\x5a\x4b\x9b\x28\x00\x5f\xea\x1a\x6c\x9f\xee\x0f\x8b\x95\x0f\x54\xe6\x46\x9d\xb2\x83\xc7\x04\x7c\x4c\x53\x6f\xfd\xa7\x5b\x82\xa5\xc0\x5e\x9b\x6f\xb6\xda\xac\x57\xe2\xd5\x2a\x1d\xc1\xb2\xcc\x7e\x23\xad\xb2\x9b\xdc\x93\xb8\x73\xa9\x59\xa4\x0f\x75\x26\x92\xf8\xdb\xc0\x23\x78\x20\x8c\xc1\x5f\x35\x57\xf1\x8c\x2d\xf7\xf4\x3e\xf5\x22\x81\xe5\x0b\xe5\x0b\x56\x0b\x04\x04\xe7\xbe\x59\xc9\x29\x12\x91\x32\x02\x80\x24\xa9\xa7\x8c\x62\xed\x05\x61\x49\x13\x17\xfb\xb8\x23\x01\x39\xaa\x65\x32\x76\x9f\x4a\xc9\x44\xa2\xe3\x23\x01\x03\x38\x1e\x37\x39\x15\xc1\x95\x86\x8b\x88\x5b\xd9\x09\x60\xb3\x80\xac\x51\xbb\x9f\x49\x47\xde\x74\xb2\x76\x87\xa8\xef\xdd\xf3\x4f\xe9\xf3\x2f\x9a\x3a\x87\x01\x10\xd4\x0f\xb3\x85\x0a\xb3\x1b\x7a\x60\xa4\x02\x9c\x91\x8a\x1e\xeb\x46\x22\x73\xe4\x50\xf4\x29\x2c\x83\xc5\xe2\x60\xd2\xdd\x48\xea\x94\x0d\xb0\x83\x7b\xf6\xd2\x7a\xf2\x82\xe8\xd7\x6c\x56\x8f\xdf\xe1\xc9\x77\x1e\xb2\x8c\x96\xe1\x03\xde\xb6\x8d\xea\xae\xec\x45\x16\xe6\x23\x28\x40\x6c\xf6\xc3\x1c\xf5\xa6\x10\x84\x9f\x49\x1c\xc1\xd2\x89\x50\xed\xc8\x35\x85\x23\xa2\x59\x14\x00\xca\x31\x69\xc6\xaa\x0b\xbb\xa7\x57\xce\x6b\xc9\x57\xc8\x73\x48\xe7\xf6\x72\xe7\x56\x4f\xd5\xae\x8b\x88\x32\xff\xef\xf9\x7d\x5e\x36\x87\xa9\xc1\xff\xdc\x72\xc0\xee\x94\x57\xd8\x6c\xea\xc6\x5b\x7d\xcf\xf2\xec\xb6\xa3\xac\x93\xf9\x21\xe2\x86\x47\x53\x17\x58\x10\x9a\x9d\x33\xb0\xd5\x67\xb0\xfb\x35\x4e\xdd\x56\xd2\x62\xbc\xbd\xdc\x55\x5f\xc5\x38\x73\xe7\xa6\x3b\x9f\x7c\xeb\x9e\xe8\xfa\x64\x7f\xef\x3e\x25\xc9\x58\xaa\x58\xf7\x57\xf2\xf8\xf9\xa6\xa4\x9e\x28\xdd\x80\x8d\x64\xd5\xcd\xe4\x3d\x7a\x55\xca\x04\x26\x80\x5e\x99\xfd\xae\xe4\x26\x71\x7f\x74\xa1\xad\xdb\x74\x58\x07\xc2\x16\x7c\x57\xd4\x25\xba\x65\x69\x15\x9e\x45\xfe\xe2\x4d\x07\x2c\xd0\x83\xcc\xa4\x9b\x46\x45\xcd\xb9\x46\xe3\xfb\xc9\x85\xc5\xab\x42\xe1\xb2\x3d\x51\x06\xed\x67\x4d\xe2\xf4\x75\x0f\x93\x0e\x98\x9e\xfb\xe7\xa2\xd8\xd8\x5d\x06\x68\x0d\xcf\x70\xbb\xc6\xbd\xf2\xe6\x67\x0e\xe2\xdf\x03\x92\x3b\x05\x2e\x14\x5a\xe4\xe4\x75\x94\x7c\x1e\x56\xbc\x88\x50\x92\xa7\xae\x66\x66\x20\x62\x6b\x9f\xe5\x84\xf2\x9b\x3a\xd0\xb8\xad\x51\x3a\x8b\xf0\xd7\x62\x39\x6e\x67\x5c\x31\x9a\x33\xa9\x26\xe1\x75\xde\xa0\xb8\xce\x9d\x5f\xce\xeb\x75\xcd\xcc\x2b\x30\x30\x45\xc9\x8a\xb5\x10\x8d\xdf\x9c\x82\xde\x9c\x68\xe1\xb3\xfd\xf2\x35\x7a\x05\x87\x75\x1c\xff\xb3\x5a\xa0\xd9\xe1\x54\xd2\xef\x2b\x67\x88\x76\xc2\x7f\x7e\xa0\xb8\x5e\xb9\x34\xec\xac\x3b\x11\xdd\xfc\xe3\xb0\x9b\xbb\xb5\x3a\x27\x19\x3d\xed\x58\xe9\x26\x56\xbc\x09\x86\x2d\x2f\x17\x6f\x3e\x7f\x33\xd8\x1b\xd7\x0f\xb2\x3e\x39\xb1\xa4\x1f\xe5\xdb\xb8\x9f\xe9\xe8\x60\x2f\x81\x46\xba\xa7\x1e\x91\x31\xfa\x36\xcf\x9a\x51\x90\x94\xef\x2e\xe6\x62\x7d\x6d\x56\xce\xad\x9d\x9b\xf3\x8b\xf2\x7e\x0d\xfe\x27\x6b\x2d\xd5\x37\x83\x8a\xa6\x2c\x51\xf7\x9f\xd7\xee\x7f\xba\xb6\x1a\xb7\x3b\x5d\xa5\x7b\x24\xd6\x93\x28\xac\x8d\x3a\x11\xe4\x71\x0e\x18\x58\xd2\x01\xdb\x1e\xc0\xa3\x64\x93\x78\xee\xc6\xe7\x1b\xd0\x16\x44\x02\x7e\xf8\x68\xda\x5e\x64\x1a\x57\x92\xbf\x65\xa2\x24\x0a\x3e\x2f\xd9\x01\x52\xcc\x8d\x7e\xdc\x81\x4f\x67\xd7\x0b\x65\xd3\xe8\x35\xce\xb3\x45\xc1\xdb\xd8\x90\x36\xca\xb3\x2f\xd2\xbc\x73\x16\xc4\x08\x7c\x00\x6b\x65\x31\xfa\x4b\x38\x18\xc9\x72\xcf\x13\xcb\xf4\xbd\x7a\x30\xed\xb3\xd2\x23\x83\x04\x49\x11\xf4\x4c\x5e\x43\xa4\xf1\x35\xea\x18\xd1\xad\xf2\xed\x10\x26\x73\x74\x96\xd7\x84\x27\x2f\x07\x65\x5e\x61\xd7\x5e\xba\x41\x8f\x1d\x13\x3f\x9b\x7a\xe6\xf3\x60\x95\xeb\x66\x80\xd2\xa8\x93\xda\x93\xf4\x92\x46\x7a\x62\x33\x96\x94\x4e\xcd\xba\xc5\xc5\x1e\x30\xad\xf3\x0d\xb7\xa7\x19\x82\x90\x8f\x1c\x74\xaa\x50\xb8\x42\xee\xd9\x5d\xf2\x18\xa7\xea\x00\x15\x5a\xb2\xb4\x28\x94\x14\xba\x42\xd2\x14\x9f\xcf\x7b\x55\xb5\x1f\x4c\x54\xaa\xc1\xb2\x2c\x29\xaa\x24\x42\x85\x18\xc0\x90\x6a\xde\x43\x97\x5e\xee\x76\xa7\x7b\x77\x8b\x71\x3c\xab\x97\xb8\x30\x2f\xdf\x8e\xad\x64\x46\x8a\x6e\x20\x51\x85\xc4\xd4\xa1\x65\x2c\x1b\x02\x21\x1f\xc3\x6e\xb1\xc1\xdb\x9b\x2b\xd2\x7e\x3f\xf6\xb8\x98\x95\x56\x4c\xc6\x44\xa6\x47\xc4\xe9\x44\xc3\xd4\x84\xaa\x55\x17\xa6\x5d\xe3\xdf\xc2\xf4\x53\xb0\x03\xdc\xb0\x34\x5b\xaa\x86\x33\x18\x4e\xf8\x85\x64\xe9\xbc\xe9\xc9\x05\x89\x7d\x0e\x20\xc8\x4e\x47\xf9\x58\xf1\xe8\xb3\xdc\xa4\xc9\x55\x71\x4f\xdf\x0e\xdf\xce\xeb\x26\xe4\xf4\x5f\xa6\x75\x7a\x75\xc0\xe9\x70\x71\x49\xb6\xa2\xe9\x76\x87\xad\x17\xc5\xeb\x38\x1c\x16\xee\xd1\x77\xa2\x27\x51\xad\xf6\x64\xdc\xab\x89\x77\xde\xec\x2b\xc9\xcd\x28\x28\xab\xd0\xb1\xb0\x1a\x44\x98\x64\xf7\x96\x51\xde\xa3\x58\x80\x76\x70\x79\x90\x4d\x45\x11\xee\x76\xaa\xe9\x7c\x62\x80\x94\x00\x13\x79\x19\xe7\x0f\xec\x94\x25\x6c\x73\xc6\xb5\x8c\xd3\x94\x04\x80\xbd\x7e\xe1\xde\xc8\xa1\x1d\x38\x6c\x9a\x7c\x8b\x8a\x79\x4f\x45\x29\x49\xbc\x07\x41\xdf\x22\xed\x9f\xea\xfe\x6c\x7a\x11\x81\x73\x1e\x41\x24\x19\x3c\xce\x38\x6c\xf1\x05\x56\x4c\x6e\x34\x2c\xab\xd6\xe6\x88\xad\x73\x51\xf0\xbc\x2f\xda\x75\xcf\x49\x7d\x33\xaa\xa8\xf9\x6d\x5e\xac\xc0\xee\xe0\x8e\x98\x51\x9e\xea\x0f\x17\x48\xde\x3e\x86\x0e\x25\xea\xd2\xa7\x8e\xc5\x01\x2c\x7a\xa0\x7c\x7e\x68\xc8\x34\xc8\x7a\x72\x49\xb4\x14\xd3\xa0\xf4\xd4\x11\x69\x0e\xf6\x84\x0b\x31\x35\x0b\x35\x1d\x67\x2c\x81\xc0\x9f\x8b\x3e\xa2\x6f\xb0\x77\xe6\xa8\x60\xe1\x66\xef\xde\x1a\xfd\x30\x3a\x6a\x0b\xbc\x82\xd3\x44\x15\xc7\x8a\x75\x3d\x44\x65\xc2\x4e\x19\xba\xdf\x7c\x75\x6a\xd1\x07\x59\xa6\xbd\xb7\xda\xf3\xb8\x40\x69\x0f\x4f\x03\xf8\xdc\x7e\x47\x26\x43\xb7\x0d\xb6\x79\x70\x37\x45\xaf\xb6\x8c\x32\x5a\x9e\xab\xb7\x23\xda\xe6\x26\x61\x9f\xb4\xe4\x92\x8d\xd3\x4d\x23\x56\xb8\x48\xfc\x46\x7d\x01\xa1\x57\x59\xa8\xda\x03\x75\xe9\x17\xdd\xca\x45\x4a\x6a\xc6\x96\x67\xc5\x8a\xfd\x86\x16\x88\xae\x66\x5b\x9f\xc7\xc0\x4d\x06\x80\xce\xcc\x3b\xba\x78\xd0\xd9\x74\x64\x51\x8f\x49\x0f\x7a\x02\xcd\xa3\x3f\x84\x27\x4f\x13\x99\xbc\x2f\x93\x8b\x52\x7e\x54\xfc\x50\xc9\x56\xf1\x43\x71\x89\x03\x06\xed\x34\xe7\xcd\x89\xfd\x8c\xd3\xee\xdb\xcc\x85\xeb\xc5\x40\xec\xf9\xf2\x91\x22\xd6\xb2\xbf\xba\x3e\xc5\x38\x97\xe2\x25\x04\xab\x55\xbf\x9a\x0d\x07\x59\x33\xaf\xe1\x4f\x14\x08\x2e\x4d\x47\xde\x39\x12\x33\xcd\xde\x1a\x6a\x0c\xe5\xc9\xfd\x46\xcc\x20\x88\xba\x24\xb8\x98\x41\xdc\x15\xff\xfb\xcf\x42\xbc\x80\xa7\x2a\xc0\x30\x83\xc0\x17\x91\x37\x77\x85\x71\xda\x2e\x75\x8a\x29\x3c\xc6\x64\x8e\xd2\xf0\x19\x51\x9f\x08\x7b\x51\x5f\x47\x96\x91\xf8\xc1\xcf\x27\xb3\x6c\x87\x86\x27\xfc\x4f\x93\xcd\xa6\xd8\xfd\xab\xc9\xb3\x5d\x9d\x41\xa6\x76\x9d\xdd\x7f\x04\x11\x99\xf8\x3c\x0a\x17\x73\xff\x71\xef\xe6\x49\x16\x0a\xd0\x27\x6d\xe5\xee\x02\xd6\x32\x17\xfa\x3d\xfb\xc7\xbe\x70\xdb\xb5\x71\x3f\x6e\x70\x51\x18\x4b\x67\x13\xdc\xfa\x72\x6f\xab\xc1\xb4\xba\xe1\x2d\x70\xba\xe2\xa0\xc0\xaa\x03\x65\x35\x37\xb8\xe4\xd6\x3a\xca\x54\x02\xc8\x4c\x1d\x22\xa9\x47\xc8\x03\x73\xcb\x69\x93\xee\x0e\x86\x6c\xde\x76\x78\xcc\xf0\xc8\xf8\x0d\x11\xb2\x85\x71\x74\xe4\x2e\x9e\x04\xa5\xb0\x1b\x76\x4e\x8c\xef\x12\xc7\x7b\x9a\x67\xf5\xc2\xce\x9c\x62\x32\x5f\x9d\x01\xf8\x36\x61\x5c\xd9\x9c\x0e\x9a\x50\xeb\x2a\xce\x75\x19\x69\x46\x83\x52\xc0\x39\x20\x6e\xb4\xb3\xfc\xd0\xfa\x06\xad\x4d\x26\xe1\x01\x58\x9a\x37\x7c\x37\x50\x63\x10\xda\x33\x94\xd6\xee\x11\xcc\x16\x6e\xe4\x3b\x69\x99\x5a\xc8\x8d\xf4\xb4\xa7\xbe\x2f\x55\x8e\x6e\xc3\x48\xc4\x8f\x92\x3c\xa8\xbf\xb9\x87\xa7\x48\xf9\xc7\xe4\x6b\x09\x3c\x25\xd3\xc2\x18\x58\xb0\xbf\xc5\x71\x26\x67\xd4\x71\xc5\x7c\x4f\xa9\x44\x47\x49\xe5\x7c\x0d\xb3\xe0\xac\x8b\xd7\xfd\xc6\x4b\x62\xb9\xdf\x9d\x60\xd6\xe1\xde\x65\x38\x0f\x48\x9f\x15\xd9\xf6\x7f\x35\x20\x6c\x34\xeb\x45\x1b\x50\x9d\x49\xf8\x17\x4a\x8e\xb1\x57\xa8\x6c\x2b\x13\xe7\x14\xe6\x5b\x77\xe0\x49\xf3\x5c\xb7\x1f\x27\x26\x58\x9e\x26\x8e\xba\x01\xaf\x93\x41\xc0\xb8\x1e\x94\xba\x9a\x28\x7d\xca\x24\x02\x4e\xc1\xe7\x3a\x2b\x51\x35\xf5\x72\xa1\x42\x39\xcb\x04\x34\x57\x35\x99\xbc\x15\x9e\x0e\xd9\x3f\x03\xc2\x77\x37\xc4\xb1\x16\x05\x94\x03\x17\x90\x20\x64\x82\x08\x67\x9d\xcc\x80\x88\xb1\x6b\xce\xa3\xe1\x2a\x92\xcb\x9f\x13\x17\xeb\xcf\x8a\xa5\x33\x51\xe4\xb2\x53\x3d\x99\x1d\xbe\xe5\xb0\x2a\xcc\x3f\xd9\x82\x1c\x7b\x17\xd8\x41\x42\x92\x26\x83\x26\xae\xd4\xb5\x9c\xdd\x85\x64\xab\x7b\x3f\xa5\x5b\x1b\x15\xf7\x00\x5e\x50\x60\xea\x95\x1b\x8a\x86\x2e\x9c\x6b\xf7\xd5\x98\x1d\x5b\x0e\x43\x31\x02\x03\x23\xc5\x39\xef\x97\x49\x16\x4a\x79\xb6\x28\x2d\x8a\x38\x66\x9f\x5e\x27\x4b\x6e\x84\xd8\xae\xe5\x9e\x52\xb6\xe3\x60\x43\x76\x14\xe4\xdc\xe4\x5f\x1c\x6b\xc5\x8f\xc0\xe0\x8a\xa3\x54\x85\xd7\x70\x20\x1c\x54\x6a\x11\x86\x2c\x7e\xa8\x6c\x51\x6f\x77\xcc\xad\xdf\xeb\xf6\x1b\xa2\xc6\x83\x1c\x9b\xef\x29\x97\x14\x74\x59\xb4\xd2\x8f\x12\xed\x3b\xd6\x47\xe5\xdb\xbe\xd3\x47\xed\x6f\x3f\xd7\x30\x24\x90\xa0\x5b\x2d\x75\x73\x2c\xad\xae\x55\x00\x29\x9b\x61\x68\x22\xfc\x9d\x6e\xfd\x58\x5c\x38\xe2\xab\x6f\x3d\x96\x69\xd1\x93\x4a\x48\x9f\xa1\x07\x0b\xef\x18\x10\xf1\x00\xe5\x6b\x06\x17\xb5\x8c\x9a\x78\x9a\xdd\x9b\xa7\x4f\x53\xec\xbc\xb3\x27\x1f\x87\xd6\xbb\x04\xdf\x32\xed\xb4\x22\xc9\x27\x9b\x7a\x51\xb8\x33\x2a\x30\x6a\xe1\xab\xa3\x5d\xb0\x0f\x3b\xe7\xb5\x3e\x58\xfa\xf2\x38\x18\xe5\xa9\xb5\x72\x8f\x13\xb2\xfe\xcd\xdb\x83\x8e\xcb\xe3\xbe\xb7\xdf\xa8\x74\x11\xdd\x1d\x1a\x4e\xb7\x67\xf7\xed\x9e\x12\x02\x85\xcf\x47\xa0\x0d\x62\xd7\xd4\x80\x99\x33\x79\x50\x1f\x60\x58\x31\x55\x91\x84\x79\x46\x80\xd3\xf3\x52\xe1\x6d\xb3\xda\xac\x26\x59\xba\x2e\xf7\x5c\x78\x7d\x75\xcc\xa4\x6b\x14\x83\x0c\x39\x20\x6e\xc2\x50\xf9\x77\xd1\x5d\xd6\xa8\xeb\x04\x33\x09\x75\x5e\x84\x73\x1b\x21\x30\x9f\xe4\xe5\x47\x95\x46\xdd\x60\x84\xcb\xe5\x17\x93\x67\x5c\xd7\x5f\x7f\xaf\x9a\xba\x04\xc6\xda\x39\x4f\x37\x90\x47\xb5\x5b\xe9\x32\x7f\x88\x77\x5a\x19\x56\xfb\xc5\x8d\x74\x4a\x73\x8c\xd7\x70\xad\xa6\x23\x7c\x2b\x92\x1d\x09\x75\x99\xd0\x46\xa7\xc7\x6f\xa1\x07\x64\x18\x54\x37\x55\x05\xfe\x58\xe3\x73\x9c\xf2\x72\xc9\xc7\x16\x1f\xbd\xf3\x7b\x38\x70\x7a\x46\x1d\x5c\x51\x1a\x09\x26\xd0\x52\x49\x47\x7b\xff\x3b\x9d\xe1\x47\x1c\x42\x19\x35\x9c\xf5\x5c\x0e\x31\xbe\x53\x96\x63\x9d\x33\x56\x93\x1d\x4d\x7d\x3d\x8e\xce\x05\xb6\xa1\x31\x4f\x8c\xb9\x68\xff\xba\xb8\x84\x3e\x5f\xbc\x00\x75\xc5\x27\xc8\xe7\xa5\xbc\x74\xf6\x4b\xe5\x99\x68\x04\x3b\x7f\x67\x67\x0e\x13\x6f\x2d\xc5\xa5\x30\xad\x51\x0b\xa3\xe4\x02\x0f\x6a\xa3\xa2\x43\x27\x33\xb8\x68\x2b\x6d\x7c\x07\x43\xbe\x68\xe8\x1a\xae\x36\xae\xa7\x6c\x97\x06\xd2\x8f\xf2\x1c\x0d\x8c\x25\x14\xa0\xb1\xdf\x21\xb7\xe1\xe4\x1e\xa4\xad\x22\x75\xe7\x8c\xeb\x41\x0e\x45\x7f\x53\xba\xe0\xca\x3d\xe8\x9e\x3f\x9f\x7a\x7c\x6b\xd7\x11\x35\xaf\x24\x6a\x58\x93\x19\x95\xdc\x98\x64\x21\xb0\x26\xfd\xf3\x19\xcd\xdc\xf9\x02\x5c\xf4\x04\x91\x67\x3b\xb1\xea\xf7\xa5\x7e\xed\x43\x1d\x12\x19\x01\x16\x5e\x4f\x4f\x2e\x72\x51\x86\x1d\xc2\x26\x2f\x99\x5b\x08\xaf\x5c\xba\x01\x77\xad\xac\xe4\xe8\xcd\x1c\x7f\x19\x63\x8f\xb9\x5f\x9a\x6c\xdb\x8e\x3a\xe6\xbe\xfe\x57\x92\xf4\xe8\xa4\x01\x8f\x52\x8d\xf1\xcc\x13\x55\x35\xb4\x5a\x42\x6b\xd9\x54\x6d\x30\x85\xee\xe1\x3b\xb4\x9c\x9a\xe3\xd3\x3f\x26\x15\x4e\x0a\x45\xb9\x5f\x4c\xbd\x43\xb1\xdd\x37\x5b\xfa\x7f\xcb\xa4\xd4\x2b\x9d\x76\x02\xba\xb6\x46\x62\x77\xd9\xfb\x7a\x5f\x22\x49\xb6\x2c\x0b\x96\xc1\xdb\xfd\x42\xb4\xda\x9d\xb1\xc9\x7f\xdb\x0a\xac\x46\xb3\x47\x07\x01\x8e\x42\xef\xa9\xa1\x70\x39\x2b\x39\x0d\x2d\x6e\x5b\x34\x19\x35\x6f\x84\xbb\xf8\x3e\x6b\x25\xc4\x59\xaa\x46\x12\x75\xe4\x16\x7a\x46\x91\x6b\x5d\xf2\xf1\x3c\x9f\x6b\x2a\x29\xec\x13\xea\x4e\x19\x98\x4c\x13\xd6\x23\x7b\x8d\x3a\x82\x09\xce\xab\x70\x67\xae\xaf\x65\xaf\xca\xc3\x96\x59\x20\xa4\x14\x8d\x70\xdd\x19\xe9\x1c\xd4\x9c\xb4\x32\x5b\xac\x48\xce\x96\xc6\xcf\x72\x97\x0a\x70\x26\xb7\xb4\xd0\x2e\x2c\xa2\x39\xc2\x0e\xff\xb1\x22\xb3\xb0\x46\xc9\x65\x0d\x7c\x1e\xbd\x03\xb7\x16\x66\x14\xb6\x2b\xca\x70\x9f\x75\xc1\xa9\x73\x2b\xff\xe3\xba\x2e\xc7\x2a\x99\x6d\x5c\x7c\x44\x24\xe1\x5d\xee\xd1\xaa\x99\x2c\x02\x08\x4f\x73\x71\x68\xdf\xf8\xa2\xc6\xc6\x06\x95\x83\x8c\xc8\x57\xc2\xbd\xb6\x9b\x7f\xb4\x03\xbf\x1e\x7d\x77\x95\x4b\x51\x2d\x95\x04\x5a\x70\x89\x82\x27\x53\x99\x49\xe5\xc6\x07\x5b\xa0\xa3\x77\x2c\x2e\xf6\xd3\x50\xb6\xff\xdd\xd8\x4d\x6b\x55\xe5\x87\xa1\xa3\xc4\xbf\x5f\x14\x77\x68\x55\x06\x5d\xa4\xe0\x21\x9f\x7b\xff\x6b\x06\x29\x17\xb7\x0b\xee\xf0\x21\x9c\xa0\x8f\x24\x08\x22\x4f\x90\x33\x67\x3b\x11\xa6\x77\x7f\x00\xc7\x65\xb3\xaf\x48\xe6\x2f\x69\x45\x44\x10\xab\xad\x24\x69\x3c\xb1\xad\x0f\xa6\xfc\x4b\x20\xa6\x1e\xb9\x33\x1e\xde\xd5\x03\xba\x2f\x17\xd9\x61\x7c\x99\xe1\x92\x2e\xaa\xa5\xbd\x22\x6b\x36\x98\xba\x93\x01\xea\xdf\x22\x89\xe3\x0f\x61\x81\x6e\x30\x90\xb7\xcd\xeb\x7e\xfb\x5b\xbe\x08\x4f\xe9\xdd\x26\x52\x0f\x59\xcc\xc5\x83\xca\x53\x0c\x6d\xac\x7f\x1c\xeb\xf2\x37\xe7\x72\xc2\xbc\xf9\xd8\x17\x0b\x04\xcc\x4d\xf0\x2e\xab\xcc\x9a\xb2\x0c\xc0\xcc\xfe\xf6\xf8\x77\xd3\xce\x77\x25\x89\xcb\x5e\x87\x67\xc1\xb9\xde\xd4\x33\x22\x5d\xdc\xe3\xb9\x1d\x03\x9c\x35\xb7\x64\xef\x87\xc2\x38\xeb\x1f\x2e\xba\x02\x11\x9b\xce\x8f\xc9\x56\x35\xf0\xb4\x65\x56\x20\xf9\x19\xc9\xb2\x00\x5b\x39\xa6\x92\x12\xc9\x67\xb6\x8c\x39\xf0\x7f\x3b\x7d\xde\x47\xe6\x68\xa2\x9e\x7a\x34\x6c\x39\x81\x3f\x56\xce\xf5\x5f\xbb\xff\xbf\xcc\x0c\x24\x24\xb5\x0d\x49\x4a\x79\x8e\x0a\x7c\x56\x16\xd0\x2c\xfa\x35\x69\x2f\xdf\x51\xb4\xdd\xb3\x47\x48\x80\x4e\xc4\x91\x7d\x43\x0d\x8d\xf5\x5f\x65\xe6\x26\x83\xcf\xda\xed\x6b\x00\x4f\x6b\x4d\x65\xdd\x67\x9a\xab\x49\x55\xf0\x42\x85\x46\x8e\x00\xfc\x8c\x09\x9a\x68\xdd\x29\x96\x28\x2c\x3d\x59\x71\xcf\x60\xb2\x60\x84\xab\x1c\x98\x1b\x49\xe6\x44\xe0\x22\x7e\xeb\xc6\xfa\xfd\xa3\xd9\x19\x69\x9f\x56\xd2\xc8\x3f\x5c\x8f\xfc\xc3\x23\xf1\x9b\xef\x9e\xd8\xab\xb8\x98\xa7\x05\x41\x5a\x95\xaf\x24\xb5\xac\xc2\x61\x73\x20\x31\xad\x82\x8c\x30\x6f\x3c\x6e\x21\x72\xe6\x5a\x0d\x92\xb7\x4c\xdc\x76\xb5\x8f\xce\xd3\x74\x7f\x67\x95\x8c\x3f\x2c\xbe\xfc\xfc\xf5\x0b\xc1\x83\xee\x02\x92\xe1\x51\xec\x4b\x27\x1b\x88\xd7\xfb\x8d\xb3\x30\xc5\x62\xf2\x51\x90\xb2\xa9\xf7\xdb\x14\x6d\xde\x9e\x95\xea\x84\x95\xf8\xa7\x8b\xf0\x8a\xe0\x91\x6d\xdd\x8a\xcf\x2a\x29\x0b\x48\x6d\x2e\x2e\x00\x4c\xa3\x9e\x41\xfe\xdd\x6d\xb8\x19\x6b\xeb\x0b\x36\x8f\x2b\x93\x0e\x56\xb8\x86\xcf\x1a\xc6\xf6\xaa\xee\x32\x5f\x63\x0a\xef\xa8\x64\x7d\x55\x97\xff\x27\x2a\xe2\x75\x41\x63\xd3\xc9\xb7\xc4\x2d\x36\x93\x67\x5e\xed\xef\x3f\x2a\xc5\xf2\x5f\x08\xdc\x59\xef\x9b\x92\xc7\x84\xe0\xbd\x58\xf0\xcb\x76\x1d\xda\x7a\x3d\xda\x9f\x56\x4a\x0f\x77\xb6\x9a\xf1\x2d\x3a\x9b\xdc\x52\x81\x2f\x69\xe0\x77\xb7\xcd\xd2\xc5\x59\xac\x5c\xd4\x9f\xa7\x02\x17\x82\x60\x27\x99\x7e\xc4\xa5\x3f\xca\xe1\xfc\xac\x3d\x46\xc5\xf5\xa2\x2b\xcd\x17\x74\xb4\x9e\xbd\x72\x1f\xbb\x59\x0f\xaa\x7b\x93\x67\xaf\xe5\x4f\xb0\xe8\x41\x6e\x6f\xf2\xec\x0d\xfe\x7c\x0d\x21\xbc\x57\x03\x7f\x7b\x3d\xf0\xb7\x37\xf1\xdf\xce\xec\x8c\x0f\xeb\x22\x2f\x17\xa3\xba\xf9\x2e\xda\x1b\xe3\xbc\xd4\x59\xc1\x8d\xbe\x5d\xd7\xad\xfb\xff\x4d\x6d\x94\x1d\x58\x13\xcb\xf2\x94\xa2\xf8\xd9\x66\x7b\x0f\x39\xf9\x16\x39\xba\x9b\x11\x5b\x68\xd8\x7f\x14\xf8\x85\x73\x63\xb7\x07\xae\x4d\xc0\x28\x1b\x22\x45\xc5\x82\xcd\xea\xc5\xa1\x87\x40\x9e\xb2\x4f\x84\x90\xe5\x19\xa1\xc6\xc7\xdb\x45\x14\x26\x62\x0d\x25\x4b\x11\x8b\x88\x70\xf4\x5a\xf6\x0f\xa9\xa0\x3c\xc5\x3f\xba\xe7\x3c\x60\xbd\x2a\x60\xbf\x1a\xa0\xf8\x2a\x76\xe7\x10\x51\xfa\x64\xdb\xa6\xbe\x73\xb1\xac\x7a\xee\xc2\xee\xcd\x80\x92\xab\x44\xd1\xa8\x6b\x10\x95\xc8\x5f\xa6\xe6\xc9\x49\x72\x57\x4e\xc0\xf8\x17\xab\x86\x14\xad\xb2\x83\x62\xf8\x08\xf4\xc0\xbc\x88\xac\x21\x3a\xe3\x4b\x1f\xff\x69\x6c\x30\x4e\x09\x58\x84\x59\x8c\xbc\xbb\x3e\x9a\xf6\x6d\x02\xa6\x5d\x5b\x49\x4e\x1f\x7f\x93\x97\x79\xee\x6b\xcc\xe2\xb9\x71\x78\x4a\xd8\xc8\xaf\x71\xf6\xe4\xe1\x7d\x57\x8e\x58\xb8\x57\x8b\x2f\xe3\xf1\x5a\x7b\x67\x34\x45\x3c\xd1\xf1\xb5\x68\x46\x7c\x8f\x28\xfe\x24\x5f\xfb\xa2\x2f\x62\xf7\xe5\xe8\x3d\xee\xe2\xbf\xcd\x28\x2f\xef\xb2\xe3\xef\xed\xa7\xcd\x1e\x9d\x90\xce\xba\x45\xe3\xa7\x9b\x52\x76\x57\xee\xd9\x56\xeb\x36\x60\x2e\xc5\xe5\xa6\x16\x1f\x8d\x6f\x69\x12\xca\xb3\x31\x72\x3e\x42\x8e\xa4\x87\xdd\x2f\xd6\x80\x66\xbd\xbf\xbc\x0f\x73\x3e\xad\x5c\x5e\xf9\x9c\xf2\x2a\x69\x7c\xf5\x9a\x55\xe6\x46\xba\x13\x49\x38\x74\x5b\x1f\x17\x5b\xd3\x04\x1a\xe8\xe8\x2f\x4a\x56\xdc\x9a\xd4\xa5\x62\x8a\x18\x68\xf1\xf7\x8c\x60\x6c\xf5\x8f\xf8\xc7\x3a\xe2\x06\x70\x17\x18\x24\x04\xed\x89\xd2\x1f\x6b\x83\x8b\xdc\xd0\xf4\x44\x7e\x32\x07\x54\x15\x3c\x73\xaf\x7b\x6d\x7d\x77\x3d\x26\x1d\x3d\xec\xbb\x7e\x41\x4a\x2a\x37\x7c\xfc\xfb\xe2\xb2\x96\x9e\x48\x18\xcd\xd6\xaa\x94\xfc\x08\x75\x84\x34\x93\xe6\xd1\xa7\x7d\xbf\x73\xf4\xd9\x3a\x1b\x6a\x69\x78\xfd\x09\x76\x9d\x1e\xae\x2e\x24\x74\x07\x03\x31\x85\x4b\xa0\xa1\x26\xab\xbd\x5b\x31\x42\xb1\xb0\x2f\x97\xfb\xc7\xa2\xb9\xba\xcd\xa4\x03\xcd\x71\x8f\x39\x7b\x6f\xed\xe8\x14\x89\x67\x2c\x80\xd6\xf7\xbf\xf3\xe8\x65\xcd\x81\x3b\x5b\x02\x5a\xc5\x5b\x78\x72\xde\xfe\x7e\x16\x0e\x9c\x59\x59\xb7\x6d\xbd\x69\xcd\xc6\xa3\x8a\x08\x23\xa1\x90\x0d\xd0\x53\x6e\xdd\x57\x34\x8f\xa8\xc6\x7d\xa0\xbf\xe9\x55\x7a\x90\x8f\xa3\xdd\x4d\x1c\xe0\x2f\xa1\x16\xf0\x29\x77\x5b\xbb\x05\x98\x02\x34\xd9\x6c\xe1\x98\xbb\x0d\x93\x37\xc2\x47\x72\x7c\xa3\xc5\x7b\xe5\xfc\xa6\x7b\xca\xf6\xc3\x37\xc7\xc4\x2c\x2f\x97\x62\x78\xdd\xf4\xcf\xd4\x2f\xc7\x41\xb9\x79\x8e\xdc\x36\x90\xbe\x79\x1a\x36\xec\x4f\xa9\x0c\x9b\x94\x66\x46\xf5\x29\xc5\x00\xed\x7f\xec\x89\x0c\x51\x52\x04\xbe\x32\x99\x17\xa0\x77\x5d\xd0\xdd\x2a\x29\x55\x16\xc1\x80\x35\xa0\xf3\x11\x64\xaa\x1f\x63\x2f\xde\x33\xfc\x65\xac\xfe\x89\x28\x52\x93\xdd\x57\x21\xd1\x2c\x37\x3f\xa5\xdf\xfc\x32\xdd\x5f\x11\x5b\xfa\x67\xad\xb6\x5a\x08\xe1\xba\x52\x89\x0e\xdc\x33\x16\x8a\x95\x5e\x1c\xcc\xc6\x60\x17\x92\xf4\xa8\xdf\xe7\xe5\x9d\x5e\xaa\xed\x5c\x8b\xe7\xfb\x4c\xb3\xaa\xfd\x27\xf9\x7d\xb6\x4e\xa0\xf5\x1c\x1c\xb3\xf7\x5f\xa4\xe2\xa5\x84\x0b\x21\x16\x8f\x77\x1c\x94\xea\x47\xef\xb8\xa7\x26\xaf\x7e\x79\xc1\x66\xfe\xaa\x74\x8e\xe3\x38\x49\x88\xff\xba\x36\xcb\x1c\x4f\xeb\x12\xe0\x45\x78\xad\xfb\x4d\x36\xbf\xb9\x36\xf1\xdc\xb1\xf2\x08\x76\x72\x7e\x57\xf3\xc4\x02\x04\xeb\x80\x76\xae\x05\x4f\x37\x81\xa0\x81\xbb\x83\x04\xe9\xdc\x38\xfa\x21\xe4\xc8\x36\x45\x29\xa8\x41\x91\xe4\x23\x6b\x53\xb6\xb5\x4a\xc9\xdc\x7d\xbe\x23\x66\x5b\x50\x29\x80\x18\x0d\x34\xaa\x9b\x7b\x06\x36\x88\x55\x6d\x1e\x36\xef\xd2\x03\x4a\x58\x09\x8f\x01\x30\x87\xec\xbf\xab\x68\x85\x1e\xb3\xdd\x08\x54\xc0\x10\xb7\x98\x9e\xe0\x12\xf8\x25\xd9\x2d\x4f\x36\x12\xcd\xd5\xc9\xfc\xd6\xeb\x8e\xf9\x19\x11\x84\x33\x65\xc0\xa7\x42\x06\xa1\xbd\x5e\xfa\xe0\x5f\xef\x58\x66\x8b\x18\x4c\x46\xb0\x14\xed\x30\xcf\xfd\x98\x84\xd9\xeb\x0b\xb6\xfb\x11\x35\xb7\xb7\x4f\xcc\xe8\x62\x9b\xdd\x77\x5c\x5d\xd1\x0d\xef\x73\xbb\xd8\xbf\x9d\x33\xee\xfe\xe5\x95\xdf\xbe\x76\xbb\x87\x72\x25\x3d\x37\x7d\xd0\x07\x07\x27\x16\x2b\xa1\xf3\xba\xdc\x6f\x2a\x5f\x53\x56\x7f\x12\x6e\x73\x4b\xd2\xf7\x70\x98\xad\xa1\x44\x53\x0d\x45\xd8\x52\x65\x3c\x0c\x3a\xd3\xe9\xb2\x9e\x4e\xde\xc8\xbf\x9f\x93\x46\x68\x7e\x60\xff\x52\x93\xa8\x61\x0c\xe9\x3b\x3d\x4c\xfb\xe2\x4d\xc7\x0d\xc7\x9e\xc2\xdf\x30\x16\x44\x8c\x4f\x5d\x8c\x79\xec\xfe\x7b\x77\x6c\xff\x25\xb1\x85\xa2\x6b\xd3\x67\xea\x62\x17\x47\xfb\xcf\x6f\x77\x83\xad\x90\x9f\xbf\x19\xbd\x0f\xa9\xab\xc8\x8e\xe6\x07\xe3\x77\x1f\xb9\x11\x7d\x02\x7a\x47\x74\x00\x71\xa8\xb6\x37\x9d\xe1\xdd\x28\x9b\x18\x71\x0e\xee\xd8\xf2\x34\x90\x4f\xa9\x67\x7c\x8c\xa2\xe9\x98\x37\x7e\x6b\x52\x28\xee\xb5\x6e\x50\x70\xd6\x2e\xf1\x50\xd4\x89\x12\x5e\x03\x38\xde\x61\xf6\xd0\xa0\xf7\x74\xec\xec\xee\xcb\x3a\xa5\xcc\x14\xbd\xec\xaa\x38\xcb\x1c\xda\x98\x4d\x9a\xb4\x2f\x5b\x32\xf5\xd3\x44\xcb\xb7\x5d\x4f\xa2\xa3\x83\x11\x3d\x89\xbc\xd2\xd7\x96\xa2\x57\x87\xa6\xc9\x36\xf1\x97\xac\x53\xd5\x6b\x51\x25\x54\x05\xd1\x6c\xa8\x1e\xd5\x50\x3b\xb4\x5d\xcc\x17\xab\xe2\x08\x84\x77\x71\x8b\x75\xdb\x62\x80\xa1\x3f\x06\x0d\x53\xe7\x10\xab\x5e\xbf\x23\x5c\x2a\xee\x3b\x21\x26\x41\x25\x4a\x26\x33\x86\xf8\x4d\xab\x8f\xad\xef\x76\x47\x1e\x26\x37\x98\xbf\x83\xd1\x10\x8d\x2d\xf3\xa6\x6e\x5b\x40\xcb\xda\xe0\xa1\xf2\xab\xf7\xc5\xc2\x07\x61\x32\x35\x47\x93\x0e\x00\x3f\x0c\x26\x18\xbc\x57\x79\xcc\x41\x7a\x75\x99\x83\xf4\xea\x13\x38\x48\x17\x6a\x00\xbd\x1b\x2f\x01\xa4\xb5\x80\x71\x36\x37\x17\x2c\xd6\x07\x98\xb5\x51\xb8\xb4\x5f\x9e\xc2\xe6\x7a\x13\x5b\x12\x1a\x7b\x8f\x7e\x7b\x25\x1c\x80\x28\xd6\xd3\x70\xf4\x9f\xc9\x67\x28\x15\x05\x33\xec\xe4\xa5\x8f\x09\x96\x4f\x8b\xe2\x71\x77\xf3\xa4\xe8\xb4\x3c\xb7\x52\x1b\x2c\x94\xf4\x91\xa9\x44\xf7\x06\x86\xa8\xed\x47\xea\xb9\x27\xb6\xae\x50\x5d\x69\x4f\xff\x9e\x89\xf5\xe5\x4d\xdc\xd6\x59\x15\xa2\x9d\xbe\x76\x4f\x9d\x57\xa7\x38\x7c\x82\x23\xc6\x52\xba\x65\xc2\x1f\x48\x40\x1f\x70\x9c\x11\x03\x87\x14\x2a\x8e\x3e\x9f\x21\x4d\x8e\x91\xdc\x26\x24\x10\x1c\x7e\xc9\xf3\x02\xb9\x9e\x1e\x63\x84\x6a\x6b\x60\x79\xb6\xd3\x21\x7e\x1a\xcf\x0b\x28\x57\x48\xbe\xae\x2d\x74\x22\xd2\x66\x2f\x56\x73\xe6\xb4\x4c\x41\x65\x65\x9b\xbb\x30\x62\x89\x93\x74\x5e\x57\x62\x4e\x06\xce\x49\x1a\x3b\x19\xb6\xb6\x4e\xe2\xde\xa4\xe3\x11\xf4\x6a\x95\xcb\x25\xb5\x03\x3b\x9f\xef\x01\x53\x9a\x4e\x3e\xe6\xf9\x16\x30\x35\xe7\xa0\xc3\x1d\x41\x43\x45\x5f\x14\xb0\x26\x4b\x8a\x3d\x03\xdb\x79\x3f\x16\xa7\x45\xc2\xba\x04\x3d\x00\xed\x28\xdc\x11\x85\xe4\xc6\xc5\xd6\xd6\x4f\xe8\x9f\x70\x2a\x93\xef\x16\x46\xa3\x09\x78\x4b\x01\x2c\x40\xec\xe3\xa2\xfd\xc2\xe3\x31\xd8\x9e\xb8\x6b\xad\x39\x40\xd5\x72\x4a\x61\x67\x38\x33\x30\x1e\x46\xee\x7d\x52\x2d\x4f\x81\x43\xd1\x96\x0f\xfa\x90\x02\x40\xd4\xc9\x06\x99\x09\x45\xe0\x54\xdb\x3e\x91\xc0\x48\x1e\xb4\xfb\x5e\xc3\x84\x9a\x24\x0d\x76\x54\x47\xd1\xe6\x94\x45\x3d\x7c\xf2\xdc\xaf\x51\x56\xb1\xee\xa1\x8c\x55\x52\x2e\x46\xb5\xa7\x68\x16\x37\xbf\xa7\x6c\xc1\x29\xf2\x8e\x04\x1f\x27\x8d\x4a\xce\xa4\x95\x07\x2a\x95\x92\x7c\x02\xa1\x8c\x7a\x0a\xfd\x8d\x74\x54\x0f\x36\x61\x59\x37\x4c\xd0\x84\x0c\x90\xc0\x68\x2e\x77\x9e\x65\x4d\xdb\xc3\xe1\x29\x69\x21\xb5\x2c\x3f\x7d\x62\xa4\xa3\x07\x44\xfb\x12\xdc\x11\xbf\x5c\x47\x26\x52\x4f\xac\xce\x7a\x35\x79\x5f\xd6\xfb\xc5\xd5\x35\xa3\x1f\x05\x7c\xbb\xbc\x44\x61\x21\xd1\x2f\xee\x88\x76\x87\xcd\x4d\xa7\x9f\x64\x74\xd1\x42\x89\x8b\xbd\x87\xf8\x5c\x3d\xc4\x28\x60\x72\x53\x76\x2e\xc5\x90\xf8\xaf\xc3\xf5\xb4\x40\xaa\x62\xf5\x65\xe4\x44\x00\xe1\xad\x67\xed\x1c\xda\xc5\xac\xb8\x0d\x87\x56\x81\x66\x86\xbb\x7c\x21\x0a\x7a\x2e\xde\x24\x33\x5b\xe3\x19\x8a\x64\xcb\x3c\x0b\xea\x96\x2f\xd8\x3e\x45\x08\x26\x67\xf3\x06\x09\x54\x70\x67\xe5\xe7\x10\x40\x4f\x43\x0b\x6a\xd9\x86\x7d\x1b\xba\xae\x57\xa9\xab\xfb\x2a\xda\xed\x23\x68\x41\x4f\xad\xf5\x66\x56\x2c\x16\x80\x08\x8d\x72\x6c\x6f\xc7\x93\xb1\x1c\xef\x11\x1e\x69\xaa\xdd\x1a\x29\xaa\x8f\xa5\xe6\x0e\xd6\xb5\x5b\x06\xea\xf3\x36\x2a\xd3\x1b\x68\x59\x7c\x66\xa1\xd9\xbb\xe9\x91\x6e\x8b\xf0\x86\xa7\x2f\xd8\x78\x71\x92\x9a\xe9\x78\xcb\x84\x6c\xa3\x73\x7b\x83\xe4\x43\xe6\x54\xb1\x44\x86\xee\x55\xa2\xe5\x55\xb2\x50\x08\xc1\x2c\xb4\x7b\xf3\x82\xa3\x52\xb9\x2a\x0b\xf6\xd9\x1a\x64\xa2\x73\x35\x29\x14\x19\x7e\x21\x16\x32\xed\x85\x99\x45\x9d\xfc\xf2\x10\x17\x73\x60\x2e\x54\x51\x0f\x74\xfd\xb9\x39\x09\xad\xf8\x02\xcd\x69\x98\xc7\xc7\x27\x19\xc8\xd4\xac\x85\x61\x87\x66\x5e\x4f\x76\xae\x1d\x52\x2b\x3c\xa6\x51\x31\xd5\x22\x5b\xe5\x2f\x15\xcb\x29\xbb\xe7\xac\x97\x6d\x04\x46\xd1\x39\x01\x88\x44\x88\x01\xee\xf2\xa0\xac\xd3\x08\xc1\xe1\x16\xde\x4f\x65\x5d\x5c\x1c\xba\x1c\xce\x74\x00\x8d\xfa\x42\x44\x6f\xb1\x0c\x3c\xbb\xfb\x2d\x9c\x68\x2f\x86\xd8\x15\x47\xfc\x36\x3f\xb8\xd3\x14\x18\xa8\x75\xb6\xa8\xef\x97\x46\x90\x14\x84\x09\xdb\x75\xb1\xdc\xf5\x31\x2b\x27\x41\x71\xe1\x30\x35\x10\x0e\xba\x94\x5b\x61\x6b\x4f\x04\x29\x63\x38\x45\xaf\xf5\x3f\x66\x57\x68\xa4\xc1\xb2\x27\x10\xf3\x3c\x74\x4f\xbb\x17\x94\x5b\x2d\xaf\xd5\x56\xea\xa5\x52\xd0\x65\xa4\x95\x04\x1d\x96\xaf\x1b\x77\xc8\x16\x62\x9d\x31\x2b\xe4\x5e\x4d\x66\x4c\xd2\x4c\x6f\x81\x81\xb2\x34\x69\xdd\xf4\x30\xf9\xcf\x92\x7c\xd9\x11\x43\x79\x33\x2a\xf1\xd2\xd3\x69\xf2\x89\x97\xbc\x2d\x56\x95\xec\xc8\xad\xdb\xae\xd0\x6f\x1f\x50\x21\x43\xa3\xb6\xcc\x85\x7d\x49\xa2\xc0\x44\x8d\x8d\xb0\x0d\x63\xd9\x8c\x01\x86\x03\x12\x80\x27\xf8\xf2\x6c\xa2\x65\x2b\xaa\x64\x6d\xa8\x89\x19\x48\xce\x62\xbd\x65\xb0\xc8\x11\x91\x9f\x7f\xe8\xf8\x63\xa9\x04\x2d\x32\xc1\xd1\x2a\x69\xa0\x97\xd5\x44\xc1\xc6\xaa\x08\xf1\x9a\x95\xd3\x53\x4e\xca\xc2\xf8\xc5\xf0\x89\xa5\x88\x48\x4a\xa9\x82\x09\x93\x60\x30\xd9\xdc\xe3\xb9\xae\xb4\xf2\x4f\x16\x4b\x6d\x84\xee\xa5\x57\xde\x8e\xc4\x7c\xc1\x0b\x99\xbb\xd9\x7d\x02\xa8\xe5\x8b\x71\xc9\x15\x9e\x1a\x48\x54\x5b\xa3\xde\xf4\xed\xf9\x13\xe3\x2c\x99\xdf\x79\xb7\xff\xb6\x82\x1c\x29\x3b\xce\xa6\x81\x46\x7e\x4a\x64\xe2\x73\x56\x86\xdd\xd1\xd6\x90\x7f\x8d\xe3\x68\xc5\xd9\xb7\xe3\x45\x94\x47\x79\x4e\xae\x94\x67\x25\xea\x00\x13\xd7\xca\x3a\x5e\x4f\x22\x35\xed\x1e\x82\x1f\xc0\x5a\x5d\xa9\x66\xa8\x28\xac\xce\xea\xdf\x3a\x88\xe5\x93\x39\x0e\xbd\x9c\xad\x44\x56\xb8\x85\xe1\x14\xd7\xd5\xbb\xc8\x31\x68\x9e\x8c\xa4\x9a\x81\xa1\x56\x19\x40\xe6\x7e\xb8\xdd\x5e\xfe\xb7\x57\xcf\x99\xfa\xf2\x99\xfa\x19\xf2\xf2\x3c\x24\x17\xf8\x74\xc2\x4f\xb3\xad\x33\x54\x0f\x1c\x17\x9e\x2f\x19\xd6\xcb\xee\xb0\x12\xfe\x0f\x9d\x14\xc9\xea\xda\xe9\xae\x07\x18\xc2\xc9\x8d\x30\xb2\x72\xf4\x30\x30\x71\xbb\x54\xa1\x3a\xc7\x58\x19\x02\x3c\x3f\x18\x43\xa1\x9c\x0c\x78\x8b\x68\x21\xd9\xef\x62\x59\xf6\x4b\xcc\x62\x1e\xd4\xeb\xba\xb5\x06\x11\x4d\xd9\x96\x39\xe5\x6f\x0b\x76\x60\x8b\x2d\x77\x6f\xe6\xb3\xe0\x77\xa3\x79\x77\x0b\x77\x3b\x12\x45\x01\xc5\x03\xe6\xb8\x60\xc2\x43\x17\x91\x8d\x4f\x8f\x55\x2c\xaf\x5d\xad\x0a\x30\x02\x3a\xdf\xc5\xb7\x91\xaf\xb2\x4d\x70\x4f\x49\x88\xc0\x04\xce\x51\x88\x5b\x11\xcb\xd1\xfb\x54\xc3\x41\x0d\x90\x1f\xbd\x9b\xa1\xe4\xba\x23\xf3\x6e\x9d\x87\x56\xbb\x0c\x42\x09\x12\x21\x39\x43\x50\xd5\x95\xed\xb0\x4d\x9e\x55\x03\xe4\xcc\xbb\xa6\x10\x85\xac\x3d\x95\x42\xc5\xbb\xf2\x51\x08\xc5\xa4\x13\x77\x07\x5f\x95\x3b\xd8\x6d\xa7\x9e\x39\x48\x0e\x5e\xcb\x64\x7a\x26\xdc\xa3\x3c\xe2\x03\xac\xb0\x1c\x05\xbb\x59\x09\xcd\x12\x33\xb0\xf3\xf7\x8a\xb2\xa0\xfb\xde\xd9\x2d\x8f\x9f\x7c\x5f\x3a\x37\xdc\x5a\x52\xd2\x9c\x7d\x9b\x87\x42\x14\x8e\x10\x44\x1d\xcc\xfb\x68\xb9\x89\xbf\x01\xd1\x06\x6a\x52\xac\x66\x99\x9f\xd9\x75\xc8\xfc\xb2\xcf\xed\x03\x99\xa0\xf1\x00\x27\x51\xe2\xf1\x47\x16\xde\x97\xfc\x43\x1e\x7e\x64\xd0\x93\x93\xf2\xa0\x77\xe0\xfc\xf1\x91\x3d\xe6\x23\x23\x9b\xf5\x7e\x83\x2a\x2e\xb2\x4e\x96\x8b\x3a\x71\xd2\x7c\xc9\x93\xe6\x21\xac\xd4\x0f\x61\x89\x45\x13\x21\x12\xcb\xa8\x32\x14\x14\xa3\x0d\x84\x38\x54\x4b\xb1\x57\xeb\x75\xb4\x27\xcb\xbd\xa4\xa9\x06\xa5\x74\x7d\xca\xd5\x5e\x5d\xbb\x6f\x60\x87\x95\x54\x35\x95\x68\xe9\xe8\xba\x4d\x3b\xa2\x6e\xe2\x9f\xc4\x5b\xa3\xed\x72\x03\x75\x05\xe3\x09\x1d\x3c\xad\x1d\xd7\xeb\xbd\xbb\x48\x18\x21\x5a\x9d\xb1\xb2\xb8\x6c\x83\x54\xa8\x5b\xf0\x56\x4a\xe9\x0e\x87\xda\xa7\x2e\x46\xb3\xa7\x36\x79\x0e\x95\x01\xb7\x1b\xbf\x77\x9e\x24\x82\x81\x51\x2d\x68\xb7\xd7\x4f\xa4\x96\x9a\x43\x6d\x95\x2a\x42\x9c\x0d\x93\x5c\x86\x45\xde\xa8\x07\xa1\x19\x6f\xea\xb0\x2f\xdc\x1b\xb8\x0e\x43\x72\x0a\xd3\xd1\xf5\xcf\xff\xc3\x5e\x4e\x17\x77\xdc\xf8\x7f\xbf\xaf\xb3\x90\xfe\x9a\x3c\xe3\xfe\x8f\xfe\xfd\x67\x50\xb5\x82\x2f\x3f\xfc\xe9\xc3\x7d\xb6\xd9\x1e\x81\xff\x9c\x64\x63\x39\x9e\xc4\x3d\xb5\x3b\x3e\x63\x01\x9a\xef\xb3\xdf\x55\xd1\x93\x97\xd6\x6d\x20\x4e\x03\x29\xa2\x44\xb1\x4d\xcf\x2c\x13\x15\xa5\x1a\xa3\x32\x16\x35\xf9\x62\x3f\xef\x32\x1e\x33\x11\x56\xb1\x42\xbc\x6f\xf3\x04\x86\xc7\xb2\x8a\x3b\xec\xb3\xf2\xf0\x4f\xe1\x0f\x0e\xe5\xaa\x73\x51\x4f\xd8\xf0\x72\x28\xb4\x78\xef\x11\xa0\xc1\x3f\x29\x12\x1c\x49\xe3\x7f\xde\xce\x33\xd3\xf2\x8b\x0e\x61\xbb\xf5\x2e\xca\x51\x6c\xdc\xe9\x4a\xad\xe6\x54\xe9\x81\xa7\xe0\x5a\x62\xb9\x15\x7c\x98\x12\x4e\x8c\x67\x13\x94\xc2\x38\xfb\xab\x99\x4a\x72\x5b\x16\x4e\x35\x23\x3c\x37\xca\xac\x64\x3d\x46\x63\x9b\xfe\xab\xa9\x8d\x7c\x6f\xe4\x66\x65\x74\x3e\xae\x7f\xfa\x4a\x3a\x4f\xd8\x9d\x9f\x58\x27\xe7\x3a\xad\xd5\xfd\xdd\x11\xe8\xce\x12\xd3\xed\xec\xac\x77\x92\xe4\x54\x50\xb4\x85\x90\x7a\xd5\x27\x14\x7e\x23\xde\x0e\xe7\xa8\xee\x50\xa9\x73\x47\x1d\xf2\x76\x14\x6c\x3d\x5a\x28\x89\x4a\xb6\x59\x59\xfc\x33\x4e\xee\x90\x54\xdc\x7b\x5d\x20\x3f\xda\x33\xf8\x82\x75\xdf\xd4\xf5\x22\x52\xde\x69\x07\x6f\x1a\x39\xa6\xdb\x06\x83\x77\x97\xa1\xd0\x1b\xae\xa2\x74\x7d\x72\x5a\xc9\xd7\xbb\xb2\xb6\xf2\x15\x77\x30\xbb\xe3\x0c\x55\xc7\x99\x14\x89\x9d\xc9\x92\x2f\x92\x51\xf8\x99\x96\x89\xbf\xfe\xfe\x33\x46\xfb\xee\xd7\x6a\xcb\x84\x8d\x98\x29\x56\x79\xf6\x2a\x9d\x39\xba\xb2\xc6\x95\xaa\x1c\x87\xa3\x96\xbe\x7b\x54\x28\xfe\x0e\xf7\x79\xbc\x78\x7a\xc0\xe8\xe3\xb0\xa1\x9d\xce\xc9\x0b\xe4\x3e\x2e\xeb\xd5\x12\x38\x35\x8c\x2f\xba\x09\x58\x9a\xc4\xcc\x31\x70\x22\x53\xe5\x25\x88\x8a\x87\xf0\x68\x0c\x6b\x75\x74\xc8\x06\xde\xf5\xbb\x8e\x8b\x61\x51\x5e\xae\x20\x1c\x09\x69\x6f\x0a\x35\xfb\x0a\x19\x4f\xa2\x69\xa3\xb8\x05\xf2\x3d\x1a\x3d\xc6\xd8\xa4\xda\x31\x08\xd0\x29\xea\x81\x77\x3d\xe6\x81\x77\x83\xc4\x03\xef\x6e\x7e\xaf\x8e\x8b\x01\x64\xd2\x00\x50\xfb\x22\x09\x8d\x3f\xbb\xd9\x01\x43\xc0\xb7\x43\xdd\xcc\x9f\xa8\xec\xae\x78\xd1\x55\xb6\xd7\xb6\x87\x8c\xd4\x00\x04\x73\x6f\xea\x8f\xf9\xcd\xd5\x5b\xb3\xa8\x99\x91\x37\x8b\xac\xf9\x78\x73\x99\xea\x3c\xdd\x38\xa3\x2f\x1e\x0a\x93\x0e\xe6\xe7\x4d\x95\xcb\x88\x96\x91\xd9\x58\xe1\xc3\x2b\xe0\x35\xdc\x2b\xc5\x8d\x08\x32\x35\xcd\x81\xfc\x6e\x8a\x69\xdc\x14\x2d\xa2\x1a\x54\x99\x8f\x61\x5f\x42\x7f\x54\xd1\xc9\x3e\x0c\x52\x64\xe2\x6c\xc0\x66\xa8\x9b\x6d\xcd\xc0\xfa\x01\xe8\x24\xa1\x7a\x2a\x14\x2c\x62\x64\xae\x91\x27\xc4\x1c\xd0\xc6\xc5\xee\xf5\x22\xf1\xe4\x8a\x21\x48\x82\x27\x60\xe8\x38\x85\x92\xe3\xa1\xdf\x0a\x48\xad\x69\xfa\x76\x9b\xb3\x87\xd0\x0f\x7d\x39\x8d\xc8\x4d\x1c\xb0\x4f\x89\x71\xfa\xb0\x6b\xf2\x6a\x05\x7e\x36\x8f\x9d\x95\x2f\x1f\xb5\x8f\x6d\x6f\xec\xc8\xe6\xf9\x54\x9e\x28\x69\x82\xa1\xcf\x39\x0a\x15\x1b\xf8\x26\xb5\x3b\x4f\x91\x8d\x8d\x94\x80\xc0\xcb\x07\x2d\x05\x06\xb0\x81\x1b\x17\xdd\xb9\xce\xee\x95\xc5\x3f\xdc\x6a\x6d\xb5\xee\x86\xcf\x98\xeb\xba\x67\x95\x9c\x79\x46\x17\x30\xa3\x73\xbd\x37\x98\xcf\x04\xbb\xc1\x1b\x35\xf9\x86\xc0\x90\x35\xea\x0b\xca\x3e\xcc\x28\xac\x68\x64\x10\xb4\x8a\xed\x6e\x5f\x55\xe2\xce\x73\x92\xdd\x16\xcc\x53\x59\x93\x91\xab\x23\x22\x52\x8e\x97\xf2\x40\xc0\xef\xcc\xe0\x47\xd1\x53\xae\x8a\xed\xbe\x64\x2d\x5e\x90\xaa\x8a\x0f\xaf\x0e\xa1\x5f\x76\x47\xb6\x47\xdb\x2c\xac\x89\xab\x80\x61\x48\xfc\xb8\xb5\xbf\x45\xa5\x62\x4f\x72\xf6\xce\x93\x88\xb3\xa2\xd4\x63\x03\xf3\xa5\xe7\x11\x43\x1e\xaf\x22\x35\xc6\xa1\xf9\xf3\x90\x74\xfb\x1f\x3f\x15\xda\x1e\x89\x6f\x92\xfc\xa6\x75\xed\xb7\xe7\xeb\xda\x0f\x47\xd2\x8f\x47\x87\xd4\xd5\xdf\x99\x3c\xd2\x42\x32\x58\x45\x35\xb3\xdd\x47\xcd\x1f\x45\x83\x4a\x0f\xba\xf3\xe3\xf1\x22\x03\x1d\x25\xdc\x13\xc4\xbf\x6e\x1c\xd5\x6e\x90\x0b\x90\xe5\x0b\xb9\xb1\xb0\x4d\x17\x8d\x8b\x95\xb3\xc6\x76\x62\x97\x4f\x82\x3e\xd1\xdb\xb8\xf4\x50\x54\xbe\xb2\x10\xc8\x62\xea\x86\x82\xea\xbb\xe8\xea\x42\xf5\x1f\x18\xf2\xba\xfc\x2d\xf6\xf8\xc3\xc0\xff\x33\x5e\x8d\xfe\x98\x46\x0b\x4e\x60\xb5\xd3\x63\x72\xc6\x05\xab\x1a\x98\x32\x01\x41\x09\x41\x5a\x14\xbe\x00\xcd\xad\xe0\x0c\x1a\xb3\x20\xd1\x9c\x78\x7c\x1f\xad\xb7\xf3\x92\x3c\xd6\xdd\x2a\x12\xad\xdc\x91\xcd\x09\x11\xa3\x2b\xd4\x30\x9d\x0d\x12\x51\x28\x3a\xcd\xe5\x41\x4e\x46\x5c\x3c\x00\x3a\x03\xdf\x22\xac\x6f\x4c\x80\xce\xec\x71\x77\x0d\x54\xee\xf3\xbc\xf5\xac\x1c\x32\xd0\x53\xf3\xf3\x75\x2e\x38\xc9\x90\xea\xd0\xe4\x7a\xb3\x2f\x51\xad\xa5\x61\x96\xfa\x8b\x81\x19\x52\xf4\x42\x40\x3e\x22\xb3\xa0\x0b\x43\xc7\xe7\x0c\x64\xe0\xcd\xc5\xb1\x74\x90\xe9\x0d\xf5\x0d\xf7\x8d\x45\x4d\xf3\xfa\xb8\xda\x34\xab\xce\x1a\x64\x6d\xb4\xf0\xe4\xf9\x23\xfd\x69\x0d\xa0\x77\x9b\xef\x17\x75\x75\xc0\xe9\x5b\xec\x4a\xe9\xcf\xa8\x5c\x84\xc1\xdf\x99\x46\xa9\x33\x06\x1f\x6f\xb4\x34\x11\xb7\x45\x14\xca\x22\xaf\x9b\x85\xf3\x45\xe2\x66\xbe\xaa\x2a\x8f\x18\x24\xa7\xf1\x4a\xc1\xb4\xf8\xea\x31\xee\xb4\x88\x35\xfb\x42\xb6\xf7\xae\x98\x43\x70\xe6\x20\x0d\x02\x4d\x76\xdf\x76\x6a\x23\xa1\xd0\xa5\x6f\xd8\x5e\x78\xbf\x87\xc6\x18\xc7\x8b\x85\xaf\x30\xe8\x72\x98\x78\x69\xa3\xaa\xf6\x12\x27\xdb\xfa\x1e\xd5\x21\xd3\x54\x08\x8d\x21\x7a\xe4\xc3\x69\x21\x26\xb9\x86\xc9\x35\x0e\xcc\xaf\xbf\x77\x61\x6e\xbe\x69\xb1\x34\xdd\xe9\xd1\xe0\x29\x88\x23\x93\xd8\x99\x75\x99\x69\x14\x65\x4b\x3b\x4c\x5e\x6e\x53\xcd\x8e\x13\x87\x83\xb3\xc6\xe3\x9a\x22\x7f\xb8\xa0\x96\xf0\x60\x99\x7b\x05\x02\x5c\xa2\x33\xa7\x69\xce\x28\xdf\xf9\xde\x39\x33\xd5\xcd\xc3\xd5\x90\x4b\x12\xf0\xf8\x9c\xa4\xf2\x18\xca\xca\x1c\xcb\x17\x89\xf2\x76\x20\xff\xa6\x5b\xc0\xb2\x68\x0b\x71\x48\xaf\x76\x85\xd4\xe4\x12\xe9\x41\x05\x58\xe3\x43\xcd\x58\x50\xf2\x11\xc2\xe1\xdc\x6c\x60\xcb\xe7\xba\x95\x42\xf6\xc2\xad\xa9\xc5\x80\x50\x30\x0b\x6a\x81\xf9\x9f\x0b\xc2\x6a\x75\x63\x74\x98\x55\xbc\x12\xfa\x61\x2a\x0a\x46\xb1\xd9\x01\x5c\x2e\x73\x41\x1d\xa4\x75\xfa\xb3\x48\xe9\x95\xe8\x9a\x8e\x5e\xa7\x50\x35\x03\xb2\xc3\xee\xa1\x9d\xf6\x8e\xba\x99\xae\xf2\x46\x4f\xaa\x92\x94\x9d\x82\xd6\xaa\x2d\xa9\xd3\xee\x9a\xbd\x08\x3c\x49\x87\x78\x55\x27\x04\xef\x73\xbd\xf4\x02\x7a\x98\x5d\xf0\x8c\x78\x52\xe9\x0c\x0d\x51\x01\x8d\xc0\xc8\xd8\x61\x5b\xb4\xed\x5e\x34\x06\x6c\x9d\x24\xc2\x17\xa0\x7c\x55\xd1\x0e\xff\x12\x23\xca\x71\x67\x5b\x90\x1c\x87\x6a\x42\xde\x10\x01\x2f\x5a\x85\x93\x0f\x6b\x7e\x2e\xe3\xe3\x1d\xdc\x0b\x74\xd6\x6b\x11\x16\xe4\x25\x0a\x98\x8f\xe2\x91\x73\xd3\x28\x00\xf9\xc9\x7c\x4f\xe9\x8e\x41\xc1\x4c\xd5\xca\xf4\xd4\xcb\x28\xa9\x4a\x77\xe0\x93\xc2\x50\x91\xfb\x70\xb7\xfe\xd2\x52\x20\xd3\x14\x58\xeb\x7e\xfd\x72\x72\xa0\x06\xc2\x85\x17\x3e\x85\x3a\xf2\xd2\xa1\x91\xbc\x90\xd6\x34\x10\x53\x16\xa2\x43\x02\xb0\xe3\x43\x81\x45\x7f\x06\x9d\x6a\x3e\xf9\x39\x47\x8e\x7f\x54\xaf\xec\xef\xa0\x1e\xda\x66\xa5\xea\x7c\x20\xd1\x87\xe0\xa6\x51\x3e\x15\xda\xd0\x45\xac\x7f\x4e\x63\xe1\x02\xfc\x36\x57\x49\x31\x73\x6e\x69\x9c\x24\x06\x54\x49\xf4\xae\xbd\x39\x4d\x09\x79\xe2\xbc\xb0\x93\x62\x54\x45\x59\x06\xe7\xa3\x32\x0b\x4b\xcf\x94\x86\xc9\x4e\x4f\x70\xac\x89\x41\x38\xdb\x9c\x1d\x4c\xca\x9a\x81\x1f\x6d\xaa\x42\x1c\x47\xa9\xd4\xca\xe6\xa1\x45\xa2\x28\x98\xc7\x26\xf0\xc6\xb0\x99\x2c\x70\x63\x7a\xdd\xe1\xe1\x21\xa6\x56\x01\x36\x44\x0a\x6e\x38\x05\x13\xff\x3c\x17\x78\x1d\xaa\x59\xbb\x48\x3e\x33\xe4\xfc\x13\x99\x04\x6d\x52\xa2\xe9\x4d\x4b\xcc\xd1\xad\x97\xb6\xe0\xad\xaa\x85\x91\xc8\x3d\x47\xf9\x1d\x44\x5a\x7e\x87\xde\xa7\x71\x65\xe0\x4b\x12\x7b\xaf\xaf\xde\xaa\xf0\x18\xb6\x95\x90\x8f\x0b\xb4\x81\xb8\x45\xb1\xcd\x17\xd2\xad\xd6\x90\x75\x04\x82\x12\x00\xc7\x82\xb0\xfa\x3e\x6b\xb7\xa2\x03\x4e\xc9\x12\xac\x4d\x2b\x63\xa4\x4d\x88\x2b\xce\xa3\xf3\x2a\x5b\x4a\x1e\xca\x46\x73\x5e\xbf\xe9\x37\xa8\xe8\x59\xbf\x95\x3b\x0c\xc1\xd7\x78\x32\xbd\x9a\xff\x44\x05\x38\xb1\x5c\xba\xdf\x31\xe1\xcb\x8c\x43\xed\x7d\x8c\x3f\x5a\x13\xbd\x1f\x7d\xef\x1a\xfa\xc1\xa9\x4d\x91\xb2\x46\x44\xf2\x92\xca\x22\x6d\x2a\x93\x53\xa3\x82\x37\xa5\x49\x41\x5d\xcd\x77\xde\x51\x27\x02\x98\xd6\x88\x85\x7e\x89\x7b\x9d\x7f\x3d\x20\x0c\xa5\xd3\xd5\xe6\x11\x18\x43\x32\x55\x6d\x5d\x4a\xfb\x20\xe6\xd8\xeb\x4c\x92\xce\x5d\xd2\x89\xa3\x1b\x28\x25\xe7\x55\x88\xd4\xa5\xce\x07\x78\x75\xfa\xb4\xb2\xfe\xe0\x39\x22\x2f\x74\x5a\xa2\x38\x56\x23\xd6\x86\x37\x32\xe4\x9f\xb3\x44\x1d\xc5\x2d\xd5\x50\x50\x14\x66\x48\x6b\x68\xdb\xab\x58\xc9\xfc\xb7\x0c\x08\x37\x53\xb9\x4a\x32\xd1\xb3\x3c\x9f\x46\xcf\xaa\x2b\x56\xef\xb1\x36\x78\x99\x4a\x0e\xf9\x37\x91\xa5\x4b\x6a\x94\x65\x29\x8b\x19\x14\x4b\x7a\x56\xe5\xcb\x47\x5a\x95\x53\x9c\x4e\xd7\xc0\xda\x0e\x98\x8f\xff\x1c\x50\x2a\x8e\x74\x05\xbb\xc0\x3b\xd6\x94\x63\xb5\x22\x09\x65\x22\xc6\x2f\xd6\x96\xea\xa0\x42\xf8\xf2\x6d\x17\x84\x04\x77\x5a\x20\xa0\xf7\x5e\x1b\x0c\x52\x07\x5a\x28\x0e\xae\x6b\x50\x3a\xd0\xb7\x4d\x89\x85\xbc\x2c\xfd\x71\xd4\xec\x29\x08\xb6\xa0\x8c\x53\x9b\x8a\x66\x08\x9a\x81\x5f\x59\xee\xcb\x71\xef\xd5\xed\x7e\x18\xb8\xef\xaa\xbb\x7d\x09\x1d\x17\xc1\x57\x3d\xa0\xf7\xe7\xfc\x7b\x86\xa4\x72\xcc\x28\x70\x73\xde\x2b\x5a\xe1\xe5\x31\x6c\x6b\x0e\x2d\x92\x12\x33\x2a\x7c\x13\xb3\xbf\xe6\x8c\x1a\x5b\x5c\xf2\x3a\xaf\x9c\x00\x1d\x91\xf8\x4c\x80\xdb\x4b\x60\x53\xd0\x58\xbd\x46\x96\x84\xb9\x2f\xc3\x06\x1f\xc1\x66\x0f\x12\x52\x09\x76\x8a\x2f\x37\xca\xe8\x5f\x92\x48\xbc\xf5\x2a\x6d\x10\xc0\x8b\x7b\x28\x4b\x66\x4e\xa4\x83\x80\x39\xb0\x08\x3b\x6b\x63\xd5\x14\xb9\xd8\xb7\x60\xb4\x3d\x9d\xbd\x97\x8d\x02\x67\x17\x8b\x0a\x45\xec\xe1\x17\x0a\x60\xb6\x48\x40\xda\xd9\x74\x08\x21\x2c\x70\x61\x99\xf8\x8a\xa1\x80\x6a\x29\xfa\xe4\x9c\xe8\xdc\x33\x1a\xa8\x84\xac\xe1\xc6\x6b\x6a\x14\x47\x86\xd9\x7d\xb4\x71\xb7\x11\xdc\x19\xf6\xa3\x07\x13\xf7\x2f\xc6\x48\xe4\x37\x0a\x5a\x2d\x22\x5d\x53\x94\x94\x13\x91\x28\x7a\x68\xdd\x7a\xc1\x13\x85\x62\x83\x1d\x81\x36\x5e\x22\x68\x5b\x73\x1c\xdd\x2f\x28\x4d\xc8\x5f\xe8\x8c\x1f\x6f\x0a\x7c\x17\x73\x0f\x9d\xb2\x12\xaa\x7e\xf5\xab\xa8\x5f\x8d\x2a\x1a\x0f\x5a\x87\x41\x3d\xf6\x51\x11\x92\x76\xf7\xa5\xcd\x7d\x47\xea\x20\xaf\x58\x05\xb1\x58\x28\x2c\x0c\x6d\x56\x39\x65\x1e\xe4\x70\x08\x68\x62\x6c\xb6\xa6\x58\xad\x70\xbb\x51\x09\xb4\x87\x30\xf4\x9e\x4e\x15\xbb\x4d\x3f\x6f\x0a\xb6\x61\x88\x0c\x99\x62\xda\xe0\x83\x76\xd0\xad\x53\x13\x24\xdc\x6f\x95\xaa\x87\x95\xc2\x58\x8c\x48\xe4\xc5\x04\x88\xa9\x75\x0b\xdf\x66\x88\x6c\x83\x3b\xb0\x6e\x24\xed\xb0\x13\xce\x7e\x93\x8f\xb0\x5c\x0f\xf9\x15\x4a\x76\x9e\x44\x97\x9d\xd5\xf5\x47\x7a\xb6\x73\x11\xa8\xe5\x0d\x50\xd1\x6c\x99\x42\x5b\x03\x5f\x29\x74\xf5\xee\x3d\x66\x72\xfc\xf2\x69\x3a\xdc\xd2\x61\xd0\xd3\xf0\x1d\x55\x2f\x24\xed\x7c\x95\xb2\x88\xeb\x03\x56\xda\x61\xa0\x99\xed\x97\x43\x95\x9c\xce\x7d\xec\xc1\x94\x45\x48\x9f\x32\x0e\x9c\xd5\x8f\xe4\x79\x3f\x35\xdb\xa6\xdf\x2b\x04\x05\xb8\x88\xd2\x64\x76\x53\x1a\x0d\xa9\x19\x0c\xbe\xd3\xf0\x58\x45\x6b\x34\x11\x43\x24\xa8\x05\xca\xbf\x3b\x7f\xa8\xeb\x22\x3c\xe7\x62\xfa\x2b\x57\x92\x88\xf2\x4d\x44\x7a\x88\x98\xa0\x70\xcc\x60\x92\x68\xa1\x3e\x73\xae\x00\x1a\x0c\x57\x92\x26\x10\xff\xc7\x47\xac\x92\x9e\x17\x0b\x05\xff\xf2\xeb\xf7\x8a\x0d\x25\xe2\xe5\x5c\xb6\x4c\x52\xac\xe2\x04\xe9\x13\xb5\xd1\x7c\xdc\x0f\xf7\xad\x88\x1f\xcc\xaf\xb4\x7e\x37\x2c\x26\xf1\x0a\x97\x59\xa5\xfc\xd3\xee\xb0\xd5\x56\x5b\xbd\xbe\xca\x13\x4a\x4e\x42\xcb\xc8\x4c\x54\x46\x28\x37\x5e\x7c\x2a\x19\x39\xc9\xda\xc9\xce\xd2\xb7\x3d\xb7\x2a\x7a\xf4\x5d\x16\x0f\x32\xb9\xa2\x6d\x96\x90\x70\x34\x44\x82\xae\x53\xfd\x4d\x5c\xf7\xb6\xcb\xe9\x2d\x44\x7b\x5c\xcd\x95\x7f\x89\xfe\x69\x8f\xcc\x41\x77\x97\x46\xf3\x80\x20\x0e\x4f\xd7\x9d\x06\xab\x76\x45\xab\xfe\xc2\x87\xb1\x1f\x61\x1e\xf3\x5c\x67\x14\xed\xfb\xc9\x0c\x01\x4b\x36\x0f\x46\x8b\x3d\x44\xba\x86\xa7\xf1\xb1\x37\x36\xcb\xba\xdc\x37\x9a\x42\x5a\xc2\x3f\x16\x67\x98\x17\x44\x49\x32\xd2\xf3\x46\x7e\x87\xbe\x31\xcb\x9b\x7b\xa5\x3b\x94\x47\x61\x31\x78\x23\x8f\x23\x50\xe1\xb9\x8b\x97\x65\xe0\x91\x64\x2c\x92\xee\xc0\x0d\x34\x1a\x31\x79\x43\x67\xdd\xd7\xce\x3e\xe6\x8a\x69\xf5\xe1\x27\xf8\x62\x26\xcf\x96\x71\xc8\x26\x9a\x66\x0d\xf8\x0c\xe0\xdd\xa3\xd9\x41\xc0\xd4\xea\x54\x65\x33\x00\xb8\x25\xe0\x75\xbf\x73\xb1\xe8\xbd\xea\xcb\xb9\xdd\x58\x6d\xbc\x3c\x63\x80\x4e\x93\xab\x46\x49\x72\xc4\x17\xec\x74\x18\x4b\x9a\xc9\x66\x26\x5a\x7b\x69\x33\xf7\xfd\xba\x46\x08\x12\xbd\x65\xdf\x1d\x3b\xa6\xa6\xe9\xcf\xa4\xe8\x06\x6a\x58\x95\xf3\xdd\xd9\x06\xd1\x3a\x94\xa3\x86\xaf\x46\xd6\xc5\xa9\xc4\x84\xff\xed\xcf\xf8\xad\x3a\x4b\xc9\xba\xb1\xcd\x11\xcb\x86\x78\xa0\xbb\x28\x92\xc5\xe4\x65\x1d\x6a\x8a\x98\x93\x22\x5a\xa9\x67\x89\x28\x8e\xa9\x42\x38\x7f\xe0\x61\x4c\x99\x6f\x17\x5f\xba\xb5\x87\x52\x1b\xa0\x94\x53\xd2\xf1\x4d\x85\x5a\xad\x32\xdd\xcf\xdd\x5a\x16\xef\x30\x4b\x5f\xa8\x31\x1d\xe9\xa3\x16\x22\x02\xff\x94\x37\xd7\x62\xf5\xfb\xc0\x77\x49\x9f\xf0\x4f\x7e\x75\x52\xc8\x58\x10\x1b\xdb\xac\xf1\x72\x56\x5d\xca\x36\x09\x3b\x8a\x68\xf2\xe1\x4b\x9b\x70\x72\xc1\x12\xed\x56\x45\xd2\x7c\x7f\x65\xef\x45\x79\x52\x2c\x0d\x14\xd0\x53\x52\xad\xca\x3c\x91\xdc\x52\x4f\x21\xfe\x8d\x1c\xa5\xaa\xb9\xac\x51\x42\xa8\x63\x6b\xbc\xc0\x09\xd4\xce\x4b\xb1\x53\xf7\xa8\x7a\x79\x30\xf8\xb0\x39\xcb\xed\xb2\x49\xec\xe3\x8b\x89\x21\x30\x31\x02\x77\xad\xe4\x09\xbf\x59\xa7\x5e\x1e\x4e\xc7\x7c\xd1\xf1\x94\x22\x3b\xa7\x5d\x1d\xfa\x9c\xd3\x44\x86\x23\x5d\xe7\x11\x1a\xa0\x73\x11\x53\xa6\x36\x24\x7a\x12\xe5\xc9\x0a\x6a\x83\x1e\xaf\x46\x60\xf4\x89\xb3\x6d\x6b\xf9\x40\x02\x3c\xf0\xe6\xe8\x16\xf2\x15\x1a\xc2\xc4\x5a\xc3\x5c\xc0\xbd\x5f\x28\xc8\x4f\x0b\xeb\x45\x77\x76\xbc\x6f\x32\x4f\x43\x74\xcf\x7b\x1d\x91\x4c\x05\x98\x30\x28\xcc\xc6\xc5\xc2\x4f\x4d\xce\x2c\x05\xc9\xae\xf9\xd3\xe5\x92\x82\x92\xbf\x3c\x43\x4a\xe4\x7d\x55\x23\x13\x89\xec\x67\x84\x1f\x91\x3d\x17\xc7\xf8\x9a\x0c\xf7\xed\xfe\x72\x5d\xcd\xc5\xe2\x4e\x96\xce\xb4\x2c\x42\x57\xd8\x78\xa0\xb4\x36\x46\x1e\xe9\xcf\x75\xbd\xc0\x59\x76\x18\x45\x91\xf4\x04\x78\x62\xe4\x55\x48\x0b\x03\xf4\x61\x99\xef\xea\xfc\xe6\x2a\x88\xff\xa3\xbd\x5d\xff\xe9\xcb\x0e\x78\x6e\xa2\x91\x64\x1f\x18\xe1\xda\x5a\xd0\x8c\x8b\x89\x70\xd3\x2c\xd9\x19\x12\x8e\xab\xe1\xf2\x41\xb7\x89\x97\xd4\x47\x3e\xed\x9c\x6b\x3f\x0b\x67\x1a\x47\x92\xb6\x9f\xf3\xdf\xa6\x26\x8f\xda\xac\xcf\x64\x07\x02\x07\xf9\x8e\xea\x92\xa1\xd8\x4a\x4c\x8e\xfb\x5f\xe7\xe3\xac\xad\xfb\xaa\x75\x46\x35\x2b\x12\x48\xc6\x52\xcb\x25\x28\xfd\x9f\x09\x3d\x6c\x22\x68\x05\x14\x49\x55\x23\xbf\x75\xd0\xe0\xe9\x20\x39\x69\x61\x24\x85\x95\x35\xf2\x08\x75\x39\x5f\xbd\x91\xce\x56\x5f\xda\xcc\x3c\x53\xf0\x90\x92\xd0\x89\xe5\xd8\x64\xed\x16\xbf\xfc\xaf\x62\x80\xa0\xf3\xcd\x58\x98\xe6\x49\x35\x82\xeb\xd7\xc3\xc6\xf1\x19\xff\xc0\xf7\x72\x33\x4e\x0b\x3e\x60\x31\xef\xc4\x69\x26\xd9\x09\x44\x6f\xdb\x90\xdc\x5a\x35\xd6\xad\x30\x82\xd7\xf8\x4c\xc7\xc9\x08\x46\x16\xa1\xc2\xc5\x8d\x65\x4c\x08\xdd\xdb\x35\x81\x3c\xa9\x6e\xa6\x5e\xd9\x78\x8e\x68\xf0\x7b\xf7\xc7\xf9\xde\x23\x01\x4e\x60\x39\xc3\x3e\x99\x20\x73\x26\x2e\x14\x83\x3f\x65\xd0\x4f\x0f\x91\xd1\x96\x2c\x1b\xe5\xa9\xa5\x3f\x5c\x4f\x18\xf9\xb8\xde\x54\xa7\xae\x8b\x46\x9f\x55\xde\x57\x63\x88\xeb\xff\xbe\x06\x37\x0e\xb3\xcc\x23\xe8\xea\x24\x76\x97\x72\x84\x6f\xeb\xe6\xe3\xa4\x21\x21\x5b\x83\xcc\xed\xee\x64\xb3\xfb\x39\x56\xda\x63\x09\xf9\x0f\x65\xe1\x9c\x8c\x15\x1f\x59\xe2\xf7\xb6\xbb\xd8\x7d\xc2\x5d\x39\xb7\xce\x72\xe2\xd3\x96\x62\xb6\x89\x58\xa9\x58\x08\xec\xb4\xe2\x5e\x94\x9e\x0f\x3e\xa5\x0c\xd3\x8b\x74\xe4\x49\xb0\xe1\x93\x15\x9a\x90\x0a\x5a\x74\x23\xd7\x39\xbb\x02\xd8\x4d\xd8\x47\x6f\x75\x09\x8e\xe0\x97\x24\xfc\xe3\xbb\xc0\x34\x2e\x21\xe8\xc5\xf7\x1c\xb9\x34\x91\x2d\xfd\x4e\xb2\x55\xc3\x35\xa8\x21\xbb\xfd\xdd\xef\xa9\x06\x35\xa2\x4f\x76\x4c\xeb\x11\x51\x94\xeb\x65\x7e\x78\x38\xbc\x32\x6d\x7a\xf6\xfd\x48\x1e\x1d\x10\xb2\x80\xfb\x01\x6b\x95\xe0\xbc\x63\x14\x8e\xb5\x2c\x29\x69\x38\x9b\x30\x8a\xf8\x6a\x91\x0e\xbd\x80\xb8\xba\xad\xd2\xa0\x4d\x1c\x27\xa6\x6e\x4b\xe0\xe7\x80\x7f\x1b\x85\xa1\xbd\x3a\x95\x81\x15\x15\x62\xfa\xa7\x87\x17\x14\xc6\xf8\x9a\x03\x10\xdc\x23\xef\xb9\xd8\xcc\x08\x5b\x1c\x78\xd5\x74\x2d\x5d\xe0\x55\xc4\xe2\xec\x60\xa4\xa9\x16\x68\xa5\x58\xe4\xdc\x55\xe2\x32\x1a\x59\xcb\xdf\x7c\x51\xbb\xcf\x06\x90\xff\xb6\xce\xa0\xd4\xe0\xde\xa8\x84\x11\x82\xcc\x9b\xaa\x85\x88\x51\x7a\x81\x9e\xce\xa8\x06\x92\x65\x70\xca\x04\xfe\xef\xff\xf9\xbf\x26\x3f\x56\x3e\xf8\x96\x58\x55\x21\xaf\xee\x46\x81\x1a\x3a\x5c\x6e\xc4\xd5\x14\x52\x29\x29\xcf\x74\x59\x83\xd3\x68\xb7\x73\xa1\x99\x67\x1c\x90\x6f\x5b\xd7\x76\xb1\xcb\x37\x67\xee\x80\xaa\x2a\x67\xcc\xfc\xf2\x68\x2a\xc2\x4d\x94\xa8\x04\x78\x1c\x52\x5e\x8e\x9c\x01\x5e\xb8\x37\x64\xef\xd0\xbb\x51\xfe\x56\x6c\xf6\x9b\x71\x1b\x2a\xaf\x9e\x47\xba\x5c\x93\x67\x1f\xde\xdf\xfe\xf9\x09\xba\xaf\xdf\x9e\x71\x00\x84\x68\x99\x1d\xc0\x27\x4f\x7a\x1e\xcd\x57\x20\x4f\xbe\x0d\x49\x24\x9e\x6b\xf2\xa2\x18\x80\x11\x37\x3e\xe0\x99\xc6\x49\xbc\x58\xaa\xd8\xab\x37\x6b\xff\xb2\x96\x41\x92\x26\xec\xa8\x88\x6c\xbd\xc8\x0a\x58\x0f\xad\x68\xc1\x66\x90\x31\xfd\x88\xda\x95\x31\x83\xef\xbc\x00\xf2\x67\x48\x52\x35\x9b\xc0\xe2\x21\x11\xdd\x0a\xef\x56\x34\x3c\x4a\x37\xb2\xc8\x99\x0f\x84\x1d\x71\xa3\xd4\x49\x06\x84\x01\x6f\xfe\x6d\xe4\x72\x6b\x06\x2d\x47\xde\xb9\x9b\xc6\x8c\x55\x44\x72\xe7\x45\x95\x1e\x53\xce\x87\xb5\x00\xce\x36\xc3\xa6\x5e\x60\x37\xf7\x04\xfa\xfe\xfb\xc4\xfd\xbf\xd3\x42\xf2\x9d\x2e\xee\x18\x4c\x80\xf3\x6b\x17\xe0\x0d\x5a\x03\x89\x5f\xe2\x22\xcf\xca\x44\xcd\x30\x15\x93\xd6\x94\x89\x25\xe6\x2c\x64\x1e\xf8\x4a\x77\x16\xc2\xf8\xe0\x10\xb8\xcb\x7e\x3e\xe6\xe9\x27\x5f\x81\x84\x5e\xaf\x2c\xa4\x43\x76\x21\xcb\x0f\xb9\xd7\x12\xf5\xa5\xa7\x0d\xe9\x03\x8a\x5e\x5f\xf6\xfb\xc5\xbf\xec\xf7\x8b\x8f\xca\xe4\xec\x91\x08\xca\x58\x15\xfe\x36\x73\xab\xe1\xe1\xe1\xf3\xc3\x02\xe7\x87\xf2\x5e\xf5\xe5\xbb\x96\xf9\x4d\x5f\xcd\xab\xd7\xd0\xd2\x67\xf4\x19\x14\x07\xfa\x2b\x09\xec\xd0\x78\x44\x7e\xd9\x95\x4d\x93\xa9\x02\x91\xbb\x4a\xc2\x8c\x1e\x11\xf5\x55\x22\x6b\x16\x77\xfd\xcb\x91\x2b\xb5\x16\xd1\xb3\xb9\x46\x78\x12\x81\x0d\xc3\xba\x9b\x83\xc4\x6d\x9e\x6f\x55\xaa\x65\xb2\x72\x86\x82\x52\x51\x4a\x6f\x5a\x2d\xbc\x02\xfb\x66\x56\x66\xff\x24\xbc\xde\xb3\x90\xb6\x87\xcd\xac\x2e\xfd\x10\x17\xb9\x5b\xc0\xe7\x90\x46\x7e\x57\x04\x5e\x13\xf1\x4f\xc8\x44\x57\xdf\xc9\x2e\xc9\x74\xb8\xfa\x74\x2f\x5f\x24\xc2\x46\xfe\x09\x4f\xd2\xd0\x8e\x0d\x7e\x86\xd5\x8f\x5e\x5c\x55\x8f\x2c\x7d\x31\x77\x59\x55\x50\x5d\xd9\xcb\x9d\x82\x15\x23\x27\xa6\xdf\x3d\x3d\xfb\xea\x48\xce\xdf\x55\x32\x3c\xb3\x23\x65\x81\x01\x7f\xd3\x3e\x18\x9b\xf7\x48\x02\xee\x99\x9b\x79\x89\x42\x8d\x67\x5b\x1b\xde\x37\x8a\xf2\x88\xc5\xbe\x04\xb2\x55\x80\x73\x72\xf2\xb1\xaa\x77\x40\x4d\xb5\xbb\x86\xa5\x2d\xfb\x9d\x73\xb3\x01\x86\x47\xda\x9a\x38\xca\xf5\xbe\xfc\x08\x38\x53\xbd\xf0\x28\x67\x7e\xaf\x2d\x4a\x40\x3f\x9a\xba\x07\xf4\x79\x79\xa6\xdb\xd9\x12\x8a\xa7\x91\xa2\xa3\xa8\xee\x35\x1d\xd4\xe7\xed\x7e\x45\x6a\xd8\x13\xb4\xdd\xcf\x0c\xb3\xa2\x82\xe3\xfa\x5d\x0f\x44\x47\x95\x0f\x59\x87\xb7\x71\x32\x42\xaa\x2c\xf7\xee\x91\x0e\xcf\x09\x5e\x4f\x73\x15\xed\x8d\xac\x3b\x8c\x2a\x5f\x78\x3e\xe1\x3e\x8d\x2a\x34\xa5\xa6\x41\x57\x74\x11\x9a\xdd\xda\x02\xd5\x20\x8b\x00\xf8\x78\x2d\x28\x2b\xa8\x30\x52\x33\x91\x2b\x1d\xa9\x3b\x17\xc4\xaf\xd0\x77\x2f\xdc\x63\x77\xc5\x22\xa1\xdf\x46\xb0\xc4\x7f\x6e\xa6\x71\x38\x78\xf0\x17\x76\x2f\x55\xd0\x8e\x4c\x6a\xd4\x2e\xfe\x5c\xed\xeb\xbd\xa6\x31\x44\x1f\xc5\x00\xac\x76\x62\x8e\x6a\x16\x1b\xd5\x60\x0b\x99\x44\x9a\x44\x37\xec\x3d\x9c\x00\xd3\xdd\xee\xb4\xa7\x91\x76\xa0\x99\xa8\xc8\x7b\xc0\x75\xf7\x78\xbb\xbb\x05\x79\xbd\x45\x60\x97\xe6\x0f\x14\x4f\xdc\x6e\x05\xdd\x23\x5c\x08\xc2\x76\xa3\xcf\xe6\xfe\x1b\x6c\x63\x9b\x7d\x65\xce\x45\xeb\xef\x79\xea\xa9\x23\x6b\x70\xcf\xff\xb2\x2a\xdd\x70\x20\x16\xf9\xa6\xd1\x5a\x39\x75\x83\xf7\x75\xd3\x14\x8b\xba\x69\xff\x34\x81\xf0\xc5\xb2\x20\xa9\x5c\x59\xc6\xd7\x98\xdb\x77\xf8\x50\xfa\xc6\x36\x43\xe2\x0c\x31\x4d\x3a\xb2\x4d\x58\x03\xa4\x65\xf0\xe0\xb3\xc6\x19\x8d\xac\x12\xe8\x09\xe6\x50\x4a\x69\x4b\xc1\xdb\x64\x76\x38\x52\x2e\x53\x1b\xed\xa7\x9a\x93\x62\x7b\xbf\xdb\x54\xdb\xbc\x99\xd3\xc9\x5d\x0b\x09\xc8\x3a\x4d\x23\x47\x1d\xcf\x78\x8d\x60\x6f\x71\xfb\xa4\x2c\x72\xe1\x77\x75\x57\x59\xd5\x51\x7b\x7a\xbd\x65\x10\x9d\x47\x8d\xfd\xde\xb9\xc6\x76\x85\xf6\x09\x57\xc2\xc9\xd7\xf4\x75\xcd\x19\xbc\x65\xe3\x4f\xdd\xf8\x3e\xea\x78\xc3\x62\x15\x2a\x74\xc1\x4d\x1a\x62\x1a\x32\x6e\x93\xdf\xa5\x75\x47\x86\xad\x4c\xf7\x45\x66\x81\x98\x2b\x32\x0a\xf0\x78\x62\x6d\xbd\x0b\x44\x2e\x34\xc4\xc8\x9d\x25\x82\x71\x87\x51\x59\xee\xd9\x1e\xf0\x0c\x85\xd6\x3b\x77\xfd\xca\x77\x64\xf3\xb3\xba\x31\xea\x8d\xc9\x72\x5f\x79\x6c\x20\xbd\xe9\x02\xb4\x80\xfe\x0a\xc2\xcc\xee\x45\x7e\xf9\xf2\x43\x19\x78\x5b\x22\x11\xaa\x2f\xb8\x95\xe2\xe2\xe9\xad\xfc\x61\x97\x15\x98\xae\x5f\xf3\x59\xcb\x15\xc7\x05\xd7\xf2\xaf\x43\x33\x27\x2f\xa4\xe6\x83\xce\xdc\xe1\x52\x8b\xed\xe1\xd7\xf2\x99\xcd\xd1\x2f\xdc\x53\xa0\x0a\x44\x42\xb3\xc9\x57\x48\x4d\x8a\xcb\xa1\x07\xa1\xaf\x4b\x11\x3d\x2f\x9a\x46\xb0\xbb\x10\xca\x23\x11\xb1\x98\xa7\x55\x77\xcf\xb1\x1e\x7d\xea\x81\x7e\xe4\x9a\x13\xa4\xc4\x37\xdc\x8a\x01\x2a\x21\xfd\x03\xb1\xe7\xa7\xa0\xdd\x5e\x36\xa5\x4b\xe0\x38\xbc\x99\x3d\x16\xd2\x1b\xea\x73\x09\x97\x9f\x38\x02\x70\xc6\xe3\x2e\x04\x9d\x70\x9a\x97\x22\x14\xa5\x7b\x3b\x58\xba\x98\x44\xbf\x2d\x00\x6d\xc2\x21\xb8\x12\x75\x46\x2d\x62\xe8\x55\x9a\x7c\x8b\x2a\x65\x74\x10\x5c\x3e\x79\x61\x88\x92\x92\x71\x6e\x02\x7b\x1e\xa8\x71\x6c\x54\x20\x23\xaf\x80\xf3\x84\x06\x4a\xa8\x95\x7a\x17\xe1\x29\x7a\xe7\x62\xfb\x26\x2e\xe0\xaa\x1c\x04\x30\x62\x76\x58\xca\xf3\x73\xb1\x34\x79\x2c\xcc\x99\xae\x26\x53\x8a\xe1\xd6\xa5\xea\xcc\x43\x97\x50\x78\x86\x8c\x79\x46\x64\x11\xdd\x65\x5a\xfa\x17\xbc\x72\xa1\x64\x70\xfa\xc2\x88\xc2\xab\xeb\xcd\x17\xfe\x67\xed\x7e\xb5\xca\x5b\xcf\xff\x57\x05\x22\x15\x79\xb7\x6d\x5e\x12\x85\x16\x30\xb5\x89\x1e\xa5\xf9\x29\x46\xcb\x33\x58\x38\x40\x92\x8e\x7e\x53\x42\x36\xc2\xeb\x39\xf3\x99\x17\x77\x76\xe4\x85\xb1\x08\x19\x5a\x79\xb6\x9e\x7c\x0f\x26\xa5\x64\xa5\xd3\x6f\xc7\x4c\x0b\xb5\xf3\xe4\x36\xa1\x7a\x16\x47\x40\x7c\x74\xa3\xd1\x90\xad\x43\xf4\x6e\xf0\xdb\x15\xe8\x69\x10\x41\x79\x1c\x49\x89\x8e\x00\x22\x7a\x97\xd0\xbd\xe8\x4d\x06\xaf\xd3\x5d\x83\xef\x57\x29\x8c\x75\xc4\xd2\x53\x8f\xf9\x8a\x20\x4e\x89\xba\x1e\x0e\xb1\x54\xb3\x81\x74\x1c\x68\xac\x1e\x15\x17\x14\xc3\x3a\x3d\x2f\xc6\x92\x56\xff\x4b\xd4\x49\xc6\xa4\xbd\x25\xc3\x8d\xc9\xe9\xd3\xe8\x12\x65\x1a\x51\x08\x1e\x51\x35\x63\x9a\x1b\x4d\x5a\xd9\x64\xf1\x46\x96\xf4\x62\x11\x77\x71\xe1\x43\x49\x10\x32\x7c\x89\x79\xa6\x0d\x6b\x24\xe6\x9a\x54\x21\xfe\x72\x0c\xf4\x0b\x3f\x02\xf8\x90\xcb\x1d\xc9\x77\x3f\x7a\x37\x39\xbe\x56\x8c\x47\xdb\xc1\x47\xec\xe2\x5f\x35\xa3\xf3\x66\x54\xae\x46\x7c\xf1\xaf\xea\x72\x37\xaa\xac\x7b\x99\x56\xc6\x63\xc4\xc9\x4e\x27\x73\x8f\x2a\x28\xdd\x42\xc0\xb6\x55\x51\x53\x12\x99\xa2\x87\x00\xd2\x2c\x52\x8f\xcf\x8e\x66\x32\xd3\x64\xc9\xf7\x92\xc9\xe5\xbf\x0c\x93\xa7\xb9\xc0\x3e\xbd\xf7\x91\x64\x6c\x90\x34\x4f\xd3\x05\x21\x41\x48\x8a\xca\x98\xd2\x92\x1a\x15\x89\x90\xa6\x5e\xab\x4f\x71\xc9\xb1\x7b\x8e\xcb\x94\x9c\x8e\x2d\x09\x15\x9f\x5a\xbc\xaf\x43\x2b\xda\xf1\x41\x7e\x77\xe5\x56\xd4\x4e\x7c\xe4\xa4\xd6\x12\xea\x7b\xd5\xef\x23\x54\x76\xb9\x10\x6f\xa2\x4c\xe6\xd7\xff\xab\x17\x9f\x7f\xf8\xe9\x9b\xbf\xfe\xf5\xf3\x9f\x7e\xfe\xf1\xdb\x07\xf2\x5c\xe2\xb0\x1c\xcd\x37\xfb\xa9\x7a\xe1\x1f\x46\x37\x7b\x75\x96\xd9\x36\x47\x63\xb4\x51\x02\x92\x59\xc1\x0d\x38\x2f\x8d\x5c\x47\x0e\xb9\x93\x45\x3e\x86\x59\x52\x95\x3a\x9a\xb6\x1c\x51\xf8\xfa\x3e\x5f\x14\x7b\x72\xc3\x33\xdd\x13\x93\x19\x77\x35\xb3\xfa\x79\x3f\xe3\x70\x1c\xc6\xf6\x50\x51\x24\xf1\x69\x82\xbc\x48\xc2\xe5\x34\x3c\xba\x24\x01\x24\xb9\xa8\x19\x05\x8e\x90\x18\x29\x50\x13\xdf\x4a\x37\xd5\xd2\x28\xd4\x38\x5f\x51\xbf\x14\xfb\x70\xa4\x25\x6b\x8d\x54\x6b\x15\xe4\xb7\xd5\x8a\xa0\xf5\x17\xad\x04\x6d\xca\xb9\xf3\xd6\x4d\xec\x9e\xa5\xf9\x5f\x92\xa6\xa3\xe4\x99\x2c\x77\x9a\x3e\x14\x8e\x27\xa1\xf8\xd4\x2f\x0b\x1b\xa9\x00\xfe\x44\x21\x88\x55\x30\xe0\x0b\xbb\x59\xc8\x11\xf3\x80\xe2\x2c\x45\x40\xdb\xbc\x62\xc8\xa8\xbd\x0c\x6a\x27\x8a\x26\xb4\x1a\xe0\x1e\x8c\x30\xf2\x4c\x93\xbf\xcd\xbe\x25\x7f\x19\xfd\x57\x00\x0e\x4b\x17\x43\xb4\xeb\xbd\x74\xf2\xe2\x3f\x98\x16\x13\xc3\xee\x0c\xc5\x5a\x3b\x33\x9a\x02\xcc\xed\x82\xda\x99\x35\x79\xfe\xcf\xfc\x93\xf0\xf1\x9e\xb1\x2d\xe8\x88\x2c\xaa\xc5\x28\x26\x99\x8b\x8e\x5e\xe9\x96\x7e\xa7\x29\x3f\xe7\xf0\xe4\x37\x63\x04\x18\x56\x7b\xf2\x60\xe5\x8b\x9b\xa7\x81\x1e\xf6\x74\x43\xfb\x06\xea\x12\x33\x74\xcb\x27\x23\xeb\x09\x43\x57\x59\x15\x26\x5c\x2b\xf1\x23\x0a\x5f\x91\xe6\xbd\xec\xbf\xd6\x2f\x76\x59\x11\x43\x14\x8c\xbe\x80\xe0\x2b\x8a\x1e\x67\x9b\xb6\x72\x48\x05\xa5\x23\x6a\xa7\x2b\x85\xe3\x7b\x90\xd1\x79\x39\x64\x6f\xba\xa3\x0d\x26\xd5\x6e\x36\xba\xc4\xd2\x1f\x20\x45\x15\x5e\xf5\x45\x15\x78\xf0\x32\xc8\x78\x39\xe1\x72\x2a\xac\x18\xc3\x14\xa5\x1a\x2e\x42\xf7\x8d\x11\xec\x6c\x8f\x21\x6e\x18\x34\x51\x57\x19\xc3\x40\x46\xd1\x9e\x7a\xcf\x7d\xd7\x5d\x77\x2f\xc5\x10\xb7\x0b\x17\x24\xc5\xdd\xb9\x05\xb3\xce\x4d\xb7\x45\x85\x40\xf6\x55\xc4\xf6\x03\xb8\x82\xcf\xf9\x48\x03\x07\xdd\xed\x3d\xee\xd4\xfd\x5e\xb7\xab\x09\x6e\x63\x86\xb8\xb4\xe2\xbd\x26\x22\x8b\x07\xc5\xc7\xf2\x10\xd4\x65\x86\x72\x86\x75\x34\x60\xe9\x17\x3f\xf5\x26\xc6\x61\x32\x8f\x45\x25\x3e\x5d\xb7\x16\xa6\xd3\x75\x9c\x6b\x2c\xe2\x3e\x40\xbe\xde\xce\x92\x1f\x13\x1a\xfe\x25\x2b\x98\xc7\xfe\x65\x5d\xbb\x71\x3c\x1c\xf1\x79\x12\x00\xfd\x30\xea\x8e\x87\xd8\x9f\xa3\x10\xe7\x5f\xcc\x09\x67\x7d\x90\x02\x11\x45\x0f\x42\x1c\xe2\x80\x94\x84\xfe\x68\xcc\x18\x0e\x0f\x4d\xad\xe3\x02\x85\xe6\x42\x31\xa1\x03\x38\x6a\xbe\xf5\xf4\x36\x08\x05\x91\xdf\x2f\xc4\xea\x44\x19\x5b\xcf\x83\x19\x23\x3b\x3c\x5a\x85\xa3\x4b\x22\x91\xe4\x13\xe1\x91\x4e\x4c\xd8\x00\x68\xe3\x61\x5d\x69\x2f\xc9\x29\x58\xe4\x42\xb3\x78\x8d\xea\xe8\x45\x31\x48\xac\x6b\x79\xed\x08\x04\xa7\xc8\xa9\x10\x64\xf2\x4c\x78\xd8\x05\x5e\xc5\x22\xad\xfb\xcd\xcd\x40\x64\x32\x8a\x6a\xee\x2f\x64\xf8\x19\x47\x02\x3a\xe0\x19\x84\xc2\xe5\x43\x2a\xb5\x6e\x2a\x66\xe0\x30\x2a\x4a\x4f\xe1\x24\xe4\x09\x47\x74\x90\xaf\xc1\x94\x70\x8e\x2d\xce\xa0\xae\xdf\x42\x03\xe7\xe6\x0c\x6d\x9c\x04\x15\x32\xf2\xa5\xb1\x2f\x49\xc9\x44\x1c\x39\x01\x66\x8b\x06\xf4\x33\x72\x35\xe1\x3f\x6f\x84\x10\x56\x7c\x66\x5f\x13\x15\xcd\x18\x63\x55\x11\x83\xa3\x4e\xc6\xb4\xd3\x78\x4f\x6c\x96\x75\xfa\xeb\x0f\xb4\x6f\xba\x0d\x63\x28\xd1\x21\x7a\x88\x28\xdf\x74\x03\xc3\x37\x26\xaf\x53\xa8\x3f\x04\xca\x10\xeb\xcc\x6b\xf7\x1a\x1e\xd4\xc3\x51\xd5\xa9\x1d\xf3\x2d\xb0\x10\xd3\x4b\x15\x80\x4d\xd1\x2f\x11\x68\x9e\x6a\x28\x52\xc9\x09\x1a\x09\x44\xc3\xbf\x77\x97\x69\xe8\xc8\x09\x05\x25\xda\xf9\xe0\xf1\x87\x6f\x79\xe2\x17\x42\x87\xa3\xbe\x25\x81\x6e\x86\xdf\x2b\x27\xbb\x5e\x21\xa2\x4e\xc9\x12\x96\x53\xf0\x37\xd4\x30\xf2\x23\xae\x40\x6e\x0b\x3e\x4d\x5c\x87\x0e\xd5\x0a\x65\x6f\xe9\xeb\x28\xb3\x2d\xa9\x2f\xcc\xac\xd5\x3d\xf0\xbe\x9c\xcd\x01\x7f\x20\xfe\x2b\x1c\xe1\xb3\x42\x14\x55\x05\x57\x66\x20\xd6\x3a\x1a\x95\x8a\x3a\x47\xcb\xc4\x57\x13\xc3\xbb\x2f\x8b\x56\x02\x9f\x40\x4e\xe1\x0f\x85\x25\x5b\x12\x94\x41\xf5\xeb\xef\xbf\x70\x4e\xf7\xc6\x82\xf2\x50\x32\x46\xf1\x82\x6d\x2b\xd1\x83\x25\x6a\x9c\x7d\x41\x64\xe7\xfe\x69\x65\x34\xac\x60\x77\x11\x15\xb2\xcc\xca\x32\xe6\x15\xe9\x74\x99\xcb\xd0\xe3\x44\x3b\x11\x42\x6c\xf1\x75\x4b\x0e\xa6\x38\x69\x4d\x4f\xb9\xad\x5d\xd8\xb8\x0b\xae\x5b\x6d\x68\x07\x22\xe1\x82\x5e\x75\xe2\xa0\xdf\x5b\x03\xbd\xdf\x50\x57\xd6\xaf\x2e\x76\x81\x0d\xfe\x21\xb2\x9b\x6e\x52\xeb\x46\x6b\x1d\xc9\x6a\xf0\x4c\x73\x16\x05\x8f\x50\x27\x7d\xef\x6c\xbf\x3b\xc3\xfe\x34\xb9\xb5\xa7\xed\x2a\xb8\x23\xa6\xf5\x1b\x44\x6b\x4c\xb1\xd3\x7c\xaa\x34\x9d\x35\x1f\xc1\xe5\xf6\xa7\x89\xfd\x97\x16\xf9\xc3\xa2\xfd\x41\x1c\x13\xa6\x1a\x59\x59\xbd\x67\x02\xb5\xf5\x15\x49\x49\x42\x46\x9c\xea\x81\x7e\x28\xd3\x26\x6f\x39\x5f\x3d\xd1\x78\xdc\x7d\x3a\x0c\xc3\x95\x07\x43\x8d\xd9\x39\x83\xd6\xba\x7e\xfe\x61\x0e\x1c\xcb\x9f\x26\x5f\x35\xc2\xde\xcd\xff\xed\x3e\xd0\xf7\x5e\x12\x44\x9f\xf8\x9a\x43\xf7\x92\xa9\x71\x00\x21\x03\x39\x49\x76\x20\x10\xfd\x9f\x24\xa6\x71\x07\xe7\xe0\xeb\x8e\x5e\xaa\x9c\x64\xa9\xdc\x89\xb4\x66\x0a\x44\xf5\xb0\xed\x66\xc5\xa7\x31\x04\x70\xe6\xac\xe2\x2a\xaf\x85\x5e\xc0\x9c\x3c\x61\xf1\x40\xce\xbd\xd3\xfc\x7b\x64\xb4\xff\x15\xb3\xc4\x8d\x1b\x70\x42\x2c\xf7\x69\xc7\x4c\x6d\x75\x29\xbc\xa1\xad\xc4\xad\x78\x9c\x87\xb3\x7a\x51\x70\xa0\xe8\xd9\xca\x17\xc9\x88\xfd\xee\xda\x09\x1a\x80\xc8\xa5\xf3\x34\xb3\xdf\x60\xc7\x2f\x9c\xad\xa9\x5a\x92\xfe\x91\x52\xa7\x21\xf8\x7a\x7e\x6a\x1b\x93\x00\xba\xf6\xaa\xc0\xe2\xe9\xf6\xf4\x83\x67\x07\x31\x29\xf1\x0d\x3a\xb2\xc2\xa2\x4b\x7c\xca\x77\xc8\xb3\x66\x70\x20\x84\xad\x3d\xd0\x94\x7c\x28\x4a\x79\xc0\x1f\x6a\x67\xd4\xf7\x42\xcf\x3b\xc9\xa1\xc5\xb8\x53\xd9\xc6\x9e\x2c\x34\x1c\x93\x2a\xfe\x7a\x63\xa0\xa0\xfa\x1c\x83\xa6\xf3\x10\xf6\x60\x05\x19\x9e\x4e\x32\xde\xb8\x70\x6b\xce\xc3\x4d\x40\x2f\x87\x0e\x43\x56\x7a\x26\x46\x9c\x75\x07\x25\x4e\x69\xd7\x59\x23\x15\x77\xb2\xe7\xb8\xc9\x5d\xed\x47\xe2\x34\xe1\xf2\xa3\x75\x23\xdb\x21\x2b\xfa\x8b\xf4\x03\x3e\xb8\x89\xed\x38\x58\xf3\xf5\x8b\xb1\x0d\x97\x44\x62\x02\x6f\xb9\xbb\x2f\x56\x86\xa9\x34\xe5\x2d\x76\x10\x60\xf5\xec\x1e\x0b\xa0\x4c\xb5\x56\xbf\x76\x5e\x49\x83\x1c\xe1\x68\x89\xbc\x23\xc1\x00\x8f\x62\xa3\xdc\xb5\xf6\x4a\xe9\xdb\x7c\xab\x99\xd1\xf9\x7e\xc6\x57\x2a\xed\x99\x25\x39\xc8\xb8\xb0\x34\xc1\xb4\x21\xd3\x78\xf1\x31\x17\x15\x00\x59\x0b\xad\xb2\xc7\x77\x2e\x0d\x7f\x7d\x8f\xa8\x69\xcb\xce\x8a\xbc\x5c\x28\x77\x4d\x53\x8b\x0f\x0d\xab\xc0\x02\x1f\xf2\xd1\xee\x21\x37\x6c\x80\x9b\xb4\x50\x86\x9d\xba\x08\x94\x67\x58\x93\xdf\xe1\x51\xc4\x44\x85\x7b\x86\x3e\xd1\x9a\x1a\xa3\xc0\xb9\x91\x1f\xc3\xee\x7d\xeb\x1c\xdc\xaa\x77\x9b\x36\xcf\x37\x32\xfe\x0c\xea\xa5\x6d\x0b\xb1\xad\x6c\x51\xdf\x4f\x4d\x1c\x67\x8b\xda\xa7\x7d\x43\xe8\xcd\xdd\x71\xb5\xcd\xa7\x36\xf6\xf9\xc7\xe7\xa8\xa1\xde\x57\x93\xd5\x9e\x30\x33\xf9\x2e\x93\xa9\x32\x47\x35\x9a\x11\xf0\x90\x38\x26\xab\xfd\x32\x23\x68\x21\xc2\x2f\x20\xcc\xf8\xc7\xbe\xd8\x22\xd0\x90\x89\xed\x05\x02\x01\x0d\xea\x2c\xde\x67\x3b\x4d\x6d\x49\x9b\x2d\x51\x35\x38\xaf\xce\xeb\xc4\xce\x85\x84\xa4\xc3\xbe\x64\xad\x55\xba\x04\x04\xbc\xa9\x77\xc0\xfb\x8e\x86\xd3\x0b\x0b\xf8\x6d\x3c\x73\xe9\x05\xab\x63\x9c\x8a\x21\xda\x3c\x15\x89\xb5\xcc\xcc\x4d\x26\xad\x9e\xdd\x15\x40\xab\xc2\x01\x57\x89\xb7\x98\xa6\xa1\xc9\x97\x9c\x56\x50\x3e\x15\x95\xdd\xd9\x03\xe5\x88\x13\xdd\xed\xf2\xcd\x96\x30\x9b\x47\x13\xad\xb9\x70\x3b\x40\x17\x93\x04\x0e\x08\x9d\xa0\xe4\x99\x4b\x7e\x2a\x1a\x03\xce\x51\x32\xae\x11\xec\x38\xc5\x1c\xb4\x4a\xb5\x93\x4d\xee\x32\xec\xc9\x82\x87\x71\xbb\xdf\xba\xc9\xa3\x00\xae\x27\x48\xb2\x05\x3a\xca\xfe\x0d\xc8\x09\xbe\x3b\x4b\xaa\xff\xfb\x0a\x7b\x1e\x45\x22\xc0\x02\xed\x2b\xe4\x49\xd6\x64\x4f\x80\xb4\x50\xc5\xac\x37\xdb\x74\x2e\xd0\x2d\xb9\x8e\x0c\xa7\xd7\xfd\x78\x09\x44\x42\x95\xcf\x9d\xdb\xe8\x85\x3f\x46\xa7\xe4\x70\x2d\x19\x8f\x7e\x41\xdc\x90\xa1\xfe\xcf\xc0\x86\x0d\xb4\xac\x0b\xf6\x5e\xfa\x4d\x62\x9c\xf8\xcc\x96\x08\x83\x41\x18\xbc\x10\x52\xf7\x1a\x16\x11\xe5\xcb\x6d\x44\xe0\x50\xb8\x40\x0d\xe0\x2a\x9d\x00\xbe\x83\x4c\xc0\x15\xc9\x23\x4a\x5d\xba\xfe\x28\x09\x87\xb8\x5d\xd4\xb0\x5c\x99\x7f\x39\xcc\xc2\xb4\xd1\x0b\x1a\xea\xa0\x14\x33\x2e\x21\x60\x6d\xa9\x58\x9f\x13\xce\x34\x74\x14\x6c\x5b\x3f\x0b\x38\x0e\x81\xf0\x17\xe7\x7c\xe6\x57\x97\xd8\x7c\x3d\xfa\xfc\x5f\xcb\x7b\x21\x9a\x66\x5e\x37\x38\x2b\x1a\x67\xee\xaf\x5c\x1e\x1c\x50\x4d\xf7\x4d\x55\xff\x95\xbb\x21\xc2\xf8\xdf\x8c\xd2\x8f\x7b\x6f\x24\xa0\x3d\x8d\xcd\x33\x5b\x6c\x98\x84\x63\x40\xde\xdc\x2d\xc3\x45\xbd\x77\x67\xef\x82\x7b\x8a\xd6\xd6\xdd\xef\xf3\x57\x5a\xec\x70\x8f\x7b\xfb\x7e\x3a\xa8\x17\x39\x9c\x46\x6f\x7d\xc9\x4b\xaf\x15\xf2\xfc\x59\x39\x50\x1f\xb2\xc4\x43\xab\xcc\x25\xfa\x0d\xeb\xde\x10\xdb\x2b\xa7\xd5\x4e\xc1\xd5\x93\x5b\xc9\xfc\x3f\x23\x02\x2e\x29\x62\xe0\xab\xa8\xc7\x3a\x33\xe5\xfe\xd7\xad\xff\x6a\x45\x7b\xf0\x97\x62\x21\x56\xe1\x3f\x25\x9d\xf4\xa3\x64\x1d\xe5\x66\xa3\x38\x29\x8e\xe3\xe8\xe2\xb8\x85\x96\x4c\x81\xde\x92\xe9\x10\xf8\x5b\xa2\xe0\x3b\x95\xb3\xe6\x1e\xe7\x18\xd6\x62\xee\x5e\x26\x82\xc9\xf6\x3e\xcf\xb7\xad\xe8\x77\x8d\xd3\x91\xfe\x4b\x9e\x95\xa3\xce\x98\x7f\x29\xf5\xe8\x73\x10\x47\xbf\xea\xc7\xae\xf6\x5b\xf0\x9e\x0a\xe5\x58\xb7\xe3\xff\x3e\x63\xc5\x35\x76\x72\x82\x2c\x9a\x01\x32\x04\xe3\xd3\xe4\xd4\x53\xfb\x43\xaa\x1a\xdb\x55\x4c\x01\x76\x7e\xe6\xf6\xe2\xa2\x62\xd2\xcb\x45\xc2\x4b\xf9\x2f\x83\x05\xab\xd5\x35\x7a\xec\xb4\x77\xfd\x8b\xa8\xad\xb7\x4f\xcc\x4d\x5c\x33\xbc\xcc\x56\x04\x17\xce\x85\xd9\x4f\xc7\xa6\x1d\x7a\xd1\xd6\xd0\x74\x97\x36\xb7\xa8\x7e\xf4\xe2\xf1\x2c\xda\x7f\xd1\x0b\xff\x5a\x37\x8b\x47\xa0\x35\x4f\xd6\x64\x1f\xac\x11\x77\x3d\x9a\x8a\xdb\x8b\x9b\xcd\x25\x7a\x93\xd5\x98\xf8\x1d\xde\x1f\x78\xb9\x78\x33\xb2\xb3\xfa\xd4\x6a\xd3\x72\x08\x45\xe0\x6c\xdd\xfd\x3e\x20\xc9\xe1\x35\xe6\x1e\xf2\x52\x94\xe4\x1b\x01\x49\x8e\x71\x45\xd0\x96\xf1\x7d\xbe\x1b\xb0\x9f\xaf\x1e\xe9\x8f\x8c\x26\x80\x12\x19\x2b\x9c\x81\x4d\x5d\x69\x3a\x82\x40\x8e\x9b\x27\xa3\xde\x39\x65\x6d\x37\x71\xb4\xbb\xc9\xd9\x9b\xab\xd4\xc6\x81\xd8\x5c\xfe\x1e\x95\xf4\x21\x19\x67\x86\xe2\x8e\x15\x8f\x8d\x00\x18\xe5\x9b\x2e\x08\xaa\x9b\xe9\x39\x03\x2e\xb5\xaa\xbd\x16\x86\x6a\x5f\x12\x5c\xc1\xd1\x76\xc3\x79\xbe\xae\xbb\xa5\x35\x50\xf4\x7a\x7e\xe2\xba\x42\xb0\x1c\xda\xb5\xed\x0a\xd2\xe0\xdc\xa1\x37\x38\x4a\xab\x7d\x9a\xb9\x8c\x75\xd7\xd3\x30\x97\x76\x3f\x6b\xdd\x26\x65\x23\x19\x11\x2f\x1e\x58\xc8\xbd\xa1\xf7\x67\x48\x7b\x6a\xa3\x7d\xb7\xec\x94\x03\x4d\xe5\x99\x3c\xcb\x59\xd3\xa1\xca\x66\xcd\xd6\xf7\x52\xea\x4d\x98\x6c\x34\x04\xf7\x09\x36\xb8\xa3\x41\x97\x12\x30\x6f\x13\x96\xf7\xa5\xd6\x85\x18\xfa\x14\x3b\xaf\xb5\xda\xf9\xa2\xf7\x17\xdd\x59\x98\xb8\x8c\x11\x38\x5c\x9a\x7f\x92\x5e\x80\x98\xd2\x94\x65\xa9\x3e\x30\xfc\x49\xed\x53\x42\xcd\x7b\x1e\x42\xd1\x65\x2d\x15\xc3\xf4\x2a\xa5\x7d\xef\xb1\x4f\x9c\x32\x49\xce\xc7\x77\xe1\xf5\xcf\xf9\x6c\xff\x31\x7f\xc4\x91\x88\x0c\xf2\xef\xe2\xd8\xf9\x30\xe7\xc7\x6c\xb7\x9e\x35\x6c\xea\xbd\x39\xa5\x3d\x44\x29\x54\x43\x4c\x37\x6e\x97\xa2\xe6\x1d\x50\x08\xa9\xff\x27\x6f\xc1\xeb\x69\x6e\x6a\x56\xfa\x1b\x74\xd8\x3a\xf7\x8f\x48\x08\xa9\x60\xad\x75\x22\x05\x14\xd7\x21\x3d\x18\x03\x04\xfa\x2e\x98\x0e\x78\x3a\x91\xed\xf8\x74\xe0\x9f\xa7\x6d\x40\x38\x07\xff\x19\x58\xc2\xe3\x70\x3e\x79\xe3\x4c\xc2\x67\x93\x6f\x01\xa8\x19\x15\x99\xbc\xbf\x30\xd9\x3f\xf2\x6c\x5d\xe5\x9b\xe7\xb9\x7b\x66\xf7\xb6\xb1\x28\xea\xfb\xf2\xaa\x68\x9f\x6b\x84\x39\x58\xfc\x33\x1a\xf3\xa5\x8c\x4c\x34\x4f\xdd\x03\x66\x80\x3f\x04\xb6\x2e\xf7\x02\x2b\x00\x05\x04\xbe\x5a\x2f\x54\x59\xba\xa8\xb4\x7b\x8a\x3f\xb0\x74\x98\xe4\x91\x44\x5a\x04\x4f\x21\x5f\x76\x76\xd8\x88\x59\x76\x71\xdb\x8d\xcf\xec\xc4\xcc\xb8\x55\xee\x6e\x56\x44\x8d\xb6\x5a\x0b\x12\x05\x11\xb3\xd1\x3c\x97\xf6\x14\x08\x42\xc4\xea\x8e\x4f\x69\xb4\xbe\xcf\xcb\xbb\xbc\xab\xc4\x22\x9d\xbe\xce\xa4\x63\xe3\x59\x07\x05\x86\x3d\x52\xcb\x4e\x98\x26\xe4\xf7\x6d\x72\x01\x4d\x33\x18\x57\x83\x8c\x7e\xd7\xdd\xf5\xe0\x00\xa7\x5f\x83\xb2\x76\x59\x46\x41\x1a\xf5\x73\x8a\x16\xce\x94\x67\x07\xf4\x34\x04\xf2\x09\xbf\x24\x64\xe9\x31\x0a\x87\x92\x7c\x1c\x0f\xae\x38\xa0\x76\x6c\xbd\xe6\x76\x02\xc2\xb0\x0c\x65\xde\x18\x58\x26\x1b\x14\xbb\xad\x93\x47\xf1\xcc\xa8\xda\x9f\x07\x8f\x2b\x0d\x53\xdb\xdc\x3f\x3d\xdb\x69\xb9\xc3\xad\x62\xf5\x98\x0d\x5d\xb4\x9b\x71\xa7\xd1\x0f\xff\xe2\xec\x93\x7a\x3e\x0d\x46\x69\xbd\x0c\x5b\xa0\x12\x04\x8b\x10\x88\x06\xb5\x75\x64\x86\xe2\x34\x08\xa7\x8f\x27\xd9\xe2\x75\x17\x56\x53\x77\x09\x09\x75\x99\xbc\xdd\xdc\x3d\x6b\x93\x35\x87\xc1\x78\xef\x7c\xa4\xe7\xb9\x73\xcd\x6d\x1a\x48\xaf\x9d\x4b\x65\x01\xf2\xde\x32\x85\x21\x82\x3f\x52\x7b\x1f\x18\x57\x40\x88\x9d\x25\x25\x79\x9a\x73\xcd\x5c\x72\xc3\x03\xa3\x41\x35\xe4\x18\x13\xae\xee\x11\x27\xdc\xa9\xc5\xff\xdb\x23\x16\xfe\xc9\xcc\xc4\x1f\xc7\x1d\x64\xec\xbc\xf7\xa4\x8f\xf9\x41\x68\xf5\x9d\x3f\x7c\xff\x80\xac\xf5\x09\x91\xc8\x33\x2e\x9a\xf5\xdd\x8b\x56\xfc\x25\xb5\x9e\xe3\x31\x15\x58\xa0\x62\x0e\xba\x5e\x15\x27\xe5\xa1\x63\xd8\x06\x23\xef\x71\xed\x86\x7a\xcd\x2c\xae\x70\x11\xa2\xb3\xa4\x5e\x79\x25\xee\x5b\x3e\x1a\xf3\x45\xb5\xa8\xc1\x80\x25\x8d\x4f\x58\x88\xf6\x51\xe6\x1c\x10\x4a\xff\x9d\x33\x11\x5d\xf4\x24\x83\x1a\xab\xa1\xd6\x92\x48\x01\x9d\x8b\x3f\xb3\x6e\xd0\x69\x31\x93\x04\x9f\x7c\x61\x5c\x2f\xa1\x45\xfc\xe4\x89\x1b\x8b\xb0\x7b\x02\x81\x68\xec\x2a\x11\x1d\xc6\x08\x55\xad\x27\x8f\xca\xdc\x46\x7e\x13\xf8\x0c\x6d\x46\x60\xa4\xc8\xcf\x2f\x59\xb5\x78\xd1\xdb\xa8\x15\xc7\x5c\x1b\x0d\x8c\x72\xe6\x45\xc4\xec\x32\xad\xe9\x2d\xdf\x0e\xa5\x45\x1f\x71\xd7\xfe\x21\x7c\xc2\xe6\x80\x59\xea\x7b\x44\xe5\x68\xf1\x18\x83\x97\xbf\xe4\xd4\xfd\xe3\xe8\x5a\xd9\x86\x4c\x59\x60\x8b\x21\x82\x30\xc9\x55\x3d\x49\x7e\xca\xba\xea\x9c\x29\x1a\x51\x31\x7b\x04\x98\xe6\xe2\xf2\x42\xb7\x5e\x9d\x24\x50\x06\xfc\x3e\x6b\x7d\xcb\x9c\x43\x7a\xf8\xa7\x16\x78\x53\x6d\x8b\xf3\x79\x59\x92\x78\x46\x9e\x7a\xef\x40\xef\xd1\x39\x48\x50\xab\x5c\xf9\x03\xc3\x1a\xc2\xeb\x46\x02\x7b\xa3\x39\x8a\x1f\xbd\xa3\xdf\x9d\xda\x5e\xfe\x4c\xbf\xf0\x48\x77\x7b\x36\xf5\xf9\x5b\x79\x59\xb3\x21\xe1\x04\x99\x4c\x4e\x94\x3f\x19\x8c\xe6\x20\x69\x76\x3b\xb3\x4f\x7f\x22\x9d\xf7\xb8\x86\xd7\x1f\x9e\x22\x85\xfc\x7b\x6d\xd3\x2e\xca\xcd\xed\x3b\x77\x70\x7f\xa2\x7d\xbb\xde\x6f\xdc\x32\x2f\x16\xbf\xc3\xbe\xfd\xff\xed\x9e\x1c\xcc\x7d\x9e\xdc\x93\xfe\x2d\x1c\xdf\x93\xc8\x85\xf2\x2d\xd8\x77\x9f\x7e\x4f\x1e\x26\xb7\xee\x65\x8d\xd2\x65\xbf\xbd\x92\x2e\x7b\xd8\x8d\x2e\x34\x43\xf0\x54\x16\x60\x8a\x3a\x1c\x4b\x38\x49\xb9\x42\xe2\x2b\x74\x54\xcc\x91\x97\xc0\xaf\xe6\x71\xa1\xa5\x9d\x37\xd9\x96\x9d\x39\xe8\xfb\x97\x98\x0b\xdf\x76\xce\x07\x08\xb5\xa9\x53\x8e\xf2\x8b\xdf\xf1\x6e\xd9\xce\x45\xb9\x48\xbf\x8b\x4b\xae\x08\xd6\xc3\xc4\x3d\x49\x0f\xfc\xc0\x2e\xfd\xba\xa0\xae\x8b\xf4\x1d\x68\xf5\x1d\xe2\x2e\xbe\xe9\x5b\xb8\x35\xb2\x32\x6f\xe7\x02\x8f\x77\x4f\x55\x2f\x77\xca\x2a\x63\xe2\xdf\xaf\x8f\x89\x7f\x7f\x31\x79\x1f\xb7\xd1\xc4\x05\xd5\x42\xc5\xdd\xf4\x37\xf7\xc7\x76\x00\x3b\xd2\x03\x77\x0c\xef\xf7\x36\xbd\x1d\x7b\xa3\xd1\x27\x97\x04\x03\x02\x3e\xec\x42\x5d\xba\xa9\x2e\xfe\xf0\x74\xe5\x23\x70\xee\x6c\x8e\x28\x50\x24\x84\x86\xf7\xd2\x02\xc4\x5e\x36\xd5\xf2\xd3\xe2\xed\xba\x50\xc9\xbb\x2e\x04\xdc\x5a\x7d\x63\xde\xef\x69\x10\x69\x24\xad\x90\xe2\x55\x91\x84\x2c\x4b\x32\xfa\x48\x93\x86\x74\xea\xf1\x27\x60\x6e\xba\xac\x66\x34\xcb\x05\x1e\x81\xce\xb4\xa1\x27\x1b\xb3\x8d\xf7\x6c\x7d\x73\x6f\xf7\x2f\xd9\x22\x6b\x46\x01\xc6\x2e\x61\xdc\x7e\xf9\x76\x7c\x85\x76\xfe\x91\xb2\xd7\xf3\x5d\xbd\xe5\x46\x42\xa1\xb1\xbc\x76\x81\xf6\x74\xf4\x4d\x04\x76\x36\x59\x65\xbb\x1c\xec\x09\x1a\x15\xa3\x75\xc7\x4d\xf6\xee\x3e\xb7\xac\xce\x8e\x5a\x39\xd8\xf7\x2b\xe9\x9c\x5f\xe6\x4c\x7e\xf3\x1d\xef\xab\x8f\x55\x7d\x5f\xb9\x38\xa4\x69\x48\x66\x78\x7b\x44\x12\x14\xac\xc5\x2e\xdc\x97\xb6\x20\x26\x3e\xc9\xac\x44\x5d\xce\xa0\xc8\x73\x4c\x1b\x28\x52\x06\xb2\x4e\x93\x23\xce\xef\x1d\x8e\x8e\x42\x7a\xad\x6c\x90\xe8\x37\x99\xd7\xdb\x75\x8d\x46\x85\xa5\x98\x85\xfb\xb5\x10\x2b\x08\x3a\xbb\xdc\x37\x14\x32\x73\x87\x49\x6b\xcd\x77\x0a\x15\x5b\xe7\x60\xc4\x92\xf9\x7e\x1d\x31\xc1\xa0\x7d\xcb\x5a\xb7\xac\x5f\x0b\xd4\x72\xa6\x45\xc0\x4e\xab\xa1\x3e\xab\x2e\xb6\x5b\x90\xcd\x03\x08\x6f\x5b\xf0\xe7\x00\xdd\x7c\xe4\xd0\x44\x7f\x9f\x35\x5b\x6b\xc0\x58\x66\x33\x04\x3f\x78\xe8\x2d\xf5\x9b\x2d\x1b\x6f\x7d\xb2\x3d\x65\xa5\x4e\x39\xfc\x14\x6d\x07\xaf\x61\x05\xad\x77\xf2\x26\x95\xe9\x66\xe0\x22\xa9\xdc\x51\x7c\x89\x4b\x34\x96\x98\xe8\xc7\xdd\xa0\xea\xea\x2b\xdf\xee\xfc\x2f\xca\x8f\x07\x53\x82\xa8\x1b\x32\x51\xd8\xae\x72\xc7\xda\x7e\xe6\x8d\x63\x31\x54\x84\x7a\xd7\x27\xf6\x1f\x05\x3c\xdd\x63\xb9\xba\x73\xf3\x7b\xb7\x6b\x46\xa5\xf4\xbe\xbe\x76\x46\xef\x9a\xd9\xba\x41\x27\xfb\xb4\xf0\x5d\x2f\xe6\x3d\x0a\x3c\x62\x59\x43\x19\xd6\xdc\xcb\xd9\xc0\xce\x08\x3c\x9e\x67\x2d\x5c\x9b\xe3\xc9\xef\xe1\xc4\xde\x85\xf9\xbc\x14\xd3\x29\x7b\x81\x5f\xea\x9f\xc8\x6e\xf5\xaa\x57\xfd\xec\x27\x50\x98\x6c\xa5\x77\xa0\xf6\xce\xf6\xb3\x0f\xfb\xe6\x0e\xec\xbe\xd6\x50\x70\xb0\x56\x1a\x37\x9a\x25\x69\x43\x77\x5d\x20\xf7\xa7\xcb\xcf\x71\x72\x2f\x48\xcf\xfd\x3b\x97\x76\x59\x2e\xed\xb0\xad\x98\x50\xfe\x09\x4d\x27\x4d\x35\xca\x93\xf8\xee\x7a\x9c\x90\xbe\x18\xad\x7d\xed\xd8\x54\xc2\x9e\xa9\x04\x14\xaa\x53\xe6\x76\x9b\xdb\x32\x77\x28\xb9\xc6\xc7\xa1\x3b\x08\xdd\xa1\x8c\x36\x6a\x96\x7e\x37\xd0\x03\x73\xdf\x79\x92\xb0\xfe\x3a\x21\x7a\xc2\x8c\xb6\xbb\x2f\xc4\x03\xd8\xca\xec\x33\x98\xa9\xcb\xba\x69\x8d\xe7\x4d\x1b\xc0\x23\x9e\xdc\x02\x6d\x9c\x2d\x9b\xb1\x7c\x08\xc0\xae\xb6\x7e\x64\x6f\x57\xb5\xc2\xb6\xc8\x7a\x08\x2a\x84\x26\xc3\xb4\x27\x8e\x89\x95\x0b\xdf\x7e\x2d\x0d\x40\xbb\xe8\x8a\x31\x44\xe4\x78\xcc\x9e\xa0\x40\x92\xa2\x9f\xd5\x91\x4d\xb8\xab\xef\x01\xfd\xba\xb6\xc6\xae\x8d\x91\x19\x84\xc6\xef\x5e\x01\xb1\x02\xb1\xde\x1c\x62\x65\x5a\x33\x14\xda\x26\x01\xf0\xbb\x49\x7d\x71\xce\xf1\x88\x32\x0a\x4b\x95\x02\xef\xc5\x0b\x82\xed\x12\x2f\x81\x30\x66\xc5\xb1\x34\xe4\x0a\x76\xcf\x83\x72\x8a\xb3\x39\xc4\xe4\x4b\xcc\xe1\x05\xbf\xda\xb5\x21\xf3\x03\xd6\x75\xbf\xb3\xcc\x48\xbb\xdb\x6f\xeb\x51\x14\xbe\xdf\xb9\x20\xee\x3f\xaa\x62\x99\x0f\xd3\x54\x3e\xad\x32\xa7\x6d\x56\x62\xd9\x48\xd2\x0e\xb7\x17\x54\x1b\x3e\xa7\x36\xbf\x92\x8a\xf2\x88\xce\x8f\x94\x6b\x71\x0d\xd7\x56\x46\xc0\x05\xb0\x2c\x4d\x1f\x7e\x58\x7b\xeb\xea\xb4\xaf\x43\x4c\x48\x5f\xb8\xc3\x88\x0d\x9c\x9b\xc2\xa7\xae\xd6\xaa\x12\xab\xc2\xdf\x0b\x83\x5f\x99\x32\xfd\x79\xae\x26\x6e\x44\x89\x27\x18\x8f\xb0\x77\x6f\x9e\xdb\xf5\x16\x0f\xf3\x42\x63\x9f\xf7\x77\x81\x61\x45\x03\x78\x04\x19\xec\x93\x31\x3b\x8e\xe3\x99\x1d\x49\xf6\x84\x6d\xfc\x61\x57\x0f\xb4\x3f\xbe\x79\x6c\x6b\xca\xf8\x66\x6f\x17\x26\x4a\x72\x96\xba\x95\x0a\xae\x76\x7e\x96\x38\x77\xb6\xbf\xaf\x02\xf1\x1a\x60\x82\x3c\x9a\x16\xbf\xad\xe6\x05\xee\x75\xf3\x78\xb6\xc8\xb5\xb2\xda\xb1\xa9\x99\x1b\x0f\xfb\x44\x78\x52\xcd\xd7\x8e\xe5\x7d\xd3\x78\x7f\x3a\x79\xa3\xff\xc6\x32\x9d\xcc\x0f\x8c\x61\x9b\xb3\xaa\xbf\x47\x4e\x53\xff\xfb\x07\x11\xad\x01\xa2\x1d\x71\x5b\xf8\x78\xd1\x0d\xfd\x4d\xba\x7b\x9f\x0c\x81\x09\x8e\x40\x12\x04\x04\x1e\x1c\x0a\xff\x6c\x8c\x48\x07\x29\x6a\xa9\xd1\x1d\x91\x3a\x0e\xe9\xae\x41\x4e\xeb\x27\xb5\x3b\x6f\x8f\x35\xf2\x0c\xcc\xea\x19\x18\x73\x6c\x7e\xde\xc4\xad\x3c\x01\xcc\xfc\xf9\xb8\x76\xcf\xef\x16\x6e\x2d\x15\xcb\xc3\x03\x02\x6e\xb7\x68\xf6\x59\xf9\xdf\xff\xf6\xcd\x07\x77\x1f\xf9\xef\xc8\x38\x98\x3f\xfb\x10\x32\x38\xe8\x14\x38\x0f\xb7\x2f\x9d\xc5\xd4\xbc\x34\x71\x4d\x00\x17\x5d\xba\x15\xba\x7e\xb4\x85\x48\x6b\xdb\xff\x51\xb9\x2b\xe7\xee\x85\xdc\x9c\x3b\xfa\x03\x84\x25\xe6\x4f\xf6\xd2\x9c\x02\x4c\x53\xd7\x19\xbe\x16\xf7\xa0\x66\xdc\x4c\x00\x45\xa5\x71\x96\x2a\xeb\x93\x45\x92\xae\x1d\x3e\x06\x7e\xf0\x5c\x41\x6e\x86\xe1\xc7\xcd\xdc\xe4\x34\xa2\xb2\xbd\x75\x76\x33\x6f\x76\x45\x6e\xda\x7e\xf7\x54\xd3\x95\x96\x8d\xcd\xd4\xd3\x67\x15\xe8\x40\xfe\xc7\xbe\x20\x97\x4a\xa2\x2c\xbb\x6f\x35\xaf\x85\xdf\x6e\xa8\xa0\x81\xbe\x47\xe9\xbe\x77\x2e\x2d\x55\x29\xdc\x9f\xa5\x1d\x44\xee\x6c\x57\xc5\xb7\x95\x98\x88\x84\x24\x49\x6b\x1d\x1f\x88\x4a\x13\xaa\xbe\x42\x01\x1b\x1f\xd5\xf3\xe3\xfb\x2c\x21\x39\xca\x62\x6d\x2c\xbb\x15\x50\xc0\x31\xd3\xe5\xe2\x0c\x01\x8b\xf2\x33\xc2\x8f\x42\x2e\xde\xd8\xfa\xa2\x74\xda\xd0\xeb\x49\xef\x49\xe2\x4c\x3c\x97\x3d\xfd\x54\x94\xbf\xf6\x4d\x23\x4a\x1a\xe1\x51\xc7\xb5\x84\x7e\x67\xd2\x45\x1f\xe6\x4d\xb1\x1d\xa7\x83\xf0\xdd\xd3\xee\xbd\xc0\xc8\x9c\x2d\x9e\xcf\xb2\x96\x44\x18\x1f\x07\xc5\xeb\x1e\x04\xc6\x7e\x09\xf9\xc0\xc3\x19\x55\xbb\x47\x46\xb5\xf7\x4d\x41\xfe\xf7\x50\x66\x9b\xba\xff\xdc\x2a\xb3\x5b\x4c\xa7\xb2\x77\x81\x1a\xb8\x4c\xf0\x0b\x41\x6f\x4b\xd8\x2e\x70\x5e\x0a\x56\x87\x4c\xd7\xb6\x46\xf2\x33\x90\x53\x70\x97\x2b\xc1\x6d\x37\x6a\x3c\x49\x05\xe4\x4b\x6b\xb1\x32\x4d\x57\xae\xe7\x08\x0a\x4e\x4e\x0a\x1b\xaf\xc5\xd3\x42\x01\x30\xe5\xdf\xa1\x9e\x65\x59\x9a\x75\x66\x7c\x32\xee\xd5\xdc\xe5\x87\x96\xab\x98\x99\x3c\xb0\x2e\xe1\x12\xb2\x2b\xdc\x8f\x16\x64\x50\xd2\x9b\xde\x83\xd9\x4b\x59\x34\x7e\x43\x95\x49\x58\xf5\x38\x6d\xed\xf0\x18\x44\xfc\x0b\xd3\x85\x50\x20\x1a\x09\x2b\x60\x52\xd1\xa8\x1b\x9f\xd6\x6f\xb9\xe4\x3d\xd5\xfc\xbe\x2a\x94\x60\x04\xe2\xec\x40\x07\x22\xbc\xcf\xd0\x1e\x5c\x1e\x22\xde\x61\xdf\x78\x66\xb7\x07\xdf\x9f\x88\x1f\x11\xb5\xcb\x64\xa6\x3b\xc9\x1a\xb0\xa6\x04\x11\x61\xb7\x28\x5b\x36\x97\xc7\xe3\x8a\x3e\x5f\xcb\xeb\x58\x78\xd6\x24\x3c\xa3\x4f\x75\xd8\x1f\x7d\x45\x9c\x5a\x37\x6e\x48\x78\xaa\x93\x24\x53\x6b\x72\xff\x85\x1d\x32\xcb\x03\xed\xa8\x4c\x63\xdd\xb8\x47\xae\xc2\x84\x58\x31\xc1\x2f\xb3\x19\x05\x77\xad\x29\x60\x24\x06\x5f\x70\x8f\xcd\x1e\xcb\x69\xbd\x33\xae\x2a\xb9\xf2\xba\x58\x2c\xc0\x9e\x2d\x53\x32\xca\x4e\x6d\x36\x75\x29\x99\xbe\xc1\x60\xff\xed\x63\x43\x85\x6b\xe7\xe3\x4f\x26\xd1\x46\x44\xf4\xdf\x0a\x95\xf7\x7d\x43\xbf\x22\x0d\xdb\x2f\xe6\x4a\x39\xdb\xb9\xf4\x07\x84\x95\xd7\x6a\x5c\x3a\x92\xf0\xb2\xa0\x1e\xed\x0b\x33\xf6\x3b\x1e\x27\x99\xc7\x73\x98\x62\xaa\xa9\x7b\xaf\x73\xf4\xd2\xc7\x3c\x85\x83\xa5\xff\x2a\x2e\xd6\x5b\xfe\x2a\xe0\x50\xb4\xd6\x77\x19\x36\x47\x74\xd9\xfa\x6c\x2d\xd1\x0c\xbe\x3e\x3b\x83\x29\x59\xae\x01\x76\x06\x15\x71\x5b\x2f\x6d\x68\x94\xee\x9e\x59\x2f\x62\x86\x5f\xf8\x64\x68\x55\x57\xf6\xfd\xb3\x54\x76\xce\x0d\x89\x9b\x3e\xa3\x58\x41\x08\x66\xe0\xf9\x05\x19\xf4\xb8\xa6\x91\x4c\x49\xd1\x1a\xc9\x1f\xcc\x5f\xbb\xfe\x94\x79\x87\x3f\xf4\x0b\x7b\xaf\xc7\x85\x18\x9b\x6d\x83\x16\x1d\xdc\xae\x67\x42\xfe\x38\x16\xf4\x73\xc4\xa7\x19\xdd\x6c\xe6\xee\x51\xee\x37\xee\xa0\xdd\x16\x5e\xc5\x13\xb5\xec\xe6\x8e\xbc\x61\x6e\x8f\xe7\xee\x80\x08\x22\x05\x1f\x73\x56\xd7\xeb\x65\x4f\x67\x5d\x59\x61\xd9\xd3\xac\x77\x92\xe3\xec\x2e\xa3\x04\x6d\x36\x9f\xd7\xcd\x42\xcf\x28\xfc\x18\x0a\xdb\x91\x30\xa5\xbc\x93\x20\xfb\x30\xed\x7a\x59\x6f\x25\xce\x71\x8e\x0b\xb3\x77\x5f\x17\x79\x3a\x8c\x47\x33\x5c\x77\x1c\xad\x73\xa9\x4d\x5b\xe5\xce\x59\x01\x86\x51\x22\x05\xaa\xeb\x3e\x39\x3e\x77\xc6\x25\xab\x4c\xc2\xea\x08\x89\xb7\xa1\x17\x68\xa7\x1a\x39\xc5\xba\xb4\x7e\xe1\x17\x11\x3b\x3b\x32\xb1\xec\xea\xd6\xcc\x7e\x2c\xa5\x7b\x34\xb5\x6f\xcd\xd3\x55\x2e\x7b\x6e\x26\x27\xc3\xd4\x99\x2e\x61\x3f\x66\x8b\x9f\x6f\x03\xb3\xaf\x33\xf3\x4a\x94\x95\x9c\x9e\x1a\x16\x89\x3d\xa1\xa0\x21\xb3\xf6\x54\x74\x00\x11\xca\x38\x00\xe1\xaf\xc7\x5c\xc3\x43\x27\x1a\xed\xaa\x1f\x39\x83\xbc\xe1\x52\x2e\xa2\xad\x78\xea\x4e\x5f\xed\xe1\x0d\xff\x29\x7e\x6b\x05\x99\xea\xea\xcd\x0c\xc5\x92\xac\x61\x1b\x5b\xa6\x4d\x16\xce\x3f\x11\xb5\xcb\x2c\x42\xa8\x78\x5b\x2a\xf2\x3d\xea\xef\xfd\x1d\x2f\xbf\xa4\x30\xbd\xca\xfb\x48\x1b\x24\x2b\x8c\x71\xa2\xfb\x07\x55\xd2\x91\xa6\xc4\x36\xad\x41\xc9\x5d\x02\x21\x78\x2a\xf5\xa8\x5c\xa4\xe4\x67\x69\xa9\x8a\x09\xba\x51\xe5\x2b\x05\x63\x41\xae\xf9\x21\x40\x4c\xac\x06\x32\xa2\x3e\xd3\xd9\xf3\x72\x90\x02\x26\x33\xb4\xbf\x19\xcc\xab\xd8\xb8\xdb\x09\x0d\x11\x2d\xb3\x93\x0c\xca\x6b\x01\x1c\xfe\x69\xf2\x17\xd2\x34\xcc\xf1\x6f\x70\xa4\x17\xcd\xc6\x39\xb5\x60\xc1\x0a\xcc\xaf\x92\x34\x9c\xca\x36\x8c\x4f\x08\x15\x6b\xfd\xa2\xf3\xee\x6c\xef\x1e\x81\x7c\xf9\x15\x9c\x72\x25\x81\xd7\xb1\xbe\x0b\x72\xd3\x3c\xe7\x46\xea\xb5\x3d\x78\xd2\x96\xc0\x26\xf2\xe1\x09\x99\x04\x65\x24\x00\x92\xe4\xaa\x38\x99\x1d\x44\xd2\x66\x31\xf9\x89\x2b\xfc\x4f\xb1\x66\xa1\xb3\x41\x2d\x56\x44\x9b\xce\x14\xd5\x13\x88\x07\x5d\xe4\x9b\x02\xcb\x24\xac\x53\x93\xf0\xb4\x12\x4c\xc2\x6f\xcb\x86\xd4\x78\x55\x29\x3f\x9d\xbf\x8a\x1e\xb8\x2e\x9c\xce\x66\x07\x67\x21\x76\xc2\xc1\x28\x4a\x7c\x10\x06\xd4\x98\x94\x29\x13\x95\x94\x71\x9b\x60\xc9\xf7\x13\x44\x38\xf1\x0d\x4d\x6b\x46\x18\xca\x27\x99\x74\x30\x41\xca\xf6\x71\x6e\x17\xa8\x42\x2b\xe3\xf2\xd5\x72\x0f\x67\x55\x9a\xab\xe8\xc2\xfc\xdd\xfd\xd7\xa9\x91\x7c\xef\x2e\xe8\x2c\x3d\x80\x88\xd8\xdb\xb0\x38\x89\x3d\x69\xd7\xb0\x9c\xc2\xdb\x3e\x59\xe7\x74\x1c\xdd\xbd\x5e\x4e\x58\x13\xe0\x6a\x6c\xbd\xbd\xe2\xba\xd7\x92\xf3\x2a\xdf\x30\x07\xcc\x98\x5e\xe7\x4e\xd2\x61\x5f\x4c\xfe\xea\xa3\x9e\x9e\xc9\xf0\x3f\x93\x98\x19\x22\x0c\x59\x2c\x65\x14\xbc\x2f\x1c\x5e\x30\x0b\x43\xb8\x51\xfd\xb8\xa8\x6e\xa6\xce\x55\x76\xe7\x82\x5a\x29\x16\x5d\xbb\xf7\x55\xb6\xde\x99\xed\x1b\x4c\x65\x97\x27\x79\x60\x19\xf9\x91\x7a\xf7\x73\xbe\xdf\x89\xe8\x38\xa5\xd9\x85\xee\x38\xbc\x3f\xe9\x04\x25\x4c\xf7\xbc\x1a\xc0\x83\x17\x08\x6d\xf6\x54\xf7\x52\xc6\xe0\xd9\x06\x1a\x43\xa2\x9d\xeb\xe3\xec\xd2\x7e\x33\x75\x4e\x48\xb6\xa1\xf0\x00\x34\x7e\xf7\xb3\x93\x0d\x68\x1f\x4a\x36\x4c\x27\x0b\x64\x99\x31\x93\xd8\x96\x79\x2e\x39\x5f\x3f\x1d\xd9\x3d\xe6\xe1\xda\x4f\x89\x24\x56\x81\xa4\x0e\xca\x35\xc8\xef\xb5\xb5\x7b\x53\x24\xff\xa7\x38\xc9\x69\xa6\xf1\x85\xad\x23\x0a\x68\xff\x69\xf2\xf5\xde\x53\xc0\x0c\xa5\x7c\xa7\x92\x20\x39\x58\xf4\xa3\x23\x8a\xa0\x4a\xa2\x3c\x70\x10\x25\x65\xd3\xe0\x21\x24\xa3\xa0\x47\x6f\xf9\x10\x35\x71\x64\x3d\xe3\x34\x35\x79\x49\x46\xc9\x94\xd0\x2c\x8f\x2e\x63\xd6\xa9\x0d\xf2\x06\x35\x55\xd0\x73\x67\xae\xea\x86\x4e\x5f\x9b\x38\xa8\x33\x4d\x8f\x7e\xfd\xbd\x78\x6f\x19\xa8\x98\x83\x90\x76\xb8\x34\x4f\x9a\xcc\xed\x58\xa6\xd7\x02\x0e\x82\x74\xb6\xc5\x1a\xc4\x08\x44\x98\x6c\xd4\x3d\xc6\x76\xe9\x0c\xaf\xb5\xf1\x49\x16\x32\xe9\x64\x75\xf1\x09\xe2\x07\x37\x7b\x2c\x5b\xec\x0e\xe2\x83\x51\xc7\x01\x63\xf4\x80\x51\x9f\xb1\xf1\x17\xa9\x67\x6d\xde\xdc\xc9\xb0\x4c\xe5\x9d\x48\xb9\xb2\xf0\x09\x73\xb7\x9b\xc3\x0f\x80\x7a\xaa\x98\x95\x6a\xfd\xfa\x56\x40\x16\xdd\x65\x89\xce\x43\x7b\xff\xc9\x04\x4d\xa2\xf9\x1c\x82\xb2\x3c\x89\x4e\xc9\xa1\x58\x58\x29\x80\x4e\x1e\x27\xef\x8f\xbb\xf5\x73\xa9\xf8\x98\x8b\xca\xd7\x4a\xbb\x53\x78\x3d\x0c\xb5\xd0\x21\xba\xef\xac\x7e\xaf\x04\x20\x6e\x7d\x31\x4a\x38\x5a\xd0\x62\x20\x75\x40\x1d\x2d\x6b\x86\x42\x9f\x48\x79\xd3\x8d\x1f\x86\x4b\x87\x42\xb1\x4d\xc4\x6b\x5f\x74\x64\x92\x3c\x5b\x1f\xc9\xfc\x94\x41\xde\xb8\x13\xfc\x95\x87\xf4\xa4\x44\x21\xc3\x76\x94\xb8\xfd\x39\x05\xb6\x4b\x68\xd6\xc1\x4b\x57\x56\xa5\x96\x42\x27\x09\xdb\xfe\x89\x10\x15\xd9\x24\x77\x99\xe6\x30\x79\x0f\xd1\xf5\x51\xbd\x29\x57\x86\xb3\x7f\xf2\x64\xd7\xed\xa4\xbd\x2f\x9a\x32\x28\xcd\x03\xd9\xbc\xa9\xc1\x3c\xbe\xae\x77\xfe\xf0\x64\x4a\xd1\x9d\x39\xbb\x1c\x0c\x5f\x93\x1c\x86\xba\xf5\xd9\xdf\x81\xaa\xb5\xc5\x00\xe7\xab\xd4\xdc\xf7\xbc\x75\xbb\x45\x9a\xd2\xf7\x90\xb8\x53\xa4\x32\x3c\x39\x38\x4c\x9c\x3f\x0c\x0a\x21\xb7\x87\xc9\x10\xc2\x0c\xd0\x70\x06\x5e\x48\x19\x49\xea\x29\xda\x8c\xd4\x15\x5c\xe4\x8d\x86\x5c\xa4\x6f\xc9\x1b\x05\x66\x3d\x8b\x2b\x1b\xce\x95\x80\x5e\x1f\x74\x4f\x9d\xb3\x75\x13\xa9\x05\x16\xbb\x51\xf4\xa3\xf2\x28\x1e\x7f\x9f\x77\x6b\xf0\x0f\x96\x38\x7b\xd1\x61\x47\x7b\x5c\xa2\xf0\xb6\x43\xb4\xc4\xdc\xa0\xc0\x6d\x19\x06\xc7\x51\x38\x0b\x03\xc5\xee\xc1\xca\x35\x29\x50\x9d\xba\x35\xe7\xce\x6d\x99\x45\xe1\x47\x36\x1d\x4d\xd1\x1a\x44\xa5\xed\x94\x36\x65\x57\x7a\xf7\x18\xdf\x46\x90\x3b\xec\xd3\x13\xbe\x18\x45\x03\xf6\x5d\xb5\x2c\x8b\xf9\x6e\xf2\x2b\x81\x1c\xa3\xea\x77\x9f\x86\x77\xe5\x12\xce\xa3\x41\x26\xb0\x01\x3b\xa1\x08\x35\xe9\x52\x1a\x04\xa8\x0d\x40\xd6\xa9\x90\x71\x12\xae\xf6\x1a\x70\xb5\x0e\x9d\xc4\xff\xd1\xf4\x5b\x67\x80\x59\xaf\x47\x02\xb3\xaa\x16\x50\x82\x9f\x4a\xb0\xdc\x8f\xaa\xb8\xbc\x7f\x12\x6c\x16\x70\x58\xab\x86\x81\x05\xce\x85\xfd\x2a\x83\x4a\x32\x6a\xa8\x6e\x99\x54\xb9\xa8\x0f\xf1\x0b\x3e\x2b\xba\x41\x35\xce\xfd\x75\x99\x5d\xde\xc9\x18\xd1\x89\x9d\x5c\xb4\x86\xd2\xf8\x81\xcb\xed\x26\xfc\xe1\x18\xbc\xcb\x6b\x7b\x0c\x50\x7e\xeb\x9f\x3e\x40\x0e\x23\xfc\xf3\x3f\x81\x91\x42\xeb\x56\x84\xff\x1a\xd8\x16\xee\x57\xcd\x86\x58\x81\x99\x54\x24\xcb\x7a\xbe\xe7\x91\x04\x07\xfd\x92\x43\x31\x32\x59\xfd\xf3\x51\x7f\x37\x7c\x40\x26\x5f\xb1\x38\xb0\x7b\x24\x5a\x6e\xa7\xf5\x8d\x4a\x2c\xd3\x24\xe7\x69\xb8\xcc\x67\xed\x89\x7e\xa6\x31\xa7\xa0\x74\x5c\x8d\x3d\x04\x4f\x08\x0a\xf4\xce\xc1\x37\x57\x97\xfa\xfc\x57\x3e\x0b\x9f\x86\xc2\xe1\x81\xf6\xee\xed\x00\xc8\xec\xcd\x58\x83\x46\xc9\x10\x63\x57\x66\x89\x75\xb8\x9c\xfc\xee\x91\xa4\xbe\x67\x1a\xc0\xaf\x6d\x92\xfa\x40\xf1\xb3\x65\x15\xad\x2f\x37\xa4\x6a\x72\x27\x5f\xab\x0d\x5b\xb3\x7a\xe1\x42\x59\x54\x17\x99\x47\xb8\x56\xbd\xf5\x4c\xad\x57\xab\x9c\xc2\x1c\x88\x3e\x07\xe9\xdd\x3e\xde\x35\xb6\xb2\x24\x7d\x28\x2d\x78\x2e\xbf\x13\x2b\xf9\x7f\xff\xcf\xff\x45\xb8\x18\x92\x2a\xa1\x4c\x13\x3b\xb4\xbe\x97\x3b\x55\x8a\x1b\x89\x08\xc7\xf5\xfb\x3d\x92\x2a\x18\xde\xc5\xae\xe3\x39\x1e\xb0\x53\x63\x8c\x7d\x34\xf2\x31\x8f\x6d\x71\x34\xdf\x74\x68\xc8\xf0\xe5\x35\x53\x94\xe7\x65\x4d\x84\xdd\x4b\xc9\xbf\x8d\x94\xe4\x11\x30\x48\xa6\xe9\xbc\x98\xfc\x11\x0c\x70\x50\x5f\xbf\x34\x0a\x79\x73\xcd\x20\xe4\x93\xe2\xe5\x1f\x62\xa6\x80\x8f\xff\xbf\xd4\x48\x0d\xef\x7f\x0a\xb1\x14\x9b\x3c\xb1\x58\xd6\xf5\x79\x55\xc3\x10\x23\xc9\x8f\x18\x86\x07\xec\x40\x96\xb4\xd4\xe6\xf6\x71\xe1\x11\xec\x14\x0f\x8a\xd2\x42\x85\x23\xbf\xd8\x11\x1a\xea\x26\x42\xf6\x9d\xf4\xcb\xe2\x5a\xe7\x4a\xa8\xb8\xf7\x2f\x1d\x74\xff\xcb\x63\x84\x19\xcc\x64\xcd\x0f\x96\xef\xe8\x8f\x6f\x99\x4a\xd3\x6a\xd2\x2f\xab\xc4\x62\xff\x12\xa4\x9f\x83\xd5\xe3\x47\x57\x30\x4f\x2f\x95\x76\x63\xae\xa5\x65\x64\xd9\xfe\x89\xb9\x60\x7a\xa5\x6f\x92\x58\x53\x85\x7e\xdb\x6e\x0e\xb4\xcc\x3a\xb3\xa7\xbf\xc0\x4a\xf1\x5e\x0f\x77\x13\xaf\xd8\x8d\x30\xe9\x12\xdc\xaa\xde\xf6\xae\x15\x8d\xb3\xd0\xa3\xe5\xc5\x98\x99\xf2\xe6\x17\x98\x63\x72\x77\xba\x4b\xab\xae\x97\xd3\xa6\x5f\xcd\x26\x8e\xb6\x7f\x1f\x58\x81\xfa\xb7\x05\x8c\x2c\xe0\xac\xd0\xe2\x10\x44\xe1\x25\x1e\x1b\x70\xe2\xae\x64\x12\x07\x8c\xde\x11\x61\x59\xe1\xdc\x10\x65\xd9\x04\x64\x16\xe1\xd3\xa4\x59\xbf\xbd\x86\x39\x10\x48\x03\x11\x1f\xff\xd8\x93\x29\xba\xea\x75\x31\xbd\x4c\x99\x7a\xe2\x98\x97\xf6\xea\xc7\x4e\x55\xd5\x72\x77\x7a\x8d\x4b\xba\x07\xdd\x56\xf8\x58\xb9\x17\xe2\xbe\xb9\x6d\xce\x78\x16\xe3\x8f\x03\x96\xb9\x99\x31\x04\xb0\x85\x0a\xdc\xd4\xb1\x3a\x73\x1c\xa4\x57\xb7\x04\x7a\x5b\x97\xc5\xa2\x7b\xa9\x80\x9a\x46\x89\xa7\x68\xa4\x37\x42\xaf\xbf\x70\x3b\x91\x8c\xa5\xff\x94\x9e\x02\x29\xc0\x69\x6a\xed\x33\xd2\x7f\xc8\xfb\xb1\x3b\x6b\x34\x69\x65\x96\x05\xb4\x6a\xc5\x65\xc7\xd1\x9a\xff\x5d\x80\x4e\x5e\xa5\x2e\x03\x1a\xc4\x59\xa4\x7a\x3e\xdf\x6f\x0b\xb6\x9d\x92\xd0\xa4\xc7\x9b\x60\x8b\x99\xe5\xd0\xdd\xbe\x4a\xe1\x23\x17\xb7\x50\x7d\x7a\x33\xf6\x2b\xce\xa8\x7f\x5b\xb1\xe8\x95\xf2\xd4\xbe\x5f\x17\x4d\x19\x3b\x20\x57\xb1\x5b\x3f\x4b\x0f\x73\xc2\x0c\xa2\x7c\xbc\x91\x44\xe8\x30\xf7\xd6\xce\xd3\x59\x91\x7f\x6b\xcc\x36\xe6\x48\x41\x93\x75\x20\x6f\x83\x75\xda\xbf\x33\xac\xb1\x6e\x06\x59\xbb\xc8\xc9\xe9\x37\xef\x87\x38\xb6\x19\x16\x92\xa7\x11\x0a\x9a\x6d\x1b\xea\xf0\xf5\x26\x47\x33\x94\xb3\xf7\x77\x6c\x03\xd9\x5d\xd5\xa9\x02\x6f\x02\xf2\xab\x56\x89\xe3\xeb\x39\x9a\x24\x4c\xa1\x95\xef\x5e\xc4\x51\xed\xa0\x2b\x85\x12\xac\xa7\x05\x7a\x80\x2f\x45\x71\x8d\x81\xfe\xc4\xc7\xf9\x54\x14\xcf\xf9\x2b\x41\x80\x30\x88\x38\x51\x62\xc1\xeb\x44\xa4\x33\x57\x61\xa2\x98\x6d\x81\x59\xba\xed\x9e\x68\x6c\xbf\x7f\x3c\x99\x94\x41\xac\x2d\xd9\x6a\xb5\x63\xcc\xf5\xa7\x0c\x3c\x5f\x8d\x0e\x3c\x8b\xb6\x10\x7e\xea\x51\x2c\xa5\xdf\x5d\xb1\x92\x24\xc9\xfe\x0a\xac\xe5\x70\x21\xdc\x66\x98\x6b\xef\xd7\x64\x05\xd5\x83\xc6\x1d\x43\xf3\xab\xb2\x98\x47\x42\x57\x69\x32\xfe\x3a\xec\x26\xb7\x43\xe2\x00\x5e\x34\x42\xa6\xba\xcc\x8f\x10\xfa\xdd\x56\x07\x81\x73\xa5\x90\x7b\xd3\x73\x22\xd8\xb4\x69\x0e\x06\x3d\xf0\x57\x03\x32\x84\xf9\x9a\x56\xb1\x1b\x09\x27\xe8\x67\x2c\x2a\xb7\x96\x75\xeb\x12\x7d\x04\x02\x4d\x6c\x56\x35\x9a\xbc\x15\x8a\x09\xd9\x27\xe0\xef\x1f\xc7\x2b\x7a\x29\x7f\x7f\x22\xb2\x74\x7c\xf9\xff\x8f\xfd\x66\x3b\xaa\x80\xfa\xcb\xd5\x97\xbd\xe8\x93\xaf\xeb\xed\x96\x1b\x79\x5d\xb0\x40\xb8\x3a\xd5\xc5\x38\x42\x55\xd6\x58\xbe\x46\x53\x88\x74\x7b\x52\x53\x8a\xe4\xcf\x80\x99\xde\x6c\x71\x60\x4a\x24\x80\x1e\x90\xa6\xd8\x96\x8f\x20\x6f\xfc\x8f\x8a\x4c\x86\x9f\x56\x53\xef\x1a\x3d\xda\x17\xc8\xf1\x56\xbd\xa6\xec\xe3\x8d\x09\xfa\x4d\x8f\xda\x5d\xd4\xd0\xc3\x03\x51\xd8\x6f\xc4\xed\xae\x73\x88\xfd\x64\x54\xd7\x21\xb2\xbd\x22\x15\x1e\x15\xed\xb3\x85\xd8\x2a\xe6\xe0\x25\x31\x13\xdf\x58\xa1\xed\x84\xd8\xba\xc3\x24\x13\xdc\x6a\xd2\x37\xa4\xc2\x39\xe2\x5f\xb0\x7d\xc4\x9d\x95\xa7\xe1\x64\xb1\xc9\x20\x3e\xa6\x74\xc7\xf0\xda\xc5\x08\x6c\x9b\xb6\x1b\x61\x60\x82\x07\x93\xaf\x39\x0f\x5e\xfe\x30\x73\x56\x0c\xc9\x2e\x35\x8b\xce\xe3\x61\x04\x05\xdf\x87\xdf\x99\x0a\x8a\x46\xbe\xe6\x7b\xb4\xf5\xb1\xd6\x3c\xe1\xcb\x1d\x96\x20\x6f\x01\x24\x9c\x21\xbf\xe4\xa8\xdd\x48\x67\xa7\x5c\x75\x44\x77\xb6\x67\xbe\x3b\xfa\x5c\xd2\x8a\x2b\xf6\x5f\xe6\x7b\xe7\xdb\xb2\xf9\x68\xfb\x2d\x50\xcb\xad\x29\x75\x7b\x77\x7a\x8a\x9a\x87\x78\x7d\xec\x59\x46\xae\xbf\xc3\xff\x0c\x0f\x6c\xeb\xb5\x5e\x78\x3b\xc3\x08\x3f\xa8\x1b\x23\x9b\x10\xc1\xc2\xb0\xd4\xfd\x6b\xbf\xe0\x19\x21\x84\xbf\x2d\x7b\x27\xe8\xa5\xb8\xff\xb6\x6a\xfc\x74\x92\x63\x4d\x81\x72\xd2\xfb\x2e\x66\x77\x05\xd9\x3c\x62\x53\xff\x35\x5f\x21\x20\xfb\x6b\xdd\x8c\x83\x0d\x7c\x7d\x91\xa8\xd7\x18\x72\x65\x63\x4c\xeb\xb4\x33\xbd\x7a\x7b\xba\x6b\x5c\x62\x8f\x25\x75\xaa\xee\xd0\x15\xdf\xc2\xbe\xb5\xfd\xae\x28\x34\x45\xe1\xac\xb9\xb9\xa6\x26\xec\x20\xed\x83\x38\x20\x84\x02\xe0\xb4\x3f\xc5\x04\xf1\x03\xca\x99\x04\xa1\xa2\x91\x97\xe6\x43\x4e\xfb\xa9\x34\x62\x70\x2f\x19\x3e\x3d\x9c\xff\x14\x15\x6b\xbd\x70\xd0\xa6\x20\x84\xc2\xfd\x35\x07\xd8\x62\xb3\x01\x14\xd1\x00\xc3\x85\x48\x8b\x91\xfc\x17\xf4\x86\xee\x7c\x55\x3c\xae\x78\x2b\x58\x84\x78\xe1\x5a\xc7\xe7\x77\x36\x82\x84\x17\x4c\x33\x31\xb7\xc2\x56\xe0\xd6\x15\x2d\x97\xdb\x25\xab\x9a\x1d\xd1\x20\x50\x29\x72\x81\xb3\x12\xaf\xde\xe6\xee\xec\xd1\x5b\x71\x97\x51\xfd\x80\xcd\xe3\x33\x10\xd9\xa2\x14\xe5\xb6\x3b\x3b\xbb\x03\x2d\x63\x3a\x12\xb7\x27\x91\xa8\xa0\x72\x36\x96\x25\x1e\xa7\xd8\x10\xf9\x5e\x61\x4e\x7c\xa4\x59\x41\x55\x0b\xfd\x49\xe7\xfb\xe7\xd7\x68\x46\x61\x60\xe3\xbf\x2f\x01\x5e\x89\x54\xdc\x41\xa9\x27\xd3\xb9\x91\x82\x37\x7f\xc6\x46\x92\x56\xb5\xd6\xf2\x9d\xc4\x2e\xec\xb8\xee\x5c\xcf\x7d\x8f\x4d\x59\x85\xc2\x2b\xba\x9f\x2b\xdf\x06\x2d\xf5\x9e\xb8\x42\x64\x67\x64\xc2\x01\xd6\xc7\x71\x2e\x2e\xf4\xb2\x58\x71\x01\xde\x85\x56\xf2\x2f\x26\xdf\x62\x9e\x7f\xcb\x36\xce\x7c\x4e\xad\x41\x4d\x86\x4e\x4a\x4f\xb7\x1a\xd9\xed\x42\xa4\x70\xf6\x1b\x23\x28\x69\xe7\x0f\x3b\x47\xee\x75\x28\x72\xf6\xfe\xd0\x15\x0d\x43\x7c\x3e\xf9\xb5\xf6\x3c\x9e\xb9\x0b\x55\x16\x35\xe9\xda\x61\x61\x99\x8c\xa7\xbf\xa1\x74\x7a\xee\x06\x53\xf1\xe1\xee\x34\x28\x5e\x67\x4b\x67\x57\xcb\x62\xae\xdf\xe0\x4f\x74\x25\xe2\x6a\x30\xf3\x2d\x80\x5d\x40\xe3\xb1\xd5\x7c\xf2\x7e\xed\x6e\xe2\x93\xc6\x53\xb7\x72\xee\xf2\x46\x05\xd9\x4a\xb6\x0f\xb9\xcf\xbe\xaf\x91\x0d\xac\xc0\xef\x4b\x18\xdf\x47\xbd\x1f\xaf\xb0\x45\x47\x8c\x6f\xb6\xe4\xa0\x78\xdb\x4a\xc9\x7c\x35\x18\x24\x0b\xbb\x33\x0b\x8b\xc9\xcf\xfb\x45\x55\xaf\x56\xe6\x68\x97\xce\x5e\x8c\xb3\x91\x35\x0e\x47\xe7\x53\x7d\x90\x45\xfe\x1e\x47\xfb\x28\x0a\xac\xf7\x4f\x11\x75\xfd\xf6\x8f\xbd\x0b\x21\x76\xb9\xb9\x18\xaf\x25\x8a\x85\xba\x5c\xe7\xbf\xa6\x41\x00\xda\x30\xce\x6c\x52\x30\xe6\x8a\xbe\xb9\xf4\xd2\x89\x82\xce\xfa\x45\x78\xef\xb7\xee\xcd\x66\x51\x1b\x8f\x87\x5d\x1f\xbf\x10\xae\x72\x25\x0e\x2e\x46\x70\xd9\x39\x62\x9d\xb5\x34\xfa\x98\xdb\x85\xe1\xe3\x94\x77\xbe\x11\xbc\xa9\x9c\x4d\xd1\xfa\xe2\xbf\x61\xe2\x33\x63\x38\x5e\xa9\x48\x71\x42\xbe\xa3\x53\xeb\xfb\xc0\x93\x3e\x27\xce\x85\x38\x12\x79\x83\xba\x99\xc8\x27\x1b\x19\x48\xb7\x73\x24\x11\xa4\x92\xcb\x92\x9b\x42\xbb\x17\x35\xf8\x7d\x85\x44\x83\x7b\x58\x4b\xcd\x54\x6e\x15\x17\x77\x09\xcb\xc8\xb3\xe3\xef\xf9\xe6\xb4\xcb\x61\x9d\x3f\x72\x77\x43\x7c\x0d\x4e\x46\x87\xf5\xd6\x73\x41\x7a\x13\xc0\xdf\xf8\x59\xa8\x61\xf7\x30\xcf\xfe\xf2\x44\x59\xc7\x38\xef\xaa\x97\x32\xee\x24\xff\x87\xc0\x16\x21\x29\xde\x5a\xb7\x83\x0c\x7e\x86\x5c\x9f\x9a\xa9\x74\xe4\x98\x0d\x8d\x54\xfb\xa3\x66\x42\xb2\xde\x0d\xbd\x5b\x7d\x96\x93\xae\xf3\x12\x09\xa1\x77\x42\x46\x33\x55\x68\x07\xfb\x9b\xe6\x7b\xe7\xd4\x8a\xad\x7e\x2b\x8d\xb9\xee\xbd\xcf\xd7\x0c\xb9\x80\x09\x5f\x80\xd6\xdd\x1a\x5e\x0c\x4f\xa3\x05\x3f\xc9\x1e\xfc\x12\x95\x00\x05\x41\xb2\x1c\x0c\xc2\x99\x0b\xa5\xe9\xe7\xa5\x34\xfb\x65\x2f\x42\x1e\x09\x45\x60\x74\x6c\xd6\x87\x5c\x3a\xa8\x8a\xc4\x53\xd6\x76\x9f\xa8\x93\x22\x62\xfb\xf4\x67\x72\x94\x69\xb0\x29\x62\x17\x57\x13\x6e\x72\x64\xe5\x68\x4b\xb5\xf3\x18\x9c\x2b\x96\xa3\x6b\xe6\x00\xe9\xc1\x3e\xe6\x75\x5c\x8a\x2b\x98\x5d\x5a\xa1\xbf\xec\x77\xa3\x58\x7e\x3b\x54\x22\x0f\xe2\x3c\x82\xdf\x3a\x79\xf6\x32\xc5\x62\xba\x08\xa5\x10\xb4\xe3\xcd\x48\xf9\x1e\x4f\x00\x3c\x73\xae\xc6\x09\x63\xa8\x7c\xcb\xa7\x69\x8e\x1e\xc4\x24\xd8\x79\x84\x62\xb3\xa9\x67\xb0\x06\x0b\xd2\x1a\x2d\xb5\xcd\xba\xdd\x8a\x5f\xc9\xe6\xd6\xfc\x37\xe7\xfb\xe5\x58\xc0\x9a\xe8\x17\x35\x6f\x24\x67\x8c\x89\xc8\x2c\x48\x2b\x1d\x89\x70\xd3\x86\xb4\x00\x92\xb5\xa4\x4b\xb1\x04\x35\xb0\x87\x95\x9e\xda\x74\x3f\x14\x55\x2c\xf9\x04\x67\x40\xe4\xe7\xdb\xe2\x9f\x69\x06\x38\x83\xcf\xb4\xb3\x5e\x4f\x8e\x02\x8f\xe7\xe1\x0c\xd1\x50\xb4\x0e\xbf\x8c\x80\xad\x14\xe3\xcd\x7d\xb3\x62\x94\x51\x46\xc8\xad\xee\xac\x73\x39\x93\xe1\xc4\x5a\x25\xf4\x39\xe8\xaa\xb7\xb1\x86\x80\x8c\xe1\x58\x4e\xad\x57\xef\x2b\x76\xc4\x48\xb8\xe8\x71\x72\x6b\xdc\x49\xd1\xe3\xc7\x37\xa1\x30\x81\x04\xe3\x3c\x8d\xd1\x0e\x67\xe9\x78\xb9\xd4\x17\xd2\x50\xd8\x46\xdd\xa8\x96\x3c\x30\xe5\x5f\xad\x09\x12\x88\x91\xb4\xbc\xca\xd2\x60\x8d\x54\x07\x1d\xae\x2a\xf2\x21\x9b\x5a\xb1\xc3\xd1\x8c\x8b\x61\x47\x03\x5a\xbd\x81\xe8\xb1\xef\xe4\x5b\x34\x87\x29\x84\x2b\xdc\xe2\x2d\x23\x6a\x8c\x7b\xe1\xe0\x23\xea\xa3\x38\xdd\x13\x7c\x1c\x59\x28\xe7\xa8\x0b\x7b\x8c\x05\xa9\xa2\x23\xac\xe4\x4e\x48\x53\x00\xef\x08\x2b\x24\x74\xb6\x80\x67\x6b\xd3\x35\x3e\x83\x25\xdb\x66\xff\xd8\x47\x4e\x8d\x0e\x67\x4a\x31\x4e\x80\x03\xc1\x6a\xdd\x6b\x6a\x14\x43\x17\x37\xb5\xfa\x0b\xc8\x94\x8c\xf3\x2a\xdd\x66\x6d\x26\x3f\xe7\x08\xa5\x32\xf1\x08\x47\xe4\xd6\x6e\xff\x65\x3a\x42\x86\x23\xe2\xbf\x16\xcb\x3c\xc0\xe9\xb5\x5b\x24\x6a\x1b\xf9\x3a\xbf\xab\x49\xe5\x7f\x46\xd7\xa0\xc7\xb9\xa7\xcd\xbc\x3c\xc2\xb4\x81\x11\xb9\x23\xd5\x7b\x66\xbc\x5c\xc5\x4d\xa5\xd9\x92\xdd\x36\xca\xaf\x37\xdc\xcc\xaa\xf2\x1e\x53\x28\x1b\x2c\x45\xfb\xd9\x6b\x84\xf1\x00\x15\x99\xe8\x7c\x31\xee\x85\xde\x91\xc9\xfb\x53\xa7\x48\xed\xd0\xd1\x49\xb1\xb3\xa7\xd4\x1d\x56\xd6\x60\xbd\x4e\x04\xa5\x56\x75\xb9\x60\x48\x4e\x4a\xf3\xca\xb3\x1a\x38\x27\x0d\x44\xcf\x5b\xaf\x31\xc4\xfa\x84\xfb\x4b\xf5\x91\xcd\xb0\x15\xab\xf7\x57\xef\xd2\x18\x91\x9f\xfd\x31\xe6\xd4\xf2\xa9\x91\xae\x4c\xd4\xc9\xa4\xed\xa4\xa1\xb0\xcc\x1d\xa8\x2e\x29\x80\x61\xe3\x7b\x65\xe9\xb4\xe4\x68\xdb\x13\xae\x21\xc1\xbe\x56\x2c\x8e\x9d\x6f\xb8\x5b\xa9\x2f\xbf\x93\x8e\xbc\x07\x45\x41\xab\x37\x02\x43\x8f\x90\xf8\xa2\x68\xe4\xdc\xe2\x9e\x32\xb5\x44\x15\xca\x51\x73\x4e\x92\x89\xd9\x52\xe3\xa3\x39\x97\x03\x89\x95\xeb\x48\xd3\x81\x20\xd9\x79\xcc\xa8\x87\x6a\x95\x6a\xbb\x97\xd1\x84\xfe\xac\x65\xf1\x9b\x67\x0d\xe5\x89\xbb\x6f\x96\x30\xf3\x36\xad\x2c\xe1\x3c\x0b\x6d\xfc\xf7\x64\xfd\x23\x43\x52\x5e\x94\x92\x02\x93\xfc\x1d\x49\x13\x58\xe7\x82\x36\x06\x51\x34\x09\x01\xe0\xbc\x2c\x36\x33\xb4\xdd\x7a\xbf\x1f\x4e\xf4\x2a\x4f\x0b\x62\x59\x89\x99\x58\x88\xab\x1f\xbf\x3b\xb2\x18\xc8\xf2\x0f\x78\xc1\xba\x0a\x3d\x7b\x49\x5d\x3f\xa6\x66\x3a\xc4\xd8\x9b\x3d\x37\xca\x02\xdc\x83\x6e\x78\xdb\x18\x70\x87\xcf\x15\x82\x24\xd2\x42\x87\xe3\xf5\x7a\x3d\xd7\xc3\x4d\x64\x0e\x18\x61\xca\xfa\x8a\xfd\x04\x8b\x0a\xa4\xb2\x30\xa6\x57\xe6\x88\xca\xf7\xb2\xac\x41\x7c\xb6\x12\x76\xd1\x0e\x4d\xb4\xf5\x87\x0b\xa6\x21\x2b\xeb\xe5\xb8\xf4\x30\x5c\xcb\x9e\x41\x7b\xf1\x58\x06\xbf\x33\x07\x93\xb4\x92\xb9\x17\xb9\x44\x81\xa0\xe9\xc9\x65\xd4\xed\x49\xd2\xd0\xf3\x85\x64\x3b\xb4\x1e\x47\xd4\x1d\x8e\xa9\x2e\x9b\x6f\x41\xe1\x57\xf5\x27\xe9\x3e\xbe\x0c\x0b\x95\x1a\x0c\x6e\x3e\xaa\x96\x76\xe4\xb8\xd6\xbc\x5e\xf1\x18\x9b\xde\xab\xcb\xba\x3b\x5e\x19\xc2\x85\xd9\x5e\x4f\x79\xc2\xdc\x23\xb2\xb6\x0b\xa3\x6f\x00\xeb\xc2\x17\x04\x28\x94\x39\x3b\xe4\xe7\xc8\xcc\x59\x81\x5b\x07\xc5\x03\x02\x28\x17\x49\xe1\xaa\x1f\x35\x63\x65\x47\x93\x6b\x5e\x70\xae\x1f\x05\x24\xd4\x5a\xdc\x73\x45\x0b\xad\x01\x95\x23\x0a\x41\xe9\xf9\x2a\x90\xd7\x1f\xf0\x95\x26\xe4\x37\xdd\x25\xef\xeb\xa6\x92\xca\x96\x5b\x3d\x3b\xaa\x72\xa8\x99\xed\x62\x43\x46\x23\x07\x71\x48\xde\x89\x3c\x69\xde\xad\xb0\x9f\xd9\x43\x84\xc1\xdc\x36\xee\x2a\xa3\x82\xd8\x5f\x2e\x16\xa9\xba\x32\xb2\xec\x74\xc9\xfa\xa8\xe7\xf6\x0b\x91\x82\xbf\x69\x7b\x8c\x57\x64\xf2\x4a\x15\x09\xca\xcb\x8a\x6c\x43\xbd\x46\x53\x8b\x12\xdc\xd7\x61\xf9\x81\xf3\x57\xcd\x44\x3d\x89\xf5\x52\x2e\x90\x43\xb0\x4e\x03\xf7\x19\x09\x11\xf1\xaa\x2a\xfd\x58\xa9\x67\x84\xca\x4c\xbd\x9c\x59\x5d\x4a\x8d\xc1\xde\x8b\xca\x69\x74\xc0\x64\x93\xcc\x98\x72\x13\x56\xac\xd0\xd6\xe2\x7f\x9f\xa0\x96\xd8\xb6\x7c\x0a\xd6\x24\x9a\x1a\x46\x2d\x1d\xc2\x21\x79\x50\xb9\xe3\x88\x2e\x85\x5f\x95\x3f\xdb\x34\xb6\xbc\x5a\xc7\xa0\x0a\xc7\xcb\x17\x89\x0c\xc7\x05\xbc\xa3\xdf\x68\xfb\xfb\x8e\x54\x97\x11\xa7\x91\xa7\xf7\x1f\x9e\x87\x27\xe9\xf1\xc9\x83\x0f\x1b\x56\x04\x37\xbb\x2c\x06\xea\x93\x34\x39\xbb\xf4\xb1\x8c\x11\xe5\xeb\x8c\x62\x09\x3c\x29\x1e\xe6\xcd\x99\xc6\x49\x58\x64\xa6\x20\x2d\x02\x4f\xd9\x92\xc6\x53\xf7\xbf\x6e\x16\x03\x5d\x95\x63\x08\x08\x82\x25\xfa\xca\xed\x81\x87\x64\xd3\xce\xa3\x5b\x91\x36\xd3\x83\x09\x5d\x63\x23\x52\x65\xb3\x82\xf3\xb7\xdc\x37\x5a\x74\x68\x84\xef\x27\x03\x67\xc8\xd4\x72\x68\x5c\x44\x2b\xd8\x9b\x6b\x28\xf5\x4c\x9e\x7d\xef\xfc\x22\x24\xe0\xaf\x20\xd7\x81\x8c\xeb\xc7\x3c\x31\x29\x5c\x6e\xb2\x4a\xac\x51\xef\xa5\x96\xed\x87\x9b\xf4\x66\x42\x4c\x92\xe8\xe9\x7e\xba\x2e\xbd\x2f\x17\xef\x3e\xed\x36\x0e\x37\x43\x99\x9a\x10\x86\xd2\xed\x68\x66\x8d\x2c\xcd\x95\x68\x5c\x2a\x26\x55\xb8\xbc\x88\xcc\xa8\xa5\xeb\x12\xd8\xba\xe2\x74\xfc\xf3\xe4\x5b\xfb\x8c\xec\xcf\xf1\x6d\xfb\xe5\x28\x2a\xdc\xb0\x6d\xff\x8a\x57\xf6\xec\xc3\xfb\xdb\x3f\xdf\x5c\xdf\x29\xd7\x26\xd2\xa7\x41\x62\x5d\x02\x41\x4f\x68\x64\x09\x30\x4d\xb6\x56\x5e\xe5\xcd\xea\x60\xba\xa8\x4d\x41\x5d\xb3\x8e\x5a\xd6\x25\x89\x81\x33\x34\xb3\x1f\x76\x6e\xd9\xad\xe0\xed\x0e\x08\xca\xef\xa9\xe9\x97\xa2\x89\x85\xd1\x14\xbc\x99\xe2\xb0\xb3\x3f\x11\xcc\x90\x3e\x7f\xce\x63\x8a\x0a\x56\x2f\x87\xce\x4f\x1f\xac\x0d\x56\xc6\x3a\x0b\xfd\xbf\x4f\xdc\xff\xfb\x25\xf6\xa0\x8e\x68\xc1\xf8\x54\xb4\x84\xed\x41\x57\xe6\x99\x3b\xc4\x9d\x15\x7c\xf9\x32\xfc\xe5\x35\xff\x82\xa1\xbe\xfc\x43\xf8\xab\x3b\x64\x6e\x06\xf8\x4b\xbe\xec\x2b\xb7\x7e\xd9\xa7\x31\xe9\xfd\x69\xe4\x91\x25\xb4\xb7\xb7\x55\xb1\x41\x95\xd9\x4d\x3b\x8a\x4d\xbb\x76\x54\x92\xed\x12\xe5\x99\x47\xc9\xc3\x47\x47\x98\x4a\xb4\xbb\x48\xa8\x5e\xac\x11\x7b\x5f\x05\x77\x94\x60\x44\x87\xb4\xd9\x0d\x47\xe4\x26\x08\x13\x63\x74\xd3\x60\xe9\xfb\xa8\x84\x56\x33\x16\xe6\x95\x3d\x72\x87\x08\xcf\x3b\xfe\x95\xc7\x44\xdc\x71\xbf\xa0\x00\xed\x8f\x16\x6e\xa4\x76\xdf\x30\x93\x80\x3a\x51\xac\x73\x42\x2b\xe8\x8f\x28\x89\x41\x43\xf3\x99\xf2\x5b\xb5\x2c\x7a\x47\xe9\x3b\x8e\x40\x1c\x20\xb7\x31\x39\x40\xbf\xd6\x49\xa8\x65\x9a\x29\xcc\xd7\x28\xb3\xe8\xa8\x58\x4b\x96\x8b\x15\x67\x46\x61\x34\xbe\xbe\x5e\xff\xce\xe3\x96\xc3\x43\x61\xf1\x96\xcd\x48\x29\x6c\x8c\x9f\xc6\xa7\xe0\x0d\x60\x7c\xd4\xee\x0e\x2c\xa2\x34\x33\x09\x51\xef\xcc\xbd\x9f\x42\xf4\x33\x7c\x9d\xbe\x25\x80\x2f\x5d\x0a\xf6\xf6\x03\x20\x99\x0c\xd6\xc2\x2c\x13\x90\xef\xbd\x2e\x0d\x0b\x58\x08\x44\x49\x10\x01\x5a\x9f\x8e\xbf\xbc\xa9\xef\xbc\xee\x0e\x90\x65\x9d\x31\x28\x4d\xe6\x98\xc6\xeb\x4e\x36\x57\x96\x51\xb4\x89\xfc\x5d\x45\x9a\x44\x9e\xde\xc7\xa2\xd6\x1c\x17\x9f\x3f\xdd\x1d\x18\xe5\x49\xd7\xfb\x4d\xa6\x54\xf2\x2e\x86\x01\x2b\xcf\x0d\x58\x92\xfc\xa4\x78\x70\x57\x0b\xe4\x5c\xdb\x29\x84\xb8\x75\xc1\x3d\x35\x79\x6e\x93\x65\xc4\x1d\xcf\x03\xfe\x06\x4b\xa9\x3f\x65\x2a\x1a\xc3\xb7\xbc\xb0\xd7\x2c\x9c\xb9\xa9\xc2\x09\xdc\xd7\x40\xd0\x2a\x1e\xd7\x9e\x2a\x70\x44\x74\x85\x08\x06\x8d\x46\xe5\x61\x53\x37\xdb\x75\xa0\x42\xf7\xee\x94\x31\x9b\xeb\x7c\xc6\x63\x19\xdf\x53\x13\x7e\x9f\x25\xa2\xa6\xcd\xbe\xe2\xa9\x29\x72\x9e\x31\x27\x5f\xec\x56\x33\x21\x65\x6e\x34\x84\x60\xd1\xd8\xb1\xbb\xcf\xf5\x30\xb4\xd2\x61\x7f\x58\x67\x8d\xcc\x8f\xf4\x53\x1f\x70\x10\x5d\xc3\xc4\xb8\x17\x84\xee\xd5\xdd\x7d\xb1\xba\x7a\x3d\x66\xd0\xaa\x3c\xc6\x8c\x54\xdd\xe4\xe8\xa5\x56\x44\x7e\x7e\xd2\x86\xe8\x1d\xc6\x58\x90\xf0\x55\x34\xed\xd6\x72\xbd\xa7\x37\x21\x7a\xdb\xd4\x80\x44\x8f\x91\xee\x7a\x77\x84\x5c\xb0\xd1\x3b\x02\x48\xbb\xa1\xa1\xc4\x86\x2a\xd4\xcf\x12\x9e\x56\x18\xaa\x84\x97\x39\x6f\x08\x52\x33\x17\x22\xdb\xa2\xde\xed\xf6\xf8\xdf\xf3\xfb\xbc\x44\x65\x7f\x09\xe5\x17\x4d\xaa\xba\xf5\x3d\x0d\xc9\x98\x07\x6d\x70\xbf\x52\xf4\xf8\x77\x4f\x3d\xff\x68\x92\x1a\x50\x19\x53\x76\xec\x8c\x40\x5d\xa4\xc6\x51\x5f\x19\xbd\xcb\x2f\xc0\xc5\xbb\x97\x82\xe0\x62\x01\x77\xeb\xf7\xe9\x33\x0a\x3a\xa7\x45\xb3\x7b\x5c\xbd\xa3\xd3\x62\xf4\x80\xc6\x22\x5f\x83\x93\x8e\xd9\x34\xba\xd0\x55\x79\xba\xb1\xe8\x53\x71\x33\x3e\xba\x05\x2d\x61\x69\x3c\xbe\x42\xbe\xcf\xdc\x90\x77\x70\xef\x9e\x46\xe5\xea\x8c\x02\xbd\x5f\x28\xd4\xaa\xc3\x04\xb8\xed\xb1\xb4\xa4\x89\x54\xf1\xb9\xbd\x97\x68\xc0\xbf\xb6\xb4\x3c\x17\xd4\x60\x32\x6c\x93\xc9\x66\x95\x56\xe5\xe7\x0b\xf0\xb0\xeb\x09\x6d\xd2\x6f\xe8\x97\xb9\x67\x2b\xf9\x10\x4b\xd9\xc8\x36\xe6\x58\x2f\x9e\xdf\x09\x6a\x6b\x56\xf4\x04\x00\xb2\x62\xc2\x7f\x71\xf0\xba\xc0\xc7\x0b\x6e\xd6\xf5\x3c\x44\xa5\x33\xed\x29\xf0\x59\xfe\x21\x83\x40\x44\x87\xf5\xeb\x82\x24\x02\xb3\x00\xef\x16\xef\x8e\x89\xe4\x86\x24\x83\xed\x32\xcd\x25\x84\x4e\xe5\x4f\xd9\x9d\xfc\x6e\x54\xc2\xea\x7b\x8c\xfe\xb6\x71\x5e\xe1\x28\xdb\x79\xfb\x14\xb6\x53\xcb\x04\x64\xaa\x34\x2c\xcc\x83\x21\x98\xd7\xaa\x13\x67\x93\x1e\xe4\xe3\x7e\x5d\x6b\xdb\x8b\x35\x0c\x67\x98\x38\x03\xe0\x6f\x21\xad\x38\x27\xc0\x38\x95\xc6\xb1\x80\x9c\x35\xd3\xe1\xc6\xe4\xc4\x6e\x83\xa1\x7e\x72\xfb\xde\xb7\xef\xbd\x7c\x3d\xf9\x9c\xeb\x36\x24\x89\x37\xb5\x5b\xf7\x05\xb6\x48\xb7\x6a\xbb\x33\xdc\xb1\x1a\xd5\x05\x88\xf0\x39\xce\x08\x65\x6c\x35\xdc\x23\xf8\xe2\x5e\xe2\xe8\xf5\xe7\x5f\x7f\xf3\xff\x8e\x5d\x4d\x7f\x71\xd3\x31\x2a\xe5\x79\x19\xaf\xed\xc3\xe9\xd5\xcf\x18\xc8\x5e\xe7\x69\xb7\x3b\xfd\xe8\x02\xba\xb0\x71\x1d\xee\xa5\xb3\xdd\xce\xf3\x22\xfe\x03\x0b\x48\xe4\x29\xcd\xda\xee\xc6\x31\xc6\xae\x45\xcf\xf2\x34\x3b\x7a\xfc\x9a\xbb\xa5\xfa\x70\x95\x3b\x67\x3a\x5a\xf4\x0a\xf9\x44\xa6\x68\x88\x7a\x2c\xed\xeb\x1e\xef\x02\x2e\x1c\xe1\xd5\x87\xb0\xef\x63\x85\x07\xba\xe4\x19\xb5\x3b\xda\xea\xd2\x77\x25\x7d\x91\x30\x6d\xf8\x5e\x25\xc0\x76\xdc\xb0\xb7\x40\xf3\x47\x7e\xe8\x94\x4d\x9e\xd2\xc4\x20\x8d\xa9\x6c\xf3\x65\xe1\x41\x1a\x38\xf0\x72\xdb\x9d\x18\x73\x05\xbe\xf3\xf7\xd4\x26\x96\xec\x4f\x25\x17\x89\x7e\x40\x64\xe1\xbe\x31\xd6\x32\x76\xa7\x98\xa8\x54\x36\xb9\x2b\x50\x73\xee\xb0\x02\xe9\x38\xe5\x74\xb6\x09\xa4\x03\xe3\x4b\xed\x78\xa0\xf3\x92\x54\xeb\xa0\x8a\x22\xd5\xee\x29\x67\xeb\x0e\xcf\x6d\x26\x06\x83\x97\xde\x3e\x52\x17\x44\xaf\xee\xa5\x87\xb0\x8d\x72\x91\xd0\x8e\xf6\xbe\x68\xe6\x65\x3e\xaa\xda\x78\x89\x86\xdf\xcb\x71\xee\xd1\xba\x46\x37\x18\x1d\x10\xce\xfa\xfd\x82\xce\x45\x5b\x94\xd6\x6e\x56\x34\x6e\xa5\x0c\x8a\x86\x3f\x4c\xb3\xf8\x94\x3b\xde\x45\xcb\xfa\xd6\x26\x1f\x5e\x8f\x37\x14\xe7\x2a\x27\x29\xf6\x7f\xea\xa9\xa5\x77\xec\x4c\x38\x10\xfa\xda\xc4\x9a\x6b\x5a\x58\x19\x72\xc0\xd2\x8e\x9d\x33\xe5\x94\x3f\x2b\x03\x50\xb3\xaf\x72\xd3\x7c\x40\xf9\xa1\xa1\x19\xe0\x9a\xb7\xbb\x13\xb1\xdd\x7a\xe8\xbc\x50\x08\x95\xba\xc3\x4c\x76\x99\xa0\xc3\xd3\x1a\x68\x41\xc5\x4e\xed\x4c\x4f\xcd\x6e\x77\xd8\x4a\x17\x41\x80\x30\x3c\x77\x8f\x5a\x82\xf7\x29\x83\x48\x77\x6e\xbe\x10\xfa\x5c\x73\x84\xb6\x85\x78\x86\xb4\x7e\x0b\x17\x7b\x2a\x88\x98\xcb\x59\x85\x00\xdb\x38\x27\x65\x8c\x31\x68\x9a\xac\x78\xc3\x49\x8f\x15\xc9\x59\xbc\x76\x0c\x01\xa4\xbf\xa8\xec\x54\x75\x1b\xdc\x52\x16\x72\x9a\x64\x12\x5d\x08\xd6\x53\x15\xed\xa5\xfe\x76\x54\x99\x34\x61\xf7\xae\xa0\x14\xdf\x43\x4f\xf2\x4e\x19\xc1\x8c\x7c\x5b\x88\x6f\x13\xbf\xf6\xfd\xda\xf9\x2c\xed\x26\x4b\x0b\xcc\x97\x3c\x1f\xda\x84\xbb\xb4\x4e\x11\x0a\xa7\x0d\x5c\x89\x74\x3d\x92\x16\x0b\x9b\x81\xb3\x37\x3c\xfe\xd3\x48\x2e\x0b\x5c\xc6\x80\x7d\x2f\x89\xb4\x13\x34\x38\x4d\x06\xf6\x58\x9b\x7b\x41\xc9\x31\x19\xcc\x63\x81\x6c\x08\x4f\xdd\x1b\x98\x8b\x0c\x4e\x26\x76\xdb\xba\x77\xa1\x30\xb8\x15\xe5\x11\x1d\x2b\xf7\x4d\x1b\x65\xa5\xa6\xc6\xfb\x20\x41\x71\x77\x01\x6a\xc6\x09\xe9\x41\xac\x41\x1e\x42\xe8\xbb\xf1\xd2\x52\xf6\xec\x54\xa4\x53\x07\xd3\xab\xf7\xb6\xd6\x04\x71\xe6\x2c\x79\xc2\xaa\xba\xf9\x1d\x69\x96\x81\x76\x75\x2c\x2e\xe6\xdc\xa1\xf4\x3f\xb2\x66\x14\xb9\xdb\x0f\x17\x9c\x48\xe3\x32\xb7\xab\x7c\x13\x81\x5a\x9a\xbc\x2c\xfe\xb1\xcf\x9a\x43\x57\x6d\xbe\x6e\x30\x3a\xc6\x66\xde\x71\x18\x96\x7c\x7d\xbc\xb0\xeb\x89\x74\x90\x10\x54\xaa\xac\x9a\xb5\x09\x64\xce\x70\xb8\x48\x75\xce\x06\x30\x93\xfd\xc2\xe8\xf7\xa5\x34\x78\x89\x77\x88\x46\x8d\xc0\x7a\x1f\x9e\x42\x7d\x95\x45\x8f\x68\xbc\xdf\x43\x6b\xb2\xab\xe1\xfa\x45\xb5\xce\xc8\xa9\x99\x5c\x33\xd0\x16\x3a\x9f\x32\xaa\xff\xc7\x65\x4b\x85\x9c\x53\xb0\x3e\x4f\x58\x63\xe5\x1a\x4c\x6e\x51\x04\xf3\x6f\x9e\x33\xd1\x24\x2d\xb1\x45\x51\x37\xa7\x04\x98\xd2\xbf\xb0\x97\x3d\x82\xe6\x64\x5a\xd3\x2f\x68\x66\xdd\xbe\x62\x22\x33\x8c\xdc\xb2\xef\x96\x94\xde\x7b\x38\x5e\xf4\x18\x8a\x62\x17\x0c\x9b\xca\x9d\xf1\x12\xda\x0d\xcc\xb7\xf1\x4c\xa6\x76\x91\x80\x27\x6f\x58\x50\xda\x39\xcf\x6d\x6b\x52\xf7\x6a\xba\xac\xe2\x54\x17\xc0\x11\xbb\x0b\x8c\x71\xa5\xf5\x4a\xc9\x65\xaa\x83\xbf\x50\x00\x16\x26\x39\xf6\xbe\x6b\xf0\x2c\x9c\xb7\xaa\x8e\x49\x4c\xae\x99\x1d\x37\x79\xb4\x4f\x24\x09\xc0\x63\xad\xa8\xf6\xa6\xfd\x6a\x76\xd8\x26\x4a\xb8\x48\x89\x98\x4d\xbe\x39\xaa\xa3\x3e\x7c\x32\x05\xbd\x73\xa6\xac\xc3\xcb\x11\x06\x64\x2e\xf0\xa4\xe5\x20\xd0\xb3\x26\x7f\xe6\x6f\x2c\x94\x76\x07\xa6\xf3\x71\x16\xbd\x05\xa5\x77\x53\x64\x54\x47\xa5\x5c\x54\x03\x39\x80\x7c\xb9\x14\x99\xd1\x30\xd5\xc5\x2e\x70\x2b\x7a\x5f\xbd\xfb\x3e\x3c\x80\x1a\xfb\xe8\xd5\x1b\xc9\x60\x9c\x7a\xb7\x3f\xa2\x2c\x8f\x4b\x86\x85\x11\xd5\x67\xe5\x51\x79\x47\x8d\x99\x0a\x21\x27\x6d\x26\x2b\x94\x71\xd8\xed\xe9\xbc\xa5\xb9\x74\x20\xa2\xf5\xd8\xed\x96\xa0\xb3\x1c\x3e\xae\xbb\xbe\xc7\x9a\x1d\x84\x02\x3e\x61\x41\x41\x82\xb4\xb2\x58\x51\xe2\xd3\x1e\xb5\x99\x7c\xe7\xec\x85\xf3\x74\x56\x39\xf9\x3e\x44\xe1\x59\x66\x22\xbc\xcf\x79\xfd\xff\xb1\xf7\x6e\x4b\x8e\x23\x47\xb6\xe8\xaf\xf0\xad\x2b\x4d\xcc\xb2\xaa\xac\x4b\x57\xeb\x61\x9b\x95\xfa\xa6\xb6\x3d\x7d\x39\xaa\xd2\xc8\xf4\x08\x12\x60\x26\x94\x24\x40\x01\x60\x66\xb3\x9f\xf6\xb7\x9c\x4f\x3b\x5f\x72\x62\x2d\x77\x8f\x0b\x00\x92\x60\x5e\x5a\x9a\x3d\xf3\x30\x9a\xae\x24\x09\x04\x02\x11\x1e\x7e\x59\xbe\x16\x1b\x30\xff\x1e\xae\x86\x9b\x29\xdd\xa4\xdf\xf9\x28\xb2\xd0\xa0\xb9\xe5\xa9\x79\xec\x1f\x92\x1c\x06\x25\x06\x91\xd0\xe3\x97\x68\xf8\x43\x5b\xe1\x17\x9d\xf6\xbf\x7b\xad\xc5\xe4\x5a\x47\x67\xf9\x47\xe7\x80\x51\x6d\x53\xde\x78\xf0\x1f\xa2\xb9\xe6\xfa\xa1\xf2\xa7\x89\x39\x07\xfa\x41\x6f\x27\xe5\xd8\x24\x7f\xf0\x7d\x25\xe5\xb1\xd6\x37\x20\xa6\xf6\x89\x86\xc7\x14\x0a\x11\xf2\x1f\x27\x7f\x13\xa3\xaa\x23\x93\xb3\x39\x7a\xfb\xe3\x81\xb4\x98\xa4\x30\x4e\xa0\xea\xc5\x1c\x59\xa9\xdf\x0f\xbc\x0f\x60\xea\x19\x89\x79\x6c\xde\xec\x4a\xfd\xc9\x51\xf4\x2e\xcd\x95\xbd\xb9\x70\xcb\x1c\xce\xec\xbd\x1d\x0d\x5f\x98\x48\x4c\x5f\x87\xbb\x2c\x22\x3a\xda\x93\xd6\x21\xe6\x1b\x95\x05\x44\xbb\x63\xba\x81\xdf\x7c\xdd\xdf\xd0\xba\xa0\x99\xf8\x3d\x73\x0e\x06\xbd\x44\x6e\xac\x27\xba\x1d\x7a\xd7\x8f\x7a\xfd\x67\xf1\x39\x1a\xfa\x63\xed\xc4\x8c\xc4\x0e\x23\x6c\xb4\x67\x9f\xb6\xde\x28\x3d\xea\x09\xab\x08\xe1\xfe\x58\xaa\x26\xe2\x16\x08\x3f\x42\x84\x14\x40\x66\x91\xb9\x0a\x33\x54\x76\xe1\x59\x49\xe7\x59\xa5\x6d\xce\xf6\xee\xcb\x76\x60\x4b\xbd\x8e\x4e\xca\x71\xd0\x83\x98\xf0\xd7\x3d\xfc\xb7\x8c\x4f\xa6\xcf\xc6\xea\x2c\xcf\x5d\xe1\xc9\x93\xa3\xb7\x93\xbc\x96\x14\x9b\x82\xc5\xf4\xa0\xf6\xac\xf1\x77\x36\xdd\x55\xfd\xb1\x74\x7b\x74\x24\x81\xf2\xfa\x77\xa8\x30\x3d\x31\x81\xcb\xe3\xf3\xe8\x9a\xdd\x70\xfb\xd5\xf9\x16\xd7\x1a\x54\xe7\x2c\xc2\xf4\xd5\xe3\x15\x25\x8d\x0f\xd1\x21\xd1\x0f\xd5\xcf\xc0\x87\x7e\x94\x8b\xb8\x15\xbe\x86\x98\xcb\xdb\x3f\xbc\xd6\x24\xbc\x16\x6a\x74\xa5\xc5\xba\xb9\x32\x24\x64\x5a\x04\xa2\x0a\xd1\xe9\xdd\x5a\xa7\x6b\xbd\x8f\x0f\x77\xab\x5a\x93\x02\xcf\x5d\x8a\xdd\x1c\xbd\x7e\xd7\x16\x21\x60\xb6\xfe\xd7\x28\xdd\x69\xea\x8b\x33\xdf\x72\x68\x34\x10\x9c\x93\x31\xa4\xf3\xb8\xcc\x9d\x9b\xb5\x69\xc9\x7e\x2c\x79\xe7\x1c\xdf\x3c\x80\xd6\xf6\x41\x64\x1f\x6f\xa6\x8a\xdb\x49\x5f\xb5\x82\xfb\x6e\xdc\xe7\x7b\xf7\xbd\x05\x5f\x25\x74\xcd\x5d\xdc\xea\xa6\x79\x90\x48\x7c\x68\x1e\x71\x5a\xec\x96\x92\x83\x9c\x51\x39\xc0\x66\x2a\x37\x02\xc4\x84\x4c\x63\xdb\x62\x25\xeb\xba\x8f\x70\x18\xba\x09\xe6\xd1\x97\x0c\xc5\xb3\xeb\x24\x4f\x48\xd3\x0c\x56\xaf\xf2\x9a\xfc\x19\xb1\xae\xf3\x06\x1b\xe2\x34\xbb\x66\x66\x59\x45\x29\x85\x8d\x42\xfc\x19\x38\xf4\x4e\x0c\x6e\x36\x9e\xd3\xd9\xad\x30\x35\xc9\x18\x93\x9e\x5b\x17\x30\x5c\xbd\x23\x03\x58\x2b\x75\x72\x71\xc6\x8d\x76\x43\x0b\xa3\x79\x81\x43\x81\x79\x4f\x66\x86\x03\x30\x27\x00\xa6\x5e\x3a\x7b\x55\x49\xef\x78\x5e\xb8\x87\xdf\x10\xdc\xad\x49\xc1\xdd\xc6\x80\xaf\x5e\x70\xdb\xa6\x24\x91\xdc\xd6\x1b\x69\x5f\xb1\x8c\x77\xc2\xc1\x82\xb3\x28\xbe\x0b\x38\x95\x1a\xb2\x89\xfa\xcc\x1f\x76\x8d\x2f\xfc\x68\xca\x56\x67\x5c\x7a\xdb\x97\x6c\xb5\x88\xe6\xc9\xe3\x7b\x05\xf7\xcb\xc2\x51\x17\xe8\xbd\xee\xea\xf5\x4e\x8b\x0b\xed\xb6\xbe\x2d\xfa\x10\x2a\xad\x28\xb5\x85\x0a\x76\xcb\x08\x34\x91\x61\xa4\xc8\xd8\x0b\x75\x7d\xdb\xb2\xb1\xd4\x7f\xe9\xc5\x2a\x66\xb4\x13\xbe\x3d\xfe\x98\xc8\x49\x17\x4b\xec\x40\x23\xc1\xbf\x8f\x3e\x9e\x54\xda\xdb\x5a\x67\x9b\x2f\x57\x53\xcd\xac\xfa\x6c\x62\x56\x0f\x83\x8f\xf1\x12\x2f\x67\xa7\xb3\x73\xc4\x61\x25\x87\xf9\x2c\x23\x09\x54\xd9\xd9\xfb\x93\xaa\xbf\x5f\x6f\x75\x63\x6b\x49\x5b\xbc\x65\xc6\xdd\x80\xba\xe4\x9b\xdc\x2d\x3e\x11\x6e\x0b\x44\x5e\xe6\xc9\x26\x7e\xf9\x36\xeb\xc7\xf1\x52\x08\xd2\xec\xd7\xc8\xe1\x67\x6b\x55\x66\xf7\x84\x85\x51\x67\x6f\xbc\xf2\x07\x72\xe7\x77\x65\xbb\xd3\x5f\x2b\x01\x69\x24\xb0\x2e\x30\x3b\x0c\xb4\x0f\x47\xab\xe3\x45\xd1\xe3\x2a\x94\x78\x72\xb7\xce\xd9\xf2\x08\x26\xba\xe8\x65\xbb\x37\x25\x5b\x57\x4c\x48\x0c\xaf\x48\x84\xd8\x46\x6f\x44\xa8\x99\x98\x1e\xad\xe6\x2c\xf0\xae\x84\xca\x68\xf8\xc3\xb2\x9b\xee\x72\x1d\xd1\xb1\x79\xf5\x24\xdd\xba\x0f\x67\x66\x99\x46\x26\xad\x55\xa4\x93\x4d\xeb\x0c\x5b\xe0\x4a\x6d\x8b\x05\x35\xeb\x59\x10\xdb\x2c\x76\x85\xb8\x23\x2c\xca\x70\x03\x4a\xc0\xad\x5b\x9b\x4c\x20\xeb\x56\x56\x5e\xda\xe1\x9b\x48\xfd\xf2\xe7\x81\xe1\xd7\xdf\x85\x29\x83\x46\x9c\x37\xe9\x5d\xbd\xd9\x89\x92\x41\x69\xac\xbd\x60\x93\x44\x6b\x2e\x0d\x0e\x3a\x7a\x59\x87\x10\x33\x23\x7c\x1e\x3d\xd5\x86\x64\x5c\xc6\x88\xee\x8b\x4a\x72\xe7\x79\xcc\x97\x0e\x23\x9f\xe7\x96\xbb\x8c\x23\xbd\x4c\x78\xf5\x3d\x20\x62\x3e\xab\xea\x2e\xea\x0f\x46\xae\x70\x6e\x21\x5f\xac\x3f\x71\x4c\xb9\x78\x04\xe0\x53\xfc\x13\xbb\x0d\x49\xc1\xfc\xfd\xec\x0f\x13\x06\xf2\xd2\xb9\x78\x51\xab\x6f\x74\xac\x60\x1f\x10\xab\xa1\x66\x9f\x7a\x31\x53\x48\x94\xc7\x69\xe6\x7a\x44\x70\xce\xaa\x92\xaa\x99\x5b\xd4\x5e\xa2\x52\x49\x28\x01\x09\x45\xd0\xba\xdf\x57\xa2\xef\xa8\x72\xb2\x9b\x53\xf9\x74\xb2\xd7\xf9\x37\xe2\x56\x1f\x4e\xdf\xf3\x4c\x3b\xfe\xfc\xb6\x90\x41\xdc\x65\x7f\x70\x66\x21\xa9\x35\x4f\xc1\x81\x0e\x04\xa1\x8e\x22\xe8\xb4\x11\xdb\x52\xa3\xea\x9c\xd8\x9f\x75\x6b\xff\xc1\xe6\x0a\xe5\x84\xa4\xd6\x57\x79\xfd\x35\xfe\xe1\x5f\x53\x7f\x92\xb1\x85\xe2\x93\x1b\xe5\x1f\xae\x22\xaf\x81\x99\xc1\xa9\xb2\xb9\x63\x17\x7b\x33\xed\x24\xfa\x87\xbb\xd4\x0f\x98\x8c\x49\xd8\x89\x1f\x9e\x01\x5b\x1a\x5a\x99\xd6\x45\xb1\x2c\x9e\xa9\xd3\xe0\x69\x34\x3c\x92\xec\x00\x94\x21\x33\x39\x23\x22\x2c\x4f\x16\x51\x80\xa4\xd5\x36\xd3\xe4\xd8\xba\x77\xec\xfe\x5a\x99\xb6\xd8\x90\xc8\x25\xf0\xae\x50\x19\x46\xb8\x9e\x05\xae\x19\x23\xae\x9c\xbb\x7c\x42\x44\x60\x76\x18\x70\x45\x0e\x55\xf7\xfd\x0d\xe9\xf4\x8c\x86\x05\x54\x9b\x73\xe5\x2b\xa4\x76\x11\x71\x38\x73\x28\xa8\xaf\xd7\x9a\xe7\x44\xd2\x0d\xb5\x63\x12\xa6\x6d\xb7\x4d\xbd\x6d\x4a\xce\x48\x1d\xf1\x6d\xe7\xc5\xb6\x24\x67\x54\x54\xe8\xd2\x99\x6b\x77\x00\xbe\x96\xc8\xc9\xdf\x14\x59\x27\x38\xa7\x75\x1e\xea\xd4\xb2\x3b\x29\x6b\x40\xe3\x4d\xd2\x7a\x37\x5f\x64\x0f\xac\x99\x22\x71\x77\x91\x56\x21\x6b\x0f\x6f\x94\x5e\xad\x3f\xff\x99\x0c\x5d\x85\x77\xd9\x2d\xdb\xba\x17\x9c\x7c\x71\xf6\x42\xa3\x86\xae\xa9\xaf\xd7\x75\xbe\xef\x98\xe7\x03\x41\xe5\xcd\x51\xd6\xdb\x8f\x69\x63\x45\xd6\xf4\x66\x5f\x3d\x12\x77\x84\xed\x5a\xdf\x00\x72\x08\x43\x96\x19\x56\xac\xb4\x7c\x8f\xa0\xb2\x6a\xa9\x10\x08\x4e\x05\x6f\xbc\x97\x37\x6a\xa3\x1f\x09\xa1\x55\xdc\xc2\x62\x77\xcc\xd6\x12\x59\x58\x00\x43\x9f\xde\x22\x9b\xb8\xfb\xc4\x47\x38\x6c\xce\x94\x3c\x57\xb8\xc3\x38\x49\xb8\xc1\x81\xaa\x68\x47\xa4\xa4\x7a\x7c\x94\xb2\xeb\x8f\x27\x8c\x3c\x0c\x25\x44\x5f\x20\x58\x04\xdf\xd7\x2d\x9d\xb4\x4f\xe5\xa6\x5c\xc3\x47\x88\x78\x19\xc3\x9c\xe9\x14\x0b\xc6\xee\xb6\x88\xda\xcc\x64\x09\x63\xc7\x84\xbf\xc1\x54\xb5\xda\xe6\xe1\xbe\xae\x4e\xa1\xe0\xd0\x88\x39\x71\x21\x09\xc2\x2f\x9d\xc4\x28\x8c\x3c\xb6\x1c\x7e\xb9\xd9\xb7\x02\x6d\x43\x45\x5a\x5f\xad\xf7\x0e\xe5\x39\x81\xa9\xc8\xd6\xc6\x52\xb6\x90\x49\xf3\xeb\xc3\x1d\x69\xfa\x48\x0c\x30\x58\xbc\xc9\x08\x77\x0d\xfc\x98\x7d\xd9\xcf\x5d\x5b\x48\xf0\x17\xd6\x12\xc6\x6a\x59\x03\x5d\x18\x48\x01\x46\xd9\x84\xcc\x08\x26\xa3\xdb\x7b\xa7\x38\xb0\x2c\xc4\xa5\xb2\xd9\x0b\x55\xb0\xbb\xe6\xac\x5c\x80\xaa\xd7\x1d\xaa\x49\x3d\x43\xcf\x2b\x04\xb6\xa8\x64\xfc\x90\xac\x83\xbc\x6c\x9d\x09\xae\xda\xf4\x85\xad\x18\x61\xd9\x78\x7a\xc5\x15\xb3\x69\x31\x77\x68\xa9\xc6\x01\x5e\x5d\x67\x8a\xb3\x28\x5c\x41\xa0\x01\xce\xae\x0b\x25\xe1\x0a\x0b\x37\xe7\x2a\x03\xb4\xac\xd7\x28\xfa\xaf\x11\xb7\x97\xaf\x89\x35\xde\xa5\x89\xb7\xb9\x17\x58\x6c\x9c\xbf\x5e\x36\xaa\x58\xc0\x3c\x57\x38\xf2\xa6\x9d\xe8\x6e\xb9\x7c\x8d\xb9\xfb\x1b\xd7\xfd\xd3\xf7\x8c\xbc\x7f\xe6\x84\xfe\x69\xca\xcf\x51\x8c\xf2\x3d\x16\x1d\x72\xa6\x6e\x7b\x45\x0c\x0e\xf7\x19\x51\xc2\x9e\xf3\xc4\x83\x0d\xd3\xe4\x7c\x6a\x53\x35\x97\x28\x0e\x46\x5b\xfe\x1a\xe1\xf9\xe2\x6e\x12\x63\x3c\x56\xa6\xda\x18\xd3\x28\xc2\x39\xb8\x93\x56\x08\x7c\x4d\x9e\xdb\x85\xf9\x77\x7e\xdc\x86\x00\xed\x4d\xfe\x61\x62\x80\x16\x45\x39\x54\xc0\xa8\x8d\xdc\xbb\xae\x14\x46\xa8\xe0\x61\x49\x86\xb4\xff\x9a\xf5\x6e\x6f\xe2\x0c\xda\xa1\x77\xdd\xcd\x20\xab\xef\xa6\xe5\x8c\x18\xcb\xad\xfe\x3f\xbb\xfb\x0e\x96\xfd\x57\xff\xee\xcb\x5e\x16\xfa\xe8\xd2\x06\x58\x35\x1f\x59\xdb\x2b\xf2\x5d\x46\x54\x3e\xa8\x11\x55\xff\x60\x37\x4a\xc4\xea\xec\xa5\x34\x0d\xcf\x00\x32\x60\x5b\xdd\x5f\xbe\x7a\x15\x2d\xc6\xb9\x33\x49\x77\x65\x1e\xb2\x79\xa6\x7f\xb4\x11\x3e\xa1\xfd\xac\xda\x81\x31\x29\xc5\xb8\x9e\xa8\x6c\x05\x16\x6b\x8c\xdf\xc0\x1c\x21\x1b\x00\x17\x01\xb2\x89\xd2\x46\x03\xdf\x61\xbd\x36\xca\xdd\xd6\xf7\x44\xe9\x0a\xd7\xd3\x9a\x89\x23\x65\xd6\x0d\xce\x9f\xe8\xcc\x3c\x74\x6f\x4c\x58\x56\xb8\xf5\xdf\xea\x26\x7f\x04\xad\xd5\xd1\x00\xfe\x59\x85\xca\x0e\xaf\x30\xd3\x14\x05\xb3\xea\xce\x12\xdd\xd0\x93\x0e\xbc\xd1\xf3\x51\x6b\x78\x1e\x37\x2e\x6d\xdf\xa8\xe9\x7b\x9d\xbf\xfd\xaf\x68\xfa\xde\x9e\x69\xfa\xde\x9e\xc9\xb8\x86\x82\xe6\x59\xa6\xef\xd3\xee\xfa\x1a\x2e\xda\x48\x86\x69\x0c\x78\xfa\xed\x4f\x4f\x69\x00\xa5\x7e\x59\x39\xef\xfb\x0b\x38\xef\x15\xa2\xea\x2c\x10\x69\x67\xc3\xaa\x26\xe3\xb3\xbc\x01\x77\x34\x84\x66\x99\x44\x2f\x8f\xb5\x91\x1a\x00\xed\x77\x0a\xf5\x5b\x99\x4e\xc6\x03\x3b\xa0\xa3\x61\x9c\xd0\xc7\x82\x35\xe9\x82\xc6\x4d\xa9\xe2\xd5\x10\xe0\xab\x44\xd0\x00\xd1\xd2\x7d\x7d\x21\x51\x8f\xe4\xb4\x5c\x5c\x5d\x56\xab\xf5\x8e\x9f\xcb\x36\xea\xee\x8b\xf5\x5d\xf1\xd0\x9d\xe4\x2b\x99\x2c\x23\x21\x58\x63\xdb\x0e\x02\x62\x98\x01\x35\xf6\x5f\xa7\x06\xba\x0f\x42\xa7\xe1\x2d\xc1\x70\xa8\xa1\xbb\xd7\x08\xd1\xde\x3c\xbf\x96\x7c\x0d\x07\x86\x41\x30\x88\xca\x17\xb2\xc9\xaa\x4a\xea\x98\x16\x77\x09\x18\x25\x9e\x2c\x50\x4a\xf3\x04\xc2\x4e\xa8\x2b\xd0\xaf\x21\x6a\xbd\xf5\x48\x71\x1f\xcd\xd4\xa8\xc9\x2d\x94\x6d\x73\x6e\x0c\xd4\xc2\xbd\x59\x0b\x2c\x18\x95\x1c\xf7\x62\xa1\x31\xc1\x26\x2a\xfb\x2e\x29\x95\x13\x68\xf3\xe2\xae\x14\x14\x04\x9e\x16\xb1\x4c\x86\x0a\xe5\xae\xab\x21\x46\x25\x2f\xa5\x72\x06\xc9\x6a\x95\x66\x45\x56\x63\xbc\xab\x43\xd3\x11\xfb\x74\x31\xe6\x4b\xd0\x84\x87\xf0\xa0\x9e\xe4\x0e\x26\xd0\xcd\xd1\xae\x68\xc7\x27\x2c\xe5\x50\xd1\xe8\x65\x51\xb4\x9d\x21\x05\xd5\x30\x26\x6f\x0a\xa7\x7e\xff\x42\xa6\x02\x54\x56\xbb\xc0\x2f\x0e\x50\x7f\x93\x64\x82\xf4\xa9\xfd\x75\xfc\x22\xf7\xec\xbd\x92\x21\xca\x95\x78\xec\xa6\x6e\x18\xbe\x3b\x0b\x31\xc8\xe3\x07\x11\xeb\x9d\x94\xd0\x56\xa5\xb6\xea\x59\x98\x47\x61\xf9\xf6\x56\x9e\x2c\xaf\x27\x21\x85\xe1\x20\x48\xcf\xc1\x7e\x50\x2f\x4c\xca\xe0\x4a\x86\x91\xad\xc3\x43\x44\x14\xb0\xe1\x99\x07\x05\x44\x4d\x0e\xe9\x96\x97\x1a\x0e\x9b\x91\xc4\x3a\xd5\xeb\xbc\x44\x41\xf6\x9a\xa8\x2e\x29\x87\x94\x68\x04\x72\xc6\xcc\xde\x90\x34\xaf\x2c\x8a\xeb\xeb\x8c\x33\xed\x0e\x2e\xab\x1c\x75\x89\x34\x80\x20\x10\x36\x90\x57\x2a\x56\x75\x53\x8c\x62\xba\xfc\xf8\xe5\xdb\xdb\xa2\x01\x63\xce\x24\xd9\x4a\x12\x33\xef\x83\x51\xb1\xf2\x86\x67\x6e\x8b\xd3\x33\x51\x71\x25\x2e\x53\x77\x43\x45\xe0\x04\xac\xf6\xc4\x27\xeb\x97\xdd\xcd\xa5\x9c\x87\xe1\x8c\xed\xb7\x6a\x10\x26\x01\x7d\xa4\x7e\xa2\xbc\x9a\x7d\x98\xf4\xf3\x37\xe3\x3f\x9f\x7d\x35\xe9\xd7\xd9\x6c\x6f\xb6\x36\xc3\x75\xa6\xb9\x8e\xbf\x96\xe4\xa1\x01\xf1\xee\xb7\x59\xc3\x22\x1a\xc4\xa5\xb7\xe3\xa5\xdf\xc7\xea\x38\x4c\x06\x1a\x05\xb9\x31\x36\x6b\xb6\xcb\xdd\x7a\xeb\x05\xdc\xdc\x69\xb8\x7f\x62\x36\x87\x89\x92\xc3\x5e\x70\x55\xb9\x1d\x5a\x34\xae\x14\xa7\xe4\xbd\x4e\x62\xeb\x54\xd6\xe7\x46\x4e\x65\x93\x98\xe3\xbe\xe0\xda\x6f\x9d\xdf\xa1\xe2\x0d\x02\xfa\x10\x64\x45\x46\x39\xc2\x95\xe9\x7e\xc4\x14\x57\xbd\x3b\xbd\x8b\x31\x00\x87\xfa\x05\x46\x19\x1a\xc6\xce\x87\x41\x61\xf7\xea\x30\x7b\x83\x5b\x98\xf0\xd3\xc1\x20\x31\xd2\xee\xe2\x0d\xde\xf1\x34\xb6\x6f\xb1\x1e\x4b\xae\x5a\xf3\xf2\xb2\xd9\xb5\xa6\xcd\xe6\x6f\x18\xf4\x07\xea\x29\xcf\xfa\xc3\x89\x27\x7a\x36\x42\xd8\x7a\xb6\x68\x80\x02\x71\x01\xce\x7c\xfc\x19\x62\xa0\x81\x1f\xfd\xe9\x24\xe7\xc1\xfe\x0b\x77\x7e\x67\x5b\x83\x3b\x48\x8d\xa7\x06\x37\x4e\x7c\x67\xed\x6b\x60\xd3\xf8\x13\xbc\x21\xa1\xdd\x14\xf1\xf6\x90\xed\x8e\x41\x9e\x71\x59\x21\xfa\xca\x60\x7f\x1d\x68\xde\x6f\x8a\xb5\x86\xe5\x23\xf3\x67\x1c\x0d\xb5\xba\xfc\xbf\x67\xa1\xfe\x6a\x22\x69\xc8\x6f\xc3\x42\xe7\x87\x47\x32\x3c\x3c\x26\x33\xf4\xb0\x92\xe6\x51\x0b\xba\x20\x33\xc2\x80\x57\xf1\x60\x30\xa1\x0d\x77\xeb\x6c\xb1\x77\x6e\x12\x3e\x00\x4e\x71\x53\x6e\x45\xb7\xf2\x73\xdc\x50\x24\xba\x35\xe3\x6a\x35\x81\xc1\xa1\x0c\x6b\x5f\xb0\x75\xbf\x15\x67\x48\xc7\x58\xa7\x6a\x28\x67\x44\x38\x7f\xb9\xae\x9e\xdf\xc0\xc9\xd5\x00\x89\x68\xff\x30\xed\x4a\xe6\x36\x25\x74\x28\x92\xd2\x05\x77\x31\xfd\xb0\x32\x68\xdf\xcc\xa3\x81\x86\xf6\xab\xc8\xe5\x91\x53\xb2\xee\xb2\x1d\x5b\xc5\xaf\x6b\x77\x40\x74\x0d\x0a\x90\x1b\x58\x9f\x24\x96\xb0\x8b\x5e\x3c\x46\x5d\xc5\xbd\xb0\x20\x6a\x19\x29\x9e\x21\x53\xb0\x42\xa1\x54\xb5\x19\xa9\x2f\xae\x5a\x68\xb6\x6f\xe7\xf6\x1b\xa3\x5f\xeb\x6f\xe9\x49\xde\x4a\x41\x0c\x9d\x9b\x68\xa2\xd3\x1e\x20\x23\xf1\x20\x02\xdc\x87\xe3\x56\x8e\x53\xea\x8c\x93\x96\x7a\x56\xf6\x43\x51\x7f\x57\x6c\x6d\x57\x10\xe2\x34\xa2\x4e\xc4\x6a\x7d\x54\x93\x76\x27\x10\x45\x4c\xaa\xd0\x79\x26\xcd\x4e\x1b\x37\xa3\x56\xcd\x61\x20\x6b\xa2\xb1\x0c\xc4\xfe\xb9\x2b\xb7\x6c\x4e\x93\xdd\x89\xf2\x63\x10\x15\xe6\xad\x87\x65\xfb\xbf\xb6\xbe\x3a\x64\x35\x5b\x39\x12\xc2\xb8\xc3\xcf\xfb\xcc\x2c\xe8\x1f\xc5\x64\xbf\x9c\xfd\x54\xab\xaa\x8a\xc6\x08\x42\x80\xbb\x2c\xfc\x2e\x37\xb8\x82\x91\x24\xa0\x5f\x85\x35\xc4\xa5\x08\xef\xa5\x8c\x00\xd2\x39\x76\xba\x1f\x6c\x53\x34\xd4\xe8\x48\x9e\x32\x6e\x89\xa3\x7d\xf2\x28\xd0\xb8\x51\x3c\xf0\x63\x69\x94\xfd\xe2\x17\x34\xb6\x6d\xa3\x6a\x64\x1b\xe4\x40\xd0\xe4\x00\x6f\x5d\xcb\xbf\xe1\x32\x33\x11\x27\xce\x55\x80\x2c\xe6\x05\xb0\x04\xb9\x60\x6b\xad\xc9\x97\xd0\xbe\x4c\x80\xb5\xf8\x67\xb9\x09\x42\x71\x8c\x66\xb4\x73\x54\xb5\xa0\xf8\x96\xef\xe3\x87\x2d\x63\xb8\xdc\x6c\xc0\x23\x63\x6f\x11\x43\x16\xe9\xca\xf0\xfe\xc8\x68\xc1\x87\xb2\xe2\x52\xe9\xb5\x9e\x68\xa3\x22\xed\x5f\x62\x27\xfd\x9b\x0a\xbd\x7b\x47\xfb\x08\x9d\x7d\x83\xf6\x91\x16\xae\x43\xaf\x49\x18\x82\xf1\xab\x22\x8f\x22\x8d\x59\xd2\x1d\x28\xe2\x55\x08\xb4\xd9\x6f\xb4\x5b\xda\x21\x90\x99\xae\x16\xdb\xe5\x5a\xe5\x89\x7b\x61\xba\xdf\x50\x83\x8c\x60\x2b\xc1\x2d\x5a\x95\x1e\xdb\x50\x76\x17\xee\x8b\xee\xb1\x5a\x4f\xad\x28\x8d\x31\x07\x58\xcc\x8c\xe2\xf3\xb3\x8d\xfa\x8b\x80\x6a\x49\x86\xf7\xa2\x6e\x44\xad\x65\xb3\xeb\xac\xff\xbf\xe7\x0a\xb5\xbb\x85\x40\x9e\x0f\x0c\xe1\xdd\xab\x63\x23\x70\x47\x0c\x7f\xc5\xa2\x2e\x7e\x88\xae\x76\x74\x22\x57\xc5\x21\xa5\x62\x23\xaf\x76\x97\x08\xaf\x5b\x52\x09\xfa\xd2\x5f\x3e\x90\x22\xcd\xad\x44\x37\x11\x1f\x97\xce\xea\x8d\xcb\x02\x5d\x3d\xb6\x2a\xf6\xd5\x44\xfe\x40\xa3\xc4\x69\x6e\x76\x8b\xac\x59\x08\x8f\xa0\x6c\x67\x00\x64\xa5\x31\x1e\xe7\xe9\xf2\xe6\x69\xa4\x37\x8c\x99\xfa\x81\x6a\x1b\x37\x68\x68\x64\xd6\xe8\xba\x01\xc1\x67\x86\xe9\x43\x9b\x95\x0b\x21\x5a\xa3\xc7\xf3\x32\x7c\x83\xe4\xec\xc2\x99\x81\x4e\x8b\xc5\xed\xb6\xc9\xf6\x92\x92\x2b\x73\x95\x01\x1a\x47\x32\x7b\x16\x12\xef\x11\x1c\x05\xfb\xbe\xcd\xdf\xf2\x9a\x9e\x8d\x3f\xea\xc1\xc4\x20\xae\x7a\x9f\x6b\xd3\x45\x21\x34\xab\x94\xad\xa1\x8a\x12\xa5\xeb\x7e\x0e\xb2\x41\x22\x88\xc1\xe7\xdd\xae\xa5\x7c\x1e\xdd\x5b\x40\x22\xb8\xec\xaa\x1f\x99\x19\xdc\x09\x92\x39\xc1\x98\x60\x28\x6e\x93\x4f\x18\xc4\x73\x96\x6a\xde\x34\xf9\x51\x79\x8d\x17\x94\xcd\xb1\xa1\x0b\x6c\x0e\x95\x81\x73\x4a\x38\x57\xd5\x58\x09\xe7\x28\x80\xd8\xbd\xc3\xa1\xb2\xc1\xdb\xe9\x7b\xfb\x47\xc6\x09\xb3\x1f\x9d\x9d\xab\xdd\x61\x39\x9a\x52\x7a\x12\xc9\x9d\x53\x3b\x1c\x02\x2b\x60\x29\xdb\xad\x57\x3b\x25\x16\xdc\x12\x6a\x94\x91\x4e\x85\x0c\xe0\x2a\x8d\x75\x5c\xa7\xfa\x69\xe4\x3d\x1f\xdc\xf4\x89\xfa\x66\x07\x29\xf0\x8d\x4e\xa9\x17\x27\x10\xda\x8e\xcf\xc8\xea\x92\x05\xcf\x6b\xc7\x94\x92\x12\xac\x1b\xd4\xb7\x68\xbb\x0f\xe5\x60\x06\x8b\x56\xbf\x73\x49\x4b\xd8\xb2\x43\x3e\x54\x87\xe1\x50\x14\xaa\x8c\xe5\x45\x26\x35\xfa\x62\xa3\xd0\xa5\xe7\xf9\xf9\x75\xcb\x0d\x55\x59\xf9\xc9\xb6\xa2\x3e\xc4\x9c\x0a\xf7\xbe\x6e\x2e\x66\x4c\xf8\x3b\xd4\x90\x29\x28\xa6\xb1\xca\xec\x90\xc6\xcf\xe0\xbc\xa1\xb7\x9c\xf2\xdd\x7a\x0b\x9f\x2b\xc3\x8e\xc6\x81\x5b\x56\x81\xf7\x89\x49\xb6\x36\xd2\xfc\x6c\xeb\xb5\xb3\x1f\xea\x4b\xcf\xa3\x91\xe2\x49\xd6\x75\x8e\x26\xb8\x6f\xc7\x24\xc6\xde\x25\x2d\x3a\x32\x68\x39\x33\x47\xae\xf1\x30\x99\x22\xe4\xa5\x56\x2c\x8f\x3c\x6f\x42\xea\xf9\x8a\xcf\x01\xa2\x61\x6b\x58\xd6\x76\x8f\x51\x19\xcb\x64\x72\x39\xfa\xdf\x24\x7d\x23\xab\xf8\xe1\xfd\x52\x07\xba\x73\x27\x11\xbe\x62\xbe\xb8\xae\xe0\x5f\xb6\x17\x4f\x09\x72\xd3\xf8\x74\x42\x8a\x3c\x9a\xf7\xa6\xd8\x3a\xd3\xd3\xb2\x33\xad\xba\x46\x40\x26\xf9\x4d\x67\x00\x10\xf7\x24\x1d\xb6\x7b\xeb\xb5\x88\xf4\x16\xa0\x27\x46\x09\x5c\xf7\xcd\x75\x59\xdd\xce\xb9\x22\xdc\xca\xbe\x2b\x54\x75\x41\xbf\x72\x0b\xa2\x3a\xe7\xeb\xa0\x53\xd6\x0d\x08\xf5\x74\x7a\xf9\x6b\x35\x22\xf7\xce\xce\xa3\x9c\x4b\x2c\x72\xe0\xcb\x1f\x0c\x68\xa8\x57\x3a\x23\xc6\xbd\x2f\x57\xaa\xe5\xb8\xa2\x92\x60\xc7\x58\xc5\xdc\x8f\xdd\xa9\xb0\x8c\x68\xf7\xdc\xa9\xd2\x4c\x50\x31\xec\xad\x55\x0b\x76\x88\x76\xdf\xd2\x7a\x6b\x03\x09\xe9\x34\x63\xf4\x88\x84\x3a\x09\xd1\xfd\x17\x9d\x07\x50\x79\x2a\x35\x99\xd2\xea\x0c\xe6\xf7\x1f\xa5\x4f\xf3\xe1\x0b\xf9\xd1\x3d\x17\x2c\xe1\x46\x14\xc6\xf5\x16\x0c\xdf\x50\xeb\x3e\x4a\x3b\xc9\x72\xcb\x54\xf4\xc5\x93\xb2\xd0\xfe\x9d\x94\x42\x9a\xc8\x40\xb0\xc8\x98\xd1\x1f\x60\xfd\x93\x22\xf8\xe2\x2e\x58\x6e\xb7\x2c\x0c\x5b\x1f\x74\x9c\xd3\x24\xcd\x15\x69\xb6\x82\x8f\x7b\xc1\xcc\x41\xda\xc3\x6c\x01\x3f\x18\x89\xf6\xe2\xd8\xdb\x85\xa5\x67\x15\x97\xf0\x9d\xbd\xee\xe7\x53\xaa\xda\x7d\x43\x6f\x30\x67\x39\x1f\xbd\x46\x5d\x50\x95\xf6\x9a\x16\x3e\x7f\xe2\xa5\x69\x73\x51\x96\x10\x54\xf7\xa2\xd8\xd7\x9a\xcf\x5c\xb0\xb1\xbd\x41\xe0\xa1\x99\x1a\x77\x94\x91\xf9\x48\xb7\x1f\x8a\xdb\x92\x84\x79\x3d\x33\x35\x00\xb7\x00\x90\xe2\x74\xe7\x07\xda\x41\x62\x21\x84\xa0\x91\xe0\x36\xca\x1b\x7f\x1c\xdf\x03\xa1\x18\x29\xf3\x6a\x4a\x22\x70\x3c\x87\x2c\x82\x14\x1f\x84\xad\x72\x20\x62\x66\xd3\xac\xfc\x90\x2b\x17\x7c\x21\x9a\x91\x2a\x1f\x44\x6c\x50\x82\xa7\x7b\x25\x33\x05\xd2\x5b\x40\xe5\xa7\x6d\x3a\xba\x07\x2e\x32\x6c\x36\x4f\x8f\x0f\x7d\x4d\x51\xa9\x67\x83\x87\x4e\x38\x1b\xfe\xb4\xce\x7e\x13\x99\xf5\x05\x0d\x38\xbd\x98\xed\x7a\xb7\xd9\x14\x5d\x4f\xaa\x3b\x03\xa9\xc6\xae\x89\xb2\x1e\xb1\xdf\x37\x5a\x43\x1d\xa8\x41\x66\xb3\xb7\x27\x61\xd0\xf4\x2d\x06\xc4\xd0\x53\xfc\x32\x59\x39\xd2\x7f\xbf\x75\x77\xcd\xdb\xde\x2a\x18\x73\xdd\x5e\xf5\x7c\x37\x46\xc1\xaf\x9e\xa1\xd0\x18\xdf\x3b\x96\x90\x84\xcf\xe5\x79\x8d\x98\xbe\x75\x5b\x6f\x2f\xe9\x00\x22\x08\x0c\x6b\x41\x73\x41\x59\x95\x49\x62\x2f\x32\x94\x76\x54\xb6\x92\xe5\xc5\x83\x02\x97\x67\x89\x5a\x8a\x2b\xe6\x26\xec\x0f\xf8\x9f\x29\x0e\x19\x34\x5b\xdc\xba\xab\x6e\x27\x55\xd5\x9e\x92\x84\xff\x91\x20\xc1\x83\xbb\x68\xbc\xef\x94\xb9\xd8\x3e\xad\xbe\xf7\xaa\x48\x26\xe5\x91\x74\xdb\x76\xbf\xbc\x71\x9e\x81\x6f\x63\x0b\xe8\x65\xe5\x91\x00\x53\x81\x28\x11\x21\x5c\x2a\x44\x1e\xa8\x15\x76\xef\x4c\xda\x5a\x84\x40\xc1\x10\xd9\x1a\x4c\x49\xa2\x39\xd4\xaa\x0c\xc9\xe7\x81\x45\x09\xab\x3e\x9a\x9b\x56\x75\xb9\x46\x87\x7a\x7b\x63\x59\x6a\x82\x3d\x34\x61\xdd\xc4\x2a\x5b\xad\xf4\x57\x81\xbe\x9a\xbc\x70\x8a\x6c\xb4\x81\x47\xc4\x84\x9b\x52\x84\x2f\xdc\xc7\x44\xf4\x96\x15\x7c\x31\x19\xa2\x8b\x1e\x76\x49\x06\x6b\x8a\x61\x66\x12\xfa\x07\xed\x08\x9a\xe4\x14\xfd\xf0\x64\x90\x98\x73\x9b\x50\x1f\xcb\xc9\x7f\xc8\xe5\x79\x04\x09\x77\x6b\x4a\x24\x49\x03\x9e\x77\xfe\x13\x8f\x48\xa8\x52\x0e\x74\x84\x7e\x8e\x1b\xb3\x08\xb9\x13\x69\x86\xd5\x31\x52\x7e\xb1\x25\x67\x92\xe9\xff\x90\x36\x0e\xca\x23\xcc\xb9\xf2\x95\x0f\x86\xce\x16\x87\xac\x7d\x3b\xde\xdd\x62\x5d\x0e\xbf\xdc\x10\x4e\xa2\xf1\x67\x60\x98\x99\x27\x34\x0d\x5f\xb4\xf6\x57\xc8\x24\x53\xff\xbc\xa9\x81\x21\x65\x26\xaa\xd3\x12\x58\xde\xec\x94\x8c\x3e\x6a\xb6\xb4\xbe\x09\xaf\x88\xcc\xcd\xa5\x87\x90\x60\x2a\xd1\xc9\x95\x2d\x18\x5c\xab\x4b\x62\xcb\x3f\x88\xcc\xd7\x8d\x3f\x56\xb5\x35\x11\xa3\xef\x8a\xc3\x9d\x89\xe3\xa8\xc0\x33\xe6\x73\x74\x1d\x5c\xc6\xa2\x5b\x2e\xec\x73\x73\xb0\xd9\xe5\x20\xde\x75\x87\xf8\xb6\x91\xfe\x0f\x6d\x99\x25\x7d\xfb\x0d\xaa\x12\x97\x31\x89\xcb\xa0\x4f\xf9\xdd\x78\x9b\x72\xda\xef\x2b\xef\x96\x12\xb4\xda\x49\xdc\x9f\x69\xed\xde\x33\xf8\xf1\xef\xd2\x48\x79\x7c\x36\x63\x2d\xc4\xe3\x0d\x96\x7e\xf7\x69\x63\xe2\xa0\x81\xf1\xbf\x4e\xdf\xa5\xff\xd0\xa8\x15\x4e\x74\x4f\x1e\x33\xe9\x8d\xe8\xf3\xc0\xca\x0d\x2c\xfa\x97\x13\x2d\x7a\x94\xf5\xed\xa7\xa4\xb1\x98\x1e\xc6\x6d\x23\x10\xd0\x29\x52\x66\x0f\xec\x1a\x90\xf2\xb3\x68\x3a\x69\x1a\x86\x8e\x5a\x80\x49\x82\x6d\x58\x70\x8d\x20\xad\x9a\xdb\x0e\xd1\xad\xc1\x9e\x09\x1e\xde\x2e\xf8\x11\x42\xab\x08\xd8\xde\x22\x6e\x47\x0a\x44\x6e\xa0\xc1\xac\xfc\xe3\x8b\x40\xce\x24\x45\xd7\x08\xb1\x43\xb2\xad\xb9\xf3\x8c\xee\x41\x0c\xf5\x72\xf6\x33\xe4\x42\x9c\x7b\xba\x16\xc2\xb2\x0c\x56\x31\x57\x16\xa5\x85\x16\xc8\x89\x28\x6b\x8b\x0d\x1c\x4a\xb7\x3e\x51\x52\x73\x57\x28\x31\x48\xb7\x18\xee\xf0\xec\x7d\x92\x83\xa0\x6a\xa5\x99\x5f\x17\x3e\xe3\xe7\x7e\xb8\x1f\x9d\x83\xa1\x41\x5e\x74\x1b\x10\x11\x18\x77\xd7\x75\xe3\x7e\x84\x04\x7c\x96\xd7\xf7\x73\xf2\x38\x17\xcb\x72\x8b\xb6\x08\xfb\x86\x7b\xad\x98\xc3\xb5\x0b\xff\xe6\x36\xfa\xe5\xed\x25\x12\xdb\xf7\xd5\xec\x9a\x60\x0c\xfd\x2e\x25\x3a\xe9\xf4\x6c\x6a\x94\x78\xf0\x98\xc7\xf6\xfe\x48\x9b\x79\xe8\x55\x8f\x7a\xe7\x19\x36\x23\x49\x84\x0e\x0b\x19\x44\x9e\x2b\xa1\x11\xc3\x96\x8a\xf8\x8b\x48\x5a\x78\x24\x1a\x76\x5b\xf3\x7a\x47\x1e\x50\x36\xfa\x66\x6b\x7f\x21\xdf\x19\x72\x2a\x40\xf0\x7b\x56\x48\x1b\x80\x80\x12\x5a\xad\xb9\x32\x6d\xb9\xad\x0d\xc3\xb5\xd6\x9e\xe9\x7a\xbd\x72\xff\xa4\xb5\x95\x24\x6d\x4b\x59\x58\xe5\x15\x23\x8b\xea\x12\xb2\xcb\x16\x2a\xb2\x6a\x33\xd0\x2a\x63\x21\xfd\x0e\xaf\x84\x6d\xf2\x17\x29\x4a\xc4\xbd\xf7\x22\x2f\x22\x60\x43\xd4\xbe\x8d\xad\xe0\x56\x01\x38\x7e\x2c\xf9\xe4\x4d\x0f\x46\xe5\x6f\x10\x0e\x2b\xbc\x5b\x2e\xb3\xce\xed\xbc\x0b\x4f\x9a\xe1\x16\xb7\xbb\x41\x1e\x28\xd8\xac\xde\xc2\xdd\x06\x5c\x87\x81\xa9\x42\x61\xf4\xa8\xc2\x47\x20\x11\xe7\x51\xd3\xb8\xe3\x9d\x99\x89\xd1\x2e\xf4\x88\x70\xa0\x4b\x76\x20\x7e\xa7\xda\xb6\xba\xd9\xe6\x04\xfc\xc8\xfe\xb0\x49\xef\x73\x43\xe8\x56\xe5\xbb\x10\xf0\x48\x5f\x87\x38\xc1\xa3\xd8\xcf\xdc\xfd\x0c\xb8\x33\xd6\x3a\x4f\xf6\xa4\x28\xe1\x69\xe7\x69\x74\x98\xea\x85\x26\x1a\xf5\xe6\x20\x5d\xcc\xd5\x23\xbd\xf4\x13\x45\xc6\x07\x3b\xe4\xd6\x40\x4e\xb3\xed\x82\xf1\x0b\x8f\x03\xfb\xba\x76\x5e\xeb\xc5\x53\xf5\x8b\x7d\x26\xc5\x99\x5f\xc6\xf9\x6e\xbb\x2e\x97\x42\x66\xba\x8a\xd0\x5f\xc2\xad\xd1\x2b\x2a\x1e\xe2\x3a\x2a\xe2\xcb\x10\xb6\xcb\xf7\x66\x78\x94\x8d\x33\x55\xcb\x98\x47\x04\x5b\xf9\xa6\x5c\xd1\xa9\xdd\xd6\x6d\xa9\xcd\x57\x6e\x73\x7f\x81\x5d\x00\x2e\x6e\x42\xba\x3a\x49\xa5\xdf\x2a\x90\x48\x1c\x2a\xee\xa8\x58\x14\x6a\x84\xa4\x24\x96\x5f\x1b\x79\xd4\xd3\x1d\x53\x65\x4f\xf1\x5b\xd5\x40\xa8\x97\x40\x2f\xc8\x0b\xb3\x44\x4d\x3b\xfd\x3a\xea\x9c\xc4\x4d\x00\xed\x5c\xbd\x12\x42\x18\x73\xb0\x9c\xe5\xe5\x2e\x8b\x38\xd3\xe0\x24\x21\xa8\xf6\xc2\x23\x55\x60\xe6\x9d\x36\x72\xf5\xad\x95\xae\x11\x6f\x39\xfc\x6e\x1e\x58\xbf\x75\x4c\xef\x43\x39\x8e\x98\xf9\x1b\x0d\xbb\x6c\x40\x5f\xb4\x1e\xc5\x41\xdc\x91\x5d\xea\xa5\x5b\x74\xd8\x93\xf7\xf5\xb1\xcb\x57\xb3\x0f\xe1\xfa\xfa\x13\x82\xb4\xec\x27\x23\xbf\x78\xfd\x3a\xfa\xc9\xb1\x8a\x64\xb8\x0a\x50\x43\x5f\x4b\x7b\x2e\x7b\x6e\xb4\x37\x77\x4c\xc6\x8f\x14\xdb\x36\xd9\x4a\x81\x1c\x8d\x26\x59\xc4\x29\x39\xf5\x2c\xba\x61\x20\x48\xf5\x74\xe3\xc8\x90\x91\x8d\xd5\x2e\x4e\xbb\xcf\x3b\xa0\xc5\xc2\x45\x7e\xe5\x75\x55\xb3\xc3\xdd\x5b\xd7\x28\xef\xa7\x99\x8d\x81\xce\xa0\xb0\x39\x4a\xb2\x3d\x79\x95\x3c\xab\x47\xa8\xb3\xc7\x26\x2a\xb6\xcb\x2e\x00\x1c\xed\xa0\x52\x76\x76\x8f\x6a\x9c\xeb\x1f\xa0\xc6\x42\x8a\x63\xc5\x4c\xea\xd0\x19\x50\xb5\x1a\x23\x59\x8d\x0e\x0d\xee\xfa\xb7\xba\x09\x17\x0c\x8c\xfe\x66\xbe\x29\x3c\xb2\x72\x6f\xab\xa0\xdc\x73\x7a\x80\x0d\x52\x7c\x6e\xdf\x0c\x98\xec\x5e\x4d\x55\x4a\x2c\x5b\xe4\xff\x27\xf1\x8a\x3c\x91\xfd\x7f\x52\xde\xba\x47\x91\x19\x2b\xa9\x4c\x59\x19\x38\x36\x26\xb2\xa5\x71\x93\x7c\x6b\x15\x59\xc7\x7a\xc7\x7c\xac\x16\x71\xd4\x25\x09\x40\x3f\xac\x3a\x36\xed\x92\xe4\x88\xb4\xde\xf2\x8b\xf1\x24\x4d\x28\x49\xea\x20\xa4\x41\x3e\xce\xd3\xe8\x76\xb1\xb4\x4c\x76\xba\x9b\xf5\x88\xc5\xe7\xa9\xc3\xbf\xf5\x9f\xc8\x1a\x99\xcb\x65\xe1\x83\x50\xb4\x8e\xb2\xc3\xea\x96\x48\x5b\x74\x52\x92\x88\x8b\x04\xac\x73\x15\x9b\xa5\x21\x75\x27\x20\xa2\x4f\x92\xd4\x82\xf6\x3f\x4e\xb3\xfc\x3d\x2a\x42\x84\x80\xbd\x9d\x15\x7b\xed\xa6\x21\xb0\x37\xf9\x84\x9c\x68\xa9\xf4\x8e\xc8\x7d\xe7\x3a\xd5\x8c\x72\x09\x73\x1b\x81\xd4\x0c\xa0\x37\xc1\xf7\x6f\xef\x99\x89\xa3\x8f\x19\xb4\x32\x74\xe7\x52\xe9\xcc\xa3\xb1\xc9\x1f\xea\x66\x7d\xe1\xb1\x7c\x91\xb2\x10\xdf\x73\xff\x02\x41\x51\xc8\xa8\x2c\x14\x66\x4a\x2e\x0b\xf2\x62\x17\xd7\x14\xe2\xad\x23\xbd\x88\x48\x6e\x68\x9a\xd7\xd6\xba\x05\xf2\xa9\x2b\xb6\x93\x7c\xb6\xaf\xcf\x26\xad\x38\xe6\xb9\x3d\x09\x96\xd3\x1c\x35\xe5\x94\x74\xdb\xb5\x22\x7d\x5c\x1b\xff\xed\x3f\x0b\x77\x7f\xc2\x76\x8f\x78\x74\x63\x55\xb3\xa6\x2c\x56\x6c\xe0\x90\x69\x15\x33\x2e\x64\xbf\x7b\x20\x22\x15\x5d\x6f\x72\x76\x3d\x75\x4c\xd2\xb3\x0d\x31\xbd\xfd\x96\x9b\x49\x2f\x0a\x67\xea\x7e\xf6\xa3\x0b\x6b\x9a\xfd\x34\xde\xa6\x9f\x7e\x27\x21\xda\x87\x34\x7c\xc6\x10\x97\xc4\xfd\x7e\xa0\xed\x4d\xd4\x3e\x24\xbd\x31\xa2\xed\xb1\xc1\xec\x41\x45\xc3\x6d\xf5\xe2\x48\x0b\xd4\xc9\xa6\xfd\x1f\xa2\xaa\x3b\x0e\xd1\x3e\x5d\x41\xa0\x0a\x8e\x55\x05\xe5\x30\x98\xdc\xdc\x69\x79\x36\x2b\xe4\x2c\xf6\x09\x80\x30\xcd\xcc\xdb\x97\xac\xdb\x8e\x8c\x9f\x99\x5b\x6e\x65\x47\x43\x27\x1c\x10\x3e\x4c\xa4\x99\x89\x6c\x45\x9f\x8e\x5e\x82\x43\x9a\x52\x43\xae\x97\x06\x2d\x26\x1e\xc7\x33\x2b\x96\xe6\xb2\xa6\xfc\xf8\xbe\x4f\xdc\x7b\x3c\x12\xb7\x08\xe0\x37\x10\x32\x87\xba\x91\xbe\x1b\xe9\xc0\x10\x0f\x32\x3f\xdd\x32\xc2\x1b\xf0\xe1\xe5\x4c\x8c\x12\x33\x63\xc5\x29\x6e\x1f\xcd\x7c\x53\xc0\xd0\xa7\x5d\x01\x93\x74\xf7\xaf\x96\x45\x1e\xcb\x34\xe2\xa2\x5e\xda\x2a\x70\x6b\xe0\xcf\x05\xa1\xcb\x89\x32\xad\x67\xe9\xb7\x53\xca\x5d\x73\x93\x61\xcf\x40\x38\x13\x9c\x20\x15\x53\xf0\xeb\x75\x34\x14\x42\x9e\xef\xd8\xa9\x93\x11\xf7\x10\x83\xb9\xb1\x9e\x97\xbe\x25\x88\x23\xa6\xff\x86\x86\x7f\x3c\x9e\xdb\x0d\xf4\xb9\xe9\xd5\xfe\x9a\x89\x8e\xa2\xd0\xae\xcf\x7d\xab\x45\xd7\xf6\x6e\x27\x5f\x68\x7b\x77\xaf\x9b\x50\x73\x09\xdf\x8f\xf2\x77\xfc\xde\x29\x5f\x41\xb4\xe7\x45\x0f\xa1\xee\x3d\x8b\xd1\x56\x20\xf9\x11\x86\x65\x6f\xdd\x5c\x65\xe3\xef\xf3\x95\x85\x4c\xe3\xd2\x94\xc4\xa4\x71\x6f\xa1\xba\xde\x61\x1d\xda\x9e\xb0\x75\x13\xae\x8b\x21\x60\x23\x37\x75\xcd\xb8\x40\x0b\x94\xab\x52\xe4\x04\xb9\x98\xaf\xb3\xad\xaf\x9a\x47\x33\x23\x51\x20\x47\xbc\x4d\xa9\x30\x42\xc0\xa0\xb5\x18\x1f\x02\x2a\x9d\x45\x6e\x4f\x6a\x86\x61\x30\xb0\x81\x72\x90\x4e\xb8\xd0\x39\x30\xa9\x88\x28\x28\x11\x03\x1a\x7d\x3e\x3c\xdc\x0d\x18\x52\xef\x87\xfd\x83\xc7\xe3\x94\xf4\x62\x7b\x9f\x77\xac\x0a\x54\x1a\xdc\xb2\x02\xb8\x46\x36\xd1\x0d\xd1\x38\xde\x60\x8a\x9b\xe6\x9e\x81\x4d\x3e\xcb\x1d\x53\xa2\x26\x71\xa4\x57\xa3\x84\x9b\x3b\x7d\x97\xa6\x94\x18\x1e\xd5\xc8\x43\x91\x94\xd4\xea\x35\x2c\x83\x89\xb1\x89\x9f\x84\x40\x68\x05\x20\x07\xdd\xe5\xda\x14\x18\x92\x21\x87\x60\x48\x85\x44\x04\x0a\x1b\xd6\x2e\xc6\x4d\xb8\x46\x92\xfd\x2a\xaa\x7f\x30\x8a\x74\xbe\xaa\xf9\x5c\x48\xbf\x60\x35\x2c\x91\xda\x2f\x5b\xcb\x65\x60\x2d\xba\x9d\x76\x83\x05\x22\x7e\x60\xe6\x7e\x26\xd5\x45\x58\xdd\x6f\x7e\x54\x66\x90\x1c\x29\xea\x6c\x30\xa5\x5d\x5d\xc3\xda\xc1\xa1\xe3\xf8\x43\xcd\x3c\xeb\xc1\x65\x5a\xa0\x34\x56\xee\x4b\x55\xa7\xc4\x39\xc7\x5f\x9e\xe4\x37\x41\x8b\xd6\xd2\x0c\x5f\x73\xe3\x36\x31\x2b\x97\xc7\x25\xb6\x5e\x3e\x39\x88\xff\x75\x75\x53\x0c\x5e\x0c\xb3\x93\x32\xf4\xf3\xd0\xc9\x07\xb8\xdc\xcf\xe0\x04\x1c\xa6\xd3\x87\x27\xc3\xc0\x6c\xbb\xe9\xbd\x9d\x51\x04\xd0\x08\xeb\x58\xbe\x71\xfe\x75\x3d\x7b\xe1\x6f\xe3\xbc\x0a\xa5\xf6\x90\x0f\xbe\x8c\x3e\x78\xad\xac\x1d\xf8\xfb\x87\xe8\xef\x5a\x81\x64\xd4\x66\x4a\x60\x61\x9e\xb6\x78\xce\x17\x5f\xf9\xef\x4f\x73\xe0\xdc\x1e\x25\xc7\xc7\x23\x65\x1d\x1e\x06\x64\x98\xe8\x6b\xe3\xc1\x55\xe7\xf6\x05\x3c\x21\xb7\x0d\xeb\xfb\x8b\xe3\x52\x0f\x43\x50\xe7\x13\xc2\x18\x8c\xf1\x03\xde\x35\xf1\x04\x2b\xa8\x55\x49\xbb\x2d\x3b\x10\x27\x92\x61\xad\xca\x20\xbf\xdc\xab\x4c\x4b\x59\xae\x2a\xb7\x3b\x65\x8d\xc2\x1b\x8f\x5c\x94\xf3\xb4\xb3\x75\x2b\x78\xe8\xa5\xc7\x7e\xad\xf9\x24\x05\x56\x40\x58\xee\x65\x3c\xf9\xf0\x11\x7e\x5d\x66\x77\x32\x8a\xb9\xf1\x22\xbb\x3d\x51\xeb\x31\x22\x65\x97\xb9\x46\x82\xcc\xe2\xe2\x0b\xb2\xf8\xdf\x05\xa1\x3c\xa5\xc2\xf3\x35\x96\x04\x60\xa9\x8d\xc9\x22\x20\xd6\xe3\xd5\x3e\xf5\x74\xe2\xd7\xe0\xfb\x74\xb2\x5b\xd4\xa6\xd6\x6c\x22\x81\xcd\xae\x45\x51\x45\xf3\xd8\x6a\x73\x92\xd7\x35\x17\x5b\x40\x60\x85\x91\x09\xce\x65\x6b\xd1\x12\x13\xc9\x26\x65\x43\x77\x7d\xa6\xaa\xe1\xda\x37\xa6\x79\xeb\x39\xac\xb1\x03\x57\x7e\xb1\x4e\x78\x29\x83\xa1\xc4\xaf\xa9\x6c\x53\xce\x18\x7b\x41\x42\x37\x20\x18\x02\x26\x77\x06\xb5\x2e\x77\x40\xc1\x62\xe1\x14\x73\x9b\x77\x94\x7e\xda\x68\x68\xfc\x45\x2a\x00\xa2\xd6\xa1\x0a\x6c\x42\x8d\xd9\x1a\x08\xaf\xfd\xd8\x4d\x3e\xab\x9b\x8b\x35\x1d\x72\x3f\xa7\x1f\xfe\x80\x61\x86\x12\x5c\xb9\x5d\x17\xc6\x75\x9d\xa8\x0e\x25\x4e\xad\xf6\x0b\xb1\x17\xaf\xae\x2e\x93\xf5\xea\x01\x62\x24\xa3\xd2\x7e\x72\xa1\xfd\x4a\xd5\xe5\xa4\x2e\x60\x48\x78\x85\xbb\xc5\x30\xa1\xdf\xa5\x9b\xe4\x98\x6d\xae\xab\x85\x3b\xd4\x9f\xbe\x05\x76\x2a\xe6\x5e\xe5\xf5\x60\xc0\x72\x3d\xe8\x90\x47\x87\xeb\xe0\xbc\x09\x15\x49\x23\xb5\x94\x2f\x8c\xd6\xdb\x6c\xed\x9c\x4c\x6c\xef\x15\x0a\xf5\xce\x17\x7b\x62\x56\x26\xb5\xef\xc3\xcc\xca\x78\x5b\xac\x66\x45\x30\x91\x18\x1f\x86\x27\xb8\x1e\xd4\x9d\x2a\xb7\x69\x73\x64\xa8\x62\xfb\x2b\xd8\xe0\xb9\xc7\x0a\xc3\x19\x08\xe2\xf0\x31\x56\xd8\x9a\xd0\x52\xd8\xf1\x78\x69\x2c\x2f\x37\x7a\x5f\xf1\xf2\x63\xc5\xf9\x93\x04\x1c\x59\xec\x26\x7a\xe9\xf0\xa4\x78\x6c\xc1\x86\x74\x61\xd3\x45\xe0\x08\x59\x1e\xa7\x75\xa1\xf4\x22\x81\x9a\xd4\x1d\x45\x8e\x71\xae\x7b\xbc\x70\x11\xcb\x7a\x25\xf9\xbb\x6b\xc8\xc9\xae\xf7\x04\xe6\x06\x22\x46\x1a\x57\x67\x3f\xc5\xf8\xa1\xea\x1b\x47\x43\x26\x1f\x8b\x2e\x94\xb2\xdb\x89\x9f\x77\x88\x44\xc9\x45\xa4\x98\x62\x2c\x9e\xe7\x6e\x58\x93\xf3\x40\x2c\x54\xa3\x6c\x2b\x4c\x32\xc4\xf2\xb6\x23\x9c\x0a\x92\x58\xc0\x88\x84\x72\x85\xc0\x42\xb5\x32\x6c\xc2\x71\x6b\xaa\xf3\x52\xaa\xce\x0f\xbc\x86\xfe\x1d\x4b\xe8\xd6\x0f\x80\x78\xa9\x85\x98\x61\xd2\xa9\xcf\x6f\x78\xca\x19\x15\x85\x8f\xdf\xe5\xba\x5f\xfd\x18\x8a\x55\x8f\xa6\x83\xa9\xc2\x76\x54\xbb\x4d\x59\xe7\x7a\x79\x72\x61\x69\x74\x7b\x43\x29\x81\x42\x6b\x53\x53\x9c\x34\x82\xcf\xde\xe2\xdc\xeb\x64\x76\x2b\xe7\xbc\x56\x66\x2c\xb6\x29\x05\x22\x90\x84\x56\xb7\x60\x86\x02\x3c\xe1\xbb\xcc\xad\x08\x2c\xaf\x3f\x4b\xeb\x50\xcf\xfc\xbe\x7d\x24\xf9\xd2\x64\xbe\x3b\x36\xf5\xaa\x86\x1b\xa0\xa4\x1d\x01\x31\xc1\xd6\x2e\xe8\xba\x88\x01\x46\x69\xf0\x68\x93\xf2\x71\xda\x99\xe3\x04\x77\x35\x19\xba\x71\x6b\xb7\x93\xba\x7a\xe3\x5c\x4e\xe7\x24\xe7\xf5\xf5\x21\x7e\x88\xa3\xee\xef\x3c\xd4\x37\x0c\xec\x35\x2c\x19\xc9\x1e\x39\x82\xe1\x9d\x07\xf2\x26\x96\x8f\xb1\x04\x8e\x0a\x34\x9f\x52\xe1\x42\xc2\x62\x27\xbc\x67\xa1\x58\x06\xbf\xd1\x44\x5e\x89\xb3\x71\xae\x70\xb1\xed\x3c\x9e\xc1\x33\xd4\x4a\xa2\x53\xdb\xa5\xb3\xd9\x27\xc2\x51\xa1\xaa\x29\xd8\xd3\x80\x71\x61\xf2\x74\x4c\x86\xcf\xeb\x29\x88\x05\x67\xae\xca\x12\x35\xc0\xa4\xc1\x2b\x0d\x13\x6b\xc4\xa2\xf7\x07\x35\x1b\x3b\xff\x44\x6a\xfa\x17\x59\xc3\x0b\x42\x9b\x66\xad\x3c\xac\xfa\x85\xa2\x88\x1f\x3a\x22\x62\x57\xd8\x1b\x5e\xa1\xa7\xe9\xf9\x56\xda\xb9\xe9\xd5\xa0\xb1\x24\x2a\x6b\xfb\xda\xee\x09\xab\x21\x54\x2d\x99\xc0\x02\x87\xe6\x2c\x1e\xbc\xe6\xd0\x45\x5b\xa5\xec\x8a\x94\xd6\x70\x84\xca\xd0\x83\xad\x70\x8e\x95\xeb\x94\x69\x85\xd7\xc4\x91\x29\x05\x47\x55\xa1\x8a\x68\xbd\x4f\x13\x7a\x1b\xb2\x60\xdb\xd4\xa2\x09\xb4\xdc\xcb\x75\x62\xe6\x8b\xd2\x64\x91\xdf\xe6\x1f\xb0\x61\x9b\x65\xe8\xe3\x19\x58\x29\xf7\x9d\x7e\x6d\x7b\x84\x7e\x61\xb2\xf9\xfa\x31\x93\xa4\x0d\xce\x9b\x1f\xb3\x6a\xb4\x41\xe1\xcb\xa9\x36\xec\xa0\x38\xf0\xf9\xa4\x9d\x08\x96\x33\xb8\xcb\x8d\x87\xc0\x95\x77\xcc\x97\x19\x7c\xdb\x5b\xb6\x6d\xbd\x96\x84\xe5\x26\x6b\x16\xeb\x60\xe4\x22\x83\xd8\x6e\x6b\x18\x01\x2e\x1e\x76\xc1\xf6\x14\x86\xdf\xcd\xae\xb7\x17\xcf\xd5\x71\x93\x58\x45\x90\x19\x30\xa9\xa8\xcd\xc0\xe0\x11\xb9\xd7\x70\x72\x5a\x13\x43\x94\x53\x30\xd3\x28\xa8\x1f\xfa\xc6\x95\xb0\xa6\x69\x71\x58\xd6\xb1\xff\x40\x69\x5f\x14\xf7\xac\x34\x2a\xee\xdb\xba\x23\x3c\x94\xf4\xb5\x15\xff\x32\xe3\x5d\x32\x66\xaa\xa4\x37\x28\x07\xfc\x82\xd5\x80\xfb\x71\xde\x07\x21\x6f\xa8\x2c\x1d\x76\xf8\xc9\xb3\xb4\xb1\x5a\x5f\xbe\x19\x7c\x34\x41\x86\x7a\x04\xfe\xc5\x3c\x3f\x68\x85\xe2\xaf\x47\x7d\xa4\xa3\xca\xa5\x5a\xac\xe6\xef\x54\xf6\xde\x7e\x19\x19\xb4\x63\xc6\xe8\x4f\xa1\xed\x34\xfc\x92\xdd\xd5\x7e\x0f\xad\xea\x7d\xa1\xed\xab\x95\xf3\xe7\x1a\xc4\x98\xce\xa5\x04\x63\x81\xb5\xad\xca\x4b\xc9\xba\x4d\xad\x0d\x80\x88\x8b\xdd\x3a\x84\x10\x13\x2a\x7f\xb2\x80\xd1\xc5\x39\xa9\xc7\xd6\x5a\x22\x48\xdb\x01\x86\x0b\x67\x68\x23\xa5\xda\xd0\xe1\x9d\x2d\xad\x71\x01\xe7\x92\xdb\x2f\xef\x5e\x31\x7d\xd4\xea\xc6\xc0\x7f\x6b\x0f\x9d\x2d\x00\x6b\x71\x04\xb5\x98\x0c\x5b\x52\x95\x6e\xc4\x2b\x67\x7b\x65\xdb\x49\x26\x67\x89\x94\x6d\x5f\x58\x43\x1a\x58\x84\x35\xae\x8d\x85\xd1\x56\xe8\xa7\x25\x2e\xba\x41\xd4\x3d\xab\x80\x6e\x53\x76\xef\x45\x56\xfd\x73\x57\x48\x6e\xc4\xe8\x51\x5e\xcd\xb6\x45\xbd\x25\xb3\x3a\xce\x82\xd5\x0a\xef\x14\x7f\x06\x1b\xe0\x25\x19\xaf\xb6\x99\xd0\x5b\xb9\x2b\x66\x94\xf9\x72\x67\x01\xdb\x4f\xdc\x3a\x04\x79\x2b\xd7\xa1\x2c\x23\x37\x5a\x2c\x75\x4c\x8b\xaa\xf5\xa6\xc0\x67\xf7\x01\x23\x18\xbb\x92\xbc\x73\x74\xd2\x77\x60\x16\x57\x96\x94\xac\x29\x62\x69\xb9\x7a\x51\xe4\xa5\xd2\x9c\x09\x98\xa1\xc9\xd9\xc7\x49\x64\xa0\x5e\xcc\x2a\x6a\xea\xfc\xef\xdd\x16\x6b\x6f\x29\x10\xc9\x7c\xca\xcd\x6e\xc3\x03\x54\xbf\x4b\xb0\xba\x7e\xdb\xbf\xc9\xbd\x05\x0c\x1e\x06\xc3\x02\x0a\x2e\x66\xee\x7a\xe8\xc2\x13\xcf\x5c\x59\xe2\x47\x95\xbf\x77\x1a\x52\xd8\xc3\x62\x80\xab\xa2\x63\xc1\x41\xca\xaa\xba\x36\x41\x58\x00\xa9\x30\x77\x55\xb7\x85\x30\x45\x73\x1f\xa3\x12\x22\x2e\xef\x12\x6f\x76\xee\xb6\x07\x15\x8e\xcd\xe5\x74\x01\x91\x6f\xde\x8b\x6f\x74\x4d\xf1\x37\x35\x63\x95\xb2\x69\x56\xd2\xa2\xb1\x33\x6c\x99\x70\xc6\x61\x03\x7f\x27\x6b\x8e\x2d\x2f\xc4\x7a\x2b\x15\xbe\xb4\x82\x1a\x0b\x48\x8a\x52\x73\x1e\x61\x5b\x6e\x99\x8f\x84\x4f\xd2\x6e\x6a\x60\x77\x56\x43\x74\xb5\xde\x58\xbd\xb2\x7e\x44\x1e\x1b\x3f\x58\x8b\xd6\x16\xd0\xc0\xa8\xc9\x46\xc3\xe2\x30\xa2\xb4\xe0\x0d\xd1\x76\xf1\x0b\xad\xe7\xb1\x34\xde\x38\x35\xd2\xd3\x12\xf1\xc9\x19\xfe\x4b\x53\x32\xe1\xfa\xc9\xfd\xbe\xdb\x6d\x26\xc5\x20\x1f\xcf\x6a\x47\x99\xce\xbb\x30\xd6\x49\x9f\x66\x7c\xdc\xde\x9e\x5d\xe3\x8c\xc4\x07\xf7\x59\x2e\xfd\xf8\x5d\x57\x9b\x55\xef\xd4\x02\x7a\x52\xb5\xe5\x4d\xb3\x07\x65\x40\x57\x3c\xfc\x8c\x7e\x28\x6d\x92\x24\x2b\x34\x05\x9e\xe4\xe5\x83\xf8\x45\x5b\x2c\x75\x33\x69\xcb\x32\x4f\x05\x9a\x53\xaf\x62\x21\xd2\xe2\xe2\xb9\x64\xfe\xc4\x45\xc4\xd0\x2a\xd9\x66\xd6\x86\x40\x64\x68\x77\x63\x71\xb5\xc3\xcc\xb4\x11\x61\xfc\x10\xd4\x7c\x3c\xa6\x39\x40\xdd\x34\xd7\x93\x9e\x06\x93\xfd\x54\xd6\xa0\xc3\xa7\x86\xaf\x1b\xb6\x8a\xf3\x72\xa1\xae\x04\x9b\x01\xf3\x5f\xaa\xa6\x12\x2a\x51\x08\x70\xfa\xb5\x08\x48\x6b\x16\x0d\xd4\xec\x4e\x25\xbf\x3f\x49\x53\x22\xcd\x41\xd2\xe7\x16\x31\x40\x78\xea\xb5\x3c\x28\x53\x82\x3c\x43\x94\x40\xb2\x53\xb7\xf8\x1c\x5d\x69\xf8\x6b\x0f\x6c\xcc\x5d\x5c\xc4\xd5\xb9\xaa\xaf\xaf\x9d\x37\xba\x6d\x58\xcc\xc3\xe3\xc0\x81\x00\x05\x63\x50\x17\xc5\x97\xe5\xaf\x17\x13\x3b\xf3\xf8\xb0\xec\x16\x4c\xec\xd9\xa0\x47\xda\xce\x00\x83\xdb\x7b\x73\xe4\xe6\xb4\x13\xb0\xae\x14\x6d\x9a\xa3\xf3\x45\x96\xcb\xa2\x29\x41\xa4\x74\xb2\x02\xf1\x75\x2c\x12\xe7\xdb\xf3\x7d\x50\x1b\x43\x68\x06\xe3\x3d\x75\x6d\x63\x6b\x65\x8f\x8e\x21\xd1\x68\x36\xd1\x78\xb3\xeb\x1e\xf0\x42\x11\x70\x66\x8d\x31\x6d\x80\x34\x04\x48\xb9\x14\x1a\x33\xf1\x72\x5f\x6b\x74\x17\x1d\x2b\x1e\x0b\xb5\x29\x44\xb5\xb4\x60\xe6\x38\x47\x02\x81\x0d\x5b\x2c\xca\x4a\x12\x31\x92\x9e\x09\x68\x9a\x67\x4d\x95\xbd\x3b\x56\x9f\xb6\x3c\x99\x3c\x40\xf9\x9b\xdf\x2d\xe2\x00\xee\x83\x19\x1a\xcb\x9f\x29\xf5\xca\xdb\xee\x46\xbd\x07\x5e\x56\x3c\x15\x59\x7b\x63\xb6\x4f\xfc\xb8\xab\xc8\xbe\x21\x62\xe1\xaa\x75\x37\x3c\xf1\x10\x0f\x38\x12\x3f\xdd\x8f\xa9\x98\x7d\xf9\xfc\x22\x79\xc3\x40\x76\x8d\xba\xe0\x6e\x33\x93\x9c\x8c\xf6\xc2\x5e\x37\xe5\x56\x0f\x39\x17\x91\xad\x23\x8a\x22\xfc\xf1\x37\xf7\x8a\xe6\x1a\xa3\x5e\x39\x2f\xfd\x68\x70\xfa\x70\xec\xe2\xf1\x88\xd5\xb7\xae\x63\xdc\x97\xcc\x8d\x4b\x19\x87\xeb\x45\x4b\xaf\x6c\x59\xa8\xd1\x23\xd7\xab\x6b\xfc\x70\x30\x80\x9d\xc4\x1f\xce\xb9\x52\x9b\x3b\x0f\xa4\xc7\x00\xc6\xac\x8b\x62\x9c\x56\xd4\xd3\x95\x8e\x6b\x5f\xa6\xdc\x7a\xbc\x43\x48\xc2\xbc\x1c\xd2\x8f\xbe\xd1\x0c\xf2\xd2\x17\x96\x0f\x14\x6b\x92\x9c\xf9\x24\x86\xc3\x28\x9d\x2e\x4f\x6a\xdb\x23\x78\x05\x62\x54\xe2\xac\xa8\xc8\x13\x6c\x8b\x4c\xf7\xff\x08\xa9\xaa\xc0\xf4\x75\x12\x52\x8d\x84\x5e\xf1\x63\x0a\x77\xe7\x9b\xa9\xf9\x70\xf7\x28\x44\x85\x4c\xd2\x64\x7b\x16\xba\x2f\x67\xd4\x1a\xc4\x0c\xeb\x4c\x33\x18\x96\x24\x5a\x64\xd7\x16\x7f\x2a\xb0\xaa\xfc\x55\xa4\x22\x56\xd4\x44\x69\x67\x97\x14\x83\x71\xa1\x4c\x9d\x6d\x34\x58\x71\xff\x73\xee\x7e\xbb\x3a\xea\x7c\x4e\xe7\xbf\x53\x65\xd6\x08\x73\x61\x25\xf6\x1e\x35\xc1\xdb\x60\x49\x33\xb1\xa3\xe9\xfe\xb3\xf4\x80\x41\x89\x81\x1b\x98\xf3\xd1\x04\x24\xe9\x9e\x39\x39\xc6\xb5\x92\xa3\x82\x67\x11\x99\xd1\x81\x04\x14\xd9\x2b\x4a\x01\x6e\xad\xc9\xa8\x62\x97\x72\xd6\xd7\xed\xd1\x3b\x9d\x1e\x35\x23\x75\xc3\x4a\xa6\x94\x4e\xa1\x3d\x02\xaa\xbe\xa2\x51\xd6\xf9\xd5\x9a\xc1\x3a\xe3\x01\x25\x4f\x90\x00\xd9\x05\x0e\x6b\xf7\xd4\x9a\xc6\x12\x66\x6d\xad\x2c\xb3\x14\x6f\x58\x8a\x24\xad\xc1\x5a\x60\x34\x1a\x4e\x5b\xdb\x05\x02\xbe\x97\xee\x4d\x78\x6d\x6f\x0f\x66\xd3\x0a\xae\x76\xae\x47\x28\x08\x33\x84\x32\x96\xde\x5e\xc5\xe1\x3a\x1f\x9b\x0a\xb9\x4c\x3c\x19\x3c\xe9\xe2\x1f\xa3\xce\x7b\xed\xe7\xa3\x77\xe1\xbc\x28\xb6\x49\xf0\xec\x8b\xa2\x21\x4e\xb3\x97\xd3\x06\x54\x09\xc0\x30\x9a\x93\x98\x90\x56\x57\x9a\x62\xf1\x5d\xa2\xeb\x6a\xd1\x40\x88\x40\x6c\xb5\x03\xf0\x52\xf5\x23\x03\xce\x90\x21\x9c\xaa\xe2\x7e\xb0\x6e\x3d\x5e\xf0\x78\x62\x2d\x60\x4d\xe2\x4e\x63\x17\x92\x07\xea\x1d\x25\xcc\x77\xee\xc1\x3d\x50\x2a\xcb\x23\xee\xe8\x0e\x49\x1d\xf4\xd7\x15\xb8\xc8\x76\x2b\x22\xaa\xa6\x1d\xab\xa2\x34\x02\x6a\xf9\x22\x60\x8c\xa6\x43\x38\xbe\xe8\x62\xb8\x95\x81\x41\x55\x4c\xc1\xc0\x39\x9e\x66\x91\x4b\xee\x2f\xe4\x8e\xe3\xfb\xf4\x6d\xf5\xd2\xcd\xca\x29\x5a\x92\x92\x2e\xc7\xe5\x44\xe4\xe2\x3e\x20\x67\x7e\x30\xdf\x57\xd0\xf3\xb2\xa1\xa3\xa9\xd2\x5c\x8f\x9e\x90\xfe\xf2\x2e\x26\x6c\x29\x65\x20\xea\x21\xd2\xea\xed\xac\x79\xb6\x6d\x8b\x89\x0c\x01\x71\x1e\xc5\xb7\xf4\x6b\x46\x49\x37\x8e\x00\x3b\xdc\x5c\xde\x8b\x47\x28\x6d\xf1\xb9\xa0\xc4\x94\x84\x4b\x00\xf7\xfc\x66\xab\x50\x30\xfa\x41\xe5\x24\xfa\xa4\x9f\x9c\xbf\x49\x59\x86\xb1\xda\xc4\x9b\x67\xa6\xe1\x52\x7f\x6e\x6b\xcc\x81\x79\x09\xad\xe0\xdc\x99\xc3\xb6\xf3\x1e\x9a\x73\xd0\xc0\xe2\x56\x56\xb7\xc8\xf6\xc0\x1d\x8a\xdc\x09\x93\x33\x88\xb2\xe2\x6e\x61\xec\x36\x47\x39\x56\x3f\x4c\x28\x38\x58\xeb\xc9\xff\xae\xdc\x86\x40\xd4\x0b\xc2\x49\x82\x17\x1e\xc6\x12\xfd\xdd\xa0\xae\x4a\x4c\x38\x8b\x02\xde\x35\xb2\x92\xa2\xf0\x82\x31\x79\x16\x05\x7b\xcc\x89\x24\xfc\x93\x96\xfb\x18\xb2\x8b\xd1\x41\x91\x74\xb2\x50\x3b\x29\x8d\x4f\x9f\x44\xb5\x0f\x35\xf0\x46\x3c\xbd\xcb\x30\x08\x95\xaf\xa7\x63\x63\x40\xac\xcd\xa5\x9e\xd2\x68\xe6\x45\x3e\x96\xcd\x1e\x63\x14\xbe\x9e\x49\xdd\x67\x3f\xed\xdb\x6e\xb7\x96\x42\x9a\xbb\xfc\x47\x37\x77\x4f\x4e\x1d\x30\x71\x8d\x8a\xc7\xa3\xc4\x2b\x24\x3c\x5b\xdf\x3e\x3c\x61\x66\xab\x8b\x08\xd8\xec\xe1\x6d\x4d\xf2\x86\x63\x3a\xa0\x3a\x81\x28\x25\xef\x3e\xac\xac\xb6\x96\xb5\x36\xcc\x7c\x08\x31\x92\x34\x03\x8f\x50\xb3\xf5\xe4\xe9\x8e\xaf\x3f\x7f\x63\x2d\x03\x07\x16\x41\x65\x0f\xc4\xb7\x48\x28\x18\xf5\x05\x4d\x11\x88\x3c\x98\x4d\xd3\xb3\x52\x0f\x0a\xc2\x4d\x07\x79\xb1\xa4\xb5\x5b\xf3\x06\x87\xaa\x81\x07\xd0\x91\x71\x7a\x22\x7e\x62\x7d\xdc\x34\x59\xa1\xd0\xf2\x39\xdf\x95\x27\x25\xc0\x4f\x83\xf6\x34\xda\x8b\x8c\xe1\xa0\x47\xa6\x24\x43\xf3\x38\x25\x69\x3d\x90\xcc\xf7\xb1\x29\xfa\x8e\x2f\x10\x1b\xe6\x8f\x5a\xd3\xf4\x4c\x02\x38\xe9\x62\xf2\x57\xcd\xbd\x75\x75\x4c\xf2\x67\x9b\x56\x67\x2b\x34\x52\xc8\x61\x21\x9f\xcf\x75\x15\x45\x7f\x42\xb5\xc7\xdd\x34\xea\x70\xd2\x83\x33\x52\xfb\xd1\x69\xd2\xf4\x9a\xfe\x75\x6e\x44\xca\x83\x2f\x84\x5f\x8a\x73\x1d\x9e\x44\xbe\x07\x1f\x23\xba\xb5\x5f\xdc\x65\xf2\x6c\x00\xaa\x93\xb3\xde\x34\x5e\x57\x6e\xff\x89\x91\x20\xf1\xa6\xd0\x31\x7b\xb0\x8c\x16\xd8\x12\xa5\xcf\x38\xd3\x54\x47\x04\xd9\xf3\x94\xa1\x8d\xcb\x8d\xd3\x2f\x17\x75\x83\x90\xe2\x19\xbb\x3d\xf7\xbd\xb6\x42\x08\xe3\xad\x15\x79\x86\xe2\xf9\x51\xbd\x9d\xac\xbd\x7d\x92\xd7\x99\xbc\xb7\x30\x9e\x3d\x01\xdb\x81\x3a\x67\x2b\xc0\x4e\x37\xc1\xdf\xc0\x50\x14\x4c\x9e\x8a\x4a\x00\x6e\xa8\x9a\xb9\x64\xfb\x6e\xf7\x9b\x45\xbd\x8e\xc5\x84\xbc\xdf\x9a\x5c\x3f\xaa\x2c\x1d\x1b\x5e\x53\x64\x5d\xf2\x54\xad\xa2\xa0\xd9\x9c\x1d\x1b\x38\x55\x34\xe3\xb5\xeb\xc6\xff\xdb\x37\x0b\x4d\x39\x66\x7e\x76\x9b\x6b\x47\xe1\xf1\xef\x9a\xa2\x20\x0d\xee\x27\x16\x8f\xa7\xe9\xa0\xff\xe7\x59\x88\xb3\xc9\x1c\xdb\x42\xa3\xd7\xb8\x23\x90\x75\x2f\x8c\xe7\x91\xbc\xf1\x07\x03\xe3\x8f\xce\xd7\x28\xaf\xcb\x7c\x76\xbd\xae\x17\x4a\xe9\xed\x7c\x5e\xb0\x81\x5d\xef\xbd\x70\x0d\xfd\x91\x88\x3f\xbb\x2b\xb7\xad\x6c\x28\x15\x80\x18\xcd\x0c\xf5\x81\x66\x5e\x60\x81\xd1\xea\xfb\x31\x16\xe0\x03\xfa\x0d\x3e\x0c\x99\x08\x74\x1d\x36\xcf\x66\x7d\xd2\xdf\xd7\xe0\xf7\xe5\xb3\x5a\x16\xea\xe7\x2a\x46\xb5\x7a\xc1\x6d\xf9\xfa\x08\x10\xf6\x04\xb8\x9e\xa1\x10\x27\xd5\xcd\x62\x29\x12\x83\xd0\x76\x23\xed\x35\x1b\xb2\x84\x0d\xbf\xfc\x27\x9a\xd5\x0d\x2f\x05\x6f\x77\x59\x6e\x19\x65\xc9\xb7\x5e\x54\xe4\xba\xb7\xda\x07\xff\x78\xb9\xc8\xc0\xb3\xea\x43\xb5\x0b\x8e\x14\xac\xd7\xbf\xa9\x41\xb1\xcb\x92\x2b\xa6\xd8\xca\x71\xf8\x9e\x44\xdd\x68\x97\xba\x63\x46\x54\xe6\xd4\xd0\x1c\xe2\xe1\x68\x43\x01\x59\xd3\xa2\x6e\x02\x89\x60\x07\xe2\xf0\xdc\x94\xed\x7d\xb9\xd9\xc4\x61\xac\x8a\xdd\x21\x9d\xd9\xd4\xbf\x39\x3b\x2a\x4f\x02\xff\xc9\xc2\x46\x7d\xa9\xe5\x92\xd0\x03\xfb\x6b\xc2\x42\x35\xc4\xca\x9e\xa9\xb1\x4a\xe3\x4f\x2d\x01\xcc\xcc\x14\xd0\x47\x53\xac\x18\xf8\x71\xbd\xaf\x4a\xcf\x24\x24\xef\x51\xf0\xbd\x9a\x06\x48\x48\x86\x7c\xd6\x03\x8c\xb9\xc4\x52\x70\x0b\xeb\xcf\x3c\xb5\xad\x15\x05\xdc\x16\xa7\xcf\xa4\x7d\x2f\x4b\x9c\x44\xd6\x10\x2b\x6a\x0b\x91\x9e\x23\x9f\x8b\x42\xaf\xb3\x8f\x9d\x6f\x3d\x9b\x7b\xbd\xee\x14\x3b\x44\x99\xf1\x30\x64\xf6\xf0\x54\x8a\xb1\x0e\x7f\x7e\xc1\x75\x21\xf5\x56\x48\x82\xc8\x0a\x20\x1b\xdb\xcd\xae\x59\x1b\x10\xd2\x0f\xd4\x44\xd8\xf8\x2f\xb4\x69\x0a\xac\x82\x17\xb8\x60\x0a\xc5\x05\xcf\x1d\x31\xf1\xf0\x69\x28\xdc\x32\x8f\xc4\xfa\x22\x9f\x47\xa1\x48\x7a\x05\xc3\xf7\xd5\xab\xbe\x22\x5d\x50\x6f\x2f\xba\x68\xe4\x6c\x14\x30\x90\xa6\xde\x54\x1a\x35\xdd\xf4\xf0\xfd\xd8\x5a\x9d\x5b\xd7\xa9\xfc\xf0\x26\xd3\xf6\x59\x69\xa4\xd1\x9f\x52\xff\x32\x58\xa6\x67\x2d\x1e\x7d\x79\x48\x78\x65\x1c\x67\xfd\xfe\x08\xcc\xfa\x3d\x92\xc2\xbd\xa4\xf3\x34\xe6\xed\x70\xe0\xfd\xa5\x70\x81\x0c\x81\x35\x07\x4e\xbc\xb7\x8f\x3f\xf1\x26\x1e\x78\x37\xc5\xa6\xa4\xfd\xa7\x2b\x10\xc4\x25\xc8\x27\x68\xe7\xa0\xa4\x9f\x37\x00\x3d\x63\x76\x0f\xfc\xe6\x7a\xb7\x71\x76\x26\x5b\x94\xcb\x27\x2e\xed\x3c\x50\x10\x4e\x90\x68\x88\x1a\x83\x34\x9c\x14\x3f\x8a\x4a\x04\xfc\x7a\x41\x9b\x69\x86\xae\x66\xff\x41\xf8\x82\xd8\x8c\x46\x2c\x8a\x42\xe0\x22\x85\x72\xe0\xbe\x07\x21\xd8\x14\xa2\xfc\x71\x7e\x89\x98\xa0\x4a\x07\x98\x9f\x55\x6a\xd2\x82\xf3\x7c\x86\x53\xcb\xeb\x43\x2a\x94\x68\xae\x7e\xc5\xdc\xd3\x3e\x5a\xc1\xc7\x62\x8d\x01\xe1\x6e\x54\x58\xa7\x52\xad\x94\xad\x3d\x27\x45\x36\xe0\xd3\x37\x35\x01\xc4\xa7\xf8\xe8\xa6\x90\x2e\x9b\x44\x1a\x20\xe1\x5d\xcf\x3c\x51\xbe\x41\x5c\x47\xde\x87\x42\x01\x7c\x42\x44\x7e\x20\xf9\x10\x16\x8c\x52\x5a\x74\x69\x46\xa1\x69\xe3\x59\xa2\x32\xa2\x98\x96\x46\x4c\x5b\x3c\x70\x8f\x32\xd0\xe1\x79\x3a\xb2\xbd\x6a\xad\x7a\x11\xd2\xd3\xb4\xff\xf6\x7c\xf7\x05\x56\xa5\x8b\x2f\xc4\xcf\xfe\x07\x96\x44\x5f\x7d\xd6\x74\x67\x93\x37\xdf\x7f\x66\x2e\x32\xbf\x16\x06\x27\x74\x4a\x70\xbc\x85\xd2\x7b\x52\x3a\xe3\x80\x9c\xb9\x41\x05\xc2\x20\x7f\xbb\x56\x48\xe6\xa2\x47\xce\xac\x55\xd5\x57\x19\xa2\xa6\x6a\x32\x44\xbd\x9c\x25\x09\x54\xb3\xeb\x9a\x88\xd8\x96\xc4\x20\xec\xa4\xfa\x2b\xa9\xd2\x85\xf1\x44\x4f\x22\x45\xfd\x88\xf0\x1a\x44\x97\xd7\x0d\xd9\xa7\x45\x59\x85\x41\x48\xea\x06\x28\xd7\x5b\x1b\xb7\x0b\x00\x06\xa8\xb0\x1c\x79\x65\xfa\x52\xcb\x89\x51\x48\x57\xbb\xc7\xfc\xa1\x71\x43\x2c\xdd\x81\x08\xfc\xff\x37\x00\xad\x4d\x8b\x42\x9e\x90\xcd\xe7\xf9\xca\xe1\x67\x25\xb8\xbe\x0e\xd9\x9c\x69\x6a\xe4\x49\x6e\x6a\x51\x5c\x03\x16\x9b\xc1\x55\xa3\x9e\x02\xc1\xdb\x95\xa6\xcc\x2e\x9d\x29\xde\xad\x56\x6b\x5a\xa9\xce\x39\x5a\xc6\xad\x25\xa5\x23\xe9\xb6\xd8\x7a\x4b\xdd\xcb\x0b\xf5\xfc\x5f\x6f\x0f\x8c\x64\x07\x3e\x6e\x30\x2d\xc1\x27\x38\xb1\xf2\xdc\x10\x87\x16\x9c\x0e\x30\x8a\x7b\x5d\x9b\x88\xfd\xca\x03\xd9\xd2\x33\x9d\x2b\xb6\xd4\x09\x46\x12\x54\x67\x59\x9b\xb4\xd3\xc1\x2f\x1b\x3f\x0a\x64\x53\x6a\xd5\x1b\xdb\xb2\x35\x44\x77\x14\x85\xc7\x1a\x24\x09\x0e\x74\xde\xdf\x62\xd2\x28\x9c\xdc\x37\xbe\xb6\x37\x0e\x74\xd8\x92\x56\xa2\x6c\x64\x1a\x54\x9a\x7d\x84\x69\x49\xf8\x6f\xa8\x23\x41\x3e\x11\x49\xe6\x08\x5f\x87\x9e\x6e\x83\x68\xee\x10\x05\xca\xe1\x9d\xf9\x0b\xce\xa2\xbf\xe9\x4c\x7f\x86\x8c\xd8\xa4\x2c\xf4\xc7\x27\x56\xc9\x14\x35\x55\x15\x34\x58\xec\x1a\xb0\xbc\x51\x0a\x17\xf9\x2b\xf6\xa4\x75\xb5\x2f\xd0\xbb\x10\x92\x0e\xb5\xfb\x8f\xdd\x51\x49\x8a\xf3\x49\x10\x0f\x91\xe2\x7a\x6e\xdc\xef\xc1\xc1\xbd\x46\xa1\xdf\x6a\x26\xa3\x6e\xd1\x5d\x51\xae\xc5\x29\x02\x57\xb7\x96\xef\x44\x5f\x4a\xda\x52\xbb\x22\x24\x1d\xe6\x6e\x19\xb4\xb7\x4a\x97\x37\xf3\x0c\x3f\x4b\xeb\xe2\xd6\x6f\xfa\x5a\xd6\xcb\xd9\xb0\xe2\x52\x24\x59\x85\xa1\x4a\xe5\x9b\x44\xa4\x32\xc6\x1a\xba\x7f\x5e\x08\x5f\xd7\xec\x0f\xaf\x5f\x29\x0c\xc5\xcd\x53\xd8\x4c\x2f\x3e\x75\x45\xb6\xee\x6e\xbc\x96\x78\xd2\x93\x46\xc2\x5c\xd4\xed\xa4\x67\x6d\xb1\xf7\x29\xaf\x4d\x91\x55\x3d\x39\x22\x98\x94\xa6\x60\x15\x45\xe1\x6d\x72\x3f\xed\x51\x5d\x14\x37\xa5\xa8\xe2\xf2\xaa\x6d\x70\xa3\xa8\x70\xd7\x5a\x3b\xbe\xaa\x8e\x4f\x5d\xe0\x38\x9a\x27\xf1\xc6\x7d\x7e\x0e\xe7\xdf\x97\x00\xdd\x1a\x21\xea\x0f\x7d\xee\x47\x85\x54\x8e\x2c\x50\x5d\x86\x3f\xd6\x3b\x7a\x36\x17\x47\x20\x21\x1f\xbd\x38\xbb\x05\xdb\x91\xe8\x7d\x2f\xa1\x35\x38\x7b\x58\x6b\x81\x78\x59\x51\x31\xbd\xdf\x52\xc5\xd6\x97\xa8\x2d\x03\x12\x91\x91\x0b\x02\xc3\x9d\x49\x6e\xe9\xf3\xe8\x61\xec\xce\xd6\x92\x8b\x5e\xc2\x4c\x54\x84\x4f\x76\x29\x19\xf0\x5d\x42\x72\x5f\xc0\x03\xf4\x26\x61\xfd\xb8\xa7\xe3\xf9\x21\xb4\x1e\xcd\x55\xe0\x2a\x00\x22\xb4\x07\x45\xe7\x43\x90\x19\x94\x5d\x64\xbb\xb5\xf6\xde\x09\x19\x91\xaf\x82\x47\x1c\x71\x53\x20\xd0\xd1\x68\x23\x8e\xf5\x7e\x03\x40\x1d\xda\x0e\x84\x67\x4e\xbd\xd3\x74\x68\x1e\x6a\xa0\x78\x35\x8c\x26\x5b\xa1\x59\xa4\xf8\x87\x9c\x51\x07\xe8\x15\x7b\xbd\x01\x3e\x51\x55\x69\x2d\x79\x58\x59\x9a\xb4\x87\xd0\x90\x9b\x21\x40\x83\xe5\x59\x3e\x3d\xc7\xf9\x64\x78\xe4\x54\x31\xa2\x87\x3b\x73\x03\x8a\x4c\x3a\x74\x37\xab\x82\x0c\x8d\xf6\xa7\xef\x99\xc5\xff\x79\x9d\x83\x4d\xf1\xe1\x35\xce\x65\x93\xad\xba\xa4\xc6\x29\x19\x7f\x7a\x05\x8d\x6a\x80\xb2\xc7\xa4\x14\xc0\x4d\xf6\x10\x77\x31\x8a\x96\xab\x54\x41\xe5\x54\xc4\x9c\x48\x11\x6d\xc3\x2a\xb0\xe2\x50\xe0\x7c\xf4\x86\xda\xda\x7b\x5d\xd4\x53\xd5\x6e\xc7\x0a\x6f\xd1\xc0\xd6\x0c\x45\x4f\xb3\xd9\xeb\x57\x81\x9a\x28\xb0\x17\x95\xad\x15\xde\x45\xbf\x8d\x92\x94\x09\xb9\xde\x18\x2b\xa4\x4f\x55\xe1\x64\x73\x77\x0a\xb5\x2d\x10\xe9\x65\x79\x22\x67\x7a\x52\x4b\xc2\x1e\x3d\x68\x49\xa8\x32\x09\xba\x86\x8b\x46\xe7\x20\xaa\xff\xb8\xf5\xbd\x5b\x97\x73\xd5\x8a\x42\x6f\x43\xd5\x25\x43\x9f\x52\x0b\xee\x55\xa3\x87\xc1\x68\xac\xb6\xe3\x87\x68\x74\xf1\x8f\x97\xca\x11\x25\x35\x7c\x91\x8e\x66\x61\xdc\xfb\x9e\x49\x2d\x5b\x97\xbf\x59\xa0\x90\x8c\x21\xd5\xf1\x09\xba\x6c\xd3\xe8\xf9\xc4\x39\xcf\x0e\xba\xe6\x45\xdc\x7c\x6e\xf8\x12\xcc\x67\x9b\x8e\x22\xad\xac\x09\x87\x7f\x34\xa7\xb2\x58\xf4\x19\x60\xa5\x03\xd7\x9e\x73\x86\x55\xe7\x87\xfd\xc3\x26\xb6\x64\xf0\xa3\xf8\x2e\xe2\x8c\xe1\x4d\x6c\x85\x38\xc1\xca\x7a\xd2\x51\x2e\x62\xe2\x38\x1c\xdd\x74\x2f\x9b\xba\x6d\xd3\x9d\xb4\x68\xca\xdc\x1a\x77\x9d\x09\xaf\x54\x83\xca\x8d\x7e\xc5\x7c\x42\xcd\xd0\xce\x7d\xd6\x15\x5b\xfc\x4b\x97\x8f\xfc\xcc\xbf\x25\xbd\xa7\x33\xf7\x77\xa5\x71\x7d\xac\x78\x10\xf2\xa7\x38\x67\x48\x2a\x78\x17\xbf\x2d\xbd\x75\xf1\x6b\xd9\x6a\xaf\xa2\x3c\xb3\x33\x96\x7c\xca\x98\xfc\xf2\x57\x60\xb0\x04\x2d\x81\xdd\xc6\x68\x12\x91\x1b\x45\xb0\xee\xdd\x44\x23\x2f\xa2\xb9\xe6\x76\x5d\xa2\xd6\x61\x92\x59\x2e\x72\x11\x2d\xe4\x5c\xa1\x64\x8c\x9f\x6e\x2b\xe9\xa9\xa0\x3d\x5f\x1d\x0d\x1e\xab\xf0\xfa\xc3\x8a\x68\x99\xdc\x71\xd6\x0b\x9c\xa5\x26\x7b\xae\xd3\xfa\x85\x08\x36\x48\x8b\x91\x54\xcb\x97\x4a\x9e\x27\x2d\x8f\x9a\x7e\xf2\x1d\x7c\x5e\xeb\x03\x22\x3c\xb6\x7a\xec\x24\x0e\xa4\x5e\x59\x92\xca\x5c\xc6\x04\xfc\x5e\xd5\x2f\xce\xe1\x64\x27\x2e\x86\xf6\x47\x7e\x4b\x0b\xf5\xc2\xc0\x48\x3a\x87\xbb\x4c\xf2\x3e\xca\xa1\xe3\xaf\xce\xe2\xa1\x70\x6a\xd5\x55\x80\x86\xcf\xd3\x85\x2f\xe2\x5a\x6e\x75\x21\xc9\x9e\xaa\x2e\xf6\x4c\xa7\xe7\xdf\x32\x37\x24\x4c\xa1\xb5\x01\x8d\x2b\xcc\xeb\xf7\xe6\xd6\xba\x95\x87\x75\x15\x84\x77\xda\xde\x91\x45\xbe\x9d\xdf\x32\x63\x98\x90\xe6\x0f\xdd\x73\xce\x34\x72\xc8\x96\xa8\xf4\x54\xff\x96\x7f\xf4\x34\xf0\x3a\x74\x43\x63\xb5\x71\x89\x81\xaf\x48\x8a\xf1\x5b\x74\x89\x35\x25\x81\x91\xf5\x01\x49\x17\x8f\x67\x9f\x52\x59\x88\xbc\xa0\xff\x5d\x22\x55\x3d\xa9\xa0\xf0\xc3\xd3\xc2\xd6\x9f\x27\xe7\x7f\xa6\xdb\xd2\x65\xdb\xd0\x9a\xca\xe2\xc0\x26\x6b\x8a\xfe\xdb\x3e\x4a\x4c\x18\x09\xf2\x19\x7d\x3e\x80\xb1\x2b\x77\x48\x65\x46\x76\x08\x2b\x03\xe7\x1d\x0e\xed\x4a\x9c\x69\xf3\x2e\xc2\x51\x9a\x75\x49\x57\xf2\x98\xd3\x33\xce\x63\x3d\x89\x7f\x1a\xd8\x81\x9b\x8e\xa4\xf3\x43\x2f\xa3\x87\x85\xd6\x7e\x8d\x84\xbf\x53\xfa\x36\x46\x55\x0b\x93\xfb\x09\x71\xb0\x9c\xb2\x12\x7b\x8d\x65\x84\xac\x65\xfb\x2d\x3a\x4c\xd2\x7d\x7d\x6e\x46\xe8\xf7\xea\x1d\x7b\x20\xcd\x12\xba\xc4\x86\x04\x26\x93\xda\x4a\x7e\x51\xf6\xa0\x4f\x9d\x9b\xcb\x49\xb0\xdf\x74\x8b\x36\x65\xb7\xcb\xd6\xff\xeb\xef\xdf\xba\xbd\xa6\xff\x3d\x85\xa9\xe4\xe1\x1b\xf7\x68\xbc\xff\x80\x1d\xfa\x51\x0b\x6b\xff\xdc\x65\x6d\x79\xd9\x50\x3c\xed\xa6\x6e\xda\xe2\x92\xca\x6f\x7e\x7f\x5a\x56\x20\x61\x7f\x3c\xc4\xb5\x74\x04\x00\x33\xa0\x46\x88\x0a\x1b\x81\x1e\x41\x68\x08\x94\x08\xee\x9f\x3b\xba\x08\xbe\x06\x9f\xe5\x39\x9c\xa7\x45\xa9\xf9\x69\xb8\x26\xe4\x6c\xa8\x02\x4b\x38\x7e\xc3\x04\xf1\x68\x88\x7c\x97\x91\x54\x42\xa1\x37\xdb\x9d\x10\x3d\xf8\xa6\x7d\xb9\xaf\x61\x33\x13\xde\xf2\x1e\x81\x93\x90\x06\x15\x27\x20\x88\xa3\x88\xe7\x21\x58\x41\x13\x19\x44\x5e\xf8\x12\x18\x2f\x2e\x8c\x9e\x89\x92\xa6\xd2\x15\x75\x28\x90\x2c\x5b\xed\x06\x75\x3f\x63\x31\x18\xef\x6f\x6e\x39\x36\x23\xd8\x57\x65\x11\x65\xb4\x90\x07\x49\x75\xe2\xd9\x9a\xb1\xd6\x69\xa9\xb8\xd0\xe8\x61\xbc\x7e\xa3\x7f\x67\x4a\x68\x85\xfd\x2d\x8a\x5b\xa3\x1c\x06\x7e\xd8\x90\x20\xcc\xa5\x43\x62\x95\xb1\x5f\xfb\xba\xbc\xb3\xe2\x11\x9e\x30\xa0\x13\xac\x81\x1c\x89\xaa\x81\xd6\x90\x82\x49\x8e\x74\x9c\xab\xac\x4f\xb8\x73\x9f\x7f\x7f\x52\xde\x42\x3a\x79\x3f\xba\xf1\x4e\x2a\x34\x7d\x7d\x16\xb7\xc1\xfb\x47\xec\xf9\x29\x90\x36\xc9\x45\x1f\x14\xdc\x71\xc7\xaa\x70\x8a\x8a\xbc\x60\xdd\xac\x73\xe4\x87\xaa\x0e\x7e\x2f\x17\x4f\xa9\xcb\x4c\x80\xc9\x46\x28\xef\x3c\xef\xfb\x4a\xb9\x46\x9c\xff\x9e\xcd\xae\x6b\xa1\x5a\x68\x4a\xb7\x2f\xf2\x52\xf0\xaa\x79\xb1\x71\xaf\x84\x60\xad\x81\x94\xa5\x5c\x8f\x48\xba\x16\xf5\x27\x0a\x63\xe3\x56\x6e\x31\xe9\xfd\x5b\x61\x50\x99\x2d\x8b\x35\xc2\x4e\x5e\xb3\x32\x8d\x3f\x53\x73\x84\xca\x26\x32\x81\xf5\x5e\x58\xb1\x84\x6d\xd6\x0d\xdb\x92\xe2\xb7\x7d\x6d\x89\x48\x26\x6e\xcc\x48\xf5\x3a\x49\x65\x91\xe1\x79\x63\x60\x6c\x42\xc6\xbe\x89\xfa\xb5\x94\xb7\x1f\x4f\x81\x0f\x0e\x92\x9e\x49\x61\xe6\x9f\x3b\xe6\xd9\x62\xff\xc3\x17\xed\xd9\x76\xca\x58\x87\x91\x60\xd4\x4d\x19\x1e\xa4\xda\xc3\xe4\xbc\xf8\xe6\xc7\x2f\x5a\x35\xaa\x17\x93\x52\x8c\x7d\xf3\x6d\xe4\x9a\xd4\x07\x40\xa6\x03\x4b\x6e\xb7\x36\x09\xc6\xa0\x2a\xc4\x4e\x1a\xcf\x59\x3f\x23\x53\xb2\xc7\x21\xb5\xb7\xe9\xc5\x51\xe9\x36\xb2\x19\x32\xaf\x94\x52\x61\x74\x06\x48\xe0\xb9\x2b\x12\x04\xec\x85\xb3\xde\x5b\x76\x94\x04\xea\x05\x92\x0e\x0c\x75\xf2\xda\x2d\x1b\x59\x83\x3a\x61\xdc\xc8\xee\x66\xe2\xfa\x47\x32\xd6\x2d\x58\xec\xdd\x01\x07\xc5\x19\xb6\xc1\x32\x54\x56\x2d\x77\xc4\x19\xf0\x29\x8a\xf5\x16\x1f\x52\x76\x84\x84\xf4\x5d\xb7\x2e\x2e\x42\xeb\xda\xaf\xb3\x17\xed\x76\xcf\x26\x71\x77\x54\x39\xaf\x6d\x53\x2a\xa3\xb2\x75\x98\xef\x5a\xd3\xcf\x23\xd6\xb3\x6e\xd8\xcd\xa8\x9e\x6c\xbe\x73\xc3\xa9\xab\x0b\xa5\xc5\xee\x09\x30\xa0\xcf\x6a\x57\x89\x28\x9b\xcf\x02\xc4\x13\xb6\x70\xa7\x2e\xb1\x75\x1a\x20\xeb\xb4\x1d\x75\xba\x7e\x91\x29\x14\x93\x2d\xce\xea\x5d\xe6\xce\xa8\x6e\xaf\xbd\xd2\x1b\x29\xa0\xd8\x36\xd2\x65\x85\xd9\x2c\x89\x16\x6e\x9d\x7f\x80\x01\xe6\x75\xe5\xfd\xe6\x6b\x80\x3e\x6b\x85\x9f\x13\x79\xdd\x6a\xbe\xd8\x59\x6e\xec\x16\x64\x26\xd6\x04\xae\x4a\xa2\x45\xf6\xa1\xb1\xf9\x6f\xa4\xcc\xb6\x16\xcb\xde\xba\x57\x41\x8e\x2a\xd9\xb2\xd7\xe8\x3b\xc3\x91\x0c\x57\x8e\x3e\xf7\xa7\x7a\x13\x93\xf9\xc9\x55\xfc\x3a\x11\xfa\x23\x5b\x41\x72\xa6\xc9\xd6\xe1\x82\xc5\x33\x57\xaa\xee\x72\xd4\x39\xa5\xdc\xe9\x6e\xad\xa8\xe8\xdd\x66\x21\xa9\x96\xf6\x36\x61\x0b\xd8\xc8\xa0\xe8\xc5\x58\x4b\xa2\xce\x95\x80\xbe\x65\xb6\xa5\xfd\x0a\x67\xe6\xf5\x16\xeb\xdc\x83\x3f\x3f\xca\x25\xe3\xcb\x88\xf8\x89\xbf\xc8\xeb\xf9\xab\xf0\x33\x32\x50\x3b\x27\x25\xb7\xb1\xc4\x3f\x24\xe9\xfe\x0b\xe3\xa1\xe2\x3f\x2f\xa2\xeb\xbc\x8a\x2f\x94\x67\x7b\x2f\xaa\xc0\xfd\x98\x13\xce\x22\x1d\x9a\x3a\x6a\x50\x2b\xb6\x12\x1d\x70\xe5\x95\xcd\x72\xb7\xc1\x29\xb2\x2c\x6c\xf7\x1f\xcc\xf1\x87\x3c\x91\x1b\x24\xd9\xb6\xcb\xeb\xca\xfc\xaf\x9e\xcb\x56\x38\x23\xa6\x67\xbe\xcd\x97\xbc\x53\x62\x0c\xd7\x77\xd2\xdb\xc2\x64\xca\x7d\x86\x6e\xa8\x97\xb3\x9f\xea\x4a\xe2\x7a\x90\x96\xe1\x1e\x2d\x62\x70\x8d\xea\x6d\xad\x32\x64\xf3\xe0\x97\x76\x77\x7d\x2d\xf6\xc0\x5b\x11\x59\x8d\x05\x0d\xb5\xe4\x03\x58\x5f\x0d\xd7\xec\xaf\x7d\xd3\x7d\xc0\x9a\x8c\x81\x0b\x8d\x0b\x46\xd1\x67\xb8\xa4\xb3\xa4\xe3\x61\x82\x0d\x4a\x15\xed\xae\x74\x9e\x6a\x76\x1c\xb0\xb0\x32\xea\xb9\x98\x52\x93\xcd\xb1\xad\x9f\x48\x1a\x16\xaf\x3c\x92\xb9\xd1\x14\xf9\xe5\xce\x1d\xf7\x11\xbf\xce\xca\x2f\xfd\xe2\xd7\xad\xb0\x5d\x25\x57\x6d\x0a\xdf\xd9\x0f\x93\x71\x83\xbd\x24\x54\x09\x82\xc5\x6d\x48\x68\x82\x7d\x48\xf1\x34\x39\xb5\x09\xfc\x1c\xc9\x6f\x08\x25\x18\x7c\x78\x70\x1b\x8a\xa4\x69\xa2\xd7\xb4\xab\xcc\x98\x71\xb4\x4a\x2e\xdd\xd8\xce\xec\x83\x92\x64\xe4\x7d\xdc\x5c\xd0\x0c\x3e\x3c\xfa\x89\x22\x91\x45\xb5\x2e\x5b\x4d\x8b\xfd\xa3\x36\x28\x30\x02\x91\x2d\x4e\x08\x36\x7d\xb6\x22\x6a\xb2\x90\x6e\x0c\x04\x08\x52\x3f\x96\x08\xd4\x05\xba\x94\xf4\x16\x08\x62\xd0\x31\x92\x2a\xa7\x68\x03\xe7\xb1\x2f\x7f\xd2\x59\xfc\x53\xc9\x8a\xdf\xa4\x72\xf1\x28\x0a\x42\x82\xb9\x87\x16\xb8\xfe\x51\xdc\x3b\xb7\xbd\xc7\x32\x69\x46\xe7\x41\x1d\xa1\x93\x38\x28\xc5\xd7\x0c\xad\xa1\xbe\x79\x2f\x51\x7c\x1f\xf1\x45\x45\x9b\xb5\x47\x04\x1d\x29\x92\x2d\x4a\x01\x1c\x1e\xf2\x03\xdd\x2b\x2c\xf6\x89\x3b\xe8\xe9\x51\x65\xf9\xf5\x22\x25\x3b\x95\x93\x84\x92\x2d\x5e\x38\x9f\xcc\xcc\x8e\x61\xae\x5f\x7c\x36\x93\x34\x44\x82\x2a\x19\xee\x6e\xe3\x9c\xde\x98\x76\x4d\x68\xd8\x55\x7c\xa5\xac\xc0\xc7\x5d\x68\x4b\x0f\x0d\xf0\xba\x10\xc8\x66\x2e\x9a\xb6\xb7\x45\xb1\xa5\x17\xab\x68\xff\xfb\x20\xd3\xa5\xca\xa7\x2d\x07\xf7\xf2\xc2\x72\x47\x86\xb5\x17\x5b\xc1\xbf\xc8\xe0\x87\xd9\x21\x6b\x0a\x71\x8b\xdd\x45\x49\xd9\xc9\x6c\x96\xb8\x85\x0b\xd3\x5f\x10\x92\xbd\x71\x11\xb5\x1f\x52\x40\x22\x13\xfa\x7e\x32\xbc\x32\xd6\xb8\xc6\x19\xeb\x16\xa9\x0c\x31\x43\x7c\x70\x2e\xe4\xb2\xb5\x09\x69\xee\x11\x8f\xad\x26\x43\xc7\xe4\x09\xd2\x05\x20\x2d\x8f\x2a\xbf\x19\x9a\xe5\x5b\xb3\x84\x0b\x38\x18\x9a\x41\xd4\xea\xbe\x3a\x75\xda\xcc\xbe\xd9\x58\x49\x2a\xf6\xe0\xd8\x4c\xbf\x85\xa3\xcc\x70\x9e\x41\x46\x96\x83\xa6\x4b\xbc\xfb\x7a\x76\xbd\x43\xf6\x3a\x13\x9a\x54\xe3\x87\xa6\xb8\x97\x73\x97\xd0\x01\xe2\x6c\x95\x40\x53\xd2\x65\x5b\x2f\x8a\xbd\xf6\xad\x14\x9d\x2e\xa9\xc1\xe8\xd5\x91\x5e\x0d\x96\x67\xca\x31\xac\x45\x9d\x46\x72\xdf\x54\x1a\xb5\x1a\x1e\x35\x45\xe4\x49\x6e\x50\xed\x11\x8a\xd7\x7b\xeb\xe6\xc3\x2f\x86\x6f\xdb\x5a\xed\x81\x00\x1b\xce\x68\x44\x54\x79\x20\x8d\x29\x0b\x1e\x29\x43\x8b\x2a\x19\x20\x91\x89\x8b\x2f\x79\x05\x61\xf5\x88\x64\x35\xee\x31\xf5\xbc\x40\x2c\x43\xc1\x74\x27\x27\x16\x4f\xa8\x10\x3e\x0d\xbf\x3d\x17\x82\x6f\x7f\x0e\x75\x9e\x6a\x34\x28\xc9\xca\x0a\x2a\x3b\x65\xc2\x11\x6e\x58\x62\xa6\xb5\xf9\xf4\x77\x4c\x92\x66\x26\x81\xcd\xc3\x64\x9e\xee\x8a\x90\x21\x0d\x3e\xa3\xe5\xc8\xde\x77\x37\x97\x92\x21\xc5\xa5\xe6\x2a\xb5\x19\x7f\xe3\xcb\xe1\x37\x5e\x7f\x48\xbe\x52\xcd\x3e\xf4\xbe\x93\x55\xda\x42\x25\x52\x50\xb4\xd5\xe8\xee\xd5\x4b\x7e\x15\xbe\xee\x9f\x62\xea\x59\x5a\xcc\x3e\x81\xa4\xe2\xe1\xa4\xd0\x8f\x6b\x6a\x77\x4b\xf5\x16\x45\xc8\x4d\x41\x46\xe0\x3a\xef\x1f\xaa\xc2\x8f\x35\xc7\x61\xb5\xab\xb4\x34\x17\x07\xc8\xc3\xb5\x76\xf1\x14\xf9\x1c\x7f\xa4\x9e\xa3\xc5\x6a\x4c\xca\x12\x4d\x10\xf8\x3e\xe8\x4b\x6f\x49\x56\xeb\xfe\x74\xcb\x66\x2a\xcd\x84\xea\x29\xa5\x9d\x69\x55\x8b\x5d\x69\x0f\x7b\x6c\x5f\x45\x62\xc3\xca\x01\xef\x2b\xdb\x80\x87\x5b\x6b\x3d\x94\x3c\xd0\xf2\x20\x92\x3e\x9b\xa8\xc5\x16\x0b\xfb\xeb\x52\xe2\xd8\x3f\x01\x34\x69\xdb\x3e\x88\xd6\xfc\x62\x77\xfd\xae\x6c\x7c\xeb\x2d\x1a\x73\x25\xdf\xfc\x4d\xd9\x6e\xa5\x8d\x50\xed\x45\xb1\x04\x81\x86\x96\x18\xc4\x88\xfd\x04\xf8\xc3\x9f\xd1\x6c\x1b\x74\x77\x42\x8e\xc8\x5d\xb2\x12\x45\xe4\x2c\x19\x76\x62\x62\x3a\xa1\x6e\xa0\xcd\xca\xf4\x9c\x4a\x06\x3e\x97\x3a\x85\x55\xf8\x43\x72\x07\x76\x53\x72\x2b\x30\xb3\xe8\xda\x93\x02\x29\x2a\x0f\x6e\x6b\xab\xb9\xc4\x67\xdf\x93\xca\x68\x35\xfb\xd8\xde\x68\x42\x62\x5d\xd7\xcc\x6e\x69\xcd\x74\xc9\xa4\x1d\xe1\xaf\x21\xf3\xf1\x49\x08\x71\xbe\x93\x32\xb1\x7c\x91\xf9\xa2\xbc\x6c\xdd\x5b\x3f\xa9\x9b\x91\x8a\x33\x95\x51\x3a\x4c\x7a\xf5\xae\x4b\x88\xee\xb8\x68\x5c\xd9\x8b\x33\xcf\xa0\x28\x2f\x57\xd7\x0e\xcf\x41\x39\xf5\xc7\xec\x6f\x97\x6a\x14\x31\x8b\xa1\x87\x80\x84\xe6\xbc\x4a\x08\x41\xc7\x6e\x01\x21\xd5\xba\xd6\xa6\x42\xf4\x48\x20\x83\x61\xca\x9f\x61\x81\xf3\xb2\x61\x15\xcf\x85\xd5\x38\xbc\x6f\x7e\x9b\x3d\x47\xdd\x20\x39\x08\xde\xdc\x5f\x3d\x30\x4e\x07\x35\x51\xfa\x3b\xcd\xfd\x2d\x58\x6a\x90\x04\xe4\xa0\x33\x89\x79\x96\x68\xb2\x5c\x5c\x3a\x68\x16\x57\xdf\x95\x67\xad\xac\x39\xaf\x0d\x7e\x94\xa7\x0e\x88\xf2\x40\xfe\xdf\x0d\xdc\xe2\xe3\x1e\x62\xff\xd8\xa7\xee\x8c\xe9\xf2\x7a\x7f\x71\x60\x24\xdc\xbf\x51\x87\x34\x87\xc7\x36\xe3\x70\x25\xc4\x82\x11\x09\xe8\xb7\xad\x93\xab\x8a\x03\x57\xf2\x44\x16\xe7\x04\x89\xd0\x28\xbc\x6d\xa0\xec\x78\xc8\x2c\x4d\xa5\xa2\x3b\x7e\x36\x75\xb3\xef\xc9\x3b\x34\xa9\x3c\x78\x04\x14\x8c\x9d\xec\x19\x77\xfa\xc5\xfc\x77\xbf\x4b\x7d\x60\xf6\xe2\x27\x4e\xf3\x00\xa9\xfe\x1d\xb4\x32\x0e\xa9\x83\x7b\xba\x9f\x3e\xc6\x71\xe4\xf0\x89\x19\xac\x6e\x40\xf3\xe6\x96\xcd\x5d\xd9\x65\x8a\x99\x45\xaa\x58\x08\x9a\xbc\xc4\xa0\xcf\xb5\x93\x1f\x15\x9e\x70\xa3\x47\xd0\x7d\xed\x33\x13\x52\xf4\xb2\x60\x44\xe3\x89\xeb\x46\x95\x4c\x8b\x92\x7b\xc8\x67\x1b\xc4\x66\x56\xd7\x97\x38\x66\x9c\x69\xad\x0a\xa8\x1a\x3e\x40\xfe\x4d\xdc\xb2\xd7\xbe\x29\x23\x52\x59\x1c\x8a\x6c\x7d\x74\x3f\xd0\x46\x5e\x7d\xc6\x52\x80\x8a\xaf\x62\x11\xaf\x44\xad\x8b\x36\x4f\xae\xa5\x92\x77\xee\x82\x4b\xc9\xcb\xa0\x2b\x1d\x84\x57\x55\xb2\x3f\x36\xb5\xdf\xa6\xd6\x10\x18\xa8\x00\x84\x4c\xed\xad\x07\xdf\xf8\x36\x99\x95\x24\xe1\x40\xbf\x36\xe3\x58\x40\xd5\x55\xdf\x1d\x4f\x37\x9b\x51\x2b\x7e\x25\x8c\xd1\xd8\x64\x58\x0f\xc5\x3d\xbd\xfc\x1a\x9b\x32\x23\x6e\x82\x54\x04\x0c\xe7\x94\x14\x81\x0c\x99\xf5\x80\x17\xc1\x16\x7d\xdd\x3c\x72\xaa\x16\x55\x63\xd9\x8d\xb5\xb4\x83\xaf\xd3\x79\x47\xf6\xf0\x12\x75\xcb\xb1\x89\x1f\x7b\x83\xf6\x0a\xe4\xca\x0a\xe2\x10\xdd\x53\xaf\x4a\x80\xab\xef\xcb\xc2\x1d\x38\x2e\x90\x5a\x16\x71\xf3\x76\xc6\xea\xa5\x64\xe2\x6b\x55\x19\xbe\x81\xb0\x48\xdb\x4d\xcc\x29\xd5\x65\xeb\x86\xf6\x69\xdb\x64\xfb\x49\xaa\xa7\xe7\x38\xc2\xaf\x9f\xd9\xbe\x3c\xd0\x4f\x95\xc8\x3f\x74\xf5\x3b\xab\x7e\x2f\x41\xf3\x34\x64\xd0\xb6\xa9\x85\x22\xc7\x57\xf1\xab\xfa\xd7\x12\x59\xe0\xeb\x2c\x26\xea\x70\x8e\xe2\x66\x2c\x39\x94\xa0\x68\x0e\x52\x68\x78\x2c\xcd\xeb\xfc\xf5\x15\x56\x0e\xde\xd3\x69\xda\x8b\x60\x0b\xe1\x86\x8d\xe3\x5a\xae\x42\xe5\x50\x4e\xf7\x00\x8c\x79\x71\xe5\x3e\x86\xf4\xee\xeb\xf0\xa7\x37\xf2\x27\xca\xa7\x7c\x69\x7f\x7c\x8b\x3f\x8e\x1c\x76\xaf\xaf\xfa\x64\xab\x57\x23\x7f\x7b\x33\xf2\xb7\xb7\xf1\xdf\x8e\xad\xd8\xf5\xde\x99\x83\xed\xcd\x24\x70\xdb\xe7\xe7\xc0\xf8\xa3\xc2\xd6\x28\x67\xe6\xb2\x5e\xd6\x75\xf5\xa4\x2d\x5e\x29\x07\xde\xb0\xd1\x6b\x02\xd9\x6a\x74\x22\x7a\xaa\xc9\xb6\xef\xe1\x45\xe9\x5b\x38\x6c\x6a\x9d\x8e\x4b\xf6\xf2\x34\x15\x3a\x4c\x5c\x73\x4a\xfb\xfb\x81\xce\xc1\xec\xae\x2e\x25\xc1\x25\xc8\x77\x72\x76\xc4\x32\x85\xd9\xae\xab\xc1\x7f\xa6\x4a\x04\x0a\xf0\x96\x13\xac\x4c\xb5\x0b\x4f\x62\xd4\x53\xba\xcd\xf1\xce\x9e\x48\x49\x2d\xca\x6c\xe6\x4d\x2d\x44\x3b\xaf\xc2\x1c\xb1\x49\x26\x07\xb9\x3f\x77\xb7\x4d\x86\x67\x64\xab\xa8\xb3\x09\x16\xc5\x9b\x5a\x08\x4c\xd7\xeb\x02\xb3\xa7\x5c\xa3\xb1\xd0\x16\xbc\x86\x82\xe1\x54\x56\xa5\xc8\xbc\x17\x75\x4f\x6a\x5b\x13\x32\x02\x16\x4a\x44\x83\xb3\xc1\x3d\x2e\x62\xa8\xe1\x17\xb0\x4c\x9b\x18\xbb\x33\x8f\xe8\x6c\x34\xd6\xb5\x1e\xa3\x76\x59\xb3\x0a\x94\xb1\xf8\xc3\x4c\x55\x40\x32\x05\xf0\x8f\x25\x83\xf1\x84\x95\x3c\x2e\x59\x48\x5c\xd4\xc7\xec\x15\x13\x9b\xc6\x03\x25\x66\xb3\x68\x5a\xa2\xd9\xbb\xfd\xc4\x9e\x02\x11\x8e\x94\x10\x20\x9e\x7c\x55\xbc\xf5\x6b\x90\x19\x2e\x66\xd9\x12\x3d\x4a\x3d\x23\x45\x62\xe6\x50\x51\x8b\x87\xe9\xce\xaa\x37\xd1\x5d\x08\x61\xca\x43\x8c\x1c\x16\x11\x0a\x8a\xa2\x8c\x69\x37\x64\x0d\xc8\xcd\x1a\x54\x81\xc1\xa4\xe9\xd6\xcc\x56\x43\xf6\x78\xd9\x48\xab\x15\x20\x52\xad\xb7\xcd\x3e\xb7\x79\x27\x19\xfa\xde\xc0\xd9\xa1\x1b\x0b\x48\xa5\xbf\xb6\x55\xe0\x3c\x8c\xdd\x72\x80\x6d\x8b\xae\x33\x1c\x8a\x87\x43\x18\xd0\x7d\x57\xa1\x56\xb3\xc4\x41\x76\xea\xfd\xc4\xa9\xdf\x75\xb9\x29\xbb\x40\x27\x94\x69\x56\xb6\x4c\x05\x81\x74\x01\x55\x9e\x38\x2c\x7e\x83\x5e\x44\x55\x5a\x36\x09\x73\x99\x8b\x3b\x26\x3c\x65\xf3\x44\x0b\x48\x82\xd7\x58\x11\xc8\x17\xad\x25\xaf\x54\x33\xfb\xef\x0c\xc9\xb4\x75\x86\xed\x41\x32\x3c\x08\xf5\x46\xb0\x61\xbf\xbe\x3e\xa7\xc9\x68\x92\xd8\x20\x57\x9d\x81\xe2\x66\x07\xd8\xdb\x3d\xdc\xb3\xd0\x63\x74\x0f\xce\x64\x0d\x01\x34\x69\x22\x38\x41\x3c\xb5\xc7\x09\x4e\xf3\xcf\xc0\xba\xfc\x37\x30\xa9\xff\xb9\xc8\x86\x3d\xa2\x5f\x3d\x96\x21\xe6\x54\xb2\xf2\x71\x1e\xda\x9f\x0e\x35\x7e\xde\xc3\x6c\x61\xc3\xa1\x0d\x02\xd1\x94\xb0\x9d\xdd\x4b\x9b\xb5\xe7\x99\x4d\x1c\x32\x12\x33\xa5\x0d\x32\xec\x3e\x6f\x7d\x8b\x7e\x58\xdf\xc3\x6c\x82\x48\x74\x63\xf7\xce\x23\xd0\xf4\x1c\x09\xd5\x6c\xbd\xff\x4d\xdb\x41\xda\x6e\x57\x55\xa6\xa1\x86\x6c\x5e\xc9\x55\xc6\xac\xfb\xd8\x25\x9d\x2f\x58\x49\x6a\x22\x6e\x80\x82\x57\x65\x88\x41\x4c\x12\xf2\xa2\x8f\xe9\xf9\x9a\xb4\x3e\x00\xf9\x9f\xb6\x3e\x7e\x7a\x3a\xa7\xe8\xf1\xcb\xe3\x88\xff\x7e\x36\xec\x7f\xc7\xca\x55\xc6\x2a\x93\xe8\x27\x0a\x67\xb9\xe2\x6f\x30\xd4\x62\x9d\x72\x5a\x1c\xf4\x74\x08\xf6\x2c\x82\xda\xf1\xf0\xf5\x2b\x7e\xb6\x25\xb3\x15\xc1\x3a\xa9\x87\xb0\x2a\xee\x49\x99\xd3\xa9\xaf\xf0\xb3\x99\x86\x18\xf4\x9e\xac\x84\x33\xdf\xf8\x27\xb7\x58\x07\x6f\xfc\xc3\x7f\xab\x37\x2e\x90\xc8\x83\x6f\x1c\x86\xc4\xc5\x3a\xeb\x4d\xd2\xa3\x3a\x69\x01\xcc\x23\x5a\x0f\xe7\x23\x6e\x16\x14\xb6\xd7\xb3\x3f\x72\x10\xf9\xee\xdf\x1d\x7e\xf7\xd8\xf3\x62\x54\xce\x5b\x01\x07\xd8\x85\xe4\x52\xa3\x75\xf7\x83\x91\x64\x36\xda\x06\x62\x12\xe8\xed\xe1\xce\x0c\x1b\x7b\xa0\xee\x9d\x4e\xdb\xd1\x64\x7b\xf1\xa9\xfe\x2c\x26\x7e\x52\x4b\xf6\xe8\xd1\x75\x18\xe0\xfc\xe6\x39\x97\xea\x41\x74\xf3\x5f\x19\xc2\xb5\xe5\xaf\x71\xd7\xfc\x09\xb6\x82\xc4\xb4\xf0\x0d\x28\x73\x4a\xb4\x6c\x7c\x4c\x70\x95\x7f\x30\x75\xdd\xa3\x12\xbc\x4f\xdb\x46\xfc\x7c\x2a\xe6\x76\xc8\xf7\xf2\x10\x1f\xce\x56\x31\xff\x20\x89\xf4\x29\x59\x02\xa4\x96\xcb\xbc\xbc\x2e\xa5\x8e\x33\x29\xb7\xf5\xf9\x77\xca\x6d\xfd\x75\x6a\x0e\xe0\x88\x9d\x3c\x48\x82\x77\x96\x09\xfd\x9c\x20\x7f\x28\xee\x54\x7b\x56\x68\x90\x0c\x2f\x0d\x6f\x5e\x13\x23\xe8\x97\x23\x78\x26\x77\xaa\xa1\xb2\x65\xeb\xb1\xaf\xc8\xaa\x2c\x4a\x55\x0c\xe9\xbf\xfb\x54\xc3\x49\x2e\xfb\x84\xe8\x59\xac\xdc\x54\xd9\xb1\x2c\x1b\x78\x4e\xf2\x2f\x86\xd1\xc2\x6f\xbf\xd7\x3b\xc4\x7c\xca\xed\x0d\x8f\x06\xb2\xf2\x64\xcd\x2d\x35\x1a\x2d\x7f\x87\xde\xdf\xb9\x48\x07\xc3\xa4\x72\x8c\x55\xdd\x69\x25\x14\xe7\x48\xee\x36\x52\x5e\x37\x53\x06\x99\x0c\x6d\xbd\x57\x25\x51\xf8\x96\x15\x6f\xb7\xa3\x8c\x18\x99\xa7\x09\x11\x76\xef\xf8\xc6\x5a\x91\x85\x76\x38\xdb\x6c\xd1\x79\xfb\x90\x9b\x51\xd1\x54\xba\x21\xca\xf5\x11\xe9\x01\x50\x18\x94\x4b\xe6\xe5\x27\x4d\xfc\x4d\x89\xe2\x07\x94\x7b\xe7\x2a\x6b\x73\x17\x34\x6c\xa3\x8b\x49\x36\xb4\x52\x68\xf6\x06\x89\xb2\x52\x42\x50\x5d\xef\x53\xee\xa6\x07\xda\xb2\x5e\xd7\x4d\x50\xc5\xde\xb8\xb7\x66\xf3\x24\x84\xd7\x5a\x57\x8d\xe9\xc0\xf5\x73\x65\x15\x39\xef\xb6\x9e\x47\x22\xa2\x4b\xef\x20\x7d\xa1\x9a\x53\x51\x27\x68\x29\x9d\xca\xe6\x66\x20\xcc\x8b\x79\x62\x03\x37\x44\xcc\x20\x1f\x9d\xc1\xfc\xa6\x94\x7b\xdd\x09\xfc\x80\xda\xc4\x66\xb7\xee\x4a\xf4\x23\xc0\x2c\x45\x4a\x5d\x4c\x07\xc9\x6b\x71\x67\x7f\x11\x42\xed\xba\xba\x4c\xd6\x8a\xdf\x83\x8c\x64\x0b\x69\xb3\x12\x5a\x5b\x83\x29\xb0\x49\x5b\xfb\x9d\x64\x1f\x55\x11\x85\xac\x6f\x8b\x9a\xe6\x0d\x58\xeb\x0e\x2c\x59\xee\xac\xd6\xb7\x17\x93\x1a\x9e\x9e\x90\xc6\xeb\x69\x1b\x93\x99\x8b\x3d\xe0\x94\xf2\x25\x08\x91\x20\x79\xe2\x08\x77\x43\xe6\x16\x64\xd7\x73\xed\xaa\xa7\x59\xa8\x08\xa4\x54\x4a\x46\x22\xe4\xad\xf3\x3f\xae\x3a\x46\xc8\xc5\x49\xca\x0c\x31\x85\x80\xba\xbb\x34\xc5\xa4\x2c\x12\x9e\xf9\x58\xd9\xcd\x97\xf9\x2d\x64\xa5\x63\x42\x0a\x3d\x32\x14\xd4\x31\xcb\xfb\xca\xf7\xbf\x87\x0c\x62\xcc\x36\xa9\xc4\xf4\xa2\x11\x2a\x7d\xbf\xba\x84\xd9\x6b\x23\x0e\x41\x98\x1b\x70\x9c\xe6\x61\xc9\x71\xe6\xd8\x09\x6f\x77\x3f\xb1\x75\x08\x3b\x51\xcc\x71\xb9\x29\xce\x00\xca\xa5\xb2\x47\xc8\xdb\xae\xac\xbd\x34\x05\x01\x7d\x7b\x57\x12\x09\xe4\xdc\x59\xf7\x22\xbe\x36\xce\xb3\x69\xab\xbe\x65\x06\xfb\x40\x7d\xed\xd1\xa2\x8d\x58\xf1\xb3\x17\x08\xd8\xc8\x4a\xe3\x5e\xf4\xc5\xb3\xa5\x72\x26\x54\x1d\xbe\xe5\x49\x47\xd3\x44\xdb\x5d\xa0\x81\x6a\x4f\xe7\x58\xe5\xb4\xdd\x2f\x6e\xa2\x0a\x99\x70\x6a\x0b\x65\x04\xfa\x9c\xda\xb4\x15\x0d\xd7\xf0\x54\x8a\x09\x1a\x8c\x47\x39\x93\xca\xbb\x66\x4b\xb1\x88\x94\xb4\x3e\xa6\xb7\xe7\xbc\x4c\x22\xe4\xfd\xce\xdc\x51\x93\x37\x22\x45\xaa\xbb\xf1\x07\x41\xd0\xa2\xb2\x0f\x38\x97\xac\x37\x19\x1e\xc6\x9d\xa9\x29\x3d\xce\xc1\xf5\xfa\xf2\x2f\x45\xfe\xc7\x38\x7d\x15\xd1\xe0\xc3\x26\xf8\x2d\x3b\x40\x4a\x93\x96\x62\xc0\x7e\xaf\x0a\x79\x21\x6c\xab\x8f\xf7\x35\x5c\x5d\xfe\xcc\xb5\x71\x68\x0c\xb0\x51\xcf\x3d\x86\x37\x97\x7f\x2f\xe0\x0d\x1e\x1a\x83\xb7\x8a\xcf\x3d\x90\xb7\x97\xdf\xbb\xe3\xb1\x3a\x34\x8e\xa4\xde\xfa\x6c\x83\x78\x77\xf9\xa7\xf5\xee\xe0\xfb\x88\xa4\x11\x9e\x6d\x04\xef\x2f\x7f\xa8\x5c\x94\x54\xff\xf1\x38\xdb\x44\x89\x9c\xa6\x8b\x21\x21\x42\x9b\xb3\xce\xb3\xd1\xf6\xaf\xea\x09\xd2\x0f\x52\x4c\x09\x83\x5e\x73\x63\xb2\xf0\x03\x17\x46\x5d\x9c\x9e\x69\xd7\x5f\x09\x48\x8c\xdd\x8c\x72\x76\x44\xbf\x28\x23\x51\x62\xb0\x71\x58\xcd\x47\x18\x02\x59\x73\x80\x67\xb3\x08\x8c\x75\x04\x8e\x15\x1d\xfa\x09\x8b\x3c\xe4\x7f\xb5\x3d\x5c\x06\x58\x08\x15\x11\x6e\xcc\xd0\x3f\xaf\x51\x10\xa8\x0a\xb9\xc2\x42\xd4\xf1\x8a\xe5\x0e\x6e\xd5\x5c\x3a\x2e\xc8\x15\x89\x87\xa6\x2a\xd2\xa0\xc6\x08\xe9\x3a\x55\x86\x51\x97\x2d\x9b\xdd\x96\xd5\x51\xcc\xca\x97\x97\xff\x59\xd6\xeb\xa2\x3b\xfd\xe2\x16\x2e\xf6\xce\x0f\xbd\xb5\xb1\xe2\xac\xbe\x2f\x77\x24\x34\xdd\x88\xb3\x8a\x3a\x6d\x9a\x2a\x92\x96\x79\xb6\x35\xe0\x5e\x95\xfb\x28\x7d\x3d\xd2\x84\x31\x4a\x71\xde\x87\xf3\x1d\xc4\x71\x5a\x34\x69\xfd\xc8\x26\xc2\x9e\x1b\x53\x5b\xcd\xaa\xb1\x7f\xda\xb8\x9b\xa6\x4f\x4a\x57\xb6\x8a\xc7\xe1\x5d\x22\x85\xac\x5e\x8b\x1b\x66\x4f\x68\x1b\x72\xfe\xd9\x5a\x00\xfb\x84\xbb\x6c\x5d\x53\x61\x46\x7a\xea\xbe\x88\xf4\x11\x9b\x86\x09\x91\x6f\x89\xe7\x57\x9c\x56\xd1\xbe\xbc\x38\xf2\x72\x3f\x5c\x7e\x92\x1e\xc3\xc4\x34\x30\x1b\xd7\xec\xdc\x4a\x42\x35\xed\xbe\xe6\xe1\x0a\xf9\x45\x54\xf9\x89\x0c\x02\x4c\x0a\xed\x67\x38\xb7\x4c\xa2\xfe\xc3\x08\x48\xe3\x43\x1f\x7b\x31\x51\xba\x20\x38\x34\x7f\xcb\x26\x16\x1b\x3e\x3e\x29\x00\xe3\x08\x47\x49\x94\x25\x3c\x57\x7f\x27\x08\x03\xcc\x53\xef\xc5\xaf\x42\x75\x5f\x14\x45\x81\xa2\xaf\xb0\xb0\x6d\xb3\x7f\xee\x0a\x12\x8b\x7a\xca\xcf\xaf\x94\xe7\x02\xeb\x71\xee\x59\x6d\x45\x4c\x95\x10\x1a\x2a\xc0\x28\xe6\xee\x72\x36\x82\x0f\x3b\x4c\x99\xd8\x03\x4c\xfb\x2f\x7a\xd9\x4c\x8e\x44\x31\x1a\x4a\xeb\x2e\xa3\x7a\x13\xb4\x06\xa1\xf3\x58\x50\x46\xe5\xd0\xbd\x13\x5e\xde\x93\xc9\x99\xcf\x76\x5f\x6b\x5c\xf1\x3c\xdf\x07\x35\xdd\x9c\xcb\x56\x6a\xf5\x56\x7f\x1b\xe9\x86\x6d\xf9\x7e\x3c\xda\x30\x33\x7e\x17\x03\x4b\xa3\xc7\x2b\x61\x34\xb0\x63\x81\xb6\x66\x1e\xe8\x89\x35\xa0\x49\xa9\xfd\x98\x69\xcc\xa4\x85\xac\x33\x9a\x13\x19\xc2\x0d\xe0\x25\x0b\x96\x06\x2d\x69\xb3\xeb\x66\xa9\x4e\x4b\x42\x63\x92\x97\x9b\xb0\x32\xa4\x15\x4b\xce\x0b\x10\x9b\xe9\x17\x25\x29\x96\x45\x0d\x62\x82\x12\xcf\x5d\x08\x74\x5d\x31\xdf\xa0\x81\x13\xc2\x97\x61\x67\xf4\x50\x8f\x21\xf4\x08\x59\xef\x80\x3e\x40\xcc\x84\xcf\xa9\xee\xcb\xfa\x85\x84\x05\x96\x96\xff\x1d\x41\x99\xe4\x00\x94\x37\x7e\x15\x98\x98\x85\xe6\x84\x27\x41\xeb\x4f\x6c\xe9\x4c\x1a\xc3\x77\x4f\x47\xb6\x29\xec\x51\xad\x75\xaa\x70\x34\x65\xad\xe1\x8c\x25\x2b\x20\x1b\x98\xd1\xea\xcd\x00\xad\x55\x9e\x69\xed\x0e\xea\xc5\x12\x8a\x14\x89\x4e\x03\xed\x03\x6d\x43\x73\x05\x37\x0f\xa9\x23\x7a\x12\x18\xf7\xc6\x56\x08\xcc\x85\x28\x17\x17\x72\xd3\x90\x3b\x09\xef\x48\x3b\x03\xf0\x23\xea\x34\x63\x6c\xc4\x72\x84\x05\x5e\x2b\xb6\x3e\x5a\xeb\x1c\x01\xbf\x7c\x04\x3d\x3f\x1e\xbd\xc8\xa4\xf6\x78\x28\x99\x80\x92\xb8\x15\xbd\xd8\x30\x5b\x45\x2b\x42\x82\xed\xb2\x29\x17\xf8\x5e\xb1\x3e\x8d\x9f\x92\x29\x97\x28\x59\xb5\x17\x50\x23\x27\x77\xe8\xc8\x34\xcc\x43\xcf\x29\x63\xbd\x46\xce\xf6\x3b\x3a\x2c\x73\x12\x70\x83\x78\x3b\xa0\xb0\xd1\x32\xe4\x1f\x1d\xe5\x28\x50\xe8\xeb\x55\xcb\x36\xbe\x25\x41\x37\x62\x66\xdc\xbd\xfb\x06\xa6\xd7\x50\xfb\x91\x5d\x55\xa0\x81\x41\xaa\x61\xbd\x96\xef\x78\xf1\x08\x76\x96\xeb\x79\x26\x6f\x17\xdd\x8d\xd8\xc6\xce\x41\x93\x16\xda\x15\x61\xb7\x83\xd2\xca\xf1\x40\xcf\x87\x79\x63\x7a\x67\x4f\x16\xe8\x79\x85\x80\xb2\x0d\x33\x65\xa6\x77\x1e\xe7\x30\x69\xbc\x72\x2f\x92\x22\x00\x96\xd1\xf5\x2d\xd6\x50\xae\xd6\x7f\xdd\x78\x6d\xb9\x16\x72\xa2\x16\xb5\x24\x4a\x21\x7e\xe9\xd8\xd4\xc4\x01\xe8\xd8\xec\x3c\x59\x08\x7a\x62\x76\x1e\x37\x35\xf1\x92\x94\xe3\x28\xe2\x10\x3d\x8a\x6e\x8f\x62\xdf\xb1\xc7\x7f\xda\xe8\x57\xaa\x83\x67\xbc\x4d\x26\x92\x20\x8c\x34\xf5\x75\x46\x21\xf4\xd8\xe3\x3c\x61\x10\x3d\x13\xae\x78\x39\xa8\x55\xf5\xa2\x49\x7b\xbd\x19\x2d\xb0\x84\x5a\x37\x9e\x79\x43\x6a\x8a\x01\x0f\xb0\x2d\xc4\xd7\x69\x90\xd9\x93\x1b\xd5\x6b\xb7\xe6\xb4\x62\x30\x0f\xd6\x21\x2c\x9d\xa3\xf2\xee\x3e\x7e\x3f\x21\x6e\xf8\x3b\xbf\x4a\xb7\x31\x63\x4b\x73\xf2\x4d\x1e\xcf\x02\xc4\x01\xdb\xff\xe4\x01\x9e\x3e\x0f\x10\x71\xb8\x1c\xc8\x04\x9c\xb0\x67\x2a\xe7\x1a\xb4\x70\xc4\x87\x9c\x6e\xc2\x12\xb7\x97\xf1\x2e\xed\x5a\x9e\xed\xf5\x4f\xb2\xc3\x58\x5c\x13\xfe\xe0\x91\x4d\x17\x37\x8e\x1f\x5b\x6c\xc7\x33\x17\xf1\x62\xfb\xaf\x9d\xbb\xf8\xbf\x2a\x5d\x11\x9b\xa0\xe1\x01\x28\xba\xd8\x4a\x3c\x72\xf6\x62\x09\x06\x1a\xc6\xe1\xb0\x36\xf1\xcb\x07\x4a\x2e\xfe\xe2\xfc\x40\xe0\xa3\xbf\x5b\xbb\x7f\x3d\x79\x9b\xd3\x83\xeb\x8e\x27\x73\x16\x87\x8a\x8c\x1f\x67\xab\x35\xbb\x42\xa8\x90\x85\x87\x3a\x20\x57\x8a\x0d\x2f\x9f\xa7\xac\x12\x03\xc7\x59\xaa\x2c\x48\x6f\x54\xda\xd8\x88\x50\x14\x60\x10\x5e\x2d\x60\x96\xa3\x4b\x8e\x04\xcc\x65\xac\x92\xa0\x2d\x70\xa7\x23\x65\x0d\x94\xc7\x29\x38\x23\xc6\xcd\xb8\xea\xac\xf4\x9b\x3e\x60\xc6\xc7\x28\x43\x4e\x69\x2e\x64\xdc\xa2\xad\xcb\x9e\x60\x8c\xcf\x84\x98\x46\x29\x12\xf3\x9a\xe4\x72\x75\x30\x10\x1a\x55\x1c\x42\x45\x89\x47\x92\x0c\xb1\x62\x14\x43\xa6\x2e\xd6\x2a\x7d\x95\x1e\x0a\xaf\xfe\xa6\x12\x38\xf5\x9b\xaf\x53\x3d\xa4\x97\xb3\x1f\xc5\xe8\xa9\xc7\x1a\xb7\x5f\xf7\x7b\xae\xd3\x4a\x02\x60\x56\xc1\x13\x78\x64\xeb\xd8\x87\xa3\x9d\x63\x1f\x06\x8d\x63\x1f\x7c\xdf\x58\xf8\xeb\x5b\xf7\xd7\xc9\x5d\xd2\x87\x53\x96\x57\xc3\x3f\xbd\x19\xfe\xe9\x6d\xf4\xa7\xa3\xf6\xe1\xba\xc9\xd0\xc9\x3b\xfb\x41\x29\xd8\x27\xa1\x13\x9e\x98\x3a\x7d\x3e\xfb\xf1\x08\x68\x8c\xde\x82\xd8\xd9\x35\x78\xa3\x9f\x56\xef\xaf\x07\xaf\x32\x86\x7c\x21\x96\x32\x35\x95\x2c\xd5\x53\x89\x18\x57\x47\x44\x55\x52\x34\x35\x75\xe1\xb5\x69\xa2\x95\x55\x14\x53\x9e\x7a\xa4\x3d\xd2\x7c\x8d\x36\x56\xc5\x3c\xfd\xe5\x86\x74\xfa\x5b\x91\x66\xf4\xae\x53\x15\x5c\x83\x45\x31\x14\x6c\x79\x13\x09\xb6\x04\x94\x81\x32\x41\xf7\xe9\x53\x3d\x20\x57\xf9\x27\xfc\xed\x85\xa2\x54\x2c\xd9\x3d\xdd\x01\x20\x05\x68\x92\x28\x8f\x67\x38\xcc\x65\x53\x6e\x49\x18\x28\x4d\x2f\xc4\x7e\x60\xbb\x03\x04\xe4\x05\xa1\xd4\xe2\x4f\xa3\x52\xf5\xd3\x12\x51\x17\xe8\x14\xcd\xd3\x31\x42\x73\xae\xba\xd6\xf6\x95\x50\x34\xd1\xc6\x2b\xc9\x5c\x9b\x54\x0f\x5a\xe4\x1b\xcb\x42\x4a\x1e\x48\x93\x2e\xc9\x25\x57\xa5\x32\x56\xeb\x05\x98\x1f\x27\x9a\xbd\xf5\x7a\x5d\x11\x5f\x51\xce\x47\x16\x0b\x1f\x4e\x36\xd3\x84\xa6\x9d\x94\xe4\x50\x72\x13\xeb\x99\xd3\xa5\x91\x9f\xb6\xe2\x9f\x53\x94\x49\x98\x23\xbb\x54\xeb\x19\x5f\xc4\x20\xe7\x68\x49\x83\x8f\x99\x49\xea\x15\x24\xe3\x9e\x09\xb7\x0c\xab\xc7\xb3\x57\xba\xc5\xac\x4e\x4a\xb6\xcb\xb9\xde\xfc\x4d\xd4\x1f\xe4\x2b\x18\xb1\xd4\xd6\x1a\xdf\xd3\x5c\xe1\x22\x23\xa1\xec\xf8\x16\xc3\x19\x0b\xd5\xc4\x58\x7d\x03\x6b\x2d\x6b\xf0\xf1\x4a\xf6\x98\xd0\xe7\x44\xcc\x75\x1a\xcc\x1a\x97\x5b\x5e\xd7\x42\x9d\x1d\xee\x66\xc2\xdd\x41\xbc\xa9\xb6\xa9\x13\xb6\xcb\xfb\x5e\x3e\xb4\xcd\x94\x04\x6c\x59\x37\x0d\x92\x5d\x82\xab\x77\x3e\xed\x4d\xe3\x66\xe7\x28\x51\xac\x29\x0d\x7b\x31\x1a\xb5\x01\x8a\xe7\xc1\x69\x82\x7e\xb9\x8c\x61\x9a\x86\x48\x89\x06\xcf\xa2\x58\x66\x4a\x66\x82\x95\x3c\x48\x7c\x53\xaf\xb2\xe7\x1a\x93\xa2\xe1\xb0\xe6\x50\xa9\x3d\x76\x55\x84\xde\xb0\x3a\x43\x2a\x01\xe4\x99\xde\x43\x78\xf0\x78\x7d\xa2\x68\x66\xc1\x88\x53\x34\xe2\x80\x45\x5b\x8c\x04\x9d\x36\x9e\x79\x9f\xf6\xcc\x12\xf5\x21\x8e\x2b\x23\x91\xe4\x6a\xef\x0c\x5e\xc9\x96\x23\x95\xe9\x54\xbb\x74\x23\xe4\x73\x07\x85\x9c\x8e\x1e\x84\x44\x51\xfe\x80\xdb\x4c\xc2\x2b\x9d\x73\x04\xbe\x7b\xa5\x64\xef\xa7\x5b\xac\x05\xf2\x89\xfe\x53\xb7\xa6\x4c\x13\x72\x93\xe5\x4a\xc6\x6c\xc0\xd2\xb6\xcf\x99\xf5\x6e\x76\xbd\x3d\xbf\x19\x3b\x07\x38\xeb\xd9\xcf\x53\x00\x47\x97\xf5\x76\x9f\xee\xf8\x80\x12\x1d\x53\x09\x2b\xe4\x17\xf4\x5a\xd5\x30\x74\x7c\xf3\x9e\xee\x66\x78\xcc\x7a\x20\x9d\x5b\x3c\x95\x36\xb0\xce\xd9\x6f\xd0\xe4\x04\x49\x23\xeb\x82\x4d\x7a\x27\x5a\x80\xf5\xc2\x85\xcb\xcb\x75\xd1\x3f\x75\xc1\x00\xa5\xc2\xa0\xb2\xae\xa8\xce\x40\xbe\xba\x9d\x4a\xf2\x74\xee\xae\x9e\xc4\x35\xb5\x34\x3d\x36\xfc\xde\xc9\x71\xae\xe2\xd6\xdf\xfb\x78\xc5\xb0\xdf\x51\xbe\x92\xe3\xc5\xdf\x5c\x01\xb0\xac\x89\xdb\xd6\x2c\x14\x66\xc8\xe4\x41\x09\xe2\xf3\x56\x1c\x19\x6d\x37\xa5\xaa\x83\xe7\x22\xc7\xa6\x44\x73\x2f\xa9\x7f\x42\x51\x54\xbc\x0d\x17\xd3\x2e\x5b\xb9\xb0\x9c\xa5\x2e\x44\x91\x53\xd2\xed\x9d\xf5\x7e\xca\x73\xc4\xfb\x9a\x4d\xa1\x7b\xf5\x2f\x6e\xa4\xa4\x17\x7d\xc2\x73\xd6\x87\x43\x54\x0a\x53\x42\xaf\x56\x14\x11\x7e\x8e\x74\x96\x04\xeb\x2e\x92\xa5\x46\x20\xe3\xcb\xc4\xf7\xec\x13\xe0\x06\x92\x0e\x0e\x21\x01\xaf\x94\xcf\x4f\xfe\xc6\x4b\xd5\xf7\xd2\x0e\xbf\xf0\xec\x4e\x96\x81\xf2\x4c\xbd\xbd\x0b\xcc\xfd\x27\x56\xd3\x63\xdc\x57\x64\xab\x42\x08\x4b\x75\x01\x7a\x0a\x25\xf0\x2a\x45\x02\x96\xed\xff\x9c\x2d\xff\xf7\x9f\x2d\x2a\x0f\x2c\x4b\xf0\x5b\xb6\xfc\x4e\x22\xb8\xfa\xf8\xbb\x34\x34\x3f\x54\x8c\xb9\xcf\x6b\xf5\x4d\xd1\x16\xcd\x21\x5e\x2b\xff\xb7\xff\x04\x5b\x03\x42\x84\x88\xec\xaa\x8f\xb1\x3d\xd2\xe6\x33\x72\xe4\x0c\x35\x5f\x98\xf9\xed\x73\x73\xf8\x56\x6a\xd6\x35\x53\x98\x3a\xf2\xe1\x56\x32\x50\xb8\x77\xdc\xd6\x76\x79\x1c\xc7\xce\xfb\x13\xc6\xfe\xa0\xf5\x70\xc7\x8e\x95\x7c\xf6\x7d\x5d\x0f\x65\x91\x5e\x3f\xf3\xb2\xa0\xdf\xe1\x16\x37\x7a\xd0\x49\xe9\xd8\x00\x65\x9c\x13\x1f\xd3\x96\xeb\x3b\xc5\x1b\x97\x0d\xe6\xf5\x01\x24\xd7\xe3\x8b\xeb\x54\x02\xd0\x16\x98\xae\x9a\x68\xf9\x7c\x53\xdc\xd5\xb4\x16\xe7\xf4\x86\x8d\x35\x33\x8e\xf0\xcb\xce\xb9\x0e\x8e\x2c\x1c\x69\x7c\xef\xa4\x5c\x62\x76\x6a\x09\xb1\x04\x84\x98\xe8\x30\x60\xd5\xdd\x27\x9c\xdd\xba\x59\x14\x8d\x3c\x9f\xbb\xba\xe7\xda\x06\x5c\xc3\x70\xf6\x60\xb1\x04\xd1\x36\x39\xb6\xd5\x3f\x90\x16\xc6\x93\xda\xa7\x61\x21\x91\x9d\xad\x25\x22\x04\xf1\x9f\x67\x61\x8b\xb8\xf7\xd3\x4e\x08\x71\x94\xe0\x97\xe4\x77\x40\xc0\x6b\xfd\x4d\x72\x83\xc8\x1b\xb5\x69\x77\x83\xaa\x1c\xc6\x24\x25\x48\x26\x86\xc2\xcb\x18\xc5\x00\xd7\x12\xa6\xbd\xf5\x54\x2a\x9b\x7e\x1b\x31\x35\x0f\xdc\x20\xf3\xfd\xb4\x6b\x48\x43\x4e\x0f\x07\x15\xb5\x24\xa7\xcf\x03\x73\x5e\xdc\xf7\x4a\x25\xd1\x73\x35\xee\x35\xe0\xfb\xe7\xf4\xa1\xf7\xb6\xaf\x30\x89\x4d\xea\xf2\xfd\x7d\xcc\xf9\x39\x96\xdb\xef\x2f\xb1\xc4\x87\x54\x05\xeb\x74\xd2\xad\x50\x84\xed\xc0\xa7\xc7\x8b\x62\xde\xbc\xd8\xa1\x8e\x02\x8a\x3a\x56\x12\xf9\x21\xbf\x1d\x84\xbd\xb0\xc5\xb4\x40\x9e\xad\x90\xce\xef\xda\xe8\x1d\x0e\xae\x03\xad\x23\xf9\xb6\xf7\xf1\x49\x94\xca\xad\xe8\x0e\x1c\xe8\x53\x68\xec\x9f\xfc\x8a\xd9\x65\xa1\xc8\x3c\x4f\x2f\xec\xd8\x7a\x8a\xd7\x51\xd8\x20\x42\xb8\x17\x66\x42\x49\x5d\x86\xa7\xcc\x01\x32\xb3\x23\x6b\x6d\xd7\x20\xf1\xf5\x1d\x38\xee\x70\xd5\x6f\xd0\x95\x37\xe9\x88\xf8\x7c\x86\x72\xde\xbf\x88\xc5\x6e\x7c\x29\x8e\x55\x7d\xc8\xf5\xe8\x61\x3d\x2b\x9b\x8d\x1c\xb3\x11\xb8\x2d\xdf\x31\xe5\x79\xa9\xd5\x17\x45\x81\x8e\x61\x3d\x8f\x28\xe6\x71\x59\xed\xb4\xfc\x2d\x49\xbe\x4a\xce\xc2\x95\xd6\xa5\x6d\xe9\xb2\xb8\xd3\x16\x49\x72\xe8\xc8\x8b\xdc\xd3\x6a\xdc\x54\x08\xa0\x46\x9b\x00\xaf\x9e\x9d\xc4\xed\x89\x09\x2c\x0e\xc1\x8a\x8d\x55\xb7\x52\x9d\xda\x95\x16\x7b\x06\xd4\x00\xbe\x57\xd4\x3a\x49\x7b\x6f\x52\xd4\xc6\x87\x7a\x86\x42\x93\x09\x62\x80\x1d\xd8\x7e\x59\x4e\x2a\x25\x38\x94\x6c\xbb\xbb\x6d\xd4\xcc\x29\xb9\x08\x2d\xee\xe1\x8b\xf7\x75\x73\x2b\x5c\x49\xd0\x20\x3c\x6a\x1c\xec\xdb\x09\x1c\x1d\x92\xcb\x35\x94\xc6\x0d\x6c\x99\xfd\xf6\x1b\x1d\x06\xd4\x28\xd6\xd9\x5e\xf4\xd7\xd6\x35\xb2\xf9\x69\x77\x96\x3e\xcb\xeb\x34\x6f\x7a\x50\x68\xf4\x1c\x20\xe9\x23\x3a\x6d\x3f\x61\x1a\xf0\x84\x00\x66\x2f\xd6\x70\x00\x44\x9d\xb1\xdd\xe2\x5c\x16\xf9\x01\xaf\xc0\x68\xc7\x36\x06\x78\x95\x14\x3c\xe7\xc6\x97\x9a\x89\x5e\xe5\xb2\x6e\xaa\xc2\x4a\x1a\xb6\x16\xe8\x70\x89\xf6\x63\x8b\x98\xdf\xf9\xbe\x7b\x24\x62\x96\x10\x67\xd2\x52\x28\x3f\x06\x6b\x5a\x69\xd9\x21\x2f\x5d\xe8\x99\xea\x52\xf9\x6a\x56\x87\x1a\x09\x5b\xff\xc5\xbd\x8f\x7f\xc9\x10\x3d\x7e\xe3\x26\x6e\x92\x50\xcd\x4f\x93\x85\x6a\x26\xb2\xeb\x03\xe5\x0e\x32\xf6\x7e\x7e\xf0\xe1\x32\x35\xd3\xad\x43\x5f\xab\xe6\x3f\xca\x55\x71\x71\xcc\xb2\xff\x9d\xf5\x54\xc9\xdf\xcc\xc8\xda\x31\xe2\x74\x77\xe0\xce\x5f\x15\x7d\x41\x6b\x3d\x61\x17\xc8\xf5\xf1\xa7\x01\x5c\x62\x02\xa2\x39\xfb\x44\x7a\xf4\x45\x5f\x30\xa4\x5f\x8b\x04\x4b\x77\xe3\xdd\x7d\x86\xff\xa8\xe7\x3b\x8f\x5d\xba\x7f\x9b\x82\xd2\x47\xc4\xc8\xd4\xf9\xfe\x30\x97\x1e\x86\x27\xd6\x20\x22\x94\x9c\xb8\x0e\xe9\x46\x07\xcf\x45\x52\x87\xd1\x21\xb3\xa4\xf3\x1e\x1d\x7f\x7a\xea\x68\x41\x26\x08\xb0\x27\x0a\x84\x11\xb4\x5e\x68\x98\xf2\x84\x33\x45\xe9\xf3\xa0\x3e\x7b\x8f\x90\x01\x59\x52\xa6\x13\xfb\x37\x99\xe3\xfe\xad\xf2\x41\x18\xa6\x45\x41\x29\x73\x15\x44\x69\xb9\xbb\x11\x07\x88\x58\x8f\x5c\x0a\x74\xb1\xa5\x48\xb0\x18\x8d\x4b\xc4\x26\x4d\x7a\x78\xb6\x6b\x2b\xb8\xf8\x7e\xa0\xa2\xd8\x9b\xdf\x18\x26\x21\x51\x87\x2d\x1c\xcf\xf8\x12\x53\xbd\xf3\x27\x13\x2d\x01\x79\xe9\x67\xd2\x11\x40\x7c\xe4\x3d\x53\x3e\x5e\x3d\x31\x90\x0d\xb6\x1d\xdc\x58\xc0\x32\x28\xfc\xe5\x16\x05\x75\x29\xc6\x29\xd2\xd6\x22\x8d\x1e\x7d\x8d\x3c\x79\xcd\x75\xc6\x5e\x91\xae\xb8\x6e\x94\x21\x82\xd9\xc4\x5d\x73\x57\xde\x65\xe8\xa7\x21\xba\xc9\x3d\x90\xc8\x1b\x08\x7b\x01\x93\x10\x61\xdb\xa6\x1c\xa1\x04\x6c\x1d\x7b\x5a\x67\xc0\xbc\x52\x99\xb7\xe4\x9c\x33\x49\xd8\x01\xb3\x9e\xad\x5f\x0e\xfb\x31\xb3\xd9\xe5\x5b\x67\x8a\xab\x6c\x2d\x5b\x22\x0b\xdc\xf7\x0c\x12\xe7\xa9\x4b\xac\x49\x34\xa5\xeb\x61\x3a\x0f\x87\x20\xc9\xb0\x7d\x9b\xba\xde\xc0\x57\x59\x33\xe1\x79\xc4\xec\xaa\xb8\x9d\xde\x8f\xa0\x4b\xe0\x97\x18\xf8\xbd\x56\xcb\x9f\xa4\xd3\x5f\x4e\xb2\xc8\xcd\xde\x6d\xfa\xcf\x90\x37\xc8\xd0\x7e\x34\xfb\x93\x33\x90\x93\xac\xf3\x37\x8f\xf7\xa2\xdf\x4c\x23\xd7\xdd\x96\xc5\x52\x82\xf4\xe2\xfa\xda\x4d\x0b\xda\x7a\xf8\xa2\xee\xeb\xa8\x9b\x03\x90\xc8\x34\xc5\x70\xf1\x28\xf5\xea\x03\x26\xd9\x2d\x3b\x50\x3e\x8b\x22\x84\x4c\x19\xd5\x4b\x70\xb2\x5c\x9f\x90\x3a\x39\xe2\x5c\xcf\x4d\x19\x9d\x8b\x16\x57\x24\xbf\x56\xe2\x22\xe9\x1a\xc3\x32\xd0\xe2\xef\xb0\x3a\x14\x72\x1a\xb4\xb9\x49\x4a\x59\x58\x5c\x31\x8a\x2b\x4f\x31\xab\xd6\x29\x6d\x11\x99\x22\x71\x35\x9e\x23\x0a\x6b\xb8\x35\x76\x3d\x2f\x3f\x1a\x66\x8c\xcf\x18\x67\x99\x17\x3c\x95\x6f\x0a\xfa\xa1\xd0\x3f\xa9\x3b\x31\x83\xc6\x64\x5b\x43\xd6\x0b\xfc\xea\xd7\xbb\x20\x53\xe5\xaf\xad\x70\x10\xcf\xd1\x5f\x4b\x66\x6e\x8f\xcd\xd0\x65\xaa\xf7\x61\x95\x7d\x31\x90\xca\xf7\x8d\xf4\x66\xc0\x67\xb6\x07\x54\x1b\x8e\xed\x1f\xba\xb4\xdf\x56\x6e\x1d\x2f\xc4\x43\x9a\x14\xbd\xfc\xf4\x3b\x45\x2f\x0f\x61\x31\x31\x0f\xc5\xf9\x69\xdd\xcd\xc5\x14\x02\xf5\x8f\xea\x1a\x2f\x8a\x6c\xc3\x29\x74\xbe\xed\x9d\xd0\x1a\x2b\x87\xa7\x21\x51\x02\xcd\x83\x33\x71\x74\x47\x06\x34\xeb\x69\x50\x73\x18\xe1\x36\x9a\x83\x1b\x05\xbd\xe5\xac\x01\x05\x25\x4f\x4d\x66\x73\x83\xdc\x17\xd9\xd6\x27\xf7\x5a\x5f\xe3\xa1\x2e\x4e\x75\x9d\x00\xb2\x27\x6b\x6d\x8d\x00\xda\x13\xce\x66\x9e\xdd\x9e\x1f\xf4\x34\x44\x3e\x7a\x4e\x85\x1b\xc6\xdc\x7c\xf3\x23\x83\x7b\xa0\xd8\x88\xae\xea\xef\x9a\xba\xed\x26\x21\x63\xcf\x22\x28\x79\xee\x68\xfc\x8c\x82\xc9\x59\xd5\xfb\x8f\xcc\xbd\x96\xb9\x5f\xe6\x8b\xf5\xae\xb8\x74\x41\x83\xb3\x6d\x0a\x88\x87\xbe\x30\x56\xd1\x73\x2d\xea\x32\x46\x70\x46\x8d\x24\x96\x57\x6b\xa5\xa8\xdd\xf7\x0e\x34\xa6\x8e\x96\xf2\x01\x28\xfc\x29\xa2\xc9\xff\x8e\x38\x50\xdd\x0c\x9f\xdc\xca\x40\x23\xc0\xa4\x0c\xe3\x4f\xff\x46\xdb\xa1\x6f\xce\x07\xfc\x81\xb3\x17\x7f\xad\x72\xa8\x89\x5d\x1c\x35\xf1\x8d\xcc\x43\x0b\x8c\x39\x21\x22\x10\xe3\xa5\xa6\x94\xda\xf8\xb5\xf2\x34\xb3\x3f\xfa\xf9\xad\xba\x6c\x04\xd0\x62\xa6\x2d\x65\xc4\x73\x4c\xa1\x40\x3d\x2c\x3f\xca\xb8\xd3\x72\xd6\x8f\x49\x1f\x3d\x9a\x3e\xf3\xaa\x3a\x40\x9f\x79\x68\x0b\x1e\x66\xcf\x7c\xdd\x76\xe7\xee\xac\xab\x69\x1b\xa4\x10\x68\x65\x37\x0d\x1c\x36\x9a\xb8\x35\x87\xe4\x61\x79\x9d\xad\xf0\xca\x3a\xf3\x53\x48\xb5\x38\xd4\x6c\x1f\x1a\x03\xa4\x49\x9b\x63\xc4\x6e\xfd\x02\x90\x80\xa3\xdc\x83\xec\x80\xf2\x17\xa2\x3d\x7c\xe0\xc2\x57\xe3\x3c\xf5\xe2\xae\x23\xb4\xe5\x6f\xc9\xf3\xfa\xfa\x5d\xc2\x5b\xfe\xdd\xb1\x9e\xe6\x64\x57\xd8\x65\x62\xf5\x8e\x7e\xff\xd3\xa0\xcb\x6e\xf6\xe2\x75\xc2\x55\xcc\x2f\xc8\x2b\xb9\x98\x4c\x97\xcf\xfa\x2a\x0e\x1c\x84\xf4\xa2\x7d\xed\x2e\x2c\x6e\x5e\x0b\xe2\xe4\x6b\xb8\x2a\x81\x8e\x81\x6d\xe2\x17\xa2\x0a\x5e\xed\x4d\x5b\x82\x59\x84\x5c\x75\xc4\xaf\x02\x60\x58\x49\x22\x3c\x17\x9c\xdd\x0d\xb9\x03\x7b\xe5\xb9\xe2\x7c\xe4\x61\x77\x9b\x6d\xec\x1a\x0d\x48\x31\xb3\x5d\xab\x32\x12\xeb\x72\xb3\xa0\x10\x72\xd5\xfb\xfd\x60\xbf\xb8\x97\xf3\x87\xd7\xef\x26\xed\x09\xb7\x33\x33\xd0\x72\x14\x93\x82\xe9\xcf\x4f\x9b\xea\x6c\xa8\x42\x5b\x6a\xc3\xd4\xce\x45\x4d\x05\xa5\xce\x9e\x50\xa0\x7b\xb2\x16\xd3\x89\x6d\xc3\x4c\xcb\xcd\x6e\x93\x55\x90\x7e\x61\x7f\x18\xe3\x7d\xe9\x73\x4c\x3e\x7d\x39\xfb\x25\xc9\x72\x26\x49\xa5\x29\xf9\xce\x84\x18\x44\x79\x5b\x50\x02\xcf\x72\x88\x74\x70\xe1\x4a\xca\xc9\x80\x88\xc0\x8f\xaf\x4d\x6f\x10\x89\x51\x04\xf7\x55\x67\x6c\xe2\xf8\x45\xaf\x66\x1f\x32\xa8\x4c\xfa\xb1\x30\xd6\xf8\x54\x00\x18\xe3\x02\xe3\x61\xc4\x50\x72\x6a\x97\x29\xf3\x80\x3b\x61\x89\x37\x97\x61\xfb\x01\xf7\x15\xa1\xcb\xea\xc6\x99\x98\xce\xde\x2b\x20\x9a\xe9\x8a\x8f\xf2\xbd\x4d\x26\xf5\x57\x51\xd8\x11\x93\xf4\xcd\x8f\x06\x73\x98\xe5\xe0\x2c\x61\xae\x15\x4b\x62\xad\xf5\xe8\xc0\xde\xdb\x65\x0b\x91\x78\x8e\x99\xf0\xb2\x4e\xf5\x45\x86\x2d\xd0\x74\x11\xfd\x36\xf7\xe9\x6b\x7d\x08\x77\x63\xc1\x54\xe2\xd6\xd4\xd9\x38\x32\x2f\x1c\xda\xe5\xec\x2f\xee\x01\xfe\xd8\xfb\xda\xff\x7a\xf5\xfa\xf2\xd5\x5b\x67\xb6\xb3\xeb\xba\x5a\xd4\x4d\x35\xf8\xfc\xdd\xe5\xeb\x37\xb3\x6f\x9c\x97\xb2\x9a\xcf\x6e\x28\x92\x90\x7e\xe1\xf5\xdb\xcb\xab\xd7\xf6\x05\x0a\xaa\x65\xe5\xe0\x2a\x57\x57\x97\x57\xef\x66\xdf\xae\xdd\x57\xf2\xac\xb9\x1d\x7c\xfc\xfe\xf2\xcd\x5b\xf9\x18\x47\x77\xff\xe3\x37\xef\x2e\xdf\x5e\xc9\xc7\xf7\x44\x37\xa5\x1f\xbf\x7d\x73\xf9\xf6\xfd\xec\xfb\x8a\x3d\x9b\x2b\xca\x22\x0e\xbe\xf2\xe5\xe5\xbb\x2b\xfb\x4a\xe3\x5c\xb9\xfe\x17\xde\xbd\xb9\x7c\xf7\x7e\xf6\x67\x08\xd0\xb1\x1f\xb1\xf7\xe9\x97\x97\xce\xf9\xe4\xa7\xce\x27\xec\x7f\xfa\xfe\xf5\xe5\xfb\x0f\xfc\x74\x4d\x20\x17\x43\x1c\x94\xb4\x06\x5f\xfc\xea\xf2\xcb\xf7\xd1\x17\xdd\xbb\xdd\x0d\xbe\xf4\xe5\x97\x97\x5f\xb9\x2f\x61\x27\xf7\x3f\xfa\xea\xcb\x4b\xf7\x22\x3f\x97\xc5\x4a\x28\xe1\x8f\x6e\x84\x26\x18\xd6\x3c\xce\x87\xcb\x3e\x2d\xa5\x26\xb6\x41\x0f\x29\xea\x0e\x58\xb8\xc5\xaf\x5b\x77\x88\x23\xa1\xd3\x26\x62\x44\x22\x5b\xbf\x95\xb3\xb8\x2c\xbc\xb8\x8f\xe2\x68\x6b\xc4\x7a\x95\x97\x0a\x82\xc4\xce\x96\x19\x6f\xf7\x5f\xdc\x2a\x6d\xfa\xb5\x26\x26\x37\xc2\xee\xb4\x36\xf2\xf8\xdb\xee\x4b\x25\x09\x84\x33\x81\x90\x2e\xc1\x7f\x5b\x5d\xc7\xf0\xe0\x63\x67\x0a\xcb\x10\x5f\xa3\xe8\xf0\x6f\x84\x90\x3c\x07\xce\xe0\x21\x35\x8f\x82\xa7\x7d\x54\xfc\x2b\x8f\x90\x39\x73\xa2\x52\x89\xd1\x34\x26\xf1\xeb\xb1\x9c\x03\x4a\x0b\xc2\x04\x5d\x54\x41\xab\x41\xff\x44\x82\x51\xfe\x5e\x5b\x8b\xdd\x9b\x2a\x36\x73\xbe\x2f\xfe\xd9\x9a\x99\xa4\xe6\x11\x0c\xf7\x42\x82\x7d\xd5\x83\x65\x31\x54\x54\xb9\xa9\x6e\xa5\x7e\x84\xde\xa4\xad\x2d\xdd\xb3\x28\x7c\x11\x88\x82\x61\xad\x5b\xcd\xf9\x34\x5d\xc6\xbf\x78\xc0\xcc\xa4\xa4\xcc\x13\x23\x21\x9f\x23\xc9\x38\xc5\xb3\x1e\x83\x1d\x6a\x67\x9a\xea\x70\x1d\x4e\x42\x73\xc6\x8d\xb0\xf5\xad\x14\x60\xf2\x3c\xd6\xf7\xc2\x87\x72\x10\xe1\x3e\x29\x90\x40\x3c\x64\x49\xd6\xd3\x74\xf8\xab\xd1\x17\x2f\xfd\x00\xe0\xb5\x68\x43\x5b\xd1\x0b\x31\x43\x61\x8e\xee\xc4\x68\x82\x2e\x7f\x3b\xc9\xa3\x04\x30\xbe\x58\x8e\x0a\x17\x7c\xf9\x2f\xac\x9e\x3f\xbb\x17\x19\x87\x62\x53\x9c\xc9\x14\xb0\x9f\xfa\x85\x2b\x16\x17\x22\xc4\x5d\x46\x14\xd4\xae\x61\x05\x1b\x7a\xeb\x65\x0e\xc7\x0d\x2f\x17\x2c\xd9\x10\xf4\xb6\xf4\x5a\xc4\xb1\xa0\x18\x54\x81\xfa\x85\x9a\x39\xdd\x3d\x36\xe9\xc9\x7a\xed\x45\x66\x23\x35\xf1\x11\x91\xaa\x69\x85\xd9\xf1\xaa\x78\x9b\x94\xc5\x29\xf4\xe6\x4b\xe2\x0a\x28\xb4\x62\x73\xc8\xcc\xa8\x50\x9e\x14\xc2\x7f\xe8\x1e\x52\x00\xb7\xbe\x1d\xe9\xa2\x74\x11\x9d\x20\x51\x8d\x4d\x5e\x6a\x4c\x93\x6b\xdf\x3a\xd2\x78\xf2\xea\x4a\x35\xb4\x84\x6b\xee\x49\x6a\xd8\x8a\x61\xa3\x2b\x2a\x93\x38\x52\xbc\xfe\xef\x5d\x38\x1e\x79\x68\xff\xce\x62\x71\x79\xab\xfe\x73\x61\x93\x1c\xf1\xd4\x26\x84\xb5\xdd\x53\xa8\xa1\xf1\x91\x5a\xf6\xab\xf2\x20\x92\xa8\x09\xea\x5a\x52\x64\xc4\x9f\xe4\xc1\xd2\xc7\x52\xc0\xdc\x17\x5d\x2c\x0b\x28\x09\xc4\x1e\x7b\xfc\x00\xcb\x3d\x98\xf1\x74\x7a\x35\x43\x11\xbf\x85\x69\x07\xf4\x1d\x90\x56\xb3\xef\x1b\xf7\xcb\x6e\xff\xf0\x74\xd8\x41\xd4\xe9\x54\x35\x5a\x82\xf0\x02\x93\x51\xc3\xce\x6d\x98\xa4\xf3\xdb\x11\x26\x48\x1e\x9c\x2b\x3f\xdb\xc8\x3c\xb5\x80\xe6\xdf\x89\x1e\x3b\x90\x8d\xaf\x62\x90\xea\x3c\xe8\x95\x23\x78\x9a\x2d\xf7\x44\xf3\x35\x27\xd5\xb3\x45\x80\x3b\x22\xb6\x41\xd2\x96\x5e\x58\xeb\x31\x90\x54\x94\x74\x51\x13\xba\xfa\x9d\x3f\x75\x63\xc1\x28\x36\xca\x75\x13\xc3\xde\x44\x3a\x1c\xeb\x65\xb7\x95\x9c\x36\x8d\x46\xa6\x07\x5d\x57\x6f\xe3\x16\xef\x01\x7d\x80\xaf\xa4\xc7\x8d\x64\x51\xd5\xef\x00\x6f\x23\xf4\x16\x9a\x6c\xe1\x1e\x91\x9b\x69\x55\xfe\x5a\xe4\xde\x5f\x55\x3f\x04\x63\xc0\xd6\x46\x53\x1f\x64\x73\xcd\x92\x62\xb0\x27\xc4\x43\xc8\xd1\x20\x1c\x67\x7a\xd1\x17\x41\x13\x67\x59\x70\xa1\x5c\x50\x91\xb6\x5a\x22\xf4\xe5\x74\x97\xaa\xb1\xbb\x22\x2b\x22\xfe\x57\xfa\x44\x65\x66\x53\x32\xd3\xb6\x6b\x4a\xe9\xa8\xfc\x07\x32\xf1\x22\x53\xba\x87\xdd\x45\xc9\x6a\xd7\x48\xaa\xdb\x4e\x28\xe7\x3d\x73\x6a\x39\x72\xe1\x28\x8d\x94\x5b\xa2\x20\xcf\x88\x31\x47\xe6\x5d\x69\x4e\x79\x63\x65\x39\x66\x5b\x5f\xc2\x0e\xa9\x1c\xa5\x75\xbb\x2c\x49\xfc\x08\x2e\x17\x86\xb5\x20\x58\x1a\x60\x29\x26\x17\x79\x53\x8c\xbc\x87\x52\x8c\x4f\x0d\xd7\x12\xcf\x0c\x3c\xf7\x44\xab\x52\xde\x95\xab\x69\x5d\x71\x3f\x3d\x75\xfb\x93\x7a\x7d\x96\x3c\x7c\xf3\xbc\xbe\xde\x83\x50\x92\x83\x0c\xbc\x3f\x73\xe0\xd3\x98\x71\xa0\x7f\x82\x5d\xa9\x8c\xb2\xee\x5c\x06\xbe\x7a\x32\x64\x71\x96\x02\x64\x03\xc8\xce\x7e\x71\x70\x00\xb1\x23\x09\xf6\x24\x6c\xdf\x32\x40\xe6\x4e\x79\x1e\x47\x56\x46\xbd\xd5\x9a\xf5\x93\x43\xe6\x27\xad\x0d\xdf\x0c\x07\x38\x33\x20\x35\x68\xc3\x15\x6f\x05\x8d\xd6\xe0\x52\x46\xc3\x38\xb7\xaa\x7b\xa8\xe5\x0d\x22\xe3\x67\x41\x65\xd9\x12\x58\x0b\x68\xc3\xdd\x0f\xd4\xb6\x9e\x29\x4d\x8e\xb2\xf7\x11\x0b\x37\x22\xc8\x64\x03\xeb\xf7\x91\x9d\x2c\x09\x96\x36\xfa\xb3\xac\x8c\x15\xc0\xef\x6f\xea\xb5\x7e\x19\x79\x1d\x76\x78\x3b\xd7\x2a\x2f\x97\x3b\xc0\x3f\x93\x33\xc4\x19\x7d\xb1\x13\x3b\xe7\x57\x35\xfd\x9b\xcd\x45\xb2\xcc\x48\x72\x78\xf0\x92\xd2\xde\x5d\xae\x15\xea\x38\x99\xd2\xdc\xbd\x99\xaa\x15\x5e\x2c\xa1\xbd\x1e\x55\x91\x9a\xaa\x70\x53\x1c\xba\xaa\xcf\x4e\xc0\xcc\xd2\x3b\x5c\xa2\x32\xa2\x79\xeb\x9e\xd5\xc5\x13\x58\x74\x6b\x3f\x66\x09\x06\xc4\x95\x58\xd1\xee\xff\x0b\x0c\xee\xc7\xc2\x1d\xe7\x1b\xe9\x29\x80\x1e\x79\x44\x3a\x27\x17\xe0\x64\xea\xcd\xb7\x3b\x86\xe3\x7e\xf2\x79\x71\x50\xb3\xde\xda\xf1\xc6\x6f\x7b\x5f\x55\x1c\xef\xbb\x12\xf9\xb6\x5d\xd7\x82\xc1\xc7\xff\xec\xb8\x01\x17\xbc\x4f\x16\xa8\xe5\xd4\x93\x6c\xea\x1e\xd1\xab\x7f\x31\xc6\x02\x8d\x73\x46\x67\x61\x7c\x26\x2d\x51\x84\x56\x0f\x77\xec\x60\x50\xd6\xd7\xa1\xbf\x15\xde\x32\x42\x84\x8d\xd0\xfc\x8d\x38\x3f\x8b\xfd\xa5\x76\x78\x00\xbc\xef\x5c\x81\xd8\xdd\xf1\x13\x7f\xec\xc9\xf4\xe0\xb3\x3b\x1f\x1e\xa6\xca\xd8\x63\x48\x1e\x61\x3c\xb6\x88\x0e\xdb\x9f\x4f\xd9\x12\x23\x9b\xce\xa0\xf7\x6f\x85\x13\x3a\x98\x51\xe0\xf3\x5c\x92\xd8\x02\xd3\xc6\x97\x0f\x52\x24\x92\xbe\xd5\xd5\xc0\xd0\x1f\xe6\xe6\xff\x7c\xac\x85\xe5\x30\x67\x37\xc1\xdf\xa8\xe6\xcb\xed\x3b\xaf\x9a\x15\x5d\x4f\xca\xbc\x55\x9d\x4a\x8a\x2f\x09\x7d\x14\x57\xa6\x6c\xd3\x5c\xd4\xbf\x2b\xb8\xe7\x59\xd1\x3b\x9f\xdc\xdb\xeb\x76\x59\xb3\x7f\x78\xf3\x78\x4c\x9c\xf1\x50\x08\xb3\x91\xd7\x68\xd7\xf8\xa6\x6c\x9a\xfa\x38\x36\x61\x52\xf6\x74\xd8\xf9\x7d\xe0\x7c\x3c\x06\xc7\xf1\x90\x1b\x63\x0e\x1c\x87\xf6\xc2\xa8\x47\x49\xa3\xda\xe3\x7c\x85\x4a\x16\x49\xec\xf4\xfa\x6c\x73\x93\x20\x9b\x65\x5d\x74\x30\x93\x61\x55\xc5\x0a\x5b\x6b\x8a\x38\x4c\xdd\xfa\xf2\x14\x1d\x2c\xaf\xa2\xda\x14\x52\x14\xb5\x84\x11\xd2\x0a\xad\x98\xbf\x30\x08\x8d\xc5\x86\xdd\x1e\xd6\x2f\x3e\xfa\x30\xdc\x59\x0c\x33\x7c\x77\x87\x85\x4b\x62\x5d\xb7\xee\x56\x46\x8c\xc7\x36\xba\xc5\xe9\x18\x6c\xec\x46\x1b\xe5\xc7\x89\x46\xbc\xe4\x29\x9f\xf9\x1c\xb2\x6f\x6b\xe1\xf7\xdc\xd6\xdf\xec\x93\x7e\x6f\xff\x60\x93\x6d\xf8\x12\x5a\xa3\x98\xf1\xbf\x8c\xa8\xd1\x5d\x3d\xd6\x88\x4f\x60\x38\x7c\x1a\x78\xdb\x7f\x28\xa0\x73\x04\xde\xf6\x1d\xd8\x03\x2e\x1e\x56\x6b\x8a\x28\x9b\x84\x7e\xd9\xc4\xeb\xd8\x56\x49\xe0\x89\xf2\x76\x6e\x88\xb3\xd1\x3d\x31\xde\x99\x99\x7c\x15\xc9\x99\xb0\x58\x95\x9d\xe0\xd8\xa2\x39\x02\x9a\xf3\xa8\x2f\x37\xba\x13\x70\xb9\xf7\x53\x99\x3f\x9f\x47\xfa\x79\x9f\xaa\x01\x47\x94\xaf\xc0\x17\x9e\xa7\xfd\x7c\x12\xbd\x36\x85\x06\xf8\xd3\xb2\xd9\x8f\x29\x91\x9f\x6e\x79\x39\x25\x44\x7e\x82\x02\x58\xcf\x85\x55\xbd\xdc\x1d\x41\xe6\x44\x79\x99\x66\xdf\x22\x79\xbd\x60\xfe\x25\x4b\x8f\x12\xd5\xc0\x5d\xd5\xe0\x5a\x2c\xe9\x43\xd3\x04\x4f\x02\xc2\x3d\x8c\x86\x64\xbc\xad\xf1\x7f\x57\xf5\xbd\xbb\xfb\x35\xa2\xf6\xfe\xd6\x4c\x19\x71\xbe\xae\xdd\x93\x5e\xf8\x7f\x7e\xba\xcf\x36\xdb\x43\x5c\x38\xa7\x5b\x10\xfe\xde\x6b\x9e\x26\x65\x56\xc6\x50\x5a\x43\xb3\xa4\x7b\x52\x8f\x8c\x88\x49\x5b\x4a\x77\x9e\x36\x3b\x51\x19\x13\x8e\xc8\xa1\x3b\x77\xf8\xe0\xb2\x04\x89\xbb\xb6\x0a\xb3\x33\xa0\x42\xbe\xf1\x1e\x7b\xc4\x53\x35\x44\x3b\xd4\x93\x9f\xd5\x02\xd3\xdb\x1a\xc1\xd5\xb2\xae\x2a\x65\xd5\x08\x18\x38\x92\xf7\x0b\xe7\x93\xfe\x1e\xd7\xe3\x40\xbf\x68\x8a\xa8\xb6\x12\xa8\x88\x35\x73\x88\xd3\x74\x50\xa9\x9c\xdd\xd5\x6b\x80\x5c\x1a\xf4\x1c\x97\x8c\x50\xee\x49\x9b\x22\xbc\x59\xf5\xa2\x05\x35\xdc\x51\xda\x15\xff\xea\x67\x97\xb3\x4f\x18\xe3\x8f\x2a\x49\x7f\xac\xbf\xba\x00\x8b\x26\xe5\x91\x5f\xf8\x67\xc3\xbb\xcb\xd3\xae\x89\x0b\x77\xcd\x3f\xbc\x3b\xde\x8e\xde\x76\xbd\xeb\x6c\x8a\x2e\xb9\xc4\x1f\x5e\xf5\xa1\x27\xdf\x65\x9b\x72\x5d\xba\xc5\xf1\x62\xec\x85\xe0\x4d\xe1\xc6\x97\xef\x8e\x56\x81\xfc\xcb\x99\xfe\xd8\xff\xe1\x62\x8b\x8a\xac\x7b\xcd\x6c\x5b\x2e\xb9\x2c\xdd\x7d\xae\x8e\xd1\x9d\x09\xd1\x8b\xb2\x5c\x5f\x83\x0d\xc6\xed\x75\xf7\xa3\xb7\xc7\x94\x28\x2c\x9d\x34\x9f\xf1\x28\x74\x73\x7a\x93\x95\xce\x5a\x2c\x4a\x51\xe5\x76\x4b\xc1\x83\xaf\x18\xf0\xb8\x0b\xbe\x7e\x75\x4c\xac\xa5\x27\x44\x31\x22\x2e\x18\xf7\x93\x25\xc2\xd1\x5f\x48\x6b\x4d\x74\x70\x98\xe7\x69\x44\xdd\x3c\x00\xae\xde\x32\x0d\x74\x34\x8d\x31\xee\x16\x6a\x72\x8e\xc7\x4b\x9b\xa6\x57\x44\x03\xfe\x28\xcb\x40\x38\xa7\x13\x23\x12\xe7\x02\xf4\x2a\x3d\xe6\x3d\xa6\x95\x35\x19\x22\x5f\x10\xf5\x2c\x4f\x46\xe7\xa5\x63\x99\x87\x2e\xd9\x99\xdf\x1f\x47\xd9\x8d\x74\xf3\x7d\x3c\x20\xd3\x15\x9e\xcb\x92\xcc\xee\xaf\x6d\x6f\x88\xd9\x6c\xbd\x73\x26\x1c\xba\xde\x75\xb3\xc0\x39\x6a\xf0\x15\xb2\xc0\xac\xac\x0f\xab\x3d\x21\x02\xe2\x8c\x60\x26\xdb\x91\x8f\x21\x47\x7d\xf0\x39\x2d\xfa\xf5\x5e\xb8\x67\xa1\x1c\xe3\x9c\x34\xa7\xd9\x3c\x9f\x55\x52\x24\xf1\x1e\x46\x6e\x10\x49\x7d\x1a\xa3\x16\xee\x34\xf7\x65\xb7\x20\xd5\x88\xba\xf0\x98\xf4\x49\x1e\xaf\xbb\x14\x1e\xe2\xd3\xa6\x1c\x41\xe7\xbe\x9e\xec\xf1\x1e\x8d\x0b\x8f\x1d\xfe\x4f\x5b\x81\x3b\x9c\x1b\xff\x4c\x24\x1a\xda\x14\x4c\x46\x0e\xd9\xec\xac\x1f\xa5\x39\x3b\xb9\x46\xe6\x22\xee\x84\xb3\x22\xcd\x98\xdc\xf8\x5c\x16\x8e\x7e\x7f\xe5\xce\x56\x5b\xeb\xec\x85\xba\xbc\xa9\x29\x1e\x81\x64\x53\xb7\x0f\xd8\x00\xbb\x30\x9b\xf1\x2c\xb7\x39\x7b\xdd\x93\xbf\xb2\x4c\x63\x38\x12\x23\xd8\xaa\xb9\xb2\xee\xec\xbb\xae\xd0\x76\x55\x56\x42\xa0\xd2\xfa\x54\xeb\x11\x90\xfb\xee\x44\xc7\xea\xa3\xba\x46\xac\x2d\x2b\x75\xaf\x67\x87\xcd\x65\x4f\xad\x26\xde\x13\xcd\x48\xa4\xfe\x2e\x36\x14\xbb\xb6\x88\x25\x05\xc0\x9e\xb4\x53\x61\x42\xd3\x0f\x10\x56\x82\x72\x15\xf3\xb1\x2b\x9d\x40\x5e\xfb\x09\x95\xaf\x86\xea\x5f\x34\x0a\x21\x6e\x6a\x77\x8b\x4d\xd1\x5c\x4b\xf9\x4f\xfc\xc8\xf9\x79\xcd\x90\xcf\xd4\xff\xe2\xcc\x28\xc8\xe2\x74\x15\xe9\xe2\xc1\xda\xea\x94\x4c\xcd\xd6\x5b\x2f\x8f\xf5\xbe\x17\x63\x48\x74\x81\xef\x8f\xf7\xc7\x4c\x0a\x21\xdc\xee\xf9\x41\x2c\x32\x41\x04\x93\xa2\xe8\x6f\x9e\x4e\x4c\x84\x91\xc4\x47\xe7\x48\x54\xb2\xe4\x3f\x67\xeb\xa5\x56\x61\x34\xeb\x84\xee\xdb\x5b\xa9\xd7\xae\xfa\x14\x86\x8f\xeb\x90\x99\x50\xf8\x1f\xd2\x88\x91\xb8\x3e\x39\xc6\xc6\xcb\xf5\x96\x23\x47\xe9\x18\x07\xad\x7e\x3b\x71\x2a\xf4\x42\x6a\x37\xbc\x42\xcd\x2f\xf0\xe2\x5f\x86\x7f\x1f\xb8\x81\x14\x11\xae\x6f\xea\x16\x22\x50\x34\x55\xd0\xef\x59\xef\x60\x86\xa7\x1d\x26\xc5\x66\x6a\xf8\x78\x0e\x2d\xf6\x9b\x47\xa4\x4d\x3e\x88\xff\x34\xf5\xbd\x59\x9a\xe4\x63\xb3\xbc\x59\x15\xfb\x8b\x07\x51\x5b\x27\xac\x2d\x6b\x0d\x44\x42\x5f\x81\xec\x48\xce\x76\xa6\xba\xcd\xe4\x1f\x14\x10\x66\xc2\x0b\x79\x22\xa5\x0e\x1f\xed\xba\x84\x46\x13\x31\x18\x62\xac\xa2\x90\x8e\x59\xc0\x79\x20\xd7\x0e\x37\x75\x67\x04\xe8\x50\x7c\x2f\x46\x40\x88\x9a\xcd\xbf\xc9\xa8\xfd\xd8\x8b\xe4\x88\xa0\x09\x2a\x64\x45\xde\x5a\x9f\xe0\xae\x3a\x9f\x37\x21\x00\x42\xf3\xb2\x05\x4b\x17\xb4\x14\x2c\xd2\x0b\x83\xe5\xe2\x67\xa8\xe8\xfe\xff\xd2\x59\x2a\x01\x33\x38\xb7\x1f\xc1\xbe\x9c\xbd\x0a\x53\x8a\x74\x78\xcc\x81\xe5\x03\xa5\xe4\x11\x6e\x26\x37\xce\x95\x60\x69\xa9\xbd\x81\x3e\x55\x23\xa5\x06\x96\xe4\x68\x2d\x64\x33\xe0\x4e\xc0\x77\x08\x77\x60\x59\x5d\x2e\x8a\xee\xbe\x80\xa8\xc6\xdf\x03\xde\x4a\x5e\x6a\x16\x9a\x5e\x58\xb8\x06\x7b\xe6\x1c\x58\x67\x12\xb3\x27\xa9\x59\xed\x07\xd1\xb2\xb8\x0f\xb6\x17\x99\x7b\x70\xf7\x54\x7c\xb9\x8c\x68\x28\xac\xbc\x59\xb8\x53\xf1\x67\xec\xdd\x7b\x37\x41\x73\x2b\x62\xe9\xe7\x5d\x4f\x06\x44\xdc\x24\x1f\xa1\xcb\xec\x8e\xd3\xa7\x3b\x9f\xaa\x22\xef\x05\x46\x38\xc2\x1b\x1e\x09\x0b\xb5\xb5\xdb\x5b\xcd\xa9\x57\x69\xe8\xfe\x7b\x06\x09\x5d\xba\x0e\x34\xd4\xae\xa5\x32\x2a\xc3\xdc\x06\xda\x6a\x68\x77\x90\xfd\xb4\xa7\x0c\xa1\xb1\x45\x2f\x56\x42\x45\x38\xcf\x99\x47\xef\x52\x8c\xdd\x17\x2c\xe2\xa1\x06\x64\x76\x6d\xa0\x56\x79\x83\xd7\xa9\x24\xce\x04\x54\x23\x7f\x8f\x9a\xb9\xf4\x3d\x08\x30\x67\x85\xf6\xc9\x4a\x96\x1a\xbb\x96\xc2\x5f\x7b\x4d\x43\x60\xe0\x51\x79\xa8\xb2\xf1\xcd\x79\x23\x03\x0e\x4b\xaa\x12\x78\x74\x65\x94\xe0\x73\x1d\x97\x14\xc5\xe1\xa5\xd8\xb8\x0c\xe7\xa3\x42\xcb\x52\xda\x67\xb0\xc5\xf1\x2c\x76\x9b\xad\x18\x7c\xfe\x8d\x04\xe4\xcc\x56\x30\x08\xda\xb0\x9d\x02\xfd\x97\xec\xf9\x73\xae\x73\x8e\x21\x1e\xf3\x51\x52\x60\xd6\xae\x2d\x7a\xfc\xde\xfa\xa2\x42\xa2\xc5\x33\xc6\xbb\xaf\x3f\x9e\xb3\xbb\x6f\x57\x84\xbc\xce\x0d\xe0\x3e\xb3\xb0\x2f\xa5\x84\x35\xbb\x91\x4f\x3b\x9f\xc8\xa4\x3e\x09\x3b\x74\x56\x62\xff\xaf\x95\xdb\xa6\xce\x07\xcf\x4f\x3b\x26\x34\x37\xa1\xab\x6f\x85\x26\xb1\x65\x4d\x4c\xc4\xbd\x73\x96\x8f\x3a\x20\x04\x52\x9c\xa8\x82\xf9\x13\xec\x7b\xbc\xc8\xd9\xcf\x6e\x91\xfc\x5c\x15\x8f\x48\xf8\xb7\x85\x38\x4f\x1c\xb7\x7b\x17\x6d\x26\x42\xdf\xce\x0e\x56\xdd\xfe\x72\x85\xe3\x07\x22\x28\xad\xa7\xd1\x49\x31\xaf\x0c\x86\x8c\xfa\x7e\xa5\x39\xa6\x97\xa9\x22\xe8\x0d\x03\x5a\x4a\xef\xe8\x0d\xb4\xf1\xc8\xad\xe0\x1c\xa9\x82\x65\xed\x62\x9c\xdf\x14\x11\x9b\xf9\xe0\x1e\x10\x48\x39\x8d\x7c\xbe\x6f\xae\x71\x12\xc2\xe1\xf6\x1e\x9f\x53\x63\x0c\xe9\x1c\x95\xf5\x81\x94\x7d\x5e\x3a\xf7\x7d\xbd\x4f\x25\xd5\xd0\xbd\xd7\x46\x87\xef\x31\xd2\xa0\x90\x9d\xa6\x30\x00\x46\x82\xf2\x4c\xae\x0f\x91\x55\xea\x5f\x8a\x06\x82\x3c\xd4\x34\xd9\x03\x7f\x0d\x99\x88\x4c\x40\x18\x31\x81\x8f\x1c\x74\x90\xf4\x3e\xc6\xd8\xa3\xac\x64\x7d\x0e\x65\x56\xdb\x03\x51\x94\x57\x4c\xac\x64\xf2\x44\xfd\x8e\x6d\x40\xef\x66\x14\x94\xaa\xe4\x84\x5b\x46\xdb\xcf\xc6\x66\xc9\x06\x77\x64\x95\x77\xc5\x68\x49\x62\x52\xc4\xf0\xcf\x5d\x01\x57\xea\xc9\x21\xc2\x53\x3b\xe6\x19\x03\xcc\xf8\x61\x2b\x10\x35\x05\xfa\xcd\x67\xee\x1c\x6e\x32\x70\xb9\x37\xbb\xc5\xde\x90\xd0\xdb\xed\x0d\xe3\x5a\x1c\xea\x7d\xfa\xc4\xc7\x34\x80\x4c\x53\x30\x3b\x18\x5b\xfc\xc9\x64\xc3\x93\x44\x12\xca\x23\x03\xa6\x72\xd1\x18\x54\x5c\xa9\xc2\x8d\x6e\xca\x3c\x87\xd8\xd1\x7d\xb6\x07\xe8\x7e\xa5\xb5\x5e\xf4\xb2\x2a\x8b\xf5\x20\x25\xf7\xb7\x03\x02\x61\xdc\x08\xc2\xc9\x18\x65\xfc\x22\x7b\x1e\x82\x1d\xdd\xad\x42\x0e\x2e\xdf\x54\xc5\xde\xd2\xf9\x9d\x92\xd8\x52\x50\x12\xf2\xc3\x58\x91\xe5\x1d\x9b\x9e\xe5\x40\x6f\xa5\x64\xa5\xc9\x31\xdd\xbe\x9e\x45\x3c\xbe\x0a\x4e\x56\x5e\x68\x42\x31\x3a\xe2\x1c\x8f\xb2\x7b\x94\x3c\x65\x6b\xa5\x40\x91\xdd\x76\xec\x68\x0b\xdb\x5d\x0b\xdc\x1b\xa9\x80\xcb\x8d\x69\xd3\x20\xd1\xb4\x54\x0a\xc6\x7a\xb6\x82\x2a\x84\x74\x54\x7b\xe6\x67\xdb\x40\xd7\xc4\xba\xac\xf3\xe3\x2e\x56\xb0\x11\x4c\x5c\x79\x59\x2f\x7b\x2f\xde\xd3\x80\x0d\x71\x16\xd5\xec\xdb\x50\x00\xcc\x60\x51\x21\x54\xf0\x1c\x86\xf4\x54\x45\xbd\x8b\xe4\xb1\x5e\xb8\xce\xb2\xb4\x14\x28\xea\x27\x8b\xbf\x15\x77\x0d\x33\xb3\x5c\xef\xf2\x42\x5b\xc0\xa4\x74\xb7\xa7\x75\xa7\xd8\x86\x22\xab\x3a\x38\xb9\xad\xdb\xf6\x58\x6d\x91\x3e\x9c\x36\x2d\xac\x4d\x54\x32\xb5\x5e\x7d\xc5\x9c\x49\x47\xfe\x4d\xb6\x2d\xdc\xa1\x28\x27\xde\x08\x9d\xf2\xab\x47\xda\x98\xe3\xb1\xe9\xd4\x7a\xfe\x8c\xdc\xb5\x88\x54\x67\x2f\x10\xe6\x2d\x0a\xb7\x5a\x2e\xce\x6b\x33\x38\x1c\xa2\x3e\xa0\xca\x3f\xe0\x66\x16\xd1\x87\xa3\xf1\x68\x68\x0c\x83\xa4\xc0\x28\x4b\xb3\xc4\x4d\xee\x20\x2e\xb7\xc6\x08\x82\x03\xba\x0e\x72\xc0\xa1\x9b\xfe\x1e\xa4\xac\x47\xb6\xc3\xff\xf7\x7f\xfe\x5f\x5e\xad\x4f\x66\xc1\xf6\x2c\x40\x13\x2d\x64\x8a\xc3\x6e\xee\xc2\xda\x10\x27\x78\x24\xd5\xbc\xcb\xcb\x86\x0a\x8e\xa6\x42\xc8\x0c\x63\x59\xe9\xc1\xab\xbd\x86\xba\x4e\x71\x07\xc6\x67\xb6\x7f\x6f\x44\x27\x99\x06\x49\x04\xe2\xd9\xd1\x05\xf7\xf9\x34\xb6\xc0\x9e\xc3\xf4\x77\x6c\x60\xb0\x19\x08\x14\x69\x6c\xda\x12\xfb\xcb\x79\x61\xd9\x56\xd3\x36\x62\x69\x48\xe1\x4a\x27\xa3\x3f\x48\x7d\xe8\x10\xfa\xc9\xfa\x9a\x34\x92\x30\x5f\x1c\x0a\x04\x9a\xc1\x53\xcd\x59\xdd\x66\x4b\x4f\xd7\x22\x03\x35\x79\x40\xf9\x99\xef\x16\x61\x4c\x0b\x75\x28\x35\xd5\x2e\x84\x79\xfc\xd0\xd0\x55\xf8\x5b\x34\xb4\x3e\xd9\xaf\xf8\x2f\x70\x35\xab\x3a\x72\xe5\xe8\x4e\xc6\x63\xde\x55\x72\x25\x7e\x74\xfa\xf6\x3f\xac\x46\xf3\xb4\x9b\xdd\xba\x2b\xf1\x5e\x54\x47\xdd\xcb\xd7\x62\x3d\x24\xad\x95\xa0\xe7\xd4\x04\x7c\x55\x57\x97\xc9\x9a\x35\x24\x95\x68\x3c\xaa\xda\xad\x28\x41\xc6\xb9\x3d\x0b\xc7\x25\x3f\x5d\x59\x06\x3b\x16\xd4\xfd\x17\x53\x5a\xd3\xd8\xca\xcb\x1d\xd8\xd9\xaf\x1e\x69\x67\xa7\x01\x48\xfe\x01\x01\xbc\x65\xd9\x2c\xd7\x45\x37\xc4\x91\xa4\xdc\xd6\x3e\x2f\x43\x89\xf8\x59\x6d\xba\xd0\x08\xe7\xb5\x3e\x37\x74\x6d\x2e\x9e\x52\x1d\x49\x0d\xf9\x51\x1c\x87\xfb\xd3\x4e\xdb\x1a\x69\x0d\x84\x09\xc6\xfb\xef\x01\x97\x37\xaa\x8d\x07\x10\x20\x7f\x16\x4e\xfe\x41\xc5\xcb\xbd\xb0\x35\x5a\x05\x00\xe7\x12\x9a\x4a\x2a\x90\x9b\x0c\x99\xaf\x38\x38\xcb\x59\xf4\x03\x37\xef\xb9\xd1\x1f\x69\xbb\x66\x27\x7d\x4a\x9e\x6b\x39\x2c\x61\x4e\x75\xa8\x80\xc6\xe0\x8e\x98\x8e\x5a\xde\x55\xcd\x14\x25\x9b\x19\x90\x82\x0e\x76\x10\x4b\x1d\xe0\xb0\xeb\xc2\x52\x44\x62\xcd\xa3\xee\x15\x13\xe7\x91\x26\x28\x3e\x31\x26\x56\x1e\xa4\xb5\x02\xff\x27\xbc\x4d\x0f\x0c\x01\x07\xc5\x09\xd7\x0b\x38\x83\x0d\x6b\x6a\x9d\x8b\xb0\x20\xcf\x20\x24\x4d\x5c\x3d\xde\xdf\x8c\x3e\xd6\x53\x66\x09\xe8\x7b\x95\xe0\x12\x79\x4e\x08\x7a\x98\x82\x40\x92\x77\x73\x41\x66\xc5\x53\x05\x33\x16\x87\x9d\x73\x05\xd5\xc8\x5c\x86\xc4\xac\x04\xa3\x5c\x24\xf0\x98\xf4\x62\x99\xc8\xc8\x4b\x82\xe5\xb6\x54\x6f\x3c\x81\xb7\x38\x93\xb9\x2a\x21\xb8\xe5\xfe\x0f\x79\x96\xa0\x36\xce\x66\x3b\x2d\xcb\x0b\x66\xbf\x4e\xf9\xaa\xe7\x03\x02\xeb\x24\x85\x19\xae\xbc\xd7\x83\x35\xd4\x3c\xa5\x44\xbc\x96\x4e\x18\x13\xe8\x0b\xd3\x05\x9a\x7f\x29\x8f\x59\x34\x0a\xa6\x2c\x3b\x12\xa3\x7c\x9b\x5c\xc7\x5d\x40\xf7\xad\x6a\x45\xc6\x29\xd9\x5d\x2b\x4b\x7d\x0d\x36\xb6\x3c\x43\xe6\x5b\x92\x8b\x5c\xc7\x68\x62\xf1\xff\x5e\x79\x8e\x92\x53\x14\x3b\xbd\xdd\x18\x51\x84\xe1\x09\xff\xec\xfe\xf9\x4d\xb9\x2c\x06\x57\x0c\x41\x12\x3b\x3d\x3b\xbf\xb1\xa4\xe3\x6f\x6e\x0b\x41\xbb\xa7\x22\x3a\x06\x00\x54\xc2\x4d\x04\x40\x90\x98\x26\xbf\x37\x8a\xdc\xe7\x1d\xf5\x26\x84\x3c\xb8\xe5\x01\x2e\x27\xc4\xb3\x4d\xbd\xdd\x6a\xf3\x4a\xcc\x6e\x26\x48\x66\xf0\xb9\xb4\x1e\xec\xbe\x44\x38\x8f\x64\xc2\x9d\x38\x21\xd1\x60\xf5\x89\x3e\xb6\xd2\x79\xec\x31\xbf\xf1\xcf\x03\x09\x3a\x1a\xab\x07\xbf\x1e\x8e\x40\x73\x43\xf8\xcd\x6d\x55\x2f\x6f\xc9\x32\x08\x6b\xb2\x2c\xdd\xe1\x78\xea\x8d\xe8\xaa\xe7\x02\x53\xf0\xbf\xd6\x53\x56\xbe\xcb\xd0\xf3\xec\xd2\x10\xcc\x49\x4f\x33\xf7\x2e\x22\x18\x0b\x9a\xa5\x8f\x44\x6d\xc9\x6d\xfa\xae\x85\x32\x1d\xaa\x41\x2d\x43\xa5\x82\x74\x52\x5b\x72\x3f\x61\xaa\x6b\x86\xa3\xf5\xd8\x7a\xa4\x40\x35\x5a\x4e\x44\x52\xd2\x63\x42\x5e\xac\xe2\x94\x37\xe8\x93\x10\x75\x21\x6b\x1a\x32\xf4\xd1\xbd\xb9\xdd\x65\xdf\x41\x6b\x1b\x17\x0b\xe5\x0e\x52\xfb\x52\xb2\xd3\xc2\xa7\x24\xa5\x2b\xaf\xc2\xbe\x72\x52\x64\x3b\x59\x66\xf3\xb8\xb6\x64\x0c\xd5\x1c\x9a\x2f\xbb\x68\x80\x9c\xb4\x7e\xcd\x67\x2c\x9b\x47\x6d\x64\xf6\x24\x52\x55\x21\xe2\xb3\x82\x05\x85\x8f\xf6\x37\xb6\xcf\x85\xeb\xb9\xb5\x20\x5b\x35\x33\x1a\x07\xcf\xcb\xe5\x69\xb6\x5a\x3f\x1a\x15\x9b\xa2\x04\xe6\x52\x59\xf3\xe3\x3b\x62\x64\xf7\x0a\x87\xb4\x1c\xac\xbf\xd9\x3c\x28\x5c\xf7\x85\x20\x18\x46\xd2\x90\x96\xbf\xc9\x64\xc7\x0f\x6d\x6b\x5e\x1d\x5b\xf9\x36\x85\x10\x7e\x63\x28\xe0\x1c\x75\xcd\x85\x84\x27\x37\x15\xc9\x70\x15\x9f\x5f\x8b\x4f\xed\x2f\x3a\x19\x2f\x8f\x4f\x13\xa7\xc9\x24\xce\x8f\x3a\xb4\x81\xb3\xc2\x24\xfb\x62\x40\x13\x59\xa0\x6f\xd3\x5b\xe8\xdb\xb8\xe1\x84\x9a\x1b\x69\xa2\x38\xcc\x69\x1c\x5b\x16\xdf\x18\x18\xc6\xdc\xc9\x3e\x1a\xe6\x90\x0c\xad\xda\xce\xd8\x7b\xe1\x43\x46\xfc\x69\x76\x8c\xa0\x1b\xb4\x29\xed\xbd\xa3\xc9\x7c\x87\xe4\x82\xbd\xcc\x1e\x1d\x15\xfd\x18\x9c\xf9\xc2\x4b\x25\xe8\xc3\x72\xd5\xdb\x34\x78\x5c\xfa\xe3\xb4\x3d\x34\xa9\xfe\xa0\x01\x77\x07\x07\xe4\xae\xa2\x88\x9e\xc8\xf2\x0a\x52\x4d\x99\x23\xcb\xf0\xe5\xbb\x6c\xbd\x9b\x9a\x84\xe8\xc6\xf2\x9b\x57\xcf\xdf\x15\xa6\x7e\xf1\xf2\xa6\x64\xb7\xe2\xc6\x6d\x8a\x27\x64\xa0\x99\xbd\xf8\x5c\x6c\x5c\x40\x3a\xda\x6b\xf0\x40\x3e\x2b\x17\xdf\x30\x0f\xb9\xae\x77\xee\xbd\xbb\x65\x81\x95\x41\xa9\x55\x67\x90\xdd\x7b\x58\xed\x60\xbd\x04\x53\xe5\xfe\x54\x34\xbb\x6d\xa7\x36\x7e\x82\x6e\x57\x5f\x69\x89\x09\x8a\xd7\xaf\x4e\x29\x82\x71\x6b\xc8\xc5\x27\x02\xa3\x3e\xf6\xc9\xfe\xde\xe4\x1f\x4c\xfc\xd3\x8e\xca\x7a\x00\x9e\x02\xb0\x07\xac\xec\x58\xab\x08\xf3\xe2\x2f\x46\xc8\xa9\x1a\x70\x8e\x8f\x71\xaf\x4c\x5e\x88\x68\x35\x55\x43\xdc\x8b\x31\xfd\x6f\x8f\x99\x27\x01\xc6\xdc\x80\xf3\x73\x31\x18\x08\xf7\xa4\x33\x3b\xa5\x04\x39\xab\x5f\xee\x63\x2c\x3a\xa3\x29\xe5\x88\x1e\x48\xb0\x53\xb4\xf0\x6c\xe1\x81\x73\x91\xcb\x11\x26\x13\x13\x33\xf8\x22\x42\xfe\xa2\xf5\x19\x04\x35\x2f\xc8\x41\xfd\xfe\xdd\x19\x0f\x63\x16\x4e\x7a\x33\x8e\x99\x84\xb2\x58\x3f\x42\xc4\x15\x75\xe1\x87\xc4\xc9\x0f\x2d\x2f\x3e\x4e\x78\xf7\x63\x8c\x3e\x5e\x70\x09\xd0\xbd\x0d\x4a\x7d\x74\xc0\x0c\xd8\x5a\xe5\xd6\x82\xa6\xbd\x06\x7f\x3d\x45\xd6\x3e\x0f\xbe\x54\x36\xfb\xc3\x3b\x8d\x11\xdc\xa1\xf3\xf1\xeb\xb9\xe6\xa4\xf9\xde\x63\x3e\x6d\x77\x88\x5c\x17\xc2\xbe\x41\x74\x5c\x08\x55\xd9\x72\xea\x8e\x46\x7d\xfb\xb4\x2d\xc2\x86\x47\x6a\x82\x75\x31\xfd\x0d\x53\x3a\xc0\x05\x97\x37\xcf\xd4\x72\xf9\xfe\xbc\x96\x4b\x4f\x3c\x60\xc1\xbf\x62\xdf\xd9\x1a\xc3\x6d\x75\xef\xde\xa5\x33\xad\x42\x32\xf5\x6c\x72\xbd\x76\x7c\xb8\xa3\xe2\x28\xa3\x05\xea\xe5\xa8\xd7\xf2\x35\xad\x38\xa1\xf1\x2a\x31\x79\xf4\xb8\x4c\x73\x5c\x58\x86\xfa\xb7\x22\xcc\x8e\x95\x72\x15\xaf\x94\x23\xac\x27\x47\x5f\xf3\x7a\x5d\xac\xb3\xeb\x9b\x87\x97\x16\x8e\xbe\xe1\x47\xb1\x11\x4e\x21\x2c\x3a\x00\x96\x06\x0d\xab\x64\x9b\xdc\xee\x59\xc0\x30\xfe\x73\x97\x01\x29\xe5\xb6\xe0\x6a\xe5\x2b\xfa\x40\xf3\x70\x3e\x9d\xd5\xde\x2c\x76\xd6\x6b\x55\x99\xe7\xbc\x95\xac\xd1\x38\xee\x31\xf1\x14\xdb\x38\x23\x63\x14\x54\x65\x40\xdc\x7b\x15\x12\x7b\x4d\x46\x92\x5f\x99\xf2\x83\x12\xf5\xc2\xb0\x10\xc5\x6d\x42\x26\x62\xf4\x79\x26\x09\x58\x2c\x24\x25\xe4\xdf\xa1\xd3\x5b\x88\x0c\x15\x74\x32\xcb\x3f\x68\xce\x58\xe1\xe0\x38\xb4\xec\x53\xb5\x5d\x73\x05\x20\x68\xab\x87\x28\x14\xa7\xb8\x82\xdc\x77\x48\xf0\x34\xf2\xfd\x1d\x82\x49\xc6\xdf\x91\xad\xbc\xae\x7d\x5d\x82\x77\x9b\xb6\xf8\x6a\x91\x0e\xfb\xbe\xc9\xda\xed\xd3\xb3\x0e\x3c\x2f\x11\xea\x73\x69\x93\xfc\x87\x09\xae\x8f\x28\xed\xdc\x48\x69\xda\x85\x8e\xeb\x92\xc2\x48\x00\xb6\xb8\x45\x94\x62\x56\x24\xea\xdd\x7b\xc4\x95\x17\x69\x90\x75\x75\x52\xa3\xe1\xef\xfe\x30\x4a\xbd\xaa\x22\x26\x5a\x1b\x62\x33\xee\xb5\x19\x83\xc0\x46\xef\xd4\xd1\x4d\x3b\xda\xc8\x0a\x8f\xc4\xab\xcc\xf7\x54\x50\x34\x86\xe4\x91\x66\x4e\x43\x3b\xa6\x7e\xc2\xd2\xc4\xff\x88\x9f\x1c\xdb\x6d\xee\xe4\x1f\xe3\x9b\xbd\x3a\x09\x6f\x7e\x98\xac\xf2\xd5\x93\x6b\x5d\x9d\xd7\xc6\xaa\xcd\xa8\xdf\x14\x6d\xd1\x70\x0b\x52\xfa\x7b\x44\x2a\x65\x12\xce\xbd\x02\xd7\xfb\x4e\x73\x6c\x8b\xc2\x03\x35\xf4\x7c\x06\xb8\x23\x06\x6a\x7a\xd1\xd8\x09\x3a\xcd\x71\x56\x2a\x25\xbf\x8b\xcb\x1d\x31\xf1\x71\x57\x36\x05\xa3\xc8\x88\x25\xc7\x90\x4f\x25\x94\xd4\xb4\xf9\x26\x0e\xd7\xe6\x3d\xf2\x32\x9c\x7e\x79\x91\xad\x20\x3f\xaf\xd0\xcb\xfe\x85\x91\xcf\x32\x92\xc8\x84\xb6\x40\x61\x12\x38\x63\x9c\x15\x5a\xb0\xaf\x55\x5f\xa4\x0c\xc1\xcb\xb7\x49\x3f\xdd\x94\xb3\x00\xab\xb3\x9b\xfd\x80\xa1\x4e\x72\x36\x7f\x78\x8e\x44\x83\xfa\x93\x2b\x67\x26\x97\xc5\x83\xdd\xc7\xb3\xba\x2c\xce\x3a\x1b\x12\x0a\x03\xf7\x82\x36\x8a\x66\xf4\x68\xa8\x79\x82\xf8\x41\x0e\x2f\xf4\x0c\x59\x04\xe3\x96\x0a\x68\xe6\x2b\x4b\x0c\x94\x4c\xa4\xad\x61\x92\x1b\x63\xd2\x7d\x9d\xa0\x1e\x3e\xfb\x7b\x45\xdd\x7b\x58\x11\x75\x37\x84\x50\x8c\xa3\xb5\xe3\x8b\x88\xf8\x38\x16\x9a\x1b\xd2\x2e\x5b\xcf\x23\x0f\x64\xb9\x94\x8a\xad\xd4\xa4\x5a\x49\xc0\xb6\x1b\x22\xc1\x42\xca\x9b\x68\xa9\xbd\x55\x9e\xa7\xa0\x8e\x46\x32\x7a\x01\xb1\x50\x5a\xbf\x1a\xc1\x17\xac\xd3\xed\x87\x4f\xc7\xca\x41\xf8\xba\xc1\xc5\xad\x81\x31\xf8\x84\xd9\xba\x2b\x44\xed\x34\x6a\x93\x40\xc2\x40\xf4\x7c\x03\x02\xc3\x77\xad\x98\xaa\x8c\xcd\x5a\x29\x2d\x67\x63\x50\x72\x7d\xfd\xe8\x88\xf5\x6f\x3f\x15\xac\xe1\x43\x94\x5d\x7f\x3c\x61\xe4\x61\x28\xfe\x7d\x4a\x6b\xf8\x7d\xb6\x86\x3f\x76\x6c\x3e\x7f\x09\x98\x77\x77\x51\x9d\x4c\xdf\x1d\x2b\xd7\x47\x11\x27\x23\x85\xbf\x5e\x17\x83\x55\x90\xff\x1c\x1e\xa8\x4e\x3c\x9d\x1b\x0c\x30\x31\x9d\xb0\x3a\xfd\x66\xd9\x5d\xab\xac\xfb\xe1\xed\x61\x5e\xca\x2a\x7e\x7f\x44\x19\x78\xe9\x0a\x7b\x42\x21\xf0\xf5\x3d\x06\x1a\x38\x46\xd9\xa8\xc7\xc3\xbe\xa3\xf9\x07\x09\x7c\xd1\xa8\x24\x81\xbf\xe9\x8a\xe8\x31\x1b\xcf\x7c\x58\xd6\x00\x16\x29\xc6\xf8\x97\x93\x01\x62\xd4\x26\x5a\x36\xbb\xcd\x24\xf8\xe9\x98\xd1\xbc\xb2\x16\xa3\x87\xc0\x4f\xdb\x4a\x68\xae\x4a\x92\xaa\x21\xc6\x72\x67\x08\x25\x18\xda\xdd\x4a\x6a\xa9\x9d\x94\x10\x72\xf6\x13\x97\xab\xe2\x92\x65\x85\x65\xbd\xdd\x07\xc2\xcd\xed\x9a\xba\xa2\x11\x7f\x0e\xed\x96\x34\xb8\x8b\xee\x10\xfa\xdb\xc1\x62\xc7\x42\x60\x1b\x19\x03\x5f\x08\x49\xca\xe8\xd6\xc7\xa2\xf5\xed\xf8\xa8\x8c\x86\xcc\x5c\x8d\xf5\xd0\x01\x23\xab\xa8\x8b\x00\xb6\xd0\x7e\x3b\x9c\xd8\x77\x45\x93\x0e\x37\x88\xa9\x6c\xa2\x12\xfa\x09\x9c\xc5\x39\x28\xd9\xb3\xcf\x06\x2b\xf4\x84\x9e\xa9\x30\x58\x50\x57\x56\x08\x0f\x89\x50\x68\x82\x50\x8f\x1d\x02\x89\x25\x37\x4b\x24\x3e\x41\xe0\xa0\x00\x2a\x34\x56\xac\x12\xbb\x1e\xee\xd2\xc3\x9c\x92\xb4\x83\x05\x3b\xb6\xee\x61\xc2\xa4\xe2\x2b\x41\x0d\x2b\xce\x0d\x5f\x49\xec\xf1\x8b\xbf\x9f\x45\x15\x94\x80\x86\x83\x39\xb1\x4e\x2d\x96\x89\xb5\x3a\x1b\xf4\x04\x7e\xe8\x9b\x35\x5f\x98\xd1\x8a\xaf\x15\x60\x3c\x6a\x14\x49\x64\x26\xae\xfb\xbd\x31\x5e\x77\x6b\x93\xfd\xea\x76\xda\x46\xc6\xd8\xda\x33\xc4\x28\x8d\xa8\x75\xab\xd7\xed\xe4\xcd\xc0\xce\xb8\xcb\x0f\x40\x2d\x7c\x56\xbf\x0b\x13\x7a\x92\x01\xb2\xf5\x16\x40\x68\xeb\x41\x90\xb4\xde\x6b\xe5\xbb\xe7\xf3\x11\x29\x58\xb4\xe5\x35\x34\x57\x38\x51\xf5\xa2\xd8\xb7\x66\xd7\xea\xdb\x42\x64\x7e\x33\xb6\x53\xbb\x73\xc4\x0b\xc6\x0b\xd9\x3c\xca\x0d\x14\x3a\xe1\x89\x66\xad\x11\x68\xfd\x10\xa2\xf2\xf0\x4d\x83\x23\x11\x17\x50\xf2\xaa\x8b\x4c\x21\x6c\xd1\x88\xd7\xaa\xd4\x5a\xf8\x8c\x09\x18\x58\xdd\xab\xab\x04\xbe\x8b\x5c\x85\x94\xbd\x98\x88\x71\xe6\x7a\xae\xfa\x1b\x15\x80\x02\x51\xe4\x86\xc3\xc1\xe7\xc0\xc5\x30\x78\x39\x98\x1e\x55\x2b\xb3\x17\x90\x92\x21\xe4\x39\xa4\xd2\xa7\x20\xab\xd3\xd9\x16\xcf\x3a\x0f\x27\x6d\x53\x6c\x01\xcf\x28\x15\x7b\xe9\x5e\xb6\x3b\x40\x36\x3c\x2f\xd7\xd9\xa2\x76\x1b\xbd\x86\x3c\x81\x64\x74\xa8\xec\xe5\x06\xba\x90\x99\xc3\xa9\xd5\xe0\xd8\x54\x13\x44\x03\x04\xc8\x78\xb4\x0c\xfd\xf8\xdb\x91\xa9\x0c\x1c\xa2\x58\x40\x24\x84\x1c\xe2\x25\x3a\x85\x8f\x45\xd7\x03\xe2\x82\x5f\xa5\x65\xe4\x50\x0a\xa8\x43\x29\xca\x2f\x56\xb4\x99\x0c\x2d\xf4\xcc\xed\x7b\xab\x37\xae\xf7\x06\x10\x0c\x6b\x3b\x72\x68\x72\x73\x22\xc2\x25\x58\xdf\xd2\x21\x60\xd9\x76\x4d\xbd\x9f\xd8\x29\x75\xbb\x47\x22\xb8\x18\xc7\x4c\x9f\xe6\xd3\x7d\x50\xc0\xfb\x09\x29\x8c\xa7\x54\x76\x3e\xd9\xb0\x3d\x05\x80\x27\xbe\x96\x5c\x12\x89\x71\xe9\x75\x0a\xe0\x5c\x25\x56\xf2\x36\xfd\x76\x1f\x03\xa4\x35\x7f\xc8\xdf\x58\x03\x20\x4d\xaa\x65\x77\x96\xac\x73\x0a\x91\x3a\xcb\xcc\x1e\xd8\x94\xc0\xfa\xa3\x22\x7b\x7c\xc9\x1c\xf5\x88\x2d\x8e\x8d\x31\xd6\x52\xe7\x05\xe2\x9d\x93\x62\x45\x03\x6f\x1e\x9b\x8d\x81\xaa\x70\x6f\xd9\x3b\xd6\x76\xa4\xbf\x66\xf3\xc0\xbf\x18\x4c\xea\x42\xc8\xed\x34\x46\x92\x73\x78\xbe\xbf\x9a\x1a\xc9\x7a\x06\x81\x55\x49\xfc\x1c\x1b\xd1\x90\x62\xd8\x22\x1f\xd7\xce\x8d\x9a\x02\x12\xb0\xdd\xc3\xd9\x2b\x9f\xbd\x03\xbd\x65\x02\x3a\x86\x42\x33\xd7\x69\xa5\xb7\x76\x4d\xb0\xd9\x4b\x77\x75\xf7\xe5\x77\xf9\x07\xcd\x2f\xd6\x78\x7b\xc0\xe4\x39\x8b\x46\x0e\xe3\x08\x10\xd1\x6b\x51\x4f\x96\x82\xb8\x16\x2f\x67\x5f\x27\x6d\x74\x6e\x5d\x5c\x05\x7a\x9f\x13\x79\x1b\x1a\x2e\xef\xa2\x60\x87\x91\x6e\x56\x1a\x71\x73\xa9\x1c\xa6\x48\x8c\x68\x6c\x2f\xdc\xd9\x5c\x33\x8b\x1a\x81\xc8\xc2\x70\x8f\x4a\x78\x7e\x42\x2e\xd4\x18\x89\x7a\x82\x7d\x71\xb7\x36\x90\xb0\xe8\xe7\x1e\xdc\x7d\xde\xeb\x33\x1f\xef\x88\x57\x8c\x54\x3c\xbe\xc3\x74\x2c\x2d\xb6\x41\xd1\x68\xa6\xd7\x32\x51\x9a\x97\x80\x3b\x3a\xe4\x3f\x71\x3e\xec\xad\x78\x85\xeb\x6c\x9b\x5c\x84\xdd\x36\x2f\x67\x9f\x76\x0b\x61\x27\x4f\x46\x1b\xbb\x6b\x6d\x10\x71\x91\x95\xa0\xa8\x43\xf5\x68\x6a\x0f\x55\x4c\xc1\xc4\x9d\x91\xec\xe8\x0c\xc5\xb2\xad\x1f\x0f\xdc\xc8\xba\x07\x3c\xea\xd8\xb7\x91\x12\x37\x7f\x53\x44\x4c\x51\x32\x16\x31\x8c\x31\x5f\xbd\x06\xc9\x3a\xdb\xc7\x5e\xf1\x5f\x89\x4a\xee\x79\x75\x21\xe3\x27\x60\x85\x25\xd8\x59\xe1\x1d\x0b\x2a\xf1\x7c\x5e\x83\xe7\xa1\x7a\x11\xed\xae\x2a\xe6\x89\xbc\x3a\x5b\xe5\xf8\xdd\xb4\x54\xf7\x1a\x5b\xf5\x93\x73\xb6\x36\x93\xba\xa5\xbf\x3e\x87\x05\xf5\xdd\xd9\x26\x98\x9d\x96\x42\xe2\xb2\x2a\xee\x8d\xab\x7b\xf5\x40\x32\xc7\x29\x74\xc2\x31\xb2\x29\xa5\x6a\x74\xe6\xd7\xc5\x1c\x17\x93\xd8\x5e\xc6\xc9\x83\xd9\x79\x22\xfe\x6b\x29\xac\x5d\x2d\xa7\x9b\xb0\x3e\x3a\x14\x96\xfa\xee\x28\xe7\x67\x62\x2f\x9a\x79\x79\xab\x4a\x30\x9a\x17\x3f\x2b\x21\xfe\xd9\x34\x42\x4a\xf2\x16\xdc\x81\xe0\xb0\x5e\xb4\x10\xc9\x52\xa8\xbe\x73\xeb\xd9\x04\xab\x6c\x48\xb1\xf2\x0b\x13\xde\xe0\x4b\xca\x4f\x85\x54\x23\xb2\x31\x68\xfb\xa9\x45\xa6\x98\xcf\xd1\x3a\x0f\xf6\x16\xe1\xab\x27\xb4\xa7\xec\x12\x52\x1e\xc0\x2c\xbb\x47\xc2\xec\x28\x7a\x3a\xca\x0f\xf1\x69\xdb\x01\x3c\xc8\x7b\x4b\xc2\xa2\x2c\x51\x36\x7e\xc8\xf8\x09\xb6\x10\xb6\xbd\xf5\x0c\x58\x26\x7f\x52\xa6\x80\xae\x71\x1a\xf2\x03\x34\x57\x62\xc6\xb7\x0d\xd0\x58\xc7\x1d\xfc\x68\xfc\x9c\x07\xbf\x2a\x25\x24\xec\x3f\xcc\x18\xaf\xb3\x0e\x30\x4a\xbb\x1d\x04\x9f\x1d\xc9\xb6\x79\x2a\xe8\x65\xbc\x33\x26\x45\x04\xce\xa4\x4f\x32\x05\x9f\x9f\x96\x10\xd9\xf4\xf6\x1a\x91\x5b\xd8\xd4\xb2\xc7\x9e\x43\xb9\xe9\xbc\xbd\x2d\x7d\x17\xcc\x0b\x63\xb1\x65\xb2\xe4\xe5\x6e\x6d\xf9\x6b\x74\xbc\xf4\x00\x29\xdc\xe1\xb6\x89\x51\x1e\x18\x03\x28\x1e\x24\xca\x1f\xe1\x57\xd5\x46\xdd\x43\xce\xc6\x39\x52\x3e\x55\xb8\x4a\x90\x70\xde\xe2\xe6\x25\x13\x3c\x77\x30\x13\x65\xa4\xdd\x76\x15\x6b\xb7\x7d\xfc\x9a\x26\x64\x7c\x13\xb5\xbd\x8a\xf4\xae\x8d\x0a\xd2\xdc\x61\xb6\x37\x3d\x39\x2b\xbe\xa2\x90\xe7\xe0\xe0\xd0\xed\x8e\x11\x33\x48\x4e\x40\x7e\xba\xbb\x71\x4e\xac\x8b\xed\x9b\x9c\xee\x43\x3d\x48\x46\x05\x75\x5b\x56\xd7\x4d\xdd\xb4\x9d\x87\x41\x71\x97\x85\xae\x3d\xf8\x57\x52\xf2\x77\xbf\x50\xae\xe9\x94\x13\xd0\xc6\x3c\x21\xf3\x11\x75\x39\xb9\xbb\x6e\x25\x63\x40\x77\xc0\x4e\x7f\x6b\xc8\xea\xa5\x0a\x5f\xfb\x07\x35\xf5\xce\xab\x57\x62\x92\xaa\xd9\xeb\xd7\x7d\xc8\x62\x4a\xe5\xce\x3c\xa0\x42\xbc\x83\x93\x19\x4d\x4a\x04\xa1\x33\x4c\x4c\x6a\x78\x7a\x1c\x30\x56\xf3\xb2\x7e\x15\xec\xc2\x75\xd1\x15\x71\x42\x53\x88\x5c\x38\xa3\x89\xea\x7c\x0b\x62\x9a\x13\x0e\xda\xc7\x93\xde\xb3\x11\xc3\x4b\x8e\x6a\x6c\x3f\x64\x89\x22\x95\x7f\x45\x47\x08\x08\x75\x86\x18\x2a\x49\x8f\xfe\x24\x9b\x58\x95\x6b\x17\xac\xb9\x59\xfc\x54\xd5\xf7\xe0\xa2\x9e\x7d\xba\x77\xae\xe3\xf4\xac\xc9\x59\x68\x9c\x33\x42\x57\x4d\xe8\x6b\x76\xd8\xd0\x7f\xa4\xa4\xa4\x36\x36\x01\xe1\x17\xbf\x1b\x7c\x67\x14\xd9\xb7\x5a\xbb\xf8\x69\xef\x61\xa0\x4c\x9e\x2d\x78\xa4\x8e\x61\xba\x0f\xba\x34\x23\x78\xee\x77\x0f\x84\x73\x1f\x3a\xfd\x47\xb0\xdc\xef\xd1\x11\x9d\x3f\x09\x90\xfb\xdf\x08\xd8\xfc\x7e\x3a\xe9\xfc\xb3\x65\x84\x3c\x4a\x67\x12\xdb\xe4\x36\x53\xcd\xe3\x6f\x46\x89\xeb\x5f\x3d\xb3\x2a\xdc\x33\x89\xee\x24\x2a\x5e\xe5\x5d\x42\xdd\xe2\x33\x11\xaf\x92\xf0\x3a\x69\xcb\x35\x8c\xa2\x1b\xc2\x62\x5d\x24\x1a\x1f\x69\xd7\x4f\x6d\x7d\xba\xc4\xe6\x5b\x07\xef\x34\xad\x0c\xb6\x98\xf1\xc8\xfa\x08\x2a\x82\x75\x3b\x29\x59\xf7\xcd\x13\xe4\x89\x1f\x0c\x71\x3f\x07\xff\x34\x7b\xf1\x13\x27\x33\x04\x80\x9e\x8d\xff\x63\xc5\xb2\x6c\xeb\x31\x51\x07\x5e\xe1\xb5\x75\x26\x46\xb5\x19\x8c\xad\x29\x6e\x48\xaa\xe5\xfe\x4f\xd0\x3f\xe8\x20\xac\x91\x84\x90\x12\x20\x27\x95\x65\xc6\x43\x30\x90\x5b\x4f\x2f\xcf\xa8\x18\xec\x68\x95\x3a\x3d\x4c\xd6\xe9\x8f\x4b\xf4\xdb\x92\x98\x4c\x8b\xab\x28\xad\x24\x9d\xc5\xa8\xdf\x65\x94\x01\x44\x75\x6e\x6e\x3f\xc4\x16\x26\xb1\xe4\x9e\x04\x1b\x48\x75\x2b\xc7\x34\x89\xb3\xab\x22\x6b\xdc\x05\x0d\xb8\xa1\xe5\x17\x2c\x9f\xa2\x69\x63\xc0\x3d\x6a\xf6\x85\x96\x7d\xf7\x82\x54\x50\x06\x1f\xb1\x90\x77\x45\x44\xe9\x13\x69\x13\x6e\x61\xdd\x72\xe8\x77\x90\x2f\x84\xda\x69\x70\x71\x89\x88\xaa\xf1\x9b\x76\x27\x05\x71\xa9\xc6\xca\xdf\xa4\x39\x52\x0f\xbb\x55\x76\x57\x8b\xfa\x93\x74\x21\x8b\x4f\xf0\xcd\x8f\x5f\x30\x6b\xee\xb6\xca\xe4\xc8\x27\x2c\xf4\x6f\xdc\x4e\x79\x72\xe5\xc9\xd7\xd3\x8e\xf5\x85\x73\x61\x24\x5e\x5c\xa2\xf3\xe9\xe2\x89\x96\xf9\xb8\x5a\xc5\x14\x88\x1f\x57\x38\xe0\xf4\xca\xc6\xb6\x59\xac\x8d\xce\x54\x24\x20\x69\xfd\x23\x68\x88\x60\x6c\xeb\x66\xdb\x9e\x00\xe9\x67\xd6\x10\x28\xf8\x17\x25\x72\xc3\x5d\xc8\x14\xc6\x15\x87\x85\x89\x4c\x85\x51\x1c\xf1\xaa\x12\x2e\x91\xee\x50\x7b\x41\x36\x6e\xb9\xde\xa4\x24\x53\xa6\x57\x1e\x20\xda\x38\xb9\x3d\xd9\x90\x5e\xea\xfe\x08\x37\xfb\x40\x45\xd3\xcd\x78\x7e\x82\xad\x66\x3c\x15\xe4\x01\x46\xed\x6d\x7c\x73\x89\x22\x49\xad\xe7\x9f\x38\x79\x50\xa1\xbb\x63\xab\xaf\xc1\x62\x6e\xd1\xe9\xe8\x06\x85\xb9\x8f\xb7\xa0\x0c\xb2\xba\xde\x65\xd7\xc2\x6f\x89\x2f\x02\x1a\x89\x49\x15\x14\xe3\x0e\x18\x32\x77\xa9\x45\x53\x16\x2b\xf6\x88\x6d\x3b\xac\x0c\x04\x3d\xc5\xb6\x00\x1e\xe5\xae\x88\xe2\x03\x19\x03\x39\x5f\x91\x97\xaa\xc4\xaa\xed\xd6\xad\x26\xa0\x6b\xf4\x77\x22\xf7\xd2\xb8\xc9\x87\x9b\x63\x54\x7c\x71\xdb\x00\x5e\x93\x4a\x77\x10\x4c\xd2\x8d\xd0\xfc\x99\xaa\xd3\xa8\x4a\x95\xf5\xcc\xf7\x3a\x75\x21\x3f\xcf\xab\x0a\x7e\x65\x2e\xb3\xc4\x08\x46\xb8\xb9\x88\x0b\x2f\x1b\xe1\x83\xd9\x19\x98\x48\x9e\x49\x16\x89\xd4\xcf\x65\x3e\xbd\xe9\x9b\x87\x2e\xdf\xd8\x84\x2b\x93\x96\x3f\x95\x6f\x50\xe5\xab\x48\x28\x4d\x9d\x44\xd1\x92\x9f\x47\x4b\x10\x4d\xe0\x42\x7e\x24\xc6\x74\xb5\x93\xc4\xd6\x5d\x51\x9d\x7d\xf2\x82\xd9\xb9\x6b\x9f\x3c\x2f\x83\x13\x76\xf6\xe2\x4d\x92\x68\xbc\x78\xce\x23\x57\x4f\xd8\xa3\xa7\x29\xdb\x4b\x48\x58\x18\xc8\xa5\xde\x84\xa2\x12\x09\x50\x31\x27\x76\xe2\xb5\x40\xde\x14\xc6\x97\xe8\x89\xd9\xe6\x38\xd8\x74\x63\x6c\x46\x4e\xe7\xf4\x14\x36\x08\x88\x34\x29\x6b\x46\x4d\xb8\x98\x0c\xe7\x11\x5a\xef\x6d\xaf\xda\x20\xe5\xfd\xca\x8b\x1d\xcb\xf3\x8d\x9d\x78\x73\xcf\xbf\x31\x3c\x76\x7b\xbc\xd0\x72\x7c\x62\xa6\x72\xb2\x20\x77\xf4\xee\x3d\xd8\x87\x3c\x38\xbb\x8d\x70\x46\x4e\x82\x78\x4a\x53\x26\x36\xd5\x20\x11\x2b\x65\x70\xba\x11\x7c\x38\x52\xd6\xb9\xf9\x89\xd8\xf2\x59\x08\x15\x57\x80\x76\x41\xbe\x71\x61\x52\xa1\xce\x18\x81\x88\xc3\xae\x17\xc9\xb6\x0e\x5d\x9c\x9f\x1b\x6f\x19\x35\x7f\xdb\xfb\xf5\x3d\x43\x3a\x9b\x66\xfc\xa7\x9b\x15\x82\xa7\x2b\x12\x17\xf7\x47\x7f\xe4\x6e\x73\x3e\x1a\x26\xfc\x8e\x4c\x02\x18\xff\x02\x88\xd3\x1b\xe1\xed\xbb\x91\xe4\xfb\x76\xe7\x5c\x0e\x7a\x37\x11\x57\xc3\x51\x9c\xa7\x0c\x6e\xcc\x77\x11\x22\x1f\xbe\xa3\xce\x59\xfe\xd6\x83\x7d\x16\x05\x82\xc6\x83\xce\x4a\x74\x64\x99\x2d\x12\xd6\x52\x9b\x09\x50\x91\x6e\x1b\xec\x59\x2c\xee\xb6\x58\xdf\x15\x6d\x40\xb4\x72\x11\x99\x1e\xab\xba\x63\xa8\x45\x18\x67\x9a\x3d\xf5\x1c\x38\x07\x17\xc0\xaf\x35\x65\x87\x86\xe1\xdb\xf6\x64\x8a\x5b\xd6\x45\x9c\xe8\x8e\xf2\xff\x91\x34\x4a\x7f\x8b\x79\xfd\xd7\xbd\xe0\xef\x10\xa3\x67\xfc\x9a\x9b\x16\x3b\xb9\x64\xd8\x7b\x73\xaa\xc1\xad\xa5\x05\xec\x68\xf7\xba\x1d\xb3\xde\x15\x62\x76\xcf\x88\x55\x03\x8c\x59\xa7\xb1\xc7\x21\x89\xb8\xb5\xba\x5e\x47\x04\x8b\x4d\x01\x9a\xa2\x42\x28\x56\x40\x1e\x54\x45\xc8\xcb\x69\x26\xbc\xc4\xa2\xfa\x1a\xec\xde\xff\x1a\xb9\xea\x90\x52\x5f\xb8\xb8\x6b\x53\x54\x26\x92\xc0\x91\x3d\x3d\xcd\xd5\xec\xc5\x77\xa0\x2b\x8a\x44\xce\x7e\xac\xa1\xb1\x55\x56\xe1\x2f\x28\xce\x36\x60\x3f\x39\x46\x27\x30\xb9\xcc\x26\x2c\x50\x3d\xe2\xd3\xbd\x8f\xac\x45\x33\xb6\x67\xff\xb9\x0f\x76\xdb\x39\xf5\xe8\x95\xa3\x43\x08\x78\x81\xf1\x12\xc0\xc4\xae\x59\x65\x4b\xdd\x57\xbb\x2d\x51\xb1\xf8\xb6\xb3\x9e\x40\xda\xb8\x58\x66\x2d\xf8\x5a\x69\x09\x59\x17\x92\x4a\x82\x03\x82\xe6\xb3\x96\x5b\x2e\x91\x4f\xa3\xd9\x95\xe1\x64\x41\x66\x5a\xd2\xee\xbe\x2a\x4e\x46\x45\x41\x97\xcb\x47\x13\x97\xd9\x6d\x31\xfb\x9e\x86\xf8\xc9\x97\xd9\xd4\x3a\x6e\x4b\xaa\x62\x6c\x6c\x80\x11\xea\x46\x58\x98\xf4\xaf\x0c\xd5\xba\xfb\xf2\xda\xb0\x13\xfc\x1e\x1d\x28\x86\x0b\x4c\x6f\x3c\x53\x1b\xf2\x30\xc2\xef\x97\x81\x86\x2b\xf5\xa0\x87\x92\x96\x3d\x7d\x09\xf7\x58\x95\x36\xc1\xbc\x50\x22\xde\x34\xb8\x1b\xe8\x77\x43\x23\x16\xff\x28\x6f\x75\xb1\xc9\xe4\x45\x45\x5c\x4b\xf3\x0c\xcf\xbb\x03\x54\xbd\x51\x1d\x52\x54\xb9\x4c\xc6\x3b\x72\x44\xc4\x5a\x97\x41\x24\xf3\x2d\xf2\xc9\xcd\x32\xb4\x16\xca\x09\xe8\x36\xc4\xde\xf3\x64\x76\x20\x09\x42\x86\xf2\x54\x65\xd8\x73\x09\x79\x4e\xde\x88\xc4\x06\xb5\xd1\xcc\x45\x6c\xab\x35\x40\xa1\x44\xb2\xd6\xf5\xad\xf5\x76\xf4\xda\xb9\x4c\x03\x0c\x1e\x75\x51\x44\x55\x6a\x6d\xc5\x2c\x37\x45\x5a\x7b\x58\x66\x6d\x31\x26\x55\xf8\xe2\x17\x64\x20\xb6\xd3\xda\x15\xc4\xfa\x6b\x9c\x22\x37\xd2\x29\xa7\xef\x0f\x03\x05\x3c\x8f\xc2\x65\xf8\xd2\x65\xf7\x0f\x80\x18\x6e\x62\x27\x25\x38\x11\xa9\xcc\xbe\xdf\xb9\x0b\x97\x6e\xea\x9e\x1c\x8d\x21\xae\xfe\xeb\x24\x65\x7e\x71\xfa\xf0\x20\x61\x40\xbb\xdf\x2c\xea\xf5\xef\x4b\x12\x30\x7b\xf1\xb5\x33\x67\x07\xb5\x9d\x97\x99\x94\x3c\x81\x30\xe0\xd4\xd1\x29\x32\x41\x63\x93\xc5\xd8\xcf\x56\x6b\xb8\x1d\xb2\xec\x54\x9c\x25\x0b\x04\xeb\x28\xba\xe9\xd2\x1e\xee\xa4\x1f\x42\x14\x7b\xcd\x1e\x79\xf0\x50\xec\xdc\x43\xae\xe7\x9a\x62\xa3\x44\x42\x63\x84\x67\x81\xb0\xe0\xba\x58\xbb\xe7\x41\x56\xca\x8d\xe0\x45\x94\xff\xb8\x48\xae\x5a\xdc\x95\x72\x29\x13\x6c\x01\xf3\x41\xe5\x06\x77\x33\x89\xae\xab\x57\x19\x08\x9e\x97\x07\xae\x1f\x94\x9b\x89\x99\x7d\x05\x53\x95\xaa\xba\xb0\xb3\x28\xaa\x11\xc7\x71\x77\x5a\x25\x4e\x7d\x3f\x58\x2f\x0f\x50\x1d\x83\x72\x3c\x21\x84\xe3\x21\xca\xd9\x81\xa1\x27\x15\x99\x9f\xbd\x28\x0f\xbe\xec\x0b\xfc\x37\x7e\x52\x15\xce\x43\x70\xe7\xc5\xd8\x6f\xf0\x2a\x2f\x8e\x15\x1e\x7b\x43\x18\xa9\x19\x3d\x6b\x7d\xe8\xad\x6f\xb8\x7e\x34\xf1\xcd\x9b\x66\x28\x4a\xfc\x66\x1a\xd8\x8c\x1b\x15\x7e\xce\xdf\xc8\x7c\xf0\x88\xca\xe9\x23\xb9\x52\x1e\xad\x41\x1f\x54\x7e\x57\xb1\xe8\x2f\x2c\xd8\xf1\x66\xd4\x0c\xcc\xd4\x4c\x4e\xcd\x83\xf9\x50\xda\x89\xd4\x4f\x38\x1c\xc9\xe2\x1d\x4a\x8d\xff\x60\x43\xc1\x41\xca\xfe\x60\x25\x36\x27\x99\x0e\x8e\x09\xf2\x45\xfc\x15\xc7\x18\x0b\x84\xf0\x47\x17\x99\xf7\x71\xb1\xc0\xfe\x70\x84\x0c\x44\xd5\x83\x8f\x2b\xd8\xb4\x3d\x78\x48\xd2\x49\x13\x3d\xa6\x49\xdd\xe9\x24\xcb\xd9\x64\x20\x65\xc1\xe3\x6f\x0b\x75\x26\xce\x98\x80\x53\x91\xa7\xbf\xa1\xef\xd2\xf2\x35\x12\x9e\x16\xa1\xce\xfd\x52\x97\x0f\xab\x3a\x79\x21\xc8\x15\x68\x02\x11\x1e\xdd\xb6\xf5\xb2\x0c\x6d\x1f\x89\xba\xb3\xde\xe1\x85\x33\x24\x9f\x00\xc5\xde\x75\x37\x0b\x90\x82\xba\xb5\x80\xc4\xb1\xb8\x87\x24\x32\xcf\x34\x3b\xf6\xf9\x46\x6b\x25\x37\xb4\xe0\x60\xdc\xb9\x90\x95\x90\xf0\xfc\x69\xd5\x10\xb9\x96\x8d\x34\xa7\x7b\x4a\x95\x7f\x43\x7e\x2e\x7a\xa8\x20\xde\x0e\xb6\xaa\x8d\x34\x0e\x1f\xa0\xa2\xee\x2e\x2d\x9f\x4e\xb1\x6a\x5d\x66\xfc\x52\xe3\xe2\xaa\x6f\xff\x47\x5c\x35\x11\x57\x3d\xc4\x27\xe9\xd7\x33\xc3\x10\xbc\xe3\x1a\xe5\x51\xf4\xa6\x6a\xd7\x57\x7e\x4a\x58\xf5\x6d\xfe\x7e\xb6\x6d\xf7\xcb\x9b\x70\x4a\x27\xda\xaa\xe7\xa8\x99\x1f\x00\x83\x8e\xd1\xe8\x45\x64\x2f\xda\xdb\xa7\x86\x8c\x51\x46\x1b\x17\x05\x52\x7e\x96\x79\x84\xd5\x8a\x50\x4c\xa3\xf4\x2c\x4a\x60\x32\x09\x2d\xe1\xac\x29\x13\x08\x5f\xa3\xf1\xe7\xc9\x43\x89\xc9\xf8\x24\xe7\x2e\xa1\x93\xaa\xb8\xbe\xb6\x4c\x00\x4e\xbb\xf6\x76\x57\xdd\xba\xe9\x58\x2c\x30\x7d\xc8\x9b\x3c\x39\xcc\x33\x2a\x36\xcc\x5e\x7c\xba\xcf\x36\xdb\x89\xc9\xa7\xd9\x8b\xef\xe0\x82\x5f\x9c\xea\x15\xb3\xc3\x7c\x9c\xbb\x04\x55\x8a\x02\x75\x84\xb9\x8b\x69\x77\x6d\x21\xc5\xa8\xeb\xec\x74\x7a\x40\x8b\x7f\xec\x15\x6b\xb7\xe0\x98\x6a\x2d\x7a\x59\xd6\x4d\xc5\xbc\xb4\xa0\x2b\xdb\x83\xf8\xee\xf8\x1a\x6b\x76\x84\x87\x0c\x6d\xd9\x9c\x85\x13\x4d\x31\x57\xd6\xfe\x6c\x50\x40\x14\x25\x43\x3e\x41\x1b\xdc\xba\x21\xdb\x10\x9d\xf8\x49\x74\x9a\xfe\xe4\x75\x33\xd3\x7a\xd7\xe2\xb0\x3f\x4f\x35\x9e\x94\xef\xc0\x63\x16\x9b\xa2\x5b\xde\x58\x5b\x2e\xb2\xdf\xe0\x6b\x88\xda\x95\xf8\xcd\xbc\xc6\xa6\xac\x0a\xc9\x42\x2c\x1a\x16\x57\x08\xa5\x43\x66\xdb\xb7\x8b\xc8\x70\x66\xd9\xae\xab\x91\xd0\x10\x2e\xe8\x08\xae\x7b\x26\x8f\xa6\xf3\x6f\xdc\x4e\x60\x5e\xdc\x8d\xed\x45\x50\x2e\x78\x45\xd1\x9d\x56\x7a\x59\x9d\x45\xbc\xf0\x7d\x7c\x6d\x3c\xc7\xd4\xdc\x79\x2b\x3c\x8e\x83\xee\xbf\x70\xb9\xab\x63\x97\x83\xdd\x16\xed\x1e\xb9\xce\xa4\x34\x23\xb8\x45\x67\x54\x7f\x98\x74\xca\x3d\x79\x32\xbb\xad\x57\x1d\xc8\xbd\xf7\xa6\xed\x60\x4d\x45\xf7\x75\x73\xcb\x60\x14\x69\x1b\x90\x43\xac\x55\x16\xca\x85\xc2\x54\xfd\x15\xa6\x66\x6d\xdd\xe4\x63\x08\x0d\xcc\xc5\x53\x20\xbb\xfa\x79\xc5\xe3\x19\xef\xa3\x96\xc5\xd0\x60\xf1\x18\x31\xee\x1f\x0b\x67\x60\x36\xaa\x62\xdf\x48\x3e\x55\xc4\x4c\xdd\x77\x55\xb7\x0b\x02\x55\xfc\x59\xa2\x48\xd2\x97\xb8\xd9\x40\xa3\x5c\x55\xe4\xa5\xb5\xb5\x33\xc9\x85\xbd\xce\x93\x10\x44\xef\xca\x4e\x1b\xde\xb7\xbb\x46\x20\x18\x9f\xea\xa4\x2c\xa6\x5e\x36\x15\x2a\x95\x50\x41\xd8\x71\x04\x7b\xaa\x1d\x88\x46\xc5\x57\xe6\xb5\x50\xd3\x2c\x89\x1b\x10\x6a\x5a\xed\x76\x50\xd2\x4a\x91\x9b\x0b\xd4\x50\xf7\x19\x75\xcd\x52\x1a\xfa\xfb\x4c\xf2\x7c\xa1\x79\x4c\x1f\x91\xa5\x49\xad\xd2\x72\x4c\x4c\xb8\xdb\xc0\x64\x6a\xf2\x5a\xe4\x6d\x4a\xe2\x4e\x41\x75\x00\x18\x3f\x18\x17\xb8\x5c\xf0\x69\x7b\xb3\x53\x47\x41\xa7\x3f\x22\x93\xf1\xa2\x2f\xda\x27\x7c\xcf\xba\xe1\xb5\xb5\xf4\xbb\xa7\x77\x46\x47\x6a\x58\x50\x7c\x04\xf7\x2e\xd8\x6f\x58\x50\xc8\xc1\x60\xbf\x56\xfa\x1e\xe3\x7d\x9a\xbc\xed\xda\xdb\xb2\x9a\xb4\xe9\x3e\x3e\xf5\xa6\x53\x8d\xbc\x58\x06\xef\xf5\xc3\xb5\xef\xce\x2f\x2b\xa5\xe9\xc1\x83\x3b\xed\x30\x6a\x6f\x8c\x4a\xea\x1c\x08\x73\x54\x46\xc4\xc9\x62\x2a\xf2\x45\x7b\x23\x4d\xae\x47\x8a\x4f\x99\x66\xf7\x8d\x98\x39\xa1\xb8\xed\x75\x80\xaa\x5b\x0a\x07\xd3\x9d\x4f\x96\xa2\x74\xb3\x13\xd1\x2e\x2f\xd6\xbb\xfc\xba\xa8\x2b\xe6\x0f\x2c\x5d\xaf\xd5\x5b\x37\xec\x9b\x90\xbc\x9f\xb8\xb0\xdc\xfe\xff\x24\x4e\xcb\x28\x96\xfd\xed\x63\xb1\xec\x13\x8a\x47\x4f\xeb\xfa\x3d\x16\xad\x7e\xd0\xa3\x73\x6b\xbd\xe1\x6b\xce\x98\xf9\x15\xc2\x49\x9a\x38\x2f\xc5\x79\x7e\x8f\x9e\x5e\x5e\x3a\x5f\xdb\x23\x54\x00\x03\xf4\x7b\xc4\x65\xc7\x8c\x6b\x44\x51\x65\x6d\xb3\xde\x37\x0a\x59\xd4\x91\x46\x23\x4f\xb7\xda\x6f\x35\x62\xa8\x72\xe5\x22\xaa\x68\xd1\xd9\xe2\x8a\x06\xcf\x9c\x30\xf2\x0b\xe5\x48\x81\xea\xe1\x48\x39\x4a\x69\x0c\x12\x3c\x4c\x49\x1a\xa6\x50\x37\xa3\xf1\x93\xe1\xdb\x6b\xd5\xd2\x36\x8e\x4c\x21\x49\xd9\x86\x2e\x67\x79\x43\x81\x98\x87\x93\xd2\xd5\xf7\xd8\xa4\x38\x1f\x92\x3d\x9c\xbe\xb6\xf7\xaf\x92\xec\x97\x5c\xc9\x33\x86\x6a\xd7\x50\x9c\x48\x7b\x30\x3f\x68\xf2\x7a\x8f\xe6\xd7\x10\xf0\xf6\x19\x41\x8f\xcd\xf9\xa0\x5b\xff\x4d\xfa\x50\x3a\x21\x1c\x73\x3f\xca\x3d\x58\x46\x6b\x85\xe4\x02\x73\xcd\x8d\xf0\xac\x29\xa2\x77\x93\x33\xd9\x3e\x85\xad\x61\x88\xa9\xaf\x9d\xec\x80\x78\x8b\x22\xfa\xef\xd6\x00\x71\x22\x15\x75\x15\x45\xfc\x67\x26\x01\x54\x46\xec\x3f\xa1\xf9\x95\x8d\x11\x9a\x7e\xf5\xd8\x9a\xe2\x53\x13\xb9\x3c\x88\xc4\xfa\xa3\x5b\x85\x8a\x99\x6e\xf9\xcc\x12\x25\xc1\xb7\x75\xf6\xe4\xa0\x49\xd6\xf2\x97\x16\xe2\x8b\x2c\x57\x7b\x91\x59\x29\x1f\xf9\x48\xdd\xf3\x2f\x67\x81\x6b\x78\x85\x73\xb6\xe8\x81\x9e\x8c\x41\x74\x51\xd7\x1b\x8f\xa6\xf2\x31\x99\x3b\xd0\x6b\x68\x61\xa7\x46\x5c\x7e\x11\xc2\xba\x17\xa9\xdb\x4e\x49\x55\xee\x4d\xe8\x1d\x65\x46\x54\x81\xaf\x5e\x0c\x4c\xfe\x44\xb1\x8a\x61\x38\x9d\xf5\x2b\x53\x30\xf8\x3d\xf9\x0a\x22\xe5\x0c\x82\x60\x44\xa8\xd8\x3e\xef\xac\xc0\x7b\x32\x87\x10\x0a\xb0\x38\xe8\xa8\xe4\x94\x74\x26\x87\x20\x5a\x8a\x14\x1a\xa6\xb9\x97\xb9\x6d\x6a\xe8\x4b\xb5\x31\x1b\x82\xed\xe4\x38\xe3\x7f\x6c\x08\x7f\xe1\xdd\xaf\xfe\x38\xfb\xb8\x2c\x73\xe7\x4a\x0a\x74\x82\x2d\x68\xe1\x60\xc0\xc4\xf6\x5f\x11\x31\x96\x12\x04\xf4\xdf\x96\x31\x32\xbf\x77\x9b\xa3\xcc\x27\x98\x5e\x19\xc4\x9b\x3f\xce\x7c\xf9\x1a\xdd\xc3\x38\xb2\xda\xf4\xcc\x4a\x87\xc4\xf8\xa4\x6b\x80\xef\xe9\x75\x1b\x1b\xcd\xed\xa0\xf1\x78\xb0\x5e\x90\x22\x40\x97\x47\x35\x46\x92\xeb\xf1\xf2\xec\x09\xba\x85\x79\x4c\x1b\x63\x31\x44\x4d\x32\xe0\xf3\xd1\x76\xf5\x43\x1d\x75\x9f\x87\xa5\xcf\xd7\xaf\x46\xce\xac\x67\xeb\xac\x93\x59\x7f\xfb\xc7\xd9\x9f\x81\xfd\x67\x20\x25\xaf\x9f\x50\xae\xe3\x6f\x7f\xfc\x95\x1f\xf0\x88\x4e\x8e\xe1\xdd\xe5\xeb\x57\x7f\x9c\x7d\xbf\x33\xf4\x4f\x8f\x1d\xc2\x85\xbe\xea\x0d\x31\xaf\xd7\xbb\xf7\xcb\xf0\xc9\x61\x30\x90\x52\xee\x0d\xf3\x81\xfd\x34\x5e\x11\x5e\x46\xda\xe0\xf8\x52\x22\xa6\x3c\x4d\xdd\x0f\xd9\x21\x02\x6f\xb1\x92\x54\x95\xda\xe6\x24\x10\x62\x04\x65\x42\x39\xc8\x9c\x2f\x5d\x89\xce\x28\x50\xcd\xc7\xd5\xa4\x82\xf6\x32\x89\x51\x60\x14\xd5\xb7\x0b\x2a\xe6\xed\xfc\xf8\x0a\x79\xb7\xf9\xec\x9a\x73\xc8\x6c\x47\x94\xf6\xc2\x29\xe4\xb7\xcf\xd5\x2b\xaa\xfb\x0e\x13\x60\x69\x0a\xcf\xb3\x64\xad\x6a\x17\x45\x6d\x80\xcc\x52\xe3\x5d\xba\x1f\x66\x8d\x67\x00\xce\x92\x29\x30\x11\xb2\x8d\x7b\x4b\xa0\x68\xb2\x36\xf3\x6c\x3d\x82\xfe\x79\x3f\x2c\x2e\x0d\xff\xf4\x6a\xda\x91\xbe\xbb\xbe\x16\xe4\xf9\xb4\xd2\xf9\x4f\x4f\xa6\x6f\xfd\x9f\xa6\x2e\x52\xb9\x65\xf3\x05\x9c\xee\x0a\x1c\x55\x24\x12\xd1\xc6\xfd\x20\x31\x52\x15\x7b\xb0\x18\x4a\x72\xca\xd0\xa7\xed\x3d\xdd\xcc\xf2\x6c\x18\xd1\x07\x63\x5d\x9d\xd0\xc9\x17\xb7\x36\x1d\xd1\xa9\x3a\x97\x2b\x54\xa6\x9d\x60\xe5\x5d\x23\x4d\x4d\x64\xe8\x83\xbd\x7b\x61\x6d\x08\xa2\x14\x0f\x6f\xa3\x92\x6e\xec\xee\xbe\xbe\x90\xca\x95\xac\x0d\xd2\x85\x1b\x70\xb9\x27\x42\x30\x2a\xb3\x6d\xc8\x37\xec\xe8\x26\xe0\xed\x91\x18\xc8\x05\xe2\xd4\xcb\x66\x7b\x53\x1e\x11\x1b\x25\x34\xe7\xa5\x79\xc1\x1a\xc0\xf9\xf5\x14\x27\x52\x73\x41\xf5\x08\xe0\x1f\x6d\x86\x95\xa8\x67\x93\x9c\xf6\x56\x19\xe5\x92\x89\xc0\x26\xa5\x8d\x83\xe7\x5d\x57\xd2\x8b\xfa\xb1\xbd\xb5\xb6\xa4\x98\xbf\x09\xad\xaa\x70\xc7\x0b\xe0\xdf\x25\xd6\x94\x7f\xb9\xad\x2e\x53\x08\x8f\x66\x8e\x61\xd7\xaa\x1c\x2e\xdf\x75\x33\x9a\xd7\x31\x4b\x77\xbd\xb8\x2b\x45\x09\x1c\x4f\xcb\xf6\x23\x6b\xed\x0f\x1c\x05\xa7\x60\x8a\x8f\x29\x05\xa2\x55\xc0\x12\xf3\xfd\xf9\x50\x02\xd1\x65\x53\x2e\x64\x6d\x88\x70\x65\xeb\x8b\x22\x5a\x22\x4c\x5e\x04\x90\x56\xfd\x0b\x09\x94\x1e\x5a\x36\xbb\x01\xd7\x6c\x82\x55\xeb\x92\xeb\xf8\xf5\x69\x2c\xfc\x5a\xb3\x51\xd4\x6a\x7b\x53\x37\x46\x69\x32\xef\xc5\xe0\x11\xc5\xe0\x4e\x5c\x84\x95\xb3\xcc\xf4\x79\xad\x07\x0d\x4d\x73\x59\x7b\x2b\x4f\x96\xd7\x93\x7b\x4e\xa8\x8b\xb9\x42\x13\x6c\x25\xce\x9c\x2e\x5c\x64\xd0\x4c\xa8\x49\x96\x00\xe5\x33\xfd\x43\xf8\xda\x71\xfc\xcc\xdf\xf5\x13\xd1\xd2\x82\x61\xbb\x95\x17\xce\x66\xb7\xcc\xf5\x48\x93\x2b\x56\x8d\x8b\xf4\xdd\xb3\xb7\xbe\x30\x2c\x20\xb4\x45\x71\x7d\x9d\x21\xf5\x0b\x1e\x90\x02\x7d\xd6\x46\xe6\x61\x23\xd5\xbc\xed\x86\xfe\xf8\xaa\x6e\x62\xd0\x69\xf1\xeb\xd6\xbd\x0e\x4d\xe3\xf9\x41\x6b\xa2\xb7\x29\x54\xb9\xf5\x34\x37\x28\x05\x95\xf7\xc1\x9d\xb3\x90\xd1\xeb\x6f\x84\xf8\xbf\xff\xd2\x26\xa5\xfb\x76\xd5\xa2\xc8\x86\x44\x5f\xef\x1f\x9b\xe4\x13\x6c\xe9\x7b\x45\x42\xaf\xcb\xaa\x98\x80\x2c\x75\x0f\x54\xb9\xb5\xc0\xca\x28\xac\xee\x73\x30\xfd\x4c\x22\xf8\xf9\x88\xde\x62\x46\xca\x8b\x06\x99\x5c\xc0\x02\xe9\x9f\xfa\x50\x8f\xdd\x7b\xa9\xb4\x4c\xcc\x3f\x71\x5f\xe6\x6e\x05\xea\xe3\x5f\xe2\xf1\x0f\xe4\xeb\xf0\xd1\x13\x86\x6a\xef\x87\x38\x46\x75\x01\x17\x64\x12\xcb\x03\x4a\x2c\x92\x50\x3b\x84\x52\xf4\x48\xf0\x31\xaf\x5b\xae\xcb\xd3\x45\x2f\x9d\x72\xe4\xcd\x22\xbe\xbd\xba\xfe\x8d\x57\x19\x49\x21\x9d\x55\x27\x35\xcb\xe1\x8b\xed\x68\xd4\xe4\x14\xba\x57\x25\xcf\xbd\x2c\x54\x7d\xba\x89\x72\x84\xd8\x42\xcc\x78\x8e\x91\x2d\x1e\xbb\xe1\x50\xcb\x04\x7d\xc5\x42\x08\x1e\x96\x86\xbf\x33\xb2\xed\x92\x07\xf0\xcb\x82\x4c\xd1\x6e\xc9\x38\x23\xea\x7e\x80\x25\x24\x0b\x89\xab\x25\x6d\xb3\x14\x39\xab\x72\x63\x4b\x4d\xc4\xd4\xa3\x20\xf7\x8d\xa5\x1e\x3e\x73\xe5\xf0\x32\x20\x8b\xaf\xf8\xdf\x03\x47\xf3\xfd\x34\x08\xa6\x33\x01\xee\x80\xe9\x06\x36\xe0\xc3\xef\x90\xe8\xe7\xc6\x5f\x95\x1a\x57\x47\xf4\x35\xee\xa1\x18\x9a\x3d\xb2\x18\x3b\x7d\xcb\xff\xc9\xbf\x4d\x9b\xcf\x38\xad\x93\xd9\x5e\x7e\x10\xf5\xde\xc8\xce\xcf\x6c\x1d\x3c\xdd\xde\x7f\x8d\x30\xf4\xf8\xe6\xc7\x92\x32\x23\xf9\x5f\x66\xd3\x47\x14\x3c\xa3\x37\xed\xb1\x42\x1d\x41\x92\x24\xdc\x50\x71\x1e\x98\x65\x83\x23\x13\xa2\x2a\x2f\x35\x4a\x87\x36\x88\x89\x5d\x86\x22\x56\xd0\x4a\xa5\x3d\x6b\x6e\xc9\xd5\x51\x56\x01\xae\x23\xde\x4e\x96\xb4\x1e\x66\x43\x57\x55\x82\xc2\xab\x69\x41\xe1\x7d\xb9\xea\x66\xff\xcf\x0e\x32\x67\x83\x6d\xfd\xee\xf7\x92\x26\xc4\x89\xfe\x4f\x8e\x81\xde\xaa\xc6\xf2\x1e\x8a\x82\x3c\x92\xdf\xee\x19\x1b\x43\x59\x40\x78\xda\x33\xff\x68\x5b\x39\x3b\x92\x36\xee\xc7\x62\xae\x75\xb0\xff\x7f\x77\xdf\xb6\xdc\x36\x92\x6d\xf9\x3e\x5f\xc1\xa8\x97\xb6\xe6\xd0\x1e\x4b\x96\x54\x75\xfa\xa1\x23\x54\x76\xd7\xe9\x8a\x68\x57\x75\xb4\xdd\x5d\xd1\xf3\x06\x92\xa0\x88\x16\x08\xb0\x71\x11\xcd\x7a\x3a\xdf\x32\x9f\x36\x5f\x32\xb9\xf6\x25\x73\x27\x00\x92\xa0\x44\xb9\x3a\xa6\x22\x2a\x6c\x4b\x24\x90\x48\x64\xee\xdc\x97\xb5\xd7\x62\x8e\xfc\x90\x64\x04\x11\x01\xf3\xca\xd5\xed\x66\x93\x93\x33\x66\xea\xaf\x61\xe8\x5a\xfc\x76\x51\xdb\xba\xf6\x75\xa6\xa0\xca\xbd\xf2\xbd\x10\x41\x1e\xad\xcb\x38\xd6\x5f\x4b\x3f\x0f\x96\xb8\xda\x53\x2b\x65\x3e\x54\xdb\x96\x3e\x87\x23\xd0\x4c\xc9\xec\x04\xb1\x98\xf0\x48\x21\x1d\xc6\x93\xa3\x14\x85\x8a\xf5\xa4\x4b\x4a\x58\x18\x91\xe4\x4d\xa3\x29\x0d\xe1\x6e\x95\x12\xc3\x39\x87\x48\x03\xaf\x9f\xae\x4a\xed\xe6\x2a\x36\xa3\x69\x17\xfd\xec\xe0\xd4\x73\x83\x18\x7d\xa6\xee\x5c\x30\xea\xe8\xb5\xdb\x12\x04\x25\xf7\xd5\x5e\x02\x75\xf1\xf6\xe5\x09\x18\x93\x28\xd8\x97\x12\x4b\x89\x05\x79\x9e\xe0\x72\x23\x9e\x9e\x7c\x8f\x23\x77\xf2\xea\xd3\xfb\xbb\xff\xba\x18\x45\x5d\x75\x4a\x11\xe6\xe6\xe0\xb1\x7b\x36\xea\xb7\xa3\xcd\xb9\x11\x2e\x72\x5d\x52\x11\xac\xda\x11\x39\x41\xce\xa7\xbc\xb6\x3b\xcc\xf2\x64\xa1\x29\x0b\xa4\x85\x36\xa6\x41\xab\x7b\x7e\xc6\x84\x31\xd2\x4b\x2f\x72\xee\xd3\x81\x6a\xf6\x70\x22\xda\x97\xb3\xa5\xf0\xe7\x7b\x12\xba\x7b\xf1\x0f\x13\xf7\xdf\x71\xe1\xc3\xdb\x83\xc2\x87\xb7\x3d\xe1\xc3\xdb\x41\xe1\xc3\xdb\x21\xe1\xc3\xdb\x11\x05\xc0\x77\x23\x6b\x82\xbd\xd5\x48\x7d\x7c\xa3\xf4\x8f\x06\x51\x44\x6a\x7c\x9f\x72\x3c\xac\xd3\x6a\xde\x42\xd2\x63\xb3\x2a\x6b\xf7\x7f\xd5\x4a\xaa\xd5\xab\x0a\x29\xce\x88\xdc\x89\x4d\xe2\x69\x32\x99\x0c\xfc\x31\xc9\x5b\x36\x1f\x1e\x2d\x49\x15\xb2\x27\xc2\x90\x3a\xe2\x42\xb4\x40\x38\xff\xb0\x57\x69\x28\x66\x53\xda\xbb\x0f\x0e\xf7\xe7\x65\x05\xe7\x84\x50\xd1\x97\xac\xd5\x7d\xbe\xdb\xac\x34\x8d\x2a\xe5\x0d\x6a\x3d\x0f\xa4\x1b\x11\xc6\x6f\x99\x97\x25\xf8\x3f\x04\x1d\x47\x59\x56\xe2\x7f\xbb\x30\x1d\xbe\x5e\xe7\x2e\x64\x11\x67\x54\x4b\xa8\x39\x69\x43\x59\x76\x41\xbd\xf1\x00\xcc\xcd\x66\x65\x09\x95\xec\x89\x1b\x6a\x19\x6e\x81\x0d\x40\x32\x5c\x2b\xb4\xf7\xfb\x4e\x46\x71\x82\xfd\xa8\xa7\xe6\x9a\x9c\xbc\x7a\x14\x5e\x57\x38\x40\x8a\x33\x90\x27\xec\xc8\xe9\x09\x45\x16\x5c\x26\xac\x86\x94\x00\x16\x9d\xfb\x04\x01\x3f\x7a\x32\x79\x4a\xb2\x04\x41\x59\x03\x0e\x17\x9d\x3d\x53\x45\x57\xc8\xe7\x32\xd6\x94\x5b\x98\x1a\x97\xde\x94\x4e\x3f\x26\x1c\x19\x7c\x7d\xe1\xb1\xe0\x64\x57\xd0\x00\x0a\xfd\x08\x36\x7d\x26\x5a\x47\xcc\x31\xef\x57\xd5\x51\x2e\x6d\xbd\x74\x41\xaa\x1c\x41\x48\x7d\xea\x9e\xcb\x1d\x4c\xac\x18\x5b\x3c\x4f\x85\x8d\xe9\xa6\x08\x40\x7e\x2c\xf4\x5e\xa4\x73\x50\x1e\x50\xca\x4f\x1e\xa2\x36\x73\xb0\x1d\xee\x2f\xe3\xb4\x1c\x7d\xa4\xf6\x8b\x7d\x11\x2d\x6b\x9e\xc9\x75\x89\xaf\xed\x36\x74\xb6\xfb\xeb\x8b\x5e\x26\xa3\xe7\x58\x08\x62\x52\x37\x29\x49\x99\x29\x74\x86\x2e\x8e\x49\xf1\xb2\x4b\x9a\x0f\xe6\x7a\x26\xd6\x1b\xf7\x62\x4f\x51\x1f\xaf\xca\x84\x2f\xa5\x3b\xc3\x85\x73\x15\x55\xa0\x6d\x1b\x30\x3e\x4c\x65\x9c\x22\xdb\x80\xb4\x49\xd3\x8d\x76\x13\x41\x02\xbb\xa6\xde\xd8\xc1\x67\xec\xee\x3c\xf3\x9c\x42\x72\xe2\x1f\x13\x79\xf9\x72\x93\x16\xf1\x5d\x4e\x1f\xae\x73\x3e\x65\x92\x3c\x80\x41\x27\x68\x4c\x66\x65\xd9\x56\x34\x73\x55\xba\x54\x81\x40\x4d\xc2\xd6\x65\xdc\x54\x4f\x59\x4d\x72\xad\x88\x66\x8b\xcb\x93\x3a\xb2\x88\x80\x88\xac\x05\x69\x5f\x79\x40\x85\x69\x1f\xde\xa8\x2a\x22\x4a\x14\xee\xa2\xee\x55\xb1\xb4\x97\x37\x3f\xab\x94\x42\x65\x98\xb2\x54\x34\xa1\xa1\x19\xab\xdf\x7b\xa0\x62\x5f\x04\x42\x66\x50\x70\xb5\x10\xd4\x2f\x4a\xd9\x29\x13\xca\x71\x1b\xdb\x2a\xb9\x67\x2a\x0a\x20\x81\x59\x8c\xb2\xaa\x2f\x02\x9d\x53\x9c\x9a\x1e\x6a\x10\xd0\x59\x31\xcb\x2f\xf0\x21\x51\x0a\x60\xe5\xae\x90\xec\xcc\xdb\x02\x98\x19\xce\xdf\xa8\x56\x6e\x7f\x2a\x98\x1b\x88\xbd\x2b\x0b\x8f\x5b\x2f\x37\x9c\x32\x9f\xa5\xa0\xa8\x5a\x0a\x8a\x59\x0b\x3a\x3f\x13\x5a\x54\xad\x8d\x35\x57\xf7\x39\xb1\x72\x2f\x05\xa6\xea\xd3\x1b\x1d\x8c\x23\x1d\xb7\x71\x46\x2a\xb4\xf0\x4f\xa5\x5b\x40\x8e\xda\x98\x74\x81\x7d\xe8\xa3\x10\xc5\x30\x22\x5d\x4d\xc4\x5c\xc9\x09\x6d\x6d\x3c\xd7\x07\x22\xd8\x77\xa4\x91\xa7\xa0\x46\xa3\x0b\x40\xd7\x3e\xd6\x4e\x3e\x00\x83\x3c\xf4\x52\x3e\x00\x3b\xf1\xfb\x3e\x3b\xfb\xb1\xb4\xcd\x14\x1e\x26\xfe\x75\xf9\x76\xe1\xe6\xad\xdb\x2d\xfe\x64\x80\x03\x3e\x7c\x70\xbc\x59\x8d\xcd\xf6\x84\x11\x0f\x27\x9a\xe4\x0a\x33\xd0\x7c\x55\x82\x6b\xaf\xee\x5b\x81\x0c\x2a\xe5\x98\xdf\x25\x71\x96\xe9\x83\x69\xf2\xe3\xda\x52\x26\x88\xc6\x79\xb2\x21\x06\x2b\x12\x3a\x4f\x60\xf9\xf0\x70\x81\x24\x4a\x5b\x12\x9e\xd0\x5f\x77\x30\xa7\xeb\x0e\xd3\xfd\x53\x33\x48\x2a\x6e\xd0\x4e\x4b\x4a\xe2\x7a\xbc\x93\x3e\x67\xef\x65\xa2\xdd\x1a\x24\x40\xe1\xf3\x11\x56\x93\x75\x4c\x7c\xff\x2f\x4f\x09\x4e\x13\x6d\x4f\xe2\x31\x11\x77\x97\x7a\xb7\x0a\xcc\x4c\xb6\xce\xac\xf8\xc0\x9c\xb7\x8f\x01\xc2\xfa\x84\x16\x39\x3a\xc9\xec\x30\x57\xd9\x9f\xdc\xa1\x83\xe4\x06\xf2\x52\x07\x16\x8c\x33\xcd\x59\xbd\x4e\x46\x71\x2b\x04\xd4\x2a\xce\x5d\xb7\xcf\x73\xd5\x7b\x44\xfd\x73\xa3\x1d\x67\x47\x96\x08\x57\xae\xe5\x3d\x53\xb0\xc6\x8c\x49\x96\x08\x86\xae\xe9\x4b\xbd\x41\xb2\x90\xf1\x21\x46\xe7\x54\x33\x06\x23\x44\x8f\x5d\x10\xec\xd6\x62\xb3\xdb\x3b\x19\x1d\x7f\xeb\xb4\x19\x59\x54\x04\xba\xca\x70\x93\xb4\x33\x0d\x77\xfe\xe7\x56\xdf\x56\x3b\x44\x7d\x7f\xa8\x68\x28\x84\xba\x3f\xf9\x63\xdd\x4d\x58\xa3\x23\x8a\x3f\x0a\x47\x40\x30\x2c\xa0\x8f\x14\xf2\xd0\x0c\x34\xbf\xb3\x19\x4e\xdb\x15\x57\x14\x3e\x7c\xa4\x44\x9d\xdb\x53\x75\xa4\xb4\xac\xd1\x14\xa0\x02\x15\xa2\xa0\xf9\x41\xf2\x3b\x77\xd4\x3d\xc1\xf2\x44\x9b\x8c\x6d\xc3\x3c\x6b\x42\x97\x7b\xfa\x65\x5e\xb5\x68\x7b\xc7\x37\x36\x4a\x74\xb4\x7f\xfb\x1d\xd2\xfa\x82\x24\xd6\xe9\x16\xa0\x2f\xdd\x15\x9f\x87\x03\x26\xc0\x2a\x1a\x40\x7f\xab\x20\xb2\x3b\x9f\x61\x57\xf3\x4e\xf4\xa8\x63\x45\xbd\xb2\x46\xb4\xbc\x0e\xaa\x99\xb5\x05\xec\xe9\xd3\xcd\x5c\x8d\x2b\x1c\xb5\x71\xbd\x5c\x05\xce\xb9\x11\x09\xea\xcf\x89\x7b\x26\xe7\xfa\xfd\xc9\x85\x13\x98\xc8\x3f\x27\x2d\x35\x64\x9c\x5f\xf3\xef\xdd\xb8\x12\x94\x5b\x55\xbb\x09\x73\xcc\xa8\xd6\x54\xa2\x89\x25\x64\xe7\x2d\xad\x4d\x56\xbd\x48\x6b\xb2\x6f\x37\xfe\x2f\x2c\x99\xc9\xcf\xce\x6d\xfd\xb9\x48\x9f\xa6\x01\x68\xd6\x5d\x07\x08\x4a\xcf\xb3\x17\x5e\xa4\x84\xdc\x35\x33\x28\x30\xbf\x2d\x1c\x70\xa4\x60\x05\x59\xb3\x74\x0b\x63\x67\x36\x04\xe5\xb7\x97\x99\x40\x56\xf1\x22\x99\xee\xd7\xf2\x8f\x08\x38\x37\x1b\xe8\xb6\x1f\xa3\x70\x43\xea\x58\x24\xb6\x34\xe5\x05\x2a\x64\xd8\xc6\x46\x30\x14\x4a\x89\x36\xd9\x2e\xba\xc9\xef\x53\x38\xdd\x75\x99\x09\xba\xe6\x7c\x5e\xf2\xac\x5d\x7b\x35\x3a\x2e\x78\x8d\x91\x98\xbb\x3b\x5c\x61\x12\x99\x2d\x9f\x41\xef\x18\x82\xe8\xac\x08\x7c\x2c\xfb\xd5\x4e\xa2\xd9\x84\x97\xd4\xeb\x29\x89\x66\x92\x4c\x0f\x90\x7a\x1a\x11\xc0\xbd\x56\x94\xa7\xad\x7d\x3d\x25\xad\xfd\xd9\x79\x11\x0f\xa8\x7e\x67\xf5\xd3\x4b\x4e\x2f\xc0\xde\xf3\x34\x1e\xb2\x01\x16\x80\xe1\x6d\x79\x02\xfd\x3f\x39\x73\x36\xa1\x90\x0e\x43\xb7\xa9\x50\x81\x6e\xd7\xe6\x00\x65\x0f\x59\xa9\x18\xe7\x90\x06\x54\x7d\x9f\xe8\x56\xb7\x7c\xfa\x05\x6c\x30\x22\x3c\x9d\x03\x2b\x1d\xf7\x38\x05\x10\xf8\x41\x4b\x11\x98\x73\xe9\x21\x91\xa3\xd8\x54\xe0\xa4\x51\x9e\x18\x0a\x48\x4d\x3c\xcd\x3f\xf5\x62\xe2\xb2\x66\x79\xb4\x1c\x46\xd2\xdf\xb9\x19\x58\xd3\x87\x40\x77\x60\x74\x09\xbb\x7d\x58\x15\x3e\xf7\x57\x6f\xa9\x6f\x8f\x2f\x24\x2b\xd6\x0d\x21\x38\x8a\x51\xcd\x36\xd6\x13\x1a\x81\x04\x54\x18\xe5\xef\xfd\x23\x34\x55\x78\x8b\xc9\xe4\x4f\xed\x7d\xd4\x75\x1d\x14\xd4\x3f\x8a\x93\x18\x05\x24\x46\xef\x7b\x90\xde\x08\x7e\x17\xa3\xf4\x64\x80\x26\x3f\xe2\xbb\xf1\xe8\x4a\x7e\x02\xb6\x4a\xb2\xc0\x5f\x15\xbc\x9b\x32\xdc\x04\xc4\x3a\x2d\xf8\x77\x6f\x3b\x2d\xdf\x55\x2a\xba\x52\x81\x2d\xbe\xdd\x50\xc6\x04\x8d\xca\xa4\xaa\x95\xee\x4a\xc9\x64\xf2\xe1\x10\xf1\xe0\xdb\xae\x55\x31\x77\x31\xa6\xa8\x43\xcb\x90\xd5\x96\x2f\x57\x01\x32\x8d\x18\x0d\x04\xc5\xf7\x55\xb6\x89\xcc\x73\x9e\x2d\x31\x21\x32\x2c\x02\xba\xd4\xa2\x3b\xee\xbe\xbf\xce\x16\xaf\xdd\x51\x7c\xa4\x72\x5a\xb7\xb3\x3a\xfd\x57\x0b\x7a\x68\xa6\x46\x88\x6b\xa4\x76\xfb\x34\xa5\xaa\x73\x71\xad\x54\x7a\x52\x06\xc7\xa9\xe6\x35\x00\x13\x76\xc2\x19\xe5\xf1\xad\xfc\x56\x0e\x0e\x8f\xf6\xd9\x9e\x05\x16\xe7\xea\x29\xed\x55\xab\xf1\xa2\x22\xc7\x46\x98\x1e\x7e\xec\xe4\xb2\x19\xee\x4b\xd9\xc2\x92\x93\x1d\xf3\xa4\xaa\x32\x15\x5a\x8f\xc1\xee\x74\x2f\x77\x0a\x1d\x5b\x24\x23\xd7\xc4\x71\x21\xb4\x90\x72\xef\x8c\x8e\x51\x0f\x3a\x9f\xb2\x98\x6d\xcc\xf5\x9c\xed\x64\xf4\x62\xf7\xef\x27\x71\x44\xf8\xd6\xce\x3e\xe7\x76\xc0\x36\xe4\xb6\xea\xb3\xc2\xe2\x33\x72\x22\xcf\x30\x8f\xff\x88\xec\x38\xe5\x68\x25\x66\x23\x53\x2e\x47\x48\x98\x9e\xee\xca\x0d\x59\xca\x28\xaf\x9d\x28\xb3\x7d\x53\x12\x23\x83\x24\xa2\x13\x4f\x8e\x90\x28\x86\x03\x67\x62\xdd\xb0\x46\x07\x25\x99\x9d\x37\x91\x4a\xd2\x9d\x34\xf5\x44\xbb\xab\xa0\x8b\xd8\x6f\x21\x65\x5b\x06\xd4\x2e\xbd\xa3\x22\x68\x7d\x3d\x66\x03\xbd\x12\xa1\x13\x72\x4c\x54\xe1\x9e\x75\xe3\xdc\xf6\xdd\xf9\xa1\x6c\x7f\x2b\x04\xd3\x3f\x42\x81\x0d\xf9\x0d\xea\xde\x2a\x1e\x48\x0b\x22\x47\x82\x87\x7a\xc3\x0f\xc4\x0d\x57\xd7\x07\x7b\x1a\xc6\x95\xd8\x1b\x99\x00\xf7\xae\x71\x77\xb7\xcc\x9a\x6d\xca\x0e\x44\x45\x78\x7a\x8e\x6d\x7a\xe4\x04\xb4\x5c\x38\xc6\x57\xf6\xca\x65\xb2\x76\x5b\x09\x1d\x8a\x51\xbf\x96\x14\x0d\xdd\xaa\xe6\xaa\x98\x77\x33\xd7\xcc\xa0\x4e\x2b\x39\x34\xbf\xb3\x87\x62\xc9\xeb\x39\xf3\xea\xb1\xcd\xc6\xe1\xc5\x5d\xc3\x81\xdd\xbb\xf0\xd3\xda\xc6\xb5\x16\x67\x9c\xea\xcc\x82\x1c\xf2\x1d\xd3\xce\x53\x93\x03\x12\x66\x70\x86\x91\x40\x92\xc3\x22\x28\x2a\xd4\x2e\x78\x2f\x9d\x85\x76\xc1\x71\x4d\x72\x2a\x9c\xfa\x2c\x82\x83\xae\x1c\x25\x02\xeb\x7d\x98\x76\xef\xde\x17\x39\x89\x8f\x48\x65\x52\xd2\xf4\xa7\x54\x7c\xe2\xe9\x43\x88\x53\xf7\xf9\xd5\xf6\x45\x31\xa1\x34\x4e\x0a\x01\x21\x69\x44\x85\x28\xce\xba\xfa\xc3\x9b\x26\x81\x47\x0d\xab\xab\xf9\xfa\x79\xc9\x44\x39\xc4\x06\xd3\x9d\x06\x32\x9d\xf0\x00\x88\xa8\x7c\x6c\xa4\xb0\x29\xab\x66\x14\xe8\xe0\xfd\xf9\xd4\x94\x9e\x87\x7b\xe1\x20\x7d\x04\x90\xd4\x60\xff\x64\xa9\x81\x78\x08\x48\x33\x3c\x74\xed\x97\x25\x1f\x15\x5c\xd9\xea\xee\xc8\xfa\xa4\xb0\x5d\xaa\xf1\x70\xc7\xf3\x74\xa4\xfb\xce\xa4\xc7\xa8\x13\x17\x89\xc7\x5d\x39\x1b\x41\x75\x23\x39\x11\x7d\xc6\xd5\x17\x23\x95\x6f\xd7\x68\x5a\xb8\xc8\x5f\x7a\x4f\x28\xa7\x48\x9c\xf8\x89\x0b\xb0\x82\xc6\x6d\x47\xfc\x75\x86\xa6\x8b\x7c\xd1\x3d\xfb\x81\xcd\xee\x4e\x03\xaf\xfc\xee\x28\x25\x3c\xd0\x81\x30\xad\x25\x63\xec\xfc\xad\xf4\x97\x23\xed\xd3\x3f\x30\xd5\x6a\xf2\xe0\x40\x78\x49\x7b\x7b\xf3\x05\xc0\x07\x6b\x42\x78\x6b\xbb\x1f\x9b\x4b\xe4\x74\xa5\x87\x32\x54\x69\xf2\x9d\xcf\xaa\x72\x95\x62\x01\x8a\x1c\x72\x18\x80\x45\x41\x8f\x2f\xdb\x86\x23\x09\xfa\x1f\xcc\xb0\x5e\x4f\x3e\x66\x28\x59\xba\xbf\x7c\x12\x5c\xdc\x1d\x20\x14\xaf\x27\x3f\x2f\x97\x93\xcf\xfc\xbe\x5e\x23\x96\xe7\xbf\x77\xa1\x4c\x7f\x71\xe3\x4f\xb0\x01\x26\xef\x19\x83\xf5\x7a\xf2\xc5\xfc\xff\xf6\xf2\xb5\x1b\x63\xf7\x4b\x77\x81\x6f\x94\x5d\xd6\x51\xdf\xfa\x3b\x18\x38\x75\xec\xfc\xa9\xb7\x37\xf8\xf3\xf6\xf5\xe5\x3b\xf7\xe7\xe5\xf5\x6b\x77\xe6\xbd\x9e\x5c\xdd\x0c\x7d\xfb\x13\x8e\xad\xf7\x89\x88\x53\xd1\xb7\xdf\xe1\x5b\xef\xae\x5f\x5f\xe3\xcf\xeb\xeb\xd7\x37\xf8\xf3\xe6\x7a\xf0\xde\x59\xba\xc5\x68\xf1\x8a\xe9\xbb\xdd\xef\x7c\x8b\x3f\xbf\x1d\xfc\xee\x07\xea\xd0\xa2\xb2\xea\xc9\xdf\xfd\x21\xc9\xdd\xc2\xec\x5d\xe1\xe6\x2d\xbe\x49\xb3\xa4\xd3\x36\xee\x7d\xff\x7e\xf2\x4d\xf7\x8d\x7d\x43\x46\x1b\x07\xc0\xc6\xff\xa6\x11\x83\xca\xab\x54\xd0\x75\x5b\x2a\x42\xd7\xd9\xbd\x3b\x19\x39\x04\x9a\x33\x88\x05\xfb\xe5\xcd\xe4\x9b\xde\x5b\xd5\x2b\x7b\xc3\x21\x30\x47\x1b\x8b\x20\x85\x1d\x2a\x4f\xca\xa4\x66\xf7\x48\x57\x1e\x0d\x0d\xea\x6b\xe7\xe9\xad\xea\xe0\x79\x32\x9a\x48\xfd\xbe\x2d\x19\xd2\xdf\x41\xae\x70\x56\x25\x80\x83\x01\x39\x81\xa6\x8e\x42\x3f\x52\x95\x3b\x52\xdf\x70\x0e\x98\xd8\xba\xf9\x0a\x84\x91\xd4\x26\x5c\xcd\xa8\xca\x46\x1f\xfc\xb3\x73\x61\x40\x3e\x93\x42\x36\xc7\xd9\x84\xf5\xec\xd0\xce\xfa\x26\x5a\xa3\xdf\x70\x69\x99\x20\x42\xcc\x63\x0a\x7c\xcc\x0c\x0b\x91\xd8\x64\x4b\x17\x8d\x52\xff\x47\xe7\x13\xce\x88\xa5\xb4\xdd\x91\x33\x67\xc1\x2f\x1a\x62\xf8\x98\xb7\xc0\xfb\xf0\x31\xdf\x44\xcb\x9d\x06\x42\x4d\x8d\x9d\x3b\xd5\xf8\x94\x6d\xc4\x47\x63\x7f\xdb\x0c\xb8\x6f\xb0\xb3\x34\xe8\xe0\xc6\x7d\x63\x36\xc5\xf0\xa3\xd2\xe5\x4b\x92\x86\x94\x56\xee\x9d\x10\xae\x50\xf5\xcc\x5d\xc2\xac\xec\xe8\x12\xbc\xd6\x54\x12\x92\xbd\x4c\x62\xa1\x48\xec\xa2\xf3\x0e\x92\x56\x3a\x52\xb7\x5b\x80\xa7\x0c\x57\x9d\x62\x55\x3b\xfb\xe6\x83\x82\x75\xb2\x39\xf8\x06\x7b\x3b\x2e\x1a\x97\xc0\x35\x44\x2e\x09\x56\xff\xcd\xe4\x2f\x72\x03\x3a\xe0\xe8\xf4\x41\xbe\x78\x5e\xed\xbc\xe2\x1c\x16\x90\x1b\x78\xd3\xae\x69\x76\x71\x88\xa3\xa5\xe1\x91\xe7\x0f\x1e\x64\x9e\xb7\x8c\xfe\x2d\x2b\xef\x30\x4b\xee\x40\x00\x2e\xba\x25\xf9\x9c\xd5\xb7\x10\xa6\x88\x46\x16\xbc\x5d\x1a\x5b\x7d\x24\x97\xc1\x26\x9d\xf3\x05\xd2\xee\x5b\x91\x7c\xc6\x66\xf2\x2a\x76\xa5\x85\xc7\x50\x25\x04\x02\x80\x6d\x4b\x92\x8d\xe5\xe4\xfe\x60\x7f\x66\x38\x4b\x9e\x70\x33\xc2\x81\x23\x51\xed\xb1\x49\x71\xb9\xda\x1a\x0b\xc2\x32\xf9\x8f\x4b\x90\xfc\x66\xf2\x21\xa0\x9a\x96\x26\x9b\x77\x09\x0c\xc5\x17\xfe\x83\x0a\x15\x85\xe7\x28\xf2\xf7\xf2\xcd\x14\x2c\x61\xc0\xca\x24\x39\x08\x0f\xa2\xd6\x50\x09\x40\xfc\xfb\xe7\xcf\x4d\x2e\x95\x1e\x73\x0a\xf1\x29\x24\x9a\xc2\x08\xd8\xb5\xc0\x49\x4e\x1a\x55\x37\xf4\xcf\x77\xea\x60\x00\x55\xef\xc6\xc5\x79\x48\xde\xe4\x5b\x45\x1e\x99\xa7\x00\x52\xf8\x46\x07\x4f\xcb\xe7\xf2\x3b\xbe\xa3\xf7\x15\x8c\x93\xc1\x4f\x56\x79\x98\xa7\xbf\x0c\x4f\x19\x54\x1b\x77\x34\x22\x09\xd5\xbf\xe3\x06\x32\x91\x7a\x20\xdc\xc9\x84\x24\x7f\xab\x66\x35\x9d\x5c\xf9\xbf\xbf\x46\x40\x30\x75\x83\x77\x3f\xe0\xbf\x52\x6d\xb8\xa4\x1a\x0c\xa7\x64\x29\x9b\x4a\xf2\xc2\x9e\xdb\x0a\x9d\xa7\x75\x1d\xd2\x8f\xc4\x18\x21\xcb\x3c\xc0\xba\xca\x04\x92\x61\xee\x10\x6a\xb8\x46\xb4\xa5\xcb\xb9\x55\xa3\x0f\x4a\x9d\x93\x40\x79\x7b\x61\x30\x9d\x27\x1c\x1b\xee\xee\x47\xdc\x21\xeb\xf7\x9c\xb2\x3c\x89\x11\xa2\xdd\xf0\x9a\x03\x51\x86\x1b\x7f\xd1\x84\x16\x1c\xb7\xeb\x1f\x33\xf1\x37\xf8\x0a\x86\xd6\x55\xee\x19\x11\x47\xd3\x57\x23\xf1\x8e\x95\x00\xee\x44\x78\x16\xfc\x12\x6b\x1c\x83\xb3\xd2\xc5\x13\x25\x4e\xb6\xe5\x70\x7f\xb2\x1d\x9b\x04\x93\xe1\x54\xf4\x5f\xa7\x3a\x31\x81\x09\x93\x7c\xee\x86\x27\x92\x54\xd4\x92\x52\xaf\xca\x4d\x10\xa2\x5c\x4b\xcb\x70\x48\xb7\x97\xa5\xc0\x64\x28\xcb\xb3\xa6\xbc\x8b\x96\x0f\xc2\x1d\xde\x4c\xfe\xcb\x59\xc0\x8a\x29\x35\xe8\xa9\x78\x4f\x07\x3a\xdf\x3a\xa5\x33\x5d\x5a\x32\x18\x4d\x0b\x23\xc9\x8a\x97\x21\x02\x17\xa9\x69\x4e\x6e\x51\xea\xc4\xbe\x6c\x42\x1a\x67\x8f\xc9\x8c\xe8\x82\xf9\xd9\xbb\x29\x85\xa3\x41\x3f\x7b\xc2\xbf\x8f\xa0\xf9\x6d\xb1\x71\x0e\x49\x36\x27\xa7\x5a\xb4\xe5\x5d\x34\x45\xce\xb6\x7f\xf1\xcc\x90\xf2\x4f\xf7\xe8\x45\xba\xd3\x86\x16\xb3\x90\x7d\x64\xbd\x67\x25\xa9\x62\x08\xc2\x4d\xab\x65\xa0\x51\x35\x1c\xfe\x94\x5d\x7e\xb5\x0e\xbe\x2e\xc9\x5e\x80\x5a\x63\x79\xf4\x57\x6b\x37\xa0\x0c\xd9\xb7\x35\x3d\x13\x6b\x30\x97\xf3\x79\xeb\xe2\x4c\xd0\x99\x1b\x52\x1c\x5f\x3c\xbc\x38\x25\xd4\x66\x73\xcb\x1e\xe4\xa8\xea\xdc\xfb\x13\xb0\xfe\x97\x23\x68\x42\xaa\x84\x50\xe2\x10\x13\x14\x71\x60\xa2\x90\x59\x86\x4e\x1f\xf7\xe2\x18\x54\x71\x8f\xe6\x29\xfa\xd1\xcd\x93\xa1\xfc\x42\x60\x3c\x8a\x20\x84\xea\xe9\xc9\xc5\xb8\xbe\xf0\x7a\xaf\x7e\xc6\xa2\x4a\xb6\x21\xf0\x7d\xad\x58\x75\xdf\xec\x52\x44\x6a\x57\x11\x42\x98\xbd\xf4\x5a\xbb\x42\x9d\x63\xcb\xfb\xd2\x3b\x0b\xe5\x71\x77\xbf\x2b\xaa\x7c\xc8\xfb\x97\x95\xca\xcc\xdd\x23\x23\xe4\x3b\xf4\x36\x43\x1b\x82\xe0\x37\xb8\x7b\x4e\x09\xde\x3a\xe2\xfb\xe6\xb1\xf0\x74\xa4\x5b\xa1\xd9\x66\x1e\x4b\xca\x06\xb7\x87\x6b\x46\x43\x32\x5f\x06\xdc\x29\xb7\x0d\x59\x15\xa5\x3a\x1b\x94\x22\xb1\x8e\x86\x4e\x92\x37\x65\x00\xd4\xc3\x94\xb5\x05\xb6\xd9\x26\x23\x9a\x46\x62\xac\x5c\x4a\x13\x92\xf2\x57\xea\xef\x0f\x9a\x22\xd8\xdb\x75\xf2\x4f\xd8\x8b\x14\x06\xb6\x06\x23\x51\x96\xc7\x19\x44\x30\x24\x55\x18\xf9\x44\x5a\xe0\x54\x03\xfd\xc0\x7b\xb5\x60\x72\xb8\xcd\x5b\x0f\xcb\xe5\x07\xc9\xa8\xb7\x12\x65\x00\x45\xdb\x52\x90\x25\xcf\x2b\xd0\x79\x38\xc4\x6d\x91\xb9\x55\xd0\x5d\x11\xaf\x89\x04\xb9\x92\x1c\xa0\x82\xe4\xaa\x96\xc4\x50\x2b\x69\xe8\x23\xc3\x69\x24\x46\x36\x70\x75\x2b\xab\x27\xc3\x30\xdb\x41\xc9\xfb\xa1\x86\x17\xd6\x58\xa6\x55\x17\x0d\x47\xc8\x4e\xe1\x4a\x85\x77\xe7\x57\xe8\xc7\x04\xc8\xf0\x84\xf8\x13\x01\x04\xf1\x8e\x92\xaf\x99\x7e\xf8\x18\x0a\xce\x7c\x0f\xd3\x3b\xdf\xbd\x95\xf0\x87\x70\x05\x70\x01\x9d\x5e\xe4\xdd\xc2\x05\x90\x87\x25\x21\x0b\x14\xa1\x3b\xd3\x86\x2a\x5d\xba\xc6\x91\xcc\xa5\x6b\x04\x7e\x3b\x11\x2d\x8f\x20\x7d\xa7\x50\x19\x1c\xd9\xe0\xb3\xdd\x44\xab\x5c\x8d\xcd\x30\x86\xdd\xeb\xed\x05\xab\x9f\x2c\xe0\x75\xa3\x78\xe3\xb6\xf4\x2e\x45\x08\x88\x5b\x12\xaf\x3d\x4a\x50\xaa\x7b\x2a\x97\xb7\x31\xcb\x9e\x38\x95\x6f\x86\xd2\x5d\xb2\x1b\x77\xf8\x14\x35\xf1\x5c\xfe\x20\xf2\x46\x70\xec\x1f\x46\x41\xbc\xe2\xe3\x87\x15\xa2\xfe\xf0\x8f\x3f\x7e\x72\x67\x0c\xff\xfd\x6c\xc0\xaf\xc0\x46\x25\xdd\x68\x07\x4f\x95\x03\x94\xd4\x63\xf2\xc1\xfc\xae\x29\x7b\x8f\xf6\x88\x1c\xa4\x46\xab\xb2\xca\x7e\x2d\x89\x1d\xd4\x9b\x5f\x72\x2b\xe0\xa0\x77\x3b\x9f\xb8\x63\x10\xfb\x7a\xc5\xd4\xe6\xd2\xf1\x44\x02\x52\xb5\x7e\x21\xe8\xdc\x18\x55\xca\xa2\x6f\xeb\x4e\xc9\x31\x4b\x2a\xd6\xbd\xc0\x1e\x33\xb1\xe1\xc6\x90\x6a\x28\x70\xcc\x92\xdf\xbe\x89\x4b\xd5\x94\xbd\xe0\x26\x0e\x18\x57\x32\x84\x84\xfd\xca\x7a\xf4\x35\x02\x96\x0a\x38\x34\xb1\x03\x34\x06\x86\x9e\x89\x3f\x7e\xdf\x51\x2b\x18\x6e\xa6\xa2\xef\x01\x77\xbe\x5e\x97\xb3\x8c\x32\x64\x99\x1c\x56\x49\xb0\xa9\x57\x6f\xad\x9c\x93\x7a\xf7\x54\xde\x0d\x99\x97\xab\x1e\x10\x5b\x87\xcf\x83\x23\xc1\x70\x29\x8d\x94\x72\xd2\x36\x7e\xde\xfa\x77\xa2\x13\xf6\x47\xc1\x7c\xe1\x2e\x22\x3c\xeb\xec\x30\xc0\xc2\x42\xe6\x47\xe0\x25\xa2\xbc\xda\x16\x40\xb8\x65\x50\xfe\xa8\x73\x77\xb0\xd6\xde\xf7\xcc\xb3\x07\xf1\xc8\x7d\xbe\x9d\x2f\x85\xe4\x86\xdb\x66\x62\x52\x56\x5a\x74\xd6\x46\x37\xc0\x92\x4a\x24\xdc\x7f\xe8\x76\xd1\xd0\x13\xf1\x95\x18\x99\x20\xd7\x0b\x9e\x0e\xba\x75\x37\x98\x80\x82\x88\xfc\xe1\xe0\x67\x0d\x37\x4c\x6b\x6b\xf8\x06\xb5\xad\xa5\xd2\x44\x69\x47\xb6\xf0\x31\xcc\xca\xa6\x29\xd7\x23\xb8\x90\x3a\x6f\xe1\xf2\xad\xed\xd2\xf3\x63\x7d\x25\x1d\x64\x08\x8f\x53\xa6\x81\xf6\x73\xc1\x4f\x20\xa8\x23\xa4\x35\xdd\x34\x8a\xf5\x0b\x2a\xef\x17\x4f\x82\xbf\xad\x92\x76\x0d\xb7\xe5\x7e\x37\xaa\x9d\xfb\xf3\xf9\x30\xac\x07\x3a\x5a\xc7\xa0\x4f\xd9\x0f\xde\x53\x5a\x06\x48\x60\xc9\x44\x77\xee\x22\x68\xc0\x2a\x51\x42\xa4\x4e\xd0\xec\x9e\xf5\x25\x5a\x77\x72\x89\x4e\x2b\x3a\x77\xf1\xdb\xd8\x72\x98\x1a\xb5\x69\x5d\xe2\x09\xf7\xe9\x45\xc3\xe1\x1a\x7d\xfb\xc0\xb2\xf8\xbf\xff\xfd\x7f\xb8\x72\xf3\xc8\x1c\xa7\x20\xda\x55\x15\x86\x55\x95\xb2\x10\x6c\xcd\x5a\x11\xed\x42\xd2\x1f\x6b\xd6\xe6\x1c\x75\x46\xcb\x0d\x84\x4c\x7c\x99\x27\x6b\xd6\x85\x5f\xe6\xd4\x09\x33\x15\x46\x1f\xa4\x85\x17\xd9\x5a\xb0\x6b\xb4\xb5\xe6\x65\x5e\x56\x4f\xbc\x0f\xfa\x19\x58\xc8\x02\xfd\x8c\x41\xa9\xe7\x3e\x60\xfb\x4e\xba\xa8\x38\x18\x9d\xca\xb7\xd0\xf3\x71\xc3\xac\x7b\x0c\xb8\x5b\xa9\xcf\xbb\x32\xa1\x4d\x37\xa8\x88\x2a\x88\x21\x9b\x5f\xb5\x6b\x69\xe3\x11\x96\x60\xc5\x7e\xed\x58\x0c\x01\xc9\x35\x4e\x96\x02\xb9\x8b\x7b\x3b\xd3\x4b\x2c\x9b\x63\xc6\xdf\xad\xd7\x0b\xb3\x3b\xce\x33\x00\x33\x51\x7f\x10\x8c\x0a\xe2\x69\xa4\x99\xf1\x82\x76\x1c\x70\x30\x68\x7e\xcd\x02\x1e\x23\x6e\x96\xe4\x8d\xf4\xf4\x99\x04\xb6\x4e\x43\xba\xeb\x36\x37\x1d\xb7\x59\x5d\x06\x73\x1f\xea\xd3\xda\x0c\x68\x34\xab\x20\x42\x6b\x57\x70\xc3\x97\xaf\x05\x7b\xaf\x9b\x83\xfa\xe4\x18\x12\x29\xbd\x32\x92\xff\x62\x9d\xd7\xac\x5e\x67\xc4\x54\x32\x27\x50\x80\xa0\x31\x13\xd3\x51\x30\xce\x9a\x01\x9d\xf5\xcb\x2a\xdb\x7c\x6d\x63\xc6\x7e\xd9\x19\x48\x9f\x8e\x61\x65\x90\x79\x9f\x4e\x1e\x5d\xfc\xf0\x1a\x87\x26\x96\xe4\x86\x1b\x85\x15\xea\x5f\x56\x5a\x0d\x33\x9c\x6f\x49\xe3\x89\xf8\xd6\x74\xe8\xb2\x4e\x40\x12\x75\x39\x8a\xdd\xfb\x78\x54\x4e\xd3\xca\xd0\x09\x42\x4e\x3e\xb1\xca\x14\xd4\xda\x65\x79\x82\x9e\x5d\x2c\xca\x2d\x55\xef\x65\x0f\x71\xf9\x67\x12\xde\x09\x28\xd5\x0e\xb6\xad\x83\x11\x55\x5f\x80\xd2\x7c\x95\x54\xd4\xc7\xb1\x1c\xfd\x16\xac\x18\x47\xd4\x1a\xcf\x49\x9a\x31\xb0\x3f\xc8\xce\xcd\xd1\x8f\x33\x28\x92\xf2\xf6\x3c\xfc\x89\x37\x96\x73\x6c\x1f\x81\xe2\xa7\x73\x00\x5b\xfa\x44\x69\x63\x19\x72\x4f\x53\x53\x89\xf6\x20\xf1\xce\x99\x73\xc3\x1f\x4b\x9a\xf4\x13\xb8\x19\xc8\x6e\x17\xc1\xdf\x83\xdb\xdd\xed\x2d\x8e\x69\x60\xfa\x19\xa1\x0e\x9e\xf4\xb4\x0e\xd8\x3d\xdb\x30\xe6\xc4\x3f\x16\x7f\x7c\xfd\x8d\xf2\xf2\x32\x15\x5f\x63\x93\x61\x51\x0c\xab\xa8\x5e\xfe\xff\xa5\xa2\x6a\x9a\xd5\x55\x46\xf5\x2c\xda\xa9\x2c\x4b\x24\x88\x26\x25\x91\xe7\xe4\x6f\xd2\x2e\x40\x27\x12\x94\x5f\xa2\x98\xf4\x98\xb4\xea\x80\x2e\x44\x47\x5a\xf5\xce\xe7\xdf\x50\x51\xea\x89\xda\x58\xc0\x77\xd6\x9c\x22\x44\x34\x03\x59\x3c\x58\x35\xfd\xa9\x15\x62\x71\x05\xd8\x3d\x14\xec\x1e\x52\xe3\xd8\x41\x16\xf6\xa3\x6b\x10\x0d\x80\xcf\x58\x7e\x07\x6d\xfc\xe5\x4d\x80\xca\x5d\x3c\xa9\xc5\x69\xbc\x95\xd7\x9a\xc7\xe7\x74\xbd\x01\x63\xcf\x90\xdd\x7f\x92\x44\x16\x26\xa8\x63\xc8\xb9\x36\x46\x64\x5e\x31\x5d\xee\x00\x2d\x44\x32\xb1\xb3\xe0\xe3\x11\xcf\xdd\xff\x2c\xeb\x3d\xa0\x67\xf2\xdd\x90\x9e\x09\xf2\x51\x07\xd6\xd4\x3e\x7a\xc8\x81\xc3\xe1\x30\x73\x26\xdf\xe4\x70\x0b\xba\xcf\x5c\x43\x0e\xb8\x4e\x49\xb4\xc1\xa3\xfb\x99\xc6\xba\x4a\xf7\xa8\xae\x2a\xa9\x92\xfa\xfe\xc8\x27\x45\x5d\x1f\x07\x77\x8e\x6d\x90\xfa\x9d\x61\xde\x88\x49\x8d\xd6\x44\x5f\x69\x5f\x37\xa2\x6f\x6f\x4f\xf0\xba\xa9\x0f\x42\x08\x6b\x5f\x52\xfd\xe9\xaa\x78\x8a\x40\xf8\xa0\xca\xd3\x65\xdd\x0c\xd8\x89\x31\x24\xba\x9f\xb3\x85\x3b\x37\x7f\xc1\x2e\x18\xf4\x07\x9f\xab\xaf\x7c\x79\x75\x6a\x3a\x7b\x9b\x48\x6f\xf0\xf3\xd9\x73\x0f\x37\x1f\x94\xc5\x3f\x25\x86\x48\xbc\x19\xa0\x9b\x6b\xab\x0c\x07\x4f\x94\xb5\x2c\x0b\xcf\xfa\xd5\x4f\x2e\xd3\x8f\xc5\x31\x89\xfb\x68\x38\x56\x8b\xa3\x14\x66\xd8\x0e\x40\x6a\xfd\xb9\xf3\x5f\xf2\x7d\x8c\xbb\x74\x87\x31\x1a\x35\xde\x88\xd0\xd1\xd4\x33\x20\xd7\x6e\x15\xf5\xf5\x5e\xd4\x88\xc4\xe7\x4e\xa7\xb1\x36\x39\xcd\x56\x74\xae\x45\xc2\x2a\x32\xb7\x69\xe1\xe5\xa0\x29\x30\xe5\xbc\x6c\x27\xeb\x4f\x4c\x97\x82\x20\x72\x77\x77\x8f\xeb\xc6\xdb\x66\xac\x0a\x09\xb4\x44\xd9\x70\x5f\xa4\x24\xb7\x2c\x31\x2d\xc1\x77\x7a\x92\x70\x87\x59\xc2\xce\xed\x62\x5e\x8f\xdc\x7d\xce\x67\xfa\xd4\x94\x9b\x51\x72\x66\x9f\x4f\x3c\xa2\x5f\x9c\x47\xf3\xd0\xf6\x9a\x55\x59\xba\x24\x6c\x29\x01\x7c\x90\x85\x84\x07\xb4\x64\x3f\x91\x4c\x19\x2a\x24\x24\xca\xd4\x36\xbe\xd3\xe7\xcd\xe4\xa7\x92\x3f\xb2\xa1\x8b\x72\x25\x30\xa6\x08\x99\x9a\x0a\x88\x30\x61\x5e\xff\xc7\xa5\xf0\xcb\x32\x34\x0f\x14\x46\xe2\x68\x06\x7c\xa9\x27\x98\xe5\x55\xc5\x6c\x35\x94\x58\xd7\x04\xeb\xc8\x15\xa2\xbd\x40\x26\x29\xac\xd7\x14\x16\xd8\xc8\xcb\xdd\xb8\x9d\x5a\x0a\xba\xb6\xd8\xf9\x84\x58\x28\x5b\x71\x58\x39\xf4\x15\x25\x20\x48\xf6\x06\x8c\xcc\xb5\x2e\x38\xe7\xc1\xfe\x49\x1c\x1f\x8a\x5c\x8d\xbf\xfc\x66\x12\x1d\xd8\xcd\x60\xb3\x13\x77\xe6\x96\x1e\x9b\x6a\xea\x18\x41\xd6\xcd\x57\x32\x7c\x2d\xb7\x43\x3e\x98\x35\x03\x99\x10\xf7\xd6\xc6\x6c\x12\xd2\xe7\xa9\x47\x1d\x4d\x1f\xce\x27\x18\xcc\x1a\x41\x24\xe6\x0c\x95\x6e\x12\x3a\xcf\x39\x2f\xfc\x6b\x76\x7f\x8f\xad\xf1\xd4\x92\x6b\xcc\xbe\x79\x0a\x31\xad\x59\x84\xf7\x6e\xe0\x4d\xa7\x13\x2b\x88\xf5\x76\xe0\x0c\xa6\x7f\x8a\x9a\xa1\x36\x60\x7c\x04\x56\xf4\xbe\x25\x57\xa3\xa1\x54\x45\xfd\x66\xf2\xd1\xbd\x5d\xe4\x12\xa7\x81\xe6\x4c\xc2\x1e\xa6\xe0\x99\xc6\x3c\x46\xb4\x86\x01\xcd\xa9\x63\x7e\x6c\x7f\x65\x5f\x59\x05\x1c\xcf\x5c\x2d\x0c\x28\x28\xb1\xd4\xc9\x6e\x5c\xc9\xca\xb3\x5f\xff\x95\x08\x0e\x86\xfc\x96\xe7\x32\x37\x8c\xf5\x5b\x68\x69\xd0\x81\xf3\xb5\xdc\x16\x4f\x1d\xaa\xee\xb2\xc8\xab\x43\xc2\x6b\xd1\xaf\x85\x73\x99\x3d\x0b\xd5\xa9\x64\x72\x6d\x7a\x9c\x22\x48\x55\x5c\x27\xa1\x57\xd7\x23\xee\x0b\xd5\xaf\x11\x94\x54\x9d\x37\xe5\x16\xe2\xc7\x76\xf1\x7b\x67\xe2\x3d\x13\x36\x89\xab\x6b\x8b\x1e\x30\x1c\x36\x16\x50\x4e\x1f\x04\xee\xff\x6a\x41\x9c\x5b\xe6\xed\x5a\x9b\x8e\x91\x8e\x46\x59\x49\x8b\x71\xfa\xf4\x47\x05\x88\x47\x17\x3f\xd4\x1c\x1a\xef\xcf\xdd\xfa\x5e\x08\x1f\xb2\xc6\x0f\xd0\xdd\x7b\xe1\x0c\x7b\x21\x6d\x94\xa6\xd1\x9a\x21\x91\x35\xb0\x71\xc4\x37\x83\xda\xfc\x1f\x99\x3f\xbd\xf4\xba\x3e\x41\x86\xb0\x7c\x24\xf8\x74\xe8\xc7\xc4\x53\xcd\x4b\x68\xc4\x5d\x7b\x4f\x26\xd0\x5b\xe9\x7e\x0e\x87\x43\x54\x71\xdb\x87\x87\xb1\x9e\xe4\x60\xa6\x22\xa2\x33\xa0\x4f\x13\xaf\xa4\x48\x66\xb1\x04\x42\xda\x25\x2a\xcc\x22\xcc\x1b\xcf\xd6\x31\xee\xc2\xc3\x51\x6f\x2d\x68\x4b\x49\xce\x60\x2e\xc4\x55\x0d\x94\x0c\x53\xe1\x1c\x91\x5a\xf9\x44\x6a\x6c\x81\x1b\x21\xd5\x7e\xf5\xc0\xe2\x10\x84\x90\x08\xc0\x41\x1d\xc6\xb3\x1d\xd5\x17\x18\x0c\x45\x3f\x59\x4a\x35\x4b\x6e\xfd\xb4\x65\x33\x99\xa7\x59\x4e\xba\xe6\xfa\x04\x84\x00\xe9\x00\x06\x83\x42\x22\x3e\xa1\x82\xa8\x8c\x15\x19\xe3\xf6\xdf\x8d\x74\xf4\x5f\x4a\x99\x32\xec\xf3\x8f\xac\xf2\x89\xed\x1e\xed\x73\x3c\x57\x49\xb4\xfa\xf3\x87\x3a\x09\x8e\x35\x2d\x93\x48\x2c\x56\xe3\x21\x82\x67\x50\xfb\x29\xa9\xdf\xf2\x32\xa8\xcb\x65\x23\x06\x6f\xbf\xbe\x78\x34\xb5\x72\x1f\x3b\xaf\xe6\xa2\x27\xc5\x54\x43\x4b\x54\x2d\x80\x59\x5d\x92\x88\x80\x65\xe3\xc0\xc7\xae\x3c\xdb\x8f\xde\x5b\xaa\x42\xa4\x31\x73\x1f\x7a\xe0\xe5\x07\xd7\x8d\xf3\x8a\x02\x24\x4a\x3a\x2c\x10\x93\x57\x1f\xde\x4f\xae\xde\x12\x5d\x36\x48\x91\x04\x74\x7d\x75\xd3\x49\x6c\x92\x9d\x15\x00\x49\xd6\xec\x4b\x09\x25\x51\x15\xae\x5e\xb5\x45\x43\x0f\xbd\x44\xa6\x46\xae\xa4\x74\xd7\x70\x09\xfb\xd8\xac\x7f\xc3\x18\x4b\x3b\x98\x41\xd4\x40\x18\xcc\xa6\x1e\x25\x1b\x76\xc6\x0e\xee\x83\x59\xd0\xc3\x98\xef\x7d\x35\xe1\x41\x74\x9e\xee\xb6\x88\x44\x21\x09\xe5\x54\xe1\x27\xcf\x0a\x37\x9f\x6b\xc4\x1c\x40\xee\x35\x31\x95\x1a\x9f\x29\x1c\x28\xd0\xaf\xa7\x4a\x63\xa4\xcd\x4a\xd3\x23\xd8\x6b\x06\xce\xd0\xd6\x32\xfd\x79\x15\x7b\xa6\xe9\xa2\x07\x72\xe6\x41\x18\xff\x91\x74\x7f\x97\x1e\xd6\xd5\xc5\xea\xed\xe2\x6d\x04\x4e\xed\x70\x48\x88\x7f\x29\xd7\x04\x10\xef\x4b\xd6\x0c\x77\x71\xf1\x67\x66\xda\x2c\x78\xd3\x3b\x60\xc7\x39\xa3\x29\x42\xf8\x2a\x5b\x3c\xa3\x41\xe1\x09\x41\xfc\xd9\x25\xc9\x35\xc5\xfe\x13\xcd\x6c\xc8\xb0\xd3\x1f\x93\x57\xee\x45\x70\xe2\x5d\x6a\x41\x93\x57\x77\xc5\x3c\xc3\xad\xdd\xcf\x0e\x8a\xd5\x0c\xc1\xab\xc9\x51\x60\x22\xfe\x34\xc4\xe1\x8c\x48\xe4\x56\xfc\x8c\x12\xb1\xda\x97\xaf\x4c\x15\xe6\x48\xa6\xc5\x47\x0c\xda\x5a\x33\xd5\xa4\xed\xe4\xfb\x12\x25\x23\xf7\xd9\xda\xf7\xd3\xe7\xd9\xa3\x12\xd4\xfa\x85\xe6\xaf\x52\x67\xbf\x46\x2d\xf5\xfc\x2d\x98\xe7\xfe\x9a\xe8\x8e\xfe\x8d\xc5\xfa\x38\x2b\x29\x0d\xa3\x26\x12\x86\x77\x0b\x6f\xc0\x3f\x41\x7d\xf4\x11\x30\x4e\x22\x8d\x06\x7a\xdd\xfb\x20\x98\xa2\x56\x34\x0f\x78\x08\xcc\x72\x9e\x12\xab\x59\xa1\xb2\x0b\x48\x9c\xf0\x7e\xf0\x6e\x3b\x7c\x78\xb9\x33\x73\xd0\x53\xe6\x4d\xae\x4b\x93\xea\x1e\xfd\x77\x74\x5a\xf2\xf3\x84\xfe\x29\x64\xad\xcb\x7d\x88\xaa\xfd\x8d\x0b\x3c\x2a\x49\x7c\xcb\xdb\x1b\xd8\x60\x8a\x53\x25\x3b\x51\x94\x61\x96\xf3\x74\xd9\x44\xad\x5c\x83\x77\xd4\xa1\x4f\x44\xd4\x6c\x0c\x6e\x9d\xf1\xe3\x20\xec\xd5\x83\x41\x9a\x7f\x65\x75\x92\xf9\xd9\x28\xaf\xdb\x00\x11\xa3\x5f\x20\xa9\xe7\x44\x24\x0f\xb7\x6d\x84\x43\x82\x96\xc5\x28\xd3\xd1\xa6\x93\xbf\x94\xf9\xce\x39\x3f\x9b\xd5\xd9\x53\x80\x4f\x05\x92\x4f\x89\x3d\xc9\x45\x79\xf4\xc3\xfb\x76\xed\xfc\x06\x37\x39\xf3\xa9\x67\xe1\xa9\x59\x05\x79\x5d\x3e\xa4\xa7\x33\x8a\x1e\xcd\x8b\x1c\xcf\x82\xbc\x0f\x51\x69\x87\x4d\x26\x90\xc6\xa0\x0a\x2d\x60\xc0\xca\x4a\x2f\x8d\x61\x19\xe1\x77\xec\xdd\xc4\x0e\x3c\x89\xa5\x7d\x43\x27\x66\x28\x05\x0f\x7c\x2e\x50\x90\xc8\x3a\x52\x22\xb1\x22\x52\x13\x98\xbc\x32\xbf\x53\x9b\x55\xc8\x86\xa6\x7c\x5e\xd1\xa5\x1d\x89\x93\xa2\x17\x42\xa9\xa9\x63\xe6\x35\x0d\x26\x83\x21\xbc\xbb\xfb\x41\xe8\x68\x8a\x88\xbd\xdd\xa8\xde\x86\xb9\x23\x7b\xb1\xc8\xd2\xd0\x3d\x1b\x14\xcd\x8d\x1e\x1e\x79\x21\x9e\xba\x1e\x28\xad\x70\xab\xa6\x3f\x2a\xf5\x99\x7d\xe3\xc8\xc1\xb6\x59\x2b\x74\xa0\x14\x01\x31\x2b\x62\xa0\xf6\xbb\x1b\x22\x63\x31\x8c\xa4\x43\x5c\xa4\x1e\x84\x96\xf9\x1a\x7e\x2d\x54\xef\x07\x6e\x35\x82\x81\x91\xdf\x71\xe0\x63\x54\x72\x1a\x18\x8b\xa4\xb7\x4e\xf8\x75\xd2\x61\xe0\xf6\x56\xbc\xa6\xd0\xc9\x43\x0b\x31\xb0\x58\xf1\x27\xad\x70\x23\x19\x79\x74\x2a\xe6\x29\xdc\xb8\x8a\xab\xd0\xa0\xbe\xa6\xd4\x09\x8e\x03\xa1\x85\xa5\x30\x2b\xbc\x79\xe7\x62\xbf\x12\xfd\x05\xb2\x37\x5d\xbc\x83\xf2\x10\x90\x8d\x4e\x7a\xb7\xb8\xb0\x64\xae\xee\x5a\xf7\x74\xa2\xc2\xa8\x92\x18\x86\x25\x6a\x14\xf7\x5e\x2d\x2d\x71\x3d\x21\xa3\x58\xa5\x2a\xaf\x16\x78\x2e\xfd\x05\xd4\xd6\xeb\x24\x50\xc3\x41\x95\x36\x94\xe3\xa1\x9a\x4f\x9e\xdd\x17\x74\x68\x90\x04\x93\x5b\x26\xc0\x6d\x40\x8f\x7a\x9c\x60\xb6\x33\x2e\xed\x5a\xba\xd6\xed\xca\x67\x1c\xab\xde\x96\x97\x89\x86\x90\x15\x8a\x17\x4d\xcd\xdc\x51\x16\x9e\xbd\xee\x58\x01\x37\x50\x2a\x4b\x88\x77\x5a\xb4\xeb\x59\x4a\x2c\x6b\xe6\x4e\xc8\xbe\x26\x0b\x95\x62\xb6\x01\x2a\xfa\xff\x7f\x5c\xda\x1b\x32\x6e\x99\x3a\x83\x71\x15\x6c\xda\x8d\x34\xb1\xdb\x7d\xcb\x6e\x72\xfa\x05\xe1\x9d\x46\x82\x6c\x3d\x98\xdb\x7e\x60\xe0\x50\x39\xa7\x82\xa5\xf2\x7b\xc5\xdf\xd6\x55\xe0\x4e\xd9\x76\x9e\x76\xa9\x3e\xcd\x75\xfa\x43\xf1\x7b\x49\x8b\x82\x86\x8a\xfc\xd8\x3b\xb2\xf1\xa8\xaa\xd3\xab\x3b\x29\x25\x17\x49\xfc\xb8\x17\x4f\xb7\x97\x05\x54\x78\x16\xe9\xfe\x5b\xf4\x6d\x15\x94\xd3\x9e\x72\xde\xc6\x50\xef\x33\x6d\x3d\x5a\xd1\x69\x53\x6a\x80\xce\xc9\x45\xc8\x17\x51\xf9\x13\xe9\xac\x92\x78\x1c\x9d\x7d\x02\xa8\x9b\x28\x97\xcd\xcd\x88\x15\x2d\x48\x45\x30\xb4\x99\x07\x3d\x6e\x69\x62\x37\xc1\xf7\x59\xa7\x24\xd6\xa3\x21\x4e\xd8\x09\x5d\x8a\x3b\xd8\x2b\x91\x23\x81\xf3\x95\xa2\xa1\xc1\x7d\x37\xe8\x09\xb8\xb3\x1b\xb1\x55\x91\x2e\x35\x28\x92\x8e\x7b\x12\x2b\x71\x8f\xb6\x59\x1f\xb1\xc7\x3f\x9b\xf3\x6b\x80\x6b\x96\x8c\x5b\x11\x9f\x72\x6a\xad\x22\xbb\x96\xc4\x8b\xcd\xac\x25\xf2\xc6\x85\x04\xd7\xe8\x69\x85\x03\x52\x3f\xa3\xd0\x0e\xf3\xdd\x21\xfb\xf7\x9f\x64\xf8\xd0\xdf\xd1\x99\xb0\x8c\x44\x31\xa0\x91\xb9\x13\xd0\x70\xa0\x64\x08\x7a\xe5\x64\x6f\x12\x14\xe1\xca\x21\x41\x4b\xf6\x15\xac\xc0\x94\x2c\x17\xcf\x91\x4d\x4a\x20\x60\xb1\xe6\x36\x9e\xc0\x98\xb1\x1a\x78\xf6\x60\xf6\xb8\x07\xb9\x2e\x73\xa4\x84\x29\x80\xe0\xb7\xd4\xad\x5e\x1e\x7b\x61\x02\xcf\xe6\x43\xba\x77\xf4\xb2\x8f\x1d\x08\x5d\x94\x93\xd3\x8e\x8b\x66\x80\x09\x1f\x44\x95\xac\x33\x6d\xe8\x69\x05\xc1\xad\xe4\xa8\x56\xdc\x8e\xc4\x07\x46\x23\x3d\x53\x87\xcb\xef\xf1\x99\xa9\x04\xb3\x87\x4f\x4c\x4b\xee\x66\x52\x7a\x09\x2d\x2b\xa1\x16\x8a\x54\x4a\xb6\x6e\x2f\x69\x10\x08\x63\xc8\x9d\xaf\xbc\xab\x92\x66\x60\x4b\xc5\x2f\x84\xe7\x4e\xa2\xa9\x72\x19\x8b\x5d\x75\x96\xa2\xf2\x48\x48\xb7\xad\x96\xbd\x41\x03\xdc\xf8\x76\x54\x31\x48\x4b\x8d\xe4\x4c\xed\x55\xac\x94\x39\x3d\xba\xd6\x7a\x6c\x7c\xf1\x57\x77\x56\x54\x02\xa4\x18\x15\x62\x0c\x8a\x14\xb0\xd3\xfe\x34\x29\xdd\x7a\xe3\x66\xfd\x81\x6d\xe0\xaa\x04\xe4\x2b\xd1\x8e\x50\x91\x49\x24\x52\x5d\xeb\xbe\x5f\xdd\x3c\x47\x0f\x71\x4c\xa9\x4d\x8b\xaf\x87\x5a\x2e\xb8\x92\xda\x15\x6d\xc2\x9b\x25\x72\x2d\xd2\x8b\x5e\x52\x74\xa1\xbb\x47\x9a\x2c\xdf\x52\xdf\x72\x1d\x6a\x6b\x60\xd7\x12\x59\x74\xf0\x2d\x27\x35\x4c\x89\x3b\x5e\x37\xee\x10\xcf\x5d\x4c\x75\x9f\xfa\x36\x0a\xbb\xf6\xca\x36\x67\x23\x25\x49\x12\xf1\x6c\x87\x19\xaa\xcb\x8a\x33\x03\x79\xb6\xd4\x68\x08\xad\xcf\x4d\x6d\xce\xe2\xb1\xd9\x59\xe2\x4b\xa1\xef\x6f\x85\x4f\xb4\x48\x5b\xe8\x9d\x12\x07\x28\x1e\xc2\x5d\xaf\x86\x7b\x3f\xa7\x1a\x17\xc3\x75\x6a\x42\xab\xf1\x66\x00\x07\x36\x7f\x72\x0e\x60\x47\x2d\x7e\xb4\xe7\xd0\xf5\x61\x9b\x38\x54\x98\x21\xcb\x17\xea\xe5\x76\xd9\xfd\xa0\xe2\x01\x1a\x84\x98\xbd\xf7\x1e\x7c\x6f\xb0\xea\xd9\x7a\x56\x8f\xc2\xaf\x73\x82\x04\x4d\xa9\x9b\xaa\x7c\xe4\x38\x1d\xe7\xe8\xac\x5c\xec\xbc\xac\x22\xa3\x37\xf3\x1e\xbf\x15\x7c\x58\x0f\xec\xaf\xd3\x80\xea\x24\xff\xa1\xe7\x0b\x25\xeb\xb4\x73\xd2\x10\xe8\x49\x49\x1f\xf6\x34\x35\xf7\x45\xd8\x2f\xe3\x46\xdb\xb1\x3b\xfe\x13\x09\xe9\x8d\x4a\x71\x9f\x11\x2e\x21\xbb\xdd\x59\x65\xb7\xd0\xc8\x1b\xd7\x80\x90\xba\xcf\xb8\x28\x4a\x85\xcc\xab\x1b\xda\xd8\x50\xaf\x4c\x16\x92\xf4\x73\xb3\xe9\x62\x31\xf7\x17\x56\x50\x9d\xa2\xe6\xe0\x7e\x21\xad\xd9\xcb\x44\x4c\x2c\xd1\xcc\xd2\xfe\x8f\x09\xf1\x7f\x5b\x20\x46\xf6\x28\xd1\x43\x2f\xf2\xdc\x8b\xc7\xe0\xca\x3c\xe1\xdb\xd9\x09\xd8\x61\xda\x99\xc4\x29\x19\xce\x7b\xf7\x8e\x97\xc6\xbd\xec\x1a\xa7\x32\x1a\x98\xdd\x09\x95\x7a\xfe\x40\xf4\x15\x62\xdb\x2f\x16\x6e\xd9\xcd\x76\x5c\x16\x08\x5d\xee\xb8\xb9\x77\x26\xff\x48\x85\xd8\xc0\x4e\x41\x9e\x46\x2b\xfc\x6f\x9e\xe3\x5b\xe1\x10\xe3\x17\x61\x53\x65\x0f\xe9\xa8\xf6\xa2\x0f\x67\xcb\x69\x9d\x9e\x11\x3f\xce\xb6\x73\xa0\xb1\x68\x2f\xd2\xfc\x24\xc9\x1b\x9c\x34\xa8\x3c\xaa\xe7\xb9\x4a\x44\x37\x98\xb3\x57\x09\xb8\xd2\x49\x09\xa2\x09\x82\x76\x51\xa2\x4a\x44\xc2\x14\x10\x44\x6e\x2c\xc3\xef\x28\x7d\x4e\x8c\x05\x71\x75\x84\xb8\x0b\x97\x60\xb9\xa8\xa9\x12\xd8\x15\x40\xd8\x69\xd6\x3e\x92\x65\xa1\x4f\x71\xbd\xdf\x88\xd8\x0d\xb4\x04\x4e\xd5\xc6\xca\xe9\x67\x92\x44\x6e\xdd\x12\xa5\x21\x38\xea\x46\xad\xa5\xba\x75\x7f\x66\xa3\x78\xd1\x4f\xa1\x7e\xfa\x84\x69\x39\x5f\x69\xe5\x56\x44\x1a\x4e\xac\xe1\xdd\x91\xec\x71\xc0\x1d\x93\xa7\x74\x2f\x91\x9e\xa1\x32\x6a\x7c\x63\x73\xff\x90\xb0\xad\xea\x9a\xe7\x62\x11\x51\x00\xcf\x37\x16\xcd\x2e\x70\x64\xff\x4f\x60\xce\xd9\x2a\xdc\x28\x06\x19\xa8\x1a\x45\xeb\xba\xef\x0f\xe7\x0f\x8f\xca\x80\xfa\xfb\xcb\x91\x37\xe5\xb8\xa9\x2b\x84\xee\x01\xbb\x23\xf0\x28\x23\xba\x23\x6e\x41\x6c\xd6\x07\x3d\x9c\x4d\x9c\x52\x34\x92\xea\x46\x6a\x32\xfd\x58\xd0\xf8\xf2\xf1\xe3\xeb\x4f\xa6\x36\x3a\xb1\x85\x4c\x01\x30\x4f\x05\x01\x74\x33\xdc\xc7\x01\x60\xc3\x3e\xfd\x16\x2d\x95\xf9\x9b\xa3\xaf\x9c\xc9\xb4\x36\xc9\xdc\xfc\x5c\x30\x3a\xea\x78\xf1\x0d\x4f\xe8\x60\xa2\xe4\xc8\xcd\xf0\x5c\x47\x48\x14\xca\x07\x78\xc4\x90\x9f\xf4\xbc\x5b\x62\x71\x5f\x8a\xd4\xa7\xc8\x90\x78\x21\x16\x8c\xf9\x77\x5e\x49\x97\x7c\x5d\x24\xa0\xe8\xfc\x97\x69\x0a\xe1\x97\x66\xba\x82\xee\x2d\xee\xef\x8b\xbf\x1e\x96\xd3\x53\x57\xe9\x5c\x18\x54\x9d\x42\x13\xe5\xe7\x8d\xba\x1b\xdd\x25\x83\x44\x06\x8f\x69\x3f\xe9\xc6\xc0\x12\xb2\xb3\xd3\xb2\x65\x0e\x77\xf0\x7a\x1c\x40\x7d\x6c\xb3\x35\xd4\xc2\xde\x4c\xbe\x17\x2e\x10\x8f\xbf\xab\xe6\xfe\x1f\x5b\x69\xe4\x01\x00\xaa\xe3\x21\xd8\x3d\x65\x16\xb9\x7f\xad\xaf\xee\x9a\x55\x0e\x75\x8d\x7a\x84\xd2\x76\x86\xfa\xfd\x82\xd3\x86\x2a\xed\x49\xed\x0a\x9c\x9c\xc4\xbe\x94\x54\x06\xae\x34\x8d\x79\x4b\x62\x78\x12\x0e\x04\x5e\x73\xa6\x04\x49\x56\xe0\x08\x35\x0e\x01\x3d\x6e\xad\x4c\x9f\xfc\xec\x66\xe0\x67\xd7\x03\x3f\x7b\x37\xf0\xb3\xab\x81\x9f\x5d\x8e\x93\x02\xfc\x5b\x41\xb8\x86\x4f\x69\x85\x33\xf2\x6b\xb1\x43\xdd\x8e\x2d\xea\xb9\xc8\x82\xd7\x89\xd0\xa4\x71\xed\x6e\x96\xd1\x9c\x6f\xcb\x72\xf1\x3c\x9f\xf9\x24\x0f\xd9\x03\x54\x0a\xab\x04\xef\x96\xf7\x22\xa7\xde\x12\x12\xb3\xa6\xf4\x29\xaf\x6e\x5a\x24\x92\xcf\xad\xbd\xc0\x4b\x52\x3f\xd4\x3d\x1a\x83\x76\x40\x41\x43\xe2\x48\x7e\x31\xfb\x0e\xd5\x7d\xd4\x52\x31\x80\x32\x3a\x63\x7f\xe4\xe0\xff\xee\xbd\x8b\xcc\xa6\xb6\xc4\xa8\x95\x51\xbf\xb9\xd0\xdd\xd5\x49\x35\xb3\xcb\xa4\x3b\x66\xb0\xd8\x76\x8a\x25\x21\x8a\xf8\xc1\x84\xa4\x12\x95\x87\xc6\xe1\x40\xd6\xc1\x05\x18\x70\x91\x28\x0b\x84\x99\x28\xdd\xdb\xe2\x1b\xdf\x78\x24\x02\xa6\x2e\x85\xc0\xb9\x97\xec\xe0\xb4\x57\x3c\xcf\x36\x07\x1f\xbd\x32\x01\xb9\xae\xda\x35\xc5\x1e\xf2\x69\xa2\x5b\x5a\x94\x81\x8e\x65\x99\x36\x2c\x16\xcf\x71\xd1\x74\x32\xcf\x59\xe5\x63\x8a\x61\x2f\xe8\x2f\x4b\xd1\x19\x9e\xe7\xc8\x66\x83\xed\x18\x16\x98\xba\x2d\x33\x2a\x25\xe1\xf2\xcc\x64\x5b\x8a\x72\xac\x2a\xf7\x6c\x33\xee\x69\x92\x48\xfb\x3e\x53\xbd\x31\x9e\x89\x69\x34\x15\x7e\xed\xb1\x53\x5b\x3f\xa8\x59\x9a\xa5\x75\xe3\x13\xb1\x12\xce\xf1\x12\xc4\x8b\x96\xae\xc6\xf0\x35\x61\x55\xde\x26\x99\xb8\x51\xc1\xd3\x96\xfb\x8e\x41\x95\x0e\xbf\x2b\x9d\xeb\x44\x06\x48\x04\xd2\x34\xab\xaa\xe9\x14\x30\x95\xb7\x43\xfe\xc4\x53\xd8\xa2\xfe\x9e\xac\x37\x19\x60\x42\x92\x0d\x18\xd1\x92\xf1\xd3\xbf\x3b\xe0\x89\x44\xd2\x2f\xc6\x99\xb4\x54\xc2\x79\xdd\x71\xce\x72\x2d\xca\xed\xeb\x6d\x45\xaa\xab\x0b\x0e\xde\x18\xf1\xbd\x59\x95\x85\xd8\x33\x9a\x71\xaa\xbf\xd0\x9e\x5f\x21\xdc\x16\xdd\x1a\x26\x98\x3b\x4e\xb8\x92\xf4\xbc\x67\xba\x00\x39\x25\xe2\x1d\xaf\x94\xcb\x4d\x82\x44\xa5\xfb\xbd\xed\xea\xb7\x07\xe6\x9d\x2a\xa5\x30\xcf\xd4\x22\x7d\xb1\x98\x3c\x66\x3a\x9b\xd7\x6e\x90\xb4\xe8\xbb\x32\xf0\x00\x9b\x36\x56\x4f\xaf\x2b\x62\x14\x05\x24\xf6\x81\x0e\x58\xae\x3e\xdb\xcf\x0b\xb4\xde\x5e\x7b\xee\x89\x51\xad\xb7\xb7\x07\x5a\x6f\xdf\x55\x8b\x53\x39\x5e\xde\x8d\xea\xe0\xff\x7b\xc6\xb4\xc2\x1f\x51\x33\xad\xc6\x91\xb3\x9d\xa4\x30\x7c\xfb\xd2\xaa\x43\x7b\x92\x1d\xa8\x94\x26\xf5\x2a\x62\x70\xcd\x44\x58\x86\x31\x00\xcc\x2d\xdc\xce\x9a\x1c\x41\x01\xc0\x1f\x8d\x30\x7f\x47\x1b\x61\x3f\x7c\xe7\xc7\x65\x57\x3c\x8b\xfa\x8f\xf0\x85\x57\x22\x51\x0a\x03\x19\x38\x45\x43\x7b\x14\xf3\xe8\x65\x27\x88\xfc\x6a\x0b\xe2\x64\x53\xef\xe6\xab\xb0\x3d\xa8\xee\x07\x07\x7a\x91\xd5\x3d\x7d\x5b\x3a\x03\x6c\x1a\x25\x63\x6f\xbd\x56\x18\x80\x09\x88\xb8\xa6\xac\x54\xc3\xcf\xe3\x35\xba\x3e\x48\xd7\x72\xdd\xa3\x6b\xb9\xbe\x18\x60\x6b\xb9\x1e\xa2\x35\xba\xee\xfb\xd6\xd7\xfd\x95\x7f\xdd\xf7\xd4\xaf\x47\x6d\x06\xb7\x54\xca\xdc\x4d\xee\xa7\x0d\x35\x16\x0e\x36\x7d\x5d\x3f\x97\xd8\xe2\xf2\xe6\x54\xcc\xdc\x7d\x46\x3e\x66\xde\xde\x4f\xc0\xcd\x79\x71\xde\xde\xda\xd3\x29\x8a\x24\x69\xd8\x04\x89\x93\x5e\x4f\x57\x32\xb9\x97\x96\xa9\x4b\xee\x03\x9b\x49\x12\x2a\x5d\x83\x42\xdf\x7d\x60\x9e\x2d\xb0\x37\xd1\xef\x27\xea\x50\x0c\xa4\xde\xe4\xe5\x22\x95\x16\xdb\xab\xb7\x96\xe2\xe9\x7c\x8d\xeb\xfb\xf3\x3b\x97\x6f\xdd\xea\xa5\xb1\x99\x0d\x76\xd3\xfd\x59\xb3\x7f\xe7\x1c\x6f\x7a\x30\x9d\xed\xb8\x4a\x56\x64\x0d\x88\x9b\xcd\xfd\x8a\x72\xcc\x9d\x5e\xf2\xec\xba\xd9\x77\x76\x75\x46\x1b\x6d\x7c\xb7\x17\x0f\x9c\x61\xee\x38\xfc\xaa\xf4\x4b\x6f\xfb\x66\xe0\x66\x94\x19\xf8\x45\x56\xea\x0f\xce\xdb\x7f\x81\xdd\x3f\x9a\xab\x82\xdb\x82\x7d\x88\xed\x5c\xbd\xda\xfd\x5f\xb5\xf5\xc5\xcb\xb8\xa8\x7f\x96\xb2\xc2\x3e\xb2\xb3\xc9\xab\x1f\x00\xc8\xb8\x78\xba\xdd\xf0\xd4\x66\x9a\x91\x46\x40\x25\x29\x52\x67\x77\x17\xbe\xed\x67\x7c\xda\xf9\x36\xca\x3a\x5f\xf5\x92\xce\x97\xda\x03\x49\x92\x93\x24\x3d\x05\x77\x00\xe7\x7f\xb8\xc8\xd5\xdb\x1e\x97\xf4\xb1\x6b\x99\xfc\x35\x68\xf0\x37\x09\xf8\xe3\xa9\x26\xfd\xf5\xb3\xd9\xcf\x30\x77\x37\x60\x7c\xc1\x4b\x38\x73\x1a\xfb\xe7\x02\x0d\x0f\x0b\x93\x43\x44\x5e\x9a\x15\x14\x39\xfb\x49\x3c\x74\x7b\x8c\xd2\x54\xb8\xb4\x3a\xa3\xa3\x96\x84\x68\x56\x44\x03\xc1\xb6\x7c\xf6\xcb\xda\xac\x58\x40\x83\xa9\xba\x09\xec\x81\xae\x46\xdf\x33\x21\x37\xd5\x1c\xb6\x69\x3b\xa5\x17\xe5\xa1\xc8\xa1\x39\xf5\x78\x27\x2a\x16\x8d\x28\xa6\x76\x66\x47\x1e\xd9\x9b\xfe\xdf\xc6\xbc\x9f\xce\x0a\xd4\x31\xeb\x62\x67\xbf\x3b\xc5\xce\xc2\xd0\x8c\x6a\x6b\x7a\x21\x43\xbb\x21\xc6\x78\x98\x58\xc2\x05\x30\x62\x00\x8a\x02\xce\xd6\xae\x78\xfa\x90\x26\xaa\x48\x7c\x05\x6d\xa1\x27\x1b\xe0\xb7\x62\x81\x4f\x16\x30\xbe\x33\xc9\xcc\x60\x35\x99\x3b\xec\xe9\x75\xbc\xcf\x1d\x63\xa3\x52\xdc\x25\x10\x77\x4d\x57\x4b\x74\xca\xc9\x3e\x43\xc5\xef\x16\x03\x80\xc2\xd4\xea\x30\x23\xc0\x2f\xab\x60\x93\x1a\x6a\x02\x9d\x55\x4f\xd8\x8e\x73\x1c\xc8\xa2\xa5\xaa\x1c\x90\x2a\x36\x2b\x35\xf4\x0d\x7f\xb0\xf5\x0c\x6d\xf5\x2d\x0c\x10\x74\xaa\xe1\x94\xd3\x3d\x17\x80\xc8\xb1\xaa\xeb\xc6\x4b\x7e\x10\x92\x00\x6e\x22\xa6\x28\x62\x3d\xf2\x4a\x74\x14\xc7\x21\x0d\x0c\xbb\x98\xc3\x2a\xc8\x79\x43\x6f\xbc\x25\x5f\xdb\xcd\xa2\x27\x6f\x9f\xed\x5e\xab\x54\xab\x73\x32\xd2\x5c\x3d\x50\xfa\x87\xef\xa0\x00\x9a\x31\xbb\x6f\x89\xb9\x99\x53\x97\xd2\xd7\x88\x4f\x31\xfb\x49\xb1\x33\xb0\x6d\x3d\x34\x2e\xff\xd7\xb5\xd1\x2a\xa0\x19\xdb\x73\x78\x68\xa8\xc9\x05\x9c\xb6\x09\xdd\xfb\x49\x04\xe5\xda\xb0\xdc\x20\xdb\xab\xa8\x2e\x68\x71\x5c\x42\x70\x26\xad\x5b\x3d\x3b\xf4\x2a\x56\xca\x01\x1e\x09\x9f\x39\xc8\x9d\xf9\x53\xc9\x22\x04\x94\x9e\x5d\xed\x6a\xa5\x52\x4b\xea\x98\x68\x80\x4d\xee\x8f\x8d\x28\x0d\xb4\x05\x77\xf8\xe6\x91\xf7\xeb\x85\x70\x17\x19\x59\x0c\x3e\x2f\xf8\x1f\x2a\xb6\x78\x87\x7f\x23\x63\x7c\x4f\x4d\x22\x6c\xdd\xd0\x1a\x56\x95\x3b\x63\xa3\x7d\xc7\x1c\x94\x24\xb6\x40\x69\xda\xc5\x0f\xb6\x01\x06\x45\xd4\xfb\xa1\x2a\x33\xb8\x3e\xc4\x44\xa1\xbf\x10\x39\xbc\xde\x73\x8d\xc8\x6c\xaa\xc9\xfb\x71\xc0\xe0\xdd\xfe\x66\x9e\xe5\xbf\xda\xa4\x6a\x7e\xfd\x7a\x46\x6d\xd0\x15\xcc\xe6\x27\x78\x82\x4f\xb0\x0e\x64\x1b\xd6\xe7\xb3\x0d\x35\x66\x2d\x7d\x11\xbb\xe0\xdd\x4c\x21\x1f\x39\xdd\xa5\x3c\x9f\xbd\xe8\xfa\x9d\xc7\xed\x07\x41\xc6\x9f\xe4\x9d\x0e\x11\x09\xbf\x5d\xdc\x42\x2e\x60\x71\x6e\x07\xd5\x7a\xef\x46\xc2\x97\x00\x84\x7c\x6c\x29\x26\x94\xab\x22\xe0\x70\xe4\xe2\xfc\x22\xaa\xd1\x5d\xb1\xd2\x65\xd4\xce\x06\xd0\x81\x9e\x1b\x75\x3a\x0f\xea\x2f\x8c\x10\x7f\x6c\x73\xc8\x08\x7a\x85\x70\xef\xdf\xbe\x99\xfc\x15\xe8\x00\xf6\x38\x3a\x17\x60\xfc\x4e\x9e\xf7\x7a\xe7\xbc\xd1\xcb\xb8\x8c\x46\xca\x22\x48\xf0\xad\x32\x4a\xc3\xb8\x77\xc5\x6e\xf0\x12\xc0\x53\xf7\x93\xcc\xcb\x7c\x75\x30\x1b\x5e\xcb\x2b\xe2\x65\x29\xfd\x3b\x53\x63\x37\x74\xc5\x83\xce\xf0\x48\x6e\xd2\xcf\xfd\xd7\x7f\x13\xbf\xfd\x17\xa3\x16\x79\xb6\x43\xfd\xed\x11\x87\xba\xe3\xe5\x77\xf6\x5c\x37\x8d\x72\xdb\x03\x9b\x50\x71\x07\xc7\xe9\x98\x97\x71\x42\x61\xe1\x76\xc0\x7b\xc7\x9e\xeb\x67\x4e\x6e\x4f\xf0\xe8\x3f\x61\xf4\xa3\x99\x3e\x4f\x3a\xe4\xfe\x73\xec\x19\x87\x22\x19\xbd\xf9\x25\xf4\x8a\x17\x2f\x74\xba\x3d\x21\x07\x12\x33\x82\xf2\x84\xd5\xdb\xac\x22\x50\x2f\xb1\xd6\xc4\xf0\x80\xae\x43\xbf\xb7\x95\x78\x3f\x32\xcf\xa6\x48\x2e\xfb\x69\x0d\x8b\xcb\xf3\x86\xea\x31\x29\x32\xa2\x26\xf5\x14\x6d\x16\xfb\xe0\x2c\x20\x39\x46\x35\x49\x91\xd3\x23\x10\x74\x0a\x2c\x8b\xa8\x75\x84\xde\xfb\xbb\xc8\xf7\x9c\xb9\x8f\x03\xb2\xc9\xdc\x8a\x06\x97\xe4\x8f\x23\x7f\x7c\xa0\x8d\x65\xa1\xa2\x5d\x96\x1b\xc1\xd3\x3a\xea\x49\xe9\x01\x66\x1c\x64\x7f\x8d\x24\xe3\x88\x3d\x80\x40\xf1\xb7\x8c\x6a\xd9\xc9\xa3\xb7\xc4\x84\x62\xee\x09\x9a\xf3\x87\xae\x31\x81\xc7\x87\xb4\x76\x81\xe1\x85\xff\xf7\x47\x94\x75\x93\xac\x18\x29\x20\x6a\x7b\xe8\xfd\xde\x60\x7f\x90\xe8\x98\xce\x11\xf3\xba\x15\x70\x4b\x71\x17\x2d\x18\xf5\xb1\x08\xf4\xef\xe6\xa5\x4e\x17\x67\x8a\x02\x03\xd3\xcc\x24\xf2\xfb\xee\x20\xa2\x03\x02\x0b\xb7\x0c\x76\xa1\x84\x2d\x0e\xb1\xb9\xe9\x55\x74\xd3\xc0\x6b\x4d\xe8\xc6\x77\xd1\x23\x7c\x1d\x5f\xf0\xb4\xd8\xf1\x82\xc2\x56\x0b\x29\x50\x29\x6b\xf4\x8a\x61\xdb\x49\xc9\x13\xc7\x68\xb6\xe0\xd3\x2a\x5c\xa9\xac\x62\x97\x52\xbd\xe7\x28\x3c\xb8\x98\xc6\x44\x78\xb6\x0b\xff\xa0\xdf\xe9\x19\x87\x0d\xa1\x1b\x7c\x5c\xb8\x78\x4a\x94\x65\x70\x4a\xf8\x15\x5a\x70\x17\x56\xb1\x4e\xba\x47\xa5\x87\xa7\xa0\x7e\xa6\x85\x7d\x88\x51\xfe\xa7\x97\x37\x22\x72\x42\x0a\x40\x48\x21\x36\xad\xb3\x2a\xed\xf0\xce\xb9\xb0\x98\x9c\xb4\x9d\x61\x95\x61\xa3\x19\x93\xb7\x56\x1e\x3b\x15\xfc\x0e\xe9\xac\xa6\x6a\x34\xcb\xbf\xfb\x14\x4e\x27\x25\x83\xa0\x24\x73\xf1\x15\x99\x46\x4d\x40\x70\xa9\xda\x87\xd1\x80\x4b\x81\xee\x49\x02\x1b\x7e\xad\x78\x33\x20\xe3\x6d\x37\x20\x3c\xe1\xb9\xa0\x4d\x4a\x27\x5b\x23\x1c\xcd\x6d\x1d\xf1\xe4\x1a\xd7\x8a\x58\xc9\xb2\xc5\xbd\x10\x00\xd4\x6b\xe2\x97\xd5\x40\xb1\x4a\xd6\x9b\x31\x40\x26\xfd\xbc\x9b\x99\xc2\x59\xbd\x54\xc9\xb6\x0b\x9b\xe6\xcf\x09\x42\x37\x0d\x4d\x56\xce\x89\x14\xac\x16\x75\xfd\x2a\xf2\x9a\xf7\x38\xa4\x5a\xf9\xb2\xf2\x6c\x56\x09\xb5\x6a\x17\x69\xae\xaf\xce\xcf\x77\xf8\x8a\xfb\xc3\x5d\x23\x4f\x84\x64\x7a\x96\x34\x8d\x68\x8c\x1b\x65\xf9\xd3\x43\x15\x0a\x4b\x3d\x31\xe7\xc8\xc0\xc5\xd8\x2d\x0d\x60\x6e\xf6\x04\x30\x9a\x13\x25\xfb\x52\xa4\xd0\xb6\x37\xd1\x89\x9f\x97\x83\xb1\x08\xb7\xf3\x32\xb2\xd8\xd9\xc7\x82\x93\xff\x6a\xcf\x4a\xb8\xf6\x79\xb2\xa9\x7d\x99\xf3\xc3\x47\x40\x0a\xb2\x1a\xfd\x45\xe3\x38\x34\xd1\x28\x8c\xf3\x45\xf1\x9a\xe6\x04\x1b\x60\x0e\x21\x82\xd2\x55\x99\x77\x3b\x9e\x68\x76\x7b\x5d\xcb\x7b\x92\x51\xce\x82\x68\x93\xbb\xf9\x36\xe0\x17\xaa\xb7\x3c\xe0\x30\x9d\xe0\x38\x90\xba\xd8\x0b\x90\xc7\x5d\x3d\xc1\x73\x66\x99\xaf\x97\xf1\x19\xc6\x26\x86\x1a\xc6\x9d\x6f\xf2\x2c\x21\x44\x6f\x43\x09\x66\x67\x5c\x90\x9d\x87\xc1\xa8\x9b\x3c\xd3\x76\x07\x98\xb7\x3c\x7d\xed\x36\x63\xb5\x91\xd1\x0f\xa4\xbb\x23\x2a\xba\x81\xa4\xd3\x81\xa4\xcb\xc4\xd0\xe3\x36\xe5\x88\x92\x64\xdf\xdf\xbe\x31\xee\x36\xe7\xa9\x54\x65\x58\x3b\x7f\x03\xf0\x21\x92\xb6\xcd\xea\x4e\xbd\x12\xd7\xec\x5d\xd2\x3c\xef\x90\x8b\xfe\xef\x5a\x89\xfc\x76\xf1\x5d\x5f\xb9\xed\x5c\x4d\x35\x1d\x07\x81\x05\x80\xe3\xf4\x2d\xba\x66\x66\x29\xe8\x79\xf2\x72\x9b\x33\xdd\xf2\xc6\x59\x16\x30\x0d\xed\x44\xf9\xd5\x06\x1d\x07\x09\x75\xf9\x82\x1e\xdf\x45\x91\xcc\xf5\x00\x31\xd9\x0f\x6d\x05\x5b\x02\x0c\xed\xb4\x9b\x3f\x31\x37\xe8\x96\x1e\x8f\xd5\x19\x0f\x74\x69\xec\x7b\x43\x3f\x36\xe6\x3d\xd4\x90\xf4\x3b\x89\xd1\xf5\x09\x6d\x4e\x2f\x90\x70\x99\x11\x25\xdf\x6e\xc3\xe4\x77\x9d\x5a\xe6\xf1\x52\xe6\x50\x32\xe4\xdb\x93\x4a\x99\xbf\x24\x9c\x0a\x78\x89\xcc\xc7\xed\xb9\x35\x4e\x9e\x15\xef\x3d\x1f\x44\x36\x98\x08\xe1\x16\xc5\xaf\x9d\x00\x31\x09\xf7\xa8\x1e\x20\x7b\xe6\x0c\x80\x91\xc8\x2c\x1f\x4e\xad\x7c\xee\x66\x44\xd0\xd7\x96\x2d\x97\xd9\x1c\x74\x4c\xa2\x76\x7d\x70\x6f\x81\xfe\x21\xe1\x21\x47\xa2\x68\x82\x96\x88\x6c\x89\xbf\x0b\xce\x9d\x21\x0c\xa9\x81\x8f\x4a\x9f\xbc\x01\x64\x64\x35\x3b\xce\x5e\x5f\x93\x7e\x29\xd2\x4a\xa2\xad\xd1\xb5\x8b\x98\x3f\x46\x85\x92\xd1\x12\x2d\xb4\x4f\x44\x8c\x24\x44\x16\xa8\xb2\xd9\xb4\x2f\xb7\xea\x0c\x15\x13\xc5\xb9\xb4\xc2\x4e\xe1\x3e\x68\x78\x8f\xbf\x55\x52\x31\xfa\x57\x89\x55\xdc\x66\xd1\x20\x3d\x99\x88\xc2\x96\x94\x75\x34\xeb\x4e\xc4\x18\xe5\xaf\x69\x71\x21\xce\xf3\x4d\x54\xfd\xe1\xdf\xf9\x4f\x8b\x4f\xcd\x2e\xb5\xf3\xac\x0d\x27\x86\x75\x9e\x3b\xdf\x3a\xe0\x45\x4b\x7b\x60\x62\xc7\x23\xbf\x4e\x17\x51\xeb\x22\x6f\x1c\x8d\xf2\x96\x19\x81\x7d\x7f\xf3\x54\x58\x45\x8d\x34\xdf\x97\x68\x7f\xef\xd8\xc3\xab\x01\x7b\x78\x77\x76\xa6\x08\x1c\xe1\xc4\x84\xb6\x41\x00\x56\xb4\x6b\xd5\xfb\x8b\xa9\x60\x6e\x40\x03\x43\xa7\xc2\xd4\x48\xb9\x50\x00\xc5\xe8\x6e\x3a\x42\x41\xed\xd3\xf3\x08\x9f\xda\xe1\xa6\x7c\x10\x9e\xe9\xf6\xbd\x3b\x87\x8b\x8b\xc3\x3d\x6e\x50\xd3\xad\x89\x1d\x73\x2f\x07\x04\x05\x2d\x81\xab\x79\x07\x5a\x21\x0d\xbe\x98\x17\x91\x09\x9b\xfd\x03\x5a\xb9\x8c\xa1\x36\xb7\x5f\x28\x55\x1b\x4b\x13\x8a\xed\xbd\xed\x08\x22\xba\x35\x4c\x9d\x24\xee\xc6\xff\xa1\xb2\x92\x6e\x75\xbb\x2d\x41\x81\xae\x71\x38\x6a\x9f\x67\xc6\x9e\xa9\xd2\x5a\x58\x9f\x63\x38\x00\x92\x65\x75\x29\x0e\xa8\xd2\xff\xb3\x9b\xa2\x1e\xa2\x97\xe7\xf1\x19\x91\xd0\x4a\x72\x82\xf2\x68\x24\x46\x43\x07\xe8\x00\x47\xa5\xfc\xba\x33\x6b\xc2\xd8\x54\xa7\x9b\xa4\x4a\x24\xeb\xd1\x6b\x8a\x62\x7e\x2f\x02\x1c\xc8\x8d\xc2\x34\x13\x81\x1b\x6c\x90\x6f\x5a\x61\x46\x4e\xb1\x65\x21\x70\xf6\x1d\xc7\xe1\xb8\xa3\x4b\xaa\xac\x75\xb8\xe4\xa9\x8a\xd6\xba\x53\x7f\xc9\xf6\x15\x6e\xae\xce\x21\xe7\x78\x2e\x1d\xd1\x63\x3e\xca\xa0\x68\xef\x60\xbe\x19\xa6\x94\xfb\xf6\xdc\x73\x5f\xe1\xba\x39\xa7\x00\x68\xeb\x5e\x20\x82\x82\xea\x0c\x3b\x22\x3b\xe2\x60\x36\xb5\x59\xc1\x1b\x29\x49\x9a\x64\x7e\x69\x2b\x30\x87\x19\xed\x51\xa1\x0d\x16\x76\x0b\x39\xe0\xb3\x5e\x88\x79\x8a\x82\x88\xbf\x84\xb2\xbb\x9d\x24\x98\x02\x39\xf7\x1f\xe9\x98\x05\x3d\x87\x5f\xd3\x1d\x01\x2c\xab\x76\x36\xe2\x6a\x41\x3c\xcd\x7d\xf5\x88\x76\x9a\x4f\x65\x37\xee\xdd\xac\x5e\x23\xe3\xb6\x30\x74\x03\xc7\xee\xe6\xe5\xf0\x86\x3c\x23\x9a\xcf\xf0\x10\x1d\x2d\xaa\x31\x57\x0e\x0e\x0f\x85\x11\x43\xae\x54\x3d\xd0\x73\xc3\x7b\x7a\xa7\xcc\xd8\x94\x85\x0d\xcd\xe7\x78\x5b\x23\x66\x71\x95\x2e\xee\xa5\x6b\xfd\xd1\xdd\xcf\x79\x95\xf7\x89\xd8\xca\x65\x79\x1f\xa7\xf9\x90\x90\xaa\x24\xe5\x6c\xd6\xf1\x6f\x7e\xe8\xc3\x11\xf9\x9e\x7a\x15\x87\x18\xa2\xde\x1d\x25\x9c\x7e\x52\xcb\xfa\x68\x1e\xea\x7a\x05\x95\x86\x2a\xe5\x15\x67\x1b\xd8\x93\xed\x81\xb3\xfc\xea\x9a\x2c\xc2\xb8\x2a\xd8\xfb\x32\x21\x16\x7b\x66\xac\x1f\x55\xfa\xea\x6b\x6e\xb1\xad\x43\x21\xaa\x7b\xda\xd7\x7b\xe3\xa0\x2e\x03\xd4\x8c\x5b\x46\x27\x22\xd3\x8b\x37\x33\x7c\xc8\xdf\x29\x01\x71\xb8\x07\x1d\x2d\x4c\x81\x8b\x8f\x67\x95\x32\x03\x42\xa3\x8c\x36\x46\x5a\x6f\xb2\xae\xad\x3a\xb6\x30\x5c\xa4\xfc\xf0\x6f\xb7\x26\xfc\x2a\x98\x97\xd5\xc3\x73\x1d\xba\xe1\xa5\x20\xff\xfc\xb4\x4d\xd6\x9b\x81\x95\x31\x5a\x85\xcd\xbc\x5c\xe6\xc8\x60\x61\x4b\x14\x6d\xf2\xec\x5f\xad\x49\x62\xbe\xf6\x4d\xef\xf4\xe2\xa7\xd4\xc1\x34\x85\x46\xcd\x74\x52\x17\xa0\xa4\xf6\x3a\x39\x53\x96\xed\x78\x4c\xdc\x97\x92\x5a\x68\xaa\xb7\x29\x51\x80\x55\x6b\xe2\x4c\xe0\x80\x49\x22\xf2\x57\x46\x78\x0a\xb7\x27\xda\xe5\x32\x6f\x48\xed\xed\x31\x11\xc1\x0c\xc4\x20\xe4\x9a\x59\x0c\x0b\xf1\x1f\xbb\x2f\xbb\x78\xea\x6f\x4f\x59\xe0\xa2\xf3\x90\x85\xce\xf8\x13\x71\x71\xdc\x09\x2c\xa4\xcc\x46\x05\xaa\x9d\x51\x45\x8b\x85\x41\x65\x2e\x6d\xf3\xba\x12\x1b\xdb\xde\xe7\x58\xab\x46\x2c\xbd\xbc\x06\xca\x5a\x30\x83\xf9\xd2\x3b\xca\x81\x8e\x66\xec\x86\xd9\x9d\xdc\x8b\xf8\xfe\x25\xd0\x34\x70\x89\x73\xf6\xf4\x9f\x96\x56\x3a\xdc\x82\xb4\x27\xa9\x34\x26\x73\x24\x18\x4f\x9f\x3b\x12\x28\x78\xc7\x45\xa3\xfc\xe4\xb1\x1c\x12\x47\x03\x74\x39\xaa\xca\x12\x87\xb5\xf2\x40\x67\xc8\x2f\x12\xba\x65\x40\x46\xaa\x5c\x2e\x2d\xa1\x8c\xbd\xd2\x73\x84\xe2\x86\x34\xa6\xf8\xb2\x3e\x6d\x33\x96\xd5\xea\x88\x0a\x53\xfa\x4f\x71\xd6\x78\x8f\x02\xfe\xba\x11\xfd\x25\x6e\x24\x4e\x50\x21\x1e\x60\x32\x11\x3d\x8a\x8c\x90\x3e\x4a\x1b\x25\x8c\xca\x66\x73\x31\xe3\x7d\x90\xf4\x31\xcd\xcd\x91\xfa\xf6\x91\x52\x41\x36\xa4\x81\x25\x13\x2d\x15\x12\x37\x03\x6d\xbe\xb4\x85\xf8\x06\xa8\xef\x98\xfd\x49\x69\x01\x34\x6d\x4e\xd2\xc3\xe6\xca\xa6\xa7\xbb\x4a\x37\x69\xd2\xf4\x86\x7d\x3c\xaa\xf4\xeb\x48\xaf\x4b\xd2\x49\x5f\xb2\x35\xa3\x90\x97\xb0\x43\x1f\x53\x06\x25\xf7\x99\xb6\x28\xe0\x44\x65\x97\x65\x95\x0c\x4b\xf2\xd2\x3e\xb4\x5e\xbb\x8e\xa9\x8b\x95\x56\x9d\x4d\x52\xad\x94\xf0\xfc\x98\x6e\xa2\xdc\x4b\x8e\x7b\xa8\xb6\x88\x18\x73\xe8\x25\xef\xf6\xcf\x30\x73\x27\x89\x53\xab\x70\x53\x62\x28\x27\x01\x66\x15\x72\x49\x86\xa4\x5b\x0e\x27\xa3\xee\x4c\xbc\x6a\x58\x1d\x4a\x2d\xc9\xf3\xfd\xe3\x6c\x2e\xdf\x0d\x23\x45\x91\x39\x27\xe6\x15\xe6\xbd\x11\xe6\xa7\x47\x92\xcf\xd9\x80\xb0\x62\x9e\xbb\x30\x21\x10\x94\x93\x39\x9b\xb2\x1a\x28\xa9\x8e\x2d\xd2\x7a\x4e\x81\xb9\xa7\x59\x41\x66\x13\x57\xa0\x0e\x66\xdd\xe5\xb8\xa2\x6e\xf1\x68\x6f\xee\x9d\x32\x13\x16\x62\x8b\x68\xd8\x5e\x25\x6b\xfb\x29\x82\x33\x19\xe5\x61\x34\xf8\x08\x89\xed\x3a\xaa\x32\xc6\x92\x12\x3d\x73\xe4\x29\xcf\x8e\xf5\x07\xff\xd2\x4f\x3c\x4f\xfb\x6f\x3a\x62\xc9\x92\xee\x6c\x13\xe4\x21\x4f\xa3\x64\xd1\x1c\xe3\x0d\xea\x61\x8f\x9c\xa9\xae\x34\x78\x0c\x52\x66\xa1\x5f\x95\x2b\xfc\xad\x43\x9c\x74\x36\x2a\x3f\xf2\xfe\x25\xaa\x3b\x42\xae\xe5\x5c\x6f\xb4\xaa\x6d\xd3\xd9\x59\xc5\x71\xc4\x4d\xfd\x1b\xe2\x85\x45\xe2\x9c\xe1\x93\x0f\x65\x18\x39\xb5\x3c\x54\x4f\x41\x02\xf2\x61\x87\x91\xce\x28\xfd\x1d\xaa\x39\xc3\x72\x4f\x16\xaf\x97\xce\x6a\xce\x65\x87\x8a\x38\xe9\xc9\x86\x63\x8a\xaf\xd4\x2f\xd3\xfb\xaf\x23\xcd\xd0\x4f\x12\x30\x7b\xb4\x33\x1c\x20\x0a\x9c\xd5\x73\xe1\x4f\x76\x41\xce\xb1\x74\x87\xc2\xe9\xe4\xda\xc4\xba\x55\xb8\x27\x40\x86\x47\x73\xab\xcd\xb6\x14\x87\x79\xcd\x35\x90\x57\xc1\x15\x27\x13\x2a\x12\x5d\x17\x7c\x58\xee\x28\x3d\xa4\x9e\x3c\x5a\xe5\x10\xf8\x73\x0d\x19\xc9\x0c\x2b\xee\x29\xd3\x8c\x7c\xc4\xcc\xe3\x67\xea\x20\x31\x1a\x90\xe3\x96\xe8\xbd\x43\x2a\x19\x53\xb1\xbe\x99\xfc\x82\x87\xd1\x71\x88\xdd\x8c\x7a\x60\x44\x95\x65\x91\x6e\x98\x77\xec\xa6\xc3\xd3\xdb\x9f\xa6\x3f\xf6\xfb\x74\xe9\xf6\xa6\x54\xad\xe8\x5f\xdc\x9c\xde\x5f\x54\x9d\x5a\xab\x54\xb9\xff\xfc\x19\x9a\x4a\x62\xff\xc1\xa8\x43\x64\x41\xd1\xb7\x33\x2c\x7f\x22\xcc\x98\xaa\x62\x19\xe9\x6e\x1d\xc4\x1a\xf4\x6d\x1d\x5d\xd4\xe2\x0d\x55\x67\x41\x8d\x7c\x4f\x79\x73\x3f\x5f\xa2\x9e\x76\x1d\x39\x21\x83\x49\x0c\xf7\x3f\x9a\x4b\xd4\x9d\x02\x43\xbe\x06\xce\x86\xcd\xf6\x8d\xd9\x73\xf0\xb0\xf1\xb1\xf4\x0b\xa3\x64\xb5\x77\x65\xc6\xac\x4a\x20\xfd\x42\xdf\xf5\x44\x84\x8b\xbd\x48\x28\x48\x21\xe2\x1e\xee\xbe\xb8\xf7\x9e\xa5\x81\xef\xf5\x2a\xf2\x57\xe3\x48\x1c\xdc\x5e\x5e\x8c\x92\x22\xf8\xf1\xbc\x20\xaa\xf3\x06\x46\x5a\x5c\x3f\x81\xea\xf9\x43\x95\x6c\x05\xca\xc9\x7a\x79\xce\x81\x75\x0b\x68\x49\xc8\x27\x52\x96\xc7\x81\xbe\xb1\x42\x29\xca\xa4\xc5\xd0\x2b\x67\x6f\xdb\x1a\x82\x12\x51\x2a\x98\xad\x23\xe8\x14\xdd\xa7\xb5\x23\x99\xa0\xc8\xec\x26\xac\x07\xd8\x59\x12\xe7\x0a\x70\xbd\x54\xc2\x30\xf5\x8a\x4d\x4a\x7c\xc4\x61\x10\xed\xfc\x01\x7e\xa4\x91\xea\xb9\xe8\x90\x59\x35\x69\x91\x2e\xf6\x9c\x17\xf4\xe0\xa8\x91\xcd\xd9\x4a\x17\x5d\xd2\xfd\x68\x2e\xa7\x6e\x44\x45\xb6\x14\xe0\x2c\x41\x0b\x4b\x80\x77\x08\xf4\xb0\x4e\x28\x85\xc6\x6c\x94\x6b\x90\xe4\x70\xbf\xd7\x0a\x17\xdb\x17\x8d\xd0\x92\x0f\x83\x34\x37\x96\x18\xe5\x64\xc2\x28\x30\x94\x76\x18\xa3\xf6\xc5\x83\x9d\x23\x63\xa9\x16\xd9\x47\x1e\x7d\x61\xdc\x51\x0c\xa6\xbf\xac\xb2\x2a\xdf\xee\xad\x2b\x7d\xfb\xdc\xba\x92\x12\x4d\xef\xdf\x92\x3d\xa6\xd2\x83\x89\xde\x67\xa4\x2f\x0e\xf4\x35\x6c\xfd\x2c\xac\xca\xad\x5b\x5b\x8b\x72\x5b\x30\xfb\xbd\xac\xfe\x08\xf1\x82\xcd\xe8\xa6\x71\x9e\x2d\x77\xe2\xc9\xf8\xaf\x23\xf1\xe5\x9b\x04\x78\x57\x4d\xfd\x2e\x23\x3c\xe0\x7c\x47\x3d\x36\x55\xb4\xcb\x82\xaf\x74\x8c\x4a\xaf\x65\xe9\x89\x6a\xd2\x01\xc2\x37\xd1\x30\xfa\xa1\x18\x49\xf7\x8a\x04\x8c\x10\x50\x77\x33\x22\xe1\xeb\x6e\xf5\x3d\x50\x86\x1b\x5f\xeb\x07\xc1\x0c\x71\x0e\x2d\x0f\xa4\xa2\x95\xce\x5b\x11\x1f\x71\x5f\xe2\x76\x73\x2f\x7d\x22\x9f\x21\x0d\xc3\xae\x84\xe1\xee\x58\xef\xdf\x29\x40\xb9\xfd\x4d\x8e\xda\x15\x60\xf1\x35\xfe\x79\x75\x33\xc5\x3f\x95\x8f\x72\x73\x41\x02\xc9\xe3\xa0\x62\xe7\xa1\x41\xe1\xe3\xc2\x8a\xcf\xa8\xcc\x01\xe2\x12\xea\x95\x3d\xb7\x1e\x3b\xb5\x29\x2f\x58\xc8\x7e\x6a\x55\x9e\x7b\xd4\xe0\x2c\x6f\xe4\x49\x9a\xc9\x4b\x39\x81\xf1\x5b\xca\xe8\xc6\x67\xca\xba\x13\xb0\x0f\x9f\x90\x46\xc2\x4d\x5d\x5f\x62\xc0\x0b\xd3\x2b\x0e\xf5\xb7\x10\xdf\xc0\x4d\x40\x72\xa5\x55\x10\x0b\x9e\xaa\x00\x5b\xf7\x6b\xf2\x6a\x9b\x72\x83\xf7\x32\x24\xc5\x6e\x12\x01\xf1\x43\xb1\xf4\x48\x9e\x0f\xa2\xc1\x86\x6f\x88\xa2\x6f\x8d\xc9\x5a\xa7\x09\xfb\x8e\xee\x99\x77\xd8\x74\xcb\xc3\x59\x96\xf1\x22\xf1\x5d\x67\xd4\xbd\xa0\xb0\x21\x46\x79\xa6\x61\x1d\xf5\x67\x79\xc8\x45\xed\xbd\x1c\x4d\xe8\xad\xda\x0a\xaf\x04\x14\xa3\x5f\x7c\x2a\x26\x70\xcc\x66\x92\x09\x92\xb4\x96\xb7\x41\x5f\x99\x6b\xec\x76\x80\x7f\xf3\x7f\x0e\xd1\x7e\x0f\xc0\x49\x7b\x47\x26\x9e\x7e\xb0\x50\x76\x3b\x56\xad\x77\x8f\x22\xc5\xc8\x8a\x18\xf9\xeb\xac\x28\xa7\x4a\x5a\x07\x4e\xcc\xef\x8e\x57\x46\xf7\xe4\x0c\x12\x7f\x92\x9c\x58\x05\x62\x35\x4d\xb8\xb2\x49\x0d\xa6\x43\x26\x84\xe8\x4b\xd0\xb2\xb1\xcc\x38\xc6\x83\xa4\x30\x79\xc0\xf3\xbc\x6c\x17\x0a\x6c\xd2\x72\x12\xfd\x50\xd5\xd2\xe2\xbd\x86\x60\x98\x64\xdc\xb8\x05\xcc\x5d\xe1\x9d\x91\xd0\x1e\x40\x30\xd9\x82\x97\xed\x68\x24\x08\x81\x40\x3c\xc9\x75\xf6\x32\x7b\x03\x82\x06\x56\xc0\x8d\xc1\x12\xe8\x90\x21\x4e\x53\x61\x04\xa8\x18\x4a\x89\x3e\xae\x41\xa9\xcd\xbf\xd2\xaf\xc8\xca\xf2\x01\x21\xcb\x62\xaa\xa1\xb5\xc8\x9e\x75\xd0\xe6\x19\xf5\xa3\x27\xee\x44\xca\x1a\x02\x34\x85\x3e\x18\x66\xda\x1f\x76\x20\x3a\xcf\x10\xc6\x65\x67\x96\xef\x28\xa5\x65\x11\xb9\xc4\x85\x2e\x5f\xf3\xd0\x3a\x2a\xbe\x47\x12\x31\xdd\x41\x9b\x5b\xb1\x5d\xe5\xd7\xe6\x0d\xab\x00\x53\x43\xba\xd3\x7f\xdd\x27\x80\x7b\x95\x3a\x5a\x55\x3a\x73\x21\x1b\x40\x79\x6f\x7d\x9e\x8c\xf5\x6b\x25\x9b\xac\x81\x39\x4f\x59\x4e\xcd\x8b\x24\xd9\x11\xe6\x3f\xba\xb7\x24\x5b\x57\xa9\x01\x0d\x2d\x64\x35\x8d\xaa\x13\x8a\xb9\xc8\xcf\x8f\x39\x1f\xdf\x34\xe4\x96\x99\x33\xc8\x49\x21\xe4\x9f\x4b\xc2\x1d\x10\x86\x2d\xfd\x42\x74\xce\xac\x7b\xf6\x42\xd4\x85\x3f\xb1\x3c\xb5\x2f\xbe\x73\x79\xfd\xa0\x18\x8e\x45\x7e\x55\x59\x4d\xf1\xa1\xd4\xa7\xf7\xe0\xce\x4f\x55\xc4\xb9\x89\x61\xe7\x37\x87\xd1\xe1\xff\x88\x38\x68\x0c\x83\x11\xf9\xc8\x38\xee\xcc\x20\xea\xd2\x66\xae\x98\xd2\x97\x84\xca\xd1\x84\x5c\x50\x13\xf2\x26\x21\x71\xbe\x41\x3f\xfc\xdf\x4b\x75\xa7\xe7\xc8\xbe\x5b\x7c\x77\x76\x3f\xf6\x58\xb9\xce\xac\x86\x07\x17\xe5\x63\x66\xdc\x4b\x23\xf5\xf9\xa9\x41\xc7\xdd\x13\xad\x46\x02\x3a\x5b\xb7\xcf\x3f\xad\x45\x03\x47\x9d\x61\x31\x38\x71\x11\x4f\x82\x19\x36\x07\x7b\x28\xb1\xfe\xcc\x44\x6b\x94\x92\xde\xb2\x0a\xcd\x1a\xc7\x6e\x06\xb6\x90\x59\x25\x64\xd2\xca\x51\xc5\xfc\x83\xee\xfc\x68\x37\x40\x04\xbb\x29\xac\x18\x53\x3b\x2b\x73\x6d\x2b\xe5\xf1\x96\xc0\x75\x26\xd5\x0e\x35\x15\x0c\x83\xe0\x8d\x79\xd2\xa2\x77\x9c\x56\x38\x57\x3a\x3d\x5f\x4c\x78\xbd\x64\x14\x97\xc2\xd7\xc8\xf7\xe1\xbd\x6d\xcb\xb5\xa4\x96\xf8\x66\xf2\xea\x7b\xb4\x76\x23\x96\x11\x67\xcd\xf9\x72\xc4\x59\xcc\xda\xc5\x59\x7a\x8f\x5c\x87\xdb\xfe\x2a\xdc\x58\x67\xeb\x2c\x4f\x2a\x3b\x2a\x56\xe8\x6e\x0b\x95\x42\x7f\x73\xe1\x95\x7f\xc9\xb4\x47\x27\xfd\xc0\x5c\xc6\x05\x46\x71\xc1\x46\xf5\xee\x64\xf5\x6a\x54\xa2\xf0\xfd\xf9\x34\x06\x9c\xd1\x3c\x2f\x87\xf3\x80\xa9\xc3\x73\xe1\x58\xc4\x2b\xa5\x46\xdc\x2c\x55\x01\x6a\x14\x69\xca\x0a\x0e\x6e\x74\x64\x03\x85\xfc\x66\xf2\xfd\x8e\x95\x35\x76\x2c\x3c\x49\xce\x13\x8e\xd5\x90\x22\x48\x72\x15\xa0\xa2\x86\xb8\xd0\x27\xce\x78\xc9\x94\x74\xd1\xc9\x72\xcd\xe7\x6e\x01\x7a\x54\x6c\x25\x5d\xed\x47\xc5\x34\x67\x49\xed\xac\xba\xd7\x23\xf2\xce\x7a\x46\xc1\xce\xa2\xdd\xe4\x6e\xf9\x35\x56\x3d\x5b\x44\x97\x96\x93\xef\x6c\x5f\x98\xe8\x31\x93\x76\x72\x69\xbb\xdf\xd7\xec\xbb\xed\xd4\x09\x61\xe2\x77\xe5\x93\x16\x0e\xd2\x10\x8c\x43\x52\x92\xf5\x4c\xe4\x85\x59\x11\x51\x99\x2c\x36\x5d\xec\x61\x1c\x23\x79\x28\x0b\xa3\xb4\xd4\x01\xee\x76\x12\xa1\xc7\x30\xa3\xa6\x65\x96\xae\xca\xcd\xe1\x9e\xe5\xcc\x6b\xdc\xe2\xe1\x1e\x93\xbc\x95\x38\x9d\xa5\xd2\x13\x11\xf5\xcb\x9a\x54\x44\x89\xe5\xfb\x12\x15\x45\xc5\x65\xef\xf3\xfa\x6c\x8f\x9b\xb5\xda\x72\x5b\x8d\xd1\x1e\x15\x6f\x3e\xca\x77\x8d\x79\xc6\x04\xd3\xa4\xc1\xc2\xd6\xbd\x03\x9b\x9e\xa6\x47\xb2\x97\x27\xbf\x98\xc5\x05\xa1\x07\x18\x29\xd6\x73\x7a\x0d\xa9\x57\x12\x2a\xd3\x59\x2f\xa4\xe8\xe4\x1c\xc1\x2a\x9b\x85\xf4\x83\x32\x08\xb0\xfa\x2d\x77\x96\xd3\x8b\x1f\x33\x6a\x02\xee\x99\x10\xe7\xc0\x90\x3b\x3d\x10\xbe\x5c\xb2\xdb\x58\xd5\xd6\xe7\xdd\xd4\xde\x8f\xe8\x17\x05\x49\xe8\x4e\xcb\x0c\x4d\xd5\xba\x8d\x14\x26\xa1\x81\x8b\xf8\xcd\x70\x16\x24\xd8\xe3\x86\x58\xa6\x57\x9c\x4b\x1b\x06\xab\xed\xd0\x59\x8f\x05\x8c\x6a\x24\x9f\x18\x79\xde\x95\x3b\xb7\xe4\x8f\xee\xd0\x9b\xaf\x7e\x57\xb3\xa4\xf0\x82\xab\xb3\x2c\x63\x34\xe2\x59\xdd\xea\x31\xc3\x77\xa1\x3f\xfa\xf6\xa1\xc0\xdb\xe0\x0c\x52\x1c\x05\xb0\x3d\xa4\x7f\x80\xf2\x07\x76\x3d\xfe\x4e\x04\x0a\xe2\xae\x10\x6e\x0f\x3d\x18\x82\x6f\x0c\x5b\x9f\x06\x4e\xbf\x42\x32\xe9\x02\x41\x15\x9b\x37\x37\x9f\xf0\xd4\x6a\xa9\xbb\xd2\xa3\xcc\xa1\xa2\x53\x2e\xb0\x19\x19\x35\xb5\xc5\xc7\x5a\xe8\x97\x50\xff\xf0\x97\x04\xda\x3d\x53\x6a\xe0\x71\xc6\x59\xb5\xa6\x31\x6d\xfc\x20\x2e\xa8\xdb\xb0\x89\x01\xc0\xab\x0f\xd9\x5a\x96\xa4\x3f\x5f\x65\x4c\xdc\x41\x62\x2c\xd4\x41\xce\x5a\xe9\xee\x77\xc6\x3f\x8a\xe8\x12\x85\xe8\x4d\xe6\x81\x09\x40\xfc\x0c\x58\x09\xce\x2a\x42\xb5\x87\x0d\x43\xe6\x4b\x5c\xe0\x55\xca\xa8\xfa\x72\xa2\xcd\x77\x3a\xbf\x55\xac\x71\x8c\x1f\x1e\x7a\x8d\x18\x20\x93\x44\xcc\x00\x6d\x65\x46\x3c\xe7\x4f\x66\xee\x15\x52\x8a\x8b\xf3\xbc\xb3\x74\x57\x6a\x55\x7b\x5e\x6e\xbc\xf1\xe4\xb6\x5d\x99\x56\xb7\x26\x3f\x21\x5e\x15\xd1\x1b\x4c\xb0\xb8\x4b\x1f\x3e\xc2\x43\xdc\xb8\xd5\xe1\xfc\x7c\x44\xdd\xee\x1f\x40\xad\x52\x99\xf3\x33\x7f\x00\x41\x3c\x6d\xf5\x09\x1a\xc5\x9a\x76\x41\x01\x78\xd5\x32\xb5\x00\x36\x2b\xac\x19\x1b\x37\x2e\xe6\x17\x66\xf9\x5b\x43\xc1\x68\xff\x7a\xd5\xff\x69\x9e\x3d\xa4\x79\xe6\xe6\x50\xb2\xd6\xe1\x01\xef\x11\x3d\x6e\xe1\x82\x62\x40\xfe\xbc\xe3\xa9\x91\x03\x06\x6f\x76\x6a\xc3\xd8\x40\x13\x23\x1f\xa4\x94\xc2\x0c\x9c\x94\x55\x43\xc9\x05\x9a\xc7\x85\x6f\x69\x95\xcb\xb5\xee\xeb\x15\x27\x10\xdb\x02\x32\x20\x24\x31\x07\xc9\x63\x52\x2b\x54\x3a\x19\x5e\xb9\xac\xa7\xbe\x65\xba\x6e\xc8\xf3\x2d\xfc\x03\x76\x16\x35\x7e\xc4\x5e\x3d\x05\x4e\x8f\x59\x9e\x63\x2f\x13\x8c\x98\x54\xcc\xf9\xf6\xce\xe5\x03\x8f\x2b\xae\xe7\xee\x4d\x6e\x25\x36\x20\x72\x10\x5c\x0f\x71\x4e\x6f\xb9\xd0\x98\xdd\x5d\x4a\x2f\x14\xe5\x0d\xdd\x1e\xc4\x8e\xe0\x99\xa0\x43\x16\xc1\x33\x73\x15\xd2\xa5\x7d\x2c\x47\x3a\xd9\x9f\xd8\xdf\xc4\x51\xac\xc3\x24\xa1\x74\xe7\x2a\xdc\xbb\x7d\x03\x07\x39\x9c\x84\xb4\xa3\xaa\x26\x5b\x42\x8c\x6c\x2d\xd2\xb6\xc2\x23\xcb\xf9\x09\x50\xc0\x48\xcb\x1d\xdd\x64\x83\x39\x2c\x02\x04\x18\x57\xc1\x3e\x6d\xab\x0a\x96\xa8\xdc\x16\x87\x9b\x5a\x24\x02\x11\xc4\x1e\xdc\x30\x91\x28\x33\xcc\x38\x6e\xde\xa0\x1c\x49\xf6\x4b\x16\x80\xe9\x6d\x51\x9f\x88\xab\x22\xd6\x27\xda\x3a\x1f\x4e\xba\x7c\x00\xf3\x6f\x88\xab\x48\xf2\x3f\x52\x7b\xaf\x88\x09\x29\x34\xd9\xf9\x2e\x7c\x6d\xba\xe7\xac\x07\xcd\x6b\x86\xd6\x65\x4c\x1c\xc2\x25\x9c\x89\xa6\x05\x0f\x9a\x86\x3d\xfd\x26\x24\x52\xc4\x21\x5b\x1a\xf7\x4a\xd6\xb9\x6a\x69\x2a\xcb\x8a\xca\x63\xa2\x35\xa3\x22\x3b\x2e\x88\x6c\x8e\x81\x3b\xe5\x08\xda\xe9\x3e\xaa\xf4\x72\x77\xef\xa6\x02\x61\x67\x2f\xe7\x9d\x77\x05\x15\xe2\x48\x08\x3b\x42\x26\x2c\x92\x9d\x9c\x65\xa1\x08\x0b\x13\x87\x9f\x87\x33\x9a\x09\x1c\x94\x23\x09\x16\x71\x51\xe2\x6f\x85\xd0\x1e\x07\x27\x89\x02\x38\x0a\x0a\x1e\xdd\x21\x31\x55\xfd\x2c\x4d\xeb\x38\x13\x54\x92\xcf\x4c\xf3\xbc\x48\x2d\xeb\xa3\x8e\x05\x86\x13\x0b\xb5\x51\x58\x5c\x32\x79\xf7\x0e\xd3\x48\x67\x1b\x74\x8a\x54\xbc\x90\x1a\xb7\x38\x68\x12\xd3\x49\x6f\x8d\x1c\xff\x94\x50\x3c\xe4\x02\x49\xdb\xa2\xec\x7b\x5e\x55\xf4\xce\x07\xf4\x75\x86\xc4\x2b\xaf\xfb\x49\xec\x51\x64\x90\xc4\x01\xf0\xbd\x8b\x48\x47\xc9\x56\x9e\x58\xf4\x1d\x9b\x96\xda\x66\xf7\x6c\x0c\x12\x82\x5c\x05\x8e\x95\x19\x8c\x9e\x9b\x87\x76\xfe\x80\xe9\xa7\x17\x87\x77\x74\xe6\x0c\xd5\x53\x94\xbd\x07\xf3\x56\xb3\x34\x59\x33\x66\xc3\xb9\x46\x8c\x07\x9b\xc1\x9d\x4f\x9d\x6d\xb9\xdf\x21\xcd\x38\x97\xc6\xb1\xa6\xe4\x60\xbd\x97\x94\x11\x79\x1e\x44\xd0\x42\xa9\xd1\xd6\x8d\x40\xa1\xaa\x39\x2e\xee\x67\xe1\x40\x9b\xb0\x17\x92\x93\xc6\xb8\x41\x25\xb9\x18\x3a\x70\x48\x3a\xce\xad\xb9\x2b\x73\x5f\x2b\x1e\x37\xac\xdf\xd6\xcf\xf2\x1f\x28\x5d\x13\x95\xc0\xe0\x2d\x26\x91\x58\x79\x17\x85\x3e\xdc\x18\x3c\x74\x03\x53\x8e\x4e\xf3\x3a\xb5\x5f\xed\xf5\xfb\x86\x06\x6a\xda\x9e\x0a\x9e\xb7\x6d\x01\xc2\x49\x54\x89\x05\xa3\xfe\xce\x92\x22\x75\xc2\xe5\x79\x99\xe6\x97\xe4\x56\xb9\x22\x1a\xe1\x13\xc5\x7f\x68\x92\xf7\x73\xab\x5c\xd6\xcd\x90\xb9\xb9\x1a\x63\x48\x90\x43\x70\xc3\xfa\x6b\x3a\x1f\x4a\x72\x3f\x97\x18\xeb\x66\x84\x29\x79\x5e\xb2\x86\xb3\xd4\x47\x6b\x60\x4b\xa8\x85\xf6\x8b\x60\x3d\x28\x3d\xb7\x21\x7b\x87\x24\xcd\x53\xf2\x47\xd8\x7b\x72\x87\x26\x04\xfd\x18\xd3\x9e\xdd\x17\x54\xc0\xa9\x9d\x71\x68\x5a\xe7\xe5\x70\x48\xc0\x74\x8a\xbd\x00\xda\x5f\x49\xf3\x35\x12\xd0\x6b\x24\xbc\xb7\x09\x44\xfb\xa0\x36\x65\x70\x4a\xe5\xce\x5e\xe1\xc5\x0d\xcc\xb9\xa5\xe9\x42\xca\xc5\x3a\xa0\xc9\x2b\xc4\xa2\xb3\xd4\x1d\xcf\xcc\x17\x39\xb4\x4a\x31\x1e\x18\x36\x06\x40\xf0\x95\x64\xdd\xea\x75\x2c\x7e\x09\x7b\xc6\x79\x8a\x92\x8a\x39\x16\x7d\x20\x3f\xed\x07\x6c\x2f\x49\x0a\x20\x7d\x57\x4c\x5e\x07\x3c\xa0\xb9\xd8\x1f\x05\xf7\xba\x23\x26\x65\x5f\xd8\xb9\xc9\x94\xa1\x62\xa6\xcc\x4a\xd2\xcb\x39\x8e\x99\xe2\x81\x7f\x2a\xf9\x30\xe7\x25\xf8\xe7\x76\x36\xd4\x7d\xbf\xf1\x07\xb9\xa1\x08\x90\x5a\xe0\x3a\x29\x0a\xee\x49\x42\x1a\xce\xb7\x52\xb3\x9f\x13\xdd\x34\x5c\x7e\xc4\xd4\x1c\xd8\x7b\x6e\x39\xaf\x48\xd2\x7b\x9d\x35\xe9\x33\x0e\x72\x2b\x15\x7c\xd6\x6c\xe9\x53\x0e\x65\xe1\xdc\xd8\x23\xbe\xca\xc0\x68\x75\x84\x91\x3c\x92\x9e\x32\x56\x4e\x8d\x39\x75\xbc\x27\x6d\x45\x11\xed\xc9\x54\xf9\x4f\x12\xf3\x77\x82\xde\x1c\x37\x5a\xe2\xe5\xee\x22\xf3\xee\xc4\xb3\x65\x07\xb0\x77\x68\x84\x13\x3d\x40\x01\xf7\xa3\x22\x19\x54\x63\x01\x85\x62\x3b\xf6\x74\x00\x47\x2d\x38\x51\x81\xd2\x50\x21\xc9\x7d\x8e\x6a\xb9\x13\x26\x25\x4d\x73\x21\x55\xad\xcb\xfc\x51\xf4\xfd\xc8\x97\xd0\xc9\x1a\xb3\x04\xff\xb7\x64\x4b\x3f\x57\x6d\xb3\x1a\xc7\x4b\xf1\xd3\xb9\x1b\x2f\x0e\xb4\x04\x8f\xe3\xa2\xe8\xb0\xbd\x18\xda\x97\x0f\xe9\x63\x49\x3c\x32\x63\xa8\x60\x22\x82\x44\xcd\xce\xfd\xea\x09\x40\xef\x5b\xe6\x87\x91\xf7\xe2\xe2\x8b\x74\xc3\xb4\x41\xc4\x5a\x71\x73\x06\x2c\xee\x88\xea\x7f\x97\x66\x4a\xb7\x05\xd9\xab\x63\xaa\x59\x5d\xf4\x16\x47\x41\x91\x82\xc0\xca\x59\xff\x7a\x9d\x9c\x02\x01\xe6\xf8\x92\xea\x19\xd4\xc6\x90\x67\xb3\x94\x7a\x73\xf3\x2c\x8d\xb9\xc8\x55\x69\x12\x33\xfd\x50\xb8\x5d\xa4\xc9\xae\xb8\xcc\x19\x3a\x1a\x2b\xc1\xbc\x71\x07\xc6\xe8\x1e\xc1\x3b\x37\xdc\x6e\xcf\x3d\xed\xf1\x6d\x52\xf9\x3c\x82\xb8\x91\xa2\xf6\x4c\x5c\xae\xc9\x63\x89\xe6\xe2\xa2\xde\x72\x6f\xda\xbf\x5a\x44\xa5\xd2\x42\xe0\xd1\x08\x4c\xb5\xcc\x60\x10\x4a\x8d\xb8\xb3\x59\x94\xe6\x29\xe1\x8a\x94\x48\x6b\x9e\xa7\xd6\x8c\x7f\xfa\x98\xd4\x70\x43\xb4\x84\x4b\xf7\xa9\xf7\x74\x4a\x98\xe4\xe9\x8c\x6a\x40\xd4\x20\x2d\x63\x6f\xb0\x5d\x07\xf6\x37\x6f\x25\x68\xad\xb7\xeb\x3f\xfc\x8f\xff\x07\x83\x5e\x0b\x0d\x6f\x66\x07\x00")
 
 func dataSpellsCompendium121XmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,27 +83,7 @@ func dataSpellsCompendium121Xml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/Spells Compendium 1.2.1.xml", size: 484962, mode: os.FileMode(493), modTime: time.Unix(1479925834, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dataDropEverythingAndStartOverSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x96\x4d\x73\xda\x3a\x17\xc7\xf7\x7c\x8a\x33\xac\xe0\x19\x77\x26\x40\x49\xdb\xc9\x3c\x0b\xc7\x88\xd4\x53\x62\x7a\x6d\x93\xb4\x2b\xa3\xd8\x27\xa0\x89\x91\x32\xb2\x68\x86\x7e\xfa\x3b\xb2\x01\xcb\xb6\x9a\xa6\xf7\x5e\x56\x8c\xcf\x4f\x7f\x9d\x37\x1d\x29\x22\x31\x3c\x0a\x89\x6c\xc3\x93\x27\x3c\x24\xe9\x16\xd3\xa7\x02\xfe\x0f\x17\x57\xbd\x59\xb8\xfc\x0a\xb1\x7b\xbd\x20\xe0\xcf\x81\x7c\xf3\xa3\x38\x02\xf0\x72\x5a\x14\xd1\x33\xe6\x79\xe1\x80\xb7\xa5\x92\xa6\x0a\xe5\x02\x7f\xa0\xfe\x50\x1a\x1c\x58\x9f\x0d\x6b\xa7\x5a\xe1\xc0\x7a\x55\xa0\x5c\x1f\x65\xef\x7c\x72\x0f\x86\xec\xda\xa3\x9c\x0b\x5e\xe9\xae\xaf\x7a\xbf\xf4\x6b\x74\xd5\xeb\x79\x21\x71\x63\x72\x74\xad\x92\x85\x41\x0f\x00\x80\x65\xd0\xf9\xf9\x41\x0c\xab\x20\xf2\x6f\x02\x32\x03\x77\x15\x2f\x13\x3f\xf0\x42\x72\x4b\x82\xd8\x29\x17\xed\x0b\x94\x9c\xee\xd0\x5c\x74\xe7\x86\xde\x67\x37\x1c\x5c\x5e\x0c\x21\x58\xc6\x10\xac\x16\x8b\x8a\x7e\xa6\x45\xf1\x22\x64\x63\xa3\x2e\x0a\x25\xfb\x35\xf4\x6f\xdd\xf0\x3b\x7c\x21\xdf\x07\x2c\x1b\xf6\x86\x6d\xe7\xcb\xd4\xbc\xe2\x7b\xec\x07\xdf\x7f\xeb\x7f\xdb\x77\xd3\xff\xe9\xc5\x10\x56\x81\xff\xd7\x8a\xb4\xc2\x78\xa0\x05\x26\xa9\xde\x3e\x39\x6f\xdc\xd9\xad\xc6\x8d\x48\x40\x87\x52\x7d\x9d\x2f\x43\xe2\xdf\x04\xd5\xd7\x86\xe2\x10\x42\x32\x27\x21\x09\x3c\x12\x55\x51\xda\x13\x50\x16\xfc\xdf\x14\xef\xb5\xe0\xc7\xd3\x69\xbb\x7a\xb9\xee\xd3\x16\x5e\xb2\xa3\x61\xf9\xbf\x49\x17\xe9\x56\x88\xfc\xcd\xe2\x29\x2d\x54\xa2\x58\xc3\xa1\x57\xf0\x6c\x2f\xa9\x62\x82\xbf\x51\x7d\x2d\x29\xdf\xe0\xfa\xcd\xce\x88\xdd\x73\xf2\x03\xe5\x03\xad\x03\xb8\x5e\x2e\x17\xc4\x0d\x6c\x64\x21\x76\x54\xb1\xf4\x0d\xe4\x8e\x2a\x94\xec\xac\x6a\x27\x4f\x50\x92\x61\x71\x12\x8d\xc9\xb7\xf8\xa4\xc3\x53\xe4\xaa\x11\xfe\x51\xa7\x02\x24\x53\x7b\xda\x4a\x7c\x03\xd0\xb2\x92\x3d\x37\xd2\xa7\xf5\xdb\x05\x14\x7b\x99\x62\xd2\xe8\x2c\xb3\xa5\x3a\xcd\x6d\xed\xed\xc1\x59\xa6\xd1\xd6\x7a\xf0\xd8\xbb\xda\x18\x7f\xbf\x6e\xed\x7f\xda\xdd\xaf\x97\x5d\xd2\xf4\x0f\x57\x14\xfa\x08\x26\xf4\x81\xe5\x4c\x1d\x92\x9d\xc8\xd8\x23\x43\x59\xfa\x66\x4c\x3d\x29\x1e\x59\xca\x90\xa7\x87\xe4\x41\xf0\x7d\x51\x07\x50\x43\x7a\x90\x26\xdd\x50\x9b\x23\xa5\xb1\xf7\x6f\x33\x7f\x94\xb4\xe6\x1d\x96\x01\xcc\xc8\x82\xc4\x04\x3c\x37\xf2\xdc\x19\xb1\x0c\xd8\xe6\x05\x55\x55\x23\xdd\xd2\x8e\x9f\xdd\xae\x68\x0d\xc7\xaa\xc5\x5a\x13\xd2\x32\x1c\x8f\xe2\xce\x79\xbd\x6d\x56\x1e\xa1\x46\x58\x66\xd3\x58\xa3\xb3\xe9\xfc\xe1\xb8\x35\x2e\xef\xe3\xd0\xad\xaa\xdf\x8c\xf2\x3f\xcb\xc5\x49\xfc\xf5\x64\x9c\xa8\x46\x10\xa5\x93\xbf\xb8\x69\xde\x1e\x75\xf9\xcc\x30\x9f\x16\xe0\x46\x10\x91\x05\xf1\x62\xf8\x1f\xcc\xc3\xe5\xed\xf1\x06\xba\xff\x4c\x42\x62\x8c\x0b\x3f\x80\xc1\xc8\x81\xb1\x03\x13\x2d\xf7\xee\x1d\xf8\x9c\x29\x46\x73\xf6\x13\x41\xec\x25\x14\x4a\x0a\xbe\x01\xe4\x8a\x29\x86\x45\xaf\xe7\x07\x11\x09\x63\x9d\xbb\xe5\xf9\x4d\xa2\x23\x3f\xbd\x2e\x9c\xf3\xcb\x61\x08\x77\xee\x62\x45\xa2\x9e\xde\xa1\xff\xf5\xf3\x75\xdf\x81\xbe\x12\x8a\xe6\xf9\x81\x0b\x55\x60\xba\x97\x38\xea\x0f\x9d\xde\x60\xec\x40\x9f\x10\x9b\x7d\x5c\xda\x27\x0e\xf4\x23\xcf\xbd\xb1\x11\x93\xfe\xb0\x77\xd5\xf4\xeb\x94\x23\x07\x2a\x8f\x5a\x57\xf6\xd1\xad\x63\x7d\x4b\xef\xae\xa9\xcc\xfa\x4e\x79\x62\x8f\x95\xd0\x16\xed\x96\x97\xa3\x64\x69\xd7\x36\x39\xdb\x60\xe0\xca\x94\x72\x3a\xec\x3b\x30\x36\x88\xf7\x06\xf1\x85\x8b\x97\x1c\xb3\x0d\xb6\xa1\xa9\x01\x2d\xd8\x63\xc7\x7e\xd9\xb0\x6f\xb6\xaa\x0d\x7c\x30\x80\x80\xaa\xbd\xec\x48\x7c\x34\x88\x18\x77\xcf\x58\x74\x44\x3e\x99\x88\x64\xe9\x13\xca\x43\x9b\x19\x5d\x18\xd0\x3d\x95\x1d\xfb\xc8\xb0\xcf\x90\xaa\x6d\x87\xd0\xf9\x9c\xc9\x3d\xb3\xa4\x7a\x34\x39\xd9\xca\x74\x2a\x96\xea\xd5\xa3\xc6\xf2\xf7\x35\xe2\x09\x5a\x45\xd1\x24\xa6\x35\x31\xc3\x02\xa5\x05\xb9\xac\x91\xb9\x90\x68\x53\xf9\x50\x23\x37\x92\x16\x45\x4e\x79\xd6\xa5\x3e\xd6\xd4\xad\xd8\x73\x45\x19\x3f\x41\x50\x53\x9f\x6a\x2a\x7a\xa1\xbb\xe7\x8e\xce\xf8\xa2\x26\x56\x3c\x43\x99\x51\xf9\xd4\xa5\xca\x23\x44\x73\x9a\x31\x6e\xe9\xd3\x71\x6d\x85\x81\xcb\xf5\x05\xa6\x8a\x32\xfd\x23\x13\x9b\x98\xd8\x0c\x7f\x08\xfd\xae\xe8\x62\xef\x4d\xec\x0e\xf9\x06\x29\x4f\xb1\xcb\x4d\x4d\x6e\x49\xd5\xf6\x41\x22\x7d\x42\xd9\x25\x2f\x4d\xd2\x93\xe2\xc5\xb2\xab\xce\x7a\xa8\x9f\x7e\xd2\x12\xa0\x4e\x76\x24\x64\x8a\xd2\x6a\xd6\x59\xbe\xa7\x32\x17\xe9\x93\xe5\xa4\x5e\xd4\xd6\xb2\xb9\xb6\x8f\x58\x75\xf7\x27\x93\x1a\x99\xd4\x9c\x61\x55\xf3\x26\x33\x36\x99\x1b\x89\x54\xc1\x32\xcf\x60\xc9\xb1\xcb\x4e\x4c\x76\xc5\xb3\x03\xe3\x9b\x2e\xa5\x73\x7d\xcf\x7e\x5a\xc7\xcf\x44\x27\x78\xae\x0f\xbd\x2d\xe8\xc9\x65\x6d\x85\x01\xc9\x33\xc9\x54\xba\x85\x2f\xfc\x34\x25\x26\x53\x93\x2e\xd3\x2b\x36\x7b\xb4\x28\x7d\x3c\xd9\x8e\xa3\x0c\xa1\x1c\x02\x85\xaa\x6a\x39\xf9\x30\xec\x5d\xfd\x1d\x00\x00\xff\xff\x38\x7a\x57\x29\x42\x0f\x00\x00")
-
-func dataDropEverythingAndStartOverSqlBytes() ([]byte, error) {
-	return bindataRead(
-		_dataDropEverythingAndStartOverSql,
-		"data/drop-everything-and-start-over.sql",
-	)
-}
-
-func dataDropEverythingAndStartOverSql() (*asset, error) {
-	bytes, err := dataDropEverythingAndStartOverSqlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "data/drop-everything-and-start-over.sql", size: 3906, mode: os.FileMode(420), modTime: time.Unix(1481361522, 0)}
+	info := bindataFileInfo{name: "data/Spells Compendium 1.2.1.xml", size: 484975, mode: os.FileMode(493), modTime: time.Unix(1479925834, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -161,8 +140,7 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"data/Spells Compendium 1.2.1.xml":        dataSpellsCompendium121Xml,
-	"data/drop-everything-and-start-over.sql": dataDropEverythingAndStartOverSql,
+	"data/Spells Compendium 1.2.1.xml": dataSpellsCompendium121Xml,
 }
 
 // AssetDir returns the file names below a certain
@@ -207,8 +185,7 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
-		"Spells Compendium 1.2.1.xml":        &bintree{dataSpellsCompendium121Xml, map[string]*bintree{}},
-		"drop-everything-and-start-over.sql": &bintree{dataDropEverythingAndStartOverSql, map[string]*bintree{}},
+		"Spells Compendium 1.2.1.xml": &bintree{dataSpellsCompendium121Xml, map[string]*bintree{}},
 	}},
 }}
 
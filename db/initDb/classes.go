@@ -2,14 +2,100 @@ package initDb
 
 import (
 	"database/sql"
+	"strings"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/murder-hobos/murder-hobos/model"
 )
 
-// Classes is a giant map of classes of the form:
-// ClassName:ClassStruct
-// It is used to make finding a class struct by name easier
-var Classes = map[string]model.Class{"Cleric (Light)": model.Class{ID: 6, Name: "Cleric (Light)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Druid (Coast)": model.Class{ID: 14, Name: "Druid (Coast)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Druid (Desert)": model.Class{ID: 15, Name: "Druid (Desert)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Druid (Mountain)": model.Class{ID: 18, Name: "Druid (Mountain)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Rogue (Arcane Trickster)": model.Class{ID: 38, Name: "Rogue (Arcane Trickster)", BaseClass: sql.NullInt64{Int64: 37, Valid: true}}, "Bard": model.Class{ID: 1, Name: "Bard", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Cleric (War)": model.Class{ID: 10, Name: "Cleric (War)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Druid (Underdark)": model.Class{ID: 20, Name: "Druid (Underdark)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Cleric (Knowledge)": model.Class{ID: 4, Name: "Cleric (Knowledge)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Cleric (Nature)": model.Class{ID: 7, Name: "Cleric (Nature)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Cleric (Tempest)": model.Class{ID: 8, Name: "Cleric (Tempest)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Cleric (Trickery)": model.Class{ID: 9, Name: "Cleric (Trickery)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Druid (Grassland)": model.Class{ID: 17, Name: "Druid (Grassland)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Paladin (Crown)": model.Class{ID: 26, Name: "Paladin (Crown)", BaseClass: sql.NullInt64{Int64: 21, Valid: true}}, "Wizard": model.Class{ID: 34, Name: "Wizard", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Cleric": model.Class{ID: 2, Name: "Cleric", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Druid (Forest)": model.Class{ID: 16, Name: "Druid (Forest)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Paladin (Ancients)": model.Class{ID: 22, Name: "Paladin (Ancients)", BaseClass: sql.NullInt64{Int64: 21, Valid: true}}, "Paladin (Devotion)": model.Class{ID: 23, Name: "Paladin (Devotion)", BaseClass: sql.NullInt64{Int64: 21, Valid: true}}, "Warlock": model.Class{ID: 29, Name: "Warlock", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Warlock (Undying)": model.Class{ID: 33, Name: "Warlock (Undying)", BaseClass: sql.NullInt64{Int64: 29, Valid: true}}, "Druid": model.Class{ID: 12, Name: "Druid", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Paladin (Vengeance)": model.Class{ID: 24, Name: "Paladin (Vengeance)", BaseClass: sql.NullInt64{Int64: 21, Valid: true}}, "Sorcerer": model.Class{ID: 28, Name: "Sorcerer", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Warlock (Fiend)": model.Class{ID: 31, Name: "Warlock (Fiend)", BaseClass: sql.NullInt64{Int64: 29, Valid: true}}, "Warlock (Great Old One)": model.Class{ID: 32, Name: "Warlock (Great Old One)", BaseClass: sql.NullInt64{Int64: 29, Valid: true}}, "Cleric (Life)": model.Class{ID: 5, Name: "Cleric (Life)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Druid (Arctic)": model.Class{ID: 13, Name: "Druid (Arctic)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Paladin": model.Class{ID: 21, Name: "Paladin", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Paladin (Oathbreaker)": model.Class{ID: 25, Name: "Paladin (Oathbreaker)", BaseClass: sql.NullInt64{Int64: 21, Valid: true}}, "Fighter": model.Class{ID: 35, Name: "Fighter", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Cleric (Arcana)": model.Class{ID: 3, Name: "Cleric (Arcana)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Cleric (Death)": model.Class{ID: 11, Name: "Cleric (Death)", BaseClass: sql.NullInt64{Int64: 2, Valid: true}}, "Druid (Swamp)": model.Class{ID: 19, Name: "Druid (Swamp)", BaseClass: sql.NullInt64{Int64: 12, Valid: true}}, "Ranger": model.Class{ID: 27, Name: "Ranger", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Fighter (Eldritch Knight)": model.Class{ID: 36, Name: "Fighter (Eldritch Knight)", BaseClass: sql.NullInt64{Int64: 35, Valid: true}}, "Rogue": model.Class{ID: 37, Name: "Rogue", BaseClass: sql.NullInt64{Int64: 0, Valid: false}}, "Warlock (Archfey)": model.Class{ID: 30, Name: "Warlock (Archfey)", BaseClass: sql.NullInt64{Int64: 29, Valid: true}}}
+// SplitClassName splits a class name of the form "Base (Subclass)"
+// into its parts. Base classes have an empty subclass.
+// Example:
+//
+//	SplitClassName("Cleric (Light)") // "Cleric", "Light"
+//	SplitClassName("Wizard")         // "Wizard", ""
+func SplitClassName(name string) (base, subclass string) {
+	name = strings.TrimSpace(name)
+	i := strings.Index(name, "(")
+	if i <= 0 || !strings.HasSuffix(name, ")") {
+		return name, ""
+	}
+	return strings.TrimSpace(name[:i]), strings.TrimSpace(name[i+1 : len(name)-1])
+}
 
-// This was generated, go fmt doesn't want to format it, I'm not going to bother.
-// I'm sorry.
+// ClassNames returns the names in the XMLSpell's string of comma
+// seperated classes, in order, without duplicates.
+func (x *XMLSpell) ClassNames() []string {
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, s := range strings.Split(x.Classes, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" || seen[strings.ToLower(s)] {
+			continue
+		}
+		seen[strings.ToLower(s)] = true
+		names = append(names, s)
+	}
+	return names
+}
+
+// ClassResolver finds classes in our db by name, creating the
+// ones that don't exist yet. Names are matched case insensitively.
+type ClassResolver struct {
+	db      sqlx.Ext
+	classes map[string]model.Class
+
+	// Created holds every class Resolve has inserted, in order
+	Created []model.Class
+}
+
+// NewClassResolver loads every class from db. db may be a
+// transaction.
+func NewClassResolver(db sqlx.Ext) (*ClassResolver, error) {
+	cs := []model.Class{}
+	if err := sqlx.Select(db, &cs, `SELECT id, name, base_class_id FROM Class`); err != nil {
+		return nil, err
+	}
+	r := &ClassResolver{db: db, classes: make(map[string]model.Class, len(cs))}
+	for _, c := range cs {
+		r.classes[strings.ToLower(c.Name)] = c
+	}
+	return r, nil
+}
+
+// Lookup returns the existing class called name
+func (r *ClassResolver) Lookup(name string) (model.Class, bool) {
+	c, ok := r.classes[strings.ToLower(strings.TrimSpace(name))]
+	return c, ok
+}
+
+// Resolve returns the class called name, inserting it if it doesn't
+// exist. A missing subclass gets its base class as base_class_id,
+// and the base class is created too if need be.
+func (r *ClassResolver) Resolve(name string) (model.Class, error) {
+	if c, ok := r.Lookup(name); ok {
+		return c, nil
+	}
+
+	c := model.Class{Name: strings.TrimSpace(name)}
+	if base, subclass := SplitClassName(name); subclass != "" {
+		b, err := r.Resolve(base)
+		if err != nil {
+			return model.Class{}, err
+		}
+		c.BaseClass = sql.NullInt64{Int64: int64(b.ID), Valid: true}
+	}
+
+	res, err := r.db.Exec(`INSERT INTO Class (name, base_class_id) VALUES (?, ?)`, c.Name, c.BaseClass)
+	if err != nil {
+		return model.Class{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return model.Class{}, err
+	}
+	c.ID = int(id)
+	r.classes[strings.ToLower(c.Name)] = c
+	r.Created = append(r.Created, c)
+	return c, nil
+}
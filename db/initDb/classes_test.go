@@ -0,0 +1,70 @@
+package initDb
+
+import (
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+func TestSplitClassName(t *testing.T) {
+	tests := []struct {
+		name, base, subclass string
+	}{
+		{"Cleric (Light)", "Cleric", "Light"},
+		{"Fighter (Eldritch Knight)", "Fighter", "Eldritch Knight"},
+		{" Wizard ", "Wizard", ""},
+		{"(Light)", "(Light)", ""},
+		{"Cleric (Light", "Cleric (Light", ""},
+	}
+	for _, tt := range tests {
+		base, subclass := SplitClassName(tt.name)
+		if base != tt.base || subclass != tt.subclass {
+			t.Errorf("SplitClassName(%q) = %q, %q, want %q, %q", tt.name, base, subclass, tt.base, tt.subclass)
+		}
+	}
+}
+
+func TestClassResolver(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	r, err := NewClassResolver(db.DB)
+	if err != nil {
+		t.Fatalf("NewClassResolver() error = %v", err)
+	}
+
+	light, err := r.Resolve("cleric (light)")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if light.ID != 6 || light.Name != "Cleric (Light)" || len(r.Created) != 0 {
+		t.Errorf("Resolve() of an existing class = %+v, created %v", light, r.Created)
+	}
+
+	beast, err := r.Resolve("Ranger (Beast Master)")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	ranger, _ := r.Lookup("Ranger")
+	if !beast.BaseClass.Valid || int(beast.BaseClass.Int64) != ranger.ID {
+		t.Errorf("Resolve() new subclass = %+v, want base class %d", beast, ranger.ID)
+	}
+
+	alchemist, err := r.Resolve("Artificer (Alchemist)")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if len(r.Created) != 3 || r.Created[1].Name != "Artificer" {
+		t.Errorf("Resolve() created %+v, want the subclasses and Artificer", r.Created)
+	}
+
+	// and they really are in the db
+	got, err := db.GetClassByName("Artificer (Alchemist)")
+	if err != nil {
+		t.Fatalf("GetClassByName() error = %v", err)
+	}
+	if got.ID != alchemist.ID || got.BaseClass != alchemist.BaseClass {
+		t.Errorf("GetClassByName() = %+v, want %+v", got, alchemist)
+	}
+}
@@ -3,11 +3,15 @@
 package main
 
 import (
-	"encoding/xml"
+	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -16,35 +20,45 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/murder-hobos/murder-hobos/db/initDb"
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+	"github.com/murder-hobos/murder-hobos/model"
 )
 
 var (
 	user, passwd, host, port, dbname string
-	dropEverythingAndInitialize      string
 	xmlBytes                         []byte
-	help                             bool
+	help, update, dryRun, jsonOut    bool
+	lint                             bool
+	sources                          []model.Source
 )
 
 const (
-	xmlFilePath = "data/Spells Compendium 1.2.1.xml"
-	sqlFilePath = "data/drop-everything-and-start-over.sql"
+	xmlFilePath = initDb.CompendiumAsset
 )
 
 func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] [compendium.xml | directory]...\n\n", os.Args[0])
+		fmt.Fprintln(os.Stderr, "Imports the bundled compendium when no files are given.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		flag.PrintDefaults()
+	}
 	flag.StringVar(&user, "u", os.Getenv("USER"), "Database user name")
 	flag.StringVar(&passwd, "p", "", "Database password")
 	flag.StringVar(&host, "h", "localhost", "Host name")
 	flag.StringVar(&port, "P", "3306", "Port number")
 	flag.StringVar(&dbname, "D", "", "Database name (required)")
+	flag.BoolVar(&update, "update", false, "Update canon spells in place instead of wiping the database")
+	flag.BoolVar(&dryRun, "dry-run", false, "Print what an import would change without changing anything")
+	flag.BoolVar(&lint, "lint", false, "Report spells that look inconsistent without touching the database")
+	flag.BoolVar(&jsonOut, "json", false, "Print the -dry-run diff or -lint report as json")
+	flag.Var(sourceFlag{canon: false}, "source", "Add a book, `ABBR=Name`, for spells that aren't canon (repeatable)")
+	flag.Var(sourceFlag{canon: true}, "canon-source", "Add a canon book, `ABBR=Name` (repeatable)")
 	flag.BoolVar(&help, "help", false, "Displays this help")
 
-	// Retrieve sql/xml info from bindata bundled with this executable
-	sqlBytes, err := initDb.Asset(sqlFilePath)
-	if err != nil {
-		log.Fatalln(err)
-	}
-	dropEverythingAndInitialize = string(sqlBytes)
-
+	// Retrieve xml info from bindata bundled with this executable
+	var err error
 	xmlBytes, err = initDb.Asset(xmlFilePath)
 	if err != nil {
 		log.Fatalln(err)
@@ -79,12 +93,27 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Make sure every file is there before going any further
+	comps, err := compendiums(flag.Args())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Linting only needs the files
+	if lint {
+		if !lintCompendiums(comps) {
+			os.Exit(1)
+		}
+		return
+	}
+
 	if dbname == "" {
 		fmt.Println("Error: Database name is required")
 		flag.Usage()
 		os.Exit(1)
 	}
-	if !confirm() {
+	// updating doesn't destroy anything, no need to ask
+	if !update && !dryRun && !confirm() {
 		os.Exit(1)
 	}
 
@@ -108,67 +137,212 @@ func main() {
 		MultiStatements: true,
 		Timeout:         time.Second * 15,
 	}
-	fmt.Println(dbconfig.FormatDSN())
+	if !jsonOut {
+		fmt.Println(dbconfig.FormatDSN())
+	}
 	db, err := sqlx.Connect("mysql", dbconfig.FormatDSN())
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	if _, err := db.Exec(dropEverythingAndInitialize); err != nil {
-		log.Fatalln(err)
+	if dryRun {
+		// Plan as if our books were added, then forget them
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		defer tx.Rollback()
+		if err := registerSources(tx); err != nil {
+			log.Fatalln(err)
+		}
+
+		reports := []initDb.Report{}
+		for _, comp := range comps {
+			c, err := comp.read()
+			if err != nil {
+				log.Fatalln(err)
+			}
+			plan, err := initDb.NewPlan(tx, c)
+			if err != nil {
+				log.Fatalf("%s: %s\n", comp.name, err.Error())
+			}
+			report := plan.Report()
+			report.File = comp.name
+			reports = append(reports, report)
+		}
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(reports); err != nil {
+				log.Fatalln(err)
+			}
+			return
+		}
+		for _, report := range reports {
+			fmt.Printf("==> %s\n", report.File)
+			fmt.Print(report)
+		}
+		return
 	}
 
-	// Have to be silly about this because range is a reserved word
-	insertSpell, err := db.PrepareNamed(`
-		INSERT INTO Spell (name, level, school, cast_time, duration,
-		` + "`range`" + `, comp_verbal, comp_somatic, comp_material, material_desc, concentration, ritual, description, source_id) 
-		VALUES 
-		(:name, :level, :school, :cast_time, :duration, :range, :comp_verbal, :comp_somatic, 
-		:comp_material, :material_desc, :concentration, :ritual, 
-		:description, :source_id);
-	`)
-	if err != nil {
+	// Start over from an empty database at our latest schema,
+	// unless we're updating, then just make sure we're at it.
+	if !update {
+		if err := migrate.Reset(db); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	if _, err := migrate.Up(db, 0); err != nil {
 		log.Fatalln(err)
 	}
-
-	insertClassSpells, err := db.Prepare(`
-		INSERT INTO ClassSpells (spell_id, class_id) VALUES (?, ?);
-	`)
-	if err != nil {
+	if err := registerSources(db); err != nil {
 		log.Fatalln(err)
 	}
 
-	var c initDb.Compendium
-	xml.Unmarshal(xmlBytes, &c)
-
-	// for each spell in our xml file
-	for _, xmlSpell := range c.XMLSpells {
-		s, err := xmlSpell.ToDbSpell()
+	// Either way, importing is an update, on an empty database
+	// everything is just added. Spells are streamed from each file
+	// as they're imported, a file at a time. Keep going if a file
+	// fails, so we know about every file that needs fixing.
+	failed := false
+	for _, comp := range comps {
+		fmt.Printf("==> %s\n", comp.name)
+		tally, err := comp.update(db)
 		if err != nil {
-			log.Fatalln("Error converting to db spell")
+			failed = true
+			fmt.Printf("failed, nothing from this file was imported: %s\n", err.Error())
+			continue
 		}
+		fmt.Println(tally)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
 
-		// Insert into Spell table
-		result, err := insertSpell.Exec(&s)
+// lintCompendiums prints a lint report for every compendium,
+// returning false if any of them have issues.
+func lintCompendiums(comps []compendium) bool {
+	reports := []initDb.LintReport{}
+	clean := true
+	for _, comp := range comps {
+		c, err := comp.read()
 		if err != nil {
 			log.Fatalln(err)
 		}
-
-		// Remember which spell we inserted so we can insert into ClassSpells
-		spellID, err := result.LastInsertId()
-		if err != nil {
+		report := initDb.Lint(c)
+		report.File = comp.name
+		reports = append(reports, report)
+		if len(report.Issues) > 0 {
+			clean = false
+		}
+	}
+	if jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
 			log.Fatalln(err)
 		}
+		return clean
+	}
+	for _, report := range reports {
+		fmt.Printf("==> %s\n", report.File)
+		fmt.Print(report)
+	}
+	return clean
+}
 
-		// Insert into ClassSpells table
-		if classes, ok := xmlSpell.ParseClasses(); ok {
-			for _, class := range classes {
-				if _, err := insertClassSpells.Exec(spellID, class.ID); err != nil {
-					log.Fatalln(err)
-				}
-			}
-		} else {
-			log.Fatalf("Error parsing classes from %v\n", xmlSpell)
+// sourceFlag adds the books given to -source or -canon-source to
+// sources
+type sourceFlag struct {
+	canon bool
+}
+
+func (f sourceFlag) String() string {
+	return ""
+}
+
+func (f sourceFlag) Set(v string) error {
+	parts := strings.SplitN(v, "=", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("%q isn't ABBR=Name", v)
+	}
+	sources = append(sources, model.Source{Abbreviation: parts[0], Name: parts[1], Canon: f.canon})
+	return nil
+}
+
+// registerSources adds every book from the command line that db
+// doesn't have yet, saying so when a book was already there
+func registerSources(db sqlx.Ext) error {
+	for _, s := range sources {
+		got, err := initDb.RegisterSource(db, s)
+		if err != nil {
+			return err
+		}
+		if got.Name != strings.TrimSpace(s.Name) || got.Canon != s.Canon {
+			fmt.Fprintf(os.Stderr, "%s is already %s (canon: %t), keeping it\n", got.Abbreviation, got.Name, got.Canon)
 		}
 	}
+	return nil
+}
+
+// compendium is a compendium file to import, or our bundled one
+type compendium struct {
+	name    string
+	bundled bool
+}
+
+// compendiums names every compendium file in args, or our bundled
+// compendium if there aren't any.
+func compendiums(args []string) ([]compendium, error) {
+	if len(args) == 0 {
+		return []compendium{{name: xmlFilePath, bundled: true}}, nil
+	}
+
+	files, err := initDb.CompendiumFiles(args)
+	if err != nil {
+		return nil, err
+	}
+	comps := make([]compendium, 0, len(files))
+	for _, file := range files {
+		comps = append(comps, compendium{name: file})
+	}
+	return comps, nil
+}
+
+func (comp compendium) open() (io.ReadCloser, error) {
+	if comp.bundled {
+		return ioutil.NopCloser(bytes.NewReader(xmlBytes)), nil
+	}
+	return os.Open(comp.name)
+}
+
+// read parses the whole compendium
+func (comp compendium) read() (*initDb.Compendium, error) {
+	r, err := comp.open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	c, err := initDb.ReadCompendium(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", comp.name, err.Error())
+	}
+	return c, nil
+}
+
+// update streams the compendium into db, printing each spell
+// that's added or changed when we're updating
+func (comp compendium) update(db *sqlx.DB) (initDb.Tally, error) {
+	r, err := comp.open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return initDb.UpdateStream(db, r, func(s *initDb.SpellUpdate) {
+		if update && s.Action != initDb.Unchanged {
+			fmt.Printf("%-9s %s\n", s.Action, s.New.Name)
+		}
+	})
 }
@@ -0,0 +1,187 @@
+package initDb
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// FieldDiff is a single changed Spell column
+type FieldDiff struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// SpellDiff is everything an update would change about one spell
+type SpellDiff struct {
+	Name           string      `json:"name"`
+	Source         string      `json:"source"`
+	Action         string      `json:"action"`
+	Fields         []FieldDiff `json:"fields,omitempty"`
+	AddedClasses   []string    `json:"added_classes,omitempty"`
+	RemovedClasses []string    `json:"removed_classes,omitempty"`
+}
+
+// Report is the diff of a whole Plan, only Added and Changed spells
+// are included.
+type Report struct {
+	// File optionally names the compendium the plan was made from
+	File      string `json:"file,omitempty"`
+	Added     int    `json:"added"`
+	Changed   int    `json:"changed"`
+	Unchanged int    `json:"unchanged"`
+	// NewClasses are classes that will be created
+	NewClasses []string    `json:"new_classes,omitempty"`
+	Spells     []SpellDiff `json:"spells"`
+}
+
+// Report returns a field level diff of every spell p would add or
+// change.
+func (p *Plan) Report() Report {
+	r := Report{
+		Added:      p.Count(Added),
+		Changed:    p.Count(Changed),
+		Unchanged:  p.Count(Unchanged),
+		NewClasses: p.NewClasses,
+		Spells:     []SpellDiff{},
+	}
+	for _, u := range p.Spells {
+		if u.Action == Unchanged {
+			continue
+		}
+		d := SpellDiff{
+			Name:   u.New.Name,
+			Source: u.Source,
+			Action: u.Action.String(),
+			Fields: diffSpells(u.Old, u.New),
+		}
+		if !equalStrings(u.OldRolls, u.NewRolls) {
+			d.Fields = append(d.Fields, FieldDiff{
+				Field: "rolls",
+				Old:   strings.Join(u.OldRolls, ", "),
+				New:   strings.Join(u.NewRolls, ", "),
+			})
+		}
+		if !equalUpcasts(u.OldUpcasts, u.NewUpcasts) {
+			d.Fields = append(d.Fields, FieldDiff{
+				Field: "upcasts",
+				Old:   upcastsString(u.OldUpcasts),
+				New:   upcastsString(u.NewUpcasts),
+			})
+		}
+		if !equalCantrips(u.OldCantrips, u.NewCantrips) {
+			d.Fields = append(d.Fields, FieldDiff{
+				Field: "cantrip scaling",
+				Old:   cantripsString(u.OldCantrips),
+				New:   cantripsString(u.NewCantrips),
+			})
+		}
+		if !equalStrings(u.OldDamage, u.NewDamage) {
+			d.Fields = append(d.Fields, FieldDiff{
+				Field: "damage types",
+				Old:   strings.Join(u.OldDamage, ", "),
+				New:   strings.Join(u.NewDamage, ", "),
+			})
+		}
+		if !equalStrings(u.OldSaves, u.NewSaves) {
+			d.Fields = append(d.Fields, FieldDiff{
+				Field: "saves",
+				Old:   strings.Join(u.OldSaves, ", "),
+				New:   strings.Join(u.NewSaves, ", "),
+			})
+		}
+		d.AddedClasses, d.RemovedClasses = diffStrings(u.OldClasses, u.NewClasses)
+		r.Spells = append(r.Spells, d)
+	}
+	return r
+}
+
+// String formats r for people, ex.
+//
+//	~ Fire Bolt (PHB)
+//	    description: "You hurl a mote." -> "You hurl a mote of fire."
+//	    classes: -Sorcerer
+//	+ Shield (PHB)
+//	    ...
+//	1 added, 1 changed, 406 unchanged
+func (r Report) String() string {
+	var b bytes.Buffer
+	for _, s := range r.Spells {
+		mark := "~"
+		if s.Action == Added.String() {
+			mark = "+"
+		}
+		fmt.Fprintf(&b, "%s %s (%s)\n", mark, s.Name, s.Source)
+		for _, f := range s.Fields {
+			if s.Action == Added.String() {
+				fmt.Fprintf(&b, "    %s: %q\n", f.Field, f.New)
+			} else {
+				fmt.Fprintf(&b, "    %s: %q -> %q\n", f.Field, f.Old, f.New)
+			}
+		}
+		if len(s.AddedClasses) > 0 || len(s.RemovedClasses) > 0 {
+			b.WriteString("    classes:")
+			for _, c := range s.AddedClasses {
+				b.WriteString(" +" + c)
+			}
+			for _, c := range s.RemovedClasses {
+				b.WriteString(" -" + c)
+			}
+			b.WriteString("\n")
+		}
+	}
+	if len(r.NewClasses) > 0 {
+		fmt.Fprintf(&b, "new classes: %s\n", strings.Join(r.NewClasses, ", "))
+	}
+	fmt.Fprintf(&b, "%d added, %d changed, %d unchanged\n", r.Added, r.Changed, r.Unchanged)
+	return b.String()
+}
+
+// diffSpells compares every column of old and new, except id, and
+// returns the ones that differ. The parsed cast time, range and
+// duration are left out since they follow from the text columns.
+func diffSpells(old, new model.Spell) []FieldDiff {
+	var fs []FieldDiff
+	o, n := reflect.ValueOf(old), reflect.ValueOf(new)
+	t := o.Type()
+	for i := 0; i < t.NumField(); i++ {
+		col := t.Field(i).Tag.Get("db")
+		if col == "id" || col == "" {
+			continue
+		}
+		ov, nv := fieldString(o.Field(i)), fieldString(n.Field(i))
+		if ov != nv {
+			fs = append(fs, FieldDiff{Field: col, Old: ov, New: nv})
+		}
+	}
+	return fs
+}
+
+func upcastsString(rs []spelltext.UpcastRule) string {
+	ss := make([]string, len(rs))
+	for i, r := range rs {
+		ss[i] = r.String()
+	}
+	return strings.Join(ss, "; ")
+}
+
+func cantripsString(rs []spelltext.CantripRule) string {
+	ss := make([]string, len(rs))
+	for i, r := range rs {
+		ss[i] = r.String()
+	}
+	return strings.Join(ss, "; ")
+}
+
+func fieldString(v reflect.Value) string {
+	if ns, ok := v.Interface().(sql.NullString); ok {
+		return ns.String
+	}
+	return fmt.Sprint(v.Interface())
+}
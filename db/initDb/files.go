@@ -0,0 +1,128 @@
+package initDb
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SpellError is an error importing a single spell, it says which
+// spell and where it is in the file.
+type SpellError struct {
+	Name string
+	Line int
+	Err  error
+}
+
+func (e *SpellError) Error() string {
+	return fmt.Sprintf("%s (line %d): %s", e.Name, e.Line, e.Err.Error())
+}
+
+// DecodeCompendium streams the <spell> elements of a <compendium>
+// document from r, calling fn with each one in turn, so the whole
+// file never has to be in memory. Each spell's Line is set to the line
+// its <spell> element starts on, and its Source to the <compendium>'s
+// source attribute. Decoding stops at the first error, either from the
+// xml or returned by fn.
+func DecodeCompendium(r io.Reader, fn func(x *XMLSpell) error) error {
+	d := xml.NewDecoder(r)
+	source := ""
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if ok && start.Name.Local == "compendium" {
+			for _, attr := range start.Attr {
+				if attr.Name.Local == "source" {
+					source = strings.TrimSpace(attr.Value)
+				}
+			}
+		}
+		if !ok || start.Name.Local != "spell" {
+			continue
+		}
+		line, _ := d.InputPos()
+		x := &XMLSpell{}
+		if err := d.DecodeElement(x, &start); err != nil {
+			return fmt.Errorf("spell at line %d: %s", line, err.Error())
+		}
+		x.Line = line
+		x.Source = source
+		if err := fn(x); err != nil {
+			return err
+		}
+	}
+}
+
+// ReadCompendium parses a <compendium> document from r
+func ReadCompendium(r io.Reader) (*Compendium, error) {
+	c := &Compendium{}
+	err := DecodeCompendium(r, func(x *XMLSpell) error {
+		c.XMLSpells = append(c.XMLSpells, *x)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ReadCompendiumFile parses the compendium xml file at path
+func ReadCompendiumFile(path string) (*Compendium, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c, err := ReadCompendium(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return c, nil
+}
+
+// CompendiumFiles expands paths into a list of compendium files. Files
+// are kept as given, directories are replaced by the .xml files directly
+// inside them, sorted by name.
+func CompendiumFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		infos, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		var found []string
+		for _, info := range infos {
+			if !info.IsDir() && strings.EqualFold(filepath.Ext(info.Name()), ".xml") {
+				found = append(found, filepath.Join(path, info.Name()))
+			}
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("%s: no .xml files", path)
+		}
+		sort.Strings(found)
+		files = append(files, found...)
+	}
+	return files, nil
+}
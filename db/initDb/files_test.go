@@ -0,0 +1,71 @@
+package initDb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompendiumFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compendiums")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	xml := `<compendium><spell><name>Fire Bolt</name><level>0</level><school>EV</school></spell></compendium>`
+	for _, name := range []string{"b.xml", "a.XML", "notes.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(xml), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	single := filepath.Join(dir, "notes.txt")
+
+	files, err := CompendiumFiles([]string{dir, single})
+	if err != nil {
+		t.Fatalf("CompendiumFiles() error = %v", err)
+	}
+	want := []string{filepath.Join(dir, "a.XML"), filepath.Join(dir, "b.xml"), single}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("CompendiumFiles() = %v, want %v", files, want)
+	}
+
+	c, err := ReadCompendiumFile(files[0])
+	if err != nil {
+		t.Fatalf("ReadCompendiumFile() error = %v", err)
+	}
+	if len(c.XMLSpells) != 1 || c.XMLSpells[0].Name != "Fire Bolt" {
+		t.Errorf("ReadCompendiumFile() = %+v", c)
+	}
+
+	if _, err := CompendiumFiles([]string{filepath.Join(dir, "missing")}); err == nil {
+		t.Error("CompendiumFiles() of a missing path returned no error")
+	}
+	if err := ioutil.WriteFile(single, []byte("<compendium>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadCompendiumFile(single); err == nil {
+		t.Error("ReadCompendiumFile() of broken xml returned no error")
+	}
+}
+
+func TestReadCompendium_Source(t *testing.T) {
+	xml := `<compendium version="5" source=" HB ">
+	<spell><name>Fire Bolt</name></spell>
+	<spell><name>Absorb Elements (EE)</name></spell>
+</compendium>`
+	c, err := ReadCompendium(strings.NewReader(xml))
+	if err != nil {
+		t.Fatalf("ReadCompendium() error = %v", err)
+	}
+	var got []string
+	for _, x := range c.XMLSpells {
+		got = append(got, x.SourceAbbreviation())
+	}
+	if want := []string{"HB", "EE"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadCompendium() sources = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,44 @@
+package initDb
+
+import (
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// Import inserts every spell in c into db, along with its ClassSpells
+// relationships, rolls, upcasting rules, cantrip scaling, damage types
+// and saves, without comparing against what's already there. db must
+// already have our schema and the compendium's sources, missing
+// classes are created as needed.
+// Like Update, it all happens in one transaction. Queries are portable
+// between mysql and sqlite.
+func Import(db *sqlx.DB, c *Compendium) error {
+	sources, err := NewSourceResolver(db)
+	if err != nil {
+		return err
+	}
+	p := &Plan{}
+	for _, xmlSpell := range c.XMLSpells {
+		s, err := sources.ToDbSpell(&xmlSpell)
+		if err != nil {
+			return err
+		}
+		u := SpellUpdate{
+			Action:      Added,
+			New:         s,
+			Source:      xmlSpell.SourceAbbreviation(),
+			NewClasses:  xmlSpell.ClassNames(),
+			NewRolls:    xmlSpell.RollExpressions(),
+			NewUpcasts:  spelltext.ParseUpcast(s.Description),
+			NewCantrips: CantripRules(s),
+			NewDamage:   spelltext.ParseDamageTypes(s.Description),
+			NewSaves:    spelltext.ParseSaves(s.Description),
+			Line:        xmlSpell.Line,
+		}
+		sort.Strings(u.NewClasses)
+		p.Spells = append(p.Spells, u)
+	}
+	return p.Apply(db)
+}
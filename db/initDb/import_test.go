@@ -0,0 +1,184 @@
+package initDb
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+func TestImport(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+
+	c := &Compendium{XMLSpells: []XMLSpell{
+		{
+			Name:       "Absorb Elements (EE)",
+			Level:      "1",
+			School:     "A",
+			Time:       "1 reaction",
+			Range:      "Self",
+			Components: "S",
+			Duration:   "1 round",
+			Classes:    "Druid, Ranger, Wizard, Fighter (Eldritch Knight)",
+			Texts:      []string{"The spell captures some of the incoming energy."},
+			Rolls:      []string{"1d6", " 2d6 "},
+		},
+	}}
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	s, err := db.GetCannonSpellByName("Absorb Elements")
+	if err != nil {
+		t.Fatalf("GetCannonSpellByName() error = %v", err)
+	}
+	ee, err := db.GetSourceByAbbreviation("EE")
+	if err != nil {
+		t.Fatalf("GetSourceByAbbreviation() error = %v", err)
+	}
+	if s.SourceID.Int64 != int64(ee.ID) || s.IsHomebrew() || s.School != "Abjuration" || !s.Somatic {
+		t.Errorf("imported spell = %+v", s)
+	}
+	cs, err := db.GetSpellClasses(s.ID)
+	if err != nil {
+		t.Fatalf("GetSpellClasses() error = %v", err)
+	}
+	if len(*cs) != 4 {
+		t.Errorf("GetSpellClasses() got %d classes, want 4", len(*cs))
+	}
+	rs, err := db.GetSpellRolls(s.ID)
+	if err != nil {
+		t.Fatalf("GetSpellRolls() error = %v", err)
+	}
+	want := []model.SpellRoll{{SpellID: s.ID, Position: 0, Expression: "1d6"}, {SpellID: s.ID, Position: 1, Expression: "2d6"}}
+	if !reflect.DeepEqual(*rs, want) {
+		t.Errorf("GetSpellRolls() = %+v, want %+v", *rs, want)
+	}
+
+	bad := &Compendium{XMLSpells: []XMLSpell{{Name: "Nope", School: "?", Source: "PHB"}}}
+	if err := Import(db.DB, bad); err == nil {
+		t.Error("Import() of a spell with an unknown school returned no error")
+	}
+}
+
+func TestImport_RollsBack(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+
+	xml := `<compendium source="PHB">
+	<spell><name>Fire Bolt</name><level>0</level><school>EV</school><classes>Wizard, Artificer</classes></spell>
+	<spell>
+		<name>Nope</name>
+		<school>?</school>
+	</spell>
+</compendium>`
+	c, err := ReadCompendium(strings.NewReader(xml))
+	if err != nil {
+		t.Fatalf("ReadCompendium() error = %v", err)
+	}
+	err = Import(db.DB, c)
+	serr, ok := err.(*SpellError)
+	if !ok {
+		t.Fatalf("Import() error = %v, want a *SpellError", err)
+	}
+	if serr.Name != "Nope" || serr.Line != 3 {
+		t.Errorf("Import() error = %q, want Nope at line 3", serr)
+	}
+
+	// Fail part way through writing, source 99 doesn't exist
+	p := &Plan{Spells: []SpellUpdate{
+		{Action: Added, New: model.Spell{Name: "Fire Bolt", Level: "0", School: "Evocation", SourceID: sql.NullInt64{Int64: 1, Valid: true}},
+			NewClasses: []string{"Artificer", "Wizard"}, Line: 2},
+		{Action: Added, New: model.Spell{Name: "Nope", Level: "0", School: "Evocation", SourceID: sql.NullInt64{Int64: 99, Valid: true}}, Line: 7},
+	}}
+	err = p.Apply(db.DB)
+	if serr, ok := err.(*SpellError); !ok || serr.Name != "Nope" || serr.Line != 7 {
+		t.Errorf("Apply() error = %v, want a *SpellError for Nope at line 7", err)
+	}
+	if _, err := db.GetCannonSpellByName("Fire Bolt"); err != model.ErrNoResult {
+		t.Errorf("Fire Bolt was imported anyway, error = %v", err)
+	}
+	if _, err := db.GetClassByName("Artificer"); err == nil {
+		t.Error("Artificer was created anyway")
+	}
+}
+
+func TestImport_Batches(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+
+	// enough ClassSpells rows to need a few batches
+	c := &Compendium{}
+	n := batchSize
+	for i := 0; i < n; i++ {
+		c.XMLSpells = append(c.XMLSpells, XMLSpell{
+			Name:    fmt.Sprintf("Spell %d", i),
+			Level:   "1",
+			School:  "EV",
+			Classes: "Wizard, Sorcerer, Bard",
+			Source:  "PHB",
+		})
+	}
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	var count int
+	if err := db.Get(&count, `SELECT COUNT(*) FROM ClassSpells`); err != nil {
+		t.Fatal(err)
+	}
+	if count != 3*n {
+		t.Errorf("Import() inserted %d ClassSpells rows, want %d", count, 3*n)
+	}
+}
+
+func TestImport_SpellBatches(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+
+	// a few batches of spells, with one repeated in the same batch,
+	// each has to get its own id and its own rolls
+	c := &Compendium{}
+	for i := 0; i < 2*spellBatchSize+1; i++ {
+		c.XMLSpells = append(c.XMLSpells, XMLSpell{
+			Name:   fmt.Sprintf("Spell %d", i),
+			Level:  "1",
+			School: "EV",
+			Rolls:  []string{fmt.Sprintf("%dd6", i+1)},
+			Source: "PHB",
+		})
+	}
+	c.XMLSpells[1].Name = "Spell 0"
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	rolls := []struct {
+		Name       string `db:"name"`
+		Expression string `db:"expression"`
+	}{}
+	if err := db.Select(&rolls, `SELECT S.name, R.expression FROM Spell AS S
+								 JOIN SpellRolls AS R ON R.spell_id = S.id ORDER BY S.id`); err != nil {
+		t.Fatal(err)
+	}
+	if len(rolls) != len(c.XMLSpells) {
+		t.Fatalf("Import() inserted %d rolls, want %d", len(rolls), len(c.XMLSpells))
+	}
+	for i, r := range rolls {
+		if want := fmt.Sprintf("%dd6", i+1); r.Name != c.XMLSpells[i].Name || r.Expression != want {
+			t.Errorf("spell %d = %s with %s, want %s with %s", i, r.Name, r.Expression, c.XMLSpells[i].Name, want)
+		}
+	}
+}
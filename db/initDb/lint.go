@@ -0,0 +1,228 @@
+package initDb
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// Lint checks, what a LintIssue is about
+const (
+	LintSchool        = "school"
+	LintLevel         = "level"
+	LintComponents    = "components"
+	LintConcentration = "concentration"
+	LintRitual        = "ritual"
+	LintClasses       = "classes"
+	LintCasting       = "casting"
+	LintSource        = "source"
+)
+
+// LintIssue is something about a compendium spell that looks wrong.
+// Nothing stops it from being imported, it's for people auditing our
+// canon data.
+type LintIssue struct {
+	Spell string `json:"spell"`
+	// Line is where the spell starts in its file, if known
+	Line    int    `json:"line,omitempty"`
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+func (i LintIssue) String() string {
+	if i.Line > 0 {
+		return fmt.Sprintf("%s (line %d): %s: %s", i.Spell, i.Line, i.Check, i.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", i.Spell, i.Check, i.Message)
+}
+
+// LintReport is every issue in one compendium
+type LintReport struct {
+	// File optionally names the compendium that was linted
+	File   string      `json:"file,omitempty"`
+	Issues []LintIssue `json:"issues"`
+}
+
+// String lists r's issues one per line, followed by a count of them
+func (r LintReport) String() string {
+	var b bytes.Buffer
+	for _, i := range r.Issues {
+		b.WriteString(i.String() + "\n")
+	}
+	fmt.Fprintf(&b, "%d issues\n", len(r.Issues))
+	return b.String()
+}
+
+// Lint checks every spell in c for flags, components, schools and
+// classes that look inconsistent, and for not saying which book it's
+// from, in compendium order.
+func Lint(c *Compendium) LintReport {
+	r := LintReport{Issues: []LintIssue{}}
+	for i := range c.XMLSpells {
+		r.Issues = append(r.Issues, c.XMLSpells[i].Lint()...)
+	}
+	r.Issues = append(r.Issues, lintClassCasing(c)...)
+	return r
+}
+
+// Lint checks x on its own, see Lint
+func (x *XMLSpell) Lint() []LintIssue {
+	var issues []LintIssue
+	add := func(check, format string, args ...interface{}) {
+		issues = append(issues, LintIssue{
+			Spell:   x.Name,
+			Line:    x.Line,
+			Check:   check,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if _, ok := schools[x.School]; !ok {
+		add(LintSchool, "unknown school %q", x.School)
+	}
+	if x.SourceAbbreviation() == "" {
+		add(LintSource, "%s", errNoSource.Error())
+	}
+	if n, err := strconv.Atoi(x.Level); err != nil || n < 0 || n > spelltext.MaxSlot {
+		add(LintLevel, "level %q isn't 0 to %d", x.Level, spelltext.MaxSlot)
+	}
+
+	// Flags are everything before the material description
+	flags, matdesc := x.Components, ""
+	if i := strings.Index(flags, "("); i >= 0 {
+		flags, matdesc = flags[:i], flags[i:]
+	}
+	hasM := strings.Contains(flags, "M")
+	for _, f := range strings.Split(flags, ",") {
+		switch f = strings.TrimSpace(f); f {
+		case "V", "S", "M":
+		default:
+			add(LintComponents, "%q in %q isn't V, S or M", f, x.Components)
+		}
+	}
+	if hasM && matdesc == "" {
+		add(LintComponents, "material component without a description")
+	}
+	if !hasM && matdesc != "" {
+		add(LintComponents, "material description without M")
+	}
+	if matdesc != "" && !strings.HasSuffix(matdesc, ")") {
+		add(LintComponents, "material description %q isn't closed", matdesc)
+	}
+	// ToDbSpell looks for the letters anywhere
+	for _, f := range []string{"V", "S", "M"} {
+		if strings.Contains(matdesc, f) && !strings.Contains(flags, f) {
+			add(LintComponents, "material description contains %q, it will be flagged as a component", f)
+		}
+	}
+
+	d := spelltext.ParseDuration(x.Duration)
+	mentions := false
+	for _, t := range x.Texts {
+		if strings.Contains(strings.ToLower(t), "concentration") {
+			mentions = true
+		}
+	}
+	if mentions && !d.Concentration {
+		add(LintConcentration, "description mentions concentration but duration %q doesn't need it", x.Duration)
+	}
+	if d.Concentration && d.DurationKind == spelltext.DurationInstantaneous {
+		add(LintConcentration, "duration %q needs concentration but is instantaneous", x.Duration)
+	}
+
+	switch x.Ritual {
+	case "", "YES":
+	default:
+		add(LintRitual, "ritual is %q, want \"YES\" or nothing", x.Ritual)
+	}
+	if x.Ritual == "YES" {
+		if x.Level == "0" {
+			add(LintRitual, "cantrips can't be rituals")
+		}
+		if u := spelltext.ParseCastTime(x.Time).CastUnit; u == spelltext.CastBonusAction || u == spelltext.CastReaction {
+			add(LintRitual, "ritual cast as a %s", u)
+		}
+	}
+
+	names := x.ClassNames()
+	if len(names) == 0 {
+		add(LintClasses, "no classes")
+	}
+	listed := 0
+	for _, s := range strings.Split(x.Classes, ",") {
+		if strings.TrimSpace(s) != "" {
+			listed++
+		}
+	}
+	if listed != len(names) {
+		add(LintClasses, "classes %q has duplicates", x.Classes)
+	}
+
+	if !strings.EqualFold(x.Time, "special") && spelltext.ParseCastTime(x.Time).CastUnit == spelltext.CastSpecial {
+		add(LintCasting, "can't make sense of casting time %q", x.Time)
+	}
+	if !strings.EqualFold(x.Range, "special") && spelltext.ParseRange(x.Range).RangeKind == spelltext.RangeSpecial {
+		add(LintCasting, "can't make sense of range %q", x.Range)
+	}
+	if !strings.EqualFold(x.Duration, "special") && d.DurationKind == spelltext.DurationSpecial {
+		add(LintCasting, "can't make sense of duration %q", x.Duration)
+	}
+	return issues
+}
+
+// lintClassCasing finds classes spelled with different capitalization
+// by different spells, they're imported as one class.
+func lintClassCasing(c *Compendium) []LintIssue {
+	spellings := make(map[string][]string)
+	first := make(map[string]*XMLSpell)
+	for i := range c.XMLSpells {
+		x := &c.XMLSpells[i]
+		for _, n := range x.ClassNames() {
+			k := strings.ToLower(n)
+			if !containsString(spellings[k], n) {
+				spellings[k] = append(spellings[k], n)
+				if len(spellings[k]) == 2 {
+					first[k] = x
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(first))
+	for k := range first {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	issues := []LintIssue{}
+	for _, k := range keys {
+		x := first[k]
+		issues = append(issues, LintIssue{
+			Spell:   x.Name,
+			Line:    x.Line,
+			Check:   LintClasses,
+			Message: fmt.Sprintf("class is spelled %s", strings.Join(quoteAll(spellings[k]), ", ")),
+		})
+	}
+	return issues
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(ss []string) []string {
+	q := make([]string, len(ss))
+	for i, s := range ss {
+		q[i] = strconv.Quote(s)
+	}
+	return q
+}
@@ -0,0 +1,80 @@
+package initDb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestXMLSpell_Lint(t *testing.T) {
+	ok := XMLSpell{
+		Name:       "Fire Bolt",
+		Level:      "0",
+		School:     "EV",
+		Time:       "1 action",
+		Range:      "120 feet",
+		Components: "V, S",
+		Duration:   "Instantaneous",
+		Classes:    "Sorcerer, Wizard",
+		Texts:      []string{"You hurl a mote of fire."},
+		Source:     "PHB",
+	}
+
+	tests := []struct {
+		name   string
+		change func(x *XMLSpell)
+		want   []string
+	}{
+		{"clean", func(x *XMLSpell) {}, nil},
+		{"school", func(x *XMLSpell) { x.School = "X" }, []string{LintSchool}},
+		{"level", func(x *XMLSpell) { x.Level = "10" }, []string{LintLevel}},
+		{"missing comma", func(x *XMLSpell) { x.Components = "V, S M (iron filings)" }, []string{LintComponents}},
+		{"no material description", func(x *XMLSpell) { x.Components = "V, S, M" }, []string{LintComponents}},
+		{"description without M", func(x *XMLSpell) { x.Components = "V (a bell)" }, []string{LintComponents}},
+		{"letters in description", func(x *XMLSpell) { x.Components = "V, M (a Silver bell)" }, []string{LintComponents}},
+		{
+			"concentration only in description",
+			func(x *XMLSpell) { x.Texts = []string{"Breaking your concentration doesn't end it."} },
+			[]string{LintConcentration},
+		},
+		{"concentration duration", func(x *XMLSpell) { x.Duration = "Concentration, up to 1 minute" }, nil},
+		{"ritual cantrip", func(x *XMLSpell) { x.Ritual = "YES" }, []string{LintRitual}},
+		{"ritual value", func(x *XMLSpell) { x.Level, x.Ritual = "1", "yes" }, []string{LintRitual}},
+		{"no classes", func(x *XMLSpell) { x.Classes = " , " }, []string{LintClasses}},
+		{"duplicate classes", func(x *XMLSpell) { x.Classes = "Wizard, wizard" }, []string{LintClasses}},
+		{"casting", func(x *XMLSpell) { x.Range = "Far away" }, []string{LintCasting}},
+		{"special", func(x *XMLSpell) { x.Range = "Special" }, nil},
+		{"no source", func(x *XMLSpell) { x.Source = "" }, []string{LintSource}},
+		{"source in name", func(x *XMLSpell) { x.Name, x.Source = "Fire Bolt (XGE)", "" }, nil},
+	}
+	for _, tt := range tests {
+		x := ok
+		tt.change(&x)
+		var got []string
+		for _, i := range x.Lint() {
+			got = append(got, i.Check)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Lint() checks = %v, want %v (%v)", tt.name, got, tt.want, x.Lint())
+		}
+	}
+}
+
+func TestLint_ClassCasing(t *testing.T) {
+	c := &Compendium{XMLSpells: []XMLSpell{
+		{Name: "Fire Bolt", Level: "0", School: "EV", Components: "V, S", Classes: "Wizard"},
+		{Name: "Light", Level: "0", School: "EV", Components: "V, M (a firefly)", Classes: "wizard", Line: 12},
+	}}
+	var classes []LintIssue
+	for _, i := range Lint(c).Issues {
+		if i.Check == LintClasses {
+			classes = append(classes, i)
+		}
+	}
+	if len(classes) != 1 {
+		t.Fatalf("Lint() classes issues = %v, want 1", classes)
+	}
+	want := `Light (line 12): classes: class is spelled "Wizard", "wizard"`
+	if got := classes[0].String(); got != want {
+		t.Errorf("Lint() issue = %q, want %q", got, want)
+	}
+}
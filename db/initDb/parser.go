@@ -10,6 +10,7 @@ import (
 	"html"
 
 	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
 	"github.com/murder-hobos/murder-hobos/util"
 )
 
@@ -29,12 +30,9 @@ var (
 )
 
 const (
-	// PHBid is the Player's Handbook id in our db
-	PHBid = 1
-	// EEid is the Elemental Evil id in our db
-	EEid = 2
-	// SCAGid is the Sword Coast Adventurer's guide id in our db
-	SCAGid = 3
+	// CompendiumAsset is the name of our bundled compendium xml
+	// file, retrievable with Asset
+	CompendiumAsset = "data/Spells Compendium 1.2.1.xml"
 )
 
 // XMLSpell represents a <spell> element from our xml file
@@ -56,9 +54,18 @@ type XMLSpell struct {
 	Duration   string   `xml:"duration"`
 	Classes    string   `xml:"classes"`
 	Texts      []string `xml:"text"`
+	Rolls      []string `xml:"roll"`
+
+	// Line is where the spell starts in its file, if known
+	Line int `xml:"-"`
+	// Source is the abbreviation of the book the spell is from when
+	// its name doesn't say, its <compendium>'s source attribute
+	Source string `xml:"-"`
 }
 
-// Compendium represents our top level <compendium> element
+// Compendium represents our top level <compendium> element. Its
+// source attribute, ex. <compendium source="PHB">, is the book spells
+// without one in their name are from, and is copied to their Source.
 type Compendium struct {
 	XMLSpells []XMLSpell `xml:"spell"`
 }
@@ -74,23 +81,16 @@ type components struct {
 // ToDbSpell parses the data from `x` into a new Spell object
 // which it returns, along with an error. In the event of an error,
 // a zero-valued Spell is returned.
+// The spell's SourceID is left for a SourceResolver to fill in, ids
+// are up to the database.
 func (x *XMLSpell) ToDbSpell() (model.Spell, error) {
 
 	// vars we need to do a little work for
 	// to convert
 	var school, desc string
-	var concentration, ritual bool
+	var ritual bool
 	var comps components
 
-	sourceID := PHBid //default to phb
-
-	if strings.Contains(x.Name, "(EE)") {
-		sourceID = EEid
-	}
-	if strings.Contains(x.Name, "(SCAG)") {
-		sourceID = SCAGid
-	}
-
 	// We want the long version, not the abbreviation
 	if s, ok := schools[x.School]; ok {
 		school = s
@@ -111,12 +111,6 @@ func (x *XMLSpell) ToDbSpell() (model.Spell, error) {
 		if text != "" {
 			b.Write([]byte(html.EscapeString(text)))
 		}
-		// This is dirty, but the file doesn't have a field
-		// for concentation, only way to find it is to see
-		// if the description mentions it.
-		if strings.Contains(text, "concentration") {
-			concentration = true
-		}
 	}
 	desc = b.String()
 
@@ -125,46 +119,46 @@ func (x *XMLSpell) ToDbSpell() (model.Spell, error) {
 	ritual = strings.Compare(x.Ritual, "YES") == 0
 
 	d := model.Spell{
-		Name:          trimSourceFromName(x.Name),
-		Level:         x.Level,
-		School:        school,
-		CastTime:      x.Time,
-		Duration:      x.Duration,
-		Range:         x.Range,
-		Verbal:        comps.Verb,
-		Somatic:       comps.Som,
-		Material:      comps.Mat,
-		MaterialDesc:  comps.Matdesc,
-		Concentration: concentration,
-		Ritual:        ritual,
-		Description:   desc,
-		SourceID:      sourceID,
+		Name:         trimSourceFromName(x.Name),
+		Level:        x.Level,
+		School:       school,
+		CastTime:     x.Time,
+		Duration:     x.Duration,
+		Range:        x.Range,
+		Verbal:       comps.Verb,
+		Somatic:      comps.Som,
+		Material:     comps.Mat,
+		MaterialDesc: comps.Matdesc,
+		Ritual:       ritual,
+		Description:  desc,
 	}
+	d.ParseText()
+	// The file doesn't have a field for concentration, but every
+	// spell that needs it says so in its duration
+	d.Concentration = d.ParsedDuration.Concentration
 
 	return d, nil
 }
 
-// ParseClasses converts the XMLSpell's string of comma seperated
-// classes into a slice of Class objects fully initialized with
-// ID and BaseClass values, ready to be inserted into our db.
-func (x *XMLSpell) ParseClasses() ([]model.Class, bool) {
-	cs := []model.Class{}
-	split := strings.Split(x.Classes, ", ")
-	for _, s := range split {
-		// here Classes is a map found in classes.go
-		// not in this file because it's long and ugly
-		if c, ok := Classes[s]; ok {
-			cs = append(cs, c)
-		} else {
-			return []model.Class{}, false
+// RollExpressions returns the spell's <roll> dice expressions,
+// ex. "10d8", in the order they appear.
+func (x *XMLSpell) RollExpressions() []string {
+	rolls := []string{}
+	for _, r := range x.Rolls {
+		if r = strings.TrimSpace(r); r != "" {
+			rolls = append(rolls, r)
 		}
 	}
-	return cs, true
+	return rolls
 }
 
-func trimSourceFromName(name string) string {
-	s := strings.NewReplacer(" (EE)", "", " (SCAG)", "")
-	return s.Replace(name)
+// CantripRules returns how s scales with character level, only
+// cantrips do.
+func CantripRules(s model.Spell) []spelltext.CantripRule {
+	if s.Level != "0" {
+		return nil
+	}
+	return spelltext.ParseCantrip(s.Description)
 }
 
 // parseComponents parses the information in the xml file's Components
@@ -9,6 +9,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
 )
 
 func TestXMLSpell_ToDbSpell(t *testing.T) {
@@ -62,10 +63,12 @@ func TestXMLSpell_ToDbSpell(t *testing.T) {
 					String: "",
 					Valid:  false,
 				},
-				Concentration: false,
-				Ritual:        false,
-				Description:   "You create a magical zone that guards against deception in a 15-foot-radius sphere centered on a point of your choice within range. Until the spell ends, a creature that enters the spell&#39;s area for the first time on a turn or starts its turn there must make a Charisma saving throw. On a failed save, a creature can&#39;t speak a deliberate lie while in the radius. You know whether each creature succeeds or fails on its saving throw.<br/><br/>An affected creature is aware of the spell and can thus avoid answering questions to which it would normally respond with a lie. Such creatures can be evasive in its answers as long as it remains within the boundaries of the truth.",
-				SourceID:      PHBid,
+				Concentration:  false,
+				Ritual:         false,
+				Description:    "You create a magical zone that guards against deception in a 15-foot-radius sphere centered on a point of your choice within range. Until the spell ends, a creature that enters the spell&#39;s area for the first time on a turn or starts its turn there must make a Charisma saving throw. On a failed save, a creature can&#39;t speak a deliberate lie while in the radius. You know whether each creature succeeds or fails on its saving throw.\n\nAn affected creature is aware of the spell and can thus avoid answering questions to which it would normally respond with a lie. Such creatures can be evasive in its answers as long as it remains within the boundaries of the truth.",
+				ParsedCastTime: spelltext.ParsedCastTime{CastAmount: 1, CastUnit: "action", CastSeconds: 6},
+				ParsedRange:    spelltext.ParsedRange{RangeKind: "feet", RangeDistance: 60, RangeFeet: 60},
+				ParsedDuration: spelltext.ParsedDuration{DurationKind: "minute", DurationAmount: 10, DurationSeconds: 600},
 			},
 			false,
 		},
@@ -104,8 +107,15 @@ func TestXMLSpell_ToDbSpell(t *testing.T) {
 				},
 				Concentration: false,
 				Ritual:        false,
-				Description:   "The spell captures some of the incoming energy, lessening its effect on you and storing it for your next melee attack. You have resistance to the triggering damage type until the start of your next turn. Also, the first time you hit with a melee attack on your next turn, the target takes an extra 1d6 damage of the triggering type, and the spell ends.<br/><br/>At Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, the extra damage increases by 1d6 for each slot level above 1st.<br/><br/>This spell can be found in the Elemental Evil Player&#39;s Companion",
-				SourceID:      EEid,
+				Description:   "The spell captures some of the incoming energy, lessening its effect on you and storing it for your next melee attack. You have resistance to the triggering damage type until the start of your next turn. Also, the first time you hit with a melee attack on your next turn, the target takes an extra 1d6 damage of the triggering type, and the spell ends.\n\nAt Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, the extra damage increases by 1d6 for each slot level above 1st.\n\nThis spell can be found in the Elemental Evil Player&#39;s Companion",
+				ParsedCastTime: spelltext.ParsedCastTime{
+					CastAmount:  1,
+					CastUnit:    "reaction",
+					CastTrigger: "which you take when you take acid, cold, fire, lightning, or thunder damage",
+					CastSeconds: 6,
+				},
+				ParsedRange:    spelltext.ParsedRange{RangeKind: "special"},
+				ParsedDuration: spelltext.ParsedDuration{DurationKind: "round", DurationAmount: 1, DurationSeconds: 6},
 			},
 			false,
 		},
@@ -135,142 +145,60 @@ func TestXMLSpell_ToDbSpell(t *testing.T) {
 	}
 }
 
-func TestXMLSpell_ParseClasses(t *testing.T) {
-	type fields struct {
-		Name       string
-		Level      string
-		School     string
-		Ritual     string
-		Time       string
-		Range      string
-		Components string
-		Duration   string
-		Classes    string
-		Texts      []string
+func TestXMLSpell_ToDbSpell_Concentration(t *testing.T) {
+	tests := []struct {
+		duration string
+		texts    []string
+		want     bool
+	}{
+		{"Concentration, up to 1 minute", []string{"A flurry of blades."}, true},
+		// Mentioning concentration doesn't need it
+		{"Until dispelled or triggered", []string{"If a creature breaks your concentration, the glyph stays."}, false},
+		{"Instantaneous", nil, false},
 	}
+	for _, tt := range tests {
+		x := XMLSpell{Name: "Test", Level: "1", School: "EV", Duration: tt.duration, Texts: tt.texts}
+		got, err := x.ToDbSpell()
+		if err != nil {
+			t.Fatalf("ToDbSpell() error = %v", err)
+		}
+		if got.Concentration != tt.want {
+			t.Errorf("ToDbSpell() with duration %q Concentration = %v, want %v", tt.duration, got.Concentration, tt.want)
+		}
+	}
+}
+
+func TestXMLSpell_ClassNames(t *testing.T) {
 	tests := []struct {
-		name   string
-		fields fields
-		want   []model.Class
-		want1  bool
+		name    string
+		classes string
+		want    []string
 	}{
 		{
 			"3 classes",
-			fields{
-				Classes: "Cleric, Cleric (Arcana), Druid",
-			},
-			[]model.Class{
-				model.Class{
-					ID:   2,
-					Name: "Cleric",
-					BaseClass: sql.NullInt64{
-						Int64: 0,
-						Valid: false,
-					},
-				},
-				model.Class{
-					ID:   3,
-					Name: "Cleric (Arcana)",
-					BaseClass: sql.NullInt64{
-						Int64: 2,
-						Valid: true,
-					},
-				},
-				model.Class{
-					ID:   12,
-					Name: "Druid",
-					BaseClass: sql.NullInt64{
-						Int64: 0,
-						Valid: false,
-					},
-				},
-			},
-			true,
+			"Cleric, Cleric (Arcana), Druid",
+			[]string{"Cleric", "Cleric (Arcana)", "Druid"},
 		},
 		{
 			"No classes",
-			fields{},
-			[]model.Class{},
-			false,
+			"",
+			[]string{},
 		},
 		{
 			"One class",
-			fields{
-				Classes: "Bard",
-			},
-			[]model.Class{
-				model.Class{
-					ID:   1,
-					Name: "Bard",
-					BaseClass: sql.NullInt64{
-						Int64: 0,
-						Valid: false,
-					},
-				},
-			},
-			true,
+			"Bard",
+			[]string{"Bard"},
 		},
 		{
-			"Absorb Elements (EE)",
-			fields{
-				Classes: "Druid, Ranger, Wizard, Fighter (Eldritch Knight)",
-			},
-			[]model.Class{
-				model.Class{
-					ID:   12,
-					Name: "Druid",
-					BaseClass: sql.NullInt64{
-						Int64: 0,
-						Valid: false,
-					},
-				},
-				model.Class{
-					ID:   27,
-					Name: "Ranger",
-					BaseClass: sql.NullInt64{
-						Int64: 0,
-						Valid: false,
-					},
-				},
-				model.Class{
-					ID:   34,
-					Name: "Wizard",
-					BaseClass: sql.NullInt64{
-						Int64: 0,
-						Valid: false,
-					},
-				},
-				model.Class{
-					ID:   36,
-					Name: "Fighter (Eldritch Knight)",
-					BaseClass: sql.NullInt64{
-						Int64: 35,
-						Valid: true,
-					},
-				},
-			},
-			true,
+			"Sloppy spacing and duplicates",
+			" Wizard,Druid (Coast) ,, wizard",
+			[]string{"Wizard", "Druid (Coast)"},
 		},
 	}
 	for _, tt := range tests {
-		x := &XMLSpell{
-			Name:       tt.fields.Name,
-			Level:      tt.fields.Level,
-			School:     tt.fields.School,
-			Ritual:     tt.fields.Ritual,
-			Time:       tt.fields.Time,
-			Range:      tt.fields.Range,
-			Components: tt.fields.Components,
-			Duration:   tt.fields.Duration,
-			Classes:    tt.fields.Classes,
-			Texts:      tt.fields.Texts,
-		}
-		got, got1 := x.ParseClasses()
-		if !reflect.DeepEqual(got, tt.want) {
-			t.Errorf("%q. XMLSpell.ParseClasses() got = %v, want %v", tt.name, got, tt.want)
-		}
-		if got1 != tt.want1 {
-			t.Errorf("%q. XMLSpell.ParseClasses() got1 = %v, want %v", tt.name, got1, tt.want1)
+		x := &XMLSpell{Classes: tt.classes}
+		if got := x.ClassNames(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q. XMLSpell.ClassNames() = %v, want %v", tt.name, got, tt.want)
 		}
 	}
 }
@@ -0,0 +1,131 @@
+package initDb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+// sourceSuffix is a book's abbreviation at the end of a spell's name,
+// ex. "Absorb Elements (EE)"
+var sourceSuffix = regexp.MustCompile(`\s*\(([A-Za-z0-9]+)\)$`)
+
+// sourceAbbreviation is what a book's abbreviation can be, anything
+// else couldn't be matched by sourceSuffix
+var sourceAbbreviation = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// errNoSource is what's wrong with a spell we don't know the book of
+var errNoSource = errors.New("doesn't say which book it's from, end its name with one, ex. (PHB), or give its <compendium> a source attribute")
+
+// splitSourceFromName splits a spell's name from the abbreviation of
+// the book it's from, "Absorb Elements (EE)" is "Absorb Elements" and
+// "EE". A name without one has the abbreviation "".
+func splitSourceFromName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	m := sourceSuffix.FindStringSubmatchIndex(name)
+	if m == nil {
+		return name, ""
+	}
+	return name[:m[0]], name[m[2]:m[3]]
+}
+
+// trimSourceFromName takes a book's abbreviation off the end of a
+// spell's name, "Absorb Elements (EE)" is just "Absorb Elements"
+func trimSourceFromName(name string) string {
+	name, _ = splitSourceFromName(name)
+	return name
+}
+
+// SourceAbbreviation returns the abbreviation of the book the spell is
+// from, going by its name, or by its Source if its name doesn't say.
+// "" means we don't know.
+func (x *XMLSpell) SourceAbbreviation() string {
+	if _, abbr := splitSourceFromName(x.Name); abbr != "" {
+		return abbr
+	}
+	return strings.TrimSpace(x.Source)
+}
+
+// RegisterSource adds the book s to db, unless there's already one
+// abbreviated s.Abbreviation, returning the book db has either way.
+// Abbreviations are what spells end their names with, so they're
+// letters and numbers only. db may be a transaction.
+func RegisterSource(db sqlx.Ext, s model.Source) (model.Source, error) {
+	s.Abbreviation = strings.TrimSpace(s.Abbreviation)
+	s.Name = strings.TrimSpace(s.Name)
+	if !sourceAbbreviation.MatchString(s.Abbreviation) || s.Name == "" {
+		return model.Source{}, fmt.Errorf("source %q (%s) needs a name and an abbreviation of letters and numbers", s.Name, s.Abbreviation)
+	}
+
+	existing := model.Source{}
+	err := sqlx.Get(db, &existing, `SELECT * FROM Source WHERE LOWER(abbreviation) = LOWER(?)`, s.Abbreviation)
+	if err == nil {
+		return existing, nil
+	}
+	if err != sql.ErrNoRows {
+		return model.Source{}, err
+	}
+	result, err := db.Exec(`INSERT INTO Source (name, abbreviation, publisher, canon, released) VALUES (?, ?, ?, ?, ?)`,
+		s.Name, s.Abbreviation, s.Publisher, s.Canon, s.Released)
+	if err != nil {
+		return model.Source{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return model.Source{}, err
+	}
+	s.ID = int(id)
+	return s, nil
+}
+
+// SourceResolver finds sources in our db by abbreviation, matched
+// case insensitively
+type SourceResolver struct {
+	sources map[string]model.Source
+}
+
+// NewSourceResolver loads every source from db. db may be a
+// transaction.
+func NewSourceResolver(db sqlx.Queryer) (*SourceResolver, error) {
+	ss := []model.Source{}
+	if err := sqlx.Select(db, &ss, `SELECT * FROM Source`); err != nil {
+		return nil, err
+	}
+	r := &SourceResolver{sources: make(map[string]model.Source, len(ss))}
+	for _, s := range ss {
+		r.sources[strings.ToLower(s.Abbreviation)] = s
+	}
+	return r, nil
+}
+
+// Lookup returns the source abbreviated abbr
+func (r *SourceResolver) Lookup(abbr string) (model.Source, bool) {
+	s, ok := r.sources[strings.ToLower(strings.TrimSpace(abbr))]
+	return s, ok
+}
+
+// ToDbSpell is XMLSpell.ToDbSpell with the spell's SourceID filled in.
+// Errors are SpellErrors, a spell that doesn't say which book it's
+// from, or is from a book that isn't in our db, is one.
+func (r *SourceResolver) ToDbSpell(x *XMLSpell) (model.Spell, error) {
+	s, err := x.ToDbSpell()
+	if err != nil {
+		return model.Spell{}, &SpellError{Name: x.Name, Line: x.Line, Err: err}
+	}
+	abbr := x.SourceAbbreviation()
+	if abbr == "" {
+		return model.Spell{}, &SpellError{Name: x.Name, Line: x.Line, Err: errNoSource}
+	}
+	source, ok := r.Lookup(abbr)
+	if !ok {
+		return model.Spell{}, &SpellError{Name: x.Name, Line: x.Line,
+			Err: fmt.Errorf("source %s isn't in the database", abbr)}
+	}
+	s.SourceID = sql.NullInt64{Int64: int64(source.ID), Valid: true}
+	return s, nil
+}
@@ -0,0 +1,121 @@
+package initDb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+func TestXMLSpell_SourceAbbreviation(t *testing.T) {
+	tests := []struct {
+		name, source, abbr, trimmed string
+	}{
+		{"Fireball", "PHB", "PHB", "Fireball"},
+		{"Fireball", "", "", "Fireball"},
+		{"Absorb Elements (EE)", "PHB", "EE", "Absorb Elements"},
+		{"Booming Blade (SCAG) ", "", "SCAG", "Booming Blade"},
+		{"Toll the Dead (XGE)", "", "XGE", "Toll the Dead"},
+		{"Tasha's Hideous Laughter", "PHB", "PHB", "Tasha's Hideous Laughter"},
+	}
+	for _, tt := range tests {
+		x := &XMLSpell{Name: tt.name, Source: tt.source}
+		if got := x.SourceAbbreviation(); got != tt.abbr {
+			t.Errorf("XMLSpell{Name: %q, Source: %q}.SourceAbbreviation() = %q, want %q", tt.name, tt.source, got, tt.abbr)
+		}
+		if got := trimSourceFromName(tt.name); got != tt.trimmed {
+			t.Errorf("trimSourceFromName(%q) = %q, want %q", tt.name, got, tt.trimmed)
+		}
+	}
+}
+
+func TestSourceResolver(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	r, err := NewSourceResolver(db.DB)
+	if err != nil {
+		t.Fatalf("NewSourceResolver() error = %v", err)
+	}
+
+	for _, b := range migrate.CanonBooks {
+		got, ok := r.Lookup(strings.ToLower(b.Abbreviation))
+		if !ok || got.ID != b.ID || got.Name != b.Name || !got.Canon {
+			t.Errorf("Lookup(%q) = %+v, want %+v", b.Abbreviation, got, b)
+		}
+	}
+	if _, ok := r.Lookup("UA"); ok {
+		t.Error("Lookup(UA) found a source we don't have")
+	}
+	_, err = r.ToDbSpell(&XMLSpell{Name: "Toll the Dead (XGE)", Level: "0", School: "N", Line: 9})
+	if serr, ok := err.(*SpellError); !ok || serr.Line != 9 {
+		t.Errorf("ToDbSpell() from a book we don't have error = %v, want a *SpellError", err)
+	}
+	if _, err := db.Exec(`INSERT INTO Source (name, abbreviation) VALUES ('Xanathar''s Guide to Everything', 'XGE')`); err != nil {
+		t.Fatal(err)
+	}
+	r, err = NewSourceResolver(db.DB)
+	if err != nil {
+		t.Fatalf("NewSourceResolver() error = %v", err)
+	}
+	s, err := r.ToDbSpell(&XMLSpell{Name: "Toll the Dead (XGE)", Level: "0", School: "N"})
+	if err != nil || s.Name != "Toll the Dead" || s.SourceID.Int64 != 4 {
+		t.Errorf("ToDbSpell() = %+v, %v, want Toll the Dead from XGE", s, err)
+	}
+	if canon, _ := r.Lookup("XGE"); canon.Canon {
+		t.Error("Lookup(XGE) is canon, sources aren't unless they say so")
+	}
+
+	s, err = r.ToDbSpell(&XMLSpell{Name: "Booming Blade (SCAG)", Level: "0", School: "EV"})
+	if err != nil || s.Name != "Booming Blade" || !s.SourceID.Valid || s.SourceID.Int64 != 3 {
+		t.Errorf("ToDbSpell() = %+v, %v", s, err)
+	}
+	if _, err := db.Exec(`DELETE FROM Source WHERE abbreviation = 'SCAG'`); err != nil {
+		t.Fatal(err)
+	}
+	r, err = NewSourceResolver(db.DB)
+	if err != nil {
+		t.Fatalf("NewSourceResolver() error = %v", err)
+	}
+	_, err = r.ToDbSpell(&XMLSpell{Name: "Booming Blade (SCAG)", Level: "0", School: "EV", Line: 4})
+	if serr, ok := err.(*SpellError); !ok || serr.Line != 4 {
+		t.Errorf("ToDbSpell() from a missing source error = %v, want a *SpellError", err)
+	}
+	_, err = r.ToDbSpell(&XMLSpell{Name: "Fireball", Level: "3", School: "EV", Line: 5})
+	if serr, ok := err.(*SpellError); !ok || serr.Err != errNoSource {
+		t.Errorf("ToDbSpell() without a source error = %v, want errNoSource", err)
+	}
+}
+
+func TestRegisterSource(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+
+	s, err := RegisterSource(db.DB, model.Source{Name: "Bob's Homebrew", Abbreviation: " HB "})
+	if err != nil {
+		t.Fatalf("RegisterSource() error = %v", err)
+	}
+	if s.ID != 4 || s.Abbreviation != "HB" || s.Canon {
+		t.Errorf("RegisterSource() = %+v, want a new non-canon book HB", s)
+	}
+	got, err := db.GetSourceByAbbreviation("hb")
+	if err != nil || got.ID != s.ID || got.Name != "Bob's Homebrew" {
+		t.Errorf("GetSourceByAbbreviation(hb) = %+v, %v", got, err)
+	}
+
+	// already there, nothing changes
+	s, err = RegisterSource(db.DB, model.Source{Name: "Player's Handbook 2", Abbreviation: "phb", Canon: false})
+	if err != nil || s.ID != 1 || s.Name != "Player's Handbook" || !s.Canon {
+		t.Errorf("RegisterSource(phb) = %+v, %v, want the Player's Handbook", s, err)
+	}
+
+	for _, bad := range []model.Source{{Name: "Spaced Out", Abbreviation: "S O"}, {Name: "", Abbreviation: "NN"}, {Name: "Brackets", Abbreviation: "(B)"}} {
+		if _, err := RegisterSource(db.DB, bad); err == nil {
+			t.Errorf("RegisterSource(%+v) returned no error", bad)
+		}
+	}
+}
@@ -0,0 +1,922 @@
+package initDb
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// Action is what an update will do with a single compendium spell
+type Action int
+
+const (
+	// Unchanged spells already match the compendium
+	Unchanged Action = iota
+	// Added spells aren't in the database yet
+	Added
+	// Changed spells are in the database, but their fields or
+	// classes differ from the compendium
+	Changed
+)
+
+func (a Action) String() string {
+	switch a {
+	case Added:
+		return "added"
+	case Changed:
+		return "changed"
+	default:
+		return "unchanged"
+	}
+}
+
+// SpellUpdate describes what an update does to a single book spell.
+// Old and OldClasses are the spell's current state in the database,
+// and are zero valued for Added spells. New and NewClasses are the
+// state described by the compendium. Classes are sorted names, rolls
+// are dice expressions, upcasts are "At Higher Levels" rules and
+// cantrips are how a cantrip scales with character level, all in the
+// order they appear in the spell. Damage types and saves are sorted
+// names.
+type SpellUpdate struct {
+	Action Action
+	// Source is the abbreviation of the book the spell is from
+	Source      string
+	Old         model.Spell
+	New         model.Spell
+	OldClasses  []string
+	NewClasses  []string
+	OldRolls    []string
+	NewRolls    []string
+	OldUpcasts  []spelltext.UpcastRule
+	NewUpcasts  []spelltext.UpcastRule
+	OldCantrips []spelltext.CantripRule
+	NewCantrips []spelltext.CantripRule
+	OldDamage   []string
+	NewDamage   []string
+	OldSaves    []string
+	NewSaves    []string
+
+	// Line is where the spell is in the compendium
+	Line int
+}
+
+// Plan is the set of changes needed to bring a database's book spells
+// in line with a compendium, in compendium order.
+type Plan struct {
+	Spells []SpellUpdate
+
+	// NewClasses are the classes the compendium uses that aren't in
+	// the database yet, and will be created, in the order found.
+	NewClasses []string
+}
+
+// Count returns the number of spells in the plan with Action a
+func (p *Plan) Count(a Action) int {
+	n := 0
+	for _, s := range p.Spells {
+		if s.Action == a {
+			n++
+		}
+	}
+	return n
+}
+
+// Summary returns a one line description of the plan, ex.
+//
+//	"3 added, 1 changed, 404 unchanged"
+func (p *Plan) Summary() string {
+	return Tally{Added: p.Count(Added), Changed: p.Count(Changed), Unchanged: p.Count(Unchanged)}.String()
+}
+
+// Tally counts spells by what an update did with them, for when
+// there's no Plan to ask.
+type Tally map[Action]int
+
+// String returns a one line description of the tally, the same as
+// Plan.Summary.
+func (t Tally) String() string {
+	return fmt.Sprintf("%d added, %d changed, %d unchanged",
+		t[Added], t[Changed], t[Unchanged])
+}
+
+// spellKey identifies a spell from a book. Names are compared case
+// insensitively, the same way mysql does.
+type spellKey struct {
+	name     string
+	sourceID int64
+}
+
+func keyOf(s model.Spell) spellKey {
+	return spellKey{strings.ToLower(s.Name), s.SourceID.Int64}
+}
+
+// NewPlan compares the spells in c against the spells from books in
+// db, canon or not, matching them up by name and source. Homebrew
+// spells, users and characters are never considered. db may be a
+// transaction.
+func NewPlan(db sqlx.Ext, c *Compendium) (*Plan, error) {
+	pl, err := newPlanner(db)
+	if err != nil {
+		return nil, err
+	}
+	p := &Plan{}
+	for i := range c.XMLSpells {
+		u, err := pl.plan(&c.XMLSpells[i])
+		if err != nil {
+			return nil, err
+		}
+		p.Spells = append(p.Spells, u)
+	}
+	p.NewClasses = pl.newClasses
+	return p, nil
+}
+
+// planner works out the SpellUpdate for one compendium spell at a
+// time, against the book spells in a database as they were when it
+// was created.
+type planner struct {
+	spells     map[spellKey]model.Spell
+	classNames map[int][]string
+	rolls      map[int][]string
+	upcasts    map[int][]spelltext.UpcastRule
+	cantrips   map[int][]spelltext.CantripRule
+	damage     map[int][]string
+	saves      map[int][]string
+
+	classes *ClassResolver
+	sources *SourceResolver
+
+	// newClasses are the classes spells have used that aren't in the
+	// database, in the order found
+	newClasses []string
+	found      map[string]bool
+}
+
+// newPlanner loads every book spell in db and what hangs off them.
+// db may be a transaction.
+func newPlanner(db sqlx.Ext) (*planner, error) {
+	existing := []model.Spell{}
+	if err := sqlx.Select(db, &existing, `SELECT * FROM Spell WHERE source_id IS NOT NULL`); err != nil {
+		return nil, err
+	}
+	pl := &planner{
+		spells:     make(map[spellKey]model.Spell, len(existing)),
+		classNames: make(map[int][]string),
+		rolls:      make(map[int][]string),
+		upcasts:    make(map[int][]spelltext.UpcastRule),
+		cantrips:   make(map[int][]spelltext.CantripRule),
+		damage:     make(map[int][]string),
+		saves:      make(map[int][]string),
+		found:      make(map[string]bool),
+	}
+	for _, s := range existing {
+		pl.spells[keyOf(s)] = s
+	}
+
+	classSpells := []struct {
+		SpellID int    `db:"spell_id"`
+		Class   string `db:"name"`
+	}{}
+	if err := sqlx.Select(db, &classSpells, `SELECT CS.spell_id, C.name
+											 FROM ClassSpells AS CS
+											 JOIN Spell AS S ON CS.spell_id = S.id
+											 JOIN Class AS C ON CS.class_id = C.id
+											 WHERE S.source_id IS NOT NULL`); err != nil {
+		return nil, err
+	}
+	for _, cs := range classSpells {
+		pl.classNames[cs.SpellID] = append(pl.classNames[cs.SpellID], cs.Class)
+	}
+
+	spellRolls := []model.SpellRoll{}
+	if err := sqlx.Select(db, &spellRolls, `SELECT R.spell_id, R.position, R.expression
+											FROM SpellRolls AS R
+											JOIN Spell AS S ON R.spell_id = S.id
+											WHERE S.source_id IS NOT NULL
+											ORDER BY R.spell_id, R.position`); err != nil {
+		return nil, err
+	}
+	for _, r := range spellRolls {
+		pl.rolls[r.SpellID] = append(pl.rolls[r.SpellID], r.Expression)
+	}
+
+	spellUpcasts := []model.SpellUpcast{}
+	if err := sqlx.Select(db, &spellUpcasts, `SELECT U.*
+											  FROM SpellUpcasts AS U
+											  JOIN Spell AS S ON U.spell_id = S.id
+											  WHERE S.source_id IS NOT NULL
+											  ORDER BY U.spell_id, U.position`); err != nil {
+		return nil, err
+	}
+	for _, u := range spellUpcasts {
+		pl.upcasts[u.SpellID] = append(pl.upcasts[u.SpellID], u.UpcastRule)
+	}
+
+	cantripScaling := []model.CantripScaling{}
+	if err := sqlx.Select(db, &cantripScaling, `SELECT C.*
+												FROM CantripScaling AS C
+												JOIN Spell AS S ON C.spell_id = S.id
+												WHERE S.source_id IS NOT NULL
+												ORDER BY C.spell_id, C.position`); err != nil {
+		return nil, err
+	}
+	for _, c := range cantripScaling {
+		pl.cantrips[c.SpellID] = append(pl.cantrips[c.SpellID], c.CantripRule)
+	}
+
+	spellDamage := []model.SpellDamageType{}
+	if err := sqlx.Select(db, &spellDamage, `SELECT D.spell_id, D.damage_type
+											 FROM SpellDamageTypes AS D
+											 JOIN Spell AS S ON D.spell_id = S.id
+											 WHERE S.source_id IS NOT NULL
+											 ORDER BY D.spell_id, D.damage_type`); err != nil {
+		return nil, err
+	}
+	for _, d := range spellDamage {
+		pl.damage[d.SpellID] = append(pl.damage[d.SpellID], d.DamageType)
+	}
+
+	spellSaves := []model.SpellSave{}
+	if err := sqlx.Select(db, &spellSaves, `SELECT V.spell_id, V.ability
+											FROM SpellSaves AS V
+											JOIN Spell AS S ON V.spell_id = S.id
+											WHERE S.source_id IS NOT NULL
+											ORDER BY V.spell_id, V.ability`); err != nil {
+		return nil, err
+	}
+	for _, v := range spellSaves {
+		pl.saves[v.SpellID] = append(pl.saves[v.SpellID], v.Ability)
+	}
+
+	var err error
+	if pl.classes, err = NewClassResolver(db); err != nil {
+		return nil, err
+	}
+	if pl.sources, err = NewSourceResolver(db); err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+// plan compares a single compendium spell against the database
+func (pl *planner) plan(xmlSpell *XMLSpell) (SpellUpdate, error) {
+	s, err := pl.sources.ToDbSpell(xmlSpell)
+	if err != nil {
+		return SpellUpdate{}, err
+	}
+
+	u := SpellUpdate{
+		New:         s,
+		Source:      xmlSpell.SourceAbbreviation(),
+		NewRolls:    xmlSpell.RollExpressions(),
+		NewUpcasts:  spelltext.ParseUpcast(s.Description),
+		NewCantrips: CantripRules(s),
+		NewDamage:   spelltext.ParseDamageTypes(s.Description),
+		NewSaves:    spelltext.ParseSaves(s.Description),
+		Line:        xmlSpell.Line,
+	}
+	for _, name := range xmlSpell.ClassNames() {
+		// Use our spelling of classes we already have
+		if class, ok := pl.classes.Lookup(name); ok {
+			u.NewClasses = append(u.NewClasses, class.Name)
+			continue
+		}
+		u.NewClasses = append(u.NewClasses, name)
+
+		// A new subclass can bring a new base class with it
+		names := []string{name}
+		if base, subclass := SplitClassName(name); subclass != "" {
+			names = []string{base, name}
+		}
+		for _, n := range names {
+			if _, ok := pl.classes.Lookup(n); ok || pl.found[strings.ToLower(n)] {
+				continue
+			}
+			pl.found[strings.ToLower(n)] = true
+			pl.newClasses = append(pl.newClasses, n)
+		}
+	}
+	sort.Strings(u.NewClasses)
+
+	old, ok := pl.spells[keyOf(s)]
+	if !ok {
+		u.Action = Added
+		return u, nil
+	}
+	u.Old = old
+	u.New.ID = old.ID
+	u.OldClasses = pl.classNames[old.ID]
+	sort.Strings(u.OldClasses)
+	u.OldRolls = pl.rolls[old.ID]
+	u.OldUpcasts = pl.upcasts[old.ID]
+	u.OldCantrips = pl.cantrips[old.ID]
+	u.OldDamage = pl.damage[old.ID]
+	u.OldSaves = pl.saves[old.ID]
+	if u.Old == u.New && equalStrings(u.OldClasses, u.NewClasses) &&
+		equalStrings(u.OldRolls, u.NewRolls) && equalUpcasts(u.OldUpcasts, u.NewUpcasts) &&
+		equalCantrips(u.OldCantrips, u.NewCantrips) && equalStrings(u.OldDamage, u.NewDamage) &&
+		equalStrings(u.OldSaves, u.NewSaves) {
+		u.Action = Unchanged
+	} else {
+		u.Action = Changed
+	}
+	return u, nil
+}
+
+// batchSize is how many ClassSpells rows we insert per statement,
+// kept under sqlite's old limit of 999 parameters
+const batchSize = 400
+
+// spellBatchSize is how many spells we insert per statement, each
+// spell is 28 parameters so this is under 999 too
+const spellBatchSize = 32
+
+// The columns we set when inserting a spell, and the named parameters
+// for one row of them. Have to be silly about this because range is
+// a reserved word.
+const (
+	spellColumns = `name, level, school, cast_time, duration,
+		` + "`range`" + `, comp_verbal, comp_somatic, comp_material, material_desc, concentration, ritual, description, source_id,
+		cast_amount, cast_unit, cast_trigger, cast_seconds,
+		range_kind, range_distance, range_feet, area_shape, area_feet,
+		duration_kind, duration_amount, duration_seconds, melee_attack, ranged_attack`
+	spellValues = `(:name, :level, :school, :cast_time, :duration, :range, :comp_verbal, :comp_somatic,
+		:comp_material, :material_desc, :concentration, :ritual,
+		:description, :source_id,
+		:cast_amount, :cast_unit, :cast_trigger, :cast_seconds,
+		:range_kind, :range_distance, :range_feet, :area_shape, :area_feet,
+		:duration_kind, :duration_amount, :duration_seconds, :melee_attack, :ranged_attack)`
+)
+
+// Apply makes the changes described by p to db. Added spells are
+// inserted, Changed spells are updated in place, keeping their ids,
+// and their ClassSpells rows are reconciled with the compendium.
+//
+// Everything happens in one transaction, on the first error it's
+// rolled back and db is left exactly as it was.
+func (p *Plan) Apply(db *sqlx.DB) (err error) {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	a, err := newApplier(tx)
+	if err != nil {
+		return err
+	}
+	defer a.close()
+
+	for i := range p.Spells {
+		if err := a.add(&p.Spells[i]); err != nil {
+			return err
+		}
+	}
+	return a.finish()
+}
+
+// applier makes the changes for spell updates in a transaction as
+// they're added. Updates are queued spellBatchSize at a time, so the
+// Added spells among them can be inserted together.
+type applier struct {
+	tx          *sqlx.Tx
+	stmts       applyStmts
+	classes     *ClassResolver
+	classSpells classSpellsBatch
+	queued      []*SpellUpdate
+
+	// done, if set, is called with each update once it's been made
+	done func(u *SpellUpdate)
+}
+
+func newApplier(tx *sqlx.Tx) (*applier, error) {
+	a := &applier{tx: tx, classSpells: classSpellsBatch{tx: tx}}
+	if err := a.prepare(); err != nil {
+		a.close()
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *applier) prepare() (err error) {
+	if a.classes, err = NewClassResolver(a.tx); err != nil {
+		return err
+	}
+
+	s := &a.stmts
+	if s.insertSpell, err = a.tx.PrepareNamed(`INSERT INTO Spell (` + spellColumns + `) VALUES ` + spellValues + `;`); err != nil {
+		return err
+	}
+	if s.updateSpell, err = a.tx.PrepareNamed(`
+		UPDATE Spell SET name = :name, level = :level, school = :school,
+		cast_time = :cast_time, duration = :duration, ` + "`range`" + ` = :range,
+		comp_verbal = :comp_verbal, comp_somatic = :comp_somatic,
+		comp_material = :comp_material, material_desc = :material_desc,
+		concentration = :concentration, ritual = :ritual,
+		description = :description,
+		cast_amount = :cast_amount, cast_unit = :cast_unit,
+		cast_trigger = :cast_trigger, cast_seconds = :cast_seconds,
+		range_kind = :range_kind, range_distance = :range_distance,
+		range_feet = :range_feet, area_shape = :area_shape, area_feet = :area_feet,
+		duration_kind = :duration_kind, duration_amount = :duration_amount,
+		duration_seconds = :duration_seconds,
+		melee_attack = :melee_attack, ranged_attack = :ranged_attack
+		WHERE id = :id AND source_id = :source_id;
+	`); err != nil {
+		return err
+	}
+	if s.deleteClassSpells, err = a.tx.Prepare(`
+		DELETE FROM ClassSpells WHERE spell_id = ? AND class_id = ?;
+	`); err != nil {
+		return err
+	}
+	if s.deleteRolls, err = a.tx.Prepare(`
+		DELETE FROM SpellRolls WHERE spell_id = ?;
+	`); err != nil {
+		return err
+	}
+	if s.insertRoll, err = a.tx.Prepare(`
+		INSERT INTO SpellRolls (spell_id, position, expression) VALUES (?, ?, ?);
+	`); err != nil {
+		return err
+	}
+	if s.deleteUpcasts, err = a.tx.Prepare(`
+		DELETE FROM SpellUpcasts WHERE spell_id = ?;
+	`); err != nil {
+		return err
+	}
+	if s.insertUpcast, err = a.tx.PrepareNamed(`
+		INSERT INTO SpellUpcasts (spell_id, position, kind, subject, base_dice, dice, max_dice,
+		amount, unit, above_slot, every, slot, text)
+		VALUES (:spell_id, :position, :kind, :subject, :base_dice, :dice, :max_dice,
+		:amount, :unit, :above_slot, :every, :slot, :text);
+	`); err != nil {
+		return err
+	}
+	if s.deleteCantrips, err = a.tx.Prepare(`
+		DELETE FROM CantripScaling WHERE spell_id = ?;
+	`); err != nil {
+		return err
+	}
+	if s.insertCantrip, err = a.tx.PrepareNamed(`
+		INSERT INTO CantripScaling (spell_id, position, subject, level_1, level_5, level_11, level_17)
+		VALUES (:spell_id, :position, :subject, :level_1, :level_5, :level_11, :level_17);
+	`); err != nil {
+		return err
+	}
+	if s.deleteDamage, err = a.tx.Prepare(`
+		DELETE FROM SpellDamageTypes WHERE spell_id = ?;
+	`); err != nil {
+		return err
+	}
+	if s.insertDamage, err = a.tx.Prepare(`
+		INSERT INTO SpellDamageTypes (spell_id, damage_type) VALUES (?, ?);
+	`); err != nil {
+		return err
+	}
+	if s.deleteSaves, err = a.tx.Prepare(`
+		DELETE FROM SpellSaves WHERE spell_id = ?;
+	`); err != nil {
+		return err
+	}
+	s.insertSave, err = a.tx.Prepare(`
+		INSERT INTO SpellSaves (spell_id, ability) VALUES (?, ?);
+	`)
+	return err
+}
+
+// close closes every statement prepare got to
+func (a *applier) close() {
+	s := &a.stmts
+	for _, stmt := range []*sqlx.NamedStmt{s.insertSpell, s.updateSpell, s.insertUpcast, s.insertCantrip} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+	for _, stmt := range []*sql.Stmt{s.deleteClassSpells, s.deleteRolls, s.insertRoll, s.deleteUpcasts,
+		s.deleteCantrips, s.deleteDamage, s.insertDamage, s.deleteSaves, s.insertSave} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+}
+
+// add queues u, making the changes for everything queued once there's
+// a batch worth
+func (a *applier) add(u *SpellUpdate) error {
+	a.queued = append(a.queued, u)
+	if len(a.queued) < spellBatchSize {
+		return nil
+	}
+	return a.flush()
+}
+
+// finish makes every change still queued
+func (a *applier) finish() error {
+	if err := a.flush(); err != nil {
+		return err
+	}
+	return a.classSpells.flush()
+}
+
+// flush inserts the queued Added spells, then makes the rest of the
+// changes for every queued update, in order.
+func (a *applier) flush() error {
+	if err := a.insertSpells(); err != nil {
+		return err
+	}
+	for _, u := range a.queued {
+		if err := u.apply(&a.stmts, a.classes, &a.classSpells); err != nil {
+			return &SpellError{Name: u.New.Name, Line: u.Line, Err: err}
+		}
+		if a.done != nil {
+			a.done(u)
+		}
+	}
+	a.queued = a.queued[:0]
+	return nil
+}
+
+// insertSpells inserts the queued Added spells with a single multi-row
+// INSERT and sets their ids. If that fails, they're inserted one at a
+// time instead, so the error says which spell broke it.
+func (a *applier) insertSpells() error {
+	var added []*SpellUpdate
+	for _, u := range a.queued {
+		if u.Action == Added {
+			added = append(added, u)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(added))
+	args := []interface{}{}
+	for _, u := range added {
+		row, rowArgs, err := sqlx.Named(spellValues, &u.New)
+		if err != nil {
+			return err
+		}
+		values = append(values, row)
+		args = append(args, rowArgs...)
+	}
+	if _, err := a.tx.Exec(`INSERT INTO Spell (`+spellColumns+`) VALUES `+strings.Join(values, ", "), args...); err != nil {
+		return a.insertSpellsOneByOne(added)
+	}
+	return a.readSpellIDs(added)
+}
+
+func (a *applier) insertSpellsOneByOne(added []*SpellUpdate) error {
+	for _, u := range added {
+		result, err := a.stmts.insertSpell.Exec(&u.New)
+		if err != nil {
+			return &SpellError{Name: u.New.Name, Line: u.Line, Err: err}
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return &SpellError{Name: u.New.Name, Line: u.Line, Err: err}
+		}
+		u.New.ID = int(id)
+	}
+	return nil
+}
+
+// readSpellIDs sets the ids of spells insertSpells just inserted,
+// matching them up by name and source. A compendium can repeat a
+// spell, so for each name and source the newest rows are this
+// batch's, in order.
+func (a *applier) readSpellIDs(added []*SpellUpdate) error {
+	names := make([]interface{}, len(added))
+	for i, u := range added {
+		names[i] = u.New.Name
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", ")
+	rows := []model.Spell{}
+	if err := a.tx.Select(&rows, `SELECT id, name, source_id FROM Spell
+								  WHERE source_id IS NOT NULL AND name IN (`+placeholders+`)
+								  ORDER BY id`, names...); err != nil {
+		return err
+	}
+	ids := make(map[spellKey][]int)
+	for _, s := range rows {
+		ids[keyOf(s)] = append(ids[keyOf(s)], s.ID)
+	}
+
+	left := make(map[spellKey]int)
+	for _, u := range added {
+		left[keyOf(u.New)]++
+	}
+	for _, u := range added {
+		k := keyOf(u.New)
+		if len(ids[k]) < left[k] {
+			return &SpellError{Name: u.New.Name, Line: u.Line, Err: fmt.Errorf("missing after insert")}
+		}
+		u.New.ID = ids[k][len(ids[k])-left[k]]
+		left[k]--
+	}
+	return nil
+}
+
+// applyStmts are the prepared statements Apply uses for every spell
+type applyStmts struct {
+	insertSpell, updateSpell       *sqlx.NamedStmt
+	deleteClassSpells, deleteRolls *sql.Stmt
+	insertRoll                     *sql.Stmt
+	deleteUpcasts, deleteCantrips  *sql.Stmt
+	insertUpcast, insertCantrip    *sqlx.NamedStmt
+	deleteDamage, insertDamage     *sql.Stmt
+	deleteSaves, insertSave        *sql.Stmt
+}
+
+// apply makes the changes for a single spell, queueing new ClassSpells
+// rows in batch. Added spells have already been inserted.
+func (u *SpellUpdate) apply(stmts *applyStmts, classes *ClassResolver, batch *classSpellsBatch) error {
+	switch u.Action {
+	case Unchanged:
+		return nil
+	case Changed:
+		if u.Old != u.New {
+			if _, err := stmts.updateSpell.Exec(&u.New); err != nil {
+				return err
+			}
+		}
+	}
+
+	add, remove := diffStrings(u.OldClasses, u.NewClasses)
+	for _, name := range add {
+		class, err := classes.Resolve(name)
+		if err != nil {
+			return fmt.Errorf("creating class %s: %s", name, err.Error())
+		}
+		if err := batch.add(u, class.ID); err != nil {
+			return err
+		}
+	}
+	for _, name := range remove {
+		class, _ := classes.Lookup(name)
+		if _, err := stmts.deleteClassSpells.Exec(u.New.ID, class.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := u.applyRolls(stmts); err != nil {
+		return err
+	}
+	if err := u.applyUpcasts(stmts); err != nil {
+		return err
+	}
+	if err := u.applyCantrips(stmts); err != nil {
+		return err
+	}
+	if err := replaceSet(u.New.ID, u.OldDamage, u.NewDamage, stmts.deleteDamage, stmts.insertDamage); err != nil {
+		return err
+	}
+	return replaceSet(u.New.ID, u.OldSaves, u.NewSaves, stmts.deleteSaves, stmts.insertSave)
+}
+
+// Rolls are ordered, just replace them all if anything changed
+func (u *SpellUpdate) applyRolls(stmts *applyStmts) error {
+	if equalStrings(u.OldRolls, u.NewRolls) {
+		return nil
+	}
+	if len(u.OldRolls) > 0 {
+		if _, err := stmts.deleteRolls.Exec(u.New.ID); err != nil {
+			return err
+		}
+	}
+	for pos, expr := range u.NewRolls {
+		if _, err := stmts.insertRoll.Exec(u.New.ID, pos, expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Upcasts are ordered too, same as rolls
+func (u *SpellUpdate) applyUpcasts(stmts *applyStmts) error {
+	if equalUpcasts(u.OldUpcasts, u.NewUpcasts) {
+		return nil
+	}
+	if len(u.OldUpcasts) > 0 {
+		if _, err := stmts.deleteUpcasts.Exec(u.New.ID); err != nil {
+			return err
+		}
+	}
+	for pos, r := range u.NewUpcasts {
+		row := model.SpellUpcast{SpellID: u.New.ID, Position: pos, UpcastRule: r}
+		if _, err := stmts.insertUpcast.Exec(&row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// And cantrip scaling, same again
+func (u *SpellUpdate) applyCantrips(stmts *applyStmts) error {
+	if equalCantrips(u.OldCantrips, u.NewCantrips) {
+		return nil
+	}
+	if len(u.OldCantrips) > 0 {
+		if _, err := stmts.deleteCantrips.Exec(u.New.ID); err != nil {
+			return err
+		}
+	}
+	for pos, r := range u.NewCantrips {
+		row := model.CantripScaling{SpellID: u.New.ID, Position: pos, CantripRule: r}
+		if _, err := stmts.insertCantrip.Exec(&row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceSet replaces a spell's damage types or saves if they changed,
+// using del to delete the old rows and insert to add each new one
+func replaceSet(spellID int, old, new []string, del, insert *sql.Stmt) error {
+	if equalStrings(old, new) {
+		return nil
+	}
+	if len(old) > 0 {
+		if _, err := del.Exec(spellID); err != nil {
+			return err
+		}
+	}
+	for _, v := range new {
+		if _, err := insert.Exec(spellID, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// classSpellsBatch collects ClassSpells rows and inserts them
+// batchSize at a time with a single multi-row INSERT.
+type classSpellsBatch struct {
+	tx     *sqlx.Tx
+	rows   []model.ClassSpells
+	spells []*SpellUpdate
+}
+
+func (b *classSpellsBatch) add(u *SpellUpdate, classID int) error {
+	b.rows = append(b.rows, model.ClassSpells{SpellID: u.New.ID, ClassID: classID})
+	b.spells = append(b.spells, u)
+	if len(b.rows) < batchSize {
+		return nil
+	}
+	return b.flush()
+}
+
+// flush inserts every queued row. Since a failed row could belong to any
+// spell in the batch, errors name the range of spells it covered.
+func (b *classSpellsBatch) flush() error {
+	if len(b.rows) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, 2*len(b.rows))
+	for _, r := range b.rows {
+		args = append(args, r.SpellID, r.ClassID)
+	}
+	values := strings.TrimSuffix(strings.Repeat("(?, ?), ", len(b.rows)), ", ")
+	if _, err := b.tx.Exec(`INSERT INTO ClassSpells (spell_id, class_id) VALUES `+values, args...); err != nil {
+		first, last := b.spells[0], b.spells[len(b.spells)-1]
+		return fmt.Errorf("inserting classes for %s (line %d) through %s (line %d): %s",
+			first.New.Name, first.Line, last.New.Name, last.Line, err.Error())
+	}
+	b.rows, b.spells = b.rows[:0], b.spells[:0]
+	return nil
+}
+
+// Update upserts the spells in c into db without touching anything
+// else, returning the plan it carried out.
+func Update(db *sqlx.DB, c *Compendium) (*Plan, error) {
+	p, err := NewPlan(db, c)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Apply(db); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UpdateStream upserts the spells decoded from r into db the same way
+// Update does, except each spell is planned and applied as it's read,
+// so only a batch of them is ever in memory. fn, if not nil, is called
+// with each spell's update once it's been made. As with Update, it all
+// happens in one transaction.
+func UpdateStream(db *sqlx.DB, r io.Reader, fn func(u *SpellUpdate)) (t Tally, err error) {
+	tx, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	pl, err := newPlanner(tx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := newApplier(tx)
+	if err != nil {
+		return nil, err
+	}
+	defer a.close()
+
+	t = Tally{}
+	a.done = func(u *SpellUpdate) {
+		t[u.Action]++
+		if fn != nil {
+			fn(u)
+		}
+	}
+	err = DecodeCompendium(r, func(x *XMLSpell) error {
+		u, err := pl.plan(x)
+		if err != nil {
+			return err
+		}
+		return a.add(&u)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := a.finish(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalUpcasts(a, b []spelltext.UpcastRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalCantrips(a, b []spelltext.CantripRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// diffStrings returns the elements of sorted slice want missing from
+// sorted slice have, and the elements of have missing from want.
+func diffStrings(have, want []string) (add, remove []string) {
+	i, j := 0, 0
+	for i < len(have) || j < len(want) {
+		switch {
+		case j == len(want) || (i < len(have) && have[i] < want[j]):
+			remove = append(remove, have[i])
+			i++
+		case i == len(have) || want[j] < have[i]:
+			add = append(add, want[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return add, remove
+}
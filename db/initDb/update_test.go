@@ -0,0 +1,345 @@
+package initDb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+func testCompendium() *Compendium {
+	return &Compendium{XMLSpells: []XMLSpell{
+		{
+			Name:       "Absorb Elements (EE)",
+			Level:      "1",
+			School:     "A",
+			Time:       "1 reaction",
+			Range:      "Self",
+			Components: "S",
+			Duration:   "1 round",
+			Classes:    "Druid, Ranger, Wizard, Fighter (Eldritch Knight)",
+			Texts:      []string{"The spell captures some of the incoming energy."},
+		},
+		{
+			Name:       "Fire Bolt",
+			Level:      "0",
+			School:     "EV",
+			Time:       "1 action",
+			Range:      "120 feet",
+			Components: "V, S",
+			Duration:   "Instantaneous",
+			Classes:    "Sorcerer, Wizard",
+			Texts:      []string{"You hurl a mote of fire."},
+			Source:     "PHB",
+		},
+	}}
+}
+
+func TestUpdate(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	c := testCompendium()
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	before, err := db.GetCannonSpellByName("Fire Bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, ok := db.CreateUser("bob", "hash")
+	if !ok {
+		t.Fatal("CreateUser() failed")
+	}
+	homebrewID, err := db.CreateSpell(u.ID, model.Spell{Name: "Fire Bolt", Level: "9", School: "Evocation"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing to do yet
+	p, err := Update(db.DB, c)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, want := p.Summary(), "0 added, 0 changed, 2 unchanged"; got != want {
+		t.Errorf("Update() summary = %q, want %q", got, want)
+	}
+
+	// Fix a typo, drop a class, add a roll and add a spell
+	c.XMLSpells[1].Texts = []string{"You hurl a mote of fire at a creature."}
+	c.XMLSpells[1].Rolls = []string{"1d10", "2d10"}
+	c.XMLSpells[1].Classes = "Wizard"
+	c.XMLSpells = append(c.XMLSpells, XMLSpell{
+		Name:     "Shield",
+		Level:    "1",
+		School:   "A",
+		Classes:  "Sorcerer, Wizard",
+		Duration: "1 round",
+		Source:   "PHB",
+	})
+	p, err = Update(db.DB, c)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, want := p.Summary(), "1 added, 1 changed, 1 unchanged"; got != want {
+		t.Errorf("Update() summary = %q, want %q", got, want)
+	}
+
+	after, err := db.GetCannonSpellByName("Fire Bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.ID != before.ID || after.Description != "You hurl a mote of fire at a creature." {
+		t.Errorf("updated Fire Bolt = %+v, want id %d with the new description", after, before.ID)
+	}
+	cs, err := db.GetSpellClasses(after.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*cs) != 1 || (*cs)[0].Name != "Wizard" {
+		t.Errorf("updated Fire Bolt classes = %+v, want just Wizard", *cs)
+	}
+	rs, err := db.GetSpellRolls(after.ID)
+	if err != nil || len(*rs) != 2 || (*rs)[1].Expression != "2d10" {
+		t.Errorf("updated Fire Bolt rolls = %v, %v, want 1d10 and 2d10", rs, err)
+	}
+	if _, err := db.GetCannonSpellByName("Shield"); err != nil {
+		t.Errorf("Shield wasn't added: %v", err)
+	}
+
+	homebrew, err := db.GetSpellByID(homebrewID)
+	if err != nil || homebrew.Level != "9" || homebrew.Description != "" {
+		t.Errorf("homebrew Fire Bolt was touched: %+v, %v", homebrew, err)
+	}
+}
+
+func TestPlanReport(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	c := testCompendium()
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	c.XMLSpells[1].Range = "60 feet"
+	c.XMLSpells[1].Classes = "Wizard, Warlock"
+	c.XMLSpells[1].Rolls = []string{"1d10"}
+	p, err := NewPlan(db.DB, c)
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	r := p.Report()
+	if r.Changed != 1 || r.Unchanged != 1 || len(r.Spells) != 1 {
+		t.Fatalf("Report() = %+v, want one changed spell", r)
+	}
+	d := r.Spells[0]
+	want := []FieldDiff{
+		{Field: "range", Old: "120 feet", New: "60 feet"},
+		{Field: "rolls", Old: "", New: "1d10"},
+	}
+	if !reflect.DeepEqual(d.Fields, want) {
+		t.Errorf("Report() fields = %+v, want %+v", d.Fields, want)
+	}
+	if !reflect.DeepEqual(d.AddedClasses, []string{"Warlock"}) ||
+		!reflect.DeepEqual(d.RemovedClasses, []string{"Sorcerer"}) {
+		t.Errorf("Report() classes = +%v -%v, want +[Warlock] -[Sorcerer]", d.AddedClasses, d.RemovedClasses)
+	}
+
+	text := r.String()
+	for _, line := range []string{
+		"~ Fire Bolt (PHB)",
+		`range: "120 feet" -> "60 feet"`,
+		"classes: +Warlock -Sorcerer",
+		"0 added, 1 changed, 1 unchanged",
+	} {
+		if !strings.Contains(text, line) {
+			t.Errorf("Report().String() = %q, missing %q", text, line)
+		}
+	}
+
+	// and a dry run really is dry
+	s, err := db.GetCannonSpellByName("Fire Bolt")
+	if err != nil || s.Range != "120 feet" {
+		t.Errorf("NewPlan() changed the database: %+v, %v", s, err)
+	}
+}
+
+func TestUpdate_Upcasts(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	c := testCompendium()
+	c.XMLSpells[0].Texts = append(c.XMLSpells[0].Texts, "",
+		"At Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, the extra damage increases by 1d6 for each slot level above 1st.")
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	s, err := db.GetCannonSpellByName("Absorb Elements")
+	if err != nil {
+		t.Fatal(err)
+	}
+	us, err := db.GetSpellUpcasts(s.ID)
+	if err != nil {
+		t.Fatalf("GetSpellUpcasts() error = %v", err)
+	}
+	want := spelltext.UpcastRule{Kind: spelltext.UpcastDice, Subject: "extra damage", Dice: "1d6", AboveSlot: 1, Every: 1}
+	if len(*us) != 1 || (*us)[0].UpcastRule != want {
+		t.Fatalf("GetSpellUpcasts() = %+v, want %+v", *us, want)
+	}
+
+	// Importing the same thing again changes nothing
+	p, err := Update(db.DB, c)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, want := p.Summary(), "0 added, 0 changed, 2 unchanged"; got != want {
+		t.Errorf("Update() summary = %q, want %q", got, want)
+	}
+
+	c.XMLSpells[0].Texts[2] = "At Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, the extra damage increases by 2d6 for each slot level above 1st."
+	p, err = Update(db.DB, c)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	fields := p.Report().Spells[0].Fields
+	if last := fields[len(fields)-1]; last.Field != "upcasts" || last.New != "extra damage +2d6 per slot above 1" {
+		t.Errorf("Report() fields = %+v, want an upcasts diff", fields)
+	}
+	us, err = db.GetSpellUpcasts(s.ID)
+	if err != nil || len(*us) != 1 || (*us)[0].Dice != "2d6" {
+		t.Errorf("updated GetSpellUpcasts() = %v, %v, want 2d6", us, err)
+	}
+}
+
+func TestUpdate_Cantrips(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	c := testCompendium()
+	c.XMLSpells[1].Texts = append(c.XMLSpells[1].Texts, "",
+		"This spell's damage increases by 1d10 when you reach 5th level (2d10), 11th level (3d10), and 17th level (4d10).")
+	if err := Import(db.DB, c); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	s, err := db.GetCannonSpellByName("Fire Bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := db.GetCantripScaling(s.ID)
+	if err != nil {
+		t.Fatalf("GetCantripScaling() error = %v", err)
+	}
+	want := spelltext.CantripRule{Subject: "damage", Level1: "1d10", Level5: "2d10", Level11: "3d10", Level17: "4d10"}
+	if len(*cs) != 1 || (*cs)[0].CantripRule != want {
+		t.Fatalf("GetCantripScaling() = %+v, want %+v", *cs, want)
+	}
+
+	// Only cantrips scale with character level
+	c.XMLSpells[1].Level = "1"
+	p, err := Update(db.DB, c)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	fields := p.Report().Spells[0].Fields
+	if last := fields[len(fields)-1]; last.Field != "cantrip scaling" || last.Old != "damage 1d10/2d10/3d10/4d10" || last.New != "" {
+		t.Errorf("Report() fields = %+v, want a cantrip scaling diff", fields)
+	}
+	if cs, err := db.GetCantripScaling(s.ID); err != nil || len(*cs) != 0 {
+		t.Errorf("GetCantripScaling() after update = %v, %v, want none", cs, err)
+	}
+}
+
+func TestUpdate_NewClasses(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	c := testCompendium()
+	c.XMLSpells[1].Classes = "Wizard, Artificer (Alchemist), Artificer"
+
+	p, err := Update(db.DB, c)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if want := []string{"Artificer", "Artificer (Alchemist)"}; !reflect.DeepEqual(p.NewClasses, want) {
+		t.Errorf("Update() new classes = %v, want %v", p.NewClasses, want)
+	}
+
+	s, err := db.GetCannonSpellByName("Fire Bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := db.GetSpellClasses(s.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*cs) != 3 {
+		t.Errorf("GetSpellClasses() = %+v, want 3 classes", *cs)
+	}
+
+	// now they exist, nothing left to do
+	p, err = NewPlan(db.DB, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.NewClasses) != 0 || p.Count(Unchanged) != 2 {
+		t.Errorf("NewPlan() after Update() = %+v, want nothing to do", p)
+	}
+}
+
+func TestUpdateStream(t *testing.T) {
+	db, err := model.NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	if err := Import(db.DB, testCompendium()); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	xml := `<compendium source="PHB">
+	<spell><name>Absorb Elements (EE)</name><level>1</level><school>A</school><time>1 reaction</time><range>Self</range><components>S</components><duration>1 round</duration><classes>Druid, Ranger, Wizard, Fighter (Eldritch Knight)</classes><text>The spell captures some of the incoming energy.</text></spell>
+	<spell><name>Fire Bolt</name><level>0</level><school>EV</school><classes>Wizard</classes><text>You hurl a mote of fire at a creature.</text></spell>
+	<spell><name>Shield</name><level>1</level><school>A</school><classes>Sorcerer, Wizard</classes></spell>
+</compendium>`
+	var got []string
+	tally, err := UpdateStream(db.DB, strings.NewReader(xml), func(u *SpellUpdate) {
+		got = append(got, u.Action.String()+" "+u.New.Name)
+	})
+	if err != nil {
+		t.Fatalf("UpdateStream() error = %v", err)
+	}
+	if want := "1 added, 1 changed, 1 unchanged"; tally.String() != want {
+		t.Errorf("UpdateStream() tally = %q, want %q", tally, want)
+	}
+	want := []string{"unchanged Absorb Elements", "changed Fire Bolt", "added Shield"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UpdateStream() updates = %v, want %v", got, want)
+	}
+	s, err := db.GetCannonSpellByName("Shield")
+	if err != nil {
+		t.Fatalf("Shield wasn't added: %v", err)
+	}
+	if cs, err := db.GetSpellClasses(s.ID); err != nil || len(*cs) != 2 {
+		t.Errorf("Shield classes = %v, %v, want 2", cs, err)
+	}
+
+	// A broken spell part way through rolls back the whole file
+	broken := `<compendium source="PHB">
+	<spell><name>Mage Armor</name><level>1</level><school>A</school><classes>Wizard</classes></spell>
+	<spell><name>Nope</name><school>?</school></spell>
+</compendium>`
+	_, err = UpdateStream(db.DB, strings.NewReader(broken), nil)
+	if serr, ok := err.(*SpellError); !ok || serr.Name != "Nope" || serr.Line != 3 {
+		t.Errorf("UpdateStream() error = %v, want a *SpellError for Nope at line 3", err)
+	}
+	if _, err := db.GetCannonSpellByName("Mage Armor"); err != model.ErrNoResult {
+		t.Errorf("Mage Armor was imported anyway, error = %v", err)
+	}
+}
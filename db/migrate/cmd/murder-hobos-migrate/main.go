@@ -0,0 +1,151 @@
+// murder-hobos-migrate moves a murder-hobos database's schema up or down
+// through our numbered migrations, without touching any data the
+// migrations themselves don't change.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+
+	//Import for side effects
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var (
+	user, passwd, host, port, dbname string
+	sqlitePath                       string
+	help                             bool
+)
+
+func init() {
+	flag.StringVar(&user, "u", os.Getenv("USER"), "Database user name")
+	flag.StringVar(&passwd, "p", "", "Database password")
+	flag.StringVar(&host, "h", "localhost", "Host name")
+	flag.StringVar(&port, "P", "3306", "Port number")
+	flag.StringVar(&dbname, "D", "", "Database name (required for mysql)")
+	flag.StringVar(&sqlitePath, "sqlite", "", "Migrate the sqlite database file at this path instead of mysql")
+	flag.BoolVar(&help, "help", false, "Displays this help")
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] up [version] | down [steps] | status\n\n", os.Args[0])
+		fmt.Fprintln(os.Stderr, "  up       apply every migration up to version (default latest)")
+		fmt.Fprintln(os.Stderr, "  down     revert the newest applied migrations (default 1)")
+		fmt.Fprintln(os.Stderr, "  status   list migrations and whether they've been applied")
+		fmt.Fprintln(os.Stderr)
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if help || flag.NArg() < 1 || flag.NArg() > 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// optional numeric argument to up/down
+	arg := 0
+	if flag.NArg() == 2 {
+		n, err := strconv.Atoi(flag.Arg(1))
+		if err != nil || n < 0 {
+			fmt.Printf("Error: %q is not a valid number\n", flag.Arg(1))
+			os.Exit(1)
+		}
+		arg = n
+	}
+
+	db := connect()
+	defer db.Close()
+
+	switch flag.Arg(0) {
+	case "up":
+		applied, err := migrate.Up(db, arg)
+		for _, m := range applied {
+			fmt.Printf("Applied  %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("Already up to date.")
+		}
+	case "down":
+		if arg == 0 {
+			arg = 1
+		}
+		reverted, err := migrate.Down(db, arg)
+		for _, m := range reverted {
+			fmt.Printf("Reverted %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+	case "status":
+		statuses, err := migrate.StatusOf(db)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt
+			}
+			fmt.Printf("%04d_%-30s %s\n", s.Version, s.Name, state)
+		}
+	default:
+		fmt.Printf("Error: unknown command %q\n", flag.Arg(0))
+		flag.Usage()
+		os.Exit(1)
+	}
+}
+
+// connect opens the database described by our flags
+func connect() *sqlx.DB {
+	if sqlitePath != "" {
+		db, err := sqlx.Connect("sqlite3", sqlitePath+"?_foreign_keys=1")
+		if err != nil {
+			log.Fatalln(err)
+		}
+		return db
+	}
+
+	if dbname == "" {
+		fmt.Println("Error: Database name is required")
+		flag.Usage()
+		os.Exit(1)
+	}
+	if passwd == "" {
+		fmt.Print("Password: ")
+		// Don't echo password out
+		pass, err := terminal.ReadPassword(0)
+		if err != nil {
+			log.Fatalln("Fine. Don't enter a password. Bye.")
+		}
+		passwd = string(pass)
+		fmt.Println()
+	}
+	dbconfig := mysql.Config{
+		User:    user,
+		Passwd:  passwd,
+		DBName:  dbname,
+		Net:     "tcp",
+		Addr:    host + ":" + port,
+		Timeout: time.Second * 15,
+	}
+	db, err := sqlx.Connect("mysql", dbconfig.FormatDSN())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	return db
+}
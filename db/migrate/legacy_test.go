@@ -0,0 +1,58 @@
+package migrate_test
+
+import (
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/murder-hobos/murder-hobos/db/initDb"
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+)
+
+// An old database's canon spells should match up with the compendium
+// they were imported from once it's migrated, so updating it doesn't
+// add them all over again. This lives outside package migrate since
+// initDb needs migrate.
+func TestUp_ExistingDatabaseUpdate(t *testing.T) {
+	db, err := sqlx.Connect("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("sqlx.Connect() error = %v", err)
+	}
+	db.SetMaxOpenConns(1)
+	for _, stmt := range migrate.Migrations[0].Up["sqlite3"] {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// what our old importer made, the book on the end of EE and SCAG names
+	if _, err := db.Exec(`INSERT INTO Spell (name, level, school, cast_time, duration, ` + "`range`" + `, comp_verbal, comp_somatic, comp_material, concentration, ritual, description, source_id)
+		VALUES ('Fire Bolt', '0', 'Evocation', '1 action', 'Instantaneous', '120 feet', 1, 1, 0, 0, 0, '', 1),
+			   ('Absorb Elements (EE)', '1', 'Abjuration', '1 reaction', '1 round', 'Self', 0, 1, 0, 0, 0, '', 2),
+			   ('Booming Blade (SCAG)', '0', 'Evocation', '1 action', '1 round', '5 feet', 0, 0, 1, 0, 0, '', 3)`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := migrate.Up(db, 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	c := &initDb.Compendium{XMLSpells: []initDb.XMLSpell{
+		{Name: "Fire Bolt", Level: "0", School: "EV", Source: "PHB"},
+		{Name: "Absorb Elements (EE)", Level: "1", School: "A"},
+		{Name: "Booming Blade (SCAG)", Level: "0", School: "EV"},
+	}}
+	p, err := initDb.NewPlan(db, c)
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	if n := p.Count(initDb.Added); n != 0 {
+		t.Errorf("NewPlan() after Up() = %s, want nothing added", p.Summary())
+	}
+
+	if _, err := migrate.Down(db, 1); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	var n int
+	if err := db.Get(&n, `SELECT COUNT(*) FROM Spell WHERE name IN ('Fire Bolt', 'Absorb Elements (EE)', 'Booming Blade (SCAG)')`); err != nil || n != 3 {
+		t.Errorf("Down() didn't put the old names back: count = %d, err = %v", n, err)
+	}
+}
@@ -0,0 +1,219 @@
+// Package migrate manages our database schema as a numbered series of
+// migrations, recording which have been applied in a schema_migrations
+// table. It replaces dropping and recreating everything whenever the
+// schema changes, so existing databases move forward without losing
+// user data.
+package migrate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Migration is a single numbered change to our schema. Up applies it
+// and Down reverts it. Statements are kept per database driver
+// ("mysql" or "sqlite3") since the two dialects differ, and are run
+// one at a time so no driver needs multi statement support.
+type Migration struct {
+	Version int
+	Name    string
+	Up      map[string][]string
+	Down    map[string][]string
+
+	// CanDown, if set, is asked before reverting the migration, an
+	// error stops Down. Reset doesn't ask, it throws everything away.
+	CanDown func(db *sqlx.DB) error
+}
+
+// Status describes whether a migration has been applied to a database
+type Status struct {
+	Migration
+	Applied bool
+	// AppliedAt is when the migration was applied, as reported by the
+	// database. Empty if not applied.
+	AppliedAt string
+}
+
+var (
+	// ErrUnknownDriver is returned when a migration has no statements
+	// for the database driver in use
+	ErrUnknownDriver = errors.New("migrate: no migrations for this database driver")
+	// ErrUnknownVersion is returned when asked to migrate to a version
+	// that doesn't exist
+	ErrUnknownVersion = errors.New("migrate: unknown version")
+)
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version             INT NOT NULL,
+	name                VARCHAR(255) NOT NULL,
+	applied_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (version)
+)`
+
+// Latest returns the version of our newest migration
+func Latest() int {
+	return Migrations[len(Migrations)-1].Version
+}
+
+// Up applies every unapplied migration up to and including version
+// target, in order. A target of 0 means the latest version. The
+// migrations that were applied are returned, along with the first
+// error encountered, if any.
+func Up(db *sqlx.DB, target int) ([]Migration, error) {
+	if target == 0 {
+		target = Latest()
+	}
+	if !known(target) {
+		return nil, ErrUnknownVersion
+	}
+
+	statuses, err := StatusOf(db)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := []Migration{}
+	for _, s := range statuses {
+		if s.Version > target {
+			break
+		}
+		if s.Applied {
+			continue
+		}
+		if err := run(db, s.Migration, s.Up, true); err != nil {
+			return applied, err
+		}
+		applied = append(applied, s.Migration)
+	}
+	return applied, nil
+}
+
+// Down reverts the newest `steps` applied migrations, newest first.
+// The migrations that were reverted are returned, along with the
+// first error encountered, if any.
+func Down(db *sqlx.DB, steps int) ([]Migration, error) {
+	return down(db, steps, true)
+}
+
+// down is Down, asking each migration's CanDown first if check is set
+func down(db *sqlx.DB, steps int, check bool) ([]Migration, error) {
+	statuses, err := StatusOf(db)
+	if err != nil {
+		return nil, err
+	}
+
+	reverted := []Migration{}
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		s := statuses[i]
+		if !s.Applied {
+			continue
+		}
+		if check && s.CanDown != nil {
+			if err := s.CanDown(db); err != nil {
+				return reverted, fmt.Errorf("migrate: %04d_%s: %s", s.Version, s.Name, err.Error())
+			}
+		}
+		if err := run(db, s.Migration, s.Down, false); err != nil {
+			return reverted, err
+		}
+		reverted = append(reverted, s.Migration)
+	}
+	return reverted, nil
+}
+
+// Reset reverts every applied migration and then drops everything our
+// first migration creates, even if it was never recorded as applied.
+// This leaves a database from before migrations existed empty too.
+// All data is lost.
+func Reset(db *sqlx.DB) error {
+	if _, err := down(db, len(Migrations), false); err != nil {
+		return err
+	}
+	first := Migrations[0]
+	stmts, ok := first.Down[db.DriverName()]
+	if !ok {
+		return ErrUnknownDriver
+	}
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			return fmt.Errorf("migrate: resetting: %s", err.Error())
+		}
+	}
+	_, err := db.Exec(`DROP TABLE IF EXISTS schema_migrations`)
+	return err
+}
+
+// StatusOf returns the status of every migration against db, oldest
+// first, creating the schema_migrations table if it doesn't exist.
+func StatusOf(db *sqlx.DB) ([]Status, error) {
+	if _, err := db.Exec(createSchemaMigrations); err != nil {
+		return nil, err
+	}
+
+	rows := []struct {
+		Version   int    `db:"version"`
+		AppliedAt string `db:"applied_at"`
+	}{}
+	if err := db.Select(&rows, `SELECT version, applied_at FROM schema_migrations`); err != nil {
+		return nil, err
+	}
+	appliedAt := make(map[int]string)
+	for _, r := range rows {
+		appliedAt[r.Version] = r.AppliedAt
+	}
+
+	statuses := make([]Status, len(Migrations))
+	for i, m := range Migrations {
+		at, ok := appliedAt[m.Version]
+		statuses[i] = Status{Migration: m, Applied: ok, AppliedAt: at}
+	}
+	return statuses, nil
+}
+
+// run executes stmts for m inside a transaction, recording m as
+// applied (up) or not (down) in schema_migrations.
+//
+// mysql implicitly commits schema changes, so a failing mysql migration
+// may be left partially applied, and most of our statements can't
+// simply be run again. The error says which statement failed, every
+// one before it has taken effect and has to be finished or undone by
+// hand, see the README.
+func run(db *sqlx.DB, m Migration, stmts map[string][]string, up bool) error {
+	driverStmts, ok := stmts[db.DriverName()]
+	if !ok {
+		return ErrUnknownDriver
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	for i, stmt := range driverStmts {
+		if _, err := tx.Exec(stmt); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migrate: %04d_%s: statement %d of %d: %s", m.Version, m.Name, i+1, len(driverStmts), err.Error())
+		}
+	}
+
+	if up {
+		_, err = tx.Exec(`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.Version, m.Name)
+	} else {
+		_, err = tx.Exec(`DELETE FROM schema_migrations WHERE version = ?`, m.Version)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func known(version int) bool {
+	for _, m := range Migrations {
+		if m.Version == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Connect("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("sqlx.Connect() error = %v", err)
+	}
+	db.SetMaxOpenConns(1)
+	return db
+}
+
+func tableExists(t *testing.T, db *sqlx.DB, name string) bool {
+	var n int
+	err := db.Get(&n, `SELECT COUNT(*) FROM sqlite_master WHERE name = ?`, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n > 0
+}
+
+func TestUpDown(t *testing.T) {
+	db := newTestDB(t)
+
+	applied, err := Up(db, 0)
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if len(applied) != len(Migrations) {
+		t.Errorf("Up() applied %d migrations, want %d", len(applied), len(Migrations))
+	}
+	if !tableExists(t, db, "Spell") || !tableExists(t, db, "CannonSpells") {
+		t.Error("Up() didn't create our schema")
+	}
+
+	// nothing left to do
+	if applied, err := Up(db, 0); err != nil || len(applied) != 0 {
+		t.Errorf("second Up() = %v, %v, want nothing applied", applied, err)
+	}
+
+	statuses, err := StatusOf(db)
+	if err != nil {
+		t.Fatalf("StatusOf() error = %v", err)
+	}
+	for _, s := range statuses {
+		if !s.Applied || s.AppliedAt == "" {
+			t.Errorf("StatusOf() %04d_%s = %+v, want applied", s.Version, s.Name, s)
+		}
+	}
+
+	reverted, err := Down(db, len(Migrations))
+	if err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if len(reverted) != len(Migrations) || reverted[0].Version != Latest() {
+		t.Errorf("Down() reverted %v, want every migration newest first", reverted)
+	}
+	if tableExists(t, db, "Spell") {
+		t.Error("Down() left Spell behind")
+	}
+
+	if _, err := Up(db, 1000); err != ErrUnknownVersion {
+		t.Errorf("Up(1000) error = %v, want ErrUnknownVersion", err)
+	}
+}
+
+// A database created by drop-everything-and-start-over.sql, before we
+// had migrations, must keep its data when migrated.
+func TestUp_ExistingDatabase(t *testing.T) {
+	db := newTestDB(t)
+	for _, stmt := range initialSchemaSQLite {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Exec(`INSERT INTO User (username, password) VALUES ('bob', 'hash')`); err != nil {
+		t.Fatal(err)
+	}
+	// one spell from EE, one of bob's
+	if _, err := db.Exec(`INSERT INTO Spell (name, level, school, cast_time, duration, ` + "`range`" + `, comp_verbal, comp_somatic, comp_material, description, source_id)
+		VALUES ('Frostbite', '0', 'Evocation', '1 action', 'Instantaneous', '60 feet', 1, 1, 0, '', 2),
+			   ('Frostbite', '0', 'Evocation', '1 action', 'Instantaneous', '60 feet', 1, 1, 0, '', 4)`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Up(db, 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	var n int
+	if err := db.Get(&n, `SELECT COUNT(*) FROM User WHERE username = 'bob'`); err != nil || n != 1 {
+		t.Errorf("bob didn't survive migrating: count = %d, err = %v", n, err)
+	}
+	if err := db.Get(&n, `SELECT COUNT(*) FROM User`); err != nil || n != 1 {
+		t.Errorf("source users weren't deleted: count = %d, err = %v", n, err)
+	}
+	if err := db.Get(&n, `SELECT COUNT(*) FROM CannonSpells WHERE source_id = 2 AND user_id IS NULL`); err != nil || n != 1 {
+		t.Errorf("EE's spell isn't canon from EE: count = %d, err = %v", n, err)
+	}
+	if err := db.Get(&n, `SELECT COUNT(*) FROM Spell WHERE user_id = 4 AND source_id IS NULL`); err != nil || n != 1 {
+		t.Errorf("bob's spell isn't his homebrew: count = %d, err = %v", n, err)
+	}
+
+	if _, err := Down(db, 1); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if err := db.Get(&n, `SELECT COUNT(*) FROM CannonSpells`); err != nil || n != 1 {
+		t.Errorf("Down() lost EE's spell: count = %d, err = %v", n, err)
+	}
+}
+
+func TestReset(t *testing.T) {
+	db := newTestDB(t)
+	for _, stmt := range initialSchemaSQLite {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// never migrated, Reset should still wipe it
+	if err := Reset(db); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	for _, table := range []string{"Spell", "User", "Class", "schema_migrations"} {
+		if tableExists(t, db, table) {
+			t.Errorf("Reset() left %s behind", table)
+		}
+	}
+}
+
+func TestRun_Error(t *testing.T) {
+	db := newTestDB(t)
+	m := Migration{Version: 99, Name: "broken"}
+	stmts := map[string][]string{"sqlite3": {
+		`CREATE TABLE Broken (id INTEGER)`,
+		`CREATE TABLE Broken (id INTEGER)`,
+	}}
+	err := run(db, m, stmts, true)
+	if err == nil || !strings.Contains(err.Error(), "0099_broken: statement 2 of 2") {
+		t.Errorf("run() error = %v, want it to name statement 2", err)
+	}
+	// sqlite rolls back schema changes, unlike mysql
+	if tableExists(t, db, "Broken") {
+		t.Error("run() kept the statements before the one that failed")
+	}
+}
+
+func TestDown_OtherSources(t *testing.T) {
+	db := newTestDB(t)
+	if _, err := Up(db, 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO User (id, username, password) VALUES (4, 'bob', 'hash')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO Source (id, name, abbreviation) VALUES (4, 'Xanathar''s Guide to Everything', 'XGE')`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Down to before sources would give XGE's spells to bob
+	_, err := Down(db, Latest()-7)
+	if err == nil || !strings.Contains(err.Error(), "0008_sources") {
+		t.Fatalf("Down() with another source error = %v, want one naming 0008_sources", err)
+	}
+	if !tableExists(t, db, "Source") {
+		t.Error("Down() reverted sources anyway")
+	}
+
+	if _, err := db.Exec(`DELETE FROM Source WHERE id = 4`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Down(db, Latest()-7); err != nil {
+		t.Fatalf("Down() once XGE is gone error = %v", err)
+	}
+	if tableExists(t, db, "Source") {
+		t.Error("Down() didn't revert sources")
+	}
+
+	// Reset throws everything away, there's nothing to check
+	if _, err := Up(db, 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO Source (id, name, abbreviation) VALUES (4, 'Xanathar''s Guide to Everything', 'XGE')`); err != nil {
+		t.Fatal(err)
+	}
+	if err := Reset(db); err != nil {
+		t.Errorf("Reset() with another source error = %v", err)
+	}
+}
@@ -0,0 +1,703 @@
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Migrations is every change we've made to our schema, oldest first.
+// Never edit a migration that has been released, add a new one.
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "initial_schema",
+		Up: map[string][]string{
+			"mysql":   initialSchemaMySQL,
+			"sqlite3": initialSchemaSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropInitialSchema,
+			"sqlite3": dropInitialSchema,
+		},
+	},
+	{
+		Version: 2,
+		Name:    "spell_rolls",
+		Up: map[string][]string{
+			"mysql":   spellRollsMySQL,
+			"sqlite3": spellRollsSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropSpellRolls,
+			"sqlite3": dropSpellRolls,
+		},
+	},
+	{
+		Version: 3,
+		Name:    "spell_upcasts",
+		Up: map[string][]string{
+			"mysql":   spellUpcastsMySQL,
+			"sqlite3": spellUpcastsSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropSpellUpcasts,
+			"sqlite3": dropSpellUpcasts,
+		},
+	},
+	{
+		Version: 4,
+		Name:    "cantrip_scaling",
+		Up: map[string][]string{
+			"mysql":   cantripScalingMySQL,
+			"sqlite3": cantripScalingSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropCantripScaling,
+			"sqlite3": dropCantripScaling,
+		},
+	},
+	{
+		Version: 5,
+		Name:    "spell_casting_fields",
+		Up: map[string][]string{
+			"mysql":   spellCastingFieldsMySQL,
+			"sqlite3": spellCastingFieldsSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropSpellCastingFieldsMySQL,
+			"sqlite3": dropSpellCastingFieldsSQLite,
+		},
+	},
+	{
+		Version: 6,
+		Name:    "spell_combat",
+		Up: map[string][]string{
+			"mysql":   spellCombatMySQL,
+			"sqlite3": spellCombatSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropSpellCombatMySQL,
+			"sqlite3": dropSpellCombatSQLite,
+		},
+	},
+	{
+		Version: 7,
+		Name:    "spell_fulltext",
+		Up: map[string][]string{
+			"mysql": spellFulltextMySQL,
+			// sqlite searches with package fulltext instead
+			"sqlite3": {},
+		},
+		Down: map[string][]string{
+			"mysql":   dropSpellFulltextMySQL,
+			"sqlite3": {},
+		},
+	},
+	{
+		Version: 8,
+		Name:    "sources",
+		Up: map[string][]string{
+			"mysql":   sourcesMySQL,
+			"sqlite3": sourcesSQLite,
+		},
+		Down: map[string][]string{
+			"mysql":   dropSourcesMySQL,
+			"sqlite3": dropSourcesSQLite,
+		},
+		CanDown: onlyCanonBooks,
+	},
+}
+
+// Our original schema from drop-everything-and-start-over.sql.
+// Tables are only created if they don't exist, so databases
+// created before migrations existed simply adopt it.
+var initialSchemaMySQL = []string{
+	`CREATE TABLE IF NOT EXISTS ` + "`User`" + ` (
+		id                  INT UNSIGNED AUTO_INCREMENT,
+		username            VARCHAR(60) NOT NULL,
+		password            CHAR(60) NOT NULL,
+		PRIMARY KEY(id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS Class (
+		id                  TINYINT UNSIGNED AUTO_INCREMENT,
+		name                VARCHAR(50) UNIQUE NOT NULL,
+		base_class_id       TINYINT UNSIGNED NULL,
+		PRIMARY KEY (id),
+		FOREIGN KEY (base_class_id) REFERENCES Class(id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS Spell (
+		id                  INT UNSIGNED AUTO_INCREMENT,
+		name                VARCHAR(255) NOT NULL,
+		level               CHAR(1)     NOT NULL,
+		school              VARCHAR(255) NOT NULL,
+		cast_time           VARCHAR(255) NOT NULL,
+		duration            VARCHAR(255) NOT NULL,
+		` + "`range`" + `             VARCHAR(255) NOT NULL,
+		comp_verbal         BOOLEAN NOT NULL,
+		comp_somatic        BOOLEAN NOT NULL,
+		comp_material       BOOLEAN NOT NULL,
+		material_desc       TEXT,
+		concentration       BOOLEAN,
+		ritual              BOOLEAN,
+		description         TEXT NOT NULL,
+		source_id           INT UNSIGNED,
+		PRIMARY KEY(id),
+		FOREIGN KEY(source_id) REFERENCES User(id)
+	)`,
+	"CREATE TABLE IF NOT EXISTS `Character` (" + `
+		id                     INT UNSIGNED AUTO_INCREMENT,
+		name                   VARCHAR(255) NOT NULL,
+		race                   VARCHAR(255) NOT NULL,
+		spell_ability_modifier INT NULL,
+		proficiency_bonus      INT NULL,
+		user_id                INT UNSIGNED NOT NULL,
+		PRIMARY KEY(id),
+		FOREIGN KEY(user_id) REFERENCES User(id) ON DELETE CASCADE
+	)`,
+	`CREATE TABLE IF NOT EXISTS CharacterLevels (
+		char_id             INT UNSIGNED,
+		class_id            TINYINT UNSIGNED,
+		PRIMARY KEY (char_id, class_id),
+		FOREIGN KEY (char_id) REFERENCES ` + "`Character`" + `(id) ON DELETE CASCADE,
+		FOREIGN KEY (class_id) REFERENCES Class(id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS ClassSpells (
+		spell_id            INT UNSIGNED,
+		class_id            TINYINT UNSIGNED,
+		PRIMARY KEY (spell_id, class_id),
+		FOREIGN KEY (spell_id) REFERENCES Spell(id),
+		FOREIGN KEY (class_id) REFERENCES Class(id)
+	)`,
+	`CREATE OR REPLACE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+	// Initialize our strong entities
+	`INSERT IGNORE INTO ` + "`User`" + ` (id, username, password) VALUES
+		(1, 'PHB', 'totallynotsecure1'),
+		(2, 'EE', 'totallynotsecure2'),
+		(3, 'SCAG', 'totallynotsecure3')`,
+	`INSERT IGNORE INTO Class (id, name, base_class_id) VALUES
+		(1, 'Bard', NULL),
+		(2, 'Cleric', NULL),
+		(3, 'Cleric (Arcana)', 2),
+		(4, 'Cleric (Knowledge)', 2),
+		(5, 'Cleric (Life)', 2),
+		(6, 'Cleric (Light)', 2),
+		(7, 'Cleric (Nature)', 2),
+		(8, 'Cleric (Tempest)', 2),
+		(9, 'Cleric (Trickery)', 2),
+		(10, 'Cleric (War)', 2),
+		(11, 'Cleric (Death)', 2),
+		(12, 'Druid', NULL),
+		(13, 'Druid (Arctic)', 12),
+		(14, 'Druid (Coast)', 12),
+		(15, 'Druid (Desert)', 12),
+		(16, 'Druid (Forest)', 12),
+		(17, 'Druid (Grassland)', 12),
+		(18, 'Druid (Mountain)', 12),
+		(19, 'Druid (Swamp)', 12),
+		(20, 'Druid (Underdark)', 12),
+		(21, 'Paladin', NULL),
+		(22, 'Paladin (Ancients)', 21),
+		(23, 'Paladin (Devotion)', 21),
+		(24, 'Paladin (Vengeance)', 21),
+		(25, 'Paladin (Oathbreaker)', 21),
+		(26, 'Paladin (Crown)', 21),
+		(27, 'Ranger', NULL),
+		(28, 'Sorcerer', NULL),
+		(29, 'Warlock', NULL),
+		(30, 'Warlock (Archfey)', 29),
+		(31, 'Warlock (Fiend)', 29),
+		(32, 'Warlock (Great Old One)', 29),
+		(33, 'Warlock (Undying)', 29),
+		(34, 'Wizard', NULL),
+		(35, 'Fighter', NULL),
+		(36, 'Fighter (Eldritch Knight)', 35),
+		(37, 'Rogue', NULL),
+		(38, 'Rogue (Arcane Trickster)', 37)`,
+}
+
+// mysql compares strings case insensitively with our collation,
+// sqlite doesn't, so text columns we look things up by are
+// COLLATE NOCASE. sqlite accepts the same backtick quoting we
+// use for `range` in our mysql queries.
+var initialSchemaSQLite = []string{
+	`CREATE TABLE IF NOT EXISTS User (
+		id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+		username            VARCHAR(60) NOT NULL COLLATE NOCASE,
+		password            CHAR(60) NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS Class (
+		id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+		name                VARCHAR(50) UNIQUE NOT NULL COLLATE NOCASE,
+		base_class_id       INTEGER NULL REFERENCES Class(id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS Spell (
+		id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+		name                VARCHAR(255) NOT NULL COLLATE NOCASE,
+		level               CHAR(1)     NOT NULL,
+		school              VARCHAR(255) NOT NULL COLLATE NOCASE,
+		cast_time           VARCHAR(255) NOT NULL,
+		duration            VARCHAR(255) NOT NULL,
+		` + "`range`" + `             VARCHAR(255) NOT NULL,
+		comp_verbal         BOOLEAN NOT NULL,
+		comp_somatic        BOOLEAN NOT NULL,
+		comp_material       BOOLEAN NOT NULL,
+		material_desc       TEXT,
+		concentration       BOOLEAN,
+		ritual              BOOLEAN,
+		description         TEXT NOT NULL,
+		source_id           INTEGER REFERENCES User(id)
+	)`,
+	"CREATE TABLE IF NOT EXISTS `Character` (" + `
+		id                     INTEGER PRIMARY KEY AUTOINCREMENT,
+		name                   VARCHAR(255) NOT NULL COLLATE NOCASE,
+		race                   VARCHAR(255) NOT NULL,
+		spell_ability_modifier INT NULL,
+		proficiency_bonus      INT NULL,
+		user_id                INTEGER NOT NULL REFERENCES User(id) ON DELETE CASCADE
+	)`,
+	`CREATE TABLE IF NOT EXISTS CharacterLevels (
+		char_id             INTEGER REFERENCES ` + "`Character`" + `(id) ON DELETE CASCADE,
+		class_id            INTEGER REFERENCES Class(id),
+		PRIMARY KEY (char_id, class_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS ClassSpells (
+		spell_id            INTEGER REFERENCES Spell(id),
+		class_id            INTEGER REFERENCES Class(id),
+		PRIMARY KEY (spell_id, class_id)
+	)`,
+	`CREATE VIEW IF NOT EXISTS CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+
+	// Initialize our strong entities
+	`INSERT OR IGNORE INTO User (id, username, password) VALUES
+		(1, 'PHB', 'totallynotsecure1'),
+		(2, 'EE', 'totallynotsecure2'),
+		(3, 'SCAG', 'totallynotsecure3')`,
+	`INSERT OR IGNORE INTO Class (id, name, base_class_id) VALUES
+		(1, 'Bard', NULL),
+		(2, 'Cleric', NULL),
+		(3, 'Cleric (Arcana)', 2),
+		(4, 'Cleric (Knowledge)', 2),
+		(5, 'Cleric (Life)', 2),
+		(6, 'Cleric (Light)', 2),
+		(7, 'Cleric (Nature)', 2),
+		(8, 'Cleric (Tempest)', 2),
+		(9, 'Cleric (Trickery)', 2),
+		(10, 'Cleric (War)', 2),
+		(11, 'Cleric (Death)', 2),
+		(12, 'Druid', NULL),
+		(13, 'Druid (Arctic)', 12),
+		(14, 'Druid (Coast)', 12),
+		(15, 'Druid (Desert)', 12),
+		(16, 'Druid (Forest)', 12),
+		(17, 'Druid (Grassland)', 12),
+		(18, 'Druid (Mountain)', 12),
+		(19, 'Druid (Swamp)', 12),
+		(20, 'Druid (Underdark)', 12),
+		(21, 'Paladin', NULL),
+		(22, 'Paladin (Ancients)', 21),
+		(23, 'Paladin (Devotion)', 21),
+		(24, 'Paladin (Vengeance)', 21),
+		(25, 'Paladin (Oathbreaker)', 21),
+		(26, 'Paladin (Crown)', 21),
+		(27, 'Ranger', NULL),
+		(28, 'Sorcerer', NULL),
+		(29, 'Warlock', NULL),
+		(30, 'Warlock (Archfey)', 29),
+		(31, 'Warlock (Fiend)', 29),
+		(32, 'Warlock (Great Old One)', 29),
+		(33, 'Warlock (Undying)', 29),
+		(34, 'Wizard', NULL),
+		(35, 'Fighter', NULL),
+		(36, 'Fighter (Eldritch Knight)', 35),
+		(37, 'Rogue', NULL),
+		(38, 'Rogue (Arcane Trickster)', 37)`,
+}
+
+var dropInitialSchema = []string{
+	`DROP VIEW IF EXISTS CannonSpells`,
+	`DROP TABLE IF EXISTS ClassSpells`,
+	`DROP TABLE IF EXISTS CharacterLevels`,
+	`DROP TABLE IF EXISTS Spell`,
+	"DROP TABLE IF EXISTS `Character`",
+	`DROP TABLE IF EXISTS Class`,
+	"DROP TABLE IF EXISTS `User`",
+}
+
+// The <roll> dice expressions from the compendium, in the order
+// they appear in the spell.
+var spellRollsMySQL = []string{
+	`CREATE TABLE SpellRolls (
+		spell_id            INT UNSIGNED,
+		position            TINYINT UNSIGNED,
+		expression          VARCHAR(64) NOT NULL,
+		PRIMARY KEY (spell_id, position),
+		FOREIGN KEY (spell_id) REFERENCES Spell(id) ON DELETE CASCADE
+	)`,
+}
+
+var spellRollsSQLite = []string{
+	`CREATE TABLE SpellRolls (
+		spell_id            INTEGER REFERENCES Spell(id) ON DELETE CASCADE,
+		position            INTEGER,
+		expression          VARCHAR(64) NOT NULL,
+		PRIMARY KEY (spell_id, position)
+	)`,
+}
+
+var dropSpellRolls = []string{
+	`DROP TABLE IF EXISTS SpellRolls`,
+}
+
+// The "At Higher Levels" rules parsed from spell descriptions, see
+// spelltext.UpcastRule for what the columns mean.
+var spellUpcastsMySQL = []string{
+	`CREATE TABLE SpellUpcasts (
+		spell_id            INT UNSIGNED,
+		position            TINYINT UNSIGNED,
+		kind                VARCHAR(16) NOT NULL,
+		subject             VARCHAR(255) NOT NULL,
+		base_dice           VARCHAR(16) NOT NULL,
+		dice                VARCHAR(16) NOT NULL,
+		max_dice            VARCHAR(16) NOT NULL,
+		amount              INT NOT NULL,
+		unit                VARCHAR(64) NOT NULL,
+		above_slot          TINYINT UNSIGNED NOT NULL,
+		every               TINYINT UNSIGNED NOT NULL,
+		slot                TINYINT UNSIGNED NOT NULL,
+		text                TEXT NOT NULL,
+		PRIMARY KEY (spell_id, position),
+		FOREIGN KEY (spell_id) REFERENCES Spell(id) ON DELETE CASCADE
+	)`,
+}
+
+var spellUpcastsSQLite = []string{
+	`CREATE TABLE SpellUpcasts (
+		spell_id            INTEGER REFERENCES Spell(id) ON DELETE CASCADE,
+		position            INTEGER,
+		kind                VARCHAR(16) NOT NULL,
+		subject             VARCHAR(255) NOT NULL,
+		base_dice           VARCHAR(16) NOT NULL,
+		dice                VARCHAR(16) NOT NULL,
+		max_dice            VARCHAR(16) NOT NULL,
+		amount              INT NOT NULL,
+		unit                VARCHAR(64) NOT NULL,
+		above_slot          INT NOT NULL,
+		every               INT NOT NULL,
+		slot                INT NOT NULL,
+		text                TEXT NOT NULL,
+		PRIMARY KEY (spell_id, position)
+	)`,
+}
+
+var dropSpellUpcasts = []string{
+	`DROP TABLE IF EXISTS SpellUpcasts`,
+}
+
+// How cantrips grow at character levels 1, 5, 11 and 17, see
+// spelltext.CantripRule. Cantrips scale with a character's total level,
+// so CharacterLevels needs to know how many levels a character has in
+// each class.
+var cantripScalingMySQL = []string{
+	`CREATE TABLE CantripScaling (
+		spell_id            INT UNSIGNED,
+		position            TINYINT UNSIGNED,
+		subject             VARCHAR(255) NOT NULL,
+		level_1             VARCHAR(255) NOT NULL,
+		level_5             VARCHAR(255) NOT NULL,
+		level_11            VARCHAR(255) NOT NULL,
+		level_17            VARCHAR(255) NOT NULL,
+		PRIMARY KEY (spell_id, position),
+		FOREIGN KEY (spell_id) REFERENCES Spell(id) ON DELETE CASCADE
+	)`,
+	`ALTER TABLE CharacterLevels ADD COLUMN level TINYINT UNSIGNED NOT NULL DEFAULT 1`,
+}
+
+var cantripScalingSQLite = []string{
+	`CREATE TABLE CantripScaling (
+		spell_id            INTEGER REFERENCES Spell(id) ON DELETE CASCADE,
+		position            INTEGER,
+		subject             VARCHAR(255) NOT NULL,
+		level_1             VARCHAR(255) NOT NULL,
+		level_5             VARCHAR(255) NOT NULL,
+		level_11            VARCHAR(255) NOT NULL,
+		level_17            VARCHAR(255) NOT NULL,
+		PRIMARY KEY (spell_id, position)
+	)`,
+	`ALTER TABLE CharacterLevels ADD COLUMN level INT NOT NULL DEFAULT 1`,
+}
+
+var dropCantripScaling = []string{
+	`ALTER TABLE CharacterLevels DROP COLUMN level`,
+	`DROP TABLE IF EXISTS CantripScaling`,
+}
+
+// Parsed cast time, range and duration, see spelltext.ParsedCastTime and
+// friends. Existing spells are filled in by running init-db -update.
+// CannonSpells is SELECT * and MySQL fixes a view's columns when it's
+// created, so it's replaced to pick them up.
+var spellCastingFieldsMySQL = []string{
+	`ALTER TABLE Spell
+		ADD COLUMN cast_amount      INT UNSIGNED NOT NULL DEFAULT 0,
+		ADD COLUMN cast_unit        VARCHAR(32)  NOT NULL DEFAULT '',
+		ADD COLUMN cast_trigger     VARCHAR(255) NOT NULL DEFAULT '',
+		ADD COLUMN cast_seconds     INT UNSIGNED NOT NULL DEFAULT 0,
+		ADD COLUMN range_kind       VARCHAR(32)  NOT NULL DEFAULT '',
+		ADD COLUMN range_distance   INT UNSIGNED NOT NULL DEFAULT 0,
+		ADD COLUMN range_feet       INT UNSIGNED NOT NULL DEFAULT 0,
+		ADD COLUMN area_shape       VARCHAR(32)  NOT NULL DEFAULT '',
+		ADD COLUMN area_feet        INT UNSIGNED NOT NULL DEFAULT 0,
+		ADD COLUMN duration_kind    VARCHAR(32)  NOT NULL DEFAULT '',
+		ADD COLUMN duration_amount  INT UNSIGNED NOT NULL DEFAULT 0,
+		ADD COLUMN duration_seconds INT UNSIGNED NOT NULL DEFAULT 0`,
+	`CREATE OR REPLACE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+}
+
+var spellCastingFieldsSQLite = []string{
+	`ALTER TABLE Spell ADD COLUMN cast_amount INT NOT NULL DEFAULT 0`,
+	`ALTER TABLE Spell ADD COLUMN cast_unit VARCHAR(32) NOT NULL DEFAULT ''`,
+	`ALTER TABLE Spell ADD COLUMN cast_trigger VARCHAR(255) NOT NULL DEFAULT ''`,
+	`ALTER TABLE Spell ADD COLUMN cast_seconds INT NOT NULL DEFAULT 0`,
+	`ALTER TABLE Spell ADD COLUMN range_kind VARCHAR(32) NOT NULL DEFAULT ''`,
+	`ALTER TABLE Spell ADD COLUMN range_distance INT NOT NULL DEFAULT 0`,
+	`ALTER TABLE Spell ADD COLUMN range_feet INT NOT NULL DEFAULT 0`,
+	`ALTER TABLE Spell ADD COLUMN area_shape VARCHAR(32) NOT NULL DEFAULT ''`,
+	`ALTER TABLE Spell ADD COLUMN area_feet INT NOT NULL DEFAULT 0`,
+	`ALTER TABLE Spell ADD COLUMN duration_kind VARCHAR(32) NOT NULL DEFAULT ''`,
+	`ALTER TABLE Spell ADD COLUMN duration_amount INT NOT NULL DEFAULT 0`,
+	`ALTER TABLE Spell ADD COLUMN duration_seconds INT NOT NULL DEFAULT 0`,
+}
+
+var dropSpellCastingFieldsMySQL = []string{
+	`ALTER TABLE Spell
+		DROP COLUMN cast_amount,
+		DROP COLUMN cast_unit,
+		DROP COLUMN cast_trigger,
+		DROP COLUMN cast_seconds,
+		DROP COLUMN range_kind,
+		DROP COLUMN range_distance,
+		DROP COLUMN range_feet,
+		DROP COLUMN area_shape,
+		DROP COLUMN area_feet,
+		DROP COLUMN duration_kind,
+		DROP COLUMN duration_amount,
+		DROP COLUMN duration_seconds`,
+	`CREATE OR REPLACE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+}
+
+var dropSpellCastingFieldsSQLite = []string{
+	`ALTER TABLE Spell DROP COLUMN cast_amount`,
+	`ALTER TABLE Spell DROP COLUMN cast_unit`,
+	`ALTER TABLE Spell DROP COLUMN cast_trigger`,
+	`ALTER TABLE Spell DROP COLUMN cast_seconds`,
+	`ALTER TABLE Spell DROP COLUMN range_kind`,
+	`ALTER TABLE Spell DROP COLUMN range_distance`,
+	`ALTER TABLE Spell DROP COLUMN range_feet`,
+	`ALTER TABLE Spell DROP COLUMN area_shape`,
+	`ALTER TABLE Spell DROP COLUMN area_feet`,
+	`ALTER TABLE Spell DROP COLUMN duration_kind`,
+	`ALTER TABLE Spell DROP COLUMN duration_amount`,
+	`ALTER TABLE Spell DROP COLUMN duration_seconds`,
+}
+
+// Damage types, saving throws and spell attacks pulled from spell
+// descriptions, see spelltext.ParseDamageTypes and friends.
+var spellCombatMySQL = []string{
+	`CREATE TABLE SpellDamageTypes (
+		spell_id            INT UNSIGNED,
+		damage_type         VARCHAR(32),
+		PRIMARY KEY (spell_id, damage_type),
+		FOREIGN KEY (spell_id) REFERENCES Spell(id) ON DELETE CASCADE
+	)`,
+	`CREATE TABLE SpellSaves (
+		spell_id            INT UNSIGNED,
+		ability             VARCHAR(32),
+		PRIMARY KEY (spell_id, ability),
+		FOREIGN KEY (spell_id) REFERENCES Spell(id) ON DELETE CASCADE
+	)`,
+	`ALTER TABLE Spell
+		ADD COLUMN melee_attack  BOOLEAN NOT NULL DEFAULT FALSE,
+		ADD COLUMN ranged_attack BOOLEAN NOT NULL DEFAULT FALSE`,
+	`CREATE OR REPLACE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+}
+
+var spellCombatSQLite = []string{
+	`CREATE TABLE SpellDamageTypes (
+		spell_id            INTEGER REFERENCES Spell(id) ON DELETE CASCADE,
+		damage_type         VARCHAR(32),
+		PRIMARY KEY (spell_id, damage_type)
+	)`,
+	`CREATE TABLE SpellSaves (
+		spell_id            INTEGER REFERENCES Spell(id) ON DELETE CASCADE,
+		ability             VARCHAR(32),
+		PRIMARY KEY (spell_id, ability)
+	)`,
+	`ALTER TABLE Spell ADD COLUMN melee_attack BOOLEAN NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE Spell ADD COLUMN ranged_attack BOOLEAN NOT NULL DEFAULT FALSE`,
+}
+
+var dropSpellCombatMySQL = []string{
+	`ALTER TABLE Spell DROP COLUMN melee_attack, DROP COLUMN ranged_attack`,
+	`CREATE OR REPLACE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+	`DROP TABLE IF EXISTS SpellSaves`,
+	`DROP TABLE IF EXISTS SpellDamageTypes`,
+}
+
+var dropSpellCombatSQLite = []string{
+	`ALTER TABLE Spell DROP COLUMN melee_attack`,
+	`ALTER TABLE Spell DROP COLUMN ranged_attack`,
+	`DROP TABLE IF EXISTS SpellSaves`,
+	`DROP TABLE IF EXISTS SpellDamageTypes`,
+}
+
+// Full-text search over spells, see model.DB.FullTextSearchCannonSpells.
+// MATCH needs an index with exactly the columns it's given.
+var spellFulltextMySQL = []string{
+	`ALTER TABLE Spell
+		ADD FULLTEXT INDEX ft_spell_name (name),
+		ADD FULLTEXT INDEX ft_spell_text (name, description, material_desc)`,
+}
+
+var dropSpellFulltextMySQL = []string{
+	`ALTER TABLE Spell DROP INDEX ft_spell_name, DROP INDEX ft_spell_text`,
+}
+
+// Book is a row our sources migration puts in the Source table
+type Book struct {
+	ID           int
+	Name         string
+	Abbreviation string
+	Publisher    string
+	// Released is when the book came out, "2006-01-02"
+	Released string
+}
+
+// CanonBooks are the books our sources migration inserts, all of them
+// canon. They're part of that migration, so like it they must never
+// change; add books with a new migration.
+var CanonBooks = []Book{
+	{1, "Player's Handbook", "PHB", "Wizards of the Coast", "2014-08-19"},
+	{2, "Elemental Evil Player's Companion", "EE", "Wizards of the Coast", "2015-03-10"},
+	{3, "Sword Coast Adventurer's Guide", "SCAG", "Wizards of the Coast", "2015-11-03"},
+}
+
+// insertCanonBooks is the statement adding CanonBooks to Source, the
+// same for mysql and sqlite
+func insertCanonBooks() string {
+	quote := func(s string) string {
+		return "'" + strings.Replace(s, "'", "''", -1) + "'"
+	}
+	rows := make([]string, len(CanonBooks))
+	for i, b := range CanonBooks {
+		rows[i] = fmt.Sprintf("(%d, %s, %s, %s, TRUE, %s)",
+			b.ID, quote(b.Name), quote(b.Abbreviation), quote(b.Publisher), quote(b.Released))
+	}
+	return `INSERT INTO Source (id, name, abbreviation, publisher, canon, released) VALUES
+		` + strings.Join(rows, ",\n\t\t")
+}
+
+// Books spells come from. Canon spells used to belong to the users PHB,
+// EE and SCAG, spells now have a user_id for whose homebrew they are
+// and a source_id for the book they're from. The books keep the ids
+// their users had, and those users are deleted. Our old importer left
+// the book on the end of EE and SCAG spells' names, ex.
+// "Absorb Elements (EE)", that's source_id's job now so it comes off.
+var sourcesMySQL = []string{
+	`CREATE TABLE Source (
+		id                  INT UNSIGNED AUTO_INCREMENT,
+		name                VARCHAR(255) NOT NULL,
+		abbreviation        VARCHAR(16) UNIQUE NOT NULL,
+		publisher           VARCHAR(255) NOT NULL DEFAULT '',
+		canon               BOOLEAN NOT NULL DEFAULT FALSE,
+		released            DATE NULL,
+		PRIMARY KEY (id)
+	)`,
+	insertCanonBooks(),
+	`ALTER TABLE Spell CHANGE source_id user_id INT UNSIGNED NULL`,
+	`ALTER TABLE Spell
+		ADD COLUMN source_id INT UNSIGNED NULL,
+		ADD CONSTRAINT Spell_source FOREIGN KEY (source_id) REFERENCES Source(id)`,
+	`UPDATE Spell SET source_id = user_id, user_id = NULL WHERE user_id IN (1, 2, 3)`,
+	`UPDATE Spell JOIN Source ON Spell.source_id = Source.id
+		SET Spell.name = RTRIM(LEFT(Spell.name, CHAR_LENGTH(Spell.name) - CHAR_LENGTH(Source.abbreviation) - 2))
+		WHERE Spell.name LIKE CONCAT('%(', Source.abbreviation, ')')`,
+	"DELETE FROM `User` WHERE id IN (1, 2, 3)",
+	`CREATE OR REPLACE VIEW CannonSpells AS
+		SELECT * FROM Spell WHERE source_id IN (SELECT id FROM Source WHERE canon)`,
+}
+
+// sqlite rewrites views that mention a column it renames, so
+// CannonSpells is dropped first. released is text because sqlite
+// reads DATE columns back as timestamps, this way it reads the same as
+// mysql's DATE.
+var sourcesSQLite = []string{
+	`CREATE TABLE Source (
+		id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+		name                VARCHAR(255) NOT NULL COLLATE NOCASE,
+		abbreviation        VARCHAR(16) UNIQUE NOT NULL COLLATE NOCASE,
+		publisher           VARCHAR(255) NOT NULL DEFAULT '',
+		canon               BOOLEAN NOT NULL DEFAULT FALSE,
+		released            VARCHAR(10) NULL
+	)`,
+	insertCanonBooks(),
+	`DROP VIEW IF EXISTS CannonSpells`,
+	`ALTER TABLE Spell RENAME COLUMN source_id TO user_id`,
+	`ALTER TABLE Spell ADD COLUMN source_id INTEGER REFERENCES Source(id)`,
+	`UPDATE Spell SET source_id = user_id, user_id = NULL WHERE user_id IN (1, 2, 3)`,
+	`UPDATE Spell SET name = RTRIM(SUBSTR(name, 1,
+		LENGTH(name) - LENGTH((SELECT abbreviation FROM Source WHERE Source.id = Spell.source_id)) - 2))
+		WHERE name LIKE '%(' || (SELECT abbreviation FROM Source WHERE Source.id = Spell.source_id) || ')'`,
+	`DELETE FROM User WHERE id IN (1, 2, 3)`,
+	`CREATE VIEW CannonSpells AS
+		SELECT * FROM Spell WHERE source_id IN (SELECT id FROM Source WHERE canon)`,
+}
+
+// onlyCanonBooks stops the sources migration being reverted while
+// there are books besides CanonBooks. Only they have users to go back
+// to, any other book's spells would end up belonging to whichever user
+// has its id.
+func onlyCanonBooks(db *sqlx.DB) error {
+	var n int
+	if err := db.Get(&n, `SELECT COUNT(*) FROM Source WHERE id NOT IN (1, 2, 3)`); err != nil {
+		return err
+	}
+	if n > 0 {
+		return fmt.Errorf("%d sources besides PHB, EE and SCAG, delete them and their spells first", n)
+	}
+	return nil
+}
+
+// Putting the users back the way our initial schema made them, and
+// EE and SCAG back on the end of their spells' names. Only our three
+// books turn back into users.
+var dropSourcesMySQL = []string{
+	"INSERT IGNORE INTO `User` (id, username, password) VALUES" + `
+		(1, 'PHB', 'totallynotsecure1'),
+		(2, 'EE', 'totallynotsecure2'),
+		(3, 'SCAG', 'totallynotsecure3')`,
+	`UPDATE Spell JOIN Source ON Spell.source_id = Source.id
+		SET Spell.name = CONCAT(Spell.name, ' (', Source.abbreviation, ')')
+		WHERE Source.id IN (2, 3)`,
+	`UPDATE Spell SET user_id = source_id WHERE source_id IN (1, 2, 3)`,
+	`ALTER TABLE Spell DROP FOREIGN KEY Spell_source`,
+	`ALTER TABLE Spell DROP COLUMN source_id`,
+	`ALTER TABLE Spell CHANGE user_id source_id INT UNSIGNED NULL`,
+	`CREATE OR REPLACE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+	`DROP TABLE IF EXISTS Source`,
+}
+
+var dropSourcesSQLite = []string{
+	`INSERT OR IGNORE INTO User (id, username, password) VALUES
+		(1, 'PHB', 'totallynotsecure1'),
+		(2, 'EE', 'totallynotsecure2'),
+		(3, 'SCAG', 'totallynotsecure3')`,
+	`UPDATE Spell SET name = name || ' (' || (SELECT abbreviation FROM Source WHERE Source.id = Spell.source_id) || ')'
+		WHERE source_id IN (2, 3)`,
+	`UPDATE Spell SET user_id = source_id WHERE source_id IN (1, 2, 3)`,
+	`DROP VIEW IF EXISTS CannonSpells`,
+	`ALTER TABLE Spell DROP COLUMN source_id`,
+	`ALTER TABLE Spell RENAME COLUMN user_id TO source_id`,
+	`CREATE VIEW CannonSpells AS SELECT * FROM Spell WHERE source_id IN (1, 2, 3)`,
+	`DROP TABLE IF EXISTS Source`,
+}
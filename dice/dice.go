@@ -0,0 +1,565 @@
+// Package dice parses and rolls dice expressions like "8d6",
+// "1d20+SPELL+PROF" or "4d6kh3".
+//
+// An expression is a sum of terms. A term is dice ("NdM", N defaults
+// to 1), a constant, or a variable like SPELL that is filled in later
+// with Bind. Any term can be multiplied by a constant, "1d10*10".
+// Dice terms can keep only their highest or lowest dice:
+// "4d6kh3" keeps the highest 3, "2d20kl1" the lowest 1. "d20adv" and
+// "d20dis" are shorthand for rolling with advantage (2d20kh1) and
+// disadvantage (2d20kl1).
+package dice
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxDice is the most dice a single term can roll
+	MaxDice = 1000
+	// MaxSides is the most sides a die can have
+	MaxSides = 1000
+)
+
+var (
+	// ErrEmpty is returned when parsing an empty expression
+	ErrEmpty = errors.New("dice: empty expression")
+	// ErrTooBig is returned for terms with more than MaxDice dice,
+	// dice with more than MaxSides sides, or constants and
+	// multipliers bigger than MaxDice*MaxSides
+	ErrTooBig = errors.New("dice: too many dice or sides")
+)
+
+// Term is a single part of an Expr. Exactly one of Sides, Var or
+// Const is meaningful: Sides > 0 for dice, Var != "" for a variable,
+// otherwise the term is the constant Const.
+type Term struct {
+	// Neg terms are subtracted instead of added
+	Neg bool
+
+	Count int
+	Sides int
+	// Keep is how many dice to keep, 0 keeps all of them
+	Keep int
+	// Lowest keeps the lowest dice instead of the highest
+	Lowest bool
+
+	Var   string
+	Const int
+
+	// Times multiplies the term, 0 means 1
+	Times int
+}
+
+// IsDice reports whether t rolls dice
+func (t Term) IsDice() bool {
+	return t.Sides > 0
+}
+
+// kept is how many dice t keeps
+func (t Term) kept() int {
+	if t.Keep > 0 && t.Keep < t.Count {
+		return t.Keep
+	}
+	return t.Count
+}
+
+func (t Term) String() string {
+	if t.Times > 1 {
+		u := t
+		u.Times = 0
+		return u.String() + "*" + strconv.Itoa(t.Times)
+	}
+	switch {
+	case t.IsDice():
+		s := fmt.Sprintf("%dd%d", t.Count, t.Sides)
+		if t.Keep > 0 {
+			if t.Lowest {
+				s += "kl" + strconv.Itoa(t.Keep)
+			} else {
+				s += "kh" + strconv.Itoa(t.Keep)
+			}
+		}
+		return s
+	case t.Var != "":
+		return t.Var
+	default:
+		return strconv.Itoa(t.Const)
+	}
+}
+
+// Expr is a parsed dice expression
+type Expr struct {
+	Terms []Term
+}
+
+// Parse parses a dice expression. Letters are case insensitive and
+// whitespace is ignored. Variables are returned in upper case.
+func Parse(s string) (*Expr, error) {
+	p := parser{s: strings.ToLower(strings.Join(strings.Fields(s), ""))}
+	if p.s == "" {
+		return nil, ErrEmpty
+	}
+
+	e := &Expr{}
+	neg := false
+	if p.peek() == '-' || p.peek() == '+' {
+		neg = p.next() == '-'
+	}
+	for {
+		t, err := p.term()
+		if err == ErrTooBig {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("dice: %q: %s", s, err.Error())
+		}
+		t.Neg = neg
+		e.Terms = append(e.Terms, t)
+
+		if p.done() {
+			return e, nil
+		}
+		switch c := p.next(); c {
+		case '+':
+			neg = false
+		case '-':
+			neg = true
+		default:
+			return nil, fmt.Errorf("dice: %q: unexpected %q at %d", s, c, p.i-1)
+		}
+	}
+}
+
+// MustParse is like Parse but panics if s can't be parsed. It's meant
+// for expressions written in code.
+func MustParse(s string) *Expr {
+	e, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String returns e in a form Parse accepts, ex. "2d20kh1+SPELL-1"
+func (e *Expr) String() string {
+	var b strings.Builder
+	for i, t := range e.Terms {
+		switch {
+		case t.Neg:
+			b.WriteString("-")
+		case i > 0:
+			b.WriteString("+")
+		}
+		b.WriteString(t.String())
+	}
+	return b.String()
+}
+
+// Vars returns the variables e uses, in order, without duplicates
+func (e *Expr) Vars() []string {
+	var vars []string
+	seen := make(map[string]bool)
+	for _, t := range e.Terms {
+		if t.Var != "" && !seen[t.Var] {
+			seen[t.Var] = true
+			vars = append(vars, t.Var)
+		}
+	}
+	return vars
+}
+
+// Bind returns a copy of e with its variables replaced by their values
+// in vars. Names are case insensitive. Variables missing from vars are
+// left alone.
+func (e *Expr) Bind(vars map[string]int) *Expr {
+	upper := make(map[string]int, len(vars))
+	for k, v := range vars {
+		upper[strings.ToUpper(k)] = v
+	}
+
+	b := &Expr{Terms: make([]Term, len(e.Terms))}
+	for i, t := range e.Terms {
+		if v, ok := upper[t.Var]; ok && t.Var != "" {
+			t.Var, t.Const = "", v
+		}
+		b.Terms[i] = t
+	}
+	return b
+}
+
+// Min returns the lowest total e can roll. Unbound variables count
+// as 0.
+func (e *Expr) Min() int {
+	total := 0
+	for _, t := range e.Terms {
+		if t.Neg {
+			total -= t.max()
+		} else {
+			total += t.min()
+		}
+	}
+	return total
+}
+
+// Max returns the highest total e can roll. Unbound variables count
+// as 0.
+func (e *Expr) Max() int {
+	total := 0
+	for _, t := range e.Terms {
+		if t.Neg {
+			total -= t.min()
+		} else {
+			total += t.max()
+		}
+	}
+	return total
+}
+
+// Average returns the expected total of e. Unbound variables count
+// as 0.
+func (e *Expr) Average() float64 {
+	total := 0.0
+	for _, t := range e.Terms {
+		if t.Neg {
+			total -= t.average()
+		} else {
+			total += t.average()
+		}
+	}
+	return total
+}
+
+func (t Term) times() int {
+	if t.Times > 1 {
+		return t.Times
+	}
+	return 1
+}
+
+func (t Term) min() int {
+	if t.IsDice() {
+		return t.kept() * t.times()
+	}
+	return t.Const * t.times()
+}
+
+func (t Term) max() int {
+	if t.IsDice() {
+		return t.kept() * t.Sides * t.times()
+	}
+	return t.Const * t.times()
+}
+
+func (t Term) average() float64 {
+	times := float64(t.times())
+	if !t.IsDice() {
+		return float64(t.Const) * times
+	}
+	if t.kept() == t.Count {
+		return float64(t.Count) * float64(t.Sides+1) / 2 * times
+	}
+	// keepAverage is exact but gets slow for huge terms, which
+	// no one actually rolls, so estimate those instead
+	if work := t.Count * t.Count * t.Sides * t.kept() * t.Sides; work > maxWork {
+		return t.estimate() * times
+	}
+	return keepAverage(t.Count, t.Sides, t.kept(), t.Lowest) * times
+}
+
+// maxWork bounds how much keepAverage is allowed to do
+const maxWork = 50000000
+
+// estimate averages a fixed number of rolls of t, with a fixed seed so
+// it always gives the same answer
+func (t Term) estimate() float64 {
+	const rolls = 10000
+	r := NewRand(1)
+	e := &Expr{Terms: []Term{{Count: t.Count, Sides: t.Sides, Keep: t.Keep, Lowest: t.Lowest}}}
+	sum := 0
+	for i := 0; i < rolls; i++ {
+		sum += e.Roll(r).Total
+	}
+	return float64(sum) / rolls
+}
+
+// keepAverage is the expected sum of the k highest (or lowest) of n
+// dice with the given sides.
+//
+// Rather than enumerate every roll, go through the faces from the best
+// one down, deciding how many of the n dice show each face. Once k
+// dice have been assigned, later (worse) faces aren't kept. ways[j][s]
+// is the number of ways to have assigned j dice with s kept so far.
+func keepAverage(n, sides, k int, lowest bool) float64 {
+	maxSum := k * sides
+	ways := make([][]float64, n+1)
+	for j := range ways {
+		ways[j] = make([]float64, maxSum+1)
+	}
+	ways[0][0] = 1
+
+	for f := 0; f < sides; f++ {
+		face := sides - f
+		if lowest {
+			face = f + 1
+		}
+		next := make([][]float64, n+1)
+		for j := range next {
+			next[j] = make([]float64, maxSum+1)
+		}
+		for j := 0; j <= n; j++ {
+			for s := 0; s <= maxSum; s++ {
+				if ways[j][s] == 0 {
+					continue
+				}
+				// c more dice show face
+				for c := 0; j+c <= n; c++ {
+					kept := c
+					if j >= k {
+						kept = 0
+					} else if j+c > k {
+						kept = k - j
+					}
+					next[j+c][s+kept*face] += ways[j][s] * choose(n-j, c)
+				}
+			}
+		}
+		ways = next
+	}
+
+	total, sum := 0.0, 0.0
+	for s, w := range ways[n] {
+		total += w
+		sum += w * float64(s)
+	}
+	return sum / total
+}
+
+func choose(n, k int) float64 {
+	c := 1.0
+	for i := 0; i < k; i++ {
+		c = c * float64(n-i) / float64(i+1)
+	}
+	return c
+}
+
+// NewRand returns a random number generator for Roll seeded with
+// seed. The same seed always gives the same rolls, which is handy
+// for tests.
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// TermRoll is how a single term of an Expr was rolled
+type TermRoll struct {
+	Term Term
+	// Dice are every die rolled, in order
+	Dice []int
+	// Kept is which of Dice counted towards Value
+	Kept []bool
+	// Value is the term's contribution to the total, negative
+	// for Neg terms
+	Value int
+}
+
+// Result is a rolled Expr
+type Result struct {
+	Total int
+	Terms []TermRoll
+}
+
+// Roll rolls e using r. Unbound variables count as 0.
+func (e *Expr) Roll(r *rand.Rand) Result {
+	var res Result
+	for _, t := range e.Terms {
+		tr := TermRoll{Term: t}
+		if t.IsDice() {
+			tr.Dice = make([]int, t.Count)
+			for i := range tr.Dice {
+				tr.Dice[i] = r.Intn(t.Sides) + 1
+			}
+			tr.Kept = keep(tr.Dice, t.kept(), t.Lowest)
+			for i, d := range tr.Dice {
+				if tr.Kept[i] {
+					tr.Value += d
+				}
+			}
+		} else {
+			tr.Value = t.Const
+		}
+		tr.Value *= t.times()
+		if t.Neg {
+			tr.Value = -tr.Value
+		}
+		res.Total += tr.Value
+		res.Terms = append(res.Terms, tr)
+	}
+	return res
+}
+
+// keep marks the k highest, or lowest, dice as kept. Ties go to the
+// die rolled first.
+func keep(dice []int, k int, lowest bool) []bool {
+	idx := make([]int, len(dice))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		if lowest {
+			return dice[idx[a]] < dice[idx[b]]
+		}
+		return dice[idx[a]] > dice[idx[b]]
+	})
+
+	kept := make([]bool, len(dice))
+	for _, i := range idx[:k] {
+		kept[i] = true
+	}
+	return kept
+}
+
+// parser walks a lower cased, whitespace free expression
+type parser struct {
+	s string
+	i int
+}
+
+func (p *parser) done() bool { return p.i >= len(p.s) }
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.s[p.i]
+}
+
+func (p *parser) next() byte {
+	c := p.peek()
+	p.i++
+	return c
+}
+
+func isDigit(c byte) bool  { return '0' <= c && c <= '9' }
+func isLetter(c byte) bool { return 'a' <= c && c <= 'z' || c == '_' }
+
+// number reads digits, returning -1 if there aren't any and
+// ErrTooBig if there are too many to fit in an int
+func (p *parser) number() (int, error) {
+	start := p.i
+	for !p.done() && isDigit(p.peek()) {
+		p.i++
+	}
+	if start == p.i {
+		return -1, nil
+	}
+	n, err := strconv.Atoi(p.s[start:p.i])
+	if err != nil {
+		return 0, ErrTooBig
+	}
+	return n, nil
+}
+
+func (p *parser) word() string {
+	start := p.i
+	for !p.done() && (isLetter(p.peek()) || isDigit(p.peek())) {
+		p.i++
+	}
+	return p.s[start:p.i]
+}
+
+// term reads a single term and its multiplier, if it has one
+func (p *parser) term() (Term, error) {
+	t, err := p.operand()
+	if err != nil || p.peek() != '*' {
+		return t, err
+	}
+	p.next()
+	if t.Times, err = p.number(); err != nil {
+		return Term{}, err
+	}
+	if t.Times < 1 {
+		return Term{}, fmt.Errorf("expected a multiplier at %d", p.i)
+	}
+	if t.Times > MaxDice*MaxSides {
+		return Term{}, ErrTooBig
+	}
+	return t, nil
+}
+
+func (p *parser) operand() (Term, error) {
+	c := p.peek()
+	isDice := c == 'd' && p.i+1 < len(p.s) && isDigit(p.s[p.i+1])
+	if isLetter(c) && !isDice {
+		w := p.word()
+		if w == "d" {
+			return Term{}, fmt.Errorf("expected sides at %d", p.i)
+		}
+		return Term{Var: strings.ToUpper(w)}, nil
+	}
+
+	n, err := p.number()
+	if err != nil {
+		return Term{}, err
+	}
+	if p.peek() != 'd' {
+		if n < 0 {
+			return Term{}, fmt.Errorf("expected a number, dice or variable at %d", p.i)
+		}
+		if n > MaxDice*MaxSides {
+			return Term{}, ErrTooBig
+		}
+		return Term{Const: n}, nil
+	}
+	p.next()
+	if n < 0 {
+		n = 1
+	}
+	sides, err := p.number()
+	if err != nil {
+		return Term{}, err
+	}
+	if sides < 1 {
+		return Term{}, fmt.Errorf("expected sides at %d", p.i)
+	}
+	t := Term{Count: n, Sides: sides}
+	if n < 1 {
+		return Term{}, fmt.Errorf("can't roll %d dice", n)
+	}
+	if n > MaxDice || sides > MaxSides {
+		return Term{}, ErrTooBig
+	}
+
+	switch {
+	case strings.HasPrefix(p.s[p.i:], "adv"), strings.HasPrefix(p.s[p.i:], "dis"):
+		if n != 1 {
+			return Term{}, errors.New("advantage and disadvantage only apply to a single die")
+		}
+		t.Lowest = p.s[p.i] == 'd'
+		p.i += 3
+		t.Count, t.Keep = 2, 1
+	case p.peek() == 'k':
+		p.next()
+		switch p.peek() {
+		case 'h':
+			p.next()
+		case 'l':
+			p.next()
+			t.Lowest = true
+		}
+		if t.Keep, err = p.number(); err != nil {
+			return Term{}, err
+		}
+		if t.Keep < 1 {
+			return Term{}, fmt.Errorf("expected how many dice to keep at %d", p.i)
+		}
+		if t.Keep > t.Count {
+			return Term{}, fmt.Errorf("can't keep %d of %d dice", t.Keep, t.Count)
+		}
+	}
+	return t, nil
+}
@@ -0,0 +1,200 @@
+package dice
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"10d8", "10d8"},
+		{"d20", "1d20"},
+		{"1D20 + SPELL + PROF", "1d20+SPELL+PROF"},
+		{"1d20+spell+prof", "1d20+SPELL+PROF"},
+		{"1d4+1", "1d4+1"},
+		{"2d6 - 1", "2d6-1"},
+		{"-1+d4", "-1+1d4"},
+		{"4d6kh3", "4d6kh3"},
+		{"4d6k3", "4d6kh3"},
+		{"2d20kl1", "2d20kl1"},
+		{"d20adv", "2d20kh1"},
+		{"1d20dis+DEX", "2d20kl1+DEX"},
+		{"3d6+1d4+2", "3d6+1d4+2"},
+		{"1d10 * 10", "1d10*10"},
+		{"1d10*1", "1d10"},
+	}
+	for _, tt := range tests {
+		e, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got := e.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"   ",
+		"d",
+		"2d",
+		"0d6",
+		"1d0",
+		"1d6+",
+		"1d6++1",
+		"1d6*",
+		"1d6*d6",
+		"4d6kh",
+		"2d6kh3",
+		"2d20adv",
+		"1001d6",
+		"1d1001",
+		"99999999999999999999d6",
+	} {
+		if e, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) = %v, want an error", in, e)
+		}
+	}
+}
+
+func TestParse_TooBig(t *testing.T) {
+	for _, in := range []string{
+		"1001d6",
+		"1d1001",
+		"99999999999999999999d6",
+		"1d99999999999999999999",
+		"1d6+99999999999999999999",
+		"1d6+1000001",
+		"1d6*99999999999999999999",
+		"1d6*1000001",
+		"4d6k99999999999999999999",
+	} {
+		if e, err := Parse(in); err != ErrTooBig {
+			t.Errorf("Parse(%q) = %v, %v, want %v", in, e, err, ErrTooBig)
+		}
+	}
+}
+
+func TestExpr_MinMaxAverage(t *testing.T) {
+	tests := []struct {
+		in       string
+		min, max int
+		avg      float64
+	}{
+		{"10d8", 10, 80, 45},
+		{"1d6+2", 3, 8, 5.5},
+		{"2d6-1d4", -2, 11, 4.5},
+		{"4d6kh3", 3, 18, 12.244598765432098},
+		{"d20adv", 1, 20, 13.825},
+		{"d20dis", 1, 20, 7.175},
+		{"1d20+SPELL", 1, 20, 10.5},
+		{"3d6*10", 30, 180, 105},
+	}
+	for _, tt := range tests {
+		e := MustParse(tt.in)
+		if got := e.Min(); got != tt.min {
+			t.Errorf("%s Min() = %d, want %d", tt.in, got, tt.min)
+		}
+		if got := e.Max(); got != tt.max {
+			t.Errorf("%s Max() = %d, want %d", tt.in, got, tt.max)
+		}
+		if got := e.Average(); math.Abs(got-tt.avg) > 1e-9 {
+			t.Errorf("%s Average() = %v, want %v", tt.in, got, tt.avg)
+		}
+	}
+
+	// too big to work out exactly, but still close
+	if got := MustParse("100d100kh50").Average(); got < 3700 || got > 3800 {
+		t.Errorf("100d100kh50 Average() = %v, want about 3750", got)
+	}
+}
+
+func TestExpr_Bind(t *testing.T) {
+	e := MustParse("1d20+SPELL+PROF")
+	if got, want := e.Vars(), []string{"SPELL", "PROF"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Vars() = %v, want %v", got, want)
+	}
+
+	b := e.Bind(map[string]int{"spell": 3, "PROF": 2})
+	if got := b.String(); got != "1d20+3+2" {
+		t.Errorf("Bind().String() = %q, want 1d20+3+2", got)
+	}
+	if b.Min() != 6 || b.Max() != 25 {
+		t.Errorf("Bind() min, max = %d, %d, want 6, 25", b.Min(), b.Max())
+	}
+	if e.String() != "1d20+SPELL+PROF" {
+		t.Error("Bind() changed the original expression")
+	}
+	if got := e.Bind(nil).Vars(); len(got) != 2 {
+		t.Errorf("Bind(nil) bound %v", got)
+	}
+
+	// multiplied variables stay multiplied
+	b = MustParse("SPELL*2+1d4").Bind(map[string]int{"spell": 3})
+	if got := b.String(); got != "3*2+1d4" {
+		t.Errorf("Bind().String() = %q, want 3*2+1d4", got)
+	}
+	if b.Min() != 7 || b.Max() != 10 || b.Average() != 8.5 {
+		t.Errorf("Bind() min, max, avg = %d, %d, %v, want 7, 10, 8.5", b.Min(), b.Max(), b.Average())
+	}
+}
+
+func TestExpr_Roll(t *testing.T) {
+	e := MustParse("4d6kh3+2-1d4+1d2*10")
+
+	// same seed, same rolls
+	a, b := e.Roll(NewRand(42)), e.Roll(NewRand(42))
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Roll() with the same seed = %+v and %+v", a, b)
+	}
+
+	r := NewRand(7)
+	for i := 0; i < 1000; i++ {
+		res := e.Roll(r)
+		if res.Total < e.Min() || res.Total > e.Max() {
+			t.Fatalf("Roll() = %d, outside [%d, %d]", res.Total, e.Min(), e.Max())
+		}
+
+		sum := 0
+		for _, tr := range res.Terms {
+			sum += tr.Value
+		}
+		if sum != res.Total {
+			t.Fatalf("Roll() terms add up to %d, total is %d", sum, res.Total)
+		}
+
+		// the dropped die is never higher than a kept one
+		kh := res.Terms[0]
+		lowestKept := 7
+		for j, d := range kh.Dice {
+			if kh.Kept[j] && d < lowestKept {
+				lowestKept = d
+			}
+		}
+		for j, d := range kh.Dice {
+			if !kh.Kept[j] && d > lowestKept {
+				t.Fatalf("Roll() dropped %d but kept %d from %v", d, lowestKept, kh.Dice)
+			}
+		}
+		if res.Terms[2].Value > -1 {
+			t.Fatalf("Roll() subtracted %d for 1d4", res.Terms[2].Value)
+		}
+	}
+}
+
+func TestKeep(t *testing.T) {
+	dice := []int{3, 6, 3, 1}
+	if got, want := keep(dice, 2, false), []bool{true, true, false, false}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keep highest = %v, want %v", got, want)
+	}
+	if got, want := keep(dice, 2, true), []bool{true, false, false, true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keep lowest = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,342 @@
+// Package fulltext is a small in-process full-text index, for
+// datastores that don't have one of their own like mysql's FULLTEXT.
+//
+// Documents are made of weighted fields, ex. a spell's name counts
+// for more than its description. Text is split into lower case words,
+// common words are dropped and the rest are stemmed so "breathe" finds
+// "breathing". Search ranks documents with BM25, any word in the query
+// can match. Snippet picks the part of a text that best matches a
+// query, for showing with results.
+package fulltext
+
+import (
+	"html"
+	"html/template"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BM25 parameters, the usual defaults
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Field is part of a document, matches in it count Weight times
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// Hit is a document matching a search
+type Hit struct {
+	ID    int
+	Score float64
+}
+
+// Index maps the words in documents to the documents, by an id the
+// caller picks. The zero value is not usable, use NewIndex. An Index
+// isn't safe for concurrent use, callers that share one lock it.
+type Index struct {
+	// postings maps a word to the weighted count of it in each
+	// document it's in
+	postings map[string]map[int]float64
+	// lengths is each document's weighted word count
+	lengths  map[int]float64
+	totalLen float64
+}
+
+// NewIndex returns an empty Index
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[int]float64),
+		lengths:  make(map[int]float64),
+	}
+}
+
+// Add indexes a document made of fields as id, replacing whatever
+// was indexed as id before
+func (ix *Index) Add(id int, fields ...Field) {
+	ix.Remove(id)
+	length := 0.0
+	for _, f := range fields {
+		for _, w := range Words(f.Text) {
+			p, ok := ix.postings[w]
+			if !ok {
+				p = make(map[int]float64)
+				ix.postings[w] = p
+			}
+			p[id] += f.Weight
+			length += f.Weight
+		}
+	}
+	ix.lengths[id] = length
+	ix.totalLen += length
+}
+
+// Remove takes id out of the index, if it's there
+func (ix *Index) Remove(id int) {
+	length, ok := ix.lengths[id]
+	if !ok {
+		return
+	}
+	for w, p := range ix.postings {
+		if _, ok := p[id]; ok {
+			delete(p, id)
+			if len(p) == 0 {
+				delete(ix.postings, w)
+			}
+		}
+	}
+	delete(ix.lengths, id)
+	ix.totalLen -= length
+}
+
+// Len is how many documents are indexed
+func (ix *Index) Len() int {
+	return len(ix.lengths)
+}
+
+// Search returns the documents containing any word of query, best
+// match first. Ties are broken by id.
+func (ix *Index) Search(query string) []Hit {
+	n := float64(len(ix.lengths))
+	if n == 0 {
+		return []Hit{}
+	}
+	avgLen := ix.totalLen / n
+
+	scores := make(map[int]float64)
+	for _, w := range distinct(Words(query)) {
+		p := ix.postings[w]
+		if len(p) == 0 {
+			continue
+		}
+		df := float64(len(p))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range p {
+			norm := 1 - b + b*ix.lengths[id]/avgLen
+			scores[id] += idf * tf * (k1 + 1) / (tf + k1*norm)
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, s := range scores {
+		hits = append(hits, Hit{ID: id, Score: s})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	return hits
+}
+
+// Words splits s into the stemmed, lower case words we index, leaving
+// out stop words. s can contain html entities like our spell
+// descriptions do.
+func Words(s string) []string {
+	words := []string{}
+	for _, t := range tokens(html.UnescapeString(s)) {
+		if w := stem(t.word); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// token is a word in a text and where it is
+type token struct {
+	word       string
+	start, end int
+}
+
+// tokens splits s into runs of letters and digits, lower cased.
+// Apostrophes inside a word are kept, "can't".
+func tokens(s string) []token {
+	var ts []token
+	start := -1
+	for i, r := range s {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r) ||
+			(r == '\'' && start >= 0 && i+1 < len(s) && isWordByte(s[i+1]))
+		if inWord && start < 0 {
+			start = i
+		}
+		if !inWord && start >= 0 {
+			ts = append(ts, token{strings.ToLower(s[start:i]), start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		ts = append(ts, token{strings.ToLower(s[start:]), start, len(s)})
+	}
+	return ts
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// stem reduces a lower case word to a rough root shared by its other
+// forms, "breathing", "breathes" and "breathe" are all "breath".
+// Stop words are "".
+func stem(w string) string {
+	w = strings.TrimSuffix(w, "'s")
+	if stopWords[w] {
+		return ""
+	}
+	switch {
+	case len(w) > 4 && strings.HasSuffix(w, "ies"):
+		w = w[:len(w)-3] + "y"
+	case len(w) > 5 && strings.HasSuffix(w, "ing"):
+		w = w[:len(w)-3]
+	case len(w) > 4 && strings.HasSuffix(w, "ed"):
+		w = w[:len(w)-2]
+	case len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss"):
+		w = w[:len(w)-1]
+	}
+	if len(w) > 4 && strings.HasSuffix(w, "e") {
+		w = w[:len(w)-1]
+	}
+	return w
+}
+
+var stopWords = map[string]bool{}
+
+func init() {
+	for _, w := range strings.Fields(`a an and are as at be but by can do does for from
+		has have how i if in into is it its let lets me my of on or so
+		that the their them then there these they this to up was what
+		when where which who why will with you your spell spells`) {
+		stopWords[w] = true
+	}
+}
+
+// Snippet returns about width words of whichever of texts best matches
+// query, with the matching words wrapped in <mark>. It's the start of
+// the first text if nothing matches. texts can contain html entities,
+// the snippet is escaped for html.
+func Snippet(query string, width int, texts ...string) template.HTML {
+	want := make(map[string]bool)
+	for _, w := range Words(query) {
+		want[w] = true
+	}
+
+	var best string
+	var bestTokens []token
+	bestStart, bestCount := 0, -1
+	for _, text := range texts {
+		text = html.UnescapeString(text)
+		ts := tokens(text)
+		start, count := bestWindow(ts, want, width)
+		if count > bestCount {
+			best, bestTokens, bestStart, bestCount = text, ts, start, count
+		}
+	}
+	if len(bestTokens) == 0 {
+		return ""
+	}
+
+	end := bestStart + width
+	if end > len(bestTokens) {
+		end = len(bestTokens)
+	}
+	var sb strings.Builder
+	if bestStart > 0 {
+		sb.WriteString("… ")
+	}
+	pos := bestTokens[bestStart].start
+	for _, t := range bestTokens[bestStart:end] {
+		sb.WriteString(html.EscapeString(squash(best[pos:t.start])))
+		word := html.EscapeString(best[t.start:t.end])
+		if want[stem(t.word)] {
+			sb.WriteString("<mark>" + word + "</mark>")
+		} else {
+			sb.WriteString(word)
+		}
+		pos = t.end
+	}
+	if end < len(bestTokens) {
+		sb.WriteString(" …")
+	} else {
+		sb.WriteString(html.EscapeString(squash(best[pos:])))
+	}
+	return template.HTML(strings.TrimSpace(sb.String()))
+}
+
+// bestWindow finds the width tokens of ts with the most words in want,
+// centered on the matches, returning where they start and how many
+// matches there are
+func bestWindow(ts []token, want map[string]bool, width int) (int, int) {
+	matched := make([]bool, len(ts))
+	for i, t := range ts {
+		matched[i] = want[stem(t.word)]
+	}
+	// the window ending at end with the most matches
+	end, bestCount, count := 0, 0, 0
+	for i := range ts {
+		if matched[i] {
+			count++
+		}
+		if i >= width && matched[i-width] {
+			count--
+		}
+		if count > bestCount {
+			end, bestCount = i, count
+		}
+	}
+	if bestCount == 0 {
+		return 0, 0
+	}
+
+	first := end
+	for i := end; i > end-width && i >= 0; i-- {
+		if matched[i] {
+			first = i
+		}
+	}
+	start := first - (width-(end-first+1))/2
+	if start > len(ts)-width {
+		start = len(ts) - width
+	}
+	if start < 0 {
+		start = 0
+	}
+	return start, bestCount
+}
+
+// squash collapses runs of whitespace in s to one space
+func squash(s string) string {
+	var sb strings.Builder
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+		sb.WriteRune(r)
+	}
+	if space {
+		sb.WriteByte(' ')
+	}
+	return sb.String()
+}
+
+func distinct(ws []string) []string {
+	seen := make(map[string]bool)
+	out := []string{}
+	for _, w := range ws {
+		if !seen[w] {
+			seen[w] = true
+			out = append(out, w)
+		}
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+package fulltext
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", []string{}},
+		{"Which spell lets me breathe underwater?", []string{"breath", "underwater"}},
+		{"Water Breathing", []string{"water", "breath"}},
+		{"The creature&#39;s bodies can&#39;t burn, fires burned", []string{"creatur", "body", "can't", "burn", "fire", "burn"}},
+	}
+	for _, tt := range tests {
+		if got := Words(tt.s); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Words(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIndex_Search(t *testing.T) {
+	ix := NewIndex()
+	ix.Add(1, Field{"Fireball", 3}, Field{"A bright streak flashes to a point you choose then blossoms into an explosion of flame.", 1})
+	ix.Add(2, Field{"Water Breathing", 3}, Field{"This spell grants creatures the ability to breathe underwater until the spell ends.", 1})
+	ix.Add(3, Field{"Control Water", 3}, Field{"Until the spell ends, you control any freestanding water.", 1})
+	ix.Add(4, Field{"Shape Water", 3}, Field{"You choose an area of water that you can see.", 1})
+
+	hits := ix.Search("which spell lets me breathe underwater")
+	if len(hits) != 1 || hits[0].ID != 2 {
+		t.Errorf("Search(breathe underwater) = %v, want only Water Breathing", hits)
+	}
+
+	// A name match ranks above a description match
+	ix.Add(5, Field{"Torrent", 3}, Field{"A torrent of water.", 1})
+	hits = ix.Search("torrent")
+	if len(hits) != 1 || hits[0].ID != 5 {
+		t.Errorf("Search(torrent) = %v", hits)
+	}
+	hits = ix.Search("water")
+	if len(hits) != 4 || hits[3].ID != 5 {
+		t.Errorf("Search(water) = %v, want Torrent, only in its description, last", hits)
+	}
+	for i := 1; i < len(hits); i++ {
+		if hits[i].Score > hits[i-1].Score {
+			t.Errorf("Search(water) isn't best first: %v", hits)
+		}
+	}
+
+	ix.Remove(2)
+	ix.Remove(42)
+	if hits := ix.Search("breathe"); len(hits) != 0 {
+		t.Errorf("Search() after Remove = %v", hits)
+	}
+	if ix.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", ix.Len())
+	}
+	if hits := ix.Search("the spell"); len(hits) != 0 {
+		t.Errorf("Search(stop words) = %v, want nothing", hits)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	desc := "This spell grants up to ten willing creatures you can see within range the ability to breathe underwater until the spell ends. Affected creatures also retain their normal mode of respiration."
+	tests := []struct {
+		query string
+		width int
+		texts []string
+		want  string
+	}{
+		{
+			"breathe underwater", 8, []string{desc},
+			"… the ability to <mark>breathe</mark> <mark>underwater</mark> until the spell …",
+		},
+		{
+			"nothing here", 6, []string{"Short &amp; sweet <b>text</b> that goes on."},
+			"Short &amp; sweet &lt;b&gt;text&lt;/b&gt; that …",
+		},
+		{
+			"feather", 10, []string{"A plain description.", "A feather"},
+			"A <mark>feather</mark>",
+		},
+		{
+			"respiration", 50, []string{desc},
+			"This spell grants up to ten willing creatures you can see within range the ability to breathe underwater until the spell ends. Affected creatures also retain their normal mode of <mark>respiration</mark>.",
+		},
+	}
+	for _, tt := range tests {
+		got := string(Snippet(tt.query, tt.width, tt.texts...))
+		if got != tt.want {
+			t.Errorf("Snippet(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+		if strings.Contains(got, "<b>") {
+			t.Errorf("Snippet(%q) isn't escaped: %q", tt.query, got)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Package fuzzy finds names that are close to a misspelled one, for
+// suggesting what someone meant.
+//
+// Names are compared ignoring case, spaces and punctuation, so
+// "fire-ball" is "Fireball". How alike two names are is the better of
+// their edit distance relative to their length, which catches typos,
+// and how many three letter runs they share, which catches words
+// missing or out of order.
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MinScore is how alike a candidate has to be for Closest to return
+// it, see Score
+const MinScore = 0.5
+
+// Match is a candidate close to what was asked for
+type Match struct {
+	// Index is where the candidate is in the slice given to Closest
+	Index int
+	Score float64
+}
+
+// Closest returns up to limit of candidates scoring at least MinScore
+// against name, best first. Ties keep candidate order.
+func Closest(name string, candidates []string, limit int) []Match {
+	n := normalize(name)
+	matches := []Match{}
+	if n == "" {
+		return matches
+	}
+	for i, c := range candidates {
+		if s := score(n, normalize(c)); s >= MinScore {
+			matches = append(matches, Match{Index: i, Score: s})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Score rates how alike a and b are, from 0 for nothing in common to
+// 1 for the same name.
+func Score(a, b string) float64 {
+	return score(normalize(a), normalize(b))
+}
+
+func score(a, b string) float64 {
+	longest := len([]rune(a))
+	if l := len([]rune(b)); l > longest {
+		longest = l
+	}
+	if longest == 0 {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	edit := 1 - float64(Distance(a, b))/float64(longest)
+	if tri := trigramSimilarity(a, b); tri > edit {
+		return tri
+	}
+	return edit
+}
+
+// Distance is the Levenshtein distance between a and b, how many
+// characters have to be added, removed or changed to turn one into
+// the other.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// trigramSimilarity is the share of a and b's three letter runs they
+// have in common, padded so short names still have some
+func trigramSimilarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range ta {
+		if tb[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+func trigrams(s string) map[string]bool {
+	r := []rune("  " + s + " ")
+	ts := make(map[string]bool)
+	for i := 0; i+3 <= len(r); i++ {
+		ts[string(r[i:i+3])] = true
+	}
+	return ts
+}
+
+// normalize lower cases s and drops everything but letters and digits
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,65 @@
+package fuzzy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "bolt", 4},
+		{"fireball", "fireball", 0},
+		{"fireball", "firebal", 1},
+		{"firebolt", "fireball", 2},
+		{"kitten", "sitting", 3},
+		{"αβγ", "αγ", 1},
+	}
+	for _, tt := range tests {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	if s := Score("Fire-Ball", "fireball"); s != 1 {
+		t.Errorf("Score() of the same name = %v, want 1", s)
+	}
+	if s := Score("", ""); s != 0 {
+		t.Errorf("Score() of nothing = %v, want 0", s)
+	}
+	if near, far := Score("Firebal", "Fireball"), Score("Firebal", "Fire Bolt"); near <= far {
+		t.Errorf("Score() Fireball %v <= Fire Bolt %v", near, far)
+	}
+	if s := Score("Wish", "Counterspell"); s >= MinScore {
+		t.Errorf("Score() of unrelated names = %v", s)
+	}
+}
+
+func TestClosest(t *testing.T) {
+	names := []string{"Fire Bolt", "Fire Shield", "Fireball", "Magic Missile", "Wish"}
+	tests := []struct {
+		name  string
+		limit int
+		want  []int
+	}{
+		{"Firebal", 5, []int{2, 0, 1}},
+		{"Firebal", 2, []int{2, 0}},
+		{"magic misile", 5, []int{3}},
+		{"Eldritch Blast", 5, []int{}},
+		{"  -- ", 5, []int{}},
+	}
+	for _, tt := range tests {
+		got := []int{}
+		for _, m := range Closest(tt.name, names, tt.limit) {
+			got = append(got, m.Index)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Closest(%q, %d) = %v, want %v", tt.name, tt.limit, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/murder-hobos/murder-hobos/spelltext"
+
+// CantripScaling represents a row in our db's CantripScaling table,
+// one thing about a cantrip that grows with character level. Position
+// orders them the way they appear in the description.
+type CantripScaling struct {
+	SpellID  int `db:"spell_id"`
+	Position int `db:"position"`
+	spelltext.CantripRule
+}
@@ -11,9 +11,17 @@ import (
 type CharacterDatastore interface {
 	GetAllCharacters(userID int) (*[]Character, error)
 	GetCharacterByName(userID int, name string) (*Character, error)
-	CreateCharacter(userID int, char *Character) (int, error)
+	CreateCharacter(userID int, char *Character, levels ...CharacterLevel) (int, error)
+
+	GetCharacterLevels(charID int) (*[]CharacterLevel, error)
+	GetCharacterLevel(charID int) (int, error)
+	SetCharacterLevel(charID, classID, level int) error
 }
 
+// MaxCharacterLevel is as high as a character's levels go, in a single
+// class or all together
+const MaxCharacterLevel = 20
+
 // Character represents our database Character table
 type Character struct {
 	ID                   int           `db:"id"`
@@ -24,6 +32,14 @@ type Character struct {
 	UserID               int           `db:"user_id"`
 }
 
+// CharacterLevel represents our database CharacterLevels table, how
+// many levels a character has in one class
+type CharacterLevel struct {
+	CharID  int `db:"char_id"`
+	ClassID int `db:"class_id"`
+	Level   int `db:"level"`
+}
+
 // GetAllCharacters gets a list of every character belonging to a
 // specified user
 func (db *DB) GetAllCharacters(userID int) (*[]Character, error) {
@@ -53,17 +69,95 @@ func (db *DB) GetCharacterByName(userID int, name string) (*Character, error) {
 	return c, nil
 }
 
-func (db *DB) CreateCharacter(userID int, char *Character) (int, error) {
-	res, err := db.Exec(`INSERT INTO `+"`Character` "+`(name, race, spell_ability_modifier, proficiency_bonus,
+// CreateCharacter adds a character, and its levels in any classes,
+// returning its new id. The levels' CharID is ignored. Either all of
+// it is saved or none of it is.
+func (db *DB) CreateCharacter(userID int, char *Character, levels ...CharacterLevel) (id int, err error) {
+	for _, l := range levels {
+		if l.ClassID <= 0 {
+			return 0, ErrInvalidID
+		}
+		if l.Level < 1 || l.Level > MaxCharacterLevel {
+			return 0, ErrInvalidLevel
+		}
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	res, err := tx.Exec(`INSERT INTO `+"`Character` "+`(name, race, spell_ability_modifier, proficiency_bonus,
 						 user_id) VALUES (?, ?, ?, ?, ?)`,
 		char.Name, char.Race, char.SpellAbilityModifier, char.ProficienyBonus,
 		char.UserID)
 	if err != nil {
 		return 0, err
 	}
-	id, err := res.LastInsertId()
+	i, err := res.LastInsertId()
 	if err != nil {
 		return 0, err
 	}
-	return int(id), nil
+	for _, l := range levels {
+		if _, err = tx.Exec(`REPLACE INTO CharacterLevels (char_id, class_id, level)
+							 VALUES (?, ?, ?)`, i, l.ClassID, l.Level); err != nil {
+			return 0, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(i), nil
+}
+
+// GetCharacterLevels gets the levels a character has in each of
+// their classes
+func (db *DB) GetCharacterLevels(charID int) (*[]CharacterLevel, error) {
+	if charID <= 0 {
+		return nil, ErrNoResult
+	}
+
+	ls := &[]CharacterLevel{}
+	err := db.Select(ls, `SELECT char_id, class_id, level FROM CharacterLevels
+						  WHERE char_id = ? ORDER BY class_id`, charID)
+	if err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+// GetCharacterLevel returns a character's total level, the sum of
+// their levels in every class. A character without classes is level 0.
+func (db *DB) GetCharacterLevel(charID int) (int, error) {
+	if charID <= 0 {
+		return 0, ErrNoResult
+	}
+
+	var level int
+	err := db.Get(&level, `SELECT COALESCE(SUM(level), 0) FROM CharacterLevels
+						   WHERE char_id = ?`, charID)
+	if err != nil {
+		return 0, err
+	}
+	return level, nil
+}
+
+// SetCharacterLevel sets how many levels a character has in a class,
+// adding the class to the character if they don't have it yet
+func (db *DB) SetCharacterLevel(charID, classID, level int) error {
+	if charID <= 0 || classID <= 0 {
+		return ErrInvalidID
+	}
+	if level < 1 || level > MaxCharacterLevel {
+		return ErrInvalidLevel
+	}
+
+	_, err := db.Exec(`REPLACE INTO CharacterLevels (char_id, class_id, level)
+					   VALUES (?, ?, ?)`, charID, classID, level)
+	return err
 }
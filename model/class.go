@@ -2,6 +2,7 @@ package model
 
 import (
 	"log"
+	"sort"
 
 	"database/sql"
 )
@@ -10,8 +11,10 @@ import (
 // database pertaining to Classes
 type ClassDatastore interface {
 	GetAllClasses() (*[]Class, error)
+	GetClassTree() (*[]ClassNode, error)
 	GetClassByName(name string) (*Class, error)
-	GetClassSpells(classID int) (*[]Spell, error)
+	GetSubclasses(classID int) (*[]Class, error)
+	GetClassSpells(classID int) (*[]ClassSpell, error)
 }
 
 // Class represents our database Class table
@@ -21,6 +24,49 @@ type Class struct {
 	BaseClass sql.NullInt64 `db:"base_class_id"`
 }
 
+// IsSubclass reports whether c is a subclass, ex. "Cleric (Light)"
+func (c *Class) IsSubclass() bool {
+	return c.BaseClass.Valid
+}
+
+// ClassNode is a base class along with its subclasses
+type ClassNode struct {
+	Class
+	Subclasses []Class
+}
+
+// ClassSpell is a spell on a class's spell list
+type ClassSpell struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+	// Subclass is true for spells a subclass adds to its base
+	// class's list, ex. Fireball for Cleric (Light)
+	Subclass bool `db:"subclass"`
+}
+
+// ClassTree arranges cs into base classes, each with its subclasses,
+// both sorted by name. A subclass whose base class isn't in cs is left
+// out.
+func ClassTree(cs []Class) []ClassNode {
+	sorted := append([]Class(nil), cs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	tree := []ClassNode{}
+	bases := make(map[int64]int)
+	for _, c := range sorted {
+		if !c.IsSubclass() {
+			bases[int64(c.ID)] = len(tree)
+			tree = append(tree, ClassNode{Class: c, Subclasses: []Class{}})
+		}
+	}
+	for _, c := range sorted {
+		if i, ok := bases[c.BaseClass.Int64]; ok && c.IsSubclass() {
+			tree[i].Subclasses = append(tree[i].Subclasses, c)
+		}
+	}
+	return tree
+}
+
 // GetAllClasses gets a list of every class in our database
 func (db *DB) GetAllClasses() (*[]Class, error) {
 
@@ -31,6 +77,17 @@ func (db *DB) GetAllClasses() (*[]Class, error) {
 	return cs, nil
 }
 
+// GetClassTree gets every base class in our database along with its
+// subclasses
+func (db *DB) GetClassTree() (*[]ClassNode, error) {
+	cs, err := db.GetAllClasses()
+	if err != nil {
+		return nil, err
+	}
+	tree := ClassTree(*cs)
+	return &tree, nil
+}
+
 // GetClassByName get a list of every spells that a class can use
 func (db *DB) GetClassByName(name string) (*Class, error) {
 	// verify arguments before hitting the db
@@ -48,21 +105,44 @@ func (db *DB) GetClassByName(name string) (*Class, error) {
 	return c, nil
 }
 
-// GetClassSpells searches the database and returns a slice of
-// Spell objects available to the class with classID
-func (db *DB) GetClassSpells(classID int) (*[]Spell, error) {
+// GetSubclasses returns the subclasses of the class with classID,
+// sorted by name. A subclass has none.
+func (db *DB) GetSubclasses(classID int) (*[]Class, error) {
+	if classID <= 0 {
+		return nil, ErrNoResult
+	}
+
+	cs := &[]Class{}
+	if err := db.Select(cs, `SELECT id, name, base_class_id FROM Class
+							 WHERE base_class_id = ?
+							 ORDER BY name`, classID); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// GetClassSpells searches the database and returns the spells
+// available to the class with classID, sorted by name. A subclass's
+// list is its base class's along with the spells it expands it with,
+// which are marked Subclass.
+func (db *DB) GetClassSpells(classID int) (*[]ClassSpell, error) {
 	if classID <= 0 {
 		return nil, ErrNoResult
 	}
 
-	spells := &[]Spell{}
-	err := db.Select(spells, `SELECT S.id, S.name
-	 					  	  FROM Spell AS S
-						  	  JOIN ClassSpells as CS ON
-						  	  S.id = CS.spell_id
-						  	  JOIN Class AS C ON
-						  	  CS.class_id = C.id
-						  	  WHERE C.id = ?`, classID)
+	// A spell on both lists is the base class's. For a base class,
+	// base_class_id is NULL and every row is its own.
+	spells := &[]ClassSpell{}
+	err := db.Select(spells, `SELECT S.id, S.name,
+							  MIN(CS.class_id <> COALESCE(C.base_class_id, C.id)) AS subclass
+							  FROM Class AS C
+							  JOIN ClassSpells AS CS ON
+							  CS.class_id IN (C.id, C.base_class_id)
+							  JOIN Spell AS S ON
+							  S.id = CS.spell_id
+							  WHERE C.id = ?
+							  GROUP BY S.id, S.name
+							  ORDER BY S.name`, classID)
 	if err != nil {
 		return nil, err
 	}
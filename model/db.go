@@ -13,6 +13,9 @@ var (
 	// ErrInvalidID is raised when a given database ID is
 	// either negative or does not exist
 	ErrInvalidID = errors.New("model: invalid userID")
+	// ErrInvalidLevel is raised when a character level isn't
+	// between 1 and 20
+	ErrInvalidLevel = errors.New("model: invalid character level")
 	// ErrNoResult is raised when a query returns no results
 	ErrNoResult = sql.ErrNoRows
 	// ErrNoResult is here to wrap the sql error. In our queries,
@@ -31,6 +34,7 @@ var (
 type Datastore interface {
 	SpellDatastore
 	ClassDatastore
+	SourceDatastore
 	CharacterDatastore
 	UserDatastore
 }
@@ -39,6 +43,8 @@ type Datastore interface {
 // use to implement the Datastore interface
 type DB struct {
 	*sqlx.DB
+	// names is what SuggestSpells completes from
+	names *spellNames
 }
 
 // NewDB returns an initialized DB connected to the mysql database
@@ -52,5 +58,9 @@ func NewDB(dsn string) (Datastore, error) {
 	if err := db.Ping(); err != nil {
 		return nil, err
 	}
-	return &DB{db}, nil
+	d := &DB{DB: db, names: newSpellNames()}
+	if err := d.LoadSpellNames(); err != nil {
+		return nil, err
+	}
+	return d, nil
 }
@@ -0,0 +1,152 @@
+package memdb
+
+import (
+	"sort"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+type charactersByID []model.Character
+
+func (c charactersByID) Len() int           { return len(c) }
+func (c charactersByID) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c charactersByID) Less(i, j int) bool { return c[i].ID < c[j].ID }
+
+type levelsByClassID []model.CharacterLevel
+
+func (l levelsByClassID) Len() int           { return len(l) }
+func (l levelsByClassID) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l levelsByClassID) Less(i, j int) bool { return l[i].ClassID < l[j].ClassID }
+
+// GetAllCharacters gets a list of every character belonging to a
+// specified user
+func (db *DB) GetAllCharacters(userID int) (*[]model.Character, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	cs := []model.Character{}
+	for _, c := range db.characters {
+		if c.UserID == userID {
+			cs = append(cs, c)
+		}
+	}
+	sort.Sort(charactersByID(cs))
+	return &cs, nil
+}
+
+// GetCharacterByName returns a user's character with matching name
+func (db *DB) GetCharacterByName(userID int, name string) (*model.Character, error) {
+	if name == "" {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var found *model.Character
+	for _, c := range db.characters {
+		if c.UserID == userID && equalFold(c.Name, name) {
+			if found == nil || c.ID < found.ID {
+				c := c
+				found = &c
+			}
+		}
+	}
+	if found == nil {
+		return nil, model.ErrNoResult
+	}
+	return found, nil
+}
+
+// CreateCharacter adds a character, and its levels in any classes,
+// returning its new id. Like model.DB, the character is owned by
+// char.UserID, and nothing is added if any of it is bad.
+func (db *DB) CreateCharacter(userID int, char *model.Character, levels ...model.CharacterLevel) (int, error) {
+	for _, l := range levels {
+		if l.Level < 1 || l.Level > model.MaxCharacterLevel {
+			return 0, model.ErrInvalidLevel
+		}
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// foreign key constraints on user_id and class_id
+	if _, ok := db.users[char.UserID]; !ok {
+		return 0, model.ErrInvalidID
+	}
+	for _, l := range levels {
+		if _, ok := db.classes[l.ClassID]; !ok {
+			return 0, model.ErrInvalidID
+		}
+	}
+	c := *char
+	c.ID = db.nextCharID
+	db.nextCharID++
+	db.characters[c.ID] = c
+	if len(levels) > 0 {
+		db.charLevels[c.ID] = make(map[int]int)
+		for _, l := range levels {
+			db.charLevels[c.ID][l.ClassID] = l.Level
+		}
+	}
+	return c.ID, nil
+}
+
+// GetCharacterLevels gets the levels a character has in each of
+// their classes
+func (db *DB) GetCharacterLevels(charID int) (*[]model.CharacterLevel, error) {
+	if charID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	ls := []model.CharacterLevel{}
+	for classID, level := range db.charLevels[charID] {
+		ls = append(ls, model.CharacterLevel{CharID: charID, ClassID: classID, Level: level})
+	}
+	sort.Sort(levelsByClassID(ls))
+	return &ls, nil
+}
+
+// GetCharacterLevel returns a character's total level, the sum of
+// their levels in every class
+func (db *DB) GetCharacterLevel(charID int) (int, error) {
+	if charID <= 0 {
+		return 0, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	total := 0
+	for _, level := range db.charLevels[charID] {
+		total += level
+	}
+	return total, nil
+}
+
+// SetCharacterLevel sets how many levels a character has in a class
+func (db *DB) SetCharacterLevel(charID, classID, level int) error {
+	if level < 1 || level > model.MaxCharacterLevel {
+		return model.ErrInvalidLevel
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// foreign key constraints on char_id and class_id
+	if _, ok := db.characters[charID]; !ok {
+		return model.ErrInvalidID
+	}
+	if _, ok := db.classes[classID]; !ok {
+		return model.ErrInvalidID
+	}
+	if db.charLevels[charID] == nil {
+		db.charLevels[charID] = make(map[int]int)
+	}
+	db.charLevels[charID][classID] = level
+	return nil
+}
@@ -0,0 +1,111 @@
+package memdb
+
+import (
+	"sort"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+type classesByID []model.Class
+
+func (c classesByID) Len() int           { return len(c) }
+func (c classesByID) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c classesByID) Less(i, j int) bool { return c[i].ID < c[j].ID }
+
+// GetAllClasses gets a list of every class
+func (db *DB) GetAllClasses() (*[]model.Class, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	cs := make([]model.Class, 0, len(db.classes))
+	for _, c := range db.classes {
+		cs = append(cs, c)
+	}
+	sort.Sort(classesByID(cs))
+	return &cs, nil
+}
+
+// GetClassTree gets every base class along with its subclasses
+func (db *DB) GetClassTree() (*[]model.ClassNode, error) {
+	cs, err := db.GetAllClasses()
+	if err != nil {
+		return nil, err
+	}
+	tree := model.ClassTree(*cs)
+	return &tree, nil
+}
+
+// GetClassByName returns the class with matching name
+func (db *DB) GetClassByName(name string) (*model.Class, error) {
+	if name == "" {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	for _, c := range db.classes {
+		if equalFold(c.Name, name) {
+			return &c, nil
+		}
+	}
+	return nil, model.ErrNoResult
+}
+
+// GetSubclasses returns the subclasses of the class with classID,
+// sorted by name
+func (db *DB) GetSubclasses(classID int) (*[]model.Class, error) {
+	if classID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	cs := []model.Class{}
+	for _, c := range db.classes {
+		if c.BaseClass.Valid && int(c.BaseClass.Int64) == classID {
+			cs = append(cs, c)
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Name < cs[j].Name })
+	return &cs, nil
+}
+
+// GetClassSpells returns the spells available to the class with
+// classID, sorted by name. For a subclass, these are its base class's
+// along with the ones it adds, marked Subclass. See model.DB.
+func (db *DB) GetClassSpells(classID int) (*[]model.ClassSpell, error) {
+	if classID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	base := db.classes[classID].BaseClass
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return db.onClassList(classID, s.ID)
+	})
+	sort.Stable(byName(spells))
+	cs := make([]model.ClassSpell, len(spells))
+	for i, s := range spells {
+		cs[i] = model.ClassSpell{
+			ID:       s.ID,
+			Name:     s.Name,
+			Subclass: base.Valid && !db.classSpells[model.ClassSpells{ClassID: int(base.Int64), SpellID: s.ID}],
+		}
+	}
+	return &cs, nil
+}
+
+// onClassList reports whether spellID is on classID's spell list, or
+// on its base class's if it's a subclass. Callers must hold the read
+// lock.
+func (db *DB) onClassList(classID, spellID int) bool {
+	if db.classSpells[model.ClassSpells{ClassID: classID, SpellID: spellID}] {
+		return true
+	}
+	base := db.classes[classID].BaseClass
+	return base.Valid && db.classSpells[model.ClassSpells{ClassID: int(base.Int64), SpellID: spellID}]
+}
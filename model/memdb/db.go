@@ -0,0 +1,267 @@
+// Package memdb provides an in-memory implementation of model.Datastore.
+//
+// It is seeded from the same compendium xml that initDb parses into our
+// mysql database, so the app can be demoed and its handlers exercised
+// without standing up a database. Every method mirrors the behavior of
+// *model.DB, including the cannon/homebrew split done by the CannonSpells
+// view and model.ErrNoResult semantics.
+package memdb
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/murder-hobos/murder-hobos/db/initDb"
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+	"github.com/murder-hobos/murder-hobos/fulltext"
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/prefix"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+	"github.com/murder-hobos/murder-hobos/util"
+)
+
+// make sure we stay in sync with model.Datastore
+var _ model.Datastore = (*DB)(nil)
+
+// DB is an in-memory datastore. The zero value is not usable,
+// use New or NewSeeded to create one.
+type DB struct {
+	mu sync.RWMutex
+
+	spells      map[int]model.Spell
+	classes     map[int]model.Class
+	classSpells map[model.ClassSpells]bool
+	rolls       map[int][]model.SpellRoll
+	upcasts     map[int][]model.SpellUpcast
+	cantrips    map[int][]model.CantripScaling
+	damageTypes map[int][]model.SpellDamageType
+	saves       map[int][]model.SpellSave
+	text        *fulltext.Index
+	names       *prefix.Index
+	characters  map[int]model.Character
+	charLevels  map[int]map[int]int
+	users       map[int]model.User
+	sources     map[int]model.Source
+
+	// emulate AUTO_INCREMENT
+	nextSpellID int
+	nextClassID int
+	nextCharID  int
+	nextUserID  int
+}
+
+// New returns a DB in the same state as a freshly initialized mysql
+// database before any spells are imported: the compendium's sources
+// exist, nothing else does. Classes are created as spells using them
+// are loaded.
+func New() *DB {
+	db := &DB{
+		spells:      make(map[int]model.Spell),
+		classes:     make(map[int]model.Class),
+		classSpells: make(map[model.ClassSpells]bool),
+		rolls:       make(map[int][]model.SpellRoll),
+		upcasts:     make(map[int][]model.SpellUpcast),
+		cantrips:    make(map[int][]model.CantripScaling),
+		damageTypes: make(map[int][]model.SpellDamageType),
+		saves:       make(map[int][]model.SpellSave),
+		text:        fulltext.NewIndex(),
+		names:       prefix.NewIndex(),
+		characters:  make(map[int]model.Character),
+		charLevels:  make(map[int]map[int]int),
+		users:       make(map[int]model.User),
+		sources:     make(map[int]model.Source),
+		nextSpellID: 1,
+		nextClassID: 1,
+		nextCharID:  1,
+		nextUserID:  1,
+	}
+
+	// Same rows our sources migration inserts
+	for _, b := range migrate.CanonBooks {
+		db.sources[b.ID] = model.Source{
+			ID:           b.ID,
+			Name:         b.Name,
+			Abbreviation: b.Abbreviation,
+			Publisher:    b.Publisher,
+			Canon:        true,
+			Released:     util.ToNullString(b.Released),
+		}
+	}
+	return db
+}
+
+// NewSeeded returns a DB populated with every spell from the
+// compendium xml bundled with initDb.
+func NewSeeded() (*DB, error) {
+	b, err := initDb.Asset(initDb.CompendiumAsset)
+	if err != nil {
+		return nil, err
+	}
+	db := New()
+	if err := db.LoadCompendium(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// LoadCompendium parses compendium xml from r and inserts each spell
+// along with its class relationships, rolls, upcasting rules and
+// cantrip scaling, exactly like murder-hobos-init-db does for mysql,
+// creating classes it hasn't seen.
+// If any spell fails to convert, nothing is inserted.
+func (db *DB) LoadCompendium(r io.Reader) error {
+	c, err := initDb.ReadCompendium(r)
+	if err != nil {
+		return err
+	}
+
+	spells := make([]model.Spell, 0, len(c.XMLSpells))
+	classes := make([][]string, 0, len(c.XMLSpells))
+	rolls := make([][]string, 0, len(c.XMLSpells))
+	upcasts := make([][]spelltext.UpcastRule, 0, len(c.XMLSpells))
+	cantrips := make([][]spelltext.CantripRule, 0, len(c.XMLSpells))
+	for _, x := range c.XMLSpells {
+		s, err := x.ToDbSpell()
+		if err != nil {
+			return fmt.Errorf("memdb: converting %s: %s", x.Name, err.Error())
+		}
+		source, err := db.GetSourceByAbbreviation(x.SourceAbbreviation())
+		if err != nil {
+			return fmt.Errorf("memdb: converting %s: no source %s", x.Name, x.SourceAbbreviation())
+		}
+		s.SourceID = sql.NullInt64{Int64: int64(source.ID), Valid: true}
+		spells = append(spells, s)
+		classes = append(classes, x.ClassNames())
+		rolls = append(rolls, x.RollExpressions())
+		upcasts = append(upcasts, spelltext.ParseUpcast(s.Description))
+		cantrips = append(cantrips, initDb.CantripRules(s))
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, s := range spells {
+		id := db.insertSpell(s)
+		for _, name := range classes[i] {
+			c := db.resolveClass(name)
+			db.classSpells[model.ClassSpells{ClassID: c.ID, SpellID: id}] = true
+		}
+		for pos, expr := range rolls[i] {
+			db.rolls[id] = append(db.rolls[id], model.SpellRoll{SpellID: id, Position: pos, Expression: expr})
+		}
+		for pos, u := range upcasts[i] {
+			db.upcasts[id] = append(db.upcasts[id], model.SpellUpcast{SpellID: id, Position: pos, UpcastRule: u})
+		}
+		for pos, r := range cantrips[i] {
+			db.cantrips[id] = append(db.cantrips[id], model.CantripScaling{SpellID: id, Position: pos, CantripRule: r})
+		}
+	}
+	return nil
+}
+
+// resolveClass returns the class called name, creating it, and its
+// base class if it's a subclass, if it doesn't exist yet. Like
+// initDb.ClassResolver does for mysql. Callers must hold the write lock.
+func (db *DB) resolveClass(name string) model.Class {
+	name = strings.TrimSpace(name)
+	for _, c := range db.classes {
+		if equalFold(c.Name, name) {
+			return c
+		}
+	}
+
+	c := model.Class{ID: db.nextClassID, Name: name}
+	if base, subclass := initDb.SplitClassName(name); subclass != "" {
+		b := db.resolveClass(base)
+		c.BaseClass = sql.NullInt64{Int64: int64(b.ID), Valid: true}
+		c.ID = db.nextClassID
+	}
+	db.nextClassID++
+	db.classes[c.ID] = c
+	return c
+}
+
+// insertSpell assigns s the next spell id and stores it, along with
+// the damage types and saves its description calls for, and indexes
+// it for full-text search and suggestions.
+// Callers must hold the write lock.
+func (db *DB) insertSpell(s model.Spell) int {
+	s.ID = db.nextSpellID
+	db.nextSpellID++
+	db.spells[s.ID] = s
+	for _, t := range spelltext.ParseDamageTypes(s.Description) {
+		db.damageTypes[s.ID] = append(db.damageTypes[s.ID], model.SpellDamageType{SpellID: s.ID, DamageType: t})
+	}
+	for _, a := range spelltext.ParseSaves(s.Description) {
+		db.saves[s.ID] = append(db.saves[s.ID], model.SpellSave{SpellID: s.ID, Ability: a})
+	}
+	db.text.Add(s.ID, s.SearchFields()...)
+	db.names.Add(s.ID, s.Name)
+	return s.ID
+}
+
+// isCannon mirrors the CannonSpells view. Callers must hold the read
+// lock.
+func (db *DB) isCannon(s model.Spell) bool {
+	return s.SourceID.Valid && db.sources[int(s.SourceID.Int64)].Canon
+}
+
+// ownedBy reports whether s is userID's homebrew
+func ownedBy(s model.Spell, userID int) bool {
+	return s.UserID.Valid && int(s.UserID.Int64) == userID
+}
+
+// sortedSpells returns every spell matching keep, ordered by id like
+// an unordered mysql select on the primary key.
+// Callers must hold the read lock.
+func (db *DB) sortedSpells(keep func(model.Spell) bool) []model.Spell {
+	spells := []model.Spell{}
+	for _, s := range db.spells {
+		if keep(s) {
+			spells = append(spells, s)
+		}
+	}
+	sort.Sort(byID(spells))
+	return spells
+}
+
+type byID []model.Spell
+
+func (s byID) Len() int           { return len(s) }
+func (s byID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byID) Less(i, j int) bool { return s[i].ID < s[j].ID }
+
+type byName []model.Spell
+
+func (s byName) Len() int           { return len(s) }
+func (s byName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byName) Less(i, j int) bool { return lower(s[i].Name) < lower(s[j].Name) }
+
+// pageOrder sorts spells by a model.Page's sort key, stably so spells
+// already ordered by name and id stay that way within a key
+type pageOrder struct {
+	spells []model.Spell
+	page   model.Page
+}
+
+func (o pageOrder) Len() int      { return len(o.spells) }
+func (o pageOrder) Swap(i, j int) { o.spells[i], o.spells[j] = o.spells[j], o.spells[i] }
+func (o pageOrder) Less(i, j int) bool {
+	a, b := o.spells[i], o.spells[j]
+	if o.page.Desc {
+		a, b = b, a
+	}
+	switch o.page.Sort {
+	case model.SortLevel:
+		return a.Level < b.Level
+	case model.SortSchool:
+		return a.School < b.School
+	case model.SortCastTime:
+		return a.CastSeconds < b.CastSeconds
+	}
+	return lower(a.Name) < lower(b.Name)
+}
@@ -0,0 +1,477 @@
+package memdb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+const testCompendium = `<?xml version='1.0' encoding='UTF-8'?>
+<compendium version="5" source="PHB">
+	<spell>
+		<name>Fire Bolt</name>
+		<level>0</level>
+		<school>EV</school>
+		<time>1 action</time>
+		<range>120 feet</range>
+		<components>V, S</components>
+		<duration>Instantaneous</duration>
+		<classes>Sorcerer, Wizard, Fighter</classes>
+		<text>You hurl a mote of fire at a creature or object within range. Make a ranged spell attack against the target. On a hit, the target takes 1d10 fire damage.</text>
+		<roll>1d20+SPELL+PROF</roll>
+		<roll>1d10</roll>
+	</spell>
+	<spell>
+		<name>Absorb Elements (EE)</name>
+		<level>1</level>
+		<school>A</school>
+		<time>1 reaction, which you take when you take acid, cold, fire, lightning, or thunder damage</time>
+		<range>Self</range>
+		<components>S</components>
+		<duration>1 round</duration>
+		<classes>Druid, Ranger, Wizard, Fighter (Eldritch Knight)</classes>
+		<text>The spell captures some of the incoming energy.</text>
+		<text/>
+		<text>At Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, the extra damage increases by 1d6 for each slot level above 1st.</text>
+	</spell>
+</compendium>`
+
+func newTestDB(t *testing.T) *DB {
+	db := New()
+	if err := db.LoadCompendium(strings.NewReader(testCompendium)); err != nil {
+		t.Fatalf("LoadCompendium() error = %v", err)
+	}
+	return db
+}
+
+func TestNewSeeded(t *testing.T) {
+	db, err := NewSeeded()
+	if err != nil {
+		t.Fatalf("NewSeeded() error = %v", err)
+	}
+	spells, err := db.GetAllCannonSpells()
+	if err != nil {
+		t.Fatalf("GetAllCannonSpells() error = %v", err)
+	}
+	if len(*spells) != 408 {
+		t.Errorf("GetAllCannonSpells() got %d spells, want 408", len(*spells))
+	}
+	if (*spells)[0].Name != "Abi-Dalzim's Horrid Wilting" {
+		t.Errorf("GetAllCannonSpells() starts with %s, want them by name", (*spells)[0].Name)
+	}
+
+	page, err := db.ListCannonSpells(model.SpellFilter{}, model.Page{Number: 9})
+	if err != nil || page.Total != 408 || len(page.Spells) != 8 || page.Page.Size != model.DefaultPageSize {
+		t.Errorf("ListCannonSpells(page 9) = %d of %d, %v", len(page.Spells), page.Total, err)
+	}
+	page, err = db.ListCannonSpells(model.SpellFilter{}, model.Page{Number: 10})
+	if err != nil || page.Total != 408 || len(page.Spells) != 0 {
+		t.Errorf("ListCannonSpells(page 10) = %d of %d, %v", len(page.Spells), page.Total, err)
+	}
+	page, err = db.ListCannonSpells(model.SpellFilter{Levels: []int{9}}, model.Page{Sort: model.SortCastTime, Desc: true, Size: 2})
+	if err != nil || len(page.Spells) != 2 || page.Spells[0].Name != "Astral Projection" {
+		t.Errorf("ListCannonSpells(level 9 by cast time) = %v, %v", page, err)
+	}
+	if _, err := db.ListCannonSpells(model.SpellFilter{}, model.Page{Sort: "color"}); err != model.ErrInvalidSort {
+		t.Errorf("ListCannonSpells(color) error = %v, want ErrInvalidSort", err)
+	}
+
+	cs, _ := db.GetAllClasses()
+	// every class and subclass the compendium mentions, the same
+	// ones our initial schema inserts
+	if len(*cs) != 38 {
+		t.Errorf("GetAllClasses() got %d classes, want 38", len(*cs))
+	}
+}
+
+func TestDB_CannonSpells(t *testing.T) {
+	db := newTestDB(t)
+
+	s, err := db.GetCannonSpellByName("fire bolt")
+	if err != nil {
+		t.Fatalf("GetCannonSpellByName() error = %v", err)
+	}
+	if s.Name != "Fire Bolt" || s.School != "Evocation" || s.SourceID.Int64 != 1 || s.IsHomebrew() {
+		t.Errorf("GetCannonSpellByName() = %+v", s)
+	}
+
+	ee, err := db.GetCannonSpellByName("Absorb Elements")
+	if err != nil {
+		t.Fatalf("GetCannonSpellByName() error = %v", err)
+	}
+	if source, err := db.GetSourceByID(int(ee.SourceID.Int64)); err != nil || source.Abbreviation != "EE" {
+		t.Errorf("Absorb Elements source = %+v, %v, want EE", source, err)
+	}
+
+	if _, err := db.GetCannonSpellByName("Nope"); err != model.ErrNoResult {
+		t.Errorf("GetCannonSpellByName(Nope) error = %v, want ErrNoResult", err)
+	}
+	if _, err := db.GetCannonSpellByName(""); err != model.ErrNoResult {
+		t.Errorf("GetCannonSpellByName(\"\") error = %v, want ErrNoResult", err)
+	}
+
+	found, err := db.SearchCannonSpells("E")
+	if err != nil {
+		t.Fatalf("SearchCannonSpells() error = %v", err)
+	}
+	if len(*found) != 2 || (*found)[0].Name != "Absorb Elements" {
+		t.Errorf("SearchCannonSpells() = %v, want both spells ordered by name", *found)
+	}
+
+	if _, err := db.FilterCannonSpells(model.SpellFilter{}); err != model.ErrNoResult {
+		t.Errorf("FilterCannonSpells() with no filters error = %v, want ErrNoResult", err)
+	}
+	filtered, err := db.FilterCannonSpells(model.SpellFilter{Levels: []int{0}, Schools: []string{"evocation"}})
+	if err != nil {
+		t.Fatalf("FilterCannonSpells() error = %v", err)
+	}
+	if len(*filtered) != 1 || (*filtered)[0].Name != "Fire Bolt" {
+		t.Errorf("FilterCannonSpells() = %v", *filtered)
+	}
+	filtered, err = db.FilterCannonSpells(model.SpellFilter{DamageType: "Fire", Attack: "ranged"})
+	if err != nil {
+		t.Fatalf("FilterCannonSpells(fire, ranged) error = %v", err)
+	}
+	if len(*filtered) != 1 || (*filtered)[0].Name != "Fire Bolt" {
+		t.Errorf("FilterCannonSpells(fire, ranged) = %v", *filtered)
+	}
+	if found, err := db.FilterCannonSpells(model.SpellFilter{Save: "dex"}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterCannonSpells(dex) = %v, %v, want none", found, err)
+	}
+	if _, err := db.FilterCannonSpells(model.SpellFilter{Attack: "sideways"}); err != model.ErrNoResult {
+		t.Errorf("FilterCannonSpells(sideways) error = %v, want ErrNoResult", err)
+	}
+	yes, no := true, false
+	filtered, err = db.FilterCannonSpells(model.SpellFilter{
+		Levels:  []int{0, 1},
+		Class:   "fighter (eldritch knight)",
+		Sources: []string{"ee"},
+		Somatic: &yes,
+		Verbal:  &no,
+		Name:    "absorb",
+	})
+	if err != nil || len(*filtered) != 1 || (*filtered)[0].Name != "Absorb Elements" {
+		t.Errorf("FilterCannonSpells(everything) = %v, %v", filtered, err)
+	}
+	// Fire Bolt is an action at 120 feet, Absorb Elements a reaction on
+	// yourself
+	if found, err := db.FilterCannonSpells(model.SpellFilter{CastUnits: []string{"reaction"}, MaxRangeFeet: 5}); err != nil || len(*found) != 1 || (*found)[0].Name != "Absorb Elements" {
+		t.Errorf("FilterCannonSpells(reaction, 5 feet) = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(model.SpellFilter{MaxRangeFeet: 120, DurationKinds: []string{"rounds", "instantaneous"}}); err != nil || len(*found) != 2 {
+		t.Errorf("FilterCannonSpells(120 feet) = %v, %v, want both spells", found, err)
+	}
+	if _, err := db.FilterCannonSpells(model.SpellFilter{AreaShapes: []string{"blob"}}); err != model.ErrNoResult {
+		t.Errorf("FilterCannonSpells(blob) error = %v, want ErrNoResult", err)
+	}
+	// the Eldritch Knight's list is the Fighter's, Fire Bolt, and its own
+	if found, err := db.FilterCannonSpells(model.SpellFilter{Class: "Fighter (Eldritch Knight)"}); err != nil || len(*found) != 2 {
+		t.Errorf("FilterCannonSpells(Eldritch Knight) = %v, %v, want both spells", found, err)
+	}
+	if found, err := db.FilterCannonSpells(model.SpellFilter{Class: "Fighter"}); err != nil || len(*found) != 1 || (*found)[0].Name != "Fire Bolt" {
+		t.Errorf("FilterCannonSpells(Fighter) = %v, %v, want Fire Bolt", found, err)
+	}
+	if _, err := db.FilterCannonSpells(model.SpellFilter{Levels: []int{10}}); err != model.ErrNoResult {
+		t.Errorf("FilterCannonSpells(level 10) error = %v, want ErrNoResult", err)
+	}
+	filtered, err = db.FilterCannonSpells(model.SpellFilter{DamageType: "fire"})
+	if err != nil || len(*filtered) != 1 {
+		t.Fatalf("FilterCannonSpells(fire) = %v, %v", filtered, err)
+	}
+	damage, err := db.GetSpellDamageTypes((*filtered)[0].ID)
+	if err != nil || len(*damage) != 1 || (*damage)[0].DamageType != "fire" {
+		t.Errorf("GetSpellDamageTypes() = %v, %v", damage, err)
+	}
+
+	matches, err := db.FullTextSearchCannonSpells("fires at creatures", model.SpellFilter{})
+	if err != nil {
+		t.Fatalf("FullTextSearchCannonSpells() error = %v", err)
+	}
+	if len(*matches) != 1 || (*matches)[0].Name != "Fire Bolt" || (*matches)[0].Score <= 0 {
+		t.Fatalf("FullTextSearchCannonSpells() = %+v, want Fire Bolt", *matches)
+	}
+	if snippet := string((*matches)[0].Snippet); !strings.Contains(snippet, "<mark>fire</mark> at a <mark>creature</mark>") {
+		t.Errorf("FullTextSearchCannonSpells() snippet = %q", snippet)
+	}
+	matches, err = db.FullTextSearchCannonSpells("creature", model.SpellFilter{Levels: []int{1}})
+	if err != nil || len(*matches) != 0 {
+		t.Errorf("FullTextSearchCannonSpells(level 1) = %v, %v, want none", matches, err)
+	}
+	if _, err := db.FullTextSearchCannonSpells("the", model.SpellFilter{}); err != model.ErrNoResult {
+		t.Errorf("FullTextSearchCannonSpells(stop word) error = %v, want ErrNoResult", err)
+	}
+
+	matches, err = db.FuzzySearchCannonSpells("absorb elemnts")
+	if err != nil || len(*matches) != 1 || (*matches)[0].ID != ee.ID {
+		t.Errorf("FuzzySearchCannonSpells() = %v, %v, want Absorb Elements", matches, err)
+	}
+	if matches, err := db.FuzzySearchCannonSpells("Eldritch Blast"); err != nil || len(*matches) != 0 {
+		t.Errorf("FuzzySearchCannonSpells(Eldritch Blast) = %v, %v, want none", matches, err)
+	}
+
+	if found, err := db.SuggestSpells(0, "ELEM"); err != nil || len(*found) != 1 || (*found)[0].ID != ee.ID {
+		t.Errorf("SuggestSpells() = %v, %v, want Absorb Elements", found, err)
+	}
+
+	facets, err := db.CannonSpellFacets("", model.SpellFilter{})
+	if err != nil {
+		t.Fatalf("CannonSpellFacets() error = %v", err)
+	}
+	if len(facets.Schools) != 2 || facets.Schools[0].Value != "Abjuration" || facets.Schools[0].Count != 1 {
+		t.Errorf("CannonSpellFacets() schools = %+v", facets.Schools)
+	}
+	if len(facets.Classes) != 6 || facets.Classes[len(facets.Classes)-1] != (model.FacetCount{Value: "Wizard", Count: 2}) ||
+		facets.Classes[2] != (model.FacetCount{Value: "Fighter (Eldritch Knight)", Count: 2}) {
+		t.Errorf("CannonSpellFacets() classes = %+v", facets.Classes)
+	}
+	if len(facets.Sources) != 2 || facets.Sources[1].Value != "EE" || facets.Sources[1].Label != "Elemental Evil Player's Companion" {
+		t.Errorf("CannonSpellFacets() sources = %+v", facets.Sources)
+	}
+	facets, err = db.CannonSpellFacets("creature", model.SpellFilter{})
+	if err != nil || len(facets.Levels) != 1 || facets.Levels[0] != (model.FacetCount{Value: "0", Count: 1}) {
+		t.Errorf("CannonSpellFacets(creature) = %+v, %v", facets, err)
+	}
+	if _, err := db.CannonSpellFacets("", model.SpellFilter{Attack: "sideways"}); err != model.ErrNoResult {
+		t.Errorf("CannonSpellFacets(sideways) error = %v, want ErrNoResult", err)
+	}
+	if _, err := db.UserSpellFacets(0, "", model.SpellFilter{}); err != model.ErrInvalidID {
+		t.Errorf("UserSpellFacets(0) error = %v, want ErrInvalidID", err)
+	}
+
+	classes, err := db.GetSpellClasses(ee.ID)
+	if err != nil {
+		t.Fatalf("GetSpellClasses() error = %v", err)
+	}
+	if len(*classes) != 4 {
+		t.Errorf("GetSpellClasses() got %d classes, want 4", len(*classes))
+	}
+	if _, err := db.GetSpellClasses(0); err != model.ErrNoResult {
+		t.Errorf("GetSpellClasses(0) error = %v, want ErrNoResult", err)
+	}
+
+	rolls, err := db.GetSpellRolls(s.ID)
+	if err != nil {
+		t.Fatalf("GetSpellRolls() error = %v", err)
+	}
+	if len(*rolls) != 2 || (*rolls)[0].Expression != "1d20+SPELL+PROF" || (*rolls)[1].Position != 1 {
+		t.Errorf("GetSpellRolls() = %+v, want both rolls in order", *rolls)
+	}
+	if rolls, err := db.GetSpellRolls(ee.ID); err != nil || len(*rolls) != 0 {
+		t.Errorf("GetSpellRolls() of a spell without rolls = %v, %v", rolls, err)
+	}
+
+	upcasts, err := db.GetSpellUpcasts(ee.ID)
+	if err != nil {
+		t.Fatalf("GetSpellUpcasts() error = %v", err)
+	}
+	if len(*upcasts) != 1 || (*upcasts)[0].Dice != "1d6" || (*upcasts)[0].AboveSlot != 1 {
+		t.Errorf("GetSpellUpcasts() = %+v, want 1d6 per slot above 1st", *upcasts)
+	}
+	if upcasts, err := db.GetSpellUpcasts(s.ID); err != nil || len(*upcasts) != 0 {
+		t.Errorf("GetSpellUpcasts() of a spell without upcasts = %v, %v", upcasts, err)
+	}
+
+	wiz, err := db.GetClassByName("Wizard")
+	if err != nil {
+		t.Fatalf("GetClassByName() error = %v", err)
+	}
+	spells, err := db.GetClassSpells(wiz.ID)
+	if err != nil {
+		t.Fatalf("GetClassSpells() error = %v", err)
+	}
+	if len(*spells) != 2 || (*spells)[0].Name != "Absorb Elements" || (*spells)[0].Subclass {
+		t.Errorf("GetClassSpells() = %+v, want 2 Wizard spells by name", spells)
+	}
+
+	ek, err := db.GetClassByName("fighter (eldritch knight)")
+	if err != nil || !ek.IsSubclass() {
+		t.Fatalf("GetClassByName() = %+v, %v", ek, err)
+	}
+	spells, err = db.GetClassSpells(ek.ID)
+	if err != nil || len(*spells) != 2 || !(*spells)[0].Subclass || (*spells)[1].Subclass {
+		t.Errorf("GetClassSpells(Eldritch Knight) = %+v, %v, want Absorb Elements from the subclass and Fire Bolt from Fighter", spells, err)
+	}
+	subclasses, err := db.GetSubclasses(int(ek.BaseClass.Int64))
+	if err != nil || len(*subclasses) != 1 || (*subclasses)[0].ID != ek.ID {
+		t.Errorf("GetSubclasses(Fighter) = %+v, %v", subclasses, err)
+	}
+	if subclasses, err := db.GetSubclasses(wiz.ID); err != nil || len(*subclasses) != 0 {
+		t.Errorf("GetSubclasses(Wizard) = %+v, %v, want none", subclasses, err)
+	}
+	tree, err := db.GetClassTree()
+	if err != nil || len(*tree) != 5 {
+		t.Fatalf("GetClassTree() = %+v, %v", tree, err)
+	}
+	if fighter := (*tree)[1]; fighter.Name != "Fighter" || len(fighter.Subclasses) != 1 || fighter.Subclasses[0].ID != ek.ID {
+		t.Errorf("GetClassTree()[1] = %+v, want Fighter and the Eldritch Knight", fighter)
+	}
+}
+
+func TestDB_Sources(t *testing.T) {
+	db := newTestDB(t)
+
+	sources, err := db.GetAllSources()
+	if err != nil || len(*sources) != len(migrate.CanonBooks) {
+		t.Fatalf("GetAllSources() = %v, %v", sources, err)
+	}
+	for i, b := range migrate.CanonBooks {
+		s := (*sources)[i]
+		if s.ID != b.ID || s.Abbreviation != b.Abbreviation || s.Released.String != b.Released || !s.Canon {
+			t.Errorf("GetAllSources()[%d] = %+v, want %+v", i, s, b)
+		}
+	}
+	if s, err := db.GetSourceByAbbreviation("scag"); err != nil || s.ID != 3 {
+		t.Errorf("GetSourceByAbbreviation(scag) = %+v, %v", s, err)
+	}
+	if _, err := db.GetSourceByID(0); err != model.ErrNoResult {
+		t.Errorf("GetSourceByID(0) error = %v, want ErrNoResult", err)
+	}
+	if _, err := db.GetSourceByAbbreviation("UA"); err != model.ErrNoResult {
+		t.Errorf("GetSourceByAbbreviation(UA) error = %v, want ErrNoResult", err)
+	}
+}
+
+func TestDB_UserSpells(t *testing.T) {
+	db := newTestDB(t)
+
+	u, ok := db.CreateUser("bob", "hunter2")
+	if !ok {
+		t.Fatal("CreateUser() failed")
+	}
+
+	id, err := db.CreateSpell(u.ID, model.Spell{
+		Name:        "Fire Bolt",
+		Level:       "0",
+		School:      "Evocation",
+		CastTime:    "1 bonus action",
+		Range:       "Self (15-foot cone)",
+		Description: "<script>alert(1)</script>pew",
+	})
+	if err != nil {
+		t.Fatalf("CreateSpell() error = %v", err)
+	}
+
+	// homebrew never shows up as cannon
+	cannon, _ := db.SearchCannonSpells("Fire Bolt")
+	if len(*cannon) != 1 {
+		t.Errorf("SearchCannonSpells() got %d spells, want 1", len(*cannon))
+	}
+
+	s, err := db.GetUserSpellByName(u.ID, "Fire Bolt")
+	if err != nil {
+		t.Fatalf("GetUserSpellByName() error = %v", err)
+	}
+	if s.ID != id || s.Description != "alert(1)pew" || !s.IsHomebrew() || s.SourceID.Valid {
+		t.Errorf("GetUserSpellByName() = %+v", s)
+	}
+	if s.CastUnit != "bonus action" || s.AreaShape != "cone" || s.AreaFeet != 15 {
+		t.Errorf("GetUserSpellByName() parsed casting = %+v %+v", s.ParsedCastTime, s.ParsedRange)
+	}
+
+	if _, err := db.CreateSpell(u.ID+1, model.Spell{Name: "Orphan"}); err != model.ErrInvalidID {
+		t.Errorf("CreateSpell() by a missing user error = %v, want ErrInvalidID", err)
+	}
+	if _, err := db.GetAllUserSpells(0); err != model.ErrInvalidID {
+		t.Errorf("GetAllUserSpells(0) error = %v, want ErrInvalidID", err)
+	}
+	if _, err := db.SearchUserSpells(u.ID, ""); err != model.ErrNoResult {
+		t.Errorf("SearchUserSpells() with empty name error = %v, want ErrNoResult", err)
+	}
+
+	if found, err := db.SuggestSpells(u.ID, "fire"); err != nil || len(*found) != 2 || (*found)[1].ID != id {
+		t.Errorf("SuggestSpells() = %v, %v, want cannon and homebrew Fire Bolt", found, err)
+	}
+
+	if err := db.DeleteSpell(u.ID+1, id); err != nil {
+		t.Errorf("DeleteSpell() error = %v", err)
+	}
+	if _, err := db.GetSpellByID(id); err != nil {
+		t.Errorf("DeleteSpell() removed another user's spell")
+	}
+	if err := db.DeleteSpell(u.ID, id); err != nil {
+		t.Errorf("DeleteSpell() error = %v", err)
+	}
+	if _, err := db.GetSpellByID(id); err != model.ErrNoResult {
+		t.Errorf("GetSpellByID() after delete error = %v, want ErrNoResult", err)
+	}
+	if found, err := db.SuggestSpells(u.ID, "fire"); err != nil || len(*found) != 1 {
+		t.Errorf("SuggestSpells() after delete = %v, %v, want only cannon Fire Bolt", found, err)
+	}
+}
+
+func TestDB_UsersAndCharacters(t *testing.T) {
+	db := New()
+
+	if _, ok := db.GetUserByUsername("alice"); ok {
+		t.Error("GetUserByUsername() found a user that doesn't exist")
+	}
+	u, ok := db.CreateUser("alice", "password")
+	if !ok {
+		t.Fatal("CreateUser() failed")
+	}
+	got, ok := db.GetUserByUsername("alice")
+	if !ok || got.ID != u.ID || len(got.Password) == 0 {
+		t.Errorf("GetUserByUsername() = %+v, %v", got, ok)
+	}
+
+	char := &model.Character{Name: "Grog", Race: "Goliath", UserID: u.ID}
+	id, err := db.CreateCharacter(u.ID, char)
+	if err != nil {
+		t.Fatalf("CreateCharacter() error = %v", err)
+	}
+	c, err := db.GetCharacterByName(u.ID, "Grog")
+	if err != nil || c.ID != id {
+		t.Errorf("GetCharacterByName() = %+v, %v", c, err)
+	}
+	if _, err := db.GetCharacterByName(u.ID+1, "Grog"); err != model.ErrNoResult {
+		t.Errorf("GetCharacterByName() for another user error = %v, want ErrNoResult", err)
+	}
+	chars, _ := db.GetAllCharacters(u.ID)
+	if len(*chars) != 1 {
+		t.Errorf("GetAllCharacters() got %d characters, want 1", len(*chars))
+	}
+
+	if level, err := db.GetCharacterLevel(id); err != nil || level != 0 {
+		t.Errorf("GetCharacterLevel() of a new character = %d, %v, want 0", level, err)
+	}
+	fighter := db.resolveClass("Fighter")
+	wizard := db.resolveClass("Wizard")
+	if err := db.SetCharacterLevel(id, fighter.ID, 2); err != nil {
+		t.Fatalf("SetCharacterLevel() error = %v", err)
+	}
+	if err := db.SetCharacterLevel(id, wizard.ID, 3); err != nil {
+		t.Fatalf("SetCharacterLevel() error = %v", err)
+	}
+	if err := db.SetCharacterLevel(id, fighter.ID, 4); err != nil {
+		t.Fatalf("SetCharacterLevel() error = %v", err)
+	}
+	if level, err := db.GetCharacterLevel(id); err != nil || level != 7 {
+		t.Errorf("GetCharacterLevel() = %d, %v, want 7", level, err)
+	}
+	levels, err := db.GetCharacterLevels(id)
+	if err != nil || len(*levels) != 2 || (*levels)[0].Level != 4 {
+		t.Errorf("GetCharacterLevels() = %v, %v", levels, err)
+	}
+	if err := db.SetCharacterLevel(id, wizard.ID, 21); err != model.ErrInvalidLevel {
+		t.Errorf("SetCharacterLevel(21) error = %v, want ErrInvalidLevel", err)
+	}
+	if err := db.SetCharacterLevel(id+1, wizard.ID, 1); err != model.ErrInvalidID {
+		t.Errorf("SetCharacterLevel() of a missing character error = %v, want ErrInvalidID", err)
+	}
+
+	vex, err := db.CreateCharacter(u.ID, &model.Character{Name: "Vex", UserID: u.ID}, model.CharacterLevel{ClassID: wizard.ID, Level: 5})
+	if err != nil {
+		t.Fatalf("CreateCharacter() with a level error = %v", err)
+	}
+	if level, err := db.GetCharacterLevel(vex); err != nil || level != 5 {
+		t.Errorf("GetCharacterLevel() of a character created at level 5 = %d, %v, want 5", level, err)
+	}
+	if _, err := db.CreateCharacter(u.ID, &model.Character{Name: "Pike", UserID: u.ID}, model.CharacterLevel{ClassID: wizard.ID, Level: 21}); err != model.ErrInvalidLevel {
+		t.Errorf("CreateCharacter() at level 21 error = %v, want ErrInvalidLevel", err)
+	}
+	if c, err := db.GetCharacterByName(u.ID, "Pike"); err == nil {
+		t.Errorf("CreateCharacter() at level 21 saved %+v anyway", c)
+	}
+}
@@ -0,0 +1,68 @@
+package memdb
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+// GetAllSources gets a list of every source, canon books first, each
+// in the order they were released
+func (db *DB) GetAllSources() (*[]model.Source, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	ss := make([]model.Source, 0, len(db.sources))
+	for _, s := range db.sources {
+		ss = append(ss, s)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		a, b := ss[i], ss[j]
+		switch {
+		case a.Canon != b.Canon:
+			return a.Canon
+		case a.Released.Valid != b.Released.Valid:
+			return a.Released.Valid
+		case a.Released.String != b.Released.String:
+			return a.Released.String < b.Released.String
+		}
+		return a.ID < b.ID
+	})
+	return &ss, nil
+}
+
+// GetSourceByID returns the source with matching id
+func (db *DB) GetSourceByID(id int) (*model.Source, error) {
+	if id <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	s, ok := db.sources[id]
+	if !ok {
+		return nil, model.ErrNoResult
+	}
+	return &s, nil
+}
+
+// GetSourceByAbbreviation returns the source abbreviated abbr,
+// ignoring case
+func (db *DB) GetSourceByAbbreviation(abbr string) (*model.Source, error) {
+	abbr = strings.TrimSpace(abbr)
+	if abbr == "" {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	for _, s := range db.sources {
+		if equalFold(s.Abbreviation, abbr) {
+			return &s, nil
+		}
+	}
+	return nil, model.ErrNoResult
+}
@@ -0,0 +1,704 @@
+package memdb
+
+import (
+	"database/sql"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/fulltext"
+	"github.com/murder-hobos/murder-hobos/fuzzy"
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// mysql compares strings case insensitively with our collation,
+// so we do too.
+func lower(s string) string {
+	return strings.ToLower(s)
+}
+
+func equalFold(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// contains reports whether v is one of vs
+func contains(vs []string, v string) bool {
+	for _, x := range vs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// like emulates `field LIKE '%sub%'`
+func like(field, sub string) bool {
+	return strings.Contains(lower(field), lower(sub))
+}
+
+// GetAllCannonSpells returns a list of every cannon spell, ordered
+// by name
+func (db *DB) GetAllCannonSpells() (*[]model.Spell, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(db.isCannon)
+	sort.Stable(byName(spells))
+	return &spells, nil
+}
+
+// GetAllUserSpells gets a list of every spell that a
+// specified user has created, ordered by name
+func (db *DB) GetAllUserSpells(userID int) (*[]model.Spell, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return ownedBy(s, userID)
+	})
+	sort.Stable(byName(spells))
+	return &spells, nil
+}
+
+// ListCannonSpells returns page p of the cannon spells matching f, or
+// every cannon spell if f is empty. See model.DB.
+func (db *DB) ListCannonSpells(f model.SpellFilter, p model.Page) (*model.SpellPage, error) {
+	return db.listSpells(f, p, db.isCannon)
+}
+
+// ListUserSpells returns page p of a user's spells matching f, or all
+// of them if f is empty. See model.DB.
+func (db *DB) ListUserSpells(userID int, f model.SpellFilter, p model.Page) (*model.SpellPage, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	return db.listSpells(f, p, func(s model.Spell) bool {
+		return ownedBy(s, userID)
+	})
+}
+
+func (db *DB) listSpells(f model.SpellFilter, p model.Page, keep func(model.Spell) bool) (*model.SpellPage, error) {
+	p, err := p.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	filtered := !f.IsEmpty()
+	if filtered {
+		if f, err = f.Normalize(); err != nil {
+			return nil, err
+		}
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return keep(s) && (!filtered || db.matches(s, f))
+	})
+	sort.Stable(byName(spells))
+	sort.Stable(pageOrder{spells, p})
+
+	page := &model.SpellPage{Spells: []model.Spell{}, Total: len(spells), Page: p}
+	if start := p.Offset(); start < len(spells) {
+		end := start + p.Size
+		if end > len(spells) {
+			end = len(spells)
+		}
+		page.Spells = append(page.Spells, spells[start:end]...)
+	}
+	return page, nil
+}
+
+// SearchCannonSpells gets a list of cannon spells with names similar
+// to `name`
+func (db *DB) SearchCannonSpells(name string) (*[]model.Spell, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return db.isCannon(s) && like(s.Name, name)
+	})
+	sort.Stable(byName(spells))
+	return &spells, nil
+}
+
+// SearchUserSpells gets a list of a user's spells with names similar
+// to `name`
+func (db *DB) SearchUserSpells(userID int, name string) (*[]model.Spell, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	if name == "" {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return ownedBy(s, userID) && like(s.Name, name)
+	})
+	sort.Stable(byName(spells))
+	return &spells, nil
+}
+
+// GetCannonSpellByName returns a single cannon spell with matching name
+func (db *DB) GetCannonSpellByName(name string) (*model.Spell, error) {
+	if name == "" {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.firstSpell(func(s model.Spell) bool {
+		return db.isCannon(s) && equalFold(s.Name, name)
+	})
+}
+
+// GetUserSpellByName returns a single user spell with matching name
+func (db *DB) GetUserSpellByName(userID int, name string) (*model.Spell, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	if name == "" {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.firstSpell(func(s model.Spell) bool {
+		return ownedBy(s, userID) && equalFold(s.Name, name)
+	})
+}
+
+// FilterCannonSpells returns a list of cannon spells matching f,
+// ordered by name. See model.DB.
+func (db *DB) FilterCannonSpells(f model.SpellFilter) (*[]model.Spell, error) {
+	f, err := f.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return db.isCannon(s) && db.matches(s, f)
+	})
+	sort.Stable(byName(spells))
+	return &spells, nil
+}
+
+// FilterUserSpells returns a list of a user's spells matching f,
+// ordered by name. See model.DB.
+func (db *DB) FilterUserSpells(userID int, f model.SpellFilter) (*[]model.Spell, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	f, err := f.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(func(s model.Spell) bool {
+		return ownedBy(s, userID) && db.matches(s, f)
+	})
+	sort.Stable(byName(spells))
+	return &spells, nil
+}
+
+// FullTextSearchCannonSpells returns cannon spells with any of the
+// words in text in their name, description or material, best match
+// first. See model.DB.
+func (db *DB) FullTextSearchCannonSpells(text string, f model.SpellFilter) (*[]model.SpellMatch, error) {
+	return db.fullTextSearch(text, f, db.isCannon)
+}
+
+// FullTextSearchUserSpells is FullTextSearchCannonSpells over a user's
+// spells
+func (db *DB) FullTextSearchUserSpells(userID int, text string, f model.SpellFilter) (*[]model.SpellMatch, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	return db.fullTextSearch(text, f, func(s model.Spell) bool {
+		return ownedBy(s, userID)
+	})
+}
+
+func (db *DB) fullTextSearch(text string, f model.SpellFilter, keep func(model.Spell) bool) (*[]model.SpellMatch, error) {
+	if len(fulltext.Words(text)) == 0 {
+		return nil, model.ErrNoResult
+	}
+	filtered := !f.IsEmpty()
+	if filtered {
+		var err error
+		if f, err = f.Normalize(); err != nil {
+			return nil, err
+		}
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	matches := []model.SpellMatch{}
+	for _, h := range db.text.Search(text) {
+		s := db.spells[h.ID]
+		if keep(s) && (!filtered || db.matches(s, f)) {
+			matches = append(matches, s.Match(text, h.Score))
+		}
+	}
+	return &matches, nil
+}
+
+// FuzzySearchCannonSpells returns the cannon spells with names closest
+// to name, best first
+func (db *DB) FuzzySearchCannonSpells(name string) (*[]model.SpellMatch, error) {
+	return db.fuzzySearch(name, db.isCannon)
+}
+
+// FuzzySearchUserSpells returns the user's spells with names closest
+// to name, best first
+func (db *DB) FuzzySearchUserSpells(userID int, name string) (*[]model.SpellMatch, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	return db.fuzzySearch(name, func(s model.Spell) bool {
+		return ownedBy(s, userID)
+	})
+}
+
+func (db *DB) fuzzySearch(name string, keep func(model.Spell) bool) (*[]model.SpellMatch, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	spells := db.sortedSpells(keep)
+	sort.Stable(byName(spells))
+	names := make([]string, len(spells))
+	for i, s := range spells {
+		names[i] = s.Name
+	}
+	matches := []model.SpellMatch{}
+	for _, m := range fuzzy.Closest(name, names, model.FuzzySearchLimit) {
+		matches = append(matches, model.SpellMatch{Spell: spells[m.Index], Score: m.Score})
+	}
+	return &matches, nil
+}
+
+// SuggestSpells returns the cannon spells, and userID's spells unless
+// it's 0, with names completing typed, best first
+func (db *DB) SuggestSpells(userID int, typed string) (*[]model.SpellSuggestion, error) {
+	if userID < 0 {
+		return nil, model.ErrInvalidID
+	}
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	keep := func(id int) bool {
+		s := db.spells[id]
+		return db.isCannon(s) || (userID != 0 && ownedBy(s, userID))
+	}
+	suggestions := []model.SpellSuggestion{}
+	for _, c := range db.names.Search(typed, model.SuggestLimit, keep) {
+		s := db.spells[c.ID]
+		suggestions = append(suggestions, model.SpellSuggestion{
+			ID:     c.ID,
+			Name:   c.Name,
+			UserID: int(s.UserID.Int64),
+			Canon:  db.isCannon(s),
+		})
+	}
+	return &suggestions, nil
+}
+
+// matches reports whether s passes the normalized filter f. Callers
+// must hold the read lock.
+func (db *DB) matches(s model.Spell, f model.SpellFilter) bool {
+	if len(f.Levels) > 0 {
+		found := false
+		for _, l := range f.Levels {
+			found = found || s.Level == strconv.Itoa(l)
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Schools) > 0 {
+		found := false
+		for _, school := range f.Schools {
+			found = found || equalFold(s.School, school)
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Class != "" {
+		found := false
+		for _, c := range db.classes {
+			found = found || (equalFold(c.Name, f.Class) && db.onClassList(c.ID, s.ID))
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.SourceIDs) > 0 || len(f.Sources) > 0 {
+		source, ok := db.sources[int(s.SourceID.Int64)]
+		found := false
+		for _, id := range f.SourceIDs {
+			found = found || (ok && source.ID == id)
+		}
+		for _, abbr := range f.Sources {
+			found = found || (ok && equalFold(source.Abbreviation, abbr))
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, c := range []struct {
+		want *bool
+		got  bool
+	}{
+		{f.Verbal, s.Verbal},
+		{f.Somatic, s.Somatic},
+		{f.Material, s.Material},
+		{f.Ritual, s.Ritual},
+		{f.Concentration, s.Concentration},
+	} {
+		if c.want != nil && *c.want != c.got {
+			return false
+		}
+	}
+	if f.Name != "" && !like(s.Name, f.Name) {
+		return false
+	}
+	if f.DamageType != "" {
+		found := false
+		for _, t := range db.damageTypes[s.ID] {
+			found = found || t.DamageType == f.DamageType
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Save != "" {
+		found := false
+		for _, a := range db.saves[s.ID] {
+			found = found || a.Ability == f.Save
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, c := range []struct {
+		vs []string
+		v  string
+	}{
+		{f.CastUnits, s.CastUnit},
+		{f.AreaShapes, s.AreaShape},
+		{f.DurationKinds, s.DurationKind},
+	} {
+		if len(c.vs) > 0 && !contains(c.vs, c.v) {
+			return false
+		}
+	}
+	if f.MaxRangeFeet > 0 && (s.RangeFeet > f.MaxRangeFeet || s.RangeKind == spelltext.RangeSpecial) {
+		return false
+	}
+	switch f.Attack {
+	case spelltext.AttackMelee:
+		return s.MeleeAttack
+	case spelltext.AttackRanged:
+		return s.RangedAttack
+	}
+	return true
+}
+
+// GetSpellClasses returns a slice of Class objects available
+// to the spell with spellID
+func (db *DB) GetSpellClasses(spellID int) (*[]model.Class, error) {
+	if spellID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	cs := []model.Class{}
+	for cls := range db.classSpells {
+		if cls.SpellID == spellID {
+			cs = append(cs, db.classes[cls.ClassID])
+		}
+	}
+	sort.Sort(classesByID(cs))
+	return &cs, nil
+}
+
+// GetSpellRolls returns the dice expressions a spell calls for,
+// in order
+func (db *DB) GetSpellRolls(spellID int) (*[]model.SpellRoll, error) {
+	if spellID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	rs := append([]model.SpellRoll{}, db.rolls[spellID]...)
+	return &rs, nil
+}
+
+// GetSpellUpcasts returns the rules for casting a spell with a higher
+// level slot, in order
+func (db *DB) GetSpellUpcasts(spellID int) (*[]model.SpellUpcast, error) {
+	if spellID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	us := append([]model.SpellUpcast{}, db.upcasts[spellID]...)
+	return &us, nil
+}
+
+// GetCantripScaling returns how a cantrip grows with character
+// level, in order
+func (db *DB) GetCantripScaling(spellID int) (*[]model.CantripScaling, error) {
+	if spellID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	cs := append([]model.CantripScaling{}, db.cantrips[spellID]...)
+	return &cs, nil
+}
+
+// GetSpellDamageTypes returns the types of damage a spell deals,
+// sorted by name
+func (db *DB) GetSpellDamageTypes(spellID int) (*[]model.SpellDamageType, error) {
+	if spellID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	ts := append([]model.SpellDamageType{}, db.damageTypes[spellID]...)
+	return &ts, nil
+}
+
+// GetSpellSaves returns the abilities a spell calls for saving throws
+// of, sorted by name
+func (db *DB) GetSpellSaves(spellID int) (*[]model.SpellSave, error) {
+	if spellID <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	ss := append([]model.SpellSave{}, db.saves[spellID]...)
+	return &ss, nil
+}
+
+// GetSpellByID returns a single spell with matching id
+func (db *DB) GetSpellByID(id int) (*model.Spell, error) {
+	if id <= 0 {
+		return nil, model.ErrNoResult
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	s, ok := db.spells[id]
+	if !ok {
+		return nil, model.ErrNoResult
+	}
+	return &s, nil
+}
+
+// CreateSpell adds a spell to the datastore, created by specified user,
+// or from the book spell.SourceID if uid is 0
+func (db *DB) CreateSpell(uid int, spell model.Spell) (id int, err error) {
+	spell.UserID = sql.NullInt64{Int64: int64(uid), Valid: uid > 0}
+	// Same (lack of) sanitizing as model.DB
+	d := strings.Replace(spell.Description, "<script>", "", -1)
+	spell.Description = strings.Replace(d, "</script>", "", -1)
+	spell.ParseText()
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// foreign key constraints on user_id and source_id
+	if _, ok := db.users[uid]; spell.UserID.Valid && !ok {
+		return 0, model.ErrInvalidID
+	}
+	if _, ok := db.sources[int(spell.SourceID.Int64)]; spell.SourceID.Valid && !ok {
+		return 0, model.ErrInvalidID
+	}
+	return db.insertSpell(spell), nil
+}
+
+// DeleteSpell deletes a spell with matching user and spell IDs.
+// Like model.DB, deleting a spell that doesn't exist isn't an error.
+func (db *DB) DeleteSpell(userID, spellID int) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if s, ok := db.spells[spellID]; ok && ownedBy(s, userID) {
+		delete(db.spells, spellID)
+		delete(db.rolls, spellID)
+		delete(db.upcasts, spellID)
+		delete(db.cantrips, spellID)
+		delete(db.damageTypes, spellID)
+		delete(db.saves, spellID)
+		db.text.Remove(spellID)
+		db.names.Remove(spellID)
+		for cls := range db.classSpells {
+			if cls.SpellID == spellID {
+				delete(db.classSpells, cls)
+			}
+		}
+	}
+	return nil
+}
+
+// firstSpell returns the lowest id spell matching keep, or
+// model.ErrNoResult if there isn't one.
+// Callers must hold the read lock.
+func (db *DB) firstSpell(keep func(model.Spell) bool) (*model.Spell, error) {
+	spells := db.sortedSpells(keep)
+	if len(spells) == 0 {
+		return nil, model.ErrNoResult
+	}
+	return &spells[0], nil
+}
+
+// CannonSpellFacets counts the cannon spells FullTextSearchCannonSpells
+// finds for text and f, or if text is "", the ones ListCannonSpells
+// lists for f, by field value. See model.DB.
+func (db *DB) CannonSpellFacets(text string, f model.SpellFilter) (*model.SpellFacets, error) {
+	return db.spellFacets(text, f, db.isCannon)
+}
+
+// UserSpellFacets is CannonSpellFacets over a user's spells
+func (db *DB) UserSpellFacets(userID int, text string, f model.SpellFilter) (*model.SpellFacets, error) {
+	if userID <= 0 {
+		return nil, model.ErrInvalidID
+	}
+	return db.spellFacets(text, f, func(s model.Spell) bool {
+		return ownedBy(s, userID)
+	})
+}
+
+func (db *DB) spellFacets(text string, f model.SpellFilter, keep func(model.Spell) bool) (*model.SpellFacets, error) {
+	// the spells to count, by id
+	counted := make(map[int]model.Spell)
+	if text != "" {
+		// fullTextSearch takes the read lock itself
+		matches, err := db.fullTextSearch(text, f, keep)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range *matches {
+			counted[m.ID] = m.Spell
+		}
+	}
+	filtered := text == "" && !f.IsEmpty()
+	if filtered {
+		var err error
+		if f, err = f.Normalize(); err != nil {
+			return nil, err
+		}
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if text == "" {
+		for _, s := range db.sortedSpells(keep) {
+			if !filtered || db.matches(s, f) {
+				counted[s.ID] = s
+			}
+		}
+	}
+
+	schools := make(map[string]int)
+	levels := make(map[string]int)
+	sources := make(map[int]int)
+	ritual := make(map[bool]int)
+	concentration := make(map[bool]int)
+	for _, s := range counted {
+		schools[s.School]++
+		levels[s.Level]++
+		if s.SourceID.Valid {
+			sources[int(s.SourceID.Int64)]++
+		}
+		ritual[s.Ritual]++
+		concentration[s.Concentration]++
+	}
+	classes := make(map[string]int)
+	for _, c := range db.classes {
+		for id := range counted {
+			if db.onClassList(c.ID, id) {
+				classes[c.Name]++
+			}
+		}
+	}
+
+	facets := &model.SpellFacets{
+		Schools:       sortedCounts(schools),
+		Levels:        sortedCounts(levels),
+		Classes:       sortedCounts(classes),
+		Sources:       []model.FacetCount{},
+		Ritual:        yesNoCounts(ritual),
+		Concentration: yesNoCounts(concentration),
+	}
+	ids := make([]int, 0, len(sources))
+	for id := range sources {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		facets.Sources = append(facets.Sources, model.FacetCount{
+			Value: db.sources[id].Abbreviation,
+			Label: db.sources[id].Name,
+			Count: sources[id],
+		})
+	}
+	return facets, nil
+}
+
+// sortedCounts returns counts ordered by value, like GROUP BY with an
+// ORDER BY on the grouped column
+func sortedCounts(counts map[string]int) []model.FacetCount {
+	fcs := make([]model.FacetCount, 0, len(counts))
+	for v, n := range counts {
+		fcs = append(fcs, model.FacetCount{Value: v, Count: n})
+	}
+	sort.Slice(fcs, func(i, j int) bool { return lower(fcs[i].Value) < lower(fcs[j].Value) })
+	return fcs
+}
+
+// yesNoCounts returns counts of a boolean column, "yes" first
+func yesNoCounts(counts map[bool]int) []model.FacetCount {
+	fcs := []model.FacetCount{}
+	if n := counts[true]; n > 0 {
+		fcs = append(fcs, model.FacetCount{Value: "yes", Count: n})
+	}
+	if n := counts[false]; n > 0 {
+		fcs = append(fcs, model.FacetCount{Value: "no", Count: n})
+	}
+	return fcs
+}
@@ -0,0 +1,60 @@
+package memdb
+
+import (
+	"log"
+
+	"github.com/murder-hobos/murder-hobos/model"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// GetUserByUsername returns the user with matching username
+func (db *DB) GetUserByUsername(name string) (*model.User, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var found *model.User
+	for _, u := range db.users {
+		if equalFold(u.Username, name) {
+			if found == nil || u.ID < found.ID {
+				u := u
+				found = &u
+			}
+		}
+	}
+	return found, found != nil
+}
+
+// GetUserByID returns the user with matching id if found
+func (db *DB) GetUserByID(id int) (*model.User, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	u, ok := db.users[id]
+	if !ok {
+		return nil, false
+	}
+	return &u, true
+}
+
+// CreateUser adds a user with a bcrypt hash of password
+func (db *DB) CreateUser(name, password string) (*model.User, bool) {
+	p, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("CreateUser: %s", err.Error())
+		return nil, false
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	u := model.User{
+		ID:       db.nextUserID,
+		Username: name,
+		Password: p,
+	}
+	db.nextUserID++
+	db.users[u.ID] = u
+
+	// model.DB doesn't hand back the password either
+	return &model.User{ID: u.ID, Username: u.Username}, true
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// SourceDatastore describes the methods we have available on our
+// database pertaining to the books spells come from
+type SourceDatastore interface {
+	GetAllSources() (*[]Source, error)
+	GetSourceByID(id int) (*Source, error)
+	GetSourceByAbbreviation(abbr string) (*Source, error)
+}
+
+// Source represents our database Source table, a book spells come
+// from, ex. the Player's Handbook
+type Source struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+	// Abbreviation is how the book is usually written short, ex. "PHB"
+	Abbreviation string `db:"abbreviation"`
+	Publisher    string `db:"publisher"`
+	// Canon books' spells are the ones in the CannonSpells view,
+	// listed for everyone
+	Canon bool `db:"canon"`
+	// Released is when the book came out, "2006-01-02"
+	Released sql.NullString `db:"released"`
+}
+
+// ReleaseYear is the year the book came out, "" if we don't know
+func (s *Source) ReleaseYear() string {
+	if !s.Released.Valid || len(s.Released.String) < 4 {
+		return ""
+	}
+	return s.Released.String[:4]
+}
+
+// GetAllSources gets a list of every source in our database, canon
+// books first, each in the order they were released
+func (db *DB) GetAllSources() (*[]Source, error) {
+	ss := &[]Source{}
+	if err := db.Select(ss, `SELECT * FROM Source
+							 ORDER BY canon DESC, released IS NULL, released, id`); err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
+
+// GetSourceByID returns the source with matching id
+func (db *DB) GetSourceByID(id int) (*Source, error) {
+	if id <= 0 {
+		return nil, ErrNoResult
+	}
+	s := &Source{}
+	if err := db.Get(s, `SELECT * FROM Source WHERE id=?`, id); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetSourceByAbbreviation returns the source abbreviated abbr,
+// ignoring case, ex. "phb"
+func (db *DB) GetSourceByAbbreviation(abbr string) (*Source, error) {
+	abbr = strings.TrimSpace(abbr)
+	if abbr == "" {
+		return nil, ErrNoResult
+	}
+	s := &Source{}
+	if err := db.Get(s, `SELECT * FROM Source WHERE LOWER(abbreviation) = LOWER(?)`, abbr); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
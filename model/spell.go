@@ -7,7 +7,7 @@ import (
 	"log"
 	"strings"
 
-	sq "github.com/Masterminds/squirrel"
+	"github.com/murder-hobos/murder-hobos/spelltext"
 	"github.com/murder-hobos/murder-hobos/util"
 )
 
@@ -15,17 +15,31 @@ import (
 // pertaining to Spells
 type SpellDatastore interface {
 	GetAllCannonSpells() (*[]Spell, error)
+	ListCannonSpells(f SpellFilter, p Page) (*SpellPage, error)
 	GetCannonSpellByName(name string) (*Spell, error)
 	SearchCannonSpells(name string) (*[]Spell, error)
-	FilterCannonSpells(level, school string) (*[]Spell, error)
+	FilterCannonSpells(f SpellFilter) (*[]Spell, error)
+	FullTextSearchCannonSpells(text string, f SpellFilter) (*[]SpellMatch, error)
+	CannonSpellFacets(text string, f SpellFilter) (*SpellFacets, error)
+	FuzzySearchCannonSpells(name string) (*[]SpellMatch, error)
+	SuggestSpells(userID int, typed string) (*[]SpellSuggestion, error)
 
 	GetAllUserSpells(userID int) (*[]Spell, error)
+	ListUserSpells(userID int, f SpellFilter, p Page) (*SpellPage, error)
 	GetUserSpellByName(userID int, name string) (*Spell, error)
 	SearchUserSpells(userID int, name string) (*[]Spell, error)
-	FilterUserSpells(userID int, level, school string) (*[]Spell, error)
+	FilterUserSpells(userID int, f SpellFilter) (*[]Spell, error)
+	FullTextSearchUserSpells(userID int, text string, f SpellFilter) (*[]SpellMatch, error)
+	UserSpellFacets(userID int, text string, f SpellFilter) (*SpellFacets, error)
+	FuzzySearchUserSpells(userID int, name string) (*[]SpellMatch, error)
 
 	GetSpellByID(id int) (*Spell, error)
 	GetSpellClasses(spellID int) (*[]Class, error)
+	GetSpellRolls(spellID int) (*[]SpellRoll, error)
+	GetSpellUpcasts(spellID int) (*[]SpellUpcast, error)
+	GetCantripScaling(spellID int) (*[]CantripScaling, error)
+	GetSpellDamageTypes(spellID int) (*[]SpellDamageType, error)
+	GetSpellSaves(spellID int) (*[]SpellSave, error)
 	CreateSpell(uid int, spell Spell) (id int, err error)
 	DeleteSpell(userID, spellID int) error
 }
@@ -46,7 +60,27 @@ type Spell struct {
 	Concentration bool           `db:"concentration"`
 	Ritual        bool           `db:"ritual"`
 	Description   string         `db:"description"`
-	SourceID      int            `db:"source_id"`
+	// UserID is whose homebrew the spell is, NULL for a spell from a
+	// book
+	UserID sql.NullInt64 `db:"user_id"`
+	// SourceID is the book the spell is from, NULL for homebrew
+	SourceID sql.NullInt64 `db:"source_id"`
+
+	// CastTime, Range, Duration and Description parsed for filtering
+	// and sorting, filled in by ParseText
+	spelltext.ParsedCastTime
+	spelltext.ParsedRange
+	spelltext.ParsedDuration
+	spelltext.ParsedAttack
+}
+
+// ParseText fills in s's parsed cast time, range and duration from
+// their text, and its spell attacks from its description.
+func (s *Spell) ParseText() {
+	s.ParsedCastTime = spelltext.ParseCastTime(s.CastTime)
+	s.ParsedRange = spelltext.ParseRange(s.Range)
+	s.ParsedDuration = spelltext.ParseDuration(s.Duration)
+	s.ParsedAttack = spelltext.ParseAttack(s.Description)
 }
 
 // ComponentsStr returns a string representation of the
@@ -86,6 +120,12 @@ func (s *Spell) HTMLDescription() template.HTML {
 	return template.HTML(desc)
 }
 
+// IsHomebrew reports whether a user made s, rather than it coming from
+// a book
+func (s *Spell) IsHomebrew() bool {
+	return s.UserID.Valid
+}
+
 // LevelStr provides the spell's level as a string, with "Cantrip" for level 0
 func (s *Spell) LevelStr() string {
 	if s.Level == "0" {
@@ -95,10 +135,10 @@ func (s *Spell) LevelStr() string {
 }
 
 // GetAllCannonSpells returns a list of every cannon spell object
-// in our database (PHB, EE, SCAG)
+// in our database, the ones from canon books, ordered by name
 func (db *DB) GetAllCannonSpells() (*[]Spell, error) {
 	spells := &[]Spell{}
-	if err := db.Select(spells, `SELECT * FROM CannonSpells`); err != nil {
+	if err := db.Select(spells, `SELECT * FROM CannonSpells ORDER BY name, id`); err != nil {
 		log.Printf("model: GetAllCannonSpells: %s", err.Error())
 		return nil, err
 	}
@@ -106,14 +146,14 @@ func (db *DB) GetAllCannonSpells() (*[]Spell, error) {
 }
 
 // GetAllUserSpells gets a list of every spell that a
-// specified user has created in our database
+// specified user has created in our database, ordered by name
 func (db *DB) GetAllUserSpells(userID int) (*[]Spell, error) {
 	if userID <= 0 {
 		return nil, ErrInvalidID
 	}
 
 	spells := &[]Spell{}
-	err := db.Select(spells, `SELECT * FROM Spell WHERE source_id=?`, userID)
+	err := db.Select(spells, `SELECT * FROM Spell WHERE user_id=? ORDER BY name, id`, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -124,10 +164,10 @@ func (db *DB) GetAllUserSpells(userID int) (*[]Spell, error) {
 // to `name`
 func (db *DB) SearchCannonSpells(name string) (*[]Spell, error) {
 	query := `SELECT * FROM CannonSpells
-			  WHERE name LIKE CONCAT ('%', ?, '%')
-			  ORDER BY name ASC`
+			  WHERE name LIKE ?
+			  ORDER BY name ASC, id ASC`
 	spells := &[]Spell{}
-	if err := db.Select(spells, query, name); err != nil {
+	if err := db.Select(spells, query, likePattern(name)); err != nil {
 		log.Printf("Error executing query %s\n %s\n", query, err.Error())
 		return nil, err
 	}
@@ -148,9 +188,9 @@ func (db *DB) SearchUserSpells(userID int, name string) (*[]Spell, error) {
 
 	spells := &[]Spell{}
 	err := db.Select(spells, `SELECT * FROM Spell 
-							  WHERE source_id=? 
-							  AND name LIKE CONCAT ('%', ?, '%')
-							  ORDER BY name ASC;`, userID, name)
+							  WHERE user_id=? 
+							  AND name LIKE ?
+							  ORDER BY name ASC, id ASC;`, userID, likePattern(name))
 	if err != nil {
 		log.Printf("model: SearchUserSpellByName: %s\n", err.Error())
 		return nil, err
@@ -159,6 +199,13 @@ func (db *DB) SearchUserSpells(userID int, name string) (*[]Spell, error) {
 	return spells, nil
 }
 
+// likePattern wraps s in wildcards for a `LIKE ?` comparison.
+// Building the pattern here instead of with CONCAT keeps our
+// queries portable between mysql and sqlite.
+func likePattern(s string) string {
+	return util.Surround(s, "%", "%")
+}
+
 // GetCannonSpellByName returns a single cannon spell with matching name
 func (db *DB) GetCannonSpellByName(name string) (*Spell, error) {
 	if name == "" {
@@ -183,91 +230,145 @@ func (db *DB) GetUserSpellByName(userID int, name string) (*Spell, error) {
 	}
 
 	s := &Spell{}
-	err := db.Get(s, "SELECT * FROM Spell WHERE source_id=? AND name=?", userID, name)
+	err := db.Get(s, "SELECT * FROM Spell WHERE user_id=? AND name=?", userID, name)
 	if err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
-// FilterCannonSpells returns a list of cannon spells matching
-// the search critera. If an empty argument is passed to one of the
-// filters, that argument is not considered for filtering.
-func (db *DB) FilterCannonSpells(level, school string) (*[]Spell, error) {
-	if level == "" && school == "" {
-		return nil, ErrNoResult
+// FilterCannonSpells returns a list of cannon spells matching f,
+// ordered by name. An empty filter, or one asking for something no
+// spell can have, is ErrNoResult.
+func (db *DB) FilterCannonSpells(f SpellFilter) (*[]Spell, error) {
+	return db.filterSpells("CannonSpells", f)
+}
+
+// FilterUserSpells returns a list of a user's spells matching f,
+// ordered by name. See FilterCannonSpells.
+func (db *DB) FilterUserSpells(userID int, f SpellFilter) (*[]Spell, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidID
 	}
+	return db.filterSpells("Spell", f, userSpells(userID))
+}
 
-	eqs := sq.Eq{}
-	if level != "" {
-		eqs["level"] = level
+// GetSpellClasses searches the database and returns a slice of
+// Class objects available to the spell with spellID
+func (db *DB) GetSpellClasses(spellID int) (*[]Class, error) {
+	if spellID <= 0 {
+		return nil, ErrNoResult
 	}
-	if school != "" {
-		eqs["school"] = school
+
+	cs := &[]Class{}
+	err := db.Select(cs, `SELECT C.id, C.name, C.base_class_id
+	 					  FROM Class AS C
+						  JOIN ClassSpells as CS ON
+						  C.id = CS.class_id
+						  JOIN Spell AS S ON
+						  CS.spell_id = S.id
+						  WHERE S.id = ?`,
+		spellID)
+	if err != nil {
+		return nil, err
 	}
+	return cs, nil
+}
 
-	query, args, err := sq.Select("*").From("CannonSpells").Where(eqs).ToSql()
+// GetSpellRolls returns the dice expressions a spell calls for,
+// in the order they appear in the spell
+func (db *DB) GetSpellRolls(spellID int) (*[]SpellRoll, error) {
+	if spellID <= 0 {
+		return nil, ErrNoResult
+	}
 
-	spells := &[]Spell{}
-	err = db.Select(spells, query, args...)
+	rs := &[]SpellRoll{}
+	err := db.Select(rs, `SELECT spell_id, position, expression
+						  FROM SpellRolls
+						  WHERE spell_id = ?
+						  ORDER BY position`,
+		spellID)
 	if err != nil {
 		return nil, err
 	}
-	return spells, nil
+	return rs, nil
 }
 
-// FilterUserSpells returns a list of user spells matching
-// the search critera. If an empty argument is passed to one of the
-// filters, that argument is not considered for filtering.
-// NOTE: name is given as a search param, not matched exactly
-func (db *DB) FilterUserSpells(userID int, level, school string) (*[]Spell, error) {
-	if userID <= 0 {
-		return nil, ErrInvalidID
-	}
-	if level == "" && school == "" {
+// GetSpellUpcasts returns the rules for casting a spell with a higher
+// level slot, in the order they appear in its description
+func (db *DB) GetSpellUpcasts(spellID int) (*[]SpellUpcast, error) {
+	if spellID <= 0 {
 		return nil, ErrNoResult
 	}
 
-	eqs := sq.Eq{}
-	eqs["source_id"] = userID
+	us := &[]SpellUpcast{}
+	err := db.Select(us, `SELECT spell_id, position, kind, subject, base_dice, dice, max_dice,
+						  amount, unit, above_slot, every, slot, text
+						  FROM SpellUpcasts
+						  WHERE spell_id = ?
+						  ORDER BY position`,
+		spellID)
+	if err != nil {
+		return nil, err
+	}
+	return us, nil
+}
 
-	if level != "" {
-		eqs["level"] = level
+// GetCantripScaling returns how a cantrip grows with character
+// level, in the order it's described. Other spells have none.
+func (db *DB) GetCantripScaling(spellID int) (*[]CantripScaling, error) {
+	if spellID <= 0 {
+		return nil, ErrNoResult
 	}
-	if school != "" {
-		eqs["school"] = school
+
+	cs := &[]CantripScaling{}
+	err := db.Select(cs, `SELECT spell_id, position, subject, level_1, level_5, level_11, level_17
+						  FROM CantripScaling
+						  WHERE spell_id = ?
+						  ORDER BY position`,
+		spellID)
+	if err != nil {
+		return nil, err
 	}
+	return cs, nil
+}
 
-	query, args, err := sq.Select("*").From("Spell").Where(eqs).ToSql()
+// GetSpellDamageTypes returns the types of damage a spell deals,
+// sorted by name
+func (db *DB) GetSpellDamageTypes(spellID int) (*[]SpellDamageType, error) {
+	if spellID <= 0 {
+		return nil, ErrNoResult
+	}
 
-	spells := &[]Spell{}
-	err = db.Select(spells, query, args...)
+	ts := &[]SpellDamageType{}
+	err := db.Select(ts, `SELECT spell_id, damage_type
+						  FROM SpellDamageTypes
+						  WHERE spell_id = ?
+						  ORDER BY damage_type`,
+		spellID)
 	if err != nil {
 		return nil, err
 	}
-	return spells, nil
+	return ts, nil
 }
 
-// GetSpellClasses searches the database and returns a slice of
-// Class objects available to the spell with spellID
-func (db *DB) GetSpellClasses(spellID int) (*[]Class, error) {
+// GetSpellSaves returns the abilities a spell calls for saving throws
+// of, sorted by name
+func (db *DB) GetSpellSaves(spellID int) (*[]SpellSave, error) {
 	if spellID <= 0 {
 		return nil, ErrNoResult
 	}
 
-	cs := &[]Class{}
-	err := db.Select(cs, `SELECT C.id, C.name, C.base_class_id
-	 					  FROM Class AS C
-						  JOIN ClassSpells as CS ON
-						  C.id = CS.class_id
-						  JOIN Spell AS S ON
-						  CS.spell_id = S.id
-						  WHERE S.id = ?`,
+	ss := &[]SpellSave{}
+	err := db.Select(ss, `SELECT spell_id, ability
+						  FROM SpellSaves
+						  WHERE spell_id = ?
+						  ORDER BY ability`,
 		spellID)
 	if err != nil {
 		return nil, err
 	}
-	return cs, nil
+	return ss, nil
 }
 
 // GetSpellByID returns a single spell with matching id
@@ -282,32 +383,76 @@ func (db *DB) GetSpellByID(id int) (*Spell, error) {
 	return s, nil
 }
 
-// CreateSpell adds a spell to the database, created by specified user
+// CreateSpell adds a spell to the database, created by specified user,
+// along with the damage types and saves its description calls for. A
+// uid of 0 adds a spell nobody made, from the book spell.SourceID.
 func (db *DB) CreateSpell(uid int, spell Spell) (id int, err error) {
+	spell.UserID = sql.NullInt64{Int64: int64(uid), Valid: uid > 0}
 	// EWW SO UGLY BUT I WANT <BR>S IN DESCRIPTION AND I'M TOO LAZY RIGHT NOW
 	// TO WRITE A CONVERTER FROM \n TO <BR>
 	d := strings.Replace(spell.Description, "<script>", "", -1)
-	desc := strings.Replace(d, "</script>", "", -1)
+	spell.Description = strings.Replace(d, "</script>", "", -1)
+	spell.ParseText()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
 
-	res, err := db.Exec(`INSERT INTO Spell (name, level, school, cast_time, duration, `+"`range`, "+
+	res, err := tx.Exec(`INSERT INTO Spell (name, level, school, cast_time, duration, `+"`range`, "+
 		`comp_verbal, comp_somatic, comp_material, material_desc, concentration, 
-						ritual, description, source_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+						ritual, description, user_id, source_id,
+						cast_amount, cast_unit, cast_trigger, cast_seconds,
+						range_kind, range_distance, range_feet, area_shape, area_feet,
+						duration_kind, duration_amount, duration_seconds,
+						melee_attack, ranged_attack)
+						VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		spell.Name, spell.Level, spell.School, spell.CastTime, spell.Duration,
 		spell.Range, spell.Verbal, spell.Somatic, spell.Material, spell.MaterialDesc,
-		spell.Concentration, spell.Ritual, desc, spell.SourceID)
+		spell.Concentration, spell.Ritual, spell.Description, spell.UserID, spell.SourceID,
+		spell.CastAmount, spell.CastUnit, spell.CastTrigger, spell.CastSeconds,
+		spell.RangeKind, spell.RangeDistance, spell.RangeFeet, spell.AreaShape, spell.AreaFeet,
+		spell.DurationKind, spell.DurationAmount, spell.DurationSeconds,
+		spell.MeleeAttack, spell.RangedAttack)
+	if err != nil {
+		return 0, err
+	}
+	i, err := res.LastInsertId()
 	if err != nil {
 		return 0, err
 	}
-	if i, err := res.LastInsertId(); err != nil {
-		return int(i), nil
+	for _, t := range spelltext.ParseDamageTypes(spell.Description) {
+		if _, err = tx.Exec(`INSERT INTO SpellDamageTypes (spell_id, damage_type) VALUES (?, ?)`, i, t); err != nil {
+			return 0, err
+		}
+	}
+	for _, a := range spelltext.ParseSaves(spell.Description) {
+		if _, err = tx.Exec(`INSERT INTO SpellSaves (spell_id, ability) VALUES (?, ?)`, i, a); err != nil {
+			return 0, err
+		}
+	}
+	suggestion := SpellSuggestion{ID: int(i), Name: spell.Name, UserID: uid}
+	if spell.SourceID.Valid {
+		if err = tx.Get(&suggestion.Canon, `SELECT canon FROM Source WHERE id=?`, spell.SourceID); err != nil {
+			return 0, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
 	}
-	return 0, err
+	db.names.add(suggestion)
+	return int(i), nil
 }
 
 // DeleteSpell deletes a spell from the database with matching
-// source and spell IDs
+// user and spell IDs
 func (db *DB) DeleteSpell(userID, spellID int) error {
-	res, err := db.Exec(`DELETE FROM Spell WHERE source_id=? AND id=?`, userID, spellID)
+	res, err := db.Exec(`DELETE FROM Spell WHERE user_id=? AND id=?`, userID, spellID)
 
 	if err != nil {
 		return err
@@ -316,6 +461,7 @@ func (db *DB) DeleteSpell(userID, spellID int) error {
 	if i, err := res.RowsAffected(); i != 1 || err != nil {
 		return err
 	}
+	db.names.remove(spellID)
 
 	return nil
 }
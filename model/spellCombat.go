@@ -0,0 +1,15 @@
+package model
+
+// SpellDamageType represents a row in our db's SpellDamageTypes table,
+// one of the types of damage a spell deals, ex. "fire"
+type SpellDamageType struct {
+	SpellID    int    `db:"spell_id"`
+	DamageType string `db:"damage_type"`
+}
+
+// SpellSave represents a row in our db's SpellSaves table, an ability
+// a spell calls for a saving throw of, ex. "dexterity"
+type SpellSave struct {
+	SpellID int    `db:"spell_id"`
+	Ability string `db:"ability"`
+}
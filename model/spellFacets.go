@@ -0,0 +1,134 @@
+package model
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SpellFacets counts the spells in a listing by each value of the
+// fields we filter on, ex. how many of them are Evocation
+type SpellFacets struct {
+	Schools []FacetCount
+	// Levels are "0" to "9"
+	Levels []FacetCount
+	// Classes are by class name, a subclass counting the spells on
+	// its base class's list too
+	Classes []FacetCount
+	// Sources are by abbreviation, labeled with the book's name.
+	// Homebrew isn't from a book, so it isn't counted.
+	Sources []FacetCount
+	// Ritual and Concentration are "yes" and "no"
+	Ritual        []FacetCount
+	Concentration []FacetCount
+}
+
+// FacetCount is how many spells have Value for a field
+type FacetCount struct {
+	Value string `db:"value"`
+	// Label is Value for people, where it isn't already
+	Label string `db:"label"`
+	Count int    `db:"count"`
+}
+
+// CannonSpellFacets counts cannon spells by field value. The spells
+// counted are the ones FullTextSearchCannonSpells finds for text and
+// f, or if text is "", the ones ListCannonSpells lists for f, so the
+// counts always add up to the results they're shown with. Values no
+// spell has aren't counted.
+func (db *DB) CannonSpellFacets(text string, f SpellFilter) (*SpellFacets, error) {
+	return db.spellFacets(text, f, cannonSpells)
+}
+
+// UserSpellFacets is CannonSpellFacets over a user's spells
+func (db *DB) UserSpellFacets(userID int, text string, f SpellFilter) (*SpellFacets, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidID
+	}
+	return db.spellFacets(text, f, userSpells(userID))
+}
+
+func (db *DB) spellFacets(text string, f SpellFilter, scope sq.Sqlizer) (*SpellFacets, error) {
+	where := sq.And{scope}
+	switch {
+	case text != "":
+		matches, err := db.fullTextSearch(text, f, scope)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(*matches))
+		for i, m := range *matches {
+			ids[i] = m.ID
+		}
+		where = append(where, sq.Eq{"id": ids})
+	case !f.IsEmpty():
+		f, err := f.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, f.where())
+	}
+
+	facets := &SpellFacets{}
+	for _, c := range []struct {
+		dst    *[]FacetCount
+		column string
+		order  string
+	}{
+		{&facets.Schools, "school", "school ASC"},
+		{&facets.Levels, "level", "level ASC"},
+		{&facets.Ritual, "ritual", "ritual DESC"},
+		{&facets.Concentration, "concentration", "concentration DESC"},
+	} {
+		query, args, err := sq.Select(c.column+" AS value", "COUNT(*) AS count").From("Spell").
+			Where(where).GroupBy(c.column).OrderBy(c.order).ToSql()
+		if err != nil {
+			return nil, err
+		}
+		counts := []FacetCount{}
+		if err := db.Select(&counts, query, args...); err != nil {
+			return nil, err
+		}
+		*c.dst = counts
+	}
+	for _, counts := range [][]FacetCount{facets.Ritual, facets.Concentration} {
+		for i := range counts {
+			counts[i].Value = yesNo(counts[i].Value)
+		}
+	}
+
+	spells, args, err := sq.Select("id").From("Spell").Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT So.abbreviation AS value, So.name AS label, COUNT(*) AS count
+			  FROM Spell AS S
+			  JOIN Source AS So ON S.source_id = So.id
+			  WHERE S.id IN (` + spells + `)
+			  GROUP BY So.id, So.abbreviation, So.name
+			  ORDER BY So.id ASC`
+	facets.Sources = []FacetCount{}
+	if err := db.Select(&facets.Sources, query, args...); err != nil {
+		return nil, err
+	}
+
+	// like GetClassSpells, a subclass counts its base class's spells
+	query = `SELECT C.name AS value, COUNT(DISTINCT CS.spell_id) AS count
+			  FROM Class AS C
+			  JOIN ClassSpells AS CS ON CS.class_id IN (C.id, C.base_class_id)
+			  WHERE CS.spell_id IN (` + spells + `)
+			  GROUP BY C.name
+			  ORDER BY C.name ASC`
+	facets.Classes = []FacetCount{}
+	if err := db.Select(&facets.Classes, query, args...); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
+
+// yesNo turns a boolean column read as a string into "yes" or "no"
+func yesNo(v string) string {
+	switch v {
+	case "1", "true", "TRUE":
+		return "yes"
+	}
+	return "no"
+}
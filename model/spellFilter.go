@@ -0,0 +1,224 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// SpellFilter describes which spells FilterCannonSpells and
+// FilterUserSpells return. A spell has to match every field that's
+// set, fields left at their zero value aren't considered.
+type SpellFilter struct {
+	// Levels the spell can be, 0 for cantrips
+	Levels []int
+	// Schools the spell can be in, ex. "Evocation"
+	Schools []string
+	// Class is the name of a class that can cast the spell,
+	// ex. "Wizard"
+	Class string
+	// SourceIDs are the books the spell can come from, Sources are
+	// the same by abbreviation, ex. "PHB". A spell from any of either
+	// matches.
+	SourceIDs []int
+	Sources   []string
+
+	// Components, ritual and concentration the spell must (true) or
+	// mustn't (false) have
+	Verbal        *bool
+	Somatic       *bool
+	Material      *bool
+	Ritual        *bool
+	Concentration *bool
+
+	// Name is text somewhere in the spell's name
+	Name string
+
+	// DamageType is one of spelltext.DamageTypes, Save is an ability
+	// or its abbreviation, ex. "dex", and Attack is "melee" or "ranged"
+	DamageType string
+	Save       string
+	Attack     string
+
+	// CastUnits are the units the spell's casting time can be in, ex.
+	// "bonus action", AreaShapes the shapes its area can be, ex.
+	// "cone", and DurationKinds the kinds its duration can be, ex.
+	// "minute". See spelltext's CastUnits, AreaShapes and
+	// DurationKinds.
+	CastUnits     []string
+	AreaShapes    []string
+	DurationKinds []string
+	// MaxRangeFeet is the farthest the spell's range can be in feet.
+	// Self and touch spells are in range, spells with a range we
+	// can't make sense of aren't.
+	MaxRangeFeet int
+}
+
+// IsEmpty reports whether f doesn't filter on anything
+func (f SpellFilter) IsEmpty() bool {
+	return len(f.Levels) == 0 && len(f.Schools) == 0 && f.Class == "" &&
+		len(f.SourceIDs) == 0 && len(f.Sources) == 0 && f.Verbal == nil && f.Somatic == nil &&
+		f.Material == nil && f.Ritual == nil && f.Concentration == nil &&
+		f.Name == "" && f.DamageType == "" && f.Save == "" && f.Attack == "" &&
+		len(f.CastUnits) == 0 && len(f.AreaShapes) == 0 && len(f.DurationKinds) == 0 &&
+		f.MaxRangeFeet == 0
+}
+
+// Normalize returns f with its damage type, save, attack, cast units,
+// area shapes and duration kinds in the form we store them, ex. "dex"
+// becomes "dexterity". It returns ErrNoResult if f is empty or asks
+// for something no spell can have.
+func (f SpellFilter) Normalize() (SpellFilter, error) {
+	if f.IsEmpty() {
+		return f, ErrNoResult
+	}
+	var ok bool
+	if f.DamageType != "" {
+		if f.DamageType, ok = spelltext.DamageType(f.DamageType); !ok {
+			return f, ErrNoResult
+		}
+	}
+	if f.Save != "" {
+		if f.Save, ok = spelltext.Ability(f.Save); !ok {
+			return f, ErrNoResult
+		}
+	}
+	switch f.Attack = strings.ToLower(f.Attack); f.Attack {
+	case "", spelltext.AttackMelee, spelltext.AttackRanged:
+	default:
+		return f, ErrNoResult
+	}
+	for _, l := range f.Levels {
+		if l < 0 || l > spelltext.MaxSlot {
+			return f, ErrNoResult
+		}
+	}
+	for _, n := range []struct {
+		vs     *[]string
+		lookup func(string) (string, bool)
+	}{
+		{&f.CastUnits, spelltext.CastUnit},
+		{&f.AreaShapes, spelltext.AreaShape},
+		{&f.DurationKinds, spelltext.DurationKind},
+	} {
+		normalized := make([]string, len(*n.vs))
+		for i, v := range *n.vs {
+			if normalized[i], ok = n.lookup(v); !ok {
+				return f, ErrNoResult
+			}
+		}
+		*n.vs = normalized
+	}
+	if f.MaxRangeFeet < 0 {
+		return f, ErrNoResult
+	}
+	return f, nil
+}
+
+// where builds the WHERE clause for a normalized f
+func (f SpellFilter) where() sq.And {
+	eqs := sq.Eq{}
+	where := sq.And{eqs}
+	if len(f.Levels) > 0 {
+		levels := make([]string, len(f.Levels))
+		for i, l := range f.Levels {
+			levels[i] = strconv.Itoa(l)
+		}
+		eqs["level"] = levels
+	}
+	if len(f.Schools) > 0 {
+		eqs["school"] = f.Schools
+	}
+	if f.Class != "" {
+		// a subclass's list includes its base class's
+		where = append(where, sq.Expr(`id IN (SELECT CS.spell_id
+											  FROM Class AS C
+											  JOIN ClassSpells AS CS ON CS.class_id IN (C.id, C.base_class_id)
+											  WHERE LOWER(C.name) = LOWER(?))`, f.Class))
+	}
+	switch {
+	case len(f.SourceIDs) > 0 && len(f.Sources) > 0:
+		where = append(where, sq.Or{sq.Eq{"source_id": f.SourceIDs}, sourceAbbreviations(f.Sources)})
+	case len(f.SourceIDs) > 0:
+		eqs["source_id"] = f.SourceIDs
+	case len(f.Sources) > 0:
+		where = append(where, sourceAbbreviations(f.Sources))
+	}
+	for col, b := range map[string]*bool{
+		"comp_verbal":   f.Verbal,
+		"comp_somatic":  f.Somatic,
+		"comp_material": f.Material,
+		"ritual":        f.Ritual,
+		"concentration": f.Concentration,
+	} {
+		if b != nil {
+			eqs[col] = *b
+		}
+	}
+	if f.Name != "" {
+		where = append(where, sq.Expr(`name LIKE ?`, likePattern(f.Name)))
+	}
+	if f.DamageType != "" {
+		where = append(where, sq.Expr(`id IN (SELECT spell_id FROM SpellDamageTypes WHERE damage_type = ?)`, f.DamageType))
+	}
+	if f.Save != "" {
+		where = append(where, sq.Expr(`id IN (SELECT spell_id FROM SpellSaves WHERE ability = ?)`, f.Save))
+	}
+	switch f.Attack {
+	case spelltext.AttackMelee:
+		eqs["melee_attack"] = true
+	case spelltext.AttackRanged:
+		eqs["ranged_attack"] = true
+	}
+	if len(f.CastUnits) > 0 {
+		eqs["cast_unit"] = f.CastUnits
+	}
+	if len(f.AreaShapes) > 0 {
+		eqs["area_shape"] = f.AreaShapes
+	}
+	if len(f.DurationKinds) > 0 {
+		eqs["duration_kind"] = f.DurationKinds
+	}
+	if f.MaxRangeFeet > 0 {
+		where = append(where, sq.Expr(`range_feet <= ? AND range_kind <> ?`, f.MaxRangeFeet, spelltext.RangeSpecial))
+	}
+	return where
+}
+
+// sourceAbbreviations matches spells from the books abbreviated by its
+// elements, ignoring case. The subquery is built along with the query
+// it's in, so any error building it comes out of that query's ToSql.
+type sourceAbbreviations []string
+
+func (abbrs sourceAbbreviations) ToSql() (string, []interface{}, error) {
+	lower := make([]string, len(abbrs))
+	for i, a := range abbrs {
+		lower[i] = strings.ToLower(a)
+	}
+	sources, args, err := sq.Select("id").From("Source").Where(sq.Eq{"LOWER(abbreviation)": lower}).ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return `source_id IN (` + sources + `)`, args, nil
+}
+
+// filterSpells runs f against table, with any extra conditions
+func (db *DB) filterSpells(table string, f SpellFilter, extra ...sq.Sqlizer) (*[]Spell, error) {
+	f, err := f.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	where := append(f.where(), extra...)
+	query, args, err := sq.Select("*").From(table).Where(where).OrderBy("name ASC", "id ASC").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	spells := &[]Spell{}
+	if err := db.Select(spells, query, args...); err != nil {
+		return nil, err
+	}
+	return spells, nil
+}
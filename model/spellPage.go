@@ -0,0 +1,163 @@
+package model
+
+import (
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Page sizes for spell listings
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+// ErrInvalidSort is returned for a Page with a Sort we don't know
+var ErrInvalidSort = errors.New("model: invalid spell sort")
+
+// SpellSort is what a spell listing is ordered by. Spells that sort
+// the same are ordered by name, then id, so a listing is in the same
+// order every time.
+type SpellSort string
+
+// The spell sorts, SortName is the default
+const (
+	SortName     SpellSort = "name"
+	SortLevel    SpellSort = "level"
+	SortSchool   SpellSort = "school"
+	SortCastTime SpellSort = "cast_time"
+)
+
+// SpellSorts are the sorts spell listings take, in the order we offer
+// them
+var SpellSorts = []SpellSort{SortName, SortLevel, SortSchool, SortCastTime}
+
+// ParseSpellSort returns the SpellSort s names, ignoring case. "" is
+// SortName.
+func ParseSpellSort(s string) (SpellSort, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return SortName, true
+	}
+	for _, sort := range SpellSorts {
+		if s == string(sort) || s == strings.Replace(string(sort), "_", "", -1) {
+			return sort, true
+		}
+	}
+	return "", false
+}
+
+// Page is the part of a spell listing to return, and how the listing
+// is sorted
+type Page struct {
+	// Number counts from 1
+	Number int
+	// Size is how many spells are on a page, DefaultPageSize if it's
+	// 0, no more than MaxPageSize
+	Size int
+	Sort SpellSort
+	// Desc reverses Sort, ties are still by name then id
+	Desc bool
+}
+
+// Normalize returns p with its zero values filled in and its size in
+// bounds. An unknown Sort is ErrInvalidSort.
+func (p Page) Normalize() (Page, error) {
+	if p.Number < 1 {
+		p.Number = 1
+	}
+	if p.Size <= 0 {
+		p.Size = DefaultPageSize
+	}
+	if p.Size > MaxPageSize {
+		p.Size = MaxPageSize
+	}
+	sort, ok := ParseSpellSort(string(p.Sort))
+	if !ok {
+		return p, ErrInvalidSort
+	}
+	p.Sort = sort
+	return p, nil
+}
+
+// Offset is how many spells come before p
+func (p Page) Offset() int {
+	return (p.Number - 1) * p.Size
+}
+
+// SpellPage is a page of a spell listing
+type SpellPage struct {
+	Spells []Spell
+	// Total is how many spells the listing has across every page
+	Total int
+	Page  Page
+}
+
+// ListCannonSpells returns page p of the cannon spells matching f, or
+// every cannon spell if f is empty, along with how many there are in
+// all. A filter no spell can match is ErrNoResult, like
+// FilterCannonSpells, a page past the end is empty.
+func (db *DB) ListCannonSpells(f SpellFilter, p Page) (*SpellPage, error) {
+	return db.listSpells(f, p, cannonSpells)
+}
+
+// ListUserSpells is ListCannonSpells over a user's spells
+func (db *DB) ListUserSpells(userID int, f SpellFilter, p Page) (*SpellPage, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidID
+	}
+	return db.listSpells(f, p, userSpells(userID))
+}
+
+func (db *DB) listSpells(f SpellFilter, p Page, scope sq.Sqlizer) (*SpellPage, error) {
+	p, err := p.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	where := sq.And{scope}
+	if !f.IsEmpty() {
+		f, err := f.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, f.where())
+	}
+
+	query, args, err := sq.Select("COUNT(*)").From("Spell").Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	page := &SpellPage{Spells: []Spell{}, Page: p}
+	if err := db.Get(&page.Total, query, args...); err != nil {
+		return nil, err
+	}
+
+	query, args, err = sq.Select("*").From("Spell").Where(where).
+		OrderBy(p.orderBy()...).
+		Limit(uint64(p.Size)).Offset(uint64(p.Offset())).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Select(&page.Spells, query, args...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// orderBy is the ORDER BY for p's sort
+func (p Page) orderBy() []string {
+	dir := " ASC"
+	if p.Desc {
+		dir = " DESC"
+	}
+	switch p.Sort {
+	case SortLevel, SortSchool, SortCastTime:
+		column := string(p.Sort)
+		if p.Sort == SortCastTime {
+			column = "cast_seconds"
+		}
+		return []string{column + dir, "name ASC", "id ASC"}
+	}
+	return []string{"name" + dir, "id ASC"}
+}
@@ -0,0 +1,10 @@
+package model
+
+// SpellRoll represents a row in our db's SpellRolls table, one of the
+// dice expressions a spell calls for, ex. "10d8" or "1d20+SPELL+PROF".
+// Position orders the rolls the way they appear in the spell.
+type SpellRoll struct {
+	SpellID    int    `db:"spell_id"`
+	Position   int    `db:"position"`
+	Expression string `db:"expression"`
+}
@@ -0,0 +1,212 @@
+package model
+
+import (
+	"fmt"
+	"html/template"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/murder-hobos/murder-hobos/fulltext"
+	"github.com/murder-hobos/murder-hobos/fuzzy"
+)
+
+// SpellMatch is a spell found by full-text or fuzzy search
+type SpellMatch struct {
+	Spell
+	// Score is how well the spell matches, higher is better. Full-text
+	// scores only compare between results of the same search, fuzzy
+	// ones are fuzzy.Score, from 0 to 1.
+	Score float64 `db:"score"`
+	// Snippet is the part of the spell's description or material that
+	// best matches, with the matching words in <mark>s
+	Snippet template.HTML `db:"-"`
+}
+
+// Full-text search weights, a match in a spell's name counts for
+// more than one in its description
+const (
+	nameWeight        = 3
+	descriptionWeight = 1
+	materialWeight    = 1
+	// snippetWords is about how long a SpellMatch.Snippet is
+	snippetWords = 30
+)
+
+// FuzzySearchLimit is the most spells fuzzy search returns
+const FuzzySearchLimit = 5
+
+// cannonSpells narrows a query on Spell to the spells in the
+// CannonSpells view, the ones from canon books
+var cannonSpells = sq.Expr(`source_id IN (SELECT id FROM Source WHERE canon)`)
+
+// userSpells narrows a query on Spell to a user's homebrew
+func userSpells(userID int) sq.Sqlizer {
+	return sq.Eq{"user_id": userID}
+}
+
+// SearchFields is what full-text search looks at in s, for indexing
+// it in a fulltext.Index
+func (s *Spell) SearchFields() []fulltext.Field {
+	return []fulltext.Field{
+		{Text: s.Name, Weight: nameWeight},
+		{Text: s.Description, Weight: descriptionWeight},
+		{Text: s.MaterialDesc.String, Weight: materialWeight},
+	}
+}
+
+// Match returns s as a match for the full-text search text, with a
+// snippet of it
+func (s *Spell) Match(text string, score float64) SpellMatch {
+	return SpellMatch{
+		Spell:   *s,
+		Score:   score,
+		Snippet: fulltext.Snippet(text, snippetWords, s.Description, s.MaterialDesc.String),
+	}
+}
+
+// FullTextSearchCannonSpells returns cannon spells with any of the
+// words in text in their name, description or material, best match
+// first. Only spells matching f are searched, unless it's empty.
+// Text without any words worth searching for is ErrNoResult.
+//
+// Against mysql this uses the FULLTEXT indexes on Spell, anywhere else
+// we index the candidate spells with package fulltext.
+func (db *DB) FullTextSearchCannonSpells(text string, f SpellFilter) (*[]SpellMatch, error) {
+	return db.fullTextSearch(text, f, cannonSpells)
+}
+
+// FullTextSearchUserSpells is FullTextSearchCannonSpells over a user's
+// spells
+func (db *DB) FullTextSearchUserSpells(userID int, text string, f SpellFilter) (*[]SpellMatch, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidID
+	}
+	return db.fullTextSearch(text, f, userSpells(userID))
+}
+
+func (db *DB) fullTextSearch(text string, f SpellFilter, scope sq.Sqlizer) (*[]SpellMatch, error) {
+	if len(fulltext.Words(text)) == 0 {
+		return nil, ErrNoResult
+	}
+	where := sq.And{scope}
+	if !f.IsEmpty() {
+		f, err := f.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, f.where())
+	}
+
+	if db.DriverName() == "mysql" {
+		return db.mysqlFullTextSearch(text, where)
+	}
+
+	query, args, err := sq.Select("*").From("Spell").Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	spells := []Spell{}
+	if err := db.Select(&spells, query, args...); err != nil {
+		return nil, err
+	}
+
+	ix := fulltext.NewIndex()
+	byID := make(map[int]*Spell, len(spells))
+	for i := range spells {
+		s := &spells[i]
+		ix.Add(s.ID, s.SearchFields()...)
+		byID[s.ID] = s
+	}
+	matches := []SpellMatch{}
+	for _, h := range ix.Search(text) {
+		matches = append(matches, byID[h.ID].Match(text, h.Score))
+	}
+	return &matches, nil
+}
+
+// mysqlFullTextSearch ranks with mysql's natural language search, a
+// match in the name counting nameWeight times
+func (db *DB) mysqlFullTextSearch(text string, where sq.And) (*[]SpellMatch, error) {
+	score := sq.Expr(fmt.Sprintf(`(MATCH(name) AGAINST (?) * %d
+		+ MATCH(name, description, material_desc) AGAINST (?)) AS score`, nameWeight), text, text)
+	where = append(where, sq.Expr(`MATCH(name, description, material_desc) AGAINST (?)`, text))
+	query, args, err := sq.Select("*").Column(score).From("Spell").Where(where).
+		OrderBy("score DESC", "name ASC").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []SpellMatch{}
+	if err := db.Select(&matches, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range matches {
+		m := &matches[i]
+		*m = m.Spell.Match(text, m.Score)
+	}
+	return &matches, nil
+}
+
+// FuzzySearchCannonSpells returns the cannon spells with names closest
+// to name, for when it's misspelled, best first. Only names that are
+// at least fuzzy.MinScore alike are returned, and no more than
+// FuzzySearchLimit of them. The matches have no Snippet.
+func (db *DB) FuzzySearchCannonSpells(name string) (*[]SpellMatch, error) {
+	return db.fuzzySearch(name, cannonSpells)
+}
+
+// FuzzySearchUserSpells is FuzzySearchCannonSpells over a user's
+// spells
+func (db *DB) FuzzySearchUserSpells(userID int, name string) (*[]SpellMatch, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidID
+	}
+	return db.fuzzySearch(name, userSpells(userID))
+}
+
+// fuzzySearch ranks every name in scope, our spell lists are small
+// enough that this beats keeping a trigram index in the database
+func (db *DB) fuzzySearch(name string, scope sq.Sqlizer) (*[]SpellMatch, error) {
+	query, args, err := sq.Select("id", "name").From("Spell").Where(scope).OrderBy("name").ToSql()
+	if err != nil {
+		return nil, err
+	}
+	candidates := []struct {
+		ID   int    `db:"id"`
+		Name string `db:"name"`
+	}{}
+	if err := db.Select(&candidates, query, args...); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(candidates))
+	for i, c := range candidates {
+		names[i] = c.Name
+	}
+	closest := fuzzy.Closest(name, names, FuzzySearchLimit)
+	if len(closest) == 0 {
+		return &[]SpellMatch{}, nil
+	}
+
+	ids := make([]int, len(closest))
+	for i, m := range closest {
+		ids[i] = candidates[m.Index].ID
+	}
+	query, args, err = sq.Select("*").From("Spell").Where(sq.Eq{"id": ids}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	spells := []Spell{}
+	if err := db.Select(&spells, query, args...); err != nil {
+		return nil, err
+	}
+	byID := make(map[int]Spell, len(spells))
+	for _, s := range spells {
+		byID[s.ID] = s
+	}
+	matches := make([]SpellMatch, 0, len(closest))
+	for i, m := range closest {
+		if s, ok := byID[ids[i]]; ok {
+			matches = append(matches, SpellMatch{Spell: s, Score: m.Score})
+		}
+	}
+	return &matches, nil
+}
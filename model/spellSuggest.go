@@ -0,0 +1,97 @@
+package model
+
+import (
+	"sync"
+
+	"github.com/murder-hobos/murder-hobos/prefix"
+)
+
+// SuggestLimit is the most spells SuggestSpells returns
+const SuggestLimit = 10
+
+// SpellSuggestion is a spell whose name completes what someone is
+// typing
+type SpellSuggestion struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+	// UserID is whose homebrew the spell is, 0 if it's from a book
+	UserID int `db:"user_id"`
+	// Canon is whether the spell is from a canon book
+	Canon bool `db:"canon"`
+}
+
+// spellNames is every spell's name in a prefix.Index, so suggestions
+// don't cost a query per keystroke
+type spellNames struct {
+	mu sync.RWMutex
+	ix *prefix.Index
+	// spells are the suggestions by spell id
+	spells map[int]SpellSuggestion
+}
+
+func newSpellNames() *spellNames {
+	return &spellNames{ix: prefix.NewIndex(), spells: make(map[int]SpellSuggestion)}
+}
+
+func (n *spellNames) add(s SpellSuggestion) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.ix.Add(s.ID, s.Name)
+	n.spells[s.ID] = s
+}
+
+func (n *spellNames) remove(id int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.ix.Remove(id)
+	delete(n.spells, id)
+}
+
+// SuggestSpells returns the cannon spells, and userID's spells unless
+// it's 0, with names completing typed, best first: names starting with
+// it, then names with a later word that does, shorter names first. No
+// more than SuggestLimit are returned.
+//
+// Suggestions come from an index of names loaded when the DB is made
+// and kept up to date by CreateSpell and DeleteSpell, see
+// LoadSpellNames.
+func (db *DB) SuggestSpells(userID int, typed string) (*[]SpellSuggestion, error) {
+	if userID < 0 {
+		return nil, ErrInvalidID
+	}
+	db.names.mu.RLock()
+	defer db.names.mu.RUnlock()
+
+	keep := func(id int) bool {
+		s := db.names.spells[id]
+		return s.Canon || (userID != 0 && s.UserID == userID)
+	}
+	suggestions := []SpellSuggestion{}
+	for _, c := range db.names.ix.Search(typed, SuggestLimit, keep) {
+		suggestions = append(suggestions, db.names.spells[c.ID])
+	}
+	return &suggestions, nil
+}
+
+// LoadSpellNames builds the index SuggestSpells answers from out of
+// every spell in the database. NewDB and NewSQLiteDB load it, spells
+// changed some other way than CreateSpell and DeleteSpell, like by
+// initDb.Import or initDb.UpdateStream, need it loaded again.
+func (db *DB) LoadSpellNames() error {
+	spells := []SpellSuggestion{}
+	if err := db.Select(&spells, `SELECT S.id, S.name, COALESCE(S.user_id, 0) AS user_id,
+									  COALESCE(So.canon, FALSE) AS canon
+								  FROM Spell AS S
+								  LEFT JOIN Source AS So ON S.source_id = So.id`); err != nil {
+		return err
+	}
+	names := newSpellNames()
+	for _, s := range spells {
+		names.add(s)
+	}
+
+	db.names.mu.Lock()
+	defer db.names.mu.Unlock()
+	db.names.ix, db.names.spells = names.ix, names.spells
+	return nil
+}
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/murder-hobos/murder-hobos/spelltext"
+
+// SpellUpcast represents a row in our db's SpellUpcasts table, one of
+// the rules from a spell's "At Higher Levels" text. Position orders
+// them the way they appear in the description.
+type SpellUpcast struct {
+	SpellID  int `db:"spell_id"`
+	Position int `db:"position"`
+	spelltext.UpcastRule
+}
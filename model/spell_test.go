@@ -23,7 +23,7 @@ func TestSpell_ComponentsStr(t *testing.T) {
 		Concentration bool
 		Ritual        bool
 		Description   string
-		SourceID      int
+		SourceID      sql.NullInt64
 	}
 	tests := []struct {
 		name   string
@@ -100,7 +100,7 @@ func TestSpell_HTMLDescription(t *testing.T) {
 		Concentration bool
 		Ritual        bool
 		Description   string
-		SourceID      int
+		SourceID      sql.NullInt64
 	}
 	tests := []struct {
 		name   string
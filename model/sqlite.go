@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/murder-hobos/murder-hobos/db/migrate"
+
+	//Import for side effects
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// NewSQLiteDB returns an initialized DB backed by the sqlite database
+// file at path, creating the file if it doesn't exist and migrating
+// it to our latest schema.
+// ":memory:" gives a throwaway database that lives as long as the DB.
+//
+// Every Datastore method on DB works the same against either database.
+// If an error is encountered, nil is returned, along with the error.
+func NewSQLiteDB(path string) (*DB, error) {
+	db, err := sqlx.Open("sqlite3", path+"?_foreign_keys=1")
+	if err != nil {
+		return nil, err
+	}
+	// sqlite only allows one writer at a time, and every connection
+	// to ":memory:" would otherwise get its own empty database
+	db.SetMaxOpenConns(1)
+
+	if _, err := migrate.Up(db, 0); err != nil {
+		db.Close()
+		return nil, err
+	}
+	d := &DB{DB: db, names: newSpellNames()}
+	if err := d.LoadSpellNames(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return d, nil
+}
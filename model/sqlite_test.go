@@ -0,0 +1,396 @@
+package model
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/util"
+)
+
+func newTestSQLiteDB(t *testing.T) *DB {
+	db, err := NewSQLiteDB(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteDB() error = %v", err)
+	}
+	return db
+}
+
+func TestNewSQLiteDB_Schema(t *testing.T) {
+	db := newTestSQLiteDB(t)
+
+	cs, err := db.GetAllClasses()
+	if err != nil {
+		t.Fatalf("GetAllClasses() error = %v", err)
+	}
+	if len(*cs) != 38 {
+		t.Errorf("GetAllClasses() got %d classes, want 38", len(*cs))
+	}
+	c, err := db.GetClassByName("cleric (light)")
+	if err != nil {
+		t.Fatalf("GetClassByName() error = %v", err)
+	}
+	if c.ID != 6 || !c.BaseClass.Valid || c.BaseClass.Int64 != 2 {
+		t.Errorf("GetClassByName() = %+v", c)
+	}
+	if _, err := db.GetClassByName("Barbarian"); err != ErrNoResult {
+		t.Errorf("GetClassByName(Barbarian) error = %v, want ErrNoResult", err)
+	}
+	subclasses, err := db.GetSubclasses(2)
+	if err != nil || len(*subclasses) != 9 || (*subclasses)[0].Name != "Cleric (Arcana)" {
+		t.Errorf("GetSubclasses(Cleric) = %+v, %v", subclasses, err)
+	}
+	tree, err := db.GetClassTree()
+	if err != nil || len(*tree) != 10 {
+		t.Fatalf("GetClassTree() = %+v, %v", tree, err)
+	}
+	if cleric := (*tree)[1]; cleric.Name != "Cleric" || len(cleric.Subclasses) != 9 || cleric.Subclasses[4].ID != 6 {
+		t.Errorf("GetClassTree()[1] = %+v, want Cleric and its 9 domains by name", cleric)
+	}
+	if s, err := db.GetSourceByID(1); err != nil || s.Abbreviation != "PHB" {
+		t.Errorf("GetSourceByID(1) = %+v, %v", s, err)
+	}
+	if u, ok := db.GetUserByID(1); ok {
+		t.Errorf("GetUserByID(1) = %+v, want no user, PHB is a source", u)
+	}
+}
+
+func TestSQLiteDB_Spells(t *testing.T) {
+	db := newTestSQLiteDB(t)
+
+	cannon := Spell{
+		Name:         "Fire Bolt",
+		Level:        "0",
+		School:       "Evocation",
+		CastTime:     "1 action",
+		Duration:     "Instantaneous",
+		Range:        "120 feet",
+		Verbal:       true,
+		Somatic:      true,
+		MaterialDesc: util.ToNullString(""),
+		Description:  "You hurl a mote of fire. Make a ranged spell attack. On a hit it takes 1d10 fire damage and must make a Dexterity saving throw.",
+		SourceID:     sql.NullInt64{Int64: 1, Valid: true},
+	}
+	cannonID, err := db.CreateSpell(0, cannon)
+	if err != nil {
+		t.Fatalf("CreateSpell() error = %v", err)
+	}
+	if cannonID <= 0 {
+		t.Errorf("CreateSpell() id = %d", cannonID)
+	}
+
+	u, ok := db.CreateUser("bob", "hunter2")
+	if !ok {
+		t.Fatal("CreateUser() failed")
+	}
+	homebrew := cannon
+	homebrew.Name = "Fire Bolt 2"
+	homebrew.SourceID = sql.NullInt64{}
+	homebrew.Description = "<script>boom</script>"
+	id, err := db.CreateSpell(u.ID, homebrew)
+	if err != nil {
+		t.Fatalf("CreateSpell() error = %v", err)
+	}
+
+	all, err := db.GetAllCannonSpells()
+	if err != nil {
+		t.Fatalf("GetAllCannonSpells() error = %v", err)
+	}
+	if len(*all) != 1 || (*all)[0].Name != "Fire Bolt" || !(*all)[0].Verbal {
+		t.Errorf("GetAllCannonSpells() = %+v", *all)
+	}
+	if got := (*all)[0]; got.CastUnit != "action" || got.RangeFeet != 120 || got.DurationKind != "instantaneous" {
+		t.Errorf("GetAllCannonSpells() parsed casting = %+v %+v %+v",
+			got.ParsedCastTime, got.ParsedRange, got.ParsedDuration)
+	}
+
+	if s, err := db.GetCannonSpellByName("FIRE BOLT"); err != nil || s.ID != cannonID {
+		t.Errorf("GetCannonSpellByName() = %+v, %v", s, err)
+	}
+	if _, err := db.GetCannonSpellByName("Fire Bolt 2"); err != ErrNoResult {
+		t.Errorf("GetCannonSpellByName() found a homebrew spell, error = %v", err)
+	}
+	if found, err := db.SearchCannonSpells("bolt"); err != nil || len(*found) != 1 {
+		t.Errorf("SearchCannonSpells() = %v, %v", found, err)
+	}
+	if found, err := db.SearchUserSpells(u.ID, "bolt"); err != nil || len(*found) != 1 {
+		t.Errorf("SearchUserSpells() = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Levels: []int{0}, Schools: []string{"Evocation"}}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells() = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{DamageType: "fire", Save: "dex", Attack: "ranged"}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells(fire, dex, ranged) = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Attack: "melee"}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterCannonSpells(melee) = %v, %v, want none", found, err)
+	}
+	if _, err := db.FilterCannonSpells(SpellFilter{Attack: "sideways"}); err != ErrNoResult {
+		t.Errorf("FilterCannonSpells(sideways) error = %v, want ErrNoResult", err)
+	}
+	// The homebrew copy's script tags were stripped, leaving no fire
+	if found, err := db.FilterUserSpells(u.ID, SpellFilter{Levels: []int{0}, DamageType: "fire"}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterUserSpells(fire) = %v, %v, want none", found, err)
+	}
+	yes := true
+	if found, err := db.FilterCannonSpells(SpellFilter{Levels: []int{0, 1, 2}, Verbal: &yes, Name: "BOLT"}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells(levels, verbal, name) = %v, %v", found, err)
+	}
+	wizard, err := db.GetClassByName("Wizard")
+	if err != nil {
+		t.Fatalf("GetClassByName() error = %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO ClassSpells (class_id, spell_id) VALUES (?, ?)`, wizard.ID, cannonID); err != nil {
+		t.Fatalf("inserting ClassSpells error = %v", err)
+	}
+	if spells, err := db.GetClassSpells(wizard.ID); err != nil || len(*spells) != 1 || (*spells)[0].Subclass {
+		t.Errorf("GetClassSpells(Wizard) = %+v, %v", spells, err)
+	}
+	ek, err := db.GetClassByName("Fighter (Eldritch Knight)")
+	if err != nil {
+		t.Fatalf("GetClassByName() error = %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO ClassSpells (class_id, spell_id) VALUES (?, ?)`, ek.ID, cannonID); err != nil {
+		t.Fatalf("inserting ClassSpells error = %v", err)
+	}
+	if spells, err := db.GetClassSpells(ek.ID); err != nil || len(*spells) != 1 || !(*spells)[0].Subclass {
+		t.Errorf("GetClassSpells(Eldritch Knight) = %+v, %v, want Fire Bolt from the subclass", spells, err)
+	}
+	if _, err := db.Exec(`INSERT INTO ClassSpells (class_id, spell_id) VALUES (?, ?)`, ek.BaseClass.Int64, cannonID); err != nil {
+		t.Fatalf("inserting ClassSpells error = %v", err)
+	}
+	if spells, err := db.GetClassSpells(ek.ID); err != nil || len(*spells) != 1 || (*spells)[0].Subclass {
+		t.Errorf("GetClassSpells(Eldritch Knight) = %+v, %v, want Fire Bolt from Fighter", spells, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Class: "fighter (eldritch knight)"}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells(Eldritch Knight) = %v, %v, want Fire Bolt from Fighter", found, err)
+	}
+	if facets, err := db.CannonSpellFacets("", SpellFilter{}); err != nil || len(facets.Classes) != 3 ||
+		facets.Classes[1] != (FacetCount{Value: "Fighter (Eldritch Knight)", Count: 1}) {
+		t.Errorf("CannonSpellFacets() classes = %+v, %v, want Fire Bolt counted once for the Eldritch Knight", facets, err)
+	}
+	if _, err := db.Exec(`DELETE FROM ClassSpells WHERE class_id IN (?, ?)`, ek.ID, ek.BaseClass.Int64); err != nil {
+		t.Fatalf("deleting ClassSpells error = %v", err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Class: "wizard"}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells(wizard) = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Class: "Cleric"}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterCannonSpells(cleric) = %v, %v, want none", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{CastUnits: []string{"Action"}, MaxRangeFeet: 120, DurationKinds: []string{"instantaneous"}}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells(action, 120 feet) = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{MaxRangeFeet: 119}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterCannonSpells(119 feet) = %v, %v, want none", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{AreaShapes: []string{"cone"}}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterCannonSpells(cone) = %v, %v, want none", found, err)
+	}
+	if _, err := db.FilterCannonSpells(SpellFilter{CastUnits: []string{"turn"}}); err != ErrNoResult {
+		t.Errorf("FilterCannonSpells(turn) error = %v, want ErrNoResult", err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Ritual: &yes}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterCannonSpells(ritual) = %v, %v, want none", found, err)
+	}
+	if found, err := db.FilterUserSpells(u.ID, SpellFilter{Schools: []string{"Abjuration", "Evocation"}}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterUserSpells(schools) = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Sources: []string{"phb"}}); err != nil || len(*found) != 1 || (*found)[0].ID != cannonID {
+		t.Errorf("FilterCannonSpells(phb) = %v, %v", found, err)
+	}
+	if found, err := db.FilterCannonSpells(SpellFilter{Sources: []string{"EE"}, SourceIDs: []int{1}}); err != nil || len(*found) != 1 {
+		t.Errorf("FilterCannonSpells(EE or 1) = %v, %v", found, err)
+	}
+	if found, err := db.FilterUserSpells(u.ID, SpellFilter{Sources: []string{"PHB"}}); err != nil || len(*found) != 0 {
+		t.Errorf("FilterUserSpells(PHB) = %v, %v, want no homebrew", found, err)
+	}
+	if s, err := db.GetSpellByID(cannonID); err != nil || s.IsHomebrew() || s.SourceID.Int64 != 1 {
+		t.Errorf("GetSpellByID(cannon) = %+v, %v", s, err)
+	}
+	if saves, err := db.GetSpellSaves(cannonID); err != nil || len(*saves) != 1 || (*saves)[0].Ability != "dexterity" {
+		t.Errorf("GetSpellSaves() = %v, %v", saves, err)
+	}
+
+	matches, err := db.FullTextSearchCannonSpells("hurling fire", SpellFilter{})
+	if err != nil || len(*matches) != 1 || (*matches)[0].ID != cannonID || (*matches)[0].Score <= 0 {
+		t.Fatalf("FullTextSearchCannonSpells() = %v, %v", matches, err)
+	}
+	if snippet := string((*matches)[0].Snippet); !strings.Contains(snippet, "You <mark>hurl</mark> a mote of <mark>fire</mark>.") {
+		t.Errorf("FullTextSearchCannonSpells() snippet = %q", snippet)
+	}
+	if matches, err := db.FullTextSearchCannonSpells("fire", SpellFilter{Attack: "melee"}); err != nil || len(*matches) != 0 {
+		t.Errorf("FullTextSearchCannonSpells(melee) = %v, %v, want none", matches, err)
+	}
+	if matches, err := db.FullTextSearchUserSpells(u.ID, "bolt", SpellFilter{}); err != nil || len(*matches) != 1 || (*matches)[0].ID != id {
+		t.Errorf("FullTextSearchUserSpells() = %v, %v", matches, err)
+	}
+
+	if matches, err := db.FuzzySearchCannonSpells("Fir Bolt"); err != nil || len(*matches) != 1 || (*matches)[0].ID != cannonID || (*matches)[0].Score >= 1 {
+		t.Errorf("FuzzySearchCannonSpells() = %v, %v", matches, err)
+	}
+	if matches, err := db.FuzzySearchCannonSpells("Wish"); err != nil || len(*matches) != 0 {
+		t.Errorf("FuzzySearchCannonSpells(Wish) = %v, %v, want none", matches, err)
+	}
+	if matches, err := db.FuzzySearchUserSpells(u.ID, "firebolt 2"); err != nil || len(*matches) != 1 || (*matches)[0].ID != id || (*matches)[0].Score != 1 {
+		t.Errorf("FuzzySearchUserSpells() = %v, %v", matches, err)
+	}
+	if _, err := db.FuzzySearchUserSpells(0, "bolt"); err != ErrInvalidID {
+		t.Errorf("FuzzySearchUserSpells(0) error = %v, want ErrInvalidID", err)
+	}
+
+	page, err := db.ListUserSpells(u.ID, SpellFilter{}, Page{Sort: SortLevel, Size: 1})
+	if err != nil || page.Total != 1 || len(page.Spells) != 1 || page.Spells[0].ID != id {
+		t.Errorf("ListUserSpells() = %+v, %v", page, err)
+	}
+	page, err = db.ListCannonSpells(SpellFilter{Levels: []int{0}}, Page{Number: 2, Size: 1})
+	if err != nil || page.Total != 1 || len(page.Spells) != 0 {
+		t.Errorf("ListCannonSpells(page 2) = %+v, %v", page, err)
+	}
+	if _, err := db.ListCannonSpells(SpellFilter{Attack: "sideways"}, Page{}); err != ErrNoResult {
+		t.Errorf("ListCannonSpells(sideways) error = %v, want ErrNoResult", err)
+	}
+
+	facets, err := db.CannonSpellFacets("", SpellFilter{})
+	if err != nil {
+		t.Fatalf("CannonSpellFacets() error = %v", err)
+	}
+	if len(facets.Classes) != 1 || facets.Classes[0] != (FacetCount{Value: "Wizard", Count: 1}) {
+		t.Errorf("CannonSpellFacets() classes = %+v", facets.Classes)
+	}
+	if len(facets.Sources) != 1 || facets.Sources[0] != (FacetCount{Value: "PHB", Label: "Player's Handbook", Count: 1}) {
+		t.Errorf("CannonSpellFacets() sources = %+v", facets.Sources)
+	}
+	if len(facets.Ritual) != 1 || facets.Ritual[0].Value != "no" || len(facets.Levels) != 1 || facets.Levels[0].Value != "0" {
+		t.Errorf("CannonSpellFacets() = %+v", facets)
+	}
+	if facets, err := db.UserSpellFacets(u.ID, "bolt", SpellFilter{}); err != nil || len(facets.Schools) != 1 || facets.Schools[0].Count != 1 {
+		t.Errorf("UserSpellFacets(bolt) = %+v, %v", facets, err)
+	}
+	if _, err := db.CannonSpellFacets("", SpellFilter{Attack: "sideways"}); err != ErrNoResult {
+		t.Errorf("CannonSpellFacets(sideways) error = %v, want ErrNoResult", err)
+	}
+
+	if found, err := db.SuggestSpells(0, "fire b"); err != nil || len(*found) != 1 || (*found)[0].ID != cannonID {
+		t.Errorf("SuggestSpells(0) = %v, %v, want only the cannon spell", found, err)
+	}
+	if found, err := db.SuggestSpells(u.ID, "bolt"); err != nil || len(*found) != 2 || (*found)[1].ID != id || (*found)[1].UserID != u.ID {
+		t.Errorf("SuggestSpells() = %v, %v, want both spells", found, err)
+	}
+	if _, err := db.SuggestSpells(-1, "bolt"); err != ErrInvalidID {
+		t.Errorf("SuggestSpells(-1) error = %v, want ErrInvalidID", err)
+	}
+	if _, err := db.Exec(`INSERT INTO Spell (name, level, school, cast_time, duration, ` + "`range`" + `, comp_verbal, comp_somatic, comp_material, concentration, ritual, description, source_id)
+		VALUES ('Boltcaller', '1', 'Evocation', '1 action', 'Instantaneous', 'Self', 1, 0, 0, 0, 0, '', 1)`); err != nil {
+		t.Fatalf("inserting Spell error = %v", err)
+	}
+	if found, err := db.SuggestSpells(0, "bolt"); err != nil || len(*found) != 1 {
+		t.Errorf("SuggestSpells() before LoadSpellNames = %v, %v", found, err)
+	}
+	if err := db.LoadSpellNames(); err != nil {
+		t.Fatalf("LoadSpellNames() error = %v", err)
+	}
+	if found, err := db.SuggestSpells(0, "bolt"); err != nil || len(*found) != 2 || (*found)[0].Name != "Boltcaller" {
+		t.Errorf("SuggestSpells() after LoadSpellNames = %v, %v", found, err)
+	}
+
+	s, err := db.GetSpellByID(id)
+	if err != nil {
+		t.Fatalf("GetSpellByID() error = %v", err)
+	}
+	if s.Description != "boom" || s.MaterialDesc.Valid {
+		t.Errorf("GetSpellByID() = %+v", s)
+	}
+
+	if err := db.DeleteSpell(u.ID, id); err != nil {
+		t.Errorf("DeleteSpell() error = %v", err)
+	}
+	if _, err := db.GetSpellByID(id); err != ErrNoResult {
+		t.Errorf("GetSpellByID() after delete error = %v, want ErrNoResult", err)
+	}
+	if found, err := db.SuggestSpells(u.ID, "fire bolt 2"); err != nil || len(*found) != 0 {
+		t.Errorf("SuggestSpells() after delete = %v, %v, want none", found, err)
+	}
+}
+
+func TestSQLiteDB_CharacterLevels(t *testing.T) {
+	db := newTestSQLiteDB(t)
+
+	u, ok := db.CreateUser("bob", "hash")
+	if !ok {
+		t.Fatal("CreateUser() failed")
+	}
+	id, err := db.CreateCharacter(u.ID, &Character{Name: "Grog", Race: "Goliath", UserID: u.ID})
+	if err != nil {
+		t.Fatalf("CreateCharacter() error = %v", err)
+	}
+	if level, err := db.GetCharacterLevel(id); err != nil || level != 0 {
+		t.Errorf("GetCharacterLevel() of a new character = %d, %v, want 0", level, err)
+	}
+
+	// Fighter and Wizard, then level up as a fighter
+	for _, l := range []CharacterLevel{{ClassID: 35, Level: 2}, {ClassID: 34, Level: 3}, {ClassID: 35, Level: 4}} {
+		if err := db.SetCharacterLevel(id, l.ClassID, l.Level); err != nil {
+			t.Fatalf("SetCharacterLevel(%d, %d) error = %v", l.ClassID, l.Level, err)
+		}
+	}
+	if level, err := db.GetCharacterLevel(id); err != nil || level != 7 {
+		t.Errorf("GetCharacterLevel() = %d, %v, want 7", level, err)
+	}
+	levels, err := db.GetCharacterLevels(id)
+	if err != nil {
+		t.Fatalf("GetCharacterLevels() error = %v", err)
+	}
+	want := []CharacterLevel{{CharID: id, ClassID: 34, Level: 3}, {CharID: id, ClassID: 35, Level: 4}}
+	if len(*levels) != 2 || (*levels)[0] != want[0] || (*levels)[1] != want[1] {
+		t.Errorf("GetCharacterLevels() = %+v, want %+v", *levels, want)
+	}
+
+	if err := db.SetCharacterLevel(id, 34, 0); err != ErrInvalidLevel {
+		t.Errorf("SetCharacterLevel(0) error = %v, want ErrInvalidLevel", err)
+	}
+	if err := db.SetCharacterLevel(id+1, 34, 1); err == nil {
+		t.Error("SetCharacterLevel() of a missing character returned no error")
+	}
+
+	vex, err := db.CreateCharacter(u.ID, &Character{Name: "Vex", UserID: u.ID}, CharacterLevel{ClassID: 34, Level: 5})
+	if err != nil {
+		t.Fatalf("CreateCharacter() with a level error = %v", err)
+	}
+	if level, err := db.GetCharacterLevel(vex); err != nil || level != 5 {
+		t.Errorf("GetCharacterLevel() of a character created at level 5 = %d, %v, want 5", level, err)
+	}
+	// a missing class rolls back the character too
+	if _, err := db.CreateCharacter(u.ID, &Character{Name: "Pike", UserID: u.ID}, CharacterLevel{ClassID: 9999, Level: 1}); err == nil {
+		t.Error("CreateCharacter() with a missing class returned no error")
+	}
+	if c, err := db.GetCharacterByName(u.ID, "Pike"); err == nil {
+		t.Errorf("CreateCharacter() with a missing class saved %+v anyway", c)
+	}
+}
+
+func TestSQLiteDB_Sources(t *testing.T) {
+	db := newTestSQLiteDB(t)
+
+	sources, err := db.GetAllSources()
+	if err != nil || len(*sources) != 3 {
+		t.Fatalf("GetAllSources() = %v, %v", sources, err)
+	}
+	for i, abbr := range []string{"PHB", "EE", "SCAG"} {
+		if (*sources)[i].Abbreviation != abbr || !(*sources)[i].Canon {
+			t.Errorf("GetAllSources()[%d] = %+v, want canon %s", i, (*sources)[i], abbr)
+		}
+	}
+	if s, err := db.GetSourceByAbbreviation("ee"); err != nil || s.ID != 2 || s.ReleaseYear() != "2015" {
+		t.Errorf("GetSourceByAbbreviation(ee) = %+v, %v", s, err)
+	}
+	if s, err := db.GetSourceByID(3); err != nil || s.Name != "Sword Coast Adventurer's Guide" {
+		t.Errorf("GetSourceByID(3) = %+v, %v", s, err)
+	}
+	if _, err := db.GetSourceByID(4); err != ErrNoResult {
+		t.Errorf("GetSourceByID(4) error = %v, want ErrNoResult", err)
+	}
+	if _, err := db.GetSourceByAbbreviation(" "); err != ErrNoResult {
+		t.Errorf("GetSourceByAbbreviation() error = %v, want ErrNoResult", err)
+	}
+}
@@ -0,0 +1,144 @@
+// Package prefix is an in-process index of names for completing what
+// someone has typed so far, ex. a search box's suggestions.
+//
+// Every word of a name is a way into it, so "bolt" completes to
+// "Fire Bolt". Case, apostrophes and punctuation don't matter,
+// "tashas hid" completes to "Tasha's Hideous Laughter". Names that
+// start with what was typed come before names with a later word that
+// does, then shorter names before longer ones.
+package prefix
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Completion is a name completing a search
+type Completion struct {
+	ID   int
+	Name string
+}
+
+// Index keeps names sorted by each of their words, so completing is a
+// binary search. The zero value is not usable, use NewIndex. An Index
+// isn't safe for concurrent use, callers that share one lock it.
+type Index struct {
+	// keys are each name from each of its words on, normalized, in
+	// order
+	keys  []key
+	names map[int]string
+}
+
+type key struct {
+	text string
+	id   int
+	// first is whether text is the whole name, not from a later word
+	first bool
+}
+
+// NewIndex returns an empty Index
+func NewIndex() *Index {
+	return &Index{names: make(map[int]string)}
+}
+
+// Add indexes name as id, replacing whatever was indexed as id before
+func (ix *Index) Add(id int, name string) {
+	ix.Remove(id)
+	ws := words(name)
+	if len(ws) == 0 {
+		return
+	}
+	ix.names[id] = name
+	for i := range ws {
+		k := key{text: strings.Join(ws[i:], " "), id: id, first: i == 0}
+		at := sort.Search(len(ix.keys), func(j int) bool { return !ix.keys[j].less(k) })
+		ix.keys = append(ix.keys, key{})
+		copy(ix.keys[at+1:], ix.keys[at:])
+		ix.keys[at] = k
+	}
+}
+
+// Remove takes id out of the index, if it's there
+func (ix *Index) Remove(id int) {
+	if _, ok := ix.names[id]; !ok {
+		return
+	}
+	keys := ix.keys[:0]
+	for _, k := range ix.keys {
+		if k.id != id {
+			keys = append(keys, k)
+		}
+	}
+	ix.keys = keys
+	delete(ix.names, id)
+}
+
+// Len is how many names are indexed
+func (ix *Index) Len() int {
+	return len(ix.names)
+}
+
+// Search returns up to limit names completing typed, best first. Only
+// ids keep returns true for are considered, every id if keep is nil.
+func (ix *Index) Search(typed string, limit int, keep func(id int) bool) []Completion {
+	q := strings.Join(words(typed), " ")
+	if q == "" || limit <= 0 {
+		return []Completion{}
+	}
+
+	// first is whether any of an id's matching keys is its whole name
+	first := make(map[int]bool)
+	at := sort.Search(len(ix.keys), func(j int) bool { return ix.keys[j].text >= q })
+	for _, k := range ix.keys[at:] {
+		if !strings.HasPrefix(k.text, q) {
+			break
+		}
+		if keep == nil || keep(k.id) {
+			first[k.id] = first[k.id] || k.first
+		}
+	}
+
+	cs := make([]Completion, 0, len(first))
+	for id := range first {
+		cs = append(cs, Completion{ID: id, Name: ix.names[id]})
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		a, b := cs[i], cs[j]
+		if first[a.ID] != first[b.ID] {
+			return first[a.ID]
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		if la, lb := strings.ToLower(a.Name), strings.ToLower(b.Name); la != lb {
+			return la < lb
+		}
+		return a.ID < b.ID
+	})
+	if len(cs) > limit {
+		cs = cs[:limit]
+	}
+	return cs
+}
+
+func (k key) less(o key) bool {
+	if k.text != o.text {
+		return k.text < o.text
+	}
+	return k.id < o.id
+}
+
+// words splits s into lower case runs of letters and digits,
+// apostrophes are dropped so "Tasha's" is "tashas"
+func words(s string) []string {
+	s = strings.Map(func(r rune) rune {
+		if r == '\'' || r == '’' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, s)
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,83 @@
+package prefix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func names(cs []Completion) []string {
+	ns := []string{}
+	for _, c := range cs {
+		ns = append(ns, c.Name)
+	}
+	return ns
+}
+
+func TestIndex_Search(t *testing.T) {
+	ix := NewIndex()
+	for id, name := range map[int]string{
+		1: "Fire Bolt",
+		2: "Fireball",
+		3: "Delayed Blast Fireball",
+		4: "Wall of Fire",
+		5: "Tasha's Hideous Laughter",
+		6: "Antipathy/Sympathy",
+		7: "Fire Shield",
+	} {
+		ix.Add(id, name)
+	}
+
+	tests := []struct {
+		typed string
+		limit int
+		want  []string
+	}{
+		{"fire", 10, []string{"Fireball", "Fire Bolt", "Fire Shield", "Wall of Fire", "Delayed Blast Fireball"}},
+		{"FIRE", 2, []string{"Fireball", "Fire Bolt"}},
+		{"fire b", 10, []string{"Fire Bolt"}},
+		{"  fire   bo", 10, []string{"Fire Bolt"}},
+		{"tashas hid", 10, []string{"Tasha's Hideous Laughter"}},
+		{"tasha's", 10, []string{"Tasha's Hideous Laughter"}},
+		{"symp", 10, []string{"Antipathy/Sympathy"}},
+		{"of", 10, []string{"Wall of Fire"}},
+		{"ice", 10, []string{}},
+		{"", 10, []string{}},
+		{"fire", 0, []string{}},
+	}
+	for _, tt := range tests {
+		if got := names(ix.Search(tt.typed, tt.limit, nil)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q, %d) = %q, want %q", tt.typed, tt.limit, got, tt.want)
+		}
+	}
+
+	odd := func(id int) bool { return id%2 == 1 }
+	if got, want := names(ix.Search("fire", 10, odd)), []string{"Fire Bolt", "Fire Shield", "Delayed Blast Fireball"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() keeping odd ids = %q, want %q", got, want)
+	}
+}
+
+func TestIndex_AddRemove(t *testing.T) {
+	ix := NewIndex()
+	ix.Add(1, "Fire Bolt")
+	ix.Add(2, "Fireball")
+	ix.Add(1, "Ice Knife")
+	if ix.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", ix.Len())
+	}
+	if got := names(ix.Search("fire", 10, nil)); !reflect.DeepEqual(got, []string{"Fireball"}) {
+		t.Errorf("Search() after replacing = %q", got)
+	}
+	if got := ix.Search("knife", 10, nil); len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("Search(knife) = %v", got)
+	}
+
+	ix.Remove(2)
+	ix.Remove(3)
+	if ix.Len() != 1 || len(ix.Search("fire", 10, nil)) != 0 {
+		t.Errorf("Remove() left %d names, %v", ix.Len(), ix.Search("fire", 10, nil))
+	}
+	ix.Add(3, " -- ")
+	if ix.Len() != 1 {
+		t.Errorf("Add() of a name without words indexed it")
+	}
+}
@@ -2,9 +2,7 @@ package routes
 
 import (
 	"context"
-	"log"
 	"net/http"
-	"os"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -27,17 +25,17 @@ func (env *Env) loginIndex(w http.ResponseWriter, r *http.Request) {
 	}
 
 	errs := r.Context().Value("Errors")
-	log.Println(errs)
+	env.log.Println(errs)
 	// We keep claims here because base template requires at least a nil claims
 	data := map[string]interface{}{
 		"Claims": nil,
 		"Errors": errs,
 	}
 
-	if t, ok := tmpls["login.html"]; ok {
+	if t, ok := env.tmpls["login.html"]; ok {
 		t.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 	}
 }
 
@@ -63,7 +61,7 @@ func (env *Env) loginProcess(w http.ResponseWriter, r *http.Request) {
 		return
 
 	}
-	assignToken(w, user.ID, user.Username)
+	env.assignToken(w, user.ID, user.Username)
 
 	http.Redirect(w, r, "/", http.StatusFound)
 	return
@@ -71,7 +69,7 @@ func (env *Env) loginProcess(w http.ResponseWriter, r *http.Request) {
 
 // Logs a user out by "deleting" their token
 func (env *Env) logoutProcess(w http.ResponseWriter, r *http.Request) {
-	deleteCookie := http.Cookie{Name: "Auth", Value: "none", Expires: time.Now()}
+	deleteCookie := http.Cookie{Name: "Auth", Value: "none", Expires: env.now()}
 	http.SetCookie(w, &deleteCookie)
 	http.Redirect(w, r, "/", http.StatusFound)
 }
@@ -100,18 +98,18 @@ func (env *Env) registerProcess(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if user, ok := env.db.CreateUser(u, p); ok {
-		log.Println("User created")
-		assignToken(w, user.ID, user.Username)
+		env.log.Println("User created")
+		env.assignToken(w, user.ID, user.Username)
 		http.Redirect(w, r, "/", http.StatusFound)
 		return
 	}
 
-	errorHandler(w, r, http.StatusInternalServerError)
+	env.errorHandler(w, r, http.StatusInternalServerError)
 }
 
-func assignToken(w http.ResponseWriter, id int, uname string) {
-	expireToken := time.Now().Add(time.Hour * 1).Unix()
-	expireCookie := time.Now().Add(time.Hour * 1)
+func (env *Env) assignToken(w http.ResponseWriter, id int, uname string) {
+	expireToken := env.now().Add(time.Hour * 1).Unix()
+	expireCookie := env.now().Add(time.Hour * 1)
 
 	claims := Claims{
 		id,
@@ -124,7 +122,7 @@ func assignToken(w http.ResponseWriter, id int, uname string) {
 
 	// Generate signed token with our claims
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(os.Getenv("TOKEN_SIGNING_KEY")))
+	signedToken, err := token.SignedString(env.signingKey)
 	if err != nil {
 		http.Error(w, "Error creating signed token", http.StatusInternalServerError)
 	}
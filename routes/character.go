@@ -1,7 +1,6 @@
 package routes
 
 import (
-	"log"
 	"net/http"
 	"strconv"
 
@@ -16,7 +15,7 @@ func (env *Env) characterIndex(w http.ResponseWriter, r *http.Request) {
 
 	chars, err := env.db.GetAllCharacters(claims.UID)
 	if err != nil && err != model.ErrNoResult {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 	}
 
 	data := map[string]interface{}{
@@ -27,7 +26,7 @@ func (env *Env) characterIndex(w http.ResponseWriter, r *http.Request) {
 	if tmpl, ok := env.tmpls["characters.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 
@@ -39,25 +38,94 @@ func (env *Env) characterDetails(w http.ResponseWriter, r *http.Request) {
 	claims := c.(Claims)
 	name := mux.Vars(r)["charName"]
 
-	char := &model.Character{}
-	c, err := env.db.GetCharacterByName(claims.UID, name)
+	char, err := env.db.GetCharacterByName(claims.UID, name)
 	if err != nil {
-		log.Printf("Error getting Character with name: %s\n", name)
-		log.Printf(err.Error())
-		errorHandler(w, r, http.StatusNotFound)
+		env.log.Printf("Error getting Character with name: %s\n", name)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusNotFound)
+		return
+	}
+
+	level, err := env.db.GetCharacterLevel(char.ID)
+	if err != nil {
+		env.log.Printf("Error getting level of character with id %d\n", char.ID)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 
 	data := map[string]interface{}{
 		"Claims":    claims,
 		"Character": char,
+		"Level":     level,
 	}
 
 	if tmpl, ok := env.tmpls["character-details.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for class-details\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for class-details\n")
+		return
+	}
+}
+
+// A spell as one of the user's characters casts it, cantrips show
+// their damage at the character's level
+func (env *Env) characterSpellDetails(w http.ResponseWriter, r *http.Request) {
+	claims := r.Context().Value("Claims").(Claims)
+	charName := mux.Vars(r)["charName"]
+	spellName := mux.Vars(r)["spellName"]
+
+	char, err := env.db.GetCharacterByName(claims.UID, charName)
+	if err != nil {
+		env.log.Printf("Error getting Character with name: %s\n", charName)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusNotFound)
+		return
+	}
+
+	// Characters can cast cannon spells and their player's own
+	spell, err := env.db.GetCannonSpellByName(spellName)
+	if err == model.ErrNoResult {
+		spell, err = env.db.GetUserSpellByName(claims.UID, spellName)
+	}
+	if err != nil {
+		env.log.Printf("Error getting spell by name: %s\n", spellName)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusNotFound)
+		return
+	}
+
+	level, err := env.db.GetCharacterLevel(char.ID)
+	if err != nil {
+		env.log.Printf("Error getting level of character with id %d\n", char.ID)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+
+	data, err := env.spellDetailsData(spell)
+	if err != nil {
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+	var now []string
+	for _, c := range data["Cantrip"].([]model.CantripScaling) {
+		if e := c.At(level); e != "" {
+			now = append(now, e)
+		}
+	}
+	data["Claims"] = claims
+	data["Character"] = char
+	data["CharacterLevel"] = level
+	data["CantripNow"] = now
+
+	if tmpl, ok := env.tmpls["spell-details.html"]; ok {
+		tmpl.ExecuteTemplate(w, "base", data)
+	} else {
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for spell-details\n")
 		return
 	}
 }
@@ -71,10 +139,10 @@ func (env *Env) newCharacterIndex(w http.ResponseWriter, r *http.Request) {
 
 	if tmpl, ok := env.tmpls["character-creator.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
-		log.Println("EXECUTED")
+		env.log.Println("EXECUTED")
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for character-creator\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for character-creator\n")
 		return
 	}
 }
@@ -83,14 +151,38 @@ func (env *Env) newCharacterProcess(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims").(Claims)
 
 	name := r.PostFormValue("name")
-	//class := r.PostFormValue("class")
-	//level := r.PostFormValue("level")
+	class := r.PostFormValue("class")
+	levelStr := r.PostFormValue("level")
+	level, _ := strconv.Atoi(levelStr)
 	race := r.PostFormValue("race")
 	a, _ := strconv.Atoi(r.PostFormValue("abilityMod"))
 	p, _ := strconv.Atoi(r.PostFormValue("profBonus"))
 	ability := util.ToNullInt64(a)
 	proficiency := util.ToNullInt64(p)
 
+	// class and level are optional, but they go together, and
+	// whatever we're given is checked before anything is saved
+	if (class == "") != (levelStr == "") {
+		env.log.Printf("Character class %q without level %q, or the other way round\n", class, levelStr)
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+	var levels []model.CharacterLevel
+	if class != "" {
+		c, err := env.db.GetClassByName(class)
+		if err != nil {
+			env.log.Printf("Error getting class by name: %s\n", class)
+			env.errorHandler(w, r, http.StatusBadRequest)
+			return
+		}
+		if level < 1 || level > model.MaxCharacterLevel {
+			env.log.Printf("Bad character level: %s\n", levelStr)
+			env.errorHandler(w, r, http.StatusBadRequest)
+			return
+		}
+		levels = append(levels, model.CharacterLevel{ClassID: c.ID, Level: level})
+	}
+
 	char := &model.Character{
 		Name:                 name,
 		Race:                 race,
@@ -99,14 +191,11 @@ func (env *Env) newCharacterProcess(w http.ResponseWriter, r *http.Request) {
 		UserID:               claims.UID,
 	}
 
-	if _, err := env.db.CreateCharacter(claims.UID, char); err != nil {
-		log.Printf("CreateCharacter: %s\n", err.Error())
-		errorHandler(w, r, http.StatusInternalServerError)
+	if _, err := env.db.CreateCharacter(claims.UID, char, levels...); err != nil {
+		env.log.Printf("CreateCharacter: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
-	//	if _, err := env.db.SetCharacterLevel(charID, className, level int); err != nil {
-	//		errorHandler(w,r,http.StatusInternalServerError)
-	//	}
 	r.Method = "GET"
 	http.Redirect(w, r, "/user/character", http.StatusFound)
 }
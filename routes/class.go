@@ -1,20 +1,20 @@
 package routes
 
 import (
-	"log"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/murder-hobos/murder-hobos/model"
 )
 
-// lists all classes
+// lists all classes, subclasses under their base class
 func (env *Env) classIndex(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims")
 
-	cs, err := env.db.GetAllClasses()
+	cs, err := env.db.GetClassTree()
 	if err != nil {
-		log.Println("Classes handler: " + err.Error())
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println("Classes handler: " + err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 
@@ -26,29 +26,37 @@ func (env *Env) classIndex(w http.ResponseWriter, r *http.Request) {
 	if tmpl, ok := env.tmpls["classes.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for classes\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for classes\n")
 		return
 	}
 }
 
-// Shows a list of all spells available to a class
+// Shows a list of all spells available to a class, along with where
+// it sits in its class tree
 func (env *Env) classDetails(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims")
 	name := mux.Vars(r)["className"]
 
 	class, err := env.db.GetClassByName(name)
 	if err != nil {
-		log.Printf("Error getting Class by name: %s\n", name)
-		log.Printf(err.Error())
-		errorHandler(w, r, http.StatusNotFound)
+		env.log.Printf("Error getting Class by name: %s\n", name)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusNotFound)
 		return
 	}
 
 	spells, err := env.db.GetClassSpells(class.ID)
 	if err != nil {
-		log.Println("Class-detail handler" + err.Error())
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println("Class-detail handler" + err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+
+	tree, err := env.db.GetClassTree()
+	if err != nil {
+		env.log.Println("Class-detail handler" + err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 
@@ -57,12 +65,26 @@ func (env *Env) classDetails(w http.ResponseWriter, r *http.Request) {
 		"Class":  class,
 		"Spells": spells,
 	}
+	if node, ok := classNode(*tree, class); ok {
+		data["Tree"] = node
+	}
 
 	if tmpl, ok := env.tmpls["class-details.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for class-details\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for class-details\n")
 		return
 	}
 }
+
+// classNode finds the base class c belongs to in tree, c itself if
+// it's a base class
+func classNode(tree []model.ClassNode, c *model.Class) (model.ClassNode, bool) {
+	for _, n := range tree {
+		if n.ID == c.ID || (c.BaseClass.Valid && int64(n.ID) == c.BaseClass.Int64) {
+			return n, true
+		}
+	}
+	return model.ClassNode{}, false
+}
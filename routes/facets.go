@@ -0,0 +1,112 @@
+package routes
+
+import (
+	"net/url"
+
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spellquery"
+)
+
+// facet is a field of model.SpellFacets as our listing templates show
+// it
+type facet struct {
+	Name   string
+	Values []facetValue
+}
+
+// facetValue is a value of a facet, how many results have it and a
+// link to only those results
+type facetValue struct {
+	Label string
+	Count int
+	URL   string
+}
+
+// refiner returns a link narrowing the current results down to those
+// with value for a spellquery field
+type refiner func(field, value string) string
+
+// filterRefiner refines a listing filtered by query parameters, at u,
+// by setting the field's parameter
+func filterRefiner(u *url.URL) refiner {
+	return func(field, value string) string {
+		return pageURL(u, func(q url.Values) {
+			q.Del("page")
+			q.Set(field, value)
+		})
+	}
+}
+
+// searchRefiner refines the results of the search box's query, at u,
+// by adding the field to the query
+func searchRefiner(u *url.URL, query spellquery.Query) refiner {
+	return func(field, value string) string {
+		return pageURL(u, func(q url.Values) {
+			q.Del("page")
+			q.Set("name", query.With(field, value).String())
+		})
+	}
+}
+
+// spellFacets is a datastore's facets, with a search or filter no
+// spell can match having no facets rather than an error
+func spellFacets(fs *model.SpellFacets, err error) (*model.SpellFacets, error) {
+	if err == model.ErrNoResult {
+		return &model.SpellFacets{}, nil
+	}
+	return fs, err
+}
+
+// newFacets lays out fs for our templates, each value linking to
+// refine
+func newFacets(fs *model.SpellFacets, refine refiner) []facet {
+	label := func(fc model.FacetCount) string {
+		if fc.Label != "" {
+			return fc.Label
+		}
+		return fc.Value
+	}
+	levelLabel := func(fc model.FacetCount) string {
+		if fc.Value == "0" {
+			return "Cantrip"
+		}
+		return "Level " + fc.Value
+	}
+	yesNoLabel := func(yes, no string) func(model.FacetCount) string {
+		return func(fc model.FacetCount) string {
+			if fc.Value == "yes" {
+				return yes
+			}
+			return no
+		}
+	}
+
+	var facets []facet
+	for _, f := range []struct {
+		name   string
+		field  string
+		counts []model.FacetCount
+		label  func(model.FacetCount) string
+	}{
+		{"School", spellquery.FieldSchool, fs.Schools, label},
+		{"Level", spellquery.FieldLevel, fs.Levels, levelLabel},
+		{"Class", spellquery.FieldClass, fs.Classes, label},
+		{"Source", spellquery.FieldSource, fs.Sources, label},
+		{"Ritual", spellquery.FieldRitual, fs.Ritual, yesNoLabel("Ritual", "Not a ritual")},
+		{"Concentration", spellquery.FieldConcentration, fs.Concentration, yesNoLabel("Concentration", "No concentration")},
+	} {
+		if len(f.counts) == 0 {
+			continue
+		}
+		values := make([]facetValue, len(f.counts))
+		for i, fc := range f.counts {
+			values[i] = facetValue{
+				Label: f.label(fc),
+				Count: fc.Count,
+				URL:   refine(f.field, fc.Value),
+			}
+		}
+		facets = append(facets, facet{Name: f.name, Values: values})
+	}
+	return facets
+}
@@ -0,0 +1,136 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spellquery"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// spellFilterParams are the query parameters parseSpellFilter reads,
+// besides name which on its own is a search
+var spellFilterParams = []string{
+	"level", "school", "class", "source",
+	"verbal", "somatic", "material", "ritual", "concentration",
+	"damage", "save", "attack",
+	"cast", "range", "area", "duration",
+}
+
+// errBadFilter is returned by parseSpellFilter for values it can't
+// make sense of
+var errBadFilter = errors.New("routes: bad spell filter")
+
+// hasSpellFilter matches requests with any of spellFilterParams, in
+// any combination
+func hasSpellFilter(r *http.Request, rm *mux.RouteMatch) bool {
+	q := r.URL.Query()
+	for _, p := range spellFilterParams {
+		if _, ok := q[p]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSpellFilter reads a model.SpellFilter from query parameters.
+// Parameters can be repeated or comma separated where the filter takes
+// several values, and levels are written like in spellquery, ex.
+//
+//	?level=1-3&school=Evocation,Necromancy&class=Wizard&ritual=false
+//	?cast=bonus+action&range=<60
+//
+// Empty values are ignored, so an unselected form field doesn't filter.
+func parseSpellFilter(q url.Values) (model.SpellFilter, error) {
+	f := model.SpellFilter{
+		Schools:    values(q, "school"),
+		Class:      strings.TrimSpace(q.Get("class")),
+		Name:       strings.TrimSpace(q.Get("name")),
+		DamageType: strings.TrimSpace(q.Get("damage")),
+		Save:       strings.TrimSpace(q.Get("save")),
+		Attack:     strings.TrimSpace(q.Get("attack")),
+	}
+
+	for _, v := range values(q, "level") {
+		levels, err := spellquery.ParseLevels(v)
+		if err != nil {
+			return f, errBadFilter
+		}
+		f.Levels = append(f.Levels, levels...)
+	}
+
+	// a source is either its id or its abbreviation, ex. "PHB"
+	for _, v := range values(q, "source") {
+		if id, err := strconv.Atoi(v); err == nil {
+			f.SourceIDs = append(f.SourceIDs, id)
+		} else {
+			f.Sources = append(f.Sources, v)
+		}
+	}
+
+	for _, c := range []struct {
+		param  string
+		dst    *[]string
+		lookup func(string) (string, bool)
+	}{
+		{"cast", &f.CastUnits, spelltext.CastUnit},
+		{"area", &f.AreaShapes, spelltext.AreaShape},
+		{"duration", &f.DurationKinds, spelltext.DurationKind},
+	} {
+		for _, v := range values(q, c.param) {
+			normalized, ok := c.lookup(v)
+			if !ok {
+				return f, errBadFilter
+			}
+			*c.dst = append(*c.dst, normalized)
+		}
+	}
+	if v := strings.TrimSpace(q.Get("range")); v != "" {
+		feet, err := spellquery.ParseMaxFeet(v)
+		if err != nil {
+			return f, errBadFilter
+		}
+		f.MaxRangeFeet = feet
+	}
+
+	for _, b := range []struct {
+		param string
+		dst   **bool
+	}{
+		{"verbal", &f.Verbal},
+		{"somatic", &f.Somatic},
+		{"material", &f.Material},
+		{"ritual", &f.Ritual},
+		{"concentration", &f.Concentration},
+	} {
+		v := strings.TrimSpace(q.Get(b.param))
+		if v == "" {
+			continue
+		}
+		parsed, ok := spellquery.ParseYesNo(v)
+		if !ok {
+			return f, errBadFilter
+		}
+		*b.dst = &parsed
+	}
+	return f, nil
+}
+
+// values returns every non-empty value of param, splitting comma
+// separated lists
+func values(q url.Values, param string) []string {
+	var vs []string
+	for _, v := range q[param] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				vs = append(vs, s)
+			}
+		}
+	}
+	return vs
+}
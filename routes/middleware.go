@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 
 	jwt "github.com/dgrijalva/jwt-go"
 )
@@ -19,15 +18,22 @@ func (env *Env) withClaims(fn http.Handler) http.Handler {
 			return
 		}
 
-		token, err := jwt.ParseWithClaims(cookie.Value, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		// Expiry is checked against our own clock below instead of jwt's
+		p := &jwt.Parser{SkipClaimsValidation: true}
+		token, err := p.ParseWithClaims(cookie.Value, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 			// Make sure token's signature wasn't changed
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, fmt.Errorf("Unexpected siging method")
 			}
-			return []byte(os.Getenv("TOKEN_SIGNING_KEY")), nil
+			return env.signingKey, nil
 		})
+		if err != nil {
+			fn.ServeHTTP(w, r)
+			return
+		}
 
-		if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+		claims, ok := token.Claims.(*Claims)
+		if ok && token.Valid && claims.VerifyExpiresAt(env.now().Unix(), true) {
 			ctx := context.WithValue(r.Context(), "Claims", *claims)
 			fn.ServeHTTP(w, r.WithContext(ctx))
 		} else {
@@ -0,0 +1,168 @@
+package routes
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+// errBadPage is returned by parsePage for values it can't make sense
+// of
+var errBadPage = errors.New("routes: bad page")
+
+// sortLabels are how we show model.SpellSorts
+var sortLabels = map[model.SpellSort]string{
+	model.SortName:     "Name",
+	model.SortLevel:    "Level",
+	model.SortSchool:   "School",
+	model.SortCastTime: "Cast time",
+}
+
+// parsePage reads which page of a spell listing to show and how to
+// sort it from query parameters, normalized, ex.
+//
+//	?page=2&size=25&sort=level&order=desc
+//
+// Missing parameters are the first page of DefaultPageSize spells by
+// name.
+func parsePage(q url.Values) (model.Page, error) {
+	p := model.Page{Sort: model.SpellSort(q.Get("sort"))}
+	var err error
+	if v := strings.TrimSpace(q.Get("page")); v != "" {
+		if p.Number, err = strconv.Atoi(v); err != nil || p.Number < 1 {
+			return p, errBadPage
+		}
+	}
+	if v := strings.TrimSpace(q.Get("size")); v != "" {
+		if p.Size, err = strconv.Atoi(v); err != nil || p.Size < 1 {
+			return p, errBadPage
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(q.Get("order"))) {
+	case "", "asc":
+	case "desc":
+		p.Desc = true
+	default:
+		return p, errBadPage
+	}
+	if p, err = p.Normalize(); err != nil {
+		return p, errBadPage
+	}
+	return p, nil
+}
+
+// spellPage returns page p of list's spells matching f. A filter no
+// spell can match is an empty page rather than an error.
+func spellPage(list func(f model.SpellFilter, p model.Page) (*model.SpellPage, error),
+	f model.SpellFilter, p model.Page) (*model.SpellPage, error) {
+	page, err := list(f, p)
+	if err == model.ErrNoResult {
+		return &model.SpellPage{Spells: []model.Spell{}, Page: p}, nil
+	}
+	return page, err
+}
+
+// pageMatches returns page p of full-text search matches, which are
+// always best match first so p's sort doesn't apply
+func pageMatches(matches []model.SpellMatch, p model.Page) []model.SpellMatch {
+	start := p.Offset()
+	if start >= len(matches) {
+		return []model.SpellMatch{}
+	}
+	end := start + p.Size
+	if end > len(matches) {
+		end = len(matches)
+	}
+	return matches[start:end]
+}
+
+// pager is what our listing templates show about a page: where it is
+// in the listing, links to the pages around it and to sort the
+// listing differently
+type pager struct {
+	Number int
+	Pages  int
+	Total  int
+	// First and Last are the positions of the page's first and last
+	// spells in the listing, from 1
+	First int
+	Last  int
+	// Prev and Next link to the pages around this one, "" if there
+	// isn't one
+	Prev string
+	Next string
+	// Sorts are links to sort the listing, nil when it can't be
+	Sorts []sortLink
+}
+
+// sortLink is a link to sort a listing by Label, or if Active, to
+// reverse how it's sorted
+type sortLink struct {
+	Label  string
+	URL    string
+	Active bool
+	Desc   bool
+}
+
+// newPager describes page p of a listing of total spells, shown of
+// which are on this page, for the listing at u. Sort links are only
+// made if sortable.
+func newPager(u *url.URL, p model.Page, total, shown int, sortable bool) pager {
+	pg := pager{Number: p.Number, Total: total, Pages: 1}
+	if total > 0 {
+		pg.Pages = (total + p.Size - 1) / p.Size
+	}
+	if shown > 0 {
+		pg.First = p.Offset() + 1
+		pg.Last = p.Offset() + shown
+	}
+	if p.Number > 1 {
+		pg.Prev = pageURL(u, func(q url.Values) { setPage(q, p.Number-1) })
+	}
+	if p.Number < pg.Pages {
+		pg.Next = pageURL(u, func(q url.Values) { setPage(q, p.Number+1) })
+	}
+	if !sortable {
+		return pg
+	}
+
+	for _, s := range model.SpellSorts {
+		active := s == p.Sort
+		desc := active && !p.Desc
+		pg.Sorts = append(pg.Sorts, sortLink{
+			Label:  sortLabels[s],
+			Active: active,
+			Desc:   active && p.Desc,
+			URL: pageURL(u, func(q url.Values) {
+				// a new sort starts over from the first page
+				q.Del("page")
+				q.Set("sort", string(s))
+				if desc {
+					q.Set("order", "desc")
+				} else {
+					q.Del("order")
+				}
+			}),
+		})
+	}
+	return pg
+}
+
+// pageURL returns u's path and query, changed by change
+func pageURL(u *url.URL, change func(q url.Values)) string {
+	q := u.Query()
+	change(q)
+	link := url.URL{Path: u.Path, RawQuery: q.Encode()}
+	return link.String()
+}
+
+func setPage(q url.Values, n int) {
+	if n == 1 {
+		q.Del("page")
+	} else {
+		q.Set("page", strconv.Itoa(n))
+	}
+}
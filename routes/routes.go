@@ -1,73 +1,96 @@
 package routes
 
 import (
+	"errors"
 	"html/template"
+	"io/ioutil"
 	"log"
 	"net/http"
-	"path/filepath"
+	"net/url"
+	"os"
+	"path"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/justinas/alice"
 	"github.com/murder-hobos/murder-hobos/model"
 )
 
-var (
-	// Package global that holds a map of our templates.
-	tmpls map[string]*template.Template
-)
-
 // Env is a struct that defines an enviornment for server request handling.
 // It allows us to specify different combinations of datastores, templates,
+// loggers and clocks.
 type Env struct {
-	db    model.Datastore
-	tmpls map[string]*template.Template
+	db         model.Datastore
+	tmpls      map[string]*template.Template
+	log        *log.Logger
+	now        func() time.Time
+	signingKey []byte
+	static     http.FileSystem
 }
 
-func init() {
-	// setup template map
-	if tmpls == nil {
-		tmpls = make(map[string]*template.Template)
-	}
+// Options holds the optional dependencies of our handler.
+// Zero values are replaced with sensible defaults, except
+// SigningKey which is required.
+type Options struct {
+	// Logger is where handlers report errors.
+	// Defaults to a logger writing to stderr.
+	Logger *log.Logger
+	// Now is the clock used to issue and expire auth tokens.
+	// Defaults to time.Now.
+	Now func() time.Time
+	// SigningKey is the HMAC key auth tokens are signed with.
+	SigningKey []byte
+	// Static is served under /static. Defaults to http.Dir("static").
+	Static http.FileSystem
+}
 
-	templatesDir := "templates/"
+// ErrNoSigningKey is returned by New when Options.SigningKey is empty
+var ErrNoSigningKey = errors.New("routes: a token signing key is required")
 
-	layouts, err := filepath.Glob(templatesDir + "layouts/*.html")
-	if err != nil {
-		log.Fatal(err)
+// New returns an http.Handler with all of our routes, backed by
+// the given datastore. Templates are read from the templates
+// FileSystem, which must contain our layouts/ and includes/
+// directories. An error is returned if the templates can't be
+// loaded or opts are invalid.
+func New(db model.Datastore, templates http.FileSystem, opts Options) (http.Handler, error) {
+	if len(opts.SigningKey) == 0 {
+		return nil, ErrNoSigningKey
 	}
-
-	includes, err := filepath.Glob(templatesDir + "includes/*.html")
-	if err != nil {
-		log.Fatal(err)
+	if opts.Logger == nil {
+		opts.Logger = log.New(os.Stderr, "", log.LstdFlags)
 	}
-
-	for _, layout := range layouts {
-		files := append(includes, layout)
-		tmpls[filepath.Base(layout)] = template.Must(template.ParseFiles(files...))
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if opts.Static == nil {
+		opts.Static = http.Dir("static")
 	}
-}
 
-// New returns an initialized gorilla *mux.Router with all of our routes
-// Panics if unable to connect to datastore with given dsn
-// (don't want the server to start without database access)
-func New(dsn string) *mux.Router {
-	db, err := model.NewDB(dsn)
+	tmpls, err := loadTemplates(templates)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	env := &Env{
+		db:         db,
+		tmpls:      tmpls,
+		log:        opts.Logger,
+		now:        opts.Now,
+		signingKey: opts.SigningKey,
+		static:     opts.Static,
 	}
-	env := &Env{db, tmpls}
 
 	stdChain := alice.New(env.withClaims)
 	userChain := stdChain.Append(env.authRequired)
 	r := mux.NewRouter()
 
 	// SPELL
+	r.Handle("/spell/suggest", stdChain.ThenFunc(env.spellSuggest)).Methods("GET")
 	r.Handle(`/spell/{spellName:[a-zA-Z '\-\/]+}`, stdChain.ThenFunc(env.spellDetails))
+	r.Handle("/spell", stdChain.ThenFunc(env.spellFilter)).MatcherFunc(hasSpellFilter)
 	r.Handle("/spell", stdChain.ThenFunc(env.spellSearch)).Queries("name", "")
-	r.Handle("/spell", stdChain.ThenFunc(env.spellFilter)).Queries("school", "")
-	r.Handle("/spell", stdChain.ThenFunc(env.spellFilter)).Queries("level", "{level:[0-9]}")
-	r.Handle("/spell", stdChain.ThenFunc(env.spellFilter)).Queries("school", "", "level", "{level:[0-9]}")
 	r.Handle("/spell", stdChain.ThenFunc(env.spellIndex))
 
 	// CLASS
@@ -85,61 +108,130 @@ func New(dsn string) *mux.Router {
 	r.Handle("/user/spell/new", userChain.ThenFunc(env.newSpellIndex)).Methods("GET")
 	r.Handle("/user/spell/new", userChain.ThenFunc(env.newSpellProcess)).Methods("POST")
 	r.Handle(`/user/spell/{spellName:[a-zA-Z0-9 '\-\/]+}`, userChain.ThenFunc(env.userSpellDetails))
+	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellFilter)).MatcherFunc(hasSpellFilter)
 	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellSearch)).Queries("name", "")
-	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellFilter)).Queries("school", "")
-	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellFilter)).Queries("level", "{level:[0-9]}")
-	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellFilter)).Queries("school", "", "level", "{level:[0-9]}")
-	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellIndex))
 	r.Handle("/user/spell", userChain.ThenFunc(env.userSpellIndex))
 	r.Handle("/user/character", userChain.ThenFunc(env.characterIndex))
 	r.Handle("/user/character/new", userChain.ThenFunc(env.newCharacterIndex)).Methods("GET")
 	r.Handle("/user/character/new", userChain.ThenFunc(env.newCharacterProcess)).Methods("POST")
+	r.Handle(`/user/character/{charName}/spell/{spellName:[a-zA-Z0-9 '\-\/]+}`, userChain.ThenFunc(env.characterSpellDetails))
 	r.Handle("/user/character/{charName}", userChain.ThenFunc(env.characterDetails))
 	r.Handle("/user", userChain.ThenFunc(env.userProfileIndex))
 
 	// ROOT
-	r.Handle("/", stdChain.ThenFunc(rootIndex))
+	r.Handle("/", stdChain.ThenFunc(env.rootIndex))
 
-	r.PathPrefix("/static").HandlerFunc(staticHandler)
-	return r
+	r.PathPrefix("/static").HandlerFunc(env.staticHandler)
+	return r, nil
+}
+
+// loadTemplates parses each page in layouts/ together with
+// everything in includes/, keyed by the page's file name.
+func loadTemplates(fs http.FileSystem) (map[string]*template.Template, error) {
+	includes, err := readDir(fs, "includes")
+	if err != nil {
+		return nil, err
+	}
+	layouts, err := readDir(fs, "layouts")
+	if err != nil {
+		return nil, err
+	}
+
+	tmpls := make(map[string]*template.Template)
+	for _, layout := range layouts {
+		t := template.New(layout)
+		for _, name := range includes {
+			text, err := readFile(fs, path.Join("includes", name))
+			if err != nil {
+				return nil, err
+			}
+			if _, err := t.New(name).Parse(text); err != nil {
+				return nil, err
+			}
+		}
+		text, err := readFile(fs, path.Join("layouts", layout))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := t.Parse(text); err != nil {
+			return nil, err
+		}
+		tmpls[layout] = t
+	}
+	return tmpls, nil
+}
+
+// readDir returns the sorted names of the .html files in dir
+func readDir(fs http.FileSystem, dir string) ([]string, error) {
+	f, err := fs.Open("/" + dir)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	infos, err := f.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, info := range infos {
+		if !info.IsDir() && strings.HasSuffix(info.Name(), ".html") {
+			names = append(names, info.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func readFile(fs http.FileSystem, name string) (string, error) {
+	f, err := fs.Open("/" + name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
 }
 
 // Index doesn't really do much for now
-func rootIndex(w http.ResponseWriter, r *http.Request) {
+func (env *Env) rootIndex(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims")
 
 	data := map[string]interface{}{
 		"Claims": claims,
 	}
 
-	if tmpl, ok := tmpls["index.html"]; ok {
+	if tmpl, ok := env.tmpls["index.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 	}
 }
 
 // serve static (js/css) files
-func staticHandler(w http.ResponseWriter, r *http.Request) {
+func (env *Env) staticHandler(w http.ResponseWriter, r *http.Request) {
 	// Don't want to list directories
 	if strings.HasSuffix(r.URL.Path, "/") {
 		http.Error(w, "File not found", http.StatusBadRequest)
+		return
 	}
-	http.ServeFile(w, r, r.URL.Path[1:])
+	http.StripPrefix("/static", http.FileServer(env.static)).ServeHTTP(w, r)
 }
 
 // Custom stuff for errors
-func errorHandler(w http.ResponseWriter, r *http.Request, status int) {
-	w.WriteHeader(status)
-	var title, message string
-	var tmpl *template.Template
-
-	if t, ok := tmpls["error.html"]; ok {
-		tmpl = t
-	} else {
+func (env *Env) errorHandler(w http.ResponseWriter, r *http.Request, status int) {
+	tmpl, ok := env.tmpls["error.html"]
+	if !ok {
 		http.Error(w, "Server's busted.", http.StatusInternalServerError)
+		return
 	}
+	w.WriteHeader(status)
 
+	var title, message string
 	if status == http.StatusNotFound {
 		title = "Not Found"
 		message = "Whoops! We can't find that!"
@@ -150,6 +242,52 @@ func errorHandler(w http.ResponseWriter, r *http.Request, status int) {
 		message = "Our server is having issues. >:("
 	}
 
-	vars := map[string]string{"Title": title, "Message": message}
+	if status == http.StatusBadRequest {
+		title = "Bad Request"
+		message = "We don't know what to do with that."
+	}
+
+	vars := map[string]interface{}{"Title": title, "Message": message}
 	tmpl.ExecuteTemplate(w, "base", vars)
 }
+
+// redirectScore is how alike a misspelled spell name and a real one
+// have to be for us to assume which was meant, see fuzzy.Score
+const redirectScore = 0.85
+
+// spellNotFound answers a request for a spell named name that doesn't
+// exist. If only one of the spells search finds is close enough we
+// redirect to it at prefix + its name, otherwise it's a 404 suggesting
+// them all.
+func (env *Env) spellNotFound(w http.ResponseWriter, r *http.Request, name, prefix string,
+	search func(name string) (*[]model.SpellMatch, error)) {
+	matches, err := search(name)
+	if err != nil || len(*matches) == 0 {
+		env.errorHandler(w, r, http.StatusNotFound)
+		return
+	}
+	near := 0
+	for _, m := range *matches {
+		if m.Score >= redirectScore {
+			near++
+		}
+	}
+	if near == 1 && (*matches)[0].Score >= redirectScore {
+		u := url.URL{Path: prefix + (*matches)[0].Name}
+		http.Redirect(w, r, u.String(), http.StatusFound)
+		return
+	}
+
+	tmpl, ok := env.tmpls["error.html"]
+	if !ok {
+		http.Error(w, "Server's busted.", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+	tmpl.ExecuteTemplate(w, "base", map[string]interface{}{
+		"Title":       "Not Found",
+		"Message":     "Whoops! We can't find that!",
+		"Suggestions": *matches,
+		"Prefix":      prefix,
+	})
+}
@@ -0,0 +1,341 @@
+package routes
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/model/memdb"
+)
+
+// newTestServer returns a handler backed by a seeded in-memory
+// datastore, along with a pointer to the clock it uses.
+func newTestServer(t *testing.T) (http.Handler, *memdb.DB, *time.Time) {
+	db, err := memdb.NewSeeded()
+	if err != nil {
+		t.Fatalf("memdb.NewSeeded() error = %v", err)
+	}
+	now := time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC)
+	h, err := New(db, http.Dir("../templates"), Options{
+		Logger:     log.New(ioutil.Discard, "", 0),
+		Now:        func() time.Time { return now },
+		SigningKey: []byte("test key"),
+		Static:     http.Dir("../static"),
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return h, db, &now
+}
+
+func get(h http.Handler, target string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("GET", target, nil)
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestNew_RequiresSigningKey(t *testing.T) {
+	db, _ := memdb.NewSeeded()
+	if _, err := New(db, http.Dir("../templates"), Options{}); err != ErrNoSigningKey {
+		t.Errorf("New() error = %v, want ErrNoSigningKey", err)
+	}
+}
+
+func TestNew_BadTemplates(t *testing.T) {
+	db, _ := memdb.NewSeeded()
+	if _, err := New(db, http.Dir("does-not-exist"), Options{SigningKey: []byte("k")}); err == nil {
+		t.Error("New() with missing templates returned no error")
+	}
+}
+
+func TestSpellPages(t *testing.T) {
+	h, _, _ := newTestServer(t)
+
+	tests := []struct {
+		target string
+		status int
+		want   string
+	}{
+		{"/spell", http.StatusOK, "Acid Splash"},
+		{"/spell", http.StatusOK, "Showing 1&ndash;50 of 408 spells"},
+		{"/spell?size=200", http.StatusOK, "Fireball"},
+		{"/spell?page=9", http.StatusOK, "Showing 401&ndash;408 of 408 spells"},
+		{"/spell?page=9", http.StatusOK, `<a href="/spell?page=8">&larr; Previous</a>`},
+		{"/spell?sort=level&order=desc&size=1", http.StatusOK, "Astral Projection"},
+		{"/spell?sort=level", http.StatusOK, `<a href="/spell?order=desc&amp;sort=level"><strong>Level &uarr;</strong></a>`},
+		{"/spell?sort=color", http.StatusBadRequest, "Bad Request"},
+		{"/spell?page=first", http.StatusBadRequest, "Bad Request"},
+		{"/spell?name=fire", http.StatusOK, "Fire Bolt"},
+		{`/spell?name=""`, http.StatusOK, "Acid Splash"},
+		{"/spell?school=Evocation", http.StatusOK, `<a href="/spell?school=Evocation">Evocation</a> (91)`},
+		{"/spell?school=Evocation&page=2", http.StatusOK, `<a href="/spell?level=0&amp;school=Evocation">Cantrip</a>`},
+		{"/spell?name=fireball", http.StatusOK, `<a href="/spell?name=fireball&#43;class%3A%22Cleric&#43;%28Light%29%22">Cleric (Light)</a> (3)`},
+		{"/spell?class=Cleric+(Light)&size=200", http.StatusOK, "Cure Wounds"},
+		{"/spell?name=class:%22cleric+(light)%22+level:3&size=200", http.StatusOK, "Fireball"},
+		{"/spell?name=class:%22cleric+(light)%22+level:3&size=200", http.StatusOK, "Spirit Guardians"},
+		{"/spell?name=fireball", http.StatusOK, `<a href="/spell?name=fireball&#43;level%3A3">Level 3</a> (1)`},
+		{"/spell?name=level:9+-ritual", http.StatusOK, `<a href="/spell?name=level%3A9&#43;ritual%3Ano">Not a ritual</a> (`},
+		{"/spell?school=Evocation", http.StatusOK, "Showing 1&ndash;50 of 91 spells"},
+		{"/spell?school=Evocation&size=100", http.StatusOK, "Magic Missile"},
+		{"/spell?school=Evocation&page=2", http.StatusOK, `<a href="/spell?school=Evocation">&larr; Previous</a>`},
+		{"/spell?damage=fire&save=dex", http.StatusOK, "Fireball"},
+		{"/spell?cast=bonus+action&range=%3C60", http.StatusOK, "Misty Step"},
+		{"/spell?name=cast:%22bonus+action%22+range:60", http.StatusOK, "Healing Word"},
+		{"/spell?area=cone&duration=instantaneous", http.StatusOK, "Burning Hands"},
+		{"/spell?cast=turn", http.StatusBadRequest, "Bad Request"},
+		{"/spell?attack=ranged", http.StatusOK, "Fire Bolt"},
+		{"/spell?level=2-4&school=Evocation,Necromancy&class=Wizard", http.StatusOK, "Fireball"},
+		{"/spell?name=fire&level=3&ritual=false", http.StatusOK, "Fireball"},
+		{"/spell?level=high", http.StatusBadRequest, "Bad Request"},
+		{"/spell?name=fire+level:3+school:evoc", http.StatusOK, "Fireball"},
+		{"/spell?name=which+spell+lets+me+breathe+underwater", http.StatusOK, "<mark>breathe</mark> <mark>underwater</mark>"},
+		{"/spell?name=breathe+underwater+level:3", http.StatusOK, "Water Breathing"},
+		{"/spell?name=level:3+lvel:2", http.StatusBadRequest, "&#34;lvel&#34; isn&#39;t a field"},
+		{"/spell/Fireball", http.StatusOK, "8d6 fire damage"},
+		{"/spell/Fireball", http.StatusOK, "<code>8d6</code>"},
+		{"/spell/Fireball", http.StatusOK, "damage 10d6 (avg 35)"},
+		{"/spell/Fireball", http.StatusOK, `From <a href="/spell?source=PHB">Player&#39;s Handbook</a> (2014)`},
+		{"/spell?source=EE", http.StatusOK, "Absorb Elements"},
+		{"/spell?source=scag", http.StatusOK, "Green-Flame Blade"},
+		{"/spell?name=source:scag", http.StatusOK, "Booming Blade"},
+		{"/spell?name=fireball", http.StatusOK, `<a href="/spell?name=fireball&#43;source%3APHB">Player&#39;s Handbook</a> (4)`},
+		{"/spell/Not a Spell", http.StatusNotFound, "can&#39;t find that"},
+		{"/spell/Firebal", http.StatusFound, `<a href="/spell/Fireball">`},
+		{"/spell/Fir Blt", http.StatusNotFound, `Did you mean: <a href="/spell/Fire%20Bolt">Fire Bolt</a>, <a href="/spell/Fireball">Fireball</a>?`},
+		{"/class/Wizard", http.StatusOK, "Fireball"},
+		{"/class/Cleric (Light)", http.StatusOK, `<a href="/spell/Fireball">Fireball</a> <span class="label label-info">Cleric (Light)</span>`},
+		{"/class/Cleric (Light)", http.StatusOK, `<a href="/spell/Cure%20Wounds">Cure Wounds</a></li>`},
+		{"/class/Cleric (Light)", http.StatusOK, `A subclass of <a href="/class/Cleric">Cleric</a>`},
+		{"/class/Cleric", http.StatusOK, `<a href="/class/Cleric%20%28Light%29">Cleric (Light)</a>`},
+		{"/class", http.StatusOK, `<a class="Classes" Tag="Subclass" href="/class/Warlock%20%28Fiend%29"> Warlock (Fiend)</a>`},
+		{"/static/css/main.css", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		w := get(h, strings.Replace(tt.target, " ", "%20", -1))
+		if w.Code != tt.status {
+			t.Errorf("GET %s status = %d, want %d", tt.target, w.Code, tt.status)
+		}
+		if !strings.Contains(w.Body.String(), tt.want) {
+			t.Errorf("GET %s body doesn't contain %q", tt.target, tt.want)
+		}
+	}
+}
+
+func TestParseSpellFilter(t *testing.T) {
+	q, _ := url.ParseQuery("level=0&level=2-3&school=Evocation,+Abjuration&class=Wizard&source=1,EE&ritual=no&material=true&name=&save=dex")
+	f, err := parseSpellFilter(q)
+	if err != nil {
+		t.Fatalf("parseSpellFilter() error = %v", err)
+	}
+	if !reflect.DeepEqual(f.Levels, []int{0, 2, 3}) || !reflect.DeepEqual(f.Schools, []string{"Evocation", "Abjuration"}) ||
+		f.Class != "Wizard" || !reflect.DeepEqual(f.SourceIDs, []int{1}) || !reflect.DeepEqual(f.Sources, []string{"EE"}) || f.Save != "dex" || f.Name != "" {
+		t.Errorf("parseSpellFilter() = %+v", f)
+	}
+	if f.Ritual == nil || *f.Ritual || f.Material == nil || !*f.Material || f.Verbal != nil {
+		t.Errorf("parseSpellFilter() flags = %v %v %v", f.Ritual, f.Material, f.Verbal)
+	}
+
+	q, _ = url.ParseQuery("cast=Bonus-Action,reaction&range=<=30&area=cone&duration=minutes")
+	f, err = parseSpellFilter(q)
+	if err != nil || !reflect.DeepEqual(f.CastUnits, []string{"bonus action", "reaction"}) || f.MaxRangeFeet != 30 ||
+		!reflect.DeepEqual(f.AreaShapes, []string{"cone"}) || !reflect.DeepEqual(f.DurationKinds, []string{"minute"}) {
+		t.Errorf("parseSpellFilter(casting) = %+v, %v", f, err)
+	}
+
+	for _, bad := range []string{"level=3-1", "level=0-10", "level=x", "ritual=maybe", "cast=turn", "range=far", "area=blob"} {
+		q, _ := url.ParseQuery(bad)
+		if _, err := parseSpellFilter(q); err != errBadFilter {
+			t.Errorf("parseSpellFilter(%s) error = %v, want errBadFilter", bad, err)
+		}
+	}
+}
+
+func TestSpellSuggest(t *testing.T) {
+	h, db, _ := newTestServer(t)
+	u, _ := db.CreateUser("bob", "hunter2")
+	if _, err := db.CreateSpell(u.ID, model.Spell{Name: "Fire Bolt Deluxe", Level: "0", School: "Evocation"}); err != nil {
+		t.Fatal(err)
+	}
+
+	form := url.Values{"username": {"bob"}, "password": {"hunter2"}}
+	r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	auth := w.Result().Cookies()[0]
+
+	tests := []struct {
+		target  string
+		cookies []*http.Cookie
+		want    []spellSuggestion
+	}{
+		{"/spell/suggest?q=fire+b", nil, []spellSuggestion{{"Fire Bolt", "/spell/Fire%20Bolt", false}}},
+		{"/spell/suggest?q=fire+b", []*http.Cookie{auth}, []spellSuggestion{
+			{"Fire Bolt", "/spell/Fire%20Bolt", false},
+			{"Fire Bolt Deluxe", "/user/spell/Fire%20Bolt%20Deluxe", true},
+		}},
+		{"/spell/suggest?q=missile", nil, []spellSuggestion{{"Magic Missile", "/spell/Magic%20Missile", false}}},
+		{"/spell/suggest", nil, []spellSuggestion{}},
+	}
+	for _, tt := range tests {
+		w := get(h, tt.target, tt.cookies...)
+		if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("GET %s status = %d, Content-Type %q", tt.target, w.Code, w.Header().Get("Content-Type"))
+		}
+		got := []spellSuggestion{}
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Errorf("GET %s body %q isn't json: %v", tt.target, w.Body.String(), err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GET %s = %+v, want %+v", tt.target, got, tt.want)
+		}
+	}
+	if w := get(h, "/spell/suggest?q=fire"); !strings.Contains(w.Body.String(), `"name":"Fireball"`) {
+		t.Errorf("GET /spell/suggest?q=fire = %s", w.Body.String())
+	}
+}
+
+func TestLogin(t *testing.T) {
+	h, db, now := newTestServer(t)
+	db.CreateUser("bob", "hunter2")
+
+	form := url.Values{"username": {"bob"}, "password": {"hunter2"}}
+	r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	cookies := w.Result().Cookies()
+	if w.Code != http.StatusFound || len(cookies) != 1 {
+		t.Fatalf("POST /login status = %d, cookies = %v", w.Code, cookies)
+	}
+	if want := now.Add(time.Hour); !cookies[0].Expires.Equal(want) {
+		t.Errorf("cookie expires %v, want %v", cookies[0].Expires, want)
+	}
+
+	if w := get(h, "/user", cookies[0]); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "bob") {
+		t.Errorf("GET /user with token status = %d", w.Code)
+	}
+
+	// token expires according to our clock, not the real one
+	*now = now.Add(2 * time.Hour)
+	if w := get(h, "/user", cookies[0]); w.Code != http.StatusUnauthorized {
+		t.Errorf("GET /user with expired token status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestCharacterCantrip(t *testing.T) {
+	h, db, _ := newTestServer(t)
+	u, _ := db.CreateUser("bob", "hunter2")
+	id, err := db.CreateCharacter(u.ID, &model.Character{Name: "Grog", Race: "Goliath", UserID: u.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wizard, err := db.GetClassByName("Wizard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetCharacterLevel(id, wizard.ID, 11); err != nil {
+		t.Fatal(err)
+	}
+
+	form := url.Values{"username": {"bob"}, "password": {"hunter2"}}
+	r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	auth := w.Result().Cookies()[0]
+
+	tests := []struct {
+		target string
+		status int
+		want   string
+	}{
+		{"/spell/Fire Bolt", http.StatusOK, "4d10"},
+		{"/user/character/Grog/spell/Fire Bolt", http.StatusOK, "damage 3d10 (avg 16.5)"},
+		{"/user/character/Grog/spell/Fire Bolt", http.StatusOK, "level 11"},
+		{"/user/character/Grog", http.StatusOK, "Goliath"},
+		{"/user/character/Nobody/spell/Fire Bolt", http.StatusNotFound, ""},
+		{"/user/character/Grog/spell/Not a Spell", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		w := get(h, strings.Replace(tt.target, " ", "%20", -1), auth)
+		if w.Code != tt.status {
+			t.Errorf("GET %s status = %d, want %d", tt.target, w.Code, tt.status)
+		}
+		if !strings.Contains(w.Body.String(), tt.want) {
+			t.Errorf("GET %s body doesn't contain %q", tt.target, tt.want)
+		}
+	}
+	// without logging in there's no character to see it as
+	if w := get(h, "/spell/Fire%20Bolt"); strings.Contains(w.Body.String(), "/user/character/Grog") {
+		t.Error("GET /spell/Fire Bolt links to characters when logged out")
+	}
+	if w := get(h, "/spell/Fire%20Bolt", auth); !strings.Contains(w.Body.String(), "/user/character/Grog/spell/Fire%20Bolt") {
+		t.Error("GET /spell/Fire Bolt doesn't link to Grog")
+	}
+}
+
+func TestNewCharacter(t *testing.T) {
+	h, db, _ := newTestServer(t)
+	u, _ := db.CreateUser("bob", "hunter2")
+
+	form := url.Values{"username": {"bob"}, "password": {"hunter2"}}
+	r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	auth := w.Result().Cookies()[0]
+
+	tests := []struct {
+		name, class, level string
+		status             int
+		wantLevel          int
+	}{
+		{"Grog", "Not a Class", "3", http.StatusBadRequest, 0},
+		{"Grog", "Wizard", "0", http.StatusBadRequest, 0},
+		{"Grog", "Wizard", "21", http.StatusBadRequest, 0},
+		{"Grog", "Wizard", "lots", http.StatusBadRequest, 0},
+		{"Grog", "Wizard", "", http.StatusBadRequest, 0},
+		{"Grog", "", "5", http.StatusBadRequest, 0},
+		{"Pike", "", "", http.StatusFound, 0},
+		{"Vex", "Wizard", "5", http.StatusFound, 5},
+	}
+	for _, tt := range tests {
+		form := url.Values{"name": {tt.name}, "race": {"Goliath"}, "class": {tt.class}, "level": {tt.level}}
+		r := httptest.NewRequest("POST", "/user/character/new", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.AddCookie(auth)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tt.status {
+			t.Errorf("POST /user/character/new %v status = %d, want %d", form, w.Code, tt.status)
+		}
+
+		char, err := db.GetCharacterByName(u.ID, tt.name)
+		if tt.status != http.StatusFound {
+			if err != model.ErrNoResult {
+				t.Errorf("POST /user/character/new %v saved %+v anyway", form, char)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("GetCharacterByName(%q) error = %v", tt.name, err)
+		}
+		if level, _ := db.GetCharacterLevel(char.ID); level != tt.wantLevel {
+			t.Errorf("POST /user/character/new %v level = %d, want %d", form, level, tt.wantLevel)
+		}
+	}
+}
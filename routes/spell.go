@@ -1,30 +1,44 @@
 package routes
 
 import (
-	"log"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spellquery"
+	"github.com/murder-hobos/murder-hobos/spelltext"
 )
 
 func (env *Env) spellIndex(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims")
 
-	spells, err := env.db.GetAllCannonSpells()
+	p, err := parsePage(r.URL.Query())
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+
+	page, err := env.db.ListCannonSpells(model.SpellFilter{}, p)
+	if err != nil {
+		env.log.Printf("routes - cannonSpells: Error listing cannon spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
 	}
 
 	data := map[string]interface{}{
-		"Spells": spells,
+		"Spells": page.Spells,
+		"Pager":  newPager(r.URL, page.Page, page.Total, len(page.Spells), true),
 		"Claims": claims,
 	}
 
 	if tmpl, ok := env.tmpls["spells.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 }
@@ -32,56 +46,177 @@ func (env *Env) spellIndex(w http.ResponseWriter, r *http.Request) {
 func (env *Env) spellFilter(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims")
 
-	level := r.FormValue("level")
-	school := r.FormValue("school")
+	f, err := parseSpellFilter(r.URL.Query())
+	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+	p, err := parsePage(r.URL.Query())
+	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
 
-	spells, err := env.db.FilterCannonSpells(level, school)
+	page, err := spellPage(env.db.ListCannonSpells, f, p)
 	if err != nil {
-		if err == model.ErrNoResult {
-			// do nothing, just show no results on page (already in template)
-		} else { // something happened
-			log.Printf("routes - cannonSpells: Error filtering cannon spells: %s\n", err.Error())
-			errorHandler(w, r, http.StatusInternalServerError)
-			return
-		}
+		env.log.Printf("routes - cannonSpells: Error filtering cannon spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+	facets, err := spellFacets(env.db.CannonSpellFacets("", f))
+	if err != nil {
+		env.log.Printf("routes - cannonSpells: Error counting cannon spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
 	}
 
 	data := map[string]interface{}{
-		"Spells": spells,
+		"Spells": page.Spells,
+		"Pager":  newPager(r.URL, page.Page, page.Total, len(page.Spells), true),
+		"Facets": newFacets(facets, filterRefiner(r.URL)),
 		"Claims": claims,
 	}
 
 	if tmpl, ok := env.tmpls["spells.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 	}
 }
 
+// spellSearch runs the search box's query, see spellquery for the
+// language. Plain words are a full-text search, shown best match first
+// with snippets. An empty query is every spell.
 func (env *Env) spellSearch(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims")
-	name := r.FormValue("name")
+	query := r.FormValue("name")
 
-	spells, err := env.db.SearchCannonSpells(name)
+	data := map[string]interface{}{
+		"Claims": claims,
+		"Query":  query,
+	}
+
+	q, f, err := parseSearch(query)
+	if err != nil {
+		env.queryError(w, r, "spells.html", data, err)
+		return
+	}
+	text := q.Text()
+	p, err := parsePage(r.URL.Query())
 	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+
+	if text != "" {
+		matches, err := env.db.FullTextSearchCannonSpells(text, f)
 		if err == model.ErrNoResult {
-			// do nothing, just show no results on page (already in template)
-		} else { // something happened
-			log.Printf("routes - cannonSpells: Error filtering cannon spells: %s\n", err.Error())
-			errorHandler(w, r, http.StatusInternalServerError)
+			matches, err = &[]model.SpellMatch{}, nil
+		}
+		if err != nil {
+			env.log.Printf("routes - cannonSpells: Error searching cannon spells: %s\n", err.Error())
+			env.errorHandler(w, r, http.StatusInternalServerError)
 			return
 		}
+		shown := pageMatches(*matches, p)
+		data["Matches"] = shown
+		data["Pager"] = newPager(r.URL, p, len(*matches), len(shown), false)
+	} else {
+		page, err := spellPage(env.db.ListCannonSpells, f, p)
+		if err != nil {
+			env.log.Printf("routes - cannonSpells: Error searching cannon spells: %s\n", err.Error())
+			env.errorHandler(w, r, http.StatusInternalServerError)
+			return
+		}
+		data["Spells"] = page.Spells
+		data["Pager"] = newPager(r.URL, page.Page, page.Total, len(page.Spells), true)
 	}
-
-	data := map[string]interface{}{
-		"Spells": spells,
-		"Claims": claims,
+	facets, err := spellFacets(env.db.CannonSpellFacets(text, f))
+	if err != nil {
+		env.log.Printf("routes - cannonSpells: Error counting cannon spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
 	}
+	data["Facets"] = newFacets(facets, searchRefiner(r.URL, q))
 
 	if tmpl, ok := env.tmpls["spells.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
+	}
+}
+
+// parseSearch parses the search box's query, and its filter. The
+// query's Text is what to full-text search for.
+func parseSearch(query string) (spellquery.Query, model.SpellFilter, error) {
+	q, err := spellquery.Parse(query)
+	if err != nil {
+		return nil, model.SpellFilter{}, err
+	}
+	f, err := q.Filter()
+	return q, f, err
+}
+
+// queryError shows page with a search query's error, for the person who
+// typed it to fix
+func (env *Env) queryError(w http.ResponseWriter, r *http.Request, page string, data map[string]interface{}, err error) {
+	qe, ok := err.(*spellquery.Error)
+	if !ok {
+		env.log.Printf("routes - queryError: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+	data["QueryError"] = qe.Msg
+
+	tmpl, ok := env.tmpls[page]
+	if !ok {
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusBadRequest)
+	tmpl.ExecuteTemplate(w, "base", data)
+}
+
+// spellSuggestion is a model.SpellSuggestion as /spell/suggest sends
+// it
+type spellSuggestion struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Homebrew bool   `json:"homebrew"`
+}
+
+// Complete the spell name in the q parameter for the search box,
+// logged in users get their own spells too. Responds with a JSON array
+// of spellSuggestions, best first.
+func (env *Env) spellSuggest(w http.ResponseWriter, r *http.Request) {
+	uid := 0
+	if c, ok := r.Context().Value("Claims").(Claims); ok {
+		uid = c.UID
+	}
+
+	found, err := env.db.SuggestSpells(uid, r.URL.Query().Get("q"))
+	if err != nil {
+		env.log.Println(err.Error())
+		http.Error(w, "Server's busted.", http.StatusInternalServerError)
+		return
+	}
+	suggestions := make([]spellSuggestion, 0, len(*found))
+	for _, s := range *found {
+		prefix := "/spell/"
+		if s.UserID != 0 {
+			prefix = "/user/spell/"
+		}
+		u := url.URL{Path: prefix + s.Name}
+		suggestions = append(suggestions, spellSuggestion{
+			Name:     s.Name,
+			URL:      u.String(),
+			Homebrew: s.UserID != 0,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(suggestions); err != nil {
+		env.log.Println(err.Error())
 	}
 }
 
@@ -92,33 +227,89 @@ func (env *Env) spellDetails(w http.ResponseWriter, r *http.Request) {
 
 	spell, err := env.db.GetCannonSpellByName(name)
 	if err != nil {
-		log.Printf("Error getting spell by name: %s\n", name)
-		log.Printf(err.Error())
-		errorHandler(w, r, http.StatusNotFound)
+		env.log.Printf("Error getting spell by name: %s\n", name)
+		env.log.Println(err.Error())
+		env.spellNotFound(w, r, name, "/spell/", env.db.FuzzySearchCannonSpells)
 		return
 	}
 
-	classes, err := env.db.GetSpellClasses(spell.ID)
-	// we shouldn't have an error at this point, we should have a spell
+	data, err := env.spellDetailsData(spell)
 	if err != nil {
-		log.Printf("Error getting spell classes with id %d\n", spell.ID)
-		log.Println(err.Error())
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
-
-	data := map[string]interface{}{
-		"Spell":    spell,
-		"Classes":  classes,
-		"Claims":   claims,
-		"IsCannon": true,
+	data["Claims"] = claims
+	data["IsCannon"] = true
+	// let players see the cantrip as one of their characters
+	if c, ok := claims.(Claims); ok && spell.Level == "0" {
+		if chars, err := env.db.GetAllCharacters(c.UID); err == nil {
+			data["Characters"] = *chars
+		}
 	}
 
 	if tmpl, ok := env.tmpls["spell-details.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for spell-details\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for spell-details\n")
 		return
 	}
 }
+
+// spellDetailsData fetches everything the spell details page shows
+// about a spell, besides who's looking at it
+func (env *Env) spellDetailsData(spell *model.Spell) (map[string]interface{}, error) {
+	classes, err := env.db.GetSpellClasses(spell.ID)
+	// we shouldn't have an error at this point, we should have a spell
+	if err != nil {
+		return nil, fmt.Errorf("Error getting spell classes with id %d: %s", spell.ID, err.Error())
+	}
+
+	rolls, err := env.db.GetSpellRolls(spell.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting spell rolls with id %d: %s", spell.ID, err.Error())
+	}
+
+	upcasts, err := env.db.GetSpellUpcasts(spell.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting spell upcasts with id %d: %s", spell.ID, err.Error())
+	}
+
+	cantrip, err := env.db.GetCantripScaling(spell.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting cantrip scaling with id %d: %s", spell.ID, err.Error())
+	}
+
+	data := map[string]interface{}{
+		"Spell":   spell,
+		"Classes": classes,
+		"Rolls":   *rolls,
+		"Upcasts": upcastTable(spell, *upcasts),
+		"Cantrip": *cantrip,
+	}
+
+	// homebrew isn't from a book
+	if spell.SourceID.Valid {
+		source, err := env.db.GetSourceByID(int(spell.SourceID.Int64))
+		if err != nil {
+			return nil, fmt.Errorf("Error getting source with id %d: %s", spell.SourceID.Int64, err.Error())
+		}
+		data["Source"] = source
+	}
+	return data, nil
+}
+
+// upcastTable lays out what a spell does at each slot level it can be
+// cast with, for the spell details page
+func upcastTable(spell *model.Spell, upcasts []model.SpellUpcast) []spelltext.SlotEffects {
+	level, err := strconv.Atoi(spell.Level)
+	if err != nil {
+		return nil
+	}
+	rules := make([]spelltext.UpcastRule, len(upcasts))
+	for i, u := range upcasts {
+		rules[i] = u.UpcastRule
+	}
+	return spelltext.UpcastTable(level, rules)
+}
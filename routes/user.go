@@ -2,7 +2,6 @@ package routes
 
 import (
 	"html"
-	"log"
 	"net/http"
 	"strconv"
 
@@ -15,78 +14,140 @@ func (env *Env) userSpellIndex(w http.ResponseWriter, r *http.Request) {
 	c := r.Context().Value("Claims")
 	claims := c.(Claims)
 
-	spells, err := env.db.GetAllUserSpells(claims.UID)
-	if err != nil && err != model.ErrNoResult {
-		errorHandler(w, r, http.StatusInternalServerError)
+	p, err := parsePage(r.URL.Query())
+	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+
+	page, err := env.db.ListUserSpells(claims.UID, model.SpellFilter{}, p)
+	if err != nil {
+		env.log.Printf("routes - userSpells: Error listing user spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
 	}
 
 	data := map[string]interface{}{
 		"Claims": claims,
-		"Spells": spells,
+		"Spells": page.Spells,
+		"Pager":  newPager(r.URL, page.Page, page.Total, len(page.Spells), true),
 	}
 
 	if tmpl, ok := env.tmpls["user-spells.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 }
 
+// listUserSpells is ListUserSpells for the user making the request
+func (env *Env) listUserSpells(claims Claims) func(f model.SpellFilter, p model.Page) (*model.SpellPage, error) {
+	return func(f model.SpellFilter, p model.Page) (*model.SpellPage, error) {
+		return env.db.ListUserSpells(claims.UID, f, p)
+	}
+}
+
 func (env *Env) userSpellFilter(w http.ResponseWriter, r *http.Request) {
 	c := r.Context().Value("Claims")
 	claims := c.(Claims)
 
-	level := r.FormValue("level")
-	school := r.FormValue("school")
+	f, err := parseSpellFilter(r.URL.Query())
+	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+	p, err := parsePage(r.URL.Query())
+	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
 
-	spells, err := env.db.FilterUserSpells(claims.UID, level, school)
+	page, err := spellPage(env.listUserSpells(claims), f, p)
 	if err != nil {
-		if err == model.ErrNoResult {
-			// do nothing, just show no results on page (already in template)
-		} else { // something happened
-			log.Printf("routes - userSpells: Error filtering cannon spells: %s\n", err.Error())
-			errorHandler(w, r, http.StatusInternalServerError)
-			return
-		}
+		env.log.Printf("routes - userSpells: Error filtering user spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
+	}
+	facets, err := spellFacets(env.db.UserSpellFacets(claims.UID, "", f))
+	if err != nil {
+		env.log.Printf("routes - userSpells: Error counting user spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
 	}
 
 	data := map[string]interface{}{
-		"Spells": spells,
+		"Spells": page.Spells,
+		"Pager":  newPager(r.URL, page.Page, page.Total, len(page.Spells), true),
+		"Facets": newFacets(facets, filterRefiner(r.URL)),
 		"Claims": claims,
 	}
 
 	if tmpl, ok := env.tmpls["user-spells.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 	}
 }
 
+// userSpellSearch runs the search box's query over a user's spells,
+// see spellSearch
 func (env *Env) userSpellSearch(w http.ResponseWriter, r *http.Request) {
 	claims := r.Context().Value("Claims").(Claims)
-	name := r.FormValue("name")
+	query := r.FormValue("name")
+
+	data := map[string]interface{}{
+		"Claims": claims,
+		"Query":  query,
+	}
 
-	spells, err := env.db.SearchUserSpells(claims.UID, name)
+	q, f, err := parseSearch(query)
+	if err != nil {
+		env.queryError(w, r, "user-spells.html", data, err)
+		return
+	}
+	text := q.Text()
+	p, err := parsePage(r.URL.Query())
 	if err != nil {
+		env.errorHandler(w, r, http.StatusBadRequest)
+		return
+	}
+
+	if text != "" {
+		matches, err := env.db.FullTextSearchUserSpells(claims.UID, text, f)
 		if err == model.ErrNoResult {
-			// do nothing, just show no results on page (already in template)
-		} else { // something happened
-			log.Printf("routes - cannonSpells: Error filtering cannon spells: %s\n", err.Error())
-			errorHandler(w, r, http.StatusInternalServerError)
+			matches, err = &[]model.SpellMatch{}, nil
+		}
+		if err != nil {
+			env.log.Printf("routes - userSpells: Error searching user spells: %s\n", err.Error())
+			env.errorHandler(w, r, http.StatusInternalServerError)
 			return
 		}
+		shown := pageMatches(*matches, p)
+		data["Matches"] = shown
+		data["Pager"] = newPager(r.URL, p, len(*matches), len(shown), false)
+	} else {
+		page, err := spellPage(env.listUserSpells(claims), f, p)
+		if err != nil {
+			env.log.Printf("routes - userSpells: Error searching user spells: %s\n", err.Error())
+			env.errorHandler(w, r, http.StatusInternalServerError)
+			return
+		}
+		data["Spells"] = page.Spells
+		data["Pager"] = newPager(r.URL, page.Page, page.Total, len(page.Spells), true)
 	}
-
-	data := map[string]interface{}{
-		"Spells": spells,
-		"Claims": claims,
+	facets, err := spellFacets(env.db.UserSpellFacets(claims.UID, text, f))
+	if err != nil {
+		env.log.Printf("routes - userSpells: Error counting user spells: %s\n", err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		return
 	}
+	data["Facets"] = newFacets(facets, searchRefiner(r.URL, q))
 
 	if tmpl, ok := env.tmpls["user-spells.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.errorHandler(w, r, http.StatusInternalServerError)
 	}
 }
 
@@ -97,33 +158,33 @@ func (env *Env) userSpellDetails(w http.ResponseWriter, r *http.Request) {
 
 	spell, err := env.db.GetUserSpellByName(claims.UID, name)
 	if err != nil {
-		log.Printf("Error getting spell by name: %s\n", name)
-		log.Printf(err.Error())
-		errorHandler(w, r, http.StatusNotFound)
+		env.log.Printf("Error getting spell by name: %s\n", name)
+		env.log.Println(err.Error())
+		env.spellNotFound(w, r, name, "/user/spell/", func(name string) (*[]model.SpellMatch, error) {
+			return env.db.FuzzySearchUserSpells(claims.UID, name)
+		})
 		return
 	}
 
-	classes, err := env.db.GetSpellClasses(spell.ID)
-	// we shouldn't have an error at this point, we should have a spell
+	data, err := env.spellDetailsData(spell)
 	if err != nil {
-		log.Printf("Error getting spell classes with id %d\n", spell.ID)
-		log.Println(err.Error())
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
-
-	data := map[string]interface{}{
-		"Spell":   spell,
-		"Classes": classes,
-		"Claims":  claims,
-		"IsUser":  true,
+	data["Claims"] = claims
+	data["IsUser"] = true
+	if spell.Level == "0" {
+		if chars, err := env.db.GetAllCharacters(claims.UID); err == nil {
+			data["Characters"] = *chars
+		}
 	}
 
 	if tmpl, ok := env.tmpls["spell-details.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for spell-details\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for spell-details\n")
 		return
 	}
 }
@@ -144,7 +205,6 @@ func (env *Env) newSpellProcess(w http.ResponseWriter, r *http.Request) {
 	conc := r.PostFormValue("concentration") != ""
 	ritual := r.PostFormValue("ritual") != ""
 	desc := html.EscapeString(r.PostFormValue("spellDesc"))
-	sourceID := claims.UID
 	spell := &model.Spell{
 		ID:            0,
 		Name:          name,
@@ -160,12 +220,11 @@ func (env *Env) newSpellProcess(w http.ResponseWriter, r *http.Request) {
 		Concentration: conc,
 		Ritual:        ritual,
 		Description:   desc,
-		SourceID:      sourceID,
 	}
 
 	if _, err := env.db.CreateSpell(claims.UID, *spell); err != nil {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println(err.Error())
 		return
 	}
 	r.Method = "GET"
@@ -177,8 +236,8 @@ func (env *Env) newSpellIndex(w http.ResponseWriter, r *http.Request) {
 
 	classes, err := env.db.GetAllClasses()
 	if err != nil {
-		log.Println(err.Error())
-		errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
 		return
 	}
 
@@ -190,8 +249,8 @@ func (env *Env) newSpellIndex(w http.ResponseWriter, r *http.Request) {
 	if tmpl, ok := env.tmpls["spell-creator.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for spell-creator\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for spell-creator\n")
 		return
 	}
 }
@@ -206,8 +265,8 @@ func (env *Env) userProfileIndex(w http.ResponseWriter, r *http.Request) {
 	if tmpl, ok := env.tmpls["profile.html"]; ok {
 		tmpl.ExecuteTemplate(w, "base", data)
 	} else {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Printf("Error loading template for spell-creator\n")
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Printf("Error loading template for spell-creator\n")
 		return
 	}
 
@@ -218,14 +277,14 @@ func (env *Env) userSpellDelete(w http.ResponseWriter, r *http.Request) {
 	sID := r.PostFormValue("spellID")
 	spellID, err := strconv.Atoi(sID)
 	if err != nil {
-		errorHandler(w, r, http.StatusBadRequest)
-		log.Printf("userSpellDelete: Error converting string to int")
+		env.errorHandler(w, r, http.StatusBadRequest)
+		env.log.Printf("userSpellDelete: Error converting string to int")
 		return
 	}
 
 	if err := env.db.DeleteSpell(claims.UID, spellID); err != nil {
-		errorHandler(w, r, http.StatusInternalServerError)
-		log.Println(err.Error())
+		env.errorHandler(w, r, http.StatusInternalServerError)
+		env.log.Println(err.Error())
 		return
 	}
 	r.Method = "GET"
@@ -0,0 +1,496 @@
+// Package spellquery parses the spell search box's query language
+// into a model.SpellFilter and text for full-text search.
+//
+// A query is terms separated by spaces. Plain words are text to search
+// for in the spell's name, description and material, a "field:value"
+// term filters on a field, name:bolt only looks at the name, and
+// "-field" or "+field" is shorthand for "field:no" or "field:yes":
+//
+//	fire level:1-3 school:evocation class:wizard ritual:no -concentration
+//
+// Values with spaces can be quoted, class:"cleric (light)", and
+// fields that take several values take them comma separated,
+// school:evocation,necromancy. Levels can be a number, a range
+// "1-3", a bound like "<=3", or "cantrip". Ranges are in feet and a
+// bound on how far, range:60 and range:<=60 are the same, so all the
+// bonus action spells under 60 feet are
+//
+//	cast:"bonus action" range:<60
+package spellquery
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/model"
+	"github.com/murder-hobos/murder-hobos/spelltext"
+)
+
+// Fields we filter on, aliases are in fieldAliases
+const (
+	// FieldText is plain words, not a field that can be typed
+	FieldText          = "text"
+	FieldName          = "name"
+	FieldLevel         = "level"
+	FieldSchool        = "school"
+	FieldClass         = "class"
+	FieldSource        = "source"
+	FieldVerbal        = "verbal"
+	FieldSomatic       = "somatic"
+	FieldMaterial      = "material"
+	FieldRitual        = "ritual"
+	FieldConcentration = "concentration"
+	FieldDamage        = "damage"
+	FieldSave          = "save"
+	FieldAttack        = "attack"
+	FieldCast          = "cast"
+	FieldRange         = "range"
+	FieldArea          = "area"
+	FieldDuration      = "duration"
+)
+
+// Schools are the eight schools of magic
+var Schools = []string{
+	"Abjuration", "Conjuration", "Divination", "Enchantment",
+	"Evocation", "Illusion", "Necromancy", "Transmutation",
+}
+
+var fieldAliases = map[string]string{
+	"name":          FieldName,
+	"level":         FieldLevel,
+	"lvl":           FieldLevel,
+	"school":        FieldSchool,
+	"class":         FieldClass,
+	"source":        FieldSource,
+	"verbal":        FieldVerbal,
+	"v":             FieldVerbal,
+	"somatic":       FieldSomatic,
+	"s":             FieldSomatic,
+	"material":      FieldMaterial,
+	"m":             FieldMaterial,
+	"ritual":        FieldRitual,
+	"concentration": FieldConcentration,
+	"conc":          FieldConcentration,
+	"damage":        FieldDamage,
+	"dmg":           FieldDamage,
+	"save":          FieldSave,
+	"attack":        FieldAttack,
+	"cast":          FieldCast,
+	"range":         FieldRange,
+	"area":          FieldArea,
+	"duration":      FieldDuration,
+	"dur":           FieldDuration,
+}
+
+// flags are the yes or no fields, the ones -field and +field work on
+var flags = map[string]bool{
+	FieldVerbal:        true,
+	FieldSomatic:       true,
+	FieldMaterial:      true,
+	FieldRitual:        true,
+	FieldConcentration: true,
+}
+
+// Error is a query we can't make sense of. Msg is written for the
+// person who typed the query.
+type Error struct {
+	// Pos is the byte offset of the term the error is about
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("spellquery: %s at %d", e.Msg, e.Pos)
+}
+
+func errorf(pos int, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Term is one part of a Query
+type Term struct {
+	// Field is one of our Field constants, FieldText for plain words
+	Field string
+	Value string
+	// Pos is the byte offset of the term in the query
+	Pos int
+}
+
+// Query is a parsed query, its terms in order
+type Query []Term
+
+// Parse splits s into terms and checks their fields exist. Values
+// aren't checked until Filter.
+func Parse(s string) (Query, error) {
+	q := Query{}
+	i := 0
+	for {
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+		if i == len(s) {
+			return q, nil
+		}
+		pos := i
+		tok, next, err := token(s, i)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+		// "" searches for nothing
+		if tok == "" {
+			continue
+		}
+
+		t, err := parseTerm(tok, pos)
+		if err != nil {
+			return nil, err
+		}
+		q = append(q, t)
+	}
+}
+
+// token reads a term starting at i, up to the next space outside of
+// quotes, and where the term ends. Quotes are removed.
+func token(s string, i int) (string, int, error) {
+	var b strings.Builder
+	for i < len(s) && !isSpace(s[i]) {
+		if s[i] != '"' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		end := strings.IndexByte(s[i+1:], '"')
+		if end < 0 {
+			return "", 0, errorf(i, "there's no closing quote")
+		}
+		b.WriteString(s[i+1 : i+1+end])
+		i += end + 2
+	}
+	return b.String(), i, nil
+}
+
+func parseTerm(tok string, pos int) (Term, error) {
+	if (tok[0] == '-' || tok[0] == '+') && len(tok) > 1 && !strings.Contains(tok, ":") {
+		f, ok := fieldAliases[strings.ToLower(tok[1:])]
+		if !ok || !flags[f] {
+			return Term{}, errorf(pos, "%q isn't something to include or exclude, try %s", tok, fieldList(true))
+		}
+		v := "yes"
+		if tok[0] == '-' {
+			v = "no"
+		}
+		return Term{Field: f, Value: v, Pos: pos}, nil
+	}
+
+	i := strings.IndexByte(tok, ':')
+	if i < 0 {
+		return Term{Field: FieldText, Value: tok, Pos: pos}, nil
+	}
+	f, ok := fieldAliases[strings.ToLower(tok[:i])]
+	if !ok {
+		return Term{}, errorf(pos, "%q isn't a field, try %s", tok[:i], fieldList(false))
+	}
+	if tok[i+1:] == "" {
+		return Term{}, errorf(pos, "%s needs a value", tok[:i+1])
+	}
+	return Term{Field: f, Value: tok[i+1:], Pos: pos}, nil
+}
+
+// Filter translates q's fields into a filter for our datastore. Plain
+// words aren't part of it, see Text. Names given more than once are
+// joined with spaces.
+func (q Query) Filter() (model.SpellFilter, error) {
+	f := model.SpellFilter{}
+	var names []string
+	seen := make(map[string]int)
+	for _, t := range q {
+		// Fields with one value can only be given once
+		switch t.Field {
+		case FieldText, FieldName, FieldLevel, FieldSchool, FieldSource, FieldCast, FieldArea, FieldDuration:
+		default:
+			if _, ok := seen[t.Field]; ok {
+				return f, errorf(t.Pos, "%s is given more than once", t.Field)
+			}
+		}
+		seen[t.Field] = t.Pos
+
+		switch t.Field {
+		case FieldText:
+		case FieldName:
+			names = append(names, t.Value)
+		case FieldLevel:
+			for _, v := range split(t.Value) {
+				levels, err := ParseLevels(v)
+				if err != nil {
+					return f, errorf(t.Pos, "%s", err.Error())
+				}
+				f.Levels = append(f.Levels, levels...)
+			}
+		case FieldSchool:
+			for _, v := range split(t.Value) {
+				school, ok := School(v)
+				if !ok {
+					return f, errorf(t.Pos, "%q isn't a school of magic", v)
+				}
+				f.Schools = append(f.Schools, school)
+			}
+		case FieldClass:
+			f.Class = t.Value
+		case FieldSource:
+			for _, v := range split(t.Value) {
+				if id, err := strconv.Atoi(v); err == nil {
+					f.SourceIDs = append(f.SourceIDs, id)
+				} else {
+					f.Sources = append(f.Sources, v)
+				}
+			}
+		case FieldDamage:
+			d, ok := spelltext.DamageType(t.Value)
+			if !ok {
+				return f, errorf(t.Pos, "%q isn't a damage type, try %s", t.Value, strings.Join(spelltext.DamageTypes, ", "))
+			}
+			f.DamageType = d
+		case FieldSave:
+			a, ok := spelltext.Ability(t.Value)
+			if !ok {
+				return f, errorf(t.Pos, "%q isn't an ability", t.Value)
+			}
+			f.Save = a
+		case FieldCast, FieldArea, FieldDuration:
+			for _, v := range split(t.Value) {
+				if err := addCasting(&f, t.Field, v); err != nil {
+					return f, errorf(t.Pos, "%s", err.Error())
+				}
+			}
+		case FieldRange:
+			feet, err := ParseMaxFeet(t.Value)
+			if err != nil {
+				return f, errorf(t.Pos, "%s", err.Error())
+			}
+			f.MaxRangeFeet = feet
+		case FieldAttack:
+			switch a := strings.ToLower(t.Value); a {
+			case spelltext.AttackMelee, spelltext.AttackRanged:
+				f.Attack = a
+			default:
+				return f, errorf(t.Pos, "attack is %s or %s, not %q", spelltext.AttackMelee, spelltext.AttackRanged, t.Value)
+			}
+		default:
+			b, ok := ParseYesNo(t.Value)
+			if !ok {
+				return f, errorf(t.Pos, "%s is yes or no, not %q", t.Field, t.Value)
+			}
+			switch t.Field {
+			case FieldVerbal:
+				f.Verbal = &b
+			case FieldSomatic:
+				f.Somatic = &b
+			case FieldMaterial:
+				f.Material = &b
+			case FieldRitual:
+				f.Ritual = &b
+			case FieldConcentration:
+				f.Concentration = &b
+			}
+		}
+	}
+	f.Name = strings.Join(names, " ")
+	return f, nil
+}
+
+// Text is q's plain words, joined with spaces
+func (q Query) Text() string {
+	var words []string
+	for _, t := range q {
+		if t.Field == FieldText {
+			words = append(words, t.Value)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// With returns q with field's terms replaced by field:value, ex. to
+// narrow a search down to one school
+func (q Query) With(field, value string) Query {
+	out := Query{}
+	for _, t := range q {
+		if t.Field != field {
+			out = append(out, t)
+		}
+	}
+	return append(out, Term{Field: field, Value: value})
+}
+
+// String writes q back out as a query Parse reads, with values quoted
+// where they need to be. Term positions aren't kept.
+func (q Query) String() string {
+	terms := make([]string, len(q))
+	for i, t := range q {
+		v := t.Value
+		if strings.ContainsAny(v, " \t\n\r") {
+			v = `"` + strings.Replace(v, `"`, "", -1) + `"`
+		}
+		if t.Field == FieldText {
+			terms[i] = v
+		} else {
+			terms[i] = t.Field + ":" + v
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+// ParseLevels returns the spell levels s describes, in order. s is a
+// level, "cantrip", a range "1-3" or a bound "<3", "<=3", ">3" or
+// ">=3".
+func ParseLevels(s string) ([]int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "cantrip" {
+		return []int{0}, nil
+	}
+
+	min, max := 0, spelltext.MaxSlot
+	var err error
+	switch {
+	case strings.HasPrefix(s, "<="):
+		max, err = level(s[2:])
+	case strings.HasPrefix(s, ">="):
+		min, err = level(s[2:])
+	case strings.HasPrefix(s, "<"):
+		max, err = level(s[1:])
+		max--
+	case strings.HasPrefix(s, ">"):
+		min, err = level(s[1:])
+		min++
+	case strings.Contains(s, "-"):
+		i := strings.Index(s, "-")
+		if min, err = level(s[:i]); err == nil {
+			max, err = level(s[i+1:])
+		}
+	default:
+		min, err = level(s)
+		max = min
+	}
+	if err != nil {
+		return nil, err
+	}
+	if min > max {
+		return nil, fmt.Errorf("no spell is level %s", s)
+	}
+
+	levels := make([]int, 0, max-min+1)
+	for l := min; l <= max; l++ {
+		levels = append(levels, l)
+	}
+	return levels, nil
+}
+
+func level(s string) (int, error) {
+	l, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || l < 0 || l > spelltext.MaxSlot {
+		return 0, fmt.Errorf("level %q isn't 0 to %d", s, spelltext.MaxSlot)
+	}
+	return l, nil
+}
+
+// ParseMaxFeet reads the farthest a range can be from s, a distance
+// in feet or a bound "<=60" or "<60", ex. "60" and "60ft" are both
+// 60 feet
+func ParseMaxFeet(s string) (int, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+	less := 0
+	switch {
+	case strings.HasPrefix(v, "<="):
+		v = v[2:]
+	case strings.HasPrefix(v, "<"):
+		v, less = v[1:], 1
+	}
+	v = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(v, "feet"), "ft"))
+	feet, err := strconv.Atoi(v)
+	if err != nil || feet-less <= 0 {
+		return 0, fmt.Errorf("range %q isn't a distance in feet", s)
+	}
+	return feet - less, nil
+}
+
+// addCasting adds v to f's cast units, area shapes or duration kinds,
+// whichever field is
+func addCasting(f *model.SpellFilter, field, v string) error {
+	switch field {
+	case FieldCast:
+		u, ok := spelltext.CastUnit(v)
+		if !ok {
+			return fmt.Errorf("%q isn't a casting time, try %s", v, strings.Join(spelltext.CastUnits, ", "))
+		}
+		f.CastUnits = append(f.CastUnits, u)
+	case FieldArea:
+		a, ok := spelltext.AreaShape(v)
+		if !ok {
+			return fmt.Errorf("%q isn't an area, try %s", v, strings.Join(spelltext.AreaShapes, ", "))
+		}
+		f.AreaShapes = append(f.AreaShapes, a)
+	case FieldDuration:
+		d, ok := spelltext.DurationKind(v)
+		if !ok {
+			return fmt.Errorf("%q isn't a duration, try %s", v, strings.Join(spelltext.DurationKinds, ", "))
+		}
+		f.DurationKinds = append(f.DurationKinds, d)
+	}
+	return nil
+}
+
+// ParseYesNo reads yes, no, or anything strconv.ParseBool takes
+func ParseYesNo(s string) (bool, bool) {
+	switch s = strings.ToLower(strings.TrimSpace(s)); s {
+	case "yes", "y":
+		return true, true
+	case "no", "n":
+		return false, true
+	}
+	b, err := strconv.ParseBool(s)
+	return b, err == nil
+}
+
+// School returns the school of magic s names or starts with, at least
+// three letters of it, ex. "evoc" is "Evocation"
+func School(s string) (string, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) < 3 {
+		return "", false
+	}
+	for _, school := range Schools {
+		if strings.HasPrefix(strings.ToLower(school), s) {
+			return school, true
+		}
+	}
+	return "", false
+}
+
+// fieldList lists the names of our fields for error messages, only
+// the yes or no ones if onlyFlags
+func fieldList(onlyFlags bool) string {
+	fs := []string{}
+	for alias, f := range fieldAliases {
+		if alias == f && (!onlyFlags || flags[f]) {
+			fs = append(fs, f)
+		}
+	}
+	sort.Strings(fs)
+	return strings.Join(fs, ", ")
+}
+
+func split(s string) []string {
+	var vs []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
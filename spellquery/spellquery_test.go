@@ -0,0 +1,209 @@
+package spellquery
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/murder-hobos/murder-hobos/model"
+)
+
+// parseFilter is Parse then Filter
+func parseFilter(s string) (model.SpellFilter, error) {
+	q, err := Parse(s)
+	if err != nil {
+		return model.SpellFilter{}, err
+	}
+	return q.Filter()
+}
+
+func TestQuery_Filter(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		query string
+		want  model.SpellFilter
+	}{
+		{"", model.SpellFilter{}},
+		{`""`, model.SpellFilter{}},
+		{"fire", model.SpellFilter{}},
+		{`name:fire NAME:"bolt"`, model.SpellFilter{Name: "fire bolt"}},
+		{
+			"fire level:1-3 school:evocation class:wizard ritual:no -concentration",
+			model.SpellFilter{
+				Levels:        []int{1, 2, 3},
+				Schools:       []string{"Evocation"},
+				Class:         "wizard",
+				Ritual:        &no,
+				Concentration: &no,
+			},
+		},
+		{
+			`lvl:cantrip,9 school:abj,NECRO class:"cleric (light)" +v -m dmg:Fire save:con attack:ranged source:1,scag`,
+			model.SpellFilter{
+				Levels:     []int{0, 9},
+				Schools:    []string{"Abjuration", "Necromancy"},
+				Class:      "cleric (light)",
+				Verbal:     &yes,
+				Material:   &no,
+				DamageType: "fire",
+				Save:       "constitution",
+				Attack:     "ranged",
+				SourceIDs:  []int{1},
+				Sources:    []string{"scag"},
+			},
+		},
+		{"level:<=2 level:>8", model.SpellFilter{Levels: []int{0, 1, 2, 9}}},
+		{
+			`cast:"bonus action",Reaction range:<60 area:cone,SPHERE dur:minutes duration:until-dispelled`,
+			model.SpellFilter{
+				CastUnits:     []string{"bonus action", "reaction"},
+				MaxRangeFeet:  59,
+				AreaShapes:    []string{"cone", "sphere"},
+				DurationKinds: []string{"minute", "until dispelled"},
+			},
+		},
+		{"range:120ft", model.SpellFilter{MaxRangeFeet: 120}},
+		{"Level:>=8 conc:yes", model.SpellFilter{Levels: []int{8, 9}, Concentration: &yes}},
+	}
+	for _, tt := range tests {
+		got, err := parseFilter(tt.query)
+		if err != nil {
+			t.Errorf("parseFilter(%q) error = %v", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseFilter(%q) = %+v, want %+v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestQuery_Text(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"", ""},
+		{"fire", "fire"},
+		{`  breathe   level:2 underwater -ritual`, "breathe underwater"},
+		{`"fire bolt" name:ray`, "fire bolt"},
+		{`""`, ""},
+		{`fire "" ""bolt`, "fire bolt"},
+	}
+	for _, tt := range tests {
+		q, err := Parse(tt.query)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.query, err)
+			continue
+		}
+		if got := q.Text(); got != tt.want {
+			t.Errorf("Parse(%q).Text() = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestQuery_With(t *testing.T) {
+	tests := []struct {
+		query, field, value string
+		want                string
+	}{
+		{"", FieldSchool, "Evocation", "school:Evocation"},
+		{"fire level:1-3 school:evoc -ritual", FieldSchool, "Evocation", "fire level:1-3 ritual:no school:Evocation"},
+		{`"fire bolt" lvl:0`, FieldClass, "Cleric (Light)", `"fire bolt" level:0 class:"Cleric (Light)"`},
+		{"level:1 level:2", FieldLevel, "3", "level:3"},
+	}
+	for _, tt := range tests {
+		q, err := Parse(tt.query)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.query, err)
+			continue
+		}
+		got := q.With(tt.field, tt.value).String()
+		if got != tt.want {
+			t.Errorf("Parse(%q).With(%s, %s) = %q, want %q", tt.query, tt.field, tt.value, got, tt.want)
+		}
+		if _, err := Parse(got); err != nil {
+			t.Errorf("Parse(%q) of With's result error = %v", got, err)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		query string
+		pos   int
+		msg   string
+	}{
+		{`class:"cleric (light)`, 6, "no closing quote"},
+		{"fire lvel:3", 5, `"lvel" isn't a field, try area, attack, cast`},
+		{"cast:turn", 0, `"turn" isn't a casting time, try action`},
+		{"area:blob", 0, `"blob" isn't an area, try cone`},
+		{"dur:forever", 0, `"forever" isn't a duration, try instantaneous`},
+		{"range:far", 0, `range "far" isn't a distance in feet`},
+		{"range:<1", 0, `range "<1" isn't a distance in feet`},
+		{"range:5 range:10", 8, "range is given more than once"},
+		{"school:", 0, "school: needs a value"},
+		{"-fire", 0, `"-fire" isn't something to include or exclude, try concentration, material`},
+		{"level:10", 0, `level "10" isn't 0 to 9`},
+		{"level:5-2", 0, "no spell is level 5-2"},
+		{"level:<0", 0, "no spell is level <0"},
+		{"school:ev", 0, `"ev" isn't a school of magic`},
+		{"damage:cheese", 0, `"cheese" isn't a damage type, try acid`},
+		{"save:luck", 0, `"luck" isn't an ability`},
+		{"attack:sideways", 0, `attack is melee or ranged, not "sideways"`},
+		{"ritual:maybe", 0, `ritual is yes or no, not "maybe"`},
+		{"class:wizard class:bard", 13, "class is given more than once"},
+		{"-ritual ritual:yes", 8, "ritual is given more than once"},
+	}
+	for _, tt := range tests {
+		_, err := parseFilter(tt.query)
+		e, ok := err.(*Error)
+		if !ok {
+			t.Errorf("parseFilter(%q) error = %v, want an *Error", tt.query, err)
+			continue
+		}
+		if e.Pos != tt.pos || !strings.Contains(e.Msg, tt.msg) {
+			t.Errorf("parseFilter(%q) error = %d %q, want %d %q", tt.query, e.Pos, e.Msg, tt.pos, tt.msg)
+		}
+	}
+}
+
+func TestParseLevels(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []int
+	}{
+		{"3", []int{3}},
+		{"Cantrip", []int{0}},
+		{"2-4", []int{2, 3, 4}},
+		{"<2", []int{0, 1}},
+		{">=7", []int{7, 8, 9}},
+	}
+	for _, tt := range tests {
+		if got, err := ParseLevels(tt.s); err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseLevels(%q) = %v, %v, want %v", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseMaxFeet(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"60", 60},
+		{"<=60", 60},
+		{"<60", 59},
+		{" 30 feet", 30},
+		{"5ft", 5},
+	}
+	for _, tt := range tests {
+		if got, err := ParseMaxFeet(tt.s); err != nil || got != tt.want {
+			t.Errorf("ParseMaxFeet(%q) = %d, %v, want %d", tt.s, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "0", "-5", "far", "<=0"} {
+		if _, err := ParseMaxFeet(bad); err == nil {
+			t.Errorf("ParseMaxFeet(%q) returned no error", bad)
+		}
+	}
+}
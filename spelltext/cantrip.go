@@ -0,0 +1,133 @@
+package spelltext
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CantripLevels are the character levels cantrips get stronger at.
+// Cantrips scale with a character's total level, not their level in
+// any one class.
+var CantripLevels = [...]int{1, 5, 11, 17}
+
+// CantripRule is one thing about a cantrip that grows as a character
+// levels up, with its value at each of CantripLevels. A value may be
+// empty when the cantrip doesn't have it yet, like Booming Blade's
+// extra damage before 5th level. Fields are tagged for our
+// CantripScaling table.
+type CantripRule struct {
+	// Subject is what grows, ex. "damage", "beams"
+	Subject string `db:"subject"`
+	Level1  string `db:"level_1"`
+	Level5  string `db:"level_5"`
+	Level11 string `db:"level_11"`
+	Level17 string `db:"level_17"`
+}
+
+// Value returns r's value for a character of level charLevel, ex.
+// "3d10" for Fire Bolt's damage at 11th level
+func (r CantripRule) Value(charLevel int) string {
+	switch {
+	case charLevel >= 17:
+		return r.Level17
+	case charLevel >= 11:
+		return r.Level11
+	case charLevel >= 5:
+		return r.Level5
+	}
+	return r.Level1
+}
+
+// At describes r for a character of level charLevel, ex. "damage
+// 3d10 (avg 16.5)", or "" if it doesn't apply yet.
+func (r CantripRule) At(charLevel int) string {
+	v := r.Value(charLevel)
+	if v == "" {
+		return ""
+	}
+	return strings.TrimSpace(r.Subject + " " + withAverage(v))
+}
+
+// String summarizes r, ex. "damage 1d10/2d10/3d10/4d10"
+func (r CantripRule) String() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s/%s/%s/%s", r.Subject, r.Level1, r.Level5, r.Level11, r.Level17))
+}
+
+var (
+	// "This spell's damage increases by 1d10 when you reach 5th level
+	// (2d10), 11th level (3d10), and 17th level (4d10)."
+	cantripDamageRe = regexp.MustCompile(`(?i)damage increases by (\d+d\d+) when you reach 5th level \((\d+d\d+)\),? 11th level \((\d+d\d+)\),? and 17(?:th)? level \((\d+d\d+)\)`)
+
+	// "two beams at 5th level, three beams at 11th level, and four
+	// beams at 17th level"
+	cantripCountRe = regexp.MustCompile(`(?i)(\w+) (\w+) at 5th level, (\w+) \w+ at 11th level,? and (\w+) \w+ at 17th level`)
+
+	// "At 5th level, the melee attack deals an extra 1d8 thunder
+	// damage ... Both damage rolls increase by 1d8 at 11th level and
+	// 17th level."
+	cantripExtraRe = regexp.MustCompile(`(?i)At 5th level, ([^.]+)\.\s*Both damage rolls increase by (\d+d\d+) at 11th level and 17th level`)
+	extraDamageRe  = regexp.MustCompile(`(?i)deals an extra (\d+d\d+) (\w+) damage`)
+	increasesToRe  = regexp.MustCompile(`(?i)^(.+?) increases to (\d+d\d+.*)$`)
+)
+
+// ParseCantrip finds how a cantrip scales with character level in its
+// description. It returns nil if there's nothing it understands.
+func ParseCantrip(desc string) []CantripRule {
+	desc = html.UnescapeString(desc)
+
+	if m := cantripDamageRe.FindStringSubmatch(desc); m != nil {
+		r := CantripRule{Subject: "damage", Level5: m[2], Level11: m[3], Level17: m[4]}
+		r.Level1 = addDice(m[2], m[1], -1)
+		return []CantripRule{r}
+	}
+
+	if m := cantripCountRe.FindStringSubmatch(desc); m != nil {
+		return []CantripRule{{
+			Subject: m[2],
+			Level1:  "1",
+			Level5:  strconv.Itoa(number(m[1])),
+			Level11: strconv.Itoa(number(m[3])),
+			Level17: strconv.Itoa(number(m[4])),
+		}}
+	}
+
+	if m := cantripExtraRe.FindStringSubmatch(desc); m != nil {
+		var rules []CantripRule
+		for _, part := range strings.Split(m[1], ", and ") {
+			r := CantripRule{}
+			if e := extraDamageRe.FindStringSubmatch(part); e != nil {
+				r.Subject = "extra " + e[2] + " damage"
+				r.Level5 = e[1]
+			} else if e := increasesToRe.FindStringSubmatch(strings.TrimSpace(part)); e != nil {
+				r.Subject = subject(e[1])
+				r.Level5 = e[2]
+			} else {
+				continue
+			}
+			r.Level11 = addDice(r.Level5, m[2], 1)
+			r.Level17 = addDice(r.Level5, m[2], 2)
+			rules = append(rules, r)
+		}
+		return rules
+	}
+	return nil
+}
+
+// addDice adds times inc to the dice at the start of expr, keeping
+// whatever follows them, ex. addDice("1d8 + 2", "1d8", 2) is
+// "3d8 + 2". expr is returned as is if the dice don't match.
+func addDice(expr, inc string, times int) string {
+	m := diceRe.FindStringSubmatchIndex(expr)
+	n, sides, ok := splitDice(inc)
+	if m == nil || m[0] != 0 || !ok {
+		return expr
+	}
+	count, _ := strconv.Atoi(expr[m[2]:m[3]])
+	if s, _ := strconv.Atoi(expr[m[4]:m[5]]); s != sides || count+n*times < 1 {
+		return expr
+	}
+	return fmt.Sprintf("%dd%d", count+n*times, sides) + expr[m[1]:]
+}
@@ -0,0 +1,77 @@
+package spelltext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCantrip(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want []CantripRule
+	}{
+		{
+			"Fire Bolt",
+			"A flammable object hit by this spell ignites if it isn&#39;t being worn or carried.\n\n" +
+				"This spell&#39;s damage increases by 1d10 when you reach 5th level (2d10), 11th level (3d10), and 17th level (4d10).",
+			[]CantripRule{{Subject: "damage", Level1: "1d10", Level5: "2d10", Level11: "3d10", Level17: "4d10"}},
+		},
+		{
+			"Poison Spray",
+			"This spell&#39;s damage increases by 1d12 when you reach 5th level (2d12), 11th level (3d12), and 17 level (4d12).",
+			[]CantripRule{{Subject: "damage", Level1: "1d12", Level5: "2d12", Level11: "3d12", Level17: "4d12"}},
+		},
+		{
+			"Eldritch Blast",
+			"The spell creates more than one beam when you reach higher levels - two beams at 5th level, three beams at 11th level, and four beams at 17th level.",
+			[]CantripRule{{Subject: "beams", Level1: "1", Level5: "2", Level11: "3", Level17: "4"}},
+		},
+		{
+			"Booming Blade",
+			"This spell&#39;s damage increases when you reach higher levels. At 5th level, the melee attack deals an extra 1d8 thunder damage to the target, and the damage the target takes for moving increases to 2d8. Both damage rolls increase by 1d8 at 11th level and 17th level.",
+			[]CantripRule{
+				{Subject: "extra thunder damage", Level5: "1d8", Level11: "2d8", Level17: "3d8"},
+				{Subject: "damage the target takes for moving", Level5: "2d8", Level11: "3d8", Level17: "4d8"},
+			},
+		},
+		{
+			"Light",
+			"You touch one object that is no larger than 10 feet in any dimension.",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		if got := ParseCantrip(tt.desc); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ParseCantrip() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCantripRule_At(t *testing.T) {
+	fireBolt := CantripRule{Subject: "damage", Level1: "1d10", Level5: "2d10", Level11: "3d10", Level17: "4d10"}
+	blast := CantripRule{Subject: "beams", Level1: "1", Level5: "2", Level11: "3", Level17: "4"}
+	extra := CantripRule{Subject: "extra fire damage", Level5: "1d8", Level11: "2d8", Level17: "3d8"}
+	second := CantripRule{Subject: "damage", Level5: "1d8 + your spellcasting ability modifier"}
+
+	tests := []struct {
+		r     CantripRule
+		level int
+		want  string
+	}{
+		{fireBolt, 0, "damage 1d10 (avg 5.5)"},
+		{fireBolt, 4, "damage 1d10 (avg 5.5)"},
+		{fireBolt, 5, "damage 2d10 (avg 11)"},
+		{fireBolt, 16, "damage 3d10 (avg 16.5)"},
+		{fireBolt, 20, "damage 4d10 (avg 22)"},
+		{blast, 11, "beams 3"},
+		{extra, 1, ""},
+		{extra, 17, "extra fire damage 3d8 (avg 13.5)"},
+		{second, 5, "damage 1d8 + your spellcasting ability modifier"},
+	}
+	for _, tt := range tests {
+		if got := tt.r.At(tt.level); got != tt.want {
+			t.Errorf("%v.At(%d) = %q, want %q", tt.r, tt.level, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package spelltext
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Unbounded stands in for distances and times with no limit, like a
+// range of Sight or a duration of Until dispelled, so they sort after
+// everything else.
+const Unbounded = math.MaxInt32
+
+// RoundSeconds is how long a round of combat lasts
+const RoundSeconds = 6
+
+// Units of ParsedCastTime
+const (
+	CastAction      = "action"
+	CastBonusAction = "bonus action"
+	CastReaction    = "reaction"
+	CastMinute      = "minute"
+	CastHour        = "hour"
+	// CastSpecial is anything we can't make sense of
+	CastSpecial = "special"
+)
+
+// Kinds of ParsedRange
+const (
+	RangeSelf      = "self"
+	RangeTouch     = "touch"
+	RangeFeet      = "feet"
+	RangeMiles     = "miles"
+	RangeSight     = "sight"
+	RangeUnlimited = "unlimited"
+	RangeSpecial   = "special"
+)
+
+// Kinds of ParsedDuration
+const (
+	DurationInstantaneous = "instantaneous"
+	DurationRound         = "round"
+	DurationMinute        = "minute"
+	DurationHour          = "hour"
+	DurationDay           = "day"
+	DurationDispelled     = "until dispelled"
+	DurationSpecial       = "special"
+)
+
+// FeetPerMile converts mile ranges to feet
+const FeetPerMile = 5280
+
+var (
+	// CastUnits are the units a ParsedCastTime can have
+	CastUnits = []string{CastAction, CastBonusAction, CastReaction, CastMinute, CastHour, CastSpecial}
+	// AreaShapes are the shapes a ParsedRange's area can have
+	AreaShapes = []string{"cone", "cube", "cylinder", "hemisphere", "line", "radius", "sphere"}
+	// DurationKinds are the kinds a ParsedDuration can have
+	DurationKinds = []string{
+		DurationInstantaneous, DurationRound, DurationMinute, DurationHour,
+		DurationDay, DurationDispelled, DurationSpecial,
+	}
+)
+
+// CastUnit returns s as one of CastUnits, ex. "Bonus-Action" is
+// "bonus action"
+func CastUnit(s string) (string, bool) {
+	return oneOf(s, CastUnits)
+}
+
+// AreaShape returns s as one of AreaShapes
+func AreaShape(s string) (string, bool) {
+	return oneOf(s, AreaShapes)
+}
+
+// DurationKind returns s as one of DurationKinds, ex. "minutes" is
+// "minute"
+func DurationKind(s string) (string, bool) {
+	return oneOf(s, DurationKinds)
+}
+
+// oneOf finds s in vs, ignoring case, a plural s, and dashes or
+// underscores written for spaces
+func oneOf(s string, vs []string) (string, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.NewReplacer("-", " ", "_", " ").Replace(s)
+	for _, v := range vs {
+		if s == v || s == v+"s" {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// ParsedCastTime is a spell's casting time, ex. "1 bonus action".
+// Fields are tagged for our Spell table.
+type ParsedCastTime struct {
+	CastAmount int    `db:"cast_amount"`
+	CastUnit   string `db:"cast_unit"`
+	// CastTrigger is when a reaction is taken, ex. "which you take
+	// when you see a creature within 60 feet of you casting a spell"
+	CastTrigger string `db:"cast_trigger"`
+	// CastSeconds is the casting time in seconds for sorting, actions
+	// of any kind take a round
+	CastSeconds int `db:"cast_seconds"`
+}
+
+// ParsedRange is a spell's range, ex. "120 feet" or "Self (15-foot
+// cube)". Fields are tagged for our Spell table.
+type ParsedRange struct {
+	RangeKind string `db:"range_kind"`
+	// RangeDistance is in RangeKind's unit, 1 for "1 mile"
+	RangeDistance int `db:"range_distance"`
+	// RangeFeet is the range in feet for filtering and sorting. Self
+	// is 0, touch is 5, sight and unlimited are Unbounded.
+	RangeFeet int `db:"range_feet"`
+	// AreaShape is the shape of a self range's area, ex. "cone",
+	// "radius", "sphere"
+	AreaShape string `db:"area_shape"`
+	// AreaFeet is the size of AreaShape in feet
+	AreaFeet int `db:"area_feet"`
+}
+
+// ParsedDuration is a spell's duration, ex. "Concentration, up to 1
+// minute". Fields other than Concentration are tagged for our Spell
+// table.
+type ParsedDuration struct {
+	DurationKind   string `db:"duration_kind"`
+	DurationAmount int    `db:"duration_amount"`
+	// DurationSeconds is the duration in seconds for sorting, until
+	// dispelled is Unbounded
+	DurationSeconds int  `db:"duration_seconds"`
+	Concentration   bool `db:"-"`
+}
+
+var (
+	castTimeRe = regexp.MustCompile(`(?i)^(\d+) (action|bonus action|reaction|minute|hour)s?$`)
+	distanceRe = regexp.MustCompile(`(?i)^(\d+) (foot|feet|mile|miles)$`)
+	selfAreaRe = regexp.MustCompile(`(?i)^self\s*\((\d+)[- ](foot|mile)[- ](radius|line|cone|cube|sphere|hemisphere|cylinder)(?:[- ](sphere|hemisphere|cylinder))?\)$`)
+	durationRe = regexp.MustCompile(`(?i)^(\d+) (round|minute|hour|day)s?$`)
+)
+
+var unitSeconds = map[string]int{
+	CastAction:      RoundSeconds,
+	CastBonusAction: RoundSeconds,
+	CastReaction:    RoundSeconds,
+	DurationRound:   RoundSeconds,
+	CastMinute:      60,
+	CastHour:        60 * 60,
+	DurationDay:     24 * 60 * 60,
+}
+
+// ParseCastTime parses a casting time like "1 action" or "1 reaction,
+// which you take when you fall". When there's a choice, like "1 action
+// or 8 hours", the first one is used.
+func ParseCastTime(s string) ParsedCastTime {
+	// A reaction's trigger can have an " or " of its own
+	s, trigger := clean(s), ""
+	if i := strings.Index(s, ","); i >= 0 {
+		s, trigger = s[:i], strings.TrimSpace(s[i+1:])
+	}
+	m := castTimeRe.FindStringSubmatch(firstAlternative(s))
+	if m == nil {
+		return ParsedCastTime{CastUnit: CastSpecial}
+	}
+	n, _ := strconv.Atoi(m[1])
+	unit := strings.ToLower(m[2])
+	return ParsedCastTime{
+		CastAmount:  n,
+		CastUnit:    unit,
+		CastTrigger: trigger,
+		CastSeconds: n * unitSeconds[unit],
+	}
+}
+
+// ParseRange parses a range like "Touch", "60 feet" or "Self (60-foot
+// cone)".
+func ParseRange(s string) ParsedRange {
+	s = clean(s)
+	switch strings.ToLower(s) {
+	case RangeSelf:
+		return ParsedRange{RangeKind: RangeSelf}
+	case RangeTouch:
+		return ParsedRange{RangeKind: RangeTouch, RangeFeet: 5}
+	case RangeSight:
+		return ParsedRange{RangeKind: RangeSight, RangeFeet: Unbounded}
+	case RangeUnlimited:
+		return ParsedRange{RangeKind: RangeUnlimited, RangeFeet: Unbounded}
+	}
+
+	if m := distanceRe.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if strings.HasPrefix(strings.ToLower(m[2]), "mile") {
+			return ParsedRange{RangeKind: RangeMiles, RangeDistance: n, RangeFeet: n * FeetPerMile}
+		}
+		return ParsedRange{RangeKind: RangeFeet, RangeDistance: n, RangeFeet: n}
+	}
+
+	if m := selfAreaRe.FindStringSubmatch(s); m != nil {
+		size, _ := strconv.Atoi(m[1])
+		if strings.ToLower(m[2]) == "mile" {
+			size *= FeetPerMile
+		}
+		shape := m[3]
+		if m[4] != "" {
+			// "10-foot-radius sphere" is a sphere
+			shape = m[4]
+		}
+		return ParsedRange{RangeKind: RangeSelf, AreaShape: strings.ToLower(shape), AreaFeet: size}
+	}
+	return ParsedRange{RangeKind: RangeSpecial}
+}
+
+// ParseDuration parses a duration like "Instantaneous" or
+// "Concentration, up to 10 minutes". When there's a choice, like
+// "Instantaneous or 1 hour (see below)", the first one is used.
+func ParseDuration(s string) ParsedDuration {
+	s = clean(s)
+	d := ParsedDuration{}
+	if l := strings.ToLower(s); strings.HasPrefix(l, "concentration,") {
+		d.Concentration = true
+		s = strings.TrimSpace(s[len("concentration,"):])
+	}
+	l := strings.ToLower(s)
+	if strings.HasPrefix(l, "up to ") {
+		s = s[len("up to "):]
+		l = l[len("up to "):]
+	}
+
+	switch {
+	case strings.HasPrefix(l, DurationDispelled):
+		d.DurationKind = DurationDispelled
+		d.DurationSeconds = Unbounded
+		return d
+	case firstAlternative(l) == DurationInstantaneous:
+		d.DurationKind = DurationInstantaneous
+		return d
+	}
+
+	m := durationRe.FindStringSubmatch(firstAlternative(s))
+	if m == nil {
+		d.DurationKind = DurationSpecial
+		return d
+	}
+	d.DurationAmount, _ = strconv.Atoi(m[1])
+	d.DurationKind = strings.ToLower(m[2])
+	d.DurationSeconds = d.DurationAmount * unitSeconds[d.DurationKind]
+	return d
+}
+
+// clean unescapes s and trims its spaces
+func clean(s string) string {
+	return strings.TrimSpace(html.UnescapeString(s))
+}
+
+// firstAlternative returns the part of s before any " or "
+func firstAlternative(s string) string {
+	if i := strings.Index(strings.ToLower(s), " or "); i >= 0 {
+		return strings.TrimSpace(s[:i])
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package spelltext
+
+import "testing"
+
+func TestParseCastTime(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ParsedCastTime
+	}{
+		{"1 action", ParsedCastTime{CastAmount: 1, CastUnit: CastAction, CastSeconds: 6}},
+		{"1 bonus action", ParsedCastTime{CastAmount: 1, CastUnit: CastBonusAction, CastSeconds: 6}},
+		{"10 minutes", ParsedCastTime{CastAmount: 10, CastUnit: CastMinute, CastSeconds: 600}},
+		{"1 action or 8 hours", ParsedCastTime{CastAmount: 1, CastUnit: CastAction, CastSeconds: 6}},
+		{
+			"1 reaction, which you take when you take acid, cold, fire, lightning, or thunder damage",
+			ParsedCastTime{
+				CastAmount:  1,
+				CastUnit:    CastReaction,
+				CastTrigger: "which you take when you take acid, cold, fire, lightning, or thunder damage",
+				CastSeconds: 6,
+			},
+		},
+		{"Whenever", ParsedCastTime{CastUnit: CastSpecial}},
+	}
+	for _, tt := range tests {
+		if got := ParseCastTime(tt.in); got != tt.want {
+			t.Errorf("ParseCastTime(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ParsedRange
+	}{
+		{"Self", ParsedRange{RangeKind: RangeSelf}},
+		{"Touch", ParsedRange{RangeKind: RangeTouch, RangeFeet: 5}},
+		{"60 feet", ParsedRange{RangeKind: RangeFeet, RangeDistance: 60, RangeFeet: 60}},
+		{"1 mile", ParsedRange{RangeKind: RangeMiles, RangeDistance: 1, RangeFeet: 5280}},
+		{"Sight", ParsedRange{RangeKind: RangeSight, RangeFeet: Unbounded}},
+		{"Unlimited", ParsedRange{RangeKind: RangeUnlimited, RangeFeet: Unbounded}},
+		{"Self (60 foot cone)", ParsedRange{RangeKind: RangeSelf, AreaShape: "cone", AreaFeet: 60}},
+		{"Self (15-foot-radius)", ParsedRange{RangeKind: RangeSelf, AreaShape: "radius", AreaFeet: 15}},
+		{"Self (10-foot-radius sphere)", ParsedRange{RangeKind: RangeSelf, AreaShape: "sphere", AreaFeet: 10}},
+		{"Self (5-mile radius)", ParsedRange{RangeKind: RangeSelf, AreaShape: "radius", AreaFeet: 26400}},
+		{"Special", ParsedRange{RangeKind: RangeSpecial}},
+	}
+	for _, tt := range tests {
+		if got := ParseRange(tt.in); got != tt.want {
+			t.Errorf("ParseRange(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ParsedDuration
+	}{
+		{"Instantaneous", ParsedDuration{DurationKind: DurationInstantaneous}},
+		{"Instantaneous or 1 hour (see below)", ParsedDuration{DurationKind: DurationInstantaneous}},
+		{"1 round", ParsedDuration{DurationKind: DurationRound, DurationAmount: 1, DurationSeconds: 6}},
+		{"Up to 8 hours", ParsedDuration{DurationKind: DurationHour, DurationAmount: 8, DurationSeconds: 28800}},
+		{
+			"Concentration, up to 10 minute",
+			ParsedDuration{DurationKind: DurationMinute, DurationAmount: 10, DurationSeconds: 600, Concentration: true},
+		},
+		{
+			"Concentration, up to 1 day",
+			ParsedDuration{DurationKind: DurationDay, DurationAmount: 1, DurationSeconds: 86400, Concentration: true},
+		},
+		{"Until dispelled or triggered", ParsedDuration{DurationKind: DurationDispelled, DurationSeconds: Unbounded}},
+		{"Special", ParsedDuration{DurationKind: DurationSpecial}},
+	}
+	for _, tt := range tests {
+		if got := ParseDuration(tt.in); got != tt.want {
+			t.Errorf("ParseDuration(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCastUnit_AreaShape_DurationKind(t *testing.T) {
+	tests := []struct {
+		lookup func(string) (string, bool)
+		in     string
+		want   string
+		ok     bool
+	}{
+		{CastUnit, "Bonus-Action", CastBonusAction, true},
+		{CastUnit, "reactions", CastReaction, true},
+		{CastUnit, "turn", "", false},
+		{AreaShape, "CONE", "cone", true},
+		{AreaShape, "blob", "", false},
+		{DurationKind, "minutes", DurationMinute, true},
+		{DurationKind, "until_dispelled", DurationDispelled, true},
+		{DurationKind, "", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := tt.lookup(tt.in); got != tt.want || ok != tt.ok {
+			t.Errorf("lookup(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
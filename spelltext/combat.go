@@ -0,0 +1,131 @@
+package spelltext
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DamageTypes are every type of damage a spell can deal
+var DamageTypes = []string{
+	"acid", "bludgeoning", "cold", "fire", "force", "lightning", "necrotic",
+	"piercing", "poison", "psychic", "radiant", "slashing", "thunder",
+}
+
+// Abilities are the six ability scores, in character sheet order
+var Abilities = []string{
+	"strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma",
+}
+
+// Attack types for filtering, see ParsedAttack
+const (
+	AttackMelee  = "melee"
+	AttackRanged = "ranged"
+)
+
+// ParsedAttack is whether a spell makes spell attacks. Fields are tagged
+// for our Spell table.
+type ParsedAttack struct {
+	MeleeAttack  bool `db:"melee_attack"`
+	RangedAttack bool `db:"ranged_attack"`
+}
+
+var (
+	damageTypes  = `(?:` + strings.Join(DamageTypes, "|") + `)`
+	damageListRe = `(` + damageTypes + `(?:(?:, |,? or |,? and )` + damageTypes + `)*)`
+
+	damageTypeRe = regexp.MustCompile(`(?i)\b` + damageTypes + `\b`)
+	// "3d6 fire damage" or "acid, cold, fire, lightning, or thunder
+	// damage"
+	damageRe = regexp.MustCompile(`(?i)\b` + damageListRe + ` damage\b`)
+	// "You choose acid, cold, fire, lightning, poison, or thunder for
+	// the type of orb you create" or "choose one of the following
+	// damage types - acid, cold, fire, lightning, or thunder"
+	damageChoiceRe = regexp.MustCompile(`(?i)\bchoose (?:one of the following damage types\s*[-:]\s*)?` + damageListRe + `\b`)
+	// "You have resistance to fire damage" doesn't deal any
+	defenseRe = regexp.MustCompile(`(?i)\b(?:resistance|resistant|immune|immunity|vulnerable|vulnerability) to $`)
+	// "a Dexterity saving throw", not "Dexterity saving throws", which
+	// is about every save a creature makes
+	saveRe   = regexp.MustCompile(`(?i)\ban? (` + strings.Join(Abilities, "|") + `) saving throw\b`)
+	attackRe = regexp.MustCompile(`(?i)\b(melee|ranged) spell attack`)
+)
+
+// ParseDamageTypes finds the types of damage a spell description deals,
+// sorted and without duplicates. Damage a spell only protects from,
+// like "resistance to cold damage", isn't included.
+func ParseDamageTypes(desc string) []string {
+	desc = html.UnescapeString(desc)
+	found := make(map[string]bool)
+	for _, re := range []*regexp.Regexp{damageRe, damageChoiceRe} {
+		for _, m := range re.FindAllStringSubmatchIndex(desc, -1) {
+			if defenseRe.MatchString(desc[:m[0]]) {
+				continue
+			}
+			for _, t := range damageTypeRe.FindAllString(desc[m[2]:m[3]], -1) {
+				found[strings.ToLower(t)] = true
+			}
+		}
+	}
+	return sortedKeys(found)
+}
+
+// ParseSaves finds the abilities a spell description calls for saving
+// throws of, sorted and without duplicates.
+func ParseSaves(desc string) []string {
+	desc = html.UnescapeString(desc)
+	found := make(map[string]bool)
+	for _, m := range saveRe.FindAllStringSubmatch(desc, -1) {
+		found[strings.ToLower(m[1])] = true
+	}
+	return sortedKeys(found)
+}
+
+// ParseAttack finds whether a spell description makes melee or ranged
+// spell attacks.
+func ParseAttack(desc string) ParsedAttack {
+	a := ParsedAttack{}
+	for _, m := range attackRe.FindAllStringSubmatch(html.UnescapeString(desc), -1) {
+		if strings.EqualFold(m[1], AttackMelee) {
+			a.MeleeAttack = true
+		} else {
+			a.RangedAttack = true
+		}
+	}
+	return a
+}
+
+// Ability returns the full name of an ability from its name or
+// abbreviation, ex. "Dex" is "dexterity".
+func Ability(s string) (string, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) < 3 {
+		return "", false
+	}
+	for _, a := range Abilities {
+		if strings.HasPrefix(a, s) {
+			return a, true
+		}
+	}
+	return "", false
+}
+
+// DamageType returns s as one of DamageTypes
+func DamageType(s string) (string, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, t := range DamageTypes {
+		if t == s {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+func sortedKeys(m map[string]bool) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
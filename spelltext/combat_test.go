@@ -0,0 +1,91 @@
+package spelltext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDamageTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want []string
+	}{
+		{"Fireball", "Each creature in a 20-foot-radius sphere must make a Dexterity saving throw. A target takes 8d6 fire damage on a failed save.", []string{"fire"}},
+		{
+			"Glyph of Warding",
+			"A creature takes 5d8 acid, cold, fire, lightning, or thunder damage on a failed saving throw",
+			[]string{"acid", "cold", "fire", "lightning", "thunder"},
+		},
+		{
+			"Chromatic Orb",
+			"You choose acid, cold, fire, lightning, poison, or thunder for the type of orb you create",
+			[]string{"acid", "cold", "fire", "lightning", "poison", "thunder"},
+		},
+		{
+			"Fire Shield",
+			"The warm shield grants you resistance to cold damage. The attacker takes 2d8 fire damage.",
+			[]string{"fire"},
+		},
+		{"Protection from Poison", "it has resistance to poison damage for the duration.", []string{}},
+		{"Light", "You touch one object.", []string{}},
+	}
+	for _, tt := range tests {
+		if got := ParseDamageTypes(tt.desc); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ParseDamageTypes() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseSaves(t *testing.T) {
+	tests := []struct {
+		desc string
+		want []string
+	}{
+		{"must make a Dexterity saving throw", []string{"dexterity"}},
+		{"must succeed on a Constitution saving throw. Then a Wisdom saving throw, and another Constitution saving throw.", []string{"constitution", "wisdom"}},
+		// Not a save the spell calls for
+		{"The target has disadvantage on Dexterity saving throws.", []string{}},
+		{"make an Intelligence saving throw", []string{"intelligence"}},
+	}
+	for _, tt := range tests {
+		if got := ParseSaves(tt.desc); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseSaves(%q) = %v, want %v", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestParseAttack(t *testing.T) {
+	tests := []struct {
+		desc string
+		want ParsedAttack
+	}{
+		{"Make a ranged spell attack against the target.", ParsedAttack{RangedAttack: true}},
+		{"Make a melee spell attack against the creature.", ParsedAttack{MeleeAttack: true}},
+		{"make a melee attack with a weapon", ParsedAttack{}},
+	}
+	for _, tt := range tests {
+		if got := ParseAttack(tt.desc); got != tt.want {
+			t.Errorf("ParseAttack(%q) = %+v, want %+v", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestAbility(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"Dex", "dexterity", true},
+		{"wisdom", "wisdom", true},
+		{" CON ", "constitution", true},
+		{"st", "", false},
+		{"luck", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := Ability(tt.in); got != tt.want || ok != tt.ok {
+			t.Errorf("Ability(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
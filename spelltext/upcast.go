@@ -0,0 +1,443 @@
+// Package spelltext pulls structured rules out of the prose in spell
+// descriptions, like what happens when a spell is cast with a higher
+// level spell slot.
+//
+// The compendium only has these rules as English, so parsing is a best
+// effort based on the phrasings it actually uses. Anything we can't make
+// sense of is left out, it's still in the description.
+package spelltext
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/murder-hobos/murder-hobos/dice"
+)
+
+// Kinds of UpcastRule
+const (
+	// UpcastDice rules add dice for every slot level, "the damage
+	// increases by 1d6 for each slot level above 3rd"
+	UpcastDice = "dice"
+	// UpcastAmount rules add a number of something for every slot
+	// level, "you can target one additional creature for each slot
+	// level above 1st" or "the duration increases by 1 hour ..."
+	UpcastAmount = "amount"
+	// UpcastAt rules apply from a given slot level on, "When you use a
+	// spell slot of 5th level or higher, the duration is 8 hours"
+	UpcastAt = "at"
+)
+
+// MaxSlot is the highest level spell slot
+const MaxSlot = 9
+
+// UpcastRule is one structured "At Higher Levels" rule. Fields are
+// tagged for our SpellUpcasts table.
+type UpcastRule struct {
+	Kind string `db:"kind"`
+	// Subject is what changes, ex. "fire damage", "duration"
+	Subject string `db:"subject"`
+
+	// For UpcastDice rules, Dice is added for each step, starting
+	// from BaseDice if we found it, and never beyond MaxDice if the
+	// spell has a maximum.
+	BaseDice string `db:"base_dice"`
+	Dice     string `db:"dice"`
+	MaxDice  string `db:"max_dice"`
+
+	// For UpcastAmount rules, Amount of Unit is added for each step.
+	// Unit may be what's being counted, like "dart", or empty if
+	// Subject is just a number.
+	Amount int    `db:"amount"`
+	Unit   string `db:"unit"`
+
+	// Dice and amount rules take a step every Every slot levels above
+	// AboveSlot.
+	AboveSlot int `db:"above_slot"`
+	Every     int `db:"every"`
+
+	// UpcastAt rules describe the spell with Text from Slot on
+	Slot int    `db:"slot"`
+	Text string `db:"text"`
+}
+
+// Steps is how many times r's increase applies for a spell slot of
+// level slot
+func (r UpcastRule) Steps(slot int) int {
+	every := r.Every
+	if every < 1 {
+		every = 1
+	}
+	if slot <= r.AboveSlot {
+		return 0
+	}
+	return (slot - r.AboveSlot) / every
+}
+
+// At describes the effect of r on a spell cast with a slot of level
+// slot, ex. "fire damage 10d6 (avg 35)", or "" if it makes no
+// difference.
+func (r UpcastRule) At(slot int) string {
+	switch r.Kind {
+	case UpcastDice:
+		steps := r.Steps(slot)
+		if steps == 0 {
+			return ""
+		}
+		d, ok := r.diceAt(steps)
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(r.Subject + " " + withAverage(d))
+	case UpcastAmount:
+		n := r.Steps(slot) * r.Amount
+		if n == 0 {
+			return ""
+		}
+		return strings.TrimSpace(fmt.Sprintf("%s +%d %s", r.Subject, n, plural(r.Unit, n)))
+	case UpcastAt:
+		if slot < r.Slot {
+			return ""
+		}
+		return r.Text
+	}
+	return ""
+}
+
+// String summarizes r, ex. "damage 8d6 +1d6 per slot above 3" or
+// "from slot 5: the duration is 8 hours"
+func (r UpcastRule) String() string {
+	per := "per slot"
+	if r.Every > 1 {
+		per = fmt.Sprintf("per %d slots", r.Every)
+	}
+	switch r.Kind {
+	case UpcastDice:
+		s := strings.Join(strings.Fields(fmt.Sprintf("%s %s +%s %s above %d", r.Subject, r.BaseDice, r.Dice, per, r.AboveSlot)), " ")
+		if r.MaxDice != "" {
+			s += " (max " + r.MaxDice + ")"
+		}
+		return s
+	case UpcastAmount:
+		return strings.TrimSpace(fmt.Sprintf("%s +%d %s", r.Subject, r.Amount, r.Unit)) +
+			fmt.Sprintf(" %s above %d", per, r.AboveSlot)
+	case UpcastAt:
+		return fmt.Sprintf("from slot %d: %s", r.Slot, r.Text)
+	}
+	return r.Kind
+}
+
+// withAverage adds the average roll to dice expressions, ex.
+// "10d6 (avg 35)". Anything else, including dice with a modifier we
+// don't know, is returned as is.
+func withAverage(expr string) string {
+	if !diceRe.MatchString(expr) {
+		return expr
+	}
+	e, err := dice.Parse(expr)
+	if err != nil || len(e.Vars()) > 0 {
+		return expr
+	}
+	return fmt.Sprintf("%s (avg %s)", expr, strconv.FormatFloat(e.Average(), 'f', -1, 64))
+}
+
+// diceAt returns the dice rolled after steps increases, "+3d6" if we
+// don't know what the spell starts with.
+func (r UpcastRule) diceAt(steps int) (string, bool) {
+	n, sides, ok := splitDice(r.Dice)
+	if !ok {
+		return "", false
+	}
+	base, baseSides, ok := splitDice(r.BaseDice)
+	if !ok || baseSides != sides {
+		return fmt.Sprintf("+%dd%d", n*steps, sides), true
+	}
+
+	total := base + n*steps
+	if max, maxSides, ok := splitDice(r.MaxDice); ok && maxSides == sides && total > max {
+		total = max
+	}
+	return fmt.Sprintf("%dd%d", total, sides), true
+}
+
+// SlotEffects is what a spell does differently when cast with a
+// slot of level Slot
+type SlotEffects struct {
+	Slot    int
+	Effects []string
+}
+
+// UpcastTable lists the effects of rules for every slot level a spell
+// of the given level can be cast with, its own level up to 9th.
+// Cantrips aren't cast with slots, so they get no rows. Of the
+// UpcastAt rules, only those with the highest Slot that applies are
+// used, each later one replaces the last.
+func UpcastTable(level int, rules []UpcastRule) []SlotEffects {
+	if level < 1 || len(rules) == 0 {
+		return nil
+	}
+
+	var table []SlotEffects
+	for slot := level; slot <= MaxSlot; slot++ {
+		row := SlotEffects{Slot: slot}
+
+		atSlot := 0
+		for _, r := range rules {
+			if r.Kind == UpcastAt && r.Slot <= slot && r.Slot > atSlot {
+				atSlot = r.Slot
+			}
+		}
+		for _, r := range rules {
+			if r.Kind == UpcastAt && r.Slot != atSlot {
+				continue
+			}
+			if e := r.At(slot); e != "" {
+				row.Effects = append(row.Effects, e)
+			}
+		}
+		table = append(table, row)
+	}
+	return table
+}
+
+var (
+	higherLevelsRe = regexp.MustCompile(`(?i)At Higher Levels:?`)
+	sentenceRe     = regexp.MustCompile(`\.(?:\s+|$)`)
+	diceRe         = regexp.MustCompile(`\b(\d+)d(\d+)\b`)
+	maxDiceRe      = regexp.MustCompile(`(?i)\(?to a maximum of (\d+d\d+)\)?`)
+
+	// "for each slot level above 1st", "for every two slot levels above the 2nd",
+	// "for each slot above 3rd", "for each slot level beyond 4th"
+	perStepRe = regexp.MustCompile(`(?i)for (each|every two|every) slot(?: levels?)? (?:above|beyond) (?:the )?(\d)(?:st|nd|rd|th)`)
+
+	// "When you use a spell slot of 5th level or higher, ...",
+	// "When you cast this spell using a 7th-level spell slot, ...",
+	// "using a spell slot of 3rd or 4th level, ...", "Using a spell slot of ..."
+	atSlotRe = regexp.MustCompile(`(?i)^(?:when|if)?\s*(?:you )?(?:cast this spell )?(?:using|use|with) (?:a |an )?(?:spell slot of )?(\d)(?:st|nd|rd|th)(?:[- ]level)?(?: or \dth)?(?: level)?(?: spell slot| slot)?(?: or higher)?(?: level)?,?\s+(.+)$`)
+
+	// "twice as many with a 5th-level slot, to 30 days with a 7th-level slot"
+	withSlotRe = regexp.MustCompile(`(?i)(?:^|, |- )([^,-]+?) with an? (\d)(?:st|nd|rd|th)-level(?: spell)? slot`)
+
+	countRe  = regexp.MustCompile(`(?i)\b(on|one|two|three|four|five|\d+) (?:additional|more) ([a-z ]+)`)
+	amountRe = regexp.MustCompile(`(?i)increases? by (?:an additional )?(\d+|one|two|three) ?(feet|foot|pounds?|hours?|minutes?|days?)?\b`)
+	splitRe  = regexp.MustCompile(`(?i),? and (?:the |both )`)
+)
+
+var numbers = map[string]int{"on": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5}
+
+// ParseUpcast finds the "At Higher Levels" rules in a spell's
+// description. It returns nil if there aren't any it understands.
+func ParseUpcast(desc string) []UpcastRule {
+	// Our descriptions are stored html escaped
+	desc = html.UnescapeString(desc)
+	loc := higherLevelsRe.FindStringIndex(desc)
+	if loc == nil {
+		return nil
+	}
+	before, text := desc[:loc[0]], desc[loc[1]:]
+	if i := strings.Index(text, "\n"); i >= 0 {
+		text = text[:i]
+	}
+
+	var rules []UpcastRule
+	for _, sentence := range sentenceRe.Split(strings.TrimSpace(text), -1) {
+		sentence = strings.TrimSpace(sentence)
+		if sentence == "" {
+			continue
+		}
+		rules = append(rules, parseSentence(sentence, before)...)
+	}
+	return rules
+}
+
+func parseSentence(sentence, before string) []UpcastRule {
+	step := perStepRe.FindStringSubmatchIndex(sentence)
+	if step == nil {
+		if rules := parseSlotList(sentence); rules != nil {
+			return rules
+		}
+		m := atSlotRe.FindStringSubmatch(sentence)
+		if m == nil {
+			return nil
+		}
+		slot, _ := strconv.Atoi(m[1])
+		return []UpcastRule{{Kind: UpcastAt, Slot: slot, Text: strings.TrimSpace(m[2])}}
+	}
+
+	every := 1
+	if strings.EqualFold(sentence[step[2]:step[3]], "every two") {
+		every = 2
+	}
+	above, _ := strconv.Atoi(sentence[step[4]:step[5]])
+	maxDice := ""
+	if m := maxDiceRe.FindStringSubmatch(sentence); m != nil {
+		maxDice = m[1]
+	}
+
+	// Only what comes after "When you cast this spell using ..., "
+	effect := sentence[:step[0]]
+	if i := strings.Index(effect, ", "); i >= 0 && atSlotRe.MatchString(effect) {
+		effect = effect[i+2:]
+	}
+
+	// "both the temporary hit points and the cold damage increase by
+	// 5", subjects without an increase share the next one's.
+	var rules []UpcastRule
+	var shared []string
+	for _, part := range splitRe.Split(effect, -1) {
+		r, ok := parseIncrease(strings.TrimSpace(part))
+		if !ok {
+			shared = append(shared, subject(part))
+			continue
+		}
+		r.AboveSlot, r.Every = above, every
+		if r.Kind == UpcastDice {
+			r.MaxDice = maxDice
+			r.BaseDice = baseDice(before, r.Dice)
+		}
+		for _, s := range shared {
+			if s != "" && r.Subject != "" {
+				sr := r
+				sr.Subject = s
+				rules = append(rules, sr)
+			}
+		}
+		shared = nil
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// parseSlotList finds "to 30 days with a 7th-level slot" style lists
+// of effects at given slot levels.
+func parseSlotList(sentence string) []UpcastRule {
+	ms := withSlotRe.FindAllStringSubmatchIndex(sentence, -1)
+	if ms == nil {
+		return nil
+	}
+
+	// "the duration increases to 10 days with ..." is about "duration"
+	prefix := ""
+	var rules []UpcastRule
+	for _, m := range ms {
+		text := strings.TrimSpace(sentence[m[2]:m[3]])
+		if i := strings.Index(text, " increases to "); i >= 0 {
+			prefix = subject(text[:i]) + " "
+			text = text[i+len(" increases to "):]
+		}
+		text = strings.TrimPrefix(strings.TrimPrefix(text, "and "), "to ")
+		slot, _ := strconv.Atoi(sentence[m[4]:m[5]])
+		rules = append(rules, UpcastRule{Kind: UpcastAt, Slot: slot, Text: prefix + text})
+	}
+	return rules
+}
+
+// parseIncrease makes sense of what increases each step, like "the
+// cold damage increases by 1d6" or "you can target one additional
+// creature".
+func parseIncrease(part string) (UpcastRule, bool) {
+	if m := diceRe.FindStringIndex(part); m != nil {
+		return UpcastRule{Kind: UpcastDice, Subject: subject(part[:m[0]]), Dice: part[m[0]:m[1]]}, true
+	}
+	if m := countRe.FindStringSubmatch(part); m != nil {
+		return UpcastRule{Kind: UpcastAmount, Amount: number(m[1]), Unit: noun(m[2])}, true
+	}
+	if m := amountRe.FindStringSubmatchIndex(part); m != nil {
+		r := UpcastRule{
+			Kind:    UpcastAmount,
+			Subject: subject(part[:m[0]]),
+			Amount:  number(part[m[2]:m[3]]),
+		}
+		if m[4] >= 0 {
+			r.Unit = part[m[4]:m[5]]
+		}
+		return r, true
+	}
+	return UpcastRule{}, false
+}
+
+// subject cleans up the words before "increases by", "the damage
+// increases by" is about "damage".
+func subject(s string) string {
+	s = strings.TrimSpace(s)
+	for _, suffix := range []string{"increases by", "increase by", "increases", "increase", "roll an additional", "for each of its effects by"} {
+		s = strings.TrimSpace(strings.TrimSuffix(s, suffix))
+	}
+	for _, suffix := range []string{"increases", "increase"} {
+		s = strings.TrimSpace(strings.TrimSuffix(s, suffix))
+	}
+	for _, prefix := range []string{"both the ", "the "} {
+		if strings.HasPrefix(strings.ToLower(s), prefix) {
+			s = s[len(prefix):]
+		}
+	}
+	s = strings.TrimSuffix(s, " of the spell")
+	if strings.EqualFold(s, "both types of damage") {
+		s = "damage"
+	}
+	return s
+}
+
+// noun takes the thing being counted from "additional undead creatures
+// for each", stopping at the first word that isn't part of it.
+func noun(s string) string {
+	stop := map[string]bool{"from": true, "to": true, "that": true, "for": true, "of": true,
+		"in": true, "within": true, "leaps": true, "can": true, "you": true}
+	var words []string
+	for _, w := range strings.Fields(s) {
+		if stop[strings.ToLower(w)] {
+			break
+		}
+		words = append(words, w)
+	}
+	return strings.Join(words, " ")
+}
+
+func number(s string) int {
+	if n, ok := numbers[strings.ToLower(s)]; ok {
+		return n
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// baseDice finds the dice an increase of inc adds to: the first dice
+// in the rest of the description with the same sides.
+func baseDice(desc, inc string) string {
+	_, sides, ok := splitDice(inc)
+	if !ok {
+		return ""
+	}
+	for _, m := range diceRe.FindAllStringSubmatch(desc, -1) {
+		if s, _ := strconv.Atoi(m[2]); s == sides {
+			return m[0]
+		}
+	}
+	return ""
+}
+
+// splitDice splits simple "NdM" dice
+func splitDice(d string) (n, sides int, ok bool) {
+	m := diceRe.FindStringSubmatch(d)
+	if m == nil || m[0] != d {
+		return 0, 0, false
+	}
+	n, _ = strconv.Atoi(m[1])
+	sides, _ = strconv.Atoi(m[2])
+	return n, sides, true
+}
+
+// plural is a naive English plural, good enough for our units
+// and creatures
+func plural(s string, n int) string {
+	if n == 1 || s == "" || strings.HasSuffix(s, "s") || s == "feet" || s == "undead" {
+		return s
+	}
+	if s == "foot" {
+		return "feet"
+	}
+	return s + "s"
+}
@@ -0,0 +1,151 @@
+package spelltext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseUpcast(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want []UpcastRule
+	}{
+		{
+			"Fireball",
+			"Each creature in a 20-foot-radius sphere must make a Dexterity saving throw. A target takes 8d6 fire damage on a failed save.\n\n" +
+				"At Higher Levels: When you cast this spell using a spell slot of 4th level or higher, the damage increases by 1d6 for each slot level above 3rd.",
+			[]UpcastRule{{Kind: UpcastDice, Subject: "damage", BaseDice: "8d6", Dice: "1d6", AboveSlot: 3, Every: 1}},
+		},
+		{
+			"Magic Missile",
+			"You create three glowing darts of magical force. A dart deals 1d4 + 1 force damage to its target.\n\n" +
+				"At Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, the spell creates one more dart for each slot level above 1st.",
+			[]UpcastRule{{Kind: UpcastAmount, Amount: 1, Unit: "dart", AboveSlot: 1, Every: 1}},
+		},
+		{
+			"Hail of Thorns",
+			"the target takes an extra 1d10 piercing damage.\n\n" +
+				"At Higher Levels: If you cast this spell using a spell slot of 2nd level or higher, the damage increases by 1d10 for each slot level above 1st (to a maximum of 6d10).",
+			[]UpcastRule{{Kind: UpcastDice, Subject: "damage", BaseDice: "1d10", Dice: "1d10", MaxDice: "6d10", AboveSlot: 1, Every: 1}},
+		},
+		{
+			"Spiritual Weapon",
+			"you can make a melee spell attack. On a hit, the target takes force damage equal to 1d8 + your spellcasting ability modifier.\n\n" +
+				"At Higher Levels: When you cast this spell using a spell slot of 3rd level or higher, the damage increases by 1d8 for every two slot levels above the 2nd.",
+			[]UpcastRule{{Kind: UpcastDice, Subject: "damage", BaseDice: "1d8", Dice: "1d8", AboveSlot: 2, Every: 2}},
+		},
+		{
+			"Armor of Agathys",
+			"You gain 5 temporary hit points for the duration.\n\n" +
+				"At Higher Levels: When you cast this spell using a spell slot of 2nd level or higher, both the temporary hit points and the cold damage increase by 5 for each slot level above 1st.",
+			[]UpcastRule{
+				{Kind: UpcastAmount, Subject: "temporary hit points", Amount: 5, AboveSlot: 1, Every: 1},
+				{Kind: UpcastAmount, Subject: "cold damage", Amount: 5, AboveSlot: 1, Every: 1},
+			},
+		},
+		{
+			"Mass Suggestion",
+			"The suggestion can continue for the duration.\n\n" +
+				"At Higher Levels: When you cast this spell using a 7th-level spell slot, the duration is 10 days. When you use an 8th-level spell slot, the duration is 30 days. When you use a 9th-level spell slot, the duration is a year and a day.",
+			[]UpcastRule{
+				{Kind: UpcastAt, Slot: 7, Text: "the duration is 10 days"},
+				{Kind: UpcastAt, Slot: 8, Text: "the duration is 30 days"},
+				{Kind: UpcastAt, Slot: 9, Text: "the duration is a year and a day"},
+			},
+		},
+		{
+			"Planar Binding",
+			"you can bind it for the duration.\n\n" +
+				"At Higher Levels: When you cast this spell using a spell slot of a higher level, the duration increases to 10 days with a 6th-level slot, to 30 days with a 7th-level slot, to 180 days with an 8th-level slot, and to a year and a day with a 9th-level spell slot.",
+			[]UpcastRule{
+				{Kind: UpcastAt, Slot: 6, Text: "duration 10 days"},
+				{Kind: UpcastAt, Slot: 7, Text: "duration 30 days"},
+				{Kind: UpcastAt, Slot: 8, Text: "duration 180 days"},
+				{Kind: UpcastAt, Slot: 9, Text: "duration a year and a day"},
+			},
+		},
+		{
+			"escaped",
+			"At Higher Levels: Using a spell slot of 5th level or higher grants a duration that doesn&#39;t require concentration.",
+			[]UpcastRule{{Kind: UpcastAt, Slot: 5, Text: "grants a duration that doesn't require concentration"}},
+		},
+		{
+			"no higher levels",
+			"A flickering flame appears in your hand. It deals 1d8 fire damage.",
+			nil,
+		},
+		{
+			"not understood",
+			"At Higher Levels: When you cast this spell using a spell slot of 5th level or higher, the bonus is better somehow.",
+			[]UpcastRule{{Kind: UpcastAt, Slot: 5, Text: "the bonus is better somehow"}},
+		},
+	}
+	for _, tt := range tests {
+		if got := ParseUpcast(tt.desc); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ParseUpcast() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUpcastRule_At(t *testing.T) {
+	fireball := UpcastRule{Kind: UpcastDice, Subject: "damage", BaseDice: "8d6", Dice: "1d6", AboveSlot: 3, Every: 1}
+	hail := UpcastRule{Kind: UpcastDice, Subject: "damage", BaseDice: "1d10", Dice: "1d10", MaxDice: "6d10", AboveSlot: 1, Every: 1}
+	weapon := UpcastRule{Kind: UpcastDice, Subject: "damage", BaseDice: "1d8", Dice: "1d8", AboveSlot: 2, Every: 2}
+	sleep := UpcastRule{Kind: UpcastDice, Dice: "2d8", AboveSlot: 1, Every: 1}
+	missile := UpcastRule{Kind: UpcastAmount, Amount: 1, Unit: "dart", AboveSlot: 1, Every: 1}
+	fog := UpcastRule{Kind: UpcastAmount, Subject: "radius of the fog", Amount: 20, Unit: "feet", AboveSlot: 1, Every: 1}
+	geas := UpcastRule{Kind: UpcastAt, Slot: 7, Text: "the duration is 1 year"}
+
+	tests := []struct {
+		r    UpcastRule
+		slot int
+		want string
+	}{
+		{fireball, 3, ""},
+		{fireball, 5, "damage 10d6 (avg 35)"},
+		{hail, 9, "damage 6d10 (avg 33)"},
+		{weapon, 3, ""},
+		{weapon, 4, "damage 2d8 (avg 9)"},
+		{weapon, 9, "damage 4d8 (avg 18)"},
+		{sleep, 3, "+4d8 (avg 18)"},
+		{missile, 2, "+1 dart"},
+		{missile, 4, "+3 darts"},
+		{fog, 3, "radius of the fog +40 feet"},
+		{geas, 6, ""},
+		{geas, 8, "the duration is 1 year"},
+	}
+	for _, tt := range tests {
+		if got := tt.r.At(tt.slot); got != tt.want {
+			t.Errorf("%v.At(%d) = %q, want %q", tt.r, tt.slot, got, tt.want)
+		}
+	}
+}
+
+func TestUpcastTable(t *testing.T) {
+	rules := []UpcastRule{
+		{Kind: UpcastAt, Slot: 4, Text: "the duration is concentration, up to 10 minutes"},
+		{Kind: UpcastAt, Slot: 5, Text: "the duration is 8 hours"},
+		{Kind: UpcastAt, Slot: 7, Text: "the duration is 24 hours"},
+		{Kind: UpcastAmount, Amount: 1, Unit: "creature", AboveSlot: 3, Every: 1},
+	}
+	want := []SlotEffects{
+		{Slot: 3},
+		{Slot: 4, Effects: []string{"the duration is concentration, up to 10 minutes", "+1 creature"}},
+		{Slot: 5, Effects: []string{"the duration is 8 hours", "+2 creatures"}},
+		{Slot: 6, Effects: []string{"the duration is 8 hours", "+3 creatures"}},
+		{Slot: 7, Effects: []string{"the duration is 24 hours", "+4 creatures"}},
+		{Slot: 8, Effects: []string{"the duration is 24 hours", "+5 creatures"}},
+		{Slot: 9, Effects: []string{"the duration is 24 hours", "+6 creatures"}},
+	}
+	if got := UpcastTable(3, rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("UpcastTable() = %+v, want %+v", got, want)
+	}
+
+	if got := UpcastTable(0, rules); got != nil {
+		t.Errorf("UpcastTable() of a cantrip = %+v, want nil", got)
+	}
+	if got := UpcastTable(3, nil); got != nil {
+		t.Errorf("UpcastTable() without rules = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,18 @@
+$(function () {
+
+    // Only show the upcast row for the chosen slot level, everything
+    // stays visible without javascript
+    function showSlot(slot) {
+        $('#upcast-table .upcast-slot').each(function () {
+            $(this).toggle(String($(this).data('slot')) === slot);
+        });
+    }
+
+    $('#upcast-slot').change(function () {
+        showSlot($(this).val());
+    });
+    if ($('#upcast-slot').length) {
+        showSlot($('#upcast-slot').val());
+    }
+
+});
@@ -1,34 +1,44 @@
-function Sort() {
-
-    var input, filter, set, li, a, i;
-    input = document.getElementById("mySearch");
-    filter = input.value.toUpperCase();
-    set = document.getElementById("Spell_List");
-    li = set.getElementsByTagName("Spell");
-
-
-    
-
-    // Loops through query and narrows results, not working rn
-    
-        for (i = 0; i < li.length; i++) {
-            a = li[i].getElementsByTagName("")[0];
-            if (a.innerHTML.toUpperCase().indexOf(filter) > -1) {
-                li[i].style.display = "";
-            } else {
-                li[i].style.display = "none";
+$(function () {
+
+    // Suggest spell names from /spell/suggest as they're typed, picking
+    // one goes straight to it. Queries with fields in them are left
+    // alone, we only complete names.
+    var input = $('#spellSearch');
+    var list = $('#spell-suggestions');
+    var urls = {};
+    var timer = null;
+    var latest = '';
+
+    function suggest(q) {
+        latest = q;
+        $.getJSON('/spell/suggest', { q: q }, function (suggestions) {
+            // answers can come back out of order
+            if (q !== latest) {
+                return;
             }
+            urls = {};
+            list.empty();
+            $.each(suggestions, function (i, s) {
+                urls[s.name] = s.url;
+                list.append($('<option>').attr('value', s.name));
+            });
+        });
+    }
+
+    input.on('input', function () {
+        var q = $.trim(input.val());
+        clearTimeout(timer);
+        if (urls[q]) {
+            window.location = urls[q];
+            return;
         }
+        if (q === '' || q.indexOf(':') >= 0 || /(^|\s)[-+]\S/.test(q)) {
+            list.empty();
+            return;
+        }
+        timer = setTimeout(function () {
+            suggest(q);
+        }, 100);
+    });
 
-
-       
-}
-/*var searchGen = {
-  valueNames: [ 'Spells']
-};
-
-var userList = new List('SpellsSearched', searchGen);*/
-
-/*var $ul = $("ul.Spell_List");
-var searchParameters = document.getElementById("mySearch").text;*/
-
+});